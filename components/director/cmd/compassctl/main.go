@@ -0,0 +1,19 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/kyma-incubator/compass/components/director/internal/compassctl"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	exitCode := compassctl.NewCLI(os.Stdin, os.Stdout, os.Stderr, compassctl.NewDirectorFromConfig).Run(ctx, os.Args[1:])
+
+	cancel()
+	os.Exit(exitCode)
+}
@@ -40,10 +40,13 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplate"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateconstraintreferences"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateversion"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/operation"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordcachevalidator"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpushedpayload"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
 	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
@@ -127,9 +130,11 @@ type config struct {
 	MaintainOperationsJobInterval time.Duration `envconfig:"APP_MAINTAIN_OPERATIONS_JOB_INTERVAL,default=60m"`
 
 	ParallelOperationProcessors        int           `envconfig:"APP_PARALLEL_OPERATION_PROCESSORS,default=10"`
+	ParallelPushOperationProcessors    int           `envconfig:"APP_PARALLEL_PUSH_OPERATION_PROCESSORS,default=2"`
 	OperationProcessorQuietPeriod      time.Duration `envconfig:"APP_OPERATION_PROCESSORS_QUIET_PERIOD,default=5s"`
 	MaxParallelDocumentsPerApplication int           `envconfig:"APP_MAX_PARALLEL_DOCUMENTS_PER_APPLICATION"`
 	MaxParallelSpecificationProcessors int           `envconfig:"APP_MAX_PARALLEL_SPECIFICATION_PROCESSORS,default=100"`
+	MaxPushedPayloadSize               int64         `envconfig:"APP_MAX_PUSHED_PAYLOAD_SIZE,default=10485760"`
 
 	SelfRegisterDistinguishLabelKey string `envconfig:"APP_SELF_REGISTER_DISTINGUISH_LABEL_KEY"`
 
@@ -149,10 +154,12 @@ type config struct {
 }
 
 type securityConfig struct {
-	JwksEndpoint        string        `envconfig:"APP_JWKS_ENDPOINT"`
-	JWKSSyncPeriod      time.Duration `envconfig:"default=5m"`
-	AllowJWTSigningNone bool          `envconfig:"APP_ALLOW_JWT_SIGNING_NONE,default=false"`
-	AggregatorSyncScope string        `envconfig:"APP_ORD_AGGREGATOR_SYNC_SCOPE,default=ord_aggregator:sync"`
+	JwksEndpoint            string        `envconfig:"APP_JWKS_ENDPOINT"`
+	JWKSSyncPeriod          time.Duration `envconfig:"default=5m"`
+	AllowJWTSigningNone     bool          `envconfig:"APP_ALLOW_JWT_SIGNING_NONE,default=false"`
+	AggregatorSyncScope     string        `envconfig:"APP_ORD_AGGREGATOR_SYNC_SCOPE,default=ord_aggregator:sync"`
+	AggregatorPushScope     string        `envconfig:"APP_ORD_AGGREGATOR_PUSH_SCOPE,default=ord_aggregator:push"`
+	AggregatorValidateScope string        `envconfig:"APP_ORD_AGGREGATOR_VALIDATE_SCOPE,default=ord_aggregator:validate"`
 }
 
 func main() {
@@ -212,6 +219,8 @@ func main() {
 
 	accessStrategyExecutorProviderWithoutTenant := accessstrategy.NewDefaultExecutorProvider(certCache, cfg.ExternalClientCertSecretName)
 	retryHTTPExecutor := retry.NewHTTPExecutor(&cfg.RetryConfig)
+	// conditional specification requests are answered with 304 Not Modified when the specification is unchanged
+	retryHTTPExecutor.WithAcceptableStatusCodes([]int{http.StatusOK, http.StatusNotModified})
 
 	authConverter := auth.NewConverter()
 	frConverter := fetchrequest.NewConverter(authConverter)
@@ -280,6 +289,7 @@ func main() {
 	runtimeContextRepo := runtimectx.NewRepository(runtimeContextConv)
 	formationRepo := formation.NewRepository(formationConv)
 	formationTemplateRepo := formationtemplate.NewRepository(formationTemplateConverter)
+	formationTemplateVersionRepo := formationtemplateversion.NewRepository(formationtemplateversion.NewConverter())
 	formationConstraintRepo := formationconstraint.NewRepository(formationConstraintConverter)
 	formationTemplateConstraintReferencesRepo := formationtemplateconstraintreferences.NewRepository(formationTemplateConstraintReferencesConverter)
 	scenarioAssignmentRepo := scenarioassignment.NewRepository(assignmentConv)
@@ -288,6 +298,8 @@ func main() {
 	formationAssignmentRepo := formationassignment.NewRepository(formationAssignmentConv)
 	bundleInstanceAuthRepo := bundleinstanceauth.NewRepository(bundleInstanceAuthConv)
 	certSubjectMappingRepo := certsubjectmapping.NewRepository(certSubjectMappingConv)
+	documentCacheValidatorRepo := ordcachevalidator.NewRepository(ordcachevalidator.NewConverter())
+	pushedPayloadRepo := ordpushedpayload.NewRepository(ordpushedpayload.NewConverter())
 
 	systemAuthConverter := systemauth.NewConverter(authConverter)
 	systemAuthRepo := systemauth.NewRepository(systemAuthConverter)
@@ -323,7 +335,7 @@ func main() {
 	webhookTenantBuilder := databuilder.NewWebhookTenantBuilder(webhookLabelBuilder, tenantRepo)
 	certSubjectInputBuilder := databuilder.NewWebhookCertSubjectBuilder(certSubjectMappingRepo)
 	webhookDataInputBuilder := databuilder.NewWebhookDataInputBuilder(applicationRepo, appTemplateRepo, runtimeRepo, runtimeContextRepo, webhookLabelBuilder, webhookTenantBuilder, certSubjectInputBuilder)
-	formationConstraintSvc := formationconstraint.NewService(formationConstraintRepo, formationTemplateConstraintReferencesRepo, uidSvc, formationConstraintConverter)
+	formationConstraintSvc := formationconstraint.NewService(formationConstraintRepo, formationTemplateConstraintReferencesRepo, formationTemplateVersionRepo, uidSvc, formationConstraintConverter)
 	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
 	constraintEngine := operators.NewConstraintEngine(transact, formationConstraintSvc, tenantSvc, scenarioAssignmentSvc, nil, nil, systemAuthSvc, formationRepo, labelRepo, labelSvc, applicationRepo, runtimeContextRepo, formationTemplateRepo, formationAssignmentRepo, nil, nil, assignmentOperationSvc, cfg.Features.RuntimeTypeLabelKey, cfg.Features.ApplicationTypeLabelKey)
 	notificationsBuilder := formation.NewNotificationsBuilder(webhookConverter, constraintEngine, cfg.Features.RuntimeTypeLabelKey, cfg.Features.ApplicationTypeLabelKey)
@@ -333,11 +345,13 @@ func main() {
 	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, constraintEngine, faNotificationSvc)
 	formationAssignmentSvc := formationassignment.NewService(formationAssignmentRepo, uidSvc, applicationRepo, runtimeRepo, runtimeContextRepo, notificationSvc, faNotificationSvc, assignmentOperationSvc, labelSvc, formationRepo, formationAssignmentStatusSvc, cfg.Features.RuntimeTypeLabelKey, cfg.Features.ApplicationTypeLabelKey)
 	formationStatusSvc := formation.NewFormationStatusService(formationRepo, labelDefRepo, scenariosSvc, notificationSvc, constraintEngine)
-	formationSvc := formation.NewService(transact, applicationRepo, labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, scenariosSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tntSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, assignmentOperationSvc, faNotificationSvc, notificationSvc, constraintEngine, webhookRepo, formationStatusSvc, cfg.Features.RuntimeTypeLabelKey, cfg.Features.ApplicationTypeLabelKey)
-	appSvc := application.NewService(&normalizer.DefaultNormalizator{}, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelSvc, bundleSvc, uidSvc, formationSvc, cfg.SelfRegisterDistinguishLabelKey, ordWebhookMapping)
+	formationSvc := formation.NewService(transact, applicationRepo, labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, formationTemplateVersionRepo, labelSvc, uidSvc, scenariosSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tntSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, assignmentOperationSvc, faNotificationSvc, notificationSvc, constraintEngine, webhookRepo, formationStatusSvc, cfg.Features.RuntimeTypeLabelKey, cfg.Features.ApplicationTypeLabelKey)
+	appSvc := application.NewService(&normalizer.DefaultNormalizator{}, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelSvc, bundleSvc, uidSvc, formationSvc, cfg.SelfRegisterDistinguishLabelKey, ordWebhookMapping, nil)
 	packageSvc := ordpackage.NewService(pkgRepo, uidSvc)
 	productSvc := product.NewService(productRepo, uidSvc)
 	vendorSvc := ordvendor.NewService(vendorRepo, uidSvc)
+	documentCacheValidatorSvc := ordcachevalidator.NewService(documentCacheValidatorRepo, uidSvc)
+	pushedPayloadSvc := ordpushedpayload.NewService(pushedPayloadRepo, uidSvc)
 	tombstoneSvc := tombstone.NewService(tombstoneRepo, uidSvc)
 	entityTypeMappingSvc := entitytypemapping.NewService(entityTypeMappingRepo, uidSvc)
 	tombstoneProcessor := processor.NewTombstoneProcessor(transact, tombstoneSvc)
@@ -360,17 +374,10 @@ func main() {
 	constraintEngine.SetFormationAssignmentService(formationAssignmentSvc)
 
 	operationsManager := operationsmanager.NewOperationsManager(transact, opSvc, model.OperationTypeOrdAggregation, cfg.OperationsManagerConfig)
+	pushOperationsManager := operationsmanager.NewOperationsManager(transact, opSvc, model.OperationTypeOrdPush, cfg.OperationsManagerConfig)
 
 	onDemandChannel := make(chan string, 100)
-
-	handler := initHandler(ctx, operationsManager, appSvc, webhookSvc, cfg, transact, onDemandChannel)
-	runMainSrv, shutdownMainSrv := createServer(ctx, cfg, handler, "main")
-
-	go func() {
-		<-ctx.Done()
-		// Interrupt signal received - shut down the servers
-		shutdownMainSrv()
-	}()
+	pushOnDemandChannel := make(chan string, 100)
 
 	clientConfig := ord.NewClientConfig(cfg.MaxParallelDocumentsPerApplication, cfg.ClientRetryDelay, cfg.ClientRetryAttempts)
 	ordClientWithTenantExecutor := newORDClientWithTenantExecutor(cfg, clientConfig, certCache)
@@ -382,44 +389,36 @@ func main() {
 	documentValidator := ord.NewDocumentValidator(validationClient)
 	documentSanitizer := ord.NewDocumentSanitizer()
 
+	pushHandler := ord.NewORDPushHTTPHandler(pushOperationsManager, opSvc, appSvc, pushedPayloadSvc, transact, pushOnDemandChannel, cfg.MaxPushedPayloadSize)
+	offlineValidator := ord.NewOfflineDocumentValidator(newORDValidationClient(cfg, clientConfig), ordClientWithoutTenantExecutor, cfg.GlobalRegistryConfig.URL, documentValidator, documentSanitizer)
+	validationHandler := ord.NewORDValidationHTTPHandler(offlineValidator, cfg.MaxPushedPayloadSize)
+	handler := initHandler(ctx, operationsManager, appSvc, webhookSvc, cfg, transact, onDemandChannel, pushHandler, validationHandler)
+	runMainSrv, shutdownMainSrv := createServer(ctx, cfg, handler, "main")
+
+	go func() {
+		<-ctx.Done()
+		// Interrupt signal received - shut down the servers
+		shutdownMainSrv()
+	}()
+
 	globalRegistrySvc := ord.NewGlobalRegistryService(transact, cfg.GlobalRegistryConfig, vendorSvc, productSvc, ordClientWithoutTenantExecutor, credentialExchangeStrategyTenantMappings, documentValidator)
 
 	ordConfig := ord.NewServiceConfig(cfg.MaxParallelSpecificationProcessors, credentialExchangeStrategyTenantMappings)
-	ordSvc := ord.NewAggregatorService(ordConfig, cfg.MetricsConfig, transact, appSvc, webhookSvc, bundleSvc, bundleReferenceSvc, apiProcessor, eventProcessor, entityTypeProcessor, capabilityProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, fetchRequestSvc, packageProcessor, productProcessor, vendorProcessor, tombstoneProcessor, tenantSvc, globalRegistrySvc, ordClientWithTenantExecutor, webhookConverter, appTemplateVersionSvc, appTemplateSvc, tombstonedResourcesDeleter, labelSvc, ordWebhookMapping, opSvc, documentValidator, documentSanitizer)
+	ordSvc := ord.NewAggregatorService(ordConfig, cfg.MetricsConfig, transact, appSvc, webhookSvc, bundleSvc, bundleReferenceSvc, apiProcessor, eventProcessor, entityTypeProcessor, capabilityProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, fetchRequestSvc, packageProcessor, productProcessor, vendorProcessor, tombstoneProcessor, tenantSvc, globalRegistrySvc, ordClientWithTenantExecutor, documentCacheValidatorSvc, webhookConverter, appTemplateVersionSvc, appTemplateSvc, tombstonedResourcesDeleter, labelSvc, ordWebhookMapping, opSvc, documentValidator, documentSanitizer)
 	ordOpProcessor := &ord.OperationsProcessor{
 		OrdSvc: ordSvc,
 	}
 	ordOperationMaintainer := ord.NewOperationMaintainer(model.OperationTypeOrdAggregation, transact, opSvc, webhookSvc, appSvc)
 
-	for i := 0; i < cfg.ParallelOperationProcessors; i++ {
-		go func(ctx context.Context, opManager *operationsmanager.OperationsManager, opProcessor *ord.OperationsProcessor, executorIndex int) {
-			for {
-				select {
-				case <-onDemandChannel:
-				default:
-				}
-
-				processedOperationID, err := claimAndProcessOperation(ctx, opManager, opProcessor)
-				if err != nil {
-					log.C(ctx).Errorf("Failed during claim and process operation %q by executor %d . Err: %v", processedOperationID, executorIndex, err)
-				}
-				if len(processedOperationID) > 0 {
-					log.C(ctx).Infof("Processed Operation: %s by executor %d", processedOperationID, executorIndex)
-				} else {
-					// Queue is empty - no operation claimed
-					log.C(ctx).Infof("No Processed Operation by executor %d", executorIndex)
-
-					select {
-					case operationID := <-onDemandChannel:
-						log.C(ctx).Infof("Operation %q send for processing through OnDemand channel to executor %d", operationID, executorIndex)
-					case <-time.After(cfg.OperationProcessorQuietPeriod):
-						log.C(ctx).Infof("Quiet period finished for executor %d", executorIndex)
-					}
-				}
-			}
-		}(ctx, operationsManager, ordOpProcessor, i)
+	pushOpProcessor := &ord.PushOperationsProcessor{
+		OrdSvc:     ordSvc,
+		PayloadSvc: pushedPayloadSvc,
+		Transact:   transact,
 	}
 
+	startOperationProcessors(ctx, cfg, cfg.ParallelOperationProcessors, operationsManager, ordOpProcessor, onDemandChannel)
+	startOperationProcessors(ctx, cfg, cfg.ParallelPushOperationProcessors, pushOperationsManager, pushOpProcessor, pushOnDemandChannel)
+
 	go func() {
 		if err := startSyncORDOperationsJob(ctx, ordOperationMaintainer, cfg); err != nil {
 			log.C(ctx).WithError(err).Error("Failed to start sync ORD documents cronjob. Stopping app...")
@@ -441,6 +440,20 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err := pushOperationsManager.StartRescheduleHangedOperationsJob(ctx); err != nil {
+			log.C(ctx).WithError(err).Error("Failed to run RescheduleHangedOperationsJob for pushed ORD documents. Stopping app...")
+			cancel()
+		}
+	}()
+
+	go func() {
+		if err := pushOperationsManager.StartDeleteOperationsJob(ctx); err != nil {
+			log.C(ctx).WithError(err).Error("Failed to run DeleteOperationsJob for pushed ORD documents. Stopping app...")
+			cancel()
+		}
+	}()
+
 	runMainSrv()
 }
 
@@ -484,7 +497,62 @@ func newORDClientWithoutTenantExecutor(cfg config, clientConfig ord.ClientConfig
 	return ord.NewClient(clientConfig, httpClient, accessStrategyExecutorProviderWithoutTenant)
 }
 
-func claimAndProcessOperation(ctx context.Context, opManager *operationsmanager.OperationsManager, opProcessor *ord.OperationsProcessor) (string, error) {
+// newORDValidationClient creates an ORD client for fetching documents from caller-provided URLs. It supports only the open
+// access strategy, so that no credentials are sent to the validated system, and connects only to publicly reachable addresses.
+func newORDValidationClient(cfg config, clientConfig ord.ClientConfig) *ord.ORDDocumentsClient {
+	httpClient := &http.Client{
+		Timeout: cfg.ClientTimeout,
+		Transport: &http.Transport{
+			DialContext: ord.NewPublicDestinationDialer(&net.Dialer{
+				Timeout:   15 * time.Second,
+				KeepAlive: 15 * time.Second,
+			}).DialContext,
+			MaxConnsPerHost:     cfg.ClientMaxConnectionsPerHost,
+			MaxIdleConnsPerHost: cfg.ClientMaxIdlConnectionsPerHost,
+			MaxIdleConns:        cfg.ClientMaxIdlConnectionsPerHost,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: cfg.SkipSSLValidation,
+			},
+		},
+	}
+	openAccessStrategyExecutorProvider := accessstrategy.NewExecutorProvider(map[accessstrategy.Type]accessstrategy.Executor{
+		accessstrategy.OpenAccessStrategy: accessstrategy.NewOpenAccessStrategyExecutor(),
+	})
+	return ord.NewClient(clientConfig, httpClient, openAccessStrategyExecutorProvider)
+}
+
+func startOperationProcessors(ctx context.Context, cfg config, processors int, opManager *operationsmanager.OperationsManager, opProcessor operationsmanager.OperationsProcessor, onDemandChannel chan string) {
+	for i := 0; i < processors; i++ {
+		go func(ctx context.Context, opManager *operationsmanager.OperationsManager, opProcessor operationsmanager.OperationsProcessor, executorIndex int) {
+			for {
+				select {
+				case <-onDemandChannel:
+				default:
+				}
+
+				processedOperationID, err := claimAndProcessOperation(ctx, opManager, opProcessor)
+				if err != nil {
+					log.C(ctx).Errorf("Failed during claim and process operation %q by executor %d . Err: %v", processedOperationID, executorIndex, err)
+				}
+				if len(processedOperationID) > 0 {
+					log.C(ctx).Infof("Processed Operation: %s by executor %d", processedOperationID, executorIndex)
+				} else {
+					// Queue is empty - no operation claimed
+					log.C(ctx).Infof("No Processed Operation by executor %d", executorIndex)
+
+					select {
+					case operationID := <-onDemandChannel:
+						log.C(ctx).Infof("Operation %q send for processing through OnDemand channel to executor %d", operationID, executorIndex)
+					case <-time.After(cfg.OperationProcessorQuietPeriod):
+						log.C(ctx).Infof("Quiet period finished for executor %d", executorIndex)
+					}
+				}
+			}
+		}(ctx, opManager, opProcessor, i)
+	}
+}
+
+func claimAndProcessOperation(ctx context.Context, opManager *operationsmanager.OperationsManager, opProcessor operationsmanager.OperationsProcessor) (string, error) {
 	op, errGetOperation := opManager.GetOperation(ctx)
 	if errGetOperation != nil {
 		if apperrors.IsNoScheduledOperationsError(errGetOperation) {
@@ -648,11 +716,13 @@ func createServer(ctx context.Context, cfg config, handler http.Handler, name st
 	return runFn, shutdownFn
 }
 
-func initHandler(ctx context.Context, opMgr *operationsmanager.OperationsManager, appSvc ord.ApplicationService, webhookSvc webhook.WebhookService, cfg config, transact persistence.Transactioner, onDemandChannel chan string) http.Handler {
+func initHandler(ctx context.Context, opMgr *operationsmanager.OperationsManager, appSvc ord.ApplicationService, webhookSvc webhook.WebhookService, cfg config, transact persistence.Transactioner, onDemandChannel chan string, pushHandler pushHTTPHandler, validationHandler validationHTTPHandler) http.Handler {
 	const (
 		healthzEndpoint   = "/healthz"
 		readyzEndpoint    = "/readyz"
 		aggregateEndpoint = "/aggregate"
+		pushEndpoint      = "/push"
+		validateEndpoint  = "/validate"
 	)
 	logger := log.C(ctx)
 
@@ -673,6 +743,17 @@ func initHandler(ctx context.Context, opMgr *operationsmanager.OperationsManager
 	configureAuthMiddleware(ctx, httpClient, apiRouter, cfg, cfg.SecurityConfig.AggregatorSyncScope)
 	apiRouter.HandleFunc(aggregateEndpoint, handler.ScheduleAggregationForORDData).Methods(http.MethodPost)
 
+	// Pushing ORD documents is allowed for the applications themselves, hence it requires a different scope than the on-demand aggregation
+	pushRouter := mainRouter.PathPrefix(cfg.AggregatorRootAPI + pushEndpoint).Subrouter()
+	configureAuthMiddleware(ctx, httpClient, pushRouter, cfg, cfg.SecurityConfig.AggregatorPushScope)
+	pushRouter.HandleFunc("", pushHandler.PushORDDocuments).Methods(http.MethodPost)
+	pushRouter.HandleFunc(fmt.Sprintf("/{%s}", ord.PushOperationIDPathParam), pushHandler.GetPushOperationStatus).Methods(http.MethodGet)
+
+	// Validating ORD documents fetches arbitrary URLs on behalf of the caller, hence it requires a dedicated scope
+	validateRouter := mainRouter.PathPrefix(cfg.AggregatorRootAPI + validateEndpoint).Subrouter()
+	configureAuthMiddleware(ctx, httpClient, validateRouter, cfg, cfg.SecurityConfig.AggregatorValidateScope)
+	validateRouter.HandleFunc("", validationHandler.ValidateORDDocuments).Methods(http.MethodPost)
+
 	healthCheckRouter := mainRouter.PathPrefix(cfg.AggregatorRootAPI).Subrouter()
 	logger.Infof("Registering readiness endpoint...")
 	healthCheckRouter.HandleFunc(readyzEndpoint, newReadinessHandler())
@@ -682,6 +763,15 @@ func initHandler(ctx context.Context, opMgr *operationsmanager.OperationsManager
 	return mainRouter
 }
 
+type pushHTTPHandler interface {
+	PushORDDocuments(writer http.ResponseWriter, request *http.Request)
+	GetPushOperationStatus(writer http.ResponseWriter, request *http.Request)
+}
+
+type validationHTTPHandler interface {
+	ValidateORDDocuments(writer http.ResponseWriter, request *http.Request)
+}
+
 func newReadinessHandler() func(writer http.ResponseWriter, request *http.Request) {
 	return func(writer http.ResponseWriter, request *http.Request) {
 		writer.WriteHeader(http.StatusOK)
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/kyma-incubator/compass/components/director/internal/ordvalidator"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	exitCode := ordvalidator.NewCLI(os.Stdout, os.Stderr, ordvalidator.NewValidatorFromConfig).Run(ctx, os.Args[1:])
+
+	cancel()
+	os.Exit(exitCode)
+}
@@ -93,6 +93,8 @@ type config struct {
 	SecurityConfig                   securityConfig
 	SystemFieldDiscoveryEngineConfig systemfielddiscoveryenginecfg.SystemFieldDiscoveryEngineConfig
 
+	TenantEvents tenantEventsConfig
+
 	OperationsManagerConfig       operationsmanager.OperationsManagerConfig
 	ParallelOperationProcessors   int           `envconfig:"APP_PARALLEL_OPERATION_PROCESSORS,default=10"`
 	OperationProcessorQuietPeriod time.Duration `envconfig:"APP_OPERATION_PROCESSORS_QUIET_PERIOD,default=5s"`
@@ -105,6 +107,13 @@ type securityConfig struct {
 	SubscriptionCallbackScope string        `envconfig:"APP_SUBSCRIPTION_CALLBACK_SCOPE"`
 	FetchTenantOnDemandScope  string        `envconfig:"APP_FETCH_TENANT_ON_DEMAND_SCOPE"`
 	SystemFieldDiscoveryScope string        `envconfig:"APP_SYSTEM_FIELD_DISCOVERY_SCOPE"`
+	TenantEventsScope         string        `envconfig:"optional,APP_TENANT_EVENTS_SCOPE"`
+	TenantEventsAdminScope    string        `envconfig:"optional,APP_TENANT_EVENTS_ADMIN_SCOPE"`
+}
+
+type tenantEventsConfig struct {
+	IngestionEnabled bool `envconfig:"default=false,APP_TENANT_EVENTS_INGESTION_ENABLED"`
+	Cleanup          resync.IngestedEventsCleanupConfig
 }
 
 func main() {
@@ -122,6 +131,10 @@ func main() {
 	ctx, err = log.Configure(ctx, &cfg.Log)
 	exitOnError(err, "Failed to configure Logger")
 
+	if cfg.TenantEvents.IngestionEnabled && (cfg.SecurityConfig.TenantEventsScope == "" || cfg.SecurityConfig.TenantEventsAdminScope == "") {
+		exitOnError(errors.New("APP_TENANT_EVENTS_SCOPE and APP_TENANT_EVENTS_ADMIN_SCOPE must be provided when the tenant events ingestion is enabled"), "Error while loading app config")
+	}
+
 	tenantSynchronizers, dbCloseFuncs := tenantSynchronizers(ctx, cfg.Handler, cfg.Features)
 	defer func() {
 		for _, fn := range dbCloseFuncs {
@@ -176,7 +189,7 @@ func main() {
 
 	uidSvc := uid.NewService()
 	tenantSvc := tenant.NewService(tenantRepo, uidSvc, tenantConverter)
-	appSvc := application.NewService(&normalizer.DefaultNormalizator{}, nil, applicationRepo, nil, nil, labelRepo, nil, nil, nil, uidSvc, nil, "", nil)
+	appSvc := application.NewService(&normalizer.DefaultNormalizator{}, nil, applicationRepo, nil, nil, labelRepo, nil, nil, nil, uidSvc, nil, "", nil, nil)
 	appTemplateSvc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, labelRepo, nil, nil)
 	systemFieldDiscoveryClient := pkgAuth.PrepareHTTPClient(cfg.Handler.ClientTimeout)
 
@@ -186,7 +199,7 @@ func main() {
 	systemFieldDiscoveryProcessor := systemfielddiscoveryengine.NewOperationProcessor(systemFieldDiscoverySvc)
 	onDemandChannel := make(chan string, 100)
 
-	handler := initAPIHandler(ctx, httpClient, cfg, tenantSynchronizers, saasRegistryOperationsManager, onDemandChannel)
+	handler := initAPIHandler(ctx, httpClient, cfg, tenantSynchronizers, saasRegistryOperationsManager, onDemandChannel, systemFieldDiscoverySvc.Registries())
 	runMainSrv, shutdownMainSrv := createServer(ctx, cfg, handler, "main")
 
 	go func() {
@@ -231,6 +244,14 @@ func main() {
 		}
 	}()
 
+	if cfg.TenantEvents.IngestionEnabled {
+		go func() {
+			if err := resync.StartIngestedEventsCleanupJob(ctx, cfg.TenantEvents.Cleanup, transact, resync.NewIngestedEventRepository()); err != nil {
+				log.C(ctx).WithError(err).Error("Failed to run the ingested tenant events cleanup job")
+			}
+		}()
+	}
+
 	go func() {
 		if err := saasRegistryOperationsManager.StartDeleteOperationsJob(ctx); err != nil {
 			log.C(ctx).WithError(err).Error("Failed to run StartDeleteOperationsJob. Stopping app...")
@@ -246,7 +267,7 @@ func stopTenantFetcherJobTicker(ctx context.Context, tenantFetcherJobTicker *tim
 	log.C(ctx).Infof("Ticker for tenant fetcher job %s is stopped", jobName)
 }
 
-func initAPIHandler(ctx context.Context, httpClient *http.Client, cfg config, synchronizers []*resync.TenantsSynchronizer, opMgr *operationsmanager.OperationsManager, onDemandChannel chan string) http.Handler {
+func initAPIHandler(ctx context.Context, httpClient *http.Client, cfg config, synchronizers []*resync.TenantsSynchronizer, opMgr *operationsmanager.OperationsManager, onDemandChannel chan string, systemFieldDiscoveryRegistries []systemfielddiscoveryengine.SystemFieldDiscoveryRegistry) http.Handler {
 	const (
 		healthzEndpoint              = "/healthz"
 		readyzEndpoint               = "/readyz"
@@ -261,7 +282,7 @@ func initAPIHandler(ctx context.Context, httpClient *http.Client, cfg config, sy
 	configureAuthMiddleware(ctx, httpClient, tenantsAPIRouter, cfg.SecurityConfig, cfg.SecurityConfig.SubscriptionCallbackScope)
 	registerTenantsHandler(ctx, tenantsAPIRouter, cfg.Handler)
 
-	handler := systemfielddiscoveryengine.NewSystemFieldDiscoveryHTTPHandler(opMgr, onDemandChannel)
+	handler := systemfielddiscoveryengine.NewSystemFieldDiscoveryHTTPHandler(opMgr, onDemandChannel, systemFieldDiscoveryRegistries)
 	systemFieldDiscoveryAPIRouter := mainRouter.PathPrefix(cfg.TenantsRootAPI).Subrouter()
 	configureAuthMiddleware(ctx, httpClient, systemFieldDiscoveryAPIRouter, cfg.SecurityConfig, cfg.SecurityConfig.SystemFieldDiscoveryScope)
 	systemFieldDiscoveryAPIRouter.HandleFunc(systemFieldDiscoveryEndpoint, handler.ScheduleSaaSRegistryDiscoveryForSystemFieldDiscoveryData).Methods(http.MethodPost)
@@ -270,6 +291,12 @@ func initAPIHandler(ctx context.Context, httpClient *http.Client, cfg config, sy
 	configureAuthMiddleware(ctx, httpClient, tenantsOnDemandAPIRouter, cfg.SecurityConfig, cfg.SecurityConfig.FetchTenantOnDemandScope)
 	registerTenantsOnDemandHandler(ctx, tenantsOnDemandAPIRouter, cfg.Handler, synchronizers)
 
+	if cfg.TenantEvents.IngestionEnabled {
+		registerTenantEventsHandler(ctx, httpClient, mainRouter, cfg, synchronizers)
+	} else {
+		logger.Infof("Tenant events ingestion is disabled, tenant events API won't be enabled")
+	}
+
 	healthCheckRouter := mainRouter.PathPrefix(cfg.TenantsRootAPI).Subrouter()
 	logger.Infof("Registering readiness endpoint...")
 	healthCheckRouter.HandleFunc(readyzEndpoint, newReadinessHandler())
@@ -279,6 +306,26 @@ func initAPIHandler(ctx context.Context, httpClient *http.Client, cfg config, sy
 	return mainRouter
 }
 
+func registerTenantEventsHandler(ctx context.Context, httpClient *http.Client, mainRouter *mux.Router, cfg config, synchronizers []*resync.TenantsSynchronizer) {
+	logger := log.C(ctx)
+
+	tenantEventsProcessors := make([]tenantfetcher.TenantEventsProcessor, 0, len(synchronizers))
+	for _, synchronizer := range synchronizers {
+		tenantEventsProcessors = append(tenantEventsProcessors, synchronizer)
+	}
+	tenantEventsHandler := tenantfetcher.NewTenantEventsHTTPHandler(tenantEventsProcessors, cfg.Handler)
+
+	tenantEventsAPIRouter := mainRouter.PathPrefix(cfg.TenantsRootAPI).Subrouter()
+	configureAuthMiddleware(ctx, httpClient, tenantEventsAPIRouter, cfg.SecurityConfig, cfg.SecurityConfig.TenantEventsScope)
+	logger.Infof("Registering tenant events ingestion endpoint on %s...", cfg.Handler.TenantEventsEndpoint)
+	tenantEventsAPIRouter.HandleFunc(cfg.Handler.TenantEventsEndpoint, tenantEventsHandler.IngestTenantEvent).Methods(http.MethodPost)
+
+	tenantEventsAdminAPIRouter := mainRouter.PathPrefix(cfg.TenantsRootAPI).Subrouter()
+	configureAuthMiddleware(ctx, httpClient, tenantEventsAdminAPIRouter, cfg.SecurityConfig, cfg.SecurityConfig.TenantEventsAdminScope)
+	logger.Infof("Registering tenant events replay endpoint on %s...", cfg.Handler.TenantEventsReplayEndpoint)
+	tenantEventsAdminAPIRouter.HandleFunc(cfg.Handler.TenantEventsReplayEndpoint, tenantEventsHandler.ReplayTenantEvents).Methods(http.MethodPost)
+}
+
 func exitOnError(err error, context string) {
 	if err != nil {
 		wrappedError := errors.Wrap(err, context)
@@ -0,0 +1,348 @@
+package compassctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	gcli "github.com/machinebox/graphql"
+	"github.com/pkg/errors"
+)
+
+const usage = `compassctl is a command-line client for the Compass Director API.
+
+Usage:
+  compassctl list <resource> [flags]
+  compassctl get <resource> <id> [flags]
+  compassctl describe <resource> <id> [flags]
+  compassctl create <resource> -f <file> [flags]
+  compassctl delete <resource> <id> [flags]
+  compassctl formation assign <formation-name> --object-id <id> --object-type <type> [--wait] [flags]
+  compassctl formation unassign <formation-name> --object-id <id> --object-type <type> [--wait] [flags]
+
+Resources:
+  applications (app), runtimes (rt), formations, formationtemplates (ft), webhooks (wh), tenants, operations (op)
+
+Webhooks are listed and created for an owner given with --application-id, --runtime-id or --formation-template-id.
+Tenants are addressed by their external ID.
+
+Run "compassctl <command> -h" to see all flags of a command.
+`
+
+const (
+	exitCodeOK    = 0
+	exitCodeError = 1
+	exitCodeUsage = 2
+)
+
+// DirectorFactory creates a Director client from the global options
+type DirectorFactory func(ctx context.Context, cfg Config) (*Director, error)
+
+// NewDirectorFromConfig creates a Director client that talks to the configured URL with the configured credentials
+func NewDirectorFromConfig(ctx context.Context, cfg Config) (*Director, error) {
+	httpClient, err := NewHTTPClient(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDirector(gcli.NewClient(cfg.URL, gcli.WithHTTPClient(httpClient)), cfg.Tenant), nil
+}
+
+type usageError struct {
+	err      error
+	reported bool
+}
+
+func (e *usageError) Error() string {
+	return e.err.Error()
+}
+
+func newUsageError(format string, args ...interface{}) error {
+	return &usageError{err: errors.Errorf(format, args...)}
+}
+
+// CLI is the compassctl command-line interface
+type CLI struct {
+	stdin       io.Reader
+	stdout      io.Writer
+	stderr      io.Writer
+	newDirector DirectorFactory
+}
+
+// NewCLI creates a compassctl command-line interface
+func NewCLI(stdin io.Reader, stdout, stderr io.Writer, newDirector DirectorFactory) *CLI {
+	return &CLI{
+		stdin:       stdin,
+		stdout:      stdout,
+		stderr:      stderr,
+		newDirector: newDirector,
+	}
+}
+
+// Run executes the command described by args and returns the process exit code
+func (c *CLI) Run(ctx context.Context, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(c.stderr, usage)
+		return exitCodeUsage
+	}
+
+	var err error
+	switch command := args[0]; command {
+	case "help", "-h", "--help":
+		fmt.Fprint(c.stdout, usage)
+		return exitCodeOK
+	case "list", "get", "describe", "create", "delete":
+		err = c.runResourceCommand(ctx, command, args[1:])
+	case "formation":
+		err = c.runFormationCommand(ctx, args[1:])
+	default:
+		err = newUsageError("unknown command %q", command)
+	}
+
+	if err == nil || errors.Is(err, flag.ErrHelp) {
+		return exitCodeOK
+	}
+
+	if usageErr, ok := err.(*usageError); ok {
+		if !usageErr.reported {
+			fmt.Fprintf(c.stderr, "Error: %v\nRun \"compassctl help\" for usage.\n", usageErr.err)
+		}
+		return exitCodeUsage
+	}
+
+	fmt.Fprintf(c.stderr, "Error: %v\n", err)
+	return exitCodeError
+}
+
+func (c *CLI) runResourceCommand(ctx context.Context, command string, args []string) error {
+	fs, cfg := c.newFlagSet(command)
+
+	opts := resourceOptions{}
+	var file, applicationID, runtimeID, formationTemplateID string
+	if command == "create" {
+		fs.StringVar(&file, "f", "", "Path to a JSON or YAML file with the input of the object, or - for standard input")
+		fs.StringVar(&file, "filename", "", "Same as -f")
+	}
+	if command == "list" || command == "create" {
+		fs.StringVar(&applicationID, "application-id", "", "ID of the application that owns the webhooks")
+		fs.StringVar(&runtimeID, "runtime-id", "", "ID of the runtime that owns the webhooks")
+		fs.StringVar(&formationTemplateID, "formation-template-id", "", "ID of the formation template that owns the webhooks")
+	}
+	if command == "list" {
+		fs.StringVar(&opts.searchTerm, "search", "", "Search term used to filter tenants")
+	}
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+
+	expectedArgs := 2
+	if command == "list" || command == "create" {
+		expectedArgs = 1
+	}
+	if len(positional) != expectedArgs {
+		if expectedArgs == 1 {
+			return newUsageError("%s expects exactly one argument: the resource", command)
+		}
+		return newUsageError("%s expects exactly two arguments: the resource and the ID", command)
+	}
+
+	res, err := findResource(positional[0])
+	if err != nil {
+		return &usageError{err: err}
+	}
+
+	if opts.webhookParent, err = webhookParentFromFlags(applicationID, runtimeID, formationTemplateID); err != nil {
+		return err
+	}
+
+	var data []byte
+	if command == "create" {
+		if file == "" {
+			return newUsageError("create requires the input file; use -f")
+		}
+		if data, err = c.readInput(file); err != nil {
+			return err
+		}
+	}
+
+	if !res.supports(command) {
+		return newUsageError("%s is not supported for %s", command, res.name)
+	}
+
+	director, err := c.director(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	var obj interface{}
+	var view *table
+	switch command {
+	case "list":
+		obj, view, err = res.list(ctx, director, opts)
+	case "get":
+		obj, view, err = res.get(ctx, director, positional[1])
+	case "describe":
+		obj, err = res.describe(ctx, director, positional[1])
+	case "create":
+		obj, view, err = res.create(ctx, director, data, opts)
+	case "delete":
+		obj, view, err = res.delete(ctx, director, positional[1])
+	}
+	if err != nil {
+		return err
+	}
+
+	return Print(c.stdout, cfg.Output, obj, view)
+}
+
+func (c *CLI) runFormationCommand(ctx context.Context, args []string) error {
+	if len(args) == 0 || (args[0] != "assign" && args[0] != "unassign") {
+		return newUsageError("formation expects a subcommand: assign or unassign")
+	}
+	subcommand, unassign := args[0], args[0] == "unassign"
+
+	fs, cfg := c.newFlagSet("formation " + subcommand)
+	var objectID, objectType string
+	var wait bool
+	waiter := &assignmentWaiter{progress: c.stderr}
+	fs.StringVar(&objectID, "object-id", "", "ID of the object to "+subcommand)
+	fs.StringVar(&objectType, "object-type", string(graphql.FormationObjectTypeApplication), "Type of the object: APPLICATION, RUNTIME, RUNTIME_CONTEXT or TENANT")
+	fs.BoolVar(&wait, "wait", false, "Wait until the formation assignments of the object are READY, or deleted when unassigning")
+	fs.DurationVar(&waiter.timeout, "wait-timeout", 5*time.Minute, "Maximum time to wait for the formation assignments")
+	fs.DurationVar(&waiter.pollInterval, "poll-interval", 2*time.Second, "Interval between two checks of the formation assignments")
+
+	positional, err := parseInterspersed(fs, args[1:])
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return newUsageError("formation %s expects exactly one argument: the formation name", subcommand)
+	}
+	if objectID == "" {
+		return newUsageError("formation %s requires --object-id", subcommand)
+	}
+
+	objType := graphql.FormationObjectType(strings.ToUpper(objectType))
+	if !objType.IsValid() {
+		return newUsageError("unsupported object type %q", objectType)
+	}
+	if waiter.pollInterval <= 0 || waiter.timeout <= 0 {
+		return newUsageError("--wait-timeout and --poll-interval must be positive")
+	}
+
+	director, err := c.director(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	var formation *graphql.Formation
+	if unassign {
+		formation, err = director.UnassignFormation(ctx, objectID, objType, positional[0])
+	} else {
+		formation, err = director.AssignFormation(ctx, objectID, objType, positional[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	if !wait {
+		return Print(c.stdout, cfg.Output, formation, formationsTable(formation))
+	}
+
+	waiter.director = director
+	assignments, waitErr := waiter.wait(ctx, formation.ID, objectID, unassign)
+	result := &FormationAssignmentResult{Formation: formation, FormationAssignments: assignments}
+	if err := Print(c.stdout, cfg.Output, result, formationAssignmentsTable(assignments...)); err != nil {
+		return err
+	}
+	return waitErr
+}
+
+func (c *CLI) newFlagSet(name string) (*flag.FlagSet, *Config) {
+	fs := flag.NewFlagSet("compassctl "+name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+
+	cfg := &Config{Output: OutputTable}
+	cfg.registerFlags(fs)
+	return fs, cfg
+}
+
+func (c *CLI) director(ctx context.Context, cfg *Config) (*Director, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, &usageError{err: err}
+	}
+	return c.newDirector(ctx, *cfg)
+}
+
+func (c *CLI) readInput(file string) ([]byte, error) {
+	if file == "-" {
+		data, err := io.ReadAll(c.stdin)
+		return data, errors.Wrap(err, "while reading standard input")
+	}
+
+	data, err := os.ReadFile(file)
+	return data, errors.Wrapf(err, "while reading %q", file)
+}
+
+func (r *resource) supports(command string) bool {
+	switch command {
+	case "list":
+		return r.list != nil
+	case "get":
+		return r.get != nil
+	case "describe":
+		return r.describe != nil
+	case "create":
+		return r.create != nil
+	case "delete":
+		return r.delete != nil
+	}
+	return false
+}
+
+func webhookParentFromFlags(applicationID, runtimeID, formationTemplateID string) (WebhookParent, error) {
+	var parents []WebhookParent
+	if applicationID != "" {
+		parents = append(parents, WebhookParent{Type: WebhookParentApplication, ID: applicationID})
+	}
+	if runtimeID != "" {
+		parents = append(parents, WebhookParent{Type: WebhookParentRuntime, ID: runtimeID})
+	}
+	if formationTemplateID != "" {
+		parents = append(parents, WebhookParent{Type: WebhookParentFormationTemplate, ID: formationTemplateID})
+	}
+
+	switch len(parents) {
+	case 0:
+		return WebhookParent{}, nil
+	case 1:
+		return parents[0], nil
+	default:
+		return WebhookParent{}, newUsageError("only one of --application-id, --runtime-id and --formation-template-id can be set")
+	}
+}
+
+// parseInterspersed parses flags that may appear before, between or after the positional arguments
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, &usageError{err: err, reported: true}
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
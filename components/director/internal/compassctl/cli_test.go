@@ -0,0 +1,370 @@
+package compassctl_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/compassctl"
+	"github.com/kyma-incubator/compass/components/director/internal/compassctl/automock"
+	gcli "github.com/machinebox/graphql"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tenantID    = "tenant-id"
+	appID       = "app-id"
+	formationID = "formation-id"
+)
+
+var (
+	testErr     = errors.New("test error")
+	credentials = []string{"--url", "https://director.local/graphql", "--tenant", tenantID, "--client-id", "id", "--client-secret", "secret", "--token-url", "https://oauth.local/token"}
+)
+
+func TestCLI_Run(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Args             []string
+		Stdin            string
+		ClientFn         func(t *testing.T) *automock.GraphQLClient
+		ExpectedExitCode int
+		ExpectedStdout   []string
+		ExpectedStderr   string
+	}{
+		{
+			Name: "List applications follows all pages and prints a table",
+			Args: []string{"list", "apps"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, "applications(first: 100)", `{"result":{"data":[{"id":"app-1","name":"first","status":{"condition":"INITIAL","timestamp":"2024-01-01T00:00:00Z"}}],"pageInfo":{"endCursor":"cursor","hasNextPage":true}}}`)
+				expectRequest(t, client, `applications(first: 100, after: "cursor")`, `{"result":{"data":[{"id":"app-2","name":"second","providerName":"provider"}],"pageInfo":{"hasNextPage":false}}}`)
+				return client
+			},
+			ExpectedStdout: []string{"ID      NAME     PROVIDER   SYSTEM NUMBER   STATUS", "app-1   first    -          -               INITIAL", "app-2   second   provider   -               -"},
+		},
+		{
+			Name: "Get application prints JSON",
+			Args: []string{"get", "application", appID, "-o", "json"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `application(id: "app-id")`, `{"result":{"id":"app-id","name":"app","labels":{"key":"value"}}}`)
+				return client
+			},
+			ExpectedStdout: []string{`"id": "app-id"`, `"key": "value"`},
+		},
+		{
+			Name: "Describe runtime prints YAML by default",
+			Args: []string{"describe", "rt", "runtime-id"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `runtime(id: "runtime-id")`, `{"result":{"id":"runtime-id","name":"runtime","webhooks":[{"id":"wh-1","type":"CONFIGURATION_CHANGED"}]}}`)
+				return client
+			},
+			ExpectedStdout: []string{"id: runtime-id", "- id: wh-1"},
+		},
+		{
+			Name:  "Create runtime from standard input",
+			Args:  []string{"create", "runtime", "-f", "-"},
+			Stdin: "name: runtime\nlabels:\n  region: eu-1\n",
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `registerRuntime(in:`, `{"result":{"id":"runtime-id","name":"runtime"}}`)
+				return client
+			},
+			ExpectedStdout: []string{"runtime-id   runtime"},
+		},
+		{
+			Name:             "Create fails for input that does not pass validation",
+			Args:             []string{"create", "formation", "-f", "-"},
+			Stdin:            "name: formation\nstate: READY\n",
+			ExpectedExitCode: 1,
+			ExpectedStderr:   "invalid input",
+		},
+		{
+			Name:             "Create fails for unknown input fields",
+			Args:             []string{"create", "runtime", "-f", "-"},
+			Stdin:            "name: runtime\nunknown: true\n",
+			ExpectedExitCode: 1,
+			ExpectedStderr:   "unknown field",
+		},
+		{
+			Name: "Delete formation resolves its name",
+			Args: []string{"delete", "formation", formationID},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `formation(id: "formation-id")`, `{"result":{"id":"formation-id","name":"formation","formationAssignments":{"data":[],"pageInfo":{"hasNextPage":false}}}}`)
+				expectRequest(t, client, `deleteFormation(formation: {name: "formation"})`, `{"result":{"id":"formation-id","name":"formation","state":"READY"}}`)
+				return client
+			},
+			ExpectedStdout: []string{"formation-id   formation"},
+		},
+		{
+			Name: "List webhooks of a formation template",
+			Args: []string{"list", "webhooks", "--formation-template-id", "ft-id"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `formationTemplate(id: "ft-id") { webhooks`, `{"result":{"webhooks":[{"id":"wh-1","type":"FORMATION_LIFECYCLE","mode":"SYNC","url":"https://example.com"}]}}`)
+				return client
+			},
+			ExpectedStdout: []string{"wh-1   FORMATION_LIFECYCLE   SYNC   https://example.com"},
+		},
+		{
+			Name:             "List webhooks requires an owner",
+			Args:             []string{"list", "webhooks"},
+			ClientFn:         func(t *testing.T) *automock.GraphQLClient { return &automock.GraphQLClient{} },
+			ExpectedExitCode: 1,
+			ExpectedStderr:   "the owner of the webhooks is required",
+		},
+		{
+			Name: "Delete tenant by external ID",
+			Args: []string{"delete", "tenant", "external-id"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `deleteTenants(in: ["external-id"])`, `{"result":1}`)
+				return client
+			},
+			ExpectedStdout: []string{"DELETED", "1"},
+		},
+		{
+			Name: "Get fails when the object does not exist",
+			Args: []string{"get", "operation", "op-id"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `operation(id: "op-id")`, `{"result":null}`)
+				return client
+			},
+			ExpectedExitCode: 1,
+			ExpectedStderr:   `operation "op-id" not found`,
+		},
+		{
+			Name: "Returns error when the request fails",
+			Args: []string{"list", "formations"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				client.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(testErr).Once()
+				return client
+			},
+			ExpectedExitCode: 1,
+			ExpectedStderr:   testErr.Error(),
+		},
+		{
+			Name:             "Unsupported operation",
+			Args:             []string{"get", "webhook", "wh-1"},
+			ExpectedExitCode: 2,
+			ExpectedStderr:   "get is not supported for webhooks",
+		},
+		{
+			Name:             "Unknown resource",
+			Args:             []string{"list", "unknown"},
+			ExpectedExitCode: 2,
+			ExpectedStderr:   `unknown resource "unknown"`,
+		},
+		{
+			Name:             "Unknown command",
+			Args:             []string{"patch"},
+			ExpectedExitCode: 2,
+			ExpectedStderr:   `unknown command "patch"`,
+		},
+		{
+			Name:             "Unsupported output format",
+			Args:             []string{"list", "runtimes", "-o", "xml"},
+			ExpectedExitCode: 2,
+			ExpectedStderr:   `unsupported output format "xml"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			client := &automock.GraphQLClient{}
+			if testCase.ClientFn != nil {
+				client = testCase.ClientFn(t)
+			}
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			cli := compassctl.NewCLI(strings.NewReader(testCase.Stdin), stdout, stderr, directorFactory(client))
+
+			// WHEN
+			exitCode := cli.Run(context.TODO(), append(testCase.Args, credentials...))
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedExitCode, exitCode, stderr.String())
+			for _, expected := range testCase.ExpectedStdout {
+				assert.Contains(t, stdout.String(), expected)
+			}
+			if testCase.ExpectedStderr != "" {
+				assert.Contains(t, stderr.String(), testCase.ExpectedStderr)
+			}
+			mock.AssertExpectationsForObjects(t, client)
+		})
+	}
+}
+
+func TestCLI_RunWithoutCredentials(t *testing.T) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cli := compassctl.NewCLI(strings.NewReader(""), stdout, stderr, directorFactory(&automock.GraphQLClient{}))
+
+	exitCode := cli.Run(context.TODO(), []string{"list", "apps", "--url", "https://director.local/graphql"})
+
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr.String(), "credentials are required")
+}
+
+func TestCLI_RunCreateFromFile(t *testing.T) {
+	// GIVEN
+	file := filepath.Join(t.TempDir(), "tenants.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("name: tenant\nexternalTenant: external-id\ntype: account\nprovider: compassctl\n"), 0600))
+
+	client := &automock.GraphQLClient{}
+	expectRequest(t, client, `writeTenants(in: [`, `{"result":["internal-id"]}`)
+	defer mock.AssertExpectationsForObjects(t, client)
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cli := compassctl.NewCLI(strings.NewReader(""), stdout, stderr, directorFactory(client))
+
+	// WHEN
+	exitCode := cli.Run(context.TODO(), append([]string{"create", "tenants", "-f", file, "-o", "yaml"}, credentials...))
+
+	// THEN
+	assert.Equal(t, 0, exitCode, stderr.String())
+	assert.Equal(t, "- internal-id\n", stdout.String())
+}
+
+func TestCLI_RunFormationAssign(t *testing.T) {
+	assignmentsPage := func(states ...string) string {
+		assignments := make([]string, 0, len(states))
+		for i, state := range states {
+			assignments = append(assignments, `{"id":"fa-`+string(rune('1'+i))+`","source":"app-id","sourceType":"APPLICATION","target":"other-id","targetType":"APPLICATION","state":"`+state+`","error":"boom"}`)
+		}
+		return `{"result":{"id":"formation-id","name":"formation","formationAssignments":{"data":[` + strings.Join(assignments, ",") + `],"pageInfo":{"hasNextPage":false}}}}`
+	}
+	assignResponse := `{"result":{"id":"formation-id","name":"formation","state":"READY"}}`
+
+	testCases := []struct {
+		Name             string
+		Args             []string
+		ClientFn         func(t *testing.T) *automock.GraphQLClient
+		ExpectedExitCode int
+		ExpectedStdout   []string
+		ExpectedStderr   string
+	}{
+		{
+			Name: "Assign without waiting prints the formation",
+			Args: []string{"formation", "assign", "formation", "--object-id", appID},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `assignFormation(objectID: "app-id", objectType: APPLICATION, formation: {name: "formation"})`, assignResponse)
+				return client
+			},
+			ExpectedStdout: []string{"formation-id   formation"},
+		},
+		{
+			Name: "Assign waits until all assignments are READY",
+			Args: []string{"formation", "assign", "formation", "--object-id", appID, "--wait", "--poll-interval", "1ms"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `assignFormation(`, assignResponse)
+				expectRequest(t, client, `formation(id: "formation-id")`, assignmentsPage("INITIAL", "READY"))
+				expectRequest(t, client, `formation(id: "formation-id")`, assignmentsPage("READY", "READY"))
+				return client
+			},
+			ExpectedStdout: []string{"fa-1   app-id   APPLICATION   other-id   APPLICATION   READY", "fa-2"},
+			ExpectedStderr: "2 READY",
+		},
+		{
+			Name: "Assign fails when an assignment ends in error",
+			Args: []string{"formation", "assign", "formation", "--object-id", appID, "--object-type", "application", "--wait", "--poll-interval", "1ms"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `assignFormation(`, assignResponse)
+				expectRequest(t, client, `formation(id: "formation-id")`, assignmentsPage("READY", "CREATE_ERROR"))
+				return client
+			},
+			ExpectedExitCode: 1,
+			ExpectedStdout:   []string{"CREATE_ERROR"},
+			ExpectedStderr:   "assignment fa-2 (app-id -> other-id) is in CREATE_ERROR: boom",
+		},
+		{
+			Name: "Assign fails when waiting times out",
+			Args: []string{"formation", "assign", "formation", "--object-id", appID, "--wait", "--poll-interval", "1h", "--wait-timeout", "10ms"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `assignFormation(`, assignResponse)
+				expectRequest(t, client, `formation(id: "formation-id")`, assignmentsPage("CONFIG_PENDING"))
+				return client
+			},
+			ExpectedExitCode: 1,
+			ExpectedStderr:   `timed out after 10ms waiting for the formation assignments of "app-id" to become READY`,
+		},
+		{
+			Name: "Unassign waits until the assignments are deleted",
+			Args: []string{"formation", "unassign", "formation", "--object-id", appID, "--wait", "--poll-interval", "1ms", "-o", "json"},
+			ClientFn: func(t *testing.T) *automock.GraphQLClient {
+				client := &automock.GraphQLClient{}
+				expectRequest(t, client, `unassignFormation(objectID: "app-id"`, assignResponse)
+				expectRequest(t, client, `formation(id: "formation-id")`, assignmentsPage("DELETING"))
+				expectRequest(t, client, `formation(id: "formation-id")`, assignmentsPage())
+				return client
+			},
+			ExpectedStdout: []string{`"formationAssignments": []`},
+		},
+		{
+			Name:             "Unsupported object type",
+			Args:             []string{"formation", "assign", "formation", "--object-id", appID, "--object-type", "bundle"},
+			ExpectedExitCode: 2,
+			ExpectedStderr:   `unsupported object type "bundle"`,
+		},
+		{
+			Name:             "Missing subcommand",
+			Args:             []string{"formation"},
+			ExpectedExitCode: 2,
+			ExpectedStderr:   "formation expects a subcommand",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			client := &automock.GraphQLClient{}
+			if testCase.ClientFn != nil {
+				client = testCase.ClientFn(t)
+			}
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			cli := compassctl.NewCLI(strings.NewReader(""), stdout, stderr, directorFactory(client))
+
+			// WHEN
+			exitCode := cli.Run(context.TODO(), append(testCase.Args, credentials...))
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedExitCode, exitCode, stderr.String())
+			for _, expected := range testCase.ExpectedStdout {
+				assert.Contains(t, stdout.String(), expected)
+			}
+			if testCase.ExpectedStderr != "" {
+				assert.Contains(t, stderr.String(), testCase.ExpectedStderr)
+			}
+			mock.AssertExpectationsForObjects(t, client)
+		})
+	}
+}
+
+func directorFactory(client compassctl.GraphQLClient) compassctl.DirectorFactory {
+	return func(_ context.Context, cfg compassctl.Config) (*compassctl.Director, error) {
+		return compassctl.NewDirector(client, cfg.Tenant), nil
+	}
+}
+
+func expectRequest(t *testing.T, client *automock.GraphQLClient, queryPart, response string) {
+	client.On("Run", mock.Anything, mock.MatchedBy(func(req *gcli.Request) bool {
+		return strings.Contains(req.Query(), queryPart) && req.Header.Get("Tenant") == tenantID
+	}), mock.Anything).Run(func(args mock.Arguments) {
+		require.NoError(t, json.Unmarshal([]byte(response), args.Get(2)))
+	}).Return(nil).Once()
+}
@@ -0,0 +1,113 @@
+package compassctl
+
+import (
+	"flag"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const envPrefix = "COMPASSCTL_"
+
+// AuthMethod is the way compassctl authenticates against the Director
+type AuthMethod string
+
+const (
+	// AuthMethodOAuth uses the OAuth 2.0 client credentials flow
+	AuthMethodOAuth AuthMethod = "oauth"
+	// AuthMethodCertificate uses a client certificate
+	AuthMethodCertificate AuthMethod = "cert"
+)
+
+// Config holds the global compassctl options
+type Config struct {
+	URL               string
+	Tenant            string
+	Output            OutputFormat
+	Timeout           time.Duration
+	SkipSSLValidation bool
+	Auth              AuthConfig
+}
+
+// AuthConfig holds the authentication options
+type AuthConfig struct {
+	Method       AuthMethod
+	ClientID     string
+	ClientSecret string
+	TokenURL     string
+	Scopes       string
+	CertFile     string
+	KeyFile      string
+	CAFile       string
+}
+
+// registerFlags binds the global options to the flag set. Defaults are taken from COMPASSCTL_* environment variables.
+func (c *Config) registerFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.URL, "url", envOrDefault("URL", ""), "Director GraphQL API URL [$COMPASSCTL_URL]")
+	fs.StringVar(&c.Tenant, "tenant", envOrDefault("TENANT", ""), "External or internal ID of the tenant to act on behalf of [$COMPASSCTL_TENANT]")
+	fs.Var(&c.Output, "output", "Output format: table, json or yaml")
+	fs.Var(&c.Output, "o", "Shorthand for --output")
+	fs.DurationVar(&c.Timeout, "timeout", 30*time.Second, "Timeout of a single request to the Director")
+	fs.BoolVar(&c.SkipSSLValidation, "insecure-skip-tls-verify", false, "Skip the validation of the server certificate")
+
+	fs.StringVar((*string)(&c.Auth.Method), "auth", envOrDefault("AUTH", ""), "Authentication method: oauth or cert. Inferred from the provided credentials when empty [$COMPASSCTL_AUTH]")
+	fs.StringVar(&c.Auth.ClientID, "client-id", envOrDefault("CLIENT_ID", ""), "OAuth client ID [$COMPASSCTL_CLIENT_ID]")
+	fs.StringVar(&c.Auth.ClientSecret, "client-secret", envOrDefault("CLIENT_SECRET", ""), "OAuth client secret [$COMPASSCTL_CLIENT_SECRET]")
+	fs.StringVar(&c.Auth.TokenURL, "token-url", envOrDefault("TOKEN_URL", ""), "OAuth token endpoint [$COMPASSCTL_TOKEN_URL]")
+	fs.StringVar(&c.Auth.Scopes, "scopes", envOrDefault("SCOPES", ""), "Comma-separated OAuth scopes [$COMPASSCTL_SCOPES]")
+	fs.StringVar(&c.Auth.CertFile, "cert", envOrDefault("CERT", ""), "Path to the PEM-encoded client certificate [$COMPASSCTL_CERT]")
+	fs.StringVar(&c.Auth.KeyFile, "key", envOrDefault("KEY", ""), "Path to the PEM-encoded client certificate key [$COMPASSCTL_KEY]")
+	fs.StringVar(&c.Auth.CAFile, "ca", envOrDefault("CA", ""), "Path to a PEM-encoded CA bundle used to verify the server [$COMPASSCTL_CA]")
+}
+
+// Validate checks that the options are sufficient to reach the Director and infers the authentication method if needed
+func (c *Config) Validate() error {
+	if c.URL == "" {
+		return errors.New("the Director URL is required; use --url or COMPASSCTL_URL")
+	}
+
+	if c.Auth.Method == "" {
+		switch {
+		case c.Auth.CertFile != "" || c.Auth.KeyFile != "":
+			c.Auth.Method = AuthMethodCertificate
+		case c.Auth.ClientID != "":
+			c.Auth.Method = AuthMethodOAuth
+		default:
+			return errors.New("credentials are required; use --client-id/--client-secret/--token-url or --cert/--key")
+		}
+	}
+
+	switch c.Auth.Method {
+	case AuthMethodOAuth:
+		if c.Auth.ClientID == "" || c.Auth.ClientSecret == "" || c.Auth.TokenURL == "" {
+			return errors.New("OAuth authentication requires --client-id, --client-secret and --token-url")
+		}
+	case AuthMethodCertificate:
+		if c.Auth.CertFile == "" || c.Auth.KeyFile == "" {
+			return errors.New("certificate authentication requires --cert and --key")
+		}
+	default:
+		return errors.Errorf("unknown authentication method %q", c.Auth.Method)
+	}
+
+	return nil
+}
+
+func (c *AuthConfig) scopes() []string {
+	var scopes []string
+	for _, scope := range strings.Split(c.Scopes, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+func envOrDefault(key, defaultValue string) string {
+	if value, ok := os.LookupEnv(envPrefix + key); ok {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,72 @@
+package compassctl_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/compassctl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	const url = "https://director.local/graphql"
+
+	testCases := []struct {
+		Name           string
+		Config         compassctl.Config
+		ExpectedMethod compassctl.AuthMethod
+		ExpectedError  string
+	}{
+		{
+			Name:           "Infers OAuth from the client ID",
+			Config:         compassctl.Config{URL: url, Auth: compassctl.AuthConfig{ClientID: "id", ClientSecret: "secret", TokenURL: "https://oauth.local/token"}},
+			ExpectedMethod: compassctl.AuthMethodOAuth,
+		},
+		{
+			Name:           "Infers certificate from the certificate files",
+			Config:         compassctl.Config{URL: url, Auth: compassctl.AuthConfig{CertFile: "client.crt", KeyFile: "client.key"}},
+			ExpectedMethod: compassctl.AuthMethodCertificate,
+		},
+		{
+			Name:          "Fails without URL",
+			Config:        compassctl.Config{Auth: compassctl.AuthConfig{CertFile: "client.crt", KeyFile: "client.key"}},
+			ExpectedError: "the Director URL is required",
+		},
+		{
+			Name:          "Fails without credentials",
+			Config:        compassctl.Config{URL: url},
+			ExpectedError: "credentials are required",
+		},
+		{
+			Name:          "Fails for incomplete OAuth credentials",
+			Config:        compassctl.Config{URL: url, Auth: compassctl.AuthConfig{Method: compassctl.AuthMethodOAuth, ClientID: "id"}},
+			ExpectedError: "OAuth authentication requires",
+		},
+		{
+			Name:          "Fails for incomplete certificate credentials",
+			Config:        compassctl.Config{URL: url, Auth: compassctl.AuthConfig{CertFile: "client.crt"}},
+			ExpectedError: "certificate authentication requires",
+		},
+		{
+			Name:          "Fails for unknown method",
+			Config:        compassctl.Config{URL: url, Auth: compassctl.AuthConfig{Method: "basic"}},
+			ExpectedError: `unknown authentication method "basic"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			cfg := testCase.Config
+
+			err := cfg.Validate()
+
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedMethod, cfg.Auth.Method)
+		})
+	}
+}
@@ -0,0 +1,544 @@
+package compassctl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
+	gcli "github.com/machinebox/graphql"
+	"github.com/pkg/errors"
+)
+
+const (
+	tenantHeader    = "Tenant"
+	defaultPageSize = 100
+
+	applicationFields = `
+		id
+		name
+		providerName
+		description
+		applicationTemplateID
+		systemNumber
+		baseUrl
+		status { condition timestamp }
+		createdAt
+		updatedAt`
+	runtimeFields = `
+		id
+		name
+		description
+		status { condition timestamp }`
+	formationFields = `
+		id
+		name
+		formationTemplateId
+		state
+		error { message errorCode }`
+	formationTemplateFields = `
+		id
+		name
+		applicationTypes
+		runtimeTypes
+		runtimeTypeDisplayName
+		runtimeArtifactKind
+		leadingProductIDs
+		supportsReset
+		discoveryConsumers`
+	formationAssignmentFields = `
+		id
+		source
+		sourceType
+		target
+		targetType
+		state
+		error`
+	webhookFields = `
+		id
+		applicationID
+		applicationTemplateID
+		runtimeID
+		formationTemplateID
+		type
+		mode
+		correlationIdKey
+		retryInterval
+		timeout
+		url
+		urlTemplate
+		inputTemplate
+		headerTemplate
+		outputTemplate
+		statusTemplate`
+	tenantFields = `
+		id
+		internalID
+		name
+		type
+		parents
+		initialized
+		labels`
+	operationFields = `
+		id
+		operationType
+		status
+		error
+		errorSeverity
+		createdAt
+		updatedAt`
+	pageInfoFields = `
+		pageInfo { startCursor endCursor hasNextPage }
+		totalCount`
+)
+
+// GraphQLClient executes GraphQL requests against the Director API
+//
+//go:generate mockery --name=GraphQLClient --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLClient interface {
+	Run(ctx context.Context, req *gcli.Request, resp interface{}) error
+}
+
+// WebhookParentType is the type of the object that owns a webhook
+type WebhookParentType string
+
+const (
+	// WebhookParentApplication marks application webhooks
+	WebhookParentApplication WebhookParentType = "application"
+	// WebhookParentRuntime marks runtime webhooks
+	WebhookParentRuntime WebhookParentType = "runtime"
+	// WebhookParentFormationTemplate marks formation template webhooks
+	WebhookParentFormationTemplate WebhookParentType = "formationTemplate"
+)
+
+// WebhookParent identifies the object that owns a webhook
+type WebhookParent struct {
+	Type WebhookParentType
+	ID   string
+}
+
+// FormationDetails is a formation together with its status and formation assignments
+type FormationDetails struct {
+	graphql.Formation
+	Status               graphql.FormationStatus        `json:"status"`
+	FormationAssignments []*graphql.FormationAssignment `json:"formationAssignments"`
+}
+
+// FormationTemplateDetails is a formation template together with its webhooks
+type FormationTemplateDetails struct {
+	graphql.FormationTemplate
+	Webhooks []*graphql.Webhook `json:"webhooks"`
+}
+
+// Director is a typed client for the Director GraphQL API
+type Director struct {
+	client   GraphQLClient
+	tenant   string
+	pageSize int
+	gqlizer  graphqlizer.Graphqlizer
+}
+
+// NewDirector creates a Director client that sends all requests on behalf of the given tenant
+func NewDirector(client GraphQLClient, tenant string) *Director {
+	return &Director{
+		client:   client,
+		tenant:   tenant,
+		pageSize: defaultPageSize,
+	}
+}
+
+// ListApplications returns all applications visible in the tenant
+func (d *Director) ListApplications(ctx context.Context) ([]*graphql.Application, error) {
+	var result []*graphql.Application
+	err := d.listAll(ctx, func(after string) string {
+		return fmt.Sprintf(`query { result: applications(%s) { data { %s } %s } }`, d.pageArgs(after), applicationFields, pageInfoFields)
+	}, func(page *rawPage) error {
+		var data []*graphql.Application
+		if err := page.decode(&data); err != nil {
+			return err
+		}
+		result = append(result, data...)
+		return nil
+	})
+	return result, err
+}
+
+// GetApplication returns the application with the given ID together with its labels and webhooks
+func (d *Director) GetApplication(ctx context.Context, id string) (*graphql.ApplicationExt, error) {
+	var result *graphql.ApplicationExt
+	query := fmt.Sprintf(`query { result: application(id: %q) { %s labels webhooks { %s } } }`, id, applicationFields, webhookFields)
+	if err := d.run(ctx, query, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, notFoundError("application", id)
+	}
+	return result, nil
+}
+
+// RegisterApplication registers a new application
+func (d *Director) RegisterApplication(ctx context.Context, in graphql.ApplicationRegisterInput) (*graphql.Application, error) {
+	gqlInput, err := d.gqlizer.ApplicationRegisterInputToGQL(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building application input")
+	}
+
+	var result *graphql.Application
+	query := fmt.Sprintf(`mutation { result: registerApplication(in: %s) { %s } }`, gqlInput, applicationFields)
+	err = d.run(ctx, query, &result)
+	return result, err
+}
+
+// UnregisterApplication deletes the application with the given ID
+func (d *Director) UnregisterApplication(ctx context.Context, id string) (*graphql.Application, error) {
+	var result *graphql.Application
+	query := fmt.Sprintf(`mutation { result: unregisterApplication(id: %q) { %s } }`, id, applicationFields)
+	err := d.run(ctx, query, &result)
+	return result, err
+}
+
+// ListRuntimes returns all runtimes visible in the tenant
+func (d *Director) ListRuntimes(ctx context.Context) ([]*graphql.Runtime, error) {
+	var result []*graphql.Runtime
+	err := d.listAll(ctx, func(after string) string {
+		return fmt.Sprintf(`query { result: runtimes(%s) { data { %s } %s } }`, d.pageArgs(after), runtimeFields, pageInfoFields)
+	}, func(page *rawPage) error {
+		var data []*graphql.Runtime
+		if err := page.decode(&data); err != nil {
+			return err
+		}
+		result = append(result, data...)
+		return nil
+	})
+	return result, err
+}
+
+// GetRuntime returns the runtime with the given ID together with its labels and webhooks
+func (d *Director) GetRuntime(ctx context.Context, id string) (*graphql.RuntimeExt, error) {
+	var result *graphql.RuntimeExt
+	query := fmt.Sprintf(`query { result: runtime(id: %q) { %s labels webhooks { %s } } }`, id, runtimeFields, webhookFields)
+	if err := d.run(ctx, query, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, notFoundError("runtime", id)
+	}
+	return result, nil
+}
+
+// RegisterRuntime registers a new runtime
+func (d *Director) RegisterRuntime(ctx context.Context, in graphql.RuntimeRegisterInput) (*graphql.Runtime, error) {
+	gqlInput, err := d.gqlizer.RuntimeRegisterInputToGQL(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building runtime input")
+	}
+
+	var result *graphql.Runtime
+	query := fmt.Sprintf(`mutation { result: registerRuntime(in: %s) { %s } }`, gqlInput, runtimeFields)
+	err = d.run(ctx, query, &result)
+	return result, err
+}
+
+// UnregisterRuntime deletes the runtime with the given ID
+func (d *Director) UnregisterRuntime(ctx context.Context, id string) (*graphql.Runtime, error) {
+	var result *graphql.Runtime
+	query := fmt.Sprintf(`mutation { result: unregisterRuntime(id: %q) { %s } }`, id, runtimeFields)
+	err := d.run(ctx, query, &result)
+	return result, err
+}
+
+// ListFormations returns all formations in the tenant
+func (d *Director) ListFormations(ctx context.Context) ([]*graphql.Formation, error) {
+	var result []*graphql.Formation
+	err := d.listAll(ctx, func(after string) string {
+		return fmt.Sprintf(`query { result: formations(%s) { data { %s } %s } }`, d.pageArgs(after), formationFields, pageInfoFields)
+	}, func(page *rawPage) error {
+		var data []*graphql.Formation
+		if err := page.decode(&data); err != nil {
+			return err
+		}
+		result = append(result, data...)
+		return nil
+	})
+	return result, err
+}
+
+// GetFormation returns the formation with the given ID together with its status and all of its formation assignments
+func (d *Director) GetFormation(ctx context.Context, id string) (*FormationDetails, error) {
+	var result *struct {
+		FormationDetails
+		FormationAssignments graphql.FormationAssignmentPage `json:"formationAssignments"`
+	}
+	query := fmt.Sprintf(`query { result: formation(id: %q) { %s status { condition errors { assignmentID message errorCode } } formationAssignments(first: %d) { data { %s } %s } } }`,
+		id, formationFields, d.pageSize, formationAssignmentFields, pageInfoFields)
+	if err := d.run(ctx, query, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, notFoundError("formation", id)
+	}
+
+	details := result.FormationDetails
+	details.FormationAssignments = result.FormationAssignments.Data
+	if pageInfo := result.FormationAssignments.PageInfo; pageInfo != nil && pageInfo.HasNextPage {
+		assignments, err := d.listFormationAssignments(ctx, id, string(pageInfo.EndCursor))
+		if err != nil {
+			return nil, err
+		}
+		details.FormationAssignments = append(details.FormationAssignments, assignments...)
+	}
+
+	return &details, nil
+}
+
+// GetFormationByName returns the formation with the given name
+func (d *Director) GetFormationByName(ctx context.Context, name string) (*graphql.Formation, error) {
+	var result *graphql.Formation
+	query := fmt.Sprintf(`query { result: formationByName(name: %q) { %s } }`, name, formationFields)
+	if err := d.run(ctx, query, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, notFoundError("formation", name)
+	}
+	return result, nil
+}
+
+// CreateFormation creates a new formation
+func (d *Director) CreateFormation(ctx context.Context, in graphql.FormationInput) (*graphql.Formation, error) {
+	// the template name is optional, so the input is built here instead of with the graphqlizer which always renders it
+	gqlInput := fmt.Sprintf("name: %q", in.Name)
+	if in.TemplateName != nil {
+		gqlInput += fmt.Sprintf(", templateName: %q", *in.TemplateName)
+	}
+
+	var result *graphql.Formation
+	query := fmt.Sprintf(`mutation { result: createFormation(formation: {%s}) { %s } }`, gqlInput, formationFields)
+	err := d.run(ctx, query, &result)
+	return result, err
+}
+
+// DeleteFormation deletes the formation with the given name
+func (d *Director) DeleteFormation(ctx context.Context, name string) (*graphql.Formation, error) {
+	var result *graphql.Formation
+	query := fmt.Sprintf(`mutation { result: deleteFormation(formation: {name: %q}) { %s } }`, name, formationFields)
+	err := d.run(ctx, query, &result)
+	return result, err
+}
+
+// AssignFormation assigns the object to the formation with the given name
+func (d *Director) AssignFormation(ctx context.Context, objectID string, objectType graphql.FormationObjectType, formationName string) (*graphql.Formation, error) {
+	var result *graphql.Formation
+	query := fmt.Sprintf(`mutation { result: assignFormation(objectID: %q, objectType: %s, formation: {name: %q}) { %s } }`, objectID, objectType, formationName, formationFields)
+	err := d.run(ctx, query, &result)
+	return result, err
+}
+
+// UnassignFormation unassigns the object from the formation with the given name
+func (d *Director) UnassignFormation(ctx context.Context, objectID string, objectType graphql.FormationObjectType, formationName string) (*graphql.Formation, error) {
+	var result *graphql.Formation
+	query := fmt.Sprintf(`mutation { result: unassignFormation(objectID: %q, objectType: %s, formation: {name: %q}) { %s } }`, objectID, objectType, formationName, formationFields)
+	err := d.run(ctx, query, &result)
+	return result, err
+}
+
+// ListFormationTemplates returns all formation templates visible in the tenant
+func (d *Director) ListFormationTemplates(ctx context.Context) ([]*graphql.FormationTemplate, error) {
+	var result []*graphql.FormationTemplate
+	err := d.listAll(ctx, func(after string) string {
+		return fmt.Sprintf(`query { result: formationTemplates(%s) { data { %s } %s } }`, d.pageArgs(after), formationTemplateFields, pageInfoFields)
+	}, func(page *rawPage) error {
+		var data []*graphql.FormationTemplate
+		if err := page.decode(&data); err != nil {
+			return err
+		}
+		result = append(result, data...)
+		return nil
+	})
+	return result, err
+}
+
+// GetFormationTemplate returns the formation template with the given ID together with its webhooks
+func (d *Director) GetFormationTemplate(ctx context.Context, id string) (*FormationTemplateDetails, error) {
+	var result *FormationTemplateDetails
+	query := fmt.Sprintf(`query { result: formationTemplate(id: %q) { %s webhooks { %s } } }`, id, formationTemplateFields, webhookFields)
+	if err := d.run(ctx, query, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, notFoundError("formation template", id)
+	}
+	return result, nil
+}
+
+// CreateFormationTemplate creates a new formation template
+func (d *Director) CreateFormationTemplate(ctx context.Context, in graphql.FormationTemplateRegisterInput) (*graphql.FormationTemplate, error) {
+	gqlInput, err := d.gqlizer.FormationTemplateRegisterInputToGQL(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building formation template input")
+	}
+
+	var result *graphql.FormationTemplate
+	query := fmt.Sprintf(`mutation { result: createFormationTemplate(in: %s) { %s } }`, gqlInput, formationTemplateFields)
+	err = d.run(ctx, query, &result)
+	return result, err
+}
+
+// DeleteFormationTemplate deletes the formation template with the given ID
+func (d *Director) DeleteFormationTemplate(ctx context.Context, id string) (*graphql.FormationTemplate, error) {
+	var result *graphql.FormationTemplate
+	query := fmt.Sprintf(`mutation { result: deleteFormationTemplate(id: %q) { %s } }`, id, formationTemplateFields)
+	err := d.run(ctx, query, &result)
+	return result, err
+}
+
+// ListWebhooks returns the webhooks of the given parent object
+func (d *Director) ListWebhooks(ctx context.Context, parent WebhookParent) ([]*graphql.Webhook, error) {
+	var result *struct {
+		Webhooks []*graphql.Webhook `json:"webhooks"`
+	}
+	query := fmt.Sprintf(`query { result: %s(id: %q) { webhooks { %s } } }`, parent.Type, parent.ID, webhookFields)
+	if err := d.run(ctx, query, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, notFoundError(string(parent.Type), parent.ID)
+	}
+	return result.Webhooks, nil
+}
+
+// AddWebhook adds a webhook to the given parent object
+func (d *Director) AddWebhook(ctx context.Context, parent WebhookParent, in graphql.WebhookInput) (*graphql.Webhook, error) {
+	gqlInput, err := d.gqlizer.WebhookInputToGQL(&in)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building webhook input")
+	}
+
+	var result *graphql.Webhook
+	query := fmt.Sprintf(`mutation { result: addWebhook(%sID: %q, in: %s) { %s } }`, parent.Type, parent.ID, gqlInput, webhookFields)
+	err = d.run(ctx, query, &result)
+	return result, err
+}
+
+// DeleteWebhook deletes the webhook with the given ID
+func (d *Director) DeleteWebhook(ctx context.Context, id string) (*graphql.Webhook, error) {
+	var result *graphql.Webhook
+	query := fmt.Sprintf(`mutation { result: deleteWebhook(webhookID: %q) { %s } }`, id, webhookFields)
+	err := d.run(ctx, query, &result)
+	return result, err
+}
+
+// ListTenants returns all tenants, optionally filtered by a search term
+func (d *Director) ListTenants(ctx context.Context, searchTerm string) ([]*graphql.Tenant, error) {
+	searchArg := ""
+	if searchTerm != "" {
+		searchArg = fmt.Sprintf(", searchTerm: %q", searchTerm)
+	}
+
+	var result []*graphql.Tenant
+	err := d.listAll(ctx, func(after string) string {
+		return fmt.Sprintf(`query { result: tenants(%s%s) { data { %s } %s } }`, d.pageArgs(after), searchArg, tenantFields, pageInfoFields)
+	}, func(page *rawPage) error {
+		var data []*graphql.Tenant
+		if err := page.decode(&data); err != nil {
+			return err
+		}
+		result = append(result, data...)
+		return nil
+	})
+	return result, err
+}
+
+// GetTenant returns the tenant with the given external ID
+func (d *Director) GetTenant(ctx context.Context, externalID string) (*graphql.Tenant, error) {
+	var result *graphql.Tenant
+	query := fmt.Sprintf(`query { result: tenantByExternalID(id: %q) { %s } }`, externalID, tenantFields)
+	if err := d.run(ctx, query, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, notFoundError("tenant", externalID)
+	}
+	return result, nil
+}
+
+// WriteTenants creates the provided tenants and returns their internal IDs
+func (d *Director) WriteTenants(ctx context.Context, in []graphql.BusinessTenantMappingInput) ([]string, error) {
+	gqlInput, err := d.gqlizer.WriteTenantsInputToGQL(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building tenants input")
+	}
+
+	var result []string
+	query := fmt.Sprintf(`mutation { result: writeTenants(in: [%s]) }`, gqlInput)
+	err = d.run(ctx, query, &result)
+	return result, err
+}
+
+// DeleteTenants deletes the tenants with the given external IDs
+func (d *Director) DeleteTenants(ctx context.Context, externalIDs []string) (int, error) {
+	quoted := make([]string, 0, len(externalIDs))
+	for _, id := range externalIDs {
+		quoted = append(quoted, strconv.Quote(id))
+	}
+
+	var result int
+	query := fmt.Sprintf(`mutation { result: deleteTenants(in: [%s]) }`, strings.Join(quoted, ", "))
+	err := d.run(ctx, query, &result)
+	return result, err
+}
+
+// GetOperation returns the operation with the given ID
+func (d *Director) GetOperation(ctx context.Context, id string) (*graphql.Operation, error) {
+	var result *graphql.Operation
+	query := fmt.Sprintf(`query { result: operation(id: %q) { %s } }`, id, operationFields)
+	if err := d.run(ctx, query, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, notFoundError("operation", id)
+	}
+	return result, nil
+}
+
+func (d *Director) listFormationAssignments(ctx context.Context, formationID, after string) ([]*graphql.FormationAssignment, error) {
+	var result []*graphql.FormationAssignment
+	err := d.listAll(ctx, func(cursor string) string {
+		if cursor == "" {
+			cursor = after
+		}
+		return fmt.Sprintf(`query { result: formation(id: %q) { formationAssignments(%s) { data { %s } %s } } }`, formationID, d.pageArgs(cursor), formationAssignmentFields, pageInfoFields)
+	}, func(page *rawPage) error {
+		var data []*graphql.FormationAssignment
+		if err := page.decode(&data); err != nil {
+			return err
+		}
+		result = append(result, data...)
+		return nil
+	}, "formationAssignments")
+	return result, err
+}
+
+func (d *Director) pageArgs(after string) string {
+	if after == "" {
+		return fmt.Sprintf("first: %d", d.pageSize)
+	}
+	return fmt.Sprintf("first: %d, after: %q", d.pageSize, after)
+}
+
+func (d *Director) run(ctx context.Context, query string, result interface{}) error {
+	req := gcli.NewRequest(query)
+	if d.tenant != "" {
+		req.Header.Set(tenantHeader, d.tenant)
+	}
+
+	resp := struct {
+		Result interface{} `json:"result"`
+	}{Result: result}
+	if err := d.client.Run(ctx, req, &resp); err != nil {
+		return errors.Wrap(err, "while executing GraphQL request")
+	}
+	return nil
+}
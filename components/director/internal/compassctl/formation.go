@@ -0,0 +1,128 @@
+package compassctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+// FormationAssignmentResult is the outcome of a formation assign or unassign operation that was waited for
+type FormationAssignmentResult struct {
+	Formation            *graphql.Formation             `json:"formation"`
+	FormationAssignments []*graphql.FormationAssignment `json:"formationAssignments"`
+}
+
+// assignmentWaiter polls the formation assignments of an object until they reach their final state
+type assignmentWaiter struct {
+	director     *Director
+	progress     io.Writer
+	timeout      time.Duration
+	pollInterval time.Duration
+}
+
+// wait polls the formation until all assignments of the object are READY, or until they are all gone when unassigning.
+// An assignment in CREATE_ERROR or DELETE_ERROR state ends the wait with an error.
+func (w *assignmentWaiter) wait(ctx context.Context, formationID, objectID string, unassign bool) ([]*graphql.FormationAssignment, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	lastSummary := ""
+	var assignments []*graphql.FormationAssignment
+	for {
+		formation, err := w.director.GetFormation(ctx, formationID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return assignments, w.timeoutError(objectID, unassign)
+			}
+			return assignments, errors.Wrap(err, "while fetching formation assignments")
+		}
+
+		assignments = assignmentsOfObject(formation.FormationAssignments, objectID)
+		if summary := summarizeAssignments(assignments); summary != lastSummary {
+			fmt.Fprintf(w.progress, "Formation assignments of %q: %s\n", objectID, summary)
+			lastSummary = summary
+		}
+
+		done, err := assignmentsSettled(assignments, unassign)
+		if err != nil || done {
+			return assignments, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return assignments, w.timeoutError(objectID, unassign)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *assignmentWaiter) timeoutError(objectID string, unassign bool) error {
+	if unassign {
+		return errors.Errorf("timed out after %s waiting for the formation assignments of %q to be deleted", w.timeout, objectID)
+	}
+	return errors.Errorf("timed out after %s waiting for the formation assignments of %q to become %s", w.timeout, objectID, model.ReadyAssignmentState)
+}
+
+func assignmentsOfObject(assignments []*graphql.FormationAssignment, objectID string) []*graphql.FormationAssignment {
+	result := make([]*graphql.FormationAssignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		if assignment.Source == objectID || assignment.Target == objectID {
+			result = append(result, assignment)
+		}
+	}
+	return result
+}
+
+func assignmentsSettled(assignments []*graphql.FormationAssignment, unassign bool) (bool, error) {
+	var failed []string
+	ready := true
+	for _, assignment := range assignments {
+		switch model.FormationAssignmentState(assignment.State) {
+		case model.CreateErrorAssignmentState, model.DeleteErrorAssignmentState:
+			reason := assignment.State
+			if assignment.Error != nil && *assignment.Error != "" {
+				reason = fmt.Sprintf("%s: %s", assignment.State, *assignment.Error)
+			}
+			failed = append(failed, fmt.Sprintf("assignment %s (%s -> %s) is in %s", assignment.ID, assignment.Source, assignment.Target, reason))
+		case model.ReadyAssignmentState:
+		default:
+			ready = false
+		}
+	}
+
+	if len(failed) > 0 {
+		return true, errors.New(strings.Join(failed, "; "))
+	}
+	if unassign {
+		return len(assignments) == 0, nil
+	}
+	return ready, nil
+}
+
+func summarizeAssignments(assignments []*graphql.FormationAssignment) string {
+	if len(assignments) == 0 {
+		return "none"
+	}
+
+	counts := make(map[string]int)
+	for _, assignment := range assignments {
+		counts[assignment.State]++
+	}
+
+	states := make([]string, 0, len(counts))
+	for state, count := range counts {
+		states = append(states, fmt.Sprintf("%d %s", count, state))
+	}
+	sort.Strings(states)
+	return strings.Join(states, ", ")
+}
@@ -0,0 +1,62 @@
+package compassctl
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"os"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// NewHTTPClient creates an HTTP client that authenticates its requests according to the provided configuration
+func NewHTTPClient(ctx context.Context, cfg Config) (*http.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.SkipSSLValidation}
+
+	if cfg.Auth.CAFile != "" {
+		caPEM, err := os.ReadFile(cfg.Auth.CAFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading CA bundle %q", cfg.Auth.CAFile)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.Errorf("no certificates found in CA bundle %q", cfg.Auth.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.Auth.Method == AuthMethodCertificate {
+		cert, err := tls.LoadX509KeyPair(cfg.Auth.CertFile, cfg.Auth.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "while loading client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	client := &http.Client{
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewHTTPTransportWrapper(&http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		})),
+		Timeout: cfg.Timeout,
+	}
+
+	if cfg.Auth.Method != AuthMethodOAuth {
+		return client, nil
+	}
+
+	oauthCfg := &clientcredentials.Config{
+		ClientID:     cfg.Auth.ClientID,
+		ClientSecret: cfg.Auth.ClientSecret,
+		TokenURL:     cfg.Auth.TokenURL,
+		Scopes:       cfg.Auth.scopes(),
+	}
+
+	oauthClient := oauthCfg.Client(context.WithValue(ctx, oauth2.HTTPClient, client))
+	oauthClient.Timeout = cfg.Timeout
+	return oauthClient, nil
+}
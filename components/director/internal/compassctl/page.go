@@ -0,0 +1,62 @@
+package compassctl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+type rawPage struct {
+	Data     json.RawMessage   `json:"data"`
+	PageInfo *graphql.PageInfo `json:"pageInfo"`
+}
+
+func (p *rawPage) decode(into interface{}) error {
+	if len(p.Data) == 0 {
+		return nil
+	}
+	return errors.Wrap(json.Unmarshal(p.Data, into), "while decoding page data")
+}
+
+// listAll follows the page cursors of a paginated query until the last page. The optional path
+// points to the page object when it is nested inside the top-level result.
+func (d *Director) listAll(ctx context.Context, queryFn func(after string) string, handleFn func(page *rawPage) error, path ...string) error {
+	after := ""
+	for {
+		var raw json.RawMessage
+		if err := d.run(ctx, queryFn(after), &raw); err != nil {
+			return err
+		}
+
+		for _, key := range path {
+			var nested map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &nested); err != nil {
+				return errors.Wrapf(err, "while decoding %q", key)
+			}
+			raw = nested[key]
+		}
+
+		page := &rawPage{}
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, page); err != nil {
+				return errors.Wrap(err, "while decoding page")
+			}
+		}
+
+		if err := handleFn(page); err != nil {
+			return err
+		}
+
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			return nil
+		}
+		after = string(page.PageInfo.EndCursor)
+	}
+}
+
+func notFoundError(resourceType, id string) error {
+	return fmt.Errorf("%s %q not found", resourceType, id)
+}
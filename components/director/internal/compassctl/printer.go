@@ -0,0 +1,95 @@
+package compassctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+)
+
+// OutputFormat is the format in which results are printed
+type OutputFormat string
+
+const (
+	// OutputTable prints results as a table
+	OutputTable OutputFormat = "table"
+	// OutputJSON prints results as JSON
+	OutputJSON OutputFormat = "json"
+	// OutputYAML prints results as YAML
+	OutputYAML OutputFormat = "yaml"
+)
+
+// String implements flag.Value
+func (o *OutputFormat) String() string {
+	return string(*o)
+}
+
+// Set implements flag.Value
+func (o *OutputFormat) Set(value string) error {
+	switch format := OutputFormat(strings.ToLower(value)); format {
+	case OutputTable, OutputJSON, OutputYAML:
+		*o = format
+		return nil
+	default:
+		return errors.Errorf("unsupported output format %q; use table, json or yaml", value)
+	}
+}
+
+// table is the tabular view of a result
+type table struct {
+	columns []string
+	rows    [][]string
+}
+
+// Print writes the object in the requested format. The table view is used only for the table format.
+func Print(w io.Writer, format OutputFormat, obj interface{}, view *table) error {
+	switch format {
+	case OutputJSON:
+		out, err := json.MarshalIndent(obj, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "while marshalling output to JSON")
+		}
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	case OutputYAML:
+		return printYAML(w, obj)
+	default:
+		if view == nil {
+			return printYAML(w, obj)
+		}
+		return printTable(w, view)
+	}
+}
+
+func printYAML(w io.Writer, obj interface{}) error {
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling output to YAML")
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+func printTable(w io.Writer, view *table) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 3, ' ', 0)
+	if _, err := fmt.Fprintln(tw, strings.Join(view.columns, "\t")); err != nil {
+		return err
+	}
+	for _, row := range view.rows {
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func valueOrDash(value *string) string {
+	if value == nil || *value == "" {
+		return "-"
+	}
+	return *value
+}
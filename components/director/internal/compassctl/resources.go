@@ -0,0 +1,397 @@
+package compassctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+// resourceOptions are the command options that only some resources make use of
+type resourceOptions struct {
+	webhookParent WebhookParent
+	searchTerm    string
+}
+
+// resource describes the operations compassctl supports for a Director object type. A nil operation is not supported.
+type resource struct {
+	name     string
+	aliases  []string
+	list     func(ctx context.Context, d *Director, opts resourceOptions) (interface{}, *table, error)
+	get      func(ctx context.Context, d *Director, id string) (interface{}, *table, error)
+	describe func(ctx context.Context, d *Director, id string) (interface{}, error)
+	create   func(ctx context.Context, d *Director, data []byte, opts resourceOptions) (interface{}, *table, error)
+	delete   func(ctx context.Context, d *Director, id string) (interface{}, *table, error)
+}
+
+var resources = []*resource{
+	{
+		name:    "applications",
+		aliases: []string{"application", "app", "apps"},
+		list: func(ctx context.Context, d *Director, _ resourceOptions) (interface{}, *table, error) {
+			apps, err := d.ListApplications(ctx)
+			return apps, applicationsTable(apps...), err
+		},
+		get: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			app, err := d.GetApplication(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			return app, applicationsTable(&app.Application), nil
+		},
+		describe: func(ctx context.Context, d *Director, id string) (interface{}, error) {
+			return d.GetApplication(ctx, id)
+		},
+		create: func(ctx context.Context, d *Director, data []byte, _ resourceOptions) (interface{}, *table, error) {
+			in := graphql.ApplicationRegisterInput{}
+			if err := decodeInput(data, &in); err != nil {
+				return nil, nil, err
+			}
+			app, err := d.RegisterApplication(ctx, in)
+			return app, applicationsTable(app), err
+		},
+		delete: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			app, err := d.UnregisterApplication(ctx, id)
+			return app, applicationsTable(app), err
+		},
+	},
+	{
+		name:    "runtimes",
+		aliases: []string{"runtime", "rt"},
+		list: func(ctx context.Context, d *Director, _ resourceOptions) (interface{}, *table, error) {
+			runtimes, err := d.ListRuntimes(ctx)
+			return runtimes, runtimesTable(runtimes...), err
+		},
+		get: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			runtime, err := d.GetRuntime(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			return runtime, runtimesTable(&runtime.Runtime), nil
+		},
+		describe: func(ctx context.Context, d *Director, id string) (interface{}, error) {
+			return d.GetRuntime(ctx, id)
+		},
+		create: func(ctx context.Context, d *Director, data []byte, _ resourceOptions) (interface{}, *table, error) {
+			in := graphql.RuntimeRegisterInput{}
+			if err := decodeInput(data, &in); err != nil {
+				return nil, nil, err
+			}
+			runtime, err := d.RegisterRuntime(ctx, in)
+			return runtime, runtimesTable(runtime), err
+		},
+		delete: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			runtime, err := d.UnregisterRuntime(ctx, id)
+			return runtime, runtimesTable(runtime), err
+		},
+	},
+	{
+		name:    "formations",
+		aliases: []string{"formation"},
+		list: func(ctx context.Context, d *Director, _ resourceOptions) (interface{}, *table, error) {
+			formations, err := d.ListFormations(ctx)
+			return formations, formationsTable(formations...), err
+		},
+		get: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			formation, err := d.GetFormation(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			return formation, formationsTable(&formation.Formation), nil
+		},
+		describe: func(ctx context.Context, d *Director, id string) (interface{}, error) {
+			return d.GetFormation(ctx, id)
+		},
+		create: func(ctx context.Context, d *Director, data []byte, _ resourceOptions) (interface{}, *table, error) {
+			in := graphql.FormationInput{}
+			if err := decodeInput(data, &in); err != nil {
+				return nil, nil, err
+			}
+			formation, err := d.CreateFormation(ctx, in)
+			return formation, formationsTable(formation), err
+		},
+		delete: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			existing, err := d.GetFormation(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			formation, err := d.DeleteFormation(ctx, existing.Name)
+			return formation, formationsTable(formation), err
+		},
+	},
+	{
+		name:    "formationtemplates",
+		aliases: []string{"formationtemplate", "formation-templates", "formation-template", "ft"},
+		list: func(ctx context.Context, d *Director, _ resourceOptions) (interface{}, *table, error) {
+			templates, err := d.ListFormationTemplates(ctx)
+			return templates, formationTemplatesTable(templates...), err
+		},
+		get: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			template, err := d.GetFormationTemplate(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			return template, formationTemplatesTable(&template.FormationTemplate), nil
+		},
+		describe: func(ctx context.Context, d *Director, id string) (interface{}, error) {
+			return d.GetFormationTemplate(ctx, id)
+		},
+		create: func(ctx context.Context, d *Director, data []byte, _ resourceOptions) (interface{}, *table, error) {
+			in := graphql.FormationTemplateRegisterInput{}
+			if err := decodeInput(data, &in); err != nil {
+				return nil, nil, err
+			}
+			template, err := d.CreateFormationTemplate(ctx, in)
+			return template, formationTemplatesTable(template), err
+		},
+		delete: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			template, err := d.DeleteFormationTemplate(ctx, id)
+			return template, formationTemplatesTable(template), err
+		},
+	},
+	{
+		name:    "webhooks",
+		aliases: []string{"webhook", "wh"},
+		list: func(ctx context.Context, d *Director, opts resourceOptions) (interface{}, *table, error) {
+			if opts.webhookParent.ID == "" {
+				return nil, nil, errNoWebhookParent
+			}
+			webhooks, err := d.ListWebhooks(ctx, opts.webhookParent)
+			return webhooks, webhooksTable(webhooks...), err
+		},
+		create: func(ctx context.Context, d *Director, data []byte, opts resourceOptions) (interface{}, *table, error) {
+			if opts.webhookParent.ID == "" {
+				return nil, nil, errNoWebhookParent
+			}
+			in := graphql.WebhookInput{}
+			if err := decodeInput(data, &in); err != nil {
+				return nil, nil, err
+			}
+			webhook, err := d.AddWebhook(ctx, opts.webhookParent, in)
+			return webhook, webhooksTable(webhook), err
+		},
+		delete: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			webhook, err := d.DeleteWebhook(ctx, id)
+			return webhook, webhooksTable(webhook), err
+		},
+	},
+	{
+		name:    "tenants",
+		aliases: []string{"tenant"},
+		list: func(ctx context.Context, d *Director, opts resourceOptions) (interface{}, *table, error) {
+			tenants, err := d.ListTenants(ctx, opts.searchTerm)
+			return tenants, tenantsTable(tenants...), err
+		},
+		get: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			tenant, err := d.GetTenant(ctx, id)
+			return tenant, tenantsTable(tenant), err
+		},
+		describe: func(ctx context.Context, d *Director, id string) (interface{}, error) {
+			return d.GetTenant(ctx, id)
+		},
+		create: func(ctx context.Context, d *Director, data []byte, _ resourceOptions) (interface{}, *table, error) {
+			var in []graphql.BusinessTenantMappingInput
+			if err := decodeListInput(data, &in); err != nil {
+				return nil, nil, err
+			}
+			ids, err := d.WriteTenants(ctx, in)
+			view := &table{columns: []string{"INTERNAL ID"}}
+			for _, id := range ids {
+				view.rows = append(view.rows, []string{id})
+			}
+			return ids, view, err
+		},
+		delete: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			count, err := d.DeleteTenants(ctx, []string{id})
+			return count, &table{columns: []string{"DELETED"}, rows: [][]string{{strconv.Itoa(count)}}}, err
+		},
+	},
+	{
+		name:    "operations",
+		aliases: []string{"operation", "op"},
+		get: func(ctx context.Context, d *Director, id string) (interface{}, *table, error) {
+			operation, err := d.GetOperation(ctx, id)
+			return operation, operationsTable(operation), err
+		},
+		describe: func(ctx context.Context, d *Director, id string) (interface{}, error) {
+			return d.GetOperation(ctx, id)
+		},
+	},
+}
+
+var errNoWebhookParent = errors.New("the owner of the webhooks is required; use --application-id, --runtime-id or --formation-template-id")
+
+func findResource(name string) (*resource, error) {
+	name = strings.ToLower(name)
+	for _, r := range resources {
+		if r.name == name {
+			return r, nil
+		}
+		for _, alias := range r.aliases {
+			if alias == name {
+				return r, nil
+			}
+		}
+	}
+
+	names := make([]string, 0, len(resources))
+	for _, r := range resources {
+		names = append(names, r.name)
+	}
+	sort.Strings(names)
+	return nil, errors.Errorf("unknown resource %q; supported resources are: %s", name, strings.Join(names, ", "))
+}
+
+// decodeInput decodes a JSON or YAML document into a GraphQL input type and validates it
+func decodeInput(data []byte, into interface{}) error {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return errors.Wrap(err, "while parsing input document")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(into); err != nil {
+		return errors.Wrap(err, "while decoding input document")
+	}
+
+	if validatable, ok := into.(interface{ Validate() error }); ok {
+		if err := validatable.Validate(); err != nil {
+			return errors.Wrap(err, "invalid input")
+		}
+	}
+	return nil
+}
+
+// decodeListInput decodes a document holding either a single input object or a list of them
+func decodeListInput(data []byte, into interface{}) error {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return errors.Wrap(err, "while parsing input document")
+	}
+
+	if trimmed := bytes.TrimSpace(jsonData); len(trimmed) > 0 && trimmed[0] != '[' {
+		jsonData = append(append([]byte("["), trimmed...), ']')
+	}
+	return decodeInput(jsonData, into)
+}
+
+func applicationsTable(apps ...*graphql.Application) *table {
+	view := &table{columns: []string{"ID", "NAME", "PROVIDER", "SYSTEM NUMBER", "STATUS"}}
+	for _, app := range apps {
+		if app == nil {
+			continue
+		}
+		id, status := "", "-"
+		if app.BaseEntity != nil {
+			id = app.ID
+		}
+		if app.Status != nil {
+			status = string(app.Status.Condition)
+		}
+		view.rows = append(view.rows, []string{id, app.Name, valueOrDash(app.ProviderName), valueOrDash(app.SystemNumber), status})
+	}
+	return view
+}
+
+func runtimesTable(runtimes ...*graphql.Runtime) *table {
+	view := &table{columns: []string{"ID", "NAME", "STATUS"}}
+	for _, runtime := range runtimes {
+		if runtime == nil {
+			continue
+		}
+		status := "-"
+		if runtime.Status != nil {
+			status = string(runtime.Status.Condition)
+		}
+		view.rows = append(view.rows, []string{runtime.ID, runtime.Name, status})
+	}
+	return view
+}
+
+func formationsTable(formations ...*graphql.Formation) *table {
+	view := &table{columns: []string{"ID", "NAME", "FORMATION TEMPLATE ID", "STATE"}}
+	for _, formation := range formations {
+		if formation == nil {
+			continue
+		}
+		view.rows = append(view.rows, []string{formation.ID, formation.Name, formation.FormationTemplateID, formation.State})
+	}
+	return view
+}
+
+func formationTemplatesTable(templates ...*graphql.FormationTemplate) *table {
+	view := &table{columns: []string{"ID", "NAME", "APPLICATION TYPES", "RUNTIME TYPES"}}
+	for _, template := range templates {
+		if template == nil {
+			continue
+		}
+		view.rows = append(view.rows, []string{template.ID, template.Name, joinOrDash(template.ApplicationTypes), joinOrDash(template.RuntimeTypes)})
+	}
+	return view
+}
+
+func formationAssignmentsTable(assignments ...*graphql.FormationAssignment) *table {
+	view := &table{columns: []string{"ID", "SOURCE", "SOURCE TYPE", "TARGET", "TARGET TYPE", "STATE"}}
+	for _, assignment := range assignments {
+		if assignment == nil {
+			continue
+		}
+		view.rows = append(view.rows, []string{assignment.ID, assignment.Source, string(assignment.SourceType), assignment.Target, string(assignment.TargetType), assignment.State})
+	}
+	return view
+}
+
+func webhooksTable(webhooks ...*graphql.Webhook) *table {
+	view := &table{columns: []string{"ID", "TYPE", "MODE", "URL"}}
+	for _, webhook := range webhooks {
+		if webhook == nil {
+			continue
+		}
+		mode := "-"
+		if webhook.Mode != nil {
+			mode = string(*webhook.Mode)
+		}
+		url := webhook.URL
+		if url == nil {
+			url = webhook.URLTemplate
+		}
+		view.rows = append(view.rows, []string{webhook.ID, string(webhook.Type), mode, valueOrDash(url)})
+	}
+	return view
+}
+
+func tenantsTable(tenants ...*graphql.Tenant) *table {
+	view := &table{columns: []string{"ID", "INTERNAL ID", "NAME", "TYPE"}}
+	for _, tenant := range tenants {
+		if tenant == nil {
+			continue
+		}
+		view.rows = append(view.rows, []string{tenant.ID, tenant.InternalID, valueOrDash(tenant.Name), tenant.Type})
+	}
+	return view
+}
+
+func operationsTable(operations ...*graphql.Operation) *table {
+	view := &table{columns: []string{"ID", "TYPE", "STATUS", "ERROR"}}
+	for _, operation := range operations {
+		if operation == nil {
+			continue
+		}
+		view.rows = append(view.rows, []string{operation.ID, string(operation.OperationType), string(operation.Status), valueOrDash(operation.Error)})
+	}
+	return view
+}
+
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,48 @@
+//go:generate go run github.com/vektah/dataloaden CapabilityLoader ParamCapability *github.com/kyma-incubator/compass/components/director/pkg/graphql.CapabilityPage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyCapability contextKey = "dataloadersCapability"
+
+// CapabilityLoaders holds the dataloader of the ORD capabilities of applications
+type CapabilityLoaders struct {
+	CapabilityByID CapabilityLoader
+}
+
+// ParamCapability is the key of the capabilities dataloader. ID is the ID of the application
+type ParamCapability struct {
+	ID    string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerCapability adds the capabilities dataloader to the context of the request
+func HandlerCapability(fetchFunc func(keys []ParamCapability) ([]*graphql.CapabilityPage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyCapability, &CapabilityLoaders{
+				CapabilityByID: CapabilityLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// CapabilityFor returns the capabilities dataloader from the context
+func CapabilityFor(ctx context.Context) *CapabilityLoaders {
+	return ctx.Value(loadersKeyCapability).(*CapabilityLoaders)
+}
@@ -0,0 +1,48 @@
+//go:generate go run github.com/vektah/dataloaden DataProductLoader ParamDataProduct *github.com/kyma-incubator/compass/components/director/pkg/graphql.DataProductPage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyDataProduct contextKey = "dataloadersDataProduct"
+
+// DataProductLoaders holds the dataloader of the ORD data products of applications
+type DataProductLoaders struct {
+	DataProductByID DataProductLoader
+}
+
+// ParamDataProduct is the key of the data products dataloader. ID is the ID of the application
+type ParamDataProduct struct {
+	ID    string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerDataProduct adds the data products dataloader to the context of the request
+func HandlerDataProduct(fetchFunc func(keys []ParamDataProduct) ([]*graphql.DataProductPage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyDataProduct, &DataProductLoaders{
+				DataProductByID: DataProductLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// DataProductFor returns the data products dataloader from the context
+func DataProductFor(ctx context.Context) *DataProductLoaders {
+	return ctx.Value(loadersKeyDataProduct).(*DataProductLoaders)
+}
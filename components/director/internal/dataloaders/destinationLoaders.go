@@ -0,0 +1,61 @@
+//go:generate go run github.com/vektah/dataloaden DestinationLoader ParamDestination []*github.com/kyma-incubator/compass/components/director/pkg/graphql.Destination
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyBundleDestination contextKey = "dataloadersBundleDestination"
+const loadersKeyApplicationDestination contextKey = "dataloadersApplicationDestination"
+
+// DestinationLoaders is a dataloader for the destinations of a parent entity
+type DestinationLoaders struct {
+	DestinationByParentID DestinationLoader
+}
+
+// ParamDestination are parameters for the destination dataloader, ID is the ID of the parent entity
+type ParamDestination struct {
+	ID  string
+	Ctx context.Context
+}
+
+// HandlerBundleDestination prepares the dataloader for the destinations of bundles
+func HandlerBundleDestination(fetchFunc func(keys []ParamDestination) ([][]*graphql.Destination, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return handlerDestination(loadersKeyBundleDestination, fetchFunc, maxBatch, wait)
+}
+
+// HandlerApplicationDestination prepares the dataloader for the destinations of applications
+func HandlerApplicationDestination(fetchFunc func(keys []ParamDestination) ([][]*graphql.Destination, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return handlerDestination(loadersKeyApplicationDestination, fetchFunc, maxBatch, wait)
+}
+
+func handlerDestination(key contextKey, fetchFunc func(keys []ParamDestination) ([][]*graphql.Destination, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), key, &DestinationLoaders{
+				DestinationByParentID: DestinationLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// BundleDestinationFor retrieves the dataloader for the destinations of bundles from the context
+func BundleDestinationFor(ctx context.Context) *DestinationLoaders {
+	return ctx.Value(loadersKeyBundleDestination).(*DestinationLoaders)
+}
+
+// ApplicationDestinationFor retrieves the dataloader for the destinations of applications from the context
+func ApplicationDestinationFor(ctx context.Context) *DestinationLoaders {
+	return ctx.Value(loadersKeyApplicationDestination).(*DestinationLoaders)
+}
@@ -0,0 +1,48 @@
+//go:generate go run github.com/vektah/dataloaden EntityTypeLoader ParamEntityType *github.com/kyma-incubator/compass/components/director/pkg/graphql.EntityTypePage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyEntityType contextKey = "dataloadersEntityType"
+
+// EntityTypeLoaders holds the dataloader of the ORD entity types of applications
+type EntityTypeLoaders struct {
+	EntityTypeByID EntityTypeLoader
+}
+
+// ParamEntityType is the key of the entity types dataloader. ID is the ID of the application
+type ParamEntityType struct {
+	ID    string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerEntityType adds the entity types dataloader to the context of the request
+func HandlerEntityType(fetchFunc func(keys []ParamEntityType) ([]*graphql.EntityTypePage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyEntityType, &EntityTypeLoaders{
+				EntityTypeByID: EntityTypeLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// EntityTypeFor returns the entity types dataloader from the context
+func EntityTypeFor(ctx context.Context) *EntityTypeLoaders {
+	return ctx.Value(loadersKeyEntityType).(*EntityTypeLoaders)
+}
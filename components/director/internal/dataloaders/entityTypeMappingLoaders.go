@@ -0,0 +1,61 @@
+//go:generate go run github.com/vektah/dataloaden EntityTypeMappingLoader ParamEntityTypeMapping []*github.com/kyma-incubator/compass/components/director/pkg/graphql.EntityTypeMapping
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyAPIEntityTypeMapping contextKey = "dataloadersAPIEntityTypeMapping"
+const loadersKeyEventEntityTypeMapping contextKey = "dataloadersEventEntityTypeMapping"
+
+// EntityTypeMappingLoaders is a dataloader for the entity type mappings of an API or event definition
+type EntityTypeMappingLoaders struct {
+	EntityTypeMappingByParentID EntityTypeMappingLoader
+}
+
+// ParamEntityTypeMapping are parameters for the entity type mapping dataloader, ID is the ID of the API or event definition
+type ParamEntityTypeMapping struct {
+	ID  string
+	Ctx context.Context
+}
+
+// HandlerAPIEntityTypeMapping prepares the dataloader for the entity type mappings of API definitions
+func HandlerAPIEntityTypeMapping(fetchFunc func(keys []ParamEntityTypeMapping) ([][]*graphql.EntityTypeMapping, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return handlerEntityTypeMapping(loadersKeyAPIEntityTypeMapping, fetchFunc, maxBatch, wait)
+}
+
+// HandlerEventEntityTypeMapping prepares the dataloader for the entity type mappings of event definitions
+func HandlerEventEntityTypeMapping(fetchFunc func(keys []ParamEntityTypeMapping) ([][]*graphql.EntityTypeMapping, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return handlerEntityTypeMapping(loadersKeyEventEntityTypeMapping, fetchFunc, maxBatch, wait)
+}
+
+func handlerEntityTypeMapping(key contextKey, fetchFunc func(keys []ParamEntityTypeMapping) ([][]*graphql.EntityTypeMapping, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), key, &EntityTypeMappingLoaders{
+				EntityTypeMappingByParentID: EntityTypeMappingLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// APIEntityTypeMappingFor retrieves the dataloader for the entity type mappings of API definitions from the context
+func APIEntityTypeMappingFor(ctx context.Context) *EntityTypeMappingLoaders {
+	return ctx.Value(loadersKeyAPIEntityTypeMapping).(*EntityTypeMappingLoaders)
+}
+
+// EventEntityTypeMappingFor retrieves the dataloader for the entity type mappings of event definitions from the context
+func EventEntityTypeMappingFor(ctx context.Context) *EntityTypeMappingLoaders {
+	return ctx.Value(loadersKeyEventEntityTypeMapping).(*EntityTypeMappingLoaders)
+}
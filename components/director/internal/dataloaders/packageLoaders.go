@@ -0,0 +1,48 @@
+//go:generate go run github.com/vektah/dataloaden PackageLoader ParamPackage *github.com/kyma-incubator/compass/components/director/pkg/graphql.PackagePage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyPackage contextKey = "dataloadersPackage"
+
+// PackageLoaders holds the dataloader of the ORD packages of applications
+type PackageLoaders struct {
+	PackageByID PackageLoader
+}
+
+// ParamPackage is the key of the packages dataloader. ID is the ID of the application
+type ParamPackage struct {
+	ID    string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerPackage adds the packages dataloader to the context of the request
+func HandlerPackage(fetchFunc func(keys []ParamPackage) ([]*graphql.PackagePage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyPackage, &PackageLoaders{
+				PackageByID: PackageLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// PackageFor returns the packages dataloader from the context
+func PackageFor(ctx context.Context) *PackageLoaders {
+	return ctx.Value(loadersKeyPackage).(*PackageLoaders)
+}
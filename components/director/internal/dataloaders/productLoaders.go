@@ -0,0 +1,48 @@
+//go:generate go run github.com/vektah/dataloaden ProductLoader ParamProduct *github.com/kyma-incubator/compass/components/director/pkg/graphql.ProductPage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyProduct contextKey = "dataloadersProduct"
+
+// ProductLoaders holds the dataloader of the ORD products of applications
+type ProductLoaders struct {
+	ProductByID ProductLoader
+}
+
+// ParamProduct is the key of the products dataloader. ID is the ID of the application
+type ParamProduct struct {
+	ID    string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerProduct adds the products dataloader to the context of the request
+func HandlerProduct(fetchFunc func(keys []ParamProduct) ([]*graphql.ProductPage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyProduct, &ProductLoaders{
+				ProductByID: ProductLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ProductFor returns the products dataloader from the context
+func ProductFor(ctx context.Context) *ProductLoaders {
+	return ctx.Value(loadersKeyProduct).(*ProductLoaders)
+}
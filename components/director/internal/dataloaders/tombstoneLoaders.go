@@ -0,0 +1,48 @@
+//go:generate go run github.com/vektah/dataloaden TombstoneLoader ParamTombstone *github.com/kyma-incubator/compass/components/director/pkg/graphql.TombstonePage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyTombstone contextKey = "dataloadersTombstone"
+
+// TombstoneLoaders holds the dataloader of the ORD tombstones of applications
+type TombstoneLoaders struct {
+	TombstoneByID TombstoneLoader
+}
+
+// ParamTombstone is the key of the tombstones dataloader. ID is the ID of the application
+type ParamTombstone struct {
+	ID    string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerTombstone adds the tombstones dataloader to the context of the request
+func HandlerTombstone(fetchFunc func(keys []ParamTombstone) ([]*graphql.TombstonePage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyTombstone, &TombstoneLoaders{
+				TombstoneByID: TombstoneLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// TombstoneFor returns the tombstones dataloader from the context
+func TombstoneFor(ctx context.Context) *TombstoneLoaders {
+	return ctx.Value(loadersKeyTombstone).(*TombstoneLoaders)
+}
@@ -0,0 +1,48 @@
+//go:generate go run github.com/vektah/dataloaden VendorLoader ParamVendor *github.com/kyma-incubator/compass/components/director/pkg/graphql.VendorPage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyVendor contextKey = "dataloadersVendor"
+
+// VendorLoaders holds the dataloader of the ORD vendors of applications
+type VendorLoaders struct {
+	VendorByID VendorLoader
+}
+
+// ParamVendor is the key of the vendors dataloader. ID is the ID of the application
+type ParamVendor struct {
+	ID    string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerVendor adds the vendors dataloader to the context of the request
+func HandlerVendor(fetchFunc func(keys []ParamVendor) ([]*graphql.VendorPage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyVendor, &VendorLoaders{
+				VendorByID: VendorLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// VendorFor returns the vendors dataloader from the context
+func VendorFor(ctx context.Context) *VendorLoaders {
+	return ctx.Value(loadersKeyVendor).(*VendorLoaders)
+}
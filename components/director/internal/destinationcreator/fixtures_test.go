@@ -2,11 +2,19 @@ package destinationcreator_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"strings"
+	"time"
 
 	destinationcreatorpkg "github.com/kyma-incubator/compass/components/director/pkg/destinationcreator"
 
@@ -14,6 +22,8 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/destinationcreator/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/tenant"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
@@ -57,6 +67,7 @@ const (
 	certificateFileNameValue   = "testCertFileNameValue"
 	certificateCommonNameValue = "testCertCommonNameValue"
 	certificateChainValue      = "testCertChainValue"
+	destinationCertificateID   = "testDestinationCertificateID"
 
 	// Formation Assignment constants
 	testAssignmentID  = "TestAssignmentID"
@@ -79,8 +90,9 @@ const (
 )
 
 var (
-	emptyCtx = context.Background()
-	testErr  = errors.New("Test Error")
+	emptyCtx    = context.Background()
+	testErr     = errors.New("Test Error")
+	notFoundErr = apperrors.NewNotFoundErrorWithType(resource.DestinationCertificate)
 
 	appTemplateID   = "testAppTemplateID"
 	appBaseURL      = "http://app-test-base-url"
@@ -360,3 +372,71 @@ func fixUnusedLabelRepo() *automock.LabelRepository {
 func fixUnusedTenantRepo() *automock.TenantRepository {
 	return &automock.TenantRepository{}
 }
+
+func fixUnusedCertificateRepo() *automock.CertificateRepository {
+	return &automock.CertificateRepository{}
+}
+
+func fixCertificateRepoWithoutRenewedCertificate() *automock.CertificateRepository {
+	certificateRepo := &automock.CertificateRepository{}
+	certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, mock.Anything, mock.Anything, model.DestinationCertificateStateActive).Return(nil, notFoundErr).Maybe()
+	return certificateRepo
+}
+
+func fixCertificateRepoThatStoresCertificate(formationAssignmentID string, authType destinationcreatorpkg.AuthType, selfSigned bool) *automock.CertificateRepository {
+	certificateRepo := &automock.CertificateRepository{}
+	certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, formationAssignmentID, string(authType), model.DestinationCertificateStateActive).Return(nil, notFoundErr).Once()
+	certificateRepo.On("Upsert", emptyCtx, certificateThatHas(formationAssignmentID, authType, selfSigned)).Return(nil).Once()
+	return certificateRepo
+}
+
+func certificateThatHas(formationAssignmentID string, authType destinationcreatorpkg.AuthType, selfSigned bool) interface{} {
+	return mock.MatchedBy(func(certificate *model.DestinationCertificate) bool {
+		return certificate.ID == destinationCertificateID &&
+			certificate.FormationAssignmentID == formationAssignmentID &&
+			certificate.AuthenticationType == string(authType) &&
+			certificate.SubaccountID == destinationExternalSubaccountID &&
+			certificate.SelfSigned == selfSigned &&
+			certificate.State == model.DestinationCertificateStateActive
+	})
+}
+
+func fixDestinationCertificate(name string, generation int) *model.DestinationCertificate {
+	return &model.DestinationCertificate{
+		ID:                    destinationCertificateID,
+		FormationAssignmentID: testAssignmentID,
+		AuthenticationType:    string(destinationcreatorpkg.AuthTypeSAMLAssertion),
+		Name:                  name,
+		SubaccountID:          destinationExternalSubaccountID,
+		InstanceID:            destinationInstanceID,
+		Generation:            generation,
+		State:                 model.DestinationCertificateStateActive,
+	}
+}
+
+func fixUIDService() *automock.UIDService {
+	uidSvc := &automock.UIDService{}
+	uidSvc.On("Generate").Return(destinationCertificateID).Maybe()
+	return uidSvc
+}
+
+// fixPEMCertificateChain returns a self-signed PEM encoded certificate which expires at the given time
+func fixPEMCertificateChain(notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: certificateCommonNameValue},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
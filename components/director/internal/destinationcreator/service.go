@@ -3,18 +3,22 @@ package destinationcreator
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	destinationcreatorpkg "github.com/kyma-incubator/compass/components/director/pkg/destinationcreator"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/client"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/tenant"
@@ -68,6 +72,12 @@ type tenantRepository interface {
 	GetByExternalTenant(ctx context.Context, externalTenant string) (*model.BusinessTenantMapping, error)
 }
 
+//go:generate mockery --exported --name=certificateRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type certificateRepository interface {
+	Upsert(ctx context.Context, certificate *model.DestinationCertificate) error
+	GetByAssignmentIDAndAuthType(ctx context.Context, formationAssignmentID, authenticationType string, state model.DestinationCertificateState) (*model.DestinationCertificate, error)
+}
+
 // UIDService generates UUIDs for new entities
 //
 //go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -84,6 +94,8 @@ type Service struct {
 	runtimeCtxRepository  runtimeCtxRepository
 	labelRepo             labelRepository
 	tenantRepo            tenantRepository
+	certificateRepo       certificateRepository
+	uidSvc                UIDService
 }
 
 // NewService creates a new Service
@@ -95,6 +107,8 @@ func NewService(
 	runtimeCtxRepository runtimeCtxRepository,
 	labelRepo labelRepository,
 	tenantRepository tenantRepository,
+	certificateRepo certificateRepository,
+	uidSvc UIDService,
 ) *Service {
 	return &Service{
 		mtlsHTTPClient:        mtlsHTTPClient,
@@ -104,6 +118,8 @@ func NewService(
 		runtimeCtxRepository:  runtimeCtxRepository,
 		labelRepo:             labelRepo,
 		tenantRepo:            tenantRepository,
+		certificateRepo:       certificateRepo,
+		uidSvc:                uidSvc,
 	}
 }
 
@@ -228,7 +244,7 @@ func (s *Service) CreateSAMLAssertionDestination(ctx context.Context, destinatio
 		return nil, errors.Wrapf(err, "while building destination URL")
 	}
 
-	certName, err := GetDestinationCertificateName(ctx, destinationcreatorpkg.AuthTypeSAMLAssertion, formationAssignment.ID)
+	certName, err := s.GetCertificateName(ctx, destinationcreatorpkg.AuthTypeSAMLAssertion, formationAssignment.ID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting destination certificate name for destination auth type: %s", destinationcreatorpkg.AuthTypeSAMLAssertion)
 	}
@@ -326,7 +342,7 @@ func (s *Service) CreateClientCertificateDestination(ctx context.Context, destin
 		return nil, errors.Wrapf(err, "while building destination URL")
 	}
 
-	certName, err := GetDestinationCertificateName(ctx, destinationcreatorpkg.AuthTypeClientCertificate, formationAssignment.ID)
+	certName, err := s.GetCertificateName(ctx, destinationcreatorpkg.AuthTypeClientCertificate, formationAssignment.ID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting destination certificate name for destination auth type: %s", destinationcreatorpkg.AuthTypeClientCertificate)
 	}
@@ -506,7 +522,7 @@ func (s *Service) CreateOAuth2mTLSDestinations(ctx context.Context, destinationD
 		return nil, errors.Wrapf(err, "while building destination URL")
 	}
 
-	certName, err := GetDestinationCertificateName(ctx, destinationcreatorpkg.AuthTypeOAuth2mTLS, formationAssignment.ID)
+	certName, err := s.GetCertificateName(ctx, destinationcreatorpkg.AuthTypeOAuth2mTLS, formationAssignment.ID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting destination certificate name for destination auth type: %s", destinationcreatorpkg.AuthTypeOAuth2mTLS)
 	}
@@ -599,14 +615,140 @@ func (s *Service) CreateCertificate(ctx context.Context, destinationsDetails []o
 		return nil, err
 	}
 
+	certData, isConflict, err := s.createCertificate(ctx, certName, region, subaccountID, destinationsDetails[0].InstanceID, destinationAuthType, useSelfSignedCert)
+	if err != nil {
+		return nil, err
+	}
+
+	if isConflict {
+		log.C(ctx).Infof("The certificate with name: %q already exists. Will be deleted and created again...", certName)
+		depth++
+		if depth > DepthLimit {
+			return nil, errors.Errorf("Destination creator service retry limit: %d is exceeded", DepthLimit)
+		}
+
+		if err := s.DeleteCertificate(ctx, certName, subaccountID, destinationsDetails[0].InstanceID, formationAssignment, skipSubaccountValidation); err != nil {
+			return nil, errors.Wrapf(err, "while deleting certificate with name: %q and subaccount ID: %q", certName, subaccountID)
+		}
+
+		return s.CreateCertificate(ctx, destinationsDetails, destinationAuthType, formationAssignment, depth, skipSubaccountValidation, useSelfSignedCert)
+	}
+
+	if err := s.deleteReplacedCertificate(ctx, formationAssignment, destinationAuthType, certName); err != nil {
+		return nil, err
+	}
+
+	certificate := &model.DestinationCertificate{
+		ID:                    s.uidSvc.Generate(),
+		FormationAssignmentID: formationAssignment.ID,
+		AuthenticationType:    string(destinationAuthType),
+		Name:                  certName,
+		SubaccountID:          subaccountID,
+		InstanceID:            destinationsDetails[0].InstanceID,
+		SelfSigned:            useSelfSignedCert,
+		State:                 model.DestinationCertificateStateActive,
+		ExpiresAt:             certificateExpiry(ctx, certData.CertificateChain),
+		CreatedAt:             time.Now(),
+	}
+	if err := s.certificateRepo.Upsert(ctx, certificate); err != nil {
+		return nil, errors.Wrapf(err, "while storing certificate with name: %q for assignment with ID: %q", certName, formationAssignment.ID)
+	}
+
+	return certData, nil
+}
+
+// RenewCertificate creates a new certificate in the remote destination service which is going to replace the given one.
+// The new certificate is created with a different name, so that the current one stays valid until the participants confirm the new one.
+// The returned certificate is not persisted.
+func (s *Service) RenewCertificate(ctx context.Context, current *model.DestinationCertificate) (*model.DestinationCertificate, *operators.CertificateData, error) {
+	region, err := s.getRegionLabel(ctx, current.SubaccountID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while getting region label for tenant with ID: %s", current.SubaccountID)
+	}
+
+	authType := destinationcreatorpkg.AuthType(current.AuthenticationType)
+	baseName, err := GetDestinationCertificateName(ctx, authType, current.FormationAssignmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	generation := current.Generation + 1
+	certName := rotatedCertificateName(baseName, generation)
+
+	for depth := uint8(0); ; depth++ {
+		certData, isConflict, err := s.createCertificate(ctx, certName, region, current.SubaccountID, current.InstanceID, authType, current.SelfSigned)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !isConflict {
+			return &model.DestinationCertificate{
+				FormationAssignmentID: current.FormationAssignmentID,
+				AuthenticationType:    current.AuthenticationType,
+				Name:                  certName,
+				SubaccountID:          current.SubaccountID,
+				InstanceID:            current.InstanceID,
+				SelfSigned:            current.SelfSigned,
+				Generation:            generation,
+				ExpiresAt:             certificateExpiry(ctx, certData.CertificateChain),
+			}, certData, nil
+		}
+
+		if depth >= DepthLimit {
+			return nil, nil, errors.Errorf("Destination creator service retry limit: %d is exceeded", DepthLimit)
+		}
+
+		// A certificate with that name can only be a leftover of a rotation which did not complete, so it is safe to replace it
+		log.C(ctx).Infof("The certificate with name: %q already exists. Will be deleted and created again...", certName)
+		if err := s.DeleteCertificate(ctx, certName, current.SubaccountID, current.InstanceID, nil, true); err != nil {
+			return nil, nil, errors.Wrapf(err, "while deleting certificate with name: %q and subaccount ID: %q", certName, current.SubaccountID)
+		}
+	}
+}
+
+// GetCertificateName returns the name of the certificate which the destinations with the given authentication type of the formation assignment should use.
+// That is the name of the active certificate if it was renewed, or the default certificate name otherwise.
+func (s *Service) GetCertificateName(ctx context.Context, destinationAuthentication destinationcreatorpkg.AuthType, formationAssignmentID string) (string, error) {
+	certificate, err := s.certificateRepo.GetByAssignmentIDAndAuthType(ctx, formationAssignmentID, string(destinationAuthentication), model.DestinationCertificateStateActive)
+	if err != nil {
+		if !apperrors.IsNotFoundError(err) {
+			return "", errors.Wrapf(err, "while getting the active %q certificate for assignment with ID: %q", destinationAuthentication, formationAssignmentID)
+		}
+		return GetDestinationCertificateName(ctx, destinationAuthentication, formationAssignmentID)
+	}
+
+	return certificate.Name, nil
+}
+
+// GetCertificateNames returns the names of all certificates with the given authentication type which exist for the formation assignment.
+// That is the name returned by GetCertificateName and the name of the renewed certificate if its rotation is in progress.
+func (s *Service) GetCertificateNames(ctx context.Context, destinationAuthentication destinationcreatorpkg.AuthType, formationAssignmentID string) ([]string, error) {
+	activeName, err := s.GetCertificateName(ctx, destinationAuthentication, formationAssignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := s.certificateRepo.GetByAssignmentIDAndAuthType(ctx, formationAssignmentID, string(destinationAuthentication), model.DestinationCertificateStatePending)
+	if err != nil {
+		if !apperrors.IsNotFoundError(err) {
+			return nil, errors.Wrapf(err, "while getting the pending %q certificate for assignment with ID: %q", destinationAuthentication, formationAssignmentID)
+		}
+		return []string{activeName}, nil
+	}
+
+	return []string{activeName, pending.Name}, nil
+}
+
+// createCertificate creates the certificate in the remote destination service. It reports whether a certificate with the same name already exists instead of creating it.
+func (s *Service) createCertificate(ctx context.Context, certName, region, subaccountID, instanceID string, destinationAuthType destinationcreatorpkg.AuthType, useSelfSignedCert bool) (*operators.CertificateData, bool, error) {
 	strURL, err := buildCertificateURL(ctx, s.config.CertificateAPIConfig, URLParameters{
 		EntityName:   certName,
 		Region:       region,
 		SubaccountID: subaccountID,
-		InstanceID:   destinationsDetails[0].InstanceID,
+		InstanceID:   instanceID,
 	}, false)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while building certificate URL")
+		return nil, false, errors.Wrapf(err, "while building certificate URL")
 	}
 
 	certNameWithFileExtension := certName + destinationcreatorpkg.JavaKeyStoreFileExtension
@@ -616,46 +758,34 @@ func (s *Service) CreateCertificate(ctx context.Context, destinationsDetails []o
 	}
 
 	if err := certReqBody.Validate(); err != nil {
-		return nil, errors.Wrapf(err, "while validating certificate request body")
+		return nil, false, errors.Wrapf(err, "while validating certificate request body")
 	}
 
 	log.C(ctx).Infof("Creating certificate with name: %q for subaccount with ID: %q in the destination service for %q destination", certName, subaccountID, destinationAuthType)
 	respBody, statusCode, err := s.executeCreateRequest(ctx, strURL, certReqBody, certName)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while creating certificate with name: %q for subaccount with ID: %q in the destination service", certName, subaccountID)
+		return nil, false, errors.Wrapf(err, "while creating certificate with name: %q for subaccount with ID: %q in the destination service", certName, subaccountID)
 	}
 
 	if statusCode == http.StatusConflict {
-		log.C(ctx).Infof("The certificate with name: %q already exists. Will be deleted and created again...", certName)
-		depth++
-		if depth > DepthLimit {
-			return nil, errors.Errorf("Destination creator service retry limit: %d is exceeded", DepthLimit)
-		}
-
-		if err := s.DeleteCertificate(ctx, certName, subaccountID, destinationsDetails[0].InstanceID, formationAssignment, skipSubaccountValidation); err != nil {
-			return nil, errors.Wrapf(err, "while deleting certificate with name: %q and subaccount ID: %q", certName, subaccountID)
-		}
-
-		return s.CreateCertificate(ctx, destinationsDetails, destinationAuthType, formationAssignment, depth, skipSubaccountValidation, useSelfSignedCert)
+		return nil, true, nil
 	}
 
 	var certResp CertificateResponse
 	err = json.Unmarshal(respBody, &certResp)
 	if err != nil {
-		return nil, errors.Wrap(err, "while unmarshalling certificate response")
+		return nil, false, errors.Wrap(err, "while unmarshalling certificate response")
 	}
 
 	if err := certResp.Validate(); err != nil {
-		return nil, errors.Wrap(err, "while validation destination certificate data")
+		return nil, false, errors.Wrap(err, "while validation destination certificate data")
 	}
 
-	certData := &operators.CertificateData{
+	return &operators.CertificateData{
 		FileName:         certResp.FileName,
 		CommonName:       certResp.CommonName,
 		CertificateChain: certResp.CertificateChain,
-	}
-
-	return certData, nil
+	}, false, nil
 }
 
 // GetDestinationCertificateName return a certificate name based on the destination authentication type and formation assignment ID.
@@ -1187,6 +1317,58 @@ func (s *Service) executeDeleteRequest(ctx context.Context, url string, entityNa
 	return nil
 }
 
+// deleteReplacedCertificate deletes the previously renewed certificate of the formation assignment, which is replaced by the newly created one with the given name
+func (s *Service) deleteReplacedCertificate(ctx context.Context, formationAssignment *model.FormationAssignment, destinationAuthType destinationcreatorpkg.AuthType, certName string) error {
+	replaced, err := s.certificateRepo.GetByAssignmentIDAndAuthType(ctx, formationAssignment.ID, string(destinationAuthType), model.DestinationCertificateStateActive)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "while getting the active %q certificate for assignment with ID: %q", destinationAuthType, formationAssignment.ID)
+	}
+
+	if replaced.Name == certName {
+		return nil
+	}
+
+	log.C(ctx).Infof("Deleting certificate with name: %q which is replaced by certificate with name: %q", replaced.Name, certName)
+	if err := s.DeleteCertificate(ctx, replaced.Name, replaced.SubaccountID, replaced.InstanceID, formationAssignment, true); err != nil {
+		return errors.Wrapf(err, "while deleting replaced certificate with name: %q", replaced.Name)
+	}
+
+	return nil
+}
+
+// rotatedCertificateName returns the name of the certificate with the given generation. The base name is truncated if needed,
+// so that the suffix is preserved and the name is compliant with the max destination name constraint.
+func rotatedCertificateName(baseName string, generation int) string {
+	suffix := fmt.Sprintf("-%d", generation)
+	if len(baseName)+len(suffix) > destinationcreatorpkg.MaxDestinationNameLength {
+		baseName = baseName[:destinationcreatorpkg.MaxDestinationNameLength-len(suffix)]
+	}
+
+	return baseName + suffix
+}
+
+// certificateExpiry returns the expiry time of the leaf certificate in the PEM encoded certificate chain.
+// Nil is returned if the chain cannot be parsed, in which case the certificate is not renewed automatically.
+func certificateExpiry(ctx context.Context, certificateChain string) *time.Time {
+	block, _ := pem.Decode([]byte(certificateChain))
+	if block == nil {
+		log.C(ctx).Warn("The certificate chain is not PEM encoded. The expiry of the certificate could not be determined")
+		return nil
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		log.C(ctx).WithError(err).Warn("Failed to parse the certificate chain. The expiry of the certificate could not be determined")
+		return nil
+	}
+
+	expiry := cert.NotAfter.UTC()
+	return &expiry
+}
+
 func enrichDestinationAdditionalPropertiesWithCorrelationIDs(
 	destinationCreatorCfg *Config,
 	correlationIDs []string,
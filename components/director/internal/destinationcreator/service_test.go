@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/tidwall/sjson"
 
@@ -333,7 +334,7 @@ func Test_CreateDesignTimeDestinations(t *testing.T) {
 			}
 			defer mock.AssertExpectationsForObjects(t, httpClient, labelRepo, tenantRepo)
 
-			svc := destinationcreator.NewService(httpClient, testCase.config, nil, nil, nil, labelRepo, tenantRepo)
+			svc := destinationcreator.NewService(httpClient, testCase.config, nil, nil, nil, labelRepo, tenantRepo, nil, nil)
 
 			err := svc.CreateDesignTimeDestinations(emptyCtx, testCase.destinationDetails, testCase.formationAssignment, 0, false)
 			if testCase.expectedErrMessage != "" {
@@ -629,7 +630,7 @@ func Test_CreateBasicDestinations(t *testing.T) {
 			}
 			defer mock.AssertExpectationsForObjects(t, httpClient, labelRepo, tenantRepo)
 
-			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo)
+			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo, nil, nil)
 
 			destInfo, err := svc.CreateBasicCredentialDestinations(emptyCtx, testCase.destinationDetails, basicAuthCreds, testCase.formationAssignment, testCase.correlationIDs, 0, false)
 			if testCase.expectedErrMessage != "" {
@@ -973,7 +974,7 @@ func Test_CreateSAMLAssertionDestinations(t *testing.T) {
 			}
 			defer mock.AssertExpectationsForObjects(t, httpClient, appRepo, labelRepo, tenantRepo)
 
-			svc := destinationcreator.NewService(httpClient, destConfig, appRepo, nil, nil, labelRepo, tenantRepo)
+			svc := destinationcreator.NewService(httpClient, destConfig, appRepo, nil, nil, labelRepo, tenantRepo, fixCertificateRepoWithoutRenewedCertificate(), nil)
 
 			destInfo, err := svc.CreateSAMLAssertionDestination(emptyCtx, testCase.destinationDetails, samlAuthCreds, testCase.formationAssignment, testCorrelationIDs, 0, false)
 			if testCase.expectedErrMessage != "" {
@@ -1199,7 +1200,7 @@ func Test_CreateClientCertificateDestination(t *testing.T) {
 			}
 			defer mock.AssertExpectationsForObjects(t, httpClient, labelRepo, tenantRepo)
 
-			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo)
+			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo, fixCertificateRepoWithoutRenewedCertificate(), nil)
 
 			destInfo, err := svc.CreateClientCertificateDestination(emptyCtx, testCase.destinationDetails, clientCertAuthTypeCreds, testCase.formationAssignment, testCorrelationIDs, 0, false)
 			if testCase.expectedErrMessage != "" {
@@ -1378,7 +1379,7 @@ func Test_DeleteDestination(t *testing.T) {
 			}
 			defer mock.AssertExpectationsForObjects(t, httpClient, labelRepo, tenantRepo)
 
-			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo)
+			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo, nil, nil)
 
 			err := svc.DeleteDestination(emptyCtx, testCase.destinationName, testCase.destinationSubaccountID, testCase.destinationInstanceID, testCase.formationAssignment, false)
 			if testCase.expectedErrMessage != "" {
@@ -1694,7 +1695,7 @@ func Test_CreateOauth2ClientCredentialsDestinations(t *testing.T) {
 			}
 			defer mock.AssertExpectationsForObjects(t, httpClient, labelRepo, tenantRepo)
 
-			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo)
+			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo, nil, nil)
 
 			destInfo, err := svc.CreateOAuth2ClientCredentialsDestinations(emptyCtx, testCase.destinationDetails, oauth2ClientCreds, testCase.formationAssignment, testCase.correlationIDs, 0, false)
 			if testCase.expectedErrMessage != "" {
@@ -2012,7 +2013,7 @@ func Test_CreateOauth2mTLSDestinations(t *testing.T) {
 			}
 			defer mock.AssertExpectationsForObjects(t, httpClient, labelRepo, tenantRepo)
 
-			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo)
+			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo, fixCertificateRepoWithoutRenewedCertificate(), nil)
 
 			destInfo, err := svc.CreateOAuth2mTLSDestinations(emptyCtx, testCase.destinationDetails, oauth2mTLSCreds, testCase.formationAssignment, testCase.correlationIDs, 0, false)
 			if testCase.expectedErrMessage != "" {
@@ -2064,6 +2065,7 @@ func Test_CreateCertificate(t *testing.T) {
 		httpClient          func() *automock.HttpClient
 		labelRepoFn         func() *automock.LabelRepository
 		tenantRepoFn        func() *automock.TenantRepository
+		certificateRepoFn   func() *automock.CertificateRepository
 		useSelfSignedCert   bool
 		expectedResult      *operators.CertificateData
 		expectedErrMessage  string
@@ -2088,6 +2090,9 @@ func Test_CreateCertificate(t *testing.T) {
 				tenantRepo.On("GetByExternalTenant", emptyCtx, destinationExternalSubaccountID).Return(subaccTenant, nil).Once()
 				return tenantRepo
 			},
+			certificateRepoFn: func() *automock.CertificateRepository {
+				return fixCertificateRepoThatStoresCertificate(faWithSourceAppAndTargetApp.ID, destinationcreatorpkg.AuthTypeSAMLAssertion, false)
+			},
 			expectedResult: &operators.CertificateData{
 				FileName:         certificateFileNameValue,
 				CommonName:       certificateCommonNameValue,
@@ -2115,6 +2120,9 @@ func Test_CreateCertificate(t *testing.T) {
 				return tenantRepo
 			},
 			useSelfSignedCert: true,
+			certificateRepoFn: func() *automock.CertificateRepository {
+				return fixCertificateRepoThatStoresCertificate(faWithSourceAppAndTargetApp.ID, destinationcreatorpkg.AuthTypeSAMLAssertion, true)
+			},
 			expectedResult: &operators.CertificateData{
 				FileName:         certificateFileNameValue,
 				CommonName:       certificateCommonNameValue,
@@ -2142,6 +2150,9 @@ func Test_CreateCertificate(t *testing.T) {
 				tenantRepo.On("GetByExternalTenant", emptyCtx, destinationExternalSubaccountID).Return(subaccTenant, nil).Once()
 				return tenantRepo
 			},
+			certificateRepoFn: func() *automock.CertificateRepository {
+				return fixCertificateRepoThatStoresCertificate(faWithSourceAppAndTargetApp.ID, destinationcreatorpkg.AuthTypeSAMLAssertion, false)
+			},
 			expectedResult: &operators.CertificateData{
 				FileName:         certificateFileNameValue,
 				CommonName:       certificateCommonNameValue,
@@ -2231,6 +2242,9 @@ func Test_CreateCertificate(t *testing.T) {
 				tenantRepo.On("GetByExternalTenant", emptyCtx, destinationExternalSubaccountID).Return(subaccTenant, nil).Once()
 				return tenantRepo
 			},
+			certificateRepoFn: func() *automock.CertificateRepository {
+				return fixCertificateRepoThatStoresCertificate(faWithSourceAppAndTargetAppAndLongID.ID, destinationcreatorpkg.AuthTypeClientCertificate, false)
+			},
 			expectedResult: &operators.CertificateData{
 				FileName:         certificateFileNameValue,
 				CommonName:       certificateCommonNameValue,
@@ -2368,6 +2382,67 @@ func Test_CreateCertificate(t *testing.T) {
 			},
 			expectedErrMessage: "while validation destination certificate data",
 		},
+		{
+			name:                "Success when a renewed certificate is replaced",
+			destinationsDetails: samlAssertionDestsDetails,
+			destinationAuthType: destinationcreatorpkg.AuthTypeSAMLAssertion,
+			formationAssignment: faWithSourceAppAndTargetApp,
+			httpClient: func() *automock.HttpClient {
+				client := &automock.HttpClient{}
+				client.On("Do", requestThatHasMethod(http.MethodPost)).Return(fixHTTPResponse(http.StatusCreated, string(certRespBytes)), nil).Once()
+				client.On("Do", requestThatHasMethod(http.MethodDelete)).Return(fixHTTPResponse(http.StatusNoContent, ""), nil).Once()
+				return client
+			},
+			labelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("GetByKey", emptyCtx, destinationInternalSubaccountID, model.TenantLabelableObject, destinationExternalSubaccountID, destinationcreator.RegionLabelKey).Return(regionLbl, nil).Twice()
+				return labelRepo
+			},
+			tenantRepoFn: func() *automock.TenantRepository {
+				tenantRepo := &automock.TenantRepository{}
+				tenantRepo.On("GetByExternalTenant", emptyCtx, destinationExternalSubaccountID).Return(subaccTenant, nil).Twice()
+				return tenantRepo
+			},
+			certificateRepoFn: func() *automock.CertificateRepository {
+				certificateRepo := &automock.CertificateRepository{}
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, faWithSourceAppAndTargetApp.ID, string(destinationcreatorpkg.AuthTypeSAMLAssertion), model.DestinationCertificateStateActive).Return(fixDestinationCertificate(string(certName)+"-1", 1), nil).Once()
+				certificateRepo.On("Upsert", emptyCtx, certificateThatHas(faWithSourceAppAndTargetApp.ID, destinationcreatorpkg.AuthTypeSAMLAssertion, false)).Return(nil).Once()
+				return certificateRepo
+			},
+			expectedResult: &operators.CertificateData{
+				FileName:         certificateFileNameValue,
+				CommonName:       certificateCommonNameValue,
+				CertificateChain: certificateChainValue,
+			},
+		},
+		{
+			name:                "Error when storing the certificate fails",
+			destinationsDetails: samlAssertionDestsDetails,
+			destinationAuthType: destinationcreatorpkg.AuthTypeSAMLAssertion,
+			formationAssignment: faWithSourceAppAndTargetApp,
+			httpClient: func() *automock.HttpClient {
+				client := &automock.HttpClient{}
+				client.On("Do", requestThatHasMethod(http.MethodPost)).Return(fixHTTPResponse(http.StatusCreated, string(certRespBytes)), nil).Once()
+				return client
+			},
+			labelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("GetByKey", emptyCtx, destinationInternalSubaccountID, model.TenantLabelableObject, destinationExternalSubaccountID, destinationcreator.RegionLabelKey).Return(regionLbl, nil).Once()
+				return labelRepo
+			},
+			tenantRepoFn: func() *automock.TenantRepository {
+				tenantRepo := &automock.TenantRepository{}
+				tenantRepo.On("GetByExternalTenant", emptyCtx, destinationExternalSubaccountID).Return(subaccTenant, nil).Once()
+				return tenantRepo
+			},
+			certificateRepoFn: func() *automock.CertificateRepository {
+				certificateRepo := &automock.CertificateRepository{}
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, faWithSourceAppAndTargetApp.ID, string(destinationcreatorpkg.AuthTypeSAMLAssertion), model.DestinationCertificateStateActive).Return(nil, notFoundErr).Once()
+				certificateRepo.On("Upsert", emptyCtx, mock.Anything).Return(testErr).Once()
+				return certificateRepo
+			},
+			expectedErrMessage: fmt.Sprintf("while storing certificate with name: %q for assignment with ID: %q", certName, faWithSourceAppAndTargetApp.ID),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2386,9 +2461,14 @@ func Test_CreateCertificate(t *testing.T) {
 			if testCase.tenantRepoFn != nil {
 				tenantRepo = testCase.tenantRepoFn()
 			}
-			defer mock.AssertExpectationsForObjects(t, httpClient, labelRepo, tenantRepo)
 
-			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo)
+			certificateRepo := fixUnusedCertificateRepo()
+			if testCase.certificateRepoFn != nil {
+				certificateRepo = testCase.certificateRepoFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, httpClient, labelRepo, tenantRepo, certificateRepo)
+
+			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo, certificateRepo, fixUIDService())
 
 			result, err := svc.CreateCertificate(emptyCtx, testCase.destinationsDetails, testCase.destinationAuthType, testCase.formationAssignment, 0, false, testCase.useSelfSignedCert)
 			if testCase.expectedErrMessage != "" {
@@ -2404,6 +2484,232 @@ func Test_CreateCertificate(t *testing.T) {
 	}
 }
 
+func Test_RenewCertificate(t *testing.T) {
+	expiresAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	baseCertName := string(destinationcreatorpkg.AuthTypeSAMLAssertion) + "-" + testAssignmentID
+	current := fixDestinationCertificate(baseCertName, 0)
+	current.SelfSigned = true
+
+	certResp := fixCertificateResponse(certificateFileNameValue, certificateCommonNameValue, fixPEMCertificateChain(expiresAt))
+	certRespBytes, err := json.Marshal(certResp)
+	require.NoError(t, err)
+
+	expectedCertificate := &model.DestinationCertificate{
+		FormationAssignmentID: testAssignmentID,
+		AuthenticationType:    string(destinationcreatorpkg.AuthTypeSAMLAssertion),
+		Name:                  baseCertName + "-1",
+		SubaccountID:          destinationExternalSubaccountID,
+		InstanceID:            destinationInstanceID,
+		SelfSigned:            true,
+		Generation:            1,
+		ExpiresAt:             &expiresAt,
+	}
+	expectedCertData := &operators.CertificateData{
+		FileName:         certificateFileNameValue,
+		CommonName:       certificateCommonNameValue,
+		CertificateChain: certResp.CertificateChain,
+	}
+
+	testCases := []struct {
+		name                string
+		httpClient          func() *automock.HttpClient
+		regionCalls         int
+		expectedCertificate *model.DestinationCertificate
+		expectedCertData    *operators.CertificateData
+		expectedErrMessage  string
+	}{
+		{
+			name: "Success",
+			httpClient: func() *automock.HttpClient {
+				client := &automock.HttpClient{}
+				client.On("Do", requestThatHasMethod(http.MethodPost)).Return(fixHTTPResponse(http.StatusCreated, string(certRespBytes)), nil).Once()
+				return client
+			},
+			regionCalls:         1,
+			expectedCertificate: expectedCertificate,
+			expectedCertData:    expectedCertData,
+		},
+		{
+			name: "Success when a certificate with the new name already exists",
+			httpClient: func() *automock.HttpClient {
+				client := &automock.HttpClient{}
+				client.On("Do", requestThatHasMethod(http.MethodPost)).Return(fixHTTPResponse(http.StatusConflict, ""), nil).Once()
+				client.On("Do", requestThatHasMethod(http.MethodDelete)).Return(fixHTTPResponse(http.StatusNoContent, ""), nil).Once()
+				client.On("Do", requestThatHasMethod(http.MethodPost)).Return(fixHTTPResponse(http.StatusCreated, string(certRespBytes)), nil).Once()
+				return client
+			},
+			regionCalls:         2,
+			expectedCertificate: expectedCertificate,
+			expectedCertData:    expectedCertData,
+		},
+		{
+			name: "Error when the retry limit is exceeded",
+			httpClient: func() *automock.HttpClient {
+				client := &automock.HttpClient{}
+				client.On("Do", requestThatHasMethod(http.MethodPost)).Return(fixHTTPResponse(http.StatusConflict, ""), nil).Times(destinationcreator.DepthLimit + 1)
+				client.On("Do", requestThatHasMethod(http.MethodDelete)).Return(fixHTTPResponse(http.StatusNoContent, ""), nil).Times(destinationcreator.DepthLimit)
+				return client
+			},
+			regionCalls:        destinationcreator.DepthLimit + 1,
+			expectedErrMessage: fmt.Sprintf("Destination creator service retry limit: %d is exceeded", destinationcreator.DepthLimit),
+		},
+		{
+			name: "Error when creating the certificate fails",
+			httpClient: func() *automock.HttpClient {
+				client := &automock.HttpClient{}
+				client.On("Do", requestThatHasMethod(http.MethodPost)).Return(nil, testErr).Once()
+				return client
+			},
+			regionCalls:        1,
+			expectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			httpClient := testCase.httpClient()
+
+			labelRepo := &automock.LabelRepository{}
+			labelRepo.On("GetByKey", emptyCtx, destinationInternalSubaccountID, model.TenantLabelableObject, destinationExternalSubaccountID, destinationcreator.RegionLabelKey).Return(regionLbl, nil).Times(testCase.regionCalls)
+
+			tenantRepo := &automock.TenantRepository{}
+			tenantRepo.On("GetByExternalTenant", emptyCtx, destinationExternalSubaccountID).Return(subaccTenant, nil).Times(testCase.regionCalls)
+			defer mock.AssertExpectationsForObjects(t, httpClient, labelRepo, tenantRepo)
+
+			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo, nil, nil)
+
+			certificate, certData, err := svc.RenewCertificate(emptyCtx, current)
+			if testCase.expectedErrMessage != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.expectedErrMessage)
+				require.Nil(t, certificate)
+				require.Nil(t, certData)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedCertificate, certificate)
+				require.Equal(t, testCase.expectedCertData, certData)
+			}
+		})
+	}
+}
+
+func Test_GetCertificateName(t *testing.T) {
+	defaultCertName := string(destinationcreatorpkg.AuthTypeClientCertificate) + "-" + testAssignmentID
+
+	testCases := []struct {
+		name               string
+		certificateRepoFn  func() *automock.CertificateRepository
+		expectedName       string
+		expectedErrMessage string
+	}{
+		{
+			name: "Success when the certificate was renewed",
+			certificateRepoFn: func() *automock.CertificateRepository {
+				certificateRepo := &automock.CertificateRepository{}
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, testAssignmentID, string(destinationcreatorpkg.AuthTypeClientCertificate), model.DestinationCertificateStateActive).Return(fixDestinationCertificate(defaultCertName+"-2", 2), nil).Once()
+				return certificateRepo
+			},
+			expectedName: defaultCertName + "-2",
+		},
+		{
+			name: "Success when there is no tracked certificate",
+			certificateRepoFn: func() *automock.CertificateRepository {
+				certificateRepo := &automock.CertificateRepository{}
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, testAssignmentID, string(destinationcreatorpkg.AuthTypeClientCertificate), model.DestinationCertificateStateActive).Return(nil, notFoundErr).Once()
+				return certificateRepo
+			},
+			expectedName: defaultCertName,
+		},
+		{
+			name: "Error when getting the active certificate fails",
+			certificateRepoFn: func() *automock.CertificateRepository {
+				certificateRepo := &automock.CertificateRepository{}
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, testAssignmentID, string(destinationcreatorpkg.AuthTypeClientCertificate), model.DestinationCertificateStateActive).Return(nil, testErr).Once()
+				return certificateRepo
+			},
+			expectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			certificateRepo := testCase.certificateRepoFn()
+			defer mock.AssertExpectationsForObjects(t, certificateRepo)
+
+			svc := destinationcreator.NewService(nil, nil, nil, nil, nil, nil, nil, certificateRepo, nil)
+
+			name, err := svc.GetCertificateName(emptyCtx, destinationcreatorpkg.AuthTypeClientCertificate, testAssignmentID)
+			if testCase.expectedErrMessage != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.expectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedName, name)
+			}
+		})
+	}
+}
+
+func Test_GetCertificateNames(t *testing.T) {
+	defaultCertName := string(destinationcreatorpkg.AuthTypeClientCertificate) + "-" + testAssignmentID
+
+	testCases := []struct {
+		name               string
+		certificateRepoFn  func() *automock.CertificateRepository
+		expectedNames      []string
+		expectedErrMessage string
+	}{
+		{
+			name: "Success when the rotation of the certificate is in progress",
+			certificateRepoFn: func() *automock.CertificateRepository {
+				certificateRepo := &automock.CertificateRepository{}
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, testAssignmentID, string(destinationcreatorpkg.AuthTypeClientCertificate), model.DestinationCertificateStateActive).Return(fixDestinationCertificate(defaultCertName+"-1", 1), nil).Once()
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, testAssignmentID, string(destinationcreatorpkg.AuthTypeClientCertificate), model.DestinationCertificateStatePending).Return(fixDestinationCertificate(defaultCertName+"-2", 2), nil).Once()
+				return certificateRepo
+			},
+			expectedNames: []string{defaultCertName + "-1", defaultCertName + "-2"},
+		},
+		{
+			name: "Success when there is no pending certificate",
+			certificateRepoFn: func() *automock.CertificateRepository {
+				certificateRepo := &automock.CertificateRepository{}
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, testAssignmentID, string(destinationcreatorpkg.AuthTypeClientCertificate), model.DestinationCertificateStateActive).Return(nil, notFoundErr).Once()
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, testAssignmentID, string(destinationcreatorpkg.AuthTypeClientCertificate), model.DestinationCertificateStatePending).Return(nil, notFoundErr).Once()
+				return certificateRepo
+			},
+			expectedNames: []string{defaultCertName},
+		},
+		{
+			name: "Error when getting the pending certificate fails",
+			certificateRepoFn: func() *automock.CertificateRepository {
+				certificateRepo := &automock.CertificateRepository{}
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, testAssignmentID, string(destinationcreatorpkg.AuthTypeClientCertificate), model.DestinationCertificateStateActive).Return(nil, notFoundErr).Once()
+				certificateRepo.On("GetByAssignmentIDAndAuthType", emptyCtx, testAssignmentID, string(destinationcreatorpkg.AuthTypeClientCertificate), model.DestinationCertificateStatePending).Return(nil, testErr).Once()
+				return certificateRepo
+			},
+			expectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			certificateRepo := testCase.certificateRepoFn()
+			defer mock.AssertExpectationsForObjects(t, certificateRepo)
+
+			svc := destinationcreator.NewService(nil, nil, nil, nil, nil, nil, nil, certificateRepo, nil)
+
+			names, err := svc.GetCertificateNames(emptyCtx, destinationcreatorpkg.AuthTypeClientCertificate, testAssignmentID)
+			if testCase.expectedErrMessage != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.expectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedNames, names)
+			}
+		})
+	}
+}
+
 func Test_DeleteCertificate(t *testing.T) {
 	testCases := []struct {
 		name                    string
@@ -2544,7 +2850,7 @@ func Test_DeleteCertificate(t *testing.T) {
 			}
 			defer mock.AssertExpectationsForObjects(t, httpClient, labelRepo, tenantRepo)
 
-			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo)
+			svc := destinationcreator.NewService(httpClient, destConfig, nil, nil, nil, labelRepo, tenantRepo, nil, nil)
 
 			err := svc.DeleteCertificate(emptyCtx, testCase.certificateName, testCase.destinationSubaccountID, testCase.destinationInstanceID, testCase.formationAssignment, false)
 			if testCase.expectedErrMessage != "" {
@@ -2583,7 +2889,7 @@ func Test_EnrichAssignmentConfigWithCertificateData(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			svc := destinationcreator.NewService(nil, testCase.destinationConfig, nil, nil, nil, nil, nil)
+			svc := destinationcreator.NewService(nil, testCase.destinationConfig, nil, nil, nil, nil, nil, nil, nil)
 
 			result, err := svc.EnrichAssignmentConfigWithCertificateData(testCase.assignmentConfig, destinationcreatorpkg.ClientCertAuthDestPath, testCase.certData)
 			if testCase.expectedErrMessage != "" {
@@ -2625,7 +2931,7 @@ func Test_EnrichAssignmentConfigWithSAMLCertificateData(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			svc := destinationcreator.NewService(nil, testCase.destinationConfig, nil, nil, nil, nil, nil)
+			svc := destinationcreator.NewService(nil, testCase.destinationConfig, nil, nil, nil, nil, nil, nil, nil)
 
 			result, err := svc.EnrichAssignmentConfigWithSAMLCertificateData(testCase.assignmentConfig, destinationcreatorpkg.SAMLAssertionDestPath, testCase.certData)
 			if testCase.expectedErrMessage != "" {
@@ -2976,7 +3282,7 @@ func Test_DetermineDestinationSubaccount(t *testing.T) {
 
 			defer mock.AssertExpectationsForObjects(t, appRepo, runtimeRepo, runtimeCtxRepo, labelRepo, tenantRepo)
 
-			svc := destinationcreator.NewService(nil, nil, appRepo, runtimeRepo, runtimeCtxRepo, labelRepo, tenantRepo)
+			svc := destinationcreator.NewService(nil, nil, appRepo, runtimeRepo, runtimeCtxRepo, labelRepo, tenantRepo, nil, nil)
 
 			result, err := svc.DetermineDestinationSubaccount(emptyCtx, testCase.externalDestSubaccountID, testCase.formationAssignment, testCase.skipValidation)
 			if testCase.expectedErrMessage != "" {
@@ -3074,7 +3380,7 @@ func Test_PrepareBasicRequestBody(t *testing.T) {
 				appRepo = testCase.appRepo()
 			}
 
-			svc := destinationcreator.NewService(nil, destConfig, appRepo, nil, nil, nil, nil)
+			svc := destinationcreator.NewService(nil, destConfig, appRepo, nil, nil, nil, nil, nil, nil)
 
 			basicReqBody, err := svc.PrepareBasicRequestBody(emptyCtx, testCase.destinationDetails, testCase.basicAuthCreds, testCase.formationAssignment, testCorrelationIDs)
 			if testCase.expectedErrMessage != "" {
@@ -3151,7 +3457,7 @@ func Test_GetConsumerTenant(t *testing.T) {
 			}
 			defer mock.AssertExpectationsForObjects(t, labelRepo)
 
-			svc := destinationcreator.NewService(nil, nil, nil, nil, nil, labelRepo, nil)
+			svc := destinationcreator.NewService(nil, nil, nil, nil, nil, labelRepo, nil, nil, nil)
 
 			result, err := svc.GetConsumerTenant(emptyCtx, testCase.formationAssignment)
 			if testCase.expectedErrMessage != "" {
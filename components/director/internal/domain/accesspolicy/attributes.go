@@ -0,0 +1,189 @@
+package accesspolicy
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ApplicationResourceType is the resource type of applications in the access policies and the @hasAccess directive
+	ApplicationResourceType = "application"
+	// RuntimeResourceType is the resource type of runtimes in the access policies and the @hasAccess directive
+	RuntimeResourceType = "runtime"
+	// FormationResourceType is the resource type of formations in the access policies and the @hasAccess directive
+	FormationResourceType = "formation"
+)
+
+// supportedResourceTypes are the resource types which the access policies can be enforced on
+var supportedResourceTypes = map[string]bool{
+	ApplicationResourceType: true,
+	RuntimeResourceType:     true,
+	FormationResourceType:   true,
+}
+
+const (
+	// NameAttribute is the name of a formation
+	NameAttribute = "name"
+	// FormationTemplateIDAttribute is the ID of the template of a formation
+	FormationTemplateIDAttribute = "formationTemplateID"
+	// FormationTemplateNameAttribute is the name of the template of a formation
+	FormationTemplateNameAttribute = "formationTemplateName"
+)
+
+// ResourceRef references a resource by ID or, for resources which are referenced by name in the API, by name.
+// TemplateName is the template of a formation which is not created yet.
+type ResourceRef struct {
+	ID           string
+	Name         string
+	TemplateName string
+	// ResourceType and Operation override the ones of the directive for resources which are resolved from the arguments,
+	// e.g. the runtime which owns a webhook or the source application of a merge
+	ResourceType string
+	Operation    string
+	// New marks resources which do not exist yet. They are evaluated only against the labels they are created with.
+	New bool
+	// Labels are the labels the operation sets on the resource and RemovedLabels are the keys of the labels it deletes.
+	// If ReplaceLabels is set, the labels of the resource are replaced with Labels.
+	Labels        map[string]interface{}
+	RemovedLabels []string
+	ReplaceLabels bool
+}
+
+// changesLabels returns true if the operation sets, deletes or replaces labels of the resource
+func (r ResourceRef) changesLabels() bool {
+	return len(r.Labels) > 0 || len(r.RemovedLabels) > 0 || r.ReplaceLabels
+}
+
+// resultingAttributes returns the attributes of the resource after the operation changed its labels
+func (r ResourceRef) resultingAttributes(current map[string]interface{}) map[string]interface{} {
+	attributes := make(map[string]interface{}, len(current)+len(r.Labels))
+	if !r.New && !r.ReplaceLabels {
+		for key, value := range current {
+			attributes[key] = value
+		}
+		for _, key := range r.RemovedLabels {
+			delete(attributes, key)
+		}
+	}
+	for key, value := range r.Labels {
+		attributes[key] = value
+	}
+	return attributes
+}
+
+// AttributesProvider provides the attributes of resources of a given type
+//
+//go:generate mockery --name=AttributesProvider --output=automock --outpkg=automock --case=underscore --disable-version-string
+type AttributesProvider interface {
+	GetAttributes(ctx context.Context, tenantID string, ref ResourceRef) (map[string]interface{}, error)
+}
+
+// LabelRepository is responsible for the repo-layer label operations
+//
+//go:generate mockery --name=LabelRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type LabelRepository interface {
+	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+}
+
+// FormationRepository is responsible for the repo-layer formation operations
+//
+//go:generate mockery --name=FormationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationRepository interface {
+	Get(ctx context.Context, id, tenantID string) (*model.Formation, error)
+	GetByName(ctx context.Context, name, tenantID string) (*model.Formation, error)
+}
+
+// FormationTemplateRepository is responsible for the repo-layer formation template operations
+//
+//go:generate mockery --name=FormationTemplateRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationTemplateRepository interface {
+	Get(ctx context.Context, id string) (*model.FormationTemplate, error)
+}
+
+type labelsAttributesProvider struct {
+	labelRepo  LabelRepository
+	objectType model.LabelableObject
+}
+
+// NewLabelsAttributesProvider returns an AttributesProvider which provides the labels of labelable objects of the given type
+func NewLabelsAttributesProvider(labelRepo LabelRepository, objectType model.LabelableObject) *labelsAttributesProvider {
+	return &labelsAttributesProvider{
+		labelRepo:  labelRepo,
+		objectType: objectType,
+	}
+}
+
+// GetAttributes returns the labels of the object by key
+func (p *labelsAttributesProvider) GetAttributes(ctx context.Context, tenantID string, ref ResourceRef) (map[string]interface{}, error) {
+	labels, err := p.labelRepo.ListForObject(ctx, tenantID, p.objectType, ref.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing labels of %s with ID %s", p.objectType, ref.ID)
+	}
+
+	attributes := make(map[string]interface{}, len(labels))
+	for key, label := range labels {
+		if label != nil {
+			attributes[key] = label.Value
+		}
+	}
+
+	return attributes, nil
+}
+
+type formationAttributesProvider struct {
+	formationRepo         FormationRepository
+	formationTemplateRepo FormationTemplateRepository
+}
+
+// NewFormationAttributesProvider returns an AttributesProvider which provides the name and the template of formations
+func NewFormationAttributesProvider(formationRepo FormationRepository, formationTemplateRepo FormationTemplateRepository) *formationAttributesProvider {
+	return &formationAttributesProvider{
+		formationRepo:         formationRepo,
+		formationTemplateRepo: formationTemplateRepo,
+	}
+}
+
+// GetAttributes returns the name, the template ID and the template name of the formation. If a formation referenced by
+// name does not exist yet, the template name from the reference is returned instead.
+func (p *formationAttributesProvider) GetAttributes(ctx context.Context, tenantID string, ref ResourceRef) (map[string]interface{}, error) {
+	var formation *model.Formation
+	var err error
+	if len(ref.ID) > 0 {
+		formation, err = p.formationRepo.Get(ctx, ref.ID, tenantID)
+	} else {
+		formation, err = p.formationRepo.GetByName(ctx, ref.Name, tenantID)
+		if apperrors.IsNotFoundError(err) {
+			attributes := map[string]interface{}{NameAttribute: ref.Name}
+			if len(ref.TemplateName) > 0 {
+				attributes[FormationTemplateNameAttribute] = ref.TemplateName
+			}
+			return attributes, nil
+		}
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting formation")
+	}
+
+	formationTemplate, err := p.formationTemplateRepo.Get(ctx, formation.FormationTemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation template with ID %s", formation.FormationTemplateID)
+	}
+
+	return map[string]interface{}{
+		NameAttribute:                  formation.Name,
+		FormationTemplateIDAttribute:   formation.FormationTemplateID,
+		FormationTemplateNameAttribute: formationTemplate.Name,
+	}, nil
+}
+
+// NewAttributesProviders returns the attributes providers of the resource types supported by the access policies
+func NewAttributesProviders(labelRepo LabelRepository, formationRepo FormationRepository, formationTemplateRepo FormationTemplateRepository) map[string]AttributesProvider {
+	return map[string]AttributesProvider{
+		ApplicationResourceType: NewLabelsAttributesProvider(labelRepo, model.ApplicationLabelableObject),
+		RuntimeResourceType:     NewLabelsAttributesProvider(labelRepo, model.RuntimeLabelableObject),
+		FormationResourceType:   NewFormationAttributesProvider(formationRepo, formationTemplateRepo),
+	}
+}
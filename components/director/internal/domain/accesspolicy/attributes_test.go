@@ -0,0 +1,167 @@
+package accesspolicy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelsAttributesProvider_GetAttributes(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("returns the labels of the object", func(t *testing.T) {
+		// GIVEN
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObject", ctx, tenantID, model.ApplicationLabelableObject, appID).Return(map[string]*model.Label{
+			applicationType: {Key: applicationType, Value: s4Type},
+			"scenarios":     {Key: "scenarios", Value: []interface{}{"DEFAULT"}},
+		}, nil).Once()
+		defer labelRepo.AssertExpectations(t)
+
+		// WHEN
+		attributes, err := accesspolicy.NewLabelsAttributesProvider(labelRepo, model.ApplicationLabelableObject).GetAttributes(ctx, tenantID, accesspolicy.ResourceRef{ID: appID})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{applicationType: s4Type, "scenarios": []interface{}{"DEFAULT"}}, attributes)
+	})
+
+	t.Run("returns error when listing labels fails", func(t *testing.T) {
+		// GIVEN
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObject", ctx, tenantID, model.RuntimeLabelableObject, appID).Return(nil, testErr).Once()
+		defer labelRepo.AssertExpectations(t)
+
+		// WHEN
+		_, err := accesspolicy.NewLabelsAttributesProvider(labelRepo, model.RuntimeLabelableObject).GetAttributes(ctx, tenantID, accesspolicy.ResourceRef{ID: appID})
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestFormationAttributesProvider_GetAttributes(t *testing.T) {
+	ctx := context.TODO()
+	formation := &model.Formation{ID: formationID, Name: formationName, FormationTemplateID: formationTemplateID}
+	formationTemplate := &model.FormationTemplate{ID: formationTemplateID, Name: templateName}
+	expectedAttributes := map[string]interface{}{
+		accesspolicy.NameAttribute:                  formationName,
+		accesspolicy.FormationTemplateIDAttribute:   formationTemplateID,
+		accesspolicy.FormationTemplateNameAttribute: templateName,
+	}
+
+	testCases := []struct {
+		Name                    string
+		Ref                     accesspolicy.ResourceRef
+		FormationRepoFn         func() *automock.FormationRepository
+		FormationTemplateRepoFn func() *automock.FormationTemplateRepository
+		ExpectedAttributes      map[string]interface{}
+		ExpectedErrMsg          string
+	}{
+		{
+			Name: "returns the attributes of the formation referenced by ID",
+			Ref:  accesspolicy.ResourceRef{ID: formationID},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("Get", ctx, formationID, tenantID).Return(formation, nil).Once()
+				return repo
+			},
+			FormationTemplateRepoFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, formationTemplateID).Return(formationTemplate, nil).Once()
+				return repo
+			},
+			ExpectedAttributes: expectedAttributes,
+		},
+		{
+			Name: "returns the attributes of the formation referenced by name",
+			Ref:  accesspolicy.ResourceRef{Name: formationName, TemplateName: "ignored"},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetByName", ctx, formationName, tenantID).Return(formation, nil).Once()
+				return repo
+			},
+			FormationTemplateRepoFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, formationTemplateID).Return(formationTemplate, nil).Once()
+				return repo
+			},
+			ExpectedAttributes: expectedAttributes,
+		},
+		{
+			Name: "returns the name and the template name from the reference when the formation does not exist yet",
+			Ref:  accesspolicy.ResourceRef{Name: formationName, TemplateName: templateName},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetByName", ctx, formationName, tenantID).Return(nil, apperrors.NewNotFoundError(resource.Formations, formationName)).Once()
+				return repo
+			},
+			FormationTemplateRepoFn: func() *automock.FormationTemplateRepository {
+				return &automock.FormationTemplateRepository{}
+			},
+			ExpectedAttributes: map[string]interface{}{
+				accesspolicy.NameAttribute:                  formationName,
+				accesspolicy.FormationTemplateNameAttribute: templateName,
+			},
+		},
+		{
+			Name: "returns error when getting the formation fails",
+			Ref:  accesspolicy.ResourceRef{ID: formationID},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("Get", ctx, formationID, tenantID).Return(nil, testErr).Once()
+				return repo
+			},
+			FormationTemplateRepoFn: func() *automock.FormationTemplateRepository {
+				return &automock.FormationTemplateRepository{}
+			},
+			ExpectedErrMsg: "while getting formation",
+		},
+		{
+			Name: "returns error when getting the formation template fails",
+			Ref:  accesspolicy.ResourceRef{ID: formationID},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("Get", ctx, formationID, tenantID).Return(formation, nil).Once()
+				return repo
+			},
+			FormationTemplateRepoFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, formationTemplateID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMsg: "while getting formation template",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			formationRepo := testCase.FormationRepoFn()
+			formationTemplateRepo := testCase.FormationTemplateRepoFn()
+			defer mock.AssertExpectationsForObjects(t, formationRepo, formationTemplateRepo)
+
+			// WHEN
+			attributes, err := accesspolicy.NewFormationAttributesProvider(formationRepo, formationTemplateRepo).GetAttributes(ctx, tenantID, testCase.Ref)
+
+			// THEN
+			if testCase.ExpectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+				assert.Nil(t, attributes)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedAttributes, attributes)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package accesspolicy
+
+import (
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+// NewConverter returns a new access policy converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToGraphQL converts the access policy decision to its graphql explanation
+func (c *converter) ToGraphQL(in *Decision) *graphql.AccessExplanation {
+	if in == nil {
+		return nil
+	}
+
+	policies := make([]*graphql.AccessPolicyEvaluation, 0, len(in.Evaluations))
+	for _, evaluation := range in.Evaluations {
+		policies = append(policies, &graphql.AccessPolicyEvaluation{
+			Policy:          evaluation.Policy,
+			Effect:          graphql.AccessPolicyEffect(strings.ToUpper(string(evaluation.Effect))),
+			Applicable:      evaluation.Applicable,
+			SelectorMatched: evaluation.SelectorMatched,
+			Reason:          evaluation.Reason,
+		})
+	}
+
+	return &graphql.AccessExplanation{
+		Allowed:  in.Allowed,
+		Reason:   in.Reason,
+		Policies: policies,
+	}
+}
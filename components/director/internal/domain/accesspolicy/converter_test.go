@@ -0,0 +1,52 @@
+package accesspolicy_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy"
+	"github.com/kyma-incubator/compass/components/director/pkg/config"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// GIVEN
+		decision := &accesspolicy.Decision{
+			Allowed: false,
+			Reason:  "denied by access policy deny-formations-of-template",
+			Evaluations: []accesspolicy.Evaluation{
+				{
+					Policy:          denyTemplatePolicy.Name,
+					Effect:          config.AccessPolicyEffectDeny,
+					Applicable:      true,
+					SelectorMatched: true,
+					Reason:          "matched",
+				},
+			},
+		}
+		expected := &graphql.AccessExplanation{
+			Allowed: false,
+			Reason:  "denied by access policy deny-formations-of-template",
+			Policies: []*graphql.AccessPolicyEvaluation{
+				{
+					Policy:          denyTemplatePolicy.Name,
+					Effect:          graphql.AccessPolicyEffectDeny,
+					Applicable:      true,
+					SelectorMatched: true,
+					Reason:          "matched",
+				},
+			},
+		}
+
+		// WHEN
+		result := accesspolicy.NewConverter().ToGraphQL(decision)
+
+		// THEN
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("returns nil for nil decision", func(t *testing.T) {
+		assert.Nil(t, accesspolicy.NewConverter().ToGraphQL(nil))
+	})
+}
@@ -0,0 +1,162 @@
+package accesspolicy
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	gqlmodel "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// PolicyService evaluates requests against the access policies
+//
+//go:generate mockery --name=PolicyService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type PolicyService interface {
+	IsRestricted(req Request) bool
+	Evaluate(ctx context.Context, req Request, ref ResourceRef) (*Decision, error)
+}
+
+type directive struct {
+	transact  persistence.Transactioner
+	svc       PolicyService
+	resolvers map[string]ResourceResolver
+}
+
+// NewDirective returns a new access policy directive which resolves the resources of the mutations with the given resource resolvers
+func NewDirective(transact persistence.Transactioner, svc PolicyService, resolvers map[string]ResourceResolver) *directive {
+	return &directive{
+		transact:  transact,
+		svc:       svc,
+		resolvers: resolvers,
+	}
+}
+
+// HasAccess ensures that the access policies allow the consumer to perform the operation on the resources of the mutation.
+// The resources are referenced by the idField argument or, if a resource resolver is given, resolved by it from the arguments.
+// The resourceType argument has to be one of the resource types supported by the access policies - "application", "runtime" or "formation".
+// If no access policy applies to the request, the request is forwarded to the next resolver.
+func (d *directive) HasAccess(ctx context.Context, _ interface{}, next graphql.Resolver, resourceType string, operation string, idField string, resourceResolver *string) (interface{}, error) {
+	if !supportedResourceTypes[resourceType] {
+		return nil, errors.Errorf("unsupported resource type %q", resourceType)
+	}
+
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantCtx, err := tenant.LoadTenantPairFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	fieldCtx := graphql.GetFieldContext(ctx)
+	if fieldCtx == nil {
+		return nil, errors.New("could not get field context from request context")
+	}
+
+	resolver := ResourceResolver{Resolve: resourceRefsFromArgs}
+	if resourceResolver != nil {
+		var ok bool
+		if resolver, ok = d.resolvers[*resourceResolver]; !ok {
+			return nil, errors.Errorf("unknown resource resolver %q", *resourceResolver)
+		}
+	}
+
+	req := Request{
+		ConsumerID:       consumerInfo.ConsumerID,
+		ConsumerType:     string(consumerInfo.Type),
+		TenantID:         tenantCtx.InternalID,
+		ExternalTenantID: tenantCtx.ExternalID,
+		ResourceType:     resourceType,
+		Operation:        operation,
+	}
+	if !d.isRestricted(req, resolver) {
+		return next(ctx)
+	}
+
+	tx, err := d.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while opening the db transaction: %v", err)
+		return nil, err
+	}
+	defer d.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctxWithTx := persistence.SaveToContext(ctx, tx)
+	refs, err := resolver.Resolve(ctxWithTx, req.TenantID, fieldCtx.Args, idField)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range refs {
+		refReq := req
+		refReq.ResourceID = ref.ID
+		if len(ref.ResourceType) > 0 {
+			refReq.ResourceType = ref.ResourceType
+		}
+		if len(ref.Operation) > 0 {
+			refReq.Operation = ref.Operation
+		}
+
+		decision, err := d.svc.Evaluate(ctxWithTx, refReq, ref)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while evaluating access policies for %s %s", refReq.ResourceType, refReq.Operation)
+		}
+
+		if !decision.Allowed {
+			log.C(ctx).Infof("Consumer %s of type %s is not allowed to %s %s: %s", req.ConsumerID, req.ConsumerType, refReq.Operation, refReq.ResourceType, decision.Reason)
+			return nil, apperrors.NewUnauthorizedError(decision.Reason)
+		}
+		log.C(ctx).Debugf("Consumer %s of type %s is allowed to %s %s: %s", req.ConsumerID, req.ConsumerType, refReq.Operation, refReq.ResourceType, decision.Reason)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while committing transaction: %v", err)
+		return nil, err
+	}
+
+	return next(ctx)
+}
+
+// isRestricted returns true if any access policy applies to the resource types and operations of the directive and the resource resolver
+func (d *directive) isRestricted(req Request, resolver ResourceResolver) bool {
+	resourceTypes := append([]string{req.ResourceType}, resolver.ResourceTypes...)
+	operations := append([]string{req.Operation}, resolver.Operations...)
+	for _, resourceType := range resourceTypes {
+		for _, operation := range operations {
+			candidate := req
+			candidate.ResourceType, candidate.Operation = resourceType, operation
+			if d.svc.IsRestricted(candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resourceRefsFromArgs references the resources by the idField argument, which is an ID, a list of IDs or a formation input
+func resourceRefsFromArgs(_ context.Context, _ string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	switch arg := args[idField].(type) {
+	case string:
+		return []ResourceRef{{ID: arg}}, nil
+	case []string:
+		refs := make([]ResourceRef, 0, len(arg))
+		for _, id := range arg {
+			refs = append(refs, ResourceRef{ID: id})
+		}
+		return refs, nil
+	case gqlmodel.FormationInput:
+		ref := ResourceRef{Name: arg.Name}
+		if arg.TemplateName != nil {
+			ref.TemplateName = *arg.TemplateName
+		}
+		return []ResourceRef{ref}, nil
+	default:
+		return nil, errors.Errorf("could not get idField: %s from request context", idField)
+	}
+}
@@ -0,0 +1,312 @@
+package accesspolicy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	gqlmodel "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const nextOutput = "nextOutput"
+
+func TestDirective_HasAccess(t *testing.T) {
+	restrictedAppReq := fixRequest(intSystemID, "application", "update", nil)
+	updateAppReq := restrictedAppReq
+	updateAppReq.ResourceID = appID
+	appRef := accesspolicy.ResourceRef{ID: appID}
+	resolvers := map[string]accesspolicy.ResourceResolver{
+		"WebhookOwner": {
+			ResourceTypes: []string{"runtime"},
+			Resolve: func(_ context.Context, tenant string, args map[string]interface{}, idField string) ([]accesspolicy.ResourceRef, error) {
+				if args[idField] != webhookID || tenant != tenantID {
+					return nil, testErr
+				}
+				return []accesspolicy.ResourceRef{{ResourceType: "runtime", ID: runtimeID}}, nil
+			},
+		},
+		"None": {
+			Resolve: func(context.Context, string, map[string]interface{}, string) ([]accesspolicy.ResourceRef, error) {
+				return nil, nil
+			},
+		},
+	}
+	updateRuntimeReq := fixRequest(intSystemID, "runtime", "update", nil)
+	updateRuntimeReq.ResourceID = runtimeID
+
+	testCases := []struct {
+		Name             string
+		Ctx              context.Context
+		ResourceType     string
+		Operation        string
+		IDField          string
+		ResourceResolver *string
+		Args             map[string]interface{}
+		TransactionFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn        func() *automock.PolicyService
+		ExpectedErrMsg   string
+	}{
+		{
+			Name:         "forwards the request to the next resolver when no policy applies to it",
+			Ctx:          fixContext(intSystemID),
+			ResourceType: "application",
+			Operation:    "update",
+			IDField:      "id",
+			Args:         map[string]interface{}{"id": appID},
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(false).Once()
+				return svc
+			},
+		},
+		{
+			Name:          "forwards the request to the next resolver when the policies allow it",
+			Ctx:           fixContext(intSystemID),
+			ResourceType:  "application",
+			Operation:     "update",
+			IDField:       "id",
+			Args:          map[string]interface{}{"id": appID},
+			TransactionFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(true).Once()
+				svc.On("Evaluate", txtest.CtxWithDBMatcher(), updateAppReq, appRef).Return(&accesspolicy.Decision{Allowed: true}, nil).Once()
+				return svc
+			},
+		},
+		{
+			Name:          "references formations by the name and the template name of the formation input",
+			Ctx:           fixContext(intSystemID),
+			ResourceType:  "formation",
+			Operation:     "create",
+			IDField:       "formation",
+			Args:          map[string]interface{}{"formation": gqlmodel.FormationInput{Name: formationName, TemplateName: str.Ptr(templateName)}},
+			TransactionFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				req := fixRequest(intSystemID, "formation", "create", nil)
+				svc.On("IsRestricted", req).Return(true).Once()
+				svc.On("Evaluate", txtest.CtxWithDBMatcher(), req, accesspolicy.ResourceRef{Name: formationName, TemplateName: templateName}).Return(&accesspolicy.Decision{Allowed: true}, nil).Once()
+				return svc
+			},
+		},
+		{
+			Name:          "returns unauthorized error when the policies deny the request",
+			Ctx:           fixContext(intSystemID),
+			ResourceType:  "application",
+			Operation:     "update",
+			IDField:       "id",
+			Args:          map[string]interface{}{"id": appID},
+			TransactionFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(true).Once()
+				svc.On("Evaluate", txtest.CtxWithDBMatcher(), updateAppReq, appRef).Return(&accesspolicy.Decision{Allowed: false, Reason: "denied"}, nil).Once()
+				return svc
+			},
+			ExpectedErrMsg: apperrors.NewUnauthorizedError("denied").Error(),
+		},
+		{
+			Name:          "returns error when the evaluation fails",
+			Ctx:           fixContext(intSystemID),
+			ResourceType:  "application",
+			Operation:     "update",
+			IDField:       "id",
+			Args:          map[string]interface{}{"id": appID},
+			TransactionFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(true).Once()
+				svc.On("Evaluate", txtest.CtxWithDBMatcher(), updateAppReq, appRef).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:          "returns error when the transaction fails to begin",
+			Ctx:           fixContext(intSystemID),
+			ResourceType:  "application",
+			Operation:     "update",
+			IDField:       "id",
+			Args:          map[string]interface{}{"id": appID},
+			TransactionFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(true).Once()
+				return svc
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:          "returns error when the transaction fails to commit",
+			Ctx:           fixContext(intSystemID),
+			ResourceType:  "application",
+			Operation:     "update",
+			IDField:       "id",
+			Args:          map[string]interface{}{"id": appID},
+			TransactionFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(true).Once()
+				svc.On("Evaluate", txtest.CtxWithDBMatcher(), updateAppReq, appRef).Return(&accesspolicy.Decision{Allowed: true}, nil).Once()
+				return svc
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:          "evaluates every ID of a list of IDs",
+			Ctx:           fixContext(intSystemID),
+			ResourceType:  "application",
+			Operation:     "update",
+			IDField:       "ids",
+			Args:          map[string]interface{}{"ids": []string{appID, appID}},
+			TransactionFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(true).Once()
+				svc.On("Evaluate", txtest.CtxWithDBMatcher(), updateAppReq, appRef).Return(&accesspolicy.Decision{Allowed: true}, nil).Twice()
+				return svc
+			},
+		},
+		{
+			Name:             "evaluates the resources resolved by the resource resolver with their resource type",
+			Ctx:              fixContext(intSystemID),
+			ResourceType:     "application",
+			Operation:        "update",
+			IDField:          "webhookID",
+			ResourceResolver: str.Ptr("WebhookOwner"),
+			Args:             map[string]interface{}{"webhookID": webhookID},
+			TransactionFn:    txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(false).Once()
+				svc.On("IsRestricted", fixRequest(intSystemID, "runtime", "update", nil)).Return(true).Once()
+				svc.On("Evaluate", txtest.CtxWithDBMatcher(), updateRuntimeReq, accesspolicy.ResourceRef{ResourceType: "runtime", ID: runtimeID}).Return(&accesspolicy.Decision{Allowed: false, Reason: "denied"}, nil).Once()
+				return svc
+			},
+			ExpectedErrMsg: apperrors.NewUnauthorizedError("denied").Error(),
+		},
+		{
+			Name:             "forwards the request to the next resolver when the resource resolver resolves no resources",
+			Ctx:              fixContext(intSystemID),
+			ResourceType:     "application",
+			Operation:        "update",
+			IDField:          "webhookID",
+			ResourceResolver: str.Ptr("None"),
+			Args:             map[string]interface{}{"webhookID": webhookID},
+			TransactionFn:    txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(true).Once()
+				return svc
+			},
+		},
+		{
+			Name:             "returns error when the resource resolver fails",
+			Ctx:              fixContext(intSystemID),
+			ResourceType:     "application",
+			Operation:        "update",
+			IDField:          "webhookID",
+			ResourceResolver: str.Ptr("WebhookOwner"),
+			Args:             map[string]interface{}{"webhookID": "unknown"},
+			TransactionFn:    txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(true).Once()
+				return svc
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:             "returns error when the resource resolver is unknown",
+			Ctx:              fixContext(intSystemID),
+			ResourceType:     "application",
+			Operation:        "update",
+			IDField:          "id",
+			ResourceResolver: str.Ptr("Unknown"),
+			Args:             map[string]interface{}{"id": appID},
+			ExpectedErrMsg:   `unknown resource resolver "Unknown"`,
+		},
+		{
+			Name:          "returns error when the id field is missing",
+			Ctx:           fixContext(intSystemID),
+			ResourceType:  "application",
+			Operation:     "update",
+			IDField:       "id",
+			Args:          map[string]interface{}{},
+			TransactionFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("IsRestricted", restrictedAppReq).Return(true).Once()
+				return svc
+			},
+			ExpectedErrMsg: "could not get idField: id from request context",
+		},
+		{
+			Name:           "returns error when there is no tenant in the context",
+			Ctx:            context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: intSystemID, Type: consumer.IntegrationSystem}),
+			ResourceType:   "application",
+			Args:           map[string]interface{}{"id": appID},
+			ExpectedErrMsg: apperrors.NewCannotReadTenantError().Error(),
+		},
+		{
+			Name:           "returns error when there is no consumer in the context",
+			Ctx:            context.TODO(),
+			ResourceType:   "application",
+			Args:           map[string]interface{}{"id": appID},
+			ExpectedErrMsg: consumer.NoConsumerError.Error(),
+		},
+		{
+			Name:           "returns error when the resource type is not supported",
+			Ctx:            fixContext(intSystemID),
+			ResourceType:   "formations",
+			Operation:      "create",
+			IDField:        "formation",
+			Args:           map[string]interface{}{"formation": gqlmodel.FormationInput{Name: formationName}},
+			ExpectedErrMsg: `unsupported resource type "formations"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := txGen.ThatDoesntStartTransaction()
+			if testCase.TransactionFn != nil {
+				persist, transact = testCase.TransactionFn()
+			}
+			svc := &automock.PolicyService{}
+			if testCase.ServiceFn != nil {
+				svc = testCase.ServiceFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, persist, transact, svc)
+
+			ctx := graphql.WithFieldContext(testCase.Ctx, &graphql.FieldContext{Args: testCase.Args})
+			directive := accesspolicy.NewDirective(transact, svc, resolvers)
+
+			// WHEN
+			res, err := directive.HasAccess(ctx, nil, func(ctx context.Context) (interface{}, error) {
+				return nextOutput, nil
+			}, testCase.ResourceType, testCase.Operation, testCase.IDField, testCase.ResourceResolver)
+
+			// THEN
+			if testCase.ExpectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+				assert.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, nextOutput, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package accesspolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/config"
+)
+
+// Request is a request of a consumer to perform an operation on a resource
+type Request struct {
+	ConsumerID       string
+	ConsumerType     string
+	TenantID         string
+	ExternalTenantID string
+	ResourceType     string
+	ResourceID       string
+	Operation        string
+	// Attributes of the resource which are matched against the resource selectors of the policies
+	Attributes map[string]interface{}
+}
+
+// Evaluation is the result of the evaluation of a single policy against a request
+type Evaluation struct {
+	Policy          string
+	Effect          config.AccessPolicyEffect
+	Applicable      bool
+	SelectorMatched bool
+	Reason          string
+}
+
+// Decision is the result of the evaluation of all policies against a request
+type Decision struct {
+	Allowed     bool
+	Reason      string
+	Evaluations []Evaluation
+}
+
+// Evaluator evaluates requests against a set of access policies.
+//
+// A request which no policy applies to is allowed. A request is denied if a deny policy applies to it and its resource
+// matches the resource selector of that policy. Otherwise, if allow policies apply to the request, its resource has to
+// match the resource selector of at least one of them.
+type Evaluator struct {
+	policies []config.AccessPolicy
+}
+
+// NewEvaluator returns a new Evaluator of the given policies
+func NewEvaluator(policies []config.AccessPolicy) *Evaluator {
+	return &Evaluator{policies: policies}
+}
+
+// HasApplicablePolicies returns true if at least one policy applies to the request regardless of its resource attributes
+func (e *Evaluator) HasApplicablePolicies(req Request) bool {
+	for _, policy := range e.policies {
+		if applicable, _ := isApplicable(policy, req); applicable {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate evaluates the request against all policies and explains the decision
+func (e *Evaluator) Evaluate(req Request) Decision {
+	evaluations := make([]Evaluation, 0, len(e.policies))
+	var denyingPolicies, allowingPolicies, applicableAllowPolicies []string
+	for _, policy := range e.policies {
+		evaluation := evaluate(policy, req)
+		evaluations = append(evaluations, evaluation)
+
+		if !evaluation.Applicable {
+			continue
+		}
+
+		switch policy.Effect {
+		case config.AccessPolicyEffectDeny:
+			if evaluation.SelectorMatched {
+				denyingPolicies = append(denyingPolicies, policy.Name)
+			}
+		case config.AccessPolicyEffectAllow:
+			applicableAllowPolicies = append(applicableAllowPolicies, policy.Name)
+			if evaluation.SelectorMatched {
+				allowingPolicies = append(allowingPolicies, policy.Name)
+			}
+		}
+	}
+
+	decision := Decision{Evaluations: evaluations}
+	switch {
+	case len(denyingPolicies) > 0:
+		decision.Reason = fmt.Sprintf("denied by access policies: %s", strings.Join(denyingPolicies, ", "))
+	case len(applicableAllowPolicies) == 0:
+		decision.Allowed = true
+		decision.Reason = "no access policy restricts the request"
+	case len(allowingPolicies) > 0:
+		decision.Allowed = true
+		decision.Reason = fmt.Sprintf("allowed by access policies: %s", strings.Join(allowingPolicies, ", "))
+	default:
+		decision.Reason = fmt.Sprintf("the %s does not match the resource selector of any of the applicable access policies: %s", req.ResourceType, strings.Join(applicableAllowPolicies, ", "))
+	}
+
+	return decision
+}
+
+func evaluate(policy config.AccessPolicy, req Request) Evaluation {
+	evaluation := Evaluation{
+		Policy: policy.Name,
+		Effect: policy.Effect,
+	}
+
+	applicable, reason := isApplicable(policy, req)
+	if !applicable {
+		evaluation.Reason = reason
+		return evaluation
+	}
+	evaluation.Applicable = true
+
+	for key, value := range policy.ResourceSelector {
+		if !attributeMatches(req.Attributes[key], value) {
+			evaluation.Reason = fmt.Sprintf("attribute %q of the %s does not match %q", key, req.ResourceType, value)
+			return evaluation
+		}
+	}
+	evaluation.SelectorMatched = true
+	evaluation.Reason = fmt.Sprintf("the %s matches the resource selector", req.ResourceType)
+
+	return evaluation
+}
+
+func isApplicable(policy config.AccessPolicy, req Request) (bool, string) {
+	if !matchesAny(policy.ConsumerTypes, req.ConsumerType) {
+		return false, fmt.Sprintf("consumer type %q is not one of %s", req.ConsumerType, strings.Join(policy.ConsumerTypes, ", "))
+	}
+	if !matchesAny(policy.ConsumerIDs, req.ConsumerID) {
+		return false, fmt.Sprintf("consumer ID %q is not one of %s", req.ConsumerID, strings.Join(policy.ConsumerIDs, ", "))
+	}
+	if !matchesAny(policy.Tenants, req.TenantID, req.ExternalTenantID) {
+		return false, fmt.Sprintf("tenant %q is not one of %s", req.ExternalTenantID, strings.Join(policy.Tenants, ", "))
+	}
+	if !matchesAny(policy.ResourceTypes, req.ResourceType) {
+		return false, fmt.Sprintf("resource type %q is not one of %s", req.ResourceType, strings.Join(policy.ResourceTypes, ", "))
+	}
+	if !matchesAny(policy.Operations, req.Operation) {
+		return false, fmt.Sprintf("operation %q is not one of %s", req.Operation, strings.Join(policy.Operations, ", "))
+	}
+	return true, ""
+}
+
+// matchesAny returns true if the allowed values are empty or contain any of the given values
+func matchesAny(allowed []string, values ...string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, a := range allowed {
+		for _, v := range values {
+			if len(v) > 0 && a == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// attributeMatches returns true if the attribute is equal to the value or is a list containing the value
+func attributeMatches(attribute interface{}, value string) bool {
+	switch a := attribute.(type) {
+	case nil:
+		return false
+	case string:
+		return a == value
+	case []string:
+		for _, item := range a {
+			if item == value {
+				return true
+			}
+		}
+		return false
+	case []interface{}:
+		for _, item := range a {
+			if attributeMatches(item, value) {
+				return true
+			}
+		}
+		return false
+	default:
+		return fmt.Sprint(a) == value
+	}
+}
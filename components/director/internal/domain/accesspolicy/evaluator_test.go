@@ -0,0 +1,108 @@
+package accesspolicy_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy"
+	"github.com/kyma-incubator/compass/components/director/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	evaluator := accesspolicy.NewEvaluator([]config.AccessPolicy{s4AllowPolicy, denyTemplatePolicy})
+
+	testCases := []struct {
+		Name                string
+		Request             accesspolicy.Request
+		ExpectedAllowed     bool
+		ExpectedReason      string
+		ExpectedApplicable  []bool
+		ExpectedSelectorMet []bool
+	}{
+		{
+			Name:                "allows when the resource matches the selector of an applicable allow policy",
+			Request:             fixRequest(intSystemID, "application", "update", map[string]interface{}{applicationType: s4Type}),
+			ExpectedAllowed:     true,
+			ExpectedReason:      "allowed by access policies: int-system-s4-applications",
+			ExpectedApplicable:  []bool{true, false},
+			ExpectedSelectorMet: []bool{true, false},
+		},
+		{
+			Name:                "denies when the resource does not match the selector of any applicable allow policy",
+			Request:             fixRequest(intSystemID, "application", "delete", map[string]interface{}{applicationType: "SAP Ariba"}),
+			ExpectedAllowed:     false,
+			ExpectedReason:      "the application does not match the resource selector of any of the applicable access policies: int-system-s4-applications",
+			ExpectedApplicable:  []bool{true, false},
+			ExpectedSelectorMet: []bool{false, false},
+		},
+		{
+			Name:                "allows when no policy applies to the request",
+			Request:             fixRequest(otherIntSystemID, "application", "update", nil),
+			ExpectedAllowed:     true,
+			ExpectedReason:      "no access policy restricts the request",
+			ExpectedApplicable:  []bool{false, false},
+			ExpectedSelectorMet: []bool{false, false},
+		},
+		{
+			Name:                "denies when the resource matches the selector of an applicable deny policy",
+			Request:             fixRequest(intSystemID, "formation", "assign", map[string]interface{}{accesspolicy.FormationTemplateNameAttribute: templateName}),
+			ExpectedAllowed:     false,
+			ExpectedReason:      "denied by access policies: deny-formations-of-template",
+			ExpectedApplicable:  []bool{false, true},
+			ExpectedSelectorMet: []bool{false, true},
+		},
+		{
+			Name:                "allows when the resource does not match the selector of an applicable deny policy",
+			Request:             fixRequest(intSystemID, "formation", "assign", map[string]interface{}{accesspolicy.FormationTemplateNameAttribute: "other"}),
+			ExpectedAllowed:     true,
+			ExpectedReason:      "no access policy restricts the request",
+			ExpectedApplicable:  []bool{false, true},
+			ExpectedSelectorMet: []bool{false, false},
+		},
+		{
+			Name:                "matches list attributes containing the selector value",
+			Request:             fixRequest(intSystemID, "application", "update", map[string]interface{}{applicationType: []interface{}{"SAP Ariba", s4Type}}),
+			ExpectedAllowed:     true,
+			ExpectedReason:      "allowed by access policies: int-system-s4-applications",
+			ExpectedApplicable:  []bool{true, false},
+			ExpectedSelectorMet: []bool{true, false},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			decision := evaluator.Evaluate(testCase.Request)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedAllowed, decision.Allowed)
+			assert.Equal(t, testCase.ExpectedReason, decision.Reason)
+			require.Len(t, decision.Evaluations, 2)
+			for i, evaluation := range decision.Evaluations {
+				assert.Equal(t, testCase.ExpectedApplicable[i], evaluation.Applicable, evaluation.Policy)
+				assert.Equal(t, testCase.ExpectedSelectorMet[i], evaluation.SelectorMatched, evaluation.Policy)
+				assert.NotEmpty(t, evaluation.Reason)
+			}
+		})
+	}
+
+	t.Run("explains why a policy does not apply", func(t *testing.T) {
+		// WHEN
+		decision := evaluator.Evaluate(fixRequest(otherIntSystemID, "application", "update", nil))
+
+		// THEN
+		assert.Equal(t, `consumer ID "0f1f6c1e-3b1a-4f4e-8b43-5dc0c1b7a1a2" is not one of c7b0f2c1-6b3e-4a0e-9d4e-4d3c1e0b9b11`, decision.Evaluations[0].Reason)
+		assert.Equal(t, `resource type "application" is not one of formation`, decision.Evaluations[1].Reason)
+	})
+}
+
+func TestEvaluator_HasApplicablePolicies(t *testing.T) {
+	evaluator := accesspolicy.NewEvaluator([]config.AccessPolicy{s4AllowPolicy, denyTemplatePolicy})
+
+	assert.True(t, evaluator.HasApplicablePolicies(fixRequest(intSystemID, "application", "update", nil)))
+	assert.False(t, evaluator.HasApplicablePolicies(fixRequest(intSystemID, "runtime", "update", nil)))
+	assert.False(t, evaluator.HasApplicablePolicies(fixRequest(otherIntSystemID, "application", "update", nil)))
+	assert.True(t, evaluator.HasApplicablePolicies(fixRequest(otherIntSystemID, "formation", "delete", nil)))
+	assert.False(t, accesspolicy.NewEvaluator(nil).HasApplicablePolicies(fixRequest(intSystemID, "application", "update", nil)))
+}
@@ -0,0 +1,68 @@
+package accesspolicy_test
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/config"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+)
+
+const (
+	tenantID            = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	externalTenantID    = "external-tenant"
+	intSystemID         = "c7b0f2c1-6b3e-4a0e-9d4e-4d3c1e0b9b11"
+	otherIntSystemID    = "0f1f6c1e-3b1a-4f4e-8b43-5dc0c1b7a1a2"
+	appID               = "3b5d5f8a-9c39-4c54-8a45-0fd58b3f9c62"
+	runtimeID           = "8f3c2b1a-5d4e-4f6a-9b7c-1e2d3f4a5b6c"
+	webhookID           = "6a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+	bundleID            = "2c4e6a8b-1d3f-4b5a-9c7e-0f2a4c6e8b1d"
+	formationID         = "1f5b1c27-4ad0-4f5f-9a4a-6b1c4b9d6f7b"
+	formationName       = "my-formation"
+	formationTemplateID = "d5a3f2b7-6c0a-44b2-9d34-5a1b8f6c2e1d"
+	templateName        = "Side-by-side extensibility"
+	applicationType     = "applicationType"
+	s4Type              = "SAP S/4HANA"
+)
+
+var (
+	testErr = errors.New("test error")
+	txGen   = txtest.NewTransactionContextGenerator(testErr)
+
+	s4AllowPolicy = config.AccessPolicy{
+		Name:             "int-system-s4-applications",
+		Effect:           config.AccessPolicyEffectAllow,
+		ConsumerTypes:    []string{string(consumer.IntegrationSystem)},
+		ConsumerIDs:      []string{intSystemID},
+		ResourceTypes:    []string{"application"},
+		Operations:       []string{"update", "delete"},
+		ResourceSelector: map[string]string{applicationType: s4Type},
+	}
+	denyTemplatePolicy = config.AccessPolicy{
+		Name:             "deny-formations-of-template",
+		Effect:           config.AccessPolicyEffectDeny,
+		Tenants:          []string{externalTenantID},
+		ResourceTypes:    []string{"formation"},
+		ResourceSelector: map[string]string{accesspolicy.FormationTemplateNameAttribute: templateName},
+	}
+)
+
+func fixRequest(consumerID, resourceType, operation string, attributes map[string]interface{}) accesspolicy.Request {
+	return accesspolicy.Request{
+		ConsumerID:       consumerID,
+		ConsumerType:     string(consumer.IntegrationSystem),
+		TenantID:         tenantID,
+		ExternalTenantID: externalTenantID,
+		ResourceType:     resourceType,
+		Operation:        operation,
+		Attributes:       attributes,
+	}
+}
+
+func fixContext(consumerID string) context.Context {
+	ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: consumerID, Type: consumer.IntegrationSystem})
+	return context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID, ExternalID: externalTenantID})
+}
@@ -0,0 +1,82 @@
+package accesspolicy
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+// Converter converts access policy decisions to the graphql model
+//
+//go:generate mockery --name=Converter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Converter interface {
+	ToGraphQL(in *Decision) *graphql.AccessExplanation
+}
+
+// Resolver is an object responsible for resolver-layer access policy operations.
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      PolicyService
+	conv     Converter
+}
+
+// NewResolver returns a new object responsible for resolver-layer access policy operations.
+func NewResolver(transact persistence.Transactioner, svc PolicyService, conv Converter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// ExplainAccess evaluates the access policies for the requested consumer, which defaults to the requesting one, in the current tenant
+func (r *Resolver) ExplainAccess(ctx context.Context, in graphql.AccessRequestInput) (*graphql.AccessExplanation, error) {
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantCtx, err := tenant.LoadTenantPairFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := Request{
+		ConsumerID:       consumerInfo.ConsumerID,
+		ConsumerType:     string(consumerInfo.Type),
+		TenantID:         tenantCtx.InternalID,
+		ExternalTenantID: tenantCtx.ExternalID,
+		ResourceType:     in.ResourceType,
+		ResourceID:       in.ResourceID,
+		Operation:        in.Operation,
+	}
+	if in.ConsumerID != nil {
+		req.ConsumerID = str.PtrStrToStr(in.ConsumerID)
+	}
+	if in.ConsumerType != nil {
+		req.ConsumerType = str.PtrStrToStr(in.ConsumerType)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	decision, err := r.svc.Evaluate(ctx, req, ResourceRef{ID: in.ResourceID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(decision), nil
+}
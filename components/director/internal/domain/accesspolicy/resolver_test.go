@@ -0,0 +1,125 @@
+package accesspolicy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ExplainAccess(t *testing.T) {
+	input := graphql.AccessRequestInput{
+		ConsumerID:   str.Ptr(otherIntSystemID),
+		ResourceType: "application",
+		ResourceID:   appID,
+		Operation:    "update",
+	}
+
+	expectedReq := fixRequest(otherIntSystemID, "application", "update", nil)
+	expectedReq.ResourceID = appID
+	decision := &accesspolicy.Decision{Allowed: true, Reason: "no access policy restricts the request"}
+	explanation := &graphql.AccessExplanation{Allowed: true, Reason: "no access policy restricts the request", Policies: []*graphql.AccessPolicyEvaluation{}}
+
+	testCases := []struct {
+		Name           string
+		Ctx            context.Context
+		TransactionFn  func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.PolicyService
+		ConverterFn    func() *automock.Converter
+		ExpectedResult *graphql.AccessExplanation
+		ExpectedErrMsg string
+	}{
+		{
+			Name:          "success for the consumer from the input",
+			Ctx:           fixContext(intSystemID),
+			TransactionFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("Evaluate", txtest.CtxWithDBMatcher(), expectedReq, accesspolicy.ResourceRef{ID: appID}).Return(decision, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", decision).Return(explanation).Once()
+				return conv
+			},
+			ExpectedResult: explanation,
+		},
+		{
+			Name:           "returns error when there is no consumer in the context",
+			Ctx:            context.TODO(),
+			ExpectedErrMsg: consumer.NoConsumerError.Error(),
+		},
+		{
+			Name:           "returns error when the transaction fails to begin",
+			Ctx:            fixContext(intSystemID),
+			TransactionFn:  txGen.ThatFailsOnBegin,
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:          "returns error when the evaluation fails",
+			Ctx:           fixContext(intSystemID),
+			TransactionFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("Evaluate", txtest.CtxWithDBMatcher(), expectedReq, accesspolicy.ResourceRef{ID: appID}).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:          "returns error when the transaction fails to commit",
+			Ctx:           fixContext(intSystemID),
+			TransactionFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.PolicyService {
+				svc := &automock.PolicyService{}
+				svc.On("Evaluate", txtest.CtxWithDBMatcher(), expectedReq, accesspolicy.ResourceRef{ID: appID}).Return(decision, nil).Once()
+				return svc
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := txGen.ThatDoesntStartTransaction()
+			if testCase.TransactionFn != nil {
+				persist, transact = testCase.TransactionFn()
+			}
+			svc := &automock.PolicyService{}
+			if testCase.ServiceFn != nil {
+				svc = testCase.ServiceFn()
+			}
+			conv := &automock.Converter{}
+			if testCase.ConverterFn != nil {
+				conv = testCase.ConverterFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+
+			resolver := accesspolicy.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.ExplainAccess(testCase.Ctx, input)
+
+			// THEN
+			if testCase.ExpectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,464 @@
+package accesspolicy
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantconfiguration"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	gqlmodel "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ApplicationByBundle resolves the application of the bundle referenced by the idField argument
+	ApplicationByBundle = "ApplicationByBundle"
+	// ApplicationByAPIDefinition resolves the application of the API definition referenced by the idField argument
+	ApplicationByAPIDefinition = "ApplicationByAPIDefinition"
+	// ApplicationByEventDefinition resolves the application of the event definition referenced by the idField argument
+	ApplicationByEventDefinition = "ApplicationByEventDefinition"
+	// ApplicationByDocument resolves the application of the document referenced by the idField argument
+	ApplicationByDocument = "ApplicationByDocument"
+	// WebhookOwner resolves the application or the runtime of the webhook referenced by the idField argument
+	WebhookOwner = "WebhookOwner"
+	// NewWebhookOwner resolves the application or the runtime referenced by the applicationID and runtimeID arguments of a new webhook
+	NewWebhookOwner = "NewWebhookOwner"
+	// MergedApplications resolves the destination application, which is updated, and the source application, which is deleted, of a merge
+	MergedApplications = "MergedApplications"
+	// NewResource resolves the application or the runtime created from the input referenced by the idField argument
+	NewResource = "NewResource"
+	// SetLabel resolves the resource referenced by the idField argument together with the label set by the key and value arguments
+	SetLabel = "SetLabel"
+	// DeleteLabel resolves the resource referenced by the idField argument together with the label deleted by the key argument
+	DeleteLabel = "DeleteLabel"
+	// DeletedResource resolves the soft deleted resource referenced by the idField argument together with its archived labels
+	DeletedResource = "DeletedResource"
+	// TenantAccessResource resolves the application or the runtime which a tenant access is granted or revoked for
+	TenantAccessResource = "TenantAccessResource"
+	// TenantConfigurationDocument resolves the applications, runtimes and formations of the tenant configuration document referenced by the idField argument
+	TenantConfigurationDocument = "TenantConfigurationDocument"
+
+	deleteOperation = "delete"
+	createOperation = "create"
+	updateOperation = "update"
+	assignOperation = "assign"
+)
+
+// ResourceResolver resolves the resources which a mutation operates on from its arguments.
+// ResourceTypes and Operations are the resource types and operations which the resolved resources may have besides
+// the ones of the directive. They are used to decide whether the mutation has to be evaluated at all.
+type ResourceResolver struct {
+	ResourceTypes []string
+	Operations    []string
+	Resolve       func(ctx context.Context, tenantID string, args map[string]interface{}, idField string) ([]ResourceRef, error)
+}
+
+// ApplicationLabelsFromTemplateFunc returns the labels of the application which would be registered from the input
+type ApplicationLabelsFromTemplateFunc func(ctx context.Context, in gqlmodel.ApplicationFromTemplateInput) (map[string]interface{}, error)
+
+// BundleRepository is responsible for the repo-layer bundle operations
+//
+//go:generate mockery --name=BundleRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type BundleRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.Bundle, error)
+}
+
+// APIRepository is responsible for the repo-layer API definition operations
+//
+//go:generate mockery --name=APIRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type APIRepository interface {
+	GetByID(ctx context.Context, tenantID, id string) (*model.APIDefinition, error)
+}
+
+// EventRepository is responsible for the repo-layer event definition operations
+//
+//go:generate mockery --name=EventRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EventRepository interface {
+	GetByID(ctx context.Context, tenantID, id string) (*model.EventDefinition, error)
+}
+
+// DocumentRepository is responsible for the repo-layer document operations
+//
+//go:generate mockery --name=DocumentRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type DocumentRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.Document, error)
+}
+
+// WebhookRepository is responsible for the repo-layer webhook operations
+//
+//go:generate mockery --name=WebhookRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type WebhookRepository interface {
+	GetByIDGlobal(ctx context.Context, id string) (*model.Webhook, error)
+}
+
+// RuntimeContextRepository is responsible for the repo-layer runtime context operations
+//
+//go:generate mockery --name=RuntimeContextRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type RuntimeContextRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.RuntimeContext, error)
+}
+
+// ApplicationRepository is responsible for the repo-layer application operations
+//
+//go:generate mockery --name=ApplicationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationRepository interface {
+	ListAll(ctx context.Context, tenantID string) ([]*model.Application, error)
+}
+
+// RuntimeRepository is responsible for the repo-layer runtime operations
+//
+//go:generate mockery --name=RuntimeRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type RuntimeRepository interface {
+	ListAll(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]*model.Runtime, error)
+}
+
+// SoftDeletedResourceRepository is responsible for the repo-layer soft deleted resource operations
+//
+//go:generate mockery --name=SoftDeletedResourceRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SoftDeletedResourceRepository interface {
+	ListArchivedLabelsGlobal(ctx context.Context, id string) (map[string]interface{}, error)
+}
+
+type resourceResolvers struct {
+	bundleRepo            BundleRepository
+	apiRepo               APIRepository
+	eventRepo             EventRepository
+	documentRepo          DocumentRepository
+	webhookRepo           WebhookRepository
+	runtimeContextRepo    RuntimeContextRepository
+	appRepo               ApplicationRepository
+	runtimeRepo           RuntimeRepository
+	formationRepo         FormationRepository
+	softDeletedRepo       SoftDeletedResourceRepository
+	applicationLabelsFunc ApplicationLabelsFromTemplateFunc
+}
+
+// NewResourceResolvers returns the resource resolvers which can be referenced by the access policy directive
+func NewResourceResolvers(bundleRepo BundleRepository, apiRepo APIRepository, eventRepo EventRepository, documentRepo DocumentRepository, webhookRepo WebhookRepository, runtimeContextRepo RuntimeContextRepository,
+	appRepo ApplicationRepository, runtimeRepo RuntimeRepository, formationRepo FormationRepository, softDeletedRepo SoftDeletedResourceRepository, applicationLabelsFunc ApplicationLabelsFromTemplateFunc) map[string]ResourceResolver {
+	r := &resourceResolvers{
+		bundleRepo:            bundleRepo,
+		apiRepo:               apiRepo,
+		eventRepo:             eventRepo,
+		documentRepo:          documentRepo,
+		webhookRepo:           webhookRepo,
+		runtimeContextRepo:    runtimeContextRepo,
+		appRepo:               appRepo,
+		runtimeRepo:           runtimeRepo,
+		formationRepo:         formationRepo,
+		softDeletedRepo:       softDeletedRepo,
+		applicationLabelsFunc: applicationLabelsFunc,
+	}
+
+	applicationAndRuntime := []string{ApplicationResourceType, RuntimeResourceType}
+	return map[string]ResourceResolver{
+		ApplicationByBundle:          {Resolve: r.applicationByBundle},
+		ApplicationByAPIDefinition:   {Resolve: r.applicationByAPIDefinition},
+		ApplicationByEventDefinition: {Resolve: r.applicationByEventDefinition},
+		ApplicationByDocument:        {Resolve: r.applicationByDocument},
+		WebhookOwner:                 {ResourceTypes: applicationAndRuntime, Resolve: r.webhookOwner},
+		NewWebhookOwner:              {ResourceTypes: applicationAndRuntime, Resolve: newWebhookOwner},
+		MergedApplications:           {Operations: []string{deleteOperation}, Resolve: mergedApplications},
+		NewResource:                  {Resolve: r.newResource},
+		SetLabel:                     {Resolve: setLabel},
+		DeleteLabel:                  {Resolve: deleteLabel},
+		DeletedResource:              {Resolve: r.deletedResource},
+		TenantAccessResource:         {ResourceTypes: applicationAndRuntime, Resolve: r.tenantAccessResource},
+		TenantConfigurationDocument: {
+			ResourceTypes: []string{ApplicationResourceType, RuntimeResourceType, FormationResourceType},
+			Operations:    []string{createOperation, updateOperation, assignOperation},
+			Resolve:       r.tenantConfigurationDocument,
+		},
+	}
+}
+
+func (r *resourceResolvers) applicationByBundle(ctx context.Context, tenantID string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	id, err := stringArg(args, idField)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.applicationOfBundle(ctx, tenantID, id)
+}
+
+func (r *resourceResolvers) applicationOfBundle(ctx context.Context, tenantID, bundleID string) ([]ResourceRef, error) {
+	bndl, err := r.bundleRepo.GetByID(ctx, tenantID, bundleID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting bundle with ID %s", bundleID)
+	}
+
+	return applicationRefs(bndl.ApplicationID), nil
+}
+
+func (r *resourceResolvers) applicationByAPIDefinition(ctx context.Context, tenantID string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	id, err := stringArg(args, idField)
+	if err != nil {
+		return nil, err
+	}
+
+	api, err := r.apiRepo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting API definition with ID %s", id)
+	}
+
+	return applicationRefs(api.ApplicationID), nil
+}
+
+func (r *resourceResolvers) applicationByEventDefinition(ctx context.Context, tenantID string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	id, err := stringArg(args, idField)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := r.eventRepo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting event definition with ID %s", id)
+	}
+
+	return applicationRefs(event.ApplicationID), nil
+}
+
+func (r *resourceResolvers) applicationByDocument(ctx context.Context, tenantID string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	id, err := stringArg(args, idField)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := r.documentRepo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting document with ID %s", id)
+	}
+
+	return r.applicationOfBundle(ctx, tenantID, document.BundleID)
+}
+
+// webhookOwner resolves the application or the runtime of the webhook. Webhooks of templates and integration systems are not subject to the access policies.
+func (r *resourceResolvers) webhookOwner(ctx context.Context, _ string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	id, err := stringArg(args, idField)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := r.webhookRepo.GetByIDGlobal(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting webhook with ID %s", id)
+	}
+
+	switch webhook.ObjectType {
+	case model.ApplicationWebhookReference:
+		return []ResourceRef{{ResourceType: ApplicationResourceType, ID: webhook.ObjectID}}, nil
+	case model.RuntimeWebhookReference:
+		return []ResourceRef{{ResourceType: RuntimeResourceType, ID: webhook.ObjectID}}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func newWebhookOwner(_ context.Context, _ string, args map[string]interface{}, _ string) ([]ResourceRef, error) {
+	var refs []ResourceRef
+	if appID, ok := args["applicationID"].(*string); ok && appID != nil {
+		refs = append(refs, ResourceRef{ResourceType: ApplicationResourceType, ID: *appID})
+	}
+	if runtimeID, ok := args["runtimeID"].(*string); ok && runtimeID != nil {
+		refs = append(refs, ResourceRef{ResourceType: RuntimeResourceType, ID: *runtimeID})
+	}
+	return refs, nil
+}
+
+func mergedApplications(_ context.Context, _ string, args map[string]interface{}, _ string) ([]ResourceRef, error) {
+	destinationID, err := stringArg(args, "destinationID")
+	if err != nil {
+		return nil, err
+	}
+	sourceID, err := stringArg(args, "sourceID")
+	if err != nil {
+		return nil, err
+	}
+
+	return []ResourceRef{{ID: destinationID}, {ID: sourceID, Operation: deleteOperation}}, nil
+}
+
+func (r *resourceResolvers) newResource(ctx context.Context, _ string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	switch in := args[idField].(type) {
+	case gqlmodel.ApplicationRegisterInput:
+		return []ResourceRef{{ResourceType: ApplicationResourceType, New: true, Labels: in.Labels}}, nil
+	case gqlmodel.RuntimeRegisterInput:
+		return []ResourceRef{{ResourceType: RuntimeResourceType, New: true, Labels: in.Labels}}, nil
+	case gqlmodel.ApplicationFromTemplateInput:
+		labels, err := r.applicationLabelsFunc(ctx, in)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while rendering the labels of the application from application template with name %s", in.TemplateName)
+		}
+		return []ResourceRef{{ResourceType: ApplicationResourceType, New: true, Labels: labels}}, nil
+	default:
+		return nil, errors.Errorf("could not get idField: %s from request context", idField)
+	}
+}
+
+func setLabel(_ context.Context, _ string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	id, err := stringArg(args, idField)
+	if err != nil {
+		return nil, err
+	}
+	key, err := stringArg(args, "key")
+	if err != nil {
+		return nil, err
+	}
+
+	return []ResourceRef{{ID: id, Labels: map[string]interface{}{key: args["value"]}}}, nil
+}
+
+func deleteLabel(_ context.Context, _ string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	id, err := stringArg(args, idField)
+	if err != nil {
+		return nil, err
+	}
+	key, err := stringArg(args, "key")
+	if err != nil {
+		return nil, err
+	}
+
+	return []ResourceRef{{ID: id, RemovedLabels: []string{key}}}, nil
+}
+
+// deletedResource resolves a soft deleted resource as a new resource with its archived labels, which are restored with it
+func (r *resourceResolvers) deletedResource(ctx context.Context, _ string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	id, err := stringArg(args, idField)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := r.softDeletedRepo.ListArchivedLabelsGlobal(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing the archived labels of soft deleted resource with ID %s", id)
+	}
+
+	return []ResourceRef{{ID: id, New: true, Labels: labels}}, nil
+}
+
+// tenantAccessResource resolves the resource of the tenant access input or of the resourceType and resourceID arguments.
+// Tenant accesses for runtime contexts are evaluated against the runtime of the runtime context.
+func (r *resourceResolvers) tenantAccessResource(ctx context.Context, tenantID string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	var objectType gqlmodel.TenantAccessObjectType
+	var id string
+	if in, ok := args[idField].(gqlmodel.TenantAccessInput); ok {
+		objectType, id = in.ResourceType, in.ResourceID
+	} else {
+		objectType, _ = args["resourceType"].(gqlmodel.TenantAccessObjectType)
+		id, _ = args["resourceID"].(string)
+	}
+
+	switch objectType {
+	case gqlmodel.TenantAccessObjectTypeApplication:
+		return []ResourceRef{{ResourceType: ApplicationResourceType, ID: id}}, nil
+	case gqlmodel.TenantAccessObjectTypeRuntime:
+		return []ResourceRef{{ResourceType: RuntimeResourceType, ID: id}}, nil
+	case gqlmodel.TenantAccessObjectTypeRuntimeContext:
+		rtmCtx, err := r.runtimeContextRepo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while getting runtime context with ID %s", id)
+		}
+		return []ResourceRef{{ResourceType: RuntimeResourceType, ID: rtmCtx.RuntimeID}}, nil
+	default:
+		return nil, errors.Errorf("could not get the tenant access resource from request context")
+	}
+}
+
+// tenantConfigurationDocument resolves the applications and runtimes which the import creates or updates, matched by name as
+// the import does, and the formations which it creates or assigns participants to. A dry run does not modify any resource.
+func (r *resourceResolvers) tenantConfigurationDocument(ctx context.Context, tenantID string, args map[string]interface{}, idField string) ([]ResourceRef, error) {
+	if mode, ok := args["mode"].(*gqlmodel.TenantConfigurationImportMode); ok && mode != nil && *mode == gqlmodel.TenantConfigurationImportModeDryRun {
+		return nil, nil
+	}
+
+	document, ok := args[idField].(gqlmodel.CLOB)
+	if !ok {
+		return nil, errors.Errorf("could not get idField: %s from request context", idField)
+	}
+
+	doc, err := tenantconfiguration.UnmarshalDocument(string(document))
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []ResourceRef
+	if len(doc.Applications) > 0 {
+		apps, err := r.appRepo.ListAll(ctx, tenantID)
+		if err != nil {
+			return nil, errors.Wrap(err, "while listing applications")
+		}
+		appIDs := make(map[string][]string, len(apps))
+		for _, app := range apps {
+			appIDs[app.Name] = append(appIDs[app.Name], app.ID)
+		}
+		for _, entry := range doc.Applications {
+			refs = appendImportedRef(refs, ApplicationResourceType, appIDs[entry.Name], entry.Labels)
+		}
+	}
+
+	if len(doc.Runtimes) > 0 {
+		runtimes, err := r.runtimeRepo.ListAll(ctx, tenantID, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "while listing runtimes")
+		}
+		runtimeIDs := make(map[string][]string, len(runtimes))
+		for _, rt := range runtimes {
+			runtimeIDs[rt.Name] = append(runtimeIDs[rt.Name], rt.ID)
+		}
+		for _, entry := range doc.Runtimes {
+			refs = appendImportedRef(refs, RuntimeResourceType, runtimeIDs[entry.Name], entry.Labels)
+		}
+	}
+
+	for _, entry := range doc.Formations {
+		ref := ResourceRef{ResourceType: FormationResourceType, Name: entry.Name}
+		if entry.TemplateName != nil {
+			ref.TemplateName = *entry.TemplateName
+		}
+
+		_, err := r.formationRepo.GetByName(ctx, entry.Name, tenantID)
+		if err != nil && !apperrors.IsNotFoundError(err) {
+			return nil, errors.Wrapf(err, "while getting formation with name %s", entry.Name)
+		}
+		if err != nil {
+			createRef := ref
+			createRef.Operation = createOperation
+			refs = append(refs, createRef)
+		}
+		if len(entry.Applications) > 0 || len(entry.Runtimes) > 0 {
+			ref.Operation = assignOperation
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs, nil
+}
+
+// appendImportedRef appends a new resource if no resource with the name of the entry exists and an updated resource,
+// whose labels are replaced with the ones of the entry, if exactly one exists. Entries matching several resources are not imported.
+func appendImportedRef(refs []ResourceRef, resourceType string, existingIDs []string, labels map[string]interface{}) []ResourceRef {
+	switch len(existingIDs) {
+	case 0:
+		return append(refs, ResourceRef{ResourceType: resourceType, Operation: createOperation, New: true, Labels: labels})
+	case 1:
+		return append(refs, ResourceRef{ResourceType: resourceType, Operation: updateOperation, ID: existingIDs[0], Labels: labels, ReplaceLabels: true})
+	default:
+		return refs
+	}
+}
+
+func applicationRefs(appID *string) []ResourceRef {
+	if appID == nil {
+		return nil
+	}
+	return []ResourceRef{{ResourceType: ApplicationResourceType, ID: *appID}}
+}
+
+func stringArg(args map[string]interface{}, field string) (string, error) {
+	value, ok := args[field].(string)
+	if !ok {
+		return "", errors.Errorf("could not get idField: %s from request context", field)
+	}
+	return value, nil
+}
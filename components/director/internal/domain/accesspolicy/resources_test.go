@@ -0,0 +1,325 @@
+package accesspolicy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	apiID            = "5e7a9c1b-3d5f-4a7b-9c1d-3e5f7a9b1c3d"
+	eventID          = "7b9d1f3a-5c7e-4b9d-8f1a-3c5e7b9d1f3a"
+	documentID       = "9d1f3b5a-7e9c-4d1f-8b3a-5e7c9d1f3b5a"
+	runtimeContextID = "1e3a5c7d-9f1b-4e3a-8c5d-7f9b1e3a5c7d"
+)
+
+type resourceResolverMocks struct {
+	bundleRepo         *automock.BundleRepository
+	apiRepo            *automock.APIRepository
+	eventRepo          *automock.EventRepository
+	documentRepo       *automock.DocumentRepository
+	webhookRepo        *automock.WebhookRepository
+	runtimeContextRepo *automock.RuntimeContextRepository
+	appRepo            *automock.ApplicationRepository
+	runtimeRepo        *automock.RuntimeRepository
+	formationRepo      *automock.FormationRepository
+	softDeletedRepo    *automock.SoftDeletedResourceRepository
+}
+
+func newResourceResolverMocks() resourceResolverMocks {
+	return resourceResolverMocks{
+		bundleRepo:         &automock.BundleRepository{},
+		apiRepo:            &automock.APIRepository{},
+		eventRepo:          &automock.EventRepository{},
+		documentRepo:       &automock.DocumentRepository{},
+		webhookRepo:        &automock.WebhookRepository{},
+		runtimeContextRepo: &automock.RuntimeContextRepository{},
+		appRepo:            &automock.ApplicationRepository{},
+		runtimeRepo:        &automock.RuntimeRepository{},
+		formationRepo:      &automock.FormationRepository{},
+		softDeletedRepo:    &automock.SoftDeletedResourceRepository{},
+	}
+}
+
+func (m resourceResolverMocks) resolvers(applicationLabelsFunc accesspolicy.ApplicationLabelsFromTemplateFunc) map[string]accesspolicy.ResourceResolver {
+	return accesspolicy.NewResourceResolvers(m.bundleRepo, m.apiRepo, m.eventRepo, m.documentRepo, m.webhookRepo, m.runtimeContextRepo, m.appRepo, m.runtimeRepo, m.formationRepo, m.softDeletedRepo, applicationLabelsFunc)
+}
+
+func (m resourceResolverMocks) assertExpectations(t *testing.T) {
+	mock.AssertExpectationsForObjects(t, m.bundleRepo, m.apiRepo, m.eventRepo, m.documentRepo, m.webhookRepo, m.runtimeContextRepo, m.appRepo, m.runtimeRepo, m.formationRepo, m.softDeletedRepo)
+}
+
+func TestResourceResolvers(t *testing.T) {
+	ctx := context.TODO()
+	appRefs := []accesspolicy.ResourceRef{{ResourceType: "application", ID: appID}}
+	runtimeRefs := []accesspolicy.ResourceRef{{ResourceType: "runtime", ID: runtimeID}}
+	labels := map[string]interface{}{applicationType: s4Type}
+
+	testCases := []struct {
+		Name                  string
+		Resolver              string
+		IDField               string
+		Args                  map[string]interface{}
+		MocksFn               func(m resourceResolverMocks)
+		ApplicationLabelsFunc accesspolicy.ApplicationLabelsFromTemplateFunc
+		ExpectedRefs          []accesspolicy.ResourceRef
+		ExpectedErrMsg        string
+	}{
+		{
+			Name:     "resolves the application of a bundle",
+			Resolver: accesspolicy.ApplicationByBundle,
+			IDField:  "bundleID",
+			Args:     map[string]interface{}{"bundleID": bundleID},
+			MocksFn: func(m resourceResolverMocks) {
+				m.bundleRepo.On("GetByID", ctx, tenantID, bundleID).Return(&model.Bundle{ApplicationID: str.Ptr(appID)}, nil).Once()
+			},
+			ExpectedRefs: appRefs,
+		},
+		{
+			Name:     "resolves no resource for a bundle of an application template version",
+			Resolver: accesspolicy.ApplicationByBundle,
+			IDField:  "id",
+			Args:     map[string]interface{}{"id": bundleID},
+			MocksFn: func(m resourceResolverMocks) {
+				m.bundleRepo.On("GetByID", ctx, tenantID, bundleID).Return(&model.Bundle{}, nil).Once()
+			},
+		},
+		{
+			Name:     "returns error when getting the bundle fails",
+			Resolver: accesspolicy.ApplicationByBundle,
+			IDField:  "id",
+			Args:     map[string]interface{}{"id": bundleID},
+			MocksFn: func(m resourceResolverMocks) {
+				m.bundleRepo.On("GetByID", ctx, tenantID, bundleID).Return(nil, testErr).Once()
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:     "resolves the application of an API definition",
+			Resolver: accesspolicy.ApplicationByAPIDefinition,
+			IDField:  "id",
+			Args:     map[string]interface{}{"id": apiID},
+			MocksFn: func(m resourceResolverMocks) {
+				m.apiRepo.On("GetByID", ctx, tenantID, apiID).Return(&model.APIDefinition{ApplicationID: str.Ptr(appID)}, nil).Once()
+			},
+			ExpectedRefs: appRefs,
+		},
+		{
+			Name:     "resolves the application of an event definition",
+			Resolver: accesspolicy.ApplicationByEventDefinition,
+			IDField:  "eventID",
+			Args:     map[string]interface{}{"eventID": eventID},
+			MocksFn: func(m resourceResolverMocks) {
+				m.eventRepo.On("GetByID", ctx, tenantID, eventID).Return(&model.EventDefinition{ApplicationID: str.Ptr(appID)}, nil).Once()
+			},
+			ExpectedRefs: appRefs,
+		},
+		{
+			Name:     "resolves the application of the bundle of a document",
+			Resolver: accesspolicy.ApplicationByDocument,
+			IDField:  "id",
+			Args:     map[string]interface{}{"id": documentID},
+			MocksFn: func(m resourceResolverMocks) {
+				m.documentRepo.On("GetByID", ctx, tenantID, documentID).Return(&model.Document{BundleID: bundleID}, nil).Once()
+				m.bundleRepo.On("GetByID", ctx, tenantID, bundleID).Return(&model.Bundle{ApplicationID: str.Ptr(appID)}, nil).Once()
+			},
+			ExpectedRefs: appRefs,
+		},
+		{
+			Name:     "resolves the runtime of a runtime webhook",
+			Resolver: accesspolicy.WebhookOwner,
+			IDField:  "webhookID",
+			Args:     map[string]interface{}{"webhookID": webhookID},
+			MocksFn: func(m resourceResolverMocks) {
+				m.webhookRepo.On("GetByIDGlobal", ctx, webhookID).Return(&model.Webhook{ObjectID: runtimeID, ObjectType: model.RuntimeWebhookReference}, nil).Once()
+			},
+			ExpectedRefs: runtimeRefs,
+		},
+		{
+			Name:     "resolves no resource for a webhook of a formation template",
+			Resolver: accesspolicy.WebhookOwner,
+			IDField:  "webhookID",
+			Args:     map[string]interface{}{"webhookID": webhookID},
+			MocksFn: func(m resourceResolverMocks) {
+				m.webhookRepo.On("GetByIDGlobal", ctx, webhookID).Return(&model.Webhook{ObjectID: formationTemplateID, ObjectType: model.FormationTemplateWebhookReference}, nil).Once()
+			},
+		},
+		{
+			Name:         "resolves the application and the runtime of a new webhook",
+			Resolver:     accesspolicy.NewWebhookOwner,
+			IDField:      "applicationID",
+			Args:         map[string]interface{}{"applicationID": str.Ptr(appID), "runtimeID": str.Ptr(runtimeID), "applicationTemplateID": (*string)(nil)},
+			ExpectedRefs: append(appRefs, runtimeRefs...),
+		},
+		{
+			Name:         "resolves the destination and the source application of a merge",
+			Resolver:     accesspolicy.MergedApplications,
+			IDField:      "destinationID",
+			Args:         map[string]interface{}{"destinationID": appID, "sourceID": runtimeID},
+			ExpectedRefs: []accesspolicy.ResourceRef{{ID: appID}, {ID: runtimeID, Operation: "delete"}},
+		},
+		{
+			Name:         "resolves a new application with the labels of the input",
+			Resolver:     accesspolicy.NewResource,
+			IDField:      "in",
+			Args:         map[string]interface{}{"in": graphql.ApplicationRegisterInput{Name: "app", Labels: labels}},
+			ExpectedRefs: []accesspolicy.ResourceRef{{ResourceType: "application", New: true, Labels: labels}},
+		},
+		{
+			Name:         "resolves a new runtime with the labels of the input",
+			Resolver:     accesspolicy.NewResource,
+			IDField:      "in",
+			Args:         map[string]interface{}{"in": graphql.RuntimeRegisterInput{Name: "runtime", Labels: labels}},
+			ExpectedRefs: []accesspolicy.ResourceRef{{ResourceType: "runtime", New: true, Labels: labels}},
+		},
+		{
+			Name:     "resolves a new application with the labels rendered from the application template",
+			Resolver: accesspolicy.NewResource,
+			IDField:  "in",
+			Args:     map[string]interface{}{"in": graphql.ApplicationFromTemplateInput{TemplateName: templateName}},
+			ApplicationLabelsFunc: func(_ context.Context, in graphql.ApplicationFromTemplateInput) (map[string]interface{}, error) {
+				if in.TemplateName != templateName {
+					return nil, testErr
+				}
+				return labels, nil
+			},
+			ExpectedRefs: []accesspolicy.ResourceRef{{ResourceType: "application", New: true, Labels: labels}},
+		},
+		{
+			Name:     "returns error when rendering the labels from the application template fails",
+			Resolver: accesspolicy.NewResource,
+			IDField:  "in",
+			Args:     map[string]interface{}{"in": graphql.ApplicationFromTemplateInput{TemplateName: "unknown"}},
+			ApplicationLabelsFunc: func(context.Context, graphql.ApplicationFromTemplateInput) (map[string]interface{}, error) {
+				return nil, testErr
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:         "resolves the label set on a resource",
+			Resolver:     accesspolicy.SetLabel,
+			IDField:      "applicationID",
+			Args:         map[string]interface{}{"applicationID": appID, "key": applicationType, "value": s4Type},
+			ExpectedRefs: []accesspolicy.ResourceRef{{ID: appID, Labels: labels}},
+		},
+		{
+			Name:         "resolves the label deleted from a resource",
+			Resolver:     accesspolicy.DeleteLabel,
+			IDField:      "applicationID",
+			Args:         map[string]interface{}{"applicationID": appID, "key": applicationType},
+			ExpectedRefs: []accesspolicy.ResourceRef{{ID: appID, RemovedLabels: []string{applicationType}}},
+		},
+		{
+			Name:     "resolves a soft deleted resource with its archived labels",
+			Resolver: accesspolicy.DeletedResource,
+			IDField:  "id",
+			Args:     map[string]interface{}{"id": appID},
+			MocksFn: func(m resourceResolverMocks) {
+				m.softDeletedRepo.On("ListArchivedLabelsGlobal", ctx, appID).Return(labels, nil).Once()
+			},
+			ExpectedRefs: []accesspolicy.ResourceRef{{ID: appID, New: true, Labels: labels}},
+		},
+		{
+			Name:         "resolves the application of a tenant access input",
+			Resolver:     accesspolicy.TenantAccessResource,
+			IDField:      "in",
+			Args:         map[string]interface{}{"in": graphql.TenantAccessInput{ResourceType: graphql.TenantAccessObjectTypeApplication, ResourceID: appID}},
+			ExpectedRefs: appRefs,
+		},
+		{
+			Name:     "resolves the runtime of the runtime context of a tenant access",
+			Resolver: accesspolicy.TenantAccessResource,
+			IDField:  "resourceID",
+			Args:     map[string]interface{}{"resourceType": graphql.TenantAccessObjectTypeRuntimeContext, "resourceID": runtimeContextID},
+			MocksFn: func(m resourceResolverMocks) {
+				m.runtimeContextRepo.On("GetByID", ctx, tenantID, runtimeContextID).Return(&model.RuntimeContext{ID: runtimeContextID, RuntimeID: runtimeID}, nil).Once()
+			},
+			ExpectedRefs: runtimeRefs,
+		},
+		{
+			Name:     "resolves the resources created, updated and assigned by a tenant configuration document",
+			Resolver: accesspolicy.TenantConfigurationDocument,
+			IDField:  "document",
+			Args: map[string]interface{}{"document": graphql.CLOB(`version: v1
+applications:
+  - name: existing-app
+    labels:
+      applicationType: SAP S/4HANA
+  - name: new-app
+runtimes:
+  - name: ambiguous-runtime
+formations:
+  - name: my-formation
+    templateName: Side-by-side extensibility
+    applications: [existing-app]
+`)},
+			MocksFn: func(m resourceResolverMocks) {
+				m.appRepo.On("ListAll", ctx, tenantID).Return([]*model.Application{{Name: "existing-app", BaseEntity: &model.BaseEntity{ID: appID}}}, nil).Once()
+				m.runtimeRepo.On("ListAll", ctx, tenantID, []*labelfilter.LabelFilter(nil)).Return([]*model.Runtime{{ID: runtimeID, Name: "ambiguous-runtime"}, {ID: "other", Name: "ambiguous-runtime"}}, nil).Once()
+				m.formationRepo.On("GetByName", ctx, formationName, tenantID).Return(nil, apperrors.NewNotFoundError(resource.Formations, formationName)).Once()
+			},
+			ExpectedRefs: []accesspolicy.ResourceRef{
+				{ResourceType: "application", Operation: "update", ID: appID, Labels: labels, ReplaceLabels: true},
+				{ResourceType: "application", Operation: "create", New: true},
+				{ResourceType: "formation", Operation: "create", Name: formationName, TemplateName: templateName},
+				{ResourceType: "formation", Operation: "assign", Name: formationName, TemplateName: templateName},
+			},
+		},
+		{
+			Name:     "resolves no resource for a dry run of a tenant configuration document",
+			Resolver: accesspolicy.TenantConfigurationDocument,
+			IDField:  "document",
+			Args: map[string]interface{}{
+				"document": graphql.CLOB(`{"version": "v1", "applications": [{"name": "app"}]}`),
+				"mode": func() *graphql.TenantConfigurationImportMode {
+					m := graphql.TenantConfigurationImportModeDryRun
+					return &m
+				}(),
+			},
+		},
+		{
+			Name:           "returns error when the id field is missing",
+			Resolver:       accesspolicy.ApplicationByBundle,
+			IDField:        "bundleID",
+			Args:           map[string]interface{}{},
+			ExpectedErrMsg: "could not get idField: bundleID from request context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			mocks := newResourceResolverMocks()
+			if testCase.MocksFn != nil {
+				testCase.MocksFn(mocks)
+			}
+			defer mocks.assertExpectations(t)
+
+			resolver, ok := mocks.resolvers(testCase.ApplicationLabelsFunc)[testCase.Resolver]
+			require.True(t, ok)
+
+			// WHEN
+			refs, err := resolver.Resolve(ctx, tenantID, testCase.Args, testCase.IDField)
+
+			// THEN
+			if testCase.ExpectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedRefs, refs)
+		})
+	}
+}
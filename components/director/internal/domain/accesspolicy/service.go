@@ -0,0 +1,59 @@
+package accesspolicy
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+type service struct {
+	evaluator           *Evaluator
+	attributesProviders map[string]AttributesProvider
+}
+
+// NewService returns a new access policy service which uses the given attributes providers by resource type
+func NewService(evaluator *Evaluator, attributesProviders map[string]AttributesProvider) *service {
+	return &service{
+		evaluator:           evaluator,
+		attributesProviders: attributesProviders,
+	}
+}
+
+// IsRestricted returns true if any access policy applies to the request, i.e. the request has to be evaluated
+func (s *service) IsRestricted(req Request) bool {
+	return s.evaluator.HasApplicablePolicies(req)
+}
+
+// Evaluate evaluates the request against all access policies using the attributes of the referenced resource.
+// If the operation changes the labels of the resource, both its current and its resulting labels have to be allowed.
+// Resources which do not exist yet are evaluated only against the labels they are created with.
+func (s *service) Evaluate(ctx context.Context, req Request, ref ResourceRef) (*Decision, error) {
+	var current map[string]interface{}
+	if !ref.New {
+		attributes, err := s.getAttributes(ctx, req, ref)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Attributes = attributes
+		decision := s.evaluator.Evaluate(req)
+		if !decision.Allowed || !ref.changesLabels() {
+			return &decision, nil
+		}
+		current = attributes
+	}
+
+	req.Attributes = ref.resultingAttributes(current)
+	decision := s.evaluator.Evaluate(req)
+	return &decision, nil
+}
+
+func (s *service) getAttributes(ctx context.Context, req Request, ref ResourceRef) (map[string]interface{}, error) {
+	provider, ok := s.attributesProviders[req.ResourceType]
+	if !ok {
+		log.C(ctx).Debugf("No attributes provider for resource type %q. Evaluating the access policies without resource attributes...", req.ResourceType)
+		return nil, nil
+	}
+
+	return provider.GetAttributes(ctx, req.TenantID, ref)
+}
@@ -0,0 +1,129 @@
+package accesspolicy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Evaluate(t *testing.T) {
+	ctx := context.TODO()
+	evaluator := accesspolicy.NewEvaluator([]config.AccessPolicy{s4AllowPolicy})
+	ref := accesspolicy.ResourceRef{ID: appID}
+
+	t.Run("evaluates the request with the attributes of the resource", func(t *testing.T) {
+		// GIVEN
+		provider := &automock.AttributesProvider{}
+		provider.On("GetAttributes", ctx, tenantID, ref).Return(map[string]interface{}{applicationType: s4Type}, nil).Once()
+		defer provider.AssertExpectations(t)
+		svc := accesspolicy.NewService(evaluator, map[string]accesspolicy.AttributesProvider{"application": provider})
+
+		// WHEN
+		decision, err := svc.Evaluate(ctx, fixRequest(intSystemID, "application", "update", nil), ref)
+
+		// THEN
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+	})
+
+	t.Run("evaluates the request without attributes when there is no provider for the resource type", func(t *testing.T) {
+		// GIVEN
+		svc := accesspolicy.NewService(evaluator, map[string]accesspolicy.AttributesProvider{})
+
+		// WHEN
+		decision, err := svc.Evaluate(ctx, fixRequest(intSystemID, "application", "update", nil), ref)
+
+		// THEN
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+	})
+
+	t.Run("denies the request when the resulting labels of the resource are not allowed", func(t *testing.T) {
+		// GIVEN
+		provider := &automock.AttributesProvider{}
+		provider.On("GetAttributes", ctx, tenantID, mock.Anything).Return(map[string]interface{}{applicationType: s4Type}, nil).Twice()
+		defer provider.AssertExpectations(t)
+		svc := accesspolicy.NewService(evaluator, map[string]accesspolicy.AttributesProvider{"application": provider})
+
+		// WHEN
+		setDecision, err := svc.Evaluate(ctx, fixRequest(intSystemID, "application", "update", nil), accesspolicy.ResourceRef{ID: appID, Labels: map[string]interface{}{applicationType: "other"}})
+		require.NoError(t, err)
+		deleteDecision, err := svc.Evaluate(ctx, fixRequest(intSystemID, "application", "update", nil), accesspolicy.ResourceRef{ID: appID, RemovedLabels: []string{applicationType}})
+		require.NoError(t, err)
+
+		// THEN
+		assert.False(t, setDecision.Allowed)
+		assert.False(t, deleteDecision.Allowed)
+	})
+
+	t.Run("denies the request when the current labels of the resource are not allowed", func(t *testing.T) {
+		// GIVEN
+		provider := &automock.AttributesProvider{}
+		provider.On("GetAttributes", ctx, tenantID, mock.Anything).Return(map[string]interface{}{applicationType: "other"}, nil).Once()
+		defer provider.AssertExpectations(t)
+		svc := accesspolicy.NewService(evaluator, map[string]accesspolicy.AttributesProvider{"application": provider})
+
+		// WHEN
+		decision, err := svc.Evaluate(ctx, fixRequest(intSystemID, "application", "update", nil), accesspolicy.ResourceRef{ID: appID, Labels: map[string]interface{}{applicationType: s4Type}})
+
+		// THEN
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+	})
+
+	t.Run("evaluates the replaced labels of the resource", func(t *testing.T) {
+		// GIVEN
+		provider := &automock.AttributesProvider{}
+		provider.On("GetAttributes", ctx, tenantID, mock.Anything).Return(map[string]interface{}{applicationType: s4Type}, nil).Once()
+		defer provider.AssertExpectations(t)
+		svc := accesspolicy.NewService(evaluator, map[string]accesspolicy.AttributesProvider{"application": provider})
+
+		// WHEN
+		decision, err := svc.Evaluate(ctx, fixRequest(intSystemID, "application", "update", nil), accesspolicy.ResourceRef{ID: appID, Labels: map[string]interface{}{"other": "label"}, ReplaceLabels: true})
+
+		// THEN
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+	})
+
+	t.Run("evaluates new resources only against the labels they are created with", func(t *testing.T) {
+		// GIVEN
+		provider := &automock.AttributesProvider{}
+		defer provider.AssertExpectations(t)
+		svc := accesspolicy.NewService(evaluator, map[string]accesspolicy.AttributesProvider{"application": provider})
+
+		// WHEN
+		decision, err := svc.Evaluate(ctx, fixRequest(intSystemID, "application", "delete", nil), accesspolicy.ResourceRef{New: true, Labels: map[string]interface{}{applicationType: s4Type}})
+
+		// THEN
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+	})
+
+	t.Run("returns error when getting the attributes fails", func(t *testing.T) {
+		// GIVEN
+		provider := &automock.AttributesProvider{}
+		provider.On("GetAttributes", ctx, tenantID, ref).Return(nil, testErr).Once()
+		defer provider.AssertExpectations(t)
+		svc := accesspolicy.NewService(evaluator, map[string]accesspolicy.AttributesProvider{"application": provider})
+
+		// WHEN
+		_, err := svc.Evaluate(ctx, fixRequest(intSystemID, "application", "update", nil), ref)
+
+		// THEN
+		require.EqualError(t, err, testErr.Error())
+	})
+}
+
+func TestService_IsRestricted(t *testing.T) {
+	svc := accesspolicy.NewService(accesspolicy.NewEvaluator([]config.AccessPolicy{s4AllowPolicy}), nil)
+
+	assert.True(t, svc.IsRestricted(fixRequest(intSystemID, "application", "delete", nil)))
+	assert.False(t, svc.IsRestricted(fixRequest(intSystemID, "application", "create", nil)))
+}
@@ -18,10 +18,6 @@ type APIDefinitionService struct {
 func (_m *APIDefinitionService) GetForApplication(ctx context.Context, id string, appID string) (*model.APIDefinition, error) {
 	ret := _m.Called(ctx, id, appID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetForApplication")
-	}
-
 	var r0 *model.APIDefinition
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.APIDefinition, error)); ok {
@@ -44,6 +40,32 @@ func (_m *APIDefinitionService) GetForApplication(ctx context.Context, id string
 	return r0, r1
 }
 
+// ListByApplicationID provides a mock function with given fields: ctx, appID
+func (_m *APIDefinitionService) ListByApplicationID(ctx context.Context, appID string) ([]*model.APIDefinition, error) {
+	ret := _m.Called(ctx, appID)
+
+	var r0 []*model.APIDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.APIDefinition, error)); ok {
+		return rf(ctx, appID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.APIDefinition); ok {
+		r0 = rf(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.APIDefinition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewAPIDefinitionService creates a new instance of APIDefinitionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAPIDefinitionService(t interface {
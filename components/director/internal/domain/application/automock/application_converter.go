@@ -5,10 +5,9 @@ package automock
 import (
 	context "context"
 
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	mock "github.com/stretchr/testify/mock"
-
-	model "github.com/kyma-incubator/compass/components/director/internal/model"
 )
 
 // ApplicationConverter is an autogenerated mock type for the ApplicationConverter type
@@ -20,10 +19,6 @@ type ApplicationConverter struct {
 func (_m *ApplicationConverter) CreateInputFromGraphQL(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error) {
 	ret := _m.Called(ctx, in)
 
-	if len(ret) == 0 {
-		panic("no return value specified for CreateInputFromGraphQL")
-	}
-
 	var r0 model.ApplicationRegisterInput
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error)); ok {
@@ -48,10 +43,6 @@ func (_m *ApplicationConverter) CreateInputFromGraphQL(ctx context.Context, in g
 func (_m *ApplicationConverter) GraphQLToModel(obj *graphql.Application, tenantID string) *model.Application {
 	ret := _m.Called(obj, tenantID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GraphQLToModel")
-	}
-
 	var r0 *model.Application
 	if rf, ok := ret.Get(0).(func(*graphql.Application, string) *model.Application); ok {
 		r0 = rf(obj, tenantID)
@@ -64,14 +55,26 @@ func (_m *ApplicationConverter) GraphQLToModel(obj *graphql.Application, tenantI
 	return r0
 }
 
-// MultipleToGraphQL provides a mock function with given fields: in
-func (_m *ApplicationConverter) MultipleToGraphQL(in []*model.Application) []*graphql.Application {
+// MergePreviewToGraphQL provides a mock function with given fields: in
+func (_m *ApplicationConverter) MergePreviewToGraphQL(in *model.ApplicationMergePreview) *graphql.ApplicationMergePreview {
 	ret := _m.Called(in)
 
-	if len(ret) == 0 {
-		panic("no return value specified for MultipleToGraphQL")
+	var r0 *graphql.ApplicationMergePreview
+	if rf, ok := ret.Get(0).(func(*model.ApplicationMergePreview) *graphql.ApplicationMergePreview); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.ApplicationMergePreview)
+		}
 	}
 
+	return r0
+}
+
+// MultipleToGraphQL provides a mock function with given fields: in
+func (_m *ApplicationConverter) MultipleToGraphQL(in []*model.Application) []*graphql.Application {
+	ret := _m.Called(in)
+
 	var r0 []*graphql.Application
 	if rf, ok := ret.Get(0).(func([]*model.Application) []*graphql.Application); ok {
 		r0 = rf(in)
@@ -88,10 +91,6 @@ func (_m *ApplicationConverter) MultipleToGraphQL(in []*model.Application) []*gr
 func (_m *ApplicationConverter) ToGraphQL(in *model.Application) *graphql.Application {
 	ret := _m.Called(in)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ToGraphQL")
-	}
-
 	var r0 *graphql.Application
 	if rf, ok := ret.Get(0).(func(*model.Application) *graphql.Application); ok {
 		r0 = rf(in)
@@ -108,10 +107,6 @@ func (_m *ApplicationConverter) ToGraphQL(in *model.Application) *graphql.Applic
 func (_m *ApplicationConverter) UpdateInputFromGraphQL(in graphql.ApplicationUpdateInput) model.ApplicationUpdateInput {
 	ret := _m.Called(in)
 
-	if len(ret) == 0 {
-		panic("no return value specified for UpdateInputFromGraphQL")
-	}
-
 	var r0 model.ApplicationUpdateInput
 	if rf, ok := ret.Get(0).(func(graphql.ApplicationUpdateInput) model.ApplicationUpdateInput); ok {
 		r0 = rf(in)
@@ -5,12 +5,10 @@ package automock
 import (
 	context "context"
 
+	uuid "github.com/google/uuid"
 	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
-	mock "github.com/stretchr/testify/mock"
-
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
-	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // ApplicationService is an autogenerated mock type for the ApplicationService type
@@ -22,10 +20,6 @@ type ApplicationService struct {
 func (_m *ApplicationService) Create(ctx context.Context, in model.ApplicationRegisterInput) (string, error) {
 	ret := _m.Called(ctx, in)
 
-	if len(ret) == 0 {
-		panic("no return value specified for Create")
-	}
-
 	var r0 string
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, model.ApplicationRegisterInput) (string, error)); ok {
@@ -50,10 +44,6 @@ func (_m *ApplicationService) Create(ctx context.Context, in model.ApplicationRe
 func (_m *ApplicationService) Delete(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
 
-	if len(ret) == 0 {
-		panic("no return value specified for Delete")
-	}
-
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
 		r0 = rf(ctx, id)
@@ -68,10 +58,6 @@ func (_m *ApplicationService) Delete(ctx context.Context, id string) error {
 func (_m *ApplicationService) DeleteLabel(ctx context.Context, applicationID string, key string) error {
 	ret := _m.Called(ctx, applicationID, key)
 
-	if len(ret) == 0 {
-		panic("no return value specified for DeleteLabel")
-	}
-
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
 		r0 = rf(ctx, applicationID, key)
@@ -86,10 +72,6 @@ func (_m *ApplicationService) DeleteLabel(ctx context.Context, applicationID str
 func (_m *ApplicationService) Get(ctx context.Context, id string) (*model.Application, error) {
 	ret := _m.Called(ctx, id)
 
-	if len(ret) == 0 {
-		panic("no return value specified for Get")
-	}
-
 	var r0 *model.Application
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Application, error)); ok {
@@ -116,10 +98,6 @@ func (_m *ApplicationService) Get(ctx context.Context, id string) (*model.Applic
 func (_m *ApplicationService) GetByLocalTenantIDAndAppTemplateID(ctx context.Context, localTenantID string, appTemplateID string) (*model.Application, error) {
 	ret := _m.Called(ctx, localTenantID, appTemplateID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetByLocalTenantIDAndAppTemplateID")
-	}
-
 	var r0 *model.Application
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Application, error)); ok {
@@ -146,10 +124,6 @@ func (_m *ApplicationService) GetByLocalTenantIDAndAppTemplateID(ctx context.Con
 func (_m *ApplicationService) GetBySystemNumber(ctx context.Context, systemNumber string) (*model.Application, error) {
 	ret := _m.Called(ctx, systemNumber)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetBySystemNumber")
-	}
-
 	var r0 *model.Application
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Application, error)); ok {
@@ -176,10 +150,6 @@ func (_m *ApplicationService) GetBySystemNumber(ctx context.Context, systemNumbe
 func (_m *ApplicationService) GetLabel(ctx context.Context, applicationID string, key string) (*model.Label, error) {
 	ret := _m.Called(ctx, applicationID, key)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetLabel")
-	}
-
 	var r0 *model.Label
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Label, error)); ok {
@@ -202,14 +172,24 @@ func (_m *ApplicationService) GetLabel(ctx context.Context, applicationID string
 	return r0, r1
 }
 
+// IsSoftDeleteEnabled provides a mock function with given fields: ctx
+func (_m *ApplicationService) IsSoftDeleteEnabled(ctx context.Context) bool {
+	ret := _m.Called(ctx)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // List provides a mock function with given fields: ctx, filter, pageSize, cursor
 func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	ret := _m.Called(ctx, filter, pageSize, cursor)
 
-	if len(ret) == 0 {
-		panic("no return value specified for List")
-	}
-
 	var r0 *model.ApplicationPage
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) (*model.ApplicationPage, error)); ok {
@@ -236,10 +216,6 @@ func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.La
 func (_m *ApplicationService) ListAll(ctx context.Context) ([]*model.Application, error) {
 	ret := _m.Called(ctx)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListAll")
-	}
-
 	var r0 []*model.Application
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.Application, error)); ok {
@@ -266,10 +242,6 @@ func (_m *ApplicationService) ListAll(ctx context.Context) ([]*model.Application
 func (_m *ApplicationService) ListAllGlobalByFilter(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationWithTenantsPage, error) {
 	ret := _m.Called(ctx, filter, pageSize, cursor)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListAllGlobalByFilter")
-	}
-
 	var r0 *model.ApplicationWithTenantsPage
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) (*model.ApplicationWithTenantsPage, error)); ok {
@@ -296,10 +268,6 @@ func (_m *ApplicationService) ListAllGlobalByFilter(ctx context.Context, filter
 func (_m *ApplicationService) ListByLocalTenantID(ctx context.Context, localTenantID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	ret := _m.Called(ctx, localTenantID, filter, pageSize, cursor)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListByLocalTenantID")
-	}
-
 	var r0 *model.ApplicationPage
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) (*model.ApplicationPage, error)); ok {
@@ -326,10 +294,6 @@ func (_m *ApplicationService) ListByLocalTenantID(ctx context.Context, localTena
 func (_m *ApplicationService) ListByRuntimeID(ctx context.Context, runtimeUUID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	ret := _m.Called(ctx, runtimeUUID, pageSize, cursor)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListByRuntimeID")
-	}
-
 	var r0 *model.ApplicationPage
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, string) (*model.ApplicationPage, error)); ok {
@@ -356,10 +320,6 @@ func (_m *ApplicationService) ListByRuntimeID(ctx context.Context, runtimeUUID u
 func (_m *ApplicationService) ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error) {
 	ret := _m.Called(ctx, applicationID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListLabels")
-	}
-
 	var r0 map[string]*model.Label
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]*model.Label, error)); ok {
@@ -386,10 +346,6 @@ func (_m *ApplicationService) ListLabels(ctx context.Context, applicationID stri
 func (_m *ApplicationService) ListLabelsGlobal(ctx context.Context, applicationID string) (map[string]*model.Label, error) {
 	ret := _m.Called(ctx, applicationID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListLabelsGlobal")
-	}
-
 	var r0 map[string]*model.Label
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]*model.Label, error)); ok {
@@ -416,10 +372,6 @@ func (_m *ApplicationService) ListLabelsGlobal(ctx context.Context, applicationI
 func (_m *ApplicationService) Merge(ctx context.Context, destID string, sourceID string) (*model.Application, error) {
 	ret := _m.Called(ctx, destID, sourceID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for Merge")
-	}
-
 	var r0 *model.Application
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Application, error)); ok {
@@ -442,14 +394,36 @@ func (_m *ApplicationService) Merge(ctx context.Context, destID string, sourceID
 	return r0, r1
 }
 
+// PreviewMerge provides a mock function with given fields: ctx, destID, sourceID
+func (_m *ApplicationService) PreviewMerge(ctx context.Context, destID string, sourceID string) (*model.ApplicationMergePreview, error) {
+	ret := _m.Called(ctx, destID, sourceID)
+
+	var r0 *model.ApplicationMergePreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.ApplicationMergePreview, error)); ok {
+		return rf(ctx, destID, sourceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ApplicationMergePreview); ok {
+		r0 = rf(ctx, destID, sourceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationMergePreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, destID, sourceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetLabel provides a mock function with given fields: ctx, label
 func (_m *ApplicationService) SetLabel(ctx context.Context, label *model.LabelInput) error {
 	ret := _m.Called(ctx, label)
 
-	if len(ret) == 0 {
-		panic("no return value specified for SetLabel")
-	}
-
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, *model.LabelInput) error); ok {
 		r0 = rf(ctx, label)
@@ -464,10 +438,6 @@ func (_m *ApplicationService) SetLabel(ctx context.Context, label *model.LabelIn
 func (_m *ApplicationService) Unpair(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
 
-	if len(ret) == 0 {
-		panic("no return value specified for Unpair")
-	}
-
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
 		r0 = rf(ctx, id)
@@ -482,10 +452,6 @@ func (_m *ApplicationService) Unpair(ctx context.Context, id string) error {
 func (_m *ApplicationService) Update(ctx context.Context, id string, in model.ApplicationUpdateInput) error {
 	ret := _m.Called(ctx, id, in)
 
-	if len(ret) == 0 {
-		panic("no return value specified for Update")
-	}
-
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, model.ApplicationUpdateInput) error); ok {
 		r0 = rf(ctx, id, in)
@@ -6,9 +6,8 @@ import (
 	context "context"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-	mock "github.com/stretchr/testify/mock"
-
 	resource "github.com/kyma-incubator/compass/components/director/pkg/resource"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // BundleService is an autogenerated mock type for the BundleService type
@@ -20,10 +19,6 @@ type BundleService struct {
 func (_m *BundleService) CreateMultiple(ctx context.Context, resourceType resource.Type, resourceID string, in []*model.BundleCreateInput) error {
 	ret := _m.Called(ctx, resourceType, resourceID, in)
 
-	if len(ret) == 0 {
-		panic("no return value specified for CreateMultiple")
-	}
-
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, resource.Type, string, []*model.BundleCreateInput) error); ok {
 		r0 = rf(ctx, resourceType, resourceID, in)
@@ -38,10 +33,6 @@ func (_m *BundleService) CreateMultiple(ctx context.Context, resourceType resour
 func (_m *BundleService) GetForApplication(ctx context.Context, id string, applicationID string) (*model.Bundle, error) {
 	ret := _m.Called(ctx, id, applicationID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetForApplication")
-	}
-
 	var r0 *model.Bundle
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Bundle, error)); ok {
@@ -64,14 +55,36 @@ func (_m *BundleService) GetForApplication(ctx context.Context, id string, appli
 	return r0, r1
 }
 
+// ListByApplicationIDNoPaging provides a mock function with given fields: ctx, appID
+func (_m *BundleService) ListByApplicationIDNoPaging(ctx context.Context, appID string) ([]*model.Bundle, error) {
+	ret := _m.Called(ctx, appID)
+
+	var r0 []*model.Bundle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.Bundle, error)); ok {
+		return rf(ctx, appID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Bundle); ok {
+		r0 = rf(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Bundle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByApplicationIDs provides a mock function with given fields: ctx, applicationIDs, pageSize, cursor
 func (_m *BundleService) ListByApplicationIDs(ctx context.Context, applicationIDs []string, pageSize int, cursor string) ([]*model.BundlePage, error) {
 	ret := _m.Called(ctx, applicationIDs, pageSize, cursor)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListByApplicationIDs")
-	}
-
 	var r0 []*model.BundlePage
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, []string, int, string) ([]*model.BundlePage, error)); ok {
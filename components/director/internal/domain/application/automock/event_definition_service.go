@@ -18,10 +18,6 @@ type EventDefinitionService struct {
 func (_m *EventDefinitionService) GetForApplication(ctx context.Context, id string, appID string) (*model.EventDefinition, error) {
 	ret := _m.Called(ctx, id, appID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetForApplication")
-	}
-
 	var r0 *model.EventDefinition
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.EventDefinition, error)); ok {
@@ -44,6 +40,32 @@ func (_m *EventDefinitionService) GetForApplication(ctx context.Context, id stri
 	return r0, r1
 }
 
+// ListByApplicationID provides a mock function with given fields: ctx, appID
+func (_m *EventDefinitionService) ListByApplicationID(ctx context.Context, appID string) ([]*model.EventDefinition, error) {
+	ret := _m.Called(ctx, appID)
+
+	var r0 []*model.EventDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.EventDefinition, error)); ok {
+		return rf(ctx, appID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.EventDefinition); ok {
+		r0 = rf(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.EventDefinition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewEventDefinitionService creates a new instance of EventDefinitionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEventDefinitionService(t interface {
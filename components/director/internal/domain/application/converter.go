@@ -146,6 +146,35 @@ func (c *converter) MultipleToGraphQL(in []*model.Application) []*graphql.Applic
 	return applications
 }
 
+// MergePreviewToGraphQL converts the model preview of an Application merge to its GraphQL representation
+func (c *converter) MergePreviewToGraphQL(in *model.ApplicationMergePreview) *graphql.ApplicationMergePreview {
+	if in == nil {
+		return nil
+	}
+
+	conflicts := make([]*graphql.ApplicationMergeConflict, 0, len(in.Conflicts))
+	for _, conflict := range in.Conflicts {
+		conflicts = append(conflicts, &graphql.ApplicationMergeConflict{
+			Type:             graphql.ApplicationMergeConflictType(conflict.Type),
+			Key:              conflict.Key,
+			DestinationValue: conflict.DestinationValue,
+			SourceValue:      conflict.SourceValue,
+		})
+	}
+
+	return &graphql.ApplicationMergePreview{
+		DestinationID:           in.DestinationID,
+		SourceID:                in.SourceID,
+		Labels:                  in.Labels,
+		Conflicts:               conflicts,
+		RemovedBundles:          make([]*graphql.ApplicationMergeResource, 0),
+		RemovedAPIDefinitions:   make([]*graphql.ApplicationMergeResource, 0),
+		RemovedEventDefinitions: make([]*graphql.ApplicationMergeResource, 0),
+		AffectedFormations:      in.AffectedFormations,
+		ValidationErrors:        in.ValidationErrors,
+	}
+}
+
 // CreateInputFromGraphQL missing godoc
 func (c *converter) CreateInputFromGraphQL(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error) {
 	var labels map[string]interface{}
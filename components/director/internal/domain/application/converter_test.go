@@ -89,6 +89,47 @@ func TestConverter_MultipleToGraphQL(t *testing.T) {
 	assert.Equal(t, expected, res)
 }
 
+func TestConverter_MergePreviewToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		input := &model.ApplicationMergePreview{
+			DestinationID: "foo",
+			SourceID:      "bar",
+			Labels:        map[string]interface{}{"region": "eu"},
+			Conflicts: []*model.ApplicationMergeConflict{
+				{Type: model.ApplicationMergeConflictTypeLabel, Key: "region", DestinationValue: "eu", SourceValue: "us"},
+			},
+			AffectedFormations: []string{"formation"},
+			ValidationErrors:   []string{"error"},
+		}
+		expected := &graphql.ApplicationMergePreview{
+			DestinationID: "foo",
+			SourceID:      "bar",
+			Labels:        graphql.Labels{"region": "eu"},
+			Conflicts: []*graphql.ApplicationMergeConflict{
+				{Type: graphql.ApplicationMergeConflictTypeLabel, Key: "region", DestinationValue: "eu", SourceValue: "us"},
+			},
+			RemovedBundles:          []*graphql.ApplicationMergeResource{},
+			RemovedAPIDefinitions:   []*graphql.ApplicationMergeResource{},
+			RemovedEventDefinitions: []*graphql.ApplicationMergeResource{},
+			AffectedFormations:      []string{"formation"},
+			ValidationErrors:        []string{"error"},
+		}
+
+		// WHEN
+		converter := application.NewConverter(nil, nil)
+		res := converter.MergePreviewToGraphQL(input)
+
+		// THEN
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("Returns nil for nil input", func(t *testing.T) {
+		converter := application.NewConverter(nil, nil)
+		assert.Nil(t, converter.MergePreviewToGraphQL(nil))
+	})
+}
+
 func TestConverter_CreateInputFromGraphQL(t *testing.T) {
 	allPropsInput := fixGQLApplicationRegisterInput("foo", "Lorem ipsum")
 	allPropsExpected := fixModelApplicationRegisterInput("foo", "Lorem ipsum")
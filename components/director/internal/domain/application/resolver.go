@@ -46,6 +46,7 @@ type ApplicationService interface {
 	Update(ctx context.Context, id string, in model.ApplicationUpdateInput) error
 	Get(ctx context.Context, id string) (*model.Application, error)
 	Delete(ctx context.Context, id string) error
+	IsSoftDeleteEnabled(ctx context.Context) bool
 	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
 	GetBySystemNumber(ctx context.Context, systemNumber string) (*model.Application, error)
 	ListByLocalTenantID(ctx context.Context, localTenantID string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
@@ -59,6 +60,7 @@ type ApplicationService interface {
 	DeleteLabel(ctx context.Context, applicationID string, key string) error
 	Unpair(ctx context.Context, id string) error
 	Merge(ctx context.Context, destID, sourceID string) (*model.Application, error)
+	PreviewMerge(ctx context.Context, destID, sourceID string) (*model.ApplicationMergePreview, error)
 	ListAllGlobalByFilter(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationWithTenantsPage, error)
 }
 
@@ -71,6 +73,7 @@ type ApplicationConverter interface {
 	CreateInputFromGraphQL(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error)
 	UpdateInputFromGraphQL(in graphql.ApplicationUpdateInput) model.ApplicationUpdateInput
 	GraphQLToModel(obj *graphql.Application, tenantID string) *model.Application
+	MergePreviewToGraphQL(in *model.ApplicationMergePreview) *graphql.ApplicationMergePreview
 }
 
 // ApplicationWithTenantsConverter is responsible for converting between graphql and model objects
@@ -141,6 +144,7 @@ type RuntimeService interface {
 type BundleService interface {
 	GetForApplication(ctx context.Context, id string, applicationID string) (*model.Bundle, error)
 	ListByApplicationIDs(ctx context.Context, applicationIDs []string, pageSize int, cursor string) ([]*model.BundlePage, error)
+	ListByApplicationIDNoPaging(ctx context.Context, appID string) ([]*model.Bundle, error)
 	CreateMultiple(ctx context.Context, resourceType resource.Type, resourceID string, in []*model.BundleCreateInput) error
 }
 
@@ -156,6 +160,7 @@ type OperationService interface {
 //go:generate mockery --name=APIDefinitionService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type APIDefinitionService interface {
 	GetForApplication(ctx context.Context, id string, appID string) (*model.APIDefinition, error)
+	ListByApplicationID(ctx context.Context, appID string) ([]*model.APIDefinition, error)
 }
 
 // EventDefinitionService missing godoc
@@ -163,6 +168,7 @@ type APIDefinitionService interface {
 //go:generate mockery --name=EventDefinitionService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type EventDefinitionService interface {
 	GetForApplication(ctx context.Context, id string, appID string) (*model.EventDefinition, error)
+	ListByApplicationID(ctx context.Context, appID string) ([]*model.EventDefinition, error)
 }
 
 // IntegrationDependencyService is responsible for the service-layer Integration Dependency operations
@@ -655,15 +661,18 @@ func (r *Resolver) UnregisterApplication(ctx context.Context, id string) (*graph
 		return nil, err
 	}
 
-	auths, err := r.sysAuthSvc.ListForObject(ctx, pkgmodel.ApplicationReference, app.ID)
-	if err != nil {
-		return nil, err
-	}
+	// Soft deleted applications keep their OAuth 2.0 clients until they are purged, so that they can be restored
+	if !r.appSvc.IsSoftDeleteEnabled(ctx) {
+		auths, err := r.sysAuthSvc.ListForObject(ctx, pkgmodel.ApplicationReference, app.ID)
+		if err != nil {
+			return nil, err
+		}
 
-	err = r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths)
-	if err != nil {
-		return nil, err
+		if err = r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths); err != nil {
+			return nil, err
+		}
 	}
+
 	err = r.appSvc.Delete(ctx, id)
 	if err != nil {
 		return nil, err
@@ -772,6 +781,54 @@ func (r *Resolver) MergeApplications(ctx context.Context, destID string, sourceI
 	return gqlApp, nil
 }
 
+// PreviewMergeApplications shows the outcome of merging the source application into the destination application without persisting anything
+func (r *Resolver) PreviewMergeApplications(ctx context.Context, destID string, sourceID string) (*graphql.ApplicationMergePreview, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	preview, err := r.appSvc.PreviewMerge(ctx, destID, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	bundles, err := r.bndlSvc.ListByApplicationIDNoPaging(ctx, sourceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing bundles for Application with id %s", sourceID)
+	}
+
+	apis, err := r.apiDefinitionSvc.ListByApplicationID(ctx, sourceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing API definitions for Application with id %s", sourceID)
+	}
+
+	events, err := r.eventDefinitionSvc.ListByApplicationID(ctx, sourceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing event definitions for Application with id %s", sourceID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	gqlPreview := r.appConverter.MergePreviewToGraphQL(preview)
+	for _, bndl := range bundles {
+		gqlPreview.RemovedBundles = append(gqlPreview.RemovedBundles, &graphql.ApplicationMergeResource{ID: bndl.ID, Name: bndl.Name})
+	}
+	for _, api := range apis {
+		gqlPreview.RemovedAPIDefinitions = append(gqlPreview.RemovedAPIDefinitions, &graphql.ApplicationMergeResource{ID: api.ID, Name: api.Name})
+	}
+	for _, event := range events {
+		gqlPreview.RemovedEventDefinitions = append(gqlPreview.RemovedEventDefinitions, &graphql.ApplicationMergeResource{ID: event.ID, Name: event.Name})
+	}
+
+	return gqlPreview, nil
+}
+
 // DeleteApplicationLabel missing godoc
 func (r *Resolver) DeleteApplicationLabel(ctx context.Context, applicationID string, key string) (*graphql.Label, error) {
 	tx, err := r.transact.Begin()
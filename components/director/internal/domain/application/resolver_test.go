@@ -291,6 +291,7 @@ func TestResolver_UnregisterApplication(t *testing.T) {
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("Get", context.TODO(), appID.String()).Return(modelApplication, nil).Once()
+				svc.On("IsSoftDeleteEnabled", context.TODO()).Return(false).Once()
 				svc.On("Delete", context.TODO(), appID.String()).Return(nil).Once()
 				return svc
 			},
@@ -318,12 +319,43 @@ func TestResolver_UnregisterApplication(t *testing.T) {
 			ExpectedApplication: gqlApplication,
 			ExpectedErr:         nil,
 		},
+		{
+			Name:            "Success when the application is soft deleted keeps its OAuth 2.0 clients",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", context.TODO(), appID.String()).Return(modelApplication, nil).Once()
+				svc.On("IsSoftDeleteEnabled", context.TODO()).Return(true).Once()
+				svc.On("Delete", context.TODO(), appID.String()).Return(nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("ToGraphQL", modelApplication).Return(gqlApplication).Once()
+				return conv
+			},
+			EventingSvcFn: func() *automock.EventingService {
+				svc := &automock.EventingService{}
+				svc.On("CleanupAfterUnregisteringApplication", context.TODO(), appID).Return(nil, nil).Once()
+				return svc
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			InputID:             appID.String(),
+			ExpectedApplication: gqlApplication,
+			ExpectedErr:         nil,
+		},
 		{
 			Name:            "Returns error when application deletion failed",
 			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("Get", context.TODO(), appID.String()).Return(modelApplication, nil).Once()
+				svc.On("IsSoftDeleteEnabled", context.TODO()).Return(false).Once()
 				svc.On("Delete", context.TODO(), appID.String()).Return(testErr).Once()
 				return svc
 			},
@@ -385,6 +417,7 @@ func TestResolver_UnregisterApplication(t *testing.T) {
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("Get", context.TODO(), appID.String()).Return(modelApplication, nil).Once()
+				svc.On("IsSoftDeleteEnabled", context.TODO()).Return(false).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.ApplicationConverter {
@@ -415,6 +448,7 @@ func TestResolver_UnregisterApplication(t *testing.T) {
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("Get", context.TODO(), appID.String()).Return(modelApplication, nil).Once()
+				svc.On("IsSoftDeleteEnabled", context.TODO()).Return(false).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.ApplicationConverter {
@@ -849,6 +883,224 @@ func TestResolver_MergeApplications(t *testing.T) {
 	}
 }
 
+func TestResolver_PreviewMergeApplications(t *testing.T) {
+	// GIVEN
+	srcAppID := "srcID"
+	destAppID := "destID"
+
+	modelPreview := &model.ApplicationMergePreview{DestinationID: destAppID, SourceID: srcAppID, AffectedFormations: []string{}, ValidationErrors: []string{}}
+	bundles := []*model.Bundle{{Name: "bundle", BaseEntity: &model.BaseEntity{ID: "bundleID"}}}
+	apis := []*model.APIDefinition{{Name: "api", BaseEntity: &model.BaseEntity{ID: "apiID"}}}
+	events := []*model.EventDefinition{{Name: "event", BaseEntity: &model.BaseEntity{ID: "eventID"}}}
+
+	gqlPreviewFn := func() *graphql.ApplicationMergePreview {
+		return &graphql.ApplicationMergePreview{
+			DestinationID:           destAppID,
+			SourceID:                srcAppID,
+			Conflicts:               []*graphql.ApplicationMergeConflict{},
+			RemovedBundles:          []*graphql.ApplicationMergeResource{},
+			RemovedAPIDefinitions:   []*graphql.ApplicationMergeResource{},
+			RemovedEventDefinitions: []*graphql.ApplicationMergeResource{},
+			AffectedFormations:      []string{},
+			ValidationErrors:        []string{},
+		}
+	}
+	expectedPreview := gqlPreviewFn()
+	expectedPreview.RemovedBundles = []*graphql.ApplicationMergeResource{{ID: "bundleID", Name: "bundle"}}
+	expectedPreview.RemovedAPIDefinitions = []*graphql.ApplicationMergeResource{{ID: "apiID", Name: "api"}}
+	expectedPreview.RemovedEventDefinitions = []*graphql.ApplicationMergeResource{{ID: "eventID", Name: "event"}}
+
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name                   string
+		TransactionerFn        func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn              func() *automock.ApplicationService
+		BundleServiceFn        func() *automock.BundleService
+		APIServiceFn           func() *automock.APIDefinitionService
+		EventServiceFn         func() *automock.EventDefinitionService
+		ApplicationConverterFn func() *automock.ApplicationConverter
+		ExpectedResult         *graphql.ApplicationMergePreview
+		ExpectedErrMessage     string
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("PreviewMerge", txtest.CtxWithDBMatcher(), destAppID, srcAppID).Return(modelPreview, nil).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), srcAppID).Return(bundles, nil).Once()
+				return svc
+			},
+			APIServiceFn: func() *automock.APIDefinitionService {
+				svc := &automock.APIDefinitionService{}
+				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), srcAppID).Return(apis, nil).Once()
+				return svc
+			},
+			EventServiceFn: func() *automock.EventDefinitionService {
+				svc := &automock.EventDefinitionService{}
+				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), srcAppID).Return(events, nil).Once()
+				return svc
+			},
+			ApplicationConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("MergePreviewToGraphQL", modelPreview).Return(gqlPreviewFn()).Once()
+				return conv
+			},
+			ExpectedResult: expectedPreview,
+		},
+		{
+			Name:            "Returns error when PreviewMerge fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("PreviewMerge", txtest.CtxWithDBMatcher(), destAppID, srcAppID).Return(nil, testErr).Once()
+				return svc
+			},
+			BundleServiceFn:        func() *automock.BundleService { return &automock.BundleService{} },
+			APIServiceFn:           func() *automock.APIDefinitionService { return &automock.APIDefinitionService{} },
+			EventServiceFn:         func() *automock.EventDefinitionService { return &automock.EventDefinitionService{} },
+			ApplicationConverterFn: func() *automock.ApplicationConverter { return &automock.ApplicationConverter{} },
+			ExpectedErrMessage:     testErr.Error(),
+		},
+		{
+			Name:            "Returns error when listing bundles fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("PreviewMerge", txtest.CtxWithDBMatcher(), destAppID, srcAppID).Return(modelPreview, nil).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), srcAppID).Return(nil, testErr).Once()
+				return svc
+			},
+			APIServiceFn:           func() *automock.APIDefinitionService { return &automock.APIDefinitionService{} },
+			EventServiceFn:         func() *automock.EventDefinitionService { return &automock.EventDefinitionService{} },
+			ApplicationConverterFn: func() *automock.ApplicationConverter { return &automock.ApplicationConverter{} },
+			ExpectedErrMessage:     "while listing bundles for Application with id srcID",
+		},
+		{
+			Name:            "Returns error when listing API definitions fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("PreviewMerge", txtest.CtxWithDBMatcher(), destAppID, srcAppID).Return(modelPreview, nil).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), srcAppID).Return(bundles, nil).Once()
+				return svc
+			},
+			APIServiceFn: func() *automock.APIDefinitionService {
+				svc := &automock.APIDefinitionService{}
+				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), srcAppID).Return(nil, testErr).Once()
+				return svc
+			},
+			EventServiceFn:         func() *automock.EventDefinitionService { return &automock.EventDefinitionService{} },
+			ApplicationConverterFn: func() *automock.ApplicationConverter { return &automock.ApplicationConverter{} },
+			ExpectedErrMessage:     "while listing API definitions for Application with id srcID",
+		},
+		{
+			Name:            "Returns error when listing event definitions fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("PreviewMerge", txtest.CtxWithDBMatcher(), destAppID, srcAppID).Return(modelPreview, nil).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), srcAppID).Return(bundles, nil).Once()
+				return svc
+			},
+			APIServiceFn: func() *automock.APIDefinitionService {
+				svc := &automock.APIDefinitionService{}
+				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), srcAppID).Return(apis, nil).Once()
+				return svc
+			},
+			EventServiceFn: func() *automock.EventDefinitionService {
+				svc := &automock.EventDefinitionService{}
+				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), srcAppID).Return(nil, testErr).Once()
+				return svc
+			},
+			ApplicationConverterFn: func() *automock.ApplicationConverter { return &automock.ApplicationConverter{} },
+			ExpectedErrMessage:     "while listing event definitions for Application with id srcID",
+		},
+		{
+			Name:                   "Returns error when beginning transaction fails",
+			TransactionerFn:        txGen.ThatFailsOnBegin,
+			ServiceFn:              func() *automock.ApplicationService { return &automock.ApplicationService{} },
+			BundleServiceFn:        func() *automock.BundleService { return &automock.BundleService{} },
+			APIServiceFn:           func() *automock.APIDefinitionService { return &automock.APIDefinitionService{} },
+			EventServiceFn:         func() *automock.EventDefinitionService { return &automock.EventDefinitionService{} },
+			ApplicationConverterFn: func() *automock.ApplicationConverter { return &automock.ApplicationConverter{} },
+			ExpectedErrMessage:     testErr.Error(),
+		},
+		{
+			Name:            "Returns error when committing transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("PreviewMerge", txtest.CtxWithDBMatcher(), destAppID, srcAppID).Return(modelPreview, nil).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), srcAppID).Return(bundles, nil).Once()
+				return svc
+			},
+			APIServiceFn: func() *automock.APIDefinitionService {
+				svc := &automock.APIDefinitionService{}
+				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), srcAppID).Return(apis, nil).Once()
+				return svc
+			},
+			EventServiceFn: func() *automock.EventDefinitionService {
+				svc := &automock.EventDefinitionService{}
+				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), srcAppID).Return(events, nil).Once()
+				return svc
+			},
+			ApplicationConverterFn: func() *automock.ApplicationConverter { return &automock.ApplicationConverter{} },
+			ExpectedErrMessage:     testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			bndlSvc := testCase.BundleServiceFn()
+			apiSvc := testCase.APIServiceFn()
+			eventSvc := testCase.EventServiceFn()
+			converter := testCase.ApplicationConverterFn()
+
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, nil, bndlSvc, nil, nil, apiSvc, eventSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+
+			// WHEN
+			result, err := resolver.PreviewMergeApplications(context.TODO(), destAppID, srcAppID)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				assert.Nil(t, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, bndlSvc, apiSvc, eventSvc, converter)
+		})
+	}
+}
+
 func TestResolver_ApplicationBySystemNumber(t *testing.T) {
 	// GIVEN
 	systemNumber := "18"
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/filtersanitizer"
@@ -127,6 +129,13 @@ type FormationService interface {
 	UnassignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation, ignoreASA bool) (*model.Formation, error)
 }
 
+// SoftDeleteService is responsible for soft deleting Applications when soft delete is enabled
+//
+//go:generate mockery --name=SoftDeleteService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SoftDeleteService interface {
+	SoftDelete(ctx context.Context, resourceType resource.Type, id string) error
+}
+
 // RuntimeRepository missing godoc
 //
 //go:generate mockery --name=RuntimeRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -193,10 +202,12 @@ type service struct {
 	selfRegisterDistinguishLabelKey string
 
 	ordWebhookMapping []ORDWebhookMapping
+
+	softDeleteService SoftDeleteService
 }
 
 // NewService missing godoc
-func NewService(appNameNormalizer normalizer.Normalizator, appHideCfgProvider ApplicationHideCfgProvider, app ApplicationRepository, webhook WebhookRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, intSystemRepo IntegrationSystemRepository, labelService LabelService, bndlService BundleService, uidService UIDService, formationService FormationService, selfRegisterDistinguishLabelKey string, ordWebhookMapping []ORDWebhookMapping) *service {
+func NewService(appNameNormalizer normalizer.Normalizator, appHideCfgProvider ApplicationHideCfgProvider, app ApplicationRepository, webhook WebhookRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, intSystemRepo IntegrationSystemRepository, labelService LabelService, bndlService BundleService, uidService UIDService, formationService FormationService, selfRegisterDistinguishLabelKey string, ordWebhookMapping []ORDWebhookMapping, softDeleteService SoftDeleteService) *service {
 	return &service{
 		appNameNormalizer:               appNameNormalizer,
 		appHideCfgProvider:              appHideCfgProvider,
@@ -213,6 +224,7 @@ func NewService(appNameNormalizer normalizer.Normalizator, appHideCfgProvider Ap
 		filterSanitizer:                 &filtersanitizer.FilterSanitizer{},
 		selfRegisterDistinguishLabelKey: selfRegisterDistinguishLabelKey,
 		ordWebhookMapping:               ordWebhookMapping,
+		softDeleteService:               softDeleteService,
 	}
 }
 
@@ -673,6 +685,48 @@ func (s *service) Update(ctx context.Context, id string, in model.ApplicationUpd
 	return nil
 }
 
+// Rename changes the name of the Application with the given ID and its normalized name label.
+// Same as on creation, the normalized name has to be unique for the tenant.
+func (s *service) Rename(ctx context.Context, id, name string) error {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	app, err := s.Get(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "while getting Application with id %s", id)
+	}
+
+	if app.Name == name {
+		return nil
+	}
+
+	applications, err := s.appRepo.ListAll(ctx, appTenant)
+	if err != nil {
+		return err
+	}
+
+	normalizedName := s.appNameNormalizer.Normalize(name)
+	for _, existing := range applications {
+		if existing.ID != app.ID && normalizedName == s.appNameNormalizer.Normalize(existing.Name) && str.PtrStrToStr(existing.SystemNumber) == str.PtrStrToStr(app.SystemNumber) {
+			return apperrors.NewNotUniqueNameError(resource.Application)
+		}
+	}
+
+	app.Name = name
+	app.SetUpdatedAt(s.timestampGen())
+	if err = s.appRepo.Update(ctx, appTenant, app); err != nil {
+		return errors.Wrapf(err, "while updating Application with id %s", id)
+	}
+
+	if err = s.SetLabel(ctx, createLabel(nameKey, normalizedName, app.ID)); err != nil {
+		return errors.Wrap(err, "while setting application name label")
+	}
+
+	return nil
+}
+
 // Upsert persists application or update it if it already exists
 func (s *service) Upsert(ctx context.Context, in model.ApplicationRegisterInput) error {
 	tenant, err := tenant.LoadFromContext(ctx)
@@ -775,8 +829,18 @@ func (s *service) TrustedUpsertFromTemplate(ctx context.Context, in model.Applic
 	return s.genericUpsert(ctx, tenant, in, upserterFunc)
 }
 
-// Delete missing godoc
+// Delete deletes the Application. When soft delete is enabled and the operation mode is sync, the Application is soft deleted and can be restored until its retention period ends.
 func (s *service) Delete(ctx context.Context, id string) error {
+	return s.delete(ctx, id, s.IsSoftDeleteEnabled(ctx))
+}
+
+// IsSoftDeleteEnabled returns whether Delete soft deletes the Applications in the given context.
+// In that case the cleanup which cannot be reverted on restore is postponed until the Application is purged.
+func (s *service) IsSoftDeleteEnabled(ctx context.Context) bool {
+	return s.softDeleteService != nil && operation.ModeFromCtx(ctx) == graphql.OperationModeSync
+}
+
+func (s *service) delete(ctx context.Context, id string, softDelete bool) error {
 	appTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return errors.Wrapf(err, "while loading tenant from context")
@@ -786,6 +850,13 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	if softDelete {
+		if err = s.softDeleteService.SoftDelete(ctx, resource.Application, id); err != nil {
+			return errors.Wrapf(err, "while soft deleting Application with id %s", id)
+		}
+		return nil
+	}
+
 	err = s.appRepo.Delete(ctx, appTenant, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting Application with id %s", id)
@@ -987,18 +1058,16 @@ func (s *service) Merge(ctx context.Context, destID, srcID string) (*model.Appli
 		srcAppLabels = make(map[string]*model.Label)
 	}
 
-	srcBaseURL := strings.TrimSuffix(str.PtrStrToStr(srcApp.BaseURL), urlSuffixToBeTrimmed)
-	destBaseURL := strings.TrimSuffix(str.PtrStrToStr(destApp.BaseURL), urlSuffixToBeTrimmed)
-	if len(srcBaseURL) == 0 || len(destBaseURL) == 0 || srcBaseURL != destBaseURL {
-		return nil, errors.Errorf("BaseURL for applications %s and %s are not the same. Destination app BaseURL: %s. Source app BaseURL: %s", destID, srcID, destBaseURL, srcBaseURL)
+	if err := validateMergeBaseURLs(destID, srcID, destApp, srcApp); err != nil {
+		return nil, err
 	}
 
-	srcTemplateID := str.PtrStrToStr(srcApp.ApplicationTemplateID)
-	destTemplateID := str.PtrStrToStr(destApp.ApplicationTemplateID)
-	if len(srcTemplateID) == 0 || len(destTemplateID) == 0 || srcTemplateID != destTemplateID {
-		return nil, errors.Errorf("Application templates are not the same. Destination app template: %s. Source app template: %s", destTemplateID, srcTemplateID)
+	if err := validateMergeTemplates(destApp, srcApp); err != nil {
+		return nil, err
 	}
 
+	srcTemplateID := str.PtrStrToStr(srcApp.ApplicationTemplateID)
+
 	appTemplateLabels, err := s.labelRepo.ListForObject(ctx, appTenant, model.AppTemplateLabelableObject, srcTemplateID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting labels for app template with id %s", srcTemplateID)
@@ -1008,12 +1077,8 @@ func (s *service) Merge(ctx context.Context, destID, srcID string) (*model.Appli
 		log.C(ctx).Infof("applications should not be merged, because an application template with id %s has label %s", srcTemplateID, s.selfRegisterDistinguishLabelKey)
 		return nil, errors.Errorf("app template: %s has label %s", srcTemplateID, s.selfRegisterDistinguishLabelKey)
 	}
-	if srcApp.Status == nil {
-		return nil, errors.Errorf("Could not determine status of source application with id %s", srcID)
-	}
-
-	if srcApp.Status.Condition != model.ApplicationStatusConditionInitial {
-		return nil, errors.Errorf("Cannot merge application with id %s, because it is in a %s status", srcID, model.ApplicationStatusConditionConnected)
+	if err := validateMergeSourceStatus(srcID, srcApp); err != nil {
+		return nil, err
 	}
 
 	log.C(ctx).Infof("Merging applications with ids %s and %s", destID, srcID)
@@ -1027,8 +1092,9 @@ func (s *service) Merge(ctx context.Context, destID, srcID string) (*model.Appli
 		return nil, errors.Wrapf(err, "while trying to merge labels for applications with ids %s and %s", destID, srcID)
 	}
 
+	// The source application is merged into the destination one, so it is deleted permanently instead of being left restorable
 	log.C(ctx).Infof("Deleting source application with id %s", srcID)
-	if err := s.Delete(ctx, srcID); err != nil {
+	if err := s.delete(ctx, srcID, false); err != nil {
 		return nil, err
 	}
 
@@ -1044,6 +1110,176 @@ func (s *service) Merge(ctx context.Context, destID, srcID string) (*model.Appli
 	return s.appRepo.GetByID(ctx, appTenant, destID)
 }
 
+// PreviewMerge computes the outcome of merging the Source Application into the Destination Application the same way Merge does,
+// without persisting anything. The reasons for which Merge would fail are collected as validation errors instead of being returned.
+func (s *service) PreviewMerge(ctx context.Context, destID, srcID string) (*model.ApplicationMergePreview, error) {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	srcApp, err := s.Get(ctx, srcID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting source application")
+	}
+
+	destApp, err := s.Get(ctx, destID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting destination application")
+	}
+
+	preview := &model.ApplicationMergePreview{
+		DestinationID:      destID,
+		SourceID:           srcID,
+		AffectedFormations: make([]string, 0),
+		ValidationErrors:   make([]string, 0),
+	}
+
+	formations, err := s.formationService.ListFormationsForObjectGlobal(ctx, srcID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formations for Application with ID %s", srcID)
+	}
+	for _, formation := range formations {
+		preview.AffectedFormations = append(preview.AffectedFormations, formation.Name)
+	}
+	if len(preview.AffectedFormations) > 0 {
+		preview.ValidationErrors = append(preview.ValidationErrors, partOfFormationsMessage(srcApp.Name, preview.AffectedFormations))
+	}
+
+	if err := validateMergeBaseURLs(destID, srcID, destApp, srcApp); err != nil {
+		preview.ValidationErrors = append(preview.ValidationErrors, err.Error())
+	}
+
+	if err := validateMergeTemplates(destApp, srcApp); err != nil {
+		preview.ValidationErrors = append(preview.ValidationErrors, err.Error())
+	} else {
+		srcTemplateID := str.PtrStrToStr(srcApp.ApplicationTemplateID)
+		appTemplateLabels, err := s.labelRepo.ListForObject(ctx, appTenant, model.AppTemplateLabelableObject, srcTemplateID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while getting labels for app template with id %s", srcTemplateID)
+		}
+		if _, exists := appTemplateLabels[s.selfRegisterDistinguishLabelKey]; exists {
+			preview.ValidationErrors = append(preview.ValidationErrors, fmt.Sprintf("app template: %s has label %s", srcTemplateID, s.selfRegisterDistinguishLabelKey))
+		}
+	}
+
+	if err := validateMergeSourceStatus(srcID, srcApp); err != nil {
+		preview.ValidationErrors = append(preview.ValidationErrors, err.Error())
+	}
+
+	destAppLabels, err := s.labelRepo.ListForObject(ctx, appTenant, model.ApplicationLabelableObject, destID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting labels for Application with id %s", destID)
+	}
+
+	srcAppLabels, err := s.labelRepo.ListForObject(ctx, appTenant, model.ApplicationLabelableObject, srcID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting labels for Application with id %s", srcID)
+	}
+
+	if destAppLabels == nil {
+		destAppLabels = make(map[string]*model.Label)
+	}
+
+	if srcAppLabels == nil {
+		srcAppLabels = make(map[string]*model.Label)
+	}
+
+	// The conflicts are computed before the labels are merged, as merging modifies the destination labels
+	preview.Conflicts = append(mergePropertyConflicts(destApp, srcApp), mergeLabelConflicts(destAppLabels, srcAppLabels)...)
+
+	preview.Labels, err = s.handleMergeLabels(ctx, srcAppLabels, destAppLabels)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while trying to merge labels for applications with ids %s and %s", destID, srcID)
+	}
+
+	return preview, nil
+}
+
+func validateMergeBaseURLs(destID, srcID string, destApp, srcApp *model.Application) error {
+	srcBaseURL := strings.TrimSuffix(str.PtrStrToStr(srcApp.BaseURL), urlSuffixToBeTrimmed)
+	destBaseURL := strings.TrimSuffix(str.PtrStrToStr(destApp.BaseURL), urlSuffixToBeTrimmed)
+	if len(srcBaseURL) == 0 || len(destBaseURL) == 0 || srcBaseURL != destBaseURL {
+		return errors.Errorf("BaseURL for applications %s and %s are not the same. Destination app BaseURL: %s. Source app BaseURL: %s", destID, srcID, destBaseURL, srcBaseURL)
+	}
+
+	return nil
+}
+
+func validateMergeTemplates(destApp, srcApp *model.Application) error {
+	srcTemplateID := str.PtrStrToStr(srcApp.ApplicationTemplateID)
+	destTemplateID := str.PtrStrToStr(destApp.ApplicationTemplateID)
+	if len(srcTemplateID) == 0 || len(destTemplateID) == 0 || srcTemplateID != destTemplateID {
+		return errors.Errorf("Application templates are not the same. Destination app template: %s. Source app template: %s", destTemplateID, srcTemplateID)
+	}
+
+	return nil
+}
+
+func validateMergeSourceStatus(srcID string, srcApp *model.Application) error {
+	if srcApp.Status == nil {
+		return errors.Errorf("Could not determine status of source application with id %s", srcID)
+	}
+
+	if srcApp.Status.Condition != model.ApplicationStatusConditionInitial {
+		return errors.Errorf("Cannot merge application with id %s, because it is in a %s status", srcID, model.ApplicationStatusConditionConnected)
+	}
+
+	return nil
+}
+
+// mergePropertyConflicts returns the properties which are set to different values in both applications. Merging keeps the destination values.
+func mergePropertyConflicts(destApp, srcApp *model.Application) []*model.ApplicationMergeConflict {
+	conflicts := make([]*model.ApplicationMergeConflict, 0)
+	if destApp.Name != "" && srcApp.Name != "" && destApp.Name != srcApp.Name {
+		conflicts = append(conflicts, &model.ApplicationMergeConflict{Type: model.ApplicationMergeConflictTypeProperty, Key: "name", DestinationValue: destApp.Name, SourceValue: srcApp.Name})
+	}
+
+	properties := []struct {
+		key       string
+		destValue *string
+		srcValue  *string
+	}{
+		{key: "providerName", destValue: destApp.ProviderName, srcValue: srcApp.ProviderName},
+		{key: "description", destValue: destApp.Description, srcValue: srcApp.Description},
+		{key: "healthCheckURL", destValue: destApp.HealthCheckURL, srcValue: srcApp.HealthCheckURL},
+		{key: "integrationSystemID", destValue: destApp.IntegrationSystemID, srcValue: srcApp.IntegrationSystemID},
+		{key: "systemNumber", destValue: destApp.SystemNumber, srcValue: srcApp.SystemNumber},
+		{key: "localTenantID", destValue: destApp.LocalTenantID, srcValue: srcApp.LocalTenantID},
+		{key: "applicationNamespace", destValue: destApp.ApplicationNamespace, srcValue: srcApp.ApplicationNamespace},
+		{key: "systemStatus", destValue: destApp.SystemStatus, srcValue: srcApp.SystemStatus},
+	}
+	for _, property := range properties {
+		if property.destValue != nil && property.srcValue != nil && *property.destValue != *property.srcValue {
+			conflicts = append(conflicts, &model.ApplicationMergeConflict{Type: model.ApplicationMergeConflictTypeProperty, Key: property.key, DestinationValue: *property.destValue, SourceValue: *property.srcValue})
+		}
+	}
+
+	return conflicts
+}
+
+// mergeLabelConflicts returns the labels which are set to different values in both applications. Merging keeps the destination values.
+// The scenarios and managed labels are not reported, as they are handled separately by handleMergeLabels.
+func mergeLabelConflicts(destAppLabels, srcAppLabels map[string]*model.Label) []*model.ApplicationMergeConflict {
+	conflicts := make([]*model.ApplicationMergeConflict, 0)
+	for key, srcLabel := range srcAppLabels {
+		if key == model.ScenariosKey || key == ManagedLabelKey {
+			continue
+		}
+		destLabel, ok := destAppLabels[key]
+		if !ok || reflect.DeepEqual(destLabel.Value, srcLabel.Value) {
+			continue
+		}
+		conflicts = append(conflicts, &model.ApplicationMergeConflict{Type: model.ApplicationMergeConflictTypeLabel, Key: key, DestinationValue: destLabel.Value, SourceValue: srcLabel.Value})
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Key < conflicts[j].Key
+	})
+
+	return conflicts
+}
+
 // handleMergeLabels merges source labels into destination labels. ManagedLabelKey label is merged manually.
 // It is updated only if the source or destination label have a value "true"
 func (s *service) handleMergeLabels(ctx context.Context, srcAppLabels, destAppLabels map[string]*model.Label) (map[string]interface{}, error) {
@@ -1062,7 +1298,9 @@ func (s *service) handleMergeLabels(ctx context.Context, srcAppLabels, destAppLa
 		return nil, errors.Wrapf(err, "while trying to merge labels")
 	}
 
-	destAppLabels[model.ScenariosKey].Value = destScenariosStrSlice
+	if scenarios, ok := destAppLabels[model.ScenariosKey]; ok {
+		scenarios.Value = destScenariosStrSlice
+	}
 
 	srcLabelManaged, ok := srcAppLabels[ManagedLabelKey]
 	if !ok {
@@ -1150,13 +1388,16 @@ func (s *service) ensureApplicationNotPartOfAnyScenario(ctx context.Context, ten
 			formationNames = append(formationNames, formation.Name)
 		}
 
-		msg := fmt.Sprintf("System %s is part of the following formations : %s", application.Name, strings.Join(formationNames, ", "))
-		return apperrors.NewInvalidOperationError(msg)
+		return apperrors.NewInvalidOperationError(partOfFormationsMessage(application.Name, formationNames))
 	}
 
 	return nil
 }
 
+func partOfFormationsMessage(appName string, formationNames []string) string {
+	return fmt.Sprintf("System %s is part of the following formations : %s", appName, strings.Join(formationNames, ", "))
+}
+
 func (s *service) createRelatedResources(ctx context.Context, in model.ApplicationRegisterInput, tenant string, applicationID string) error {
 	var err error
 	webhooks := make([]*model.Webhook, 0, len(in.Webhooks))
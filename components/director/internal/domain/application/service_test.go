@@ -456,7 +456,7 @@ func TestService_Create(t *testing.T) {
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			bndlSvc := testCase.BundleServiceFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, bndlSvc, uidSvc, nil, "", nil)
+			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, bndlSvc, uidSvc, nil, "", nil, nil)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// WHEN
@@ -476,7 +476,7 @@ func TestService_Create(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 		// WHEN
 		_, err := svc.Create(context.TODO(), model.ApplicationRegisterInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -881,7 +881,7 @@ func TestService_CreateFromTemplate(t *testing.T) {
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			bndlSvc := testCase.BundleServiceFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, bndlSvc, uidSvc, nil, "", nil)
+			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, bndlSvc, uidSvc, nil, "", nil, nil)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// WHEN
@@ -901,7 +901,7 @@ func TestService_CreateFromTemplate(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 		// WHEN
 		_, err := svc.Create(context.TODO(), model.ApplicationRegisterInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -1387,7 +1387,7 @@ func TestService_Upsert_TrustedUpsert(t *testing.T) {
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			webhookRepo := testCase.WebhookRepoFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, nil, uidSvc, nil, "", testCase.OrdWebhookMapping)
+			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, nil, uidSvc, nil, "", testCase.OrdWebhookMapping, nil)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -1411,7 +1411,7 @@ func TestService_Upsert_TrustedUpsert(t *testing.T) {
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			webhookRepo := testCase.WebhookRepoFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, nil, uidSvc, nil, "", testCase.OrdWebhookMapping)
+			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, nil, uidSvc, nil, "", testCase.OrdWebhookMapping, nil)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -1430,7 +1430,7 @@ func TestService_Upsert_TrustedUpsert(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 		// when
 		_, err := svc.Create(context.TODO(), model.ApplicationRegisterInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -1733,7 +1733,7 @@ func TestService_TrustedUpsertFromTemplate(t *testing.T) {
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			webhookRepo := testCase.WebhookRepoFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, nil, uidSvc, nil, "", testCase.OrdWebhookMapping)
+			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, nil, uidSvc, nil, "", testCase.OrdWebhookMapping, nil)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -1752,7 +1752,7 @@ func TestService_TrustedUpsertFromTemplate(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 		// when
 		_, err := svc.Create(context.TODO(), model.ApplicationRegisterInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -2204,7 +2204,7 @@ func TestService_Update(t *testing.T) {
 			lblSvc := testCase.LabelSvcFn()
 			webhookRepo := testCase.WebhookRepoFn()
 			uidSvc := testCase.UIDServiceFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, lblSvc, nil, uidSvc, nil, "", testCase.ORDWebhookMapping)
+			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, lblSvc, nil, uidSvc, nil, "", testCase.ORDWebhookMapping, nil)
 			svc.SetTimestampGen(timestampGenFunc)
 
 			// WHEN
@@ -2223,6 +2223,119 @@ func TestService_Update(t *testing.T) {
 	}
 }
 
+func TestService_Rename(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+	newName := "renamed"
+	timestamp := time.Now()
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	nameLabel := fixLabelInput("name", "mp-"+newName, id, model.ApplicationLabelableObject)
+	isRenamed := mock.MatchedBy(func(app *model.Application) bool {
+		return app.ID == id && app.Name == newName
+	})
+
+	testCases := []struct {
+		Name               string
+		AppRepoFn          func() *automock.ApplicationRepository
+		LabelSvcFn         func() *automock.LabelService
+		NewName            string
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixModelApplication(id, tnt, "initial", "desc"), nil).Once()
+				repo.On("ListAll", ctx, tnt).Return([]*model.Application{fixModelApplication(id, tnt, "initial", "desc"), fixModelApplication("bar", tnt, "other", "desc")}, nil).Once()
+				repo.On("Update", ctx, tnt, isRenamed).Return(nil).Once()
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			LabelSvcFn: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("UpsertLabel", ctx, tnt, nameLabel).Return(nil).Once()
+				return svc
+			},
+			NewName: newName,
+		},
+		{
+			Name: "Does nothing when the name is not changed",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixModelApplication(id, tnt, newName, "desc"), nil).Once()
+				return repo
+			},
+			LabelSvcFn: UnusedLabelService,
+			NewName:    newName,
+		},
+		{
+			Name: "Returns error when the name is already used by another application",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixModelApplication(id, tnt, "initial", "desc"), nil).Once()
+				repo.On("ListAll", ctx, tnt).Return([]*model.Application{fixModelApplication("bar", tnt, "Renamed", "desc")}, nil).Once()
+				return repo
+			},
+			LabelSvcFn:         UnusedLabelService,
+			NewName:            newName,
+			ExpectedErrMessage: "Object name is not unique",
+		},
+		{
+			Name: "Returns error when application update fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(fixModelApplication(id, tnt, "initial", "desc"), nil).Once()
+				repo.On("ListAll", ctx, tnt).Return([]*model.Application{}, nil).Once()
+				repo.On("Update", ctx, tnt, isRenamed).Return(testErr).Once()
+				return repo
+			},
+			LabelSvcFn:         UnusedLabelService,
+			NewName:            newName,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when application retrieval fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, id).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelSvcFn:         UnusedLabelService,
+			NewName:            newName,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appRepo := testCase.AppRepoFn()
+			lblSvc := testCase.LabelSvcFn()
+			svc := application.NewService(&normalizer.DefaultNormalizator{}, nil, appRepo, nil, nil, nil, nil, lblSvc, nil, nil, nil, "", nil, nil)
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+
+			// WHEN
+			err := svc.Rename(ctx, id, testCase.NewName)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, appRepo, lblSvc)
+		})
+	}
+}
+
 func TestService_UpdateBaseURL(t *testing.T) {
 	// GIVEN
 	testErr := errors.New("Test error")
@@ -2333,7 +2446,7 @@ func TestService_UpdateBaseURL(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			resetModels()
 			appRepo := testCase.AppRepoFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			err := svc.UpdateBaseURL(testCase.Context, testCase.InputID, testCase.TargetURL)
@@ -2444,7 +2557,7 @@ func TestService_UpdateBaseURLAndReadyState(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			resetModels()
 			appRepo := testCase.AppRepoFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			err := svc.UpdateBaseURLAndReadyState(testCase.Context, testCase.InputID, updatedBaseURL, true)
@@ -2489,6 +2602,7 @@ func TestService_Delete(t *testing.T) {
 		Name               string
 		AppRepoFn          func() *automock.ApplicationRepository
 		FormationServiceFn func() *automock.FormationService
+		SoftDeleteSvcFn    func() *automock.SoftDeleteService
 		Input              model.ApplicationRegisterInput
 		InputID            string
 		ExpectedErrMessage string
@@ -2564,6 +2678,36 @@ func TestService_Delete(t *testing.T) {
 			InputID:            id,
 			ExpectedErrMessage: testErr.Error(),
 		},
+		{
+			Name: "Success when soft delete is enabled",
+			FormationServiceFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("ListFormationsForObjectGlobal", ctx, id).Return([]*model.Formation{}, nil).Once()
+				return svc
+			},
+			SoftDeleteSvcFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("SoftDelete", ctx, resource.Application, id).Return(nil).Once()
+				return svc
+			},
+			InputID:            id,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when application soft deletion failed",
+			FormationServiceFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("ListFormationsForObjectGlobal", ctx, id).Return([]*model.Formation{}, nil).Once()
+				return svc
+			},
+			SoftDeleteSvcFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("SoftDelete", ctx, resource.Application, id).Return(testErr).Once()
+				return svc
+			},
+			InputID:            id,
+			ExpectedErrMessage: testErr.Error(),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2576,7 +2720,13 @@ func TestService_Delete(t *testing.T) {
 			if testCase.FormationServiceFn != nil {
 				formationSvc = testCase.FormationServiceFn()
 			}
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, formationSvc, "", nil)
+			softDeleteSvc := &automock.SoftDeleteService{}
+			var softDeleteSvcArg application.SoftDeleteService
+			if testCase.SoftDeleteSvcFn != nil {
+				softDeleteSvc = testCase.SoftDeleteSvcFn()
+				softDeleteSvcArg = softDeleteSvc
+			}
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, formationSvc, "", nil, softDeleteSvcArg)
 
 			// WHEN
 			err := svc.Delete(ctx, testCase.InputID)
@@ -2589,7 +2739,7 @@ func TestService_Delete(t *testing.T) {
 				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
 			}
 
-			mock.AssertExpectationsForObjects(t, appRepo, formationSvc)
+			mock.AssertExpectationsForObjects(t, appRepo, formationSvc, softDeleteSvc)
 		})
 	}
 }
@@ -2849,7 +2999,7 @@ func TestService_Unpair(t *testing.T) {
 			}
 			ctx := testCase.ContextFn()
 			ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
-			svc := application.NewService(nil, nil, appRepo, nil, runtimeRepo, nil, nil, nil, nil, nil, formationSvc, "", nil)
+			svc := application.NewService(nil, nil, appRepo, nil, runtimeRepo, nil, nil, nil, nil, nil, formationSvc, "", nil, nil)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 			// WHEN
 			err := svc.Unpair(ctx, testCase.InputID)
@@ -3453,7 +3603,9 @@ func TestService_Merge(t *testing.T) {
 			if testCase.FormationServiceFn != nil {
 				fomationService = testCase.FormationServiceFn()
 			}
-			svc := application.NewService(nil, nil, appRepo, nil, nil, labelRepo, nil, labelUpserSvc, nil, nil, fomationService, selfRegDistLabelKey, nil)
+			// the source application is deleted permanently even when soft delete is enabled
+			softDeleteSvc := &automock.SoftDeleteService{}
+			svc := application.NewService(nil, nil, appRepo, nil, nil, labelRepo, nil, labelUpserSvc, nil, nil, fomationService, selfRegDistLabelKey, nil, softDeleteSvc)
 
 			// WHEN
 			destApp, err := svc.Merge(testCase.Ctx, testCase.DestinationID, testCase.SourceID)
@@ -3467,7 +3619,7 @@ func TestService_Merge(t *testing.T) {
 				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
 			}
 
-			mock.AssertExpectationsForObjects(t, appRepo, labelRepo, labelUpserSvc, fomationService)
+			mock.AssertExpectationsForObjects(t, appRepo, labelRepo, labelUpserSvc, fomationService, softDeleteSvc)
 		})
 
 		srcAppLabels = fixApplicationLabels(srcID, labelKey1, labelKey2, labelValue1, "true")
@@ -3475,6 +3627,202 @@ func TestService_Merge(t *testing.T) {
 	}
 }
 
+func TestService_PreviewMerge(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	destID := "foo"
+	srcID := "bar"
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+	templateID := "12346789"
+	selfRegDistLabelKey := "subscriptionProviderId"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	fixLabels := func(appID string, values map[string]interface{}) map[string]*model.Label {
+		labels := make(map[string]*model.Label, len(values))
+		for key, value := range values {
+			labels[key] = &model.Label{Tenant: str.Ptr(tnt), Key: key, Value: value, ObjectID: appID, ObjectType: model.ApplicationLabelableObject}
+		}
+		return labels
+	}
+	destLabels := func() map[string]*model.Label {
+		return fixLabels(destID, map[string]interface{}{model.ScenariosKey: []interface{}{"Easter", "Bunny"}, "managed": "false", "region": "eu"})
+	}
+	srcLabels := func() map[string]*model.Label {
+		return fixLabels(srcID, map[string]interface{}{"managed": "true", "region": "us", "owner": "team"})
+	}
+
+	destModel := fixDetailedModelApplication(t, destID, tnt, "dest app", "dest description")
+	destModel.ApplicationTemplateID = &templateID
+
+	srcModel := fixDetailedModelApplication(t, srcID, tnt, "src app", "src description")
+	srcModel.ApplicationTemplateID = &templateID
+
+	invalidSrcModel := fixDetailedModelApplication(t, srcID, tnt, "src app", "src description")
+	invalidSrcModel.ApplicationTemplateID = &templateID
+	invalidSrcModel.BaseURL = str.Ptr("http://other.com")
+	invalidSrcModel.Status.Condition = model.ApplicationStatusConditionConnected
+
+	testCases := []struct {
+		Name               string
+		AppRepoFn          func() *automock.ApplicationRepository
+		LabelRepoFn        func() *automock.LabelRepository
+		FormationServiceFn func() *automock.FormationService
+		ExpectedPreview    *model.ApplicationMergePreview
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, srcID).Return(srcModel, nil).Once()
+				repo.On("GetByID", ctx, tnt, destID).Return(destModel, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.AppTemplateLabelableObject, templateID).Return(map[string]*model.Label{}, nil).Once()
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, destID).Return(destLabels(), nil).Once()
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, srcID).Return(srcLabels(), nil).Once()
+				return repo
+			},
+			FormationServiceFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("ListFormationsForObjectGlobal", ctx, srcID).Return([]*model.Formation{}, nil).Once()
+				return svc
+			},
+			ExpectedPreview: &model.ApplicationMergePreview{
+				DestinationID: destID,
+				SourceID:      srcID,
+				Labels: map[string]interface{}{
+					model.ScenariosKey: []string{"Easter", "Bunny"},
+					"managed":          "true",
+					"region":           "eu",
+					"owner":            "team",
+				},
+				Conflicts: []*model.ApplicationMergeConflict{
+					{Type: model.ApplicationMergeConflictTypeProperty, Key: "name", DestinationValue: "dest app", SourceValue: "src app"},
+					{Type: model.ApplicationMergeConflictTypeProperty, Key: "description", DestinationValue: "dest description", SourceValue: "src description"},
+					{Type: model.ApplicationMergeConflictTypeLabel, Key: "region", DestinationValue: "eu", SourceValue: "us"},
+				},
+				AffectedFormations: []string{},
+				ValidationErrors:   []string{},
+			},
+		},
+		{
+			Name: "Success with collected validation errors",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, srcID).Return(invalidSrcModel, nil).Once()
+				repo.On("GetByID", ctx, tnt, destID).Return(destModel, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.AppTemplateLabelableObject, templateID).Return(map[string]*model.Label{selfRegDistLabelKey: {Key: selfRegDistLabelKey, Value: "id"}}, nil).Once()
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, destID).Return(nil, nil).Once()
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, srcID).Return(nil, nil).Once()
+				return repo
+			},
+			FormationServiceFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("ListFormationsForObjectGlobal", ctx, srcID).Return([]*model.Formation{{Name: testScenario}}, nil).Once()
+				return svc
+			},
+			ExpectedPreview: &model.ApplicationMergePreview{
+				DestinationID: destID,
+				SourceID:      srcID,
+				Labels:        map[string]interface{}{},
+				Conflicts: []*model.ApplicationMergeConflict{
+					{Type: model.ApplicationMergeConflictTypeProperty, Key: "name", DestinationValue: "dest app", SourceValue: "src app"},
+					{Type: model.ApplicationMergeConflictTypeProperty, Key: "description", DestinationValue: "dest description", SourceValue: "src description"},
+				},
+				AffectedFormations: []string{testScenario},
+				ValidationErrors: []string{
+					fmt.Sprintf("System src app is part of the following formations : %s", testScenario),
+					"BaseURL for applications foo and bar are not the same. Destination app BaseURL: base_url. Source app BaseURL: http://other.com",
+					fmt.Sprintf("app template: %s has label %s", templateID, selfRegDistLabelKey),
+					"Cannot merge application with id bar, because it is in a CONNECTED status",
+				},
+			},
+		},
+		{
+			Name: "Error when getting source application fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, srcID).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelRepoFn:        func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			FormationServiceFn: func() *automock.FormationService { return &automock.FormationService{} },
+			ExpectedErrMessage: "while getting source application",
+		},
+		{
+			Name: "Error when listing formations of source application fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, srcID).Return(srcModel, nil).Once()
+				repo.On("GetByID", ctx, tnt, destID).Return(destModel, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			FormationServiceFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("ListFormationsForObjectGlobal", ctx, srcID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Error when listing labels of destination application fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, srcID).Return(srcModel, nil).Once()
+				repo.On("GetByID", ctx, tnt, destID).Return(destModel, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.AppTemplateLabelableObject, templateID).Return(map[string]*model.Label{}, nil).Once()
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, destID).Return(nil, testErr).Once()
+				return repo
+			},
+			FormationServiceFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("ListFormationsForObjectGlobal", ctx, srcID).Return([]*model.Formation{}, nil).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appRepo := testCase.AppRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			formationSvc := testCase.FormationServiceFn()
+			svc := application.NewService(nil, nil, appRepo, nil, nil, labelRepo, nil, nil, nil, nil, formationSvc, selfRegDistLabelKey, nil, nil)
+
+			// WHEN
+			preview, err := svc.PreviewMerge(ctx, destID, srcID)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedPreview, preview)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				assert.Nil(t, preview)
+			}
+
+			mock.AssertExpectationsForObjects(t, appRepo, labelRepo, formationSvc)
+		})
+	}
+}
+
 func TestService_Get(t *testing.T) {
 	// GIVEN
 	testErr := errors.New("Test error")
@@ -3530,7 +3878,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			app, err := svc.Get(ctx, testCase.InputID)
@@ -3599,7 +3947,7 @@ func TestService_GetGlobalByID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			app, err := svc.GetGlobalByID(ctx, testCase.InputID)
@@ -3679,7 +4027,7 @@ func TestService_GetSystem(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			app, err := svc.GetSccSystem(testCase.Ctx, "id", locationID, virtualHost)
@@ -3849,7 +4197,7 @@ func TestService_List(t *testing.T) {
 				formationSvc = testCase.FormationServiceFn()
 			}
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, formationSvc, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, formationSvc, "", nil, nil)
 
 			// WHEN
 			app, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, after)
@@ -3932,7 +4280,7 @@ func TestService_ListAll(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			defer mock.AssertExpectationsForObjects(t, repo)
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			app, err := svc.ListAll(testCase.Context)
@@ -4073,7 +4421,7 @@ func TestService_ListAllGlobalByFilter(t *testing.T) {
 				formationSvc = testCase.FormationServiceFn()
 			}
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, formationSvc, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, formationSvc, "", nil, nil)
 
 			// WHEN
 			app, err := svc.ListAllGlobalByFilter(ctx, testCase.LabelFilter, pageSize, cursor)
@@ -4309,7 +4657,7 @@ func TestService_ListByRuntimeID(t *testing.T) {
 			if testCase.FormationServiceFn != nil {
 				formationSvc = testCase.FormationServiceFn()
 			}
-			svc := application.NewService(nil, cfgProvider, appRepository, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, formationSvc, "", nil)
+			svc := application.NewService(nil, cfgProvider, appRepository, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, formationSvc, "", nil, nil)
 
 			// WHEN
 			results, err := svc.ListByRuntimeID(ctx, testCase.Input, first, cursor)
@@ -4454,7 +4802,7 @@ func TestService_ListBySCC(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appRepo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, labelRepo, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, appRepo, nil, nil, labelRepo, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			app, err := svc.ListBySCC(testCase.Ctx, filter)
@@ -4550,7 +4898,7 @@ func TestService_ListSCCs(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, nil, nil, nil, repo, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, nil, nil, nil, repo, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			app, err := svc.ListSCCs(testCase.Ctx)
@@ -4625,7 +4973,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// GIVEN
 			appRepo := testCase.RepositoryFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			value, err := svc.Exist(ctx, testCase.InputApplicationID)
@@ -4745,7 +5093,7 @@ func TestService_SetLabel(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelSvc := testCase.LabelServiceFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, labelSvc, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, labelSvc, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			err := svc.SetLabel(ctx, testCase.InputLabel)
@@ -4856,7 +5204,7 @@ func TestService_GetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			l, err := svc.GetLabel(ctx, testCase.InputApplicationID, testCase.InputLabel.Key)
@@ -4969,7 +5317,7 @@ func TestService_ListLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			l, err := svc.ListLabels(ctx, testCase.InputApplicationID)
@@ -5078,7 +5426,7 @@ func TestService_ListLabelsGlobal(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			l, err := svc.ListLabelsGlobal(ctx, testCase.InputApplicationID)
@@ -5193,7 +5541,7 @@ func TestService_DeleteLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			err := svc.DeleteLabel(ctx, testCase.InputApplicationID, testCase.InputKey)
@@ -5255,7 +5603,7 @@ func TestService_GetBySystemNumber(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// GIVEN
 			appRepo := testCase.RepositoryFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			value, err := svc.GetBySystemNumber(ctx, testCase.InputSystemNumber)
@@ -5424,7 +5772,7 @@ func TestService_ListByLocalTenantID(t *testing.T) {
 			if testCase.FormationServiceFn != nil {
 				formationSvc = testCase.FormationServiceFn()
 			}
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, formationSvc, "", nil)
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, formationSvc, "", nil, nil)
 
 			// WHEN
 			value, err := svc.ListByLocalTenantID(testCase.Ctx, testCase.LocalTenantID, testCase.Filter, testCase.First, testCase.Cursor)
@@ -5490,7 +5838,7 @@ func TestService_GetByLocalTenantIDAndAppTemplateID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// GIVEN
 			appRepo := testCase.RepositoryFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil)
 
 			// WHEN
 			value, err := svc.GetByLocalTenantIDAndAppTemplateID(ctx, testCase.LocalTenantID, testCase.AppTemplateID)
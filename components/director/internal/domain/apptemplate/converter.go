@@ -297,11 +297,21 @@ func (c *converter) placeholdersModelToJSON(in []model.ApplicationTemplatePlaceh
 func (c *converter) placeholdersFromGraphql(in []*graphql.PlaceholderDefinitionInput) []model.ApplicationTemplatePlaceholder {
 	placeholders := make([]model.ApplicationTemplatePlaceholder, 0, len(in))
 	for _, p := range in {
+		var placeholderType *model.PlaceholderType
+		if p.Type != nil {
+			t := model.PlaceholderType(*p.Type)
+			placeholderType = &t
+		}
 		np := model.ApplicationTemplatePlaceholder{
-			Name:        p.Name,
-			Description: p.Description,
-			JSONPath:    p.JSONPath,
-			Optional:    p.Optional,
+			Name:          p.Name,
+			Description:   p.Description,
+			JSONPath:      p.JSONPath,
+			Optional:      p.Optional,
+			Type:          placeholderType,
+			Pattern:       p.Pattern,
+			AllowedValues: p.AllowedValues,
+			DefaultValue:  p.DefaultValue,
+			Sensitive:     p.Sensitive,
 		}
 		placeholders = append(placeholders, np)
 	}
@@ -312,10 +322,17 @@ func (c *converter) placeholdersToGraphql(in []model.ApplicationTemplatePlacehol
 	placeholders := make([]*graphql.PlaceholderDefinition, 0, len(in))
 	for _, p := range in {
 		np := graphql.PlaceholderDefinition{
-			Name:        p.Name,
-			Description: p.Description,
-			JSONPath:    p.JSONPath,
-			Optional:    p.Optional,
+			Name:          p.Name,
+			Description:   p.Description,
+			JSONPath:      p.JSONPath,
+			Optional:      p.Optional,
+			Type:          graphql.PlaceholderType(p.GetType()),
+			Pattern:       p.Pattern,
+			AllowedValues: p.AllowedValues,
+			Sensitive:     p.IsSensitive(),
+		}
+		if !p.IsSensitive() {
+			np.DefaultValue = p.DefaultValue
 		}
 		placeholders = append(placeholders, &np)
 	}
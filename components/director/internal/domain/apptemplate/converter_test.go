@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate/automock"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -111,6 +112,32 @@ func TestConverter_ToGraphQL(t *testing.T) {
 	}
 }
 
+func TestConverter_ToGraphQL_TypedPlaceholders(t *testing.T) {
+	// GIVEN
+	modelWebhooks := fixModelApplicationWebhooks(testWebhookID, testID)
+	webhookConverter := &automock.WebhookConverter{}
+	webhookConverter.On("MultipleToGraphQL", modelWebhooks).Return(fixGQLApplicationWebhooks(testWebhookID, testID), nil)
+
+	sensitive := true
+	enumType := model.PlaceholderTypeEnum
+	in := fixModelApplicationTemplate(testID, testName, modelWebhooks)
+	in.Placeholders = []model.ApplicationTemplatePlaceholder{
+		{Name: "region", Type: &enumType, AllowedValues: []string{"eu10", "us10"}, DefaultValue: str.Ptr("eu10")},
+		{Name: "api-key", Pattern: str.Ptr("^[a-z]+$"), DefaultValue: str.Ptr("secret"), Sensitive: &sensitive},
+	}
+	converter := apptemplate.NewConverter(&automock.AppConverter{}, webhookConverter)
+
+	// WHEN
+	res, err := converter.ToGraphQL(in)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, []*graphql.PlaceholderDefinition{
+		{Name: "region", Type: graphql.PlaceholderTypeEnum, AllowedValues: []string{"eu10", "us10"}, DefaultValue: str.Ptr("eu10")},
+		{Name: "api-key", Type: graphql.PlaceholderTypeString, Pattern: str.Ptr("^[a-z]+$"), Sensitive: true},
+	}, res.Placeholders)
+}
+
 func TestConverter_MultipleToGraphQL(t *testing.T) {
 	// GIVEN
 	modelWebhooks := [][]*model.Webhook{
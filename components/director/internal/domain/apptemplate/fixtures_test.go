@@ -692,6 +692,7 @@ func fixGQLPlaceholders() []*graphql.PlaceholderDefinition {
 			Description: &placeholderDesc,
 			JSONPath:    &placeholderJSONPath,
 			Optional:    &isOptional,
+			Type:        graphql.PlaceholderTypeString,
 		},
 	}
 }
@@ -0,0 +1,83 @@
+package apptemplate
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+// validatePlaceholderDefinitions checks that the default values of the placeholders satisfy their own definitions
+func validatePlaceholderDefinitions(placeholders []model.ApplicationTemplatePlaceholder) error {
+	fieldErrors := make(map[string]error)
+	for _, placeholder := range placeholders {
+		if placeholder.DefaultValue == nil {
+			continue
+		}
+		if err := validateTypedPlaceholderValue(placeholder, *placeholder.DefaultValue); err != nil {
+			fieldErrors[placeholder.Name] = errors.Wrap(err, "invalid default value")
+		}
+	}
+
+	return apperrors.NewInvalidDataErrorWithFields(fieldErrors, "placeholder definitions")
+}
+
+// validateTypedPlaceholderValue checks the value against the type, pattern and allowed values of the placeholder.
+// Values of sensitive placeholders are not included in the returned errors.
+func validateTypedPlaceholderValue(placeholder model.ApplicationTemplatePlaceholder, value string) error {
+	quotedValue := "value"
+	if !placeholder.IsSensitive() {
+		quotedValue = fmt.Sprintf("value %q", value)
+	}
+
+	switch placeholder.GetType() {
+	case model.PlaceholderTypeURL:
+		parsed, err := url.ParseRequestURI(value)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return errors.Errorf("%s is not an absolute URL", quotedValue)
+		}
+	case model.PlaceholderTypeEnum:
+		if !contains(placeholder.AllowedValues, value) {
+			return errors.Errorf("%s is not one of the allowed values [%s]", quotedValue, strings.Join(placeholder.AllowedValues, ", "))
+		}
+	case model.PlaceholderTypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return errors.Errorf("%s is not an integer", quotedValue)
+		}
+	case model.PlaceholderTypeBoolean:
+		if value != "true" && value != "false" {
+			return errors.Errorf("%s is not a boolean", quotedValue)
+		}
+	case model.PlaceholderTypeUUID:
+		if _, err := uuid.Parse(value); err != nil {
+			return errors.Errorf("%s is not a UUID", quotedValue)
+		}
+	}
+
+	if placeholder.Pattern != nil && *placeholder.Pattern != "" {
+		pattern, err := regexp.Compile(*placeholder.Pattern)
+		if err != nil {
+			return errors.Wrapf(err, "while compiling the pattern of placeholder %s", placeholder.Name)
+		}
+		if !pattern.MatchString(value) {
+			return errors.Errorf("%s does not match the pattern %s", quotedValue, *placeholder.Pattern)
+		}
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -127,6 +127,13 @@ type LabelService interface {
 	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
 }
 
+// PlaceholderValuesService is responsible for recording the placeholder values applications are registered with
+//
+//go:generate mockery --name=PlaceholderValuesService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type PlaceholderValuesService interface {
+	RecordPlaceholderValues(ctx context.Context, appID string, appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) error
+}
+
 // SelfRegisterManager missing godoc
 //
 //go:generate mockery --name=SelfRegisterManager --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -154,10 +161,11 @@ type Resolver struct {
 	certSubjectMappingSvc   CertSubjectMappingService
 	ordClient               *apiclient.ORDClient
 	envConsumerSubjects     []string
+	placeholderValuesSvc    PlaceholderValuesService
 }
 
 // NewResolver missing godoc
-func NewResolver(transact persistence.Transactioner, appSvc ApplicationService, appConverter ApplicationConverter, appTemplateSvc ApplicationTemplateService, appTemplateConverter ApplicationTemplateConverter, webhookService WebhookService, webhookConverter WebhookConverter, labelSvc LabelService, selfRegisterManager SelfRegisterManager, uidService UIDService, certSubjectMappingSvc CertSubjectMappingService, appTemplateProductLabel string, ordAggregatorClientConfig apiclient.OrdAggregatorClientConfig, environmentConsumerSubjects []string) *Resolver {
+func NewResolver(transact persistence.Transactioner, appSvc ApplicationService, appConverter ApplicationConverter, appTemplateSvc ApplicationTemplateService, appTemplateConverter ApplicationTemplateConverter, webhookService WebhookService, webhookConverter WebhookConverter, labelSvc LabelService, selfRegisterManager SelfRegisterManager, uidService UIDService, certSubjectMappingSvc CertSubjectMappingService, appTemplateProductLabel string, ordAggregatorClientConfig apiclient.OrdAggregatorClientConfig, environmentConsumerSubjects []string, placeholderValuesSvc PlaceholderValuesService) *Resolver {
 	return &Resolver{
 		transact:                transact,
 		appSvc:                  appSvc,
@@ -173,6 +181,7 @@ func NewResolver(transact persistence.Transactioner, appSvc ApplicationService,
 		certSubjectMappingSvc:   certSubjectMappingSvc,
 		ordClient:               apiclient.NewORDClient(ordAggregatorClientConfig),
 		envConsumerSubjects:     environmentConsumerSubjects,
+		placeholderValuesSvc:    placeholderValuesSvc,
 	}
 }
 
@@ -552,87 +561,117 @@ func (r *Resolver) RegisterApplicationFromTemplate(ctx context.Context, in graph
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	log.C(ctx).Debugf("Extracting Application Template with name %q and consumer id REDACTED_%x from GraphQL input", in.TemplateName, sha256.Sum256([]byte(consumerInfo.ConsumerID)))
-	appTemplate, err := r.retrieveAppTemplate(ctx, in.TemplateName, consumerInfo.ConsumerID, in.ID)
+	log.C(ctx).Infof("Registering an Application from Application Template with name %s", in.TemplateName)
+	appTemplate, convertedIn, appCreateInputJSON, appCreateInputModel, err := r.prepareApplicationFromTemplate(ctx, consumerInfo.ConsumerID, in)
 	if err != nil {
 		return nil, err
 	}
 
-	log.C(ctx).Infof("Registering an Application from Application Template with name %s", in.TemplateName)
-	convertedIn, err := r.appTemplateConverter.ApplicationFromTemplateInputFromGraphQL(appTemplate, in)
+	applicationName, err := extractApplicationNameFromTemplateInput(appCreateInputJSON)
 	if err != nil {
 		return nil, err
 	}
 
-	log.C(ctx).Debugf("Preparing ApplicationCreateInput JSON from Application Template with name %s", in.TemplateName)
-	appCreateInputJSON, err := r.appTemplateSvc.PrepareApplicationCreateInputJSON(appTemplate, convertedIn.Values)
+	log.C(ctx).Infof("Creating an Application with name %s from Application Template with name %s", applicationName, in.TemplateName)
+	id, err := r.appSvc.CreateFromTemplate(ctx, appCreateInputModel, &appTemplate.ID, false)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while preparing ApplicationCreateInput JSON from Application Template with name %s", in.TemplateName)
+		return nil, errors.Wrapf(err, "while creating an Application with name %s from Application Template with name %s", applicationName, in.TemplateName)
 	}
+	log.C(ctx).Infof("Application with name %s and id %s successfully created from Application Template with name %s", applicationName, id, in.TemplateName)
 
-	log.C(ctx).Debugf("Converting ApplicationCreateInput JSON to GraphQL ApplicationRegistrationInput from Application Template with name %s", in.TemplateName)
-	appCreateInputGQL, err := r.appConverter.CreateRegisterInputJSONToGQL(appCreateInputJSON)
-	if err != nil {
-		return nil, errors.Wrapf(err, "while converting ApplicationCreateInput JSON to GraphQL ApplicationRegistrationInput from Application Template with name %s", in.TemplateName)
+	if err := r.placeholderValuesSvc.RecordPlaceholderValues(ctx, id, appTemplate, convertedIn.Values); err != nil {
+		return nil, err
 	}
 
-	log.C(ctx).Infof("Validating GraphQL ApplicationRegistrationInput from Application Template with name %s", convertedIn.TemplateName)
-	if err := inputvalidation.Validate(appCreateInputGQL); err != nil {
-		return nil, errors.Wrapf(err, "while validating application input from Application Template with name %s", convertedIn.TemplateName)
+	app, err := r.appSvc.Get(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	appCreateInputModel, err := r.appConverter.CreateInputFromGraphQL(ctx, appCreateInputGQL)
+	err = tx.Commit()
 	if err != nil {
-		return nil, errors.Wrap(err, "while converting ApplicationFromTemplate input")
+		return nil, err
 	}
 
-	if appCreateInputModel.Labels == nil {
-		appCreateInputModel.Labels = make(map[string]interface{})
+	gqlApp := r.appConverter.ToGraphQL(app)
+
+	if err := r.ordClient.Aggregate(ctx, app.ID, appTemplate.ID); err != nil {
+		log.C(ctx).WithError(err).Errorf("Error while calling aggregate API with AppID %q and AppTemplateID %q", app.ID, id)
 	}
 
-	if _, exists := appCreateInputModel.Labels[application.ManagedLabelKey]; !exists {
-		appCreateInputModel.Labels[application.ManagedLabelKey] = "false"
+	if err := r.ordClient.Aggregate(ctx, app.ID, ""); err != nil {
+		log.C(ctx).WithError(err).Errorf("Error while calling aggregate API with AppID %q", app.ID)
 	}
 
-	if convertedIn.Labels != nil {
-		for k, v := range in.Labels {
-			appCreateInputModel.Labels[k] = v
-		}
+	return gqlApp, nil
+}
+
+// ApplicationLabels returns the labels of the application which would be registered from the input, i.e. the labels rendered
+// from the application template with the placeholder values of the input together with the labels of the input.
+// The context is expected to contain a transaction.
+func (r *Resolver) ApplicationLabels(ctx context.Context, in graphql.ApplicationFromTemplateInput) (map[string]interface{}, error) {
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while fetching consumer info from context")
 	}
 
-	applicationName, err := extractApplicationNameFromTemplateInput(appCreateInputJSON)
+	_, _, _, appCreateInputModel, err := r.prepareApplicationFromTemplate(ctx, consumerInfo.ConsumerID, in)
 	if err != nil {
 		return nil, err
 	}
 
-	log.C(ctx).Infof("Creating an Application with name %s from Application Template with name %s", applicationName, in.TemplateName)
-	id, err := r.appSvc.CreateFromTemplate(ctx, appCreateInputModel, &appTemplate.ID, false)
+	return appCreateInputModel.Labels, nil
+}
+
+func (r *Resolver) prepareApplicationFromTemplate(ctx context.Context, consumerID string, in graphql.ApplicationFromTemplateInput) (*model.ApplicationTemplate, model.ApplicationFromTemplateInput, string, model.ApplicationRegisterInput, error) {
+	log.C(ctx).Debugf("Extracting Application Template with name %q and consumer id REDACTED_%x from GraphQL input", in.TemplateName, sha256.Sum256([]byte(consumerID)))
+	appTemplate, err := r.retrieveAppTemplate(ctx, in.TemplateName, consumerID, in.ID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while creating an Application with name %s from Application Template with name %s", applicationName, in.TemplateName)
+		return nil, model.ApplicationFromTemplateInput{}, "", model.ApplicationRegisterInput{}, err
 	}
-	log.C(ctx).Infof("Application with name %s and id %s successfully created from Application Template with name %s", applicationName, id, in.TemplateName)
 
-	app, err := r.appSvc.Get(ctx, id)
+	convertedIn, err := r.appTemplateConverter.ApplicationFromTemplateInputFromGraphQL(appTemplate, in)
 	if err != nil {
-		return nil, err
+		return nil, model.ApplicationFromTemplateInput{}, "", model.ApplicationRegisterInput{}, err
 	}
 
-	err = tx.Commit()
+	log.C(ctx).Debugf("Preparing ApplicationCreateInput JSON from Application Template with name %s", in.TemplateName)
+	appCreateInputJSON, err := r.appTemplateSvc.PrepareApplicationCreateInputJSON(appTemplate, convertedIn.Values)
 	if err != nil {
-		return nil, err
+		return nil, model.ApplicationFromTemplateInput{}, "", model.ApplicationRegisterInput{}, errors.Wrapf(err, "while preparing ApplicationCreateInput JSON from Application Template with name %s", in.TemplateName)
 	}
 
-	gqlApp := r.appConverter.ToGraphQL(app)
+	log.C(ctx).Debugf("Converting ApplicationCreateInput JSON to GraphQL ApplicationRegistrationInput from Application Template with name %s", in.TemplateName)
+	appCreateInputGQL, err := r.appConverter.CreateRegisterInputJSONToGQL(appCreateInputJSON)
+	if err != nil {
+		return nil, model.ApplicationFromTemplateInput{}, "", model.ApplicationRegisterInput{}, errors.Wrapf(err, "while converting ApplicationCreateInput JSON to GraphQL ApplicationRegistrationInput from Application Template with name %s", in.TemplateName)
+	}
 
-	if err := r.ordClient.Aggregate(ctx, app.ID, appTemplate.ID); err != nil {
-		log.C(ctx).WithError(err).Errorf("Error while calling aggregate API with AppID %q and AppTemplateID %q", app.ID, id)
+	log.C(ctx).Infof("Validating GraphQL ApplicationRegistrationInput from Application Template with name %s", convertedIn.TemplateName)
+	if err := inputvalidation.Validate(appCreateInputGQL); err != nil {
+		return nil, model.ApplicationFromTemplateInput{}, "", model.ApplicationRegisterInput{}, errors.Wrapf(err, "while validating application input from Application Template with name %s", convertedIn.TemplateName)
 	}
 
-	if err := r.ordClient.Aggregate(ctx, app.ID, ""); err != nil {
-		log.C(ctx).WithError(err).Errorf("Error while calling aggregate API with AppID %q", app.ID)
+	appCreateInputModel, err := r.appConverter.CreateInputFromGraphQL(ctx, appCreateInputGQL)
+	if err != nil {
+		return nil, model.ApplicationFromTemplateInput{}, "", model.ApplicationRegisterInput{}, errors.Wrap(err, "while converting ApplicationFromTemplate input")
 	}
 
-	return gqlApp, nil
+	if appCreateInputModel.Labels == nil {
+		appCreateInputModel.Labels = make(map[string]interface{})
+	}
+
+	if _, exists := appCreateInputModel.Labels[application.ManagedLabelKey]; !exists {
+		appCreateInputModel.Labels[application.ManagedLabelKey] = "false"
+	}
+
+	if convertedIn.Labels != nil {
+		for k, v := range in.Labels {
+			appCreateInputModel.Labels[k] = v
+		}
+	}
+
+	return appTemplate, convertedIn, appCreateInputJSON, appCreateInputModel, nil
 }
 
 // UpdateApplicationTemplate missing godoc
@@ -158,7 +158,7 @@ func TestResolver_ApplicationTemplate(t *testing.T) {
 			webhookSvc := testCase.WebhookSvcFn()
 			webhookConverter := testCase.WebhookConvFn()
 
-			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects, nil)
 
 			// WHEN
 			result, err := resolver.ApplicationTemplate(ctx, testID)
@@ -295,7 +295,7 @@ func TestResolver_ApplicationTemplates(t *testing.T) {
 			webhookSvc := testCase.WebhookSvcFn()
 			webhookConverter := testCase.WebhookConvFn()
 
-			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects, nil)
 
 			// WHEN
 			result, err := resolver.ApplicationTemplates(ctx, testCase.LabelFilter, &first, &gqlAfter)
@@ -405,7 +405,7 @@ func TestResolver_Webhooks(t *testing.T) {
 			mockPersistence := testCase.PersistenceFn()
 			mockTransactioner := testCase.TransactionerFn(mockPersistence)
 
-			resolver := apptemplate.NewResolver(mockTransactioner, nil, nil, nil, nil, webhookSvc, converter, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects)
+			resolver := apptemplate.NewResolver(mockTransactioner, nil, nil, nil, nil, webhookSvc, converter, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects, nil)
 
 			// WHEN
 			result, err := resolver.Webhooks(context.TODO(), appTemplate)
@@ -1635,7 +1635,7 @@ func TestResolver_CreateApplicationTemplate(t *testing.T) {
 				ctx = testCase.Ctx
 			}
 
-			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, labelService, selfRegManager, uuidSvc, certSubjectMappingService, AppTemplateProductLabel, apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, labelService, selfRegManager, uuidSvc, certSubjectMappingService, AppTemplateProductLabel, apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects, nil)
 
 			// WHEN
 			result, err := resolver.CreateApplicationTemplate(ctx, *testCase.Input)
@@ -1661,7 +1661,7 @@ func TestResolver_CreateApplicationTemplate(t *testing.T) {
 		expectedError := errors.New("failed to parse webhook url template")
 		_, transact := txGen.ThatSucceeds()
 
-		resolver := apptemplate.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects)
+		resolver := apptemplate.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects, nil)
 
 		// WHEN
 		_, err := resolver.CreateApplicationTemplate(ctxWithCertConsumer, *gqlAppTemplateInputInvalid)
@@ -1772,7 +1772,7 @@ func TestResolver_Labels(t *testing.T) {
 			//persist, transact := testCase.TxFn()
 			appTemplateSvc := testCase.AppTemplateSvcFn()
 
-			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, nil, nil, nil, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, nil, nil, nil, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects, nil)
 
 			// WHEN
 			result, err := resolver.Labels(context.TODO(), gqlAppTemplate, testCase.InputKey)
@@ -1823,18 +1823,29 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 	modelAppFromTemplateWithIDInput := fixModelApplicationFromTemplateInput(testName)
 	modelAppFromTemplateWithIDInput.ID = &customID
 
+	recordsPlaceholderValues := func(appTemplateID string, values model.ApplicationFromTemplateInputValues, err error) func() *automock.PlaceholderValuesService {
+		return func() *automock.PlaceholderValuesService {
+			svc := &automock.PlaceholderValuesService{}
+			svc.On("RecordPlaceholderValues", txtest.CtxWithDBMatcher(), testID, mock.MatchedBy(func(appTemplate *model.ApplicationTemplate) bool {
+				return appTemplate.ID == appTemplateID
+			}), values).Return(err).Once()
+			return svc
+		}
+	}
+
 	testCases := []struct {
-		Name                 string
-		AppFromTemplateInput graphql.ApplicationFromTemplateInput
-		TxFn                 func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
-		AppTemplateSvcFn     func() *automock.ApplicationTemplateService
-		AppTemplateConvFn    func() *automock.ApplicationTemplateConverter
-		WebhookSvcFn         func() *automock.WebhookService
-		WebhookConvFn        func() *automock.WebhookConverter
-		AppSvcFn             func() *automock.ApplicationService
-		AppConvFn            func() *automock.ApplicationConverter
-		ExpectedOutput       *graphql.Application
-		ExpectedError        error
+		Name                   string
+		AppFromTemplateInput   graphql.ApplicationFromTemplateInput
+		TxFn                   func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppTemplateSvcFn       func() *automock.ApplicationTemplateService
+		AppTemplateConvFn      func() *automock.ApplicationTemplateConverter
+		WebhookSvcFn           func() *automock.WebhookService
+		WebhookConvFn          func() *automock.WebhookConverter
+		AppSvcFn               func() *automock.ApplicationService
+		AppConvFn              func() *automock.ApplicationConverter
+		PlaceholderValuesSvcFn func() *automock.PlaceholderValuesService
+		ExpectedOutput         *graphql.Application
+		ExpectedError          error
 	}{
 		{
 			Name:                 "Success",
@@ -1866,10 +1877,11 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 				appConv.On("ToGraphQL", &modelApplication).Return(&gqlApplication).Once()
 				return appConv
 			},
-			WebhookConvFn:  UnusedWebhookConv,
-			WebhookSvcFn:   UnusedWebhookSvc,
-			ExpectedOutput: &gqlApplication,
-			ExpectedError:  nil,
+			WebhookConvFn:          UnusedWebhookConv,
+			WebhookSvcFn:           UnusedWebhookSvc,
+			PlaceholderValuesSvcFn: recordsPlaceholderValues(testID, modelAppFromTemplateInput.Values, nil),
+			ExpectedOutput:         &gqlApplication,
+			ExpectedError:          nil,
 		},
 		{
 			Name:                 "SuccessWithIDField",
@@ -1902,10 +1914,11 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 				appConv.On("ToGraphQL", &modelApplication).Return(&gqlApplication).Once()
 				return appConv
 			},
-			WebhookConvFn:  UnusedWebhookConv,
-			WebhookSvcFn:   UnusedWebhookSvc,
-			ExpectedOutput: &gqlApplication,
-			ExpectedError:  nil,
+			WebhookConvFn:          UnusedWebhookConv,
+			WebhookSvcFn:           UnusedWebhookSvc,
+			PlaceholderValuesSvcFn: recordsPlaceholderValues(customID, modelAppFromTemplateWithIDInput.Values, nil),
+			ExpectedOutput:         &gqlApplication,
+			ExpectedError:          nil,
 		},
 		{
 			Name:                 "Success when managed label is present",
@@ -1937,10 +1950,11 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 				appConv.On("ToGraphQL", &modelApplication).Return(&gqlApplication).Once()
 				return appConv
 			},
-			WebhookConvFn:  UnusedWebhookConv,
-			WebhookSvcFn:   UnusedWebhookSvc,
-			ExpectedOutput: &gqlApplication,
-			ExpectedError:  nil,
+			WebhookConvFn:          UnusedWebhookConv,
+			WebhookSvcFn:           UnusedWebhookSvc,
+			PlaceholderValuesSvcFn: recordsPlaceholderValues(testID, modelAppFromTemplateWithManagedLabelInput.Values, nil),
+			ExpectedOutput:         &gqlApplication,
+			ExpectedError:          nil,
 		},
 		{
 			Name:                 "Returns error when transaction begin fails",
@@ -2166,6 +2180,38 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			ExpectedOutput: nil,
 			ExpectedError:  testError,
 		},
+		{
+			Name:                 "Returns error when recording placeholder values fails",
+			AppFromTemplateInput: gqlAppFromTemplateInput,
+			TxFn:                 txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("ListByFilters", txtest.CtxWithDBMatcher(), filters).Return([]*model.ApplicationTemplate{modelAppTemplate}, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateInputFromGraphQL", modelAppTemplate, gqlAppFromTemplateInput).Return(modelAppFromTemplateInput, nil).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("CreateFromTemplate", txtest.CtxWithDBMatcher(), modelAppWithLabelCreateInput, str.Ptr(testID), false).Return(testID, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				appConv.On("CreateRegisterInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				return appConv
+			},
+			WebhookConvFn:          UnusedWebhookConv,
+			WebhookSvcFn:           UnusedWebhookSvc,
+			PlaceholderValuesSvcFn: recordsPlaceholderValues(testID, modelAppFromTemplateInput.Values, testError),
+			ExpectedOutput:         nil,
+			ExpectedError:          testError,
+		},
 		{
 			Name:                 "Returns error when getting Application fails",
 			AppFromTemplateInput: gqlAppFromTemplateInput,
@@ -2193,10 +2239,11 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 				appConv.On("CreateRegisterInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
 				return appConv
 			},
-			WebhookConvFn:  UnusedWebhookConv,
-			WebhookSvcFn:   UnusedWebhookSvc,
-			ExpectedOutput: nil,
-			ExpectedError:  testError,
+			WebhookConvFn:          UnusedWebhookConv,
+			WebhookSvcFn:           UnusedWebhookSvc,
+			PlaceholderValuesSvcFn: recordsPlaceholderValues(testID, modelAppFromTemplateInput.Values, nil),
+			ExpectedOutput:         nil,
+			ExpectedError:          testError,
 		},
 		{
 			Name:                 "Returns error when committing transaction fails",
@@ -2225,10 +2272,11 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 				appConv.On("CreateRegisterInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
 				return appConv
 			},
-			WebhookConvFn:  UnusedWebhookConv,
-			WebhookSvcFn:   UnusedWebhookSvc,
-			ExpectedOutput: nil,
-			ExpectedError:  testError,
+			WebhookConvFn:          UnusedWebhookConv,
+			WebhookSvcFn:           UnusedWebhookSvc,
+			PlaceholderValuesSvcFn: recordsPlaceholderValues(testID, modelAppFromTemplateInput.Values, nil),
+			ExpectedOutput:         nil,
+			ExpectedError:          testError,
 		},
 		{
 			Name:                 "ErrorWhenNoTemplatesWithGivenIDFound",
@@ -2259,8 +2307,12 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			webhookConverter := testCase.WebhookConvFn()
 			appSvc := testCase.AppSvcFn()
 			appConv := testCase.AppConvFn()
+			placeholderValuesSvc := &automock.PlaceholderValuesService{}
+			if testCase.PlaceholderValuesSvcFn != nil {
+				placeholderValuesSvc = testCase.PlaceholderValuesSvcFn()
+			}
 
-			resolver := apptemplate.NewResolver(transact, appSvc, appConv, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects)
+			resolver := apptemplate.NewResolver(transact, appSvc, appConv, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects, placeholderValuesSvc)
 
 			// WHEN
 			result, err := resolver.RegisterApplicationFromTemplate(ctx, testCase.AppFromTemplateInput)
@@ -2280,6 +2332,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			appTemplateConv.AssertExpectations(t)
 			appSvc.AssertExpectations(t)
 			appConv.AssertExpectations(t)
+			placeholderValuesSvc.AssertExpectations(t)
 		})
 	}
 }
@@ -2692,7 +2745,7 @@ func TestResolver_UpdateApplicationTemplate(t *testing.T) {
 			webhookSvc := testCase.WebhookSvcFn()
 			webhookConverter := testCase.WebhookConvFn()
 
-			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, selfRegManager, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, selfRegManager, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects, nil)
 
 			// WHEN
 			result, err := resolver.UpdateApplicationTemplate(ctx, testID, testCase.InputOverride, *testCase.Input)
@@ -2715,7 +2768,7 @@ func TestResolver_UpdateApplicationTemplate(t *testing.T) {
 		expectedError := errors.New("failed to parse webhook url template")
 		_, transact := txGen.ThatSucceeds()
 
-		resolver := apptemplate.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects)
+		resolver := apptemplate.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects, nil)
 
 		// WHEN
 		override := false
@@ -3153,7 +3206,7 @@ func TestResolver_DeleteApplicationTemplate(t *testing.T) {
 			uuidSvc := uidSvcFn()
 			certSubjMappingSvc := testCase.CertSubjMappingSvcFn()
 
-			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, selfRegManager, uuidSvc, certSubjMappingSvc, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects)
+			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, selfRegManager, uuidSvc, certSubjMappingSvc, "", apiclient.OrdAggregatorClientConfig{}, envConsumerSubjects, nil)
 
 			// WHEN
 			result, err := resolver.DeleteApplicationTemplate(ctx, testID)
@@ -3249,3 +3302,57 @@ func SuccessfulSkipCertSubjectMappingCreation(csm *model.CertSubjectMapping) fun
 		return svc
 	}
 }
+
+func TestResolver_ApplicationLabels(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+	ctx = consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: testConsumerID})
+
+	globalSubaccountIDLabelKey := "global_subaccount_id"
+	filters := []*labelfilter.LabelFilter{
+		labelfilter.NewForKeyWithQuery(globalSubaccountIDLabelKey, fmt.Sprintf("\"%s\"", "consumer-id")),
+	}
+
+	jsonAppCreateInput := fixJSONApplicationCreateInput(testName)
+	modelAppTemplate := fixModelAppTemplateWithAppInputJSON(testID, testName, jsonAppCreateInput, fixModelApplicationTemplateWebhooks(testWebhookID, testID))
+	gqlAppCreateInput := fixGQLApplicationCreateInput(testName)
+	gqlAppFromTemplateInput := fixGQLApplicationFromTemplateInput(testName)
+	modelAppFromTemplateInput := fixModelApplicationFromTemplateInput(testName)
+
+	t.Run("Success", func(t *testing.T) {
+		appTemplateSvc := &automock.ApplicationTemplateService{}
+		appTemplateSvc.On("ListByFilters", ctx, filters).Return([]*model.ApplicationTemplate{}, nil).Once()
+		appTemplateSvc.On("ListByName", ctx, testName).Return([]*model.ApplicationTemplate{modelAppTemplate}, nil).Once()
+		appTemplateSvc.On("GetLabel", ctx, testID, globalSubaccountIDLabelKey).Return(nil, apperrors.NewNotFoundError(resource.Label, "id")).Once()
+		appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+		appTemplateConv := &automock.ApplicationTemplateConverter{}
+		appTemplateConv.On("ApplicationFromTemplateInputFromGraphQL", modelAppTemplate, gqlAppFromTemplateInput).Return(modelAppFromTemplateInput, nil).Once()
+		appConv := &automock.ApplicationConverter{}
+		appConv.On("CreateRegisterInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+		appConv.On("CreateInputFromGraphQL", ctx, gqlAppCreateInput).Return(fixModelApplicationCreateInput(testName), nil).Once()
+		defer mock.AssertExpectationsForObjects(t, appTemplateSvc, appTemplateConv, appConv)
+
+		resolver := apptemplate.NewResolver(nil, nil, appConv, appTemplateSvc, appTemplateConv, nil, nil, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, []string{}, nil)
+
+		// WHEN
+		labels, err := resolver.ApplicationLabels(ctx, gqlAppFromTemplateInput)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixModelApplicationWithManagedLabelCreateInput(testName, "false").Labels, labels)
+	})
+
+	t.Run("Returns error when the application template does not exist", func(t *testing.T) {
+		appTemplateSvc := &automock.ApplicationTemplateService{}
+		appTemplateSvc.On("ListByFilters", ctx, filters).Return(nil, testError).Once()
+		defer appTemplateSvc.AssertExpectations(t)
+
+		resolver := apptemplate.NewResolver(nil, nil, nil, appTemplateSvc, nil, nil, nil, nil, nil, nil, nil, "", apiclient.OrdAggregatorClientConfig{}, []string{}, nil)
+
+		// WHEN
+		_, err := resolver.ApplicationLabels(ctx, gqlAppFromTemplateInput)
+
+		// THEN
+		require.EqualError(t, err, testError.Error())
+	})
+}
@@ -133,6 +133,10 @@ func (s *service) Create(ctx context.Context, in model.ApplicationTemplateInput)
 
 	log.C(ctx).Debugf("ID %s generated for Application Template with name %s", appTemplateID, in.Name)
 
+	if err := validatePlaceholderDefinitions(in.Placeholders); err != nil {
+		return "", err
+	}
+
 	appInputJSON, err := enrichWithApplicationTypeLabel(in.ApplicationInputJSON, in.Name)
 	if err != nil {
 		return "", err
@@ -311,6 +315,10 @@ func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, p
 // Update updates a given Application Template with its labels. Webhooks are deleted and re-created.
 // It also finds the Application children and updates their applicationTypeLabelKey label
 func (s *service) Update(ctx context.Context, id string, override bool, in model.ApplicationTemplateUpdateInput) error {
+	if err := validatePlaceholderDefinitions(in.Placeholders); err != nil {
+		return err
+	}
+
 	oldAppTemplate, err := s.Get(ctx, id)
 	if err != nil {
 		return err
@@ -402,23 +410,39 @@ func (s *service) Delete(ctx context.Context, id string) error {
 // PrepareApplicationCreateInputJSON prepares the string JSON representation of graphql.ApplicationRegisterInput by
 // populating the placeholders in the Application Input with the given input values
 func (s *service) PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, error) {
-	appCreateInputJSON := appTemplate.ApplicationInputJSON
+	placeholderValues := make(map[string]string, len(appTemplate.Placeholders))
+	placeholderErrors := make(map[string]error)
 	for _, placeholder := range appTemplate.Placeholders {
 		newValue, err := values.FindPlaceholderValue(placeholder.Name)
-		isOptional := false
-		if placeholder.Optional != nil {
-			isOptional = *placeholder.Optional
+		if err != nil && placeholder.DefaultValue != nil {
+			newValue, err = *placeholder.DefaultValue, nil
 		}
 
-		if err != nil && !isOptional {
-			return "", errors.Wrap(err, "required placeholder not provided")
+		if err != nil && !placeholder.IsOptional() {
+			placeholderErrors[placeholder.Name] = errors.Wrap(err, "required placeholder not provided")
+			continue
 		}
 
-		err = validatePlaceholderValue(placeholder, newValue)
-		if err != nil {
-			return "", errors.Wrap(err, "value of placeholder is invalid")
+		if err = validatePlaceholderValue(placeholder, newValue); err != nil {
+			placeholderErrors[placeholder.Name] = errors.Wrap(err, "value of placeholder is invalid")
+			continue
 		}
 
+		if newValue != "" || !placeholder.IsOptional() {
+			if err = validateTypedPlaceholderValue(placeholder, newValue); err != nil {
+				placeholderErrors[placeholder.Name] = errors.Wrap(err, "value of placeholder is invalid")
+				continue
+			}
+		}
+		placeholderValues[placeholder.Name] = newValue
+	}
+	if err := apperrors.NewInvalidDataErrorWithFields(placeholderErrors, "placeholder values"); err != nil {
+		return "", err
+	}
+
+	appCreateInputJSON := appTemplate.ApplicationInputJSON
+	for _, placeholder := range appTemplate.Placeholders {
+		newValue := placeholderValues[placeholder.Name]
 		labelKey, err := getLabelKeyForPlaceholder(appCreateInputJSON, placeholder.Name)
 		if err != nil {
 			return "", errors.Wrap(err, "error while looking for label key")
@@ -271,6 +271,21 @@ func TestService_Create(t *testing.T) {
 			LabelRepoFn:      UnusedLabelRepo,
 			ExpectedError:    testError,
 		},
+		{
+			Name: "Error when placeholder default value does not satisfy the placeholder definition",
+			Input: func() *model.ApplicationTemplateInput {
+				in := fixModelAppTemplateInput(testName, appInputJSON)
+				in.Placeholders = []model.ApplicationTemplatePlaceholder{
+					{Name: "region", Type: placeholderTypePtr(model.PlaceholderTypeEnum), AllowedValues: []string{"eu10"}, DefaultValue: str.Ptr("us10")},
+				}
+				return in
+			},
+			AppTemplateRepoFn: UnusedAppTemplateRepo,
+			WebhookRepoFn:     UnusedWebhookRepo,
+			LabelUpsertSvcFn:  UnusedLabelUpsertSvc,
+			LabelRepoFn:       UnusedLabelRepo,
+			ExpectedError:     errors.New(`Invalid data placeholder definitions [region=invalid default value: value "us10" is not one of the allowed values [eu10]]`),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1920,6 +1935,68 @@ func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 			ExpectedOutput: "",
 			ExpectedError:  errors.New("value of placeholder is invalid: your application type cannot start with \"SAP\""),
 		},
+		{
+			Name: "Success when typed placeholders are valid",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"BaseURL": "{{base-url}}", "Region": "{{region}}", "Number": "{{system-number}}", "ID": "{{system-id}}", "Enabled": "{{enabled}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "base-url", Type: placeholderTypePtr(model.PlaceholderTypeURL)},
+					{Name: "region", Type: placeholderTypePtr(model.PlaceholderTypeEnum), AllowedValues: []string{"eu10", "us10"}},
+					{Name: "system-number", Type: placeholderTypePtr(model.PlaceholderTypeInteger), Pattern: str.Ptr(`^\d{3}$`)},
+					{Name: "system-id", Type: placeholderTypePtr(model.PlaceholderTypeUUID)},
+					{Name: "enabled", Type: placeholderTypePtr(model.PlaceholderTypeBoolean)},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "base-url", Value: "https://system.example.com"},
+				{Placeholder: "region", Value: "eu10"},
+				{Placeholder: "system-number", Value: "042"},
+				{Placeholder: "system-id", Value: "5e0a4a6a-0f5b-4c4c-9d3e-2b7a1c5d9f10"},
+				{Placeholder: "enabled", Value: "true"},
+			},
+			ExpectedOutput: `{"BaseURL": "https://system.example.com", "Region": "eu10", "Number": "042", "ID": "5e0a4a6a-0f5b-4c4c-9d3e-2b7a1c5d9f10", "Enabled": "true"}`,
+		},
+		{
+			Name: "Success when default value is used for missing placeholder",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Region": "{{region}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "region", Type: placeholderTypePtr(model.PlaceholderTypeEnum), AllowedValues: []string{"eu10", "us10"}, DefaultValue: str.Ptr("us10")},
+				},
+			},
+			InputValues:    []*model.ApplicationTemplateValueInput{},
+			ExpectedOutput: `{"Region": "us10"}`,
+		},
+		{
+			Name: "Returns errors for every invalid typed placeholder",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"BaseURL": "{{base-url}}", "Number": "{{system-number}}", "Region": "{{region}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "base-url", Type: placeholderTypePtr(model.PlaceholderTypeURL)},
+					{Name: "system-number", Type: placeholderTypePtr(model.PlaceholderTypeInteger)},
+					{Name: "region", Type: placeholderTypePtr(model.PlaceholderTypeEnum), AllowedValues: []string{"eu10"}},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "base-url", Value: "system.example.com"},
+				{Placeholder: "system-number", Value: "abc"},
+				{Placeholder: "region", Value: "eu10"},
+			},
+			ExpectedError: errors.New(`Invalid data placeholder values [base-url=value of placeholder is invalid: value "system.example.com" is not an absolute URL; system-number=value of placeholder is invalid: value "abc" is not an integer]`),
+		},
+		{
+			Name: "Returns error without the value of a sensitive placeholder",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Key": "{{key}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "key", Pattern: str.Ptr(`^[a-f0-9]{8}$`), Sensitive: &placeholderIsOptional},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "key", Value: "secret-value"},
+			},
+			ExpectedError: errors.New(`key=value of placeholder is invalid: value does not match the pattern ^[a-f0-9]{8}$`),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1940,6 +2017,10 @@ func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 	}
 }
 
+func placeholderTypePtr(placeholderType model.PlaceholderType) *model.PlaceholderType {
+	return &placeholderType
+}
+
 func UnusedLabelRepo() *automock.LabelRepository {
 	return &automock.LabelRepository{}
 }
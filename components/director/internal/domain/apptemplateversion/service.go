@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
@@ -127,3 +128,24 @@ func (s *service) ListByAppTemplateID(ctx context.Context, appTemplateID string)
 
 	return applicationTemplateVersion, nil
 }
+
+// GetLatestByAppTemplateID gets the most recently created ApplicationTemplateVersion of an Application Template
+func (s *service) GetLatestByAppTemplateID(ctx context.Context, appTemplateID string) (*model.ApplicationTemplateVersion, error) {
+	applicationTemplateVersions, err := s.ListByAppTemplateID(ctx, appTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(applicationTemplateVersions) == 0 {
+		return nil, apperrors.NewNotFoundErrorWithMessage(resource.ApplicationTemplateVersion, appTemplateID, "Application Template has no versions")
+	}
+
+	latest := applicationTemplateVersions[0]
+	for _, applicationTemplateVersion := range applicationTemplateVersions[1:] {
+		if applicationTemplateVersion.CreatedAt.After(latest.CreatedAt) {
+			latest = applicationTemplateVersion
+		}
+	}
+
+	return latest, nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplateversion"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplateversion/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -313,6 +315,74 @@ func TestService_ListByAppTemplateID(t *testing.T) {
 	}
 }
 
+func TestService_GetLatestByAppTemplateID(t *testing.T) {
+	// GIVEN
+	ctx := context.Background()
+	olderApplicationTemplateVersion := fixModelApplicationTemplateVersion("older-id")
+	olderApplicationTemplateVersion.CreatedAt = mockedTimestamp.Add(-time.Hour)
+	latestApplicationTemplateVersion := fixModelApplicationTemplateVersion(appTemplateVersionID)
+
+	testCases := []struct {
+		Name                     string
+		AppTemplateVersionRepoFn func() *automock.ApplicationTemplateVersionRepository
+		ExpectedError            error
+		ExpectedOutput           *model.ApplicationTemplateVersion
+	}{
+		{
+			Name: "Success",
+			AppTemplateVersionRepoFn: func() *automock.ApplicationTemplateVersionRepository {
+				appTemplateVersionRepo := &automock.ApplicationTemplateVersionRepository{}
+				appTemplateVersionRepo.On("ListByAppTemplateID", ctx, appTemplateID).Return([]*model.ApplicationTemplateVersion{olderApplicationTemplateVersion, latestApplicationTemplateVersion}, nil).Once()
+				return appTemplateVersionRepo
+			},
+			ExpectedOutput: latestApplicationTemplateVersion,
+		},
+		{
+			Name: "Returns not found error when the Application Template has no versions",
+			AppTemplateVersionRepoFn: func() *automock.ApplicationTemplateVersionRepository {
+				appTemplateVersionRepo := &automock.ApplicationTemplateVersionRepository{}
+				appTemplateVersionRepo.On("ListByAppTemplateID", ctx, appTemplateID).Return([]*model.ApplicationTemplateVersion{}, nil).Once()
+				return appTemplateVersionRepo
+			},
+			ExpectedError: apperrors.NewNotFoundErrorWithMessage(resource.ApplicationTemplateVersion, appTemplateID, "Application Template has no versions"),
+		},
+		{
+			Name: "Returns an error when listing the Application Template Versions",
+			AppTemplateVersionRepoFn: func() *automock.ApplicationTemplateVersionRepository {
+				appTemplateVersionRepo := &automock.ApplicationTemplateVersionRepository{}
+				appTemplateVersionRepo.On("ListByAppTemplateID", ctx, appTemplateID).Return(nil, testError).Once()
+				return appTemplateVersionRepo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appTemplateVersionRepo := testCase.AppTemplateVersionRepoFn()
+			idSvc := fixEmptyUIDService()
+			timeSvc := fixEmptyTimeService()
+			appTemplateSvc := fixEmptyAppTemplateService()
+			svc := apptemplateversion.NewService(appTemplateVersionRepo, appTemplateSvc, idSvc, timeSvc)
+
+			defer mock.AssertExpectationsForObjects(t, idSvc, appTemplateVersionRepo, timeSvc, appTemplateSvc)
+
+			// WHEN
+			result, err := svc.GetLatestByAppTemplateID(ctx, appTemplateID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.ExpectedOutput, result)
+		})
+	}
+}
+
 func fixUIDService() *automock.UIDService {
 	uidSvc := &automock.UIDService{}
 	uidSvc.On("Generate").Return(appTemplateVersionID)
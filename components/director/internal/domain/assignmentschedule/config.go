@@ -0,0 +1,13 @@
+package assignmentschedule
+
+import "time"
+
+// Config configures the job which assigns and unassigns the objects with validity periods
+type Config struct {
+	// Enabled switches the assignment schedule job on
+	Enabled bool `envconfig:"default=false,APP_FORMATION_ASSIGNMENT_SCHEDULE_ENABLED"`
+	// JobInterval is how often the scheduled and expired formation assignments are processed
+	JobInterval time.Duration `envconfig:"default=1m,APP_FORMATION_ASSIGNMENT_SCHEDULE_JOB_INTERVAL"`
+	// BatchSize is the maximum number of scheduled and expired formation assignments processed in a single run of the job
+	BatchSize int `envconfig:"default=100,APP_FORMATION_ASSIGNMENT_SCHEDULE_BATCH_SIZE"`
+}
@@ -0,0 +1,66 @@
+package assignmentschedule_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	assignmentID     = "b7d6d2b6-4e1f-4a4b-8e2c-0f3f4b0b1c11"
+	formationID      = "f1d4ad5b-5b8e-4c6a-9b1c-7f6c8b1a1d22"
+	formationName    = "test-formation"
+	tenantID         = "a0c2e5a8-3f3c-4a57-9d3a-2d4b6b8c9e33"
+	externalTenantID = "external-tenant-id"
+	applicationID    = "c3b2a1d4-7e6f-4a5b-8c9d-0e1f2a3b4c44"
+	batchSize        = 100
+)
+
+var (
+	testErr     = errors.New("test error")
+	notFoundErr = apperrors.NewNotFoundError(resource.FormationAssignment, assignmentID)
+	now         = time.Date(2024, 7, 8, 10, 0, 0, 0, time.UTC)
+	validFrom   = now.Add(-time.Minute)
+	validUntil  = now.Add(time.Hour)
+)
+
+func fixAssignment(state model.FormationAssignmentState) *model.FormationAssignment {
+	return &model.FormationAssignment{
+		ID:          assignmentID,
+		FormationID: formationID,
+		TenantID:    tenantID,
+		Source:      applicationID,
+		SourceType:  model.FormationAssignmentTypeApplication,
+		Target:      applicationID,
+		TargetType:  model.FormationAssignmentTypeApplication,
+		State:       string(state),
+		ValidFrom:   &validFrom,
+		ValidUntil:  &validUntil,
+	}
+}
+
+func fixFailedAssignment() *model.FormationAssignment {
+	assignment := fixAssignment(model.CreateErrorAssignmentState)
+	assignment.Error = json.RawMessage(`{"error":{"message":"test error","errorCode":1}}`)
+	return assignment
+}
+
+func fixFormation() *model.Formation {
+	return &model.Formation{
+		ID:       formationID,
+		TenantID: tenantID,
+		Name:     formationName,
+		State:    model.ReadyFormationState,
+	}
+}
+
+func fixTenant() *model.BusinessTenantMapping {
+	return &model.BusinessTenantMapping{
+		ID:             tenantID,
+		ExternalTenant: externalTenantID,
+	}
+}
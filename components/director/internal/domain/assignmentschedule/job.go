@@ -0,0 +1,41 @@
+package assignmentschedule
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/cronjob"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+// AssignmentScheduler assigns the scheduled objects to their formations and unassigns the expired ones
+type AssignmentScheduler interface {
+	ActivateScheduledAssignments(ctx context.Context) (int, error)
+	UnassignExpiredAssignments(ctx context.Context) (int, error)
+}
+
+// StartScheduleJob starts the job which triggers the assign and unassign operations of the objects with validity periods and blocks.
+// Only the leader instance executes the job.
+func StartScheduleJob(ctx context.Context, cfg Config, electionCfg cronjob.ElectionConfig, scheduler AssignmentScheduler) error {
+	scheduleJob := cronjob.CronJob{
+		Name: "ProcessFormationAssignmentSchedules",
+		Fn: func(jobCtx context.Context) {
+			activated, err := scheduler.ActivateScheduledAssignments(jobCtx)
+			if err != nil {
+				log.C(jobCtx).WithError(err).Error("Failed to assign the scheduled objects to their formations")
+			} else if activated > 0 {
+				log.C(jobCtx).Infof("Assigned %d scheduled objects to their formations", activated)
+			}
+
+			unassigned, err := scheduler.UnassignExpiredAssignments(jobCtx)
+			if err != nil {
+				log.C(jobCtx).WithError(err).Error("Failed to unassign the expired objects from their formations")
+				return
+			}
+			if unassigned > 0 {
+				log.C(jobCtx).Infof("Unassigned %d expired objects from their formations", unassigned)
+			}
+		},
+		SchedulePeriod: cfg.JobInterval,
+	}
+	return cronjob.RunCronJob(ctx, electionCfg, scheduleJob)
+}
@@ -0,0 +1,238 @@
+package assignmentschedule
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// Now is a function variable that returns the current time. It is used, so we could mock it in the tests.
+var Now = time.Now
+
+// FormationAssignmentRepository is responsible for the repo-layer formation assignment operations
+//
+//go:generate mockery --name=FormationAssignmentRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationAssignmentRepository interface {
+	ListScheduledDueGlobal(ctx context.Context, now time.Time, limit int) ([]*model.FormationAssignment, error)
+	ListExpiredGlobal(ctx context.Context, now time.Time, limit int) ([]*model.FormationAssignment, error)
+	GetGlobalByID(ctx context.Context, id string) (*model.FormationAssignment, error)
+	Update(ctx context.Context, fa *model.FormationAssignment) error
+}
+
+// FormationRepository is responsible for the repo-layer formation operations
+//
+//go:generate mockery --name=FormationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationRepository interface {
+	GetGlobalByID(ctx context.Context, id string) (*model.Formation, error)
+}
+
+// TenantRepository is responsible for the repo-layer tenant operations
+//
+//go:generate mockery --name=TenantRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type TenantRepository interface {
+	Get(ctx context.Context, id string) (*model.BusinessTenantMapping, error)
+}
+
+// FormationService is responsible for the assign and unassign operations
+//
+//go:generate mockery --name=FormationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationService interface {
+	AssignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation, initialConfigurations model.InitialConfigurations) (*model.Formation, error)
+	UnassignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation, ignoreASA bool) (*model.Formation, error)
+}
+
+// Scheduler triggers the regular assign and unassign operations for the objects assigned with a validity period.
+//
+// The validity period of an object is kept on its self-referencing formation assignment. When the period starts in the future
+// the formation assignment is in SCHEDULED state and the object is not yet part of the formation. Once the period starts
+// the object is assigned to the formation, and once the period ends it is unassigned from it.
+type Scheduler struct {
+	transact                persistence.Transactioner
+	formationAssignmentRepo FormationAssignmentRepository
+	formationRepo           FormationRepository
+	tenantRepo              TenantRepository
+	formationSvc            FormationService
+	batchSize               int
+}
+
+// NewScheduler creates a new formation assignment Scheduler
+func NewScheduler(transact persistence.Transactioner, formationAssignmentRepo FormationAssignmentRepository, formationRepo FormationRepository, tenantRepo TenantRepository, formationSvc FormationService, batchSize int) *Scheduler {
+	return &Scheduler{
+		transact:                transact,
+		formationAssignmentRepo: formationAssignmentRepo,
+		formationRepo:           formationRepo,
+		tenantRepo:              tenantRepo,
+		formationSvc:            formationSvc,
+		batchSize:               batchSize,
+	}
+}
+
+// ActivateScheduledAssignments assigns the objects whose validity period has started to their formations and returns the number of the assigned objects.
+// Every object is assigned separately, so a failure is logged, stored in the formation assignment and does not affect the others.
+func (s *Scheduler) ActivateScheduledAssignments(ctx context.Context) (int, error) {
+	assignments, err := s.listAssignments(ctx, func(ctx context.Context) ([]*model.FormationAssignment, error) {
+		return s.formationAssignmentRepo.ListScheduledDueGlobal(ctx, Now(), s.batchSize)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "while listing the scheduled formation assignments")
+	}
+
+	activated := 0
+	for _, assignment := range assignments {
+		if err = s.activate(ctx, assignment); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to assign object with ID: %q of type: %q to formation with ID: %q", assignment.Source, assignment.SourceType, assignment.FormationID)
+			s.setToErrorState(ctx, assignment.ID, err)
+			continue
+		}
+		activated++
+	}
+
+	return activated, nil
+}
+
+// UnassignExpiredAssignments unassigns the objects whose validity period has ended from their formations and returns the number of the unassigned objects.
+// Every object is unassigned separately, so a failure is logged and does not affect the others.
+func (s *Scheduler) UnassignExpiredAssignments(ctx context.Context) (int, error) {
+	assignments, err := s.listAssignments(ctx, func(ctx context.Context) ([]*model.FormationAssignment, error) {
+		return s.formationAssignmentRepo.ListExpiredGlobal(ctx, Now(), s.batchSize)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "while listing the expired formation assignments")
+	}
+
+	unassigned := 0
+	for _, assignment := range assignments {
+		if err = s.unassign(ctx, assignment); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to unassign object with ID: %q of type: %q from formation with ID: %q", assignment.Source, assignment.SourceType, assignment.FormationID)
+			continue
+		}
+		unassigned++
+	}
+
+	return unassigned, nil
+}
+
+// activate moves the scheduled formation assignment to INITIAL state and assigns the object to the formation.
+// The state is changed in a separate transaction as the assign operation persists the formation assignments in transactions of its own.
+func (s *Scheduler) activate(ctx context.Context, assignment *model.FormationAssignment) error {
+	ctx, err := s.contextWithTenant(ctx, assignment.TenantID)
+	if err != nil {
+		return err
+	}
+
+	var formation *model.Formation
+	if err = s.inTransaction(ctx, func(ctx context.Context) error {
+		if formation, err = s.formationRepo.GetGlobalByID(ctx, assignment.FormationID); err != nil {
+			return errors.Wrapf(err, "while getting formation with ID: %q", assignment.FormationID)
+		}
+
+		assignment.State = string(model.InitialAssignmentState)
+		if err = s.formationAssignmentRepo.Update(ctx, assignment); err != nil {
+			return errors.Wrapf(err, "while updating formation assignment with ID: %q", assignment.ID)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	log.C(ctx).Infof("The validity period of object with ID: %q started. Assigning it to formation %q", assignment.Source, formation.Name)
+	return s.inTransaction(ctx, func(ctx context.Context) error {
+		_, err := s.formationSvc.AssignFormation(ctx, assignment.TenantID, assignment.Source, graphql.FormationObjectType(assignment.SourceType), *formation, nil)
+		return err
+	})
+}
+
+func (s *Scheduler) unassign(ctx context.Context, assignment *model.FormationAssignment) error {
+	ctx, err := s.contextWithTenant(ctx, assignment.TenantID)
+	if err != nil {
+		return err
+	}
+
+	return s.inTransaction(ctx, func(ctx context.Context) error {
+		formation, err := s.formationRepo.GetGlobalByID(ctx, assignment.FormationID)
+		if err != nil {
+			return errors.Wrapf(err, "while getting formation with ID: %q", assignment.FormationID)
+		}
+
+		log.C(ctx).Infof("The validity period of object with ID: %q ended. Unassigning it from formation %q", assignment.Source, formation.Name)
+		_, err = s.formationSvc.UnassignFormation(ctx, assignment.TenantID, assignment.Source, graphql.FormationObjectType(assignment.SourceType), *formation, false)
+		return err
+	})
+}
+
+// setToErrorState stores the failure in the formation assignment if it was not deleted by the failed assign operation
+func (s *Scheduler) setToErrorState(ctx context.Context, assignmentID string, failure error) {
+	err := s.inTransaction(ctx, func(ctx context.Context) error {
+		assignment, err := s.formationAssignmentRepo.GetGlobalByID(ctx, assignmentID)
+		if err != nil {
+			if apperrors.IsNotFoundError(err) {
+				return nil
+			}
+			return errors.Wrapf(err, "while getting formation assignment with ID: %q", assignmentID)
+		}
+
+		assignmentError, err := json.Marshal(formationassignment.AssignmentErrorWrapper{Error: formationassignment.AssignmentError{
+			Message:   failure.Error(),
+			ErrorCode: formationassignment.TechnicalError,
+		}})
+		if err != nil {
+			return errors.Wrapf(err, "while preparing error message for formation assignment with ID: %q", assignmentID)
+		}
+
+		assignment.State = string(model.CreateErrorAssignmentState)
+		assignment.Error = assignmentError
+		return s.formationAssignmentRepo.Update(ctx, assignment)
+	})
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to set formation assignment with ID: %q to %s state", assignmentID, model.CreateErrorAssignmentState)
+	}
+}
+
+// contextWithTenant stores the tenant of the formation assignment in the context as the assign and unassign operations are tenant scoped
+func (s *Scheduler) contextWithTenant(ctx context.Context, tenantID string) (context.Context, error) {
+	var tnt *model.BusinessTenantMapping
+	if err := s.inTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if tnt, err = s.tenantRepo.Get(ctx, tenantID); err != nil {
+			return errors.Wrapf(err, "while getting tenant with ID: %q", tenantID)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return tenant.SaveToContext(ctx, tnt.ID, tnt.ExternalTenant), nil
+}
+
+func (s *Scheduler) listAssignments(ctx context.Context, list func(ctx context.Context) ([]*model.FormationAssignment, error)) ([]*model.FormationAssignment, error) {
+	var assignments []*model.FormationAssignment
+	err := s.inTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		assignments, err = list(ctx)
+		return err
+	})
+	return assignments, err
+}
+
+func (s *Scheduler) inTransaction(ctx context.Context, dbCalls func(ctx context.Context) error) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	if err = dbCalls(persistence.SaveToContext(ctx, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,344 @@
+package assignmentschedule_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/assignmentschedule"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/assignmentschedule/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_ActivateScheduledAssignments(t *testing.T) {
+	assignmentschedule.Now = func() time.Time { return now }
+	defer func() { assignmentschedule.Now = time.Now }()
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name              string
+		TxFn              func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AssignmentRepoFn  func() *automock.FormationAssignmentRepository
+		FormationRepoFn   func() *automock.FormationRepository
+		TenantRepoFn      func() *automock.TenantRepository
+		FormationSvcFn    func() *automock.FormationService
+		ExpectedActivated int
+		ExpectedError     string
+	}{
+		{
+			Name: "Success",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(4)
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListScheduledDueGlobal", txtest.CtxWithDBMatcher(), now, batchSize).Return([]*model.FormationAssignment{fixAssignment(model.ScheduledAssignmentState)}, nil).Once()
+				repo.On("Update", ctxWithTenantAndDBMatcher(), fixAssignment(model.InitialAssignmentState)).Return(nil).Once()
+				return repo
+			},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetGlobalByID", ctxWithTenantAndDBMatcher(), formationID).Return(fixFormation(), nil).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("Get", txtest.CtxWithDBMatcher(), tenantID).Return(fixTenant(), nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("AssignFormation", ctxWithTenantAndDBMatcher(), tenantID, applicationID, graphql.FormationObjectTypeApplication, *fixFormation(), model.InitialConfigurations(nil)).Return(fixFormation(), nil).Once()
+				return svc
+			},
+			ExpectedActivated: 1,
+		},
+		{
+			Name: "Failed assign is stored in the formation assignment",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(5, 4)
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListScheduledDueGlobal", txtest.CtxWithDBMatcher(), now, batchSize).Return([]*model.FormationAssignment{fixAssignment(model.ScheduledAssignmentState)}, nil).Once()
+				repo.On("Update", ctxWithTenantAndDBMatcher(), fixAssignment(model.InitialAssignmentState)).Return(nil).Once()
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.InitialAssignmentState), nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), fixFailedAssignment()).Return(nil).Once()
+				return repo
+			},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetGlobalByID", ctxWithTenantAndDBMatcher(), formationID).Return(fixFormation(), nil).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("Get", txtest.CtxWithDBMatcher(), tenantID).Return(fixTenant(), nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("AssignFormation", ctxWithTenantAndDBMatcher(), tenantID, applicationID, graphql.FormationObjectTypeApplication, *fixFormation(), model.InitialConfigurations(nil)).Return(nil, testErr).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Failed assign is only logged when the formation assignment is already deleted",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(5, 4)
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListScheduledDueGlobal", txtest.CtxWithDBMatcher(), now, batchSize).Return([]*model.FormationAssignment{fixAssignment(model.ScheduledAssignmentState)}, nil).Once()
+				repo.On("Update", ctxWithTenantAndDBMatcher(), fixAssignment(model.InitialAssignmentState)).Return(nil).Once()
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetGlobalByID", ctxWithTenantAndDBMatcher(), formationID).Return(fixFormation(), nil).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("Get", txtest.CtxWithDBMatcher(), tenantID).Return(fixTenant(), nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("AssignFormation", ctxWithTenantAndDBMatcher(), tenantID, applicationID, graphql.FormationObjectTypeApplication, *fixFormation(), model.InitialConfigurations(nil)).Return(nil, testErr).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Assignment is skipped when getting the tenant fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 1)
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListScheduledDueGlobal", txtest.CtxWithDBMatcher(), now, batchSize).Return([]*model.FormationAssignment{fixAssignment(model.ScheduledAssignmentState)}, nil).Once()
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(nil, testErr).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("Get", txtest.CtxWithDBMatcher(), tenantID).Return(nil, testErr).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when listing the scheduled formation assignments fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListScheduledDueGlobal", txtest.CtxWithDBMatcher(), now, batchSize).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: "while listing the scheduled formation assignments",
+		},
+		{
+			Name:          "Error when beginning the transaction fails",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			assignmentRepo, formationRepo, tenantRepo, formationSvc := fixMocks(testCase.AssignmentRepoFn, testCase.FormationRepoFn, testCase.TenantRepoFn, testCase.FormationSvcFn)
+
+			scheduler := assignmentschedule.NewScheduler(transact, assignmentRepo, formationRepo, tenantRepo, formationSvc, batchSize)
+
+			// WHEN
+			activated, err := scheduler.ActivateScheduledAssignments(context.TODO())
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedActivated, activated)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, assignmentRepo, formationRepo, tenantRepo, formationSvc)
+		})
+	}
+}
+
+func TestScheduler_UnassignExpiredAssignments(t *testing.T) {
+	assignmentschedule.Now = func() time.Time { return now }
+	defer func() { assignmentschedule.Now = time.Now }()
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name               string
+		TxFn               func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AssignmentRepoFn   func() *automock.FormationAssignmentRepository
+		FormationRepoFn    func() *automock.FormationRepository
+		TenantRepoFn       func() *automock.TenantRepository
+		FormationSvcFn     func() *automock.FormationService
+		ExpectedUnassigned int
+		ExpectedError      string
+	}{
+		{
+			Name: "Success",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListExpiredGlobal", txtest.CtxWithDBMatcher(), now, batchSize).Return([]*model.FormationAssignment{fixAssignment(model.ReadyAssignmentState)}, nil).Once()
+				return repo
+			},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetGlobalByID", ctxWithTenantAndDBMatcher(), formationID).Return(fixFormation(), nil).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("Get", txtest.CtxWithDBMatcher(), tenantID).Return(fixTenant(), nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("UnassignFormation", ctxWithTenantAndDBMatcher(), tenantID, applicationID, graphql.FormationObjectTypeApplication, *fixFormation(), false).Return(fixFormation(), nil).Once()
+				return svc
+			},
+			ExpectedUnassigned: 1,
+		},
+		{
+			Name: "Failed unassign is not counted",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(2)
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListExpiredGlobal", txtest.CtxWithDBMatcher(), now, batchSize).Return([]*model.FormationAssignment{fixAssignment(model.ReadyAssignmentState)}, nil).Once()
+				return repo
+			},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetGlobalByID", ctxWithTenantAndDBMatcher(), formationID).Return(fixFormation(), nil).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("Get", txtest.CtxWithDBMatcher(), tenantID).Return(fixTenant(), nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("UnassignFormation", ctxWithTenantAndDBMatcher(), tenantID, applicationID, graphql.FormationObjectTypeApplication, *fixFormation(), false).Return(nil, testErr).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Failed getting of the formation is not counted",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(2)
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListExpiredGlobal", txtest.CtxWithDBMatcher(), now, batchSize).Return([]*model.FormationAssignment{fixAssignment(model.ReadyAssignmentState)}, nil).Once()
+				return repo
+			},
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetGlobalByID", ctxWithTenantAndDBMatcher(), formationID).Return(nil, testErr).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("Get", txtest.CtxWithDBMatcher(), tenantID).Return(fixTenant(), nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when listing the expired formation assignments fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListExpiredGlobal", txtest.CtxWithDBMatcher(), now, batchSize).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: "while listing the expired formation assignments",
+		},
+		{
+			Name:          "Error when committing the transaction fails",
+			TxFn:          txGen.ThatFailsOnCommit,
+			ExpectedError: testErr.Error(),
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListExpiredGlobal", txtest.CtxWithDBMatcher(), now, batchSize).Return([]*model.FormationAssignment{}, nil).Once()
+				return repo
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			assignmentRepo, formationRepo, tenantRepo, formationSvc := fixMocks(testCase.AssignmentRepoFn, testCase.FormationRepoFn, testCase.TenantRepoFn, testCase.FormationSvcFn)
+
+			scheduler := assignmentschedule.NewScheduler(transact, assignmentRepo, formationRepo, tenantRepo, formationSvc, batchSize)
+
+			// WHEN
+			unassigned, err := scheduler.UnassignExpiredAssignments(context.TODO())
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedUnassigned, unassigned)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, assignmentRepo, formationRepo, tenantRepo, formationSvc)
+		})
+	}
+}
+
+func fixMocks(assignmentRepoFn func() *automock.FormationAssignmentRepository, formationRepoFn func() *automock.FormationRepository, tenantRepoFn func() *automock.TenantRepository, formationSvcFn func() *automock.FormationService) (*automock.FormationAssignmentRepository, *automock.FormationRepository, *automock.TenantRepository, *automock.FormationService) {
+	assignmentRepo := &automock.FormationAssignmentRepository{}
+	if assignmentRepoFn != nil {
+		assignmentRepo = assignmentRepoFn()
+	}
+	formationRepo := &automock.FormationRepository{}
+	if formationRepoFn != nil {
+		formationRepo = formationRepoFn()
+	}
+	tenantRepo := &automock.TenantRepository{}
+	if tenantRepoFn != nil {
+		tenantRepo = tenantRepoFn()
+	}
+	formationSvc := &automock.FormationService{}
+	if formationSvcFn != nil {
+		formationSvc = formationSvcFn()
+	}
+	return assignmentRepo, formationRepo, tenantRepo, formationSvc
+}
+
+func ctxWithTenantAndDBMatcher() interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		if _, err := persistence.FromCtx(ctx); err != nil {
+			return false
+		}
+		tntID, err := tenant.LoadFromContext(ctx)
+		return err == nil && tntID == tenantID
+	})
+}
@@ -1,9 +1,12 @@
 package capability
 
 import (
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 )
 
@@ -11,6 +14,7 @@ import (
 //
 //go:generate mockery --name=VersionConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
 type VersionConverter interface {
+	ToGraphQL(in *model.Version) *graphql.Version
 	FromEntity(version version.Version) *model.Version
 	ToEntity(version model.Version) version.Version
 }
@@ -108,3 +112,47 @@ func (c *converter) convertVersionToEntity(inVer *model.Version) version.Version
 
 	return c.version.ToEntity(*inVer)
 }
+
+// ToGraphQL converts the provided service-layer representation of a Capability to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.Capability) (*graphql.Capability, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var labels graphql.Labels
+	if in.Labels != nil {
+		if err := json.Unmarshal(in.Labels, &labels); err != nil {
+			return nil, err
+		}
+	}
+
+	var relatedEntityTypes []string
+	if in.RelatedEntityTypes != nil {
+		if err := json.Unmarshal(in.RelatedEntityTypes, &relatedEntityTypes); err != nil {
+			return nil, err
+		}
+	}
+
+	return &graphql.Capability{
+		ID:                  in.ID,
+		OrdID:               in.OrdID,
+		LocalID:             in.LocalTenantID,
+		Name:                in.Name,
+		Description:         in.Description,
+		ShortDescription:    in.ShortDescription,
+		Type:                in.Type,
+		CustomType:          in.CustomType,
+		PartOfPackage:       in.PackageID,
+		Visibility:          in.Visibility,
+		ReleaseStatus:       in.ReleaseStatus,
+		SystemInstanceAware: in.SystemInstanceAware,
+		RelatedEntityTypes:  relatedEntityTypes,
+		Links:               graphql.JSONPtrFromRawMessage(in.Links),
+		Tags:                graphql.JSONPtrFromRawMessage(in.Tags),
+		Labels:              labels,
+		DocumentationLabels: graphql.JSONPtrFromRawMessage(in.DocumentationLabels),
+		CorrelationIDs:      graphql.JSONPtrFromRawMessage(in.CorrelationIDs),
+		Version:             c.version.ToGraphQL(in.Version),
+		LastUpdate:          in.LastUpdate,
+	}, nil
+}
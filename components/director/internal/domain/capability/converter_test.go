@@ -1,10 +1,14 @@
 package capability_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/capability"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -78,3 +82,63 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		assert.Equal(t, expectedModel, capabilityModel)
 	})
 }
+
+func TestEntityConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		in := &model.Capability{
+			BaseEntity:         &model.BaseEntity{ID: "id"},
+			OrdID:              str.Ptr("ordID"),
+			Name:               "title",
+			Tags:               json.RawMessage(`["tag"]`),
+			Labels:             json.RawMessage(`{"key":["value"]}`),
+			Version:            &model.Version{Value: "1.0.0"},
+			RelatedEntityTypes: json.RawMessage(`["sap.odm:entityType:BusinessPartner:v1"]`),
+		}
+		tags := graphql.JSON(`["tag"]`)
+		expected := &graphql.Capability{
+			ID:                 "id",
+			OrdID:              str.Ptr("ordID"),
+			Name:               "title",
+			Tags:               &tags,
+			Labels:             graphql.Labels{"key": []interface{}{"value"}},
+			Version:            &graphql.Version{Value: "1.0.0"},
+			RelatedEntityTypes: []string{"sap.odm:entityType:BusinessPartner:v1"},
+		}
+		conv := capability.NewConverter(version.NewConverter())
+
+		// WHEN
+		result, err := conv.ToGraphQL(in)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Returns nil if capability model is nil", func(t *testing.T) {
+		conv := capability.NewConverter(version.NewConverter())
+
+		result, err := conv.ToGraphQL(nil)
+
+		require.NoError(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Returns error when labels are invalid", func(t *testing.T) {
+		conv := capability.NewConverter(version.NewConverter())
+
+		result, err := conv.ToGraphQL(&model.Capability{BaseEntity: &model.BaseEntity{ID: "id"}, Labels: json.RawMessage("invalid")})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Returns error when related entity types are invalid", func(t *testing.T) {
+		conv := capability.NewConverter(version.NewConverter())
+
+		result, err := conv.ToGraphQL(&model.Capability{BaseEntity: &model.BaseEntity{ID: "id"}, RelatedEntityTypes: json.RawMessage("invalid")})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+}
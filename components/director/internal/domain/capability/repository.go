@@ -6,11 +6,22 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 	"github.com/pkg/errors"
 )
 
-const capabilityTable string = `"public"."capabilities"`
+const (
+	capabilityTable            string = `"public"."capabilities"`
+	idColumn                   string = "id"
+	appIDColumn                string = "app_id"
+	appTemplateVersionIDColumn string = "app_template_version_id"
+	visibilityColumn           string = "visibility"
+	internalVisibilityScope    string = "internal_visibility:read"
+	publicVisibilityValue      string = "public"
+)
 
 var (
 	capabilityColumns = []string{"id", "app_id", "app_template_version_id", "package_id", "name", "description", "ord_id", "type", "custom_type", "local_tenant_id",
@@ -41,6 +52,9 @@ type pgRepository struct {
 	updaterGlobal      repo.UpdaterGlobal
 	deleter            repo.Deleter
 	deleterGlobal      repo.DeleterGlobal
+	unionLister        repo.UnionLister
+	unionListerGlobal  repo.UnionListerGlobal
+	queryBuilder       repo.QueryBuilderGlobal
 	conv               CapabilityConverter
 }
 
@@ -57,10 +71,92 @@ func NewRepository(conv CapabilityConverter) *pgRepository {
 		updaterGlobal:      repo.NewUpdaterGlobal(resource.Capability, capabilityTable, updatableColumns, idColumns),
 		deleter:            repo.NewDeleter(capabilityTable),
 		deleterGlobal:      repo.NewDeleterGlobal(resource.Capability, capabilityTable),
+		unionLister:        repo.NewUnionLister(capabilityTable, capabilityColumns),
+		unionListerGlobal:  repo.NewUnionListerGlobal(resource.Capability, capabilityTable, capabilityColumns),
+		queryBuilder:       repo.NewQueryBuilderGlobal(resource.Capability, capabilityTable, idColumns),
 		conv:               conv,
 	}
 }
 
+// ListByResourceIDs gets a page of capabilities for each of the given resource IDs. The resources are either applications or application template versions
+func (r *pgRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.CapabilityPage, error) {
+	conditions, err := r.visibilityConditions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilityCollection := CapabilityCollection{}
+
+	var counts map[string]int
+	if resourceType == resource.Application {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionLister.List(ctx, resource.Capability, tenantID, resourceIDs, appIDColumn, pageSize, cursor, orderByColumns, &capabilityCollection, conditions...)
+	} else {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appTemplateVersionIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionListerGlobal.ListGlobal(ctx, resourceIDs, appTemplateVersionIDColumn, pageSize, cursor, orderByColumns, &capabilityCollection, conditions...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	capabilitiesByResourceID := make(map[string][]*model.Capability, len(resourceIDs))
+	for _, capability := range capabilityCollection {
+		capabilityModel := r.conv.FromEntity(&capability)
+
+		resourceID := capability.ApplicationID.String
+		if resourceType != resource.Application {
+			resourceID = capability.ApplicationTemplateVersionID.String
+		}
+		capabilitiesByResourceID[resourceID] = append(capabilitiesByResourceID[resourceID], capabilityModel)
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	capabilityPages := make([]*model.CapabilityPage, 0, len(resourceIDs))
+	for _, resourceID := range resourceIDs {
+		totalCount := counts[resourceID]
+		hasNextPage := false
+		endCursor := ""
+		if totalCount > offset+len(capabilitiesByResourceID[resourceID]) {
+			hasNextPage = true
+			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		}
+
+		page := &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		}
+
+		capabilityPages = append(capabilityPages, &model.CapabilityPage{Data: capabilitiesByResourceID[resourceID], TotalCount: totalCount, PageInfo: page})
+	}
+
+	return capabilityPages, nil
+}
+
+func (r *pgRepository) visibilityConditions(ctx context.Context) (repo.Conditions, error) {
+	isInternalVisibilityScopePresent, err := scope.Contains(ctx, internalVisibilityScope)
+	if err != nil {
+		log.C(ctx).Infof("No scopes are present in the context meaning the flow is not user-initiated. Processing Capabilities without visibility check...")
+		return nil, nil
+	}
+	if isInternalVisibilityScopePresent {
+		log.C(ctx).Infof("Internal visibility scope is present in the context. Processing Capabilities without visibility check...")
+		return nil, nil
+	}
+
+	log.C(ctx).Infof("No internal visibility scope is present in the context. Processing only public Capabilities")
+	query, args, err := r.queryBuilder.BuildQueryGlobal(false, repo.NewEqualCondition(visibilityColumn, publicVisibilityValue))
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.Conditions{repo.NewInConditionForSubQuery(idColumn, query, args)}, nil
+}
+
 // CapabilityCollection is an array of Entities
 type CapabilityCollection []Entity
 
@@ -1,6 +1,7 @@
 package capability_test
 
 import (
+	"context"
 	"database/sql/driver"
 	"regexp"
 	"testing"
@@ -10,7 +11,12 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/capability/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPgRepository_ListByResourceID(t *testing.T) {
@@ -340,3 +346,164 @@ func TestPgRepository_DeleteGlobal(t *testing.T) {
 
 	suite.Run(t)
 }
+
+func TestPgRepository_ListByResourceIDs(t *testing.T) {
+	pageSize := 2
+	cursor := ""
+	emptyPageResourceID := "emptyPageResourceID"
+	entity1App := fixFullEntityCapabilityWithAppID(capabilityID, "name")
+	capabilityModel1App, _ := fixFullCapabilityModelWithAppID("name")
+	entity2App := fixFullEntityCapabilityWithAppID(capabilityID, "name2")
+	capabilityModel2App, _ := fixFullCapabilityModelWithAppID("name2")
+	entity1AppTemplateVersion := fixFullEntityCapabilityWithAppTemplateVersionID(capabilityID, "name")
+	capabilityModel1AppTemplateVersion, _ := fixFullCapabilityModelWithAppTemplateVersionID("name")
+	entity2AppTemplateVersion := fixFullEntityCapabilityWithAppTemplateVersionID(capabilityID, "name2")
+	capabilityModel2AppTemplateVersion, _ := fixFullCapabilityModelWithAppTemplateVersionID("name2")
+
+	suiteForApplication := testdb.RepoListPageableTestSuite{
+		Name: "List Capabilities for multiple Applications with paging",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`(SELECT id, app_id, app_template_version_id, package_id, name, description, ord_id, type, custom_type, local_tenant_id, short_description, system_instance_aware, tags, related_entity_types, links, release_status, labels, visibility, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, resource_hash, documentation_labels, correlation_ids, last_update FROM "public"."capabilities" WHERE (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $1)) AND app_id = $2 ORDER BY app_id ASC, id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, app_id, app_template_version_id, package_id, name, description, ord_id, type, custom_type, local_tenant_id, short_description, system_instance_aware, tags, related_entity_types, links, release_status, labels, visibility, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, resource_hash, documentation_labels, correlation_ids, last_update FROM "public"."capabilities" WHERE (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $5)) AND app_id = $6 ORDER BY app_id ASC, id ASC LIMIT $7 OFFSET $8)`),
+				Args:     []driver.Value{tenantID, emptyPageResourceID, pageSize, 0, tenantID, appID, pageSize, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRow(capabilityID, "name")...).AddRow(fixCapabilityRow(capabilityID, "name2")...)}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT app_id AS id, COUNT(*) AS total_count FROM "public"."capabilities" WHERE (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $1)) AND app_id IN ($2, $3) GROUP BY app_id ORDER BY app_id ASC`),
+				Args:     []driver.Value{tenantID, emptyPageResourceID, appID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow(emptyPageResourceID, 0).AddRow(appID, 2)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: nil,
+				ExpectedDBEntities:    nil,
+				ExpectedPage: &model.CapabilityPage{
+					Data: nil,
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 0,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{&capabilityModel1App, &capabilityModel2App},
+				ExpectedDBEntities:    []interface{}{&entity1App, &entity2App},
+				ExpectedPage: &model.CapabilityPage{
+					Data: []*model.Capability{&capabilityModel1App, &capabilityModel2App},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.CapabilityConverter{}
+		},
+		RepoConstructorFunc:       capability.NewRepository,
+		MethodName:                "ListByResourceIDs",
+		MethodArgs:                []interface{}{tenantID, resource.Application, []string{emptyPageResourceID, appID}, pageSize, cursor},
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForApplicationTemplateVersion := testdb.RepoListPageableTestSuite{
+		Name: "List Capabilities for multiple Application Template Versions with paging",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`(SELECT id, app_id, app_template_version_id, package_id, name, description, ord_id, type, custom_type, local_tenant_id, short_description, system_instance_aware, tags, related_entity_types, links, release_status, labels, visibility, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, resource_hash, documentation_labels, correlation_ids, last_update FROM "public"."capabilities" WHERE app_template_version_id = $1 ORDER BY app_template_version_id ASC, id ASC LIMIT $2 OFFSET $3) UNION (SELECT id, app_id, app_template_version_id, package_id, name, description, ord_id, type, custom_type, local_tenant_id, short_description, system_instance_aware, tags, related_entity_types, links, release_status, labels, visibility, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, resource_hash, documentation_labels, correlation_ids, last_update FROM "public"."capabilities" WHERE app_template_version_id = $4 ORDER BY app_template_version_id ASC, id ASC LIMIT $5 OFFSET $6)`),
+				Args:     []driver.Value{emptyPageResourceID, pageSize, 0, appTemplateVersionID, pageSize, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRowForAppTemplateVersion(capabilityID, "name")...).AddRow(fixCapabilityRowForAppTemplateVersion(capabilityID, "name2")...)}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT app_template_version_id AS id, COUNT(*) AS total_count FROM "public"."capabilities" WHERE app_template_version_id IN ($1, $2) GROUP BY app_template_version_id ORDER BY app_template_version_id ASC`),
+				Args:     []driver.Value{emptyPageResourceID, appTemplateVersionID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow(emptyPageResourceID, 0).AddRow(appTemplateVersionID, 2)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: nil,
+				ExpectedDBEntities:    nil,
+				ExpectedPage: &model.CapabilityPage{
+					Data: nil,
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 0,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{&capabilityModel1AppTemplateVersion, &capabilityModel2AppTemplateVersion},
+				ExpectedDBEntities:    []interface{}{&entity1AppTemplateVersion, &entity2AppTemplateVersion},
+				ExpectedPage: &model.CapabilityPage{
+					Data: []*model.Capability{&capabilityModel1AppTemplateVersion, &capabilityModel2AppTemplateVersion},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.CapabilityConverter{}
+		},
+		RepoConstructorFunc:       capability.NewRepository,
+		MethodName:                "ListByResourceIDs",
+		MethodArgs:                []interface{}{tenantID, resource.ApplicationTemplateVersion, []string{emptyPageResourceID, appTemplateVersionID}, pageSize, cursor},
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForApplication.Run(t)
+	suiteForApplicationTemplateVersion.Run(t)
+
+	t.Run("ListByResourceIDs returns only public capabilities when the internal visibility scope is missing", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`(SELECT id, app_id, app_template_version_id, package_id, name, description, ord_id, type, custom_type, local_tenant_id, short_description, system_instance_aware, tags, related_entity_types, links, release_status, labels, visibility, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, resource_hash, documentation_labels, correlation_ids, last_update FROM "public"."capabilities" WHERE id IN (SELECT id FROM "public"."capabilities" WHERE visibility = $1) AND (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $2)) AND app_id = $3 ORDER BY app_id ASC, id ASC LIMIT $4 OFFSET $5)`)).
+			WithArgs("public", tenantID, appID, pageSize, 0).
+			WillReturnRows(sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRow(capabilityID, "name")...))
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_id AS id, COUNT(*) AS total_count FROM "public"."capabilities" WHERE id IN (SELECT id FROM "public"."capabilities" WHERE visibility = $1) AND (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $2)) AND app_id IN ($3) GROUP BY app_id ORDER BY app_id ASC`)).
+			WithArgs("public", tenantID, appID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(appID, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		ctx = scope.SaveToContext(ctx, []string{"test:test"})
+
+		convMock := &automock.CapabilityConverter{}
+		convMock.On("FromEntity", &entity1App).Return(&capabilityModel1App).Once()
+		pgRepository := capability.NewRepository(convMock)
+
+		// WHEN
+		pages, err := pgRepository.ListByResourceIDs(ctx, tenantID, resource.Application, []string{appID}, pageSize, cursor)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, pages, 1)
+		assert.Equal(t, []*model.Capability{&capabilityModel1App}, pages[0].Data)
+		assert.Equal(t, 1, pages[0].TotalCount)
+
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,80 @@
+package capability
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpage"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// CapabilityService is responsible for the service-layer Capability operations.
+//
+//go:generate mockery --name=CapabilityService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CapabilityService interface {
+	ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.CapabilityPage, error)
+}
+
+// GraphQLConverter converts Capabilities from the model.Capability service-layer representation to the graphql-layer representation.
+//
+//go:generate mockery --name=GraphQLConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLConverter interface {
+	ToGraphQL(in *model.Capability) (*graphql.Capability, error)
+}
+
+// Resolver is an object responsible for resolver-layer Capability operations.
+type Resolver struct {
+	pages *ordpage.Resolver[*model.CapabilityPage, *graphql.CapabilityPage]
+}
+
+// NewResolver returns a new object responsible for resolver-layer Capability operations.
+func NewResolver(transact persistence.Transactioner, capabilitySvc CapabilityService, capabilityConverter GraphQLConverter, appTemplateVersionSvc ordpage.ApplicationTemplateVersionService) *Resolver {
+	toGraphQLPage := func(page *model.CapabilityPage) (*graphql.CapabilityPage, error) {
+		data, err := ordpage.ConvertData(page.Data, capabilityConverter.ToGraphQL)
+		if err != nil {
+			return nil, err
+		}
+
+		return &graphql.CapabilityPage{
+			Data:       data,
+			TotalCount: page.TotalCount,
+			PageInfo:   ordpage.ToGraphQLPageInfo(page.PageInfo),
+		}, nil
+	}
+	emptyPage := func() *graphql.CapabilityPage {
+		return &graphql.CapabilityPage{Data: []*graphql.Capability{}, PageInfo: &graphql.PageInfo{}}
+	}
+
+	return &Resolver{
+		pages: ordpage.NewResolver(transact, appTemplateVersionSvc, capabilitySvc.ListByResourceIDs, toGraphQLPage, emptyPage),
+	}
+}
+
+// ApplicationCapabilities fetches a page of capabilities for an Application
+func (r *Resolver) ApplicationCapabilities(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.CapabilityPage, error) {
+	param := dataloader.ParamCapability{ID: obj.ID, Ctx: ctx, First: first, After: after}
+	return dataloader.CapabilityFor(ctx).CapabilityByID.Load(param)
+}
+
+// ApplicationCapabilitiesDataLoader retrieves a page of capabilities for each Application ID in the keys argument
+func (r *Resolver) ApplicationCapabilitiesDataLoader(keys []dataloader.ParamCapability) ([]*graphql.CapabilityPage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	return r.pages.ApplicationPages(keys[0].Ctx, applicationIDs, keys[0].First, keys[0].After)
+}
+
+// ApplicationTemplateCapabilities fetches a page of capabilities of the latest version of an Application Template
+func (r *Resolver) ApplicationTemplateCapabilities(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.CapabilityPage, error) {
+	return r.pages.ApplicationTemplatePage(ctx, obj.ID, first, after)
+}
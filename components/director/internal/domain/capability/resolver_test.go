@@ -0,0 +1,112 @@
+package capability_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability/automock"
+	ordpageautomock "github.com/kyma-incubator/compass/components/director/internal/domain/ordpage/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// The paging, transaction handling and error cases are covered by the tests of the ordpage package
+func TestResolver_ApplicationCapabilitiesDataLoader(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	firstAppID := "appID"
+	secondAppID := "appID2"
+	first := 2
+	after := graphql.PageCursor("test")
+
+	capabilityFirstApp := &model.Capability{ApplicationID: &firstAppID}
+	capabilitySecondApp := &model.Capability{ApplicationID: &secondAppID}
+	gqlCapabilityFirstApp := &graphql.Capability{ID: "capabilityID"}
+	gqlCapabilitySecondApp := &graphql.Capability{ID: "capabilityID2"}
+
+	pageInfo := &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: true}
+	gqlPageInfo := &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: true}
+
+	persist, transact := txGen.ThatSucceeds()
+
+	svc := &automock.CapabilityService{}
+	svc.On("ListByResourceIDs", txtest.CtxWithDBMatcher(), resource.Application, []string{firstAppID, secondAppID}, first, string(after)).Return([]*model.CapabilityPage{
+		{Data: []*model.Capability{capabilityFirstApp}, PageInfo: pageInfo, TotalCount: 1},
+		{Data: []*model.Capability{capabilitySecondApp}, PageInfo: pageInfo, TotalCount: 1},
+	}, nil).Once()
+
+	conv := &automock.GraphQLConverter{}
+	conv.On("ToGraphQL", capabilityFirstApp).Return(gqlCapabilityFirstApp, nil).Once()
+	conv.On("ToGraphQL", capabilitySecondApp).Return(gqlCapabilitySecondApp, nil).Once()
+
+	appTemplateVersionSvc := &ordpageautomock.ApplicationTemplateVersionService{}
+	defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv, appTemplateVersionSvc)
+
+	resolver := capability.NewResolver(transact, svc, conv, appTemplateVersionSvc)
+	keys := []dataloader.ParamCapability{
+		{ID: firstAppID, Ctx: context.TODO(), First: &first, After: &after},
+		{ID: secondAppID, Ctx: context.TODO(), First: &first, After: &after},
+	}
+
+	// WHEN
+	result, errs := resolver.ApplicationCapabilitiesDataLoader(keys)
+
+	// THEN
+	require.Nil(t, errs)
+	assert.Equal(t, []*graphql.CapabilityPage{
+		{Data: []*graphql.Capability{gqlCapabilityFirstApp}, PageInfo: gqlPageInfo, TotalCount: 1},
+		{Data: []*graphql.Capability{gqlCapabilitySecondApp}, PageInfo: gqlPageInfo, TotalCount: 1},
+	}, result)
+
+	t.Run("Returns error when there are no Applications", func(t *testing.T) {
+		result, errs := capability.NewResolver(nil, svc, conv, nil).ApplicationCapabilitiesDataLoader([]dataloader.ParamCapability{})
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Applications found")
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_ApplicationTemplateCapabilities(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	appTemplateID := "appTemplateID"
+	versionID := "versionID"
+	first := 2
+
+	capabilityOfVersion := &model.Capability{ApplicationTemplateVersionID: &versionID}
+	gqlCapabilityOfVersion := &graphql.Capability{ID: "capabilityID"}
+
+	persist, transact := txGen.ThatSucceeds()
+
+	appTemplateVersionSvc := &ordpageautomock.ApplicationTemplateVersionService{}
+	appTemplateVersionSvc.On("GetLatestByAppTemplateID", txtest.CtxWithDBMatcher(), appTemplateID).Return(&model.ApplicationTemplateVersion{ID: versionID, ApplicationTemplateID: appTemplateID}, nil).Once()
+
+	svc := &automock.CapabilityService{}
+	svc.On("ListByResourceIDs", txtest.CtxWithDBMatcher(), resource.ApplicationTemplateVersion, []string{versionID}, first, "").Return([]*model.CapabilityPage{
+		{Data: []*model.Capability{capabilityOfVersion}, PageInfo: &pagination.Page{}, TotalCount: 1},
+	}, nil).Once()
+
+	conv := &automock.GraphQLConverter{}
+	conv.On("ToGraphQL", capabilityOfVersion).Return(gqlCapabilityOfVersion, nil).Once()
+	defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv, appTemplateVersionSvc)
+
+	resolver := capability.NewResolver(transact, svc, conv, appTemplateVersionSvc)
+
+	// WHEN
+	result, err := resolver.ApplicationTemplateCapabilities(context.TODO(), &graphql.ApplicationTemplate{ID: appTemplateID}, &first, nil)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, &graphql.CapabilityPage{Data: []*graphql.Capability{gqlCapabilityOfVersion}, PageInfo: &graphql.PageInfo{}, TotalCount: 1}, result)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
@@ -16,6 +17,7 @@ import (
 //go:generate mockery --name=CapabilityRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type CapabilityRepository interface {
 	ListByResourceID(ctx context.Context, tenantID string, resourceType resource.Type, resourceID string) ([]*model.Capability, error)
+	ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.CapabilityPage, error)
 	GetByID(ctx context.Context, tenantID, id string) (*model.Capability, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.Capability, error)
 	Create(ctx context.Context, tenant string, item *model.Capability) error
@@ -76,6 +78,24 @@ func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, appTem
 	return s.repo.ListByResourceID(ctx, "", resource.ApplicationTemplateVersion, appTemplateVersionID)
 }
 
+// ListByResourceIDs lists a page of capabilities for each of the given application or application template version IDs
+func (s *service) ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.CapabilityPage, error) {
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	if resourceType.IsTenantIgnorable() {
+		return s.repo.ListByResourceIDs(ctx, "", resourceType, resourceIDs, pageSize, cursor)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListByResourceIDs(ctx, tnt, resourceType, resourceIDs, pageSize, cursor)
+}
+
 // Get returns the Capability by its ID.
 func (s *service) Get(ctx context.Context, id string) (*model.Capability, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/capability/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
@@ -650,3 +651,120 @@ func emptySpecService() *automock.SpecService {
 	svc := &automock.SpecService{}
 	return svc
 }
+
+func TestService_ListByResourceIDs(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	tnt := "tenant"
+	externalTnt := "external-tenant"
+	resourceIDs := []string{"id1", "id2"}
+	after := "test"
+
+	pages := []*model.CapabilityPage{
+		{
+			Data:       []*model.Capability{{ApplicationID: &resourceIDs[0]}},
+			PageInfo:   &pagination.Page{StartCursor: "", EndCursor: "", HasNextPage: false},
+			TotalCount: 1,
+		},
+	}
+
+	ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+
+	testCases := []struct {
+		Name               string
+		Context            context.Context
+		ResourceType       resource.Type
+		PageSize           int
+		RepositoryFn       func() *automock.CapabilityRepository
+		ExpectedResult     []*model.CapabilityPage
+		ExpectedErrMessage string
+	}{
+		{
+			Name:         "Success for Application",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			PageSize:     2,
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByResourceIDs", ctx, tnt, resource.Application, resourceIDs, 2, after).Return(pages, nil).Once()
+				return repo
+			},
+			ExpectedResult: pages,
+		},
+		{
+			Name:         "Success for Application Template Version",
+			Context:      context.TODO(),
+			ResourceType: resource.ApplicationTemplateVersion,
+			PageSize:     2,
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByResourceIDs", context.TODO(), "", resource.ApplicationTemplateVersion, resourceIDs, 2, after).Return(pages, nil).Once()
+				return repo
+			},
+			ExpectedResult: pages,
+		},
+		{
+			Name:         "Returns error when page size is less than 1",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			PageSize:     0,
+			RepositoryFn: func() *automock.CapabilityRepository {
+				return &automock.CapabilityRepository{}
+			},
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name:         "Returns error when page size is bigger than 200",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			PageSize:     201,
+			RepositoryFn: func() *automock.CapabilityRepository {
+				return &automock.CapabilityRepository{}
+			},
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name:         "Returns error when tenant is missing in the context",
+			Context:      context.TODO(),
+			ResourceType: resource.Application,
+			PageSize:     2,
+			RepositoryFn: func() *automock.CapabilityRepository {
+				return &automock.CapabilityRepository{}
+			},
+			ExpectedErrMessage: "cannot read tenant from context",
+		},
+		{
+			Name:         "Returns error when Capabilities listing failed",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			PageSize:     2,
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByResourceIDs", ctx, tnt, resource.Application, resourceIDs, 2, after).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := capability.NewService(repo, nil, nil)
+
+			// WHEN
+			result, err := svc.ListByResourceIDs(testCase.Context, testCase.ResourceType, resourceIDs, testCase.PageSize, after)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
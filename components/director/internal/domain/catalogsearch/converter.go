@@ -0,0 +1,118 @@
+package catalogsearch
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+var (
+	resourceTypesFromGraphQL = map[graphql.CatalogResourceType]resource.Type{
+		graphql.CatalogResourceTypeAPIDefinition:   resource.API,
+		graphql.CatalogResourceTypeEventDefinition: resource.EventDefinition,
+		graphql.CatalogResourceTypeEntityType:      resource.EntityType,
+		graphql.CatalogResourceTypeCapability:      resource.Capability,
+		graphql.CatalogResourceTypeDataProduct:     resource.DataProduct,
+	}
+
+	resourceTypesToGraphQL = map[resource.Type]graphql.CatalogResourceType{
+		resource.API:             graphql.CatalogResourceTypeAPIDefinition,
+		resource.EventDefinition: graphql.CatalogResourceTypeEventDefinition,
+		resource.EntityType:      graphql.CatalogResourceTypeEntityType,
+		resource.Capability:      graphql.CatalogResourceTypeCapability,
+		resource.DataProduct:     graphql.CatalogResourceTypeDataProduct,
+	}
+)
+
+type converter struct{}
+
+// NewConverter returns a new catalog search converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// FromEntity converts the catalog search result entity to a model
+func (c *converter) FromEntity(in *Entity) *model.CatalogSearchResult {
+	if in == nil {
+		return nil
+	}
+
+	return &model.CatalogSearchResult{
+		ResourceType:  resource.Type(in.ResourceType),
+		ID:            in.ID,
+		OrdID:         repo.StringPtrFromNullableString(in.OrdID),
+		Name:          in.Name,
+		Description:   repo.StringPtrFromNullableString(in.Description),
+		ApplicationID: repo.StringPtrFromNullableString(in.ApplicationID),
+		ReleaseStatus: repo.StringPtrFromNullableString(in.ReleaseStatus),
+		Visibility:    repo.StringPtrFromNullableString(in.Visibility),
+		Rank:          in.Rank,
+	}
+}
+
+// QueryFromGraphQL converts the GraphQL arguments of a catalog search to a search query
+func (c *converter) QueryFromGraphQL(text string, resourceTypes []graphql.CatalogResourceType, filters *graphql.CatalogSearchFilter) (*model.CatalogSearchQuery, error) {
+	query := &model.CatalogSearchQuery{
+		Text: text,
+	}
+
+	for _, resourceType := range resourceTypes {
+		modelType, ok := resourceTypesFromGraphQL[resourceType]
+		if !ok {
+			return nil, apperrors.NewInvalidDataError("unknown catalog resource type %q", resourceType)
+		}
+		query.ResourceTypes = append(query.ResourceTypes, modelType)
+	}
+
+	if filters != nil {
+		query.ApplicationIDs = filters.ApplicationIDs
+		query.ReleaseStatuses = filters.ReleaseStatuses
+		query.Visibilities = filters.Visibilities
+	}
+
+	return query, nil
+}
+
+// ToGraphQL converts the catalog search result to its GraphQL representation
+func (c *converter) ToGraphQL(in *model.CatalogSearchResult) (*graphql.CatalogSearchResult, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	resourceType, ok := resourceTypesToGraphQL[in.ResourceType]
+	if !ok {
+		return nil, apperrors.NewInternalError("unknown catalog resource type %q", in.ResourceType)
+	}
+
+	return &graphql.CatalogSearchResult{
+		ResourceType:  resourceType,
+		ID:            in.ID,
+		OrdID:         in.OrdID,
+		Name:          in.Name,
+		Description:   in.Description,
+		ApplicationID: in.ApplicationID,
+		ReleaseStatus: in.ReleaseStatus,
+		Visibility:    in.Visibility,
+		Rank:          in.Rank,
+	}, nil
+}
+
+// MultipleToGraphQL converts multiple catalog search results to their GraphQL representation
+func (c *converter) MultipleToGraphQL(in []*model.CatalogSearchResult) ([]*graphql.CatalogSearchResult, error) {
+	results := make([]*graphql.CatalogSearchResult, 0, len(in))
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+
+		result, err := c.ToGraphQL(r)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
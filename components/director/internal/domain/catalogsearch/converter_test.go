@@ -0,0 +1,102 @@
+package catalogsearch_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalogsearch"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		result := catalogsearch.NewConverter().FromEntity(fixSearchResultEntity())
+
+		// THEN
+		require.Equal(t, fixSearchResultModel(), result)
+	})
+
+	t.Run("Returns nil for nil entity", func(t *testing.T) {
+		require.Nil(t, catalogsearch.NewConverter().FromEntity(nil))
+	})
+}
+
+func TestConverter_QueryFromGraphQL(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceTypes []graphql.CatalogResourceType
+		Filters       *graphql.CatalogSearchFilter
+		Expected      *model.CatalogSearchQuery
+		ExpectedError string
+	}{
+		{
+			Name:          "Resource types and filters",
+			ResourceTypes: []graphql.CatalogResourceType{graphql.CatalogResourceTypeAPIDefinition, graphql.CatalogResourceTypeDataProduct},
+			Filters: &graphql.CatalogSearchFilter{
+				ApplicationIDs:  []string{appID},
+				ReleaseStatuses: []string{releaseStatus},
+				Visibilities:    []string{visibility},
+			},
+			Expected: &model.CatalogSearchQuery{
+				Text:            searchText,
+				ResourceTypes:   []resource.Type{resource.API, resource.DataProduct},
+				ApplicationIDs:  []string{appID},
+				ReleaseStatuses: []string{releaseStatus},
+				Visibilities:    []string{visibility},
+			},
+		},
+		{
+			Name:     "Without resource types and filters",
+			Expected: &model.CatalogSearchQuery{Text: searchText},
+		},
+		{
+			Name:          "Error for unknown resource type",
+			ResourceTypes: []graphql.CatalogResourceType{"UNKNOWN"},
+			ExpectedError: `unknown catalog resource type "UNKNOWN"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			query, err := catalogsearch.NewConverter().QueryFromGraphQL(searchText, testCase.ResourceTypes, testCase.Filters)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+				require.Nil(t, query)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.Expected, query)
+			}
+		})
+	}
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		results, err := catalogsearch.NewConverter().MultipleToGraphQL([]*model.CatalogSearchResult{fixSearchResultModel(), nil})
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*graphql.CatalogSearchResult{fixSearchResultGraphQL()}, results)
+	})
+
+	t.Run("Error for unknown resource type", func(t *testing.T) {
+		result := fixSearchResultModel()
+		result.ResourceType = resource.Application
+
+		// WHEN
+		results, err := catalogsearch.NewConverter().MultipleToGraphQL([]*model.CatalogSearchResult{result})
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `unknown catalog resource type "application"`)
+		require.Nil(t, results)
+	})
+}
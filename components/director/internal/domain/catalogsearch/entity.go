@@ -0,0 +1,24 @@
+package catalogsearch
+
+import "database/sql"
+
+// Entity represents a catalog resource which matches a full-text search
+type Entity struct {
+	ResourceType  string         `db:"resource_type"`
+	ID            string         `db:"id"`
+	OrdID         sql.NullString `db:"ord_id"`
+	Name          string         `db:"name"`
+	Description   sql.NullString `db:"description"`
+	ApplicationID sql.NullString `db:"app_id"`
+	ReleaseStatus sql.NullString `db:"release_status"`
+	Visibility    sql.NullString `db:"visibility"`
+	Rank          float64        `db:"rank"`
+}
+
+// EntityCollection is a collection of catalog search result entities
+type EntityCollection []Entity
+
+// Len returns the number of entities in the collection
+func (c EntityCollection) Len() int {
+	return len(c)
+}
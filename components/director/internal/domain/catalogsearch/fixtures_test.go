@@ -0,0 +1,95 @@
+package catalogsearch_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalogsearch"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	apiID         = "ddb8e2a3-7f1d-4a3e-8b0c-8d3a9f2c4b51"
+	appID         = "c5ab8c1b-4f1e-4a42-8a45-2b31f2a5e0b7"
+	tenantID      = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	apiOrdID      = "ns:apiResource:SALES_ORDER:v1"
+	apiName       = "Sales Order API"
+	apiDesc       = "Create and manage sales orders"
+	releaseStatus = "active"
+	visibility    = "public"
+	searchText    = "sales order"
+	rank          = 0.6
+)
+
+var testErr = errors.New("test error")
+
+func fixSearchQuery() *model.CatalogSearchQuery {
+	return &model.CatalogSearchQuery{
+		Text:          searchText,
+		ResourceTypes: []resource.Type{resource.API},
+	}
+}
+
+func fixSearchResultModel() *model.CatalogSearchResult {
+	return &model.CatalogSearchResult{
+		ResourceType:  resource.API,
+		ID:            apiID,
+		OrdID:         str.Ptr(apiOrdID),
+		Name:          apiName,
+		Description:   str.Ptr(apiDesc),
+		ApplicationID: str.Ptr(appID),
+		ReleaseStatus: str.Ptr(releaseStatus),
+		Visibility:    str.Ptr(visibility),
+		Rank:          rank,
+	}
+}
+
+func fixSearchResultEntity() *catalogsearch.Entity {
+	return &catalogsearch.Entity{
+		ResourceType:  string(resource.API),
+		ID:            apiID,
+		OrdID:         sql.NullString{String: apiOrdID, Valid: true},
+		Name:          apiName,
+		Description:   sql.NullString{String: apiDesc, Valid: true},
+		ApplicationID: sql.NullString{String: appID, Valid: true},
+		ReleaseStatus: sql.NullString{String: releaseStatus, Valid: true},
+		Visibility:    sql.NullString{String: visibility, Valid: true},
+		Rank:          rank,
+	}
+}
+
+func fixSearchResultGraphQL() *graphql.CatalogSearchResult {
+	return &graphql.CatalogSearchResult{
+		ResourceType:  graphql.CatalogResourceTypeAPIDefinition,
+		ID:            apiID,
+		OrdID:         str.Ptr(apiOrdID),
+		Name:          apiName,
+		Description:   str.Ptr(apiDesc),
+		ApplicationID: str.Ptr(appID),
+		ReleaseStatus: str.Ptr(releaseStatus),
+		Visibility:    str.Ptr(visibility),
+		Rank:          rank,
+	}
+}
+
+func fixSearchResultPage() *model.CatalogSearchResultPage {
+	return &model.CatalogSearchResultPage{
+		Data: []*model.CatalogSearchResult{fixSearchResultModel()},
+		PageInfo: &pagination.Page{
+			StartCursor: "",
+			EndCursor:   "",
+			HasNextPage: false,
+		},
+		TotalCount: 1,
+	}
+}
+
+func ctxWithTenant() context.Context {
+	return tenant.SaveToContext(context.TODO(), tenantID, "external-"+tenantID)
+}
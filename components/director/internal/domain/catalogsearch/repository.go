@@ -0,0 +1,200 @@
+package catalogsearch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/pkg/errors"
+)
+
+const (
+	// searchConfiguration is the text search configuration the search vectors are built with, see the introduce_catalog_search migration
+	searchConfiguration = "english"
+
+	internalVisibilityScope = "internal_visibility:read"
+	visibilityColumn        = "visibility"
+	publicVisibilityValue   = "public"
+
+	resultColumns = "resource_type, id, ord_id, name, description, app_id, release_status, visibility, rank"
+	orderByColumn = "rank DESC, id"
+
+	searchSubQuery = `SELECT '%s' AS resource_type, id, ord_id, %s AS name, description, app_id, release_status::TEXT AS release_status, visibility::TEXT AS visibility, ts_rank_cd(search_vector, query) AS rank
+		FROM %s, websearch_to_tsquery('%s', ?) AS query
+		WHERE %s AND %s`
+	searchVectorMatch = "search_vector @@ query"
+	// specificationTitleMatch matches the resources with a specification whose title matches the query. The expression is the same as the one of the index on the titles.
+	specificationTitleMatch = "(search_vector @@ query OR id IN (SELECT %s FROM public.specifications WHERE to_tsvector('%s', title) @@ query))"
+)
+
+// searchableResources maps the resource types of the catalog to the table and the column with their name,
+// and, for the resources with specifications, to the column referencing them from the specifications
+var searchableResources = map[resource.Type]struct {
+	table             string
+	nameColumn        string
+	specificationsRef string
+}{
+	resource.API:             {table: "public.api_definitions", nameColumn: "name", specificationsRef: "api_def_id"},
+	resource.EventDefinition: {table: "public.event_api_definitions", nameColumn: "name", specificationsRef: "event_def_id"},
+	resource.EntityType:      {table: "public.entity_types", nameColumn: "title"},
+	resource.Capability:      {table: "public.capabilities", nameColumn: "name", specificationsRef: "capability_def_id"},
+	resource.DataProduct:     {table: "public.data_products", nameColumn: "title"},
+}
+
+// EntityConverter converts catalog search result entities to models
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	FromEntity(in *Entity) *model.CatalogSearchResult
+}
+
+type repository struct {
+	conv EntityConverter
+}
+
+// NewRepository returns a new catalog search repository
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		conv: conv,
+	}
+}
+
+// Search returns a page of the catalog resources visible to the tenant which match the query, the most relevant first
+func (r *repository) Search(ctx context.Context, tenant string, query *model.CatalogSearchQuery, pageSize int, cursor string) (*model.CatalogSearchResultPage, error) {
+	if query == nil {
+		return nil, errors.New("catalog search query cannot be empty")
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	paginationSQL, err := pagination.ConvertOffsetLimitAndOrderedColumnToSQL(pageSize, offset, orderByColumn)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting offset and limit to cursor")
+	}
+
+	searchQuery, args, err := buildSearchQuery(ctx, tenant, query)
+	if err != nil {
+		return nil, err
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := sqlx.Rebind(sqlx.DOLLAR, fmt.Sprintf("%s %s", searchQuery, paginationSQL))
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+
+	var entities EntityCollection
+	if err = persist.SelectContext(ctx, &entities, stmt, args...); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.CatalogSearchResult, resource.List, "while searching the catalog")
+	}
+
+	countStmt := sqlx.Rebind(sqlx.DOLLAR, fmt.Sprintf("SELECT COUNT(*) FROM (%s) AS results", searchQuery))
+	log.C(ctx).Debugf("Executing DB query: %s", countStmt)
+
+	var totalCount int
+	if err = persist.GetContext(ctx, &totalCount, countStmt, args...); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.CatalogSearchResult, resource.List, "while counting the catalog search results")
+	}
+
+	items := make([]*model.CatalogSearchResult, 0, len(entities))
+	for i := range entities {
+		items = append(items, r.conv.FromEntity(&entities[i]))
+	}
+
+	hasNextPage, endCursor := false, ""
+	if totalCount > offset+len(entities) {
+		hasNextPage = true
+		endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+	}
+
+	return &model.CatalogSearchResultPage{
+		Data: items,
+		PageInfo: &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		},
+		TotalCount: totalCount,
+	}, nil
+}
+
+// buildSearchQuery builds a union of the matching resources of each searched type, restricted to the ones the tenant has access to.
+// The filters are applied to the union, which Postgres pushes down to the queries of the single types.
+// Without the internal visibility scope only the public resources are found.
+func buildSearchQuery(ctx context.Context, tenant string, query *model.CatalogSearchQuery) (string, []interface{}, error) {
+	if len(query.ResourceTypes) == 0 {
+		return "", nil, errors.New("at least one resource type must be searched")
+	}
+
+	subQueries := make([]string, 0, len(query.ResourceTypes))
+	args := make([]interface{}, 0)
+	for _, resourceType := range query.ResourceTypes {
+		searchable, ok := searchableResources[resourceType]
+		if !ok {
+			return "", nil, errors.Errorf("resource type %q cannot be searched", resourceType)
+		}
+
+		tenantCondition, err := repo.NewTenantIsolationCondition(resourceType, tenant, false)
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "while building tenant isolation condition for %s", resourceType)
+		}
+		tenantArgs, _ := tenantCondition.GetQueryArgs()
+
+		match := searchVectorMatch
+		if searchable.specificationsRef != "" {
+			match = fmt.Sprintf(specificationTitleMatch, searchable.specificationsRef, searchConfiguration)
+		}
+
+		subQueries = append(subQueries, fmt.Sprintf(searchSubQuery, resourceType, searchable.nameColumn, searchable.table, searchConfiguration, match, tenantCondition.GetQueryPart()))
+		args = append(args, query.Text)
+		args = append(args, tenantArgs...)
+	}
+
+	filters := make([]repo.Condition, 0)
+	if len(query.ApplicationIDs) > 0 {
+		filters = append(filters, repo.NewInConditionForStringValues("app_id", query.ApplicationIDs))
+	}
+	if len(query.ReleaseStatuses) > 0 {
+		filters = append(filters, repo.NewInConditionForStringValues("release_status", query.ReleaseStatuses))
+	}
+	if len(query.Visibilities) > 0 {
+		filters = append(filters, repo.NewInConditionForStringValues(visibilityColumn, query.Visibilities))
+	}
+
+	isInternalVisibilityScopePresent, err := scope.Contains(ctx, internalVisibilityScope)
+	if err != nil {
+		log.C(ctx).Infof("No scopes are present in the context meaning the flow is not user-initiated. Searching the catalog without visibility check...")
+		isInternalVisibilityScopePresent = true
+	}
+	if !isInternalVisibilityScopePresent {
+		log.C(ctx).Infof("No internal visibility scope is present in the context. Searching only the public catalog resources...")
+		filters = append(filters, repo.NewEqualCondition(visibilityColumn, publicVisibilityValue))
+	}
+
+	stmt := fmt.Sprintf("SELECT %s FROM (%s) AS matches", resultColumns, strings.Join(subQueries, " UNION ALL "))
+	if len(filters) == 0 {
+		return stmt, args, nil
+	}
+
+	filterParts := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		filterParts = append(filterParts, filter.GetQueryPart())
+		filterArgs, _ := filter.GetQueryArgs()
+		args = append(args, filterArgs...)
+	}
+
+	return fmt.Sprintf("%s WHERE %s", stmt, strings.Join(filterParts, " AND ")), args, nil
+}
@@ -0,0 +1,207 @@
+package catalogsearch_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalogsearch"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalogsearch/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/stretchr/testify/require"
+)
+
+var resultColumns = []string{"resource_type", "id", "ord_id", "name", "description", "app_id", "release_status", "visibility", "rank"}
+
+func TestRepository_Search(t *testing.T) {
+	apiSubQuery := `SELECT 'api' AS resource_type, id, ord_id, name AS name, description, app_id, release_status::TEXT AS release_status, visibility::TEXT AS visibility, ts_rank_cd\(search_vector, query\) AS rank\s+` +
+		`FROM public\.api_definitions, websearch_to_tsquery\('english', \$1\) AS query\s+` +
+		`WHERE \(search_vector @@ query OR id IN \(SELECT api_def_id FROM public\.specifications WHERE to_tsvector\('english', title\) @@ query\)\) AND \(id IN \(SELECT id FROM api_definitions_tenants WHERE tenant_id = \$2\)\)`
+	dataProductSubQuery := `SELECT 'dataProduct' AS resource_type, id, ord_id, title AS name, .* ` +
+		`FROM public\.data_products, websearch_to_tsquery\('english', \$3\) AS query\s+` +
+		`WHERE search_vector @@ query AND \(id IN \(SELECT id FROM data_products_tenants WHERE tenant_id = \$4\)\)`
+	searchQuery := regexp.QuoteMeta("SELECT resource_type, id, ord_id, name, description, app_id, release_status, visibility, rank FROM (") +
+		apiSubQuery + " UNION ALL " + dataProductSubQuery +
+		regexp.QuoteMeta(") AS matches WHERE app_id IN ($5) AND visibility IN ($6)")
+
+	query := &model.CatalogSearchQuery{
+		Text:           searchText,
+		ResourceTypes:  []resource.Type{resource.API, resource.DataProduct},
+		ApplicationIDs: []string{appID},
+		Visibilities:   []string{visibility},
+	}
+	args := []driver.Value{searchText, tenantID, searchText, tenantID, appID, visibility}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixSearchResultEntity()
+		dbMock.ExpectQuery(`^` + searchQuery + regexp.QuoteMeta(" ORDER BY rank DESC, id LIMIT 1 OFFSET 0") + `$`).
+			WithArgs(args...).
+			WillReturnRows(sqlmock.NewRows(resultColumns).AddRow(entity.ResourceType, entity.ID, entity.OrdID, entity.Name, entity.Description, entity.ApplicationID, entity.ReleaseStatus, entity.Visibility, entity.Rank))
+		dbMock.ExpectQuery(`^` + regexp.QuoteMeta("SELECT COUNT(*) FROM (") + searchQuery + regexp.QuoteMeta(") AS results") + `$`).
+			WithArgs(args...).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", entity).Return(fixSearchResultModel()).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := catalogsearch.NewRepository(conv)
+
+		// WHEN
+		page, err := repo.Search(ctx, tenantID, query, 1, "")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, &model.CatalogSearchResultPage{
+			Data: []*model.CatalogSearchResult{fixSearchResultModel()},
+			PageInfo: &pagination.Page{
+				StartCursor: "",
+				EndCursor:   pagination.EncodeNextOffsetCursor(0, 1),
+				HasNextPage: true,
+			},
+			TotalCount: 2,
+		}, page)
+	})
+
+	t.Run("Success finds only public resources without the internal visibility scope", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		publicSearchQuery := searchQuery + regexp.QuoteMeta(" AND visibility = $7")
+		publicArgs := append(append([]driver.Value{}, args...), "public")
+
+		dbMock.ExpectQuery(`^` + publicSearchQuery + regexp.QuoteMeta(" ORDER BY rank DESC, id LIMIT 1 OFFSET 0") + `$`).
+			WithArgs(publicArgs...).
+			WillReturnRows(sqlmock.NewRows(resultColumns))
+		dbMock.ExpectQuery(`^` + regexp.QuoteMeta("SELECT COUNT(*) FROM (") + publicSearchQuery + regexp.QuoteMeta(") AS results") + `$`).
+			WithArgs(publicArgs...).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		ctx = scope.SaveToContext(ctx, []string{"application:read"})
+		repo := catalogsearch.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		page, err := repo.Search(ctx, tenantID, query, 1, "")
+
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, page.Data)
+	})
+
+	t.Run("Success finds resources of any visibility with the internal visibility scope", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(`^` + searchQuery + regexp.QuoteMeta(" ORDER BY rank DESC, id LIMIT 1 OFFSET 0") + `$`).
+			WithArgs(args...).
+			WillReturnRows(sqlmock.NewRows(resultColumns))
+		dbMock.ExpectQuery(`^` + regexp.QuoteMeta("SELECT COUNT(*) FROM (") + searchQuery + regexp.QuoteMeta(") AS results") + `$`).
+			WithArgs(args...).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		ctx = scope.SaveToContext(ctx, []string{"application:read", "internal_visibility:read"})
+		repo := catalogsearch.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		page, err := repo.Search(ctx, tenantID, query, 1, "")
+
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, page.Data)
+	})
+
+	t.Run("Error when searching fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(`^` + searchQuery + ` ORDER BY .*$`).
+			WithArgs(args...).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := catalogsearch.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		page, err := repo.Search(ctx, tenantID, query, 1, "")
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+		require.Nil(t, page)
+	})
+
+	t.Run("Error when counting fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(`^` + searchQuery + ` ORDER BY .*$`).
+			WithArgs(args...).
+			WillReturnRows(sqlmock.NewRows(resultColumns))
+		dbMock.ExpectQuery(`^SELECT COUNT\(\*\) FROM .*$`).
+			WithArgs(args...).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := catalogsearch.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		page, err := repo.Search(ctx, tenantID, query, 1, "")
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+		require.Nil(t, page)
+	})
+
+	t.Run("Error when the resource type cannot be searched", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := catalogsearch.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		page, err := repo.Search(ctx, tenantID, &model.CatalogSearchQuery{Text: searchText, ResourceTypes: []resource.Type{resource.Application}}, 1, "")
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `resource type "application" cannot be searched`)
+		require.Nil(t, page)
+	})
+
+	t.Run("Error when the cursor is not correct", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := catalogsearch.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		page, err := repo.Search(ctx, tenantID, query, 1, "not-a-cursor")
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while decoding page cursor")
+		require.Nil(t, page)
+	})
+}
@@ -0,0 +1,89 @@
+package catalogsearch
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// CatalogSearchService is responsible for the service-layer catalog search operations
+//
+//go:generate mockery --name=CatalogSearchService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CatalogSearchService interface {
+	Search(ctx context.Context, query *model.CatalogSearchQuery, pageSize int, cursor string) (*model.CatalogSearchResultPage, error)
+}
+
+// GraphQLConverter converts catalog searches between their GraphQL representation and the model
+//
+//go:generate mockery --name=GraphQLConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLConverter interface {
+	QueryFromGraphQL(text string, resourceTypes []graphql.CatalogResourceType, filters *graphql.CatalogSearchFilter) (*model.CatalogSearchQuery, error)
+	MultipleToGraphQL(in []*model.CatalogSearchResult) ([]*graphql.CatalogSearchResult, error)
+}
+
+// Resolver is the catalog search resolver
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      CatalogSearchService
+	conv     GraphQLConverter
+}
+
+// NewResolver creates a new catalog search resolver
+func NewResolver(transact persistence.Transactioner, svc CatalogSearchService, conv GraphQLConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// SearchCatalog returns a page of the APIs, events, entity types, capabilities and data products of the tenant which match the query, the most relevant first
+func (r *Resolver) SearchCatalog(ctx context.Context, query string, resourceTypes []graphql.CatalogResourceType, filters *graphql.CatalogSearchFilter, first *int, after *graphql.PageCursor) (*graphql.CatalogSearchResultPage, error) {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	searchQuery, err := r.conv.QueryFromGraphQL(query, resourceTypes, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	page, err := r.svc.Search(ctx, searchQuery, *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	results, err := r.conv.MultipleToGraphQL(page.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.CatalogSearchResultPage{
+		Data:       results,
+		TotalCount: page.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(page.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(page.PageInfo.EndCursor),
+			HasNextPage: page.PageInfo.HasNextPage,
+		},
+	}, nil
+}
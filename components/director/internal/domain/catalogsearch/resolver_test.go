@@ -0,0 +1,161 @@
+package catalogsearch_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalogsearch"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalogsearch/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_SearchCatalog(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	first := 50
+	after := graphql.PageCursor("cursor")
+	resourceTypes := []graphql.CatalogResourceType{graphql.CatalogResourceTypeAPIDefinition}
+	filters := &graphql.CatalogSearchFilter{Visibilities: []string{visibility}}
+	gqlResults := []*graphql.CatalogSearchResult{fixSearchResultGraphQL()}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.CatalogSearchService
+		ConverterFn    func() *automock.GraphQLConverter
+		First          *int
+		ExpectedOutput *graphql.CatalogSearchResultPage
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.CatalogSearchService {
+				svc := &automock.CatalogSearchService{}
+				svc.On("Search", txtest.CtxWithDBMatcher(), fixSearchQuery(), first, string(after)).Return(fixSearchResultPage(), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("QueryFromGraphQL", searchText, resourceTypes, filters).Return(fixSearchQuery(), nil).Once()
+				conv.On("MultipleToGraphQL", fixSearchResultPage().Data).Return(gqlResults, nil).Once()
+				return conv
+			},
+			First: &first,
+			ExpectedOutput: &graphql.CatalogSearchResultPage{
+				Data:       gqlResults,
+				PageInfo:   &graphql.PageInfo{},
+				TotalCount: 1,
+			},
+		},
+		{
+			Name:          "Error when first is missing",
+			TxFn:          txGen.ThatDoesntStartTransaction,
+			ServiceFn:     func() *automock.CatalogSearchService { return &automock.CatalogSearchService{} },
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			ExpectedError: "missing required parameter 'first'",
+		},
+		{
+			Name:      "Error when converting the query fails",
+			TxFn:      txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.CatalogSearchService { return &automock.CatalogSearchService{} },
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("QueryFromGraphQL", searchText, resourceTypes, filters).Return(nil, testErr).Once()
+				return conv
+			},
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:      "Error when beginning transaction fails",
+			TxFn:      txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.CatalogSearchService { return &automock.CatalogSearchService{} },
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("QueryFromGraphQL", searchText, resourceTypes, filters).Return(fixSearchQuery(), nil).Once()
+				return conv
+			},
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when searching fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.CatalogSearchService {
+				svc := &automock.CatalogSearchService{}
+				svc.On("Search", txtest.CtxWithDBMatcher(), fixSearchQuery(), first, string(after)).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("QueryFromGraphQL", searchText, resourceTypes, filters).Return(fixSearchQuery(), nil).Once()
+				return conv
+			},
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when committing transaction fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.CatalogSearchService {
+				svc := &automock.CatalogSearchService{}
+				svc.On("Search", txtest.CtxWithDBMatcher(), fixSearchQuery(), first, string(after)).Return(fixSearchResultPage(), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("QueryFromGraphQL", searchText, resourceTypes, filters).Return(fixSearchQuery(), nil).Once()
+				return conv
+			},
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when converting the results fails",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.CatalogSearchService {
+				svc := &automock.CatalogSearchService{}
+				svc.On("Search", txtest.CtxWithDBMatcher(), fixSearchQuery(), first, string(after)).Return(fixSearchResultPage(), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("QueryFromGraphQL", searchText, resourceTypes, filters).Return(fixSearchQuery(), nil).Once()
+				conv.On("MultipleToGraphQL", []*model.CatalogSearchResult{fixSearchResultModel()}).Return(nil, testErr).Once()
+				return conv
+			},
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+			resolver := catalogsearch.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.SearchCatalog(context.TODO(), searchText, resourceTypes, filters, testCase.First, &after)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedOutput, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
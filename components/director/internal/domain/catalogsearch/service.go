@@ -0,0 +1,62 @@
+package catalogsearch
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// SearchableResourceTypes are the resource types of the catalog, in the order they are searched when the query does not restrict them
+var SearchableResourceTypes = []resource.Type{resource.API, resource.EventDefinition, resource.EntityType, resource.Capability, resource.DataProduct}
+
+// CatalogSearchRepository is responsible for the repo-layer catalog search operations
+//
+//go:generate mockery --name=CatalogSearchRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CatalogSearchRepository interface {
+	Search(ctx context.Context, tenant string, query *model.CatalogSearchQuery, pageSize int, cursor string) (*model.CatalogSearchResultPage, error)
+}
+
+type service struct {
+	repo CatalogSearchRepository
+}
+
+// NewService returns a new catalog search service
+func NewService(repo CatalogSearchRepository) *service {
+	return &service{
+		repo: repo,
+	}
+}
+
+// Search returns a page of the catalog resources of the tenant in the context which match the query, ordered by relevance.
+// All resource types of the catalog are searched when the query does not restrict them.
+func (s *service) Search(ctx context.Context, query *model.CatalogSearchQuery, pageSize int, cursor string) (*model.CatalogSearchResultPage, error) {
+	if query == nil || strings.TrimSpace(query.Text) == "" {
+		return nil, apperrors.NewInvalidDataError("the search query must not be empty")
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	searchQuery := *query
+	if len(searchQuery.ResourceTypes) == 0 {
+		searchQuery.ResourceTypes = SearchableResourceTypes
+	}
+
+	page, err := s.repo.Search(ctx, tnt, &searchQuery, pageSize, cursor)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while searching the catalog")
+	}
+
+	return page, nil
+}
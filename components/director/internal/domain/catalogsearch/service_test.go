@@ -0,0 +1,106 @@
+package catalogsearch_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalogsearch"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalogsearch/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Search(t *testing.T) {
+	ctx := ctxWithTenant()
+
+	testCases := []struct {
+		Name          string
+		Context       context.Context
+		Query         *model.CatalogSearchQuery
+		PageSize      int
+		RepoFn        func() *automock.CatalogSearchRepository
+		ExpectedError string
+	}{
+		{
+			Name:     "Success",
+			Context:  ctx,
+			Query:    fixSearchQuery(),
+			PageSize: 50,
+			RepoFn: func() *automock.CatalogSearchRepository {
+				repo := &automock.CatalogSearchRepository{}
+				repo.On("Search", ctx, tenantID, fixSearchQuery(), 50, "cursor").Return(fixSearchResultPage(), nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:     "Success searches all resource types when none are given",
+			Context:  ctx,
+			Query:    &model.CatalogSearchQuery{Text: searchText},
+			PageSize: 50,
+			RepoFn: func() *automock.CatalogSearchRepository {
+				repo := &automock.CatalogSearchRepository{}
+				repo.On("Search", ctx, tenantID, &model.CatalogSearchQuery{Text: searchText, ResourceTypes: catalogsearch.SearchableResourceTypes}, 50, "cursor").Return(fixSearchResultPage(), nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:          "Error when the search text is blank",
+			Context:       ctx,
+			Query:         &model.CatalogSearchQuery{Text: "  "},
+			PageSize:      50,
+			RepoFn:        func() *automock.CatalogSearchRepository { return &automock.CatalogSearchRepository{} },
+			ExpectedError: "the search query must not be empty",
+		},
+		{
+			Name:          "Error when page size is out of range",
+			Context:       ctx,
+			Query:         fixSearchQuery(),
+			PageSize:      201,
+			RepoFn:        func() *automock.CatalogSearchRepository { return &automock.CatalogSearchRepository{} },
+			ExpectedError: "page size must be between 1 and 200",
+		},
+		{
+			Name:          "Error when tenant is missing",
+			Context:       context.TODO(),
+			Query:         fixSearchQuery(),
+			PageSize:      50,
+			RepoFn:        func() *automock.CatalogSearchRepository { return &automock.CatalogSearchRepository{} },
+			ExpectedError: "while loading tenant from context",
+		},
+		{
+			Name:     "Error when searching fails",
+			Context:  ctx,
+			Query:    fixSearchQuery(),
+			PageSize: 50,
+			RepoFn: func() *automock.CatalogSearchRepository {
+				repo := &automock.CatalogSearchRepository{}
+				repo.On("Search", ctx, tenantID, fixSearchQuery(), 50, "cursor").Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			svc := catalogsearch.NewService(repo)
+
+			// WHEN
+			page, err := svc.Search(testCase.Context, testCase.Query, testCase.PageSize, "cursor")
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+				require.Nil(t, page)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, fixSearchResultPage(), page)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
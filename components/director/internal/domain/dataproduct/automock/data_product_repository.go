@@ -151,6 +151,32 @@ func (_m *DataProductRepository) ListByResourceID(ctx context.Context, tenantID
 	return r0, r1
 }
 
+// ListByResourceIDs provides a mock function with given fields: ctx, tenantID, resourceType, resourceIDs, pageSize, cursor
+func (_m *DataProductRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.DataProductPage, error) {
+	ret := _m.Called(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+
+	var r0 []*model.DataProductPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.Type, []string, int, string) ([]*model.DataProductPage, error)); ok {
+		return rf(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.Type, []string, int, string) []*model.DataProductPage); ok {
+		r0 = rf(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DataProductPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, resource.Type, []string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, tenant, item
 func (_m *DataProductRepository) Update(ctx context.Context, tenant string, item *model.DataProduct) error {
 	ret := _m.Called(ctx, tenant, item)
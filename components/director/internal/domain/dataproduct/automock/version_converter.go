@@ -5,6 +5,7 @@ package automock
 import (
 	version "github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -43,6 +44,22 @@ func (_m *VersionConverter) ToEntity(_a0 model.Version) version.Version {
 	return r0
 }
 
+// ToGraphQL provides a mock function with given fields: in
+func (_m *VersionConverter) ToGraphQL(in *model.Version) *graphql.Version {
+	ret := _m.Called(in)
+
+	var r0 *graphql.Version
+	if rf, ok := ret.Get(0).(func(*model.Version) *graphql.Version); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.Version)
+		}
+	}
+
+	return r0
+}
+
 // NewVersionConverter creates a new instance of VersionConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewVersionConverter(t interface {
@@ -1,9 +1,12 @@
 package dataproduct
 
 import (
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 )
 
@@ -11,6 +14,7 @@ import (
 //
 //go:generate mockery --name=VersionConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
 type VersionConverter interface {
+	ToGraphQL(in *model.Version) *graphql.Version
 	FromEntity(version version.Version) *model.Version
 	ToEntity(version model.Version) version.Version
 }
@@ -143,3 +147,53 @@ func (c *converter) convertVersionToEntity(inVer *model.Version) version.Version
 
 	return c.version.ToEntity(*inVer)
 }
+
+// ToGraphQL converts the provided service-layer representation of a Data Product to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.DataProduct) (*graphql.DataProduct, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var labels graphql.Labels
+	if in.Labels != nil {
+		if err := json.Unmarshal(in.Labels, &labels); err != nil {
+			return nil, err
+		}
+	}
+
+	return &graphql.DataProduct{
+		ID:                  in.ID,
+		OrdID:               in.OrdID,
+		LocalID:             in.LocalTenantID,
+		Title:               in.Title,
+		ShortDescription:    in.ShortDescription,
+		Description:         in.Description,
+		PartOfPackage:       in.PackageID,
+		Version:             c.version.ToGraphQL(in.Version),
+		LastUpdate:          in.LastUpdate,
+		Visibility:          in.Visibility,
+		ReleaseStatus:       in.ReleaseStatus,
+		Disabled:            in.Disabled,
+		DeprecationDate:     in.DeprecationDate,
+		SunsetDate:          in.SunsetDate,
+		Successors:          graphql.JSONPtrFromRawMessage(in.Successors),
+		ChangeLogEntries:    graphql.JSONPtrFromRawMessage(in.ChangeLogEntries),
+		Type:                in.Type,
+		Category:            in.Category,
+		EntityTypes:         graphql.JSONPtrFromRawMessage(in.EntityTypes),
+		InputPorts:          graphql.JSONPtrFromRawMessage(in.InputPorts),
+		OutputPorts:         graphql.JSONPtrFromRawMessage(in.OutputPorts),
+		Responsible:         in.Responsible,
+		DataProductLinks:    graphql.JSONPtrFromRawMessage(in.DataProductLinks),
+		Links:               graphql.JSONPtrFromRawMessage(in.Links),
+		Industry:            graphql.JSONPtrFromRawMessage(in.Industry),
+		LineOfBusiness:      graphql.JSONPtrFromRawMessage(in.LineOfBusiness),
+		Tags:                graphql.JSONPtrFromRawMessage(in.Tags),
+		Labels:              labels,
+		DocumentationLabels: graphql.JSONPtrFromRawMessage(in.DocumentationLabels),
+		PolicyLevel:         in.PolicyLevel,
+		CustomPolicyLevel:   in.CustomPolicyLevel,
+		SystemInstanceAware: in.SystemInstanceAware,
+		CorrelationIDs:      graphql.JSONPtrFromRawMessage(in.CorrelationIDs),
+	}, nil
+}
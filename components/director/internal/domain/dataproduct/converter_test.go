@@ -1,10 +1,14 @@
 package dataproduct_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/dataproduct"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -48,3 +52,52 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Nil(t, dataProductModel)
 	})
 }
+
+func TestEntityConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		in := &model.DataProduct{
+			BaseEntity: &model.BaseEntity{ID: "id"},
+			OrdID:      str.Ptr("ordID"),
+			Title:      "title",
+			Tags:       json.RawMessage(`["tag"]`),
+			Labels:     json.RawMessage(`{"key":["value"]}`),
+			Version:    &model.Version{Value: "1.0.0"},
+		}
+		tags := graphql.JSON(`["tag"]`)
+		expected := &graphql.DataProduct{
+			ID:      "id",
+			OrdID:   str.Ptr("ordID"),
+			Title:   "title",
+			Tags:    &tags,
+			Labels:  graphql.Labels{"key": []interface{}{"value"}},
+			Version: &graphql.Version{Value: "1.0.0"},
+		}
+		conv := dataproduct.NewConverter(version.NewConverter())
+
+		// WHEN
+		result, err := conv.ToGraphQL(in)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Returns nil if data product model is nil", func(t *testing.T) {
+		conv := dataproduct.NewConverter(version.NewConverter())
+
+		result, err := conv.ToGraphQL(nil)
+
+		require.NoError(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Returns error when labels are invalid", func(t *testing.T) {
+		conv := dataproduct.NewConverter(version.NewConverter())
+
+		result, err := conv.ToGraphQL(&model.DataProduct{BaseEntity: &model.BaseEntity{ID: "id"}, Labels: json.RawMessage("invalid")})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+}
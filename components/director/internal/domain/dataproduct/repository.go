@@ -6,7 +6,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 	"github.com/pkg/errors"
 )
 
@@ -15,6 +18,9 @@ const (
 	idColumn                   string = "id"
 	appIDColumn                string = "app_id"
 	appTemplateVersionIDColumn string = "app_template_version_id"
+	visibilityColumn           string = "visibility"
+	internalVisibilityScope    string = "internal_visibility:read"
+	publicVisibilityValue      string = "public"
 )
 
 var (
@@ -48,6 +54,9 @@ type pgRepository struct {
 	updaterGlobal      repo.UpdaterGlobal
 	deleter            repo.Deleter
 	deleterGlobal      repo.DeleterGlobal
+	unionLister        repo.UnionLister
+	unionListerGlobal  repo.UnionListerGlobal
+	queryBuilder       repo.QueryBuilderGlobal
 
 	conv DataProductConverter
 }
@@ -65,11 +74,93 @@ func NewRepository(conv DataProductConverter) *pgRepository {
 		updaterGlobal:      repo.NewUpdaterGlobal(resource.DataProduct, dataProductTable, updatableColumns, idColumns),
 		deleter:            repo.NewDeleter(dataProductTable),
 		deleterGlobal:      repo.NewDeleterGlobal(resource.DataProduct, dataProductTable),
+		unionLister:        repo.NewUnionLister(dataProductTable, dataProductColumns),
+		unionListerGlobal:  repo.NewUnionListerGlobal(resource.DataProduct, dataProductTable, dataProductColumns),
+		queryBuilder:       repo.NewQueryBuilderGlobal(resource.DataProduct, dataProductTable, idColumns),
 
 		conv: conv,
 	}
 }
 
+// ListByResourceIDs gets a page of data products for each of the given resource IDs. The resources are either applications or application template versions
+func (r *pgRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.DataProductPage, error) {
+	conditions, err := r.visibilityConditions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dataProductCollection := DataProductCollection{}
+
+	var counts map[string]int
+	if resourceType == resource.Application {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionLister.List(ctx, resource.DataProduct, tenantID, resourceIDs, appIDColumn, pageSize, cursor, orderByColumns, &dataProductCollection, conditions...)
+	} else {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appTemplateVersionIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionListerGlobal.ListGlobal(ctx, resourceIDs, appTemplateVersionIDColumn, pageSize, cursor, orderByColumns, &dataProductCollection, conditions...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dataProductsByResourceID := make(map[string][]*model.DataProduct, len(resourceIDs))
+	for _, dataProduct := range dataProductCollection {
+		dataProductModel := r.conv.FromEntity(&dataProduct)
+
+		resourceID := dataProduct.ApplicationID.String
+		if resourceType != resource.Application {
+			resourceID = dataProduct.ApplicationTemplateVersionID.String
+		}
+		dataProductsByResourceID[resourceID] = append(dataProductsByResourceID[resourceID], dataProductModel)
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	dataProductPages := make([]*model.DataProductPage, 0, len(resourceIDs))
+	for _, resourceID := range resourceIDs {
+		totalCount := counts[resourceID]
+		hasNextPage := false
+		endCursor := ""
+		if totalCount > offset+len(dataProductsByResourceID[resourceID]) {
+			hasNextPage = true
+			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		}
+
+		page := &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		}
+
+		dataProductPages = append(dataProductPages, &model.DataProductPage{Data: dataProductsByResourceID[resourceID], TotalCount: totalCount, PageInfo: page})
+	}
+
+	return dataProductPages, nil
+}
+
+func (r *pgRepository) visibilityConditions(ctx context.Context) (repo.Conditions, error) {
+	isInternalVisibilityScopePresent, err := scope.Contains(ctx, internalVisibilityScope)
+	if err != nil {
+		log.C(ctx).Infof("No scopes are present in the context meaning the flow is not user-initiated. Processing Data Products without visibility check...")
+		return nil, nil
+	}
+	if isInternalVisibilityScopePresent {
+		log.C(ctx).Infof("Internal visibility scope is present in the context. Processing Data Products without visibility check...")
+		return nil, nil
+	}
+
+	log.C(ctx).Infof("No internal visibility scope is present in the context. Processing only public Data Products")
+	query, args, err := r.queryBuilder.BuildQueryGlobal(false, repo.NewEqualCondition(visibilityColumn, publicVisibilityValue))
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.Conditions{repo.NewInConditionForSubQuery(idColumn, query, args)}, nil
+}
+
 // DataProductCollection is an array of Entities
 type DataProductCollection []Entity
 
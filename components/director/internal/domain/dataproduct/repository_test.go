@@ -1,6 +1,7 @@
 package dataproduct_test
 
 import (
+	"context"
 	"database/sql/driver"
 	"regexp"
 	"testing"
@@ -10,7 +11,12 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/dataproduct/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPgRepository_ListByResourceID(t *testing.T) {
@@ -325,3 +331,158 @@ func TestPgRepository_DeleteGlobal(t *testing.T) {
 
 	suite.Run(t)
 }
+
+func TestPgRepository_ListByResourceIDs(t *testing.T) {
+	pageSize := 2
+	cursor := ""
+	emptyPageResourceID := "emptyPageResourceID"
+	firstDataProductID := "111111111-1111-1111-1111-111111111111"
+	secondDataProductID := "222222222-2222-2222-2222-222222222222"
+
+	suiteForApplication := testdb.RepoListPageableTestSuite{
+		Name: "List Data Products for multiple Applications with paging",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`(SELECT id, app_id, app_template_version_id, ord_id, local_tenant_id, correlation_ids, title, short_description, description, package_id, last_update, visibility, release_status, disabled, deprecation_date, sunset_date, successors, changelog_entries, type, category, entity_types, input_ports, output_ports, responsible, data_product_links, links, industry, line_of_business, tags, labels, documentation_labels, policy_level, custom_policy_level, system_instance_aware, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, resource_hash FROM public.data_products WHERE (id IN (SELECT id FROM data_products_tenants WHERE tenant_id = $1)) AND app_id = $2 ORDER BY app_id ASC, id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, app_id, app_template_version_id, ord_id, local_tenant_id, correlation_ids, title, short_description, description, package_id, last_update, visibility, release_status, disabled, deprecation_date, sunset_date, successors, changelog_entries, type, category, entity_types, input_ports, output_ports, responsible, data_product_links, links, industry, line_of_business, tags, labels, documentation_labels, policy_level, custom_policy_level, system_instance_aware, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, resource_hash FROM public.data_products WHERE (id IN (SELECT id FROM data_products_tenants WHERE tenant_id = $5)) AND app_id = $6 ORDER BY app_id ASC, id ASC LIMIT $7 OFFSET $8)`),
+				Args:     []driver.Value{tenantID, emptyPageResourceID, pageSize, 0, tenantID, appID, pageSize, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixDataProductColumns()).AddRow(fixDataProductRow(firstDataProductID, appID)...).AddRow(fixDataProductRow(secondDataProductID, appID)...)}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT app_id AS id, COUNT(*) AS total_count FROM public.data_products WHERE (id IN (SELECT id FROM data_products_tenants WHERE tenant_id = $1)) AND app_id IN ($2, $3) GROUP BY app_id ORDER BY app_id ASC`),
+				Args:     []driver.Value{tenantID, emptyPageResourceID, appID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow(emptyPageResourceID, 0).AddRow(appID, 2)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: nil,
+				ExpectedDBEntities:    nil,
+				ExpectedPage: &model.DataProductPage{
+					Data: nil,
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 0,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{fixDataProductModel(firstDataProductID), fixDataProductModel(secondDataProductID)},
+				ExpectedDBEntities:    []interface{}{fixDataProductEntity(firstDataProductID, appID), fixDataProductEntity(secondDataProductID, appID)},
+				ExpectedPage: &model.DataProductPage{
+					Data: []*model.DataProduct{fixDataProductModel(firstDataProductID), fixDataProductModel(secondDataProductID)},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.DataProductConverter{}
+		},
+		RepoConstructorFunc:       dataproduct.NewRepository,
+		MethodName:                "ListByResourceIDs",
+		MethodArgs:                []interface{}{tenantID, resource.Application, []string{emptyPageResourceID, appID}, pageSize, cursor},
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForApplicationTemplateVersion := testdb.RepoListPageableTestSuite{
+		Name: "List Data Products for multiple Application Template Versions with paging",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`(SELECT id, app_id, app_template_version_id, ord_id, local_tenant_id, correlation_ids, title, short_description, description, package_id, last_update, visibility, release_status, disabled, deprecation_date, sunset_date, successors, changelog_entries, type, category, entity_types, input_ports, output_ports, responsible, data_product_links, links, industry, line_of_business, tags, labels, documentation_labels, policy_level, custom_policy_level, system_instance_aware, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, resource_hash FROM public.data_products WHERE app_template_version_id = $1 ORDER BY app_template_version_id ASC, id ASC LIMIT $2 OFFSET $3) UNION (SELECT id, app_id, app_template_version_id, ord_id, local_tenant_id, correlation_ids, title, short_description, description, package_id, last_update, visibility, release_status, disabled, deprecation_date, sunset_date, successors, changelog_entries, type, category, entity_types, input_ports, output_ports, responsible, data_product_links, links, industry, line_of_business, tags, labels, documentation_labels, policy_level, custom_policy_level, system_instance_aware, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, resource_hash FROM public.data_products WHERE app_template_version_id = $4 ORDER BY app_template_version_id ASC, id ASC LIMIT $5 OFFSET $6)`),
+				Args:     []driver.Value{emptyPageResourceID, pageSize, 0, appTemplateVersionID, pageSize, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixDataProductColumns()).AddRow(fixDataProductRow(firstDataProductID, appID)...).AddRow(fixDataProductRow(secondDataProductID, appID)...)}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT app_template_version_id AS id, COUNT(*) AS total_count FROM public.data_products WHERE app_template_version_id IN ($1, $2) GROUP BY app_template_version_id ORDER BY app_template_version_id ASC`),
+				Args:     []driver.Value{emptyPageResourceID, appTemplateVersionID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow(emptyPageResourceID, 0).AddRow(appTemplateVersionID, 2)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: nil,
+				ExpectedDBEntities:    nil,
+				ExpectedPage: &model.DataProductPage{
+					Data: nil,
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 0,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{fixDataProductModel(firstDataProductID), fixDataProductModel(secondDataProductID)},
+				ExpectedDBEntities:    []interface{}{fixDataProductEntity(firstDataProductID, appID), fixDataProductEntity(secondDataProductID, appID)},
+				ExpectedPage: &model.DataProductPage{
+					Data: []*model.DataProduct{fixDataProductModel(firstDataProductID), fixDataProductModel(secondDataProductID)},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.DataProductConverter{}
+		},
+		RepoConstructorFunc:       dataproduct.NewRepository,
+		MethodName:                "ListByResourceIDs",
+		MethodArgs:                []interface{}{tenantID, resource.ApplicationTemplateVersion, []string{emptyPageResourceID, appTemplateVersionID}, pageSize, cursor},
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForApplication.Run(t)
+	suiteForApplicationTemplateVersion.Run(t)
+
+	t.Run("ListByResourceIDs returns only public data products when the internal visibility scope is missing", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`(SELECT id, app_id, app_template_version_id, ord_id, local_tenant_id, correlation_ids, title, short_description, description, package_id, last_update, visibility, release_status, disabled, deprecation_date, sunset_date, successors, changelog_entries, type, category, entity_types, input_ports, output_ports, responsible, data_product_links, links, industry, line_of_business, tags, labels, documentation_labels, policy_level, custom_policy_level, system_instance_aware, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, resource_hash FROM public.data_products WHERE id IN (SELECT id FROM public.data_products WHERE visibility = $1) AND (id IN (SELECT id FROM data_products_tenants WHERE tenant_id = $2)) AND app_id = $3 ORDER BY app_id ASC, id ASC LIMIT $4 OFFSET $5)`)).
+			WithArgs("public", tenantID, appID, pageSize, 0).
+			WillReturnRows(sqlmock.NewRows(fixDataProductColumns()).AddRow(fixDataProductRow(firstDataProductID, appID)...))
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_id AS id, COUNT(*) AS total_count FROM public.data_products WHERE id IN (SELECT id FROM public.data_products WHERE visibility = $1) AND (id IN (SELECT id FROM data_products_tenants WHERE tenant_id = $2)) AND app_id IN ($3) GROUP BY app_id ORDER BY app_id ASC`)).
+			WithArgs("public", tenantID, appID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(appID, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		ctx = scope.SaveToContext(ctx, []string{"test:test"})
+
+		convMock := &automock.DataProductConverter{}
+		convMock.On("FromEntity", fixDataProductEntity(firstDataProductID, appID)).Return(fixDataProductModel(firstDataProductID)).Once()
+		pgRepository := dataproduct.NewRepository(convMock)
+
+		// WHEN
+		pages, err := pgRepository.ListByResourceIDs(ctx, tenantID, resource.Application, []string{appID}, pageSize, cursor)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, pages, 1)
+		assert.Equal(t, []*model.DataProduct{fixDataProductModel(firstDataProductID)}, pages[0].Data)
+		assert.Equal(t, 1, pages[0].TotalCount)
+
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,80 @@
+package dataproduct
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpage"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// DataProductService is responsible for the service-layer Data Product operations.
+//
+//go:generate mockery --name=DataProductService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type DataProductService interface {
+	ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.DataProductPage, error)
+}
+
+// GraphQLConverter converts Data Products from the model.DataProduct service-layer representation to the graphql-layer representation.
+//
+//go:generate mockery --name=GraphQLConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLConverter interface {
+	ToGraphQL(in *model.DataProduct) (*graphql.DataProduct, error)
+}
+
+// Resolver is an object responsible for resolver-layer Data Product operations.
+type Resolver struct {
+	pages *ordpage.Resolver[*model.DataProductPage, *graphql.DataProductPage]
+}
+
+// NewResolver returns a new object responsible for resolver-layer Data Product operations.
+func NewResolver(transact persistence.Transactioner, dataProductSvc DataProductService, dataProductConverter GraphQLConverter, appTemplateVersionSvc ordpage.ApplicationTemplateVersionService) *Resolver {
+	toGraphQLPage := func(page *model.DataProductPage) (*graphql.DataProductPage, error) {
+		data, err := ordpage.ConvertData(page.Data, dataProductConverter.ToGraphQL)
+		if err != nil {
+			return nil, err
+		}
+
+		return &graphql.DataProductPage{
+			Data:       data,
+			TotalCount: page.TotalCount,
+			PageInfo:   ordpage.ToGraphQLPageInfo(page.PageInfo),
+		}, nil
+	}
+	emptyPage := func() *graphql.DataProductPage {
+		return &graphql.DataProductPage{Data: []*graphql.DataProduct{}, PageInfo: &graphql.PageInfo{}}
+	}
+
+	return &Resolver{
+		pages: ordpage.NewResolver(transact, appTemplateVersionSvc, dataProductSvc.ListByResourceIDs, toGraphQLPage, emptyPage),
+	}
+}
+
+// ApplicationDataProducts fetches a page of data products for an Application
+func (r *Resolver) ApplicationDataProducts(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.DataProductPage, error) {
+	param := dataloader.ParamDataProduct{ID: obj.ID, Ctx: ctx, First: first, After: after}
+	return dataloader.DataProductFor(ctx).DataProductByID.Load(param)
+}
+
+// ApplicationDataProductsDataLoader retrieves a page of data products for each Application ID in the keys argument
+func (r *Resolver) ApplicationDataProductsDataLoader(keys []dataloader.ParamDataProduct) ([]*graphql.DataProductPage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	return r.pages.ApplicationPages(keys[0].Ctx, applicationIDs, keys[0].First, keys[0].After)
+}
+
+// ApplicationTemplateDataProducts fetches a page of data products of the latest version of an Application Template
+func (r *Resolver) ApplicationTemplateDataProducts(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.DataProductPage, error) {
+	return r.pages.ApplicationTemplatePage(ctx, obj.ID, first, after)
+}
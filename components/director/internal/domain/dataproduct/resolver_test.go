@@ -0,0 +1,112 @@
+package dataproduct_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/dataproduct"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/dataproduct/automock"
+	ordpageautomock "github.com/kyma-incubator/compass/components/director/internal/domain/ordpage/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// The paging, transaction handling and error cases are covered by the tests of the ordpage package
+func TestResolver_ApplicationDataProductsDataLoader(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	firstAppID := "appID"
+	secondAppID := "appID2"
+	first := 2
+	after := graphql.PageCursor("test")
+
+	dataProductFirstApp := &model.DataProduct{ApplicationID: &firstAppID}
+	dataProductSecondApp := &model.DataProduct{ApplicationID: &secondAppID}
+	gqlDataProductFirstApp := &graphql.DataProduct{ID: "dataProductID"}
+	gqlDataProductSecondApp := &graphql.DataProduct{ID: "dataProductID2"}
+
+	pageInfo := &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: true}
+	gqlPageInfo := &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: true}
+
+	persist, transact := txGen.ThatSucceeds()
+
+	svc := &automock.DataProductService{}
+	svc.On("ListByResourceIDs", txtest.CtxWithDBMatcher(), resource.Application, []string{firstAppID, secondAppID}, first, string(after)).Return([]*model.DataProductPage{
+		{Data: []*model.DataProduct{dataProductFirstApp}, PageInfo: pageInfo, TotalCount: 1},
+		{Data: []*model.DataProduct{dataProductSecondApp}, PageInfo: pageInfo, TotalCount: 1},
+	}, nil).Once()
+
+	conv := &automock.GraphQLConverter{}
+	conv.On("ToGraphQL", dataProductFirstApp).Return(gqlDataProductFirstApp, nil).Once()
+	conv.On("ToGraphQL", dataProductSecondApp).Return(gqlDataProductSecondApp, nil).Once()
+
+	appTemplateVersionSvc := &ordpageautomock.ApplicationTemplateVersionService{}
+	defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv, appTemplateVersionSvc)
+
+	resolver := dataproduct.NewResolver(transact, svc, conv, appTemplateVersionSvc)
+	keys := []dataloader.ParamDataProduct{
+		{ID: firstAppID, Ctx: context.TODO(), First: &first, After: &after},
+		{ID: secondAppID, Ctx: context.TODO(), First: &first, After: &after},
+	}
+
+	// WHEN
+	result, errs := resolver.ApplicationDataProductsDataLoader(keys)
+
+	// THEN
+	require.Nil(t, errs)
+	assert.Equal(t, []*graphql.DataProductPage{
+		{Data: []*graphql.DataProduct{gqlDataProductFirstApp}, PageInfo: gqlPageInfo, TotalCount: 1},
+		{Data: []*graphql.DataProduct{gqlDataProductSecondApp}, PageInfo: gqlPageInfo, TotalCount: 1},
+	}, result)
+
+	t.Run("Returns error when there are no Applications", func(t *testing.T) {
+		result, errs := dataproduct.NewResolver(nil, svc, conv, nil).ApplicationDataProductsDataLoader([]dataloader.ParamDataProduct{})
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Applications found")
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_ApplicationTemplateDataProducts(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	appTemplateID := "appTemplateID"
+	versionID := "versionID"
+	first := 2
+
+	dataProductOfVersion := &model.DataProduct{ApplicationTemplateVersionID: &versionID}
+	gqlDataProductOfVersion := &graphql.DataProduct{ID: "dataProductID"}
+
+	persist, transact := txGen.ThatSucceeds()
+
+	appTemplateVersionSvc := &ordpageautomock.ApplicationTemplateVersionService{}
+	appTemplateVersionSvc.On("GetLatestByAppTemplateID", txtest.CtxWithDBMatcher(), appTemplateID).Return(&model.ApplicationTemplateVersion{ID: versionID, ApplicationTemplateID: appTemplateID}, nil).Once()
+
+	svc := &automock.DataProductService{}
+	svc.On("ListByResourceIDs", txtest.CtxWithDBMatcher(), resource.ApplicationTemplateVersion, []string{versionID}, first, "").Return([]*model.DataProductPage{
+		{Data: []*model.DataProduct{dataProductOfVersion}, PageInfo: &pagination.Page{}, TotalCount: 1},
+	}, nil).Once()
+
+	conv := &automock.GraphQLConverter{}
+	conv.On("ToGraphQL", dataProductOfVersion).Return(gqlDataProductOfVersion, nil).Once()
+	defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv, appTemplateVersionSvc)
+
+	resolver := dataproduct.NewResolver(transact, svc, conv, appTemplateVersionSvc)
+
+	// WHEN
+	result, err := resolver.ApplicationTemplateDataProducts(context.TODO(), &graphql.ApplicationTemplate{ID: appTemplateID}, &first, nil)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, &graphql.DataProductPage{Data: []*graphql.DataProduct{gqlDataProductOfVersion}, PageInfo: &graphql.PageInfo{}, TotalCount: 1}, result)
+}
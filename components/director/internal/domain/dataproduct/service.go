@@ -6,6 +6,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
@@ -16,6 +17,7 @@ import (
 //go:generate mockery --name=DataProductRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type DataProductRepository interface {
 	ListByResourceID(ctx context.Context, tenantID string, resourceType resource.Type, resourceID string) ([]*model.DataProduct, error)
+	ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.DataProductPage, error)
 	Create(ctx context.Context, tenant string, item *model.DataProduct) error
 	CreateGlobal(ctx context.Context, item *model.DataProduct) error
 	GetByID(ctx context.Context, tenantID, id string) (*model.DataProduct, error)
@@ -63,6 +65,24 @@ func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, appTem
 	return s.repo.ListByResourceID(ctx, "", resource.ApplicationTemplateVersion, appTemplateVersionID)
 }
 
+// ListByResourceIDs lists a page of data products for each of the given application or application template version IDs
+func (s *service) ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.DataProductPage, error) {
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	if resourceType.IsTenantIgnorable() {
+		return s.repo.ListByResourceIDs(ctx, "", resourceType, resourceIDs, pageSize, cursor)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListByResourceIDs(ctx, tnt, resourceType, resourceIDs, pageSize, cursor)
+}
+
 // Create creates Data Product for a resource with given id.
 func (s *service) Create(ctx context.Context, resourceType resource.Type, resourceID string, packageID *string, in model.DataProductInput, dataProductHash uint64) (string, error) {
 	id := s.uidService.Generate()
@@ -5,13 +5,10 @@ package automock
 import (
 	context "context"
 
-	destinationcreator "github.com/kyma-incubator/compass/components/director/pkg/destinationcreator"
-
-	mock "github.com/stretchr/testify/mock"
-
-	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
 	operators "github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	destinationcreatorpkg "github.com/kyma-incubator/compass/components/director/pkg/destinationcreator"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // DestinationCreatorService is an autogenerated mock type for the destinationCreatorService type
@@ -20,19 +17,19 @@ type DestinationCreatorService struct {
 }
 
 // CreateBasicCredentialDestinations provides a mock function with given fields: ctx, destinationDetails, basicAuthenticationCredentials, formationAssignment, correlationIDs, depth, skipSubaccountValidation
-func (_m *DestinationCreatorService) CreateBasicCredentialDestinations(ctx context.Context, destinationDetails operators.Destination, basicAuthenticationCredentials operators.BasicAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, depth uint8, skipSubaccountValidation bool) (*destinationcreator.DestinationInfo, error) {
+func (_m *DestinationCreatorService) CreateBasicCredentialDestinations(ctx context.Context, destinationDetails operators.Destination, basicAuthenticationCredentials operators.BasicAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, depth uint8, skipSubaccountValidation bool) (*destinationcreatorpkg.DestinationInfo, error) {
 	ret := _m.Called(ctx, destinationDetails, basicAuthenticationCredentials, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 
-	var r0 *destinationcreator.DestinationInfo
+	var r0 *destinationcreatorpkg.DestinationInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, operators.BasicAuthentication, *model.FormationAssignment, []string, uint8, bool) (*destinationcreator.DestinationInfo, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, operators.BasicAuthentication, *model.FormationAssignment, []string, uint8, bool) (*destinationcreatorpkg.DestinationInfo, error)); ok {
 		return rf(ctx, destinationDetails, basicAuthenticationCredentials, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, operators.BasicAuthentication, *model.FormationAssignment, []string, uint8, bool) *destinationcreator.DestinationInfo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, operators.BasicAuthentication, *model.FormationAssignment, []string, uint8, bool) *destinationcreatorpkg.DestinationInfo); ok {
 		r0 = rf(ctx, destinationDetails, basicAuthenticationCredentials, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*destinationcreator.DestinationInfo)
+			r0 = ret.Get(0).(*destinationcreatorpkg.DestinationInfo)
 		}
 	}
 
@@ -46,19 +43,19 @@ func (_m *DestinationCreatorService) CreateBasicCredentialDestinations(ctx conte
 }
 
 // CreateClientCertificateDestination provides a mock function with given fields: ctx, destinationDetails, clientCertAuthCreds, formationAssignment, correlationIDs, depth, skipSubaccountValidation
-func (_m *DestinationCreatorService) CreateClientCertificateDestination(ctx context.Context, destinationDetails operators.Destination, clientCertAuthCreds *operators.ClientCertAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, depth uint8, skipSubaccountValidation bool) (*destinationcreator.DestinationInfo, error) {
+func (_m *DestinationCreatorService) CreateClientCertificateDestination(ctx context.Context, destinationDetails operators.Destination, clientCertAuthCreds *operators.ClientCertAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, depth uint8, skipSubaccountValidation bool) (*destinationcreatorpkg.DestinationInfo, error) {
 	ret := _m.Called(ctx, destinationDetails, clientCertAuthCreds, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 
-	var r0 *destinationcreator.DestinationInfo
+	var r0 *destinationcreatorpkg.DestinationInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.ClientCertAuthentication, *model.FormationAssignment, []string, uint8, bool) (*destinationcreator.DestinationInfo, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.ClientCertAuthentication, *model.FormationAssignment, []string, uint8, bool) (*destinationcreatorpkg.DestinationInfo, error)); ok {
 		return rf(ctx, destinationDetails, clientCertAuthCreds, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.ClientCertAuthentication, *model.FormationAssignment, []string, uint8, bool) *destinationcreator.DestinationInfo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.ClientCertAuthentication, *model.FormationAssignment, []string, uint8, bool) *destinationcreatorpkg.DestinationInfo); ok {
 		r0 = rf(ctx, destinationDetails, clientCertAuthCreds, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*destinationcreator.DestinationInfo)
+			r0 = ret.Get(0).(*destinationcreatorpkg.DestinationInfo)
 		}
 	}
 
@@ -86,19 +83,19 @@ func (_m *DestinationCreatorService) CreateDesignTimeDestinations(ctx context.Co
 }
 
 // CreateOAuth2ClientCredentialsDestinations provides a mock function with given fields: ctx, destinationDetails, oauth2ClientCredsCredentials, formationAssignment, correlationIDs, depth, skipSubaccountValidation
-func (_m *DestinationCreatorService) CreateOAuth2ClientCredentialsDestinations(ctx context.Context, destinationDetails operators.Destination, oauth2ClientCredsCredentials *operators.OAuth2ClientCredentialsAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, depth uint8, skipSubaccountValidation bool) (*destinationcreator.DestinationInfo, error) {
+func (_m *DestinationCreatorService) CreateOAuth2ClientCredentialsDestinations(ctx context.Context, destinationDetails operators.Destination, oauth2ClientCredsCredentials *operators.OAuth2ClientCredentialsAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, depth uint8, skipSubaccountValidation bool) (*destinationcreatorpkg.DestinationInfo, error) {
 	ret := _m.Called(ctx, destinationDetails, oauth2ClientCredsCredentials, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 
-	var r0 *destinationcreator.DestinationInfo
+	var r0 *destinationcreatorpkg.DestinationInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.OAuth2ClientCredentialsAuthentication, *model.FormationAssignment, []string, uint8, bool) (*destinationcreator.DestinationInfo, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.OAuth2ClientCredentialsAuthentication, *model.FormationAssignment, []string, uint8, bool) (*destinationcreatorpkg.DestinationInfo, error)); ok {
 		return rf(ctx, destinationDetails, oauth2ClientCredsCredentials, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.OAuth2ClientCredentialsAuthentication, *model.FormationAssignment, []string, uint8, bool) *destinationcreator.DestinationInfo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.OAuth2ClientCredentialsAuthentication, *model.FormationAssignment, []string, uint8, bool) *destinationcreatorpkg.DestinationInfo); ok {
 		r0 = rf(ctx, destinationDetails, oauth2ClientCredsCredentials, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*destinationcreator.DestinationInfo)
+			r0 = ret.Get(0).(*destinationcreatorpkg.DestinationInfo)
 		}
 	}
 
@@ -112,19 +109,19 @@ func (_m *DestinationCreatorService) CreateOAuth2ClientCredentialsDestinations(c
 }
 
 // CreateOAuth2mTLSDestinations provides a mock function with given fields: ctx, destinationDetails, oauth2mTLSAuthentication, formationAssignment, correlationIDs, depth, skipSubaccountValidation
-func (_m *DestinationCreatorService) CreateOAuth2mTLSDestinations(ctx context.Context, destinationDetails operators.Destination, oauth2mTLSAuthentication *operators.OAuth2mTLSAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, depth uint8, skipSubaccountValidation bool) (*destinationcreator.DestinationInfo, error) {
+func (_m *DestinationCreatorService) CreateOAuth2mTLSDestinations(ctx context.Context, destinationDetails operators.Destination, oauth2mTLSAuthentication *operators.OAuth2mTLSAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, depth uint8, skipSubaccountValidation bool) (*destinationcreatorpkg.DestinationInfo, error) {
 	ret := _m.Called(ctx, destinationDetails, oauth2mTLSAuthentication, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 
-	var r0 *destinationcreator.DestinationInfo
+	var r0 *destinationcreatorpkg.DestinationInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.OAuth2mTLSAuthentication, *model.FormationAssignment, []string, uint8, bool) (*destinationcreator.DestinationInfo, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.OAuth2mTLSAuthentication, *model.FormationAssignment, []string, uint8, bool) (*destinationcreatorpkg.DestinationInfo, error)); ok {
 		return rf(ctx, destinationDetails, oauth2mTLSAuthentication, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.OAuth2mTLSAuthentication, *model.FormationAssignment, []string, uint8, bool) *destinationcreator.DestinationInfo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.OAuth2mTLSAuthentication, *model.FormationAssignment, []string, uint8, bool) *destinationcreatorpkg.DestinationInfo); ok {
 		r0 = rf(ctx, destinationDetails, oauth2mTLSAuthentication, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*destinationcreator.DestinationInfo)
+			r0 = ret.Get(0).(*destinationcreatorpkg.DestinationInfo)
 		}
 	}
 
@@ -138,19 +135,19 @@ func (_m *DestinationCreatorService) CreateOAuth2mTLSDestinations(ctx context.Co
 }
 
 // CreateSAMLAssertionDestination provides a mock function with given fields: ctx, destinationDetails, samlAuthCreds, formationAssignment, correlationIDs, depth, skipSubaccountValidation
-func (_m *DestinationCreatorService) CreateSAMLAssertionDestination(ctx context.Context, destinationDetails operators.Destination, samlAuthCreds *operators.SAMLAssertionAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, depth uint8, skipSubaccountValidation bool) (*destinationcreator.DestinationInfo, error) {
+func (_m *DestinationCreatorService) CreateSAMLAssertionDestination(ctx context.Context, destinationDetails operators.Destination, samlAuthCreds *operators.SAMLAssertionAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, depth uint8, skipSubaccountValidation bool) (*destinationcreatorpkg.DestinationInfo, error) {
 	ret := _m.Called(ctx, destinationDetails, samlAuthCreds, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 
-	var r0 *destinationcreator.DestinationInfo
+	var r0 *destinationcreatorpkg.DestinationInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.SAMLAssertionAuthentication, *model.FormationAssignment, []string, uint8, bool) (*destinationcreator.DestinationInfo, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.SAMLAssertionAuthentication, *model.FormationAssignment, []string, uint8, bool) (*destinationcreatorpkg.DestinationInfo, error)); ok {
 		return rf(ctx, destinationDetails, samlAuthCreds, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.SAMLAssertionAuthentication, *model.FormationAssignment, []string, uint8, bool) *destinationcreator.DestinationInfo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, operators.Destination, *operators.SAMLAssertionAuthentication, *model.FormationAssignment, []string, uint8, bool) *destinationcreatorpkg.DestinationInfo); ok {
 		r0 = rf(ctx, destinationDetails, samlAuthCreds, formationAssignment, correlationIDs, depth, skipSubaccountValidation)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*destinationcreator.DestinationInfo)
+			r0 = ret.Get(0).(*destinationcreatorpkg.DestinationInfo)
 		}
 	}
 
@@ -229,6 +226,32 @@ func (_m *DestinationCreatorService) EnsureDestinationSubaccountIDsCorrectness(c
 	return r0
 }
 
+// GetCertificateNames provides a mock function with given fields: ctx, destinationAuthentication, formationAssignmentID
+func (_m *DestinationCreatorService) GetCertificateNames(ctx context.Context, destinationAuthentication destinationcreatorpkg.AuthType, formationAssignmentID string) ([]string, error) {
+	ret := _m.Called(ctx, destinationAuthentication, formationAssignmentID)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, destinationcreatorpkg.AuthType, string) ([]string, error)); ok {
+		return rf(ctx, destinationAuthentication, formationAssignmentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, destinationcreatorpkg.AuthType, string) []string); ok {
+		r0 = rf(ctx, destinationAuthentication, formationAssignmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, destinationcreatorpkg.AuthType, string) error); ok {
+		r1 = rf(ctx, destinationAuthentication, formationAssignmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetConsumerTenant provides a mock function with given fields: ctx, formationAssignment
 func (_m *DestinationCreatorService) GetConsumerTenant(ctx context.Context, formationAssignment *model.FormationAssignment) (string, error) {
 	ret := _m.Called(ctx, formationAssignment)
@@ -5,9 +5,8 @@ package automock
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // DestinationRepository is an autogenerated mock type for the destinationRepository type
@@ -55,6 +54,58 @@ func (_m *DestinationRepository) GetDestinationByNameAndTenant(ctx context.Conte
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, tenantID, filter, pageSize, cursor
+func (_m *DestinationRepository) List(ctx context.Context, tenantID string, filter *model.DestinationFilter, pageSize int, cursor string) (*model.DestinationPage, error) {
+	ret := _m.Called(ctx, tenantID, filter, pageSize, cursor)
+
+	var r0 *model.DestinationPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.DestinationFilter, int, string) (*model.DestinationPage, error)); ok {
+		return rf(ctx, tenantID, filter, pageSize, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.DestinationFilter, int, string) *model.DestinationPage); ok {
+		r0 = rf(ctx, tenantID, filter, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DestinationPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *model.DestinationFilter, int, string) error); ok {
+		r1 = rf(ctx, tenantID, filter, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByApplicationIDs provides a mock function with given fields: ctx, tenantID, appIDs
+func (_m *DestinationRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) (map[string][]*model.Destination, error) {
+	ret := _m.Called(ctx, tenantID, appIDs)
+
+	var r0 map[string][]*model.Destination
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (map[string][]*model.Destination, error)); ok {
+		return rf(ctx, tenantID, appIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) map[string][]*model.Destination); ok {
+		r0 = rf(ctx, tenantID, appIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*model.Destination)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, appIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByAssignmentID provides a mock function with given fields: ctx, formationAssignmentID
 func (_m *DestinationRepository) ListByAssignmentID(ctx context.Context, formationAssignmentID string) ([]*model.Destination, error) {
 	ret := _m.Called(ctx, formationAssignmentID)
@@ -81,13 +132,39 @@ func (_m *DestinationRepository) ListByAssignmentID(ctx context.Context, formati
 	return r0, r1
 }
 
-// UpsertWithEmbeddedTenant provides a mock function with given fields: ctx, _a1
-func (_m *DestinationRepository) UpsertWithEmbeddedTenant(ctx context.Context, _a1 *model.Destination) error {
-	ret := _m.Called(ctx, _a1)
+// ListByBundleIDs provides a mock function with given fields: ctx, tenantID, bundleIDs
+func (_m *DestinationRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string) ([]*model.Destination, error) {
+	ret := _m.Called(ctx, tenantID, bundleIDs)
+
+	var r0 []*model.Destination
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]*model.Destination, error)); ok {
+		return rf(ctx, tenantID, bundleIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.Destination); ok {
+		r0 = rf(ctx, tenantID, bundleIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Destination)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, bundleIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertWithEmbeddedTenant provides a mock function with given fields: ctx, destination
+func (_m *DestinationRepository) UpsertWithEmbeddedTenant(ctx context.Context, destination *model.Destination) error {
+	ret := _m.Called(ctx, destination)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, *model.Destination) error); ok {
-		r0 = rf(ctx, _a1)
+		r0 = rf(ctx, destination)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3,6 +3,7 @@ package destination
 import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 // NewConverter creates a new destination converter
@@ -27,6 +28,9 @@ func (c *converter) ToEntity(in *model.Destination) *Entity {
 		TenantID:              in.SubaccountID,
 		InstanceID:            repo.NewNullableString(in.InstanceID),
 		FormationAssignmentID: repo.NewNullableString(in.FormationAssignmentID),
+		BundleID:              repo.NewNullableString(in.BundleID),
+		Revision:              repo.NewNullableString(in.Revision),
+		LastSyncTimestamp:     in.LastSyncTimestamp,
 	}
 }
 
@@ -45,5 +49,45 @@ func (c *converter) FromEntity(e *Entity) *model.Destination {
 		SubaccountID:          e.TenantID,
 		InstanceID:            repo.StringPtrFromNullableString(e.InstanceID),
 		FormationAssignmentID: repo.StringPtrFromNullableString(e.FormationAssignmentID),
+		BundleID:              repo.StringPtrFromNullableString(e.BundleID),
+		Revision:              repo.StringPtrFromNullableString(e.Revision),
+		LastSyncTimestamp:     e.LastSyncTimestamp,
 	}
 }
+
+// ToGraphQL converts from an internal model to its non-sensitive GraphQL representation
+func (c *converter) ToGraphQL(in *model.Destination) *graphql.Destination {
+	if in == nil {
+		return nil
+	}
+
+	var lastSyncTimestamp *graphql.Timestamp
+	if in.LastSyncTimestamp != nil {
+		timestamp := graphql.Timestamp(*in.LastSyncTimestamp)
+		lastSyncTimestamp = &timestamp
+	}
+
+	return &graphql.Destination{
+		ID:                 in.ID,
+		Name:               in.Name,
+		Type:               in.Type,
+		URL:                in.URL,
+		AuthenticationType: in.Authentication,
+		BundleID:           in.BundleID,
+		Revision:           in.Revision,
+		LastSyncTimestamp:  lastSyncTimestamp,
+	}
+}
+
+// MultipleToGraphQL converts multiple internal models to their non-sensitive GraphQL representation
+func (c *converter) MultipleToGraphQL(in []*model.Destination) []*graphql.Destination {
+	destinations := make([]*graphql.Destination, 0, len(in))
+	for _, d := range in {
+		if d == nil {
+			continue
+		}
+		destinations = append(destinations, c.ToGraphQL(d))
+	}
+
+	return destinations
+}
@@ -3,10 +3,13 @@ package destination_test
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/destination"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,3 +94,68 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		})
 	}
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	lastSyncTimestamp := time.Date(2024, 6, 17, 10, 0, 0, 0, time.UTC)
+	graphqlLastSyncTimestamp := graphql.Timestamp(lastSyncTimestamp)
+
+	syncedDestinationModel := fixDestinationModel(destinationName)
+	syncedDestinationModel.BundleID = str.Ptr(destinationBundleID)
+	syncedDestinationModel.Revision = str.Ptr(destinationLatestRevision)
+	syncedDestinationModel.LastSyncTimestamp = &lastSyncTimestamp
+
+	testCases := []struct {
+		name            string
+		input           *model.Destination
+		expectedGraphQL *graphql.Destination
+	}{
+		{
+			name:  "success when all nullable properties are filled",
+			input: syncedDestinationModel,
+			expectedGraphQL: &graphql.Destination{
+				ID:                 destinationID,
+				Name:               destinationName,
+				Type:               string(destinationType),
+				URL:                destinationURL,
+				AuthenticationType: string(destinationNoAuthn),
+				BundleID:           str.Ptr(destinationBundleID),
+				Revision:           str.Ptr(destinationLatestRevision),
+				LastSyncTimestamp:  &graphqlLastSyncTimestamp,
+			},
+		},
+		{
+			name:  "success when all nullable properties are empty",
+			input: destinationModel,
+			expectedGraphQL: &graphql.Destination{
+				ID:                 destinationID,
+				Name:               destinationName,
+				Type:               string(destinationType),
+				URL:                destinationURL,
+				AuthenticationType: string(destinationNoAuthn),
+			},
+		},
+		{
+			name:            "returns nil when input is nil",
+			input:           nil,
+			expectedGraphQL: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			gqlDestination := converter.ToGraphQL(testCase.input)
+
+			// THEN
+			require.Equal(t, testCase.expectedGraphQL, gqlDestination)
+		})
+	}
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// WHEN
+	gqlDestinations := converter.MultipleToGraphQL([]*model.Destination{destinationModel, nil})
+
+	// THEN
+	require.Equal(t, []*graphql.Destination{converter.ToGraphQL(destinationModel)}, gqlDestinations)
+}
@@ -1,6 +1,9 @@
 package destination
 
-import "database/sql"
+import (
+	"database/sql"
+	"time"
+)
 
 // Entity is a representation of a destination entity in the database.
 type Entity struct {
@@ -14,6 +17,13 @@ type Entity struct {
 	Revision              sql.NullString `db:"revision"`
 	InstanceID            sql.NullString `db:"instance_id"`
 	FormationAssignmentID sql.NullString `db:"formation_assignment_id"`
+	LastSyncTimestamp     *time.Time     `db:"last_sync_timestamp"`
+}
+
+// applicationDestinationEntity is a destination entity together with the ID of the application owning its bundle
+type applicationDestinationEntity struct {
+	Entity
+	AppID string `db:"app_id"`
 }
 
 // EntityCollection missing godoc
@@ -21,6 +21,7 @@ const (
 	destinationFormationAssignmentID       = "654ac686-5773-4ad0-8eb1-2349e931f852"
 	destinationBundleID                    = "765ac686-5773-4ad0-8eb1-2349e931f852"
 	destinationInstanceID                  = "999ac686-5773-4ad0-8eb1-2349e931f852"
+	destinationApplicationID               = "321ac686-5773-4ad0-8eb1-2349e931f852"
 
 	// Destination constants
 	destinationName           = "test-destination-name"
@@ -219,7 +220,7 @@ func fixOAuth2mTLSAuthn() *operators.OAuth2mTLSAuthentication {
 }
 
 func fixColumns() []string {
-	return []string{"id", "name", "type", "url", "authentication", "tenant_id", "bundle_id", "revision", "instance_id", "formation_assignment_id"}
+	return []string{"id", "name", "type", "url", "authentication", "tenant_id", "bundle_id", "revision", "instance_id", "formation_assignment_id", "last_sync_timestamp"}
 }
 
 func fixUUID() string {
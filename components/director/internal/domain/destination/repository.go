@@ -2,11 +2,19 @@ package destination
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
@@ -16,12 +24,21 @@ const (
 	tenantIDColumn              = "tenant_id"
 	formationAssignmentIDColumn = "formation_assignment_id"
 	destinationNameColumn       = "name"
+	destinationTypeColumn       = "type"
+	authenticationColumn        = "authentication"
+	bundleIDColumn              = "bundle_id"
+	appIDColumn                 = "app_id"
+	listOrderByColumns          = "name, id"
+
+	listByApplicationIDsQuery = "SELECT %s, b.app_id FROM public.destinations d JOIN public.bundles b ON b.id = d.bundle_id WHERE d.tenant_id = ? AND b.%s"
 )
 
 var (
-	destinationColumns = []string{"id", "name", "type", "url", "authentication", "tenant_id", "bundle_id", "revision", "instance_id", "formation_assignment_id"}
+	destinationColumns = []string{"id", "name", "type", "url", "authentication", "tenant_id", "bundle_id", "revision", "instance_id", "formation_assignment_id", "last_sync_timestamp"}
 	conflictingColumns = []string{"name", "instance_id", "tenant_id"}
-	updateColumns      = []string{"name", "type", "url", "authentication", "revision"}
+	updateColumns      = []string{"name", "type", "url", "authentication", "revision", "last_sync_timestamp"}
+
+	likePatternEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
 )
 
 // EntityConverter missing godoc
@@ -40,6 +57,8 @@ type repository struct {
 	upserterWithEmbeddedTenant repo.UpserterGlobal
 	upserterGlobal             repo.UpserterGlobal
 	globalLister               repo.ListerGlobal
+	lister                     repo.Lister
+	pageableQuerier            repo.PageableQuerier
 }
 
 // NewRepository returns new destination repository
@@ -52,20 +71,24 @@ func NewRepository(converter EntityConverter) *repository {
 		upserterWithEmbeddedTenant: repo.NewUpserterWithEmbeddedTenant(resource.Destination, destinationTable, destinationColumns, conflictingColumns, updateColumns, tenantIDColumn),
 		upserterGlobal:             repo.NewUpserterGlobal(resource.Destination, destinationTable, destinationColumns, conflictingColumns, updateColumns),
 		globalLister:               repo.NewListerGlobal(resource.Destination, destinationTable, destinationColumns),
+		lister:                     repo.NewListerWithEmbeddedTenant(destinationTable, tenantIDColumn, destinationColumns),
+		pageableQuerier:            repo.NewPageableQuerierWithEmbeddedTenant(destinationTable, tenantIDColumn, destinationColumns),
 	}
 }
 
 // Upsert upserts a destination entity in db
 func (r *repository) Upsert(ctx context.Context, in model.DestinationInput, id, tenantID, bundleID, revisionID string) error {
+	lastSyncTimestamp := time.Now()
 	destination := Entity{
-		ID:             id,
-		Name:           in.Name,
-		Type:           in.Type,
-		URL:            in.URL,
-		Authentication: in.Authentication,
-		BundleID:       repo.NewNullableString(&bundleID),
-		TenantID:       tenantID,
-		Revision:       repo.NewNullableString(&revisionID),
+		ID:                id,
+		Name:              in.Name,
+		Type:              in.Type,
+		URL:               in.URL,
+		Authentication:    in.Authentication,
+		BundleID:          repo.NewNullableString(&bundleID),
+		TenantID:          tenantID,
+		Revision:          repo.NewNullableString(&revisionID),
+		LastSyncTimestamp: &lastSyncTimestamp,
 	}
 	return r.upserterGlobal.UpsertGlobal(ctx, destination)
 }
@@ -107,12 +130,7 @@ func (r *repository) ListByAssignmentID(ctx context.Context, formationAssignment
 		return nil, err
 	}
 
-	items := make([]*model.Destination, 0, destCollection.Len())
-	for _, destEntity := range destCollection {
-		items = append(items, r.conv.FromEntity(&destEntity))
-	}
-
-	return items, nil
+	return r.multipleFromEntities(destCollection), nil
 }
 
 // DeleteByDestinationNameAndAssignmentID deletes all destinations for a given `destinationName`, `formationAssignmentID` and `tenantID` from the DB
@@ -121,3 +139,97 @@ func (r *repository) DeleteByDestinationNameAndAssignmentID(ctx context.Context,
 	conditions := repo.Conditions{repo.NewEqualCondition(destinationNameColumn, destinationName), repo.NewEqualCondition(formationAssignmentIDColumn, formationAssignmentID)}
 	return r.deleter.DeleteMany(ctx, resource.Destination, tenantID, conditions)
 }
+
+// List returns a page of the destinations of a given `tenantID` matching the filter
+func (r *repository) List(ctx context.Context, tenantID string, filter *model.DestinationFilter, pageSize int, cursor string) (*model.DestinationPage, error) {
+	var destCollection EntityCollection
+	page, totalCount, err := r.pageableQuerier.List(ctx, resource.Destination, tenantID, pageSize, cursor, listOrderByColumns, &destCollection, filterConditions(filter)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.DestinationPage{
+		Data:       r.multipleFromEntities(destCollection),
+		PageInfo:   page,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// ListByBundleIDs returns all destinations of a given `tenantID` linked to any of the bundles with `bundleIDs`
+func (r *repository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string) ([]*model.Destination, error) {
+	if len(bundleIDs) == 0 {
+		return nil, nil
+	}
+
+	var destCollection EntityCollection
+	conditions := repo.Conditions{repo.NewInConditionForStringValues(bundleIDColumn, bundleIDs)}
+	if err := r.lister.List(ctx, resource.Destination, tenantID, &destCollection, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(destCollection), nil
+}
+
+// ListByApplicationIDs returns all destinations of a given `tenantID` linked to any of the bundles of the applications with `appIDs`, grouped by application ID
+func (r *repository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) (map[string][]*model.Destination, error) {
+	if len(appIDs) == 0 {
+		return nil, nil
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inCond := repo.NewInConditionForStringValues(appIDColumn, appIDs)
+	inArgs, _ := inCond.GetQueryArgs()
+	args := append([]interface{}{tenantID}, inArgs...)
+
+	columns := make([]string, 0, len(destinationColumns))
+	for _, column := range destinationColumns {
+		columns = append(columns, "d."+column)
+	}
+	listByApplicationIDsStatement := fmt.Sprintf(listByApplicationIDsQuery, strings.Join(columns, ", "), inCond.GetQueryPart())
+	listByApplicationIDsStatement = sqlx.Rebind(sqlx.DOLLAR, listByApplicationIDsStatement)
+
+	log.C(ctx).Debugf("Executing DB query: %s", listByApplicationIDsStatement)
+	var entities []applicationDestinationEntity
+	if err = persist.SelectContext(ctx, &entities, listByApplicationIDsStatement, args...); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, persistence.MapSQLError(ctx, err, resource.Destination, resource.List, "while listing destinations of applications with IDs %q", appIDs)
+	}
+
+	destinationsPerApplication := make(map[string][]*model.Destination, len(appIDs))
+	for i := range entities {
+		destinationsPerApplication[entities[i].AppID] = append(destinationsPerApplication[entities[i].AppID], r.conv.FromEntity(&entities[i].Entity))
+	}
+
+	return destinationsPerApplication, nil
+}
+
+func (r *repository) multipleFromEntities(destCollection EntityCollection) []*model.Destination {
+	items := make([]*model.Destination, 0, destCollection.Len())
+	for i := range destCollection {
+		items = append(items, r.conv.FromEntity(&destCollection[i]))
+	}
+
+	return items
+}
+
+func filterConditions(filter *model.DestinationFilter) repo.Conditions {
+	conditions := repo.Conditions{}
+	if filter == nil {
+		return conditions
+	}
+
+	if filter.Name != nil {
+		conditions = append(conditions, repo.NewLikeCondition(destinationNameColumn, "%"+likePatternEscaper.Replace(*filter.Name)+"%"))
+	}
+	if filter.Type != nil {
+		conditions = append(conditions, repo.NewEqualCondition(destinationTypeColumn, *filter.Type))
+	}
+	if filter.AuthenticationType != nil {
+		conditions = append(conditions, repo.NewEqualCondition(authenticationColumn, *filter.AuthenticationType))
+	}
+
+	return conditions
+}
@@ -9,10 +9,13 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/destination"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/destination/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,8 +27,8 @@ func TestRepository_Upsert(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`INSERT INTO public.destinations ( id, name, type, url, authentication, tenant_id, bundle_id, revision, instance_id, formation_assignment_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( name, instance_id, tenant_id ) DO UPDATE SET name=EXCLUDED.name, type=EXCLUDED.type, url=EXCLUDED.url, authentication=EXCLUDED.authentication, revision=EXCLUDED.revision`)
-		dbMock.ExpectExec(escapedQuery).WithArgs(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, destinationBundleID, destinationLatestRevision, repo.NewValidNullableString(""), repo.NewValidNullableString("")).WillReturnResult(sqlmock.NewResult(1, 1))
+		escapedQuery := regexp.QuoteMeta(`INSERT INTO public.destinations ( id, name, type, url, authentication, tenant_id, bundle_id, revision, instance_id, formation_assignment_id, last_sync_timestamp ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( name, instance_id, tenant_id ) DO UPDATE SET name=EXCLUDED.name, type=EXCLUDED.type, url=EXCLUDED.url, authentication=EXCLUDED.authentication, revision=EXCLUDED.revision, last_sync_timestamp=EXCLUDED.last_sync_timestamp`)
+		dbMock.ExpectExec(escapedQuery).WithArgs(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, destinationBundleID, destinationLatestRevision, repo.NewValidNullableString(""), repo.NewValidNullableString(""), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		ctx := context.TODO()
 		ctx = persistence.SaveToContext(ctx, db)
@@ -48,8 +51,8 @@ func TestRepository_UpsertWithEmbeddedTenant(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		escapedQuery := regexp.QuoteMeta(`INSERT INTO public.destinations ( id, name, type, url, authentication, tenant_id, bundle_id, revision, instance_id, formation_assignment_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( name, instance_id, tenant_id ) DO UPDATE SET name=EXCLUDED.name, type=EXCLUDED.type, url=EXCLUDED.url, authentication=EXCLUDED.authentication, revision=EXCLUDED.revision WHERE  public.destinations.tenant_id = ?`)
-		dbMock.ExpectExec(escapedQuery).WithArgs(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, repo.NewValidNullableString(""), repo.NewValidNullableString(""), destinationInstanceID, destinationFormationAssignmentID, internalDestinationSubaccountID).WillReturnResult(sqlmock.NewResult(1, 1))
+		escapedQuery := regexp.QuoteMeta(`INSERT INTO public.destinations ( id, name, type, url, authentication, tenant_id, bundle_id, revision, instance_id, formation_assignment_id, last_sync_timestamp ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( name, instance_id, tenant_id ) DO UPDATE SET name=EXCLUDED.name, type=EXCLUDED.type, url=EXCLUDED.url, authentication=EXCLUDED.authentication, revision=EXCLUDED.revision, last_sync_timestamp=EXCLUDED.last_sync_timestamp WHERE  public.destinations.tenant_id = ?`)
+		dbMock.ExpectExec(escapedQuery).WithArgs(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, repo.NewValidNullableString(""), repo.NewValidNullableString(""), destinationInstanceID, destinationFormationAssignmentID, nil, internalDestinationSubaccountID).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		ctx := context.TODO()
 		ctx = persistence.SaveToContext(ctx, db)
@@ -104,11 +107,11 @@ func TestRepository_GetDestinationByNameAndTenant(t *testing.T) {
 		MethodName: "GetDestinationByNameAndTenant",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, name, type, url, authentication, tenant_id, bundle_id, revision, instance_id, formation_assignment_id FROM public.destinations WHERE tenant_id = $1 AND name = $2`),
+				Query:    regexp.QuoteMeta(`SELECT id, name, type, url, authentication, tenant_id, bundle_id, revision, instance_id, formation_assignment_id, last_sync_timestamp FROM public.destinations WHERE tenant_id = $1 AND name = $2`),
 				Args:     []driver.Value{internalDestinationSubaccountID, destinationName},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
-					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, repo.NewValidNullableString(""), repo.NewValidNullableString(""), destinationInstanceID, destinationFormationAssignmentID)}
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, repo.NewValidNullableString(""), repo.NewValidNullableString(""), destinationInstanceID, destinationFormationAssignmentID, nil)}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
@@ -134,11 +137,11 @@ func TestRepository_ListByAssignmentID(t *testing.T) {
 		MethodName: "ListByAssignmentID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, name, type, url, authentication, tenant_id, bundle_id, revision, instance_id, formation_assignment_id FROM public.destinations WHERE formation_assignment_id = $1`),
+				Query:    regexp.QuoteMeta(`SELECT id, name, type, url, authentication, tenant_id, bundle_id, revision, instance_id, formation_assignment_id, last_sync_timestamp FROM public.destinations WHERE formation_assignment_id = $1`),
 				Args:     []driver.Value{destinationFormationAssignmentID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
-					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, repo.NewValidNullableString(""), repo.NewValidNullableString(""), destinationInstanceID, destinationFormationAssignmentID)}
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, repo.NewValidNullableString(""), repo.NewValidNullableString(""), destinationInstanceID, destinationFormationAssignmentID, nil)}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
@@ -181,3 +184,159 @@ func TestRepository_DeleteByDestinationNameAndAssignmentID(t *testing.T) {
 
 	suite.Run(t)
 }
+
+func TestRepository_List(t *testing.T) {
+	filter := &model.DestinationFilter{
+		Name:               str.Ptr("dest_1%"),
+		Type:               str.Ptr(string(destinationType)),
+		AuthenticationType: str.Ptr(string(destinationNoAuthn)),
+	}
+
+	suite := testdb.RepoListPageableTestSuite{
+		Name:       "List Destinations",
+		MethodName: "List",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, name, type, url, authentication, tenant_id, bundle_id, revision, instance_id, formation_assignment_id, last_sync_timestamp FROM public.destinations WHERE (tenant_id = $1 AND name ILIKE $2 AND type = $3 AND authentication = $4) ORDER BY name, id LIMIT 4 OFFSET 0`),
+				Args:     []driver.Value{internalDestinationSubaccountID, `%dest\_1\%%`, destinationType, destinationNoAuthn},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, repo.NewValidNullableString(""), repo.NewValidNullableString(""), destinationInstanceID, destinationFormationAssignmentID, nil)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT COUNT(*) FROM public.destinations WHERE (tenant_id = $1 AND name ILIKE $2 AND type = $3 AND authentication = $4)`),
+				Args:     []driver.Value{internalDestinationSubaccountID, `%dest\_1\%%`, destinationType, destinationNoAuthn},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"count"}).AddRow(1)}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: destination.NewRepository,
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: []interface{}{destinationModel},
+				ExpectedDBEntities:    []interface{}{destinationEntity},
+				ExpectedPage: &model.DestinationPage{
+					Data: []*model.Destination{destinationModel},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 1,
+				},
+			},
+		},
+		MethodArgs:                []interface{}{internalDestinationSubaccountID, filter, 4, ""},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_ListByBundleIDs(t *testing.T) {
+	secondBundleID := "second-bundle-id"
+	suite := testdb.RepoListTestSuite{
+		Name:       "List Destinations by Bundle IDs",
+		MethodName: "ListByBundleIDs",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, name, type, url, authentication, tenant_id, bundle_id, revision, instance_id, formation_assignment_id, last_sync_timestamp FROM public.destinations WHERE tenant_id = $1 AND bundle_id IN ($2, $3)`),
+				Args:     []driver.Value{internalDestinationSubaccountID, destinationBundleID, secondBundleID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, repo.NewValidNullableString(""), repo.NewValidNullableString(""), destinationInstanceID, destinationFormationAssignmentID, nil)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       destination.NewRepository,
+		ExpectedModelEntities:     []interface{}{destinationModel},
+		ExpectedDBEntities:        []interface{}{destinationEntity},
+		MethodArgs:                []interface{}{internalDestinationSubaccountID, []string{destinationBundleID, secondBundleID}},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_ListByApplicationIDs(t *testing.T) {
+	secondApplicationID := "second-application-id"
+	appIDs := []string{destinationApplicationID, secondApplicationID}
+	dbQuery := regexp.QuoteMeta(`SELECT d.id, d.name, d.type, d.url, d.authentication, d.tenant_id, d.bundle_id, d.revision, d.instance_id, d.formation_assignment_id, d.last_sync_timestamp, b.app_id FROM public.destinations d JOIN public.bundles b ON b.id = d.bundle_id WHERE d.tenant_id = $1 AND b.app_id IN ($2, $3)`)
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(append(fixColumns(), "app_id")).AddRow(destinationID, destinationName, destinationType, destinationURL, destinationNoAuthn, internalDestinationSubaccountID, repo.NewValidNullableString(""), repo.NewValidNullableString(""), destinationInstanceID, destinationFormationAssignmentID, nil, destinationApplicationID)
+		dbMock.ExpectQuery(dbQuery).WithArgs(internalDestinationSubaccountID, destinationApplicationID, secondApplicationID).WillReturnRows(rows)
+
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("FromEntity", destinationEntity).Return(destinationModel).Once()
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := destination.NewRepository(conv)
+
+		// WHEN
+		result, err := repository.ListByApplicationIDs(ctx, internalDestinationSubaccountID, appIDs)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, map[string][]*model.Destination{destinationApplicationID: {destinationModel}}, result)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(dbQuery).WithArgs(internalDestinationSubaccountID, destinationApplicationID, secondApplicationID).WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := destination.NewRepository(nil)
+
+		// WHEN
+		result, err := repository.ListByApplicationIDs(ctx, internalDestinationSubaccountID, appIDs)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+	})
+
+	t.Run("Error when there is no persistence in the context", func(t *testing.T) {
+		repository := destination.NewRepository(nil)
+
+		// WHEN
+		result, err := repository.ListByApplicationIDs(context.TODO(), internalDestinationSubaccountID, appIDs)
+
+		// THEN
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Returns nothing when there are no application IDs", func(t *testing.T) {
+		repository := destination.NewRepository(nil)
+
+		// WHEN
+		result, err := repository.ListByApplicationIDs(context.TODO(), internalDestinationSubaccountID, nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.Nil(t, result)
+	})
+}
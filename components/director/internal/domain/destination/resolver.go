@@ -0,0 +1,163 @@
+package destination
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// DestinationService is responsible for the service-layer destination read operations
+//
+//go:generate mockery --name=DestinationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type DestinationService interface {
+	List(ctx context.Context, filter *model.DestinationFilter, pageSize int, cursor string) (*model.DestinationPage, error)
+	ListByBundleIDs(ctx context.Context, bundleIDs []string) ([][]*model.Destination, error)
+	ListByApplicationIDs(ctx context.Context, appIDs []string) ([][]*model.Destination, error)
+}
+
+// GraphQLConverter converts destinations to their GraphQL representation
+//
+//go:generate mockery --name=GraphQLConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLConverter interface {
+	MultipleToGraphQL(in []*model.Destination) []*graphql.Destination
+}
+
+// Resolver is the destination resolver
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      DestinationService
+	conv     GraphQLConverter
+}
+
+// NewResolver creates a new destination resolver
+func NewResolver(transact persistence.Transactioner, svc DestinationService, conv GraphQLConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// Destinations returns a page of the destinations of the tenant matching the filter
+func (r *Resolver) Destinations(ctx context.Context, filter *graphql.DestinationFilter, first *int, after *graphql.PageCursor) (*graphql.DestinationPage, error) {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	page, err := r.svc.List(ctx, filterFromGraphQL(filter), *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &graphql.DestinationPage{
+		Data:       r.conv.MultipleToGraphQL(page.Data),
+		TotalCount: page.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(page.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(page.PageInfo.EndCursor),
+			HasNextPage: page.PageInfo.HasNextPage,
+		},
+	}, nil
+}
+
+// BundleDestinations returns the destinations of the tenant linked to the bundle
+func (r *Resolver) BundleDestinations(ctx context.Context, obj *graphql.Bundle) ([]*graphql.Destination, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Bundle cannot be empty")
+	}
+
+	params := dataloader.ParamDestination{ID: obj.ID, Ctx: ctx}
+	return dataloader.BundleDestinationFor(ctx).DestinationByParentID.Load(params)
+}
+
+// BundleDestinationsDataLoader retrieves the destinations for each bundle ID in the keys
+func (r *Resolver) BundleDestinationsDataLoader(keys []dataloader.ParamDestination) ([][]*graphql.Destination, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Bundles found")}
+	}
+
+	return r.listForKeys(keys, r.svc.ListByBundleIDs)
+}
+
+// ApplicationDestinations returns the destinations of the tenant linked to any of the bundles of the application
+func (r *Resolver) ApplicationDestinations(ctx context.Context, obj *graphql.Application) ([]*graphql.Destination, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	params := dataloader.ParamDestination{ID: obj.ID, Ctx: ctx}
+	return dataloader.ApplicationDestinationFor(ctx).DestinationByParentID.Load(params)
+}
+
+// ApplicationDestinationsDataLoader retrieves the destinations for each application ID in the keys
+func (r *Resolver) ApplicationDestinationsDataLoader(keys []dataloader.ParamDestination) ([][]*graphql.Destination, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	return r.listForKeys(keys, r.svc.ListByApplicationIDs)
+}
+
+func (r *Resolver) listForKeys(keys []dataloader.ParamDestination, listFn func(ctx context.Context, ids []string) ([][]*model.Destination, error)) ([][]*graphql.Destination, []error) {
+	ctx := keys[0].Ctx
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	destinationsPerID, err := listFn(ctx, ids)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	gqlDestinations := make([][]*graphql.Destination, 0, len(destinationsPerID))
+	for _, destinations := range destinationsPerID {
+		gqlDestinations = append(gqlDestinations, r.conv.MultipleToGraphQL(destinations))
+	}
+
+	return gqlDestinations, nil
+}
+
+func filterFromGraphQL(in *graphql.DestinationFilter) *model.DestinationFilter {
+	if in == nil {
+		return nil
+	}
+
+	return &model.DestinationFilter{
+		Name:               in.Name,
+		Type:               in.Type,
+		AuthenticationType: in.AuthenticationType,
+	}
+}
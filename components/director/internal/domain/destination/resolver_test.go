@@ -0,0 +1,333 @@
+package destination_test
+
+import (
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/destination"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/destination/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Destinations(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	after := graphql.PageCursor("cursor")
+	gqlFilter := &graphql.DestinationFilter{Name: str.Ptr(destinationName), AuthenticationType: str.Ptr(string(destinationNoAuthn))}
+	filter := &model.DestinationFilter{Name: str.Ptr(destinationName), AuthenticationType: str.Ptr(string(destinationNoAuthn))}
+
+	destinations := []*model.Destination{destinationModel}
+	gqlDestinations := []*graphql.Destination{{ID: destinationID, Name: destinationName}}
+	page := &model.DestinationPage{
+		Data:       destinations,
+		PageInfo:   &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: true},
+		TotalCount: 3,
+	}
+	gqlPage := &graphql.DestinationPage{
+		Data:       gqlDestinations,
+		PageInfo:   &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: true},
+		TotalCount: 3,
+	}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.DestinationService
+		ConverterFn    func() *automock.GraphQLConverter
+		First          *int
+		ExpectedOutput *graphql.DestinationPage
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), filter, first, string(after)).Return(page, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("MultipleToGraphQL", destinations).Return(gqlDestinations).Once()
+				return conv
+			},
+			First:          &first,
+			ExpectedOutput: gqlPage,
+		},
+		{
+			Name: "Error when listing destinations fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), filter, first, string(after)).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when the transaction fails to commit",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), filter, first, string(after)).Return(page, nil).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when the transaction fails to begin",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ServiceFn:     func() *automock.DestinationService { return &automock.DestinationService{} },
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when first is missing",
+			TxFn:          txGen.ThatDoesntStartTransaction,
+			ServiceFn:     func() *automock.DestinationService { return &automock.DestinationService{} },
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			ExpectedError: "missing required parameter 'first'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+			resolver := destination.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.Destinations(ctx, gqlFilter, testCase.First, &after)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_BundleDestinations(t *testing.T) {
+	t.Run("Error when the bundle is nil", func(t *testing.T) {
+		resolver := destination.NewResolver(nil, nil, nil)
+
+		// WHEN
+		result, err := resolver.BundleDestinations(ctx, nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Bundle cannot be empty")
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_BundleDestinationsDataLoader(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	secondBundleID := "second-bundle-id"
+	destinations := []*model.Destination{destinationModel}
+	gqlDestinations := []*graphql.Destination{{ID: destinationID, Name: destinationName}}
+	keys := []dataloader.ParamDestination{{ID: destinationBundleID, Ctx: ctx}, {ID: secondBundleID, Ctx: ctx}}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.DestinationService
+		ConverterFn    func() *automock.GraphQLConverter
+		Keys           []dataloader.ParamDestination
+		ExpectedOutput [][]*graphql.Destination
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("ListByBundleIDs", txtest.CtxWithDBMatcher(), []string{destinationBundleID, secondBundleID}).Return([][]*model.Destination{destinations, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("MultipleToGraphQL", destinations).Return(gqlDestinations).Once()
+				conv.On("MultipleToGraphQL", []*model.Destination(nil)).Return(nil).Once()
+				return conv
+			},
+			Keys:           keys,
+			ExpectedOutput: [][]*graphql.Destination{gqlDestinations, nil},
+		},
+		{
+			Name: "Error when listing destinations fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("ListByBundleIDs", txtest.CtxWithDBMatcher(), []string{destinationBundleID, secondBundleID}).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			Keys:          keys,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when the transaction fails to begin",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ServiceFn:     func() *automock.DestinationService { return &automock.DestinationService{} },
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			Keys:          keys,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when there are no keys",
+			TxFn:          txGen.ThatDoesntStartTransaction,
+			ServiceFn:     func() *automock.DestinationService { return &automock.DestinationService{} },
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			ExpectedError: "No Bundles found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+			resolver := destination.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, errs := resolver.BundleDestinationsDataLoader(testCase.Keys)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedError)
+			} else {
+				require.Empty(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_ApplicationDestinations(t *testing.T) {
+	t.Run("Error when the application is nil", func(t *testing.T) {
+		resolver := destination.NewResolver(nil, nil, nil)
+
+		// WHEN
+		result, err := resolver.ApplicationDestinations(ctx, nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_ApplicationDestinationsDataLoader(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	destinations := []*model.Destination{destinationModel}
+	gqlDestinations := []*graphql.Destination{{ID: destinationID, Name: destinationName}}
+	keys := []dataloader.ParamDestination{{ID: destinationApplicationID, Ctx: ctx}}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.DestinationService
+		ConverterFn    func() *automock.GraphQLConverter
+		Keys           []dataloader.ParamDestination
+		ExpectedOutput [][]*graphql.Destination
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), []string{destinationApplicationID}).Return([][]*model.Destination{destinations}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("MultipleToGraphQL", destinations).Return(gqlDestinations).Once()
+				return conv
+			},
+			Keys:           keys,
+			ExpectedOutput: [][]*graphql.Destination{gqlDestinations},
+		},
+		{
+			Name: "Error when listing destinations fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), []string{destinationApplicationID}).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			Keys:          keys,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when the transaction fails to commit",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), []string{destinationApplicationID}).Return([][]*model.Destination{destinations}, nil).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			Keys:          keys,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when there are no keys",
+			TxFn:          txGen.ThatDoesntStartTransaction,
+			ServiceFn:     func() *automock.DestinationService { return &automock.DestinationService{} },
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			ExpectedError: "No Applications found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+			resolver := destination.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, errs := resolver.ApplicationDestinationsDataLoader(testCase.Keys)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedError)
+			} else {
+				require.Empty(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
@@ -5,12 +5,12 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
-	"github.com/kyma-incubator/compass/components/director/internal/destinationcreator"
 	destinationcreatorpkg "github.com/kyma-incubator/compass/components/director/pkg/destinationcreator"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
@@ -23,6 +23,9 @@ type destinationRepository interface {
 	DeleteByDestinationNameAndAssignmentID(ctx context.Context, destinationName, formationAssignmentID, tenantID string) error
 	ListByAssignmentID(ctx context.Context, formationAssignmentID string) ([]*model.Destination, error)
 	UpsertWithEmbeddedTenant(ctx context.Context, destination *model.Destination) error
+	List(ctx context.Context, tenantID string, filter *model.DestinationFilter, pageSize int, cursor string) (*model.DestinationPage, error)
+	ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string) ([]*model.Destination, error)
+	ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) (map[string][]*model.Destination, error)
 }
 
 //go:generate mockery --exported --name=tenantRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -51,6 +54,7 @@ type destinationCreatorService interface {
 	DetermineDestinationSubaccount(ctx context.Context, externalDestSubaccountID string, formationAssignment *model.FormationAssignment, skipSubaccountValidation bool) (string, error)
 	GetConsumerTenant(ctx context.Context, formationAssignment *model.FormationAssignment) (string, error)
 	EnsureDestinationSubaccountIDsCorrectness(ctx context.Context, destinationsDetails []operators.Destination, formationAssignment *model.FormationAssignment, skipSubaccountValidation bool) error
+	GetCertificateNames(ctx context.Context, destinationAuthentication destinationcreatorpkg.AuthType, formationAssignmentID string) ([]string, error)
 }
 
 // supportedDestinationsWithCertificate is a map of all destinations that as part of their creation a certificate resource is also created
@@ -455,12 +459,14 @@ func (s *Service) DeleteDestinations(ctx context.Context, formationAssignment *m
 		externalDestSubaccountID := tnt.ExternalTenant
 
 		if supportedDestinationsWithCertificate[destination.Authentication] {
-			certName, err := destinationcreator.GetDestinationCertificateName(ctx, destinationcreatorpkg.AuthType(destination.Authentication), formationAssignmentID)
+			certNames, err := s.destinationCreatorSvc.GetCertificateNames(ctx, destinationcreatorpkg.AuthType(destination.Authentication), formationAssignmentID)
 			if err != nil {
-				return errors.Wrapf(err, "while getting destination certificate name for destination auth type: %s", destination.Authentication)
+				return errors.Wrapf(err, "while getting destination certificate names for destination auth type: %s", destination.Authentication)
 			}
-			if err = s.destinationCreatorSvc.DeleteCertificate(ctx, certName, externalDestSubaccountID, str.PtrStrToStr(destination.InstanceID), formationAssignment, skipSubaccountValidation); err != nil {
-				return errors.Wrapf(err, "while deleting destination certificate with name: %q", certName)
+			for _, certName := range certNames {
+				if err = s.destinationCreatorSvc.DeleteCertificate(ctx, certName, externalDestSubaccountID, str.PtrStrToStr(destination.InstanceID), formationAssignment, skipSubaccountValidation); err != nil {
+					return errors.Wrapf(err, "while deleting destination certificate with name: %q", certName)
+				}
 			}
 		}
 
@@ -475,3 +481,62 @@ func (s *Service) DeleteDestinations(ctx context.Context, formationAssignment *m
 
 	return nil
 }
+
+// List returns a page of the destinations of the tenant from the context matching the filter
+func (s *Service) List(ctx context.Context, filter *model.DestinationFilter, pageSize int, cursor string) (*model.DestinationPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.destinationRepo.List(ctx, tnt, filter, pageSize, cursor)
+}
+
+// ListByBundleIDs returns the destinations of the tenant from the context linked to each of the bundles with the given IDs, in the order of the IDs
+func (s *Service) ListByBundleIDs(ctx context.Context, bundleIDs []string) ([][]*model.Destination, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	destinations, err := s.destinationRepo.ListByBundleIDs(ctx, tnt, bundleIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing destinations of bundles with IDs %q", bundleIDs)
+	}
+
+	destinationsPerBundle := make(map[string][]*model.Destination, len(bundleIDs))
+	for _, destination := range destinations {
+		bundleID := str.PtrStrToStr(destination.BundleID)
+		destinationsPerBundle[bundleID] = append(destinationsPerBundle[bundleID], destination)
+	}
+
+	return orderByIDs(bundleIDs, destinationsPerBundle), nil
+}
+
+// ListByApplicationIDs returns the destinations of the tenant from the context linked to any of the bundles of each of the applications with the given IDs, in the order of the IDs
+func (s *Service) ListByApplicationIDs(ctx context.Context, appIDs []string) ([][]*model.Destination, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	destinationsPerApplication, err := s.destinationRepo.ListByApplicationIDs(ctx, tnt, appIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing destinations of applications with IDs %q", appIDs)
+	}
+
+	return orderByIDs(appIDs, destinationsPerApplication), nil
+}
+
+func orderByIDs(ids []string, destinationsPerID map[string][]*model.Destination) [][]*model.Destination {
+	result := make([][]*model.Destination, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, destinationsPerID[id])
+	}
+
+	return result
+}
@@ -9,9 +9,11 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/destination"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/destination/automock"
+	tenantpkg "github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -1392,6 +1394,7 @@ func TestService_DeleteDestinations(t *testing.T) {
 			Name: "Success",
 			DestinationCreatorServiceFn: func() *automock.DestinationCreatorService {
 				destCreatorSvc := &automock.DestinationCreatorService{}
+				destCreatorSvc.On("GetCertificateNames", ctx, destinationcreatorpkg.AuthTypeSAMLAssertion, fa.ID).Return([]string{samlDestCertName}, nil).Once()
 				destCreatorSvc.On("DeleteCertificate", ctx, samlDestCertName, externalDestinationSubaccountID, destinationInstanceID, &fa, false).Return(nil).Once()
 				destCreatorSvc.On("DeleteDestination", ctx, samlAssertionDestName, externalDestinationSubaccountID, destinationInstanceID, &fa, false).Return(nil).Once()
 				destCreatorSvc.On("DeleteDestination", ctx, basicDestName, externalDestinationSubaccountID, destinationInstanceID, &fa, false).Return(nil).Once()
@@ -1441,10 +1444,30 @@ func TestService_DeleteDestinations(t *testing.T) {
 			},
 			ExpectedErrMessage: fmt.Sprintf("while getting tenant for destination subaccount ID: %q: %s", internalDestinationSubaccountID, testErr.Error()),
 		},
+		{
+			Name: "Error when getting certificate name",
+			DestinationCreatorServiceFn: func() *automock.DestinationCreatorService {
+				destCreatorSvc := &automock.DestinationCreatorService{}
+				destCreatorSvc.On("GetCertificateNames", ctx, destinationcreatorpkg.AuthTypeSAMLAssertion, fa.ID).Return(nil, testErr).Once()
+				return destCreatorSvc
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				tenantRepo := &automock.TenantRepository{}
+				tenantRepo.On("Get", ctx, internalDestinationSubaccountID).Return(tenant, nil).Once()
+				return tenantRepo
+			},
+			DestinationRepoFn: func() *automock.DestinationRepository {
+				destinationRepo := &automock.DestinationRepository{}
+				destinationRepo.On("ListByAssignmentID", ctx, fa.ID).Return([]*model.Destination{samlDestModel}, nil)
+				return destinationRepo
+			},
+			ExpectedErrMessage: "while getting destination certificate names for destination auth type:",
+		},
 		{
 			Name: "Error when deleting certificate",
 			DestinationCreatorServiceFn: func() *automock.DestinationCreatorService {
 				destCreatorSvc := &automock.DestinationCreatorService{}
+				destCreatorSvc.On("GetCertificateNames", ctx, destinationcreatorpkg.AuthTypeSAMLAssertion, fa.ID).Return([]string{samlDestCertName}, nil).Once()
 				destCreatorSvc.On("DeleteCertificate", ctx, samlDestCertName, externalDestinationSubaccountID, destinationInstanceID, &fa, false).Return(testErr).Once()
 				return destCreatorSvc
 			},
@@ -1535,3 +1558,217 @@ func TestService_DeleteDestinations(t *testing.T) {
 		})
 	}
 }
+
+func TestService_List(t *testing.T) {
+	ctxWithTenant := tenantpkg.SaveToContext(ctx, internalDestinationSubaccountID, externalDestinationSubaccountID)
+	filter := &model.DestinationFilter{Name: str.Ptr(destinationName)}
+	page := &model.DestinationPage{Data: []*model.Destination{destinationModel}, TotalCount: 1}
+
+	testCases := []struct {
+		Name               string
+		Context            context.Context
+		PageSize           int
+		DestinationRepoFn  func() *automock.DestinationRepository
+		ExpectedPage       *model.DestinationPage
+		ExpectedErrMessage string
+	}{
+		{
+			Name:     "Success",
+			Context:  ctxWithTenant,
+			PageSize: 100,
+			DestinationRepoFn: func() *automock.DestinationRepository {
+				destinationRepo := &automock.DestinationRepository{}
+				destinationRepo.On("List", ctxWithTenant, internalDestinationSubaccountID, filter, 100, "").Return(page, nil).Once()
+				return destinationRepo
+			},
+			ExpectedPage: page,
+		},
+		{
+			Name:     "Error when listing destinations",
+			Context:  ctxWithTenant,
+			PageSize: 100,
+			DestinationRepoFn: func() *automock.DestinationRepository {
+				destinationRepo := &automock.DestinationRepository{}
+				destinationRepo.On("List", ctxWithTenant, internalDestinationSubaccountID, filter, 100, "").Return(nil, testErr).Once()
+				return destinationRepo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:               "Error when page size is out of range",
+			Context:            ctxWithTenant,
+			PageSize:           201,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name:               "Error when tenant is missing in the context",
+			Context:            ctx,
+			PageSize:           100,
+			ExpectedErrMessage: "while loading tenant from context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			destRepo := unusedDestinationRepository()
+			if testCase.DestinationRepoFn != nil {
+				destRepo = testCase.DestinationRepoFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, destRepo)
+
+			svc := destination.NewService(nil, destRepo, nil, nil, nil)
+
+			// WHEN
+			result, err := svc.List(testCase.Context, filter, testCase.PageSize, "")
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedPage, result)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Nil(t, result)
+			}
+		})
+	}
+}
+
+func TestService_ListByBundleIDs(t *testing.T) {
+	ctxWithTenant := tenantpkg.SaveToContext(ctx, internalDestinationSubaccountID, externalDestinationSubaccountID)
+	secondBundleID := "second-bundle-id"
+	bundleIDs := []string{destinationBundleID, secondBundleID}
+
+	firstDestination := fixDestinationModel(destinationName)
+	firstDestination.BundleID = str.Ptr(destinationBundleID)
+	secondDestination := fixDestinationModel("second-destination")
+	secondDestination.BundleID = str.Ptr(destinationBundleID)
+
+	testCases := []struct {
+		Name                 string
+		Context              context.Context
+		DestinationRepoFn    func() *automock.DestinationRepository
+		ExpectedDestinations [][]*model.Destination
+		ExpectedErrMessage   string
+	}{
+		{
+			Name:    "Success",
+			Context: ctxWithTenant,
+			DestinationRepoFn: func() *automock.DestinationRepository {
+				destinationRepo := &automock.DestinationRepository{}
+				destinationRepo.On("ListByBundleIDs", ctxWithTenant, internalDestinationSubaccountID, bundleIDs).Return([]*model.Destination{firstDestination, secondDestination}, nil).Once()
+				return destinationRepo
+			},
+			ExpectedDestinations: [][]*model.Destination{{firstDestination, secondDestination}, nil},
+		},
+		{
+			Name:    "Error when listing destinations",
+			Context: ctxWithTenant,
+			DestinationRepoFn: func() *automock.DestinationRepository {
+				destinationRepo := &automock.DestinationRepository{}
+				destinationRepo.On("ListByBundleIDs", ctxWithTenant, internalDestinationSubaccountID, bundleIDs).Return(nil, testErr).Once()
+				return destinationRepo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:               "Error when tenant is missing in the context",
+			Context:            ctx,
+			ExpectedErrMessage: "while loading tenant from context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			destRepo := unusedDestinationRepository()
+			if testCase.DestinationRepoFn != nil {
+				destRepo = testCase.DestinationRepoFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, destRepo)
+
+			svc := destination.NewService(nil, destRepo, nil, nil, nil)
+
+			// WHEN
+			result, err := svc.ListByBundleIDs(testCase.Context, bundleIDs)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedDestinations, result)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Nil(t, result)
+			}
+		})
+	}
+}
+
+func TestService_ListByApplicationIDs(t *testing.T) {
+	ctxWithTenant := tenantpkg.SaveToContext(ctx, internalDestinationSubaccountID, externalDestinationSubaccountID)
+	secondApplicationID := "second-application-id"
+	appIDs := []string{secondApplicationID, destinationApplicationID}
+	destinations := []*model.Destination{destinationModel}
+
+	testCases := []struct {
+		Name                 string
+		Context              context.Context
+		DestinationRepoFn    func() *automock.DestinationRepository
+		ExpectedDestinations [][]*model.Destination
+		ExpectedErrMessage   string
+	}{
+		{
+			Name:    "Success",
+			Context: ctxWithTenant,
+			DestinationRepoFn: func() *automock.DestinationRepository {
+				destinationRepo := &automock.DestinationRepository{}
+				destinationRepo.On("ListByApplicationIDs", ctxWithTenant, internalDestinationSubaccountID, appIDs).Return(map[string][]*model.Destination{destinationApplicationID: destinations}, nil).Once()
+				return destinationRepo
+			},
+			ExpectedDestinations: [][]*model.Destination{nil, destinations},
+		},
+		{
+			Name:    "Error when listing destinations",
+			Context: ctxWithTenant,
+			DestinationRepoFn: func() *automock.DestinationRepository {
+				destinationRepo := &automock.DestinationRepository{}
+				destinationRepo.On("ListByApplicationIDs", ctxWithTenant, internalDestinationSubaccountID, appIDs).Return(nil, testErr).Once()
+				return destinationRepo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:               "Error when tenant is missing in the context",
+			Context:            ctx,
+			ExpectedErrMessage: "while loading tenant from context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			destRepo := unusedDestinationRepository()
+			if testCase.DestinationRepoFn != nil {
+				destRepo = testCase.DestinationRepoFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, destRepo)
+
+			svc := destination.NewService(nil, destRepo, nil, nil, nil)
+
+			// WHEN
+			result, err := svc.ListByApplicationIDs(testCase.Context, appIDs)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedDestinations, result)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Nil(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package destinationcertificate
+
+import "time"
+
+// Config configures the rotation of the destination certificates created for formation assignments
+type Config struct {
+	// Enabled switches the certificate rotation job on
+	Enabled bool `envconfig:"default=false,APP_DESTINATION_CERTIFICATE_ROTATION_ENABLED"`
+	// JobInterval is how often the certificates are checked for expiry and the pending rotations are completed
+	JobInterval time.Duration `envconfig:"default=1h,APP_DESTINATION_CERTIFICATE_ROTATION_JOB_INTERVAL"`
+	// RenewBeforeExpiry is how long before its expiry a certificate is renewed
+	RenewBeforeExpiry time.Duration `envconfig:"default=720h,APP_DESTINATION_CERTIFICATE_ROTATION_RENEW_BEFORE_EXPIRY"`
+	// RotationTimeout is how long the participant has to confirm a renewed certificate before the rotation is aborted
+	RotationTimeout time.Duration `envconfig:"default=72h,APP_DESTINATION_CERTIFICATE_ROTATION_TIMEOUT"`
+}
@@ -0,0 +1,55 @@
+package destinationcertificate
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+)
+
+type converter struct{}
+
+// NewConverter returns a new destination certificate converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the destination certificate model to an entity
+func (c *converter) ToEntity(in *model.DestinationCertificate) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:                    in.ID,
+		FormationAssignmentID: in.FormationAssignmentID,
+		AuthenticationType:    in.AuthenticationType,
+		Name:                  in.Name,
+		SubaccountID:          in.SubaccountID,
+		InstanceID:            repo.NewValidNullableString(in.InstanceID),
+		SelfSigned:            in.SelfSigned,
+		Generation:            in.Generation,
+		State:                 string(in.State),
+		ExpiresAt:             in.ExpiresAt,
+		CreatedAt:             in.CreatedAt,
+	}
+}
+
+// FromEntity converts the destination certificate entity to a model
+func (c *converter) FromEntity(in *Entity) *model.DestinationCertificate {
+	if in == nil {
+		return nil
+	}
+
+	return &model.DestinationCertificate{
+		ID:                    in.ID,
+		FormationAssignmentID: in.FormationAssignmentID,
+		AuthenticationType:    in.AuthenticationType,
+		Name:                  in.Name,
+		SubaccountID:          in.SubaccountID,
+		InstanceID:            in.InstanceID.String,
+		SelfSigned:            in.SelfSigned,
+		Generation:            in.Generation,
+		State:                 model.DestinationCertificateState(in.State),
+		ExpiresAt:             in.ExpiresAt,
+		CreatedAt:             in.CreatedAt,
+	}
+}
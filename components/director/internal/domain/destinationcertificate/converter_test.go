@@ -0,0 +1,22 @@
+package destinationcertificate_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/destinationcertificate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	conv := destinationcertificate.NewConverter()
+
+	assert.Equal(t, fixCertificateEntity(), conv.ToEntity(fixCertificateModel()))
+	assert.Nil(t, conv.ToEntity(nil))
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	conv := destinationcertificate.NewConverter()
+
+	assert.Equal(t, fixCertificateModel(), conv.FromEntity(fixCertificateEntity()))
+	assert.Nil(t, conv.FromEntity(nil))
+}
@@ -0,0 +1,29 @@
+package destinationcertificate
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Entity represents a destination certificate in the database
+type Entity struct {
+	ID                    string         `db:"id"`
+	FormationAssignmentID string         `db:"formation_assignment_id"`
+	AuthenticationType    string         `db:"authentication_type"`
+	Name                  string         `db:"name"`
+	SubaccountID          string         `db:"subaccount_id"`
+	InstanceID            sql.NullString `db:"instance_id"`
+	SelfSigned            bool           `db:"self_signed"`
+	Generation            int            `db:"generation"`
+	State                 string         `db:"state"`
+	ExpiresAt             *time.Time     `db:"expires_at"`
+	CreatedAt             time.Time      `db:"created_at"`
+}
+
+// EntityCollection is a collection of destination certificate entities
+type EntityCollection []Entity
+
+// Len returns the number of entities in the collection
+func (c EntityCollection) Len() int {
+	return len(c)
+}
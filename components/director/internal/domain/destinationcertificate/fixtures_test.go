@@ -0,0 +1,132 @@
+package destinationcertificate_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/destinationcertificate"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	certificateID        = "9c5b2d1e-7f3a-4e6b-8d2c-1a0b9c8d7e6f"
+	renewedCertificateID = "3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7"
+	assignmentID         = "0f9e8d7c-6b5a-4c3d-8e1f-a2b3c4d5e6f7"
+	reverseAssignmentID  = "7a6b5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d"
+	formationID          = "f1e2d3c4-b5a6-4978-8695-a4b3c2d1e0f9"
+	tenantID             = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	sourceID             = "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a"
+	targetID             = "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	subaccountID         = "d7c6b5a4-3f2e-4d1c-9b8a-7f6e5d4c3b2a"
+	instanceID           = "instance-id"
+	certificateName      = "SAMLAssertion-0f9e8d7c-6b5a-4c3d-8e1f-a2b3c4d5e6f7"
+	certificateChain     = "-----BEGIN CERTIFICATE-----renewed-----END CERTIFICATE-----"
+	authType             = "SAMLAssertion"
+)
+
+var (
+	testErr      = errors.New("test error")
+	notFoundErr  = apperrors.NewNotFoundErrorWithType(resource.DestinationCertificate)
+	createdAt    = time.Date(2024, 6, 10, 10, 0, 0, 0, time.UTC)
+	expiresAt    = time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+	tableColumns = []string{"id", "formation_assignment_id", "authentication_type", "name", "subaccount_id", "instance_id", "self_signed", "generation", "state", "expires_at", "created_at"}
+
+	assignmentConfig = json.RawMessage(`{"credentials":{"inboundCommunication":{"samlAssertion":{"correlationIds":["corr-id"],"destinations":[{"name":"saml-destination"}]}}}}`)
+	enrichedConfig   = json.RawMessage(`{"credentials":{"inboundCommunication":{"samlAssertion":{"correlationIds":["corr-id"],"destinations":[{"name":"saml-destination"}],"certificate":"renewed"}}}}`)
+	reverseConfig    = json.RawMessage(`{"credentials":{"outboundCommunication":{"samlAssertion":{"url":"https://saml.example.com"}}}}`)
+)
+
+func fixCertificateModel() *model.DestinationCertificate {
+	expiry := expiresAt
+	return &model.DestinationCertificate{
+		ID:                    certificateID,
+		FormationAssignmentID: assignmentID,
+		AuthenticationType:    authType,
+		Name:                  certificateName,
+		SubaccountID:          subaccountID,
+		InstanceID:            instanceID,
+		SelfSigned:            true,
+		Generation:            0,
+		State:                 model.DestinationCertificateStateActive,
+		ExpiresAt:             &expiry,
+		CreatedAt:             createdAt,
+	}
+}
+
+func fixCertificateEntity() *destinationcertificate.Entity {
+	expiry := expiresAt
+	return &destinationcertificate.Entity{
+		ID:                    certificateID,
+		FormationAssignmentID: assignmentID,
+		AuthenticationType:    authType,
+		Name:                  certificateName,
+		SubaccountID:          subaccountID,
+		InstanceID:            sql.NullString{String: instanceID, Valid: true},
+		SelfSigned:            true,
+		Generation:            0,
+		State:                 string(model.DestinationCertificateStateActive),
+		ExpiresAt:             &expiry,
+		CreatedAt:             createdAt,
+	}
+}
+
+func fixRenewedCertificateModel() *model.DestinationCertificate {
+	expiry := expiresAt.AddDate(1, 0, 0)
+	return &model.DestinationCertificate{
+		FormationAssignmentID: assignmentID,
+		AuthenticationType:    authType,
+		Name:                  certificateName + "-1",
+		SubaccountID:          subaccountID,
+		InstanceID:            instanceID,
+		SelfSigned:            true,
+		Generation:            1,
+		ExpiresAt:             &expiry,
+	}
+}
+
+func fixPendingCertificateModel() *model.DestinationCertificate {
+	certificate := fixRenewedCertificateModel()
+	certificate.ID = renewedCertificateID
+	certificate.State = model.DestinationCertificateStatePending
+	certificate.CreatedAt = createdAt
+	return certificate
+}
+
+func fixActivatedCertificateModel() *model.DestinationCertificate {
+	certificate := fixPendingCertificateModel()
+	certificate.State = model.DestinationCertificateStateActive
+	return certificate
+}
+
+func fixAssignment(state model.FormationAssignmentState, value json.RawMessage) *model.FormationAssignment {
+	return &model.FormationAssignment{
+		ID:          assignmentID,
+		FormationID: formationID,
+		TenantID:    tenantID,
+		Source:      sourceID,
+		SourceType:  model.FormationAssignmentTypeApplication,
+		Target:      targetID,
+		TargetType:  model.FormationAssignmentTypeApplication,
+		State:       string(state),
+		Value:       value,
+	}
+}
+
+func fixReverseAssignment(state model.FormationAssignmentState, lastNotificationSent *time.Time) *model.FormationAssignment {
+	return &model.FormationAssignment{
+		ID:                            reverseAssignmentID,
+		FormationID:                   formationID,
+		TenantID:                      tenantID,
+		Source:                        targetID,
+		SourceType:                    model.FormationAssignmentTypeApplication,
+		Target:                        sourceID,
+		TargetType:                    model.FormationAssignmentTypeApplication,
+		State:                         string(state),
+		Value:                         reverseConfig,
+		LastNotificationSentTimestamp: lastNotificationSent,
+	}
+}
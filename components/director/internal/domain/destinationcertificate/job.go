@@ -0,0 +1,41 @@
+package destinationcertificate
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/cronjob"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+// CertificateRotator renews the expiring destination certificates and completes the rotations confirmed by the participants
+type CertificateRotator interface {
+	RotateExpiringCertificates(ctx context.Context) (int, error)
+	CompleteRotations(ctx context.Context) (int, error)
+}
+
+// StartRotationJob starts the job which rotates the expiring destination certificates and blocks.
+// Only the leader instance executes the job.
+func StartRotationJob(ctx context.Context, cfg Config, electionCfg cronjob.ElectionConfig, rotator CertificateRotator) error {
+	rotationJob := cronjob.CronJob{
+		Name: "RotateDestinationCertificates",
+		Fn: func(jobCtx context.Context) {
+			rotated, err := rotator.RotateExpiringCertificates(jobCtx)
+			if err != nil {
+				log.C(jobCtx).WithError(err).Error("Failed to rotate the expiring destination certificates")
+			} else if rotated > 0 {
+				log.C(jobCtx).Infof("Started the rotation of %d destination certificates", rotated)
+			}
+
+			completed, err := rotator.CompleteRotations(jobCtx)
+			if err != nil {
+				log.C(jobCtx).WithError(err).Error("Failed to complete the pending destination certificate rotations")
+				return
+			}
+			if completed > 0 {
+				log.C(jobCtx).Infof("Completed the rotation of %d destination certificates", completed)
+			}
+		},
+		SchedulePeriod: cfg.JobInterval,
+	}
+	return cronjob.RunCronJob(ctx, electionCfg, rotationJob)
+}
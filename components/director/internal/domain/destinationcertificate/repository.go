@@ -0,0 +1,120 @@
+package destinationcertificate
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableName                   = "public.destination_certificates"
+	idColumn                    = "id"
+	formationAssignmentIDColumn = "formation_assignment_id"
+	authenticationTypeColumn    = "authentication_type"
+	stateColumn                 = "state"
+	expiresAtColumn             = "expires_at"
+)
+
+var (
+	tableColumns     = []string{idColumn, formationAssignmentIDColumn, authenticationTypeColumn, "name", "subaccount_id", "instance_id", "self_signed", "generation", stateColumn, expiresAtColumn, "created_at"}
+	conflictColumns  = []string{formationAssignmentIDColumn, authenticationTypeColumn, stateColumn}
+	updatableColumns = []string{"name", "subaccount_id", "instance_id", "self_signed", "generation", stateColumn, expiresAtColumn}
+)
+
+// EntityConverter converts between the model and the entity of a destination certificate
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.DestinationCertificate) *Entity
+	FromEntity(in *Entity) *model.DestinationCertificate
+}
+
+type repository struct {
+	upserter     repo.UpserterGlobal
+	updater      repo.UpdaterGlobal
+	singleGetter repo.SingleGetterGlobal
+	lister       repo.ListerGlobal
+	deleter      repo.DeleterGlobal
+	conv         EntityConverter
+}
+
+// NewRepository returns a new destination certificate repository
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		upserter:     repo.NewUpserterGlobal(resource.DestinationCertificate, tableName, tableColumns, conflictColumns, updatableColumns),
+		updater:      repo.NewUpdaterGlobal(resource.DestinationCertificate, tableName, updatableColumns, []string{idColumn}),
+		singleGetter: repo.NewSingleGetterGlobal(resource.DestinationCertificate, tableName, tableColumns),
+		lister:       repo.NewListerGlobal(resource.DestinationCertificate, tableName, tableColumns),
+		deleter:      repo.NewDeleterGlobal(resource.DestinationCertificate, tableName),
+		conv:         conv,
+	}
+}
+
+// Upsert stores the certificate. A certificate in the same state for the same formation assignment and authentication type is replaced.
+func (r *repository) Upsert(ctx context.Context, certificate *model.DestinationCertificate) error {
+	if certificate == nil {
+		return errors.New("destination certificate cannot be empty")
+	}
+
+	return r.upserter.UpsertGlobal(ctx, r.conv.ToEntity(certificate))
+}
+
+// Update updates the certificate with the ID of the given one
+func (r *repository) Update(ctx context.Context, certificate *model.DestinationCertificate) error {
+	if certificate == nil {
+		return errors.New("destination certificate cannot be empty")
+	}
+
+	return r.updater.UpdateSingleGlobal(ctx, r.conv.ToEntity(certificate))
+}
+
+// GetByAssignmentIDAndAuthType returns the certificate in the given state created for the formation assignment and authentication type
+func (r *repository) GetByAssignmentIDAndAuthType(ctx context.Context, formationAssignmentID, authenticationType string, state model.DestinationCertificateState) (*model.DestinationCertificate, error) {
+	var entity Entity
+	conditions := repo.Conditions{
+		repo.NewEqualCondition(formationAssignmentIDColumn, formationAssignmentID),
+		repo.NewEqualCondition(authenticationTypeColumn, authenticationType),
+		repo.NewEqualCondition(stateColumn, string(state)),
+	}
+	if err := r.singleGetter.GetGlobal(ctx, conditions, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// ListActiveExpiringBefore returns the active certificates which expire before the given time
+func (r *repository) ListActiveExpiringBefore(ctx context.Context, before time.Time) ([]*model.DestinationCertificate, error) {
+	return r.list(ctx, repo.Conditions{
+		repo.NewEqualCondition(stateColumn, string(model.DestinationCertificateStateActive)),
+		repo.NewLessThanCondition(expiresAtColumn, before),
+	})
+}
+
+// ListByState returns the certificates in the given state
+func (r *repository) ListByState(ctx context.Context, state model.DestinationCertificateState) ([]*model.DestinationCertificate, error) {
+	return r.list(ctx, repo.Conditions{repo.NewEqualCondition(stateColumn, string(state))})
+}
+
+// Delete deletes the certificate with the given ID
+func (r *repository) Delete(ctx context.Context, id string) error {
+	return r.deleter.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition(idColumn, id)})
+}
+
+func (r *repository) list(ctx context.Context, conditions repo.Conditions) ([]*model.DestinationCertificate, error) {
+	var entities EntityCollection
+	if err := r.lister.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	certificates := make([]*model.DestinationCertificate, 0, len(entities))
+	for i := range entities {
+		certificates = append(certificates, r.conv.FromEntity(&entities[i]))
+	}
+
+	return certificates, nil
+}
@@ -0,0 +1,229 @@
+package destinationcertificate_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/destinationcertificate"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/destinationcertificate/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Upsert(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixCertificateEntity()
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.destination_certificates ( id, formation_assignment_id, authentication_type, name, subaccount_id, instance_id, self_signed, generation, state, expires_at, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( formation_assignment_id, authentication_type, state ) DO UPDATE SET name=EXCLUDED.name, subaccount_id=EXCLUDED.subaccount_id, instance_id=EXCLUDED.instance_id, self_signed=EXCLUDED.self_signed, generation=EXCLUDED.generation, state=EXCLUDED.state, expires_at=EXCLUDED.expires_at`)).
+			WithArgs(entity.ID, entity.FormationAssignmentID, entity.AuthenticationType, entity.Name, entity.SubaccountID, entity.InstanceID, entity.SelfSigned, entity.Generation, entity.State, entity.ExpiresAt, entity.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		conv := &automock.EntityConverter{}
+		conv.On("ToEntity", fixCertificateModel()).Return(entity).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := destinationcertificate.NewRepository(conv)
+
+		// WHEN
+		err := repo.Upsert(ctx, fixCertificateModel())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when the certificate is nil", func(t *testing.T) {
+		repo := destinationcertificate.NewRepository(nil)
+
+		err := repo.Upsert(context.TODO(), nil)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "destination certificate cannot be empty")
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixCertificateEntity()
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.destination_certificates SET name = ?, subaccount_id = ?, instance_id = ?, self_signed = ?, generation = ?, state = ?, expires_at = ? WHERE id = ?`)).
+			WithArgs(entity.Name, entity.SubaccountID, entity.InstanceID, entity.SelfSigned, entity.Generation, entity.State, entity.ExpiresAt, entity.ID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		conv := &automock.EntityConverter{}
+		conv.On("ToEntity", fixCertificateModel()).Return(entity).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := destinationcertificate.NewRepository(conv)
+
+		// WHEN
+		err := repo.Update(ctx, fixCertificateModel())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when the certificate is nil", func(t *testing.T) {
+		repo := destinationcertificate.NewRepository(nil)
+
+		err := repo.Update(context.TODO(), nil)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "destination certificate cannot be empty")
+	})
+}
+
+func TestRepository_GetByAssignmentIDAndAuthType(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixCertificateEntity()
+		rows := sqlmock.NewRows(tableColumns).
+			AddRow(entity.ID, entity.FormationAssignmentID, entity.AuthenticationType, entity.Name, entity.SubaccountID, entity.InstanceID, entity.SelfSigned, entity.Generation, entity.State, entity.ExpiresAt, entity.CreatedAt)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, formation_assignment_id, authentication_type, name, subaccount_id, instance_id, self_signed, generation, state, expires_at, created_at FROM public.destination_certificates WHERE formation_assignment_id = $1 AND authentication_type = $2 AND state = $3`)).
+			WithArgs(assignmentID, authType, string(model.DestinationCertificateStateActive)).
+			WillReturnRows(rows)
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", entity).Return(fixCertificateModel()).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := destinationcertificate.NewRepository(conv)
+
+		// WHEN
+		result, err := repo.GetByAssignmentIDAndAuthType(ctx, assignmentID, authType, model.DestinationCertificateStateActive)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, fixCertificateModel(), result)
+	})
+
+	t.Run("Error when getting fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(`SELECT .* FROM public\.destination_certificates`).
+			WithArgs(assignmentID, authType, string(model.DestinationCertificateStatePending)).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := destinationcertificate.NewRepository(nil)
+
+		// WHEN
+		result, err := repo.GetByAssignmentIDAndAuthType(ctx, assignmentID, authType, model.DestinationCertificateStatePending)
+
+		// THEN
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestRepository_ListActiveExpiringBefore(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+
+	entity := fixCertificateEntity()
+	rows := sqlmock.NewRows(tableColumns).
+		AddRow(entity.ID, entity.FormationAssignmentID, entity.AuthenticationType, entity.Name, entity.SubaccountID, entity.InstanceID, entity.SelfSigned, entity.Generation, entity.State, entity.ExpiresAt, entity.CreatedAt)
+	dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, formation_assignment_id, authentication_type, name, subaccount_id, instance_id, self_signed, generation, state, expires_at, created_at FROM public.destination_certificates WHERE state = $1 AND expires_at < $2`)).
+		WithArgs(string(model.DestinationCertificateStateActive), expiresAt).
+		WillReturnRows(rows)
+
+	conv := &automock.EntityConverter{}
+	conv.On("FromEntity", entity).Return(fixCertificateModel()).Once()
+	defer conv.AssertExpectations(t)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := destinationcertificate.NewRepository(conv)
+
+	// WHEN
+	result, err := repo.ListActiveExpiringBefore(ctx, expiresAt)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []*model.DestinationCertificate{fixCertificateModel()}, result)
+}
+
+func TestRepository_ListByState(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixCertificateEntity()
+		rows := sqlmock.NewRows(tableColumns).
+			AddRow(entity.ID, entity.FormationAssignmentID, entity.AuthenticationType, entity.Name, entity.SubaccountID, entity.InstanceID, entity.SelfSigned, entity.Generation, entity.State, entity.ExpiresAt, entity.CreatedAt)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, formation_assignment_id, authentication_type, name, subaccount_id, instance_id, self_signed, generation, state, expires_at, created_at FROM public.destination_certificates WHERE state = $1`)).
+			WithArgs(string(model.DestinationCertificateStatePending)).
+			WillReturnRows(rows)
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", entity).Return(fixCertificateModel()).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := destinationcertificate.NewRepository(conv)
+
+		// WHEN
+		result, err := repo.ListByState(ctx, model.DestinationCertificateStatePending)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.DestinationCertificate{fixCertificateModel()}, result)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(`SELECT .* FROM public\.destination_certificates`).
+			WithArgs(string(model.DestinationCertificateStatePending)).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := destinationcertificate.NewRepository(nil)
+
+		// WHEN
+		result, err := repo.ListByState(ctx, model.DestinationCertificateStatePending)
+
+		// THEN
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestRepository_Delete(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+
+	dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.destination_certificates WHERE id = $1`)).
+		WithArgs(certificateID).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := destinationcertificate.NewRepository(nil)
+
+	// WHEN
+	err := repo.Delete(ctx, certificateID)
+
+	// THEN
+	require.NoError(t, err)
+}
@@ -0,0 +1,551 @@
+package destinationcertificate
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	destinationcreatorpkg "github.com/kyma-incubator/compass/components/director/pkg/destinationcreator"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// Now is a function variable that returns the current time. It is used, so we could mock it in the tests.
+var Now = time.Now
+
+// CertificateRepository is responsible for the repo-layer destination certificate operations
+//
+//go:generate mockery --name=CertificateRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CertificateRepository interface {
+	Upsert(ctx context.Context, certificate *model.DestinationCertificate) error
+	Update(ctx context.Context, certificate *model.DestinationCertificate) error
+	GetByAssignmentIDAndAuthType(ctx context.Context, formationAssignmentID, authenticationType string, state model.DestinationCertificateState) (*model.DestinationCertificate, error)
+	ListActiveExpiringBefore(ctx context.Context, before time.Time) ([]*model.DestinationCertificate, error)
+	ListByState(ctx context.Context, state model.DestinationCertificateState) ([]*model.DestinationCertificate, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// FormationAssignmentRepository is responsible for the repo-layer formation assignment operations
+//
+//go:generate mockery --name=FormationAssignmentRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationAssignmentRepository interface {
+	GetGlobalByID(ctx context.Context, id string) (*model.FormationAssignment, error)
+	GetReverseBySourceAndTarget(ctx context.Context, tenantID, formationID, sourceID, targetID string) (*model.FormationAssignment, error)
+	Update(ctx context.Context, m *model.FormationAssignment) error
+}
+
+// DestinationCreatorService is responsible for the certificate operations in the remote destination service
+//
+//go:generate mockery --name=DestinationCreatorService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type DestinationCreatorService interface {
+	RenewCertificate(ctx context.Context, current *model.DestinationCertificate) (*model.DestinationCertificate, *operators.CertificateData, error)
+	DeleteCertificate(ctx context.Context, certificateName, externalDestSubaccountID, instanceID string, formationAssignment *model.FormationAssignment, skipSubaccountValidation bool) error
+	EnrichAssignmentConfigWithCertificateData(assignmentConfig json.RawMessage, destinationTypePath string, certData *operators.CertificateData) (json.RawMessage, error)
+	EnrichAssignmentConfigWithSAMLCertificateData(assignmentConfig json.RawMessage, destinationTypePath string, certData *operators.CertificateData) (json.RawMessage, error)
+}
+
+// DestinationService is responsible for the destinations which use the rotated certificates
+//
+//go:generate mockery --name=DestinationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type DestinationService interface {
+	CreateSAMLAssertionDestination(ctx context.Context, destinationsDetails []operators.Destination, samlAssertionAuthCredentials *operators.SAMLAssertionAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, skipSubaccountValidation bool) error
+	CreateClientCertificateAuthenticationDestination(ctx context.Context, destinationsDetails []operators.Destination, clientCertAuthCredentials *operators.ClientCertAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, skipSubaccountValidation bool) error
+	CreateOAuth2mTLSDestinations(ctx context.Context, destinationsDetails []operators.Destination, oauth2mTLSCredentials *operators.OAuth2mTLSAuthentication, formationAssignment *model.FormationAssignment, correlationIDs []string, skipSubaccountValidation bool) error
+}
+
+// FormationService is responsible for sending the formation assignment notifications
+//
+//go:generate mockery --name=FormationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationService interface {
+	ResynchronizeFormationNotifications(ctx context.Context, formationID string, shouldReset bool) (*model.Formation, error)
+}
+
+// UIDService generates UUIDs for new entities
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+// Rotator renews the destination certificates of the formation assignments before they expire.
+//
+// A renewed certificate is stored with a new name next to the current one and is tracked as PENDING.
+// The assignment configuration is enriched with the renewed certificate and the participant is notified again through the reverse assignment.
+// Once the participant confirms the notification with READY, the destinations are switched to the renewed certificate and the old one is deleted.
+// If the participant does not confirm it within the rotation timeout, the renewed certificate is deleted and the rotation starts over.
+type Rotator struct {
+	transact                persistence.Transactioner
+	certificateRepo         CertificateRepository
+	formationAssignmentRepo FormationAssignmentRepository
+	destinationCreatorSvc   DestinationCreatorService
+	destinationSvc          DestinationService
+	formationSvc            FormationService
+	uidSvc                  UIDService
+	renewBeforeExpiry       time.Duration
+	rotationTimeout         time.Duration
+}
+
+// NewRotator creates a new destination certificate Rotator
+func NewRotator(transact persistence.Transactioner, certificateRepo CertificateRepository, formationAssignmentRepo FormationAssignmentRepository, destinationCreatorSvc DestinationCreatorService, destinationSvc DestinationService, formationSvc FormationService, uidSvc UIDService, renewBeforeExpiry, rotationTimeout time.Duration) *Rotator {
+	return &Rotator{
+		transact:                transact,
+		certificateRepo:         certificateRepo,
+		formationAssignmentRepo: formationAssignmentRepo,
+		destinationCreatorSvc:   destinationCreatorSvc,
+		destinationSvc:          destinationSvc,
+		formationSvc:            formationSvc,
+		uidSvc:                  uidSvc,
+		renewBeforeExpiry:       renewBeforeExpiry,
+		rotationTimeout:         rotationTimeout,
+	}
+}
+
+// RotateExpiringCertificates renews the active certificates which expire within the configured period and returns the number of the started rotations.
+// Every certificate is renewed in its own transaction, so a failure is logged and does not affect the others.
+func (r *Rotator) RotateExpiringCertificates(ctx context.Context) (int, error) {
+	certificates, err := r.listCertificates(ctx, func(ctx context.Context) ([]*model.DestinationCertificate, error) {
+		return r.certificateRepo.ListActiveExpiringBefore(ctx, Now().Add(r.renewBeforeExpiry))
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "while listing the expiring destination certificates")
+	}
+
+	rotated := 0
+	for _, certificate := range certificates {
+		reverseAssignment, err := r.rotateCertificate(ctx, certificate)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to rotate destination certificate with name: %q for formation assignment with ID: %q", certificate.Name, certificate.FormationAssignmentID)
+			continue
+		}
+		if reverseAssignment == nil {
+			continue
+		}
+
+		rotated++
+		r.resynchronizeFormation(ctx, reverseAssignment)
+	}
+
+	return rotated, nil
+}
+
+// CompleteRotations activates the renewed certificates whose participants confirmed the new certificate data and returns the number of the completed rotations
+func (r *Rotator) CompleteRotations(ctx context.Context) (int, error) {
+	certificates, err := r.listCertificates(ctx, func(ctx context.Context) ([]*model.DestinationCertificate, error) {
+		return r.certificateRepo.ListByState(ctx, model.DestinationCertificateStatePending)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "while listing the pending destination certificates")
+	}
+
+	completed := 0
+	for _, certificate := range certificates {
+		replaced, done, err := r.completeRotation(ctx, certificate)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to complete the rotation of destination certificate with name: %q for formation assignment with ID: %q", certificate.Name, certificate.FormationAssignmentID)
+			continue
+		}
+		if !done {
+			continue
+		}
+
+		completed++
+		if replaced != nil {
+			r.deleteRemoteCertificate(ctx, replaced)
+		}
+	}
+
+	return completed, nil
+}
+
+// rotateCertificate renews the certificate and returns the reverse formation assignment through which the participant has to be notified.
+// A nil assignment is returned when the certificate is not rotated.
+// The certificate is renewed in the remote destination service outside of a transaction. If the renewed certificate cannot be stored, it is deleted again.
+func (r *Rotator) rotateCertificate(ctx context.Context, current *model.DestinationCertificate) (*model.FormationAssignment, error) {
+	rotatable, err := r.isRotatable(ctx, current)
+	if err != nil || !rotatable {
+		return nil, err
+	}
+
+	renewed, certData, err := r.destinationCreatorSvc.RenewCertificate(ctx, current)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while renewing destination certificate with name: %q", current.Name)
+	}
+
+	reverseAssignment, err := r.storeRenewedCertificate(ctx, current, renewed, certData)
+	if err != nil || reverseAssignment == nil {
+		r.deleteRemoteCertificate(ctx, renewed)
+		return nil, err
+	}
+
+	return reverseAssignment, nil
+}
+
+// isRotatable reports whether the certificate can be renewed
+func (r *Rotator) isRotatable(ctx context.Context, current *model.DestinationCertificate) (bool, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	assignment, reverseAssignment, err := r.getRotationAssignments(ctx, current)
+	if err != nil || assignment == nil || reverseAssignment == nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// storeRenewedCertificate stores the renewed certificate as PENDING, enriches the assignment configuration with its data and
+// resets the reverse formation assignment, so that the participant is notified again. The state is checked again,
+// as it may have changed while the certificate was renewed.
+func (r *Rotator) storeRenewedCertificate(ctx context.Context, current, renewed *model.DestinationCertificate, certData *operators.CertificateData) (*model.FormationAssignment, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	assignment, reverseAssignment, err := r.getRotationAssignments(ctx, current)
+	if err != nil || assignment == nil || reverseAssignment == nil {
+		return nil, err
+	}
+
+	config, err := r.enrichAssignmentConfig(assignment.Value, current.AuthenticationType, certData)
+	if err != nil {
+		return nil, err
+	}
+	assignment.Value = config
+	if err = r.formationAssignmentRepo.Update(ctx, assignment); err != nil {
+		return nil, errors.Wrapf(err, "while updating formation assignment with ID: %q", assignment.ID)
+	}
+
+	reverseAssignment.State = string(model.InitialAssignmentState)
+	if err = r.formationAssignmentRepo.Update(ctx, reverseAssignment); err != nil {
+		return nil, errors.Wrapf(err, "while updating reverse formation assignment with ID: %q", reverseAssignment.ID)
+	}
+
+	renewed.ID = r.uidSvc.Generate()
+	renewed.State = model.DestinationCertificateStatePending
+	renewed.CreatedAt = Now()
+	if err = r.certificateRepo.Upsert(ctx, renewed); err != nil {
+		return nil, errors.Wrapf(err, "while storing renewed destination certificate with name: %q", renewed.Name)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	log.C(ctx).Infof("Destination certificate with name: %q for formation assignment with ID: %q is renewed as %q", current.Name, assignment.ID, renewed.Name)
+	return reverseAssignment, nil
+}
+
+// getRotationAssignments returns the formation assignment of the certificate and its reverse formation assignment.
+// Nil assignments are returned when the certificate should not be rotated.
+func (r *Rotator) getRotationAssignments(ctx context.Context, current *model.DestinationCertificate) (*model.FormationAssignment, *model.FormationAssignment, error) {
+	pending, err := r.getCertificate(ctx, current.FormationAssignmentID, current.AuthenticationType, model.DestinationCertificateStatePending)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pending != nil {
+		log.C(ctx).Infof("The rotation of destination certificate with name: %q is already in progress", current.Name)
+		return nil, nil, nil
+	}
+
+	assignment, err := r.formationAssignmentRepo.GetGlobalByID(ctx, current.FormationAssignmentID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while getting formation assignment with ID: %q", current.FormationAssignmentID)
+	}
+	if assignment.State != string(model.ReadyAssignmentState) {
+		log.C(ctx).Infof("Formation assignment with ID: %q is in %q state. The rotation of destination certificate with name: %q is postponed", assignment.ID, assignment.State, current.Name)
+		return nil, nil, nil
+	}
+
+	reverseAssignment, err := r.formationAssignmentRepo.GetReverseBySourceAndTarget(ctx, assignment.TenantID, assignment.FormationID, assignment.Source, assignment.Target)
+	if err != nil {
+		if !apperrors.IsNotFoundError(err) {
+			return nil, nil, errors.Wrapf(err, "while getting reverse formation assignment for formation assignment with ID: %q", assignment.ID)
+		}
+		log.C(ctx).Infof("There is no reverse formation assignment for formation assignment with ID: %q. The rotation of destination certificate with name: %q is skipped", assignment.ID, current.Name)
+		return nil, nil, nil
+	}
+
+	return assignment, reverseAssignment, nil
+}
+
+// completeRotation activates the pending certificate once the reverse formation assignment is READY and returns the replaced certificate.
+// The returned flag reports whether the rotation is completed.
+// The destinations are recreated in the remote destination service before the activation and outside of its transaction.
+// If the activation fails, the pending certificate is completed again on the next run.
+func (r *Rotator) completeRotation(ctx context.Context, pending *model.DestinationCertificate) (*model.DestinationCertificate, bool, error) {
+	assignment, reverseAssignment, confirmed, err := r.getConfirmedAssignments(ctx, pending)
+	if err != nil || !confirmed {
+		return nil, false, err
+	}
+
+	if reverseAssignment != nil {
+		if err = r.recreateDestinations(ctx, assignment, reverseAssignment, pending); err != nil {
+			return nil, false, err
+		}
+	}
+
+	replaced, err := r.activateCertificate(ctx, pending)
+	if err != nil {
+		return nil, false, err
+	}
+
+	log.C(ctx).Infof("Destination certificate with name: %q for formation assignment with ID: %q is activated", pending.Name, assignment.ID)
+	return replaced, true, nil
+}
+
+// getConfirmedAssignments returns the formation assignment of the pending certificate and its reverse formation assignment.
+// The returned flag reports whether the participant confirmed the pending certificate or there is no reverse formation assignment.
+// Otherwise, the participant is notified again if needed, or the rotation is aborted once the rotation timeout is exceeded.
+func (r *Rotator) getConfirmedAssignments(ctx context.Context, pending *model.DestinationCertificate) (*model.FormationAssignment, *model.FormationAssignment, bool, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	assignment, err := r.formationAssignmentRepo.GetGlobalByID(ctx, pending.FormationAssignmentID)
+	if err != nil {
+		return nil, nil, false, errors.Wrapf(err, "while getting formation assignment with ID: %q", pending.FormationAssignmentID)
+	}
+
+	reverseAssignment, err := r.formationAssignmentRepo.GetReverseBySourceAndTarget(ctx, assignment.TenantID, assignment.FormationID, assignment.Source, assignment.Target)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return nil, nil, false, errors.Wrapf(err, "while getting reverse formation assignment for formation assignment with ID: %q", assignment.ID)
+	}
+
+	if reverseAssignment != nil && reverseAssignment.State != string(model.ReadyAssignmentState) {
+		if Now().After(pending.CreatedAt.Add(r.rotationTimeout)) {
+			return nil, nil, false, r.abortRotation(ctx, tx, pending, reverseAssignment)
+		}
+
+		if err = tx.Commit(); err != nil {
+			return nil, nil, false, err
+		}
+
+		if isNotificationPending(reverseAssignment, pending) {
+			r.resynchronizeFormation(ctx, reverseAssignment)
+		}
+		log.C(ctx).Infof("Waiting for reverse formation assignment with ID: %q in %q state to be confirmed before activating destination certificate with name: %q", reverseAssignment.ID, reverseAssignment.State, pending.Name)
+		return nil, nil, false, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, false, err
+	}
+
+	return assignment, reverseAssignment, true, nil
+}
+
+// activateCertificate marks the pending certificate as ACTIVE and deletes the certificate it replaces, which is returned
+func (r *Rotator) activateCertificate(ctx context.Context, pending *model.DestinationCertificate) (*model.DestinationCertificate, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	replaced, err := r.getCertificate(ctx, pending.FormationAssignmentID, pending.AuthenticationType, model.DestinationCertificateStateActive)
+	if err != nil {
+		return nil, err
+	}
+	if replaced != nil {
+		if err = r.certificateRepo.Delete(ctx, replaced.ID); err != nil {
+			return nil, errors.Wrapf(err, "while deleting destination certificate with name: %q", replaced.Name)
+		}
+	}
+
+	pending.State = model.DestinationCertificateStateActive
+	if err = r.certificateRepo.Update(ctx, pending); err != nil {
+		return nil, errors.Wrapf(err, "while activating destination certificate with name: %q", pending.Name)
+	}
+
+	return replaced, tx.Commit()
+}
+
+// abortRotation deletes the pending certificate whose participant did not confirm it in time.
+// The active certificate stays in use and is renewed again on the next run.
+func (r *Rotator) abortRotation(ctx context.Context, tx persistence.PersistenceTx, pending *model.DestinationCertificate, reverseAssignment *model.FormationAssignment) error {
+	if err := r.certificateRepo.Delete(ctx, pending.ID); err != nil {
+		return errors.Wrapf(err, "while deleting destination certificate with name: %q", pending.Name)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	r.deleteRemoteCertificate(ctx, pending)
+	return errors.Errorf("reverse formation assignment with ID: %q is in %q state and did not confirm destination certificate with name: %q within %s. The rotation is aborted", reverseAssignment.ID, reverseAssignment.State, pending.Name, r.rotationTimeout)
+}
+
+// recreateDestinations points the destinations of the formation assignment to the certificate which is being activated.
+// The destination service stores the recreated destinations in its own transaction, which is not shared with the certificate activation.
+func (r *Rotator) recreateDestinations(ctx context.Context, assignment, reverseAssignment *model.FormationAssignment, certificate *model.DestinationCertificate) error {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = r.createDestinations(ctx, assignment, reverseAssignment, certificate); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *Rotator) createDestinations(ctx context.Context, assignment, reverseAssignment *model.FormationAssignment, certificate *model.DestinationCertificate) error {
+	var assignmentConfig operators.Configuration
+	if err := json.Unmarshal(assignment.Value, &assignmentConfig); err != nil {
+		return errors.Wrapf(err, "while unmarshalling configuration of formation assignment with ID: %q", assignment.ID)
+	}
+
+	var reverseAssignmentConfig operators.Configuration
+	if err := json.Unmarshal(reverseAssignment.Value, &reverseAssignmentConfig); err != nil {
+		return errors.Wrapf(err, "while unmarshalling configuration of reverse formation assignment with ID: %q", reverseAssignment.ID)
+	}
+
+	inboundDetails := assignmentConfig.Credentials.InboundCommunicationDetails
+	outboundCreds := reverseAssignmentConfig.Credentials.OutboundCommunicationCredentials
+	if inboundDetails == nil || outboundCreds == nil {
+		log.C(ctx).Infof("There are no destination details for formation assignment with ID: %q. No destination will be recreated", assignment.ID)
+		return nil
+	}
+
+	switch destinationcreatorpkg.AuthType(certificate.AuthenticationType) {
+	case destinationcreatorpkg.AuthTypeSAMLAssertion:
+		if details := inboundDetails.SAMLAssertionDetails; details != nil && outboundCreds.SAMLAssertionAuthentication != nil && len(details.Destinations) > 0 {
+			if err := r.destinationSvc.CreateSAMLAssertionDestination(ctx, withSubaccount(details.Destinations, certificate.SubaccountID), outboundCreds.SAMLAssertionAuthentication, assignment, details.CorrelationIDs, true); err != nil {
+				return errors.Wrap(err, "while recreating SAML Assertion destinations")
+			}
+		}
+	case destinationcreatorpkg.AuthTypeClientCertificate:
+		if details := inboundDetails.ClientCertificateAuthenticationDetails; details != nil && outboundCreds.ClientCertAuthentication != nil && len(details.Destinations) > 0 {
+			if err := r.destinationSvc.CreateClientCertificateAuthenticationDestination(ctx, withSubaccount(details.Destinations, certificate.SubaccountID), outboundCreds.ClientCertAuthentication, assignment, details.CorrelationIDs, true); err != nil {
+				return errors.Wrap(err, "while recreating client certificate authentication destinations")
+			}
+		}
+	case destinationcreatorpkg.AuthTypeOAuth2mTLS:
+		if details := inboundDetails.OAuth2mTLSAuthenticationDetails; details != nil && outboundCreds.OAuth2mTLSAuthentication != nil && len(details.Destinations) > 0 {
+			if err := r.destinationSvc.CreateOAuth2mTLSDestinations(ctx, withSubaccount(details.Destinations, certificate.SubaccountID), outboundCreds.OAuth2mTLSAuthentication, assignment, details.CorrelationIDs, true); err != nil {
+				return errors.Wrap(err, "while recreating oauth2 mTLS destinations")
+			}
+		}
+	default:
+		return errors.Errorf("Unsupported destination certificate authentication type: %q", certificate.AuthenticationType)
+	}
+
+	return nil
+}
+
+func (r *Rotator) enrichAssignmentConfig(config json.RawMessage, authenticationType string, certData *operators.CertificateData) (json.RawMessage, error) {
+	switch destinationcreatorpkg.AuthType(authenticationType) {
+	case destinationcreatorpkg.AuthTypeSAMLAssertion:
+		return r.destinationCreatorSvc.EnrichAssignmentConfigWithSAMLCertificateData(config, destinationcreatorpkg.SAMLAssertionDestPath, certData)
+	case destinationcreatorpkg.AuthTypeClientCertificate:
+		return r.destinationCreatorSvc.EnrichAssignmentConfigWithCertificateData(config, destinationcreatorpkg.ClientCertAuthDestPath, certData)
+	case destinationcreatorpkg.AuthTypeOAuth2mTLS:
+		return r.destinationCreatorSvc.EnrichAssignmentConfigWithCertificateData(config, destinationcreatorpkg.Oauth2mTLSAuthDestPath, certData)
+	default:
+		return nil, errors.Errorf("Unsupported destination certificate authentication type: %q", authenticationType)
+	}
+}
+
+// resynchronizeFormation sends the notification of the reverse formation assignment with the renewed certificate data to the participant.
+// A failure is only logged, as the notification is sent again on the next run until the participant confirms it.
+func (r *Rotator) resynchronizeFormation(ctx context.Context, reverseAssignment *model.FormationAssignment) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Failed to begin a transaction for the formation resynchronization")
+		return
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	ctx = tenant.SaveToContext(ctx, reverseAssignment.TenantID, "")
+
+	if _, err = r.formationSvc.ResynchronizeFormationNotifications(ctx, reverseAssignment.FormationID, false); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to resynchronize formation with ID: %q for formation assignment with ID: %q", reverseAssignment.FormationID, reverseAssignment.ID)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to commit the resynchronization of formation with ID: %q", reverseAssignment.FormationID)
+	}
+}
+
+// deleteRemoteCertificate deletes the certificate from the remote destination service.
+// A failure is only logged, as the certificate is not used and expires on its own.
+func (r *Rotator) deleteRemoteCertificate(ctx context.Context, certificate *model.DestinationCertificate) {
+	assignment := &model.FormationAssignment{ID: certificate.FormationAssignmentID}
+	if err := r.destinationCreatorSvc.DeleteCertificate(ctx, certificate.Name, certificate.SubaccountID, certificate.InstanceID, assignment, true); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to delete destination certificate with name: %q", certificate.Name)
+	}
+}
+
+func (r *Rotator) getCertificate(ctx context.Context, formationAssignmentID, authenticationType string, state model.DestinationCertificateState) (*model.DestinationCertificate, error) {
+	certificate, err := r.certificateRepo.GetByAssignmentIDAndAuthType(ctx, formationAssignmentID, authenticationType, state)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "while getting %s destination certificate for formation assignment with ID: %q", state, formationAssignmentID)
+	}
+
+	return certificate, nil
+}
+
+func (r *Rotator) listCertificates(ctx context.Context, listFn func(ctx context.Context) ([]*model.DestinationCertificate, error)) ([]*model.DestinationCertificate, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	certificates, err := listFn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return certificates, tx.Commit()
+}
+
+// isNotificationPending reports whether the participant has not been notified about the pending certificate yet
+func isNotificationPending(reverseAssignment *model.FormationAssignment, pending *model.DestinationCertificate) bool {
+	return reverseAssignment.State == string(model.InitialAssignmentState) &&
+		(reverseAssignment.LastNotificationSentTimestamp == nil || reverseAssignment.LastNotificationSentTimestamp.Before(pending.CreatedAt))
+}
+
+// withSubaccount returns the destinations with the certificate subaccount set where no subaccount is provided
+func withSubaccount(destinations []operators.Destination, subaccountID string) []operators.Destination {
+	result := make([]operators.Destination, 0, len(destinations))
+	for _, destination := range destinations {
+		if destination.SubaccountID == "" {
+			destination.SubaccountID = subaccountID
+		}
+		result = append(result, destination)
+	}
+
+	return result
+}
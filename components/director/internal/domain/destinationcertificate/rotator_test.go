@@ -0,0 +1,559 @@
+package destinationcertificate_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/destinationcertificate"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/destinationcertificate/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	destinationcreatorpkg "github.com/kyma-incubator/compass/components/director/pkg/destinationcreator"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	renewBeforeExpiry = 720 * time.Hour
+	rotationTimeout   = 72 * time.Hour
+)
+
+func TestRotator_RotateExpiringCertificates(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	certData := &operators.CertificateData{FileName: certificateName + "-1.jks", CommonName: "common-name", CertificateChain: certificateChain}
+
+	testCases := []struct {
+		Name                    string
+		TxFn                    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		CertificateRepoFn       func() *automock.CertificateRepository
+		AssignmentRepoFn        func() *automock.FormationAssignmentRepository
+		DestinationCreatorSvcFn func() *automock.DestinationCreatorService
+		FormationSvcFn          func() *automock.FormationService
+		UIDSvcFn                func() *automock.UIDService
+		ExpectedRotated         int
+		ExpectedError           string
+	}{
+		{
+			Name: "Success",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(4)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListActiveExpiringBefore", txtest.CtxWithDBMatcher(), createdAt.Add(renewBeforeExpiry)).Return([]*model.DestinationCertificate{fixCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStatePending).Return(nil, notFoundErr).Twice()
+				repo.On("Upsert", txtest.CtxWithDBMatcher(), fixPendingCertificateModel()).Return(nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, assignmentConfig), nil).Twice()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.ReadyAssignmentState, nil), nil).Twice()
+				repo.On("Update", txtest.CtxWithDBMatcher(), fixAssignment(model.ReadyAssignmentState, enrichedConfig)).Return(nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), fixReverseAssignment(model.InitialAssignmentState, nil)).Return(nil).Once()
+				return repo
+			},
+			DestinationCreatorSvcFn: func() *automock.DestinationCreatorService {
+				svc := &automock.DestinationCreatorService{}
+				svc.On("RenewCertificate", mock.Anything, fixCertificateModel()).Return(fixRenewedCertificateModel(), certData, nil).Once()
+				svc.On("EnrichAssignmentConfigWithSAMLCertificateData", assignmentConfig, destinationcreatorpkg.SAMLAssertionDestPath, certData).Return(enrichedConfig, nil).Once()
+				return svc
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("ResynchronizeFormationNotifications", txtest.CtxWithDBMatcher(), formationID, false).Return(&model.Formation{ID: formationID}, nil).Once()
+				return svc
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(renewedCertificateID).Once()
+				return svc
+			},
+			ExpectedRotated: 1,
+		},
+		{
+			Name: "Rotation is counted when the resynchronization fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(3)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListActiveExpiringBefore", txtest.CtxWithDBMatcher(), createdAt.Add(renewBeforeExpiry)).Return([]*model.DestinationCertificate{fixCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStatePending).Return(nil, notFoundErr).Twice()
+				repo.On("Upsert", txtest.CtxWithDBMatcher(), fixPendingCertificateModel()).Return(nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, assignmentConfig), nil).Twice()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.ReadyAssignmentState, nil), nil).Twice()
+				repo.On("Update", txtest.CtxWithDBMatcher(), mock.AnythingOfType("*model.FormationAssignment")).Return(nil).Twice()
+				return repo
+			},
+			DestinationCreatorSvcFn: func() *automock.DestinationCreatorService {
+				svc := &automock.DestinationCreatorService{}
+				svc.On("RenewCertificate", mock.Anything, fixCertificateModel()).Return(fixRenewedCertificateModel(), certData, nil).Once()
+				svc.On("EnrichAssignmentConfigWithSAMLCertificateData", assignmentConfig, destinationcreatorpkg.SAMLAssertionDestPath, certData).Return(enrichedConfig, nil).Once()
+				return svc
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("ResynchronizeFormationNotifications", txtest.CtxWithDBMatcher(), formationID, false).Return(nil, testErr).Once()
+				return svc
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(renewedCertificateID).Once()
+				return svc
+			},
+			ExpectedRotated: 1,
+		},
+		{
+			Name: "Skips the certificate when its rotation is already in progress",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(1)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListActiveExpiringBefore", txtest.CtxWithDBMatcher(), createdAt.Add(renewBeforeExpiry)).Return([]*model.DestinationCertificate{fixCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStatePending).Return(fixPendingCertificateModel(), nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Skips the certificate when the formation assignment is not ready",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(1)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListActiveExpiringBefore", txtest.CtxWithDBMatcher(), createdAt.Add(renewBeforeExpiry)).Return([]*model.DestinationCertificate{fixCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStatePending).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ConfigPendingAssignmentState, assignmentConfig), nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Skips the certificate when there is no reverse formation assignment",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(1)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListActiveExpiringBefore", txtest.CtxWithDBMatcher(), createdAt.Add(renewBeforeExpiry)).Return([]*model.DestinationCertificate{fixCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStatePending).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, assignmentConfig), nil).Once()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(nil, notFoundErr).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Deletes the renewed certificate when storing it fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(2)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListActiveExpiringBefore", txtest.CtxWithDBMatcher(), createdAt.Add(renewBeforeExpiry)).Return([]*model.DestinationCertificate{fixCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStatePending).Return(nil, notFoundErr).Twice()
+				repo.On("Upsert", txtest.CtxWithDBMatcher(), fixPendingCertificateModel()).Return(testErr).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, assignmentConfig), nil).Twice()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.ReadyAssignmentState, nil), nil).Twice()
+				repo.On("Update", txtest.CtxWithDBMatcher(), mock.AnythingOfType("*model.FormationAssignment")).Return(nil).Twice()
+				return repo
+			},
+			DestinationCreatorSvcFn: func() *automock.DestinationCreatorService {
+				svc := &automock.DestinationCreatorService{}
+				svc.On("RenewCertificate", mock.Anything, fixCertificateModel()).Return(fixRenewedCertificateModel(), certData, nil).Once()
+				svc.On("EnrichAssignmentConfigWithSAMLCertificateData", assignmentConfig, destinationcreatorpkg.SAMLAssertionDestPath, certData).Return(enrichedConfig, nil).Once()
+				svc.On("DeleteCertificate", mock.Anything, certificateName+"-1", subaccountID, instanceID, &model.FormationAssignment{ID: assignmentID}, true).Return(nil).Once()
+				return svc
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(renewedCertificateID).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Continues with the other certificates when renewing fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListActiveExpiringBefore", txtest.CtxWithDBMatcher(), createdAt.Add(renewBeforeExpiry)).Return([]*model.DestinationCertificate{fixCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStatePending).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, assignmentConfig), nil).Once()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.ReadyAssignmentState, nil), nil).Once()
+				return repo
+			},
+			DestinationCreatorSvcFn: func() *automock.DestinationCreatorService {
+				svc := &automock.DestinationCreatorService{}
+				svc.On("RenewCertificate", mock.Anything, fixCertificateModel()).Return(nil, nil, testErr).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Error when getting the pending certificate fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(1)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListActiveExpiringBefore", txtest.CtxWithDBMatcher(), createdAt.Add(renewBeforeExpiry)).Return([]*model.DestinationCertificate{fixCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStatePending).Return(nil, testErr).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when listing the expiring certificates fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListActiveExpiringBefore", txtest.CtxWithDBMatcher(), createdAt.Add(renewBeforeExpiry)).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when the transaction fails to begin",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			destinationcertificate.Now = func() time.Time { return createdAt }
+			defer func() { destinationcertificate.Now = time.Now }()
+
+			persist, transact := testCase.TxFn()
+			certificateRepo := &automock.CertificateRepository{}
+			if testCase.CertificateRepoFn != nil {
+				certificateRepo = testCase.CertificateRepoFn()
+			}
+			assignmentRepo := &automock.FormationAssignmentRepository{}
+			if testCase.AssignmentRepoFn != nil {
+				assignmentRepo = testCase.AssignmentRepoFn()
+			}
+			destinationCreatorSvc := &automock.DestinationCreatorService{}
+			if testCase.DestinationCreatorSvcFn != nil {
+				destinationCreatorSvc = testCase.DestinationCreatorSvcFn()
+			}
+			formationSvc := &automock.FormationService{}
+			if testCase.FormationSvcFn != nil {
+				formationSvc = testCase.FormationSvcFn()
+			}
+			uidSvc := &automock.UIDService{}
+			if testCase.UIDSvcFn != nil {
+				uidSvc = testCase.UIDSvcFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, persist, transact, certificateRepo, assignmentRepo, destinationCreatorSvc, formationSvc, uidSvc)
+
+			rotator := destinationcertificate.NewRotator(transact, certificateRepo, assignmentRepo, destinationCreatorSvc, nil, formationSvc, uidSvc, renewBeforeExpiry, rotationTimeout)
+
+			// WHEN
+			rotated, err := rotator.RotateExpiringCertificates(context.TODO())
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.ExpectedRotated, rotated)
+		})
+	}
+}
+
+func TestRotator_CompleteRotations(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	notifiedAfterRenewal := createdAt.Add(time.Hour)
+	notifiedBeforeRenewal := createdAt.Add(-time.Hour)
+	timedOut := createdAt.Add(rotationTimeout + time.Hour)
+	expectedDestinations := []operators.Destination{{Name: "saml-destination", SubaccountID: subaccountID}}
+	expectedCreds := &operators.SAMLAssertionAuthentication{URL: "https://saml.example.com"}
+
+	testCases := []struct {
+		Name                    string
+		TxFn                    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		CertificateRepoFn       func() *automock.CertificateRepository
+		AssignmentRepoFn        func() *automock.FormationAssignmentRepository
+		DestinationCreatorSvcFn func() *automock.DestinationCreatorService
+		DestinationSvcFn        func() *automock.DestinationService
+		FormationSvcFn          func() *automock.FormationService
+		Now                     *time.Time
+		ExpectedCompleted       int
+		ExpectedError           string
+	}{
+		{
+			Name: "Success",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(4)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListByState", txtest.CtxWithDBMatcher(), model.DestinationCertificateStatePending).Return([]*model.DestinationCertificate{fixPendingCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStateActive).Return(fixCertificateModel(), nil).Once()
+				repo.On("Delete", txtest.CtxWithDBMatcher(), certificateID).Return(nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), fixActivatedCertificateModel()).Return(nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, enrichedConfig), nil).Once()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.ReadyAssignmentState, &notifiedAfterRenewal), nil).Once()
+				return repo
+			},
+			DestinationSvcFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("CreateSAMLAssertionDestination", txtest.CtxWithDBMatcher(), expectedDestinations, expectedCreds, fixAssignment(model.ReadyAssignmentState, enrichedConfig), []string{"corr-id"}, true).Return(nil).Once()
+				return svc
+			},
+			DestinationCreatorSvcFn: func() *automock.DestinationCreatorService {
+				svc := &automock.DestinationCreatorService{}
+				svc.On("DeleteCertificate", mock.Anything, certificateName, subaccountID, instanceID, &model.FormationAssignment{ID: assignmentID}, true).Return(nil).Once()
+				return svc
+			},
+			ExpectedCompleted: 1,
+		},
+		{
+			Name: "Success when there is no reverse formation assignment",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListByState", txtest.CtxWithDBMatcher(), model.DestinationCertificateStatePending).Return([]*model.DestinationCertificate{fixPendingCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStateActive).Return(nil, notFoundErr).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), fixActivatedCertificateModel()).Return(nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, enrichedConfig), nil).Once()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			ExpectedCompleted: 1,
+		},
+		{
+			Name: "Rotation is completed when deleting the replaced certificate fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(4)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListByState", txtest.CtxWithDBMatcher(), model.DestinationCertificateStatePending).Return([]*model.DestinationCertificate{fixPendingCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStateActive).Return(fixCertificateModel(), nil).Once()
+				repo.On("Delete", txtest.CtxWithDBMatcher(), certificateID).Return(nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), fixActivatedCertificateModel()).Return(nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, enrichedConfig), nil).Once()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.ReadyAssignmentState, &notifiedAfterRenewal), nil).Once()
+				return repo
+			},
+			DestinationSvcFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("CreateSAMLAssertionDestination", txtest.CtxWithDBMatcher(), expectedDestinations, expectedCreds, fixAssignment(model.ReadyAssignmentState, enrichedConfig), []string{"corr-id"}, true).Return(nil).Once()
+				return svc
+			},
+			DestinationCreatorSvcFn: func() *automock.DestinationCreatorService {
+				svc := &automock.DestinationCreatorService{}
+				svc.On("DeleteCertificate", mock.Anything, certificateName, subaccountID, instanceID, &model.FormationAssignment{ID: assignmentID}, true).Return(testErr).Once()
+				return svc
+			},
+			ExpectedCompleted: 1,
+		},
+		{
+			Name: "Resends the notification when the participant was not notified about the renewed certificate",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListByState", txtest.CtxWithDBMatcher(), model.DestinationCertificateStatePending).Return([]*model.DestinationCertificate{fixPendingCertificateModel()}, nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, enrichedConfig), nil).Once()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.InitialAssignmentState, &notifiedBeforeRenewal), nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("ResynchronizeFormationNotifications", txtest.CtxWithDBMatcher(), formationID, false).Return(&model.Formation{ID: formationID}, nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Waits for the participant to confirm the renewed certificate",
+			TxFn: txGen.ThatSucceedsTwice,
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListByState", txtest.CtxWithDBMatcher(), model.DestinationCertificateStatePending).Return([]*model.DestinationCertificate{fixPendingCertificateModel()}, nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, enrichedConfig), nil).Once()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.InitialAssignmentState, &notifiedAfterRenewal), nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Aborts the rotation when the participant does not confirm the renewed certificate in time",
+			TxFn: txGen.ThatSucceedsTwice,
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListByState", txtest.CtxWithDBMatcher(), model.DestinationCertificateStatePending).Return([]*model.DestinationCertificate{fixPendingCertificateModel()}, nil).Once()
+				repo.On("Delete", txtest.CtxWithDBMatcher(), renewedCertificateID).Return(nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, enrichedConfig), nil).Once()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.CreateErrorAssignmentState, &notifiedAfterRenewal), nil).Once()
+				return repo
+			},
+			DestinationCreatorSvcFn: func() *automock.DestinationCreatorService {
+				svc := &automock.DestinationCreatorService{}
+				svc.On("DeleteCertificate", mock.Anything, certificateName+"-1", subaccountID, instanceID, &model.FormationAssignment{ID: assignmentID}, true).Return(nil).Once()
+				return svc
+			},
+			Now: &timedOut,
+		},
+		{
+			Name: "Continues with the other certificates when recreating the destinations fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(2)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListByState", txtest.CtxWithDBMatcher(), model.DestinationCertificateStatePending).Return([]*model.DestinationCertificate{fixPendingCertificateModel()}, nil).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, enrichedConfig), nil).Once()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.ReadyAssignmentState, &notifiedAfterRenewal), nil).Once()
+				return repo
+			},
+			DestinationSvcFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("CreateSAMLAssertionDestination", txtest.CtxWithDBMatcher(), expectedDestinations, expectedCreds, fixAssignment(model.ReadyAssignmentState, enrichedConfig), []string{"corr-id"}, true).Return(testErr).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Continues with the other certificates when activating the renewed certificate fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(3)
+			},
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListByState", txtest.CtxWithDBMatcher(), model.DestinationCertificateStatePending).Return([]*model.DestinationCertificate{fixPendingCertificateModel()}, nil).Once()
+				repo.On("GetByAssignmentIDAndAuthType", txtest.CtxWithDBMatcher(), assignmentID, authType, model.DestinationCertificateStateActive).Return(fixCertificateModel(), nil).Once()
+				repo.On("Delete", txtest.CtxWithDBMatcher(), certificateID).Return(nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), fixActivatedCertificateModel()).Return(testErr).Once()
+				return repo
+			},
+			AssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixAssignment(model.ReadyAssignmentState, enrichedConfig), nil).Once()
+				repo.On("GetReverseBySourceAndTarget", txtest.CtxWithDBMatcher(), tenantID, formationID, sourceID, targetID).Return(fixReverseAssignment(model.ReadyAssignmentState, &notifiedAfterRenewal), nil).Once()
+				return repo
+			},
+			DestinationSvcFn: func() *automock.DestinationService {
+				svc := &automock.DestinationService{}
+				svc.On("CreateSAMLAssertionDestination", txtest.CtxWithDBMatcher(), expectedDestinations, expectedCreds, fixAssignment(model.ReadyAssignmentState, enrichedConfig), []string{"corr-id"}, true).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Error when listing the pending certificates fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			CertificateRepoFn: func() *automock.CertificateRepository {
+				repo := &automock.CertificateRepository{}
+				repo.On("ListByState", txtest.CtxWithDBMatcher(), model.DestinationCertificateStatePending).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			now := createdAt.Add(2 * time.Hour)
+			if testCase.Now != nil {
+				now = *testCase.Now
+			}
+			destinationcertificate.Now = func() time.Time { return now }
+			defer func() { destinationcertificate.Now = time.Now }()
+
+			persist, transact := testCase.TxFn()
+			certificateRepo := &automock.CertificateRepository{}
+			if testCase.CertificateRepoFn != nil {
+				certificateRepo = testCase.CertificateRepoFn()
+			}
+			assignmentRepo := &automock.FormationAssignmentRepository{}
+			if testCase.AssignmentRepoFn != nil {
+				assignmentRepo = testCase.AssignmentRepoFn()
+			}
+			destinationCreatorSvc := &automock.DestinationCreatorService{}
+			if testCase.DestinationCreatorSvcFn != nil {
+				destinationCreatorSvc = testCase.DestinationCreatorSvcFn()
+			}
+			destinationSvc := &automock.DestinationService{}
+			if testCase.DestinationSvcFn != nil {
+				destinationSvc = testCase.DestinationSvcFn()
+			}
+			formationSvc := &automock.FormationService{}
+			if testCase.FormationSvcFn != nil {
+				formationSvc = testCase.FormationSvcFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, persist, transact, certificateRepo, assignmentRepo, destinationCreatorSvc, destinationSvc, formationSvc)
+
+			rotator := destinationcertificate.NewRotator(transact, certificateRepo, assignmentRepo, destinationCreatorSvc, destinationSvc, formationSvc, nil, renewBeforeExpiry, rotationTimeout)
+
+			// WHEN
+			completed, err := rotator.CompleteRotations(context.TODO())
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.ExpectedCompleted, completed)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package entitytype
 
 import (
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
@@ -134,3 +136,53 @@ func (c *converter) FromEntity(entity *Entity) *model.EntityType {
 	}
 	return output
 }
+
+// ToGraphQL converts the provided service-layer representation of an Entity Type to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.EntityType) (*graphql.EntityType, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var labels graphql.Labels
+	if in.Labels != nil {
+		if err := json.Unmarshal(in.Labels, &labels); err != nil {
+			return nil, err
+		}
+	}
+
+	var partOfProducts []string
+	if in.PartOfProducts != nil {
+		if err := json.Unmarshal(in.PartOfProducts, &partOfProducts); err != nil {
+			return nil, err
+		}
+	}
+
+	return &graphql.EntityType{
+		ID:                  in.ID,
+		OrdID:               in.OrdID,
+		LocalID:             in.LocalTenantID,
+		CorrelationIDs:      graphql.JSONPtrFromRawMessage(in.CorrelationIDs),
+		Level:               in.Level,
+		Title:               in.Title,
+		ShortDescription:    in.ShortDescription,
+		Description:         in.Description,
+		SystemInstanceAware: in.SystemInstanceAware,
+		ChangeLogEntries:    graphql.JSONPtrFromRawMessage(in.ChangeLogEntries),
+		PartOfPackage:       in.PackageID,
+		Visibility:          in.Visibility,
+		Links:               graphql.JSONPtrFromRawMessage(in.Links),
+		PartOfProducts:      partOfProducts,
+		LastUpdate:          in.LastUpdate,
+		PolicyLevel:         in.PolicyLevel,
+		CustomPolicyLevel:   in.CustomPolicyLevel,
+		ReleaseStatus:       in.ReleaseStatus,
+		SunsetDate:          in.SunsetDate,
+		DeprecationDate:     in.DeprecationDate,
+		Successors:          graphql.JSONPtrFromRawMessage(in.Successors),
+		Extensible:          graphql.JSONPtrFromRawMessage(in.Extensible),
+		Tags:                graphql.JSONPtrFromRawMessage(in.Tags),
+		Labels:              labels,
+		DocumentationLabels: graphql.JSONPtrFromRawMessage(in.DocumentationLabels),
+		Version:             c.vc.ToGraphQL(in.Version),
+	}, nil
+}
@@ -1,10 +1,13 @@
 package entitytype_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,3 +50,63 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Nil(t, entityTypeModel)
 	})
 }
+
+func TestEntityConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		in := &model.EntityType{
+			BaseEntity:     &model.BaseEntity{ID: "id"},
+			OrdID:          "ordID",
+			Title:          "title",
+			Tags:           json.RawMessage(`["tag"]`),
+			Labels:         json.RawMessage(`{"key":["value"]}`),
+			Version:        &model.Version{Value: "1.0.0"},
+			PartOfProducts: json.RawMessage(`["sap:product:S4HANA_OD:"]`),
+		}
+		tags := graphql.JSON(`["tag"]`)
+		expected := &graphql.EntityType{
+			ID:             "id",
+			OrdID:          "ordID",
+			Title:          "title",
+			Tags:           &tags,
+			Labels:         graphql.Labels{"key": []interface{}{"value"}},
+			Version:        &graphql.Version{Value: "1.0.0"},
+			PartOfProducts: []string{"sap:product:S4HANA_OD:"},
+		}
+		conv := entitytype.NewConverter(version.NewConverter())
+
+		// WHEN
+		result, err := conv.ToGraphQL(in)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Returns nil if entity type model is nil", func(t *testing.T) {
+		conv := entitytype.NewConverter(version.NewConverter())
+
+		result, err := conv.ToGraphQL(nil)
+
+		require.NoError(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Returns error when labels are invalid", func(t *testing.T) {
+		conv := entitytype.NewConverter(version.NewConverter())
+
+		result, err := conv.ToGraphQL(&model.EntityType{BaseEntity: &model.BaseEntity{ID: "id"}, Labels: json.RawMessage("invalid")})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Returns error when part of products are invalid", func(t *testing.T) {
+		conv := entitytype.NewConverter(version.NewConverter())
+
+		result, err := conv.ToGraphQL(&model.EntityType{BaseEntity: &model.BaseEntity{ID: "id"}, PartOfProducts: json.RawMessage("invalid")})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 	"github.com/pkg/errors"
 )
 
@@ -16,6 +18,9 @@ const (
 	appTemplateVersionIDColumn = "app_template_version_id"
 	appIDColumn                = "app_id"
 	idColumn                   = "id"
+	visibilityColumn           = "visibility"
+	internalVisibilityScope    = "internal_visibility:read"
+	publicVisibilityValue      = "public"
 )
 
 var (
@@ -51,6 +56,9 @@ type pgRepository struct {
 	creatorGlobal      repo.CreatorGlobal
 	updater            repo.Updater
 	updaterGlobal      repo.UpdaterGlobal
+	unionLister        repo.UnionLister
+	unionListerGlobal  repo.UnionListerGlobal
+	queryBuilder       repo.QueryBuilderGlobal
 }
 
 // NewRepository returns a repository instance
@@ -69,9 +77,91 @@ func NewRepository(conv EntityTypeConverter) *pgRepository {
 		creatorGlobal:      repo.NewCreatorGlobal(resource.EntityType, entityTypeTable, entityTypeColumns),
 		updater:            repo.NewUpdater(entityTypeTable, updatableColumns, []string{idColumn}),
 		updaterGlobal:      repo.NewUpdaterGlobal(resource.EntityType, entityTypeTable, updatableColumns, []string{idColumn}),
+		unionLister:        repo.NewUnionLister(entityTypeTable, entityTypeColumns),
+		unionListerGlobal:  repo.NewUnionListerGlobal(resource.EntityType, entityTypeTable, entityTypeColumns),
+		queryBuilder:       repo.NewQueryBuilderGlobal(resource.EntityType, entityTypeTable, []string{idColumn}),
 	}
 }
 
+// ListByResourceIDs gets a page of entity types for each of the given resource IDs. The resources are either applications or application template versions
+func (r *pgRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.EntityTypePage, error) {
+	conditions, err := r.visibilityConditions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entityTypeCollection := EntityTypeCollection{}
+
+	var counts map[string]int
+	if resourceType == resource.Application {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionLister.List(ctx, resource.EntityType, tenantID, resourceIDs, appIDColumn, pageSize, cursor, orderByColumns, &entityTypeCollection, conditions...)
+	} else {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appTemplateVersionIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionListerGlobal.ListGlobal(ctx, resourceIDs, appTemplateVersionIDColumn, pageSize, cursor, orderByColumns, &entityTypeCollection, conditions...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entityTypesByResourceID := make(map[string][]*model.EntityType, len(resourceIDs))
+	for _, entityType := range entityTypeCollection {
+		entityTypeModel := r.conv.FromEntity(&entityType)
+
+		resourceID := entityType.ApplicationID.String
+		if resourceType != resource.Application {
+			resourceID = entityType.ApplicationTemplateVersionID.String
+		}
+		entityTypesByResourceID[resourceID] = append(entityTypesByResourceID[resourceID], entityTypeModel)
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	entityTypePages := make([]*model.EntityTypePage, 0, len(resourceIDs))
+	for _, resourceID := range resourceIDs {
+		totalCount := counts[resourceID]
+		hasNextPage := false
+		endCursor := ""
+		if totalCount > offset+len(entityTypesByResourceID[resourceID]) {
+			hasNextPage = true
+			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		}
+
+		page := &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		}
+
+		entityTypePages = append(entityTypePages, &model.EntityTypePage{Data: entityTypesByResourceID[resourceID], TotalCount: totalCount, PageInfo: page})
+	}
+
+	return entityTypePages, nil
+}
+
+func (r *pgRepository) visibilityConditions(ctx context.Context) (repo.Conditions, error) {
+	isInternalVisibilityScopePresent, err := scope.Contains(ctx, internalVisibilityScope)
+	if err != nil {
+		log.C(ctx).Infof("No scopes are present in the context meaning the flow is not user-initiated. Processing Entity Types without visibility check...")
+		return nil, nil
+	}
+	if isInternalVisibilityScopePresent {
+		log.C(ctx).Infof("Internal visibility scope is present in the context. Processing Entity Types without visibility check...")
+		return nil, nil
+	}
+
+	log.C(ctx).Infof("No internal visibility scope is present in the context. Processing only public Entity Types")
+	query, args, err := r.queryBuilder.BuildQueryGlobal(false, repo.NewEqualCondition(visibilityColumn, publicVisibilityValue))
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.Conditions{repo.NewInConditionForSubQuery(idColumn, query, args)}, nil
+}
+
 // EntityTypeCollection is an array of Entities
 type EntityTypeCollection []Entity
 
@@ -1,6 +1,7 @@
 package entitytype_test
 
 import (
+	"context"
 	"database/sql/driver"
 	"regexp"
 	"testing"
@@ -11,7 +12,11 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPgRepository_GetForApplication(t *testing.T) {
@@ -450,3 +455,158 @@ func TestPgRepository_UpdateGlobal(t *testing.T) {
 
 	suite.Run(t)
 }
+
+func TestPgRepository_ListByResourceIDs(t *testing.T) {
+	pageSize := 2
+	cursor := ""
+	emptyPageResourceID := "emptyPageResourceID"
+	firstEntityTypeID := "111111111-1111-1111-1111-111111111111"
+	secondEntityTypeID := "222222222-2222-2222-2222-222222222222"
+
+	suiteForApplication := testdb.RepoListPageableTestSuite{
+		Name: "List Entity Types for multiple Applications with paging",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`(SELECT id, ready, created_at, updated_at, deleted_at, error, app_id, app_template_version_id, ord_id, local_tenant_id, correlation_ids, level, title, short_description, description, system_instance_aware, changelog_entries, package_id, visibility, links, part_of_products, last_update, policy_level, custom_policy_level, release_status, sunset_date, deprecation_date, successors, extensible, tags, labels, documentation_labels, resource_hash, version_value, version_deprecated, version_deprecated_since, version_for_removal FROM public.entity_types WHERE (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $1)) AND app_id = $2 ORDER BY app_id ASC, id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, ready, created_at, updated_at, deleted_at, error, app_id, app_template_version_id, ord_id, local_tenant_id, correlation_ids, level, title, short_description, description, system_instance_aware, changelog_entries, package_id, visibility, links, part_of_products, last_update, policy_level, custom_policy_level, release_status, sunset_date, deprecation_date, successors, extensible, tags, labels, documentation_labels, resource_hash, version_value, version_deprecated, version_deprecated_since, version_for_removal FROM public.entity_types WHERE (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $5)) AND app_id = $6 ORDER BY app_id ASC, id ASC LIMIT $7 OFFSET $8)`),
+				Args:     []driver.Value{tenantID, emptyPageResourceID, pageSize, 0, tenantID, appID, pageSize, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRow(firstEntityTypeID)...).AddRow(fixEntityTypeRow(secondEntityTypeID)...)}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT app_id AS id, COUNT(*) AS total_count FROM public.entity_types WHERE (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $1)) AND app_id IN ($2, $3) GROUP BY app_id ORDER BY app_id ASC`),
+				Args:     []driver.Value{tenantID, emptyPageResourceID, appID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow(emptyPageResourceID, 0).AddRow(appID, 2)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: nil,
+				ExpectedDBEntities:    nil,
+				ExpectedPage: &model.EntityTypePage{
+					Data: nil,
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 0,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{fixEntityTypeModel(firstEntityTypeID), fixEntityTypeModel(secondEntityTypeID)},
+				ExpectedDBEntities:    []interface{}{fixEntityTypeEntity(firstEntityTypeID), fixEntityTypeEntity(secondEntityTypeID)},
+				ExpectedPage: &model.EntityTypePage{
+					Data: []*model.EntityType{fixEntityTypeModel(firstEntityTypeID), fixEntityTypeModel(secondEntityTypeID)},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityTypeConverter{}
+		},
+		RepoConstructorFunc:       entitytype.NewRepository,
+		MethodName:                "ListByResourceIDs",
+		MethodArgs:                []interface{}{tenantID, resource.Application, []string{emptyPageResourceID, appID}, pageSize, cursor},
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForApplicationTemplateVersion := testdb.RepoListPageableTestSuite{
+		Name: "List Entity Types for multiple Application Template Versions with paging",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`(SELECT id, ready, created_at, updated_at, deleted_at, error, app_id, app_template_version_id, ord_id, local_tenant_id, correlation_ids, level, title, short_description, description, system_instance_aware, changelog_entries, package_id, visibility, links, part_of_products, last_update, policy_level, custom_policy_level, release_status, sunset_date, deprecation_date, successors, extensible, tags, labels, documentation_labels, resource_hash, version_value, version_deprecated, version_deprecated_since, version_for_removal FROM public.entity_types WHERE app_template_version_id = $1 ORDER BY app_template_version_id ASC, id ASC LIMIT $2 OFFSET $3) UNION (SELECT id, ready, created_at, updated_at, deleted_at, error, app_id, app_template_version_id, ord_id, local_tenant_id, correlation_ids, level, title, short_description, description, system_instance_aware, changelog_entries, package_id, visibility, links, part_of_products, last_update, policy_level, custom_policy_level, release_status, sunset_date, deprecation_date, successors, extensible, tags, labels, documentation_labels, resource_hash, version_value, version_deprecated, version_deprecated_since, version_for_removal FROM public.entity_types WHERE app_template_version_id = $4 ORDER BY app_template_version_id ASC, id ASC LIMIT $5 OFFSET $6)`),
+				Args:     []driver.Value{emptyPageResourceID, pageSize, 0, appTemplateVersionID, pageSize, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRow(firstEntityTypeID)...).AddRow(fixEntityTypeRow(secondEntityTypeID)...)}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT app_template_version_id AS id, COUNT(*) AS total_count FROM public.entity_types WHERE app_template_version_id IN ($1, $2) GROUP BY app_template_version_id ORDER BY app_template_version_id ASC`),
+				Args:     []driver.Value{emptyPageResourceID, appTemplateVersionID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow(emptyPageResourceID, 0).AddRow(appTemplateVersionID, 2)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: nil,
+				ExpectedDBEntities:    nil,
+				ExpectedPage: &model.EntityTypePage{
+					Data: nil,
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 0,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{fixEntityTypeModel(firstEntityTypeID), fixEntityTypeModel(secondEntityTypeID)},
+				ExpectedDBEntities:    []interface{}{fixEntityTypeEntity(firstEntityTypeID), fixEntityTypeEntity(secondEntityTypeID)},
+				ExpectedPage: &model.EntityTypePage{
+					Data: []*model.EntityType{fixEntityTypeModel(firstEntityTypeID), fixEntityTypeModel(secondEntityTypeID)},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityTypeConverter{}
+		},
+		RepoConstructorFunc:       entitytype.NewRepository,
+		MethodName:                "ListByResourceIDs",
+		MethodArgs:                []interface{}{tenantID, resource.ApplicationTemplateVersion, []string{emptyPageResourceID, appTemplateVersionID}, pageSize, cursor},
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForApplication.Run(t)
+	suiteForApplicationTemplateVersion.Run(t)
+
+	t.Run("ListByResourceIDs returns only public entity types when the internal visibility scope is missing", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`(SELECT id, ready, created_at, updated_at, deleted_at, error, app_id, app_template_version_id, ord_id, local_tenant_id, correlation_ids, level, title, short_description, description, system_instance_aware, changelog_entries, package_id, visibility, links, part_of_products, last_update, policy_level, custom_policy_level, release_status, sunset_date, deprecation_date, successors, extensible, tags, labels, documentation_labels, resource_hash, version_value, version_deprecated, version_deprecated_since, version_for_removal FROM public.entity_types WHERE id IN (SELECT id FROM public.entity_types WHERE visibility = $1) AND (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $2)) AND app_id = $3 ORDER BY app_id ASC, id ASC LIMIT $4 OFFSET $5)`)).
+			WithArgs("public", tenantID, appID, pageSize, 0).
+			WillReturnRows(sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRow(firstEntityTypeID)...))
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_id AS id, COUNT(*) AS total_count FROM public.entity_types WHERE id IN (SELECT id FROM public.entity_types WHERE visibility = $1) AND (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $2)) AND app_id IN ($3) GROUP BY app_id ORDER BY app_id ASC`)).
+			WithArgs("public", tenantID, appID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(appID, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		ctx = scope.SaveToContext(ctx, []string{"test:test"})
+
+		convMock := &automock.EntityTypeConverter{}
+		convMock.On("FromEntity", fixEntityTypeEntity(firstEntityTypeID)).Return(fixEntityTypeModel(firstEntityTypeID)).Once()
+		pgRepository := entitytype.NewRepository(convMock)
+
+		// WHEN
+		pages, err := pgRepository.ListByResourceIDs(ctx, tenantID, resource.Application, []string{appID}, pageSize, cursor)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, pages, 1)
+		assert.Equal(t, []*model.EntityType{fixEntityTypeModel(firstEntityTypeID)}, pages[0].Data)
+		assert.Equal(t, 1, pages[0].TotalCount)
+
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
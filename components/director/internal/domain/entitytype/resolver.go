@@ -0,0 +1,80 @@
+package entitytype
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpage"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// EntityTypeService is responsible for the service-layer Entity Type operations.
+//
+//go:generate mockery --name=EntityTypeService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityTypeService interface {
+	ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.EntityTypePage, error)
+}
+
+// GraphQLConverter converts Entity Types from the model.EntityType service-layer representation to the graphql-layer representation.
+//
+//go:generate mockery --name=GraphQLConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLConverter interface {
+	ToGraphQL(in *model.EntityType) (*graphql.EntityType, error)
+}
+
+// Resolver is an object responsible for resolver-layer Entity Type operations.
+type Resolver struct {
+	pages *ordpage.Resolver[*model.EntityTypePage, *graphql.EntityTypePage]
+}
+
+// NewResolver returns a new object responsible for resolver-layer Entity Type operations.
+func NewResolver(transact persistence.Transactioner, entityTypeSvc EntityTypeService, entityTypeConverter GraphQLConverter, appTemplateVersionSvc ordpage.ApplicationTemplateVersionService) *Resolver {
+	toGraphQLPage := func(page *model.EntityTypePage) (*graphql.EntityTypePage, error) {
+		data, err := ordpage.ConvertData(page.Data, entityTypeConverter.ToGraphQL)
+		if err != nil {
+			return nil, err
+		}
+
+		return &graphql.EntityTypePage{
+			Data:       data,
+			TotalCount: page.TotalCount,
+			PageInfo:   ordpage.ToGraphQLPageInfo(page.PageInfo),
+		}, nil
+	}
+	emptyPage := func() *graphql.EntityTypePage {
+		return &graphql.EntityTypePage{Data: []*graphql.EntityType{}, PageInfo: &graphql.PageInfo{}}
+	}
+
+	return &Resolver{
+		pages: ordpage.NewResolver(transact, appTemplateVersionSvc, entityTypeSvc.ListByResourceIDs, toGraphQLPage, emptyPage),
+	}
+}
+
+// ApplicationEntityTypes fetches a page of entity types for an Application
+func (r *Resolver) ApplicationEntityTypes(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.EntityTypePage, error) {
+	param := dataloader.ParamEntityType{ID: obj.ID, Ctx: ctx, First: first, After: after}
+	return dataloader.EntityTypeFor(ctx).EntityTypeByID.Load(param)
+}
+
+// ApplicationEntityTypesDataLoader retrieves a page of entity types for each Application ID in the keys argument
+func (r *Resolver) ApplicationEntityTypesDataLoader(keys []dataloader.ParamEntityType) ([]*graphql.EntityTypePage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	return r.pages.ApplicationPages(keys[0].Ctx, applicationIDs, keys[0].First, keys[0].After)
+}
+
+// ApplicationTemplateEntityTypes fetches a page of entity types of the latest version of an Application Template
+func (r *Resolver) ApplicationTemplateEntityTypes(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.EntityTypePage, error) {
+	return r.pages.ApplicationTemplatePage(ctx, obj.ID, first, after)
+}
@@ -0,0 +1,112 @@
+package entitytype_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype/automock"
+	ordpageautomock "github.com/kyma-incubator/compass/components/director/internal/domain/ordpage/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// The paging, transaction handling and error cases are covered by the tests of the ordpage package
+func TestResolver_ApplicationEntityTypesDataLoader(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	firstAppID := "appID"
+	secondAppID := "appID2"
+	first := 2
+	after := graphql.PageCursor("test")
+
+	entityTypeFirstApp := &model.EntityType{ApplicationID: &firstAppID}
+	entityTypeSecondApp := &model.EntityType{ApplicationID: &secondAppID}
+	gqlEntityTypeFirstApp := &graphql.EntityType{ID: "entityTypeID"}
+	gqlEntityTypeSecondApp := &graphql.EntityType{ID: "entityTypeID2"}
+
+	pageInfo := &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: true}
+	gqlPageInfo := &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: true}
+
+	persist, transact := txGen.ThatSucceeds()
+
+	svc := &automock.EntityTypeService{}
+	svc.On("ListByResourceIDs", txtest.CtxWithDBMatcher(), resource.Application, []string{firstAppID, secondAppID}, first, string(after)).Return([]*model.EntityTypePage{
+		{Data: []*model.EntityType{entityTypeFirstApp}, PageInfo: pageInfo, TotalCount: 1},
+		{Data: []*model.EntityType{entityTypeSecondApp}, PageInfo: pageInfo, TotalCount: 1},
+	}, nil).Once()
+
+	conv := &automock.GraphQLConverter{}
+	conv.On("ToGraphQL", entityTypeFirstApp).Return(gqlEntityTypeFirstApp, nil).Once()
+	conv.On("ToGraphQL", entityTypeSecondApp).Return(gqlEntityTypeSecondApp, nil).Once()
+
+	appTemplateVersionSvc := &ordpageautomock.ApplicationTemplateVersionService{}
+	defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv, appTemplateVersionSvc)
+
+	resolver := entitytype.NewResolver(transact, svc, conv, appTemplateVersionSvc)
+	keys := []dataloader.ParamEntityType{
+		{ID: firstAppID, Ctx: context.TODO(), First: &first, After: &after},
+		{ID: secondAppID, Ctx: context.TODO(), First: &first, After: &after},
+	}
+
+	// WHEN
+	result, errs := resolver.ApplicationEntityTypesDataLoader(keys)
+
+	// THEN
+	require.Nil(t, errs)
+	assert.Equal(t, []*graphql.EntityTypePage{
+		{Data: []*graphql.EntityType{gqlEntityTypeFirstApp}, PageInfo: gqlPageInfo, TotalCount: 1},
+		{Data: []*graphql.EntityType{gqlEntityTypeSecondApp}, PageInfo: gqlPageInfo, TotalCount: 1},
+	}, result)
+
+	t.Run("Returns error when there are no Applications", func(t *testing.T) {
+		result, errs := entitytype.NewResolver(nil, svc, conv, nil).ApplicationEntityTypesDataLoader([]dataloader.ParamEntityType{})
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Applications found")
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_ApplicationTemplateEntityTypes(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	appTemplateID := "appTemplateID"
+	versionID := "versionID"
+	first := 2
+
+	entityTypeOfVersion := &model.EntityType{ApplicationTemplateVersionID: &versionID}
+	gqlEntityTypeOfVersion := &graphql.EntityType{ID: "entityTypeID"}
+
+	persist, transact := txGen.ThatSucceeds()
+
+	appTemplateVersionSvc := &ordpageautomock.ApplicationTemplateVersionService{}
+	appTemplateVersionSvc.On("GetLatestByAppTemplateID", txtest.CtxWithDBMatcher(), appTemplateID).Return(&model.ApplicationTemplateVersion{ID: versionID, ApplicationTemplateID: appTemplateID}, nil).Once()
+
+	svc := &automock.EntityTypeService{}
+	svc.On("ListByResourceIDs", txtest.CtxWithDBMatcher(), resource.ApplicationTemplateVersion, []string{versionID}, first, "").Return([]*model.EntityTypePage{
+		{Data: []*model.EntityType{entityTypeOfVersion}, PageInfo: &pagination.Page{}, TotalCount: 1},
+	}, nil).Once()
+
+	conv := &automock.GraphQLConverter{}
+	conv.On("ToGraphQL", entityTypeOfVersion).Return(gqlEntityTypeOfVersion, nil).Once()
+	defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv, appTemplateVersionSvc)
+
+	resolver := entitytype.NewResolver(transact, svc, conv, appTemplateVersionSvc)
+
+	// WHEN
+	result, err := resolver.ApplicationTemplateEntityTypes(context.TODO(), &graphql.ApplicationTemplate{ID: appTemplateID}, &first, nil)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, &graphql.EntityTypePage{Data: []*graphql.EntityType{gqlEntityTypeOfVersion}, PageInfo: &graphql.PageInfo{}, TotalCount: 1}, result)
+}
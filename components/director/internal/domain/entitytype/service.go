@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pkg/errors"
 )
@@ -25,6 +26,7 @@ type EntityTypeRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.EntityType, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.EntityType, error)
 	ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*model.EntityType, error)
+	ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.EntityTypePage, error)
 }
 
 // UIDService missing godoc
@@ -133,6 +135,24 @@ func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, appTem
 	return s.entityTypeRepo.ListByResourceID(ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion)
 }
 
+// ListByResourceIDs lists a page of entity types for each of the given application or application template version IDs
+func (s *service) ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.EntityTypePage, error) {
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	if resourceType.IsTenantIgnorable() {
+		return s.entityTypeRepo.ListByResourceIDs(ctx, "", resourceType, resourceIDs, pageSize, cursor)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.entityTypeRepo.ListByResourceIDs(ctx, tnt, resourceType, resourceIDs, pageSize, cursor)
+}
+
 func (s *service) createEntityType(ctx context.Context, entityType *model.EntityType, resourceType resource.Type) error {
 	if resourceType.IsTenantIgnorable() {
 		return s.entityTypeRepo.CreateGlobal(ctx, entityType)
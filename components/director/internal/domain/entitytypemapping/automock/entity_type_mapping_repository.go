@@ -125,6 +125,32 @@ func (_m *EntityTypeMappingRepository) ListByResourceID(ctx context.Context, ten
 	return r0, r1
 }
 
+// ListByResourceIDs provides a mock function with given fields: ctx, tenantID, resourceIDs, resourceType
+func (_m *EntityTypeMappingRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceIDs []string, resourceType resource.Type) ([]*model.EntityTypeMapping, error) {
+	ret := _m.Called(ctx, tenantID, resourceIDs, resourceType)
+
+	var r0 []*model.EntityTypeMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, resource.Type) ([]*model.EntityTypeMapping, error)); ok {
+		return rf(ctx, tenantID, resourceIDs, resourceType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, resource.Type) []*model.EntityTypeMapping); ok {
+		r0 = rf(ctx, tenantID, resourceIDs, resourceType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.EntityTypeMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, resource.Type) error); ok {
+		r1 = rf(ctx, tenantID, resourceIDs, resourceType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewEntityTypeMappingRepository creates a new instance of EntityTypeMappingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEntityTypeMappingRepository(t interface {
@@ -1,9 +1,13 @@
 package entitytypemapping
 
 import (
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
 )
 
 type converter struct {
@@ -60,3 +64,45 @@ func (c *converter) FromEntity(entity *Entity) *model.EntityTypeMapping {
 	}
 	return output
 }
+
+// ToGraphQL converts the internal model to its graphql representation
+func (c *converter) ToGraphQL(in *model.EntityTypeMapping) (*graphql.EntityTypeMapping, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var apiModelSelectors []*model.APIModelSelector
+	if in.APIModelSelectors != nil {
+		if err := json.Unmarshal(in.APIModelSelectors, &apiModelSelectors); err != nil {
+			return nil, errors.Wrapf(err, "while unmarshalling the API model selectors of Entity Type Mapping with ID %s", in.ID)
+		}
+	}
+
+	var entityTypeTargets []*model.EntityTypeTarget
+	if in.EntityTypeTargets != nil {
+		if err := json.Unmarshal(in.EntityTypeTargets, &entityTypeTargets); err != nil {
+			return nil, errors.Wrapf(err, "while unmarshalling the entity type targets of Entity Type Mapping with ID %s", in.ID)
+		}
+	}
+
+	output := &graphql.EntityTypeMapping{
+		ID: in.ID,
+	}
+
+	for _, selector := range apiModelSelectors {
+		output.APIModelSelectors = append(output.APIModelSelectors, &graphql.APIModelSelector{
+			Type:          selector.Type,
+			EntitySetName: selector.EntitySetName,
+			JSONPointer:   selector.JSONPointer,
+		})
+	}
+
+	for _, target := range entityTypeTargets {
+		output.EntityTypeTargets = append(output.EntityTypeTargets, &graphql.EntityTypeTarget{
+			OrdID:         target.OrdID,
+			CorrelationID: target.CorrelationID,
+		})
+	}
+
+	return output, nil
+}
@@ -1,9 +1,13 @@
 package entitytypemapping_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytypemapping"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -46,3 +50,51 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Nil(t, entityTypeModel)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := entitytypemapping.NewConverter()
+
+		gqlEntityTypeMapping, err := conv.ToGraphQL(fixEntityTypeMappingModel(entityTypeMappingID))
+
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.EntityTypeMapping{
+			ID: entityTypeMappingID,
+			APIModelSelectors: []*graphql.APIModelSelector{
+				{Type: "odata", EntitySetName: str.Ptr("A_OperationalAcctgDocItemCube")},
+			},
+			EntityTypeTargets: []*graphql.EntityTypeTarget{
+				{OrdID: str.Ptr("sap.odm:entityType:WorkforcePerson:v1")},
+				{CorrelationID: str.Ptr("sap.s4:csnEntity:WorkForcePersonView_v1")},
+				{CorrelationID: str.Ptr("sap.s4:csnEntity:sap.odm.JobDetails_v1")},
+			},
+		}, gqlEntityTypeMapping)
+	})
+
+	t.Run("Returns nil if entity type mapping model is nil", func(t *testing.T) {
+		conv := entitytypemapping.NewConverter()
+
+		gqlEntityTypeMapping, err := conv.ToGraphQL(nil)
+
+		require.NoError(t, err)
+		require.Nil(t, gqlEntityTypeMapping)
+	})
+
+	t.Run("Returns error when API model selectors are invalid", func(t *testing.T) {
+		conv := entitytypemapping.NewConverter()
+
+		gqlEntityTypeMapping, err := conv.ToGraphQL(&model.EntityTypeMapping{BaseEntity: &model.BaseEntity{ID: entityTypeMappingID}, APIModelSelectors: json.RawMessage("invalid")})
+
+		require.Error(t, err)
+		require.Nil(t, gqlEntityTypeMapping)
+	})
+
+	t.Run("Returns error when entity type targets are invalid", func(t *testing.T) {
+		conv := entitytypemapping.NewConverter()
+
+		gqlEntityTypeMapping, err := conv.ToGraphQL(&model.EntityTypeMapping{BaseEntity: &model.BaseEntity{ID: entityTypeMappingID}, EntityTypeTargets: json.RawMessage("invalid")})
+
+		require.Error(t, err)
+		require.Nil(t, gqlEntityTypeMapping)
+	})
+}
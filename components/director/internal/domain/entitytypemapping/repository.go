@@ -132,3 +132,31 @@ func (r *pgRepository) ListByResourceID(ctx context.Context, tenantID, resourceI
 	}
 	return entityTypeMappings, nil
 }
+
+// ListByResourceIDs lists the EntityTypeMappings of all resources with the given type and IDs
+func (r *pgRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceIDs []string, resourceType resource.Type) ([]*model.EntityTypeMapping, error) {
+	if len(resourceIDs) == 0 {
+		return nil, nil
+	}
+
+	var column string
+	switch resourceType {
+	case resource.API:
+		column = apiDefinitionIDColumn
+	case resource.EventDefinition:
+		column = eventDefinitionIDColumn
+	default:
+		return nil, errors.Errorf("unsupported resource type: %s", resourceType)
+	}
+
+	entityTypeMappingCollection := EntityTypeMappingCollection{}
+	if err := r.lister.List(ctx, resource.EntityTypeMapping, tenantID, &entityTypeMappingCollection, repo.NewInConditionForStringValues(column, resourceIDs)); err != nil {
+		return nil, err
+	}
+
+	entityTypeMappings := make([]*model.EntityTypeMapping, 0, entityTypeMappingCollection.Len())
+	for _, entityTypeMappingEnt := range entityTypeMappingCollection {
+		entityTypeMappings = append(entityTypeMappings, r.conv.FromEntity(&entityTypeMappingEnt))
+	}
+	return entityTypeMappings, nil
+}
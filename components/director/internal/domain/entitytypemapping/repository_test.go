@@ -115,6 +115,71 @@ func TestPgRepository_ListByResourceID(t *testing.T) {
 	suiteForEvent.Run(t)
 }
 
+func TestPgRepository_ListByResourceIDs(t *testing.T) {
+	firstEntityTypeMappingID := "111111111-1111-1111-1111-111111111111"
+	firstEntityTypeModel := fixEntityTypeMappingModel(firstEntityTypeMappingID)
+	firstEntityTypeEntity := fixEntityTypeMappingEntity(firstEntityTypeMappingID)
+	secondEntityTypeMappingID := "222222222-2222-2222-2222-222222222222"
+	secondEntityTypeModel := fixEntityTypeMappingModel(secondEntityTypeMappingID)
+	secondEntityTypeEntity := fixEntityTypeMappingEntity(secondEntityTypeMappingID)
+	secondAPIDefinitionID := "secondAPIDefinitionID"
+
+	suiteForAPI := testdb.RepoListTestSuite{
+		Name: "List EntityTypeMappings for multiple APIs",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, ready, created_at, updated_at, deleted_at, error, api_definition_id, event_definition_id, api_model_selectors, entity_type_targets FROM public.entity_type_mappings WHERE api_definition_id IN ($1, $2) AND (id IN (SELECT id FROM entity_type_mappings_tenants WHERE tenant_id = $3))`),
+				Args:     []driver.Value{testAPIDefinitionID, secondAPIDefinitionID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeMappingColumns()).AddRow(fixEntityTypeMappingRow(firstEntityTypeMappingID)...).AddRow(fixEntityTypeMappingRow(secondEntityTypeMappingID)...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeMappingColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityTypeMappingConverter{}
+		},
+		RepoConstructorFunc:       entitytypemapping.NewRepository,
+		ExpectedModelEntities:     []interface{}{firstEntityTypeModel, secondEntityTypeModel},
+		ExpectedDBEntities:        []interface{}{firstEntityTypeEntity, secondEntityTypeEntity},
+		MethodArgs:                []interface{}{tenantID, []string{testAPIDefinitionID, secondAPIDefinitionID}, resource.API},
+		MethodName:                "ListByResourceIDs",
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForEvent := testdb.RepoListTestSuite{
+		Name: "List EntityTypeMappings for multiple Events",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, ready, created_at, updated_at, deleted_at, error, api_definition_id, event_definition_id, api_model_selectors, entity_type_targets FROM public.entity_type_mappings WHERE event_definition_id IN ($1) AND (id IN (SELECT id FROM entity_type_mappings_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{testEventDefinitionID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeMappingColumns()).AddRow(fixEntityTypeMappingRow(firstEntityTypeMappingID)...).AddRow(fixEntityTypeMappingRow(secondEntityTypeMappingID)...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeMappingColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityTypeMappingConverter{}
+		},
+		RepoConstructorFunc:       entitytypemapping.NewRepository,
+		ExpectedModelEntities:     []interface{}{firstEntityTypeModel, secondEntityTypeModel},
+		ExpectedDBEntities:        []interface{}{firstEntityTypeEntity, secondEntityTypeEntity},
+		MethodArgs:                []interface{}{tenantID, []string{testEventDefinitionID}, resource.EventDefinition},
+		MethodName:                "ListByResourceIDs",
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForAPI.Run(t)
+	suiteForEvent.Run(t)
+}
+
 func TestPgRepository_CreateEntityTypeMappingInAPI(t *testing.T) {
 	// GIVEN
 	var nilEntityTypeMappingModel *model.EntityTypeMapping
@@ -0,0 +1,120 @@
+package entitytypemapping
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// EntityTypeMappingService is responsible for the service-layer Entity Type Mapping read operations
+//
+//go:generate mockery --name=EntityTypeMappingService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityTypeMappingService interface {
+	ListByOwnerResourceIDs(ctx context.Context, resourceIDs []string, resourceType resource.Type) ([][]*model.EntityTypeMapping, error)
+}
+
+// GraphQLConverter converts Entity Type Mappings to their graphql representation
+//
+//go:generate mockery --name=GraphQLConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLConverter interface {
+	ToGraphQL(in *model.EntityTypeMapping) (*graphql.EntityTypeMapping, error)
+}
+
+// Resolver is the Entity Type Mapping resolver
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      EntityTypeMappingService
+	conv     GraphQLConverter
+}
+
+// NewResolver creates a new Entity Type Mapping resolver
+func NewResolver(transact persistence.Transactioner, svc EntityTypeMappingService, conv GraphQLConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// APIDefinitionEntityTypeMappings returns the entity type mappings of the API definition
+func (r *Resolver) APIDefinitionEntityTypeMappings(ctx context.Context, obj *graphql.APIDefinition) ([]*graphql.EntityTypeMapping, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("API Definition cannot be empty")
+	}
+
+	params := dataloader.ParamEntityTypeMapping{ID: obj.ID, Ctx: ctx}
+	return dataloader.APIEntityTypeMappingFor(ctx).EntityTypeMappingByParentID.Load(params)
+}
+
+// APIDefinitionEntityTypeMappingsDataLoader retrieves the entity type mappings for each API definition ID in the keys
+func (r *Resolver) APIDefinitionEntityTypeMappingsDataLoader(keys []dataloader.ParamEntityTypeMapping) ([][]*graphql.EntityTypeMapping, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No API Definitions found")}
+	}
+
+	return r.listForKeys(keys, resource.API)
+}
+
+// EventDefinitionEntityTypeMappings returns the entity type mappings of the event definition
+func (r *Resolver) EventDefinitionEntityTypeMappings(ctx context.Context, obj *graphql.EventDefinition) ([]*graphql.EntityTypeMapping, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Event Definition cannot be empty")
+	}
+
+	params := dataloader.ParamEntityTypeMapping{ID: obj.ID, Ctx: ctx}
+	return dataloader.EventEntityTypeMappingFor(ctx).EntityTypeMappingByParentID.Load(params)
+}
+
+// EventDefinitionEntityTypeMappingsDataLoader retrieves the entity type mappings for each event definition ID in the keys
+func (r *Resolver) EventDefinitionEntityTypeMappingsDataLoader(keys []dataloader.ParamEntityTypeMapping) ([][]*graphql.EntityTypeMapping, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Event Definitions found")}
+	}
+
+	return r.listForKeys(keys, resource.EventDefinition)
+}
+
+func (r *Resolver) listForKeys(keys []dataloader.ParamEntityTypeMapping, resourceType resource.Type) ([][]*graphql.EntityTypeMapping, []error) {
+	ctx := keys[0].Ctx
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	entityTypeMappingsPerID, err := r.svc.ListByOwnerResourceIDs(ctx, ids, resourceType)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	gqlEntityTypeMappings := make([][]*graphql.EntityTypeMapping, 0, len(entityTypeMappingsPerID))
+	for _, entityTypeMappings := range entityTypeMappingsPerID {
+		gqlMappings := make([]*graphql.EntityTypeMapping, 0, len(entityTypeMappings))
+		for _, entityTypeMapping := range entityTypeMappings {
+			gqlMapping, err := r.conv.ToGraphQL(entityTypeMapping)
+			if err != nil {
+				return nil, []error{err}
+			}
+			gqlMappings = append(gqlMappings, gqlMapping)
+		}
+		gqlEntityTypeMappings = append(gqlEntityTypeMappings, gqlMappings)
+	}
+
+	return gqlEntityTypeMappings, nil
+}
@@ -0,0 +1,213 @@
+package entitytypemapping_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytypemapping"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytypemapping/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_APIDefinitionEntityTypeMappings(t *testing.T) {
+	t.Run("Error when the API definition is nil", func(t *testing.T) {
+		resolver := entitytypemapping.NewResolver(nil, nil, nil)
+
+		// WHEN
+		result, err := resolver.APIDefinitionEntityTypeMappings(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "API Definition cannot be empty")
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_APIDefinitionEntityTypeMappingsDataLoader(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(errTest)
+
+	secondAPIDefinitionID := "secondAPIDefinitionID"
+	entityTypeMapping := fixEntityTypeMappingModel(entityTypeMappingID)
+	gqlEntityTypeMapping := &graphql.EntityTypeMapping{ID: entityTypeMappingID}
+	keys := []dataloader.ParamEntityTypeMapping{{ID: testAPIDefinitionID, Ctx: context.TODO()}, {ID: secondAPIDefinitionID, Ctx: context.TODO()}}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.EntityTypeMappingService
+		ConverterFn    func() *automock.GraphQLConverter
+		Keys           []dataloader.ParamEntityTypeMapping
+		ExpectedOutput [][]*graphql.EntityTypeMapping
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.EntityTypeMappingService {
+				svc := &automock.EntityTypeMappingService{}
+				svc.On("ListByOwnerResourceIDs", txtest.CtxWithDBMatcher(), []string{testAPIDefinitionID, secondAPIDefinitionID}, resource.API).Return([][]*model.EntityTypeMapping{{entityTypeMapping}, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("ToGraphQL", entityTypeMapping).Return(gqlEntityTypeMapping, nil).Once()
+				return conv
+			},
+			Keys:           keys,
+			ExpectedOutput: [][]*graphql.EntityTypeMapping{{gqlEntityTypeMapping}, {}},
+		},
+		{
+			Name: "Error when converting entity type mapping fails",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.EntityTypeMappingService {
+				svc := &automock.EntityTypeMappingService{}
+				svc.On("ListByOwnerResourceIDs", txtest.CtxWithDBMatcher(), []string{testAPIDefinitionID, secondAPIDefinitionID}, resource.API).Return([][]*model.EntityTypeMapping{{entityTypeMapping}, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("ToGraphQL", entityTypeMapping).Return(nil, errTest).Once()
+				return conv
+			},
+			Keys:          keys,
+			ExpectedError: errTest.Error(),
+		},
+		{
+			Name: "Error when listing entity type mappings fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.EntityTypeMappingService {
+				svc := &automock.EntityTypeMappingService{}
+				svc.On("ListByOwnerResourceIDs", txtest.CtxWithDBMatcher(), []string{testAPIDefinitionID, secondAPIDefinitionID}, resource.API).Return(nil, errTest).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			Keys:          keys,
+			ExpectedError: errTest.Error(),
+		},
+		{
+			Name:          "Error when the transaction fails to begin",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ServiceFn:     func() *automock.EntityTypeMappingService { return &automock.EntityTypeMappingService{} },
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			Keys:          keys,
+			ExpectedError: errTest.Error(),
+		},
+		{
+			Name:          "Error when there are no keys",
+			TxFn:          txGen.ThatDoesntStartTransaction,
+			ServiceFn:     func() *automock.EntityTypeMappingService { return &automock.EntityTypeMappingService{} },
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			ExpectedError: "No API Definitions found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+			resolver := entitytypemapping.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, errs := resolver.APIDefinitionEntityTypeMappingsDataLoader(testCase.Keys)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedError)
+			} else {
+				require.Empty(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_EventDefinitionEntityTypeMappings(t *testing.T) {
+	t.Run("Error when the event definition is nil", func(t *testing.T) {
+		resolver := entitytypemapping.NewResolver(nil, nil, nil)
+
+		// WHEN
+		result, err := resolver.EventDefinitionEntityTypeMappings(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Event Definition cannot be empty")
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_EventDefinitionEntityTypeMappingsDataLoader(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(errTest)
+
+	entityTypeMapping := fixEntityTypeMappingModel(entityTypeMappingID)
+	gqlEntityTypeMapping := &graphql.EntityTypeMapping{ID: entityTypeMappingID}
+	keys := []dataloader.ParamEntityTypeMapping{{ID: testEventDefinitionID, Ctx: context.TODO()}}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.EntityTypeMappingService
+		ConverterFn    func() *automock.GraphQLConverter
+		Keys           []dataloader.ParamEntityTypeMapping
+		ExpectedOutput [][]*graphql.EntityTypeMapping
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.EntityTypeMappingService {
+				svc := &automock.EntityTypeMappingService{}
+				svc.On("ListByOwnerResourceIDs", txtest.CtxWithDBMatcher(), []string{testEventDefinitionID}, resource.EventDefinition).Return([][]*model.EntityTypeMapping{{entityTypeMapping}}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.GraphQLConverter {
+				conv := &automock.GraphQLConverter{}
+				conv.On("ToGraphQL", entityTypeMapping).Return(gqlEntityTypeMapping, nil).Once()
+				return conv
+			},
+			Keys:           keys,
+			ExpectedOutput: [][]*graphql.EntityTypeMapping{{gqlEntityTypeMapping}},
+		},
+		{
+			Name:          "Error when there are no keys",
+			TxFn:          txGen.ThatDoesntStartTransaction,
+			ServiceFn:     func() *automock.EntityTypeMappingService { return &automock.EntityTypeMappingService{} },
+			ConverterFn:   func() *automock.GraphQLConverter { return &automock.GraphQLConverter{} },
+			ExpectedError: "No Event Definitions found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+			resolver := entitytypemapping.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, errs := resolver.EventDefinitionEntityTypeMappingsDataLoader(testCase.Keys)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedError)
+			} else {
+				require.Empty(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
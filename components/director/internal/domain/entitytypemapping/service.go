@@ -21,6 +21,7 @@ type EntityTypeMappingRepository interface {
 	DeleteGlobal(ctx context.Context, id string) error
 	GetByID(ctx context.Context, tenant, id string) (*model.EntityTypeMapping, error)
 	ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*model.EntityTypeMapping, error)
+	ListByResourceIDs(ctx context.Context, tenantID string, resourceIDs []string, resourceType resource.Type) ([]*model.EntityTypeMapping, error)
 }
 
 // UIDService missing godoc
@@ -91,6 +92,38 @@ func (s *service) ListByOwnerResourceID(ctx context.Context, resourceID string,
 	return s.entityTypeMappingRepo.ListByResourceID(ctx, tnt, resourceID, resourceType)
 }
 
+// ListByOwnerResourceIDs lists the Entity Type Mappings of each of the given API or event definitions. The result is in the order of the given IDs
+func (s *service) ListByOwnerResourceIDs(ctx context.Context, resourceIDs []string, resourceType resource.Type) ([][]*model.EntityTypeMapping, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entityTypeMappings, err := s.entityTypeMappingRepo.ListByResourceIDs(ctx, tnt, resourceIDs, resourceType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Entity Type Mappings for %s with IDs %v", resourceType, resourceIDs)
+	}
+
+	entityTypeMappingsPerID := make(map[string][]*model.EntityTypeMapping, len(resourceIDs))
+	for _, entityTypeMapping := range entityTypeMappings {
+		ownerID := entityTypeMapping.APIDefinitionID
+		if resourceType == resource.EventDefinition {
+			ownerID = entityTypeMapping.EventDefinitionID
+		}
+		if ownerID == nil {
+			continue
+		}
+		entityTypeMappingsPerID[*ownerID] = append(entityTypeMappingsPerID[*ownerID], entityTypeMapping)
+	}
+
+	result := make([][]*model.EntityTypeMapping, 0, len(resourceIDs))
+	for _, id := range resourceIDs {
+		result = append(result, entityTypeMappingsPerID[id])
+	}
+
+	return result, nil
+}
+
 func (s *service) createEntityTypeMapping(ctx context.Context, entityTypeMapping *model.EntityTypeMapping, resourceType resource.Type) error {
 	if resourceType.IsTenantIgnorable() {
 		return s.entityTypeMappingRepo.CreateGlobal(ctx, entityTypeMapping)
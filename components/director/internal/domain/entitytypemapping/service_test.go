@@ -351,3 +351,88 @@ func TestService_ListByEventDefinitionID(t *testing.T) {
 		})
 	}
 }
+
+func TestService_ListByOwnerResourceIDs(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenantID)
+	secondAPIDefinitionID := "secondAPIDefinitionID"
+	resourceIDs := []string{testAPIDefinitionID, secondAPIDefinitionID}
+
+	firstMapping := fixEntityTypeMappingModel("first-mapping-id")
+	secondMapping := fixEntityTypeMappingModel("second-mapping-id")
+	secondMapping.APIDefinitionID = &secondAPIDefinitionID
+	thirdMapping := fixEntityTypeMappingModel("third-mapping-id")
+
+	testCases := []struct {
+		Name                    string
+		Context                 context.Context
+		ResourceType            resource.Type
+		EntityTypeMappingRepoFn func() *automock.EntityTypeMappingRepository
+		ExpectedOutput          [][]*model.EntityTypeMapping
+		ExpectedError           string
+	}{
+		{
+			Name:         "Success for API definitions",
+			Context:      ctx,
+			ResourceType: resource.API,
+			EntityTypeMappingRepoFn: func() *automock.EntityTypeMappingRepository {
+				entityTypeMappingRepo := &automock.EntityTypeMappingRepository{}
+				entityTypeMappingRepo.On("ListByResourceIDs", ctx, tenantID, resourceIDs, resource.API).Return([]*model.EntityTypeMapping{secondMapping, firstMapping, thirdMapping}, nil).Once()
+				return entityTypeMappingRepo
+			},
+			ExpectedOutput: [][]*model.EntityTypeMapping{{firstMapping, thirdMapping}, {secondMapping}},
+		},
+		{
+			Name:         "Success for event definitions",
+			Context:      ctx,
+			ResourceType: resource.EventDefinition,
+			EntityTypeMappingRepoFn: func() *automock.EntityTypeMappingRepository {
+				entityTypeMappingRepo := &automock.EntityTypeMappingRepository{}
+				entityTypeMappingRepo.On("ListByResourceIDs", ctx, tenantID, resourceIDs, resource.EventDefinition).Return([]*model.EntityTypeMapping{firstMapping}, nil).Once()
+				return entityTypeMappingRepo
+			},
+			ExpectedOutput: [][]*model.EntityTypeMapping{nil, nil},
+		},
+		{
+			Name:         "Returns error when tenant is missing in the context",
+			Context:      context.TODO(),
+			ResourceType: resource.API,
+			EntityTypeMappingRepoFn: func() *automock.EntityTypeMappingRepository {
+				return &automock.EntityTypeMappingRepository{}
+			},
+			ExpectedError: "cannot read tenant from context",
+		},
+		{
+			Name:         "Returns error when listing by resource ids fails",
+			Context:      ctx,
+			ResourceType: resource.API,
+			EntityTypeMappingRepoFn: func() *automock.EntityTypeMappingRepository {
+				entityTypeMappingRepo := &automock.EntityTypeMappingRepository{}
+				entityTypeMappingRepo.On("ListByResourceIDs", ctx, tenantID, resourceIDs, resource.API).Return(nil, errTest).Once()
+				return entityTypeMappingRepo
+			},
+			ExpectedError: errTest.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			entityTypeMappingRepo := testCase.EntityTypeMappingRepoFn()
+			svc := entitytypemapping.NewService(entityTypeMappingRepo, nil)
+
+			// WHEN
+			entityTypeMappings, err := svc.ListByOwnerResourceIDs(testCase.Context, resourceIDs, testCase.ResourceType)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOutput, entityTypeMappings)
+			}
+
+			entityTypeMappingRepo.AssertExpectations(t)
+		})
+	}
+}
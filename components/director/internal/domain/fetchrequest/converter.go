@@ -114,6 +114,8 @@ func (c *converter) ToEntity(in *model.FetchRequest) (*Entity, error) {
 		StatusCondition: string(in.Status.Condition),
 		StatusMessage:   message,
 		StatusTimestamp: in.Status.Timestamp,
+		ETag:            repo.NewNullableString(in.ETag),
+		LastModified:    repo.NewNullableString(in.LastModified),
 	}, nil
 }
 
@@ -138,10 +140,12 @@ func (c *converter) FromEntity(in *Entity, objectType model.FetchRequestReferenc
 			Message:   repo.StringPtrFromNullableString(in.StatusMessage),
 			Condition: model.FetchRequestStatusCondition(in.StatusCondition),
 		},
-		URL:    in.URL,
-		Mode:   model.FetchMode(in.Mode),
-		Filter: repo.StringPtrFromNullableString(in.Filter),
-		Auth:   auth,
+		URL:          in.URL,
+		Mode:         model.FetchMode(in.Mode),
+		Filter:       repo.StringPtrFromNullableString(in.Filter),
+		Auth:         auth,
+		ETag:         repo.StringPtrFromNullableString(in.ETag),
+		LastModified: repo.StringPtrFromNullableString(in.LastModified),
 	}, nil
 }
 
@@ -46,8 +46,8 @@ func TestRepository_Create(t *testing.T) {
 				},
 			},
 			{
-				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
-				Args:        []driver.Value{givenID(), sql.NullString{}, "foo.bar", apiFREntity.Auth, apiFREntity.Mode, apiFREntity.Filter, apiFREntity.StatusCondition, apiFREntity.StatusMessage, apiFREntity.StatusTimestamp, refID},
+				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
+				Args:        []driver.Value{givenID(), sql.NullString{}, "foo.bar", apiFREntity.Auth, apiFREntity.Mode, apiFREntity.Filter, apiFREntity.StatusCondition, apiFREntity.StatusMessage, apiFREntity.StatusTimestamp, refID, apiFREntity.ETag, apiFREntity.LastModified},
 				ValidResult: sqlmock.NewResult(-1, 1),
 			},
 		},
@@ -76,8 +76,8 @@ func TestRepository_Create(t *testing.T) {
 				},
 			},
 			{
-				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
-				Args:        []driver.Value{givenID(), sql.NullString{}, "foo.bar", eventFREntity.Auth, eventFREntity.Mode, eventFREntity.Filter, eventFREntity.StatusCondition, eventFREntity.StatusMessage, eventFREntity.StatusTimestamp, refID},
+				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
+				Args:        []driver.Value{givenID(), sql.NullString{}, "foo.bar", eventFREntity.Auth, eventFREntity.Mode, eventFREntity.Filter, eventFREntity.StatusCondition, eventFREntity.StatusMessage, eventFREntity.StatusTimestamp, refID, eventFREntity.ETag, eventFREntity.LastModified},
 				ValidResult: sqlmock.NewResult(-1, 1),
 			},
 		},
@@ -106,8 +106,8 @@ func TestRepository_Create(t *testing.T) {
 				},
 			},
 			{
-				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
-				Args:        []driver.Value{givenID(), sql.NullString{}, "foo.bar", capabilityFREntity.Auth, capabilityFREntity.Mode, capabilityFREntity.Filter, capabilityFREntity.StatusCondition, capabilityFREntity.StatusMessage, capabilityFREntity.StatusTimestamp, refID},
+				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
+				Args:        []driver.Value{givenID(), sql.NullString{}, "foo.bar", capabilityFREntity.Auth, capabilityFREntity.Mode, capabilityFREntity.Filter, capabilityFREntity.StatusCondition, capabilityFREntity.StatusMessage, capabilityFREntity.StatusTimestamp, refID, capabilityFREntity.ETag, capabilityFREntity.LastModified},
 				ValidResult: sqlmock.NewResult(-1, 1),
 			},
 		},
@@ -136,8 +136,8 @@ func TestRepository_Create(t *testing.T) {
 				},
 			},
 			{
-				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
-				Args:        []driver.Value{givenID(), refID, "foo.bar", docFREntity.Auth, docFREntity.Mode, docFREntity.Filter, docFREntity.StatusCondition, docFREntity.StatusMessage, docFREntity.StatusTimestamp, sql.NullString{}},
+				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
+				Args:        []driver.Value{givenID(), refID, "foo.bar", docFREntity.Auth, docFREntity.Mode, docFREntity.Filter, docFREntity.StatusCondition, docFREntity.StatusMessage, docFREntity.StatusTimestamp, sql.NullString{}, docFREntity.ETag, docFREntity.LastModified},
 				ValidResult: sqlmock.NewResult(-1, 1),
 			},
 		},
@@ -170,8 +170,8 @@ func TestRepository_CreateGlobal(t *testing.T) {
 		Name: "Create API FR",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
-				Args:        []driver.Value{givenID(), sql.NullString{}, "foo.bar", apiFREntity.Auth, apiFREntity.Mode, apiFREntity.Filter, apiFREntity.StatusCondition, apiFREntity.StatusMessage, apiFREntity.StatusTimestamp, refID},
+				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
+				Args:        []driver.Value{givenID(), sql.NullString{}, "foo.bar", apiFREntity.Auth, apiFREntity.Mode, apiFREntity.Filter, apiFREntity.StatusCondition, apiFREntity.StatusMessage, apiFREntity.StatusTimestamp, refID, apiFREntity.ETag, apiFREntity.LastModified},
 				ValidResult: sqlmock.NewResult(-1, 1),
 			},
 		},
@@ -190,8 +190,8 @@ func TestRepository_CreateGlobal(t *testing.T) {
 		Name: "Create Capability FR Global",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
-				Args:        []driver.Value{givenID(), sql.NullString{}, "foo.bar", capabilityFREntity.Auth, capabilityFREntity.Mode, capabilityFREntity.Filter, capabilityFREntity.StatusCondition, capabilityFREntity.StatusMessage, capabilityFREntity.StatusTimestamp, refID},
+				Query:       regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"),
+				Args:        []driver.Value{givenID(), sql.NullString{}, "foo.bar", capabilityFREntity.Auth, capabilityFREntity.Mode, capabilityFREntity.Filter, capabilityFREntity.StatusCondition, capabilityFREntity.StatusMessage, capabilityFREntity.StatusTimestamp, refID, capabilityFREntity.ETag, capabilityFREntity.LastModified},
 				ValidResult: sqlmock.NewResult(-1, 1),
 			},
 		},
@@ -226,8 +226,8 @@ func TestRepository_Update(t *testing.T) {
 		Name: "Update API Fetch Request",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ? WHERE id = ? AND (id IN (SELECT id FROM api_specifications_fetch_requests_tenants WHERE tenant_id = ? AND owner = true))`),
-				Args:          []driver.Value{apiFREntity.StatusCondition, apiFREntity.StatusMessage, apiFREntity.StatusTimestamp, givenID(), tenantID},
+				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ?, etag = ?, last_modified = ? WHERE id = ? AND (id IN (SELECT id FROM api_specifications_fetch_requests_tenants WHERE tenant_id = ? AND owner = true))`),
+				Args:          []driver.Value{apiFREntity.StatusCondition, apiFREntity.StatusMessage, apiFREntity.StatusTimestamp, apiFREntity.ETag, apiFREntity.LastModified, givenID(), tenantID},
 				ValidResult:   sqlmock.NewResult(-1, 1),
 				InvalidResult: sqlmock.NewResult(-1, 0),
 			},
@@ -248,8 +248,8 @@ func TestRepository_Update(t *testing.T) {
 		Name: "Update Event Fetch Request",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ? WHERE id = ? AND (id IN (SELECT id FROM event_specifications_fetch_requests_tenants WHERE tenant_id = ? AND owner = true))`),
-				Args:          []driver.Value{eventFREntity.StatusCondition, eventFREntity.StatusMessage, eventFREntity.StatusTimestamp, givenID(), tenantID},
+				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ?, etag = ?, last_modified = ? WHERE id = ? AND (id IN (SELECT id FROM event_specifications_fetch_requests_tenants WHERE tenant_id = ? AND owner = true))`),
+				Args:          []driver.Value{eventFREntity.StatusCondition, eventFREntity.StatusMessage, eventFREntity.StatusTimestamp, eventFREntity.ETag, eventFREntity.LastModified, givenID(), tenantID},
 				ValidResult:   sqlmock.NewResult(-1, 1),
 				InvalidResult: sqlmock.NewResult(-1, 0),
 			},
@@ -270,8 +270,8 @@ func TestRepository_Update(t *testing.T) {
 		Name: "Update Capability Fetch Request",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ? WHERE id = ? AND (id IN (SELECT id FROM capability_specifications_fetch_requests_tenants WHERE tenant_id = ? AND owner = true))`),
-				Args:          []driver.Value{capabilityFREntity.StatusCondition, capabilityFREntity.StatusMessage, capabilityFREntity.StatusTimestamp, givenID(), tenantID},
+				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ?, etag = ?, last_modified = ? WHERE id = ? AND (id IN (SELECT id FROM capability_specifications_fetch_requests_tenants WHERE tenant_id = ? AND owner = true))`),
+				Args:          []driver.Value{capabilityFREntity.StatusCondition, capabilityFREntity.StatusMessage, capabilityFREntity.StatusTimestamp, capabilityFREntity.ETag, capabilityFREntity.LastModified, givenID(), tenantID},
 				ValidResult:   sqlmock.NewResult(-1, 1),
 				InvalidResult: sqlmock.NewResult(-1, 0),
 			},
@@ -292,8 +292,8 @@ func TestRepository_Update(t *testing.T) {
 		Name: "Update Document Fetch Request",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ? WHERE id = ? AND (id IN (SELECT id FROM document_fetch_requests_tenants WHERE tenant_id = ? AND owner = true))`),
-				Args:          []driver.Value{docFREntity.StatusCondition, docFREntity.StatusMessage, docFREntity.StatusTimestamp, givenID(), tenantID},
+				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ?, etag = ?, last_modified = ? WHERE id = ? AND (id IN (SELECT id FROM document_fetch_requests_tenants WHERE tenant_id = ? AND owner = true))`),
+				Args:          []driver.Value{docFREntity.StatusCondition, docFREntity.StatusMessage, docFREntity.StatusTimestamp, docFREntity.ETag, docFREntity.LastModified, givenID(), tenantID},
 				ValidResult:   sqlmock.NewResult(-1, 1),
 				InvalidResult: sqlmock.NewResult(-1, 0),
 			},
@@ -323,8 +323,8 @@ func TestRepository_UpdateGlobal(t *testing.T) {
 		Name: "Update API Fetch Request",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ? WHERE id = ?`),
-				Args:          []driver.Value{apiFREntity.StatusCondition, apiFREntity.StatusMessage, apiFREntity.StatusTimestamp, givenID()},
+				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ?, etag = ?, last_modified = ? WHERE id = ?`),
+				Args:          []driver.Value{apiFREntity.StatusCondition, apiFREntity.StatusMessage, apiFREntity.StatusTimestamp, apiFREntity.ETag, apiFREntity.LastModified, givenID()},
 				ValidResult:   sqlmock.NewResult(-1, 1),
 				InvalidResult: sqlmock.NewResult(-1, 0),
 			},
@@ -344,8 +344,8 @@ func TestRepository_UpdateGlobal(t *testing.T) {
 		Name: "Update Capability Fetch Request",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ? WHERE id = ?`),
-				Args:          []driver.Value{capabilityFREntity.StatusCondition, capabilityFREntity.StatusMessage, capabilityFREntity.StatusTimestamp, givenID()},
+				Query:         regexp.QuoteMeta(`UPDATE public.fetch_requests SET status_condition = ?, status_message = ?, status_timestamp = ?, etag = ?, last_modified = ? WHERE id = ?`),
+				Args:          []driver.Value{capabilityFREntity.StatusCondition, capabilityFREntity.StatusMessage, capabilityFREntity.StatusTimestamp, capabilityFREntity.ETag, capabilityFREntity.LastModified, givenID()},
 				ValidResult:   sqlmock.NewResult(-1, 1),
 				InvalidResult: sqlmock.NewResult(-1, 0),
 			},
@@ -380,13 +380,13 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 		Name: "Get Fetch Request by API ReferenceObjectID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE spec_id = $1 AND (id IN (SELECT id FROM api_specifications_fetch_requests_tenants WHERE tenant_id = $2))`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE spec_id = $1 AND (id IN (SELECT id FROM api_specifications_fetch_requests_tenants WHERE tenant_id = $2))`),
 				Args:     []driver.Value{refID, tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{
 						sqlmock.NewRows(fixColumns()).
-							AddRow(givenID(), apiFREntity.DocumentID, "foo.bar", apiFREntity.Auth, apiFREntity.Mode, apiFREntity.Filter, apiFREntity.StatusCondition, apiFREntity.StatusMessage, apiFREntity.StatusTimestamp, apiFREntity.SpecID),
+							AddRow(givenID(), apiFREntity.DocumentID, "foo.bar", apiFREntity.Auth, apiFREntity.Mode, apiFREntity.Filter, apiFREntity.StatusCondition, apiFREntity.StatusMessage, apiFREntity.StatusTimestamp, apiFREntity.SpecID, apiFREntity.ETag, apiFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -411,13 +411,13 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 		Name: "Get Fetch Request by Event ReferenceObjectID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE spec_id = $1 AND (id IN (SELECT id FROM event_specifications_fetch_requests_tenants WHERE tenant_id = $2))`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE spec_id = $1 AND (id IN (SELECT id FROM event_specifications_fetch_requests_tenants WHERE tenant_id = $2))`),
 				Args:     []driver.Value{refID, tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{
 						sqlmock.NewRows(fixColumns()).
-							AddRow(givenID(), eventFREntity.DocumentID, "foo.bar", eventFREntity.Auth, eventFREntity.Mode, eventFREntity.Filter, eventFREntity.StatusCondition, eventFREntity.StatusMessage, eventFREntity.StatusTimestamp, eventFREntity.SpecID),
+							AddRow(givenID(), eventFREntity.DocumentID, "foo.bar", eventFREntity.Auth, eventFREntity.Mode, eventFREntity.Filter, eventFREntity.StatusCondition, eventFREntity.StatusMessage, eventFREntity.StatusTimestamp, eventFREntity.SpecID, eventFREntity.ETag, eventFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -442,13 +442,13 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 		Name: "Get Fetch Request by Capability ReferenceObjectID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE spec_id = $1 AND (id IN (SELECT id FROM capability_specifications_fetch_requests_tenants WHERE tenant_id = $2))`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE spec_id = $1 AND (id IN (SELECT id FROM capability_specifications_fetch_requests_tenants WHERE tenant_id = $2))`),
 				Args:     []driver.Value{refID, tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{
 						sqlmock.NewRows(fixColumns()).
-							AddRow(givenID(), capabilityFREntity.DocumentID, "foo.bar", capabilityFREntity.Auth, capabilityFREntity.Mode, capabilityFREntity.Filter, capabilityFREntity.StatusCondition, capabilityFREntity.StatusMessage, capabilityFREntity.StatusTimestamp, capabilityFREntity.SpecID),
+							AddRow(givenID(), capabilityFREntity.DocumentID, "foo.bar", capabilityFREntity.Auth, capabilityFREntity.Mode, capabilityFREntity.Filter, capabilityFREntity.StatusCondition, capabilityFREntity.StatusMessage, capabilityFREntity.StatusTimestamp, capabilityFREntity.SpecID, capabilityFREntity.ETag, capabilityFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -473,13 +473,13 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 		Name: "Get Fetch Request by Document ReferenceObjectID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE document_id = $1 AND (id IN (SELECT id FROM document_fetch_requests_tenants WHERE tenant_id = $2))`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE document_id = $1 AND (id IN (SELECT id FROM document_fetch_requests_tenants WHERE tenant_id = $2))`),
 				Args:     []driver.Value{refID, tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{
 						sqlmock.NewRows(fixColumns()).
-							AddRow(givenID(), docFREntity.DocumentID, "foo.bar", docFREntity.Auth, docFREntity.Mode, docFREntity.Filter, docFREntity.StatusCondition, docFREntity.StatusMessage, docFREntity.StatusTimestamp, docFREntity.SpecID),
+							AddRow(givenID(), docFREntity.DocumentID, "foo.bar", docFREntity.Auth, docFREntity.Mode, docFREntity.Filter, docFREntity.StatusCondition, docFREntity.StatusMessage, docFREntity.StatusTimestamp, docFREntity.SpecID, docFREntity.ETag, docFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -781,13 +781,13 @@ func TestRepository_ListByReferenceObjectIDs(t *testing.T) {
 		Name: "List API Fetch Requests by Object IDs",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE spec_id IN ($1, $2) AND (id IN (SELECT id FROM api_specifications_fetch_requests_tenants WHERE tenant_id = $3))`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE spec_id IN ($1, $2) AND (id IN (SELECT id FROM api_specifications_fetch_requests_tenants WHERE tenant_id = $3))`),
 				Args:     []driver.Value{firstRefID, secondRefID, tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).
-						AddRow(firstFrID, firstAPIFREntity.DocumentID, "foo.bar", firstAPIFREntity.Auth, firstAPIFREntity.Mode, firstAPIFREntity.Filter, firstAPIFREntity.StatusCondition, firstAPIFREntity.StatusMessage, firstAPIFREntity.StatusTimestamp, firstAPIFREntity.SpecID).
-						AddRow(secondFrID, secondAPIFREntity.DocumentID, "foo.bar", secondAPIFREntity.Auth, secondAPIFREntity.Mode, secondAPIFREntity.Filter, secondAPIFREntity.StatusCondition, secondAPIFREntity.StatusMessage, secondAPIFREntity.StatusTimestamp, secondAPIFREntity.SpecID),
+						AddRow(firstFrID, firstAPIFREntity.DocumentID, "foo.bar", firstAPIFREntity.Auth, firstAPIFREntity.Mode, firstAPIFREntity.Filter, firstAPIFREntity.StatusCondition, firstAPIFREntity.StatusMessage, firstAPIFREntity.StatusTimestamp, firstAPIFREntity.SpecID, firstAPIFREntity.ETag, firstAPIFREntity.LastModified).
+						AddRow(secondFrID, secondAPIFREntity.DocumentID, "foo.bar", secondAPIFREntity.Auth, secondAPIFREntity.Mode, secondAPIFREntity.Filter, secondAPIFREntity.StatusCondition, secondAPIFREntity.StatusMessage, secondAPIFREntity.StatusTimestamp, secondAPIFREntity.SpecID, secondAPIFREntity.ETag, secondAPIFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -816,13 +816,13 @@ func TestRepository_ListByReferenceObjectIDs(t *testing.T) {
 		Name: "List Event Fetch Requests by Object IDs",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE spec_id IN ($1, $2) AND (id IN (SELECT id FROM event_specifications_fetch_requests_tenants WHERE tenant_id = $3))`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE spec_id IN ($1, $2) AND (id IN (SELECT id FROM event_specifications_fetch_requests_tenants WHERE tenant_id = $3))`),
 				Args:     []driver.Value{firstRefID, secondRefID, tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).
-						AddRow(firstFrID, firstEventFREntity.DocumentID, "foo.bar", firstEventFREntity.Auth, firstEventFREntity.Mode, firstEventFREntity.Filter, firstEventFREntity.StatusCondition, firstEventFREntity.StatusMessage, firstEventFREntity.StatusTimestamp, firstEventFREntity.SpecID).
-						AddRow(secondFrID, secondEventFREntity.DocumentID, "foo.bar", secondEventFREntity.Auth, secondEventFREntity.Mode, secondEventFREntity.Filter, secondEventFREntity.StatusCondition, secondEventFREntity.StatusMessage, secondEventFREntity.StatusTimestamp, secondEventFREntity.SpecID),
+						AddRow(firstFrID, firstEventFREntity.DocumentID, "foo.bar", firstEventFREntity.Auth, firstEventFREntity.Mode, firstEventFREntity.Filter, firstEventFREntity.StatusCondition, firstEventFREntity.StatusMessage, firstEventFREntity.StatusTimestamp, firstEventFREntity.SpecID, firstEventFREntity.ETag, firstEventFREntity.LastModified).
+						AddRow(secondFrID, secondEventFREntity.DocumentID, "foo.bar", secondEventFREntity.Auth, secondEventFREntity.Mode, secondEventFREntity.Filter, secondEventFREntity.StatusCondition, secondEventFREntity.StatusMessage, secondEventFREntity.StatusTimestamp, secondEventFREntity.SpecID, secondEventFREntity.ETag, secondEventFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -851,13 +851,13 @@ func TestRepository_ListByReferenceObjectIDs(t *testing.T) {
 		Name: "List Capability Fetch Requests by Object IDs",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE spec_id IN ($1, $2) AND (id IN (SELECT id FROM capability_specifications_fetch_requests_tenants WHERE tenant_id = $3))`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE spec_id IN ($1, $2) AND (id IN (SELECT id FROM capability_specifications_fetch_requests_tenants WHERE tenant_id = $3))`),
 				Args:     []driver.Value{firstRefID, secondRefID, tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).
-						AddRow(firstFrID, firstCapabilityFREntity.DocumentID, "foo.bar", firstCapabilityFREntity.Auth, firstCapabilityFREntity.Mode, firstCapabilityFREntity.Filter, firstCapabilityFREntity.StatusCondition, firstCapabilityFREntity.StatusMessage, firstCapabilityFREntity.StatusTimestamp, firstCapabilityFREntity.SpecID).
-						AddRow(secondFrID, secondCapabilityFREntity.DocumentID, "foo.bar", secondCapabilityFREntity.Auth, secondCapabilityFREntity.Mode, secondCapabilityFREntity.Filter, secondCapabilityFREntity.StatusCondition, secondCapabilityFREntity.StatusMessage, secondCapabilityFREntity.StatusTimestamp, secondCapabilityFREntity.SpecID),
+						AddRow(firstFrID, firstCapabilityFREntity.DocumentID, "foo.bar", firstCapabilityFREntity.Auth, firstCapabilityFREntity.Mode, firstCapabilityFREntity.Filter, firstCapabilityFREntity.StatusCondition, firstCapabilityFREntity.StatusMessage, firstCapabilityFREntity.StatusTimestamp, firstCapabilityFREntity.SpecID, firstCapabilityFREntity.ETag, firstCapabilityFREntity.LastModified).
+						AddRow(secondFrID, secondCapabilityFREntity.DocumentID, "foo.bar", secondCapabilityFREntity.Auth, secondCapabilityFREntity.Mode, secondCapabilityFREntity.Filter, secondCapabilityFREntity.StatusCondition, secondCapabilityFREntity.StatusMessage, secondCapabilityFREntity.StatusTimestamp, secondCapabilityFREntity.SpecID, secondCapabilityFREntity.ETag, secondCapabilityFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -886,13 +886,13 @@ func TestRepository_ListByReferenceObjectIDs(t *testing.T) {
 		Name: "List Doc Fetch Requests by Object IDs",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE document_id IN ($1, $2) AND (id IN (SELECT id FROM document_fetch_requests_tenants WHERE tenant_id = $3))`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE document_id IN ($1, $2) AND (id IN (SELECT id FROM document_fetch_requests_tenants WHERE tenant_id = $3))`),
 				Args:     []driver.Value{firstRefID, secondRefID, tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).
-						AddRow(firstFrID, firstDocFREntity.DocumentID, "foo.bar", firstDocFREntity.Auth, firstDocFREntity.Mode, firstDocFREntity.Filter, firstDocFREntity.StatusCondition, firstDocFREntity.StatusMessage, firstDocFREntity.StatusTimestamp, firstDocFREntity.SpecID).
-						AddRow(secondFrID, secondDocFREntity.DocumentID, "foo.bar", secondDocFREntity.Auth, secondDocFREntity.Mode, secondDocFREntity.Filter, secondDocFREntity.StatusCondition, secondDocFREntity.StatusMessage, secondDocFREntity.StatusTimestamp, secondDocFREntity.SpecID),
+						AddRow(firstFrID, firstDocFREntity.DocumentID, "foo.bar", firstDocFREntity.Auth, firstDocFREntity.Mode, firstDocFREntity.Filter, firstDocFREntity.StatusCondition, firstDocFREntity.StatusMessage, firstDocFREntity.StatusTimestamp, firstDocFREntity.SpecID, firstDocFREntity.ETag, firstDocFREntity.LastModified).
+						AddRow(secondFrID, secondDocFREntity.DocumentID, "foo.bar", secondDocFREntity.Auth, secondDocFREntity.Mode, secondDocFREntity.Filter, secondDocFREntity.StatusCondition, secondDocFREntity.StatusMessage, secondDocFREntity.StatusTimestamp, secondDocFREntity.SpecID, secondDocFREntity.ETag, secondDocFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -934,13 +934,13 @@ func TestRepository_ListByReferenceObjectIDsGlobal(t *testing.T) {
 		Name: "List API Fetch Requests by Object IDs Global",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE spec_id IN ($1, $2)`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE spec_id IN ($1, $2)`),
 				Args:     []driver.Value{firstRefID, secondRefID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).
-						AddRow(firstFrID, firstAPIFREntity.DocumentID, "foo.bar", firstAPIFREntity.Auth, firstAPIFREntity.Mode, firstAPIFREntity.Filter, firstAPIFREntity.StatusCondition, firstAPIFREntity.StatusMessage, firstAPIFREntity.StatusTimestamp, firstAPIFREntity.SpecID).
-						AddRow(secondFrID, secondAPIFREntity.DocumentID, "foo.bar", secondAPIFREntity.Auth, secondAPIFREntity.Mode, secondAPIFREntity.Filter, secondAPIFREntity.StatusCondition, secondAPIFREntity.StatusMessage, secondAPIFREntity.StatusTimestamp, secondAPIFREntity.SpecID),
+						AddRow(firstFrID, firstAPIFREntity.DocumentID, "foo.bar", firstAPIFREntity.Auth, firstAPIFREntity.Mode, firstAPIFREntity.Filter, firstAPIFREntity.StatusCondition, firstAPIFREntity.StatusMessage, firstAPIFREntity.StatusTimestamp, firstAPIFREntity.SpecID, firstAPIFREntity.ETag, firstAPIFREntity.LastModified).
+						AddRow(secondFrID, secondAPIFREntity.DocumentID, "foo.bar", secondAPIFREntity.Auth, secondAPIFREntity.Mode, secondAPIFREntity.Filter, secondAPIFREntity.StatusCondition, secondAPIFREntity.StatusMessage, secondAPIFREntity.StatusTimestamp, secondAPIFREntity.SpecID, secondAPIFREntity.ETag, secondAPIFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -969,13 +969,13 @@ func TestRepository_ListByReferenceObjectIDsGlobal(t *testing.T) {
 		Name: "List Event Fetch Requests by Object IDs Global",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE spec_id IN ($1, $2)`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE spec_id IN ($1, $2)`),
 				Args:     []driver.Value{firstRefID, secondRefID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).
-						AddRow(firstFrID, firstEventFREntity.DocumentID, "foo.bar", firstEventFREntity.Auth, firstEventFREntity.Mode, firstEventFREntity.Filter, firstEventFREntity.StatusCondition, firstEventFREntity.StatusMessage, firstEventFREntity.StatusTimestamp, firstEventFREntity.SpecID).
-						AddRow(secondFrID, secondEventFREntity.DocumentID, "foo.bar", secondEventFREntity.Auth, secondEventFREntity.Mode, secondEventFREntity.Filter, secondEventFREntity.StatusCondition, secondEventFREntity.StatusMessage, secondEventFREntity.StatusTimestamp, secondEventFREntity.SpecID),
+						AddRow(firstFrID, firstEventFREntity.DocumentID, "foo.bar", firstEventFREntity.Auth, firstEventFREntity.Mode, firstEventFREntity.Filter, firstEventFREntity.StatusCondition, firstEventFREntity.StatusMessage, firstEventFREntity.StatusTimestamp, firstEventFREntity.SpecID, firstEventFREntity.ETag, firstEventFREntity.LastModified).
+						AddRow(secondFrID, secondEventFREntity.DocumentID, "foo.bar", secondEventFREntity.Auth, secondEventFREntity.Mode, secondEventFREntity.Filter, secondEventFREntity.StatusCondition, secondEventFREntity.StatusMessage, secondEventFREntity.StatusTimestamp, secondEventFREntity.SpecID, secondEventFREntity.ETag, secondEventFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -1004,13 +1004,13 @@ func TestRepository_ListByReferenceObjectIDsGlobal(t *testing.T) {
 		Name: "List Capability Fetch Requests by Object IDs Global",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE spec_id IN ($1, $2)`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE spec_id IN ($1, $2)`),
 				Args:     []driver.Value{firstRefID, secondRefID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).
-						AddRow(firstFrID, firstCapabilityFREntity.DocumentID, "foo.bar", firstCapabilityFREntity.Auth, firstCapabilityFREntity.Mode, firstCapabilityFREntity.Filter, firstCapabilityFREntity.StatusCondition, firstCapabilityFREntity.StatusMessage, firstCapabilityFREntity.StatusTimestamp, firstCapabilityFREntity.SpecID).
-						AddRow(secondFrID, secondCapabilityFREntity.DocumentID, "foo.bar", secondCapabilityFREntity.Auth, secondCapabilityFREntity.Mode, secondCapabilityFREntity.Filter, secondCapabilityFREntity.StatusCondition, secondCapabilityFREntity.StatusMessage, secondCapabilityFREntity.StatusTimestamp, secondCapabilityFREntity.SpecID),
+						AddRow(firstFrID, firstCapabilityFREntity.DocumentID, "foo.bar", firstCapabilityFREntity.Auth, firstCapabilityFREntity.Mode, firstCapabilityFREntity.Filter, firstCapabilityFREntity.StatusCondition, firstCapabilityFREntity.StatusMessage, firstCapabilityFREntity.StatusTimestamp, firstCapabilityFREntity.SpecID, firstCapabilityFREntity.ETag, firstCapabilityFREntity.LastModified).
+						AddRow(secondFrID, secondCapabilityFREntity.DocumentID, "foo.bar", secondCapabilityFREntity.Auth, secondCapabilityFREntity.Mode, secondCapabilityFREntity.Filter, secondCapabilityFREntity.StatusCondition, secondCapabilityFREntity.StatusMessage, secondCapabilityFREntity.StatusTimestamp, secondCapabilityFREntity.SpecID, secondCapabilityFREntity.ETag, secondCapabilityFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -1039,13 +1039,13 @@ func TestRepository_ListByReferenceObjectIDsGlobal(t *testing.T) {
 		Name: "List Doc Fetch Requests by Object IDs Global",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE document_id IN ($1, $2)`),
+				Query:    regexp.QuoteMeta(`SELECT id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, etag, last_modified FROM public.fetch_requests WHERE document_id IN ($1, $2)`),
 				Args:     []driver.Value{firstRefID, secondRefID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).
-						AddRow(firstFrID, firstDocFREntity.DocumentID, "foo.bar", firstDocFREntity.Auth, firstDocFREntity.Mode, firstDocFREntity.Filter, firstDocFREntity.StatusCondition, firstDocFREntity.StatusMessage, firstDocFREntity.StatusTimestamp, firstDocFREntity.SpecID).
-						AddRow(secondFrID, secondDocFREntity.DocumentID, "foo.bar", secondDocFREntity.Auth, secondDocFREntity.Mode, secondDocFREntity.Filter, secondDocFREntity.StatusCondition, secondDocFREntity.StatusMessage, secondDocFREntity.StatusTimestamp, secondDocFREntity.SpecID),
+						AddRow(firstFrID, firstDocFREntity.DocumentID, "foo.bar", firstDocFREntity.Auth, firstDocFREntity.Mode, firstDocFREntity.Filter, firstDocFREntity.StatusCondition, firstDocFREntity.StatusMessage, firstDocFREntity.StatusTimestamp, firstDocFREntity.SpecID, firstDocFREntity.ETag, firstDocFREntity.LastModified).
+						AddRow(secondFrID, secondDocFREntity.DocumentID, "foo.bar", secondDocFREntity.Auth, secondDocFREntity.Mode, secondDocFREntity.Filter, secondDocFREntity.StatusCondition, secondDocFREntity.StatusMessage, secondDocFREntity.StatusTimestamp, secondDocFREntity.SpecID, secondDocFREntity.ETag, secondDocFREntity.LastModified),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
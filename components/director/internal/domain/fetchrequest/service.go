@@ -72,6 +72,9 @@ func (s *service) HandleSpec(ctx context.Context, fr *model.FetchRequest) *strin
 		return nil
 	}
 
+	// an explicit fetch always downloads the specification as there is no previously fetched content to fall back to
+	fr.ETag, fr.LastModified = nil, nil
+
 	var data *string
 	data, fr.Status = s.FetchSpec(ctx, fr, &sync.Map{})
 
@@ -109,6 +112,10 @@ func (s *service) UpdateGlobal(ctx context.Context, fr *model.FetchRequest) erro
 	return nil
 }
 
+// FetchSpec executes the fetch request and returns the fetched specification together with the resulting fetch request status.
+// If the fetch request holds cache validators from a previous fetch, a conditional request is sent. When the remote system
+// responds with 304 Not Modified a succeeded status with nil data is returned, meaning that the already stored specification is still up-to-date.
+// The cache validators of the fetch request are updated with the ones returned together with the specification.
 func (s *service) FetchSpec(ctx context.Context, fr *model.FetchRequest, headers *sync.Map) (*string, *model.FetchRequestStatus) {
 	err := s.validateFetchRequest(fr)
 	if err != nil {
@@ -122,6 +129,8 @@ func (s *service) FetchSpec(ctx context.Context, fr *model.FetchRequest, headers
 		localTenantID = ""
 	}
 
+	conditionalHeaders := httputil.ConditionalRequestHeaders(fr.ETag, fr.LastModified)
+
 	var doRequest retry.ExecutableHTTPFunc
 	if fr.Auth != nil && fr.Auth.AccessStrategy != nil && len(*fr.Auth.AccessStrategy) > 0 {
 		log.C(ctx).Infof("Fetch Request with id %s is configured with %s access strategy.", fr.ID, *fr.Auth.AccessStrategy)
@@ -133,15 +142,15 @@ func (s *service) FetchSpec(ctx context.Context, fr *model.FetchRequest, headers
 		}
 
 		doRequest = func() (*http.Response, error) {
-			return executor.Execute(ctx, s.client, fr.URL, localTenantID, headers)
+			return executor.Execute(ctx, s.client, fr.URL, localTenantID, httputil.MergeHeaders(headers, conditionalHeaders))
 		}
 	} else if fr.Auth != nil {
 		doRequest = func() (*http.Response, error) {
-			return httputil.GetRequestWithCredentials(ctx, s.client, fr.URL, localTenantID, fr.Auth)
+			return httputil.GetRequestWithCredentialsAndHeaders(ctx, s.client, fr.URL, localTenantID, fr.Auth, conditionalHeaders)
 		}
 	} else {
 		doRequest = func() (*http.Response, error) {
-			return httputil.GetRequestWithoutCredentials(s.client, fr.URL, localTenantID)
+			return httputil.GetRequestWithoutCredentialsAndHeaders(s.client, fr.URL, localTenantID, conditionalHeaders)
 		}
 	}
 
@@ -172,11 +181,18 @@ func (s *service) FetchSpec(ctx context.Context, fr *model.FetchRequest, headers
 		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While reading Spec: %s", err.Error())), s.timestampGen())
 	}
 
+	if resp.StatusCode == http.StatusNotModified && len(conditionalHeaders) > 0 {
+		log.C(ctx).Infof("Specification for %s with id %q is not modified since the last fetch", fr.ObjectType, fr.ObjectID)
+		return nil, FixStatus(model.FetchRequestStatusConditionSucceeded, nil, s.timestampGen())
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		log.C(ctx).Errorf("Failed to execute fetch request for %s with id %q: status code: %d body: %s", fr.ObjectType, fr.ObjectID, resp.StatusCode, string(body))
 		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec status code: %d", resp.StatusCode)), s.timestampGen())
 	}
 
+	fr.ETag, fr.LastModified = httputil.CacheValidatorsFromResponse(resp)
+
 	spec := string(body)
 	return &spec, FixStatus(model.FetchRequestStatusConditionSucceeded, nil, s.timestampGen())
 }
@@ -619,3 +619,158 @@ func TestService_HandleSpec_FailsAfterRetryMechanismIsExhausted(t *testing.T) {
 	assert.Nil(t, result)
 	assert.Equal(t, int(retryConfig.Attempts), invocations)
 }
+
+func TestService_HandleSpec_IgnoresStoredCacheValidators(t *testing.T) {
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, tenantID)
+
+	timestamp := time.Now()
+	frRepo := &automock.FetchRequestRepository{}
+	frRepo.On("Update", ctx, tenantID, mock.Anything).Return(nil).Once()
+
+	mockSpec := "spec"
+	certCache := credloader.NewCertificateCache()
+	svc := fetchrequest.NewService(frRepo, NewTestClient(func(req *http.Request) *http.Response {
+		assert.Empty(t, req.Header.Get("If-None-Match"))
+		assert.Empty(t, req.Header.Get("If-Modified-Since"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Etag": []string{etag}},
+			Body:       io.NopCloser(bytes.NewBufferString(mockSpec)),
+		}
+	}), accessstrategy.NewDefaultExecutorProvider(certCache, externalClientCertSecretName))
+	svc.SetTimestampGen(func() time.Time { return timestamp })
+
+	modelInput := &model.FetchRequest{
+		ID:           "test",
+		Mode:         model.FetchModeSingle,
+		ETag:         str.Ptr(`"outdated"`),
+		LastModified: str.Ptr(lastModified),
+	}
+
+	result := svc.HandleSpec(ctx, modelInput)
+
+	assert.Equal(t, mockSpec, *result)
+	assert.Equal(t, str.Ptr(etag), modelInput.ETag)
+	assert.Nil(t, modelInput.LastModified)
+	frRepo.AssertExpectations(t)
+}
+
+func TestService_FetchSpec(t *testing.T) {
+	testAccessStrategy := "testAccessStrategy"
+	mockSpec := "spec"
+	timestamp := time.Now()
+
+	conditionalHeadersMatcher := mock.MatchedBy(func(headers *sync.Map) bool {
+		value, ok := headers.Load("If-None-Match")
+		return ok && value == etag
+	})
+
+	testCases := []struct {
+		Name                 string
+		Client               func(t *testing.T) *http.Client
+		ExecutorProviderFunc func() accessstrategy.ExecutorProvider
+		InputFr              model.FetchRequest
+		ExpectedResult       *string
+		ExpectedStatus       *model.FetchRequestStatus
+		ExpectedETag         *string
+		ExpectedLastModified *string
+	}{
+		{
+			Name: "Success stores the cache validators returned with the specification",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					assert.Empty(t, req.Header.Get("If-None-Match"))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{"Etag": []string{etag}, "Last-Modified": []string{lastModified}},
+						Body:       io.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				})
+			},
+			InputFr:              model.FetchRequest{ID: "test", Mode: model.FetchModeSingle},
+			ExpectedResult:       &mockSpec,
+			ExpectedStatus:       fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+			ExpectedETag:         str.Ptr(etag),
+			ExpectedLastModified: str.Ptr(lastModified),
+		},
+		{
+			Name: "Success with nil data when the specification is not modified",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					assert.Equal(t, etag, req.Header.Get("If-None-Match"))
+					assert.Equal(t, lastModified, req.Header.Get("If-Modified-Since"))
+					return &http.Response{
+						StatusCode: http.StatusNotModified,
+						Body:       io.NopCloser(bytes.NewBufferString("")),
+					}
+				})
+			},
+			InputFr:              model.FetchRequest{ID: "test", Mode: model.FetchModeSingle, ETag: str.Ptr(etag), LastModified: str.Ptr(lastModified)},
+			ExpectedStatus:       fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+			ExpectedETag:         str.Ptr(etag),
+			ExpectedLastModified: str.Ptr(lastModified),
+		},
+		{
+			Name: "Success with nil data when the specification fetched with access strategy is not modified",
+			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
+				executor := &accessstrategyautomock.Executor{}
+				executor.On("Execute", mock.Anything, mock.Anything, "http://test.com", "", conditionalHeadersMatcher).Return(&http.Response{
+					StatusCode: http.StatusNotModified,
+					Body:       io.NopCloser(bytes.NewBufferString("")),
+				}, nil).Once()
+
+				executorProvider := &accessstrategyautomock.ExecutorProvider{}
+				executorProvider.On("Provide", accessstrategy.Type(testAccessStrategy)).Return(executor, nil).Once()
+				return executorProvider
+			},
+			Client: func(t *testing.T) *http.Client {
+				return nil
+			},
+			InputFr:        model.FetchRequest{ID: "test", Mode: model.FetchModeSingle, URL: "http://test.com", Auth: &model.Auth{AccessStrategy: &testAccessStrategy}, ETag: str.Ptr(etag)},
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+			ExpectedETag:   str.Ptr(etag),
+		},
+		{
+			Name: "Fails when the specification is reported as not modified for an unconditional request",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusNotModified,
+						Body:       io.NopCloser(bytes.NewBufferString("")),
+					}
+				})
+			},
+			InputFr:        model.FetchRequest{ID: "test", Mode: model.FetchModeSingle},
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While fetching Spec status code: 304"), timestamp),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			certCache := credloader.NewCertificateCache()
+			var executorProviderMock accessstrategy.ExecutorProvider = accessstrategy.NewDefaultExecutorProvider(certCache, externalClientCertSecretName)
+			if testCase.ExecutorProviderFunc != nil {
+				executorProviderMock = testCase.ExecutorProviderFunc()
+			}
+
+			svc := fetchrequest.NewService(&automock.FetchRequestRepository{}, testCase.Client(t), executorProviderMock)
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+
+			headers := &sync.Map{}
+			result, status := svc.FetchSpec(context.TODO(), &testCase.InputFr, headers)
+
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedStatus, status)
+			assert.Equal(t, testCase.ExpectedETag, testCase.InputFr.ETag)
+			assert.Equal(t, testCase.ExpectedLastModified, testCase.InputFr.LastModified)
+
+			_, ok := headers.Load("If-None-Match")
+			assert.False(t, ok)
+
+			if testCase.ExecutorProviderFunc != nil {
+				mock.AssertExpectationsForObjects(t, executorProviderMock)
+			}
+		})
+	}
+}
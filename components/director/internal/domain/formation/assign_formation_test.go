@@ -3075,7 +3075,7 @@ func TestServiceAssignFormation(t *testing.T) {
 				assignmentOperationService = testCase.AssignmentOperationServiceFn()
 			}
 
-			svc := formation.NewServiceWithAsaEngine(transact, applicationRepository, nil, nil, formationRepo, formationTemplateRepo, labelService, uidService, labelDefService, asaRepo, asaService, tenantSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, nil, nil, notificationSvc, constraintEngine, runtimeType, applicationType, asaEngine, nil, assignmentOperationService)
+			svc := formation.NewServiceWithAsaEngine(transact, applicationRepository, nil, nil, formationRepo, formationTemplateRepo, nil, labelService, uidService, labelDefService, asaRepo, asaService, tenantSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, nil, nil, notificationSvc, constraintEngine, runtimeType, applicationType, asaEngine, nil, assignmentOperationService)
 
 			// WHEN
 			actual, err := svc.AssignFormation(ctxWithTenant, TntInternalID, testCase.ObjectID, testCase.ObjectType, testCase.InputFormation, initialConfigurations)
@@ -3094,3 +3094,194 @@ func TestServiceAssignFormation(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceValidateAssignment(t *testing.T) {
+	ctx := tenant.SaveToContext(context.TODO(), TntInternalID, TntExternalID)
+	testErr := errors.New("test error")
+
+	inputFormation := model.Formation{Name: testFormationName}
+	formationModel := fixFormationModelWithState(model.ReadyFormationState)
+	deletingFormation := fixFormationModelWithState(model.DeletingFormationState)
+	formationTemplateModel := &model.FormationTemplate{
+		ID:                     FormationTemplateID,
+		Name:                   testFormationTemplateName,
+		RuntimeArtifactKind:    &subscriptionRuntimeArtifactKind,
+		RuntimeTypeDisplayName: runtimeTypeDisplayName,
+		RuntimeTypes:           []string{runtimeType},
+		ApplicationTypes:       []string{applicationType},
+	}
+	formationTemplateNotSupportingRuntime := &model.FormationTemplate{
+		ID:               FormationTemplateID,
+		Name:             testFormationTemplateName,
+		RuntimeTypes:     []string{},
+		ApplicationTypes: []string{applicationType},
+	}
+
+	applicationTypeLblInput := &model.LabelInput{Key: applicationType, ObjectID: ApplicationID, ObjectType: model.ApplicationLabelableObject}
+	applicationTypeLbl := &model.Label{Key: applicationType, Value: applicationType, ObjectID: ApplicationID, ObjectType: model.ApplicationLabelableObject}
+
+	formationRepoFn := func(formation *model.Formation) func() *automock.FormationRepository {
+		return func() *automock.FormationRepository {
+			repo := &automock.FormationRepository{}
+			repo.On("GetByName", ctx, testFormationName, TntInternalID).Return(formation, nil).Once()
+			return repo
+		}
+	}
+	formationTemplateRepoFn := func(formationTemplate *model.FormationTemplate) func() *automock.FormationTemplateRepository {
+		return func() *automock.FormationTemplateRepository {
+			repo := &automock.FormationTemplateRepository{}
+			repo.On("Get", ctx, FormationTemplateID).Return(formationTemplate, nil).Once()
+			return repo
+		}
+	}
+
+	testCases := []struct {
+		Name                          string
+		ObjectType                    graphql.FormationObjectType
+		ObjectID                      string
+		ApplicationRepoFn             func() *automock.ApplicationRepository
+		LabelServiceFn                func() *automock.LabelService
+		FormationRepositoryFn         func() *automock.FormationRepository
+		FormationTemplateRepositoryFn func() *automock.FormationTemplateRepository
+		ConstraintEngineFn            func() *automock.ConstraintEngine
+		ExpectedErrMessage            string
+	}{
+		{
+			Name:                          "Success for application",
+			ObjectType:                    graphql.FormationObjectTypeApplication,
+			ObjectID:                      ApplicationID,
+			ApplicationRepoFn:             expectEmptySliceApplicationAndReadyApplicationRepo,
+			FormationRepositoryFn:         formationRepoFn(formationModel),
+			FormationTemplateRepositoryFn: formationTemplateRepoFn(formationTemplateModel),
+			LabelServiceFn: func() *automock.LabelService {
+				labelService := &automock.LabelService{}
+				labelService.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(applicationTypeLbl, nil).Twice()
+				return labelService
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, preAssignLocation, fixAssignAppDetails(testFormationName), FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+		},
+		{
+			Name:       "Error when the application does not exist in the tenant",
+			ObjectType: graphql.FormationObjectTypeApplication,
+			ObjectID:   ApplicationID,
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, TntInternalID, ApplicationID).Return(nil, apperrors.NewNotFoundError(resource.Application, ApplicationID)).Once()
+				return repo
+			},
+			FormationRepositoryFn:         formationRepoFn(formationModel),
+			FormationTemplateRepositoryFn: formationTemplateRepoFn(formationTemplateModel),
+			LabelServiceFn: func() *automock.LabelService {
+				labelService := &automock.LabelService{}
+				labelService.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(applicationTypeLbl, nil).Once()
+				return labelService
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, preAssignLocation, fixAssignAppDetails(testFormationName), FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			ExpectedErrMessage: "while getting application with ID",
+		},
+		{
+			Name:                          "Error when the formation template does not support the object type",
+			ObjectType:                    graphql.FormationObjectTypeRuntime,
+			ObjectID:                      RuntimeID,
+			FormationRepositoryFn:         formationRepoFn(formationModel),
+			FormationTemplateRepositoryFn: formationTemplateRepoFn(formationTemplateNotSupportingRuntime),
+			ExpectedErrMessage:            fmt.Sprintf("does not support resources of type %q", graphql.FormationObjectTypeRuntime),
+		},
+		{
+			Name:                          "Error when enforcing the pre assign constraints fails",
+			ObjectType:                    graphql.FormationObjectTypeApplication,
+			ObjectID:                      ApplicationID,
+			FormationRepositoryFn:         formationRepoFn(formationModel),
+			FormationTemplateRepositoryFn: formationTemplateRepoFn(formationTemplateModel),
+			LabelServiceFn: func() *automock.LabelService {
+				labelService := &automock.LabelService{}
+				labelService.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(applicationTypeLbl, nil).Once()
+				return labelService
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, preAssignLocation, fixAssignAppDetails(testFormationName), FormationTemplateID).Return(testErr).Once()
+				return engine
+			},
+			ExpectedErrMessage: "while enforcing constraints for target operation",
+		},
+		{
+			Name:                          "Error when the formation is being deleted",
+			ObjectType:                    graphql.FormationObjectTypeApplication,
+			ObjectID:                      ApplicationID,
+			FormationRepositoryFn:         formationRepoFn(deletingFormation),
+			FormationTemplateRepositoryFn: formationTemplateRepoFn(formationTemplateModel),
+			LabelServiceFn: func() *automock.LabelService {
+				labelService := &automock.LabelService{}
+				labelService.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(applicationTypeLbl, nil).Once()
+				return labelService
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, preAssignLocation, fixAssignAppDetails(testFormationName), FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			ExpectedErrMessage: fmt.Sprintf("as it is in %q state", model.DeletingFormationState),
+		},
+		{
+			Name:       "Error when getting the formation fails",
+			ObjectType: graphql.FormationObjectTypeApplication,
+			ObjectID:   ApplicationID,
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetByName", ctx, testFormationName, TntInternalID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			applicationRepository := unusedApplicationRepository()
+			if testCase.ApplicationRepoFn != nil {
+				applicationRepository = testCase.ApplicationRepoFn()
+			}
+			labelService := unusedLabelService()
+			if testCase.LabelServiceFn != nil {
+				labelService = testCase.LabelServiceFn()
+			}
+			formationRepo := unusedFormationRepo()
+			if testCase.FormationRepositoryFn != nil {
+				formationRepo = testCase.FormationRepositoryFn()
+			}
+			formationTemplateRepo := unusedFormationTemplateRepo()
+			if testCase.FormationTemplateRepositoryFn != nil {
+				formationTemplateRepo = testCase.FormationTemplateRepositoryFn()
+			}
+			constraintEngine := unusedConstraintEngine()
+			if testCase.ConstraintEngineFn != nil {
+				constraintEngine = testCase.ConstraintEngineFn()
+			}
+
+			svc := formation.NewServiceWithAsaEngine(nil, applicationRepository, nil, nil, formationRepo, formationTemplateRepo, nil, labelService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, constraintEngine, runtimeType, applicationType, nil, nil, nil)
+
+			// WHEN
+			err := svc.ValidateAssignment(ctx, TntInternalID, testCase.ObjectID, testCase.ObjectType, inputFormation)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, applicationRepository, labelService, formationRepo, formationTemplateRepo, constraintEngine)
+		})
+	}
+}
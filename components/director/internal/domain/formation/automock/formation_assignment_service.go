@@ -4,16 +4,13 @@ package automock
 
 import (
 	context "context"
+	time "time"
 
 	formationassignment "github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
-
-	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
-
-	mock "github.com/stretchr/testify/mock"
-
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // FormationAssignmentService is an autogenerated mock type for the formationAssignmentService type
@@ -25,10 +22,6 @@ type FormationAssignmentService struct {
 func (_m *FormationAssignmentService) CleanupFormationAssignment(ctx context.Context, mappingPair *formationassignment.AssignmentMappingPairWithOperation) (bool, error) {
 	ret := _m.Called(ctx, mappingPair)
 
-	if len(ret) == 0 {
-		panic("no return value specified for CleanupFormationAssignment")
-	}
-
 	var r0 bool
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *formationassignment.AssignmentMappingPairWithOperation) (bool, error)); ok {
@@ -53,10 +46,6 @@ func (_m *FormationAssignmentService) CleanupFormationAssignment(ctx context.Con
 func (_m *FormationAssignmentService) Delete(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
 
-	if len(ret) == 0 {
-		panic("no return value specified for Delete")
-	}
-
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
 		r0 = rf(ctx, id)
@@ -71,10 +60,6 @@ func (_m *FormationAssignmentService) Delete(ctx context.Context, id string) err
 func (_m *FormationAssignmentService) DeleteAssignmentsForObjectID(ctx context.Context, formationID string, objectID string) error {
 	ret := _m.Called(ctx, formationID, objectID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for DeleteAssignmentsForObjectID")
-	}
-
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
 		r0 = rf(ctx, formationID, objectID)
@@ -85,21 +70,17 @@ func (_m *FormationAssignmentService) DeleteAssignmentsForObjectID(ctx context.C
 	return r0
 }
 
-// GenerateAssignments provides a mock function with given fields: ctx, tnt, objectID, objectType, _a4, initialConfigurations
-func (_m *FormationAssignmentService) GenerateAssignments(ctx context.Context, tnt string, objectID string, objectType graphql.FormationObjectType, _a4 *model.Formation, initialConfigurations model.InitialConfigurations) ([]*model.FormationAssignmentInput, error) {
-	ret := _m.Called(ctx, tnt, objectID, objectType, _a4, initialConfigurations)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GenerateAssignments")
-	}
+// GenerateAssignments provides a mock function with given fields: ctx, tnt, objectID, objectType, formation, initialConfigurations
+func (_m *FormationAssignmentService) GenerateAssignments(ctx context.Context, tnt string, objectID string, objectType graphql.FormationObjectType, formation *model.Formation, initialConfigurations model.InitialConfigurations) ([]*model.FormationAssignmentInput, error) {
+	ret := _m.Called(ctx, tnt, objectID, objectType, formation, initialConfigurations)
 
 	var r0 []*model.FormationAssignmentInput
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, graphql.FormationObjectType, *model.Formation, model.InitialConfigurations) ([]*model.FormationAssignmentInput, error)); ok {
-		return rf(ctx, tnt, objectID, objectType, _a4, initialConfigurations)
+		return rf(ctx, tnt, objectID, objectType, formation, initialConfigurations)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, graphql.FormationObjectType, *model.Formation, model.InitialConfigurations) []*model.FormationAssignmentInput); ok {
-		r0 = rf(ctx, tnt, objectID, objectType, _a4, initialConfigurations)
+		r0 = rf(ctx, tnt, objectID, objectType, formation, initialConfigurations)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.FormationAssignmentInput)
@@ -107,7 +88,7 @@ func (_m *FormationAssignmentService) GenerateAssignments(ctx context.Context, t
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, string, graphql.FormationObjectType, *model.Formation, model.InitialConfigurations) error); ok {
-		r1 = rf(ctx, tnt, objectID, objectType, _a4, initialConfigurations)
+		r1 = rf(ctx, tnt, objectID, objectType, formation, initialConfigurations)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -119,10 +100,6 @@ func (_m *FormationAssignmentService) GenerateAssignments(ctx context.Context, t
 func (_m *FormationAssignmentService) GetAssignmentsForFormation(ctx context.Context, tenantID string, formationID string) ([]*model.FormationAssignment, error) {
 	ret := _m.Called(ctx, tenantID, formationID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetAssignmentsForFormation")
-	}
-
 	var r0 []*model.FormationAssignment
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*model.FormationAssignment, error)); ok {
@@ -149,10 +126,6 @@ func (_m *FormationAssignmentService) GetAssignmentsForFormation(ctx context.Con
 func (_m *FormationAssignmentService) GetAssignmentsForFormationWithStates(ctx context.Context, tenantID string, formationID string, states []string) ([]*model.FormationAssignment, error) {
 	ret := _m.Called(ctx, tenantID, formationID, states)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetAssignmentsForFormationWithStates")
-	}
-
 	var r0 []*model.FormationAssignment
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) ([]*model.FormationAssignment, error)); ok {
@@ -179,10 +152,6 @@ func (_m *FormationAssignmentService) GetAssignmentsForFormationWithStates(ctx c
 func (_m *FormationAssignmentService) GetForFormation(ctx context.Context, id string, formationID string) (*model.FormationAssignment, error) {
 	ret := _m.Called(ctx, id, formationID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetForFormation")
-	}
-
 	var r0 *model.FormationAssignment
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.FormationAssignment, error)); ok {
@@ -209,10 +178,6 @@ func (_m *FormationAssignmentService) GetForFormation(ctx context.Context, id st
 func (_m *FormationAssignmentService) GetReverseBySourceAndTarget(ctx context.Context, formationID string, sourceID string, targetID string) (*model.FormationAssignment, error) {
 	ret := _m.Called(ctx, formationID, sourceID, targetID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetReverseBySourceAndTarget")
-	}
-
 	var r0 *model.FormationAssignment
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*model.FormationAssignment, error)); ok {
@@ -239,10 +204,6 @@ func (_m *FormationAssignmentService) GetReverseBySourceAndTarget(ctx context.Co
 func (_m *FormationAssignmentService) ListAllForObjectGlobal(ctx context.Context, objectID string) ([]*model.FormationAssignment, error) {
 	ret := _m.Called(ctx, objectID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListAllForObjectGlobal")
-	}
-
 	var r0 []*model.FormationAssignment
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.FormationAssignment, error)); ok {
@@ -269,10 +230,6 @@ func (_m *FormationAssignmentService) ListAllForObjectGlobal(ctx context.Context
 func (_m *FormationAssignmentService) ListByFormationIDs(ctx context.Context, formationIDs []string, pageSize int, cursor string) ([]*model.FormationAssignmentPage, error) {
 	ret := _m.Called(ctx, formationIDs, pageSize, cursor)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListByFormationIDs")
-	}
-
 	var r0 []*model.FormationAssignmentPage
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, []string, int, string) ([]*model.FormationAssignmentPage, error)); ok {
@@ -299,10 +256,6 @@ func (_m *FormationAssignmentService) ListByFormationIDs(ctx context.Context, fo
 func (_m *FormationAssignmentService) ListByFormationIDsNoPaging(ctx context.Context, formationIDs []string) ([][]*model.FormationAssignment, error) {
 	ret := _m.Called(ctx, formationIDs)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListByFormationIDsNoPaging")
-	}
-
 	var r0 [][]*model.FormationAssignment
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, []string) ([][]*model.FormationAssignment, error)); ok {
@@ -329,10 +282,6 @@ func (_m *FormationAssignmentService) ListByFormationIDsNoPaging(ctx context.Con
 func (_m *FormationAssignmentService) ListFormationAssignmentsForObjectID(ctx context.Context, formationID string, objectID string) ([]*model.FormationAssignment, error) {
 	ret := _m.Called(ctx, formationID, objectID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListFormationAssignmentsForObjectID")
-	}
-
 	var r0 []*model.FormationAssignment
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*model.FormationAssignment, error)); ok {
@@ -359,10 +308,6 @@ func (_m *FormationAssignmentService) ListFormationAssignmentsForObjectID(ctx co
 func (_m *FormationAssignmentService) PersistAssignments(ctx context.Context, tnt string, assignments []*model.FormationAssignmentInput) ([]*model.FormationAssignment, error) {
 	ret := _m.Called(ctx, tnt, assignments)
 
-	if len(ret) == 0 {
-		panic("no return value specified for PersistAssignments")
-	}
-
 	var r0 []*model.FormationAssignment
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, []*model.FormationAssignmentInput) ([]*model.FormationAssignment, error)); ok {
@@ -389,10 +334,6 @@ func (_m *FormationAssignmentService) PersistAssignments(ctx context.Context, tn
 func (_m *FormationAssignmentService) ProcessFormationAssignmentPair(ctx context.Context, mappingPair *formationassignment.AssignmentMappingPairWithOperation) (bool, error) {
 	ret := _m.Called(ctx, mappingPair)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ProcessFormationAssignmentPair")
-	}
-
 	var r0 bool
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *formationassignment.AssignmentMappingPairWithOperation) (bool, error)); ok {
@@ -417,10 +358,6 @@ func (_m *FormationAssignmentService) ProcessFormationAssignmentPair(ctx context
 func (_m *FormationAssignmentService) ProcessFormationAssignments(ctx context.Context, formationAssignmentsForObject []*model.FormationAssignment, requests []*webhookclient.FormationAssignmentNotificationRequestTargetMapping, operation func(context.Context, *formationassignment.AssignmentMappingPairWithOperation) (bool, error), formationOperation model.FormationOperation) error {
 	ret := _m.Called(ctx, formationAssignmentsForObject, requests, operation, formationOperation)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ProcessFormationAssignments")
-	}
-
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, []*model.FormationAssignment, []*webhookclient.FormationAssignmentNotificationRequestTargetMapping, func(context.Context, *formationassignment.AssignmentMappingPairWithOperation) (bool, error), model.FormationOperation) error); ok {
 		r0 = rf(ctx, formationAssignmentsForObject, requests, operation, formationOperation)
@@ -431,14 +368,38 @@ func (_m *FormationAssignmentService) ProcessFormationAssignments(ctx context.Co
 	return r0
 }
 
+// ScheduleAssignment provides a mock function with given fields: ctx, formationID, objectID, objectType, validFrom, validUntil
+func (_m *FormationAssignmentService) ScheduleAssignment(ctx context.Context, formationID string, objectID string, objectType graphql.FormationObjectType, validFrom *time.Time, validUntil *time.Time) error {
+	ret := _m.Called(ctx, formationID, objectID, objectType, validFrom, validUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, graphql.FormationObjectType, *time.Time, *time.Time) error); ok {
+		r0 = rf(ctx, formationID, objectID, objectType, validFrom, validUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetValidity provides a mock function with given fields: ctx, formationID, objectID, validFrom, validUntil
+func (_m *FormationAssignmentService) SetValidity(ctx context.Context, formationID string, objectID string, validFrom *time.Time, validUntil *time.Time) error {
+	ret := _m.Called(ctx, formationID, objectID, validFrom, validUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *time.Time, *time.Time) error); ok {
+		r0 = rf(ctx, formationID, objectID, validFrom, validUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, id, fa
 func (_m *FormationAssignmentService) Update(ctx context.Context, id string, fa *model.FormationAssignment) error {
 	ret := _m.Called(ctx, id, fa)
 
-	if len(ret) == 0 {
-		panic("no return value specified for Update")
-	}
-
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, *model.FormationAssignment) error); ok {
 		r0 = rf(ctx, id, fa)
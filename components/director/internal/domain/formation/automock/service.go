@@ -5,11 +5,9 @@ package automock
 import (
 	context "context"
 
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
-
 	mock "github.com/stretchr/testify/mock"
-
-	model "github.com/kyma-incubator/compass/components/director/internal/model"
 )
 
 // Service is an autogenerated mock type for the Service type
@@ -17,21 +15,17 @@ type Service struct {
 	mock.Mock
 }
 
-// AssignFormation provides a mock function with given fields: ctx, tnt, objectID, objectType, _a4, initialConfigurations
-func (_m *Service) AssignFormation(ctx context.Context, tnt string, objectID string, objectType graphql.FormationObjectType, _a4 model.Formation, initialConfigurations model.InitialConfigurations) (*model.Formation, error) {
-	ret := _m.Called(ctx, tnt, objectID, objectType, _a4, initialConfigurations)
-
-	if len(ret) == 0 {
-		panic("no return value specified for AssignFormation")
-	}
+// AssignFormation provides a mock function with given fields: ctx, tnt, objectID, objectType, formation, initialConfigurations
+func (_m *Service) AssignFormation(ctx context.Context, tnt string, objectID string, objectType graphql.FormationObjectType, formation model.Formation, initialConfigurations model.InitialConfigurations) (*model.Formation, error) {
+	ret := _m.Called(ctx, tnt, objectID, objectType, formation, initialConfigurations)
 
 	var r0 *model.Formation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, graphql.FormationObjectType, model.Formation, model.InitialConfigurations) (*model.Formation, error)); ok {
-		return rf(ctx, tnt, objectID, objectType, _a4, initialConfigurations)
+		return rf(ctx, tnt, objectID, objectType, formation, initialConfigurations)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, graphql.FormationObjectType, model.Formation, model.InitialConfigurations) *model.Formation); ok {
-		r0 = rf(ctx, tnt, objectID, objectType, _a4, initialConfigurations)
+		r0 = rf(ctx, tnt, objectID, objectType, formation, initialConfigurations)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Formation)
@@ -39,7 +33,7 @@ func (_m *Service) AssignFormation(ctx context.Context, tnt string, objectID str
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, string, graphql.FormationObjectType, model.Formation, model.InitialConfigurations) error); ok {
-		r1 = rf(ctx, tnt, objectID, objectType, _a4, initialConfigurations)
+		r1 = rf(ctx, tnt, objectID, objectType, formation, initialConfigurations)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -47,21 +41,17 @@ func (_m *Service) AssignFormation(ctx context.Context, tnt string, objectID str
 	return r0, r1
 }
 
-// CreateFormation provides a mock function with given fields: ctx, tnt, _a2, templateName
-func (_m *Service) CreateFormation(ctx context.Context, tnt string, _a2 model.Formation, templateName string) (*model.Formation, error) {
-	ret := _m.Called(ctx, tnt, _a2, templateName)
-
-	if len(ret) == 0 {
-		panic("no return value specified for CreateFormation")
-	}
+// CreateFormation provides a mock function with given fields: ctx, tnt, formation, templateName
+func (_m *Service) CreateFormation(ctx context.Context, tnt string, formation model.Formation, templateName string) (*model.Formation, error) {
+	ret := _m.Called(ctx, tnt, formation, templateName)
 
 	var r0 *model.Formation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, model.Formation, string) (*model.Formation, error)); ok {
-		return rf(ctx, tnt, _a2, templateName)
+		return rf(ctx, tnt, formation, templateName)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, model.Formation, string) *model.Formation); ok {
-		r0 = rf(ctx, tnt, _a2, templateName)
+		r0 = rf(ctx, tnt, formation, templateName)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Formation)
@@ -69,7 +59,7 @@ func (_m *Service) CreateFormation(ctx context.Context, tnt string, _a2 model.Fo
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, model.Formation, string) error); ok {
-		r1 = rf(ctx, tnt, _a2, templateName)
+		r1 = rf(ctx, tnt, formation, templateName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -77,21 +67,17 @@ func (_m *Service) CreateFormation(ctx context.Context, tnt string, _a2 model.Fo
 	return r0, r1
 }
 
-// DeleteFormation provides a mock function with given fields: ctx, tnt, _a2
-func (_m *Service) DeleteFormation(ctx context.Context, tnt string, _a2 model.Formation) (*model.Formation, error) {
-	ret := _m.Called(ctx, tnt, _a2)
-
-	if len(ret) == 0 {
-		panic("no return value specified for DeleteFormation")
-	}
+// DeleteFormation provides a mock function with given fields: ctx, tnt, formation
+func (_m *Service) DeleteFormation(ctx context.Context, tnt string, formation model.Formation) (*model.Formation, error) {
+	ret := _m.Called(ctx, tnt, formation)
 
 	var r0 *model.Formation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, model.Formation) (*model.Formation, error)); ok {
-		return rf(ctx, tnt, _a2)
+		return rf(ctx, tnt, formation)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, model.Formation) *model.Formation); ok {
-		r0 = rf(ctx, tnt, _a2)
+		r0 = rf(ctx, tnt, formation)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Formation)
@@ -99,7 +85,7 @@ func (_m *Service) DeleteFormation(ctx context.Context, tnt string, _a2 model.Fo
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, model.Formation) error); ok {
-		r1 = rf(ctx, tnt, _a2)
+		r1 = rf(ctx, tnt, formation)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -111,10 +97,6 @@ func (_m *Service) DeleteFormation(ctx context.Context, tnt string, _a2 model.Fo
 func (_m *Service) FinalizeDraftFormation(ctx context.Context, formationID string) (*model.Formation, error) {
 	ret := _m.Called(ctx, formationID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for FinalizeDraftFormation")
-	}
-
 	var r0 *model.Formation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Formation, error)); ok {
@@ -141,10 +123,6 @@ func (_m *Service) FinalizeDraftFormation(ctx context.Context, formationID strin
 func (_m *Service) Get(ctx context.Context, id string) (*model.Formation, error) {
 	ret := _m.Called(ctx, id)
 
-	if len(ret) == 0 {
-		panic("no return value specified for Get")
-	}
-
 	var r0 *model.Formation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Formation, error)); ok {
@@ -171,10 +149,6 @@ func (_m *Service) Get(ctx context.Context, id string) (*model.Formation, error)
 func (_m *Service) GetFormationByName(ctx context.Context, formationName string, tnt string) (*model.Formation, error) {
 	ret := _m.Called(ctx, formationName, tnt)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetFormationByName")
-	}
-
 	var r0 *model.Formation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Formation, error)); ok {
@@ -201,10 +175,6 @@ func (_m *Service) GetFormationByName(ctx context.Context, formationName string,
 func (_m *Service) GetGlobalByID(ctx context.Context, id string) (*model.Formation, error) {
 	ret := _m.Called(ctx, id)
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetGlobalByID")
-	}
-
 	var r0 *model.Formation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Formation, error)); ok {
@@ -231,10 +201,6 @@ func (_m *Service) GetGlobalByID(ctx context.Context, id string) (*model.Formati
 func (_m *Service) List(ctx context.Context, pageSize int, cursor string) (*model.FormationPage, error) {
 	ret := _m.Called(ctx, pageSize, cursor)
 
-	if len(ret) == 0 {
-		panic("no return value specified for List")
-	}
-
 	var r0 *model.FormationPage
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*model.FormationPage, error)); ok {
@@ -261,10 +227,6 @@ func (_m *Service) List(ctx context.Context, pageSize int, cursor string) (*mode
 func (_m *Service) ListFormationsForObjectGlobal(ctx context.Context, objectID string) ([]*model.Formation, error) {
 	ret := _m.Called(ctx, objectID)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ListFormationsForObjectGlobal")
-	}
-
 	var r0 []*model.Formation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.Formation, error)); ok {
@@ -291,10 +253,6 @@ func (_m *Service) ListFormationsForObjectGlobal(ctx context.Context, objectID s
 func (_m *Service) ResynchronizeFormationNotifications(ctx context.Context, formationID string, reset bool) (*model.Formation, error) {
 	ret := _m.Called(ctx, formationID, reset)
 
-	if len(ret) == 0 {
-		panic("no return value specified for ResynchronizeFormationNotifications")
-	}
-
 	var r0 *model.Formation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (*model.Formation, error)); ok {
@@ -317,21 +275,17 @@ func (_m *Service) ResynchronizeFormationNotifications(ctx context.Context, form
 	return r0, r1
 }
 
-// UnassignFormation provides a mock function with given fields: ctx, tnt, objectID, objectType, _a4, ignoreASA
-func (_m *Service) UnassignFormation(ctx context.Context, tnt string, objectID string, objectType graphql.FormationObjectType, _a4 model.Formation, ignoreASA bool) (*model.Formation, error) {
-	ret := _m.Called(ctx, tnt, objectID, objectType, _a4, ignoreASA)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UnassignFormation")
-	}
+// UnassignFormation provides a mock function with given fields: ctx, tnt, objectID, objectType, formation, ignoreASA
+func (_m *Service) UnassignFormation(ctx context.Context, tnt string, objectID string, objectType graphql.FormationObjectType, formation model.Formation, ignoreASA bool) (*model.Formation, error) {
+	ret := _m.Called(ctx, tnt, objectID, objectType, formation, ignoreASA)
 
 	var r0 *model.Formation
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, graphql.FormationObjectType, model.Formation, bool) (*model.Formation, error)); ok {
-		return rf(ctx, tnt, objectID, objectType, _a4, ignoreASA)
+		return rf(ctx, tnt, objectID, objectType, formation, ignoreASA)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, graphql.FormationObjectType, model.Formation, bool) *model.Formation); ok {
-		r0 = rf(ctx, tnt, objectID, objectType, _a4, ignoreASA)
+		r0 = rf(ctx, tnt, objectID, objectType, formation, ignoreASA)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Formation)
@@ -339,7 +293,7 @@ func (_m *Service) UnassignFormation(ctx context.Context, tnt string, objectID s
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, string, graphql.FormationObjectType, model.Formation, bool) error); ok {
-		r1 = rf(ctx, tnt, objectID, objectType, _a4, ignoreASA)
+		r1 = rf(ctx, tnt, objectID, objectType, formation, ignoreASA)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -347,6 +301,20 @@ func (_m *Service) UnassignFormation(ctx context.Context, tnt string, objectID s
 	return r0, r1
 }
 
+// ValidateAssignment provides a mock function with given fields: ctx, tnt, objectID, objectType, formation
+func (_m *Service) ValidateAssignment(ctx context.Context, tnt string, objectID string, objectType graphql.FormationObjectType, formation model.Formation) error {
+	ret := _m.Called(ctx, tnt, objectID, objectType, formation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, graphql.FormationObjectType, model.Formation) error); ok {
+		r0 = rf(ctx, tnt, objectID, objectType, formation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewService(t interface {
@@ -50,6 +50,7 @@ func (c *converter) ToGraphQL(i *model.Formation) (*graphql.Formation, error) {
 		Error:                         formationErr,
 		LastStateChangeTimestamp:      graphql.TimePtrToGraphqlTimestampPtr(i.LastStateChangeTimestamp),
 		LastNotificationSentTimestamp: graphql.TimePtrToGraphqlTimestampPtr(i.LastNotificationSentTimestamp),
+		FormationTemplateVersion:      i.FormationTemplateVersion,
 	}, nil
 }
 
@@ -88,6 +89,7 @@ func (c *converter) ToEntity(in *model.Formation) *Entity {
 		Error:                         repo.NewNullableStringFromJSONRawMessage(in.Error),
 		LastStateChangeTimestamp:      in.LastStateChangeTimestamp,
 		LastNotificationSentTimestamp: in.LastNotificationSentTimestamp,
+		FormationTemplateVersion:      in.FormationTemplateVersion,
 	}
 }
 
@@ -105,5 +107,6 @@ func (c *converter) FromEntity(entity *Entity) *model.Formation {
 		Error:                         repo.JSONRawMessageFromNullableString(entity.Error),
 		LastStateChangeTimestamp:      entity.LastStateChangeTimestamp,
 		LastNotificationSentTimestamp: entity.LastNotificationSentTimestamp,
+		FormationTemplateVersion:      entity.FormationTemplateVersion,
 	}
 }
@@ -15,6 +15,7 @@ type Entity struct {
 	Error                         sql.NullString `db:"error"`
 	LastStateChangeTimestamp      *time.Time     `db:"last_state_change_timestamp"`
 	LastNotificationSentTimestamp *time.Time     `db:"last_notification_sent_timestamp"`
+	FormationTemplateVersion      int            `db:"formation_template_version"`
 }
 
 // EntityCollection is a collection of formation entities.
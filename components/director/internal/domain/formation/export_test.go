@@ -1,15 +1,21 @@
 package formation
 
-import "github.com/kyma-incubator/compass/components/director/pkg/persistence"
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
 
 // NewServiceWithAsaEngine creates formation service with the provided ASAEngine
-func NewServiceWithAsaEngine(transact persistence.Transactioner, applicationRepository applicationRepository, labelDefRepository labelDefRepository, labelRepository labelRepository, formationRepository FormationRepository, formationTemplateRepository FormationTemplateRepository, labelService labelService, uuidService uuidService, labelDefService labelDefService, asaRepo automaticFormationAssignmentRepository, asaService automaticFormationAssignmentService, tenantSvc tenantService, runtimeRepo runtimeRepository, runtimeContextRepo runtimeContextRepository, formationAssignmentService formationAssignmentService, webhookRepository webhookRepository, formationAssignmentNotificationService FormationAssignmentNotificationsService, notificationsService NotificationsService, constraintEngine constraintEngine, runtimeTypeLabelKey, applicationTypeLabelKey string, engine asaEngine, statusService statusService, operationService assignmentOperationService) *service {
+func NewServiceWithAsaEngine(transact persistence.Transactioner, applicationRepository applicationRepository, labelDefRepository labelDefRepository, labelRepository labelRepository, formationRepository FormationRepository, formationTemplateRepository FormationTemplateRepository, formationTemplateVersionRepository FormationTemplateVersionRepository, labelService labelService, uuidService uuidService, labelDefService labelDefService, asaRepo automaticFormationAssignmentRepository, asaService automaticFormationAssignmentService, tenantSvc tenantService, runtimeRepo runtimeRepository, runtimeContextRepo runtimeContextRepository, formationAssignmentService formationAssignmentService, webhookRepository webhookRepository, formationAssignmentNotificationService FormationAssignmentNotificationsService, notificationsService NotificationsService, constraintEngine constraintEngine, runtimeTypeLabelKey, applicationTypeLabelKey string, engine asaEngine, statusService statusService, operationService assignmentOperationService) *service {
 	return &service{
 		applicationRepository:                  applicationRepository,
 		labelDefRepository:                     labelDefRepository,
 		labelRepository:                        labelRepository,
 		formationRepository:                    formationRepository,
 		formationTemplateRepository:            formationTemplateRepository,
+		formationTemplateVersionRepository:     formationTemplateVersionRepository,
 		labelService:                           labelService,
 		labelDefService:                        labelDefService,
 		asaService:                             asaService,
@@ -31,3 +37,8 @@ func NewServiceWithAsaEngine(transact persistence.Transactioner, applicationRepo
 		assignmentOperationService:             operationService,
 	}
 }
+
+// ListFormationTemplateWebhooks exposes listFormationTemplateWebhooks for testing
+func (s *service) ListFormationTemplateWebhooks(ctx context.Context, formation *model.Formation, formationTemplateID string) ([]*model.Webhook, error) {
+	return s.listFormationTemplateWebhooks(ctx, formation, formationTemplateID)
+}
@@ -90,8 +90,9 @@ const (
 	TargetTenant            = "targetTenant" // used as "assigning tenant" in formation scenarios/flows
 
 	// Formation Template constants
-	FormationTemplateID       = "bda5378d-caa1-4ee4-b8bf-f733e180fbf9"
-	testFormationTemplateName = "test-formation-template-name"
+	FormationTemplateID          = "bda5378d-caa1-4ee4-b8bf-f733e180fbf9"
+	testFormationTemplateName    = "test-formation-template-name"
+	testFormationTemplateVersion = 2
 
 	// Formation Assignment constants
 	FormationAssignmentID          = "FormationAssignmentID"
@@ -1081,7 +1082,7 @@ func fixUUID() string {
 }
 
 func fixColumns() []string {
-	return []string{"id", "tenant_id", "formation_template_id", "name", "state", "error", "last_state_change_timestamp", "last_notification_sent_timestamp"}
+	return []string{"id", "tenant_id", "formation_template_id", "name", "state", "error", "last_state_change_timestamp", "last_notification_sent_timestamp", "formation_template_version"}
 }
 
 func fixScenariosLabelDefinition(tenantID string, schema interface{}) model.LabelDefinition {
@@ -1353,6 +1354,7 @@ func fixFormationModel() *model.Formation {
 		Error:                         json.RawMessage(testFormationEmptyError),
 		LastStateChangeTimestamp:      &defaultTime,
 		LastNotificationSentTimestamp: &defaultTime,
+		FormationTemplateVersion:      testFormationTemplateVersion,
 	}
 }
 
@@ -1404,6 +1406,7 @@ func fixFormationEntity() *formation.Entity {
 		Error:                         repo.NewNullableStringFromJSONRawMessage(json.RawMessage(testFormationEmptyError)),
 		LastStateChangeTimestamp:      &defaultTime,
 		LastNotificationSentTimestamp: &defaultTime,
+		FormationTemplateVersion:      testFormationTemplateVersion,
 	}
 }
 
@@ -1416,6 +1419,7 @@ func fixGqlFormation() *graphql.Formation {
 		State:                         string(model.InitialFormationState),
 		LastStateChangeTimestamp:      graphql.TimePtrToGraphqlTimestampPtr(&defaultTime),
 		LastNotificationSentTimestamp: graphql.TimePtrToGraphqlTimestampPtr(&defaultTime),
+		FormationTemplateVersion:      testFormationTemplateVersion,
 	}
 }
 
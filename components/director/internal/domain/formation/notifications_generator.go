@@ -613,13 +613,14 @@ func (ns *NotificationsGenerator) GenerateNotificationsAboutApplicationsForTheRu
 // GenerateFormationLifecycleNotifications generates formation notifications for the provided webhooks
 func (ns *NotificationsGenerator) GenerateFormationLifecycleNotifications(ctx context.Context, formationTemplateWebhooks []*model.Webhook, tenantID string, formation *model.Formation, formationTemplateName, formationTemplateID string, formationOperation model.FormationOperation, customerTenantContext *webhookdir.CustomerTenantContext) ([]*webhookclient.FormationNotificationRequest, error) {
 	details := &formationconstraint.GenerateFormationNotificationOperationDetails{
-		Operation:             formationOperation,
-		FormationID:           formation.ID,
-		FormationName:         formation.Name,
-		FormationType:         formationTemplateName,
-		FormationTemplateID:   formationTemplateID,
-		TenantID:              tenantID,
-		CustomerTenantContext: customerTenantContext,
+		Operation:                formationOperation,
+		FormationID:              formation.ID,
+		FormationName:            formation.Name,
+		FormationType:            formationTemplateName,
+		FormationTemplateID:      formationTemplateID,
+		TenantID:                 tenantID,
+		FormationTemplateVersion: formation.FormationTemplateVersion,
+		CustomerTenantContext:    customerTenantContext,
 	}
 
 	reqs, err := ns.notificationBuilder.BuildFormationNotificationRequests(ctx, details, formation, formationTemplateWebhooks)
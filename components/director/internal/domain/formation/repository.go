@@ -27,9 +27,9 @@ const (
 )
 
 var (
-	updatableTableColumns = []string{"name", "state", "error", "last_state_change_timestamp", "last_notification_sent_timestamp"}
+	updatableTableColumns = []string{"name", "state", "error", "last_state_change_timestamp", "last_notification_sent_timestamp", "formation_template_version"}
 	idTableColumns        = []string{"id"}
-	tableColumns          = []string{"id", "tenant_id", "formation_template_id", "name", "state", "error", "last_state_change_timestamp", "last_notification_sent_timestamp"}
+	tableColumns          = []string{"id", "tenant_id", "formation_template_id", "name", "state", "error", "last_state_change_timestamp", "last_notification_sent_timestamp", "formation_template_version"}
 	tenantColumn          = "tenant_id"
 	formationNameColumn   = "name"
 	idTableColumn         = "id"
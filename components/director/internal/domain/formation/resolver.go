@@ -3,6 +3,7 @@ package formation
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
 
@@ -30,6 +31,7 @@ type Service interface {
 	CreateFormation(ctx context.Context, tnt string, formation model.Formation, templateName string) (*model.Formation, error)
 	DeleteFormation(ctx context.Context, tnt string, formation model.Formation) (*model.Formation, error)
 	AssignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation, initialConfigurations model.InitialConfigurations) (*model.Formation, error)
+	ValidateAssignment(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation) error
 	UnassignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation, ignoreASA bool) (*model.Formation, error)
 	ResynchronizeFormationNotifications(ctx context.Context, formationID string, reset bool) (*model.Formation, error)
 	FinalizeDraftFormation(ctx context.Context, formationID string) (*model.Formation, error)
@@ -62,6 +64,8 @@ type formationAssignmentService interface {
 	Update(ctx context.Context, id string, fa *model.FormationAssignment) error
 	GetAssignmentsForFormationWithStates(ctx context.Context, tenantID, formationID string, states []string) ([]*model.FormationAssignment, error)
 	GetReverseBySourceAndTarget(ctx context.Context, formationID, sourceID, targetID string) (*model.FormationAssignment, error)
+	ScheduleAssignment(ctx context.Context, formationID, objectID string, objectType graphql.FormationObjectType, validFrom, validUntil *time.Time) error
+	SetValidity(ctx context.Context, formationID, objectID string, validFrom, validUntil *time.Time) error
 }
 
 // FormationAssignmentConverter converts FormationAssignment between the model.FormationAssignment service-layer representation and graphql.FormationAssignment.
@@ -276,12 +280,17 @@ func (r *Resolver) DeleteFormation(ctx context.Context, formation graphql.Format
 }
 
 // AssignFormation assigns object to the provided formation
-func (r *Resolver) AssignFormation(ctx context.Context, objectID string, objectType graphql.FormationObjectType, formation graphql.FormationInput, initialConfigurations []*graphql.InitialConfiguration) (*graphql.Formation, error) {
+func (r *Resolver) AssignFormation(ctx context.Context, objectID string, objectType graphql.FormationObjectType, formation graphql.FormationInput, initialConfigurations []*graphql.InitialConfiguration, validFrom, validUntil *graphql.Timestamp) (*graphql.Formation, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	validity, err := newAssignmentValidity(objectType, validFrom, validUntil)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -326,6 +335,26 @@ func (r *Resolver) AssignFormation(ctx context.Context, objectID string, objectT
 		initCfgsSourceToTarget[cfg.SourceID][cfg.TargetID] = initialConfig
 	}
 
+	if validity.isScheduled() {
+		if len(initialConfigurations) > 0 {
+			return nil, apperrors.NewInvalidDataError("initial configurations are not supported for scheduled assignments")
+		}
+
+		if err = r.service.ValidateAssignment(ctx, tnt, objectID, objectType, r.conv.FromGraphQL(formation)); err != nil {
+			return nil, err
+		}
+
+		if err = r.formationAssignmentSvc.ScheduleAssignment(ctx, formationFromDB.ID, objectID, objectType, validity.from, validity.until); err != nil {
+			return nil, err
+		}
+
+		if err = tx.Commit(); err != nil {
+			return nil, errors.Wrap(err, "while committing transaction")
+		}
+
+		return r.conv.ToGraphQL(formationFromDB)
+	}
+
 	tenantMapping, err := r.tenantSvc.GetTenantByID(ctx, tnt)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting parent tenant by internal ID %q...", tnt)
@@ -343,6 +372,12 @@ func (r *Resolver) AssignFormation(ctx context.Context, objectID string, objectT
 		return nil, err
 	}
 
+	if validity.isSet() {
+		if err = r.formationAssignmentSvc.SetValidity(ctx, formationFromDB.ID, objectID, validity.from, validity.until); err != nil {
+			return nil, errors.Wrapf(err, "while setting the validity period of object with ID %q", objectID)
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, errors.Wrap(err, "while committing transaction")
 	}
@@ -672,3 +707,45 @@ func (r *Resolver) FinalizeDraftFormation(ctx context.Context, formationID strin
 
 	return r.conv.ToGraphQL(updatedFormation)
 }
+
+type assignmentValidity struct {
+	from  *time.Time
+	until *time.Time
+	now   time.Time
+}
+
+func newAssignmentValidity(objectType graphql.FormationObjectType, validFrom, validUntil *graphql.Timestamp) (*assignmentValidity, error) {
+	validity := &assignmentValidity{now: Now()}
+	if validFrom != nil {
+		from := time.Time(*validFrom)
+		validity.from = &from
+	}
+	if validUntil != nil {
+		until := time.Time(*validUntil)
+		validity.until = &until
+	}
+
+	if !validity.isSet() {
+		return validity, nil
+	}
+
+	if objectType == graphql.FormationObjectTypeTenant {
+		return nil, apperrors.NewInvalidDataError("validFrom and validUntil are not supported for objects of type %s", graphql.FormationObjectTypeTenant)
+	}
+	if validity.until != nil && !validity.until.After(validity.now) {
+		return nil, apperrors.NewInvalidDataError("validUntil must be in the future")
+	}
+	if validity.from != nil && validity.until != nil && !validity.from.Before(*validity.until) {
+		return nil, apperrors.NewInvalidDataError("validFrom must be before validUntil")
+	}
+
+	return validity, nil
+}
+
+func (v *assignmentValidity) isSet() bool {
+	return v.from != nil || v.until != nil
+}
+
+func (v *assignmentValidity) isScheduled() bool {
+	return v.from != nil && v.from.After(v.now)
+}
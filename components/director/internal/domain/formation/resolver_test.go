@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
@@ -313,6 +314,11 @@ func TestAssignFormation(t *testing.T) {
 	}
 	txGen := txtest.NewTransactionContextGenerator(testErr)
 
+	formation.Now = func() time.Time { return defaultTime }
+	defer func() { formation.Now = time.Now }()
+	validFrom := defaultTime.Add(time.Hour)
+	validUntil := defaultTime.Add(2 * time.Hour)
+
 	testCases := []struct {
 		Name                     string
 		TxFn                     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
@@ -325,9 +331,201 @@ func TestAssignFormation(t *testing.T) {
 		ObjectType               graphql.FormationObjectType
 		Context                  context.Context
 		InitialConfiguration     []*graphql.InitialConfiguration
+		ValidFrom                *graphql.Timestamp
+		ValidUntil               *graphql.Timestamp
 		ExpectedFormation        *graphql.Formation
 		ExpectedErrorMessage     string
 	}{
+		{
+			Name: "successfully scheduled the assignment when validFrom is in the future",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("GetFormationByName", contextThatHasTenant(TntInternalID), testFormationName, TntInternalID).Return(&modelFormation, nil).Once()
+				svc.On("ValidateAssignment", contextThatHasTenant(TntInternalID), TntInternalID, Application3ID, graphql.FormationObjectTypeApplication, modelFormation).Return(nil).Once()
+				return svc
+			},
+			FormationAssignmentSvcFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormation", contextThatHasTenant(TntInternalID), TntInternalID, FormationID).Return(formationAssignments, nil).Once()
+				svc.On("ScheduleAssignment", contextThatHasTenant(TntInternalID), FormationID, Application3ID, graphql.FormationObjectTypeApplication, &validFrom, &validUntil).Return(nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				converter := &automock.Converter{}
+				converter.On("FromGraphQL", formationInput).Return(modelFormation).Once()
+				converter.On("ToGraphQL", &modelFormation).Return(&graphqlFormation, nil).Once()
+				return converter
+			},
+			InputID:           Application3ID,
+			ObjectType:        graphql.FormationObjectTypeApplication,
+			ValidFrom:         graphql.TimePtrToGraphqlTimestampPtr(&validFrom),
+			ValidUntil:        graphql.TimePtrToGraphqlTimestampPtr(&validUntil),
+			ExpectedFormation: &graphqlFormation,
+			Context:           ctxWithTenant,
+		},
+		{
+			Name: "successfully assigned formation with validity period",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("GetFormationByName", contextThatHasTenant(TntInternalID), testFormationName, TntInternalID).Return(&modelFormation, nil).Once()
+				svc.On("AssignFormation", contextThatHasTenant(TntInternalID), TntInternalID, Application3ID, graphql.FormationObjectTypeApplication, modelFormation, model.InitialConfigurations{}).Return(&modelFormation, nil).Once()
+				return svc
+			},
+			FormationAssignmentSvcFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormation", contextThatHasTenant(TntInternalID), TntInternalID, FormationID).Return(formationAssignments, nil).Once()
+				svc.On("SetValidity", contextThatHasTenant(TntInternalID), FormationID, Application3ID, (*time.Time)(nil), &validUntil).Return(nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				converter := &automock.Converter{}
+				converter.On("FromGraphQL", formationInput).Return(modelFormation).Once()
+				converter.On("ToGraphQL", &modelFormation).Return(&graphqlFormation, nil).Once()
+				return converter
+			},
+			TenantSvcFn: func() *automock.TenantSvc {
+				svc := &automock.TenantSvc{}
+				svc.On("GetTenantByID", contextThatHasTenant(TntInternalID), TntInternalID).Return(tenantMapping, nil).Once()
+				return svc
+			},
+			InputID:           Application3ID,
+			ObjectType:        graphql.FormationObjectTypeApplication,
+			ValidUntil:        graphql.TimePtrToGraphqlTimestampPtr(&validUntil),
+			ExpectedFormation: &graphqlFormation,
+			Context:           ctxWithTenant,
+		},
+		{
+			Name: "error when setting the validity period fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("GetFormationByName", contextThatHasTenant(TntInternalID), testFormationName, TntInternalID).Return(&modelFormation, nil).Once()
+				svc.On("AssignFormation", contextThatHasTenant(TntInternalID), TntInternalID, Application3ID, graphql.FormationObjectTypeApplication, modelFormation, model.InitialConfigurations{}).Return(&modelFormation, nil).Once()
+				return svc
+			},
+			FormationAssignmentSvcFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormation", contextThatHasTenant(TntInternalID), TntInternalID, FormationID).Return(formationAssignments, nil).Once()
+				svc.On("SetValidity", contextThatHasTenant(TntInternalID), FormationID, Application3ID, (*time.Time)(nil), &validUntil).Return(testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				converter := &automock.Converter{}
+				converter.On("FromGraphQL", formationInput).Return(modelFormation).Once()
+				return converter
+			},
+			TenantSvcFn: func() *automock.TenantSvc {
+				svc := &automock.TenantSvc{}
+				svc.On("GetTenantByID", contextThatHasTenant(TntInternalID), TntInternalID).Return(tenantMapping, nil).Once()
+				return svc
+			},
+			InputID:              Application3ID,
+			ObjectType:           graphql.FormationObjectTypeApplication,
+			ValidUntil:           graphql.TimePtrToGraphqlTimestampPtr(&validUntil),
+			Context:              ctxWithTenant,
+			ExpectedErrorMessage: "while setting the validity period of object with ID",
+		},
+		{
+			Name: "error when validating the scheduled assignment fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("GetFormationByName", contextThatHasTenant(TntInternalID), testFormationName, TntInternalID).Return(&modelFormation, nil).Once()
+				svc.On("ValidateAssignment", contextThatHasTenant(TntInternalID), TntInternalID, Application3ID, graphql.FormationObjectTypeApplication, modelFormation).Return(testErr).Once()
+				return svc
+			},
+			FormationAssignmentSvcFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormation", contextThatHasTenant(TntInternalID), TntInternalID, FormationID).Return(formationAssignments, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				converter := &automock.Converter{}
+				converter.On("FromGraphQL", formationInput).Return(modelFormation).Once()
+				return converter
+			},
+			InputID:              Application3ID,
+			ObjectType:           graphql.FormationObjectTypeApplication,
+			ValidFrom:            graphql.TimePtrToGraphqlTimestampPtr(&validFrom),
+			Context:              ctxWithTenant,
+			ExpectedErrorMessage: testErr.Error(),
+		},
+		{
+			Name: "error when scheduling the assignment fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("GetFormationByName", contextThatHasTenant(TntInternalID), testFormationName, TntInternalID).Return(&modelFormation, nil).Once()
+				svc.On("ValidateAssignment", contextThatHasTenant(TntInternalID), TntInternalID, Application3ID, graphql.FormationObjectTypeApplication, modelFormation).Return(nil).Once()
+				return svc
+			},
+			FormationAssignmentSvcFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormation", contextThatHasTenant(TntInternalID), TntInternalID, FormationID).Return(formationAssignments, nil).Once()
+				svc.On("ScheduleAssignment", contextThatHasTenant(TntInternalID), FormationID, Application3ID, graphql.FormationObjectTypeApplication, &validFrom, (*time.Time)(nil)).Return(testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				converter := &automock.Converter{}
+				converter.On("FromGraphQL", formationInput).Return(modelFormation).Once()
+				return converter
+			},
+			InputID:              Application3ID,
+			ObjectType:           graphql.FormationObjectTypeApplication,
+			ValidFrom:            graphql.TimePtrToGraphqlTimestampPtr(&validFrom),
+			Context:              ctxWithTenant,
+			ExpectedErrorMessage: testErr.Error(),
+		},
+		{
+			Name: "error when scheduling the assignment with initial configurations",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("GetFormationByName", contextThatHasTenant(TntInternalID), testFormationName, TntInternalID).Return(&modelFormation, nil).Once()
+				return svc
+			},
+			FormationAssignmentSvcFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormation", contextThatHasTenant(TntInternalID), TntInternalID, FormationID).Return(formationAssignments, nil).Once()
+				return svc
+			},
+			InputID:              Application3ID,
+			ObjectType:           graphql.FormationObjectTypeApplication,
+			InitialConfiguration: initialConfigurationsInput,
+			ValidFrom:            graphql.TimePtrToGraphqlTimestampPtr(&validFrom),
+			Context:              ctxWithTenant,
+			ExpectedErrorMessage: "initial configurations are not supported for scheduled assignments",
+		},
+		{
+			Name:                 "error when validity period is provided for ObjectType tenant",
+			TxFn:                 txGen.ThatDoesntStartTransaction,
+			InputID:              Application3ID,
+			ObjectType:           graphql.FormationObjectTypeTenant,
+			ValidUntil:           graphql.TimePtrToGraphqlTimestampPtr(&validUntil),
+			Context:              ctxWithTenant,
+			ExpectedErrorMessage: "validFrom and validUntil are not supported for objects of type TENANT",
+		},
+		{
+			Name:                 "error when validUntil is in the past",
+			TxFn:                 txGen.ThatDoesntStartTransaction,
+			InputID:              Application3ID,
+			ObjectType:           graphql.FormationObjectTypeApplication,
+			ValidUntil:           graphql.TimePtrToGraphqlTimestampPtr(&defaultTime),
+			Context:              ctxWithTenant,
+			ExpectedErrorMessage: "validUntil must be in the future",
+		},
+		{
+			Name:                 "error when validFrom is not before validUntil",
+			TxFn:                 txGen.ThatDoesntStartTransaction,
+			InputID:              Application3ID,
+			ObjectType:           graphql.FormationObjectTypeApplication,
+			ValidFrom:            graphql.TimePtrToGraphqlTimestampPtr(&validUntil),
+			ValidUntil:           graphql.TimePtrToGraphqlTimestampPtr(&validFrom),
+			Context:              ctxWithTenant,
+			ExpectedErrorMessage: "validFrom must be before validUntil",
+		},
 		{
 			Name: "successfully assigned formation",
 			TxFn: txGen.ThatSucceeds,
@@ -657,7 +855,7 @@ func TestAssignFormation(t *testing.T) {
 			resolver := formation.NewResolver(transact, service, converter, formationAssignmentSvc, nil, tenantFetcher, tenantService)
 
 			// WHEN
-			formationResult, err := resolver.AssignFormation(testCase.Context, testCase.InputID, testCase.ObjectType, formationInput, testCase.InitialConfiguration)
+			formationResult, err := resolver.AssignFormation(testCase.Context, testCase.InputID, testCase.ObjectType, formationInput, testCase.InitialConfiguration, testCase.ValidFrom, testCase.ValidUntil)
 
 			// THEN
 			if testCase.ExpectedErrorMessage != "" {
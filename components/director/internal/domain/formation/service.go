@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	formationassignmentpkg "github.com/kyma-incubator/compass/components/director/pkg/formationassignment"
@@ -88,6 +89,14 @@ type FormationTemplateRepository interface {
 	GetByNameAndTenant(ctx context.Context, templateName, tenantID string) (*model.FormationTemplate, error)
 }
 
+// FormationTemplateVersionRepository is responsible for the repo-layer formation template version operations
+//
+//go:generate mockery --name=FormationTemplateVersionRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationTemplateVersionRepository interface {
+	GetByVersion(ctx context.Context, formationTemplateID string, version int) (*model.FormationTemplateVersion, error)
+	GetLatest(ctx context.Context, formationTemplateID string) (*model.FormationTemplateVersion, error)
+}
+
 // NotificationsService represents the notification service for generating and sending notifications
 //
 //go:generate mockery --name=NotificationsService --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -183,6 +192,7 @@ type service struct {
 	labelRepository                        labelRepository
 	formationRepository                    FormationRepository
 	formationTemplateRepository            FormationTemplateRepository
+	formationTemplateVersionRepository     FormationTemplateVersionRepository
 	labelService                           labelService
 	labelDefService                        labelDefService
 	asaService                             automaticFormationAssignmentService
@@ -212,6 +222,7 @@ func NewService(
 	labelRepository labelRepository,
 	formationRepository FormationRepository,
 	formationTemplateRepository FormationTemplateRepository,
+	formationTemplateVersionRepository FormationTemplateVersionRepository,
 	labelService labelService,
 	uuidService uuidService,
 	labelDefService labelDefService,
@@ -234,6 +245,7 @@ func NewService(
 		labelRepository:                        labelRepository,
 		formationRepository:                    formationRepository,
 		formationTemplateRepository:            formationTemplateRepository,
+		formationTemplateVersionRepository:     formationTemplateVersionRepository,
 		labelService:                           labelService,
 		labelDefService:                        labelDefService,
 		asaService:                             asaService,
@@ -433,6 +445,7 @@ func (s *service) CreateFormation(ctx context.Context, tnt string, formation mod
 		}
 	}
 
+	CRUDJoinPointDetails.FormationTemplateVersion = newFormation.FormationTemplateVersion
 	if err = s.constraintEngine.EnforceConstraints(ctx, formationconstraint.PostCreate, CRUDJoinPointDetails, formationTemplateID); err != nil {
 		return nil, errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.CreateFormationOperation, model.PostOperation)
 	}
@@ -456,10 +469,11 @@ func (s *service) DeleteFormation(ctx context.Context, tnt string, formation mod
 	formationTemplateName := ft.formationTemplate.Name
 
 	joinPointDetails := &formationconstraint.CRUDFormationOperationDetails{
-		FormationType:       formationTemplateName,
-		FormationTemplateID: formationTemplateID,
-		FormationName:       formationName,
-		TenantID:            tnt,
+		FormationType:            formationTemplateName,
+		FormationTemplateID:      formationTemplateID,
+		FormationName:            formationName,
+		TenantID:                 tnt,
+		FormationTemplateVersion: ft.formation.FormationTemplateVersion,
 	}
 
 	assignmentsForFormation, err := s.formationAssignmentService.GetAssignmentsForFormation(ctx, tnt, formationID)
@@ -487,9 +501,9 @@ func (s *service) DeleteFormation(ctx context.Context, tnt string, formation mod
 	if formation.State == model.DraftFormationState {
 		log.C(ctx).Infof("Formation is in %q state. Skipping notifications...", model.DraftFormationState)
 	} else {
-		formationTemplateWebhooks, err := s.webhookRepository.ListByReferenceObjectIDGlobal(ctx, formationTemplateID, model.FormationTemplateWebhookReference)
+		formationTemplateWebhooks, err := s.listFormationTemplateWebhooks(ctx, ft.formation, formationTemplateID)
 		if err != nil {
-			return nil, errors.Wrapf(err, "when listing formation lifecycle webhooks for formation template with ID: %q", formationTemplateID)
+			return nil, err
 		}
 
 		if len(formationTemplateWebhooks) > 0 {
@@ -718,6 +732,39 @@ func (s *service) AssignFormation(ctx context.Context, tnt, objectID string, obj
 	return formationFromDB, nil
 }
 
+// ValidateAssignment checks whether the object can be assigned to the formation without assigning it.
+// The object must exist in the tenant, its type must be allowed by the formation template and the pre assign constraints must be satisfied.
+func (s *service) ValidateAssignment(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation) error {
+	ft, err := s.getFormationWithTemplate(ctx, formation.Name, tnt)
+	if err != nil {
+		return errors.Wrapf(err, "while validating the assignment to formation with name %q", formation.Name)
+	}
+
+	if !isObjectTypeSupported(ft.formationTemplate, objectType) {
+		return errors.Errorf("Formation %q of type %q does not support resources of type %q", ft.formation.Name, ft.formationTemplate.Name, objectType)
+	}
+
+	joinPointDetails, err := s.prepareDetailsForAssign(ctx, tnt, objectID, objectType, ft.formation, ft.formationTemplate)
+	if err != nil {
+		return errors.Wrapf(err, "while preparing joinpoint details for target operation %q and constraint type %q", model.AssignFormationOperation, model.PreOperation)
+	}
+
+	if err = s.constraintEngine.EnforceConstraints(ctx, formationconstraint.PreAssign, joinPointDetails, ft.formationTemplate.ID); err != nil {
+		return errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.AssignFormationOperation, model.PreOperation)
+	}
+
+	if ft.formation.State == model.DeletingFormationState || ft.formation.State == model.DeleteErrorFormationState {
+		return fmt.Errorf("cannot assign to formation with ID %q as it is in %q state", ft.formation.ID, ft.formation.State)
+	}
+
+	formationTemplate, err := s.pinFormationTemplate(ctx, ft.formation, ft.formationTemplate)
+	if err != nil {
+		return err
+	}
+
+	return s.checkFormationTemplateTypes(ctx, tnt, objectID, objectType, formationTemplate)
+}
+
 func (s *service) prepareDetailsForAssign(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation *model.Formation, formationTemplate *model.FormationTemplate) (*formationconstraint.AssignFormationOperationDetails, error) {
 	resourceSubtype, err := s.getObjectSubtype(ctx, tnt, objectID, objectType)
 	if err != nil {
@@ -725,14 +772,15 @@ func (s *service) prepareDetailsForAssign(ctx context.Context, tnt, objectID str
 	}
 
 	joinPointDetails := &formationconstraint.AssignFormationOperationDetails{
-		ResourceType:        model.ResourceType(objectType),
-		ResourceSubtype:     resourceSubtype,
-		ResourceID:          objectID,
-		FormationType:       formationTemplate.Name,
-		FormationTemplateID: formationTemplate.ID,
-		FormationID:         formation.ID,
-		FormationName:       formation.Name,
-		TenantID:            tnt,
+		ResourceType:             model.ResourceType(objectType),
+		ResourceSubtype:          resourceSubtype,
+		ResourceID:               objectID,
+		FormationType:            formationTemplate.Name,
+		FormationTemplateID:      formationTemplate.ID,
+		FormationID:              formation.ID,
+		FormationName:            formation.Name,
+		TenantID:                 tnt,
+		FormationTemplateVersion: formation.FormationTemplateVersion,
 	}
 	return joinPointDetails, nil
 }
@@ -744,13 +792,14 @@ func (s *service) prepareDetailsForUnassign(ctx context.Context, tnt, objectID s
 	}
 
 	joinPointDetails := &formationconstraint.UnassignFormationOperationDetails{
-		ResourceType:        model.ResourceType(objectType),
-		ResourceSubtype:     resourceSubtype,
-		ResourceID:          objectID,
-		FormationType:       formationTemplate.Name,
-		FormationTemplateID: formationTemplate.ID,
-		FormationID:         formation.ID,
-		TenantID:            tnt,
+		ResourceType:             model.ResourceType(objectType),
+		ResourceSubtype:          resourceSubtype,
+		ResourceID:               objectID,
+		FormationType:            formationTemplate.Name,
+		FormationTemplateID:      formationTemplate.ID,
+		FormationID:              formation.ID,
+		TenantID:                 tnt,
+		FormationTemplateVersion: formation.FormationTemplateVersion,
 	}
 	return joinPointDetails, nil
 }
@@ -830,6 +879,11 @@ func (s *service) getObjectSubtype(ctx context.Context, tnt, objectID string, ob
 }
 
 func (s *service) assign(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation *model.Formation, formationTemplate *model.FormationTemplate) error {
+	formationTemplate, err := s.pinFormationTemplate(ctx, formation, formationTemplate)
+	if err != nil {
+		return err
+	}
+
 	if err := s.checkFormationTemplateTypes(ctx, tnt, objectID, objectType, formationTemplate); err != nil {
 		return err
 	}
@@ -1221,9 +1275,9 @@ func (s *service) FinalizeDraftFormation(ctx context.Context, formationID string
 
 	formationTemplateID := fTmpl.ID
 
-	formationTemplateWebhooks, err := s.webhookRepository.ListByReferenceObjectIDGlobal(ctx, formationTemplateID, model.FormationTemplateWebhookReference)
+	formationTemplateWebhooks, err := s.listFormationTemplateWebhooks(ctx, formation, formationTemplateID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "when listing formation lifecycle webhooks for formation template with ID: %q", formationTemplateID)
+		return nil, err
 	}
 
 	newState := model.ReadyFormationState
@@ -1273,6 +1327,134 @@ func (s *service) ResynchronizeFormationNotifications(ctx context.Context, forma
 	return s.resynchronizeFormation(ctx, formation, tenantID, shouldReset)
 }
 
+// ResendNotifications moves the ready assignments of the formation back to INITIAL state and sends their notifications again.
+// The configuration of the assignments is left intact. It is used when the webhooks the notifications are generated from have changed.
+func (s *service) ResendNotifications(ctx context.Context, formationID string) (*model.Formation, error) {
+	logger := log.C(ctx).WithField(log.FieldFormationID, formationID)
+	ctx = log.ContextWithLogger(ctx, logger)
+
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	formation, err := s.formationRepository.Get(ctx, formationID, tenantID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation with ID %q for tenant %q", formationID, tenantID)
+	}
+
+	if formation.State == model.DraftFormationState {
+		log.C(ctx).Infof("Formation with ID %q is in %s state, no notifications will be re-sent", formationID, model.DraftFormationState)
+		return formation, nil
+	}
+
+	log.C(ctx).Infof("Re-sending the notifications of formation with ID: %s and name: %s", formationID, formation.Name)
+	if err = s.executeInTransaction(ctx, func(ctxWithTransact context.Context) error {
+		readyAssignments, err := s.formationAssignmentService.GetAssignmentsForFormationWithStates(ctxWithTransact, tenantID, formationID, []string{string(model.ReadyAssignmentState)})
+		if err != nil {
+			return errors.Wrapf(err, "while getting ready formation assignments for formation with ID %q", formationID)
+		}
+
+		for _, assignment := range readyAssignments {
+			assignment.State = string(model.InitialAssignmentState)
+			if err = s.formationAssignmentService.Update(ctxWithTransact, assignment.ID, assignment); err != nil {
+				return errors.Wrapf(err, "while updating formation assignment with ID: '%s' to '%s' state", assignment.ID, assignment.State)
+			}
+			if _, err = s.assignmentOperationService.Create(ctxWithTransact, &model.AssignmentOperationInput{
+				Type:                  model.Assign,
+				FormationAssignmentID: assignment.ID,
+				FormationID:           assignment.FormationID,
+				TriggeredBy:           model.ResyncAssignment,
+			}); err != nil {
+				return errors.Wrapf(err, "while creating %s Operation for assignment with ID: %s", model.Assign, assignment.ID)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.resynchronizeFormation(ctx, formation, tenantID, false)
+}
+
+// ValidateParticipantsForTemplateVersion checks whether the types of all participants of the formation are allowed by the given formation template version
+// and whether the participants satisfy the assign constraints attached to that version. A description of each violation is returned.
+func (s *service) ValidateParticipantsForTemplateVersion(ctx context.Context, formation *model.Formation, version *model.FormationTemplateVersion) ([]string, error) {
+	formationTemplate, err := s.formationTemplateRepository.Get(ctx, formation.FormationTemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation template with ID %q", formation.FormationTemplateID)
+	}
+	versionedTemplate := version.Snapshot.ApplyTo(formationTemplate)
+
+	assignments, err := s.formationAssignmentService.GetAssignmentsForFormation(ctx, formation.TenantID, formation.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation assignments for formation with ID %q", formation.ID)
+	}
+
+	participantIDs := make([]string, 0)
+	participantTypes := make(map[string]model.FormationAssignmentType)
+	addParticipant := func(participantID string, participantType model.FormationAssignmentType) {
+		if _, ok := participantTypes[participantID]; !ok {
+			participantIDs = append(participantIDs, participantID)
+			participantTypes[participantID] = participantType
+		}
+	}
+	for _, assignment := range assignments {
+		addParticipant(assignment.Source, assignment.SourceType)
+		addParticipant(assignment.Target, assignment.TargetType)
+	}
+	sort.Strings(participantIDs)
+
+	violations := make([]string, 0)
+	for _, participantID := range participantIDs {
+		participantType := participantTypes[participantID]
+
+		var validationErr error
+		var objectType graphql.FormationObjectType
+		switch participantType {
+		case model.FormationAssignmentTypeApplication:
+			objectType = graphql.FormationObjectTypeApplication
+			validationErr = s.isValidApplicationType(ctx, formation.TenantID, participantID, versionedTemplate)
+		case model.FormationAssignmentTypeRuntime:
+			objectType = graphql.FormationObjectTypeRuntime
+			validationErr = s.isValidRuntimeType(ctx, formation.TenantID, participantID, versionedTemplate)
+		case model.FormationAssignmentTypeRuntimeContext:
+			objectType = graphql.FormationObjectTypeRuntimeContext
+			runtimeCtx, err := s.runtimeContextRepo.GetByID(ctx, formation.TenantID, participantID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while getting runtime context with ID %q", participantID)
+			}
+			validationErr = s.isValidRuntimeType(ctx, formation.TenantID, runtimeCtx.RuntimeID, versionedTemplate)
+		default:
+			continue
+		}
+
+		if validationErr != nil {
+			if !apperrors.IsNewInvalidOperationError(validationErr) {
+				return nil, validationErr
+			}
+			violations = append(violations, fmt.Sprintf("%s with ID %q: %s", participantType, participantID, validationErr.Error()))
+			continue
+		}
+
+		joinPointDetails, err := s.prepareDetailsForAssign(ctx, formation.TenantID, participantID, objectType, formation, versionedTemplate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while preparing joinpoint details for %s with ID %q", participantType, participantID)
+		}
+		joinPointDetails.FormationTemplateVersion = version.Version
+
+		if err = s.constraintEngine.EnforceConstraints(ctx, formationconstraint.PreAssign, joinPointDetails, formation.FormationTemplateID); err != nil {
+			var constraintErrs *multierror.Error
+			if !errors.As(err, &constraintErrs) {
+				return nil, errors.Wrapf(err, "while enforcing the constraints of version %d for %s with ID %q", version.Version, participantType, participantID)
+			}
+			violations = append(violations, fmt.Sprintf("%s with ID %q: %s", participantType, participantID, constraintErrs.Error()))
+		}
+	}
+
+	return violations, nil
+}
+
 func (s *service) resynchronizeFormation(ctx context.Context, formation *model.Formation, tenantID string, shouldReset bool) (*model.Formation, error) {
 	if formation.State == model.DraftFormationState {
 		return nil, errors.Errorf("Formations in state %s can not be resynchronized", model.DraftFormationState)
@@ -1521,9 +1703,9 @@ func (s *service) resynchronizeFormationNotifications(ctx context.Context, tenan
 	formationTemplateName := fTmpl.Name
 	formationID := formation.ID
 
-	formationTemplateWebhooks, err := s.webhookRepository.ListByReferenceObjectIDGlobal(formationResyncTransactionCtx, formationTemplateID, model.FormationTemplateWebhookReference)
+	formationTemplateWebhooks, err := s.listFormationTemplateWebhooks(formationResyncTransactionCtx, formation, formationTemplateID)
 	if err != nil {
-		return nil, false, errors.Wrapf(err, "when listing formation lifecycle webhooks for formation template with ID: %q", formationTemplateID)
+		return nil, false, err
 	}
 	operation := determineFormationOperationFromState(formation.State)
 	errorState := determineFormationErrorStateFromOperation(operation)
@@ -1876,6 +2058,14 @@ func (s *service) createFormation(ctx context.Context, tenant, templateID, forma
 		State:               state,
 	}
 
+	latestVersion, err := s.formationTemplateVersionRepository.GetLatest(ctx, templateID)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return nil, errors.Wrapf(err, "while getting the latest version of formation template with ID: %q", templateID)
+	}
+	if latestVersion != nil {
+		formation.FormationTemplateVersion = latestVersion.Version
+	}
+
 	log.C(ctx).Debugf("Creating formation with name: %q and template ID: %q...", formationName, templateID)
 	if err := s.formationRepository.Create(ctx, formation); err != nil {
 		log.C(ctx).Errorf("An error occurred while creating formation with name: %q and template ID: %q", formationName, templateID)
@@ -1906,6 +2096,41 @@ func (s *service) getFormationWithTemplate(ctx context.Context, formationName, t
 	return &formationWithTemplate{formation: formation, formationTemplate: template}, nil
 }
 
+// pinFormationTemplate returns the formation template as described by the version the formation is pinned to.
+// Formations which are not pinned follow the current state of the formation template.
+func (s *service) pinFormationTemplate(ctx context.Context, formation *model.Formation, formationTemplate *model.FormationTemplate) (*model.FormationTemplate, error) {
+	if formation.FormationTemplateVersion == 0 {
+		return formationTemplate, nil
+	}
+
+	version, err := s.formationTemplateVersionRepository.GetByVersion(ctx, formation.FormationTemplateID, formation.FormationTemplateVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting version %d of formation template with ID: %q", formation.FormationTemplateVersion, formation.FormationTemplateID)
+	}
+
+	return version.Snapshot.ApplyTo(formationTemplate), nil
+}
+
+// listFormationTemplateWebhooks returns the lifecycle webhooks of the formation template as described by the version the formation is pinned to.
+// Formations which are not pinned use the current webhooks of the formation template.
+func (s *service) listFormationTemplateWebhooks(ctx context.Context, formation *model.Formation, formationTemplateID string) ([]*model.Webhook, error) {
+	formationTemplateWebhooks, err := s.webhookRepository.ListByReferenceObjectIDGlobal(ctx, formationTemplateID, model.FormationTemplateWebhookReference)
+	if err != nil {
+		return nil, errors.Wrapf(err, "when listing formation lifecycle webhooks for formation template with ID: %q", formationTemplateID)
+	}
+
+	if formation.FormationTemplateVersion == 0 {
+		return formationTemplateWebhooks, nil
+	}
+
+	version, err := s.formationTemplateVersionRepository.GetByVersion(ctx, formationTemplateID, formation.FormationTemplateVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting version %d of formation template with ID: %q", formation.FormationTemplateVersion, formationTemplateID)
+	}
+
+	return version.Snapshot.PinWebhooks(formationTemplateWebhooks, formationTemplateID), nil
+}
+
 func (s *service) isValidRuntimeType(ctx context.Context, tnt string, runtimeID string, formationTemplate *model.FormationTemplate) error {
 	runtimeTypeLabel, err := s.labelService.GetLabel(ctx, tnt, &model.LabelInput{
 		Key:        s.runtimeTypeLabelKey,
@@ -0,0 +1,526 @@
+package formation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	formationconstraintdomain "github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formation"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formation/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceValidateParticipantsForTemplateVersion(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+
+	formationModel := fixFormationModelWithState(model.ReadyFormationState)
+	version := &model.FormationTemplateVersion{
+		FormationTemplateID: FormationTemplateID,
+		Version:             2,
+		Snapshot: model.FormationTemplateSnapshot{
+			ApplicationTypes: []string{applicationType},
+			RuntimeTypes:     []string{runtimeType},
+		},
+	}
+	assignments := []*model.FormationAssignment{
+		fixFormationAssignmentModelWithParameters("id1", FormationID, ApplicationID, RuntimeContextID, model.FormationAssignmentTypeApplication, model.FormationAssignmentTypeRuntimeContext, model.ReadyAssignmentState),
+		fixFormationAssignmentModelWithParameters("id2", FormationID, RuntimeContextID, ApplicationID, model.FormationAssignmentTypeRuntimeContext, model.FormationAssignmentTypeApplication, model.ReadyAssignmentState),
+	}
+
+	applicationTypeLblInput := &model.LabelInput{Key: applicationType, ObjectID: ApplicationID, ObjectType: model.ApplicationLabelableObject}
+	runtimeTypeLblInput := &model.LabelInput{Key: runtimeType, ObjectID: RuntimeContextRuntimeID, ObjectType: model.RuntimeLabelableObject}
+	runtimeContext := &model.RuntimeContext{ID: RuntimeContextID, RuntimeID: RuntimeContextRuntimeID}
+
+	formationTemplateModel := fixFormationTemplateModel()
+	applicationDetails := &formationconstraint.AssignFormationOperationDetails{
+		ResourceType:             model.ApplicationResourceType,
+		ResourceSubtype:          applicationType,
+		ResourceID:               ApplicationID,
+		FormationType:            formationTemplateModel.Name,
+		FormationTemplateID:      FormationTemplateID,
+		FormationID:              FormationID,
+		FormationName:            formationModel.Name,
+		TenantID:                 TntInternalID,
+		FormationTemplateVersion: 2,
+	}
+	runtimeContextDetails := &formationconstraint.AssignFormationOperationDetails{
+		ResourceType:             model.RuntimeContextResourceType,
+		ResourceSubtype:          runtimeType,
+		ResourceID:               RuntimeContextID,
+		FormationType:            formationTemplateModel.Name,
+		FormationTemplateID:      FormationTemplateID,
+		FormationID:              FormationID,
+		FormationName:            formationModel.Name,
+		TenantID:                 TntInternalID,
+		FormationTemplateVersion: 2,
+	}
+	constraintErr := multierror.Append(nil, formationconstraintdomain.ConstraintError{ConstraintName: "constraint", Reason: "Operator \"DoesNotContainResourceOfSubtype\" is not satisfied"})
+
+	formationTemplateRepoFn := func() *automock.FormationTemplateRepository {
+		repo := &automock.FormationTemplateRepository{}
+		repo.On("Get", ctx, FormationTemplateID).Return(fixFormationTemplateModel(), nil).Once()
+		return repo
+	}
+	formationAssignmentSvcFn := func() *automock.FormationAssignmentService {
+		svc := &automock.FormationAssignmentService{}
+		svc.On("GetAssignmentsForFormation", ctx, TntInternalID, FormationID).Return(assignments, nil).Once()
+		return svc
+	}
+
+	testCases := []struct {
+		Name                         string
+		FormationTemplateRepoFn      func() *automock.FormationTemplateRepository
+		FormationAssignmentServiceFn func() *automock.FormationAssignmentService
+		LabelServiceFn               func() *automock.LabelService
+		RuntimeContextRepoFn         func() *automock.RuntimeContextRepository
+		ConstraintEngineFn           func() *automock.ConstraintEngine
+		ExpectedViolations           []string
+		ExpectedErrMessage           string
+	}{
+		{
+			Name:                         "Success when all participants are allowed by the version",
+			FormationTemplateRepoFn:      formationTemplateRepoFn,
+			FormationAssignmentServiceFn: formationAssignmentSvcFn,
+			LabelServiceFn: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(&model.Label{Value: applicationType}, nil).Twice()
+				svc.On("GetLabel", ctx, TntInternalID, runtimeTypeLblInput).Return(&model.Label{Value: runtimeType}, nil).Twice()
+				return svc
+			},
+			RuntimeContextRepoFn: func() *automock.RuntimeContextRepository {
+				repo := &automock.RuntimeContextRepository{}
+				repo.On("GetByID", ctx, TntInternalID, RuntimeContextID).Return(runtimeContext, nil).Twice()
+				return repo
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, formationconstraint.PreAssign, applicationDetails, FormationTemplateID).Return(nil).Once()
+				engine.On("EnforceConstraints", ctx, formationconstraint.PreAssign, runtimeContextDetails, FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			ExpectedViolations: []string{},
+		},
+		{
+			Name:                         "Reports the participants that are not allowed by the version",
+			FormationTemplateRepoFn:      formationTemplateRepoFn,
+			FormationAssignmentServiceFn: formationAssignmentSvcFn,
+			LabelServiceFn: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(&model.Label{Value: applicationType}, nil).Twice()
+				svc.On("GetLabel", ctx, TntInternalID, runtimeTypeLblInput).Return(&model.Label{Value: "unsupported"}, nil).Once()
+				return svc
+			},
+			RuntimeContextRepoFn: func() *automock.RuntimeContextRepository {
+				repo := &automock.RuntimeContextRepository{}
+				repo.On("GetByID", ctx, TntInternalID, RuntimeContextID).Return(runtimeContext, nil).Once()
+				return repo
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, formationconstraint.PreAssign, applicationDetails, FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			ExpectedViolations: []string{`RUNTIME_CONTEXT with ID "rt-ctx-id": The operation is not allowed [reason=unsupported runtimeType "unsupported" for formation template "test-formation-template-name", allowing only ["runtimeType"]]`},
+		},
+		{
+			Name:                         "Reports the participants that do not satisfy the constraints of the version",
+			FormationTemplateRepoFn:      formationTemplateRepoFn,
+			FormationAssignmentServiceFn: formationAssignmentSvcFn,
+			LabelServiceFn: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(&model.Label{Value: applicationType}, nil).Twice()
+				svc.On("GetLabel", ctx, TntInternalID, runtimeTypeLblInput).Return(&model.Label{Value: runtimeType}, nil).Twice()
+				return svc
+			},
+			RuntimeContextRepoFn: func() *automock.RuntimeContextRepository {
+				repo := &automock.RuntimeContextRepository{}
+				repo.On("GetByID", ctx, TntInternalID, RuntimeContextID).Return(runtimeContext, nil).Twice()
+				return repo
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, formationconstraint.PreAssign, applicationDetails, FormationTemplateID).Return(constraintErr).Once()
+				engine.On("EnforceConstraints", ctx, formationconstraint.PreAssign, runtimeContextDetails, FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			ExpectedViolations: []string{fmt.Sprintf("APPLICATION with ID %q: %s", ApplicationID, constraintErr.Error())},
+		},
+		{
+			Name:                         "Error when enforcing the constraints of the version fails",
+			FormationTemplateRepoFn:      formationTemplateRepoFn,
+			FormationAssignmentServiceFn: formationAssignmentSvcFn,
+			LabelServiceFn: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(&model.Label{Value: applicationType}, nil).Twice()
+				return svc
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, formationconstraint.PreAssign, applicationDetails, FormationTemplateID).Return(testErr).Once()
+				return engine
+			},
+			ExpectedErrMessage: "while enforcing the constraints of version 2",
+		},
+		{
+			Name: "Error when getting the formation template fails",
+			FormationTemplateRepoFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while getting formation template with ID",
+		},
+		{
+			Name:                    "Error when getting the formation assignments fails",
+			FormationTemplateRepoFn: formationTemplateRepoFn,
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormation", ctx, TntInternalID, FormationID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while getting formation assignments for formation with ID",
+		},
+		{
+			Name:                         "Error when getting the label fails",
+			FormationTemplateRepoFn:      formationTemplateRepoFn,
+			FormationAssignmentServiceFn: formationAssignmentSvcFn,
+			LabelServiceFn: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:                         "Error when getting the runtime context fails",
+			FormationTemplateRepoFn:      formationTemplateRepoFn,
+			FormationAssignmentServiceFn: formationAssignmentSvcFn,
+			LabelServiceFn: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(&model.Label{Value: applicationType}, nil).Twice()
+				return svc
+			},
+			RuntimeContextRepoFn: func() *automock.RuntimeContextRepository {
+				repo := &automock.RuntimeContextRepository{}
+				repo.On("GetByID", ctx, TntInternalID, RuntimeContextID).Return(nil, testErr).Once()
+				return repo
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, formationconstraint.PreAssign, applicationDetails, FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			ExpectedErrMessage: "while getting runtime context with ID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			formationTemplateRepo := testCase.FormationTemplateRepoFn()
+			formationAssignmentSvc := &automock.FormationAssignmentService{}
+			if testCase.FormationAssignmentServiceFn != nil {
+				formationAssignmentSvc = testCase.FormationAssignmentServiceFn()
+			}
+			labelSvc := &automock.LabelService{}
+			if testCase.LabelServiceFn != nil {
+				labelSvc = testCase.LabelServiceFn()
+			}
+			runtimeContextRepo := &automock.RuntimeContextRepository{}
+			if testCase.RuntimeContextRepoFn != nil {
+				runtimeContextRepo = testCase.RuntimeContextRepoFn()
+			}
+			constraintEngine := &automock.ConstraintEngine{}
+			if testCase.ConstraintEngineFn != nil {
+				constraintEngine = testCase.ConstraintEngineFn()
+			}
+
+			svc := formation.NewService(nil, nil, nil, nil, nil, formationTemplateRepo, nil, labelSvc, nil, nil, nil, nil, nil, nil, runtimeContextRepo, formationAssignmentSvc, nil, nil, nil, constraintEngine, nil, nil, runtimeType, applicationType)
+
+			// WHEN
+			violations, err := svc.ValidateParticipantsForTemplateVersion(ctx, formationModel, version)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				assert.Nil(t, violations)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedViolations, violations)
+			}
+
+			mock.AssertExpectationsForObjects(t, formationTemplateRepo, formationAssignmentSvc, labelSvc, runtimeContextRepo, constraintEngine)
+		})
+	}
+}
+
+func TestServiceResendNotifications(t *testing.T) {
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, TntInternalID, TntExternalID)
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	readyStates := []string{string(model.ReadyAssignmentState)}
+	readyAssignment := fixFormationAssignmentModelWithParameters("id1", FormationID, ApplicationID, RuntimeID, model.FormationAssignmentTypeApplication, model.FormationAssignmentTypeRuntime, model.ReadyAssignmentState)
+	initialAssignment := fixFormationAssignmentModelWithParameters("id1", FormationID, ApplicationID, RuntimeID, model.FormationAssignmentTypeApplication, model.FormationAssignmentTypeRuntime, model.InitialAssignmentState)
+	assignmentOperationInput := &model.AssignmentOperationInput{
+		Type:                  model.Assign,
+		FormationAssignmentID: readyAssignment.ID,
+		FormationID:           FormationID,
+		TriggeredBy:           model.ResyncAssignment,
+	}
+
+	formationRepoFn := func(state model.FormationState) func() *automock.FormationRepository {
+		return func() *automock.FormationRepository {
+			repo := &automock.FormationRepository{}
+			repo.On("Get", ctxWithTenantAndLoggerMatcher(), FormationID, TntInternalID).Return(fixFormationModelWithState(state), nil).Once()
+			return repo
+		}
+	}
+
+	testCases := []struct {
+		Name                         string
+		TxFn                         func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		FormationRepositoryFn        func() *automock.FormationRepository
+		FormationAssignmentServiceFn func() *automock.FormationAssignmentService
+		AssignmentOperationServiceFn func() *automock.AssignmentOperationService
+		ExpectedFormation            *model.Formation
+		ExpectedErrMessage           string
+	}{
+		{
+			Name: "Success when the ready assignments are moved to initial state and resynchronized",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			FormationRepositoryFn: formationRepoFn(model.ReadyFormationState),
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormationWithStates", txtest.CtxWithDBMatcher(), TntInternalID, FormationID, readyStates).Return([]*model.FormationAssignment{readyAssignment.Clone()}, nil).Once()
+				svc.On("Update", txtest.CtxWithDBMatcher(), readyAssignment.ID, initialAssignment).Return(nil).Once()
+				svc.On("GetAssignmentsForFormationWithStates", txtest.CtxWithDBMatcher(), TntInternalID, FormationID, model.ResynchronizableFormationAssignmentStates).Return([]*model.FormationAssignment{}, nil).Once()
+				return svc
+			},
+			AssignmentOperationServiceFn: func() *automock.AssignmentOperationService {
+				svc := &automock.AssignmentOperationService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), assignmentOperationInput).Return("operation-id", nil).Once()
+				return svc
+			},
+			ExpectedFormation: fixFormationModelWithState(model.ReadyFormationState),
+		},
+		{
+			Name:                  "Does nothing for draft formations",
+			TxFn:                  txGen.ThatDoesntStartTransaction,
+			FormationRepositoryFn: formationRepoFn(model.DraftFormationState),
+			ExpectedFormation:     fixFormationModelWithState(model.DraftFormationState),
+		},
+		{
+			Name: "Error when getting the formation fails",
+			TxFn: txGen.ThatDoesntStartTransaction,
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("Get", ctxWithTenantAndLoggerMatcher(), FormationID, TntInternalID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while getting formation with ID",
+		},
+		{
+			Name:                  "Error when getting the ready assignments fails",
+			TxFn:                  txGen.ThatDoesntExpectCommit,
+			FormationRepositoryFn: formationRepoFn(model.ReadyFormationState),
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormationWithStates", txtest.CtxWithDBMatcher(), TntInternalID, FormationID, readyStates).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while getting ready formation assignments",
+		},
+		{
+			Name:                  "Error when updating the assignment fails",
+			TxFn:                  txGen.ThatDoesntExpectCommit,
+			FormationRepositoryFn: formationRepoFn(model.ReadyFormationState),
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormationWithStates", txtest.CtxWithDBMatcher(), TntInternalID, FormationID, readyStates).Return([]*model.FormationAssignment{readyAssignment.Clone()}, nil).Once()
+				svc.On("Update", txtest.CtxWithDBMatcher(), readyAssignment.ID, initialAssignment).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while updating formation assignment with ID",
+		},
+		{
+			Name:                  "Error when creating the assignment operation fails",
+			TxFn:                  txGen.ThatDoesntExpectCommit,
+			FormationRepositoryFn: formationRepoFn(model.ReadyFormationState),
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GetAssignmentsForFormationWithStates", txtest.CtxWithDBMatcher(), TntInternalID, FormationID, readyStates).Return([]*model.FormationAssignment{readyAssignment.Clone()}, nil).Once()
+				svc.On("Update", txtest.CtxWithDBMatcher(), readyAssignment.ID, initialAssignment).Return(nil).Once()
+				return svc
+			},
+			AssignmentOperationServiceFn: func() *automock.AssignmentOperationService {
+				svc := &automock.AssignmentOperationService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), assignmentOperationInput).Return("", testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while creating ASSIGN Operation for assignment with ID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			formationRepo := testCase.FormationRepositoryFn()
+			formationAssignmentSvc := &automock.FormationAssignmentService{}
+			if testCase.FormationAssignmentServiceFn != nil {
+				formationAssignmentSvc = testCase.FormationAssignmentServiceFn()
+			}
+			assignmentOperationSvc := &automock.AssignmentOperationService{}
+			if testCase.AssignmentOperationServiceFn != nil {
+				assignmentOperationSvc = testCase.AssignmentOperationServiceFn()
+			}
+
+			svc := formation.NewService(transact, nil, nil, nil, formationRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, formationAssignmentSvc, assignmentOperationSvc, nil, nil, nil, nil, nil, runtimeType, applicationType)
+
+			// WHEN
+			result, err := svc.ResendNotifications(ctx, FormationID)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedFormation, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, formationRepo, formationAssignmentSvc, assignmentOperationSvc)
+		})
+	}
+}
+
+func TestServiceListFormationTemplateWebhooks(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+
+	auth := &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "user", Password: "pass"}}}
+	rotatedAuth := &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "user", Password: "old-pass"}}}
+	removedAuth := &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "removed-user", Password: "removed-pass"}}}
+	currentWebhook := fixFormationLifecycleSyncWebhookModel(FormationLifecycleWebhookID, FormationTemplateID, model.FormationTemplateWebhookReference)
+	currentWebhook.Auth = auth
+	addedWebhook := fixFormationLifecycleSyncWebhookModel("added-webhook-id", FormationTemplateID, model.FormationTemplateWebhookReference)
+	currentWebhooks := []*model.Webhook{currentWebhook, addedWebhook}
+
+	asyncMode := model.WebhookModeAsyncCallback
+	pinnedURL := "https://pinned.com"
+	removedURL := "https://removed.com"
+	version := &model.FormationTemplateVersion{
+		FormationTemplateID: FormationTemplateID,
+		Version:             2,
+		Snapshot: model.FormationTemplateSnapshot{
+			Webhooks: []*model.FormationTemplateWebhookSnapshot{
+				{ID: FormationLifecycleWebhookID, Type: model.WebhookTypeFormationLifecycle, Mode: &asyncMode, URL: &pinnedURL, Auth: rotatedAuth},
+				{ID: "removed-webhook-id", Type: model.WebhookTypeFormationLifecycle, Mode: &asyncMode, URL: &removedURL, Auth: removedAuth},
+			},
+		},
+	}
+	pinnedWebhooks := []*model.Webhook{
+		{ID: FormationLifecycleWebhookID, ObjectID: FormationTemplateID, ObjectType: model.FormationTemplateWebhookReference, Type: model.WebhookTypeFormationLifecycle, Mode: &asyncMode, URL: &pinnedURL, Auth: auth},
+		{ID: "removed-webhook-id", ObjectID: FormationTemplateID, ObjectType: model.FormationTemplateWebhookReference, Type: model.WebhookTypeFormationLifecycle, Mode: &asyncMode, URL: &removedURL, Auth: removedAuth},
+	}
+
+	pinnedFormation := fixFormationModelWithState(model.ReadyFormationState)
+	pinnedFormation.FormationTemplateVersion = 2
+
+	webhookRepoFn := func() *automock.WebhookRepository {
+		repo := &automock.WebhookRepository{}
+		repo.On("ListByReferenceObjectIDGlobal", ctx, FormationTemplateID, model.FormationTemplateWebhookReference).Return(currentWebhooks, nil).Once()
+		return repo
+	}
+
+	testCases := []struct {
+		Name                                 string
+		Formation                            *model.Formation
+		WebhookRepoFn                        func() *automock.WebhookRepository
+		FormationTemplateVersionRepositoryFn func() *automock.FormationTemplateVersionRepository
+		ExpectedWebhooks                     []*model.Webhook
+		ExpectedErrMessage                   string
+	}{
+		{
+			Name:             "Returns the current webhooks for formations which are not pinned",
+			Formation:        fixFormationModelWithState(model.ReadyFormationState),
+			WebhookRepoFn:    webhookRepoFn,
+			ExpectedWebhooks: currentWebhooks,
+		},
+		{
+			Name:          "Returns the webhooks of the pinned version",
+			Formation:     pinnedFormation,
+			WebhookRepoFn: webhookRepoFn,
+			FormationTemplateVersionRepositoryFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, FormationTemplateID, 2).Return(version, nil).Once()
+				return repo
+			},
+			ExpectedWebhooks: pinnedWebhooks,
+		},
+		{
+			Name:      "Error when listing the webhooks fails",
+			Formation: pinnedFormation,
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, FormationTemplateID, model.FormationTemplateWebhookReference).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: "when listing formation lifecycle webhooks for formation template with ID",
+		},
+		{
+			Name:          "Error when getting the pinned version fails",
+			Formation:     pinnedFormation,
+			WebhookRepoFn: webhookRepoFn,
+			FormationTemplateVersionRepositoryFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, FormationTemplateID, 2).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while getting version 2 of formation template with ID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			webhookRepo := testCase.WebhookRepoFn()
+			formationTemplateVersionRepo := &automock.FormationTemplateVersionRepository{}
+			if testCase.FormationTemplateVersionRepositoryFn != nil {
+				formationTemplateVersionRepo = testCase.FormationTemplateVersionRepositoryFn()
+			}
+
+			svc := formation.NewServiceWithAsaEngine(nil, nil, nil, nil, nil, nil, formationTemplateVersionRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, webhookRepo, nil, nil, nil, runtimeType, applicationType, nil, nil, nil)
+
+			// WHEN
+			webhooks, err := svc.ListFormationTemplateWebhooks(ctx, testCase.Formation, FormationTemplateID)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				assert.Nil(t, webhooks)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedWebhooks, webhooks)
+			}
+
+			mock.AssertExpectationsForObjects(t, webhookRepo, formationTemplateVersionRepo)
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
+
+	time "time"
 )
 
 // FormationAssignmentRepository is an autogenerated mock type for the FormationAssignmentRepository type
@@ -485,6 +487,20 @@ func (_m *FormationAssignmentRepository) Update(ctx context.Context, _a1 *model.
 	return r0
 }
 
+// UpdateValidity provides a mock function with given fields: ctx, id, tenantID, validFrom, validUntil
+func (_m *FormationAssignmentRepository) UpdateValidity(ctx context.Context, id string, tenantID string, validFrom *time.Time, validUntil *time.Time) error {
+	ret := _m.Called(ctx, id, tenantID, validFrom, validUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *time.Time, *time.Time) error); ok {
+		r0 = rf(ctx, id, tenantID, validFrom, validUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewFormationAssignmentRepository creates a new instance of FormationAssignmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFormationAssignmentRepository(t interface {
@@ -62,6 +62,8 @@ func (c *converter) ToGraphQL(in *model.FormationAssignment) (*graphql.Formation
 		Error:                         errorStrValue,
 		LastStateChangeTimestamp:      graphql.TimePtrToGraphqlTimestampPtr(in.LastStateChangeTimestamp),
 		LastNotificationSentTimestamp: graphql.TimePtrToGraphqlTimestampPtr(in.LastNotificationSentTimestamp),
+		ValidFrom:                     graphql.TimePtrToGraphqlTimestampPtr(in.ValidFrom),
+		ValidUntil:                    graphql.TimePtrToGraphqlTimestampPtr(in.ValidUntil),
 	}, nil
 }
 
@@ -106,6 +108,8 @@ func (c *converter) ToEntity(in *model.FormationAssignment) *Entity {
 		Error:                         repo.NewNullableStringFromJSONRawMessage(in.Error),
 		LastStateChangeTimestamp:      in.LastStateChangeTimestamp,
 		LastNotificationSentTimestamp: in.LastNotificationSentTimestamp,
+		ValidFrom:                     in.ValidFrom,
+		ValidUntil:                    in.ValidUntil,
 	}
 }
 
@@ -128,5 +132,7 @@ func (c *converter) FromEntity(e *Entity) *model.FormationAssignment {
 		Error:                         repo.JSONRawMessageFromNullableString(e.Error),
 		LastStateChangeTimestamp:      e.LastStateChangeTimestamp,
 		LastNotificationSentTimestamp: e.LastNotificationSentTimestamp,
+		ValidFrom:                     e.ValidFrom,
+		ValidUntil:                    e.ValidUntil,
 	}
 }
@@ -19,6 +19,8 @@ type Entity struct {
 	Error                         sql.NullString `db:"error"`
 	LastStateChangeTimestamp      *time.Time     `db:"last_state_change_timestamp"`
 	LastNotificationSentTimestamp *time.Time     `db:"last_notification_sent_timestamp"`
+	ValidFrom                     *time.Time     `db:"valid_from"`
+	ValidUntil                    *time.Time     `db:"valid_until"`
 }
 
 // EntityCollection is a collection of formation assignments entities.
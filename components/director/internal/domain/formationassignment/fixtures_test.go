@@ -58,7 +58,7 @@ const (
 )
 
 var (
-	fixColumns = []string{"id", "formation_id", "tenant_id", "source", "source_type", "target", "target_type", "state", "value", "error", "last_state_change_timestamp", "last_notification_sent_timestamp"}
+	fixColumns = []string{"id", "formation_id", "tenant_id", "source", "source_type", "target", "target_type", "state", "value", "error", "last_state_change_timestamp", "last_notification_sent_timestamp", "valid_from", "valid_until"}
 
 	TestConfigValueRawJSON        = json.RawMessage(`{"configKey":"configValue"}`)
 	TestInvalidConfigValueRawJSON = json.RawMessage(`{invalid}`)
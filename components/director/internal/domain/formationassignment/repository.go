@@ -3,6 +3,7 @@ package formationassignment
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
@@ -18,12 +19,18 @@ import (
 	"github.com/pkg/errors"
 )
 
-const tableName string = `public.formation_assignments`
+const (
+	tableName string = `public.formation_assignments`
+
+	listScheduledDueQuery = "SELECT %s FROM %s WHERE source = target AND state = $1 AND valid_from <= $2 ORDER BY valid_from LIMIT $3"
+	listExpiredQuery      = "SELECT %s FROM %s WHERE source = target AND valid_until <= $1 AND state NOT IN ($2, $3, $4) ORDER BY valid_until LIMIT $5"
+	updateValidityQuery   = "UPDATE %s SET valid_from = $1, valid_until = $2 WHERE id = $3 AND tenant_id = $4"
+)
 
 var (
 	idTableColumns        = []string{"id"}
 	updatableTableColumns = []string{"state", "value", "error", "last_state_change_timestamp", "last_notification_sent_timestamp"}
-	tableColumns          = []string{"id", "formation_id", "tenant_id", "source", "source_type", "target", "target_type", "state", "value", "error", "last_state_change_timestamp", "last_notification_sent_timestamp"}
+	tableColumns          = []string{"id", "formation_id", "tenant_id", "source", "source_type", "target", "target_type", "state", "value", "error", "last_state_change_timestamp", "last_notification_sent_timestamp", "valid_from", "valid_until"}
 	tenantColumn          = "tenant_id"
 
 	// Now is a function variable that returns the current time. It is used, so we could mock it in the tests.
@@ -387,6 +394,60 @@ func (r *repository) UpdateLastNotificationSentTimestamps(ctx context.Context, f
 	return nil
 }
 
+// UpdateValidity updates the validity period of the Formation Assignment with the given ID
+func (r *repository) UpdateValidity(ctx context.Context, id, tenantID string, validFrom, validUntil *time.Time) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	stmt := fmt.Sprintf(updateValidityQuery, tableName)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+
+	res, err := persist.ExecContext(ctx, stmt, validFrom, validUntil, id, tenantID)
+	if err = persistence.MapSQLError(ctx, err, resource.FormationAssignment, resource.Update, fmt.Sprintf("while updating the validity of formation assignment with ID: %s", id)); err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "while checking affected rows")
+	}
+	if affected == 0 {
+		return apperrors.NewNotFoundError(resource.FormationAssignment, id)
+	}
+
+	return nil
+}
+
+// ListScheduledDueGlobal returns up to limit self-referencing Formation Assignments in SCHEDULED state whose validity period has started at the given time
+func (r *repository) ListScheduledDueGlobal(ctx context.Context, now time.Time, limit int) ([]*model.FormationAssignment, error) {
+	stmt := fmt.Sprintf(listScheduledDueQuery, strings.Join(tableColumns, ", "), tableName)
+	return r.listGlobalWithQuery(ctx, stmt, string(model.ScheduledAssignmentState), now, limit)
+}
+
+// ListExpiredGlobal returns up to limit self-referencing Formation Assignments whose validity period has ended at the given time.
+// The ones that are scheduled or are already being unassigned are skipped.
+func (r *repository) ListExpiredGlobal(ctx context.Context, now time.Time, limit int) ([]*model.FormationAssignment, error) {
+	stmt := fmt.Sprintf(listExpiredQuery, strings.Join(tableColumns, ", "), tableName)
+	return r.listGlobalWithQuery(ctx, stmt, now, string(model.ScheduledAssignmentState), string(model.DeletingAssignmentState), string(model.DeleteErrorAssignmentState), limit)
+}
+
+func (r *repository) listGlobalWithQuery(ctx context.Context, stmt string, args ...interface{}) ([]*model.FormationAssignment, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities EntityCollection
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if err = persist.SelectContext(ctx, &entities, stmt, args...); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.FormationAssignment, resource.List, "while listing formation assignments by validity")
+	}
+
+	return r.multipleFromEntities(entities), nil
+}
+
 // Delete deletes a Formation Assignment with given ID
 func (r *repository) Delete(ctx context.Context, id, tenantID string) error {
 	return r.deleter.DeleteOne(ctx, resource.FormationAssignment, tenantID, repo.Conditions{repo.NewEqualCondition("id", id)})
@@ -3,6 +3,8 @@ package formationassignment
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/hashicorp/go-multierror"
@@ -42,6 +44,7 @@ type FormationAssignmentRepository interface {
 	ListAllForObjectGlobal(ctx context.Context, objectID string) ([]*model.FormationAssignment, error)
 	ListForIDs(ctx context.Context, tenant string, ids []string) ([]*model.FormationAssignment, error)
 	Update(ctx context.Context, model *model.FormationAssignment) error
+	UpdateValidity(ctx context.Context, id, tenantID string, validFrom, validUntil *time.Time) error
 	Delete(ctx context.Context, id, tenantID string) error
 	DeleteAssignmentsForObjectID(ctx context.Context, tnt, formationID, objectID string) error
 	Exists(ctx context.Context, id, tenantID string) (bool, error)
@@ -388,6 +391,67 @@ func (s *service) Update(ctx context.Context, id string, fa *model.FormationAssi
 	return nil
 }
 
+// ScheduleAssignment records that the object will be assigned to the formation once the validity period starts.
+// The schedule is kept on the self-referencing formation assignment of the object in SCHEDULED state. Scheduling an object that is already scheduled updates its validity period.
+func (s *service) ScheduleAssignment(ctx context.Context, formationID, objectID string, objectType graphql.FormationObjectType, validFrom, validUntil *time.Time) error {
+	log.C(ctx).Infof("Scheduling the assignment of object with ID: %q of type: %q to formation with ID: %q", objectID, objectType, formationID)
+
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	existing, err := s.repo.GetByTargetAndSource(ctx, objectID, objectID, tenantID, formationID)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return errors.Wrapf(err, "while getting formation assignment for object with ID: %q", objectID)
+	}
+
+	if existing != nil {
+		if existing.State != string(model.ScheduledAssignmentState) {
+			return apperrors.NewInvalidOperationError(fmt.Sprintf("object with ID %q is already assigned to formation with ID %q", objectID, formationID))
+		}
+
+		if err = s.repo.UpdateValidity(ctx, existing.ID, tenantID, validFrom, validUntil); err != nil {
+			return errors.Wrapf(err, "while updating the validity of formation assignment with ID: %q", existing.ID)
+		}
+		return nil
+	}
+
+	if _, err = s.Create(ctx, &model.FormationAssignmentInput{
+		FormationID: formationID,
+		Source:      objectID,
+		SourceType:  model.FormationAssignmentType(objectType),
+		Target:      objectID,
+		TargetType:  model.FormationAssignmentType(objectType),
+		State:       string(model.ScheduledAssignmentState),
+		ValidFrom:   validFrom,
+		ValidUntil:  validUntil,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetValidity sets the validity period on the self-referencing formation assignment of the object
+func (s *service) SetValidity(ctx context.Context, formationID, objectID string, validFrom, validUntil *time.Time) error {
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	assignment, err := s.repo.GetByTargetAndSource(ctx, objectID, objectID, tenantID, formationID)
+	if err != nil {
+		return errors.Wrapf(err, "while getting formation assignment for object with ID: %q", objectID)
+	}
+
+	if err = s.repo.UpdateValidity(ctx, assignment.ID, tenantID, validFrom, validUntil); err != nil {
+		return errors.Wrapf(err, "while updating the validity of formation assignment with ID: %q", assignment.ID)
+	}
+
+	return nil
+}
+
 // Delete deletes a Formation Assignment matching ID `id`
 func (s *service) Delete(ctx context.Context, id string) error {
 	log.C(ctx).Infof("Deleting formation assignment with ID: %q", id)
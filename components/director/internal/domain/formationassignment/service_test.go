@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/statusreport"
 
@@ -1136,6 +1137,198 @@ func TestService_Update(t *testing.T) {
 	}
 }
 
+func TestService_ScheduleAssignment(t *testing.T) {
+	validFrom := time.Date(2024, 7, 8, 10, 0, 0, 0, time.UTC)
+	validUntil := validFrom.Add(time.Hour)
+
+	scheduledAssignment := &model.FormationAssignment{
+		ID:          TestID,
+		FormationID: TestFormationID,
+		TenantID:    TestTenantID,
+		Source:      TestSource,
+		SourceType:  TestSourceType,
+		Target:      TestSource,
+		TargetType:  TestSourceType,
+		State:       string(model.ScheduledAssignmentState),
+		ValidFrom:   &validFrom,
+		ValidUntil:  &validUntil,
+	}
+
+	readyAssignment := scheduledAssignment.Clone()
+	readyAssignment.State = readyAssignmentState
+
+	testCases := []struct {
+		Name                    string
+		Context                 context.Context
+		FormationAssignmentRepo func() *automock.FormationAssignmentRepository
+		ExpectedErrorMsg        string
+	}{
+		{
+			Name:    "Success when creating a scheduled formation assignment",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetByTargetAndSource", ctxWithTenant, TestSource, TestSource, TestTenantID, TestFormationID).Return(nil, notFoundError).Once()
+				repo.On("Create", ctxWithTenant, scheduledAssignment).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:    "Success when re-scheduling a scheduled formation assignment",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetByTargetAndSource", ctxWithTenant, TestSource, TestSource, TestTenantID, TestFormationID).Return(scheduledAssignment, nil).Once()
+				repo.On("UpdateValidity", ctxWithTenant, TestID, TestTenantID, &validFrom, &validUntil).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:             "Error when loading tenant from context",
+			Context:          emptyCtx,
+			ExpectedErrorMsg: "while loading tenant from context: cannot read tenant from context",
+		},
+		{
+			Name:    "Error when getting the formation assignment fails",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetByTargetAndSource", ctxWithTenant, TestSource, TestSource, TestTenantID, TestFormationID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:    "Error when the object is already assigned",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetByTargetAndSource", ctxWithTenant, TestSource, TestSource, TestTenantID, TestFormationID).Return(readyAssignment, nil).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "is already assigned to formation",
+		},
+		{
+			Name:    "Error when updating the validity fails",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetByTargetAndSource", ctxWithTenant, TestSource, TestSource, TestTenantID, TestFormationID).Return(scheduledAssignment, nil).Once()
+				repo.On("UpdateValidity", ctxWithTenant, TestID, TestTenantID, &validFrom, &validUntil).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "while updating the validity of formation assignment with ID",
+		},
+		{
+			Name:    "Error when creating the formation assignment fails",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetByTargetAndSource", ctxWithTenant, TestSource, TestSource, TestTenantID, TestFormationID).Return(nil, notFoundError).Once()
+				repo.On("Create", ctxWithTenant, scheduledAssignment).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "while creating formation assignment for formation with ID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			faRepo := &automock.FormationAssignmentRepository{}
+			if testCase.FormationAssignmentRepo != nil {
+				faRepo = testCase.FormationAssignmentRepo()
+			}
+
+			svc := formationassignment.NewService(faRepo, fixUUIDService(), nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+
+			// WHEN
+			err := svc.ScheduleAssignment(testCase.Context, TestFormationID, TestSource, graphql.FormationObjectTypeApplication, &validFrom, &validUntil)
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, faRepo)
+		})
+	}
+}
+
+func TestService_SetValidity(t *testing.T) {
+	validUntil := time.Date(2024, 7, 8, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name                    string
+		Context                 context.Context
+		FormationAssignmentRepo func() *automock.FormationAssignmentRepository
+		ExpectedErrorMsg        string
+	}{
+		{
+			Name:    "Success",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetByTargetAndSource", ctxWithTenant, TestSource, TestSource, TestTenantID, TestFormationID).Return(fa, nil).Once()
+				repo.On("UpdateValidity", ctxWithTenant, fa.ID, TestTenantID, (*time.Time)(nil), &validUntil).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:             "Error when loading tenant from context",
+			Context:          emptyCtx,
+			ExpectedErrorMsg: "while loading tenant from context: cannot read tenant from context",
+		},
+		{
+			Name:    "Error when getting the formation assignment fails",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetByTargetAndSource", ctxWithTenant, TestSource, TestSource, TestTenantID, TestFormationID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "while getting formation assignment for object with ID",
+		},
+		{
+			Name:    "Error when updating the validity fails",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetByTargetAndSource", ctxWithTenant, TestSource, TestSource, TestTenantID, TestFormationID).Return(fa, nil).Once()
+				repo.On("UpdateValidity", ctxWithTenant, fa.ID, TestTenantID, (*time.Time)(nil), &validUntil).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "while updating the validity of formation assignment with ID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			faRepo := &automock.FormationAssignmentRepository{}
+			if testCase.FormationAssignmentRepo != nil {
+				faRepo = testCase.FormationAssignmentRepo()
+			}
+
+			svc := formationassignment.NewService(faRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+
+			// WHEN
+			err := svc.SetValidity(testCase.Context, TestFormationID, TestSource, nil, &validUntil)
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, faRepo)
+		})
+	}
+}
+
 func TestService_Delete(t *testing.T) {
 	testCases := []struct {
 		Name                    string
@@ -27,6 +27,11 @@ type formationTemplateConstraintReferenceRepository interface {
 	ListByConstraintID(ctx context.Context, constraintID string) ([]*model.FormationTemplateConstraintReference, error)
 }
 
+//go:generate mockery --exported --name=formationTemplateVersionRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type formationTemplateVersionRepository interface {
+	GetByVersion(ctx context.Context, formationTemplateID string, version int) (*model.FormationTemplateVersion, error)
+}
+
 //go:generate mockery --exported --name=uidService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type uidService interface {
 	Generate() string
@@ -37,13 +42,15 @@ type service struct {
 	formationTemplateConstraintReferenceRepo formationTemplateConstraintReferenceRepository
 	converter                                formationConstraintConverter
 	uidSvc                                   uidService
+	formationTemplateVersionRepo             formationTemplateVersionRepository
 }
 
 // NewService creates a FormationConstraint service
-func NewService(repo formationConstraintRepository, formationTemplateConstraintReferenceRepo formationTemplateConstraintReferenceRepository, uidSvc uidService, converter formationConstraintConverter) *service {
+func NewService(repo formationConstraintRepository, formationTemplateConstraintReferenceRepo formationTemplateConstraintReferenceRepository, formationTemplateVersionRepo formationTemplateVersionRepository, uidSvc uidService, converter formationConstraintConverter) *service {
 	return &service{
 		repo:                                     repo,
 		formationTemplateConstraintReferenceRepo: formationTemplateConstraintReferenceRepo,
+		formationTemplateVersionRepo:             formationTemplateVersionRepo,
 		uidSvc:                                   uidSvc,
 		converter:                                converter,
 	}
@@ -174,16 +181,11 @@ func (s *service) Delete(ctx context.Context, id string) error {
 
 // ListMatchingConstraints lists formation constraints that math the provided JoinPointLocation and JoinPointDetails
 func (s *service) ListMatchingConstraints(ctx context.Context, formationTemplateID string, location formationconstraint.JoinPointLocation, details formationconstraint.MatchingDetails) ([]*model.FormationConstraint, error) {
-	formationTemplateConstraintReferences, err := s.formationTemplateConstraintReferenceRepo.ListByFormationTemplateID(ctx, formationTemplateID)
+	constraintIDs, err := s.listAttachedConstraintIDs(ctx, formationTemplateID, details.FormationTemplateVersion)
 	if err != nil {
 		return nil, err
 	}
 
-	constraintIDs := make([]string, 0, len(formationTemplateConstraintReferences))
-	for _, reference := range formationTemplateConstraintReferences {
-		constraintIDs = append(constraintIDs, reference.ConstraintID)
-	}
-
 	constraints, err := s.repo.ListMatchingFormationConstraints(ctx, constraintIDs, location, details)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while listing matching formation constraints for formation template with ID %q, target operation %q, constraint type %q, resource type %q and resource subtype %q", formationTemplateID, location.OperationName, location.ConstraintType, details.ResourceType, details.ResourceSubtype)
@@ -192,6 +194,30 @@ func (s *service) ListMatchingConstraints(ctx context.Context, formationTemplate
 	return constraints, nil
 }
 
+// listAttachedConstraintIDs returns the IDs of the constraints attached to the given version of the formation template.
+// Zero version stands for the constraints currently attached to the formation template.
+func (s *service) listAttachedConstraintIDs(ctx context.Context, formationTemplateID string, version int) ([]string, error) {
+	if version > 0 {
+		formationTemplateVersion, err := s.formationTemplateVersionRepo.GetByVersion(ctx, formationTemplateID, version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while getting version %d of formation template with ID %q", version, formationTemplateID)
+		}
+		return formationTemplateVersion.Snapshot.ConstraintIDs, nil
+	}
+
+	formationTemplateConstraintReferences, err := s.formationTemplateConstraintReferenceRepo.ListByFormationTemplateID(ctx, formationTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	constraintIDs := make([]string, 0, len(formationTemplateConstraintReferences))
+	for _, reference := range formationTemplateConstraintReferences {
+		constraintIDs = append(constraintIDs, reference.ConstraintID)
+	}
+
+	return constraintIDs, nil
+}
+
 // Update updates a FormationConstraint matching ID `id` using `in`
 func (s *service) Update(ctx context.Context, id string, in *model.FormationConstraintInput) error {
 	err := s.repo.Update(ctx, s.converter.FromModelInputToModel(in, id))
@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/automock"
 	tnt "github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	formationconstraintpkg "github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -79,7 +80,7 @@ func TestService_Create(t *testing.T) {
 			formationConstraintConv := testCase.FormationConstraintConverter()
 			idSvc := uidSvcFn()
 
-			svc := formationconstraint.NewService(formationConstraintRepo, nil, idSvc, formationConstraintConv)
+			svc := formationconstraint.NewService(formationConstraintRepo, nil, nil, idSvc, formationConstraintConv)
 
 			// WHEN
 			result, err := svc.Create(testCase.Context, testCase.Input)
@@ -139,7 +140,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			formationConstraintRepo := testCase.FormationConstraintRepository()
 
-			svc := formationconstraint.NewService(formationConstraintRepo, nil, nil, nil)
+			svc := formationconstraint.NewService(formationConstraintRepo, nil, nil, nil, nil)
 
 			// WHEN
 			result, err := svc.Get(ctx, testCase.Input)
@@ -199,7 +200,7 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			formationConstraintRepo := testCase.FormationConstraintRepository()
 
-			svc := formationconstraint.NewService(formationConstraintRepo, nil, nil, nil)
+			svc := formationconstraint.NewService(formationConstraintRepo, nil, nil, nil, nil)
 
 			// WHEN
 			result, err := svc.List(testCase.Context)
@@ -282,7 +283,7 @@ func TestService_ListByFormationTemplateID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			formationConstraintRepo := testCase.FormationConstraintRepository()
 			formationConstraintReferenceRepo := testCase.FormationConstraintReferenceRepository()
-			svc := formationconstraint.NewService(formationConstraintRepo, formationConstraintReferenceRepo, nil, nil)
+			svc := formationconstraint.NewService(formationConstraintRepo, formationConstraintReferenceRepo, nil, nil, nil)
 
 			// WHEN
 			result, err := svc.ListByFormationTemplateID(testCase.Context, formationTemplateID)
@@ -381,7 +382,7 @@ func TestService_Delete(t *testing.T) {
 				formationConstraintReferenceRepo = testCase.FormationTemplateConstraintReferencesRepository()
 			}
 
-			svc := formationconstraint.NewService(formationConstraintRepo, formationConstraintReferenceRepo, nil, nil)
+			svc := formationconstraint.NewService(formationConstraintRepo, formationConstraintReferenceRepo, nil, nil, nil)
 
 			// WHEN
 			err := svc.Delete(testCase.Context, testCase.Input)
@@ -405,11 +406,21 @@ func TestService_ListMatchingConstraints(t *testing.T) {
 
 	testErr := errors.New("test error")
 
+	pinnedMatchingDetails := matchingDetails
+	pinnedMatchingDetails.FormationTemplateVersion = 2
+	pinnedVersion := &model.FormationTemplateVersion{
+		FormationTemplateID: formationTemplateID,
+		Version:             2,
+		Snapshot:            model.FormationTemplateSnapshot{ConstraintIDs: []string{"pinned-constraint-id"}},
+	}
+
 	testCases := []struct {
 		Name                                   string
 		Context                                context.Context
+		MatchingDetails                        *formationconstraintpkg.MatchingDetails
 		FormationConstraintRepository          func() *automock.FormationConstraintRepository
 		FormationConstraintReferenceRepository func() *automock.FormationTemplateConstraintReferenceRepository
+		FormationTemplateVersionRepository     func() *automock.FormationTemplateVersionRepository
 		ExpectedOutput                         []*model.FormationConstraint
 		ExpectedError                          error
 	}{
@@ -457,16 +468,56 @@ func TestService_ListMatchingConstraints(t *testing.T) {
 			ExpectedOutput: nil,
 			ExpectedError:  testErr,
 		},
+		{
+			Name:            "Success for formation pinned to a formation template version",
+			Context:         ctx,
+			MatchingDetails: &pinnedMatchingDetails,
+			FormationConstraintRepository: func() *automock.FormationConstraintRepository {
+				repo := &automock.FormationConstraintRepository{}
+				repo.On("ListMatchingFormationConstraints", ctx, []string{"pinned-constraint-id"}, location, pinnedMatchingDetails).Return([]*model.FormationConstraint{formationConstraintModel}, nil).Once()
+				return repo
+			},
+			FormationConstraintReferenceRepository: UnusedFormationTemplateConstraintReferenceRepository,
+			FormationTemplateVersionRepository: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, formationTemplateID, 2).Return(pinnedVersion, nil).Once()
+				return repo
+			},
+			ExpectedOutput: []*model.FormationConstraint{formationConstraintModel},
+			ExpectedError:  nil,
+		},
+		{
+			Name:                                   "Error when getting the pinned formation template version",
+			Context:                                ctx,
+			MatchingDetails:                        &pinnedMatchingDetails,
+			FormationConstraintRepository:          UnusedFormationConstraintRepository,
+			FormationConstraintReferenceRepository: UnusedFormationTemplateConstraintReferenceRepository,
+			FormationTemplateVersionRepository: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, formationTemplateID, 2).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			formationConstraintRepo := testCase.FormationConstraintRepository()
 			formationConstraintReferenceRepo := testCase.FormationConstraintReferenceRepository()
-			svc := formationconstraint.NewService(formationConstraintRepo, formationConstraintReferenceRepo, nil, nil)
+			formationTemplateVersionRepo := &automock.FormationTemplateVersionRepository{}
+			if testCase.FormationTemplateVersionRepository != nil {
+				formationTemplateVersionRepo = testCase.FormationTemplateVersionRepository()
+			}
+			details := matchingDetails
+			if testCase.MatchingDetails != nil {
+				details = *testCase.MatchingDetails
+			}
+			svc := formationconstraint.NewService(formationConstraintRepo, formationConstraintReferenceRepo, formationTemplateVersionRepo, nil, nil)
 
 			// WHEN
-			result, err := svc.ListMatchingConstraints(testCase.Context, formationTemplateID, location, matchingDetails)
+			result, err := svc.ListMatchingConstraints(testCase.Context, formationTemplateID, location, details)
 
 			// THEN
 			if testCase.ExpectedError != nil {
@@ -477,7 +528,7 @@ func TestService_ListMatchingConstraints(t *testing.T) {
 			}
 			assert.Equal(t, testCase.ExpectedOutput, result)
 
-			mock.AssertExpectationsForObjects(t, formationConstraintRepo, formationConstraintReferenceRepo)
+			mock.AssertExpectationsForObjects(t, formationConstraintRepo, formationConstraintReferenceRepo, formationTemplateVersionRepo)
 		})
 	}
 }
@@ -542,7 +593,7 @@ func TestService_Update(t *testing.T) {
 			if testCase.FormationConstraintConverter != nil {
 				conv = testCase.FormationConstraintConverter()
 			}
-			svc := formationconstraint.NewService(repo, nil, nil, conv)
+			svc := formationconstraint.NewService(repo, nil, nil, nil, conv)
 
 			// WHEN
 			err := svc.Update(testCase.Context, testID, testCase.InputConstraintTemplate)
@@ -656,7 +707,7 @@ func TestService_ListByFormationTemplateIDs(t *testing.T) {
 			if testCase.FormationConstraintRepo != nil {
 				constraintRepo = testCase.FormationConstraintRepo()
 			}
-			svc := formationconstraint.NewService(constraintRepo, constraintRefRepo, nil, nil)
+			svc := formationconstraint.NewService(constraintRepo, constraintRefRepo, nil, nil, nil)
 
 			res, err := svc.ListByFormationTemplateIDs(ctx, testCase.Input)
 
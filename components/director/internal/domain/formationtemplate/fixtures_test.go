@@ -479,6 +479,15 @@ func fixColumns() []string {
 	return []string{"id", "name", "application_types", "runtime_types", "runtime_type_display_name", "runtime_artifact_kind", "leading_product_ids", "supports_reset", "discovery_consumers", "created_at", "updated_at", "tenant_id"}
 }
 
+func fixFormationTemplateVersion() *model.FormationTemplateVersion {
+	return &model.FormationTemplateVersion{
+		ID:                  "version-id",
+		FormationTemplateID: testFormationTemplateID,
+		Version:             1,
+		Snapshot:            model.NewFormationTemplateSnapshot(&formationTemplateModel, nil, nil),
+	}
+}
+
 func UnusedFormationTemplateService() *automock.FormationTemplateService {
 	return &automock.FormationTemplateService{}
 }
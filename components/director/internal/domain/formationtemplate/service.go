@@ -52,6 +52,13 @@ type WebhookService interface {
 	ListForFormationTemplate(ctx context.Context, tenant, formationTemplateID string) ([]*model.Webhook, error)
 }
 
+// FormationTemplateVersionService is responsible for the service-layer FormationTemplateVersion operations
+//
+//go:generate mockery --name=FormationTemplateVersionService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationTemplateVersionService interface {
+	CreateVersion(ctx context.Context, formationTemplateID string) (*model.FormationTemplateVersion, error)
+}
+
 //go:generate mockery --exported --name=labelService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type labelService interface {
 	UpsertMultipleLabels(ctx context.Context, tenantID string, objectType model.LabelableObject, objectID string, labels map[string]interface{}) error
@@ -69,10 +76,11 @@ type service struct {
 	webhookRepo    WebhookRepository
 	webhookService WebhookService
 	labelService   labelService
+	versionSvc     FormationTemplateVersionService
 }
 
 // NewService creates a FormationTemplate service
-func NewService(repo FormationTemplateRepository, uidSvc UIDService, converter FormationTemplateConverter, tenantSvc TenantService, webhookRepo WebhookRepository, webhookService WebhookService, labelService labelService) *service {
+func NewService(repo FormationTemplateRepository, uidSvc UIDService, converter FormationTemplateConverter, tenantSvc TenantService, webhookRepo WebhookRepository, webhookService WebhookService, labelService labelService, versionSvc FormationTemplateVersionService) *service {
 	return &service{
 		repo:           repo,
 		uidSvc:         uidSvc,
@@ -81,6 +89,7 @@ func NewService(repo FormationTemplateRepository, uidSvc UIDService, converter F
 		webhookRepo:    webhookRepo,
 		webhookService: webhookService,
 		labelService:   labelService,
+		versionSvc:     versionSvc,
 	}
 }
 
@@ -114,6 +123,10 @@ func (s *service) Create(ctx context.Context, in *model.FormationTemplateRegiste
 		return "", errors.Wrapf(err, "while creating webhooks for formation template with ID: %s", formationTemplateID)
 	}
 
+	if err = s.CreateVersion(ctx, formationTemplateID); err != nil {
+		return "", err
+	}
+
 	return formationTemplateID, nil
 }
 
@@ -161,6 +174,16 @@ func (s *service) Update(ctx context.Context, id string, in *model.FormationTemp
 		return errors.Wrapf(err, "while updating formation template with ID: %s", id)
 	}
 
+	return s.CreateVersion(ctx, id)
+}
+
+// CreateVersion stores the current state of the FormationTemplate matching ID `id` as its next version.
+// It should be called whenever the formation template, its webhooks or its attached constraints change.
+func (s *service) CreateVersion(ctx context.Context, id string) error {
+	if _, err := s.versionSvc.CreateVersion(ctx, id); err != nil {
+		return errors.Wrapf(err, "while creating a new version of formation template with ID: %s", id)
+	}
+
 	return nil
 }
 
@@ -36,6 +36,7 @@ func TestService_Create(t *testing.T) {
 		TenantSvc                   func() *automock.TenantService
 		LabelSvc                    func() *automock.LabelService
 		WebhookRepo                 func() *automock.WebhookRepository
+		VersionSvc                  func() *automock.FormationTemplateVersionService
 		ExpectedOutput              string
 		ExpectedError               error
 	}{
@@ -68,6 +69,11 @@ func TestService_Create(t *testing.T) {
 				repo.On("CreateMany", ctx, testTenantID, formationTemplateModel.Webhooks).Return(nil)
 				return repo
 			},
+			VersionSvc: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctx, testFormationTemplateID).Return(fixFormationTemplateVersion(), nil).Once()
+				return svc
+			},
 			ExpectedOutput: testFormationTemplateID,
 		},
 		{
@@ -94,6 +100,11 @@ func TestService_Create(t *testing.T) {
 				svc.On("ExtractTenantIDForTenantScopedFormationTemplates", ctxWithEmptyTenants).Return("", nil).Once()
 				return svc
 			},
+			VersionSvc: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctxWithEmptyTenants, testFormationTemplateID).Return(fixFormationTemplateVersion(), nil).Once()
+				return svc
+			},
 			ExpectedOutput: testFormationTemplateID,
 		},
 		{
@@ -120,6 +131,11 @@ func TestService_Create(t *testing.T) {
 				repo.On("CreateMany", ctx, testTenantID, formationTemplateModelAppOnly.Webhooks).Return(nil)
 				return repo
 			},
+			VersionSvc: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctx, testFormationTemplateID).Return(fixFormationTemplateVersion(), nil).Once()
+				return svc
+			},
 			ExpectedOutput: testFormationTemplateID,
 		},
 		{
@@ -221,6 +237,38 @@ func TestService_Create(t *testing.T) {
 			ExpectedOutput: "",
 			ExpectedError:  errors.New("while creating webhooks for formation template with ID:"),
 		},
+		{
+			Name:    "Error when creating the formation template version",
+			Context: ctx,
+			Input:   &formationTemplateRegisterInputModel,
+			FormationTemplateConverter: func() *automock.FormationTemplateConverter {
+				converter := &automock.FormationTemplateConverter{}
+				converter.On("FromModelRegisterInputToModel", &formationTemplateRegisterInputModel, testFormationTemplateID, testTenantID).Return(&formationTemplateModel).Once()
+				return converter
+			},
+			FormationTemplateRepository: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Create", ctx, &formationTemplateModel).Return(nil).Once()
+				return repo
+			},
+			TenantSvc: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("ExtractTenantIDForTenantScopedFormationTemplates", ctx).Return(testTenantID, nil).Once()
+				return svc
+			},
+			WebhookRepo: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("CreateMany", ctx, testTenantID, formationTemplateModel.Webhooks).Return(nil)
+				return repo
+			},
+			VersionSvc: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctx, testFormationTemplateID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedOutput: "",
+			ExpectedError:  errors.New("while creating a new version of formation template with ID:"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -238,9 +286,13 @@ func TestService_Create(t *testing.T) {
 			if testCase.WebhookRepo != nil {
 				whRepo = testCase.WebhookRepo()
 			}
+			versionSvc := &automock.FormationTemplateVersionService{}
+			if testCase.VersionSvc != nil {
+				versionSvc = testCase.VersionSvc()
+			}
 			idSvc := uidSvcFn()
 
-			svc := formationtemplate.NewService(formationTemplateRepo, idSvc, formationTemplateConv, tenantSvc, whRepo, nil, lblSvc)
+			svc := formationtemplate.NewService(formationTemplateRepo, idSvc, formationTemplateConv, tenantSvc, whRepo, nil, lblSvc, versionSvc)
 
 			// WHEN
 			result, err := svc.Create(testCase.Context, testCase.Input)
@@ -254,7 +306,7 @@ func TestService_Create(t *testing.T) {
 			}
 			assert.Equal(t, testCase.ExpectedOutput, result)
 
-			mock.AssertExpectationsForObjects(t, formationTemplateRepo, idSvc, formationTemplateConv, tenantSvc, lblSvc, whRepo)
+			mock.AssertExpectationsForObjects(t, formationTemplateRepo, idSvc, formationTemplateConv, tenantSvc, lblSvc, whRepo, versionSvc)
 		})
 	}
 }
@@ -294,7 +346,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			formationTemplateRepo := testCase.FormationTemplateRepository()
 
-			svc := formationtemplate.NewService(formationTemplateRepo, nil, nil, nil, nil, nil, nil)
+			svc := formationtemplate.NewService(formationTemplateRepo, nil, nil, nil, nil, nil, nil, nil)
 
 			// WHEN
 			result, err := svc.Exist(ctx, testCase.Input)
@@ -347,7 +399,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			formationTemplateRepo := testCase.FormationTemplateRepository()
 
-			svc := formationtemplate.NewService(formationTemplateRepo, nil, nil, nil, nil, nil, nil)
+			svc := formationtemplate.NewService(formationTemplateRepo, nil, nil, nil, nil, nil, nil, nil)
 
 			// WHEN
 			result, err := svc.Get(ctx, testCase.Input)
@@ -463,7 +515,7 @@ func TestService_List(t *testing.T) {
 			formationTemplateRepo := testCase.FormationTemplateRepository()
 			tenantSvc := testCase.TenantSvc()
 
-			svc := formationtemplate.NewService(formationTemplateRepo, nil, nil, tenantSvc, nil, nil, nil)
+			svc := formationtemplate.NewService(formationTemplateRepo, nil, nil, tenantSvc, nil, nil, nil, nil)
 
 			// WHEN
 			result, err := svc.List(testCase.Context, nil, nil, testCase.PageSize, "")
@@ -501,6 +553,7 @@ func TestService_Update(t *testing.T) {
 		FormationTemplateRepository  func() *automock.FormationTemplateRepository
 		FormationTemplateConverter   func() *automock.FormationTemplateConverter
 		TenantSvc                    func() *automock.TenantService
+		VersionSvc                   func() *automock.FormationTemplateVersionService
 		ExpectedError                error
 	}{
 		{
@@ -525,6 +578,11 @@ func TestService_Update(t *testing.T) {
 				svc.On("ExtractTenantIDForTenantScopedFormationTemplates", ctx).Return(testTenantID, nil).Once()
 				return svc
 			},
+			VersionSvc: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctx, testFormationTemplateID).Return(fixFormationTemplateVersion(), nil).Once()
+				return svc
+			},
 			ExpectedError: nil,
 		},
 		{
@@ -549,6 +607,11 @@ func TestService_Update(t *testing.T) {
 				svc.On("ExtractTenantIDForTenantScopedFormationTemplates", ctxWithEmptyTenants).Return("", nil).Once()
 				return svc
 			},
+			VersionSvc: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctxWithEmptyTenants, testFormationTemplateID).Return(fixFormationTemplateVersion(), nil).Once()
+				return svc
+			},
 			ExpectedError: nil,
 		},
 		{
@@ -621,6 +684,35 @@ func TestService_Update(t *testing.T) {
 			},
 			ExpectedError: testErr,
 		},
+		{
+			Name:                         "Error when creating the formation template version fails",
+			Context:                      ctx,
+			Input:                        testFormationTemplateID,
+			FormationTemplateUpdateInput: &formationTemplateUpdateInputModel,
+			FormationTemplateRepository: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("ExistsGlobal", ctx, testFormationTemplateID).Return(true, nil).Once()
+				repo.On("Update", ctx, &formationTemplateModel).Return(nil).Once()
+				return repo
+			},
+			FormationTemplateConverter: func() *automock.FormationTemplateConverter {
+				converter := &automock.FormationTemplateConverter{}
+				converter.On("FromModelUpdateInputToModel", &formationTemplateUpdateInputModel, testFormationTemplateID, testTenantID).Return(&formationTemplateModel).Once()
+
+				return converter
+			},
+			TenantSvc: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("ExtractTenantIDForTenantScopedFormationTemplates", ctx).Return(testTenantID, nil).Once()
+				return svc
+			},
+			VersionSvc: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctx, testFormationTemplateID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedError: testErr,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -629,7 +721,12 @@ func TestService_Update(t *testing.T) {
 			formationTemplateConverter := testCase.FormationTemplateConverter()
 			tenantSvc := testCase.TenantSvc()
 
-			svc := formationtemplate.NewService(formationTemplateRepo, uidSvcFn(), formationTemplateConverter, tenantSvc, nil, nil, nil)
+			versionSvc := &automock.FormationTemplateVersionService{}
+			if testCase.VersionSvc != nil {
+				versionSvc = testCase.VersionSvc()
+			}
+
+			svc := formationtemplate.NewService(formationTemplateRepo, uidSvcFn(), formationTemplateConverter, tenantSvc, nil, nil, nil, versionSvc)
 
 			// WHEN
 			err := svc.Update(testCase.Context, testCase.Input, testCase.FormationTemplateUpdateInput)
@@ -642,7 +739,7 @@ func TestService_Update(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, formationTemplateRepo, tenantSvc)
+			mock.AssertExpectationsForObjects(t, formationTemplateRepo, tenantSvc, versionSvc)
 		})
 	}
 }
@@ -727,7 +824,7 @@ func TestService_Delete(t *testing.T) {
 			formationTemplateRepo := testCase.FormationTemplateRepository()
 			tenantSvc := testCase.TenantSvc()
 
-			svc := formationtemplate.NewService(formationTemplateRepo, nil, nil, tenantSvc, nil, nil, nil)
+			svc := formationtemplate.NewService(formationTemplateRepo, nil, nil, tenantSvc, nil, nil, nil, nil)
 
 			// WHEN
 			err := svc.Delete(testCase.Context, testCase.Input)
@@ -832,7 +929,7 @@ func TestService_ListWebhooksForFormationTemplate(t *testing.T) {
 			tenantSvc := testCase.TenantSvc()
 			webhookSvc := testCase.WebhookSvc()
 
-			svc := formationtemplate.NewService(nil, nil, nil, tenantSvc, nil, webhookSvc, nil)
+			svc := formationtemplate.NewService(nil, nil, nil, tenantSvc, nil, webhookSvc, nil, nil)
 
 			// WHEN
 			webhooks, err := svc.ListWebhooksForFormationTemplate(testCase.Context, testFormationTemplateID)
@@ -948,7 +1045,7 @@ func TestService_SetLabel(t *testing.T) {
 				labelSvc = testCase.LabelSvc()
 			}
 
-			svc := formationtemplate.NewService(ftRepo, nil, nil, tenantSvc, nil, nil, labelSvc)
+			svc := formationtemplate.NewService(ftRepo, nil, nil, tenantSvc, nil, nil, labelSvc, nil)
 
 			// WHEN
 			err := svc.SetLabel(testCase.Context, testCase.LabelInput)
@@ -1063,7 +1160,7 @@ func TestService_DeleteLabel(t *testing.T) {
 				labelSvc = testCase.LabelSvc()
 			}
 
-			svc := formationtemplate.NewService(ftRepo, nil, nil, tenantSvc, nil, nil, labelSvc)
+			svc := formationtemplate.NewService(ftRepo, nil, nil, tenantSvc, nil, nil, labelSvc, nil)
 
 			// WHEN
 			err := svc.DeleteLabel(testCase.Context, testFormationTemplateID, testLabelKey)
@@ -1180,7 +1277,7 @@ func TestService_GetLabel(t *testing.T) {
 				labelSvc = testCase.LabelSvc()
 			}
 
-			svc := formationtemplate.NewService(ftRepo, nil, nil, tenantSvc, nil, nil, labelSvc)
+			svc := formationtemplate.NewService(ftRepo, nil, nil, tenantSvc, nil, nil, labelSvc, nil)
 
 			// WHEN
 			lbl, err := svc.GetLabel(testCase.Context, testFormationTemplateID, testLabelKey)
@@ -1298,7 +1395,7 @@ func TestService_ListLabels(t *testing.T) {
 				labelSvc = testCase.LabelSvc()
 			}
 
-			svc := formationtemplate.NewService(ftRepo, nil, nil, tenantSvc, nil, nil, labelSvc)
+			svc := formationtemplate.NewService(ftRepo, nil, nil, tenantSvc, nil, nil, labelSvc, nil)
 
 			// WHEN
 			lbl, err := svc.ListLabels(testCase.Context, testFormationTemplateID)
@@ -14,16 +14,23 @@ type formationTemplateConstraintReferenceRepository interface {
 	Delete(ctx context.Context, formationTemplateID, constraintID string) error
 }
 
+//go:generate mockery --exported --name=formationTemplateVersionService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type formationTemplateVersionService interface {
+	CreateVersion(ctx context.Context, formationTemplateID string) (*model.FormationTemplateVersion, error)
+}
+
 type service struct {
-	repo      formationTemplateConstraintReferenceRepository
-	converter constraintReferenceConverter
+	repo       formationTemplateConstraintReferenceRepository
+	converter  constraintReferenceConverter
+	versionSvc formationTemplateVersionService
 }
 
 // NewService creates a FormationTemplateConstraintReference service
-func NewService(repo formationTemplateConstraintReferenceRepository, converter constraintReferenceConverter) *service {
+func NewService(repo formationTemplateConstraintReferenceRepository, converter constraintReferenceConverter, versionSvc formationTemplateVersionService) *service {
 	return &service{
-		repo:      repo,
-		converter: converter,
+		repo:       repo,
+		converter:  converter,
+		versionSvc: versionSvc,
 	}
 }
 
@@ -35,7 +42,7 @@ func (s *service) Create(ctx context.Context, in *model.FormationTemplateConstra
 		return errors.Wrapf(err, "while creating Formation Template Constraint Reference for Constraint with ID %q and Formation Template with ID %q", in.ConstraintID, in.FormationTemplateID)
 	}
 
-	return nil
+	return s.createFormationTemplateVersion(ctx, in.FormationTemplateID)
 }
 
 // Delete deletes formation template constraint reference by constraint ID and formation template ID
@@ -44,5 +51,13 @@ func (s *service) Delete(ctx context.Context, constraintID, formationTemplateID
 		return errors.Wrapf(err, "while deleting Formation Template Constraint Reference for Constraint with ID %q and Formation Template with ID %q", constraintID, formationTemplateID)
 	}
 
+	return s.createFormationTemplateVersion(ctx, formationTemplateID)
+}
+
+func (s *service) createFormationTemplateVersion(ctx context.Context, formationTemplateID string) error {
+	if _, err := s.versionSvc.CreateVersion(ctx, formationTemplateID); err != nil {
+		return errors.Wrapf(err, "while creating a new version of Formation Template with ID %q", formationTemplateID)
+	}
+
 	return nil
 }
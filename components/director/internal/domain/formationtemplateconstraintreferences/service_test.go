@@ -24,6 +24,7 @@ func TestService_Create(t *testing.T) {
 		Context                                context.Context
 		Input                                  *model.FormationTemplateConstraintReference
 		FormationConstraintReferenceRepository func() *automock.FormationTemplateConstraintReferenceRepository
+		FormationTemplateVersionService        func() *automock.FormationTemplateVersionService
 		ExpectedErrorMsg                       string
 	}{
 		{
@@ -35,6 +36,11 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, constraintReference).Return(nil).Once()
 				return repo
 			},
+			FormationTemplateVersionService: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctx, templateID).Return(&model.FormationTemplateVersion{}, nil).Once()
+				return svc
+			},
 			ExpectedErrorMsg: "",
 		},
 		{
@@ -48,13 +54,33 @@ func TestService_Create(t *testing.T) {
 			},
 			ExpectedErrorMsg: "while creating Formation Template Constraint Reference",
 		},
+		{
+			Name:    "Error when creating formation template version",
+			Context: ctx,
+			Input:   constraintReference,
+			FormationConstraintReferenceRepository: func() *automock.FormationTemplateConstraintReferenceRepository {
+				repo := &automock.FormationTemplateConstraintReferenceRepository{}
+				repo.On("Create", ctx, constraintReference).Return(nil).Once()
+				return repo
+			},
+			FormationTemplateVersionService: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctx, templateID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrorMsg: "while creating a new version of Formation Template",
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			constraintReferenceRepo := testCase.FormationConstraintReferenceRepository()
+			versionSvc := &automock.FormationTemplateVersionService{}
+			if testCase.FormationTemplateVersionService != nil {
+				versionSvc = testCase.FormationTemplateVersionService()
+			}
 
-			svc := formationtemplateconstraintreferences.NewService(constraintReferenceRepo, nil)
+			svc := formationtemplateconstraintreferences.NewService(constraintReferenceRepo, nil, versionSvc)
 
 			// WHEN
 			err := svc.Create(testCase.Context, testCase.Input)
@@ -67,7 +93,7 @@ func TestService_Create(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, constraintReferenceRepo)
+			mock.AssertExpectationsForObjects(t, constraintReferenceRepo, versionSvc)
 		})
 	}
 }
@@ -82,6 +108,7 @@ func TestService_Delete(t *testing.T) {
 		Name                                   string
 		Context                                context.Context
 		FormationConstraintReferenceRepository func() *automock.FormationTemplateConstraintReferenceRepository
+		FormationTemplateVersionService        func() *automock.FormationTemplateVersionService
 		ExpectedErrorMsg                       string
 	}{
 		{
@@ -91,6 +118,11 @@ func TestService_Delete(t *testing.T) {
 				repo.On("Delete", ctx, templateID, constraintID).Return(nil).Once()
 				return repo
 			},
+			FormationTemplateVersionService: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctx, templateID).Return(&model.FormationTemplateVersion{}, nil).Once()
+				return svc
+			},
 			ExpectedErrorMsg: "",
 		},
 		{
@@ -102,13 +134,31 @@ func TestService_Delete(t *testing.T) {
 			},
 			ExpectedErrorMsg: "while deleting Formation Template Constraint Reference",
 		},
+		{
+			Name: "Error when creating formation template version",
+			FormationConstraintReferenceRepository: func() *automock.FormationTemplateConstraintReferenceRepository {
+				repo := &automock.FormationTemplateConstraintReferenceRepository{}
+				repo.On("Delete", ctx, templateID, constraintID).Return(nil).Once()
+				return repo
+			},
+			FormationTemplateVersionService: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("CreateVersion", ctx, templateID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrorMsg: "while creating a new version of Formation Template",
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			constraintReferenceRepo := testCase.FormationConstraintReferenceRepository()
+			versionSvc := &automock.FormationTemplateVersionService{}
+			if testCase.FormationTemplateVersionService != nil {
+				versionSvc = testCase.FormationTemplateVersionService()
+			}
 
-			svc := formationtemplateconstraintreferences.NewService(constraintReferenceRepo, nil)
+			svc := formationtemplateconstraintreferences.NewService(constraintReferenceRepo, nil, versionSvc)
 
 			// WHEN
 			err := svc.Delete(ctx, constraintID, templateID)
@@ -121,7 +171,7 @@ func TestService_Delete(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, constraintReferenceRepo)
+			mock.AssertExpectationsForObjects(t, constraintReferenceRepo, versionSvc)
 		})
 	}
 }
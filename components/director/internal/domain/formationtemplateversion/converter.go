@@ -0,0 +1,123 @@
+package formationtemplateversion
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+type converter struct{}
+
+// NewConverter creates a new formation template version converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the formation template version model to an entity
+func (c *converter) ToEntity(in *model.FormationTemplateVersion) (*Entity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	snapshot, err := json.Marshal(in.Snapshot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while marshalling the snapshot of version %d of formation template with ID %s", in.Version, in.FormationTemplateID)
+	}
+
+	return &Entity{
+		ID:                  in.ID,
+		FormationTemplateID: in.FormationTemplateID,
+		Version:             in.Version,
+		Snapshot:            string(snapshot),
+		CreatedAt:           in.CreatedAt,
+	}, nil
+}
+
+// FromEntity converts the formation template version entity to a model
+func (c *converter) FromEntity(entity *Entity) (*model.FormationTemplateVersion, error) {
+	if entity == nil {
+		return nil, nil
+	}
+
+	var snapshot model.FormationTemplateSnapshot
+	if err := json.Unmarshal([]byte(entity.Snapshot), &snapshot); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshalling the snapshot of version %d of formation template with ID %s", entity.Version, entity.FormationTemplateID)
+	}
+
+	return &model.FormationTemplateVersion{
+		ID:                  entity.ID,
+		FormationTemplateID: entity.FormationTemplateID,
+		Version:             entity.Version,
+		Snapshot:            snapshot,
+		CreatedAt:           entity.CreatedAt,
+	}, nil
+}
+
+// ToGraphQL converts the formation template version model to its graphql representation
+func (c *converter) ToGraphQL(in *model.FormationTemplateVersion) *graphql.FormationTemplateVersion {
+	if in == nil {
+		return nil
+	}
+
+	webhookIDs := make([]string, 0, len(in.Snapshot.Webhooks))
+	for _, wh := range in.Snapshot.Webhooks {
+		webhookIDs = append(webhookIDs, wh.ID)
+	}
+
+	return &graphql.FormationTemplateVersion{
+		FormationTemplateID: in.FormationTemplateID,
+		Version:             in.Version,
+		Name:                in.Snapshot.Name,
+		ApplicationTypes:    nonNil(in.Snapshot.ApplicationTypes),
+		RuntimeTypes:        in.Snapshot.RuntimeTypes,
+		SupportsReset:       in.Snapshot.SupportsReset,
+		WebhookIDs:          webhookIDs,
+		ConstraintIDs:       nonNil(in.Snapshot.ConstraintIDs),
+		CreatedAt:           graphql.Timestamp(in.CreatedAt),
+	}
+}
+
+// MultipleToGraphQL converts multiple formation template version models to their graphql representation
+func (c *converter) MultipleToGraphQL(in []*model.FormationTemplateVersion) []*graphql.FormationTemplateVersion {
+	versions := make([]*graphql.FormationTemplateVersion, 0, len(in))
+	for _, v := range in {
+		if v == nil {
+			continue
+		}
+		versions = append(versions, c.ToGraphQL(v))
+	}
+
+	return versions
+}
+
+// MigrationResultsToGraphQL converts the formation migration results to their graphql representation
+func (c *converter) MigrationResultsToGraphQL(in []*model.FormationTemplateMigrationResult) []*graphql.FormationTemplateMigrationResult {
+	results := make([]*graphql.FormationTemplateMigrationResult, 0, len(in))
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+		results = append(results, &graphql.FormationTemplateMigrationResult{
+			FormationID:          r.FormationID,
+			FromVersion:          r.FromVersion,
+			ToVersion:            r.ToVersion,
+			Status:               graphql.FormationTemplateMigrationStatus(r.Status),
+			WebhooksChanged:      r.WebhooksChanged,
+			AddedConstraintIDs:   nonNil(r.AddedConstraintIDs),
+			RemovedConstraintIDs: nonNil(r.RemovedConstraintIDs),
+			Violations:           nonNil(r.Violations),
+			Error:                r.Error,
+		})
+	}
+
+	return results
+}
+
+func nonNil(in []string) []string {
+	if in == nil {
+		return []string{}
+	}
+	return in
+}
@@ -0,0 +1,85 @@
+package formationtemplateversion_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateversion"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToEntityAndFromEntity(t *testing.T) {
+	conv := formationtemplateversion.NewConverter()
+	versionModel := fixVersionModel(testVersion, fixSnapshot("https://url.com", testConstraintID))
+
+	t.Run("round trip preserves the snapshot", func(t *testing.T) {
+		entity, err := conv.ToEntity(versionModel)
+		require.NoError(t, err)
+		assert.Equal(t, fixVersionEntity(testVersion, fixSnapshot("https://url.com", testConstraintID)), entity)
+
+		result, err := conv.FromEntity(entity)
+		require.NoError(t, err)
+		assert.Equal(t, versionModel, result)
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		entity, err := conv.ToEntity(nil)
+		require.NoError(t, err)
+		assert.Nil(t, entity)
+
+		result, err := conv.FromEntity(nil)
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error when snapshot is not valid JSON", func(t *testing.T) {
+		entity := fixVersionEntity(testVersion, fixSnapshot("https://url.com"))
+		entity.Snapshot = "{"
+
+		_, err := conv.FromEntity(entity)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling the snapshot")
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := formationtemplateversion.NewConverter()
+
+	result := conv.MultipleToGraphQL([]*model.FormationTemplateVersion{fixVersionModel(testVersion, fixSnapshot("https://url.com", testConstraintID)), nil})
+
+	assert.Equal(t, []*graphql.FormationTemplateVersion{fixGQLVersion(testVersion)}, result)
+}
+
+func TestConverter_MigrationResultsToGraphQL(t *testing.T) {
+	conv := formationtemplateversion.NewConverter()
+	errMessage := "error"
+
+	result := conv.MigrationResultsToGraphQL([]*model.FormationTemplateMigrationResult{
+		{
+			FormationID:        testFormationID,
+			FromVersion:        1,
+			ToVersion:          testVersion,
+			Status:             model.FormationTemplateMigrationStatusMigrated,
+			WebhooksChanged:    true,
+			AddedConstraintIDs: []string{testConstraintID},
+			Error:              &errMessage,
+		},
+		nil,
+	})
+
+	assert.Equal(t, []*graphql.FormationTemplateMigrationResult{
+		{
+			FormationID:          testFormationID,
+			FromVersion:          1,
+			ToVersion:            testVersion,
+			Status:               graphql.FormationTemplateMigrationStatusMigrated,
+			WebhooksChanged:      true,
+			AddedConstraintIDs:   []string{testConstraintID},
+			RemovedConstraintIDs: []string{},
+			Violations:           []string{},
+			Error:                &errMessage,
+		},
+	}, result)
+}
@@ -0,0 +1,20 @@
+package formationtemplateversion
+
+import "time"
+
+// Entity represents the formation template version entity
+type Entity struct {
+	ID                  string    `db:"id"`
+	FormationTemplateID string    `db:"formation_template_id"`
+	Version             int       `db:"version"`
+	Snapshot            string    `db:"snapshot"`
+	CreatedAt           time.Time `db:"created_at"`
+}
+
+// EntityCollection is a collection of formation template version entities.
+type EntityCollection []*Entity
+
+// Len returns the number of entities in the collection.
+func (s EntityCollection) Len() int {
+	return len(s)
+}
@@ -0,0 +1,108 @@
+package formationtemplateversion_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateversion"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const (
+	testID                  = "d1fddec6-5456-4a1e-9ae0-74447f5d6ae9"
+	testFormationTemplateID = "b5a0cfe6-63c4-4a33-a1e9-d9f9c8ddf9a4"
+	testFormationID         = "1f3f3d5c-2a0c-4c9e-a3c4-7d2b2fbb3d6a"
+	testTenantID            = "f4cfc3d8-1b8c-4f6f-8b2e-5a1c3d2e6f7a"
+	testWebhookID           = "9c7b5d3e-1f2a-4b6c-8d0e-2f4a6b8c0d1e"
+	testConstraintID        = "3e5f7a9b-1c2d-4e6f-8a0b-2c4d6e8f0a1b"
+	secondConstraintID      = "7a9b1c3d-5e6f-4a8b-0c2d-4e6f8a0b2c4d"
+	testApplicationType     = "app-type"
+	testRuntimeType         = "runtime-type"
+	testVersion             = 2
+)
+
+var (
+	testErr  = errors.New("test error")
+	testTime = time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+	nilModel *model.FormationTemplateVersion
+)
+
+func fixFormationTemplateModel() *model.FormationTemplate {
+	return &model.FormationTemplate{
+		ID:               testFormationTemplateID,
+		Name:             "formation-template",
+		ApplicationTypes: []string{testApplicationType},
+		RuntimeTypes:     []string{testRuntimeType},
+		SupportsReset:    true,
+	}
+}
+
+func fixWebhookModel(url string) *model.Webhook {
+	return &model.Webhook{
+		ID:         testWebhookID,
+		ObjectID:   testFormationTemplateID,
+		ObjectType: model.FormationTemplateWebhookReference,
+		Type:       model.WebhookTypeFormationLifecycle,
+		URL:        &url,
+	}
+}
+
+func fixSnapshot(webhookURL string, constraintIDs ...string) model.FormationTemplateSnapshot {
+	return model.NewFormationTemplateSnapshot(fixFormationTemplateModel(), []*model.Webhook{fixWebhookModel(webhookURL)}, constraintIDs)
+}
+
+func fixVersionModel(version int, snapshot model.FormationTemplateSnapshot) *model.FormationTemplateVersion {
+	return &model.FormationTemplateVersion{
+		ID:                  testID,
+		FormationTemplateID: testFormationTemplateID,
+		Version:             version,
+		Snapshot:            snapshot,
+		CreatedAt:           testTime,
+	}
+}
+
+func fixVersionEntity(version int, snapshot model.FormationTemplateSnapshot) *formationtemplateversion.Entity {
+	marshalledSnapshot, err := json.Marshal(snapshot)
+	if err != nil {
+		panic(err)
+	}
+
+	return &formationtemplateversion.Entity{
+		ID:                  testID,
+		FormationTemplateID: testFormationTemplateID,
+		Version:             version,
+		Snapshot:            string(marshalledSnapshot),
+		CreatedAt:           testTime,
+	}
+}
+
+func fixGQLVersion(version int) *graphql.FormationTemplateVersion {
+	return &graphql.FormationTemplateVersion{
+		FormationTemplateID: testFormationTemplateID,
+		Version:             version,
+		Name:                "formation-template",
+		ApplicationTypes:    []string{testApplicationType},
+		RuntimeTypes:        []string{testRuntimeType},
+		SupportsReset:       true,
+		WebhookIDs:          []string{testWebhookID},
+		ConstraintIDs:       []string{testConstraintID},
+		CreatedAt:           graphql.Timestamp(testTime),
+	}
+}
+
+func fixFormationModel(version int) *model.Formation {
+	return &model.Formation{
+		ID:                       testFormationID,
+		TenantID:                 testTenantID,
+		FormationTemplateID:      testFormationTemplateID,
+		FormationTemplateVersion: version,
+		Name:                     "formation",
+		State:                    model.ReadyFormationState,
+	}
+}
+
+func fixColumns() []string {
+	return []string{"id", "formation_template_id", "version", "snapshot", "created_at"}
+}
@@ -0,0 +1,103 @@
+package formationtemplateversion
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	tableName                 string = `public.formation_template_versions`
+	formationTemplateIDColumn string = "formation_template_id"
+	versionColumn             string = "version"
+)
+
+var tableColumns = []string{"id", formationTemplateIDColumn, versionColumn, "snapshot", "created_at"}
+
+// EntityConverter converts between the internal model and entity
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.FormationTemplateVersion) (*Entity, error)
+	FromEntity(entity *Entity) (*model.FormationTemplateVersion, error)
+}
+
+type repository struct {
+	creator      repo.CreatorGlobal
+	singleGetter repo.SingleGetterGlobal
+	lister       repo.ListerGlobal
+	conv         EntityConverter
+}
+
+// NewRepository creates a new FormationTemplateVersion repository
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		creator:      repo.NewCreatorGlobal(resource.FormationTemplateVersion, tableName, tableColumns),
+		singleGetter: repo.NewSingleGetterGlobal(resource.FormationTemplateVersion, tableName, tableColumns),
+		lister:       repo.NewListerGlobalWithOrderBy(resource.FormationTemplateVersion, tableName, tableColumns, repo.OrderByParams{repo.NewAscOrderBy(versionColumn)}),
+		conv:         conv,
+	}
+}
+
+// Create persists a new FormationTemplateVersion
+func (r *repository) Create(ctx context.Context, item *model.FormationTemplateVersion) error {
+	if item == nil {
+		return apperrors.NewInternalError("model can not be empty")
+	}
+
+	entity, err := r.conv.ToEntity(item)
+	if err != nil {
+		return err
+	}
+
+	log.C(ctx).Debugf("Persisting version %d of formation template with ID: %q", item.Version, item.FormationTemplateID)
+	return r.creator.Create(ctx, entity)
+}
+
+// GetByVersion returns the given version of the formation template
+func (r *repository) GetByVersion(ctx context.Context, formationTemplateID string, version int) (*model.FormationTemplateVersion, error) {
+	var entity Entity
+	conditions := repo.Conditions{
+		repo.NewEqualCondition(formationTemplateIDColumn, formationTemplateID),
+		repo.NewEqualCondition(versionColumn, version),
+	}
+	if err := r.singleGetter.GetGlobal(ctx, conditions, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity)
+}
+
+// GetLatest returns the most recent version of the formation template
+func (r *repository) GetLatest(ctx context.Context, formationTemplateID string) (*model.FormationTemplateVersion, error) {
+	var entity Entity
+	conditions := repo.Conditions{repo.NewEqualCondition(formationTemplateIDColumn, formationTemplateID)}
+	if err := r.singleGetter.GetGlobal(ctx, conditions, repo.OrderByParams{repo.NewDescOrderBy(versionColumn)}, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity)
+}
+
+// ListByFormationTemplateID lists all versions of the formation template, oldest first
+func (r *repository) ListByFormationTemplateID(ctx context.Context, formationTemplateID string) ([]*model.FormationTemplateVersion, error) {
+	var entities EntityCollection
+	if err := r.lister.ListGlobal(ctx, &entities, repo.NewEqualCondition(formationTemplateIDColumn, formationTemplateID)); err != nil {
+		return nil, err
+	}
+
+	versions := make([]*model.FormationTemplateVersion, 0, len(entities))
+	for _, entity := range entities {
+		version, err := r.conv.FromEntity(entity)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,139 @@
+package formationtemplateversion_test
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateversion"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateversion/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+)
+
+func TestRepository_Create(t *testing.T) {
+	versionModel := fixVersionModel(testVersion, fixSnapshot("https://url.com", testConstraintID))
+	versionEntity := fixVersionEntity(testVersion, fixSnapshot("https://url.com", testConstraintID))
+
+	suite := testdb.RepoCreateTestSuite{
+		Name:       "Create formation template version",
+		MethodName: "Create",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:       `^INSERT INTO public.formation_template_versions \(.+\) VALUES \(.+\)$`,
+				Args:        []driver.Value{versionEntity.ID, versionEntity.FormationTemplateID, versionEntity.Version, versionEntity.Snapshot, versionEntity.CreatedAt},
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: formationtemplateversion.NewRepository,
+		ModelEntity:         versionModel,
+		DBEntity:            versionEntity,
+		NilModelEntity:      nilModel,
+		IsGlobal:            true,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_GetByVersion(t *testing.T) {
+	versionModel := fixVersionModel(testVersion, fixSnapshot("https://url.com", testConstraintID))
+	versionEntity := fixVersionEntity(testVersion, fixSnapshot("https://url.com", testConstraintID))
+
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get formation template version by version",
+		MethodName: "GetByVersion",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, formation_template_id, version, snapshot, created_at FROM public.formation_template_versions WHERE formation_template_id = $1 AND version = $2`),
+				Args:     []driver.Value{testFormationTemplateID, testVersion},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(versionEntity.ID, versionEntity.FormationTemplateID, versionEntity.Version, versionEntity.Snapshot, versionEntity.CreatedAt)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: formationtemplateversion.NewRepository,
+		ExpectedModelEntity: versionModel,
+		ExpectedDBEntity:    versionEntity,
+		MethodArgs:          []interface{}{testFormationTemplateID, testVersion},
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_GetLatest(t *testing.T) {
+	versionModel := fixVersionModel(testVersion, fixSnapshot("https://url.com", testConstraintID))
+	versionEntity := fixVersionEntity(testVersion, fixSnapshot("https://url.com", testConstraintID))
+
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get latest formation template version",
+		MethodName: "GetLatest",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, formation_template_id, version, snapshot, created_at FROM public.formation_template_versions WHERE formation_template_id = $1 ORDER BY version DESC`),
+				Args:     []driver.Value{testFormationTemplateID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(versionEntity.ID, versionEntity.FormationTemplateID, versionEntity.Version, versionEntity.Snapshot, versionEntity.CreatedAt)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: formationtemplateversion.NewRepository,
+		ExpectedModelEntity: versionModel,
+		ExpectedDBEntity:    versionEntity,
+		MethodArgs:          []interface{}{testFormationTemplateID},
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_ListByFormationTemplateID(t *testing.T) {
+	firstModel := fixVersionModel(1, fixSnapshot("https://url.com"))
+	firstEntity := fixVersionEntity(1, fixSnapshot("https://url.com"))
+	secondModel := fixVersionModel(testVersion, fixSnapshot("https://url.com", testConstraintID))
+	secondEntity := fixVersionEntity(testVersion, fixSnapshot("https://url.com", testConstraintID))
+
+	suite := testdb.RepoListTestSuite{
+		Name:       "List formation template versions",
+		MethodName: "ListByFormationTemplateID",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, formation_template_id, version, snapshot, created_at FROM public.formation_template_versions WHERE formation_template_id = $1 ORDER BY version ASC`),
+				Args:     []driver.Value{testFormationTemplateID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).
+						AddRow(firstEntity.ID, firstEntity.FormationTemplateID, firstEntity.Version, firstEntity.Snapshot, firstEntity.CreatedAt).
+						AddRow(secondEntity.ID, secondEntity.FormationTemplateID, secondEntity.Version, secondEntity.Snapshot, secondEntity.CreatedAt)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   formationtemplateversion.NewRepository,
+		MethodArgs:            []interface{}{testFormationTemplateID},
+		ExpectedDBEntities:    []interface{}{firstEntity, secondEntity},
+		ExpectedModelEntities: []interface{}{firstModel, secondModel},
+	}
+
+	suite.Run(t)
+}
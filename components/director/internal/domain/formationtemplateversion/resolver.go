@@ -0,0 +1,141 @@
+package formationtemplateversion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// FormationTemplateVersionService is responsible for the service-layer formation template version operations
+//
+//go:generate mockery --name=FormationTemplateVersionService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationTemplateVersionService interface {
+	ListForFormationTemplate(ctx context.Context, formationTemplateID string) ([]*model.FormationTemplateVersion, error)
+	Migrate(ctx context.Context, formationTemplateID string, version int, formationID string, dryRun bool) (*model.FormationTemplateMigrationResult, error)
+	ResendNotifications(ctx context.Context, formationID string) error
+}
+
+// Converter converts formation template versions and migration results to their graphql representation
+//
+//go:generate mockery --name=Converter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Converter interface {
+	MultipleToGraphQL(in []*model.FormationTemplateVersion) []*graphql.FormationTemplateVersion
+	MigrationResultsToGraphQL(in []*model.FormationTemplateMigrationResult) []*graphql.FormationTemplateMigrationResult
+}
+
+// Resolver is the formation template version resolver
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      FormationTemplateVersionService
+	conv     Converter
+}
+
+// NewResolver creates a new formation template version resolver
+func NewResolver(transact persistence.Transactioner, svc FormationTemplateVersionService, conv Converter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// FormationTemplateVersions lists the versions of the formation template, oldest first
+func (r *Resolver) FormationTemplateVersions(ctx context.Context, formationTemplateID string) ([]*graphql.FormationTemplateVersion, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	versions, err := r.svc.ListForFormationTemplate(ctx, formationTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleToGraphQL(versions), nil
+}
+
+// MigrateFormationsToTemplateVersion pins each of the formations to the given version of the formation template.
+// Every formation is migrated in its own transaction, so a failure is reported in its result and does not affect the others.
+// The notifications of the migrated formations are re-sent if the webhooks of the target version differ from the previous one.
+func (r *Resolver) MigrateFormationsToTemplateVersion(ctx context.Context, templateID string, version int, formationIDs []string, dryRun *bool) ([]*graphql.FormationTemplateMigrationResult, error) {
+	isDryRun := dryRun != nil && *dryRun
+
+	results := make([]*model.FormationTemplateMigrationResult, 0, len(formationIDs))
+	for _, formationID := range formationIDs {
+		results = append(results, r.migrateFormation(ctx, templateID, version, formationID, isDryRun))
+	}
+
+	return r.conv.MigrationResultsToGraphQL(results), nil
+}
+
+func (r *Resolver) migrateFormation(ctx context.Context, templateID string, version int, formationID string, dryRun bool) *model.FormationTemplateMigrationResult {
+	result, err := r.migrateFormationInTx(ctx, templateID, version, formationID, dryRun)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to migrate formation with ID %s to version %d of formation template with ID %s", formationID, version, templateID)
+		errMessage := err.Error()
+		return &model.FormationTemplateMigrationResult{
+			FormationID: formationID,
+			ToVersion:   version,
+			Status:      model.FormationTemplateMigrationStatusFailed,
+			Error:       &errMessage,
+		}
+	}
+
+	if result.Status == model.FormationTemplateMigrationStatusMigrated && result.WebhooksChanged {
+		if err = r.resendNotificationsInTx(ctx, formationID); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to re-send the notifications of formation with ID %s after its migration", formationID)
+			errMessage := fmt.Sprintf("the formation is migrated but its notifications could not be re-sent: %s", err.Error())
+			result.Error = &errMessage
+		}
+	}
+
+	return result
+}
+
+func (r *Resolver) migrateFormationInTx(ctx context.Context, templateID string, version int, formationID string, dryRun bool) (*model.FormationTemplateMigrationResult, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	result, err := r.svc.Migrate(ctx, templateID, version, formationID, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *Resolver) resendNotificationsInTx(ctx context.Context, formationID string) error {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = r.svc.ResendNotifications(ctx, formationID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,216 @@
+package formationtemplateversion_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateversion"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateversion/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_FormationTemplateVersions(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	versions := []*model.FormationTemplateVersion{fixVersionModel(testVersion, fixSnapshot("https://url.com", testConstraintID))}
+	gqlVersions := []*graphql.FormationTemplateVersion{fixGQLVersion(testVersion)}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.FormationTemplateVersionService
+		ConverterFn    func() *automock.Converter
+		ExpectedOutput []*graphql.FormationTemplateVersion
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("ListForFormationTemplate", txtest.CtxWithDBMatcher(), testFormationTemplateID).Return(versions, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("MultipleToGraphQL", versions).Return(gqlVersions).Once()
+				return conv
+			},
+			ExpectedOutput: gqlVersions,
+		},
+		{
+			Name: "Error when listing the versions fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("ListForFormationTemplate", txtest.CtxWithDBMatcher(), testFormationTemplateID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.Converter { return &automock.Converter{} },
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when the transaction fails to begin",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ServiceFn:     func() *automock.FormationTemplateVersionService { return &automock.FormationTemplateVersionService{} },
+			ConverterFn:   func() *automock.Converter { return &automock.Converter{} },
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when the transaction fails to commit",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("ListForFormationTemplate", txtest.CtxWithDBMatcher(), testFormationTemplateID).Return(versions, nil).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.Converter { return &automock.Converter{} },
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+			resolver := formationtemplateversion.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.FormationTemplateVersions(context.TODO(), testFormationTemplateID)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOutput, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_MigrateFormationsToTemplateVersion(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	gqlResults := []*graphql.FormationTemplateMigrationResult{{FormationID: testFormationID}}
+	upToDateID := "up-to-date"
+	failingID := "failing"
+
+	migratedResult := func() *model.FormationTemplateMigrationResult {
+		return &model.FormationTemplateMigrationResult{FormationID: testFormationID, FromVersion: 1, ToVersion: testVersion, Status: model.FormationTemplateMigrationStatusMigrated, WebhooksChanged: true}
+	}
+	upToDateResult := func() *model.FormationTemplateMigrationResult {
+		return &model.FormationTemplateMigrationResult{FormationID: upToDateID, FromVersion: testVersion, ToVersion: testVersion, Status: model.FormationTemplateMigrationStatusUpToDate}
+	}
+	compatibleResult := func() *model.FormationTemplateMigrationResult {
+		return &model.FormationTemplateMigrationResult{FormationID: testFormationID, FromVersion: 1, ToVersion: testVersion, Status: model.FormationTemplateMigrationStatusCompatible, WebhooksChanged: true}
+	}
+	failedResult := func(formationID, errMessage string) *model.FormationTemplateMigrationResult {
+		return &model.FormationTemplateMigrationResult{FormationID: formationID, ToVersion: testVersion, Status: model.FormationTemplateMigrationStatusFailed, Error: str.Ptr(errMessage)}
+	}
+
+	testCases := []struct {
+		Name            string
+		TxFn            func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.FormationTemplateVersionService
+		FormationIDs    []string
+		DryRun          *bool
+		ExpectedResults []*model.FormationTemplateMigrationResult
+	}{
+		{
+			Name: "Reports the result of every formation and re-sends the notifications when webhooks changed",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(3)
+			},
+			ServiceFn: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("Migrate", txtest.CtxWithDBMatcher(), testFormationTemplateID, testVersion, testFormationID, false).Return(migratedResult(), nil).Once()
+				svc.On("ResendNotifications", txtest.CtxWithDBMatcher(), testFormationID).Return(nil).Once()
+				svc.On("Migrate", txtest.CtxWithDBMatcher(), testFormationTemplateID, testVersion, upToDateID, false).Return(upToDateResult(), nil).Once()
+				svc.On("Migrate", txtest.CtxWithDBMatcher(), testFormationTemplateID, testVersion, failingID, false).Return(nil, testErr).Once()
+				return svc
+			},
+			FormationIDs: []string{testFormationID, upToDateID, failingID},
+			ExpectedResults: []*model.FormationTemplateMigrationResult{
+				migratedResult(),
+				upToDateResult(),
+				failedResult(failingID, testErr.Error()),
+			},
+		},
+		{
+			Name: "Keeps the migration and reports the error when re-sending the notifications fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(1)
+			},
+			ServiceFn: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("Migrate", txtest.CtxWithDBMatcher(), testFormationTemplateID, testVersion, testFormationID, false).Return(migratedResult(), nil).Once()
+				svc.On("ResendNotifications", txtest.CtxWithDBMatcher(), testFormationID).Return(testErr).Once()
+				return svc
+			},
+			FormationIDs: []string{testFormationID},
+			ExpectedResults: []*model.FormationTemplateMigrationResult{
+				func() *model.FormationTemplateMigrationResult {
+					result := migratedResult()
+					result.Error = str.Ptr("the formation is migrated but its notifications could not be re-sent: " + testErr.Error())
+					return result
+				}(),
+			},
+		},
+		{
+			Name: "Does not re-send notifications on dry run",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("Migrate", txtest.CtxWithDBMatcher(), testFormationTemplateID, testVersion, testFormationID, true).Return(compatibleResult(), nil).Once()
+				return svc
+			},
+			FormationIDs:    []string{testFormationID},
+			DryRun:          boolPtr(true),
+			ExpectedResults: []*model.FormationTemplateMigrationResult{compatibleResult()},
+		},
+		{
+			Name: "Reports failure when the transaction fails to commit",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.FormationTemplateVersionService {
+				svc := &automock.FormationTemplateVersionService{}
+				svc.On("Migrate", txtest.CtxWithDBMatcher(), testFormationTemplateID, testVersion, testFormationID, false).Return(migratedResult(), nil).Once()
+				return svc
+			},
+			FormationIDs:    []string{testFormationID},
+			ExpectedResults: []*model.FormationTemplateMigrationResult{failedResult(testFormationID, testErr.Error())},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := &automock.Converter{}
+			conv.On("MigrationResultsToGraphQL", testCase.ExpectedResults).Return(gqlResults).Once()
+			resolver := formationtemplateversion.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.MigrateFormationsToTemplateVersion(context.TODO(), testFormationTemplateID, testVersion, testCase.FormationIDs, testCase.DryRun)
+
+			// THEN
+			require.NoError(t, err)
+			assert.Equal(t, gqlResults, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
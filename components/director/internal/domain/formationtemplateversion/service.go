@@ -0,0 +1,230 @@
+package formationtemplateversion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// Now is a function variable that returns the current time. It is used, so we could mock it in the tests.
+var Now = time.Now
+
+// FormationTemplateVersionRepository represents the FormationTemplateVersion repository layer
+//
+//go:generate mockery --name=FormationTemplateVersionRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationTemplateVersionRepository interface {
+	Create(ctx context.Context, item *model.FormationTemplateVersion) error
+	GetByVersion(ctx context.Context, formationTemplateID string, version int) (*model.FormationTemplateVersion, error)
+	GetLatest(ctx context.Context, formationTemplateID string) (*model.FormationTemplateVersion, error)
+	ListByFormationTemplateID(ctx context.Context, formationTemplateID string) ([]*model.FormationTemplateVersion, error)
+}
+
+// FormationTemplateRepository is responsible for repo-layer formation template operations
+//
+//go:generate mockery --name=FormationTemplateRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationTemplateRepository interface {
+	Get(ctx context.Context, id string) (*model.FormationTemplate, error)
+}
+
+// WebhookRepository is responsible for repo-layer webhook operations
+//
+//go:generate mockery --name=WebhookRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type WebhookRepository interface {
+	ListByReferenceObjectIDGlobal(ctx context.Context, objID string, objType model.WebhookReferenceObjectType) ([]*model.Webhook, error)
+}
+
+// ConstraintReferenceRepository is responsible for repo-layer formation template constraint reference operations
+//
+//go:generate mockery --name=ConstraintReferenceRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ConstraintReferenceRepository interface {
+	ListByFormationTemplateID(ctx context.Context, formationTemplateID string) ([]*model.FormationTemplateConstraintReference, error)
+}
+
+// FormationService is responsible for the service-layer formation operations
+//
+//go:generate mockery --name=FormationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationService interface {
+	Get(ctx context.Context, id string) (*model.Formation, error)
+	Update(ctx context.Context, formation *model.Formation) error
+	ValidateParticipantsForTemplateVersion(ctx context.Context, formation *model.Formation, version *model.FormationTemplateVersion) ([]string, error)
+	ResendNotifications(ctx context.Context, formationID string) (*model.Formation, error)
+}
+
+// UIDService generates UUIDs for new entities
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo                    FormationTemplateVersionRepository
+	formationTemplateRepo   FormationTemplateRepository
+	webhookRepo             WebhookRepository
+	constraintReferenceRepo ConstraintReferenceRepository
+	formationSvc            FormationService
+	uidSvc                  UIDService
+}
+
+// NewService creates a FormationTemplateVersion service
+func NewService(repo FormationTemplateVersionRepository, formationTemplateRepo FormationTemplateRepository, webhookRepo WebhookRepository, constraintReferenceRepo ConstraintReferenceRepository, formationSvc FormationService, uidSvc UIDService) *service {
+	return &service{
+		repo:                    repo,
+		formationTemplateRepo:   formationTemplateRepo,
+		webhookRepo:             webhookRepo,
+		constraintReferenceRepo: constraintReferenceRepo,
+		formationSvc:            formationSvc,
+		uidSvc:                  uidSvc,
+	}
+}
+
+// CreateVersion stores the current state of the formation template, its webhooks and attached constraints as its next version
+func (s *service) CreateVersion(ctx context.Context, formationTemplateID string) (*model.FormationTemplateVersion, error) {
+	formationTemplate, err := s.formationTemplateRepo.Get(ctx, formationTemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation template with ID %s", formationTemplateID)
+	}
+
+	webhooks, err := s.webhookRepo.ListByReferenceObjectIDGlobal(ctx, formationTemplateID, model.FormationTemplateWebhookReference)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing webhooks of formation template with ID %s", formationTemplateID)
+	}
+
+	references, err := s.constraintReferenceRepo.ListByFormationTemplateID(ctx, formationTemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing constraint references of formation template with ID %s", formationTemplateID)
+	}
+
+	constraintIDs := make([]string, 0, len(references))
+	for _, reference := range references {
+		constraintIDs = append(constraintIDs, reference.ConstraintID)
+	}
+
+	nextVersion := 1
+	latest, err := s.repo.GetLatest(ctx, formationTemplateID)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return nil, errors.Wrapf(err, "while getting the latest version of formation template with ID %s", formationTemplateID)
+	}
+	if latest != nil {
+		nextVersion = latest.Version + 1
+	}
+
+	version := &model.FormationTemplateVersion{
+		ID:                  s.uidSvc.Generate(),
+		FormationTemplateID: formationTemplateID,
+		Version:             nextVersion,
+		Snapshot:            model.NewFormationTemplateSnapshot(formationTemplate, webhooks, constraintIDs),
+		CreatedAt:           Now(),
+	}
+
+	log.C(ctx).Infof("Creating version %d of formation template with ID %s", nextVersion, formationTemplateID)
+	if err = s.repo.Create(ctx, version); err != nil {
+		return nil, errors.Wrapf(err, "while creating version %d of formation template with ID %s", nextVersion, formationTemplateID)
+	}
+
+	return version, nil
+}
+
+// ListForFormationTemplate lists all versions of the formation template, oldest first
+func (s *service) ListForFormationTemplate(ctx context.Context, formationTemplateID string) ([]*model.FormationTemplateVersion, error) {
+	versions, err := s.repo.ListByFormationTemplateID(ctx, formationTemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing versions of formation template with ID %s", formationTemplateID)
+	}
+
+	return versions, nil
+}
+
+// Migrate pins the formation to the given version of its formation template if all of its participants are allowed by it and satisfy its constraints.
+// Nothing is persisted when dryRun is set.
+func (s *service) Migrate(ctx context.Context, formationTemplateID string, version int, formationID string, dryRun bool) (*model.FormationTemplateMigrationResult, error) {
+	target, err := s.repo.GetByVersion(ctx, formationTemplateID, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting version %d of formation template with ID %s", version, formationTemplateID)
+	}
+
+	formation, err := s.formationSvc.Get(ctx, formationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if formation.FormationTemplateID != formationTemplateID {
+		return nil, apperrors.NewInvalidDataError(fmt.Sprintf("formation with ID %s is not created from formation template with ID %s", formationID, formationTemplateID))
+	}
+
+	result := &model.FormationTemplateMigrationResult{
+		FormationID: formationID,
+		FromVersion: formation.FormationTemplateVersion,
+		ToVersion:   version,
+	}
+
+	if formation.FormationTemplateVersion == version {
+		result.Status = model.FormationTemplateMigrationStatusUpToDate
+		return result, nil
+	}
+
+	pinned, err := s.getPinnedVersion(ctx, formation)
+	if err != nil {
+		return nil, err
+	}
+
+	result.AddedConstraintIDs, result.RemovedConstraintIDs = pinned.Snapshot.ConstraintsDiff(target.Snapshot)
+	result.WebhooksChanged = pinned.Snapshot.WebhooksDiffer(target.Snapshot)
+
+	violations, err := s.formationSvc.ValidateParticipantsForTemplateVersion(ctx, formation, target)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while validating the participants of formation with ID %s", formationID)
+	}
+	result.Violations = violations
+
+	if len(violations) > 0 {
+		result.Status = model.FormationTemplateMigrationStatusIncompatible
+		return result, nil
+	}
+
+	if dryRun {
+		result.Status = model.FormationTemplateMigrationStatusCompatible
+		return result, nil
+	}
+
+	log.C(ctx).Infof("Migrating formation with ID %s from version %d to version %d of formation template with ID %s", formationID, formation.FormationTemplateVersion, version, formationTemplateID)
+	formation.FormationTemplateVersion = version
+	if err = s.formationSvc.Update(ctx, formation); err != nil {
+		return nil, errors.Wrapf(err, "while pinning formation with ID %s to version %d", formationID, version)
+	}
+	result.Status = model.FormationTemplateMigrationStatusMigrated
+
+	return result, nil
+}
+
+// ResendNotifications re-sends the notifications of the formation and its assignments
+func (s *service) ResendNotifications(ctx context.Context, formationID string) error {
+	if _, err := s.formationSvc.ResendNotifications(ctx, formationID); err != nil {
+		return errors.Wrapf(err, "while re-sending the notifications of formation with ID %s", formationID)
+	}
+
+	return nil
+}
+
+// getPinnedVersion returns the version the formation is pinned to. Formations which are not pinned follow the latest version.
+func (s *service) getPinnedVersion(ctx context.Context, formation *model.Formation) (*model.FormationTemplateVersion, error) {
+	if formation.FormationTemplateVersion == 0 {
+		latest, err := s.repo.GetLatest(ctx, formation.FormationTemplateID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while getting the latest version of formation template with ID %s", formation.FormationTemplateID)
+		}
+		return latest, nil
+	}
+
+	pinned, err := s.repo.GetByVersion(ctx, formation.FormationTemplateID, formation.FormationTemplateVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting version %d of formation template with ID %s", formation.FormationTemplateVersion, formation.FormationTemplateID)
+	}
+
+	return pinned, nil
+}
@@ -0,0 +1,504 @@
+package formationtemplateversion_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateversion"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateversion/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_CreateVersion(t *testing.T) {
+	// GIVEN
+	formationtemplateversion.Now = func() time.Time { return testTime }
+	ctx := context.TODO()
+
+	webhooks := []*model.Webhook{fixWebhookModel("https://url.com")}
+	references := []*model.FormationTemplateConstraintReference{{ConstraintID: testConstraintID, FormationTemplateID: testFormationTemplateID}}
+	notFoundErr := apperrors.NewNotFoundError(resource.FormationTemplateVersion, testFormationTemplateID)
+
+	testCases := []struct {
+		Name                string
+		VersionRepoFn       func() *automock.FormationTemplateVersionRepository
+		FormationTemplateFn func() *automock.FormationTemplateRepository
+		WebhookRepoFn       func() *automock.WebhookRepository
+		ConstraintRefRepoFn func() *automock.ConstraintReferenceRepository
+		UIDSvcFn            func() *automock.UIDService
+		ExpectedVersion     *model.FormationTemplateVersion
+		ExpectedErrMsg      string
+	}{
+		{
+			Name: "Success for the first version",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetLatest", ctx, testFormationTemplateID).Return(nil, notFoundErr).Once()
+				repo.On("Create", ctx, fixVersionModel(1, fixSnapshot("https://url.com", testConstraintID))).Return(nil).Once()
+				return repo
+			},
+			FormationTemplateFn: fixFormationTemplateRepoThatReturnsTemplate,
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, testFormationTemplateID, model.FormationTemplateWebhookReference).Return(webhooks, nil).Once()
+				return repo
+			},
+			ConstraintRefRepoFn: func() *automock.ConstraintReferenceRepository {
+				repo := &automock.ConstraintReferenceRepository{}
+				repo.On("ListByFormationTemplateID", ctx, testFormationTemplateID).Return(references, nil).Once()
+				return repo
+			},
+			UIDSvcFn:        fixUIDService,
+			ExpectedVersion: fixVersionModel(1, fixSnapshot("https://url.com", testConstraintID)),
+		},
+		{
+			Name: "Success for the next version",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetLatest", ctx, testFormationTemplateID).Return(fixVersionModel(1, fixSnapshot("https://url.com")), nil).Once()
+				repo.On("Create", ctx, fixVersionModel(testVersion, fixSnapshot("https://url.com", testConstraintID))).Return(nil).Once()
+				return repo
+			},
+			FormationTemplateFn: fixFormationTemplateRepoThatReturnsTemplate,
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, testFormationTemplateID, model.FormationTemplateWebhookReference).Return(webhooks, nil).Once()
+				return repo
+			},
+			ConstraintRefRepoFn: func() *automock.ConstraintReferenceRepository {
+				repo := &automock.ConstraintReferenceRepository{}
+				repo.On("ListByFormationTemplateID", ctx, testFormationTemplateID).Return(references, nil).Once()
+				return repo
+			},
+			UIDSvcFn:        fixUIDService,
+			ExpectedVersion: fixVersionModel(testVersion, fixSnapshot("https://url.com", testConstraintID)),
+		},
+		{
+			Name: "Error when getting the formation template fails",
+			FormationTemplateFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, testFormationTemplateID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMsg: "while getting formation template with ID",
+		},
+		{
+			Name:                "Error when listing the webhooks fails",
+			FormationTemplateFn: fixFormationTemplateRepoThatReturnsTemplate,
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, testFormationTemplateID, model.FormationTemplateWebhookReference).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMsg: "while listing webhooks of formation template",
+		},
+		{
+			Name:                "Error when listing the constraint references fails",
+			FormationTemplateFn: fixFormationTemplateRepoThatReturnsTemplate,
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, testFormationTemplateID, model.FormationTemplateWebhookReference).Return(webhooks, nil).Once()
+				return repo
+			},
+			ConstraintRefRepoFn: func() *automock.ConstraintReferenceRepository {
+				repo := &automock.ConstraintReferenceRepository{}
+				repo.On("ListByFormationTemplateID", ctx, testFormationTemplateID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMsg: "while listing constraint references of formation template",
+		},
+		{
+			Name: "Error when getting the latest version fails",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetLatest", ctx, testFormationTemplateID).Return(nil, testErr).Once()
+				return repo
+			},
+			FormationTemplateFn: fixFormationTemplateRepoThatReturnsTemplate,
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, testFormationTemplateID, model.FormationTemplateWebhookReference).Return(webhooks, nil).Once()
+				return repo
+			},
+			ConstraintRefRepoFn: func() *automock.ConstraintReferenceRepository {
+				repo := &automock.ConstraintReferenceRepository{}
+				repo.On("ListByFormationTemplateID", ctx, testFormationTemplateID).Return(references, nil).Once()
+				return repo
+			},
+			ExpectedErrMsg: "while getting the latest version of formation template",
+		},
+		{
+			Name: "Error when creating the version fails",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetLatest", ctx, testFormationTemplateID).Return(nil, notFoundErr).Once()
+				repo.On("Create", ctx, fixVersionModel(1, fixSnapshot("https://url.com", testConstraintID))).Return(testErr).Once()
+				return repo
+			},
+			FormationTemplateFn: fixFormationTemplateRepoThatReturnsTemplate,
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, testFormationTemplateID, model.FormationTemplateWebhookReference).Return(webhooks, nil).Once()
+				return repo
+			},
+			ConstraintRefRepoFn: func() *automock.ConstraintReferenceRepository {
+				repo := &automock.ConstraintReferenceRepository{}
+				repo.On("ListByFormationTemplateID", ctx, testFormationTemplateID).Return(references, nil).Once()
+				return repo
+			},
+			UIDSvcFn:       fixUIDService,
+			ExpectedErrMsg: "while creating version 1 of formation template",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			versionRepo := &automock.FormationTemplateVersionRepository{}
+			if testCase.VersionRepoFn != nil {
+				versionRepo = testCase.VersionRepoFn()
+			}
+			ftRepo := testCase.FormationTemplateFn()
+			webhookRepo := &automock.WebhookRepository{}
+			if testCase.WebhookRepoFn != nil {
+				webhookRepo = testCase.WebhookRepoFn()
+			}
+			constraintRefRepo := &automock.ConstraintReferenceRepository{}
+			if testCase.ConstraintRefRepoFn != nil {
+				constraintRefRepo = testCase.ConstraintRefRepoFn()
+			}
+			uidSvc := &automock.UIDService{}
+			if testCase.UIDSvcFn != nil {
+				uidSvc = testCase.UIDSvcFn()
+			}
+
+			svc := formationtemplateversion.NewService(versionRepo, ftRepo, webhookRepo, constraintRefRepo, nil, uidSvc)
+
+			// WHEN
+			version, err := svc.CreateVersion(ctx, testFormationTemplateID)
+
+			// THEN
+			if testCase.ExpectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+				assert.Nil(t, version)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedVersion, version)
+			}
+
+			mock.AssertExpectationsForObjects(t, versionRepo, ftRepo, webhookRepo, constraintRefRepo, uidSvc)
+		})
+	}
+}
+
+func TestService_ListForFormationTemplate(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	versions := []*model.FormationTemplateVersion{fixVersionModel(1, fixSnapshot("https://url.com"))}
+
+	t.Run("Success", func(t *testing.T) {
+		versionRepo := &automock.FormationTemplateVersionRepository{}
+		versionRepo.On("ListByFormationTemplateID", ctx, testFormationTemplateID).Return(versions, nil).Once()
+		svc := formationtemplateversion.NewService(versionRepo, nil, nil, nil, nil, nil)
+
+		// WHEN
+		result, err := svc.ListForFormationTemplate(ctx, testFormationTemplateID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, versions, result)
+		versionRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		versionRepo := &automock.FormationTemplateVersionRepository{}
+		versionRepo.On("ListByFormationTemplateID", ctx, testFormationTemplateID).Return(nil, testErr).Once()
+		svc := formationtemplateversion.NewService(versionRepo, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := svc.ListForFormationTemplate(ctx, testFormationTemplateID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		versionRepo.AssertExpectations(t)
+	})
+}
+
+func TestService_Migrate(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+
+	pinnedVersion := fixVersionModel(1, fixSnapshot("https://url.com", secondConstraintID))
+	targetVersion := fixVersionModel(testVersion, fixSnapshot("https://new-url.com", testConstraintID))
+	targetWithSameWebhooks := fixVersionModel(testVersion, fixSnapshot("https://url.com", secondConstraintID))
+	violations := []string{"APPLICATION with ID \"app-id\": unsupported applicationType"}
+
+	testCases := []struct {
+		Name           string
+		DryRun         bool
+		VersionRepoFn  func() *automock.FormationTemplateVersionRepository
+		FormationSvcFn func() *automock.FormationService
+		ExpectedResult *model.FormationTemplateMigrationResult
+		ExpectedErrMsg string
+	}{
+		{
+			Name: "Migrates the formation to the target version",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, testFormationTemplateID, testVersion).Return(targetVersion, nil).Once()
+				repo.On("GetByVersion", ctx, testFormationTemplateID, 1).Return(pinnedVersion, nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("Get", ctx, testFormationID).Return(fixFormationModel(1), nil).Once()
+				svc.On("ValidateParticipantsForTemplateVersion", ctx, fixFormationModel(1), targetVersion).Return([]string{}, nil).Once()
+				svc.On("Update", ctx, fixFormationModel(testVersion)).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: &model.FormationTemplateMigrationResult{
+				FormationID:          testFormationID,
+				FromVersion:          1,
+				ToVersion:            testVersion,
+				Status:               model.FormationTemplateMigrationStatusMigrated,
+				WebhooksChanged:      true,
+				AddedConstraintIDs:   []string{testConstraintID},
+				RemovedConstraintIDs: []string{secondConstraintID},
+				Violations:           []string{},
+			},
+		},
+		{
+			Name:   "Reports compatibility without migrating on dry run for formation that follows the latest version",
+			DryRun: true,
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, testFormationTemplateID, testVersion).Return(targetWithSameWebhooks, nil).Once()
+				repo.On("GetLatest", ctx, testFormationTemplateID).Return(pinnedVersion, nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("Get", ctx, testFormationID).Return(fixFormationModel(0), nil).Once()
+				svc.On("ValidateParticipantsForTemplateVersion", ctx, fixFormationModel(0), targetWithSameWebhooks).Return([]string{}, nil).Once()
+				return svc
+			},
+			ExpectedResult: &model.FormationTemplateMigrationResult{
+				FormationID:          testFormationID,
+				FromVersion:          0,
+				ToVersion:            testVersion,
+				Status:               model.FormationTemplateMigrationStatusCompatible,
+				AddedConstraintIDs:   []string{},
+				RemovedConstraintIDs: []string{},
+				Violations:           []string{},
+			},
+		},
+		{
+			Name: "Reports incompatibility when participants are not allowed by the target version",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, testFormationTemplateID, testVersion).Return(targetVersion, nil).Once()
+				repo.On("GetByVersion", ctx, testFormationTemplateID, 1).Return(pinnedVersion, nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("Get", ctx, testFormationID).Return(fixFormationModel(1), nil).Once()
+				svc.On("ValidateParticipantsForTemplateVersion", ctx, fixFormationModel(1), targetVersion).Return(violations, nil).Once()
+				return svc
+			},
+			ExpectedResult: &model.FormationTemplateMigrationResult{
+				FormationID:          testFormationID,
+				FromVersion:          1,
+				ToVersion:            testVersion,
+				Status:               model.FormationTemplateMigrationStatusIncompatible,
+				WebhooksChanged:      true,
+				AddedConstraintIDs:   []string{testConstraintID},
+				RemovedConstraintIDs: []string{secondConstraintID},
+				Violations:           violations,
+			},
+		},
+		{
+			Name: "Reports that the formation is already pinned to the target version",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, testFormationTemplateID, testVersion).Return(targetVersion, nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("Get", ctx, testFormationID).Return(fixFormationModel(testVersion), nil).Once()
+				return svc
+			},
+			ExpectedResult: &model.FormationTemplateMigrationResult{
+				FormationID: testFormationID,
+				FromVersion: testVersion,
+				ToVersion:   testVersion,
+				Status:      model.FormationTemplateMigrationStatusUpToDate,
+			},
+		},
+		{
+			Name: "Error when the target version does not exist",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, testFormationTemplateID, testVersion).Return(nil, testErr).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				return &automock.FormationService{}
+			},
+			ExpectedErrMsg: "while getting version 2 of formation template",
+		},
+		{
+			Name: "Error when getting the formation fails",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, testFormationTemplateID, testVersion).Return(targetVersion, nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("Get", ctx, testFormationID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name: "Error when the formation is of another formation template",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, testFormationTemplateID, testVersion).Return(targetVersion, nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				formation := fixFormationModel(1)
+				formation.FormationTemplateID = "other-template"
+				svc := &automock.FormationService{}
+				svc.On("Get", ctx, testFormationID).Return(formation, nil).Once()
+				return svc
+			},
+			ExpectedErrMsg: "is not created from formation template",
+		},
+		{
+			Name: "Error when getting the pinned version fails",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, testFormationTemplateID, testVersion).Return(targetVersion, nil).Once()
+				repo.On("GetByVersion", ctx, testFormationTemplateID, 1).Return(nil, testErr).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("Get", ctx, testFormationID).Return(fixFormationModel(1), nil).Once()
+				return svc
+			},
+			ExpectedErrMsg: "while getting version 1 of formation template",
+		},
+		{
+			Name: "Error when validating the participants fails",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, testFormationTemplateID, testVersion).Return(targetVersion, nil).Once()
+				repo.On("GetByVersion", ctx, testFormationTemplateID, 1).Return(pinnedVersion, nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("Get", ctx, testFormationID).Return(fixFormationModel(1), nil).Once()
+				svc.On("ValidateParticipantsForTemplateVersion", ctx, fixFormationModel(1), targetVersion).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMsg: "while validating the participants of formation",
+		},
+		{
+			Name: "Error when updating the formation fails",
+			VersionRepoFn: func() *automock.FormationTemplateVersionRepository {
+				repo := &automock.FormationTemplateVersionRepository{}
+				repo.On("GetByVersion", ctx, testFormationTemplateID, testVersion).Return(targetVersion, nil).Once()
+				repo.On("GetByVersion", ctx, testFormationTemplateID, 1).Return(pinnedVersion, nil).Once()
+				return repo
+			},
+			FormationSvcFn: func() *automock.FormationService {
+				svc := &automock.FormationService{}
+				svc.On("Get", ctx, testFormationID).Return(fixFormationModel(1), nil).Once()
+				svc.On("ValidateParticipantsForTemplateVersion", ctx, fixFormationModel(1), targetVersion).Return([]string{}, nil).Once()
+				svc.On("Update", ctx, fixFormationModel(testVersion)).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErrMsg: "while pinning formation with ID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			versionRepo := testCase.VersionRepoFn()
+			formationSvc := testCase.FormationSvcFn()
+
+			svc := formationtemplateversion.NewService(versionRepo, nil, nil, nil, formationSvc, nil)
+
+			// WHEN
+			result, err := svc.Migrate(ctx, testFormationTemplateID, testVersion, testFormationID, testCase.DryRun)
+
+			// THEN
+			if testCase.ExpectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, versionRepo, formationSvc)
+		})
+	}
+}
+
+func TestService_ResendNotifications(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		formationSvc := &automock.FormationService{}
+		formationSvc.On("ResendNotifications", ctx, testFormationID).Return(fixFormationModel(testVersion), nil).Once()
+		svc := formationtemplateversion.NewService(nil, nil, nil, nil, formationSvc, nil)
+
+		// WHEN
+		err := svc.ResendNotifications(ctx, testFormationID)
+
+		// THEN
+		require.NoError(t, err)
+		formationSvc.AssertExpectations(t)
+	})
+
+	t.Run("Error when re-sending fails", func(t *testing.T) {
+		formationSvc := &automock.FormationService{}
+		formationSvc.On("ResendNotifications", ctx, testFormationID).Return(nil, testErr).Once()
+		svc := formationtemplateversion.NewService(nil, nil, nil, nil, formationSvc, nil)
+
+		// WHEN
+		err := svc.ResendNotifications(ctx, testFormationID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while re-sending the notifications of formation")
+		formationSvc.AssertExpectations(t)
+	})
+}
+
+func fixFormationTemplateRepoThatReturnsTemplate() *automock.FormationTemplateRepository {
+	repo := &automock.FormationTemplateRepository{}
+	repo.On("Get", context.TODO(), testFormationTemplateID).Return(fixFormationTemplateModel(), nil).Once()
+	return repo
+}
+
+func fixUIDService() *automock.UIDService {
+	uidSvc := &automock.UIDService{}
+	uidSvc.On("Generate").Return(testID).Once()
+	return uidSvc
+}
@@ -2,13 +2,33 @@
 
 package automock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // HealthCheckConverter is an autogenerated mock type for the HealthCheckConverter type
 type HealthCheckConverter struct {
 	mock.Mock
 }
 
+// MultipleToGraphQL provides a mock function with given fields: in
+func (_m *HealthCheckConverter) MultipleToGraphQL(in []*model.HealthCheck) []*graphql.HealthCheck {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.HealthCheck
+	if rf, ok := ret.Get(0).(func([]*model.HealthCheck) []*graphql.HealthCheck); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.HealthCheck)
+		}
+	}
+
+	return r0
+}
+
 // NewHealthCheckConverter creates a new instance of HealthCheckConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewHealthCheckConverter(t interface {
@@ -2,13 +2,99 @@
 
 package automock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+	time "time"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // HealthCheckRepository is an autogenerated mock type for the HealthCheckRepository type
 type HealthCheckRepository struct {
 	mock.Mock
 }
 
+// Create provides a mock function with given fields: ctx, healthCheck
+func (_m *HealthCheckRepository) Create(ctx context.Context, healthCheck *model.HealthCheck) error {
+	ret := _m.Called(ctx, healthCheck)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.HealthCheck) error); ok {
+		r0 = rf(ctx, healthCheck)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOlderThan provides a mock function with given fields: ctx, before
+func (_m *HealthCheckRepository) DeleteOlderThan(ctx context.Context, before time.Time) error {
+	ret := _m.Called(ctx, before)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, tenant, types, origin, pageSize, cursor
+func (_m *HealthCheckRepository) List(ctx context.Context, tenant string, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	ret := _m.Called(ctx, tenant, types, origin, pageSize, cursor)
+
+	var r0 *model.HealthCheckPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.HealthCheckType, *string, int, string) (*model.HealthCheckPage, error)); ok {
+		return rf(ctx, tenant, types, origin, pageSize, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.HealthCheckType, *string, int, string) *model.HealthCheckPage); ok {
+		r0 = rf(ctx, tenant, types, origin, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.HealthCheckPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.HealthCheckType, *string, int, string) error); ok {
+		r1 = rf(ctx, tenant, types, origin, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTargets provides a mock function with given fields: ctx
+func (_m *HealthCheckRepository) ListTargets(ctx context.Context) ([]*model.HealthCheckTarget, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.HealthCheckTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.HealthCheckTarget, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.HealthCheckTarget); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.HealthCheckTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewHealthCheckRepository creates a new instance of HealthCheckRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewHealthCheckRepository(t interface {
@@ -2,13 +2,44 @@
 
 package automock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // HealthCheckService is an autogenerated mock type for the HealthCheckService type
 type HealthCheckService struct {
 	mock.Mock
 }
 
+// List provides a mock function with given fields: ctx, types, origin, pageSize, cursor
+func (_m *HealthCheckService) List(ctx context.Context, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	ret := _m.Called(ctx, types, origin, pageSize, cursor)
+
+	var r0 *model.HealthCheckPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.HealthCheckType, *string, int, string) (*model.HealthCheckPage, error)); ok {
+		return rf(ctx, types, origin, pageSize, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.HealthCheckType, *string, int, string) *model.HealthCheckPage); ok {
+		r0 = rf(ctx, types, origin, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.HealthCheckPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.HealthCheckType, *string, int, string) error); ok {
+		r1 = rf(ctx, types, origin, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewHealthCheckService creates a new instance of HealthCheckService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewHealthCheckService(t interface {
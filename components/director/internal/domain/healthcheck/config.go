@@ -0,0 +1,19 @@
+package healthcheck
+
+import "time"
+
+// Config configures the periodic probing of the application endpoints
+type Config struct {
+	// Enabled switches the probing job on
+	Enabled bool `envconfig:"default=false,APP_HEALTH_CHECK_ENABLED"`
+	// JobInterval is how often the application endpoints are probed
+	JobInterval time.Duration `envconfig:"default=5m,APP_HEALTH_CHECK_JOB_INTERVAL"`
+	// ProbeTimeout is how long a single probe waits for a response
+	ProbeTimeout time.Duration `envconfig:"default=10s,APP_HEALTH_CHECK_PROBE_TIMEOUT"`
+	// MaxParallelProbes is how many endpoints are probed at the same time
+	MaxParallelProbes int `envconfig:"default=10,APP_HEALTH_CHECK_MAX_PARALLEL_PROBES"`
+	// ProbeORDEndpoints switches on the probing of the ORD well-known endpoints of the applications
+	ProbeORDEndpoints bool `envconfig:"default=false,APP_HEALTH_CHECK_PROBE_ORD_ENDPOINTS"`
+	// Retention is how long the health check results are kept
+	Retention time.Duration `envconfig:"default=168h,APP_HEALTH_CHECK_RETENTION"`
+}
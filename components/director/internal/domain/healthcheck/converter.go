@@ -1,4 +1,77 @@
 package healthcheck
 
-type converter struct {
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+// NewConverter returns a new health check converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the health check model to an entity
+func (c *converter) ToEntity(in *model.HealthCheck) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:            in.ID,
+		ApplicationID: in.Origin,
+		Type:          string(in.Type),
+		Condition:     string(in.Condition),
+		URL:           in.URL,
+		Message:       repo.NewNullableString(in.Message),
+		Timestamp:     in.Timestamp,
+	}
+}
+
+// FromEntity converts the health check entity to a model
+func (c *converter) FromEntity(in *Entity) *model.HealthCheck {
+	if in == nil {
+		return nil
+	}
+
+	return &model.HealthCheck{
+		ID:        in.ID,
+		Type:      model.HealthCheckType(in.Type),
+		Condition: model.HealthCheckStatusCondition(in.Condition),
+		Origin:    in.ApplicationID,
+		URL:       in.URL,
+		Message:   repo.StringPtrFromNullableString(in.Message),
+		Timestamp: in.Timestamp,
+	}
+}
+
+// ToGraphQL converts the health check model to its GraphQL representation
+func (c *converter) ToGraphQL(in *model.HealthCheck) *graphql.HealthCheck {
+	if in == nil {
+		return nil
+	}
+
+	origin := in.Origin
+	return &graphql.HealthCheck{
+		Type:      graphql.HealthCheckType(in.Type),
+		Condition: graphql.HealthCheckStatusCondition(in.Condition),
+		Origin:    &origin,
+		Message:   in.Message,
+		Timestamp: graphql.Timestamp(in.Timestamp),
+	}
+}
+
+// MultipleToGraphQL converts multiple health check models to their GraphQL representation
+func (c *converter) MultipleToGraphQL(in []*model.HealthCheck) []*graphql.HealthCheck {
+	healthChecks := make([]*graphql.HealthCheck, 0, len(in))
+	for _, hc := range in {
+		if hc == nil {
+			continue
+		}
+		healthChecks = append(healthChecks, c.ToGraphQL(hc))
+	}
+
+	return healthChecks
 }
@@ -0,0 +1,38 @@
+package healthcheck_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	conv := healthcheck.NewConverter()
+
+	require.Equal(t, fixHealthCheckEntity(), conv.ToEntity(fixHealthCheckModel()))
+	require.Nil(t, conv.ToEntity(nil))
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	conv := healthcheck.NewConverter()
+
+	require.Equal(t, fixHealthCheckModel(), conv.FromEntity(fixHealthCheckEntity()))
+	require.Nil(t, conv.FromEntity(nil))
+}
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	conv := healthcheck.NewConverter()
+
+	require.Equal(t, fixHealthCheckGraphQL(), conv.ToGraphQL(fixHealthCheckModel()))
+	require.Nil(t, conv.ToGraphQL(nil))
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := healthcheck.NewConverter()
+
+	require.Equal(t, []*graphql.HealthCheck{fixHealthCheckGraphQL()}, conv.MultipleToGraphQL([]*model.HealthCheck{fixHealthCheckModel(), nil}))
+	require.Empty(t, conv.MultipleToGraphQL(nil))
+}
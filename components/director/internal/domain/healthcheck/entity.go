@@ -0,0 +1,38 @@
+package healthcheck
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Entity represents a health check result in the database
+type Entity struct {
+	ID            string         `db:"id"`
+	ApplicationID string         `db:"app_id"`
+	Type          string         `db:"type"`
+	Condition     string         `db:"condition"`
+	URL           string         `db:"url"`
+	Message       sql.NullString `db:"message"`
+	Timestamp     time.Time      `db:"timestamp"`
+}
+
+// EntityCollection is a collection of health check entities
+type EntityCollection []Entity
+
+// Len returns the number of entities in the collection
+func (c EntityCollection) Len() int {
+	return len(c)
+}
+
+// targetEntity represents an application with a health check URL in the database
+type targetEntity struct {
+	ID             string `db:"id"`
+	HealthCheckURL string `db:"healthcheck_url"`
+}
+
+type targetEntityCollection []targetEntity
+
+// Len returns the number of entities in the collection
+func (c targetEntityCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,76 @@
+package healthcheck_test
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+const (
+	healthCheckID  = "4b0b0c1e-6f3a-4e6b-8d2c-1a0b9c8d7e6f"
+	applicationID  = "8e7d6c5b-4a3f-4e2d-9c1b-0a9f8e7d6c5b"
+	tenantID       = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	healthCheckURL = "https://app.example.com/health"
+	ordURL         = "https://app.example.com/.well-known/open-resource-discovery"
+	errorMessage   = "unexpected status code 503"
+)
+
+var (
+	testErr      = errors.New("test error")
+	timestamp    = time.Date(2024, 7, 15, 10, 0, 0, 0, time.UTC)
+	tableColumns = []string{"id", "app_id", "type", "condition", "url", "message", "timestamp"}
+)
+
+func fixHealthCheckModel() *model.HealthCheck {
+	message := errorMessage
+	return &model.HealthCheck{
+		ID:        healthCheckID,
+		Type:      model.ApplicationHealthCheckType,
+		Condition: model.HealthCheckStatusConditionFailed,
+		Origin:    applicationID,
+		URL:       healthCheckURL,
+		Message:   &message,
+		Timestamp: timestamp,
+	}
+}
+
+func fixHealthCheckEntity() *healthcheck.Entity {
+	return &healthcheck.Entity{
+		ID:            healthCheckID,
+		ApplicationID: applicationID,
+		Type:          string(model.ApplicationHealthCheckType),
+		Condition:     string(model.HealthCheckStatusConditionFailed),
+		URL:           healthCheckURL,
+		Message:       sql.NullString{String: errorMessage, Valid: true},
+		Timestamp:     timestamp,
+	}
+}
+
+func fixHealthCheckGraphQL() *graphql.HealthCheck {
+	origin := applicationID
+	message := errorMessage
+	return &graphql.HealthCheck{
+		Type:      graphql.HealthCheckTypeManagementPlaneApplicationHealthcheck,
+		Condition: graphql.HealthCheckStatusConditionFailed,
+		Origin:    &origin,
+		Message:   &message,
+		Timestamp: graphql.Timestamp(timestamp),
+	}
+}
+
+func fixHealthCheckPage() *model.HealthCheckPage {
+	return &model.HealthCheckPage{
+		Data: []*model.HealthCheck{fixHealthCheckModel()},
+		PageInfo: &pagination.Page{
+			StartCursor: "",
+			EndCursor:   "",
+			HasNextPage: false,
+		},
+		TotalCount: 1,
+	}
+}
@@ -0,0 +1,36 @@
+package healthcheck
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/cronjob"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+// ApplicationProber probes the endpoints of the applications and removes the outdated results
+type ApplicationProber interface {
+	ProbeApplications(ctx context.Context) (int, error)
+	DeleteExpiredHealthChecks(ctx context.Context) error
+}
+
+// StartProbeJob starts the job which probes the endpoints of the applications and blocks.
+// Only the leader instance executes the job.
+func StartProbeJob(ctx context.Context, cfg Config, electionCfg cronjob.ElectionConfig, prober ApplicationProber) error {
+	probeJob := cronjob.CronJob{
+		Name: "ProbeApplicationHealthChecks",
+		Fn: func(jobCtx context.Context) {
+			probed, err := prober.ProbeApplications(jobCtx)
+			if err != nil {
+				log.C(jobCtx).WithError(err).Error("Failed to probe the application endpoints")
+			} else {
+				log.C(jobCtx).Infof("Probed %d application endpoints", probed)
+			}
+
+			if err = prober.DeleteExpiredHealthChecks(jobCtx); err != nil {
+				log.C(jobCtx).WithError(err).Error("Failed to delete the expired health checks")
+			}
+		},
+		SchedulePeriod: cfg.JobInterval,
+	}
+	return cronjob.RunCronJob(ctx, electionCfg, probeJob)
+}
@@ -0,0 +1,348 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// The messages of the failed health checks, which are returned to the tenants. The causes of the failures are only logged,
+// so that the probes cannot be used to find out what is reachable from the cluster.
+const (
+	destinationNotAllowedMessage = "The URL is not allowed"
+	requestFailedMessage         = "The URL could not be called"
+	unexpectedStatusCodeMessage  = "The URL responded with unexpected status code %d"
+)
+
+// Now is a function variable that returns the current time. It is used, so we could mock it in the tests.
+var Now = time.Now
+
+// ValidateDestination is a function variable that checks whether a URL may be probed. It is used, so we could mock it in the tests.
+var ValidateDestination = ord.ValidatePublicDestination
+
+// WebhookRepository is responsible for the repo-layer webhook operations
+//
+//go:generate mockery --name=WebhookRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type WebhookRepository interface {
+	ListByWebhookType(ctx context.Context, webhookType model.WebhookType) ([]*model.Webhook, error)
+}
+
+// UIDService is responsible for generating GUIDs, which will be used as internal health check IDs
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+// MetricsCollector records the outcome and the duration of the probes
+//
+//go:generate mockery --name=MetricsCollector --output=automock --outpkg=automock --case=underscore --disable-version-string
+type MetricsCollector interface {
+	InstrumentHealthCheck(checkType, condition string, duration time.Duration)
+}
+
+type probe struct {
+	checkType     model.HealthCheckType
+	applicationID string
+	url           string
+	webhook       *model.Webhook
+}
+
+// Prober probes the health check URLs and optionally the ORD well-known endpoints of the applications of all tenants.
+// Only public destinations are probed and redirects are not followed. The credentials and the access strategy of the ORD webhook
+// of an application are used for the health check URL only when it points to the same host as the webhook.
+type Prober struct {
+	cfg                            Config
+	transact                       persistence.Transactioner
+	repo                           HealthCheckRepository
+	webhookRepo                    WebhookRepository
+	uidSvc                         UIDService
+	client                         *http.Client
+	accessStrategyExecutorProvider accessstrategy.ExecutorProvider
+	metrics                        MetricsCollector
+}
+
+// NewProber creates a new application Prober. The client should refuse to connect to destinations which are not public.
+func NewProber(cfg Config, transact persistence.Transactioner, repo HealthCheckRepository, webhookRepo WebhookRepository, uidSvc UIDService, client *http.Client, accessStrategyExecutorProvider accessstrategy.ExecutorProvider, metrics MetricsCollector) *Prober {
+	if client != nil {
+		probeClient := *client
+		probeClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+		client = &probeClient
+	}
+
+	return &Prober{
+		cfg:                            cfg,
+		transact:                       transact,
+		repo:                           repo,
+		webhookRepo:                    webhookRepo,
+		uidSvc:                         uidSvc,
+		client:                         client,
+		accessStrategyExecutorProvider: accessStrategyExecutorProvider,
+		metrics:                        metrics,
+	}
+}
+
+// ProbeApplications probes the endpoints of the applications, stores the results and returns the number of the executed probes
+func (p *Prober) ProbeApplications(ctx context.Context) (int, error) {
+	probes, err := p.listProbes(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(probes) == 0 {
+		return 0, nil
+	}
+
+	healthChecks := p.execute(ctx, probes)
+
+	if err = p.inTransaction(ctx, func(ctx context.Context) error {
+		for _, healthCheck := range healthChecks {
+			if err := p.repo.Create(ctx, healthCheck); err != nil {
+				return errors.Wrapf(err, "while storing the health check of application with ID: %q", healthCheck.Origin)
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return len(healthChecks), nil
+}
+
+// DeleteExpiredHealthChecks deletes the health checks which are older than the configured retention
+func (p *Prober) DeleteExpiredHealthChecks(ctx context.Context) error {
+	return p.inTransaction(ctx, func(ctx context.Context) error {
+		if err := p.repo.DeleteOlderThan(ctx, Now().Add(-p.cfg.Retention)); err != nil {
+			return errors.Wrap(err, "while deleting the expired health checks")
+		}
+		return nil
+	})
+}
+
+func (p *Prober) listProbes(ctx context.Context) ([]probe, error) {
+	var targets []*model.HealthCheckTarget
+	var webhooks []*model.Webhook
+	if err := p.inTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if targets, err = p.repo.ListTargets(ctx); err != nil {
+			return errors.Wrap(err, "while listing the applications with health check URL")
+		}
+		if webhooks, err = p.webhookRepo.ListByWebhookType(ctx, model.WebhookTypeOpenResourceDiscovery); err != nil {
+			return errors.Wrap(err, "while listing the ORD webhooks")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	ordWebhooks := make(map[string]*model.Webhook, len(webhooks))
+	for _, webhook := range webhooks {
+		if webhook.ObjectType != model.ApplicationWebhookReference || webhook.URL == nil {
+			continue
+		}
+		ordWebhooks[webhook.ObjectID] = webhook
+	}
+
+	probes := make([]probe, 0, len(targets))
+	for _, target := range targets {
+		probes = append(probes, probe{
+			checkType:     model.ApplicationHealthCheckType,
+			applicationID: target.ApplicationID,
+			url:           target.URL,
+			webhook:       ordWebhooks[target.ApplicationID],
+		})
+	}
+
+	if p.cfg.ProbeORDEndpoints {
+		for _, webhook := range webhooks {
+			if webhook.ObjectType != model.ApplicationWebhookReference || webhook.URL == nil {
+				continue
+			}
+			url := *webhook.URL
+			if webhook.ProxyURL != nil {
+				url = *webhook.ProxyURL
+			}
+			probes = append(probes, probe{
+				checkType:     model.ApplicationORDHealthCheckType,
+				applicationID: webhook.ObjectID,
+				url:           url,
+				webhook:       webhook,
+			})
+		}
+	}
+
+	return probes, nil
+}
+
+func (p *Prober) execute(ctx context.Context, probes []probe) []*model.HealthCheck {
+	parallelProbes := p.cfg.MaxParallelProbes
+	if parallelProbes < 1 {
+		parallelProbes = 1
+	}
+
+	healthChecks := make([]*model.HealthCheck, len(probes))
+	semaphore := make(chan struct{}, parallelProbes)
+	wg := sync.WaitGroup{}
+	for i := range probes {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			healthChecks[i] = p.probe(ctx, probes[i])
+		}(i)
+	}
+	wg.Wait()
+
+	return healthChecks
+}
+
+func (p *Prober) probe(ctx context.Context, pr probe) *model.HealthCheck {
+	healthCheck := &model.HealthCheck{
+		ID:        p.uidSvc.Generate(),
+		Type:      pr.checkType,
+		Condition: model.HealthCheckStatusConditionSucceeded,
+		Origin:    pr.applicationID,
+		URL:       pr.url,
+		Timestamp: Now(),
+	}
+
+	start := time.Now()
+	if message := p.call(ctx, pr); message != "" {
+		healthCheck.Condition = model.HealthCheckStatusConditionFailed
+		healthCheck.Message = &message
+	}
+
+	if p.metrics != nil {
+		p.metrics.InstrumentHealthCheck(string(healthCheck.Type), string(healthCheck.Condition), time.Since(start))
+	}
+
+	return healthCheck
+}
+
+// call probes the endpoint and returns the message of the failure, or an empty string if the endpoint is healthy
+func (p *Prober) call(ctx context.Context, pr probe) string {
+	if err := ValidateDestination(pr.url); err != nil {
+		log.C(ctx).WithError(err).Warnf("Health check of type %q for application with ID: %q is not allowed for URL %q", pr.checkType, pr.applicationID, pr.url)
+		return destinationNotAllowedMessage
+	}
+
+	resp, err := p.get(ctx, pr)
+	if err != nil {
+		log.C(ctx).WithError(err).Warnf("Health check of type %q for application with ID: %q failed", pr.checkType, pr.applicationID)
+		return requestFailedMessage
+	}
+	defer closeBody(ctx, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		log.C(ctx).Warnf("Health check of type %q for application with ID: %q failed with status code %d", pr.checkType, pr.applicationID, resp.StatusCode)
+		return fmt.Sprintf(unexpectedStatusCodeMessage, resp.StatusCode)
+	}
+
+	return ""
+}
+
+func (p *Prober) get(ctx context.Context, pr probe) (*http.Response, error) {
+	auth := webhookAuth(pr)
+
+	if auth != nil && auth.AccessStrategy != nil && len(*auth.AccessStrategy) > 0 {
+		executor, err := p.accessStrategyExecutorProvider.Provide(accessstrategy.Type(*auth.AccessStrategy))
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot find executor for access strategy %q", *auth.AccessStrategy)
+		}
+		resp, err := executor.Execute(ctx, p.client, pr.url, "", nil)
+		return resp, errors.Wrapf(err, "while calling %q with access strategy %q", pr.url, *auth.AccessStrategy)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pr.url, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while creating request to %q", pr.url)
+	}
+
+	if auth != nil && auth.Credential.Basic != nil {
+		req.SetBasicAuth(auth.Credential.Basic.Username, auth.Credential.Basic.Password)
+	} else if auth != nil && auth.Credential.Oauth != nil {
+		// the token is fetched with the prober client, so that the token URL is also restricted to public destinations
+		conf := &clientcredentials.Config{
+			ClientID:     auth.Credential.Oauth.ClientID,
+			ClientSecret: auth.Credential.Oauth.ClientSecret,
+			TokenURL:     auth.Credential.Oauth.URL,
+		}
+		token, err := conf.Token(context.WithValue(ctx, oauth2.HTTPClient, p.client))
+		if err != nil {
+			return nil, errors.Wrapf(err, "while fetching token for %q", pr.url)
+		}
+		token.SetAuthHeader(req)
+	}
+
+	resp, err := p.client.Do(req)
+	return resp, errors.Wrapf(err, "while calling %q", pr.url)
+}
+
+// webhookAuth returns the credentials of the ORD webhook of the application if the probed URL points to the host of the webhook
+func webhookAuth(pr probe) *model.Auth {
+	if pr.webhook == nil || pr.webhook.Auth == nil {
+		return nil
+	}
+
+	probedHost := urlHost(pr.url)
+	if probedHost == "" {
+		return nil
+	}
+
+	for _, webhookURL := range []*string{pr.webhook.URL, pr.webhook.ProxyURL} {
+		if webhookURL != nil && urlHost(*webhookURL) == probedHost {
+			return pr.webhook.Auth
+		}
+	}
+
+	return nil
+}
+
+func urlHost(rawURL string) string {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsedURL.Host)
+}
+
+func (p *Prober) inTransaction(ctx context.Context, dbCalls func(ctx context.Context) error) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	if err = dbCalls(persistence.SaveToContext(ctx, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func closeBody(ctx context.Context, body io.ReadCloser) {
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		log.C(ctx).WithError(err).Error("Failed to drain the response body")
+	}
+	if err := body.Close(); err != nil {
+		log.C(ctx).WithError(err).Error("Failed to close the response body")
+	}
+}
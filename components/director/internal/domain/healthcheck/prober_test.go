@@ -0,0 +1,413 @@
+package healthcheck_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	accessstrategyautomock "github.com/kyma-incubator/compass/components/director/pkg/accessstrategy/automock"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	username = "user"
+	password = "pass"
+)
+
+func TestProber_ProbeApplications(t *testing.T) {
+	healthcheck.Now = func() time.Time { return timestamp }
+	defer func() { healthcheck.Now = time.Now }()
+	// the test server listens on the loopback interface, so only the URLs with the /private path are treated as not public
+	healthcheck.ValidateDestination = func(rawURL string) error {
+		if strings.HasSuffix(rawURL, "/private") {
+			return testErr
+		}
+		return nil
+	}
+	defer func() { healthcheck.ValidateDestination = ord.ValidatePublicDestination }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health", "/private":
+			w.WriteHeader(http.StatusOK)
+		case "/redirect":
+			http.Redirect(w, r, "/health", http.StatusFound)
+		case "/secured":
+			if user, pass, ok := r.BasicAuth(); !ok || user != username || pass != password {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	accessStrategy := string(accessstrategy.CMPmTLSAccessStrategy)
+
+	// the same server under another host name, to which the webhook credentials must not be sent
+	otherHostURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+	fixTargets := func(path string) []*model.HealthCheckTarget {
+		return []*model.HealthCheckTarget{{ApplicationID: applicationID, URL: server.URL + path}}
+	}
+	fixWebhook := func(path string, auth *model.Auth) *model.Webhook {
+		return &model.Webhook{
+			ID:         "webhook-id",
+			ObjectID:   applicationID,
+			ObjectType: model.ApplicationWebhookReference,
+			Type:       model.WebhookTypeOpenResourceDiscovery,
+			URL:        str.Ptr(server.URL + path),
+			Auth:       auth,
+		}
+	}
+	fixHealthCheckForURL := func(checkType model.HealthCheckType, url string, message *string) *model.HealthCheck {
+		condition := model.HealthCheckStatusConditionSucceeded
+		if message != nil {
+			condition = model.HealthCheckStatusConditionFailed
+		}
+		return &model.HealthCheck{
+			ID:        healthCheckID,
+			Type:      checkType,
+			Condition: condition,
+			Origin:    applicationID,
+			URL:       url,
+			Message:   message,
+			Timestamp: timestamp,
+		}
+	}
+	fixHealthCheck := func(checkType model.HealthCheckType, path string, message *string) *model.HealthCheck {
+		return fixHealthCheckForURL(checkType, server.URL+path, message)
+	}
+	basicAuth := &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: username, Password: password}}}
+
+	testCases := []struct {
+		Name                 string
+		ProbeORDEndpoints    bool
+		TxFn                 func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RepoFn               func() *automock.HealthCheckRepository
+		WebhookRepoFn        func() *automock.WebhookRepository
+		ExecutorProviderFn   func() *accessstrategyautomock.ExecutorProvider
+		ExpectedProbes       int
+		ExpectedFailedProbes int
+		ExpectedError        string
+	}{
+		{
+			Name: "Success when probing the health check URLs",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("ListTargets", txtest.CtxWithDBMatcher()).Return(fixTargets("/health"), nil).Once()
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixHealthCheck(model.ApplicationHealthCheckType, "/health", nil)).Return(nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return([]*model.Webhook{fixWebhook("/secured", basicAuth)}, nil).Once()
+				return repo
+			},
+			ExpectedProbes: 1,
+		},
+		{
+			Name:              "Success when probing the ORD endpoints with the webhook credentials",
+			ProbeORDEndpoints: true,
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("ListTargets", txtest.CtxWithDBMatcher()).Return(fixTargets("/secured"), nil).Once()
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixHealthCheck(model.ApplicationHealthCheckType, "/secured", nil)).Return(nil).Once()
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixHealthCheck(model.ApplicationORDHealthCheckType, "/secured", nil)).Return(nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return([]*model.Webhook{fixWebhook("/secured", basicAuth)}, nil).Once()
+				return repo
+			},
+			ExpectedProbes: 2,
+		},
+		{
+			Name:              "Success when probing with the access strategy of the webhook",
+			ProbeORDEndpoints: true,
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("ListTargets", txtest.CtxWithDBMatcher()).Return([]*model.HealthCheckTarget{}, nil).Once()
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixHealthCheck(model.ApplicationORDHealthCheckType, "/mtls", nil)).Return(nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return([]*model.Webhook{fixWebhook("/mtls", &model.Auth{AccessStrategy: &accessStrategy})}, nil).Once()
+				return repo
+			},
+			ExecutorProviderFn: func() *accessstrategyautomock.ExecutorProvider {
+				executor := &accessstrategyautomock.Executor{}
+				executor.On("Execute", mock.Anything, mock.Anything, server.URL+"/mtls", "", (*sync.Map)(nil)).Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil).Once()
+
+				provider := &accessstrategyautomock.ExecutorProvider{}
+				provider.On("Provide", accessstrategy.CMPmTLSAccessStrategy).Return(executor, nil).Once()
+				return provider
+			},
+			ExpectedProbes: 1,
+		},
+		{
+			Name: "Failed probes are stored",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("ListTargets", txtest.CtxWithDBMatcher()).Return(fixTargets("/unavailable"), nil).Once()
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixHealthCheck(model.ApplicationHealthCheckType, "/unavailable", str.Ptr("The URL responded with unexpected status code 503"))).Return(nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return(nil, nil).Once()
+				return repo
+			},
+			ExpectedProbes:       1,
+			ExpectedFailedProbes: 1,
+		},
+		{
+			Name: "Not public destinations are not probed",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("ListTargets", txtest.CtxWithDBMatcher()).Return(fixTargets("/private"), nil).Once()
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixHealthCheck(model.ApplicationHealthCheckType, "/private", str.Ptr("The URL is not allowed"))).Return(nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return(nil, nil).Once()
+				return repo
+			},
+			ExpectedProbes:       1,
+			ExpectedFailedProbes: 1,
+		},
+		{
+			Name: "Redirects are not followed",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("ListTargets", txtest.CtxWithDBMatcher()).Return(fixTargets("/redirect"), nil).Once()
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixHealthCheck(model.ApplicationHealthCheckType, "/redirect", str.Ptr("The URL responded with unexpected status code 302"))).Return(nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return(nil, nil).Once()
+				return repo
+			},
+			ExpectedProbes:       1,
+			ExpectedFailedProbes: 1,
+		},
+		{
+			Name: "Webhook credentials are not sent to another host",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("ListTargets", txtest.CtxWithDBMatcher()).Return([]*model.HealthCheckTarget{{ApplicationID: applicationID, URL: otherHostURL + "/secured"}}, nil).Once()
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixHealthCheckForURL(model.ApplicationHealthCheckType, otherHostURL+"/secured", str.Ptr("The URL responded with unexpected status code 401"))).Return(nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return([]*model.Webhook{fixWebhook("/secured", basicAuth)}, nil).Once()
+				return repo
+			},
+			ExpectedProbes:       1,
+			ExpectedFailedProbes: 1,
+		},
+		{
+			Name: "Error when storing the health checks fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(2, 1)
+			},
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("ListTargets", txtest.CtxWithDBMatcher()).Return(fixTargets("/health"), nil).Once()
+				repo.On("Create", txtest.CtxWithDBMatcher(), fixHealthCheck(model.ApplicationHealthCheckType, "/health", nil)).Return(testErr).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return(nil, nil).Once()
+				return repo
+			},
+			ExpectedProbes: 1,
+			ExpectedError:  "while storing the health check of application",
+		},
+		{
+			Name: "Error when listing the ORD webhooks fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("ListTargets", txtest.CtxWithDBMatcher()).Return(fixTargets("/health"), nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: "while listing the ORD webhooks",
+		},
+		{
+			Name: "Error when listing the applications fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("ListTargets", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: "while listing the applications with health check URL",
+		},
+		{
+			Name:          "Error when beginning the transaction fails",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			repo := &automock.HealthCheckRepository{}
+			if testCase.RepoFn != nil {
+				repo = testCase.RepoFn()
+			}
+			webhookRepo := &automock.WebhookRepository{}
+			if testCase.WebhookRepoFn != nil {
+				webhookRepo = testCase.WebhookRepoFn()
+			}
+			executorProvider := &accessstrategyautomock.ExecutorProvider{}
+			if testCase.ExecutorProviderFn != nil {
+				executorProvider = testCase.ExecutorProviderFn()
+			}
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(healthCheckID)
+			metrics := &automock.MetricsCollector{}
+			if testCase.ExpectedProbes > 0 {
+				succeeded := testCase.ExpectedProbes - testCase.ExpectedFailedProbes
+				if succeeded > 0 {
+					metrics.On("InstrumentHealthCheck", mock.Anything, string(model.HealthCheckStatusConditionSucceeded), mock.AnythingOfType("time.Duration")).Times(succeeded)
+				}
+				if testCase.ExpectedFailedProbes > 0 {
+					metrics.On("InstrumentHealthCheck", mock.Anything, string(model.HealthCheckStatusConditionFailed), mock.AnythingOfType("time.Duration")).Times(testCase.ExpectedFailedProbes)
+				}
+			}
+
+			cfg := healthcheck.Config{MaxParallelProbes: 2, ProbeORDEndpoints: testCase.ProbeORDEndpoints}
+			prober := healthcheck.NewProber(cfg, transact, repo, webhookRepo, uidSvc, server.Client(), executorProvider, metrics)
+
+			// WHEN
+			probed, err := prober.ProbeApplications(context.TODO())
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+				assert.Equal(t, 0, probed)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedProbes, probed)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, repo, webhookRepo, executorProvider, metrics)
+		})
+	}
+}
+
+func TestProber_DeleteExpiredHealthChecks(t *testing.T) {
+	healthcheck.Now = func() time.Time { return timestamp }
+	defer func() { healthcheck.Now = time.Now }()
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	retention := 24 * time.Hour
+
+	testCases := []struct {
+		Name          string
+		TxFn          func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RepoFn        func() *automock.HealthCheckRepository
+		ExpectedError string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("DeleteOlderThan", txtest.CtxWithDBMatcher(), timestamp.Add(-retention)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when deleting fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("DeleteOlderThan", txtest.CtxWithDBMatcher(), timestamp.Add(-retention)).Return(testErr).Once()
+				return repo
+			},
+			ExpectedError: "while deleting the expired health checks",
+		},
+		{
+			Name:          "Error when beginning the transaction fails",
+			TxFn:          txGen.ThatFailsOnBegin,
+			RepoFn:        func() *automock.HealthCheckRepository { return &automock.HealthCheckRepository{} },
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			repo := testCase.RepoFn()
+
+			prober := healthcheck.NewProber(healthcheck.Config{Retention: retention}, transact, repo, nil, nil, nil, nil, nil)
+
+			// WHEN
+			err := prober.DeleteExpiredHealthChecks(context.TODO())
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, repo)
+		})
+	}
+}
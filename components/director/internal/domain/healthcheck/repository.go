@@ -1,14 +1,118 @@
 package healthcheck
 
 import (
+	"context"
+	"time"
+
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableName             = "public.health_checks"
+	applicationsTableName = "public.applications"
+	appIDColumn           = "app_id"
+	typeColumn            = "type"
+	timestampColumn       = "timestamp"
+	healthCheckURLColumn  = "healthcheck_url"
+	orderByColumns        = "timestamp DESC, id"
 )
 
-type inMemoryRepository struct {
-	store map[string]*model.Runtime
+var (
+	tableColumns  = []string{"id", appIDColumn, typeColumn, "condition", "url", "message", timestampColumn}
+	targetColumns = []string{"id", healthCheckURLColumn}
+)
+
+// EntityConverter converts between the model and the entity of a health check
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.HealthCheck) *Entity
+	FromEntity(in *Entity) *model.HealthCheck
+}
+
+type repository struct {
+	creator         repo.CreatorGlobal
+	pageableQuerier repo.PageableQuerier
+	deleter         repo.DeleterGlobal
+	targetLister    repo.ListerGlobal
+	conv            EntityConverter
+}
+
+// NewRepository returns a new health check repository
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		creator:         repo.NewCreatorGlobal(resource.HealthCheck, tableName, tableColumns),
+		pageableQuerier: repo.NewPageableQuerier(tableName, tableColumns),
+		deleter:         repo.NewDeleterGlobal(resource.HealthCheck, tableName),
+		targetLister:    repo.NewListerGlobal(resource.Application, applicationsTableName, targetColumns),
+		conv:            conv,
+	}
+}
+
+// Create stores the health check result
+func (r *repository) Create(ctx context.Context, healthCheck *model.HealthCheck) error {
+	if healthCheck == nil {
+		return errors.New("health check cannot be empty")
+	}
+
+	return r.creator.Create(ctx, r.conv.ToEntity(healthCheck))
+}
+
+// List returns a page of the health checks of the applications visible to the tenant, the most recent ones first.
+// The health checks can be filtered by type and by the ID of the probed application.
+func (r *repository) List(ctx context.Context, tenant string, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	conditions := repo.Conditions{}
+	if len(types) > 0 {
+		typeValues := make([]string, 0, len(types))
+		for _, t := range types {
+			typeValues = append(typeValues, string(t))
+		}
+		conditions = append(conditions, repo.NewInConditionForStringValues(typeColumn, typeValues))
+	}
+	if origin != nil {
+		conditions = append(conditions, repo.NewEqualCondition(appIDColumn, *origin))
+	}
+
+	var entities EntityCollection
+	page, totalCount, err := r.pageableQuerier.List(ctx, resource.HealthCheck, tenant, pageSize, cursor, orderByColumns, &entities, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	healthChecks := make([]*model.HealthCheck, 0, len(entities))
+	for i := range entities {
+		healthChecks = append(healthChecks, r.conv.FromEntity(&entities[i]))
+	}
+
+	return &model.HealthCheckPage{
+		Data:       healthChecks,
+		PageInfo:   page,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// ListTargets returns the applications of all tenants which have a health check URL
+func (r *repository) ListTargets(ctx context.Context) ([]*model.HealthCheckTarget, error) {
+	var entities targetEntityCollection
+	if err := r.targetLister.ListGlobal(ctx, &entities, repo.NewNotNullCondition(healthCheckURLColumn), repo.NewNotEqualCondition(healthCheckURLColumn, "")); err != nil {
+		return nil, err
+	}
+
+	targets := make([]*model.HealthCheckTarget, 0, len(entities))
+	for _, entity := range entities {
+		targets = append(targets, &model.HealthCheckTarget{
+			ApplicationID: entity.ID,
+			URL:           entity.HealthCheckURL,
+		})
+	}
+
+	return targets, nil
 }
 
-// NewRepository missing godoc
-func NewRepository() *inMemoryRepository {
-	return &inMemoryRepository{store: make(map[string]*model.Runtime)}
+// DeleteOlderThan deletes the health checks of all tenants which were executed before the given time
+func (r *repository) DeleteOlderThan(ctx context.Context, before time.Time) error {
+	return r.deleter.DeleteManyGlobal(ctx, repo.Conditions{repo.NewLessThanCondition(timestampColumn, before)})
 }
@@ -0,0 +1,188 @@
+package healthcheck_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixHealthCheckEntity()
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.health_checks ( id, app_id, type, condition, url, message, timestamp ) VALUES ( ?, ?, ?, ?, ?, ?, ? )`)).
+			WithArgs(entity.ID, entity.ApplicationID, entity.Type, entity.Condition, entity.URL, entity.Message, entity.Timestamp).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		conv := &automock.EntityConverter{}
+		conv.On("ToEntity", fixHealthCheckModel()).Return(entity).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(conv)
+
+		// WHEN
+		err := repo.Create(ctx, fixHealthCheckModel())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when the health check is nil", func(t *testing.T) {
+		repo := healthcheck.NewRepository(nil)
+
+		err := repo.Create(context.TODO(), nil)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "health check cannot be empty")
+	})
+}
+
+func TestRepository_List(t *testing.T) {
+	origin := applicationID
+	types := []model.HealthCheckType{model.ApplicationHealthCheckType, model.ApplicationORDHealthCheckType}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixHealthCheckEntity()
+		rows := sqlmock.NewRows(tableColumns).
+			AddRow(entity.ID, entity.ApplicationID, entity.Type, entity.Condition, entity.URL, entity.Message, entity.Timestamp)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, app_id, type, condition, url, message, timestamp FROM public.health_checks WHERE (type IN ($1, $2) AND app_id = $3 AND (id IN (SELECT id FROM health_checks_tenants WHERE tenant_id = $4))) ORDER BY timestamp DESC, id LIMIT 2 OFFSET 0`)).
+			WithArgs(string(model.ApplicationHealthCheckType), string(model.ApplicationORDHealthCheckType), applicationID, tenantID).
+			WillReturnRows(rows)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.health_checks WHERE (type IN ($1, $2) AND app_id = $3 AND (id IN (SELECT id FROM health_checks_tenants WHERE tenant_id = $4)))`)).
+			WithArgs(string(model.ApplicationHealthCheckType), string(model.ApplicationORDHealthCheckType), applicationID, tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", entity).Return(fixHealthCheckModel()).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(conv)
+
+		// WHEN
+		page, err := repo.List(ctx, tenantID, types, &origin, 2, "")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, fixHealthCheckPage(), page)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(`SELECT .* FROM public\.health_checks .*`).
+			WithArgs(tenantID).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.List(ctx, tenantID, nil, nil, 2, "")
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_ListTargets(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "healthcheck_url"}).AddRow(applicationID, healthCheckURL)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, healthcheck_url FROM public.applications WHERE healthcheck_url IS NOT NULL AND healthcheck_url != $1`)).
+			WithArgs("").
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(nil)
+
+		// WHEN
+		targets, err := repo.ListTargets(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.HealthCheckTarget{{ApplicationID: applicationID, URL: healthCheckURL}}, targets)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(`SELECT id, healthcheck_url FROM public\.applications .*`).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.ListTargets(ctx)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_DeleteOlderThan(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.health_checks WHERE timestamp < $1`)).
+			WithArgs(timestamp).
+			WillReturnResult(sqlmock.NewResult(-1, 3))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(nil)
+
+		// WHEN
+		err := repo.DeleteOlderThan(ctx, timestamp)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when deleting fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.health_checks WHERE timestamp < $1`)).
+			WithArgs(timestamp).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := healthcheck.NewRepository(nil)
+
+		// WHEN
+		err := repo.DeleteOlderThan(ctx, timestamp)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
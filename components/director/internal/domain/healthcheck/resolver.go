@@ -3,42 +3,81 @@ package healthcheck
 import (
 	"context"
 
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 )
 
-// HealthCheckService missing godoc
+// HealthCheckService is responsible for the service-layer health check operations
 //
 //go:generate mockery --name=HealthCheckService --output=automock --outpkg=automock --case=underscore --disable-version-string
-type HealthCheckService interface{}
+type HealthCheckService interface {
+	List(ctx context.Context, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error)
+}
 
-// HealthCheckConverter missing godoc
+// HealthCheckConverter converts health checks to their GraphQL representation
 //
 //go:generate mockery --name=HealthCheckConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
-type HealthCheckConverter interface{}
+type HealthCheckConverter interface {
+	MultipleToGraphQL(in []*model.HealthCheck) []*graphql.HealthCheck
+}
 
-// Resolver missing godoc
+// Resolver is the health check resolver
 type Resolver struct {
+	transact  persistence.Transactioner
 	svc       HealthCheckService
 	converter HealthCheckConverter
 }
 
-// NewResolver missing godoc
-func NewResolver(svc HealthCheckService) *Resolver {
+// NewResolver returns a new health check resolver
+func NewResolver(transact persistence.Transactioner, svc HealthCheckService, converter HealthCheckConverter) *Resolver {
 	return &Resolver{
+		transact:  transact,
 		svc:       svc,
-		converter: &converter{},
+		converter: converter,
 	}
 }
 
-// HealthChecks missing godoc
+// HealthChecks returns a page of the results of probing the endpoints of the applications visible to the tenant
 func (r *Resolver) HealthChecks(ctx context.Context, types []graphql.HealthCheckType, origin *string, first *int, after *graphql.PageCursor) (*graphql.HealthCheckPage, error) {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	healthCheckTypes := make([]model.HealthCheckType, 0, len(types))
+	for _, t := range types {
+		healthCheckTypes = append(healthCheckTypes, model.HealthCheckType(t))
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	page, err := r.svc.List(ctx, healthCheckTypes, origin, *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	return &graphql.HealthCheckPage{
-		Data: []*graphql.HealthCheck{},
+		Data:       r.converter.MultipleToGraphQL(page.Data),
+		TotalCount: page.TotalCount,
 		PageInfo: &graphql.PageInfo{
-			HasNextPage: false,
-			EndCursor:   "",
-			StartCursor: "",
+			StartCursor: graphql.PageCursor(page.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(page.PageInfo.EndCursor),
+			HasNextPage: page.PageInfo.HasNextPage,
 		},
-		TotalCount: 0,
 	}, nil
 }
@@ -0,0 +1,129 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_HealthChecks(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	after := graphql.PageCursor("cursor")
+	origin := applicationID
+	gqlTypes := []graphql.HealthCheckType{graphql.HealthCheckTypeManagementPlaneApplicationHealthcheck}
+	types := []model.HealthCheckType{model.ApplicationHealthCheckType}
+
+	healthChecks := []*model.HealthCheck{fixHealthCheckModel()}
+	gqlHealthChecks := []*graphql.HealthCheck{fixHealthCheckGraphQL()}
+	page := &model.HealthCheckPage{
+		Data:       healthChecks,
+		PageInfo:   &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: true},
+		TotalCount: 3,
+	}
+	gqlPage := &graphql.HealthCheckPage{
+		Data:       gqlHealthChecks,
+		PageInfo:   &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: true},
+		TotalCount: 3,
+	}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.HealthCheckService
+		ConverterFn    func() *automock.HealthCheckConverter
+		First          *int
+		ExpectedOutput *graphql.HealthCheckPage
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), types, &origin, first, string(after)).Return(page, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.HealthCheckConverter {
+				conv := &automock.HealthCheckConverter{}
+				conv.On("MultipleToGraphQL", healthChecks).Return(gqlHealthChecks).Once()
+				return conv
+			},
+			First:          &first,
+			ExpectedOutput: gqlPage,
+		},
+		{
+			Name: "Error when listing health checks fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), types, &origin, first, string(after)).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.HealthCheckConverter { return &automock.HealthCheckConverter{} },
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when the transaction fails to commit",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), types, &origin, first, string(after)).Return(page, nil).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.HealthCheckConverter { return &automock.HealthCheckConverter{} },
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when the transaction fails to begin",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ServiceFn:     func() *automock.HealthCheckService { return &automock.HealthCheckService{} },
+			ConverterFn:   func() *automock.HealthCheckConverter { return &automock.HealthCheckConverter{} },
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when first is missing",
+			TxFn:          txGen.ThatDoesntStartTransaction,
+			ServiceFn:     func() *automock.HealthCheckService { return &automock.HealthCheckService{} },
+			ConverterFn:   func() *automock.HealthCheckConverter { return &automock.HealthCheckConverter{} },
+			ExpectedError: "missing required parameter 'first'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := healthcheck.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.HealthChecks(context.TODO(), gqlTypes, &origin, testCase.First, &after)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
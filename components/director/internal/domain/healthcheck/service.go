@@ -1,16 +1,43 @@
 package healthcheck
 
-// HealthCheckRepository missing godoc
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+)
+
+// HealthCheckRepository is responsible for the repo-layer health check operations
 //
 //go:generate mockery --name=HealthCheckRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type HealthCheckRepository interface {
+	Create(ctx context.Context, healthCheck *model.HealthCheck) error
+	List(ctx context.Context, tenant string, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error)
+	ListTargets(ctx context.Context) ([]*model.HealthCheckTarget, error)
+	DeleteOlderThan(ctx context.Context, before time.Time) error
 }
 
 type service struct {
 	repo HealthCheckRepository
 }
 
-// NewService missing godoc
+// NewService returns a new health check service
 func NewService(repo HealthCheckRepository) *service {
 	return &service{repo: repo}
 }
+
+// List returns a page of the health checks of the applications visible to the tenant in the context
+func (s *service) List(ctx context.Context, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.repo.List(ctx, tnt, types, origin, pageSize, cursor)
+}
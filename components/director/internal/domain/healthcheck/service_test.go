@@ -0,0 +1,86 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_List(t *testing.T) {
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, tenantID)
+	origin := applicationID
+	types := []model.HealthCheckType{model.ApplicationHealthCheckType}
+
+	testCases := []struct {
+		Name           string
+		Context        context.Context
+		PageSize       int
+		RepoFn         func() *automock.HealthCheckRepository
+		ExpectedOutput *model.HealthCheckPage
+		ExpectedError  string
+	}{
+		{
+			Name:     "Success",
+			Context:  ctx,
+			PageSize: 2,
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("List", ctx, tenantID, types, &origin, 2, "").Return(fixHealthCheckPage(), nil).Once()
+				return repo
+			},
+			ExpectedOutput: fixHealthCheckPage(),
+		},
+		{
+			Name:     "Error when listing fails",
+			Context:  ctx,
+			PageSize: 2,
+			RepoFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("List", ctx, tenantID, types, &origin, 2, "").Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when page size is out of range",
+			Context:       ctx,
+			PageSize:      201,
+			RepoFn:        func() *automock.HealthCheckRepository { return &automock.HealthCheckRepository{} },
+			ExpectedError: "page size must be between 1 and 200",
+		},
+		{
+			Name:          "Error when the tenant is missing",
+			Context:       context.TODO(),
+			PageSize:      2,
+			RepoFn:        func() *automock.HealthCheckRepository { return &automock.HealthCheckRepository{} },
+			ExpectedError: "cannot read tenant from context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			svc := healthcheck.NewService(repo)
+
+			// WHEN
+			page, err := svc.List(testCase.Context, types, &origin, testCase.PageSize, "")
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.ExpectedOutput, page)
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package notificationoutbox
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/uid"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	webhookdir "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/pkg/errors"
+)
+
+// WebhookClient executes webhook requests
+//
+//go:generate mockery --name=WebhookClient --output=automock --outpkg=automock --case=underscore --disable-version-string
+type WebhookClient interface {
+	Do(ctx context.Context, request webhookclient.WebhookRequest) (*webhookdir.Response, error)
+}
+
+// EntryCreator persists notification outbox entries
+//
+//go:generate mockery --name=EntryCreator --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntryCreator interface {
+	Create(ctx context.Context, item *model.NotificationOutboxEntry) error
+}
+
+// UIDService generates UUIDs
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type client struct {
+	webhookClient WebhookClient
+	outboxRepo    EntryCreator
+	uidSvc        UIDService
+}
+
+// NewClient wraps the webhook client so that asynchronous formation and formation assignment notifications
+// are persisted in the outbox in the transaction of the caller instead of being sent right away.
+// All other requests are delegated to the wrapped client because their callers need the actual response.
+func NewClient(webhookClient WebhookClient, outboxRepo EntryCreator, uidSvc UIDService) *client {
+	return &client{
+		webhookClient: webhookClient,
+		outboxRepo:    outboxRepo,
+		uidSvc:        uidSvc,
+	}
+}
+
+// NewNotificationClient returns the webhook client to be used for formation notifications.
+// When the outbox is enabled the given client is wrapped by the outbox client, otherwise it is returned as it is.
+func NewNotificationClient(cfg Config, webhookClient WebhookClient) WebhookClient {
+	if !cfg.Enabled {
+		return webhookClient
+	}
+	return NewClient(webhookClient, NewRepository(NewConverter()), uid.NewService())
+}
+
+// Do enqueues the notification in the outbox and returns an accepted response, as if the receiver had accepted it.
+// The result of the processing is reported by the receiver through the formation assignment status API as usual.
+func (c *client) Do(ctx context.Context, request webhookclient.WebhookRequest) (*webhookdir.Response, error) {
+	extRequest, ok := request.(webhookclient.WebhookExtRequest)
+	if !ok || !isAsyncCallbackWebhook(request.GetWebhook()) {
+		return c.webhookClient.Do(ctx, request)
+	}
+
+	if _, err := persistence.FromCtx(ctx); err != nil {
+		log.C(ctx).Warnf("No transaction found in the context, the notification for webhook with ID %q will be sent without the outbox", request.GetWebhook().ID)
+		return c.webhookClient.Do(ctx, request)
+	}
+
+	// The request is rendered only to reject invalid notifications right away, as they would be rejected if sent inline.
+	// It is not stored, as it may contain credentials. The notification is generated again when it is delivered.
+	if _, err := webhookclient.Render(request); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	operation := string(extRequest.GetOperation())
+	entry := &model.NotificationOutboxEntry{
+		ID:            c.uidSvc.Generate(),
+		WebhookID:     request.GetWebhook().ID,
+		Status:        model.NotificationOutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+	if operation != "" {
+		entry.Operation = &operation
+	}
+	if correlationID := request.GetCorrelationID(); correlationID != "" {
+		entry.CorrelationID = &correlationID
+	}
+	if formation := extRequest.GetFormation(); formation != nil {
+		entry.FormationID = &formation.ID
+	}
+	if assignment := extRequest.GetFormationAssignment(); assignment != nil {
+		entry.FormationAssignmentID = &assignment.ID
+	}
+
+	if err := c.outboxRepo.Create(ctx, entry); err != nil {
+		return nil, errors.Wrapf(err, "while enqueuing notification for webhook with ID %q", entry.WebhookID)
+	}
+	log.C(ctx).Infof("Notification for webhook with ID %q was enqueued in the outbox with ID %q", entry.WebhookID, entry.ID)
+
+	return acceptedResponse(), nil
+}
+
+func isAsyncCallbackWebhook(webhook *graphql.Webhook) bool {
+	return webhook != nil && webhook.ID != "" && webhook.Mode != nil && *webhook.Mode == graphql.WebhookModeAsyncCallback
+}
+
+func acceptedResponse() *webhookdir.Response {
+	statusCode := http.StatusAccepted
+	return &webhookdir.Response{
+		SuccessStatusCode: &statusCode,
+		ActualStatusCode:  &statusCode,
+	}
+}
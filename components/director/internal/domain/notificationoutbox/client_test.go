@@ -0,0 +1,180 @@
+package notificationoutbox_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/notificationoutbox"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/notificationoutbox/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Do(t *testing.T) {
+	syncMode := graphql.WebhookModeSync
+	urlTemplate := `{"method": "PATCH", "path": "https://receiver.example.com/formations/assignments"}`
+	inputTemplate := notificationBody
+	outputTemplate := `{"success_status_code": 202}`
+
+	invalidInputTemplate := `{{ .Missing`
+
+	fixRequest := func(mode graphql.WebhookMode) *webhookclient.FormationAssignmentNotificationRequestExt {
+		return &webhookclient.FormationAssignmentNotificationRequestExt{
+			FormationAssignmentNotificationRequest: &webhookclient.FormationAssignmentNotificationRequest{
+				Webhook: &graphql.Webhook{
+					ID:             webhookID,
+					Mode:           &mode,
+					URLTemplate:    &urlTemplate,
+					InputTemplate:  &inputTemplate,
+					OutputTemplate: &outputTemplate,
+				},
+				Object:        &webhook.FormationConfigurationChangeInput{},
+				CorrelationID: correlationID,
+			},
+			Operation:           model.AssignFormation,
+			FormationAssignment: &model.FormationAssignment{ID: assignmentID},
+			Formation:           &model.Formation{ID: formationID},
+		}
+	}
+	entryMatcher := mock.MatchedBy(func(entry *model.NotificationOutboxEntry) bool {
+		return entry.ID == entryID && entry.WebhookID == webhookID && *entry.FormationID == formationID &&
+			*entry.FormationAssignmentID == assignmentID && *entry.Operation == operation && *entry.CorrelationID == correlationID &&
+			entry.Status == model.NotificationOutboxStatusPending
+	})
+	innerResponse := &webhook.Response{}
+
+	testCases := []struct {
+		Name             string
+		Request          webhookclient.WebhookRequest
+		WithTransaction  bool
+		WebhookClientFn  func() *automock.WebhookClient
+		EntryCreatorFn   func() *automock.EntryCreator
+		UIDServiceFn     func() *automock.UIDService
+		ExpectedResponse *webhook.Response
+		ExpectedErrorMsg string
+	}{
+		{
+			Name:            "Enqueues asynchronous callback notification",
+			Request:         fixRequest(graphql.WebhookModeAsyncCallback),
+			WithTransaction: true,
+			EntryCreatorFn: func() *automock.EntryCreator {
+				creator := &automock.EntryCreator{}
+				creator.On("Create", mock.Anything, entryMatcher).Return(nil).Once()
+				return creator
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				uidSvc := &automock.UIDService{}
+				uidSvc.On("Generate").Return(entryID).Once()
+				return uidSvc
+			},
+			ExpectedResponse: fixAcceptedResponse(),
+		},
+		{
+			Name:            "Delegates synchronous notification",
+			Request:         fixRequest(syncMode),
+			WithTransaction: true,
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(innerResponse, nil).Once()
+				return client
+			},
+			ExpectedResponse: innerResponse,
+		},
+		{
+			Name:    "Delegates request which is not a notification",
+			Request: &webhookclient.Request{Webhook: &graphql.Webhook{ID: webhookID}},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(innerResponse, nil).Once()
+				return client
+			},
+			ExpectedResponse: innerResponse,
+		},
+		{
+			Name:    "Delegates asynchronous callback notification when there is no transaction",
+			Request: fixRequest(graphql.WebhookModeAsyncCallback),
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(innerResponse, nil).Once()
+				return client
+			},
+			ExpectedResponse: innerResponse,
+		},
+		{
+			Name: "Error when the notification cannot be rendered",
+			Request: func() webhookclient.WebhookRequest {
+				request := fixRequest(graphql.WebhookModeAsyncCallback)
+				request.Webhook.InputTemplate = &invalidInputTemplate
+				return request
+			}(),
+			WithTransaction:  true,
+			ExpectedErrorMsg: "unable to parse webhook input body",
+		},
+		{
+			Name:            "Error when enqueuing fails",
+			Request:         fixRequest(graphql.WebhookModeAsyncCallback),
+			WithTransaction: true,
+			EntryCreatorFn: func() *automock.EntryCreator {
+				creator := &automock.EntryCreator{}
+				creator.On("Create", mock.Anything, entryMatcher).Return(testErr).Once()
+				return creator
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				uidSvc := &automock.UIDService{}
+				uidSvc.On("Generate").Return(entryID).Once()
+				return uidSvc
+			},
+			ExpectedErrorMsg: "while enqueuing notification",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			webhookClient := &automock.WebhookClient{}
+			if testCase.WebhookClientFn != nil {
+				webhookClient = testCase.WebhookClientFn()
+			}
+			entryCreator := &automock.EntryCreator{}
+			if testCase.EntryCreatorFn != nil {
+				entryCreator = testCase.EntryCreatorFn()
+			}
+			uidSvc := &automock.UIDService{}
+			if testCase.UIDServiceFn != nil {
+				uidSvc = testCase.UIDServiceFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, webhookClient, entryCreator, uidSvc)
+
+			ctx := context.TODO()
+			if testCase.WithTransaction {
+				db, _ := testdb.MockDatabase(t)
+				ctx = persistence.SaveToContext(ctx, db)
+			}
+
+			client := notificationoutbox.NewClient(webhookClient, entryCreator, uidSvc)
+
+			// WHEN
+			resp, err := client.Do(ctx, testCase.Request)
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.ExpectedResponse, resp)
+		})
+	}
+}
+
+func fixAcceptedResponse() *webhook.Response {
+	statusCode := http.StatusAccepted
+	return &webhook.Response{SuccessStatusCode: &statusCode, ActualStatusCode: &statusCode}
+}
@@ -0,0 +1,24 @@
+package notificationoutbox
+
+import "time"
+
+// Config configures the transactional outbox for formation notifications
+type Config struct {
+	// Enabled switches asynchronous formation notifications from being sent inline to being persisted in the outbox
+	Enabled bool `envconfig:"default=false,APP_NOTIFICATION_OUTBOX_ENABLED"`
+	// DispatchInterval is how often the outbox is checked for notifications which are due for delivery
+	DispatchInterval time.Duration `envconfig:"default=5s,APP_NOTIFICATION_OUTBOX_DISPATCH_INTERVAL"`
+	// BatchSize is the maximum number of notifications delivered in a single dispatch
+	BatchSize int `envconfig:"default=50,APP_NOTIFICATION_OUTBOX_BATCH_SIZE"`
+	// ClaimTimeout is how long a notification claimed for delivery is reserved for the director instance which claimed it.
+	// It is delivered again if the instance does not record the outcome of the delivery within that time.
+	ClaimTimeout time.Duration `envconfig:"default=5m,APP_NOTIFICATION_OUTBOX_CLAIM_TIMEOUT"`
+	// MaxAttempts is the number of delivery attempts after which a notification is marked as failed
+	MaxAttempts int `envconfig:"default=10,APP_NOTIFICATION_OUTBOX_MAX_ATTEMPTS"`
+	// RetryBackoff is the delay before the first redelivery. It doubles with every failed attempt.
+	RetryBackoff time.Duration `envconfig:"default=10s,APP_NOTIFICATION_OUTBOX_RETRY_BACKOFF"`
+	// MaxRetryBackoff caps the delay between two delivery attempts
+	MaxRetryBackoff time.Duration `envconfig:"default=10m,APP_NOTIFICATION_OUTBOX_MAX_RETRY_BACKOFF"`
+	// SentRetentionPeriod is how long delivered notifications are kept before they are deleted
+	SentRetentionPeriod time.Duration `envconfig:"default=168h,APP_NOTIFICATION_OUTBOX_SENT_RETENTION_PERIOD"`
+}
@@ -0,0 +1,57 @@
+package notificationoutbox
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+)
+
+type converter struct{}
+
+// NewConverter returns a new notification outbox converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the notification outbox entry model to an entity
+func (c *converter) ToEntity(in *model.NotificationOutboxEntry) (*Entity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	return &Entity{
+		ID:                    in.ID,
+		WebhookID:             in.WebhookID,
+		FormationID:           repo.NewNullableString(in.FormationID),
+		FormationAssignmentID: repo.NewNullableString(in.FormationAssignmentID),
+		Operation:             repo.NewNullableString(in.Operation),
+		CorrelationID:         repo.NewNullableString(in.CorrelationID),
+		Status:                string(in.Status),
+		Attempts:              in.Attempts,
+		NextAttemptAt:         in.NextAttemptAt,
+		LastError:             repo.NewNullableString(in.LastError),
+		CreatedAt:             in.CreatedAt,
+		SentAt:                in.SentAt,
+	}, nil
+}
+
+// FromEntity converts the notification outbox entity to a model
+func (c *converter) FromEntity(in *Entity) (*model.NotificationOutboxEntry, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	return &model.NotificationOutboxEntry{
+		ID:                    in.ID,
+		WebhookID:             in.WebhookID,
+		FormationID:           repo.StringPtrFromNullableString(in.FormationID),
+		FormationAssignmentID: repo.StringPtrFromNullableString(in.FormationAssignmentID),
+		Operation:             repo.StringPtrFromNullableString(in.Operation),
+		CorrelationID:         repo.StringPtrFromNullableString(in.CorrelationID),
+		Status:                model.NotificationOutboxStatus(in.Status),
+		Attempts:              in.Attempts,
+		NextAttemptAt:         in.NextAttemptAt,
+		LastError:             repo.StringPtrFromNullableString(in.LastError),
+		CreatedAt:             in.CreatedAt,
+		SentAt:                in.SentAt,
+	}, nil
+}
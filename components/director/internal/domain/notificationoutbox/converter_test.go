@@ -0,0 +1,33 @@
+package notificationoutbox_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/notificationoutbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	conv := notificationoutbox.NewConverter()
+
+	entity, err := conv.ToEntity(fixEntryModel())
+	require.NoError(t, err)
+	assert.Equal(t, fixEntryEntity(), entity)
+
+	entity, err = conv.ToEntity(nil)
+	require.NoError(t, err)
+	assert.Nil(t, entity)
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	conv := notificationoutbox.NewConverter()
+
+	entry, err := conv.FromEntity(fixEntryEntity())
+	require.NoError(t, err)
+	assert.Equal(t, fixEntryModel(), entry)
+
+	entry, err = conv.FromEntity(nil)
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+}
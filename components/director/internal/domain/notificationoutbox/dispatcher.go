@@ -0,0 +1,399 @@
+package notificationoutbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	webhookdir "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/pkg/errors"
+)
+
+// IdempotencyKeyHeader is the header carrying the outbox entry ID. It is the same for every delivery attempt
+// of a notification, so receivers can use it to detect redeliveries.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// EntryRepository manages the notification outbox entries during their delivery
+//
+//go:generate mockery --name=EntryRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntryRepository interface {
+	ListDueForUpdate(ctx context.Context, now time.Time, limit int) ([]*model.NotificationOutboxEntry, error)
+	Update(ctx context.Context, item *model.NotificationOutboxEntry) error
+	DeleteSentBefore(ctx context.Context, before time.Time) error
+}
+
+// WebhookRepository fetches the webhooks the formation notifications are delivered to
+//
+//go:generate mockery --name=WebhookRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type WebhookRepository interface {
+	GetByIDGlobal(ctx context.Context, id string) (*model.Webhook, error)
+}
+
+// FormationAssignmentRepository fetches the formation assignments the notifications are about and records failed deliveries in them
+//
+//go:generate mockery --name=FormationAssignmentRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationAssignmentRepository interface {
+	GetGlobalByID(ctx context.Context, id string) (*model.FormationAssignment, error)
+	Update(ctx context.Context, m *model.FormationAssignment) error
+}
+
+// FormationRepository fetches the formations the notifications are about and records failed deliveries in them
+//
+//go:generate mockery --name=FormationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationRepository interface {
+	GetGlobalByID(ctx context.Context, id string) (*model.Formation, error)
+	Update(ctx context.Context, item *model.Formation) error
+}
+
+// FormationTemplateRepository fetches the templates of the formations the notifications are about
+//
+//go:generate mockery --name=FormationTemplateRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationTemplateRepository interface {
+	Get(ctx context.Context, id string) (*model.FormationTemplate, error)
+}
+
+// FormationAssignmentNotificationGenerator generates formation assignment notifications
+//
+//go:generate mockery --name=FormationAssignmentNotificationGenerator --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationAssignmentNotificationGenerator interface {
+	GenerateFormationAssignmentNotification(ctx context.Context, fa *model.FormationAssignment, operation model.FormationOperation) (*webhookclient.FormationAssignmentNotificationRequest, error)
+}
+
+// FormationNotificationGenerator generates formation lifecycle notifications
+//
+//go:generate mockery --name=FormationNotificationGenerator --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationNotificationGenerator interface {
+	GenerateFormationNotifications(ctx context.Context, formationTemplateWebhooks []*model.Webhook, tenantID string, formation *model.Formation, formationTemplateName, formationTemplateID string, formationOperation model.FormationOperation) ([]*webhookclient.FormationNotificationRequest, error)
+}
+
+// Sender executes already rendered webhook requests
+//
+//go:generate mockery --name=Sender --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Sender interface {
+	DoRendered(ctx context.Context, webhook graphql.Webhook, rendered *webhookclient.RenderedRequest, correlationID string) (*webhookdir.Response, error)
+}
+
+type dispatcher struct {
+	cfg                            Config
+	transact                       persistence.Transactioner
+	outboxRepo                     EntryRepository
+	webhookRepo                    WebhookRepository
+	formationAssignmentRepo        FormationAssignmentRepository
+	formationRepo                  FormationRepository
+	formationTemplateRepo          FormationTemplateRepository
+	faNotificationGenerator        FormationAssignmentNotificationGenerator
+	formationNotificationGenerator FormationNotificationGenerator
+	sender                         Sender
+}
+
+// NewDispatcher returns a new dispatcher delivering the notifications from the outbox
+func NewDispatcher(cfg Config, transact persistence.Transactioner, outboxRepo EntryRepository, webhookRepo WebhookRepository, formationAssignmentRepo FormationAssignmentRepository, formationRepo FormationRepository, formationTemplateRepo FormationTemplateRepository, faNotificationGenerator FormationAssignmentNotificationGenerator, formationNotificationGenerator FormationNotificationGenerator, sender Sender) *dispatcher {
+	return &dispatcher{
+		cfg:                            cfg,
+		transact:                       transact,
+		outboxRepo:                     outboxRepo,
+		webhookRepo:                    webhookRepo,
+		formationAssignmentRepo:        formationAssignmentRepo,
+		formationRepo:                  formationRepo,
+		formationTemplateRepo:          formationTemplateRepo,
+		faNotificationGenerator:        faNotificationGenerator,
+		formationNotificationGenerator: formationNotificationGenerator,
+		sender:                         sender,
+	}
+}
+
+// Dispatch delivers a batch of due notifications and returns how many of them were sent.
+// The batch is claimed in a transaction of its own, so that no rows are locked while the notifications are sent,
+// and the outcome of every delivery is recorded in a separate transaction. A notification whose outcome
+// is not recorded before its claim expires is delivered again.
+func (d *dispatcher) Dispatch(ctx context.Context) (int, error) {
+	now := time.Now()
+	entries, err := d.claim(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, entry := range entries {
+		errorCode := d.deliver(ctx, entry, now)
+		if err := d.record(ctx, entry, errorCode); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to record the delivery of notification outbox entry with ID %q, it will be delivered again once its claim expires", entry.ID)
+			continue
+		}
+		if entry.Status == model.NotificationOutboxStatusSent {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// claim marks a batch of due notifications as in flight and deletes the notifications delivered before the retention period
+func (d *dispatcher) claim(ctx context.Context, now time.Time) ([]*model.NotificationOutboxEntry, error) {
+	tx, err := d.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer d.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	entries, err := d.outboxRepo.ListDueForUpdate(ctx, now, d.cfg.BatchSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing due notifications")
+	}
+
+	for _, entry := range entries {
+		entry.Status = model.NotificationOutboxStatusInFlight
+		entry.Attempts++
+		entry.NextAttemptAt = now.Add(d.cfg.ClaimTimeout)
+		if err = d.outboxRepo.Update(ctx, entry); err != nil {
+			return nil, errors.Wrapf(err, "while claiming notification outbox entry with ID %q", entry.ID)
+		}
+	}
+
+	if err = d.outboxRepo.DeleteSentBefore(ctx, now.Add(-d.cfg.SentRetentionPeriod)); err != nil {
+		return nil, errors.Wrap(err, "while deleting delivered notifications")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// deliver generates and sends the notification and records the outcome in the entry.
+// When the notification fails permanently the error code to be reported in the notified formation assignment or formation is returned.
+func (d *dispatcher) deliver(ctx context.Context, entry *model.NotificationOutboxEntry, now time.Time) formationassignment.AssignmentErrorCode {
+	request, err := d.generate(ctx, entry)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return d.markFailed(ctx, entry, err, formationassignment.TechnicalError)
+		}
+		return d.markForRetry(ctx, entry, now, errors.Wrapf(err, "while generating the notification"))
+	}
+
+	rendered, err := webhookclient.Render(request)
+	if err != nil {
+		return d.markFailed(ctx, entry, errors.Wrapf(err, "while rendering the notification"), formationassignment.TechnicalError)
+	}
+	if rendered.Headers == nil {
+		rendered.Headers = http.Header{}
+	}
+	rendered.Headers.Set(IdempotencyKeyHeader, entry.ID)
+
+	resp, err := d.sender.DoRendered(ctx, *request.GetWebhook(), rendered, str.PtrStrToStr(entry.CorrelationID))
+	var goneErr webhookclient.WebhookStatusGoneErr
+	switch {
+	case err == nil, errors.As(err, &goneErr):
+		sentAt := time.Now()
+		entry.Status = model.NotificationOutboxStatusSent
+		entry.SentAt = &sentAt
+		entry.LastError = nil
+		log.C(ctx).Infof("Notification outbox entry with ID %q was delivered to webhook with ID %q", entry.ID, entry.WebhookID)
+		return 0
+	case resp != nil && resp.Error != nil && *resp.Error != "":
+		return d.markFailed(ctx, entry, err, formationassignment.ClientError)
+	default:
+		return d.markForRetry(ctx, entry, now, err)
+	}
+}
+
+// generate generates the notification referenced by the entry from the current state of its formation assignment or formation
+func (d *dispatcher) generate(ctx context.Context, entry *model.NotificationOutboxEntry) (webhookclient.WebhookRequest, error) {
+	tx, err := d.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer d.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	var request webhookclient.WebhookRequest
+	if entry.FormationAssignmentID != nil {
+		request, err = d.generateFormationAssignmentNotification(ctx, entry)
+	} else {
+		request, err = d.generateFormationNotification(ctx, entry)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return request, tx.Commit()
+}
+
+func (d *dispatcher) generateFormationAssignmentNotification(ctx context.Context, entry *model.NotificationOutboxEntry) (webhookclient.WebhookRequest, error) {
+	fa, err := d.formationAssignmentRepo.GetGlobalByID(ctx, *entry.FormationAssignmentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation assignment with ID %q", *entry.FormationAssignmentID)
+	}
+
+	request, err := d.faNotificationGenerator.GenerateFormationAssignmentNotification(ctx, fa, model.FormationOperation(str.PtrStrToStr(entry.Operation)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while generating notification for formation assignment with ID %q", fa.ID)
+	}
+	if request == nil || request.Webhook == nil {
+		return nil, apperrors.NewNotFoundErrorWithMessage(resource.Webhook, entry.WebhookID, "formation assignment no longer has a webhook to be notified")
+	}
+
+	return request, nil
+}
+
+func (d *dispatcher) generateFormationNotification(ctx context.Context, entry *model.NotificationOutboxEntry) (webhookclient.WebhookRequest, error) {
+	if entry.FormationID == nil {
+		return nil, apperrors.NewNotFoundErrorWithMessage(resource.Formations, "", "notification does not reference a formation")
+	}
+
+	formation, err := d.formationRepo.GetGlobalByID(ctx, *entry.FormationID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation with ID %q", *entry.FormationID)
+	}
+
+	formationTemplate, err := d.formationTemplateRepo.Get(ctx, formation.FormationTemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation template with ID %q", formation.FormationTemplateID)
+	}
+
+	webhook, err := d.webhookRepo.GetByIDGlobal(ctx, entry.WebhookID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting webhook with ID %q", entry.WebhookID)
+	}
+
+	requests, err := d.formationNotificationGenerator.GenerateFormationNotifications(ctx, []*model.Webhook{webhook}, formation.TenantID, formation, formationTemplate.Name, formationTemplate.ID, model.FormationOperation(str.PtrStrToStr(entry.Operation)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while generating notification for formation with ID %q", formation.ID)
+	}
+	if len(requests) == 0 || requests[0] == nil {
+		return nil, apperrors.NewNotFoundErrorWithMessage(resource.Webhook, entry.WebhookID, "no notification was generated for the webhook")
+	}
+
+	return requests[0], nil
+}
+
+// record persists the outcome of the delivery. The formation assignment or formation whose notification failed permanently
+// is moved to an error state in the same transaction, so that the failure is visible and can be resynchronized.
+func (d *dispatcher) record(ctx context.Context, entry *model.NotificationOutboxEntry, errorCode formationassignment.AssignmentErrorCode) error {
+	tx, err := d.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer d.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = d.outboxRepo.Update(ctx, entry); err != nil {
+		return errors.Wrapf(err, "while updating notification outbox entry with ID %q", entry.ID)
+	}
+
+	if entry.Status == model.NotificationOutboxStatusFailed {
+		if err = d.setNotifiedObjectToErrorState(ctx, entry, errorCode); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (d *dispatcher) setNotifiedObjectToErrorState(ctx context.Context, entry *model.NotificationOutboxEntry, errorCode formationassignment.AssignmentErrorCode) error {
+	assignmentError := formationassignment.AssignmentError{
+		Message:   str.PtrStrToStr(entry.LastError),
+		ErrorCode: errorCode,
+	}
+	operation := model.FormationOperation(str.PtrStrToStr(entry.Operation))
+
+	if entry.FormationAssignmentID != nil {
+		fa, err := d.formationAssignmentRepo.GetGlobalByID(ctx, *entry.FormationAssignmentID)
+		if apperrors.IsNotFoundError(err) {
+			return nil
+		} else if err != nil {
+			return errors.Wrapf(err, "while getting formation assignment with ID %q", *entry.FormationAssignmentID)
+		}
+
+		state := model.CreateErrorAssignmentState
+		if operation == model.UnassignFormation {
+			state = model.DeleteErrorAssignmentState
+		}
+		marshaled, err := json.Marshal(formationassignment.AssignmentErrorWrapper{Error: assignmentError})
+		if err != nil {
+			return errors.Wrapf(err, "while preparing error message for formation assignment with ID %q", fa.ID)
+		}
+		fa.State = string(state)
+		fa.Error = marshaled
+		if err = d.formationAssignmentRepo.Update(ctx, fa); err != nil {
+			return errors.Wrapf(err, "while setting formation assignment with ID %q to state %q", fa.ID, state)
+		}
+		log.C(ctx).Infof("Formation assignment with ID %q was set to state %q after its notification failed", fa.ID, state)
+		return nil
+	}
+
+	if entry.FormationID == nil {
+		return nil
+	}
+
+	formation, err := d.formationRepo.GetGlobalByID(ctx, *entry.FormationID)
+	if apperrors.IsNotFoundError(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "while getting formation with ID %q", *entry.FormationID)
+	}
+
+	state := model.CreateErrorFormationState
+	if operation == model.DeleteFormation {
+		state = model.DeleteErrorFormationState
+	}
+	marshaled, err := json.Marshal(assignmentError)
+	if err != nil {
+		return errors.Wrapf(err, "while preparing error message for formation with ID %q", formation.ID)
+	}
+	formation.State = state
+	formation.Error = marshaled
+	if err = d.formationRepo.Update(ctx, formation); err != nil {
+		return errors.Wrapf(err, "while setting formation with ID %q to state %q", formation.ID, state)
+	}
+	log.C(ctx).Infof("Formation with ID %q was set to state %q after its notification failed", formation.ID, state)
+	return nil
+}
+
+func (d *dispatcher) markForRetry(ctx context.Context, entry *model.NotificationOutboxEntry, now time.Time, err error) formationassignment.AssignmentErrorCode {
+	if entry.Attempts >= d.cfg.MaxAttempts {
+		return d.markFailed(ctx, entry, errors.Wrapf(err, "giving up after %d attempts", entry.Attempts), formationassignment.TechnicalError)
+	}
+
+	errMsg := err.Error()
+	entry.Status = model.NotificationOutboxStatusPending
+	entry.LastError = &errMsg
+	entry.NextAttemptAt = now.Add(d.backoff(entry.Attempts))
+	log.C(ctx).WithError(err).Warnf("Delivery of notification outbox entry with ID %q failed, it will be retried at %s", entry.ID, entry.NextAttemptAt)
+	return 0
+}
+
+func (d *dispatcher) markFailed(ctx context.Context, entry *model.NotificationOutboxEntry, err error, errorCode formationassignment.AssignmentErrorCode) formationassignment.AssignmentErrorCode {
+	errMsg := err.Error()
+	entry.Status = model.NotificationOutboxStatusFailed
+	entry.LastError = &errMsg
+	log.C(ctx).WithError(err).Errorf("Delivery of notification outbox entry with ID %q failed permanently", entry.ID)
+	return errorCode
+}
+
+// backoff returns the delay before the next attempt, doubling the configured retry backoff with every attempt
+func (d *dispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.RetryBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= d.cfg.MaxRetryBackoff {
+			return d.cfg.MaxRetryBackoff
+		}
+	}
+	return delay
+}
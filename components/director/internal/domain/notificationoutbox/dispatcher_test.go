@@ -0,0 +1,365 @@
+package notificationoutbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/notificationoutbox"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/notificationoutbox/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	renderedMatcher := mock.MatchedBy(func(rendered *webhookclient.RenderedRequest) bool {
+		return rendered.URL == notificationURL && string(rendered.Body) == notificationBody &&
+			rendered.Headers.Get(notificationoutbox.IdempotencyKeyHeader) == entryID && rendered.Headers.Get("Content-Type") == "application/json"
+	})
+	statusMatcher := func(status model.NotificationOutboxStatus, attempts int) interface{} {
+		return mock.MatchedBy(func(entry *model.NotificationOutboxEntry) bool {
+			return entry.ID == entryID && entry.Status == status && entry.Attempts == attempts
+		})
+	}
+	claimedMatcher := mock.MatchedBy(func(entry *model.NotificationOutboxEntry) bool {
+		return entry.Status == model.NotificationOutboxStatusInFlight && entry.NextAttemptAt.After(time.Now().Add(testConfig.ClaimTimeout/2))
+	})
+	assignmentInStateMatcher := func(state model.FormationAssignmentState, errorCode formationassignment.AssignmentErrorCode) interface{} {
+		return mock.MatchedBy(func(fa *model.FormationAssignment) bool {
+			var wrapper formationassignment.AssignmentErrorWrapper
+			if err := json.Unmarshal(fa.Error, &wrapper); err != nil {
+				return false
+			}
+			return fa.ID == assignmentID && fa.State == string(state) && wrapper.Error.ErrorCode == errorCode && wrapper.Error.Message != ""
+		})
+	}
+	formationInStateMatcher := func(state model.FormationState, errorCode formationassignment.AssignmentErrorCode) interface{} {
+		return mock.MatchedBy(func(formation *model.Formation) bool {
+			var formationErr formationassignment.AssignmentError
+			if err := json.Unmarshal(formation.Error, &formationErr); err != nil {
+				return false
+			}
+			return formation.ID == formationID && formation.State == state && formationErr.ErrorCode == errorCode && formationErr.Message != ""
+		})
+	}
+	entryWithAttempts := func(attempts int) *model.NotificationOutboxEntry {
+		entry := fixEntryModel()
+		entry.Attempts = attempts
+		return entry
+	}
+	unassignEntry := func() *model.NotificationOutboxEntry {
+		entry := fixEntryModel()
+		entry.Operation = str.Ptr(string(model.UnassignFormation))
+		return entry
+	}
+	outboxRepoThatRecords := func(entry *model.NotificationOutboxEntry, recorded interface{}) func() *automock.EntryRepository {
+		return func() *automock.EntryRepository {
+			repo := &automock.EntryRepository{}
+			repo.On("ListDueForUpdate", txtest.CtxWithDBMatcher(), mock.Anything, testConfig.BatchSize).Return([]*model.NotificationOutboxEntry{entry}, nil).Once()
+			repo.On("Update", txtest.CtxWithDBMatcher(), claimedMatcher).Return(nil).Once()
+			repo.On("DeleteSentBefore", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
+			repo.On("Update", txtest.CtxWithDBMatcher(), recorded).Return(nil).Once()
+			return repo
+		}
+	}
+	faRepoThatReturnsAssignment := func(times int) func() *automock.FormationAssignmentRepository {
+		return func() *automock.FormationAssignmentRepository {
+			repo := &automock.FormationAssignmentRepository{}
+			repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(fixFormationAssignment(), nil).Times(times)
+			return repo
+		}
+	}
+	faGeneratorFor := func(operation model.FormationOperation) func() *automock.FormationAssignmentNotificationGenerator {
+		return func() *automock.FormationAssignmentNotificationGenerator {
+			generator := &automock.FormationAssignmentNotificationGenerator{}
+			generator.On("GenerateFormationAssignmentNotification", txtest.CtxWithDBMatcher(), fixFormationAssignment(), operation).Return(fixFormationAssignmentNotificationRequest(), nil).Once()
+			return generator
+		}
+	}
+	senderThatReturns := func(resp *webhook.Response, err error) func() *automock.Sender {
+		return func() *automock.Sender {
+			sender := &automock.Sender{}
+			sender.On("DoRendered", mock.Anything, *fixWebhookGraphQL(), renderedMatcher, correlationID).Return(resp, err).Once()
+			return sender
+		}
+	}
+	formationReposFor := func(times int) (func() *automock.FormationRepository, func() *automock.FormationTemplateRepository, func() *automock.WebhookRepository) {
+		return func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), formationID).Return(fixFormation(), nil).Times(times)
+				return repo
+			}, func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", txtest.CtxWithDBMatcher(), templateID).Return(fixFormationTemplate(), nil).Once()
+				return repo
+			}, func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("GetByIDGlobal", txtest.CtxWithDBMatcher(), webhookID).Return(fixWebhookModel(), nil).Once()
+				return repo
+			}
+	}
+	formationGeneratorFor := func(operation model.FormationOperation) func() *automock.FormationNotificationGenerator {
+		return func() *automock.FormationNotificationGenerator {
+			generator := &automock.FormationNotificationGenerator{}
+			generator.On("GenerateFormationNotifications", txtest.CtxWithDBMatcher(), []*model.Webhook{fixWebhookModel()}, tenantID, fixFormation(), templateName, templateID, operation).Return([]*webhookclient.FormationNotificationRequest{fixFormationNotificationRequest(operation)}, nil).Once()
+			return generator
+		}
+	}
+	receiverErr := "receiver error"
+	formationRepoFn, formationTemplateRepoFn, webhookRepoFn := formationReposFor(1)
+	formationRepoForFailureFn, _, _ := formationReposFor(2)
+
+	testCases := []struct {
+		Name                       string
+		TransactionerFn            func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		OutboxRepoFn               func() *automock.EntryRepository
+		WebhookRepoFn              func() *automock.WebhookRepository
+		FormationAssignmentRepoFn  func() *automock.FormationAssignmentRepository
+		FormationRepoFn            func() *automock.FormationRepository
+		FormationTemplateRepoFn    func() *automock.FormationTemplateRepository
+		FANotificationGeneratorFn  func() *automock.FormationAssignmentNotificationGenerator
+		FormationNotificationGenFn func() *automock.FormationNotificationGenerator
+		SenderFn                   func() *automock.Sender
+		ExpectedSent               int
+		ExpectedErrorMsg           string
+	}{
+		{
+			Name: "Delivers due formation assignment notification",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			OutboxRepoFn:              outboxRepoThatRecords(fixEntryModel(), statusMatcher(model.NotificationOutboxStatusSent, 1)),
+			FormationAssignmentRepoFn: faRepoThatReturnsAssignment(1),
+			FANotificationGeneratorFn: faGeneratorFor(model.AssignFormation),
+			SenderFn:                  senderThatReturns(&webhook.Response{}, nil),
+			ExpectedSent:              1,
+		},
+		{
+			Name: "Delivers due formation notification",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			OutboxRepoFn:               outboxRepoThatRecords(fixFormationEntryModel(model.CreateFormation), statusMatcher(model.NotificationOutboxStatusSent, 1)),
+			FormationRepoFn:            formationRepoFn,
+			FormationTemplateRepoFn:    formationTemplateRepoFn,
+			WebhookRepoFn:              webhookRepoFn,
+			FormationNotificationGenFn: formationGeneratorFor(model.CreateFormation),
+			SenderFn:                   senderThatReturns(&webhook.Response{}, nil),
+			ExpectedSent:               1,
+		},
+		{
+			Name: "Marks notification as sent when the receiver reports it as gone",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			OutboxRepoFn:              outboxRepoThatRecords(fixEntryModel(), statusMatcher(model.NotificationOutboxStatusSent, 1)),
+			FormationAssignmentRepoFn: faRepoThatReturnsAssignment(1),
+			FANotificationGeneratorFn: faGeneratorFor(model.AssignFormation),
+			SenderFn:                  senderThatReturns(&webhook.Response{}, webhookclient.NewWebhookStatusGoneErr(404)),
+			ExpectedSent:              1,
+		},
+		{
+			Name: "Schedules a retry when the delivery fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			OutboxRepoFn: outboxRepoThatRecords(fixEntryModel(), mock.MatchedBy(func(entry *model.NotificationOutboxEntry) bool {
+				return entry.Status == model.NotificationOutboxStatusPending && entry.Attempts == 1 && entry.NextAttemptAt.After(time.Now()) && *entry.LastError != ""
+			})),
+			FormationAssignmentRepoFn: faRepoThatReturnsAssignment(1),
+			FANotificationGeneratorFn: faGeneratorFor(model.AssignFormation),
+			SenderFn:                  senderThatReturns(nil, testErr),
+		},
+		{
+			Name: "Schedules a retry when generating the notification fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			OutboxRepoFn: outboxRepoThatRecords(fixEntryModel(), mock.MatchedBy(func(entry *model.NotificationOutboxEntry) bool {
+				return entry.Status == model.NotificationOutboxStatusPending && entry.Attempts == 1 && *entry.LastError != ""
+			})),
+			FormationAssignmentRepoFn: faRepoThatReturnsAssignment(1),
+			FANotificationGeneratorFn: func() *automock.FormationAssignmentNotificationGenerator {
+				generator := &automock.FormationAssignmentNotificationGenerator{}
+				generator.On("GenerateFormationAssignmentNotification", txtest.CtxWithDBMatcher(), fixFormationAssignment(), model.AssignFormation).Return(nil, testErr).Once()
+				return generator
+			},
+		},
+		{
+			Name: "Marks notification as failed after the last attempt and sets the assignment to CREATE_ERROR",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			OutboxRepoFn: outboxRepoThatRecords(entryWithAttempts(testConfig.MaxAttempts-1), statusMatcher(model.NotificationOutboxStatusFailed, testConfig.MaxAttempts)),
+			FormationAssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := faRepoThatReturnsAssignment(2)()
+				repo.On("Update", txtest.CtxWithDBMatcher(), assignmentInStateMatcher(model.CreateErrorAssignmentState, formationassignment.TechnicalError)).Return(nil).Once()
+				return repo
+			},
+			FANotificationGeneratorFn: faGeneratorFor(model.AssignFormation),
+			SenderFn:                  senderThatReturns(nil, testErr),
+		},
+		{
+			Name: "Marks notification as failed when the receiver rejects it and sets the assignment to DELETE_ERROR",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			OutboxRepoFn: outboxRepoThatRecords(unassignEntry(), statusMatcher(model.NotificationOutboxStatusFailed, 1)),
+			FormationAssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := faRepoThatReturnsAssignment(2)()
+				repo.On("Update", txtest.CtxWithDBMatcher(), assignmentInStateMatcher(model.DeleteErrorAssignmentState, formationassignment.ClientError)).Return(nil).Once()
+				return repo
+			},
+			FANotificationGeneratorFn: faGeneratorFor(model.UnassignFormation),
+			SenderFn:                  senderThatReturns(&webhook.Response{Error: str.Ptr(receiverErr)}, testErr),
+		},
+		{
+			Name: "Marks formation notification as failed when the receiver rejects it and sets the formation to DELETE_ERROR",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			OutboxRepoFn: outboxRepoThatRecords(fixFormationEntryModel(model.DeleteFormation), statusMatcher(model.NotificationOutboxStatusFailed, 1)),
+			FormationRepoFn: func() *automock.FormationRepository {
+				repo := formationRepoForFailureFn()
+				repo.On("Update", txtest.CtxWithDBMatcher(), formationInStateMatcher(model.DeleteErrorFormationState, formationassignment.ClientError)).Return(nil).Once()
+				return repo
+			},
+			FormationTemplateRepoFn:    formationTemplateRepoFn,
+			WebhookRepoFn:              webhookRepoFn,
+			FormationNotificationGenFn: formationGeneratorFor(model.DeleteFormation),
+			SenderFn:                   senderThatReturns(&webhook.Response{Error: str.Ptr(receiverErr)}, testErr),
+		},
+		{
+			Name: "Marks notification as failed when the formation assignment no longer exists",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			OutboxRepoFn: outboxRepoThatRecords(fixEntryModel(), statusMatcher(model.NotificationOutboxStatusFailed, 1)),
+			FormationAssignmentRepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", txtest.CtxWithDBMatcher(), assignmentID).Return(nil, apperrors.NewNotFoundError(resource.FormationAssignment, assignmentID)).Twice()
+				return repo
+			},
+		},
+		{
+			Name: "Continues with the next notification when recording the delivery fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			OutboxRepoFn: func() *automock.EntryRepository {
+				repo := &automock.EntryRepository{}
+				repo.On("ListDueForUpdate", txtest.CtxWithDBMatcher(), mock.Anything, testConfig.BatchSize).Return([]*model.NotificationOutboxEntry{fixEntryModel()}, nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), claimedMatcher).Return(nil).Once()
+				repo.On("DeleteSentBefore", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), statusMatcher(model.NotificationOutboxStatusSent, 1)).Return(testErr).Once()
+				return repo
+			},
+			FormationAssignmentRepoFn: faRepoThatReturnsAssignment(1),
+			FANotificationGeneratorFn: faGeneratorFor(model.AssignFormation),
+			SenderFn:                  senderThatReturns(&webhook.Response{}, nil),
+		},
+		{
+			Name:            "Error when listing due notifications fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OutboxRepoFn: func() *automock.EntryRepository {
+				repo := &automock.EntryRepository{}
+				repo.On("ListDueForUpdate", txtest.CtxWithDBMatcher(), mock.Anything, testConfig.BatchSize).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "while listing due notifications",
+		},
+		{
+			Name:            "Error when claiming notification fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OutboxRepoFn: func() *automock.EntryRepository {
+				repo := &automock.EntryRepository{}
+				repo.On("ListDueForUpdate", txtest.CtxWithDBMatcher(), mock.Anything, testConfig.BatchSize).Return([]*model.NotificationOutboxEntry{fixEntryModel()}, nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), claimedMatcher).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "while claiming notification outbox entry",
+		},
+		{
+			Name:             "Error when beginning transaction fails",
+			TransactionerFn:  txGen.ThatFailsOnBegin,
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:            "Error when committing the claim fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			OutboxRepoFn: func() *automock.EntryRepository {
+				repo := &automock.EntryRepository{}
+				repo.On("ListDueForUpdate", txtest.CtxWithDBMatcher(), mock.Anything, testConfig.BatchSize).Return([]*model.NotificationOutboxEntry{fixEntryModel()}, nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), claimedMatcher).Return(nil).Once()
+				repo.On("DeleteSentBefore", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
+				return repo
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			outboxRepo := &automock.EntryRepository{}
+			if testCase.OutboxRepoFn != nil {
+				outboxRepo = testCase.OutboxRepoFn()
+			}
+			webhookRepo := &automock.WebhookRepository{}
+			if testCase.WebhookRepoFn != nil {
+				webhookRepo = testCase.WebhookRepoFn()
+			}
+			faRepo := &automock.FormationAssignmentRepository{}
+			if testCase.FormationAssignmentRepoFn != nil {
+				faRepo = testCase.FormationAssignmentRepoFn()
+			}
+			formationRepo := &automock.FormationRepository{}
+			if testCase.FormationRepoFn != nil {
+				formationRepo = testCase.FormationRepoFn()
+			}
+			formationTemplateRepo := &automock.FormationTemplateRepository{}
+			if testCase.FormationTemplateRepoFn != nil {
+				formationTemplateRepo = testCase.FormationTemplateRepoFn()
+			}
+			faNotificationGenerator := &automock.FormationAssignmentNotificationGenerator{}
+			if testCase.FANotificationGeneratorFn != nil {
+				faNotificationGenerator = testCase.FANotificationGeneratorFn()
+			}
+			formationNotificationGenerator := &automock.FormationNotificationGenerator{}
+			if testCase.FormationNotificationGenFn != nil {
+				formationNotificationGenerator = testCase.FormationNotificationGenFn()
+			}
+			sender := &automock.Sender{}
+			if testCase.SenderFn != nil {
+				sender = testCase.SenderFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, persist, transact, outboxRepo, webhookRepo, faRepo, formationRepo, formationTemplateRepo, faNotificationGenerator, formationNotificationGenerator, sender)
+
+			dispatcher := notificationoutbox.NewDispatcher(testConfig, transact, outboxRepo, webhookRepo, faRepo, formationRepo, formationTemplateRepo, faNotificationGenerator, formationNotificationGenerator, sender)
+
+			// WHEN
+			sent, err := dispatcher.Dispatch(context.TODO())
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.ExpectedSent, sent)
+		})
+	}
+}
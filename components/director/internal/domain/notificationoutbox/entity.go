@@ -0,0 +1,30 @@
+package notificationoutbox
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Entity represents a notification outbox entry in the database
+type Entity struct {
+	ID                    string         `db:"id"`
+	WebhookID             string         `db:"webhook_id"`
+	FormationID           sql.NullString `db:"formation_id"`
+	FormationAssignmentID sql.NullString `db:"formation_assignment_id"`
+	Operation             sql.NullString `db:"operation"`
+	CorrelationID         sql.NullString `db:"correlation_id"`
+	Status                string         `db:"status"`
+	Attempts              int            `db:"attempts"`
+	NextAttemptAt         time.Time      `db:"next_attempt_at"`
+	LastError             sql.NullString `db:"last_error"`
+	CreatedAt             time.Time      `db:"created_at"`
+	SentAt                *time.Time     `db:"sent_at"`
+}
+
+// EntityCollection is a collection of notification outbox entities
+type EntityCollection []Entity
+
+// Len returns the number of entities in the collection
+func (c EntityCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,134 @@
+package notificationoutbox_test
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/notificationoutbox"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+)
+
+const (
+	entryID          = "2d0e4c57-0ac4-4b5d-8a5e-6c3b1b1f2a10"
+	webhookID        = "8a6b3e0c-5f2e-4d3c-9b7a-1e2f3a4b5c6d"
+	formationID      = "f1e2d3c4-b5a6-4978-8695-a4b3c2d1e0f9"
+	assignmentID     = "0f9e8d7c-6b5a-4c3d-8e1f-a2b3c4d5e6f7"
+	templateID       = "5c4b3a29-1807-4f6e-9d5c-4b3a29180706"
+	templateName     = "formation-template"
+	tenantID         = "3e64ebae-38b5-46a0-b1ed-9ccee153a0ae"
+	correlationID    = "corr-id"
+	notificationURL  = "https://receiver.example.com/formations/assignments"
+	notificationBody = `{"formation_id":"f1e2d3c4-b5a6-4978-8695-a4b3c2d1e0f9"}`
+)
+
+var (
+	testErr      = errors.New("test error")
+	createdAt    = time.Date(2024, 5, 27, 10, 0, 0, 0, time.UTC)
+	operation    = string(model.AssignFormation)
+	tableColumns = []string{"id", "webhook_id", "formation_id", "formation_assignment_id", "operation", "correlation_id", "status", "attempts", "next_attempt_at", "last_error", "created_at", "sent_at"}
+	testConfig   = notificationoutbox.Config{
+		BatchSize:           10,
+		ClaimTimeout:        5 * time.Minute,
+		MaxAttempts:         3,
+		RetryBackoff:        10 * time.Second,
+		MaxRetryBackoff:     time.Minute,
+		SentRetentionPeriod: time.Hour,
+	}
+)
+
+func fixEntryModel() *model.NotificationOutboxEntry {
+	formation := formationID
+	assignment := assignmentID
+	op := operation
+	corrID := correlationID
+	return &model.NotificationOutboxEntry{
+		ID:                    entryID,
+		WebhookID:             webhookID,
+		FormationID:           &formation,
+		FormationAssignmentID: &assignment,
+		Operation:             &op,
+		CorrelationID:         &corrID,
+		Status:                model.NotificationOutboxStatusPending,
+		NextAttemptAt:         createdAt,
+		CreatedAt:             createdAt,
+	}
+}
+
+func fixEntryEntity() *notificationoutbox.Entity {
+	return &notificationoutbox.Entity{
+		ID:                    entryID,
+		WebhookID:             webhookID,
+		FormationID:           sql.NullString{String: formationID, Valid: true},
+		FormationAssignmentID: sql.NullString{String: assignmentID, Valid: true},
+		Operation:             sql.NullString{String: operation, Valid: true},
+		CorrelationID:         sql.NullString{String: correlationID, Valid: true},
+		Status:                string(model.NotificationOutboxStatusPending),
+		NextAttemptAt:         createdAt,
+		CreatedAt:             createdAt,
+	}
+}
+
+func fixWebhookModel() *model.Webhook {
+	mode := model.WebhookModeAsyncCallback
+	return &model.Webhook{ID: webhookID, Mode: &mode}
+}
+
+func fixWebhookGraphQL() *graphql.Webhook {
+	mode := graphql.WebhookModeAsyncCallback
+	urlTemplate := `{"method": "PATCH", "path": "` + notificationURL + `"}`
+	inputTemplate := notificationBody
+	headerTemplate := `{"Content-Type": ["application/json"]}`
+	outputTemplate := `{"success_status_code": 202}`
+	return &graphql.Webhook{ID: webhookID, Mode: &mode, URLTemplate: &urlTemplate, InputTemplate: &inputTemplate, HeaderTemplate: &headerTemplate, OutputTemplate: &outputTemplate}
+}
+
+func fixFormationEntryModel(operation model.FormationOperation) *model.NotificationOutboxEntry {
+	entry := fixEntryModel()
+	op := string(operation)
+	entry.FormationAssignmentID = nil
+	entry.Operation = &op
+	return entry
+}
+
+func fixFormationAssignment() *model.FormationAssignment {
+	return &model.FormationAssignment{
+		ID:          assignmentID,
+		FormationID: formationID,
+		TenantID:    tenantID,
+		State:       string(model.InitialAssignmentState),
+	}
+}
+
+func fixFormation() *model.Formation {
+	return &model.Formation{
+		ID:                  formationID,
+		TenantID:            tenantID,
+		FormationTemplateID: templateID,
+		State:               model.InitialFormationState,
+	}
+}
+
+func fixFormationTemplate() *model.FormationTemplate {
+	return &model.FormationTemplate{ID: templateID, Name: templateName}
+}
+
+func fixFormationAssignmentNotificationRequest() *webhookclient.FormationAssignmentNotificationRequest {
+	return &webhookclient.FormationAssignmentNotificationRequest{
+		Webhook:       fixWebhookGraphQL(),
+		Object:        &webhook.FormationConfigurationChangeInput{},
+		CorrelationID: correlationID,
+	}
+}
+
+func fixFormationNotificationRequest(operation model.FormationOperation) *webhookclient.FormationNotificationRequest {
+	return &webhookclient.FormationNotificationRequest{
+		Request:       webhookclient.NewRequest(fixWebhookGraphQL(), &webhook.FormationLifecycleInput{}, correlationID),
+		Operation:     operation,
+		Formation:     fixFormation(),
+		FormationType: templateName,
+	}
+}
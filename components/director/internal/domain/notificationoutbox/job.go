@@ -0,0 +1,33 @@
+package notificationoutbox
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/cronjob"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+// Dispatcher delivers the notifications from the outbox
+type Dispatcher interface {
+	Dispatch(ctx context.Context) (int, error)
+}
+
+// StartDispatchJob starts the job which delivers the notifications from the outbox and blocks.
+// The job runs on every instance, as the due notifications are locked with SKIP LOCKED and are not shared between the instances.
+func StartDispatchJob(ctx context.Context, cfg Config, dispatcher Dispatcher) error {
+	dispatchJob := cronjob.CronJob{
+		Name: "DispatchNotificationOutbox",
+		Fn: func(jobCtx context.Context) {
+			sent, err := dispatcher.Dispatch(jobCtx)
+			if err != nil {
+				log.C(jobCtx).WithError(err).Error("Failed to dispatch the notification outbox")
+				return
+			}
+			if sent > 0 {
+				log.C(jobCtx).Infof("Delivered %d notifications from the outbox", sent)
+			}
+		},
+		SchedulePeriod: cfg.DispatchInterval,
+	}
+	return cronjob.RunCronJob(ctx, cronjob.ElectionConfig{ElectionEnabled: false}, dispatchJob)
+}
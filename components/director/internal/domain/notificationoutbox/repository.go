@@ -0,0 +1,120 @@
+package notificationoutbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableName           = "public.notification_outbox"
+	idColumn            = "id"
+	statusColumn        = "status"
+	nextAttemptAtColumn = "next_attempt_at"
+	sentAtColumn        = "sent_at"
+
+	// listDueQuery locks the returned rows and skips the ones locked by other director instances,
+	// so that every instance can claim entries from the outbox concurrently without claiming an entry twice.
+	// In-flight entries whose claim has expired are returned as well, as the instance which claimed them failed to record their delivery.
+	listDueQuery = `SELECT %s FROM %s WHERE status IN ($1, $2) AND next_attempt_at <= $3 ORDER BY next_attempt_at LIMIT $4 FOR UPDATE SKIP LOCKED`
+)
+
+var (
+	tableColumns     = []string{idColumn, "webhook_id", "formation_id", "formation_assignment_id", "operation", "correlation_id", statusColumn, "attempts", nextAttemptAtColumn, "last_error", "created_at", sentAtColumn}
+	updatableColumns = []string{statusColumn, "attempts", nextAttemptAtColumn, "last_error", sentAtColumn}
+)
+
+// EntityConverter converts between the model and the entity of a notification outbox entry
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.NotificationOutboxEntry) (*Entity, error)
+	FromEntity(in *Entity) (*model.NotificationOutboxEntry, error)
+}
+
+type repository struct {
+	creator       repo.CreatorGlobal
+	updater       repo.UpdaterGlobal
+	deleterGlobal repo.DeleterGlobal
+	conv          EntityConverter
+}
+
+// NewRepository returns a new notification outbox repository
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		creator:       repo.NewCreatorGlobal(resource.NotificationOutboxEntry, tableName, tableColumns),
+		updater:       repo.NewUpdaterGlobal(resource.NotificationOutboxEntry, tableName, updatableColumns, []string{idColumn}),
+		deleterGlobal: repo.NewDeleterGlobal(resource.NotificationOutboxEntry, tableName),
+		conv:          conv,
+	}
+}
+
+// Create persists the entry in the transaction stored in the context
+func (r *repository) Create(ctx context.Context, item *model.NotificationOutboxEntry) error {
+	if item == nil {
+		return errors.New("notification outbox entry cannot be empty")
+	}
+
+	entity, err := r.conv.ToEntity(item)
+	if err != nil {
+		return err
+	}
+
+	return r.creator.Create(ctx, entity)
+}
+
+// ListDueForUpdate returns up to limit pending or in-flight entries whose next attempt is not after the given time and locks them until the end of the transaction
+func (r *repository) ListDueForUpdate(ctx context.Context, now time.Time, limit int) ([]*model.NotificationOutboxEntry, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities EntityCollection
+	stmt := fmt.Sprintf(listDueQuery, strings.Join(tableColumns, ", "), tableName)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if err = persist.SelectContext(ctx, &entities, stmt, string(model.NotificationOutboxStatusPending), string(model.NotificationOutboxStatusInFlight), now, limit); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.NotificationOutboxEntry, resource.List, "while listing due notification outbox entries")
+	}
+
+	items := make([]*model.NotificationOutboxEntry, 0, len(entities))
+	for i := range entities {
+		item, err := r.conv.FromEntity(&entities[i])
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Update updates the delivery state of the entry
+func (r *repository) Update(ctx context.Context, item *model.NotificationOutboxEntry) error {
+	if item == nil {
+		return errors.New("notification outbox entry cannot be empty")
+	}
+
+	entity, err := r.conv.ToEntity(item)
+	if err != nil {
+		return err
+	}
+
+	return r.updater.UpdateSingleGlobal(ctx, entity)
+}
+
+// DeleteSentBefore deletes the entries which were delivered before the given time
+func (r *repository) DeleteSentBefore(ctx context.Context, before time.Time) error {
+	return r.deleterGlobal.DeleteManyGlobal(ctx, repo.Conditions{
+		repo.NewEqualCondition(statusColumn, string(model.NotificationOutboxStatusSent)),
+		repo.NewLessThanCondition(sentAtColumn, before),
+	})
+}
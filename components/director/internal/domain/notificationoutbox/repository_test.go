@@ -0,0 +1,131 @@
+package notificationoutbox_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/notificationoutbox"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/notificationoutbox/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Create(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+
+	entity := fixEntryEntity()
+	dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.notification_outbox ( id, webhook_id, formation_id, formation_assignment_id, operation, correlation_id, status, attempts, next_attempt_at, last_error, created_at, sent_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
+		WithArgs(entity.ID, entity.WebhookID, entity.FormationID, entity.FormationAssignmentID, entity.Operation, entity.CorrelationID, entity.Status, entity.Attempts, entity.NextAttemptAt, entity.LastError, entity.CreatedAt, entity.SentAt).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	conv := &automock.EntityConverter{}
+	conv.On("ToEntity", fixEntryModel()).Return(entity, nil).Once()
+	defer conv.AssertExpectations(t)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := notificationoutbox.NewRepository(conv)
+
+	// WHEN
+	err := repo.Create(ctx, fixEntryModel())
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestRepository_ListDueForUpdate(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixEntryEntity()
+		rows := sqlmock.NewRows(tableColumns).
+			AddRow(entity.ID, entity.WebhookID, entity.FormationID, entity.FormationAssignmentID, entity.Operation, entity.CorrelationID, entity.Status, entity.Attempts, entity.NextAttemptAt, entity.LastError, entity.CreatedAt, entity.SentAt)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, webhook_id, formation_id, formation_assignment_id, operation, correlation_id, status, attempts, next_attempt_at, last_error, created_at, sent_at FROM public.notification_outbox WHERE status IN ($1, $2) AND next_attempt_at <= $3 ORDER BY next_attempt_at LIMIT $4 FOR UPDATE SKIP LOCKED`)).
+			WithArgs(string(model.NotificationOutboxStatusPending), string(model.NotificationOutboxStatusInFlight), createdAt, 10).
+			WillReturnRows(rows)
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", entity).Return(fixEntryModel(), nil).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := notificationoutbox.NewRepository(conv)
+
+		// WHEN
+		result, err := repo.ListDueForUpdate(ctx, createdAt, 10)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.NotificationOutboxEntry{fixEntryModel()}, result)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(`SELECT .* FROM public\.notification_outbox .* FOR UPDATE SKIP LOCKED`).
+			WithArgs(string(model.NotificationOutboxStatusPending), string(model.NotificationOutboxStatusInFlight), createdAt, 10).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := notificationoutbox.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.ListDueForUpdate(ctx, createdAt, 10)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+
+	entity := fixEntryEntity()
+	dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.notification_outbox SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, sent_at = ? WHERE id = ?`)).
+		WithArgs(entity.Status, entity.Attempts, entity.NextAttemptAt, entity.LastError, entity.SentAt, entity.ID).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	conv := &automock.EntityConverter{}
+	conv.On("ToEntity", fixEntryModel()).Return(entity, nil).Once()
+	defer conv.AssertExpectations(t)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := notificationoutbox.NewRepository(conv)
+
+	// WHEN
+	err := repo.Update(ctx, fixEntryModel())
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestRepository_DeleteSentBefore(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+
+	dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.notification_outbox WHERE status = $1 AND sent_at < $2`)).
+		WithArgs(string(model.NotificationOutboxStatusSent), createdAt).
+		WillReturnResult(sqlmock.NewResult(-1, 3))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := notificationoutbox.NewRepository(nil)
+
+	// WHEN
+	err := repo.DeleteSentBefore(ctx, createdAt)
+
+	// THEN
+	require.NoError(t, err)
+}
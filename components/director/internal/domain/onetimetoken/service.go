@@ -275,6 +275,17 @@ func (s *service) getTokenFromAdapter(ctx context.Context, adapterURL string, ap
 		return nil, err
 	}
 
+	appLabels, err := s.appSvc.ListLabels(ctx, app.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing labels for application with ID %s", app.ID)
+	}
+	labels := make(map[string]interface{}, len(appLabels))
+	for key, label := range appLabels {
+		if label != nil {
+			labels[key] = label.Value
+		}
+	}
+
 	graphqlApp := s.appConverter.ToGraphQL(&app)
 	data := pairing.RequestData{
 		Application:    *graphqlApp,
@@ -282,6 +293,7 @@ func (s *service) getTokenFromAdapter(ctx context.Context, adapterURL string, ap
 		TenantType:     tnt.Type,
 		ClientUser:     clientUser,
 		ScenarioGroups: scenarioGroups,
+		Labels:         labels,
 	}
 
 	asJSON, err := json.Marshal(data)
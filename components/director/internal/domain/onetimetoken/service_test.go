@@ -382,6 +382,7 @@ func TestGenerateOneTimeToken(t *testing.T) {
 				app.IntegrationSystemID = str.Ptr(integrationSystemID)
 				appSvc := &automock.ApplicationService{}
 				appSvc.On("Get", ctxWithGlobalAccountAndScenarioGroups, appID).Return(app, nil)
+				appSvc.On("ListLabels", ctxWithGlobalAccountAndScenarioGroups, appID).Return(map[string]*model.Label{"applicationType": {Key: "applicationType", Value: "SAP S/4HANA"}}, nil)
 				return appSvc
 			},
 			appConverter: func() onetimetoken.ApplicationConverter {
@@ -422,8 +423,9 @@ func TestGenerateOneTimeToken(t *testing.T) {
 					clientUserMatches := appData.ClientUser == ""
 					appIDMatches := appData.Application.ID == appID
 					urlMatches := req.URL.String() == "https://my-integration-service.url"
+					labelsMatch := appData.Labels["applicationType"] == "SAP S/4HANA"
 
-					return urlMatches && appIDMatches && tenantMatches && clientUserMatches
+					return urlMatches && appIDMatches && tenantMatches && clientUserMatches && labelsMatch
 				})).Return(response, nil)
 				return mockHTTPClient
 			},
@@ -454,6 +456,7 @@ func TestGenerateOneTimeToken(t *testing.T) {
 				app.IntegrationSystemID = str.Ptr(integrationSystemID)
 				appSvc := &automock.ApplicationService{}
 				appSvc.On("Get", ctxWithSubaccountAndScenarioGroups, appID).Return(app, nil)
+				appSvc.On("ListLabels", ctxWithSubaccountAndScenarioGroups, appID).Return(map[string]*model.Label{}, nil)
 				return appSvc
 			},
 			appConverter: func() onetimetoken.ApplicationConverter {
@@ -527,6 +530,7 @@ func TestGenerateOneTimeToken(t *testing.T) {
 				app.IntegrationSystemID = str.Ptr(integrationSystemID)
 				appSvc := &automock.ApplicationService{}
 				appSvc.On("Get", ctxGlobalAccountWithoutExternalTenantAndScenarioGroups, appID).Return(app, nil)
+				appSvc.On("ListLabels", ctxGlobalAccountWithoutExternalTenantAndScenarioGroups, appID).Return(map[string]*model.Label{}, nil)
 				return appSvc
 			},
 			appConverter: func() onetimetoken.ApplicationConverter {
@@ -598,6 +602,7 @@ func TestGenerateOneTimeToken(t *testing.T) {
 				app.IntegrationSystemID = str.Ptr(integrationSystemID)
 				appSvc := &automock.ApplicationService{}
 				appSvc.On("Get", contextGlobalAccountWithEnabledSuggestionAndScenarioGroups, appID).Return(app, nil)
+				appSvc.On("ListLabels", contextGlobalAccountWithEnabledSuggestionAndScenarioGroups, appID).Return(map[string]*model.Label{}, nil)
 				return appSvc
 			},
 			appConverter: func() onetimetoken.ApplicationConverter {
@@ -707,6 +712,7 @@ func TestGenerateOneTimeToken(t *testing.T) {
 				}
 				appSvc := &automock.ApplicationService{}
 				appSvc.On("Get", ctxWithSubaccount, appID).Return(app, nil)
+				appSvc.On("ListLabels", ctxWithSubaccount, appID).Return(map[string]*model.Label{}, nil)
 				return appSvc
 			},
 			appConverter: func() onetimetoken.ApplicationConverter {
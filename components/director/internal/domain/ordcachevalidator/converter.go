@@ -0,0 +1,45 @@
+package ordcachevalidator
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+)
+
+type converter struct{}
+
+// NewConverter returns a new Converter used for conversion between repository and service representation of ORD document cache validators
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the service model to repository entity
+func (c *converter) ToEntity(in *model.ORDDocumentCacheValidator) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:           in.ID,
+		WebhookID:    in.WebhookID,
+		ResourceID:   in.ResourceID,
+		DocumentURL:  in.DocumentURL,
+		ETag:         repo.NewNullableString(in.ETag),
+		LastModified: repo.NewNullableString(in.LastModified),
+	}
+}
+
+// FromEntity converts the repository entity to service model
+func (c *converter) FromEntity(entity *Entity) *model.ORDDocumentCacheValidator {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.ORDDocumentCacheValidator{
+		ID:           entity.ID,
+		WebhookID:    entity.WebhookID,
+		ResourceID:   entity.ResourceID,
+		DocumentURL:  entity.DocumentURL,
+		ETag:         repo.StringPtrFromNullableString(entity.ETag),
+		LastModified: repo.StringPtrFromNullableString(entity.LastModified),
+	}
+}
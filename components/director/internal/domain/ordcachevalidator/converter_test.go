@@ -0,0 +1,79 @@
+package ordcachevalidator_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordcachevalidator"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *model.ORDDocumentCacheValidator
+		Expected *ordcachevalidator.Entity
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixCacheValidatorModel(validatorID),
+			Expected: fixCacheValidatorEntity(validatorID),
+		},
+		{
+			Name:     "Empty",
+			Input:    &model.ORDDocumentCacheValidator{},
+			Expected: &ordcachevalidator.Entity{},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conv := ordcachevalidator.NewConverter()
+
+			// WHEN
+			res := conv.ToEntity(testCase.Input)
+
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *ordcachevalidator.Entity
+		Expected *model.ORDDocumentCacheValidator
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixCacheValidatorEntity(validatorID),
+			Expected: fixCacheValidatorModel(validatorID),
+		},
+		{
+			Name:     "Empty",
+			Input:    &ordcachevalidator.Entity{},
+			Expected: &model.ORDDocumentCacheValidator{},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conv := ordcachevalidator.NewConverter()
+
+			// WHEN
+			res := conv.FromEntity(testCase.Input)
+
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package ordcachevalidator
+
+import "database/sql"
+
+// Entity represents the HTTP cache validators of an ORD document as an entity
+type Entity struct {
+	ID           string         `db:"id"`
+	WebhookID    string         `db:"webhook_id"`
+	ResourceID   string         `db:"resource_id"`
+	DocumentURL  string         `db:"document_url"`
+	ETag         sql.NullString `db:"etag"`
+	LastModified sql.NullString `db:"last_modified"`
+}
+
+// EntityCollection is a collection of ORD document cache validator entities
+type EntityCollection []*Entity
+
+// Len is implementation of a repo.Collection interface
+func (a EntityCollection) Len() int {
+	return len(a)
+}
@@ -0,0 +1,51 @@
+package ordcachevalidator_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordcachevalidator"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+var (
+	validatorID  = "684aa2a7-3b96-4374-936a-bb758d631b6b"
+	webhookID    = "11111111-2222-3333-4444-555555555555"
+	resourceID   = "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"
+	documentURL  = "https://example.com/open-resource-discovery/v1/documents/example1"
+	etag         = `"33a64df551425fcc55e4d42a148795d9f25f89d4"`
+	lastModified = "Wed, 21 Oct 2015 07:28:00 GMT"
+	testError    = errors.New("test error")
+)
+
+func fixCacheValidatorModel(id string) *model.ORDDocumentCacheValidator {
+	return &model.ORDDocumentCacheValidator{
+		ID:           id,
+		WebhookID:    webhookID,
+		ResourceID:   resourceID,
+		DocumentURL:  documentURL,
+		ETag:         str.Ptr(etag),
+		LastModified: str.Ptr(lastModified),
+	}
+}
+
+func fixCacheValidatorEntity(id string) *ordcachevalidator.Entity {
+	return &ordcachevalidator.Entity{
+		ID:           id,
+		WebhookID:    webhookID,
+		ResourceID:   resourceID,
+		DocumentURL:  documentURL,
+		ETag:         sql.NullString{String: etag, Valid: true},
+		LastModified: sql.NullString{String: lastModified, Valid: true},
+	}
+}
+
+func fixCacheValidatorColumns() []string {
+	return []string{"id", "webhook_id", "resource_id", "document_url", "etag", "last_modified"}
+}
+
+func fixCacheValidatorCreateArgs(entity ordcachevalidator.Entity) []driver.Value {
+	return []driver.Value{entity.ID, entity.WebhookID, entity.ResourceID, entity.DocumentURL, entity.ETag, entity.LastModified}
+}
@@ -0,0 +1,72 @@
+package ordcachevalidator
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const tableName string = `public.ord_document_cache_validators`
+
+var (
+	webhookIDColumn    = "webhook_id"
+	resourceIDColumn   = "resource_id"
+	tableColumns       = []string{"id", "webhook_id", "resource_id", "document_url", "etag", "last_modified"}
+	conflictingColumns = []string{"webhook_id", "resource_id", "document_url"}
+	updateColumns      = []string{"etag", "last_modified"}
+)
+
+// EntityConverter converts between the service model and entity
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.ORDDocumentCacheValidator) *Entity
+	FromEntity(entity *Entity) *model.ORDDocumentCacheValidator
+}
+
+type repository struct {
+	upserter     repo.UpserterGlobal
+	listerGlobal repo.ListerGlobal
+	conv         EntityConverter
+}
+
+// NewRepository creates a new ORD document cache validators repository
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		upserter:     repo.NewUpserterGlobal(resource.ORDDocumentCacheValidator, tableName, tableColumns, conflictingColumns, updateColumns),
+		listerGlobal: repo.NewListerGlobal(resource.ORDDocumentCacheValidator, tableName, tableColumns),
+		conv:         conv,
+	}
+}
+
+// ListByWebhookIDAndResourceID returns the cache validators of all ORD documents fetched through the given webhook for the given resource
+func (r *repository) ListByWebhookIDAndResourceID(ctx context.Context, webhookID, resourceID string) ([]*model.ORDDocumentCacheValidator, error) {
+	var entityCollection EntityCollection
+
+	conditions := repo.Conditions{
+		repo.NewEqualCondition(webhookIDColumn, webhookID),
+		repo.NewEqualCondition(resourceIDColumn, resourceID),
+	}
+	if err := r.listerGlobal.ListGlobal(ctx, &entityCollection, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entityCollection), nil
+}
+
+// Upsert updates the cache validators of an ORD document or creates new ones if they don't exist
+func (r *repository) Upsert(ctx context.Context, in *model.ORDDocumentCacheValidator) error {
+	return r.upserter.UpsertGlobal(ctx, r.conv.ToEntity(in))
+}
+
+func (r *repository) multipleFromEntities(entities EntityCollection) []*model.ORDDocumentCacheValidator {
+	items := make([]*model.ORDDocumentCacheValidator, 0, len(entities))
+
+	for _, entity := range entities {
+		items = append(items, r.conv.FromEntity(entity))
+	}
+
+	return items
+}
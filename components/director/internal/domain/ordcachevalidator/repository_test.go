@@ -0,0 +1,108 @@
+package ordcachevalidator_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordcachevalidator"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordcachevalidator/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListByWebhookIDAndResourceID(t *testing.T) {
+	entity := fixCacheValidatorEntity(validatorID)
+	validatorModel := fixCacheValidatorModel(validatorID)
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List ORD document cache validators by webhook and resource",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, webhook_id, resource_id, document_url, etag, last_modified FROM public.ord_document_cache_validators WHERE webhook_id = $1 AND resource_id = $2`),
+				Args:     []driver.Value{webhookID, resourceID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{
+						sqlmock.NewRows(fixCacheValidatorColumns()).AddRow(entity.ID, entity.WebhookID, entity.ResourceID, entity.DocumentURL, entity.ETag, entity.LastModified),
+					}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCacheValidatorColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       ordcachevalidator.NewRepository,
+		ExpectedDBEntities:        []interface{}{entity},
+		ExpectedModelEntities:     []interface{}{validatorModel},
+		MethodArgs:                []interface{}{webhookID, resourceID},
+		MethodName:                "ListByWebhookIDAndResourceID",
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_Upsert(t *testing.T) {
+	upsertQuery := regexp.QuoteMeta(`INSERT INTO public.ord_document_cache_validators ( id, webhook_id, resource_id, document_url, etag, last_modified ) VALUES ( ?, ?, ?, ?, ?, ? ) ON CONFLICT ( webhook_id, resource_id, document_url ) DO UPDATE SET etag=EXCLUDED.etag, last_modified=EXCLUDED.last_modified`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		entity := fixCacheValidatorEntity(validatorID)
+		validatorModel := fixCacheValidatorModel(validatorID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", validatorModel).Return(entity).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(upsertQuery).
+			WithArgs(fixCacheValidatorCreateArgs(*entity)...).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := ordcachevalidator.NewRepository(mockConverter)
+
+		// WHEN
+		err := repository.Upsert(ctx, validatorModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when upserting ORD document cache validator", func(t *testing.T) {
+		// GIVEN
+		entity := fixCacheValidatorEntity(validatorID)
+		validatorModel := fixCacheValidatorModel(validatorID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", validatorModel).Return(entity).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(upsertQuery).
+			WithArgs(fixCacheValidatorCreateArgs(*entity)...).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := ordcachevalidator.NewRepository(mockConverter)
+
+		// WHEN
+		err := repository.Upsert(ctx, validatorModel)
+
+		// THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
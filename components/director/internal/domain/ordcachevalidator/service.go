@@ -0,0 +1,65 @@
+package ordcachevalidator
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// ORDDocumentCacheValidatorRepository represents the ORD document cache validators repository layer
+//
+//go:generate mockery --name=ORDDocumentCacheValidatorRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ORDDocumentCacheValidatorRepository interface {
+	ListByWebhookIDAndResourceID(ctx context.Context, webhookID, resourceID string) ([]*model.ORDDocumentCacheValidator, error)
+	Upsert(ctx context.Context, in *model.ORDDocumentCacheValidator) error
+}
+
+// UIDService is responsible for generating GUIDs, which will be used as internal ORD document cache validator IDs
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo       ORDDocumentCacheValidatorRepository
+	uidService UIDService
+}
+
+// NewService returns a new ORD document cache validators service
+func NewService(repo ORDDocumentCacheValidatorRepository, uidService UIDService) *service {
+	return &service{
+		repo:       repo,
+		uidService: uidService,
+	}
+}
+
+// ListByWebhookIDAndResourceID returns the cache validators of all ORD documents fetched through the given webhook for the given resource
+func (s *service) ListByWebhookIDAndResourceID(ctx context.Context, webhookID, resourceID string) ([]*model.ORDDocumentCacheValidator, error) {
+	validators, err := s.repo.ListByWebhookIDAndResourceID(ctx, webhookID, resourceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while listing the ORD document cache validators for webhook with ID %q and resource with ID %q", webhookID, resourceID)
+	}
+
+	return validators, nil
+}
+
+// Upsert updates the cache validators of an ORD document or creates new ones if they don't exist
+func (s *service) Upsert(ctx context.Context, in *model.ORDDocumentCacheValidator) error {
+	if in == nil {
+		return nil
+	}
+
+	if len(in.ID) == 0 {
+		in.ID = s.uidService.Generate()
+	}
+
+	log.C(ctx).Debugf("Upserting cache validators of ORD document %q for webhook with ID %q", in.DocumentURL, in.WebhookID)
+	if err := s.repo.Upsert(ctx, in); err != nil {
+		return errors.Wrapf(err, "error while upserting the cache validators of ORD document %q", in.DocumentURL)
+	}
+
+	return nil
+}
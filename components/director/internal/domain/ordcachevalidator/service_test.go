@@ -0,0 +1,153 @@
+package ordcachevalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordcachevalidator"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordcachevalidator/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ListByWebhookIDAndResourceID(t *testing.T) {
+	ctx := context.TODO()
+
+	validators := []*model.ORDDocumentCacheValidator{fixCacheValidatorModel(validatorID)}
+
+	testCases := []struct {
+		Name           string
+		RepositoryFn   func() *automock.ORDDocumentCacheValidatorRepository
+		ExpectedResult []*model.ORDDocumentCacheValidator
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ORDDocumentCacheValidatorRepository {
+				repo := &automock.ORDDocumentCacheValidatorRepository{}
+				repo.On("ListByWebhookIDAndResourceID", ctx, webhookID, resourceID).Return(validators, nil).Once()
+				return repo
+			},
+			ExpectedResult: validators,
+		},
+		{
+			Name: "Error when listing ORD document cache validators",
+			RepositoryFn: func() *automock.ORDDocumentCacheValidatorRepository {
+				repo := &automock.ORDDocumentCacheValidatorRepository{}
+				repo.On("ListByWebhookIDAndResourceID", ctx, webhookID, resourceID).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedErr: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			uidSvc := &automock.UIDService{}
+
+			svc := ordcachevalidator.NewService(repo, uidSvc)
+
+			// WHEN
+			result, err := svc.ListByWebhookIDAndResourceID(ctx, webhookID, resourceID)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			repo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Upsert(t *testing.T) {
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name         string
+		Input        *model.ORDDocumentCacheValidator
+		RepositoryFn func() *automock.ORDDocumentCacheValidatorRepository
+		UIDServiceFn func() *automock.UIDService
+		ExpectedErr  error
+	}{
+		{
+			Name:  "Success when the validator has an ID",
+			Input: fixCacheValidatorModel(validatorID),
+			RepositoryFn: func() *automock.ORDDocumentCacheValidatorRepository {
+				repo := &automock.ORDDocumentCacheValidatorRepository{}
+				repo.On("Upsert", ctx, fixCacheValidatorModel(validatorID)).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name:  "Success when an ID is generated",
+			Input: fixCacheValidatorModel(""),
+			RepositoryFn: func() *automock.ORDDocumentCacheValidatorRepository {
+				repo := &automock.ORDDocumentCacheValidatorRepository{}
+				repo.On("Upsert", ctx, fixCacheValidatorModel(validatorID)).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				uidSvc := &automock.UIDService{}
+				uidSvc.On("Generate").Return(validatorID).Once()
+				return uidSvc
+			},
+		},
+		{
+			Name:  "Nothing is upserted for nil input",
+			Input: nil,
+			RepositoryFn: func() *automock.ORDDocumentCacheValidatorRepository {
+				return &automock.ORDDocumentCacheValidatorRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name:  "Error when upserting ORD document cache validator",
+			Input: fixCacheValidatorModel(validatorID),
+			RepositoryFn: func() *automock.ORDDocumentCacheValidatorRepository {
+				repo := &automock.ORDDocumentCacheValidatorRepository{}
+				repo.On("Upsert", ctx, fixCacheValidatorModel(validatorID)).Return(testError).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErr: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			uidSvc := testCase.UIDServiceFn()
+
+			svc := ordcachevalidator.NewService(repo, uidSvc)
+
+			// WHEN
+			err := svc.Upsert(ctx, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package ordpage
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// ApplicationTemplateVersionService is responsible for the service-layer Application Template Version operations.
+//
+//go:generate mockery --name=ApplicationTemplateVersionService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationTemplateVersionService interface {
+	GetLatestByAppTemplateID(ctx context.Context, appTemplateID string) (*model.ApplicationTemplateVersion, error)
+}
+
+// ListPagesFunc lists a page of ORD resources for each of the given owner IDs
+type ListPagesFunc[P any] func(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]P, error)
+
+// ToGraphQLPageFunc converts a page of ORD resources from its service-layer representation to the graphql-layer representation
+type ToGraphQLPageFunc[P, G any] func(page P) (G, error)
+
+// Resolver contains the resolver-layer logic shared by the ORD resources which are listed as pages of applications and application templates.
+// P is the service-layer page type and G is the graphql-layer page type of the ORD resource.
+type Resolver[P, G any] struct {
+	transact              persistence.Transactioner
+	appTemplateVersionSvc ApplicationTemplateVersionService
+	listPages             ListPagesFunc[P]
+	toGraphQLPage         ToGraphQLPageFunc[P, G]
+	emptyPage             func() G
+}
+
+// NewResolver returns a new object responsible for listing pages of an ORD resource
+func NewResolver[P, G any](transact persistence.Transactioner, appTemplateVersionSvc ApplicationTemplateVersionService, listPages ListPagesFunc[P], toGraphQLPage ToGraphQLPageFunc[P, G], emptyPage func() G) *Resolver[P, G] {
+	return &Resolver[P, G]{
+		transact:              transact,
+		appTemplateVersionSvc: appTemplateVersionSvc,
+		listPages:             listPages,
+		toGraphQLPage:         toGraphQLPage,
+		emptyPage:             emptyPage,
+	}
+}
+
+// ApplicationPages retrieves a page of ORD resources for each of the given Application IDs. It is meant to be used by the dataloaders.
+func (r *Resolver[P, G]) ApplicationPages(ctx context.Context, applicationIDs []string, first *int, after *graphql.PageCursor) ([]G, []error) {
+	if first == nil {
+		return nil, []error{apperrors.NewInvalidDataError("missing required parameter 'first'")}
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	pages, err := r.listPages(ctx, resource.Application, applicationIDs, *first, cursorFrom(after))
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	gqlPages := make([]G, 0, len(pages))
+	for _, page := range pages {
+		gqlPage, err := r.toGraphQLPage(page)
+		if err != nil {
+			return nil, []error{err}
+		}
+		gqlPages = append(gqlPages, gqlPage)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	return gqlPages, nil
+}
+
+// ApplicationTemplatePage fetches a page of the ORD resources of the latest version of an Application Template
+func (r *Resolver[P, G]) ApplicationTemplatePage(ctx context.Context, appTemplateID string, first *int, after *graphql.PageCursor) (G, error) {
+	var noPage G
+	if first == nil {
+		return noPage, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return noPage, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	appTemplateVersion, err := r.appTemplateVersionSvc.GetLatestByAppTemplateID(ctx, appTemplateID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return r.emptyPage(), tx.Commit()
+		}
+		return noPage, err
+	}
+
+	pages, err := r.listPages(ctx, resource.ApplicationTemplateVersion, []string{appTemplateVersion.ID}, *first, cursorFrom(after))
+	if err != nil {
+		return noPage, err
+	}
+
+	gqlPage, err := r.toGraphQLPage(pages[0])
+	if err != nil {
+		return noPage, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return noPage, err
+	}
+
+	return gqlPage, nil
+}
+
+// ConvertData converts the ORD resources of a page one by one using the given converter function
+func ConvertData[M, G any](data []M, toGraphQL func(M) (G, error)) ([]G, error) {
+	gqlData := make([]G, 0, len(data))
+	for _, item := range data {
+		gqlItem, err := toGraphQL(item)
+		if err != nil {
+			return nil, err
+		}
+		gqlData = append(gqlData, gqlItem)
+	}
+
+	return gqlData, nil
+}
+
+// ToGraphQLPageInfo converts the page info from its service-layer representation to the graphql-layer representation
+func ToGraphQLPageInfo(page *pagination.Page) *graphql.PageInfo {
+	return &graphql.PageInfo{
+		StartCursor: graphql.PageCursor(page.StartCursor),
+		EndCursor:   graphql.PageCursor(page.EndCursor),
+		HasNextPage: page.HasNextPage,
+	}
+}
+
+func cursorFrom(after *graphql.PageCursor) string {
+	if after == nil {
+		return ""
+	}
+	return string(*after)
+}
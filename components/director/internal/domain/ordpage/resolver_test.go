@@ -0,0 +1,309 @@
+package ordpage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpage"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpage/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	appTemplateID        = "app-template-id"
+	appTemplateVersionID = "app-template-version-id"
+	invalidPage          = "invalid"
+)
+
+type listPagesCall struct {
+	resourceType resource.Type
+	resourceIDs  []string
+	pageSize     int
+	cursor       string
+}
+
+func TestResolver_ApplicationPages(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	appIDs := []string{"app-id", "app-id-2"}
+	first := 2
+	after := graphql.PageCursor("cursor")
+
+	testCases := []struct {
+		Name              string
+		TransactionerFn   func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		First             *int
+		Pages             []string
+		ListErr           error
+		ExpectedCalls     []listPagesCall
+		ExpectedResult    []string
+		ExpectedErrString string
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			First:           &first,
+			Pages:           []string{"first", "second"},
+			ExpectedCalls:   []listPagesCall{{resourceType: resource.Application, resourceIDs: appIDs, pageSize: first, cursor: string(after)}},
+			ExpectedResult:  []string{"gql-first", "gql-second"},
+		},
+		{
+			Name:              "Returns error when first is missing",
+			TransactionerFn:   txGen.ThatDoesntStartTransaction,
+			ExpectedErrString: "missing required parameter 'first'",
+		},
+		{
+			Name:              "Returns error when transaction begin failed",
+			TransactionerFn:   txGen.ThatFailsOnBegin,
+			First:             &first,
+			ExpectedErrString: testErr.Error(),
+		},
+		{
+			Name:              "Returns error when listing the pages failed",
+			TransactionerFn:   txGen.ThatDoesntExpectCommit,
+			First:             &first,
+			ListErr:           testErr,
+			ExpectedCalls:     []listPagesCall{{resourceType: resource.Application, resourceIDs: appIDs, pageSize: first, cursor: string(after)}},
+			ExpectedErrString: testErr.Error(),
+		},
+		{
+			Name:              "Returns error when converting a page failed",
+			TransactionerFn:   txGen.ThatDoesntExpectCommit,
+			First:             &first,
+			Pages:             []string{"first", invalidPage},
+			ExpectedCalls:     []listPagesCall{{resourceType: resource.Application, resourceIDs: appIDs, pageSize: first, cursor: string(after)}},
+			ExpectedErrString: testErr.Error(),
+		},
+		{
+			Name:              "Returns error when transaction commit failed",
+			TransactionerFn:   txGen.ThatFailsOnCommit,
+			First:             &first,
+			Pages:             []string{"first", "second"},
+			ExpectedCalls:     []listPagesCall{{resourceType: resource.Application, resourceIDs: appIDs, pageSize: first, cursor: string(after)}},
+			ExpectedErrString: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			appTemplateVersionSvc := &automock.ApplicationTemplateVersionService{}
+
+			calls := make([]listPagesCall, 0)
+			listPages := func(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]string, error) {
+				calls = append(calls, listPagesCall{resourceType: resourceType, resourceIDs: resourceIDs, pageSize: pageSize, cursor: cursor})
+				return testCase.Pages, testCase.ListErr
+			}
+
+			resolver := ordpage.NewResolver(transact, appTemplateVersionSvc, listPages, toGraphQLPage(testErr), emptyPage)
+
+			// WHEN
+			result, errs := resolver.ApplicationPages(context.TODO(), appIDs, testCase.First, &after)
+
+			// THEN
+			if testCase.ExpectedErrString != "" {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErrString)
+				assert.Nil(t, result)
+			} else {
+				require.Nil(t, errs)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+			if testCase.ExpectedCalls != nil {
+				assert.Equal(t, testCase.ExpectedCalls, calls)
+			} else {
+				assert.Empty(t, calls)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, appTemplateVersionSvc)
+		})
+	}
+}
+
+func TestResolver_ApplicationTemplatePage(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	appTemplateVersion := &model.ApplicationTemplateVersion{ID: appTemplateVersionID}
+	expectedCalls := []listPagesCall{{resourceType: resource.ApplicationTemplateVersion, resourceIDs: []string{appTemplateVersionID}, pageSize: first}}
+
+	appTemplateVersionSvcFn := func(version *model.ApplicationTemplateVersion, err error) func() *automock.ApplicationTemplateVersionService {
+		return func() *automock.ApplicationTemplateVersionService {
+			svc := &automock.ApplicationTemplateVersionService{}
+			svc.On("GetLatestByAppTemplateID", txtest.CtxWithDBMatcher(), appTemplateID).Return(version, err).Once()
+			return svc
+		}
+	}
+
+	testCases := []struct {
+		Name                    string
+		TransactionerFn         func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppTemplateVersionSvcFn func() *automock.ApplicationTemplateVersionService
+		First                   *int
+		Pages                   []string
+		ListErr                 error
+		ExpectedCalls           []listPagesCall
+		ExpectedResult          string
+		ExpectedErrString       string
+	}{
+		{
+			Name:                    "Success",
+			TransactionerFn:         txGen.ThatSucceeds,
+			AppTemplateVersionSvcFn: appTemplateVersionSvcFn(appTemplateVersion, nil),
+			First:                   &first,
+			Pages:                   []string{"first"},
+			ExpectedCalls:           expectedCalls,
+			ExpectedResult:          "gql-first",
+		},
+		{
+			Name:                    "Returns empty page when the Application Template has no versions",
+			TransactionerFn:         txGen.ThatSucceeds,
+			AppTemplateVersionSvcFn: appTemplateVersionSvcFn(nil, apperrors.NewNotFoundError(resource.ApplicationTemplateVersion, appTemplateID)),
+			First:                   &first,
+			ExpectedResult:          "empty",
+		},
+		{
+			Name:              "Returns error when first is missing",
+			TransactionerFn:   txGen.ThatDoesntStartTransaction,
+			ExpectedErrString: "missing required parameter 'first'",
+		},
+		{
+			Name:              "Returns error when transaction begin failed",
+			TransactionerFn:   txGen.ThatFailsOnBegin,
+			First:             &first,
+			ExpectedErrString: testErr.Error(),
+		},
+		{
+			Name:                    "Returns error when getting the latest Application Template Version failed",
+			TransactionerFn:         txGen.ThatDoesntExpectCommit,
+			AppTemplateVersionSvcFn: appTemplateVersionSvcFn(nil, testErr),
+			First:                   &first,
+			ExpectedErrString:       testErr.Error(),
+		},
+		{
+			Name:                    "Returns error when listing the pages failed",
+			TransactionerFn:         txGen.ThatDoesntExpectCommit,
+			AppTemplateVersionSvcFn: appTemplateVersionSvcFn(appTemplateVersion, nil),
+			First:                   &first,
+			ListErr:                 testErr,
+			ExpectedCalls:           expectedCalls,
+			ExpectedErrString:       testErr.Error(),
+		},
+		{
+			Name:                    "Returns error when converting the page failed",
+			TransactionerFn:         txGen.ThatDoesntExpectCommit,
+			AppTemplateVersionSvcFn: appTemplateVersionSvcFn(appTemplateVersion, nil),
+			First:                   &first,
+			Pages:                   []string{invalidPage},
+			ExpectedCalls:           expectedCalls,
+			ExpectedErrString:       testErr.Error(),
+		},
+		{
+			Name:                    "Returns error when transaction commit failed",
+			TransactionerFn:         txGen.ThatFailsOnCommit,
+			AppTemplateVersionSvcFn: appTemplateVersionSvcFn(appTemplateVersion, nil),
+			First:                   &first,
+			Pages:                   []string{"first"},
+			ExpectedCalls:           expectedCalls,
+			ExpectedErrString:       testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			appTemplateVersionSvc := &automock.ApplicationTemplateVersionService{}
+			if testCase.AppTemplateVersionSvcFn != nil {
+				appTemplateVersionSvc = testCase.AppTemplateVersionSvcFn()
+			}
+
+			calls := make([]listPagesCall, 0)
+			listPages := func(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]string, error) {
+				calls = append(calls, listPagesCall{resourceType: resourceType, resourceIDs: resourceIDs, pageSize: pageSize, cursor: cursor})
+				return testCase.Pages, testCase.ListErr
+			}
+
+			resolver := ordpage.NewResolver(transact, appTemplateVersionSvc, listPages, toGraphQLPage(testErr), emptyPage)
+
+			// WHEN
+			result, err := resolver.ApplicationTemplatePage(context.TODO(), appTemplateID, testCase.First, nil)
+
+			// THEN
+			if testCase.ExpectedErrString != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrString)
+				assert.Empty(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+			if testCase.ExpectedCalls != nil {
+				assert.Equal(t, testCase.ExpectedCalls, calls)
+			} else {
+				assert.Empty(t, calls)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, appTemplateVersionSvc)
+		})
+	}
+}
+
+func TestConvertData(t *testing.T) {
+	testErr := errors.New("test error")
+	toGraphQL := func(in string) (string, error) {
+		if in == invalidPage {
+			return "", testErr
+		}
+		return "gql-" + in, nil
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		result, err := ordpage.ConvertData([]string{"first", "second"}, toGraphQL)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gql-first", "gql-second"}, result)
+	})
+
+	t.Run("Returns empty data for an empty page", func(t *testing.T) {
+		result, err := ordpage.ConvertData(nil, toGraphQL)
+		require.NoError(t, err)
+		assert.Equal(t, []string{}, result)
+	})
+
+	t.Run("Returns error when converting an item failed", func(t *testing.T) {
+		result, err := ordpage.ConvertData([]string{"first", invalidPage}, toGraphQL)
+		require.EqualError(t, err, testErr.Error())
+		assert.Nil(t, result)
+	})
+}
+
+func TestToGraphQLPageInfo(t *testing.T) {
+	result := ordpage.ToGraphQLPageInfo(&pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: true})
+	assert.Equal(t, &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: true}, result)
+}
+
+func toGraphQLPage(testErr error) ordpage.ToGraphQLPageFunc[string, string] {
+	return func(page string) (string, error) {
+		if page == invalidPage {
+			return "", testErr
+		}
+		return "gql-" + page, nil
+	}
+}
+
+func emptyPage() string {
+	return "empty"
+}
@@ -0,0 +1,55 @@
+package ordpushedpayload
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+type converter struct{}
+
+// NewConverter returns a new Converter used for conversion between repository and service representation of pushed ORD payloads
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the service model to repository entity
+func (c *converter) ToEntity(in *model.ORDPushedPayload) (*Entity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	files, err := json.Marshal(in.Files)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling the pushed ORD files")
+	}
+
+	return &Entity{
+		ID:            in.ID,
+		ApplicationID: in.ApplicationID,
+		Configuration: string(in.Configuration),
+		Files:         string(files),
+		CreatedAt:     in.CreatedAt,
+	}, nil
+}
+
+// FromEntity converts the repository entity to service model
+func (c *converter) FromEntity(entity *Entity) (*model.ORDPushedPayload, error) {
+	if entity == nil {
+		return nil, nil
+	}
+
+	files := make(map[string]string)
+	if err := json.Unmarshal([]byte(entity.Files), &files); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling the pushed ORD files")
+	}
+
+	return &model.ORDPushedPayload{
+		ID:            entity.ID,
+		ApplicationID: entity.ApplicationID,
+		Configuration: []byte(entity.Configuration),
+		Files:         files,
+		CreatedAt:     entity.CreatedAt,
+	}, nil
+}
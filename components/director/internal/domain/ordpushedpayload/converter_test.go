@@ -0,0 +1,86 @@
+package ordpushedpayload_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpushedpayload"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *model.ORDPushedPayload
+		Expected *ordpushedpayload.Entity
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixPayloadModel(payloadID),
+			Expected: fixPayloadEntity(payloadID),
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conv := ordpushedpayload.NewConverter()
+
+			// WHEN
+			res, err := conv.ToEntity(testCase.Input)
+
+			// THEN
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Input       *ordpushedpayload.Entity
+		Expected    *model.ORDPushedPayload
+		ExpectedErr string
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixPayloadEntity(payloadID),
+			Expected: fixPayloadModel(payloadID),
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+		{
+			Name:        "Error when files are not a valid JSON object",
+			Input:       &ordpushedpayload.Entity{ID: payloadID, Files: "[]"},
+			ExpectedErr: "while unmarshalling the pushed ORD files",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conv := ordpushedpayload.NewConverter()
+
+			// WHEN
+			res, err := conv.FromEntity(testCase.Input)
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package ordpushedpayload
+
+import "time"
+
+// Entity represents an ORD payload pushed by a system as an entity
+type Entity struct {
+	ID            string    `db:"id"`
+	ApplicationID string    `db:"application_id"`
+	Configuration string    `db:"configuration"`
+	Files         string    `db:"files"`
+	CreatedAt     time.Time `db:"created_at"`
+}
@@ -0,0 +1,59 @@
+package ordpushedpayload_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpushedpayload"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+var (
+	payloadID     = "684aa2a7-3b96-4374-936a-bb758d631b6b"
+	appID         = "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"
+	configuration = `{"openResourceDiscoveryV1":{"documents":[{"url":"/open-resource-discovery/v1/documents/example1"}]}}`
+	documentURL   = "/open-resource-discovery/v1/documents/example1"
+	document      = `{"openResourceDiscovery":"1.9"}`
+	specURL       = "/specs/api.json"
+	spec          = `{"openapi":"3.0.0"}`
+	filesJSON     = `{"/open-resource-discovery/v1/documents/example1":"{\"openResourceDiscovery\":\"1.9\"}","/specs/api.json":"{\"openapi\":\"3.0.0\"}"}`
+	createdAt     = time.Date(2024, 7, 29, 10, 0, 0, 0, time.UTC)
+	testError     = errors.New("test error")
+)
+
+func fixPayloadModel(id string) *model.ORDPushedPayload {
+	return &model.ORDPushedPayload{
+		ID:            id,
+		ApplicationID: appID,
+		Configuration: json.RawMessage(configuration),
+		Files:         fixFiles(),
+		CreatedAt:     createdAt,
+	}
+}
+
+func fixPayloadEntity(id string) *ordpushedpayload.Entity {
+	return &ordpushedpayload.Entity{
+		ID:            id,
+		ApplicationID: appID,
+		Configuration: configuration,
+		Files:         filesJSON,
+		CreatedAt:     createdAt,
+	}
+}
+
+func fixFiles() map[string]string {
+	return map[string]string{
+		documentURL: document,
+		specURL:     spec,
+	}
+}
+
+func fixPayloadColumns() []string {
+	return []string{"id", "application_id", "configuration", "files", "created_at"}
+}
+
+func fixPayloadCreateArgs(entity ordpushedpayload.Entity) []driver.Value {
+	return []driver.Value{entity.ID, entity.ApplicationID, entity.Configuration, entity.Files, entity.CreatedAt}
+}
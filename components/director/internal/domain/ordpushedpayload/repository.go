@@ -0,0 +1,66 @@
+package ordpushedpayload
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const tableName string = `public.ord_pushed_payloads`
+
+var (
+	idColumn     = "id"
+	tableColumns = []string{"id", "application_id", "configuration", "files", "created_at"}
+)
+
+// EntityConverter converts between the service model and entity
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.ORDPushedPayload) (*Entity, error)
+	FromEntity(entity *Entity) (*model.ORDPushedPayload, error)
+}
+
+type repository struct {
+	creator      repo.CreatorGlobal
+	singleGetter repo.SingleGetterGlobal
+	deleter      repo.DeleterGlobal
+	conv         EntityConverter
+}
+
+// NewRepository creates a new pushed ORD payloads repository
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		creator:      repo.NewCreatorGlobal(resource.ORDPushedPayload, tableName, tableColumns),
+		singleGetter: repo.NewSingleGetterGlobal(resource.ORDPushedPayload, tableName, tableColumns),
+		deleter:      repo.NewDeleterGlobal(resource.ORDPushedPayload, tableName),
+		conv:         conv,
+	}
+}
+
+// Create stores a new pushed ORD payload
+func (r *repository) Create(ctx context.Context, in *model.ORDPushedPayload) error {
+	entity, err := r.conv.ToEntity(in)
+	if err != nil {
+		return err
+	}
+
+	return r.creator.Create(ctx, entity)
+}
+
+// GetByID returns the pushed ORD payload with the given ID
+func (r *repository) GetByID(ctx context.Context, id string) (*model.ORDPushedPayload, error) {
+	var entity Entity
+	if err := r.singleGetter.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition(idColumn, id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity)
+}
+
+// Delete deletes the pushed ORD payload with the given ID
+func (r *repository) Delete(ctx context.Context, id string) error {
+	return r.deleter.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition(idColumn, id)})
+}
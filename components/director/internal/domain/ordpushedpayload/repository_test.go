@@ -0,0 +1,179 @@
+package ordpushedpayload_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpushedpayload"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpushedpayload/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Create(t *testing.T) {
+	createQuery := regexp.QuoteMeta(`INSERT INTO public.ord_pushed_payloads ( id, application_id, configuration, files, created_at ) VALUES ( ?, ?, ?, ?, ? )`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		entity := fixPayloadEntity(payloadID)
+		payloadModel := fixPayloadModel(payloadID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", payloadModel).Return(entity, nil).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(createQuery).
+			WithArgs(fixPayloadCreateArgs(*entity)...).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := ordpushedpayload.NewRepository(mockConverter)
+
+		// WHEN
+		err := repository.Create(ctx, payloadModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when converting to entity", func(t *testing.T) {
+		// GIVEN
+		payloadModel := fixPayloadModel(payloadID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", payloadModel).Return(nil, testError).Once()
+
+		repository := ordpushedpayload.NewRepository(mockConverter)
+
+		// WHEN
+		err := repository.Create(context.TODO(), payloadModel)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+
+	t.Run("Error when executing the query", func(t *testing.T) {
+		// GIVEN
+		entity := fixPayloadEntity(payloadID)
+		payloadModel := fixPayloadModel(payloadID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", payloadModel).Return(entity, nil).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(createQuery).
+			WithArgs(fixPayloadCreateArgs(*entity)...).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := ordpushedpayload.NewRepository(mockConverter)
+
+		// WHEN
+		err := repository.Create(ctx, payloadModel)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_GetByID(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, application_id, configuration, files, created_at FROM public.ord_pushed_payloads WHERE id = $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		entity := fixPayloadEntity(payloadID)
+		payloadModel := fixPayloadModel(payloadID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", entity).Return(payloadModel, nil).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(fixPayloadColumns()).AddRow(entity.ID, entity.ApplicationID, entity.Configuration, entity.Files, entity.CreatedAt)
+		dbMock.ExpectQuery(selectQuery).WithArgs(payloadID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := ordpushedpayload.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repository.GetByID(ctx, payloadID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, payloadModel, result)
+	})
+
+	t.Run("Error when executing the query", func(t *testing.T) {
+		// GIVEN
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(selectQuery).WithArgs(payloadID).WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := ordpushedpayload.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repository.GetByID(ctx, payloadID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestRepository_Delete(t *testing.T) {
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM public.ord_pushed_payloads WHERE id = $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(deleteQuery).WithArgs(payloadID).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := ordpushedpayload.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		err := repository.Delete(ctx, payloadID)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when executing the query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(deleteQuery).WithArgs(payloadID).WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := ordpushedpayload.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		err := repository.Delete(ctx, payloadID)
+
+		// THEN
+		require.Error(t, err)
+	})
+}
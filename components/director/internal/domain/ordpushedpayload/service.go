@@ -0,0 +1,80 @@
+package ordpushedpayload
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// ORDPushedPayloadRepository represents the pushed ORD payloads repository layer
+//
+//go:generate mockery --name=ORDPushedPayloadRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ORDPushedPayloadRepository interface {
+	Create(ctx context.Context, in *model.ORDPushedPayload) error
+	GetByID(ctx context.Context, id string) (*model.ORDPushedPayload, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// UIDService is responsible for generating GUIDs, which will be used as internal pushed ORD payload IDs
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo         ORDPushedPayloadRepository
+	uidService   UIDService
+	timestampGen timestamp.Generator
+}
+
+// NewService returns a new pushed ORD payloads service
+func NewService(repo ORDPushedPayloadRepository, uidService UIDService) *service {
+	return &service{
+		repo:         repo,
+		uidService:   uidService,
+		timestampGen: timestamp.DefaultGenerator,
+	}
+}
+
+// Create stores the ORD configuration and files pushed by the application with the given ID and returns the ID of the stored payload
+func (s *service) Create(ctx context.Context, appID string, configuration json.RawMessage, files map[string]string) (string, error) {
+	id := s.uidService.Generate()
+	payload := &model.ORDPushedPayload{
+		ID:            id,
+		ApplicationID: appID,
+		Configuration: configuration,
+		Files:         files,
+		CreatedAt:     s.timestampGen(),
+	}
+
+	log.C(ctx).Debugf("Storing pushed ORD payload with ID %q and %d files for application with ID %q", id, len(files), appID)
+	if err := s.repo.Create(ctx, payload); err != nil {
+		return "", errors.Wrapf(err, "error while storing the pushed ORD payload for application with ID %q", appID)
+	}
+
+	return id, nil
+}
+
+// Get returns the pushed ORD payload with the given ID
+func (s *service) Get(ctx context.Context, id string) (*model.ORDPushedPayload, error) {
+	payload, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while getting pushed ORD payload with ID %q", id)
+	}
+
+	return payload, nil
+}
+
+// Delete deletes the pushed ORD payload with the given ID
+func (s *service) Delete(ctx context.Context, id string) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return errors.Wrapf(err, "error while deleting pushed ORD payload with ID %q", id)
+	}
+
+	return nil
+}
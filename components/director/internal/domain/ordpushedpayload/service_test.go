@@ -0,0 +1,172 @@
+package ordpushedpayload_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpushedpayload"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpushedpayload/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	ctx := context.TODO()
+
+	payloadMatcher := mock.MatchedBy(func(in *model.ORDPushedPayload) bool {
+		return in.ID == payloadID && in.ApplicationID == appID && string(in.Configuration) == configuration && assert.ObjectsAreEqual(fixFiles(), in.Files) && !in.CreatedAt.IsZero()
+	})
+
+	testCases := []struct {
+		Name           string
+		RepositoryFn   func() *automock.ORDPushedPayloadRepository
+		ExpectedResult string
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ORDPushedPayloadRepository {
+				repo := &automock.ORDPushedPayloadRepository{}
+				repo.On("Create", ctx, payloadMatcher).Return(nil).Once()
+				return repo
+			},
+			ExpectedResult: payloadID,
+		},
+		{
+			Name: "Error when storing the payload",
+			RepositoryFn: func() *automock.ORDPushedPayloadRepository {
+				repo := &automock.ORDPushedPayloadRepository{}
+				repo.On("Create", ctx, payloadMatcher).Return(testError).Once()
+				return repo
+			},
+			ExpectedErr: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(payloadID).Once()
+
+			svc := ordpushedpayload.NewService(repo, uidSvc)
+
+			// WHEN
+			result, err := svc.Create(ctx, appID, json.RawMessage(configuration), fixFiles())
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			repo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name           string
+		RepositoryFn   func() *automock.ORDPushedPayloadRepository
+		ExpectedResult *model.ORDPushedPayload
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ORDPushedPayloadRepository {
+				repo := &automock.ORDPushedPayloadRepository{}
+				repo.On("GetByID", ctx, payloadID).Return(fixPayloadModel(payloadID), nil).Once()
+				return repo
+			},
+			ExpectedResult: fixPayloadModel(payloadID),
+		},
+		{
+			Name: "Error when getting the payload",
+			RepositoryFn: func() *automock.ORDPushedPayloadRepository {
+				repo := &automock.ORDPushedPayloadRepository{}
+				repo.On("GetByID", ctx, payloadID).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedErr: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := ordpushedpayload.NewService(repo, &automock.UIDService{})
+
+			// WHEN
+			result, err := svc.Get(ctx, payloadID)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.ORDPushedPayloadRepository
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ORDPushedPayloadRepository {
+				repo := &automock.ORDPushedPayloadRepository{}
+				repo.On("Delete", ctx, payloadID).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when deleting the payload",
+			RepositoryFn: func() *automock.ORDPushedPayloadRepository {
+				repo := &automock.ORDPushedPayloadRepository{}
+				repo.On("Delete", ctx, payloadID).Return(testError).Once()
+				return repo
+			},
+			ExpectedErr: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := ordpushedpayload.NewService(repo, &automock.UIDService{})
+
+			// WHEN
+			err := svc.Delete(ctx, payloadID)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -174,6 +174,32 @@ func (_m *VendorRepository) ListByResourceID(ctx context.Context, tenantID strin
 	return r0, r1
 }
 
+// ListByResourceIDs provides a mock function with given fields: ctx, tenantID, resourceType, resourceIDs, pageSize, cursor
+func (_m *VendorRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.VendorPage, error) {
+	ret := _m.Called(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+
+	var r0 []*model.VendorPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.Type, []string, int, string) ([]*model.VendorPage, error)); ok {
+		return rf(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.Type, []string, int, string) []*model.VendorPage); ok {
+		r0 = rf(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.VendorPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, resource.Type, []string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListGlobal provides a mock function with given fields: ctx
 func (_m *VendorRepository) ListGlobal(ctx context.Context) ([]*model.Vendor, error) {
 	ret := _m.Called(ctx)
@@ -1,8 +1,11 @@
 package ordvendor
 
 import (
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -56,3 +59,27 @@ func (c *converter) FromEntity(entity *Entity) (*model.Vendor, error) {
 
 	return output, nil
 }
+
+// ToGraphQL converts the provided service-layer representation of a Vendor to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.Vendor) (*graphql.Vendor, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var labels graphql.Labels
+	if in.Labels != nil {
+		if err := json.Unmarshal(in.Labels, &labels); err != nil {
+			return nil, err
+		}
+	}
+
+	return &graphql.Vendor{
+		ID:                  in.ID,
+		OrdID:               in.OrdID,
+		Title:               in.Title,
+		Partners:            graphql.JSONPtrFromRawMessage(in.Partners),
+		Tags:                graphql.JSONPtrFromRawMessage(in.Tags),
+		Labels:              labels,
+		DocumentationLabels: graphql.JSONPtrFromRawMessage(in.DocumentationLabels),
+	}, nil
+}
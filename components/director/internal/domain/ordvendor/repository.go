@@ -7,11 +7,17 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 )
 
-const vendorTable string = `public.vendors`
+const (
+	vendorTable                string = `public.vendors`
+	idColumn                   string = "id"
+	appIDColumn                string = "app_id"
+	appTemplateVersionIDColumn string = "app_template_version_id"
+)
 
 var (
 	vendorColumns    = []string{"ord_id", "app_id", "app_template_version_id", "title", "labels", "partners", "id", "tags", "documentation_labels"}
@@ -39,6 +45,8 @@ type pgRepository struct {
 	creatorGlobal      repo.CreatorGlobal
 	updater            repo.Updater
 	updaterGlobal      repo.UpdaterGlobal
+	unionLister        repo.UnionLister
+	unionListerGlobal  repo.UnionListerGlobal
 }
 
 // NewRepository creates a new instance of repository
@@ -56,6 +64,8 @@ func NewRepository(conv EntityConverter) *pgRepository {
 		creatorGlobal:      repo.NewCreatorGlobal(resource.Vendor, vendorTable, vendorColumns),
 		updater:            repo.NewUpdater(vendorTable, updatableColumns, []string{"id"}),
 		updaterGlobal:      repo.NewUpdaterGlobal(resource.Vendor, vendorTable, updatableColumns, []string{"id"}),
+		unionLister:        repo.NewUnionLister(vendorTable, vendorColumns),
+		unionListerGlobal:  repo.NewUnionListerGlobal(resource.Vendor, vendorTable, vendorColumns),
 	}
 }
 
@@ -191,6 +201,64 @@ func (r *pgRepository) ListGlobal(ctx context.Context) ([]*model.Vendor, error)
 	return vendors, nil
 }
 
+// ListByResourceIDs gets a page of vendors for each of the given resource IDs. The resources are either applications or application template versions
+func (r *pgRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.VendorPage, error) {
+	vendorCollection := vendorCollection{}
+
+	var counts map[string]int
+	var err error
+	if resourceType == resource.Application {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionLister.List(ctx, resource.Vendor, tenantID, resourceIDs, appIDColumn, pageSize, cursor, orderByColumns, &vendorCollection)
+	} else {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appTemplateVersionIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionListerGlobal.ListGlobal(ctx, resourceIDs, appTemplateVersionIDColumn, pageSize, cursor, orderByColumns, &vendorCollection)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vendorsByResourceID := make(map[string][]*model.Vendor, len(resourceIDs))
+	for _, vendor := range vendorCollection {
+		vendorModel, err := r.conv.FromEntity(&vendor)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceID := vendor.ApplicationID.String
+		if resourceType != resource.Application {
+			resourceID = vendor.ApplicationTemplateVersionID.String
+		}
+		vendorsByResourceID[resourceID] = append(vendorsByResourceID[resourceID], vendorModel)
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	vendorPages := make([]*model.VendorPage, 0, len(resourceIDs))
+	for _, resourceID := range resourceIDs {
+		totalCount := counts[resourceID]
+		hasNextPage := false
+		endCursor := ""
+		if totalCount > offset+len(vendorsByResourceID[resourceID]) {
+			hasNextPage = true
+			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		}
+
+		page := &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		}
+
+		vendorPages = append(vendorPages, &model.VendorPage{Data: vendorsByResourceID[resourceID], TotalCount: totalCount, PageInfo: page})
+	}
+
+	return vendorPages, nil
+}
+
 type vendorCollection []Entity
 
 // Len missing godoc
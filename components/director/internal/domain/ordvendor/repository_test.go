@@ -10,7 +10,9 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 func TestPgRepository_Create(t *testing.T) {
@@ -341,3 +343,126 @@ func TestPgRepository_ListGlobal(t *testing.T) {
 
 	suite.Run(t)
 }
+
+func TestPgRepository_ListByResourceIDs(t *testing.T) {
+	pageSize := 2
+	cursor := ""
+	emptyPageResourceID := "emptyPageResourceID"
+
+	suiteForApplication := testdb.RepoListPageableTestSuite{
+		Name: "List Vendors for multiple Applications with paging",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`(SELECT ord_id, app_id, app_template_version_id, title, labels, partners, id, tags, documentation_labels FROM public.vendors WHERE (id IN (SELECT id FROM vendors_tenants WHERE tenant_id = $1)) AND app_id = $2 ORDER BY app_id ASC, id ASC LIMIT $3 OFFSET $4) UNION (SELECT ord_id, app_id, app_template_version_id, title, labels, partners, id, tags, documentation_labels FROM public.vendors WHERE (id IN (SELECT id FROM vendors_tenants WHERE tenant_id = $5)) AND app_id = $6 ORDER BY app_id ASC, id ASC LIMIT $7 OFFSET $8)`),
+				Args:     []driver.Value{tenantID, emptyPageResourceID, pageSize, 0, tenantID, appID, pageSize, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixVendorColumns()).AddRow(fixVendorRowWithTitleForApp("title1")...).AddRow(fixVendorRowWithTitleForApp("title2")...)}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT app_id AS id, COUNT(*) AS total_count FROM public.vendors WHERE (id IN (SELECT id FROM vendors_tenants WHERE tenant_id = $1)) AND app_id IN ($2, $3) GROUP BY app_id ORDER BY app_id ASC`),
+				Args:     []driver.Value{tenantID, emptyPageResourceID, appID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow(emptyPageResourceID, 0).AddRow(appID, 2)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: nil,
+				ExpectedDBEntities:    nil,
+				ExpectedPage: &model.VendorPage{
+					Data: nil,
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 0,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{fixVendorModelWithTitleForApp("title1"), fixVendorModelWithTitleForApp("title2")},
+				ExpectedDBEntities:    []interface{}{fixEntityVendorWithTitleForApp("title1"), fixEntityVendorWithTitleForApp("title2")},
+				ExpectedPage: &model.VendorPage{
+					Data: []*model.Vendor{fixVendorModelWithTitleForApp("title1"), fixVendorModelWithTitleForApp("title2")},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       ordvendor.NewRepository,
+		MethodName:                "ListByResourceIDs",
+		MethodArgs:                []interface{}{tenantID, resource.Application, []string{emptyPageResourceID, appID}, pageSize, cursor},
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForApplicationTemplateVersion := testdb.RepoListPageableTestSuite{
+		Name: "List Vendors for multiple Application Template Versions with paging",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`(SELECT ord_id, app_id, app_template_version_id, title, labels, partners, id, tags, documentation_labels FROM public.vendors WHERE app_template_version_id = $1 ORDER BY app_template_version_id ASC, id ASC LIMIT $2 OFFSET $3) UNION (SELECT ord_id, app_id, app_template_version_id, title, labels, partners, id, tags, documentation_labels FROM public.vendors WHERE app_template_version_id = $4 ORDER BY app_template_version_id ASC, id ASC LIMIT $5 OFFSET $6)`),
+				Args:     []driver.Value{emptyPageResourceID, pageSize, 0, appTemplateVersionID, pageSize, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixVendorColumns()).AddRow(fixVendorRowWithTitleForAppTemplateVersion("title1")...).AddRow(fixVendorRowWithTitleForAppTemplateVersion("title2")...)}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT app_template_version_id AS id, COUNT(*) AS total_count FROM public.vendors WHERE app_template_version_id IN ($1, $2) GROUP BY app_template_version_id ORDER BY app_template_version_id ASC`),
+				Args:     []driver.Value{emptyPageResourceID, appTemplateVersionID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow(emptyPageResourceID, 0).AddRow(appTemplateVersionID, 2)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: nil,
+				ExpectedDBEntities:    nil,
+				ExpectedPage: &model.VendorPage{
+					Data: nil,
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 0,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{fixVendorModelWithTitleForAppTemplateVersion("title1"), fixVendorModelWithTitleForAppTemplateVersion("title2")},
+				ExpectedDBEntities:    []interface{}{fixEntityVendorWithTitleForAppTemplateVersion("title1"), fixEntityVendorWithTitleForAppTemplateVersion("title2")},
+				ExpectedPage: &model.VendorPage{
+					Data: []*model.Vendor{fixVendorModelWithTitleForAppTemplateVersion("title1"), fixVendorModelWithTitleForAppTemplateVersion("title2")},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       ordvendor.NewRepository,
+		MethodName:                "ListByResourceIDs",
+		MethodArgs:                []interface{}{tenantID, resource.ApplicationTemplateVersion, []string{emptyPageResourceID, appTemplateVersionID}, pageSize, cursor},
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForApplication.Run(t)
+	suiteForApplicationTemplateVersion.Run(t)
+}
@@ -0,0 +1,80 @@
+package ordvendor
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpage"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// VendorService is responsible for the service-layer Vendor operations.
+//
+//go:generate mockery --name=VendorService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type VendorService interface {
+	ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.VendorPage, error)
+}
+
+// GraphQLConverter converts Vendors from the model.Vendor service-layer representation to the graphql-layer representation.
+//
+//go:generate mockery --name=GraphQLConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLConverter interface {
+	ToGraphQL(in *model.Vendor) (*graphql.Vendor, error)
+}
+
+// Resolver is an object responsible for resolver-layer Vendor operations.
+type Resolver struct {
+	pages *ordpage.Resolver[*model.VendorPage, *graphql.VendorPage]
+}
+
+// NewResolver returns a new object responsible for resolver-layer Vendor operations.
+func NewResolver(transact persistence.Transactioner, vendorSvc VendorService, vendorConverter GraphQLConverter, appTemplateVersionSvc ordpage.ApplicationTemplateVersionService) *Resolver {
+	toGraphQLPage := func(page *model.VendorPage) (*graphql.VendorPage, error) {
+		data, err := ordpage.ConvertData(page.Data, vendorConverter.ToGraphQL)
+		if err != nil {
+			return nil, err
+		}
+
+		return &graphql.VendorPage{
+			Data:       data,
+			TotalCount: page.TotalCount,
+			PageInfo:   ordpage.ToGraphQLPageInfo(page.PageInfo),
+		}, nil
+	}
+	emptyPage := func() *graphql.VendorPage {
+		return &graphql.VendorPage{Data: []*graphql.Vendor{}, PageInfo: &graphql.PageInfo{}}
+	}
+
+	return &Resolver{
+		pages: ordpage.NewResolver(transact, appTemplateVersionSvc, vendorSvc.ListByResourceIDs, toGraphQLPage, emptyPage),
+	}
+}
+
+// ApplicationVendors fetches a page of vendors for an Application
+func (r *Resolver) ApplicationVendors(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.VendorPage, error) {
+	param := dataloader.ParamVendor{ID: obj.ID, Ctx: ctx, First: first, After: after}
+	return dataloader.VendorFor(ctx).VendorByID.Load(param)
+}
+
+// ApplicationVendorsDataLoader retrieves a page of vendors for each Application ID in the keys argument
+func (r *Resolver) ApplicationVendorsDataLoader(keys []dataloader.ParamVendor) ([]*graphql.VendorPage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	return r.pages.ApplicationPages(keys[0].Ctx, applicationIDs, keys[0].First, keys[0].After)
+}
+
+// ApplicationTemplateVendors fetches a page of vendors of the latest version of an Application Template
+func (r *Resolver) ApplicationTemplateVendors(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.VendorPage, error) {
+	return r.pages.ApplicationTemplatePage(ctx, obj.ID, first, after)
+}
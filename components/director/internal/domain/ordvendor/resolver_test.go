@@ -0,0 +1,112 @@
+package ordvendor_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	ordpageautomock "github.com/kyma-incubator/compass/components/director/internal/domain/ordpage/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// The paging, transaction handling and error cases are covered by the tests of the ordpage package
+func TestResolver_ApplicationVendorsDataLoader(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	firstAppID := "appID"
+	secondAppID := "appID2"
+	first := 2
+	after := graphql.PageCursor("test")
+
+	vendorFirstApp := &model.Vendor{ApplicationID: &firstAppID}
+	vendorSecondApp := &model.Vendor{ApplicationID: &secondAppID}
+	gqlVendorFirstApp := &graphql.Vendor{ID: "vendorID"}
+	gqlVendorSecondApp := &graphql.Vendor{ID: "vendorID2"}
+
+	pageInfo := &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: true}
+	gqlPageInfo := &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: true}
+
+	persist, transact := txGen.ThatSucceeds()
+
+	svc := &automock.VendorService{}
+	svc.On("ListByResourceIDs", txtest.CtxWithDBMatcher(), resource.Application, []string{firstAppID, secondAppID}, first, string(after)).Return([]*model.VendorPage{
+		{Data: []*model.Vendor{vendorFirstApp}, PageInfo: pageInfo, TotalCount: 1},
+		{Data: []*model.Vendor{vendorSecondApp}, PageInfo: pageInfo, TotalCount: 1},
+	}, nil).Once()
+
+	conv := &automock.GraphQLConverter{}
+	conv.On("ToGraphQL", vendorFirstApp).Return(gqlVendorFirstApp, nil).Once()
+	conv.On("ToGraphQL", vendorSecondApp).Return(gqlVendorSecondApp, nil).Once()
+
+	appTemplateVersionSvc := &ordpageautomock.ApplicationTemplateVersionService{}
+	defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv, appTemplateVersionSvc)
+
+	resolver := ordvendor.NewResolver(transact, svc, conv, appTemplateVersionSvc)
+	keys := []dataloader.ParamVendor{
+		{ID: firstAppID, Ctx: context.TODO(), First: &first, After: &after},
+		{ID: secondAppID, Ctx: context.TODO(), First: &first, After: &after},
+	}
+
+	// WHEN
+	result, errs := resolver.ApplicationVendorsDataLoader(keys)
+
+	// THEN
+	require.Nil(t, errs)
+	assert.Equal(t, []*graphql.VendorPage{
+		{Data: []*graphql.Vendor{gqlVendorFirstApp}, PageInfo: gqlPageInfo, TotalCount: 1},
+		{Data: []*graphql.Vendor{gqlVendorSecondApp}, PageInfo: gqlPageInfo, TotalCount: 1},
+	}, result)
+
+	t.Run("Returns error when there are no Applications", func(t *testing.T) {
+		result, errs := ordvendor.NewResolver(nil, svc, conv, nil).ApplicationVendorsDataLoader([]dataloader.ParamVendor{})
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Applications found")
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_ApplicationTemplateVendors(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	appTemplateID := "appTemplateID"
+	versionID := "versionID"
+	first := 2
+
+	vendorOfVersion := &model.Vendor{ApplicationTemplateVersionID: &versionID}
+	gqlVendorOfVersion := &graphql.Vendor{ID: "vendorID"}
+
+	persist, transact := txGen.ThatSucceeds()
+
+	appTemplateVersionSvc := &ordpageautomock.ApplicationTemplateVersionService{}
+	appTemplateVersionSvc.On("GetLatestByAppTemplateID", txtest.CtxWithDBMatcher(), appTemplateID).Return(&model.ApplicationTemplateVersion{ID: versionID, ApplicationTemplateID: appTemplateID}, nil).Once()
+
+	svc := &automock.VendorService{}
+	svc.On("ListByResourceIDs", txtest.CtxWithDBMatcher(), resource.ApplicationTemplateVersion, []string{versionID}, first, "").Return([]*model.VendorPage{
+		{Data: []*model.Vendor{vendorOfVersion}, PageInfo: &pagination.Page{}, TotalCount: 1},
+	}, nil).Once()
+
+	conv := &automock.GraphQLConverter{}
+	conv.On("ToGraphQL", vendorOfVersion).Return(gqlVendorOfVersion, nil).Once()
+	defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv, appTemplateVersionSvc)
+
+	resolver := ordvendor.NewResolver(transact, svc, conv, appTemplateVersionSvc)
+
+	// WHEN
+	result, err := resolver.ApplicationTemplateVendors(context.TODO(), &graphql.ApplicationTemplate{ID: appTemplateID}, &first, nil)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, &graphql.VendorPage{Data: []*graphql.Vendor{gqlVendorOfVersion}, PageInfo: &graphql.PageInfo{}, TotalCount: 1}, result)
+}
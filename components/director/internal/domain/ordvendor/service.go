@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pkg/errors"
 )
@@ -25,6 +26,7 @@ type VendorRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.Vendor, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.Vendor, error)
 	ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*model.Vendor, error)
+	ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.VendorPage, error)
 	ListGlobal(ctx context.Context) ([]*model.Vendor, error)
 }
 
@@ -172,6 +174,24 @@ func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, appTem
 	return s.vendorRepo.ListByResourceID(ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion)
 }
 
+// ListByResourceIDs lists a page of vendors for each of the given application or application template version IDs
+func (s *service) ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.VendorPage, error) {
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	if resourceType.IsTenantIgnorable() {
+		return s.vendorRepo.ListByResourceIDs(ctx, "", resourceType, resourceIDs, pageSize, cursor)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.vendorRepo.ListByResourceIDs(ctx, tnt, resourceType, resourceIDs, pageSize, cursor)
+}
+
 // ListGlobal returns a list of Global Vendors (with NULL app_id).
 func (s *service) ListGlobal(ctx context.Context) ([]*model.Vendor, error) {
 	return s.vendorRepo.ListGlobal(ctx)
@@ -174,6 +174,32 @@ func (_m *PackageRepository) ListByResourceID(ctx context.Context, tenantID stri
 	return r0, r1
 }
 
+// ListByResourceIDs provides a mock function with given fields: ctx, tenantID, resourceType, resourceIDs, pageSize, cursor
+func (_m *PackageRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error) {
+	ret := _m.Called(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+
+	var r0 []*model.PackagePage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.Type, []string, int, string) ([]*model.PackagePage, error)); ok {
+		return rf(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.Type, []string, int, string) []*model.PackagePage); ok {
+		r0 = rf(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PackagePage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, resource.Type, []string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, resourceType, resourceIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, tenant, item
 func (_m *PackageRepository) Update(ctx context.Context, tenant string, item *model.Package) error {
 	ret := _m.Called(ctx, tenant, item)
@@ -1,8 +1,11 @@
 package ordpackage
 
 import (
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -86,3 +89,48 @@ func (c *converter) FromEntity(entity *Entity) (*model.Package, error) {
 
 	return output, nil
 }
+
+// ToGraphQL converts the provided service-layer representation of a Package to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.Package) (*graphql.Package, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var labels graphql.Labels
+	if in.Labels != nil {
+		if err := json.Unmarshal(in.Labels, &labels); err != nil {
+			return nil, err
+		}
+	}
+
+	var partOfProducts []string
+	if in.PartOfProducts != nil {
+		if err := json.Unmarshal(in.PartOfProducts, &partOfProducts); err != nil {
+			return nil, err
+		}
+	}
+
+	return &graphql.Package{
+		ID:                  in.ID,
+		OrdID:               in.OrdID,
+		Vendor:              in.Vendor,
+		Title:               in.Title,
+		ShortDescription:    in.ShortDescription,
+		Description:         in.Description,
+		Version:             in.Version,
+		PackageLinks:        graphql.JSONPtrFromRawMessage(in.PackageLinks),
+		Links:               graphql.JSONPtrFromRawMessage(in.Links),
+		LicenseType:         in.LicenseType,
+		SupportInfo:         in.SupportInfo,
+		Tags:                graphql.JSONPtrFromRawMessage(in.Tags),
+		RuntimeRestriction:  in.RuntimeRestriction,
+		Countries:           graphql.JSONPtrFromRawMessage(in.Countries),
+		Labels:              labels,
+		PolicyLevel:         in.PolicyLevel,
+		CustomPolicyLevel:   in.CustomPolicyLevel,
+		PartOfProducts:      partOfProducts,
+		LineOfBusiness:      graphql.JSONPtrFromRawMessage(in.LineOfBusiness),
+		Industry:            graphql.JSONPtrFromRawMessage(in.Industry),
+		DocumentationLabels: graphql.JSONPtrFromRawMessage(in.DocumentationLabels),
+	}, nil
+}
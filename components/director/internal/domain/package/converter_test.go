@@ -1,9 +1,12 @@
 package ordpackage_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,3 +50,63 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestEntityConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		in := &model.Package{
+			ID:             "id",
+			OrdID:          "ordID",
+			Title:          "title",
+			Tags:           json.RawMessage(`["tag"]`),
+			Labels:         json.RawMessage(`{"key":["value"]}`),
+			Version:        "1.0.0",
+			PartOfProducts: json.RawMessage(`["sap:product:S4HANA_OD:"]`),
+		}
+		tags := graphql.JSON(`["tag"]`)
+		expected := &graphql.Package{
+			ID:             "id",
+			OrdID:          "ordID",
+			Title:          "title",
+			Tags:           &tags,
+			Labels:         graphql.Labels{"key": []interface{}{"value"}},
+			Version:        "1.0.0",
+			PartOfProducts: []string{"sap:product:S4HANA_OD:"},
+		}
+		conv := ordpackage.NewConverter()
+
+		// WHEN
+		result, err := conv.ToGraphQL(in)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Returns nil if package model is nil", func(t *testing.T) {
+		conv := ordpackage.NewConverter()
+
+		result, err := conv.ToGraphQL(nil)
+
+		require.NoError(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Returns error when labels are invalid", func(t *testing.T) {
+		conv := ordpackage.NewConverter()
+
+		result, err := conv.ToGraphQL(&model.Package{ID: "id", Labels: json.RawMessage("invalid")})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Returns error when part of products are invalid", func(t *testing.T) {
+		conv := ordpackage.NewConverter()
+
+		result, err := conv.ToGraphQL(&model.Package{ID: "id", PartOfProducts: json.RawMessage("invalid")})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+}
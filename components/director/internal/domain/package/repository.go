@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 )
@@ -15,6 +16,7 @@ const (
 	packageTable               = `public.packages`
 	appTemplateVersionIDColumn = "app_template_version_id"
 	appIDColumn                = "app_id"
+	idColumn                   = "id"
 )
 
 var (
@@ -46,6 +48,8 @@ type pgRepository struct {
 	creatorGlobal      repo.CreatorGlobal
 	updater            repo.Updater
 	updaterGlobal      repo.UpdaterGlobal
+	unionLister        repo.UnionLister
+	unionListerGlobal  repo.UnionListerGlobal
 }
 
 // NewRepository missing godoc
@@ -63,6 +67,8 @@ func NewRepository(conv EntityConverter) *pgRepository {
 		creatorGlobal:      repo.NewCreatorGlobal(resource.Package, packageTable, packageColumns),
 		updater:            repo.NewUpdater(packageTable, updatableColumns, []string{"id"}),
 		updaterGlobal:      repo.NewUpdaterGlobal(resource.Package, packageTable, updatableColumns, []string{"id"}),
+		unionLister:        repo.NewUnionLister(packageTable, packageColumns),
+		unionListerGlobal:  repo.NewUnionListerGlobal(resource.Package, packageTable, packageColumns),
 	}
 }
 
@@ -181,6 +187,64 @@ func (r *pgRepository) ListByResourceID(ctx context.Context, tenantID, resourceI
 	return pkgs, nil
 }
 
+// ListByResourceIDs gets a page of packages for each of the given resource IDs. The resources are either applications or application template versions
+func (r *pgRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error) {
+	pkgCollection := pkgCollection{}
+
+	var counts map[string]int
+	var err error
+	if resourceType == resource.Application {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionLister.List(ctx, resource.Package, tenantID, resourceIDs, appIDColumn, pageSize, cursor, orderByColumns, &pkgCollection)
+	} else {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appTemplateVersionIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionListerGlobal.ListGlobal(ctx, resourceIDs, appTemplateVersionIDColumn, pageSize, cursor, orderByColumns, &pkgCollection)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pkgsByResourceID := make(map[string][]*model.Package, len(resourceIDs))
+	for _, pkg := range pkgCollection {
+		pkgModel, err := r.conv.FromEntity(&pkg)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceID := pkg.ApplicationID.String
+		if resourceType != resource.Application {
+			resourceID = pkg.ApplicationTemplateVersionID.String
+		}
+		pkgsByResourceID[resourceID] = append(pkgsByResourceID[resourceID], pkgModel)
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	pkgPages := make([]*model.PackagePage, 0, len(resourceIDs))
+	for _, resourceID := range resourceIDs {
+		totalCount := counts[resourceID]
+		hasNextPage := false
+		endCursor := ""
+		if totalCount > offset+len(pkgsByResourceID[resourceID]) {
+			hasNextPage = true
+			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		}
+
+		page := &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		}
+
+		pkgPages = append(pkgPages, &model.PackagePage{Data: pkgsByResourceID[resourceID], TotalCount: totalCount, PageInfo: page})
+	}
+
+	return pkgPages, nil
+}
+
 type pkgCollection []Entity
 
 // Len missing godoc
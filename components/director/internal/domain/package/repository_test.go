@@ -11,7 +11,9 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/package/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 func TestPgRepository_Create(t *testing.T) {
@@ -315,3 +317,126 @@ func TestPgRepository_ListByResourceID(t *testing.T) {
 	suiteForApp.Run(t)
 	suiteForAppTemplateVersion.Run(t)
 }
+
+func TestPgRepository_ListByResourceIDs(t *testing.T) {
+	pageSize := 2
+	cursor := ""
+	emptyPageResourceID := "emptyPageResourceID"
+
+	suiteForApplication := testdb.RepoListPageableTestSuite{
+		Name: "List Packages for multiple Applications with paging",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`(SELECT id, app_id, app_template_version_id, ord_id, vendor, title, short_description, description, version, package_links, links, licence_type, tags, runtime_restriction, countries, labels, policy_level, custom_policy_level, part_of_products, line_of_business, industry, resource_hash, documentation_labels, support_info FROM public.packages WHERE (id IN (SELECT id FROM packages_tenants WHERE tenant_id = $1)) AND app_id = $2 ORDER BY app_id ASC, id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, app_id, app_template_version_id, ord_id, vendor, title, short_description, description, version, package_links, links, licence_type, tags, runtime_restriction, countries, labels, policy_level, custom_policy_level, part_of_products, line_of_business, industry, resource_hash, documentation_labels, support_info FROM public.packages WHERE (id IN (SELECT id FROM packages_tenants WHERE tenant_id = $5)) AND app_id = $6 ORDER BY app_id ASC, id ASC LIMIT $7 OFFSET $8)`),
+				Args:     []driver.Value{tenantID, emptyPageResourceID, pageSize, 0, tenantID, appID, pageSize, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixPackageColumns()).AddRow(fixPackageRowWithTitleForApp("title1")...).AddRow(fixPackageRowWithTitleForApp("title2")...)}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT app_id AS id, COUNT(*) AS total_count FROM public.packages WHERE (id IN (SELECT id FROM packages_tenants WHERE tenant_id = $1)) AND app_id IN ($2, $3) GROUP BY app_id ORDER BY app_id ASC`),
+				Args:     []driver.Value{tenantID, emptyPageResourceID, appID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow(emptyPageResourceID, 0).AddRow(appID, 2)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: nil,
+				ExpectedDBEntities:    nil,
+				ExpectedPage: &model.PackagePage{
+					Data: nil,
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 0,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{fixPackageModelWithTitleForApp("title1"), fixPackageModelWithTitleForApp("title2")},
+				ExpectedDBEntities:    []interface{}{fixEntityPackageWithTitleForApp("title1"), fixEntityPackageWithTitleForApp("title2")},
+				ExpectedPage: &model.PackagePage{
+					Data: []*model.Package{fixPackageModelWithTitleForApp("title1"), fixPackageModelWithTitleForApp("title2")},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       ordpackage.NewRepository,
+		MethodName:                "ListByResourceIDs",
+		MethodArgs:                []interface{}{tenantID, resource.Application, []string{emptyPageResourceID, appID}, pageSize, cursor},
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForApplicationTemplateVersion := testdb.RepoListPageableTestSuite{
+		Name: "List Packages for multiple Application Template Versions with paging",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`(SELECT id, app_id, app_template_version_id, ord_id, vendor, title, short_description, description, version, package_links, links, licence_type, tags, runtime_restriction, countries, labels, policy_level, custom_policy_level, part_of_products, line_of_business, industry, resource_hash, documentation_labels, support_info FROM public.packages WHERE app_template_version_id = $1 ORDER BY app_template_version_id ASC, id ASC LIMIT $2 OFFSET $3) UNION (SELECT id, app_id, app_template_version_id, ord_id, vendor, title, short_description, description, version, package_links, links, licence_type, tags, runtime_restriction, countries, labels, policy_level, custom_policy_level, part_of_products, line_of_business, industry, resource_hash, documentation_labels, support_info FROM public.packages WHERE app_template_version_id = $4 ORDER BY app_template_version_id ASC, id ASC LIMIT $5 OFFSET $6)`),
+				Args:     []driver.Value{emptyPageResourceID, pageSize, 0, appTemplateVersionID, pageSize, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixPackageColumns()).AddRow(fixPackageRowWithTitleForAppTemplateVersion("title1")...).AddRow(fixPackageRowWithTitleForAppTemplateVersion("title2")...)}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT app_template_version_id AS id, COUNT(*) AS total_count FROM public.packages WHERE app_template_version_id IN ($1, $2) GROUP BY app_template_version_id ORDER BY app_template_version_id ASC`),
+				Args:     []driver.Value{emptyPageResourceID, appTemplateVersionID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow(emptyPageResourceID, 0).AddRow(appTemplateVersionID, 2)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: nil,
+				ExpectedDBEntities:    nil,
+				ExpectedPage: &model.PackagePage{
+					Data: nil,
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 0,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{fixPackageModelWithTitleForAppTemplateVersion("title1"), fixPackageModelWithTitleForAppTemplateVersion("title2")},
+				ExpectedDBEntities:    []interface{}{fixEntityPackageWithTitleForAppTemplateVersion("title1"), fixEntityPackageWithTitleForAppTemplateVersion("title2")},
+				ExpectedPage: &model.PackagePage{
+					Data: []*model.Package{fixPackageModelWithTitleForAppTemplateVersion("title1"), fixPackageModelWithTitleForAppTemplateVersion("title2")},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       ordpackage.NewRepository,
+		MethodName:                "ListByResourceIDs",
+		MethodArgs:                []interface{}{tenantID, resource.ApplicationTemplateVersion, []string{emptyPageResourceID, appTemplateVersionID}, pageSize, cursor},
+		DisableConverterErrorTest: true,
+	}
+
+	suiteForApplication.Run(t)
+	suiteForApplicationTemplateVersion.Run(t)
+}
@@ -0,0 +1,80 @@
+package ordpackage
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpage"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// PackageService is responsible for the service-layer Package operations.
+//
+//go:generate mockery --name=PackageService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type PackageService interface {
+	ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error)
+}
+
+// GraphQLConverter converts Packages from the model.Package service-layer representation to the graphql-layer representation.
+//
+//go:generate mockery --name=GraphQLConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLConverter interface {
+	ToGraphQL(in *model.Package) (*graphql.Package, error)
+}
+
+// Resolver is an object responsible for resolver-layer Package operations.
+type Resolver struct {
+	pages *ordpage.Resolver[*model.PackagePage, *graphql.PackagePage]
+}
+
+// NewResolver returns a new object responsible for resolver-layer Package operations.
+func NewResolver(transact persistence.Transactioner, pkgSvc PackageService, pkgConverter GraphQLConverter, appTemplateVersionSvc ordpage.ApplicationTemplateVersionService) *Resolver {
+	toGraphQLPage := func(page *model.PackagePage) (*graphql.PackagePage, error) {
+		data, err := ordpage.ConvertData(page.Data, pkgConverter.ToGraphQL)
+		if err != nil {
+			return nil, err
+		}
+
+		return &graphql.PackagePage{
+			Data:       data,
+			TotalCount: page.TotalCount,
+			PageInfo:   ordpage.ToGraphQLPageInfo(page.PageInfo),
+		}, nil
+	}
+	emptyPage := func() *graphql.PackagePage {
+		return &graphql.PackagePage{Data: []*graphql.Package{}, PageInfo: &graphql.PageInfo{}}
+	}
+
+	return &Resolver{
+		pages: ordpage.NewResolver(transact, appTemplateVersionSvc, pkgSvc.ListByResourceIDs, toGraphQLPage, emptyPage),
+	}
+}
+
+// ApplicationPackages fetches a page of packages for an Application
+func (r *Resolver) ApplicationPackages(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.PackagePage, error) {
+	param := dataloader.ParamPackage{ID: obj.ID, Ctx: ctx, First: first, After: after}
+	return dataloader.PackageFor(ctx).PackageByID.Load(param)
+}
+
+// ApplicationPackagesDataLoader retrieves a page of packages for each Application ID in the keys argument
+func (r *Resolver) ApplicationPackagesDataLoader(keys []dataloader.ParamPackage) ([]*graphql.PackagePage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	return r.pages.ApplicationPages(keys[0].Ctx, applicationIDs, keys[0].First, keys[0].After)
+}
+
+// ApplicationTemplatePackages fetches a page of packages of the latest version of an Application Template
+func (r *Resolver) ApplicationTemplatePackages(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.PackagePage, error) {
+	return r.pages.ApplicationTemplatePage(ctx, obj.ID, first, after)
+}
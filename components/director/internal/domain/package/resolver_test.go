@@ -0,0 +1,112 @@
+package ordpackage_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	ordpageautomock "github.com/kyma-incubator/compass/components/director/internal/domain/ordpage/automock"
+	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/package/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// The paging, transaction handling and error cases are covered by the tests of the ordpage package
+func TestResolver_ApplicationPackagesDataLoader(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	firstAppID := "appID"
+	secondAppID := "appID2"
+	first := 2
+	after := graphql.PageCursor("test")
+
+	pkgFirstApp := &model.Package{ApplicationID: &firstAppID}
+	pkgSecondApp := &model.Package{ApplicationID: &secondAppID}
+	gqlPkgFirstApp := &graphql.Package{ID: "pkgID"}
+	gqlPkgSecondApp := &graphql.Package{ID: "pkgID2"}
+
+	pageInfo := &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: true}
+	gqlPageInfo := &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: true}
+
+	persist, transact := txGen.ThatSucceeds()
+
+	svc := &automock.PackageService{}
+	svc.On("ListByResourceIDs", txtest.CtxWithDBMatcher(), resource.Application, []string{firstAppID, secondAppID}, first, string(after)).Return([]*model.PackagePage{
+		{Data: []*model.Package{pkgFirstApp}, PageInfo: pageInfo, TotalCount: 1},
+		{Data: []*model.Package{pkgSecondApp}, PageInfo: pageInfo, TotalCount: 1},
+	}, nil).Once()
+
+	conv := &automock.GraphQLConverter{}
+	conv.On("ToGraphQL", pkgFirstApp).Return(gqlPkgFirstApp, nil).Once()
+	conv.On("ToGraphQL", pkgSecondApp).Return(gqlPkgSecondApp, nil).Once()
+
+	appTemplateVersionSvc := &ordpageautomock.ApplicationTemplateVersionService{}
+	defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv, appTemplateVersionSvc)
+
+	resolver := ordpackage.NewResolver(transact, svc, conv, appTemplateVersionSvc)
+	keys := []dataloader.ParamPackage{
+		{ID: firstAppID, Ctx: context.TODO(), First: &first, After: &after},
+		{ID: secondAppID, Ctx: context.TODO(), First: &first, After: &after},
+	}
+
+	// WHEN
+	result, errs := resolver.ApplicationPackagesDataLoader(keys)
+
+	// THEN
+	require.Nil(t, errs)
+	assert.Equal(t, []*graphql.PackagePage{
+		{Data: []*graphql.Package{gqlPkgFirstApp}, PageInfo: gqlPageInfo, TotalCount: 1},
+		{Data: []*graphql.Package{gqlPkgSecondApp}, PageInfo: gqlPageInfo, TotalCount: 1},
+	}, result)
+
+	t.Run("Returns error when there are no Applications", func(t *testing.T) {
+		result, errs := ordpackage.NewResolver(nil, svc, conv, nil).ApplicationPackagesDataLoader([]dataloader.ParamPackage{})
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Applications found")
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_ApplicationTemplatePackages(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	appTemplateID := "appTemplateID"
+	versionID := "versionID"
+	first := 2
+
+	pkgOfVersion := &model.Package{ApplicationTemplateVersionID: &versionID}
+	gqlPkgOfVersion := &graphql.Package{ID: "pkgID"}
+
+	persist, transact := txGen.ThatSucceeds()
+
+	appTemplateVersionSvc := &ordpageautomock.ApplicationTemplateVersionService{}
+	appTemplateVersionSvc.On("GetLatestByAppTemplateID", txtest.CtxWithDBMatcher(), appTemplateID).Return(&model.ApplicationTemplateVersion{ID: versionID, ApplicationTemplateID: appTemplateID}, nil).Once()
+
+	svc := &automock.PackageService{}
+	svc.On("ListByResourceIDs", txtest.CtxWithDBMatcher(), resource.ApplicationTemplateVersion, []string{versionID}, first, "").Return([]*model.PackagePage{
+		{Data: []*model.Package{pkgOfVersion}, PageInfo: &pagination.Page{}, TotalCount: 1},
+	}, nil).Once()
+
+	conv := &automock.GraphQLConverter{}
+	conv.On("ToGraphQL", pkgOfVersion).Return(gqlPkgOfVersion, nil).Once()
+	defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv, appTemplateVersionSvc)
+
+	resolver := ordpackage.NewResolver(transact, svc, conv, appTemplateVersionSvc)
+
+	// WHEN
+	result, err := resolver.ApplicationTemplatePackages(context.TODO(), &graphql.ApplicationTemplate{ID: appTemplateID}, &first, nil)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, &graphql.PackagePage{Data: []*graphql.Package{gqlPkgOfVersion}, PageInfo: &graphql.PageInfo{}, TotalCount: 1}, result)
+}
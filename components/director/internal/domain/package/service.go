@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pkg/errors"
 )
@@ -25,6 +26,7 @@ type PackageRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.Package, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.Package, error)
 	ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*model.Package, error)
+	ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error)
 }
 
 // UIDService missing godoc
@@ -134,6 +136,24 @@ func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, appTem
 	return s.pkgRepo.ListByResourceID(ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion)
 }
 
+// ListByResourceIDs lists a page of packages for each of the given application or application template version IDs
+func (s *service) ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error) {
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	if resourceType.IsTenantIgnorable() {
+		return s.pkgRepo.ListByResourceIDs(ctx, "", resourceType, resourceIDs, pageSize, cursor)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pkgRepo.ListByResourceIDs(ctx, tnt, resourceType, resourceIDs, pageSize, cursor)
+}
+
 func (s *service) createPackage(ctx context.Context, pkg *model.Package, resourceType resource.Type) error {
 	if resourceType.IsTenantIgnorable() {
 		return s.pkgRepo.CreateGlobal(ctx, pkg)
@@ -10,6 +10,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/package/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -643,3 +644,120 @@ func fixUIDService() *automock.UIDService {
 	uidSvc.On("Generate").Return(packageID)
 	return uidSvc
 }
+
+func TestService_ListByResourceIDs(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	tnt := "tenant"
+	externalTnt := "external-tenant"
+	resourceIDs := []string{"id1", "id2"}
+	after := "test"
+
+	pages := []*model.PackagePage{
+		{
+			Data:       []*model.Package{{ApplicationID: &resourceIDs[0]}},
+			PageInfo:   &pagination.Page{StartCursor: "", EndCursor: "", HasNextPage: false},
+			TotalCount: 1,
+		},
+	}
+
+	ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+
+	testCases := []struct {
+		Name               string
+		Context            context.Context
+		ResourceType       resource.Type
+		PageSize           int
+		RepositoryFn       func() *automock.PackageRepository
+		ExpectedResult     []*model.PackagePage
+		ExpectedErrMessage string
+	}{
+		{
+			Name:         "Success for Application",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			PageSize:     2,
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByResourceIDs", ctx, tnt, resource.Application, resourceIDs, 2, after).Return(pages, nil).Once()
+				return repo
+			},
+			ExpectedResult: pages,
+		},
+		{
+			Name:         "Success for Application Template Version",
+			Context:      context.TODO(),
+			ResourceType: resource.ApplicationTemplateVersion,
+			PageSize:     2,
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByResourceIDs", context.TODO(), "", resource.ApplicationTemplateVersion, resourceIDs, 2, after).Return(pages, nil).Once()
+				return repo
+			},
+			ExpectedResult: pages,
+		},
+		{
+			Name:         "Returns error when page size is less than 1",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			PageSize:     0,
+			RepositoryFn: func() *automock.PackageRepository {
+				return &automock.PackageRepository{}
+			},
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name:         "Returns error when page size is bigger than 200",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			PageSize:     201,
+			RepositoryFn: func() *automock.PackageRepository {
+				return &automock.PackageRepository{}
+			},
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name:         "Returns error when tenant is missing in the context",
+			Context:      context.TODO(),
+			ResourceType: resource.Application,
+			PageSize:     2,
+			RepositoryFn: func() *automock.PackageRepository {
+				return &automock.PackageRepository{}
+			},
+			ExpectedErrMessage: "cannot read tenant from context",
+		},
+		{
+			Name:         "Returns error when Packages listing failed",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			PageSize:     2,
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByResourceIDs", ctx, tnt, resource.Application, resourceIDs, 2, after).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := ordpackage.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.ListByResourceIDs(testCase.Context, testCase.ResourceType, resourceIDs, testCase.PageSize, after)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package product
 
 import (
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -64,3 +67,31 @@ func (c *converter) FromEntity(entity *Entity) (*model.Product, error) {
 
 	return output, nil
 }
+
+// ToGraphQL converts the provided service-layer representation of a Product to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.Product) (*graphql.Product, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var labels graphql.Labels
+	if in.Labels != nil {
+		if err := json.Unmarshal(in.Labels, &labels); err != nil {
+			return nil, err
+		}
+	}
+
+	return &graphql.Product{
+		ID:                  in.ID,
+		OrdID:               in.OrdID,
+		Title:               in.Title,
+		ShortDescription:    in.ShortDescription,
+		Description:         in.Description,
+		Vendor:              in.Vendor,
+		Parent:              in.Parent,
+		CorrelationIDs:      graphql.JSONPtrFromRawMessage(in.CorrelationIDs),
+		Tags:                graphql.JSONPtrFromRawMessage(in.Tags),
+		Labels:              labels,
+		DocumentationLabels: graphql.JSONPtrFromRawMessage(in.DocumentationLabels),
+	}, nil
+}
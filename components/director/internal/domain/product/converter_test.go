@@ -1,9 +1,12 @@
 package product_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,3 +50,50 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestEntityConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		in := &model.Product{
+			ID:     "id",
+			OrdID:  "ordID",
+			Title:  "title",
+			Tags:   json.RawMessage(`["tag"]`),
+			Labels: json.RawMessage(`{"key":["value"]}`),
+		}
+		tags := graphql.JSON(`["tag"]`)
+		expected := &graphql.Product{
+			ID:     "id",
+			OrdID:  "ordID",
+			Title:  "title",
+			Tags:   &tags,
+			Labels: graphql.Labels{"key": []interface{}{"value"}},
+		}
+		conv := product.NewConverter()
+
+		// WHEN
+		result, err := conv.ToGraphQL(in)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Returns nil if product model is nil", func(t *testing.T) {
+		conv := product.NewConverter()
+
+		result, err := conv.ToGraphQL(nil)
+
+		require.NoError(t, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Returns error when labels are invalid", func(t *testing.T) {
+		conv := product.NewConverter()
+
+		result, err := conv.ToGraphQL(&model.Product{ID: "id", Labels: json.RawMessage("invalid")})
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+}
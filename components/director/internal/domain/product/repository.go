@@ -7,11 +7,17 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 )
 
-const productTable string = `public.products`
+const (
+	productTable               string = `public.products`
+	idColumn                   string = "id"
+	appIDColumn                string = "app_id"
+	appTemplateVersionIDColumn string = "app_template_version_id"
+)
 
 var (
 	productColumns   = []string{"ord_id", "app_id", "app_template_version_id", "title", "short_description", "description", "vendor", "parent", "labels", "correlation_ids", "id", "tags", "documentation_labels"}
@@ -39,6 +45,8 @@ type pgRepository struct {
 	creatorGlobal      repo.CreatorGlobal
 	updater            repo.Updater
 	updaterGlobal      repo.UpdaterGlobal
+	unionLister        repo.UnionLister
+	unionListerGlobal  repo.UnionListerGlobal
 }
 
 // NewRepository creates a new instance of product repository
@@ -56,6 +64,8 @@ func NewRepository(conv EntityConverter) *pgRepository {
 		creatorGlobal:      repo.NewCreatorGlobal(resource.Product, productTable, productColumns),
 		updater:            repo.NewUpdater(productTable, updatableColumns, []string{"id"}),
 		updaterGlobal:      repo.NewUpdaterGlobal(resource.Product, productTable, updatableColumns, []string{"id"}),
+		unionLister:        repo.NewUnionLister(productTable, productColumns),
+		unionListerGlobal:  repo.NewUnionListerGlobal(resource.Product, productTable, productColumns),
 	}
 }
 
@@ -190,6 +200,64 @@ func (r *pgRepository) ListGlobal(ctx context.Context) ([]*model.Product, error)
 	return products, nil
 }
 
+// ListByResourceIDs gets a page of products for each of the given resource IDs. The resources are either applications or application template versions
+func (r *pgRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.ProductPage, error) {
+	productCollection := productCollection{}
+
+	var counts map[string]int
+	var err error
+	if resourceType == resource.Application {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionLister.List(ctx, resource.Product, tenantID, resourceIDs, appIDColumn, pageSize, cursor, orderByColumns, &productCollection)
+	} else {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appTemplateVersionIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionListerGlobal.ListGlobal(ctx, resourceIDs, appTemplateVersionIDColumn, pageSize, cursor, orderByColumns, &productCollection)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	productsByResourceID := make(map[string][]*model.Product, len(resourceIDs))
+	for _, product := range productCollection {
+		productModel, err := r.conv.FromEntity(&product)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceID := product.ApplicationID.String
+		if resourceType != resource.Application {
+			resourceID = product.ApplicationTemplateVersionID.String
+		}
+		productsByResourceID[resourceID] = append(productsByResourceID[resourceID], productModel)
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	productPages := make([]*model.ProductPage, 0, len(resourceIDs))
+	for _, resourceID := range resourceIDs {
+		totalCount := counts[resourceID]
+		hasNextPage := false
+		endCursor := ""
+		if totalCount > offset+len(productsByResourceID[resourceID]) {
+			hasNextPage = true
+			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		}
+
+		page := &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		}
+
+		productPages = append(productPages, &model.ProductPage{Data: productsByResourceID[resourceID], TotalCount: totalCount, PageInfo: page})
+	}
+
+	return productPages, nil
+}
+
 type productCollection []Entity
 
 // Len missing godoc
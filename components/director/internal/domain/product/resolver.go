@@ -0,0 +1,80 @@
+package product
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpage"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// ProductService is responsible for the service-layer Product operations.
+//
+//go:generate mockery --name=ProductService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ProductService interface {
+	ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.ProductPage, error)
+}
+
+// GraphQLConverter converts Products from the model.Product service-layer representation to the graphql-layer representation.
+//
+//go:generate mockery --name=GraphQLConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLConverter interface {
+	ToGraphQL(in *model.Product) (*graphql.Product, error)
+}
+
+// Resolver is an object responsible for resolver-layer Product operations.
+type Resolver struct {
+	pages *ordpage.Resolver[*model.ProductPage, *graphql.ProductPage]
+}
+
+// NewResolver returns a new object responsible for resolver-layer Product operations.
+func NewResolver(transact persistence.Transactioner, productSvc ProductService, productConverter GraphQLConverter, appTemplateVersionSvc ordpage.ApplicationTemplateVersionService) *Resolver {
+	toGraphQLPage := func(page *model.ProductPage) (*graphql.ProductPage, error) {
+		data, err := ordpage.ConvertData(page.Data, productConverter.ToGraphQL)
+		if err != nil {
+			return nil, err
+		}
+
+		return &graphql.ProductPage{
+			Data:       data,
+			TotalCount: page.TotalCount,
+			PageInfo:   ordpage.ToGraphQLPageInfo(page.PageInfo),
+		}, nil
+	}
+	emptyPage := func() *graphql.ProductPage {
+		return &graphql.ProductPage{Data: []*graphql.Product{}, PageInfo: &graphql.PageInfo{}}
+	}
+
+	return &Resolver{
+		pages: ordpage.NewResolver(transact, appTemplateVersionSvc, productSvc.ListByResourceIDs, toGraphQLPage, emptyPage),
+	}
+}
+
+// ApplicationProducts fetches a page of products for an Application
+func (r *Resolver) ApplicationProducts(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.ProductPage, error) {
+	param := dataloader.ParamProduct{ID: obj.ID, Ctx: ctx, First: first, After: after}
+	return dataloader.ProductFor(ctx).ProductByID.Load(param)
+}
+
+// ApplicationProductsDataLoader retrieves a page of products for each Application ID in the keys argument
+func (r *Resolver) ApplicationProductsDataLoader(keys []dataloader.ParamProduct) ([]*graphql.ProductPage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	return r.pages.ApplicationPages(keys[0].Ctx, applicationIDs, keys[0].First, keys[0].After)
+}
+
+// ApplicationTemplateProducts fetches a page of products of the latest version of an Application Template
+func (r *Resolver) ApplicationTemplateProducts(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.ProductPage, error) {
+	return r.pages.ApplicationTemplatePage(ctx, obj.ID, first, after)
+}
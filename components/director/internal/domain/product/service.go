@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pkg/errors"
 )
@@ -25,6 +26,7 @@ type ProductRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.Product, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.Product, error)
 	ListByResourceID(ctx context.Context, tenantID, appID string, resourceType resource.Type) ([]*model.Product, error)
+	ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.ProductPage, error)
 	ListGlobal(ctx context.Context) ([]*model.Product, error)
 }
 
@@ -171,6 +173,24 @@ func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, appID
 	return s.productRepo.ListByResourceID(ctx, "", appID, resource.ApplicationTemplateVersion)
 }
 
+// ListByResourceIDs lists a page of products for each of the given application or application template version IDs
+func (s *service) ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.ProductPage, error) {
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	if resourceType.IsTenantIgnorable() {
+		return s.productRepo.ListByResourceIDs(ctx, "", resourceType, resourceIDs, pageSize, cursor)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.productRepo.ListByResourceIDs(ctx, tnt, resourceType, resourceIDs, pageSize, cursor)
+}
+
 // ListGlobal returns a list of global products (with NULL app_id).
 func (s *service) ListGlobal(ctx context.Context) ([]*model.Product, error) {
 	return s.productRepo.ListGlobal(ctx)
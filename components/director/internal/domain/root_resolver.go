@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/accesspolicy"
 	assignmentOp "github.com/kyma-incubator/compass/components/director/internal/domain/assignmentoperation"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/operation"
@@ -15,6 +16,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/aspect"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency"
 	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
 
 	ordapiclient "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/apiclient"
 	systemfielddiscoveryapiclient "github.com/kyma-incubator/compass/components/director/internal/system-field-discovery-engine/apiclient"
@@ -22,11 +24,13 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/destinationcreator"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/destination"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/destinationcertificate"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/certsubjectmapping"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateconstraintreferences"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateversion"
 
 	databuilder "github.com/kyma-incubator/compass/components/director/internal/domain/webhook/datainputbuilder"
 
@@ -48,11 +52,17 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplateversion"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	bundleutil "github.com/kyma-incubator/compass/components/director/internal/domain/bundle"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundleinstanceauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundlereferences"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/catalogsearch"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/dataproduct"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytypemapping"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventing"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
@@ -61,14 +71,21 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/notificationoutbox"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	runtimectx "github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/staticgroup"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/templatedrift"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantconfiguration"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/viewer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
@@ -120,7 +137,23 @@ type RootResolver struct {
 	formationConstraint   *formationconstraint.Resolver
 	constraintReference   *formationtemplateconstraintreferences.Resolver
 	certSubjectMapping    *certsubjectmapping.Resolver
+	staticGroup           *staticgroup.Resolver
+	accessPolicy          *accesspolicy.Resolver
 	operation             *operation.Resolver
+	tenantConfiguration   *tenantconfiguration.Resolver
+	softDelete            *softdelete.Resolver
+	templateDrift         *templatedrift.Resolver
+	templateVersion       *formationtemplateversion.Resolver
+	destination           *destination.Resolver
+	pkg                   *ordpackage.Resolver
+	product               *product.Resolver
+	vendor                *ordvendor.Resolver
+	entityType            *entitytype.Resolver
+	entityTypeMapping     *entitytypemapping.Resolver
+	capability            *capability.Resolver
+	dataProduct           *dataproduct.Resolver
+	tombstone             *tombstone.Resolver
+	catalogSearch         *catalogsearch.Resolver
 }
 
 // NewRootResolver missing godoc
@@ -150,6 +183,8 @@ func NewRootResolver(
 	systemFetcherClientConfig sfapiclient.SystemFetcherSyncClientConfig,
 	systemFieldDiscoveryClientConfig systemfielddiscoveryapiclient.SystemFieldDiscoveryEngineClientConfig,
 	environmentConsumerSubjects []string,
+	softDeleteConfig softdelete.Config,
+	notificationOutboxConfig notificationoutbox.Config,
 ) (*RootResolver, error) {
 	timeService := time.NewService()
 
@@ -186,6 +221,14 @@ func NewRootResolver(
 	aspectConverter := aspect.NewConverter(aspectEventResourceConverter)
 	integrationDependencyConv := integrationdependency.NewConverter(versionConverter, aspectConverter)
 	pkgConverter := ordpackage.NewConverter()
+	productConverter := product.NewConverter()
+	vendorConverter := ordvendor.NewConverter()
+	entityTypeConverter := entitytype.NewConverter(versionConverter)
+	entityTypeMappingConverter := entitytypemapping.NewConverter()
+	capabilityConverter := capability.NewConverter(versionConverter)
+	dataProductConverter := dataproduct.NewConverter(versionConverter)
+	tombstoneConverter := tombstone.NewConverter()
+	appTemplateVersionConverter := apptemplateversion.NewConverter()
 	labelDefConverter := labeldef.NewConverter()
 	labelConverter := label.NewConverter()
 	systemAuthConverter := systemauth.NewConverter(authConverter)
@@ -200,19 +243,28 @@ func NewRootResolver(
 	bundleReferenceConv := bundlereferences.NewConverter()
 	formationConv := formation.NewConverter()
 	runtimeConverter := runtime.NewConverter(webhookConverter)
+	softDeleteConverter := softdelete.NewConverter()
+	catalogSearchConverter := catalogsearch.NewConverter()
+	templateDriftConverter := templatedrift.NewConverter()
 	formationTemplateConverter := formationtemplate.NewConverter(webhookConverter)
 	formationAssignmentConv := formationassignment.NewConverter()
 	formationConstraintConverter := formationconstraint.NewConverter()
 	appTemplateConv := apptemplate.NewConverter(appConverter, webhookConverter)
 	constraintReferencesConverter := formationtemplateconstraintreferences.NewConverter()
+	formationTemplateVersionConv := formationtemplateversion.NewConverter()
 	certSubjectMappingConv := certsubjectmapping.NewConverter()
+	staticGroupConv := staticgroup.NewConverter()
 	destinationConv := destination.NewConverter()
 	operationConv := operation.NewConverter()
+	healthCheckConv := healthcheck.NewConverter()
 
-	healthcheckRepo := healthcheck.NewRepository()
+	healthcheckRepo := healthcheck.NewRepository(healthCheckConv)
 	runtimeRepo := runtime.NewRepository(runtimeConverter)
 	runtimeContextRepo := runtimectx.NewRepository(runtimectx.NewConverter())
 	applicationRepo := application.NewRepository(appConverter)
+	softDeleteRepo := softdelete.NewRepository(softDeleteConverter, systemAuthConverter)
+	catalogSearchRepo := catalogsearch.NewRepository(catalogSearchConverter)
+	templateDriftRepo := templatedrift.NewRepository(templateDriftConverter)
 	appTemplateRepo := apptemplate.NewRepository(appTemplateConverter)
 	labelRepo := label.NewRepository(labelConverter)
 	labelDefRepo := labeldef.NewRepository(labelDefConverter)
@@ -223,6 +275,14 @@ func NewRootResolver(
 	aspectEventResourceRepo := aspecteventresource.NewRepository(aspectEventResourceConverter)
 	integrationDependencyRepo := integrationdependency.NewRepository(integrationDependencyConv)
 	pkgRepo := ordpackage.NewRepository(pkgConverter)
+	productRepo := product.NewRepository(productConverter)
+	vendorRepo := ordvendor.NewRepository(vendorConverter)
+	entityTypeRepo := entitytype.NewRepository(entityTypeConverter)
+	entityTypeMappingRepo := entitytypemapping.NewRepository(entityTypeMappingConverter)
+	capabilityRepo := capability.NewRepository(capabilityConverter)
+	dataProductRepo := dataproduct.NewRepository(dataProductConverter)
+	tombstoneRepo := tombstone.NewRepository(tombstoneConverter)
+	appTemplateVersionRepo := apptemplateversion.NewRepository(appTemplateVersionConverter)
 	specRepo := spec.NewRepository(specConverter)
 	docRepo := document.NewRepository(docConverter)
 	fetchRequestRepo := fetchrequest.NewRepository(frConverter)
@@ -238,7 +298,9 @@ func NewRootResolver(
 	formationAssignmentRepo := formationassignment.NewRepository(formationAssignmentConv)
 	formationConstraintRepo := formationconstraint.NewRepository(formationConstraintConverter)
 	constraintReferencesRepo := formationtemplateconstraintreferences.NewRepository(constraintReferencesConverter)
+	formationTemplateVersionRepo := formationtemplateversion.NewRepository(formationTemplateVersionConv)
 	certSubjectMappingRepo := certsubjectmapping.NewRepository(certSubjectMappingConv)
+	staticGroupRepo := staticgroup.NewRepository(staticGroupConv)
 	destinationRepo := destination.NewRepository(destinationConv)
 	operationRepo := operation.NewRepository(operationConv)
 
@@ -266,6 +328,14 @@ func NewRootResolver(
 	aspectEventResourceSvc := aspecteventresource.NewService(aspectEventResourceRepo, uidSvc)
 	integrationDependencySvc := integrationdependency.NewService(integrationDependencyRepo, uidSvc)
 	packageSvc := ordpackage.NewService(pkgRepo, uidSvc)
+	productSvc := product.NewService(productRepo, uidSvc)
+	vendorSvc := ordvendor.NewService(vendorRepo, uidSvc)
+	entityTypeSvc := entitytype.NewService(entityTypeRepo, uidSvc)
+	entityTypeMappingSvc := entitytypemapping.NewService(entityTypeMappingRepo, uidSvc)
+	capabilitySvc := capability.NewService(capabilityRepo, uidSvc, specSvc)
+	dataProductSvc := dataproduct.NewService(dataProductRepo, uidSvc)
+	tombstoneSvc := tombstone.NewService(tombstoneRepo, uidSvc)
+	appTemplateVersionSvc := apptemplateversion.NewService(appTemplateVersionRepo, appTemplateSvc, uidSvc, timeService)
 	bundleInstanceAuthSvc := bundleinstanceauth.NewService(bundleInstanceAuthRepo, uidSvc)
 	bundleSvc := bundleutil.NewService(bundleRepo, apiSvc, eventAPISvc, docSvc, bundleInstanceAuthSvc, uidSvc)
 	webhookClient := webhookclient.NewClient(securedHTTPClient, mtlsHTTPClient)
@@ -273,29 +343,41 @@ func NewRootResolver(
 	webhookTenantBuilder := databuilder.NewWebhookTenantBuilder(webhookLabelBuilder, tenantRepo)
 	certSubjectTenantBuilder := databuilder.NewWebhookCertSubjectBuilder(certSubjectMappingRepo)
 	webhookDataInputBuilder := databuilder.NewWebhookDataInputBuilder(applicationRepo, appTemplateRepo, runtimeRepo, runtimeContextRepo, webhookLabelBuilder, webhookTenantBuilder, certSubjectTenantBuilder)
-	formationConstraintSvc := formationconstraint.NewService(formationConstraintRepo, constraintReferencesRepo, uidSvc, formationConstraintConverter)
-	destinationCreatorSvc := destinationcreator.NewService(mtlsHTTPClient, destinationCreatorConfig, applicationRepo, runtimeRepo, runtimeContextRepo, labelRepo, tenantRepo)
+	formationConstraintSvc := formationconstraint.NewService(formationConstraintRepo, constraintReferencesRepo, formationTemplateVersionRepo, uidSvc, formationConstraintConverter)
+	destinationCreatorSvc := destinationcreator.NewService(mtlsHTTPClient, destinationCreatorConfig, applicationRepo, runtimeRepo, runtimeContextRepo, labelRepo, tenantRepo, destinationcertificate.NewRepository(destinationcertificate.NewConverter()), uidSvc)
 	destinationSvc := destination.NewService(transact, destinationRepo, tenantRepo, uidSvc, destinationCreatorSvc)
 	constraintEngine := operators.NewConstraintEngine(transact, formationConstraintSvc, tenantSvc, scenarioAssignmentSvc, destinationSvc, destinationCreatorSvc, systemAuthSvc, formationRepo, labelRepo, labelSvc, applicationRepo, runtimeContextRepo, formationTemplateRepo, formationAssignmentRepo, nil, nil, assignmentOperationSvc, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
 	notificationsBuilder := formation.NewNotificationsBuilder(webhookConverter, constraintEngine, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
 	notificationsGenerator := formation.NewNotificationsGenerator(applicationRepo, runtimeRepo, runtimeContextRepo, labelRepo, webhookRepo, webhookDataInputBuilder, notificationsBuilder)
-	notificationSvc := formation.NewNotificationService(tenantRepo, webhookClient, notificationsGenerator, constraintEngine, webhookConverter, formationTemplateRepo, formationAssignmentRepo, formationRepo)
+	notificationSvc := formation.NewNotificationService(tenantRepo, notificationoutbox.NewNotificationClient(notificationOutboxConfig, webhookClient), notificationsGenerator, constraintEngine, webhookConverter, formationTemplateRepo, formationAssignmentRepo, formationRepo)
 	faNotificationSvc := formationassignment.NewFormationAssignmentNotificationService(formationAssignmentRepo, webhookConverter, webhookRepo, tenantRepo, webhookDataInputBuilder, formationRepo, notificationsBuilder, runtimeContextRepo, labelSvc, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
 	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, constraintEngine, faNotificationSvc)
 	formationAssignmentSvc := formationassignment.NewService(formationAssignmentRepo, uidSvc, applicationRepo, runtimeRepo, runtimeContextRepo, notificationSvc, faNotificationSvc, assignmentOperationSvc, labelSvc, formationRepo, formationAssignmentStatusSvc, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
 	formationStatusSvc := formation.NewFormationStatusService(formationRepo, labelDefRepo, labelDefSvc, notificationSvc, constraintEngine)
-	formationSvc := formation.NewService(transact, applicationRepo, labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, labelDefSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tenantSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, assignmentOperationSvc, faNotificationSvc, notificationSvc, constraintEngine, webhookRepo, formationStatusSvc, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
+	formationSvc := formation.NewService(transact, applicationRepo, labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, formationTemplateVersionRepo, labelSvc, uidSvc, labelDefSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tenantSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, assignmentOperationSvc, faNotificationSvc, notificationSvc, constraintEngine, webhookRepo, formationStatusSvc, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
+	formationTemplateVersionSvc := formationtemplateversion.NewService(formationTemplateVersionRepo, formationTemplateRepo, webhookRepo, constraintReferencesRepo, formationSvc, uidSvc)
 	eventingSvc := eventing.NewService(appNameNormalizer, runtimeRepo, labelRepo, formationSvc)
-	appSvc := application.NewService(appNameNormalizer, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelSvc, bundleSvc, uidSvc, formationSvc, selfRegConfig.SelfRegisterDistinguishLabelKey, ordWebhookMappings)
+	softDeleteSvc := softdelete.NewService(softDeleteRepo, softDeleteConfig.RetentionPeriod)
+	catalogSearchSvc := catalogsearch.NewService(catalogSearchRepo)
+	var appSoftDeleteSvc application.SoftDeleteService
+	var runtimeSoftDeleteSvc runtime.SoftDeleteService
+	if softDeleteConfig.Enabled {
+		appSoftDeleteSvc, runtimeSoftDeleteSvc = softDeleteSvc, softDeleteSvc
+	}
+	appSvc := application.NewService(appNameNormalizer, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelSvc, bundleSvc, uidSvc, formationSvc, selfRegConfig.SelfRegisterDistinguishLabelKey, ordWebhookMappings, appSoftDeleteSvc)
 	runtimeContextSvc := runtimectx.NewService(runtimeContextRepo, labelRepo, runtimeRepo, labelSvc, formationSvc, tenantSvc, uidSvc)
-	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, labelSvc, uidSvc, formationSvc, tenantSvc, webhookSvc, runtimeContextSvc, featuresConfig.ProtectedLabelPattern, featuresConfig.ImmutableLabelPattern, featuresConfig.RuntimeTypeLabelKey, featuresConfig.KymaRuntimeTypeLabelValue, featuresConfig.KymaApplicationNamespaceValue, featuresConfig.KymaAdapterWebhookMode, featuresConfig.KymaAdapterWebhookType, featuresConfig.KymaAdapterWebhookURLTemplate, featuresConfig.KymaAdapterWebhookInputTemplate, featuresConfig.KymaAdapterWebhookHeaderTemplate, featuresConfig.KymaAdapterWebhookOutputTemplate)
+	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, labelSvc, uidSvc, formationSvc, tenantSvc, webhookSvc, runtimeContextSvc, featuresConfig.ProtectedLabelPattern, featuresConfig.ImmutableLabelPattern, featuresConfig.RuntimeTypeLabelKey, featuresConfig.KymaRuntimeTypeLabelValue, featuresConfig.KymaApplicationNamespaceValue, featuresConfig.KymaAdapterWebhookMode, featuresConfig.KymaAdapterWebhookType, featuresConfig.KymaAdapterWebhookURLTemplate, featuresConfig.KymaAdapterWebhookInputTemplate, featuresConfig.KymaAdapterWebhookHeaderTemplate, featuresConfig.KymaAdapterWebhookOutputTemplate, runtimeSoftDeleteSvc)
 	tokenSvc := onetimetoken.NewTokenService(systemAuthSvc, appSvc, appConverter, tenantSvc, internalFQDNHTTPClient, onetimetoken.NewTokenGenerator(tokenLength), oneTimeTokenCfg, pairingAdapters, timeService)
 	subscriptionSvc := subscription.NewService(runtimeSvc, runtimeContextSvc, tenantSvc, labelSvc, appTemplateSvc, appConverter, appTemplateConv, appSvc, uidSvc, subscriptionConfig.GlobalSubaccountIDLabelKey, subscriptionConfig.SubscriptionLabelKey, subscriptionConfig.RuntimeTypeLabelKey, subscriptionConfig.ProviderLabelKey)
 	tenantOnDemandSvc := tenant.NewFetchOnDemandService(internalGatewayHTTPClient, tenantOnDemandAPIConfig)
-	formationTemplateSvc := formationtemplate.NewService(formationTemplateRepo, uidSvc, formationTemplateConverter, tenantSvc, webhookRepo, webhookSvc, labelSvc)
-	constraintReferenceSvc := formationtemplateconstraintreferences.NewService(constraintReferencesRepo, constraintReferencesConverter)
+	formationTemplateSvc := formationtemplate.NewService(formationTemplateRepo, uidSvc, formationTemplateConverter, tenantSvc, webhookRepo, webhookSvc, labelSvc, formationTemplateVersionSvc)
+	constraintReferenceSvc := formationtemplateconstraintreferences.NewService(constraintReferencesRepo, constraintReferencesConverter, formationTemplateVersionSvc)
 	certSubjectMappingSvc := certsubjectmapping.NewService(certSubjectMappingRepo)
+	staticGroupSvc := staticgroup.NewService(staticGroupRepo, cfgProvider)
 	operationSvc := operation.NewService(operationRepo, uidSvc)
+	tenantConfigurationConv := tenantconfiguration.NewConverter(appConverter, appTemplateConverter, runtimeConverter, formationTemplateConverter, formationConstraintConverter, labelDefConverter)
+	templateDriftSvc := templatedrift.NewService(templateDriftRepo, appSvc, appTemplateSvc, appConverter, webhookSvc)
+	tenantConfigurationSvc := tenantconfiguration.NewService(appSvc, appTemplateSvc, runtimeSvc, formationTemplateSvc, formationConstraintSvc, constraintReferenceSvc, formationSvc, labelDefSvc, webhookSvc, bundleSvc, tenantConfigurationConv)
 
 	constraintEngine.SetFormationAssignmentNotificationService(faNotificationSvc)
 	constraintEngine.SetFormationAssignmentService(formationAssignmentSvc)
@@ -305,9 +387,15 @@ func NewRootResolver(
 		return nil, err
 	}
 
+	accessPolicies, err := cfgProvider.GetAccessPolicies()
+	if err != nil {
+		return nil, err
+	}
+	accessPolicySvc := accesspolicy.NewService(accesspolicy.NewEvaluator(accessPolicies), accesspolicy.NewAttributesProviders(labelRepo, formationRepo, formationTemplateRepo))
+
 	return &RootResolver{
 		appNameNormalizer:     appNameNormalizer,
-		appTemplate:           apptemplate.NewResolver(transact, appSvc, appConverter, appTemplateSvc, appTemplateConverter, webhookSvc, webhookConverter, labelSvc, selfRegisterManager, uidSvc, certSubjectMappingSvc, appTemplateProductLabel, ordAggregatorClientConfig, environmentConsumerSubjects),
+		appTemplate:           apptemplate.NewResolver(transact, appSvc, appConverter, appTemplateSvc, appTemplateConverter, webhookSvc, webhookConverter, labelSvc, selfRegisterManager, uidSvc, certSubjectMappingSvc, appTemplateProductLabel, ordAggregatorClientConfig, environmentConsumerSubjects, templateDriftSvc),
 		app:                   application.NewResolver(transact, appSvc, webhookSvc, oAuth20Svc, systemAuthSvc, appConverter, appWithTenantsConverter, webhookConverter, systemAuthConverter, eventingSvc, bundleSvc, bundleConverter, specSvc, apiSvc, eventAPISvc, integrationDependencySvc, integrationDependencyConv, aspectSvc, aspectEventResourceSvc, apiConverter, eventAPIConverter, appTemplateSvc, appTemplateConverter, operationSvc, operationConv, selfRegConfig.SelfRegisterDistinguishLabelKey, featuresConfig.TokenPrefix),
 		api:                   api.NewResolver(transact, apiSvc, runtimeSvc, bundleSvc, bundleReferenceSvc, apiConverter, frConverter, specSvc, specConverter, appSvc),
 		eventAPI:              eventdef.NewResolver(transact, eventAPISvc, bundleSvc, bundleReferenceSvc, eventAPIConverter, frConverter, specSvc, specConverter),
@@ -318,7 +406,7 @@ func NewRootResolver(
 		formationAssignment:   formationassignment.NewResolver(transact, applicationRepo, appConverter, runtimeRepo, runtimeConverter, runtimeContextRepo, runtimeContextConverter, assignmentOperationSvc, assignmentOperationConv),
 		runtime:               runtime.NewResolver(transact, runtimeSvc, scenarioAssignmentSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter, eventingSvc, bundleInstanceAuthSvc, selfRegisterManager, uidSvc, subscriptionSvc, runtimeContextSvc, runtimeContextConverter, webhookSvc, webhookConverter, tenantOnDemandSvc, formationSvc, tenantSvc, formation.NewASAEngine(scenarioAssignmentRepo, runtimeRepo, runtimeContextRepo, formationRepo, formationTemplateRepo, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)),
 		runtimeContext:        runtimectx.NewResolver(transact, runtimeContextSvc, runtimeContextConverter),
-		healthCheck:           healthcheck.NewResolver(healthCheckSvc),
+		healthCheck:           healthcheck.NewResolver(transact, healthCheckSvc, healthCheckConv),
 		webhook:               webhook.NewResolver(transact, webhookSvc, appSvc, appTemplateSvc, runtimeSvc, formationTemplateSvc, webhookConverter),
 		labelDef:              labeldef.NewResolver(transact, labelDefSvc, formationSvc, labelDefConverter),
 		token:                 onetimetoken.NewTokenResolver(transact, tokenSvc, tokenConverter, oneTimeTokenCfg.SuggestTokenHeaderKey),
@@ -335,7 +423,23 @@ func NewRootResolver(
 		formationConstraint:   formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc),
 		constraintReference:   formationtemplateconstraintreferences.NewResolver(transact, constraintReferencesConverter, constraintReferenceSvc),
 		certSubjectMapping:    certsubjectmapping.NewResolver(transact, certSubjectMappingConv, certSubjectMappingSvc, uidSvc),
+		staticGroup:           staticgroup.NewResolver(transact, staticGroupConv, staticGroupSvc, uidSvc),
+		accessPolicy:          accesspolicy.NewResolver(transact, accessPolicySvc, accesspolicy.NewConverter()),
 		operation:             operation.NewResolver(transact, operationSvc, operationConv),
+		tenantConfiguration:   tenantconfiguration.NewResolver(transact, tenantConfigurationSvc, tenantConfigurationConv),
+		softDelete:            softdelete.NewResolver(transact, softDeleteSvc, softDeleteConverter, appSvc, appConverter, runtimeSvc, runtimeConverter),
+		templateDrift:         templatedrift.NewResolver(transact, templateDriftSvc, templateDriftConverter),
+		templateVersion:       formationtemplateversion.NewResolver(transact, formationTemplateVersionSvc, formationTemplateVersionConv),
+		destination:           destination.NewResolver(transact, destinationSvc, destinationConv),
+		pkg:                   ordpackage.NewResolver(transact, packageSvc, pkgConverter, appTemplateVersionSvc),
+		product:               product.NewResolver(transact, productSvc, productConverter, appTemplateVersionSvc),
+		vendor:                ordvendor.NewResolver(transact, vendorSvc, vendorConverter, appTemplateVersionSvc),
+		entityType:            entitytype.NewResolver(transact, entityTypeSvc, entityTypeConverter, appTemplateVersionSvc),
+		entityTypeMapping:     entitytypemapping.NewResolver(transact, entityTypeMappingSvc, entityTypeMappingConverter),
+		capability:            capability.NewResolver(transact, capabilitySvc, capabilityConverter, appTemplateVersionSvc),
+		dataProduct:           dataproduct.NewResolver(transact, dataProductSvc, dataProductConverter, appTemplateVersionSvc),
+		tombstone:             tombstone.NewResolver(transact, tombstoneSvc, tombstoneConverter, appTemplateVersionSvc),
+		catalogSearch:         catalogsearch.NewResolver(transact, catalogSearchSvc, catalogSearchConverter),
 	}, nil
 }
 
@@ -359,6 +463,41 @@ func (r *RootResolver) IntegrationDependenciesDataloader(ids []dataloader.ParamI
 	return r.app.IntegrationDependenciesDataLoader(ids)
 }
 
+// PackagesDataloader is the ORD packages dataloader used in the graphql API router
+func (r *RootResolver) PackagesDataloader(ids []dataloader.ParamPackage) ([]*graphql.PackagePage, []error) {
+	return r.pkg.ApplicationPackagesDataLoader(ids)
+}
+
+// ProductsDataloader is the ORD products dataloader used in the graphql API router
+func (r *RootResolver) ProductsDataloader(ids []dataloader.ParamProduct) ([]*graphql.ProductPage, []error) {
+	return r.product.ApplicationProductsDataLoader(ids)
+}
+
+// VendorsDataloader is the ORD vendors dataloader used in the graphql API router
+func (r *RootResolver) VendorsDataloader(ids []dataloader.ParamVendor) ([]*graphql.VendorPage, []error) {
+	return r.vendor.ApplicationVendorsDataLoader(ids)
+}
+
+// EntityTypesDataloader is the ORD entity types dataloader used in the graphql API router
+func (r *RootResolver) EntityTypesDataloader(ids []dataloader.ParamEntityType) ([]*graphql.EntityTypePage, []error) {
+	return r.entityType.ApplicationEntityTypesDataLoader(ids)
+}
+
+// CapabilitiesDataloader is the ORD capabilities dataloader used in the graphql API router
+func (r *RootResolver) CapabilitiesDataloader(ids []dataloader.ParamCapability) ([]*graphql.CapabilityPage, []error) {
+	return r.capability.ApplicationCapabilitiesDataLoader(ids)
+}
+
+// DataProductsDataloader is the ORD data products dataloader used in the graphql API router
+func (r *RootResolver) DataProductsDataloader(ids []dataloader.ParamDataProduct) ([]*graphql.DataProductPage, []error) {
+	return r.dataProduct.ApplicationDataProductsDataLoader(ids)
+}
+
+// TombstonesDataloader is the ORD tombstones dataloader used in the graphql API router
+func (r *RootResolver) TombstonesDataloader(ids []dataloader.ParamTombstone) ([]*graphql.TombstonePage, []error) {
+	return r.tombstone.ApplicationTombstonesDataLoader(ids)
+}
+
 // DocumentsDataloader missing godoc
 func (r *RootResolver) DocumentsDataloader(ids []dataloader.ParamDocument) ([]*graphql.DocumentPage, []error) {
 	return r.mpBundle.DocumentsDataLoader(ids)
@@ -409,6 +548,31 @@ func (r *RootResolver) AssignmentOperationsDataLoader(ids []dataloader.ParamAssi
 	return r.formationAssignment.AssignmentOperationsDataLoader(ids)
 }
 
+// BundleDestinationsDataLoader is the Bundle Destinations dataloader used in the graphql API router
+func (r *RootResolver) BundleDestinationsDataLoader(ids []dataloader.ParamDestination) ([][]*graphql.Destination, []error) {
+	return r.destination.BundleDestinationsDataLoader(ids)
+}
+
+// ApplicationDestinationsDataLoader is the Application Destinations dataloader used in the graphql API router
+func (r *RootResolver) ApplicationDestinationsDataLoader(ids []dataloader.ParamDestination) ([][]*graphql.Destination, []error) {
+	return r.destination.ApplicationDestinationsDataLoader(ids)
+}
+
+// APIEntityTypeMappingsDataLoader is the API Definition Entity Type Mappings dataloader used in the graphql API router
+func (r *RootResolver) APIEntityTypeMappingsDataLoader(ids []dataloader.ParamEntityTypeMapping) ([][]*graphql.EntityTypeMapping, []error) {
+	return r.entityTypeMapping.APIDefinitionEntityTypeMappingsDataLoader(ids)
+}
+
+// EventEntityTypeMappingsDataLoader is the Event Definition Entity Type Mappings dataloader used in the graphql API router
+func (r *RootResolver) EventEntityTypeMappingsDataLoader(ids []dataloader.ParamEntityTypeMapping) ([][]*graphql.EntityTypeMapping, []error) {
+	return r.entityTypeMapping.EventDefinitionEntityTypeMappingsDataLoader(ids)
+}
+
+// ApplicationLabelsFromTemplate returns the labels of the application which would be registered from the application template input
+func (r *RootResolver) ApplicationLabelsFromTemplate(ctx context.Context, in graphql.ApplicationFromTemplateInput) (map[string]interface{}, error) {
+	return r.appTemplate.ApplicationLabels(ctx, in)
+}
+
 // Mutation missing godoc
 func (r *RootResolver) Mutation() graphql.MutationResolver {
 	return &mutationResolver{r}
@@ -536,6 +700,11 @@ func (r *queryResolver) FormationTemplate(ctx context.Context, id string) (*grap
 	return r.formationTemplate.FormationTemplate(ctx, id)
 }
 
+// FormationTemplateVersions lists the versions of the formation template, oldest first
+func (r *queryResolver) FormationTemplateVersions(ctx context.Context, formationTemplateID string) ([]*graphql.FormationTemplateVersion, error) {
+	return r.templateVersion.FormationTemplateVersions(ctx, formationTemplateID)
+}
+
 func (r *queryResolver) FormationTemplates(ctx context.Context, filters []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.FormationTemplatePage, error) {
 	return r.formationTemplate.FormationTemplates(ctx, filters, first, after)
 }
@@ -641,6 +810,11 @@ func (r *queryResolver) ApplicationsForRuntime(ctx context.Context, runtimeID st
 	return apps, nil
 }
 
+// PreviewMergeApplications shows the outcome of mergeApplications without persisting anything
+func (r *queryResolver) PreviewMergeApplications(ctx context.Context, destinationID string, sourceID string) (*graphql.ApplicationMergePreview, error) {
+	return r.app.PreviewMergeApplications(ctx, destinationID, sourceID)
+}
+
 // Runtimes missing godoc
 func (r *queryResolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
 	return r.runtime.Runtimes(ctx, filter, first, after)
@@ -676,11 +850,16 @@ func (r *queryResolver) BundleInstanceAuth(ctx context.Context, id string) (*gra
 	return r.bundleInstanceAuth.BundleInstanceAuth(ctx, id)
 }
 
-// HealthChecks missing godoc
+// HealthChecks returns the results of probing the endpoints of the applications visible to the tenant
 func (r *queryResolver) HealthChecks(ctx context.Context, types []graphql.HealthCheckType, origin *string, first *int, after *graphql.PageCursor) (*graphql.HealthCheckPage, error) {
 	return r.healthCheck.HealthChecks(ctx, types, origin, first, after)
 }
 
+// Destinations lists the destinations fetched from the destination service of the tenant
+func (r *queryResolver) Destinations(ctx context.Context, filter *graphql.DestinationFilter, first *int, after *graphql.PageCursor) (*graphql.DestinationPage, error) {
+	return r.destination.Destinations(ctx, filter, first, after)
+}
+
 // IntegrationSystems missing godoc
 func (r *queryResolver) IntegrationSystems(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.IntegrationSystemPage, error) {
 	return r.intSys.IntegrationSystems(ctx, first, after)
@@ -701,6 +880,21 @@ func (r *queryResolver) RootTenants(ctx context.Context, externalTenant string)
 	return r.tenant.RootTenants(ctx, externalTenant)
 }
 
+// TenantAccesses fetches the tenant accesses to a given object
+func (r *queryResolver) TenantAccesses(ctx context.Context, objectID string, objectType graphql.TenantAccessObjectType) ([]*graphql.TenantAccess, error) {
+	return r.tenant.TenantAccesses(ctx, objectID, objectType)
+}
+
+// TenantAccessesForTenant fetches the tenant accesses of a given external tenant
+func (r *queryResolver) TenantAccessesForTenant(ctx context.Context, tenantID string) ([]*graphql.TenantAccess, error) {
+	return r.tenant.TenantAccessesForTenant(ctx, tenantID)
+}
+
+// TenantTree fetches the ancestors and descendants of a given external tenant
+func (r *queryResolver) TenantTree(ctx context.Context, id string, depth *int, direction *graphql.TenantTreeDirection) (*graphql.TenantTree, error) {
+	return r.tenant.TenantTree(ctx, id, depth, direction)
+}
+
 // AutomaticScenarioAssignmentForScenario missing godoc
 func (r *queryResolver) AutomaticScenarioAssignmentForScenario(ctx context.Context, scenarioName string) (*graphql.AutomaticScenarioAssignment, error) {
 	return r.scenarioAssignment.GetAutomaticScenarioAssignmentForScenarioName(ctx, scenarioName)
@@ -734,10 +928,37 @@ func (r *queryResolver) CertificateSubjectMappings(ctx context.Context, first *i
 	return r.certSubjectMapping.CertificateSubjectMappings(ctx, first, after)
 }
 
+func (r *queryResolver) StaticGroup(ctx context.Context, id string) (*graphql.StaticGroup, error) {
+	return r.staticGroup.StaticGroup(ctx, id)
+}
+
+func (r *queryResolver) StaticGroups(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.StaticGroupPage, error) {
+	return r.staticGroup.StaticGroups(ctx, first, after)
+}
+
+func (r *queryResolver) ExplainAccess(ctx context.Context, in graphql.AccessRequestInput) (*graphql.AccessExplanation, error) {
+	return r.accessPolicy.ExplainAccess(ctx, in)
+}
+
 func (r *queryResolver) Operation(ctx context.Context, id string) (*graphql.Operation, error) {
 	return r.operation.Operation(ctx, id)
 }
 
+// ExportTenantConfiguration exports the configuration of the tenant as a versioned document
+func (r *queryResolver) ExportTenantConfiguration(ctx context.Context, format *graphql.TenantConfigurationFormat) (graphql.CLOB, error) {
+	return r.tenantConfiguration.ExportTenantConfiguration(ctx, format)
+}
+
+// DeletedApplications returns the soft deleted applications of the tenant
+func (r *queryResolver) DeletedApplications(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.DeletedApplicationPage, error) {
+	return r.softDelete.DeletedApplications(ctx, first, after)
+}
+
+// SearchCatalog searches the API and event catalog of the tenant
+func (r *queryResolver) SearchCatalog(ctx context.Context, query string, resourceTypes []graphql.CatalogResourceType, filters *graphql.CatalogSearchFilter, first *int, after *graphql.PageCursor) (*graphql.CatalogSearchResultPage, error) {
+	return r.catalogSearch.SearchCatalog(ctx, query, resourceTypes, filters, first, after)
+}
+
 type mutationResolver struct {
 	*RootResolver
 }
@@ -790,8 +1011,13 @@ func (r *mutationResolver) UpdateFormationTemplate(ctx context.Context, id strin
 	return r.formationTemplate.UpdateFormationTemplate(ctx, id, in)
 }
 
-func (r *mutationResolver) AssignFormation(ctx context.Context, objectID string, objectType graphql.FormationObjectType, formation graphql.FormationInput, initialConfigurations []*graphql.InitialConfiguration) (*graphql.Formation, error) {
-	return r.formation.AssignFormation(ctx, objectID, objectType, formation, initialConfigurations)
+// MigrateFormationsToTemplateVersion pins the formations to the given version of their formation template
+func (r *mutationResolver) MigrateFormationsToTemplateVersion(ctx context.Context, templateID string, version int, formationIDs []string, dryRun *bool) ([]*graphql.FormationTemplateMigrationResult, error) {
+	return r.templateVersion.MigrateFormationsToTemplateVersion(ctx, templateID, version, formationIDs, dryRun)
+}
+
+func (r *mutationResolver) AssignFormation(ctx context.Context, objectID string, objectType graphql.FormationObjectType, formation graphql.FormationInput, initialConfigurations []*graphql.InitialConfiguration, validFrom *graphql.Timestamp, validUntil *graphql.Timestamp) (*graphql.Formation, error) {
+	return r.formation.AssignFormation(ctx, objectID, objectType, formation, initialConfigurations, validFrom, validUntil)
 }
 
 func (r *mutationResolver) UnassignFormation(ctx context.Context, objectID string, objectType graphql.FormationObjectType, formation graphql.FormationInput) (*graphql.Formation, error) {
@@ -1191,10 +1417,42 @@ func (r *mutationResolver) DeleteCertificateSubjectMapping(ctx context.Context,
 	return r.certSubjectMapping.DeleteCertificateSubjectMapping(ctx, id)
 }
 
+func (r *mutationResolver) CreateStaticGroup(ctx context.Context, in graphql.StaticGroupInput) (*graphql.StaticGroup, error) {
+	return r.staticGroup.CreateStaticGroup(ctx, in)
+}
+
+func (r *mutationResolver) UpdateStaticGroup(ctx context.Context, id string, in graphql.StaticGroupInput) (*graphql.StaticGroup, error) {
+	return r.staticGroup.UpdateStaticGroup(ctx, id, in)
+}
+
+func (r *mutationResolver) DeleteStaticGroup(ctx context.Context, id string) (*graphql.StaticGroup, error) {
+	return r.staticGroup.DeleteStaticGroup(ctx, id)
+}
+
 func (r *mutationResolver) ScheduleOperation(ctx context.Context, id string, priority *int) (*graphql.Operation, error) {
 	return r.operation.Schedule(ctx, id, priority)
 }
 
+// ImportTenantConfiguration applies a tenant configuration document to the tenant
+func (r *mutationResolver) ImportTenantConfiguration(ctx context.Context, document graphql.CLOB, mode *graphql.TenantConfigurationImportMode) (*graphql.TenantConfigurationImportResult, error) {
+	return r.tenantConfiguration.ImportTenantConfiguration(ctx, document, mode)
+}
+
+// RestoreApplication restores a soft deleted application
+func (r *mutationResolver) RestoreApplication(ctx context.Context, id string) (*graphql.Application, error) {
+	return r.softDelete.RestoreApplication(ctx, id)
+}
+
+// RestoreRuntime restores a soft deleted runtime
+func (r *mutationResolver) RestoreRuntime(ctx context.Context, id string) (*graphql.Runtime, error) {
+	return r.softDelete.RestoreRuntime(ctx, id)
+}
+
+// UpgradeApplicationsFromTemplate applies the current version of the application template to the applications registered from it
+func (r *mutationResolver) UpgradeApplicationsFromTemplate(ctx context.Context, templateID string, applicationIDs []string, dryRun *bool) ([]*graphql.ApplicationTemplateUpgradeResult, error) {
+	return r.templateDrift.UpgradeApplicationsFromTemplate(ctx, templateID, applicationIDs, dryRun)
+}
+
 type applicationResolver struct {
 	*RootResolver
 }
@@ -1254,6 +1512,51 @@ func (r *applicationResolver) ApplicationTemplate(ctx context.Context, obj *grap
 	return r.app.ApplicationTemplate(ctx, obj)
 }
 
+// TemplateDrift resolves the differences between the application and the current version of its application template
+func (r *applicationResolver) TemplateDrift(ctx context.Context, obj *graphql.Application) (*graphql.ApplicationTemplateDrift, error) {
+	return r.templateDrift.TemplateDrift(ctx, obj)
+}
+
+// Destinations resolves the destinations linked to the bundles of the application
+func (r *applicationResolver) Destinations(ctx context.Context, obj *graphql.Application) ([]*graphql.Destination, error) {
+	return r.destination.ApplicationDestinations(ctx, obj)
+}
+
+// Packages resolves the ORD packages of the application
+func (r *applicationResolver) Packages(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.PackagePage, error) {
+	return r.pkg.ApplicationPackages(ctx, obj, first, after)
+}
+
+// Products resolves the ORD products of the application
+func (r *applicationResolver) Products(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.ProductPage, error) {
+	return r.product.ApplicationProducts(ctx, obj, first, after)
+}
+
+// Vendors resolves the ORD vendors of the application
+func (r *applicationResolver) Vendors(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.VendorPage, error) {
+	return r.vendor.ApplicationVendors(ctx, obj, first, after)
+}
+
+// EntityTypes resolves the ORD entity types of the application
+func (r *applicationResolver) EntityTypes(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.EntityTypePage, error) {
+	return r.entityType.ApplicationEntityTypes(ctx, obj, first, after)
+}
+
+// Capabilities resolves the ORD capabilities of the application
+func (r *applicationResolver) Capabilities(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.CapabilityPage, error) {
+	return r.capability.ApplicationCapabilities(ctx, obj, first, after)
+}
+
+// DataProducts resolves the ORD data products of the application
+func (r *applicationResolver) DataProducts(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.DataProductPage, error) {
+	return r.dataProduct.ApplicationDataProducts(ctx, obj, first, after)
+}
+
+// Tombstones resolves the ORD tombstones of the application
+func (r *applicationResolver) Tombstones(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.TombstonePage, error) {
+	return r.tombstone.ApplicationTombstones(ctx, obj, first, after)
+}
+
 type applicationTemplateResolver struct {
 	*RootResolver
 }
@@ -1268,6 +1571,41 @@ func (r applicationTemplateResolver) Labels(ctx context.Context, obj *graphql.Ap
 	return r.appTemplate.Labels(ctx, obj, key)
 }
 
+// Packages resolves the ORD packages of the latest version of the application template
+func (r applicationTemplateResolver) Packages(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.PackagePage, error) {
+	return r.pkg.ApplicationTemplatePackages(ctx, obj, first, after)
+}
+
+// Products resolves the ORD products of the latest version of the application template
+func (r applicationTemplateResolver) Products(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.ProductPage, error) {
+	return r.product.ApplicationTemplateProducts(ctx, obj, first, after)
+}
+
+// Vendors resolves the ORD vendors of the latest version of the application template
+func (r applicationTemplateResolver) Vendors(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.VendorPage, error) {
+	return r.vendor.ApplicationTemplateVendors(ctx, obj, first, after)
+}
+
+// EntityTypes resolves the ORD entity types of the latest version of the application template
+func (r applicationTemplateResolver) EntityTypes(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.EntityTypePage, error) {
+	return r.entityType.ApplicationTemplateEntityTypes(ctx, obj, first, after)
+}
+
+// Capabilities resolves the ORD capabilities of the latest version of the application template
+func (r applicationTemplateResolver) Capabilities(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.CapabilityPage, error) {
+	return r.capability.ApplicationTemplateCapabilities(ctx, obj, first, after)
+}
+
+// DataProducts resolves the ORD data products of the latest version of the application template
+func (r applicationTemplateResolver) DataProducts(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.DataProductPage, error) {
+	return r.dataProduct.ApplicationTemplateDataProducts(ctx, obj, first, after)
+}
+
+// Tombstones resolves the ORD tombstones of the latest version of the application template
+func (r applicationTemplateResolver) Tombstones(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.TombstonePage, error) {
+	return r.tombstone.ApplicationTemplateTombstones(ctx, obj, first, after)
+}
+
 type formationTemplateResolver struct {
 	*RootResolver
 }
@@ -1469,6 +1807,11 @@ func (r *BundleResolver) Document(ctx context.Context, obj *graphql.Bundle, id s
 	return r.mpBundle.Document(ctx, obj, id)
 }
 
+// Destinations resolves the destinations linked to the bundle
+func (r *BundleResolver) Destinations(ctx context.Context, obj *graphql.Bundle) ([]*graphql.Destination, error) {
+	return r.destination.BundleDestinations(ctx, obj)
+}
+
 type eventDefinitionResolver struct {
 	*RootResolver
 }
@@ -1477,6 +1820,11 @@ func (r *eventDefinitionResolver) Spec(ctx context.Context, obj *graphql.EventDe
 	return r.eventAPI.Spec(ctx, obj)
 }
 
+// EntityTypeMappings resolves the ORD entity type mappings of the event definition
+func (r *eventDefinitionResolver) EntityTypeMappings(ctx context.Context, obj *graphql.EventDefinition) ([]*graphql.EntityTypeMapping, error) {
+	return r.entityTypeMapping.EventDefinitionEntityTypeMappings(ctx, obj)
+}
+
 type apiDefinitionResolver struct {
 	*RootResolver
 }
@@ -1485,6 +1833,11 @@ func (r *apiDefinitionResolver) Spec(ctx context.Context, obj *graphql.APIDefini
 	return r.api.Spec(ctx, obj)
 }
 
+// EntityTypeMappings resolves the ORD entity type mappings of the API definition
+func (r *apiDefinitionResolver) EntityTypeMappings(ctx context.Context, obj *graphql.APIDefinition) ([]*graphql.EntityTypeMapping, error) {
+	return r.entityTypeMapping.APIDefinitionEntityTypeMappings(ctx, obj)
+}
+
 type tenantResolver struct {
 	*RootResolver
 }
@@ -51,6 +51,7 @@ type RuntimeService interface {
 	GetByTokenIssuer(ctx context.Context, issuer string) (*model.Runtime, error)
 	GetByFilters(ctx context.Context, filters []*labelfilter.LabelFilter) (*model.Runtime, error)
 	Delete(ctx context.Context, id string) error
+	IsSoftDeleteEnabled() bool
 	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
 	GetLabel(ctx context.Context, runtimeID string, key string) (*model.Label, error)
@@ -462,12 +463,15 @@ func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runti
 		return nil, err
 	}
 
+	// Soft deleted runtimes keep their self-registration and OAuth 2.0 clients until they are purged, so that they can be restored
+	softDelete := r.runtimeService.IsSoftDeleteEnabled()
+
 	_, err = r.runtimeService.GetLabel(ctx, runtime.ID, r.selfRegManager.GetSelfRegDistinguishingLabelKey())
 	if err != nil {
 		if !apperrors.IsNotFoundError(err) {
 			return nil, errors.Wrapf(err, "while getting self register info label")
 		}
-	} else {
+	} else if !softDelete {
 		regionLabel, err := r.runtimeService.GetLabel(ctx, runtime.ID, selfregmanager.RegionLabel)
 		if err != nil {
 			return nil, errors.Wrapf(err, "while getting region label")
@@ -525,8 +529,10 @@ func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runti
 		return nil, err
 	}
 
-	if err = r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths); err != nil {
-		return nil, err
+	if !softDelete {
+		if err = r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths); err != nil {
+			return nil, err
+		}
 	}
 
 	if err = tx.Commit(); err != nil {
@@ -785,6 +785,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("Delete", contextParam, "foo").Return(nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: UnusedScenarioAssignmentService,
@@ -849,6 +850,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("Delete", contextParam, "foo").Return(nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, nil).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				svc.On("GetLabel", contextParam, "foo", RegionKey).Return(&model.Label{Value: testRegion}, nil).Once()
 				return svc
 			},
@@ -896,6 +898,53 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 			ExpectedRuntime: gqlRuntime,
 			ExpectedErr:     nil,
 		},
+		{
+			Name:            "Success for soft deleted self registered runtime keeps its self-registration and OAuth 2.0 clients",
+			Context:         fixContextWithTenant(tenantID, ""),
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+				svc.On("Delete", contextParam, "foo").Return(nil).Once()
+				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, nil).Once()
+				svc.On("IsSoftDeleteEnabled").Return(true).Once()
+				return svc
+			},
+			ScenarioAssignmentFn: UnusedScenarioAssignmentService,
+			ConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
+				return conv
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObject", contextParam, pkgmodel.RuntimeReference, modelRuntime.ID).Return(testAuths, nil)
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			BundleInstanceAuthSvcFn: func() *automock.BundleInstanceAuthService {
+				svc := &automock.BundleInstanceAuthService{}
+				svc.On("ListByRuntimeID", contextParam, modelRuntime.ID).Return(nil, nil)
+				return svc
+			},
+			SelfRegManagerFn: rtmtest.SelfRegManagerThatDoesNotCleanup,
+			FormationsSvcFn:  UnusedFormationService,
+			TenantSvcFn: func() *automock.TenantSvc {
+				tntSvc := &automock.TenantSvc{}
+				tntSvc.On("GetTenantByID", contextParam, tenantID).Return(&model.BusinessTenantMapping{ID: tenantID, Parents: []string{parentTenantID}}, nil)
+				return tntSvc
+			},
+			AsaEngineFn: func() *automock.AsaEngine {
+				asaEngine := &automock.AsaEngine{}
+				asaEngine.On("GetScenariosFromMatchingASAs", contextParam, modelRuntime.ID, graphql.FormationObjectTypeRuntime).Return(nil, nil).Once()
+				return asaEngine
+			},
+			InputID:         "foo",
+			ExpectedRuntime: gqlRuntime,
+			ExpectedErr:     nil,
+		},
 		{
 			Name:    "Returns error when second transaction fails",
 			Context: fixContextWithTenant(tenantID, ""),
@@ -917,6 +966,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("Delete", contextParam, "foo").Return(nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, nil).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				svc.On("GetLabel", contextParam, "foo", RegionKey).Return(&model.Label{Value: testRegion}, nil).Once()
 				return svc
 			},
@@ -972,6 +1022,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, nil).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				svc.On("GetLabel", contextParam, "foo", RegionKey).Return(&model.Label{Value: testRegion}, nil).Once()
 				return svc
 			},
@@ -1009,6 +1060,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, nil).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				svc.On("GetLabel", contextParam, "foo", RegionKey).Return(nil, testErr).Once()
 				return svc
 			},
@@ -1042,6 +1094,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("Delete", contextParam, "foo").Return(testErr).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: UnusedScenarioAssignmentService,
@@ -1130,6 +1183,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("Delete", contextParam, modelRuntime.ID).Return(nil)
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: UnusedScenarioAssignmentService,
@@ -1176,6 +1230,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: UnusedScenarioAssignmentService,
@@ -1227,6 +1282,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, testErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: UnusedScenarioAssignmentService,
@@ -1251,6 +1307,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: UnusedScenarioAssignmentService,
@@ -1284,6 +1341,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("Delete", contextParam, "foo").Return(nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: UnusedScenarioAssignmentService,
@@ -1331,6 +1389,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: UnusedScenarioAssignmentService,
@@ -1370,6 +1429,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				svc.On("Delete", contextParam, "foo").Return(nil).Once()
 				return svc
 			},
@@ -1420,6 +1480,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -1463,6 +1524,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				svc.On("Delete", contextParam, "foo").Return(nil).Once()
 				return svc
 			},
@@ -1519,6 +1581,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -1566,6 +1629,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
 				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("IsSoftDeleteEnabled").Return(false).Once()
 				svc.On("Delete", contextParam, "foo").Return(nil).Once()
 				return svc
 			},
@@ -23,6 +23,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -79,6 +80,13 @@ type uidService interface {
 	Generate() string
 }
 
+// SoftDeleteService is responsible for soft deleting Runtimes when soft delete is enabled
+//
+//go:generate mockery --name=SoftDeleteService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SoftDeleteService interface {
+	SoftDelete(ctx context.Context, resourceType resource.Type, id string) error
+}
+
 // ScenariosFilterSanitizer missing godoc
 //
 //go:generate mockery --name=ScenariosFilterSanitizer --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -111,6 +119,8 @@ type service struct {
 	kymaAdapterWebhookInputTemplate  string
 	kymaAdapterWebhookHeaderTemplate string
 	kymaAdapterWebhookOutputTemplate string
+
+	softDeleteService SoftDeleteService
 }
 
 // NewService missing godoc
@@ -123,7 +133,8 @@ func NewService(repo runtimeRepository,
 	webhookService WebhookService,
 	runtimeContextService RuntimeContextService,
 	protectedLabelPattern, immutableLabelPattern, runtimeTypeLabelKey, kymaRuntimeTypeLabelValue, kymaApplicationNamespaceValue,
-	kymaAdapterWebhookMode, kymaAdapterWebhookType, kymaAdapterWebhookURLTemplate, kymaAdapterWebhookInputTemplate, kymaAdapterWebhookHeaderTemplate, kymaAdapterWebhookOutputTemplate string) *service {
+	kymaAdapterWebhookMode, kymaAdapterWebhookType, kymaAdapterWebhookURLTemplate, kymaAdapterWebhookInputTemplate, kymaAdapterWebhookHeaderTemplate, kymaAdapterWebhookOutputTemplate string,
+	softDeleteService SoftDeleteService) *service {
 	return &service{
 		repo:                             repo,
 		labelRepo:                        labelRepo,
@@ -145,6 +156,7 @@ func NewService(repo runtimeRepository,
 		kymaAdapterWebhookInputTemplate:  kymaAdapterWebhookInputTemplate,
 		kymaAdapterWebhookHeaderTemplate: kymaAdapterWebhookHeaderTemplate,
 		kymaAdapterWebhookOutputTemplate: kymaAdapterWebhookOutputTemplate,
+		softDeleteService:                softDeleteService,
 	}
 }
 
@@ -432,7 +444,8 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeUpdateI
 	return nil
 }
 
-// Delete deletes all RuntimeContexts associated with the runtime with ID `id` and then deletes the runtime and its labels
+// Delete deletes all RuntimeContexts associated with the runtime with ID `id` and then deletes the runtime and its labels.
+// When soft delete is enabled the runtime is soft deleted instead and can be restored until its retention period ends.
 func (s *service) Delete(ctx context.Context, id string) error {
 	rtmTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -454,6 +467,13 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	if s.IsSoftDeleteEnabled() {
+		if err = s.softDeleteService.SoftDelete(ctx, resource.Runtime, id); err != nil {
+			return errors.Wrapf(err, "while soft deleting Runtime")
+		}
+		return nil
+	}
+
 	if err = s.repo.Delete(ctx, rtmTenant, id); err != nil {
 		return errors.Wrapf(err, "while deleting Runtime")
 	}
@@ -538,6 +558,12 @@ func (s *service) ListLabels(ctx context.Context, runtimeID string) (map[string]
 	return extractUnProtectedLabels(labels, s.protectedLabelPattern)
 }
 
+// IsSoftDeleteEnabled returns whether Delete soft deletes the Runtimes.
+// In that case the cleanup which cannot be reverted on restore is postponed until the Runtime is purged.
+func (s *service) IsSoftDeleteEnabled() bool {
+	return s.softDeleteService != nil
+}
+
 // DeleteLabel deletes Runtime label from a given label key
 func (s *service) DeleteLabel(ctx context.Context, runtimeID string, key string) error {
 	rtmTenant, err := tenant.LoadFromContext(ctx)
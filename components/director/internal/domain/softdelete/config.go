@@ -0,0 +1,13 @@
+package softdelete
+
+import "time"
+
+// Config configures the soft delete of applications and runtimes
+type Config struct {
+	// Enabled switches synchronous application and runtime deletions to soft deletions
+	Enabled bool `envconfig:"default=false,APP_SOFT_DELETE_ENABLED"`
+	// RetentionPeriod is the period in which a soft deleted resource can be restored before it is purged
+	RetentionPeriod time.Duration `envconfig:"default=720h,APP_SOFT_DELETE_RETENTION_PERIOD"`
+	// PurgeJobInterval is how often the job purging expired soft deleted resources is executed
+	PurgeJobInterval time.Duration `envconfig:"default=1h,APP_SOFT_DELETE_PURGE_JOB_INTERVAL"`
+}
@@ -0,0 +1,73 @@
+package softdelete
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+type converter struct{}
+
+// NewConverter returns a new soft deleted resources converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the soft deleted resource model to an entity
+func (c *converter) ToEntity(in *model.SoftDeletedResource) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:           in.ID,
+		ResourceType: string(in.ResourceType),
+		TenantID:     in.TenantID,
+		Name:         in.Name,
+		DeletedAt:    in.DeletedAt,
+		PurgeAfter:   in.PurgeAfter,
+	}
+}
+
+// FromEntity converts the soft deleted resource entity to a model
+func (c *converter) FromEntity(in *Entity) *model.SoftDeletedResource {
+	if in == nil {
+		return nil
+	}
+
+	return &model.SoftDeletedResource{
+		ID:           in.ID,
+		ResourceType: resource.Type(in.ResourceType),
+		TenantID:     in.TenantID,
+		Name:         in.Name,
+		DeletedAt:    in.DeletedAt,
+		PurgeAfter:   in.PurgeAfter,
+	}
+}
+
+// ToGraphQL converts the soft deleted application to its GraphQL representation
+func (c *converter) ToGraphQL(in *model.SoftDeletedResource) *graphql.DeletedApplication {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.DeletedApplication{
+		ID:         in.ID,
+		Name:       in.Name,
+		DeletedAt:  graphql.Timestamp(in.DeletedAt),
+		PurgeAfter: graphql.Timestamp(in.PurgeAfter),
+	}
+}
+
+// MultipleToGraphQL converts multiple soft deleted applications to their GraphQL representation
+func (c *converter) MultipleToGraphQL(in []*model.SoftDeletedResource) []*graphql.DeletedApplication {
+	deleted := make([]*graphql.DeletedApplication, 0, len(in))
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+		deleted = append(deleted, c.ToGraphQL(r))
+	}
+
+	return deleted
+}
@@ -0,0 +1,33 @@
+package softdelete_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	conv := softdelete.NewConverter()
+
+	assert.Equal(t, fixSoftDeletedApplicationEntity(), conv.ToEntity(fixSoftDeletedApplicationModel()))
+	assert.Nil(t, conv.ToEntity(nil))
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	conv := softdelete.NewConverter()
+
+	assert.Equal(t, fixSoftDeletedApplicationModel(), conv.FromEntity(fixSoftDeletedApplicationEntity()))
+	assert.Nil(t, conv.FromEntity(nil))
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := softdelete.NewConverter()
+
+	result := conv.MultipleToGraphQL([]*model.SoftDeletedResource{fixSoftDeletedApplicationModel(), nil})
+
+	assert.Equal(t, []*graphql.DeletedApplication{fixDeletedApplicationGraphQL()}, result)
+	assert.Empty(t, conv.MultipleToGraphQL(nil))
+}
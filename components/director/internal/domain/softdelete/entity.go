@@ -0,0 +1,27 @@
+package softdelete
+
+import "time"
+
+// Entity represents a soft deleted resource in the database
+type Entity struct {
+	ID           string    `db:"id"`
+	ResourceType string    `db:"resource_type"`
+	TenantID     string    `db:"tenant_id"`
+	Name         string    `db:"name"`
+	DeletedAt    time.Time `db:"deleted_at"`
+	PurgeAfter   time.Time `db:"purge_after"`
+}
+
+// EntityCollection is a collection of soft deleted resource entities
+type EntityCollection []Entity
+
+// Len returns the number of entities in the collection
+func (c EntityCollection) Len() int {
+	return len(c)
+}
+
+// archivedLabel is the key and JSON value of a label archived together with a soft deleted resource
+type archivedLabel struct {
+	Key   string `db:"key"`
+	Value string `db:"value"`
+}
@@ -0,0 +1,13 @@
+package softdelete
+
+import "time"
+
+var PurgeExpired = purgeExpired
+
+func (s *service) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
+
+func (p *purger) SetTimestampGen(timestampGen func() time.Time) {
+	p.timestampGen = timestampGen
+}
@@ -0,0 +1,108 @@
+package softdelete_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	pkgmodel "github.com/kyma-incubator/compass/components/director/pkg/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	appID     = "c5ab8c1b-4f1e-4a42-8a45-2b31f2a5e0b7"
+	runtimeID = "4f5f6e1a-8c1e-4f58-9c1f-0e9f1c7d3b21"
+	tenantID  = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	appName   = "my-app"
+
+	systemAuthID    = "0c0f5f21-7b6c-4e39-8d0e-6a3f6b0f4b5e"
+	clientID        = "client-id"
+	selfRegLabelKey = "subdomain"
+)
+
+var (
+	deletedAt       = time.Date(2024, 5, 20, 9, 0, 0, 0, time.UTC)
+	retentionPeriod = 72 * time.Hour
+	purgeAfter      = deletedAt.Add(retentionPeriod)
+	tableColumns    = []string{"id", "resource_type", "tenant_id", "name", "deleted_at", "purge_after"}
+)
+
+func fixSoftDeletedApplicationModel() *model.SoftDeletedResource {
+	return &model.SoftDeletedResource{
+		ID:           appID,
+		ResourceType: resource.Application,
+		TenantID:     tenantID,
+		Name:         appName,
+		DeletedAt:    deletedAt,
+		PurgeAfter:   purgeAfter,
+	}
+}
+
+func fixSoftDeletedApplicationEntity() *softdelete.Entity {
+	return &softdelete.Entity{
+		ID:           appID,
+		ResourceType: string(resource.Application),
+		TenantID:     tenantID,
+		Name:         appName,
+		DeletedAt:    deletedAt,
+		PurgeAfter:   purgeAfter,
+	}
+}
+
+func fixArchivedSystemAuthEntity() systemauth.Entity {
+	return systemauth.Entity{
+		ID:        systemAuthID,
+		TenantID:  sql.NullString{String: tenantID, Valid: true},
+		AppID:     sql.NullString{String: appID, Valid: true},
+		RuntimeID: sql.NullString{},
+		Value:     sql.NullString{String: `{"Credential":{"Oauth":{"ClientID":"client-id"}}}`, Valid: true},
+	}
+}
+
+func fixArchivedSystemAuth() pkgmodel.SystemAuth {
+	return pkgmodel.SystemAuth{
+		ID:       systemAuthID,
+		TenantID: str.Ptr(tenantID),
+		AppID:    str.Ptr(appID),
+		Value: &model.Auth{
+			Credential: model.CredentialData{
+				Oauth: &model.OAuthCredentialData{ClientID: clientID},
+			},
+		},
+	}
+}
+
+func fixDeletedApplicationGraphQL() *graphql.DeletedApplication {
+	return &graphql.DeletedApplication{
+		ID:         appID,
+		Name:       appName,
+		DeletedAt:  graphql.Timestamp(deletedAt),
+		PurgeAfter: graphql.Timestamp(purgeAfter),
+	}
+}
+
+func fixSoftDeletedApplicationPage() *model.SoftDeletedResourcePage {
+	return &model.SoftDeletedResourcePage{
+		Data: []*model.SoftDeletedResource{fixSoftDeletedApplicationModel()},
+		PageInfo: &pagination.Page{
+			StartCursor: "",
+			EndCursor:   "",
+			HasNextPage: false,
+		},
+		TotalCount: 1,
+	}
+}
+
+var testErr = errors.New("test error")
+
+func ctxWithTenant() context.Context {
+	return tenant.SaveToContext(context.TODO(), tenantID, "external-"+tenantID)
+}
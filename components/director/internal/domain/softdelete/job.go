@@ -0,0 +1,88 @@
+package softdelete
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/cronjob"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// Purger permanently deletes the soft deleted resources whose retention period has ended
+//
+//go:generate mockery --name=Purger --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Purger interface {
+	ListExpired(ctx context.Context) ([]*model.SoftDeletedResource, error)
+	Purge(ctx context.Context, item *model.SoftDeletedResource) error
+}
+
+// StartPurgeJob starts the job which purges expired soft deleted resources and blocks.
+// Only the leader instance executes the job.
+func StartPurgeJob(ctx context.Context, cfg Config, electionCfg cronjob.ElectionConfig, transact persistence.Transactioner, purger Purger) error {
+	purgeJob := cronjob.CronJob{
+		Name: "PurgeSoftDeletedResources",
+		Fn: func(jobCtx context.Context) {
+			purged, err := purgeExpired(jobCtx, transact, purger)
+			if err != nil {
+				log.C(jobCtx).WithError(err).Error("Failed to purge soft deleted resources")
+				return
+			}
+			log.C(jobCtx).Infof("Purged %d soft deleted resources", purged)
+		},
+		SchedulePeriod: cfg.PurgeJobInterval,
+	}
+	return cronjob.RunCronJob(ctx, electionCfg, purgeJob)
+}
+
+// purgeExpired purges every expired resource in its own transaction, so that a resource which fails to be purged
+// is retried on the next run without affecting the others. It returns the number of purged resources.
+func purgeExpired(ctx context.Context, transact persistence.Transactioner, purger Purger) (int, error) {
+	expired, err := listExpired(ctx, transact, purger)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, item := range expired {
+		if err = purgeOne(ctx, transact, purger, item); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to purge soft deleted %s with ID %s. It will be retried on the next run", item.ResourceType, item.ID)
+			continue
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+func listExpired(ctx context.Context, transact persistence.Transactioner, purger Purger) ([]*model.SoftDeletedResource, error) {
+	tx, err := transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer transact.RollbackUnlessCommitted(ctx, tx)
+
+	expired, err := purger.ListExpired(persistence.SaveToContext(ctx, tx))
+	if err != nil {
+		return nil, err
+	}
+
+	return expired, tx.Commit()
+}
+
+// purgeOne commits the deletion of the resource only after its OAuth 2.0 clients and self-registration are cleaned up,
+// so that a failed cleanup leaves the resource archived and is retried on the next run.
+func purgeOne(ctx context.Context, transact persistence.Transactioner, purger Purger, item *model.SoftDeletedResource) error {
+	tx, err := transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer transact.RollbackUnlessCommitted(ctx, tx)
+
+	if err = purger.Purge(persistence.SaveToContext(ctx, tx), item); err != nil {
+		return err
+	}
+
+	return errors.Wrapf(tx.Commit(), "while committing the purge of %s with ID %s", item.ResourceType, item.ID)
+}
@@ -0,0 +1,66 @@
+package softdelete_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeExpired(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	runtimeResource := &model.SoftDeletedResource{ID: runtimeID, ResourceType: resource.Runtime, TenantID: tenantID}
+	expired := []*model.SoftDeletedResource{fixSoftDeletedApplicationModel(), runtimeResource}
+
+	t.Run("Purges every resource in its own transaction and skips the failed ones", func(t *testing.T) {
+		// GIVEN
+		persistTx, transact := txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(2)
+		purger := &automock.Purger{}
+		purger.On("ListExpired", txtest.CtxWithDBMatcher()).Return(expired, nil).Once()
+		purger.On("Purge", txtest.CtxWithDBMatcher(), fixSoftDeletedApplicationModel()).Return(testErr).Once()
+		purger.On("Purge", txtest.CtxWithDBMatcher(), runtimeResource).Return(nil).Once()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact, purger)
+
+		// WHEN
+		purged, err := softdelete.PurgeExpired(ctxWithTenant(), transact, purger)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 1, purged)
+	})
+
+	t.Run("Error when listing the expired resources fails", func(t *testing.T) {
+		// GIVEN
+		persistTx, transact := txGen.ThatDoesntExpectCommit()
+		purger := &automock.Purger{}
+		purger.On("ListExpired", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact, purger)
+
+		// WHEN
+		purged, err := softdelete.PurgeExpired(ctxWithTenant(), transact, purger)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		require.Equal(t, 0, purged)
+	})
+
+	t.Run("Error when beginning the transaction fails", func(t *testing.T) {
+		// GIVEN
+		persistTx, transact := txGen.ThatFailsOnBegin()
+		purger := &automock.Purger{}
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact, purger)
+
+		// WHEN
+		_, err := softdelete.PurgeExpired(ctxWithTenant(), transact, purger)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+	})
+}
@@ -0,0 +1,107 @@
+package softdelete
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/selfregmanager"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	pkgmodel "github.com/kyma-incubator/compass/components/director/pkg/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// OAuth20Service is responsible for deleting the OAuth 2.0 clients of the purged resources
+//
+//go:generate mockery --name=OAuth20Service --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OAuth20Service interface {
+	DeleteMultipleClientCredentials(ctx context.Context, auths []pkgmodel.SystemAuth) error
+}
+
+// SelfRegisterManager is responsible for cleaning up the self-registration of the purged runtimes
+//
+//go:generate mockery --name=SelfRegisterManager --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SelfRegisterManager interface {
+	CleanupPurgedSelfRegistration(ctx context.Context, resourceID, region string) error
+	GetSelfRegDistinguishingLabelKey() string
+}
+
+type purger struct {
+	repo           SoftDeletedResourceRepository
+	oAuth20Svc     OAuth20Service
+	selfRegManager SelfRegisterManager
+	timestampGen   timestamp.Generator
+}
+
+// NewPurger returns a new purger which permanently deletes the soft deleted resources whose retention period has ended.
+// The cleanup which cannot be reverted on restore, such as deleting OAuth 2.0 clients and self-registrations, is done on purge.
+func NewPurger(repo SoftDeletedResourceRepository, oAuth20Svc OAuth20Service, selfRegManager SelfRegisterManager) *purger {
+	return &purger{
+		repo:           repo,
+		oAuth20Svc:     oAuth20Svc,
+		selfRegManager: selfRegManager,
+		timestampGen:   timestamp.DefaultGenerator,
+	}
+}
+
+// ListExpired returns the soft deleted resources of all tenants whose retention period has ended
+func (p *purger) ListExpired(ctx context.Context) ([]*model.SoftDeletedResource, error) {
+	expired, err := p.repo.ListExpiredGlobal(ctx, p.timestampGen())
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing expired soft deleted resources")
+	}
+
+	return expired, nil
+}
+
+// Purge permanently deletes the soft deleted resource together with its OAuth 2.0 clients and self-registration
+func (p *purger) Purge(ctx context.Context, item *model.SoftDeletedResource) error {
+	auths, err := p.repo.ListArchivedSystemAuthsGlobal(ctx, item.ID)
+	if err != nil {
+		return errors.Wrapf(err, "while listing the system auths of soft deleted %s with ID %s", item.ResourceType, item.ID)
+	}
+
+	var selfRegRegion string
+	if item.ResourceType == resource.Runtime {
+		if selfRegRegion, err = p.selfRegistrationRegion(ctx, item.ID); err != nil {
+			return err
+		}
+	}
+
+	if err = p.repo.PurgeGlobal(ctx, item.ResourceType, item.ID); err != nil {
+		return errors.Wrapf(err, "while purging soft deleted %s with ID %s", item.ResourceType, item.ID)
+	}
+
+	if err = p.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths); err != nil {
+		return errors.Wrapf(err, "while deleting the OAuth 2.0 clients of %s with ID %s", item.ResourceType, item.ID)
+	}
+
+	if selfRegRegion != "" {
+		log.C(ctx).Infof("Executing clean-up for purged self-registered runtime with id %q", item.ID)
+		if err = p.selfRegManager.CleanupPurgedSelfRegistration(ctx, item.ID, selfRegRegion); err != nil {
+			return errors.Wrapf(err, "while cleaning up the self-registration of runtime with ID %s", item.ID)
+		}
+	}
+
+	return nil
+}
+
+// selfRegistrationRegion returns the region of the soft deleted runtime if it was self-registered, or an empty string otherwise
+func (p *purger) selfRegistrationRegion(ctx context.Context, id string) (string, error) {
+	labels, err := p.repo.ListArchivedLabelsGlobal(ctx, id)
+	if err != nil {
+		return "", errors.Wrapf(err, "while listing the labels of soft deleted runtime with ID %s", id)
+	}
+
+	if _, ok := labels[p.selfRegManager.GetSelfRegDistinguishingLabelKey()]; !ok {
+		return "", nil
+	}
+
+	region, ok := labels[selfregmanager.RegionLabel].(string)
+	if !ok || region == "" {
+		return "", errors.Errorf("self-registered runtime with ID %s has no region label", id)
+	}
+
+	return region, nil
+}
@@ -0,0 +1,249 @@
+package softdelete_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/selfregmanager"
+	pkgmodel "github.com/kyma-incubator/compass/components/director/pkg/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurger_ListExpired(t *testing.T) {
+	ctx := context.TODO()
+	now := purgeAfter.Add(time.Second)
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.SoftDeletedResourceRepository{}
+		repo.On("ListExpiredGlobal", ctx, now).Return([]*model.SoftDeletedResource{fixSoftDeletedApplicationModel()}, nil).Once()
+		defer repo.AssertExpectations(t)
+
+		purger := softdelete.NewPurger(repo, nil, nil)
+		purger.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		expired, err := purger.ListExpired(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.SoftDeletedResource{fixSoftDeletedApplicationModel()}, expired)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		repo := &automock.SoftDeletedResourceRepository{}
+		repo.On("ListExpiredGlobal", ctx, now).Return(nil, testErr).Once()
+		defer repo.AssertExpectations(t)
+
+		purger := softdelete.NewPurger(repo, nil, nil)
+		purger.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		_, err := purger.ListExpired(ctx)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestPurger_Purge(t *testing.T) {
+	ctx := context.TODO()
+	region := "eu-1"
+	auths := []pkgmodel.SystemAuth{fixArchivedSystemAuth()}
+	runtimeResource := &model.SoftDeletedResource{ID: runtimeID, ResourceType: resource.Runtime, TenantID: tenantID}
+	selfRegLabels := map[string]interface{}{selfRegLabelKey: "self-reg-value", selfregmanager.RegionLabel: region}
+
+	testCases := []struct {
+		Name           string
+		Item           *model.SoftDeletedResource
+		Repo           func() *automock.SoftDeletedResourceRepository
+		OAuth20Svc     func() *automock.OAuth20Service
+		SelfRegManager func() *automock.SelfRegisterManager
+		ExpectedError  string
+	}{
+		{
+			Name: "Success for application",
+			Item: fixSoftDeletedApplicationModel(),
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("ListArchivedSystemAuthsGlobal", ctx, appID).Return(auths, nil).Once()
+				repo.On("PurgeGlobal", ctx, resource.Application, appID).Return(nil).Once()
+				return repo
+			},
+			OAuth20Svc: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", ctx, auths).Return(nil).Once()
+				return svc
+			},
+			SelfRegManager: func() *automock.SelfRegisterManager {
+				return &automock.SelfRegisterManager{}
+			},
+		},
+		{
+			Name: "Success for self-registered runtime",
+			Item: runtimeResource,
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("ListArchivedSystemAuthsGlobal", ctx, runtimeID).Return(auths, nil).Once()
+				repo.On("ListArchivedLabelsGlobal", ctx, runtimeID).Return(selfRegLabels, nil).Once()
+				repo.On("PurgeGlobal", ctx, resource.Runtime, runtimeID).Return(nil).Once()
+				return repo
+			},
+			OAuth20Svc: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", ctx, auths).Return(nil).Once()
+				return svc
+			},
+			SelfRegManager: func() *automock.SelfRegisterManager {
+				manager := &automock.SelfRegisterManager{}
+				manager.On("GetSelfRegDistinguishingLabelKey").Return(selfRegLabelKey).Once()
+				manager.On("CleanupPurgedSelfRegistration", ctx, runtimeID, region).Return(nil).Once()
+				return manager
+			},
+		},
+		{
+			Name: "Success for runtime which is not self-registered",
+			Item: runtimeResource,
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("ListArchivedSystemAuthsGlobal", ctx, runtimeID).Return(nil, nil).Once()
+				repo.On("ListArchivedLabelsGlobal", ctx, runtimeID).Return(map[string]interface{}{}, nil).Once()
+				repo.On("PurgeGlobal", ctx, resource.Runtime, runtimeID).Return(nil).Once()
+				return repo
+			},
+			OAuth20Svc: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", ctx, []pkgmodel.SystemAuth(nil)).Return(nil).Once()
+				return svc
+			},
+			SelfRegManager: func() *automock.SelfRegisterManager {
+				manager := &automock.SelfRegisterManager{}
+				manager.On("GetSelfRegDistinguishingLabelKey").Return(selfRegLabelKey).Once()
+				return manager
+			},
+		},
+		{
+			Name: "Error when self-registered runtime has no region",
+			Item: runtimeResource,
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("ListArchivedSystemAuthsGlobal", ctx, runtimeID).Return(auths, nil).Once()
+				repo.On("ListArchivedLabelsGlobal", ctx, runtimeID).Return(map[string]interface{}{selfRegLabelKey: "self-reg-value"}, nil).Once()
+				return repo
+			},
+			OAuth20Svc: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			SelfRegManager: func() *automock.SelfRegisterManager {
+				manager := &automock.SelfRegisterManager{}
+				manager.On("GetSelfRegDistinguishingLabelKey").Return(selfRegLabelKey).Once()
+				return manager
+			},
+			ExpectedError: "has no region label",
+		},
+		{
+			Name: "Error when listing the archived system auths fails",
+			Item: fixSoftDeletedApplicationModel(),
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("ListArchivedSystemAuthsGlobal", ctx, appID).Return(nil, testErr).Once()
+				return repo
+			},
+			OAuth20Svc: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			SelfRegManager: func() *automock.SelfRegisterManager {
+				return &automock.SelfRegisterManager{}
+			},
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when purging fails",
+			Item: fixSoftDeletedApplicationModel(),
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("ListArchivedSystemAuthsGlobal", ctx, appID).Return(auths, nil).Once()
+				repo.On("PurgeGlobal", ctx, resource.Application, appID).Return(testErr).Once()
+				return repo
+			},
+			OAuth20Svc: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			SelfRegManager: func() *automock.SelfRegisterManager {
+				return &automock.SelfRegisterManager{}
+			},
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when deleting the OAuth 2.0 clients fails",
+			Item: fixSoftDeletedApplicationModel(),
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("ListArchivedSystemAuthsGlobal", ctx, appID).Return(auths, nil).Once()
+				repo.On("PurgeGlobal", ctx, resource.Application, appID).Return(nil).Once()
+				return repo
+			},
+			OAuth20Svc: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", ctx, auths).Return(testErr).Once()
+				return svc
+			},
+			SelfRegManager: func() *automock.SelfRegisterManager {
+				return &automock.SelfRegisterManager{}
+			},
+			ExpectedError: "while deleting the OAuth 2.0 clients",
+		},
+		{
+			Name: "Error when cleaning up the self-registration fails",
+			Item: runtimeResource,
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("ListArchivedSystemAuthsGlobal", ctx, runtimeID).Return(auths, nil).Once()
+				repo.On("ListArchivedLabelsGlobal", ctx, runtimeID).Return(selfRegLabels, nil).Once()
+				repo.On("PurgeGlobal", ctx, resource.Runtime, runtimeID).Return(nil).Once()
+				return repo
+			},
+			OAuth20Svc: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", ctx, auths).Return(nil).Once()
+				return svc
+			},
+			SelfRegManager: func() *automock.SelfRegisterManager {
+				manager := &automock.SelfRegisterManager{}
+				manager.On("GetSelfRegDistinguishingLabelKey").Return(selfRegLabelKey).Once()
+				manager.On("CleanupPurgedSelfRegistration", ctx, runtimeID, region).Return(testErr).Once()
+				return manager
+			},
+			ExpectedError: "while cleaning up the self-registration",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.Repo()
+			oAuth20Svc := testCase.OAuth20Svc()
+			selfRegManager := testCase.SelfRegManager()
+
+			purger := softdelete.NewPurger(repo, oAuth20Svc, selfRegManager)
+
+			// WHEN
+			err := purger.Purge(ctx, testCase.Item)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, oAuth20Svc, selfRegManager)
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package softdelete
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	pkgmodel "github.com/kyma-incubator/compass/components/director/pkg/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableName            = "public.soft_deleted_resources"
+	labelsTableName      = "public.labels"
+	systemAuthsTableName = "public.system_auths"
+	webhooksTableName    = "public.webhooks"
+	idColumn             = "id"
+	tenantColumn         = "tenant_id"
+	resourceTypeColumn   = "resource_type"
+	deletedAtColumn      = "deleted_at"
+	purgeAfterColumn     = "purge_after"
+
+	archiveQuery = `INSERT INTO %[1]s (id, resource_type, tenant_id, name, deleted_at, purge_after, tenant_access, labels, system_auths, webhooks)
+		SELECT r.id, $2, $3, r.name, $4, $5,
+			(SELECT COALESCE(jsonb_agg(to_jsonb(ta)), '[]'::jsonb) FROM %[3]s ta WHERE ta.id = r.id),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(l)), '[]'::jsonb) FROM %[4]s l WHERE l.%[5]s = r.id),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(sa)), '[]'::jsonb) FROM %[6]s sa WHERE sa.%[5]s = r.id),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(w)), '[]'::jsonb) FROM %[7]s w WHERE w.%[5]s = r.id)
+		FROM %[2]s r
+		WHERE r.id = $1 AND r.id IN (SELECT id FROM %[3]s WHERE tenant_id = $3 AND owner = true)`
+	deleteByObjectQuery      = `DELETE FROM %s WHERE %s = $1`
+	deleteTenantAccessQuery  = `DELETE FROM %s WHERE id = $1`
+	restoreTenantAccessQuery = `INSERT INTO %[2]s
+		SELECT ta.* FROM %[1]s s, jsonb_populate_recordset(NULL::%[2]s, s.tenant_access) ta
+		WHERE s.id = $1 AND ta.tenant_id IN (SELECT id FROM public.business_tenant_mappings)
+		ON CONFLICT DO NOTHING`
+	restoreLabelsQuery = `INSERT INTO %[2]s
+		SELECT l.* FROM %[1]s s, jsonb_populate_recordset(NULL::%[2]s, s.labels) l
+		WHERE s.id = $1 AND (l.tenant_id IS NULL OR l.tenant_id IN (SELECT id FROM public.business_tenant_mappings))
+		ON CONFLICT DO NOTHING`
+	restoreSystemAuthsQuery = `INSERT INTO %[2]s
+		SELECT sa.* FROM %[1]s s, jsonb_populate_recordset(NULL::%[2]s, s.system_auths) sa
+		WHERE s.id = $1 AND (sa.tenant_id IS NULL OR sa.tenant_id IN (SELECT id FROM public.business_tenant_mappings))
+		ON CONFLICT DO NOTHING`
+	restoreWebhooksQuery = `INSERT INTO %[2]s
+		SELECT w.* FROM %[1]s s, jsonb_populate_recordset(NULL::%[2]s, s.webhooks) w
+		WHERE s.id = $1
+		ON CONFLICT DO NOTHING`
+	archivedSystemAuthsQuery = `SELECT sa.id, sa.tenant_id, sa.app_id, sa.runtime_id, sa.integration_system_id, sa.value
+		FROM %[1]s s, jsonb_populate_recordset(NULL::%[2]s, s.system_auths) sa
+		WHERE s.id = $1`
+	archivedLabelsQuery = `SELECT l.key, l.value
+		FROM %[1]s s, jsonb_populate_recordset(NULL::%[2]s, s.labels) l
+		WHERE s.id = $1`
+)
+
+var (
+	tableColumns = []string{idColumn, resourceTypeColumn, tenantColumn, "name", deletedAtColumn, purgeAfterColumn}
+
+	// objectColumns maps the soft deletable resource types to the column which references them in the labels, system auths and webhooks tables
+	objectColumns = map[resource.Type]string{
+		resource.Application: "app_id",
+		resource.Runtime:     "runtime_id",
+	}
+)
+
+// EntityConverter converts between the model and the entity of a soft deleted resource
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.SoftDeletedResource) *Entity
+	FromEntity(in *Entity) *model.SoftDeletedResource
+}
+
+// SystemAuthConverter converts the archived system auths of a soft deleted resource
+//
+//go:generate mockery --name=SystemAuthConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SystemAuthConverter interface {
+	FromEntity(in systemauth.Entity) (pkgmodel.SystemAuth, error)
+}
+
+type repository struct {
+	getter         repo.SingleGetter
+	pageableLister repo.PageableQuerier
+	listerGlobal   repo.ListerGlobal
+	deleterGlobal  repo.DeleterGlobal
+	// resourceDeleters delete the soft deletable resources themselves when they are purged
+	resourceDeleters map[resource.Type]repo.DeleterGlobal
+	conv             EntityConverter
+	systemAuthConv   SystemAuthConverter
+}
+
+// NewRepository returns a new soft deleted resources repository
+func NewRepository(conv EntityConverter, systemAuthConv SystemAuthConverter) *repository {
+	return &repository{
+		getter:         repo.NewSingleGetterWithEmbeddedTenant(tableName, tenantColumn, tableColumns),
+		pageableLister: repo.NewPageableQuerierWithEmbeddedTenant(tableName, tenantColumn, tableColumns),
+		listerGlobal:   repo.NewListerGlobal(resource.SoftDeletedResource, tableName, tableColumns),
+		deleterGlobal:  repo.NewDeleterGlobal(resource.SoftDeletedResource, tableName),
+		resourceDeleters: map[resource.Type]repo.DeleterGlobal{
+			resource.Application: repo.NewDeleterGlobal(resource.Application, resource.TopLevelEntities[resource.Application]),
+			resource.Runtime:     repo.NewDeleterGlobal(resource.Runtime, resource.TopLevelEntities[resource.Runtime]),
+		},
+		conv:           conv,
+		systemAuthConv: systemAuthConv,
+	}
+}
+
+// Archive records the resource as soft deleted together with a snapshot of its labels, tenant accesses, system auths and webhooks, and then removes them.
+// Without tenant accesses the resource is no longer visible to any tenant, and without system auths and webhooks it can neither
+// authenticate nor be called by the components which process webhooks. Only the owner tenant of the resource can archive it.
+func (r *repository) Archive(ctx context.Context, item *model.SoftDeletedResource) error {
+	if item == nil {
+		return errors.New("soft deleted resource cannot be empty")
+	}
+
+	resourceTable, accessTable, objectColumn, err := resourceTables(item.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	entity := r.conv.ToEntity(item)
+	stmt := fmt.Sprintf(archiveQuery, tableName, resourceTable, accessTable, labelsTableName, objectColumn, systemAuthsTableName, webhooksTableName)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	res, err := persist.ExecContext(ctx, stmt, entity.ID, entity.ResourceType, entity.TenantID, entity.DeletedAt, entity.PurgeAfter)
+	if err != nil {
+		return persistence.MapSQLError(ctx, err, resource.SoftDeletedResource, resource.Create, "while archiving %s with ID %s", item.ResourceType, item.ID)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "while checking affected rows")
+	}
+	if affected == 0 {
+		return apperrors.NewNotFoundError(item.ResourceType, item.ID)
+	}
+
+	archivedTables := []struct {
+		table        string
+		resourceType resource.Type
+		description  string
+	}{
+		{table: labelsTableName, resourceType: resource.Label, description: "labels"},
+		{table: systemAuthsTableName, resourceType: resource.SystemAuth, description: "system auths"},
+		{table: webhooksTableName, resourceType: resource.Webhook, description: "webhooks"},
+	}
+	for _, archived := range archivedTables {
+		stmt = fmt.Sprintf(deleteByObjectQuery, archived.table, objectColumn)
+		log.C(ctx).Debugf("Executing DB query: %s", stmt)
+		if _, err = persist.ExecContext(ctx, stmt, item.ID); err != nil {
+			return persistence.MapSQLError(ctx, err, archived.resourceType, resource.Delete, "while deleting the %s of %s with ID %s", archived.description, item.ResourceType, item.ID)
+		}
+	}
+
+	stmt = fmt.Sprintf(deleteTenantAccessQuery, accessTable)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if _, err = persist.ExecContext(ctx, stmt, item.ID); err != nil {
+		return persistence.MapSQLError(ctx, err, resource.TenantAccess, resource.Delete, "while deleting the tenant accesses of %s with ID %s", item.ResourceType, item.ID)
+	}
+
+	return nil
+}
+
+// Restore re-creates the archived labels, tenant accesses, system auths and webhooks of the resource and removes it from the archive.
+// Accesses, labels and system auths of tenants which no longer exist are skipped.
+func (r *repository) Restore(ctx context.Context, resourceType resource.Type, id string) error {
+	_, accessTable, _, err := resourceTables(resourceType)
+	if err != nil {
+		return err
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	stmt := fmt.Sprintf(restoreTenantAccessQuery, tableName, accessTable)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if _, err = persist.ExecContext(ctx, stmt, id); err != nil {
+		return persistence.MapSQLError(ctx, err, resource.TenantAccess, resource.Create, "while restoring the tenant accesses of %s with ID %s", resourceType, id)
+	}
+
+	stmt = fmt.Sprintf(restoreLabelsQuery, tableName, labelsTableName)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if _, err = persist.ExecContext(ctx, stmt, id); err != nil {
+		return persistence.MapSQLError(ctx, err, resource.Label, resource.Create, "while restoring the labels of %s with ID %s", resourceType, id)
+	}
+
+	stmt = fmt.Sprintf(restoreSystemAuthsQuery, tableName, systemAuthsTableName)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if _, err = persist.ExecContext(ctx, stmt, id); err != nil {
+		return persistence.MapSQLError(ctx, err, resource.SystemAuth, resource.Create, "while restoring the system auths of %s with ID %s", resourceType, id)
+	}
+
+	stmt = fmt.Sprintf(restoreWebhooksQuery, tableName, webhooksTableName)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if _, err = persist.ExecContext(ctx, stmt, id); err != nil {
+		return persistence.MapSQLError(ctx, err, resource.Webhook, resource.Create, "while restoring the webhooks of %s with ID %s", resourceType, id)
+	}
+
+	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition(idColumn, id)})
+}
+
+// GetByID returns the soft deleted resource of the given type owned by the tenant
+func (r *repository) GetByID(ctx context.Context, tenant string, resourceType resource.Type, id string) (*model.SoftDeletedResource, error) {
+	var entity Entity
+	conditions := repo.Conditions{
+		repo.NewEqualCondition(idColumn, id),
+		repo.NewEqualCondition(resourceTypeColumn, string(resourceType)),
+	}
+	if err := r.getter.Get(ctx, resource.SoftDeletedResource, tenant, conditions, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// List returns a page of the soft deleted resources of the given type owned by the tenant
+func (r *repository) List(ctx context.Context, tenant string, resourceType resource.Type, pageSize int, cursor string) (*model.SoftDeletedResourcePage, error) {
+	var entities EntityCollection
+	page, totalCount, err := r.pageableLister.List(ctx, resource.SoftDeletedResource, tenant, pageSize, cursor, deletedAtColumn, &entities, repo.NewEqualCondition(resourceTypeColumn, string(resourceType)))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.SoftDeletedResource, 0, len(entities))
+	for i := range entities {
+		items = append(items, r.conv.FromEntity(&entities[i]))
+	}
+
+	return &model.SoftDeletedResourcePage{
+		Data:       items,
+		PageInfo:   page,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// ListExpiredGlobal returns the soft deleted resources of all tenants whose retention period ended before the given time
+func (r *repository) ListExpiredGlobal(ctx context.Context, before time.Time) ([]*model.SoftDeletedResource, error) {
+	var entities EntityCollection
+	if err := r.listerGlobal.ListGlobal(ctx, &entities, repo.NewLessThanCondition(purgeAfterColumn, before)); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.SoftDeletedResource, 0, len(entities))
+	for i := range entities {
+		items = append(items, r.conv.FromEntity(&entities[i]))
+	}
+
+	return items, nil
+}
+
+// PurgeGlobal permanently deletes the soft deleted resource and its archive record
+func (r *repository) PurgeGlobal(ctx context.Context, resourceType resource.Type, id string) error {
+	resourceDeleter, ok := r.resourceDeleters[resourceType]
+	if !ok {
+		return errors.Errorf("resource type %q cannot be soft deleted", resourceType)
+	}
+
+	if err := resourceDeleter.DeleteManyGlobal(ctx, repo.Conditions{repo.NewEqualCondition(idColumn, id)}); err != nil {
+		return errors.Wrapf(err, "while deleting %s with ID %s", resourceType, id)
+	}
+
+	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition(idColumn, id)})
+}
+
+// ListArchivedSystemAuthsGlobal returns the system auths archived together with the soft deleted resource
+func (r *repository) ListArchivedSystemAuthsGlobal(ctx context.Context, id string) ([]pkgmodel.SystemAuth, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities systemauth.Collection
+	stmt := fmt.Sprintf(archivedSystemAuthsQuery, tableName, systemAuthsTableName)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if err = persist.SelectContext(ctx, &entities, stmt, id); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.SystemAuth, resource.List, "while listing the archived system auths of resource with ID %s", id)
+	}
+
+	auths := make([]pkgmodel.SystemAuth, 0, len(entities))
+	for _, entity := range entities {
+		auth, err := r.systemAuthConv.FromEntity(entity)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting archived system auth with ID %s", entity.ID)
+		}
+		auths = append(auths, auth)
+	}
+
+	return auths, nil
+}
+
+// ListArchivedLabelsGlobal returns the values of the labels archived together with the soft deleted resource by their keys
+func (r *repository) ListArchivedLabelsGlobal(ctx context.Context, id string) (map[string]interface{}, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []archivedLabel
+	stmt := fmt.Sprintf(archivedLabelsQuery, tableName, labelsTableName)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if err = persist.SelectContext(ctx, &entities, stmt, id); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.Label, resource.List, "while listing the archived labels of resource with ID %s", id)
+	}
+
+	labels := make(map[string]interface{}, len(entities))
+	for _, entity := range entities {
+		var value interface{}
+		if err = json.Unmarshal([]byte(entity.Value), &value); err != nil {
+			return nil, errors.Wrapf(err, "while unmarshalling the value of archived label %q", entity.Key)
+		}
+		labels[entity.Key] = value
+	}
+
+	return labels, nil
+}
+
+func resourceTables(resourceType resource.Type) (string, string, string, error) {
+	objectColumn, ok := objectColumns[resourceType]
+	if !ok {
+		return "", "", "", errors.Errorf("resource type %q cannot be soft deleted", resourceType)
+	}
+
+	accessTable, ok := resourceType.TenantAccessTable()
+	if !ok {
+		return "", "", "", errors.Errorf("resource type %q has no tenant access table", resourceType)
+	}
+
+	return resource.TopLevelEntities[resourceType], accessTable, objectColumn, nil
+}
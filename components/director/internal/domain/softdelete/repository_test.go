@@ -0,0 +1,362 @@
+package softdelete_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/internal/selfregmanager"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	pkgmodel "github.com/kyma-incubator/compass/components/director/pkg/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Archive(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(`INSERT INTO public\.soft_deleted_resources .* FROM tenant_applications ta WHERE ta\.id = r\.id.* FROM public\.labels l WHERE l\.app_id = r\.id.* FROM public\.system_auths sa WHERE sa\.app_id = r\.id.* FROM public\.webhooks w WHERE w\.app_id = r\.id.* FROM public\.applications r`).
+			WithArgs(appID, string(resource.Application), tenantID, deletedAt, purgeAfter).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.labels WHERE app_id = $1`)).
+			WithArgs(appID).
+			WillReturnResult(sqlmock.NewResult(-1, 2))
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.system_auths WHERE app_id = $1`)).
+			WithArgs(appID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.webhooks WHERE app_id = $1`)).
+			WithArgs(appID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM tenant_applications WHERE id = $1`)).
+			WithArgs(appID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		conv := &automock.EntityConverter{}
+		conv.On("ToEntity", fixSoftDeletedApplicationModel()).Return(fixSoftDeletedApplicationEntity()).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(conv, nil)
+
+		// WHEN
+		err := repo.Archive(ctx, fixSoftDeletedApplicationModel())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when the tenant does not own the resource", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(`INSERT INTO public\.soft_deleted_resources .*`).
+			WithArgs(appID, string(resource.Application), tenantID, deletedAt, purgeAfter).
+			WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		conv := &automock.EntityConverter{}
+		conv.On("ToEntity", fixSoftDeletedApplicationModel()).Return(fixSoftDeletedApplicationEntity()).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(conv, nil)
+
+		// WHEN
+		err := repo.Archive(ctx, fixSoftDeletedApplicationModel())
+
+		// THEN
+		require.Error(t, err)
+		require.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("Error when archiving fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(`INSERT INTO public\.soft_deleted_resources .*`).
+			WithArgs(appID, string(resource.Application), tenantID, deletedAt, purgeAfter).
+			WillReturnError(testErr)
+
+		conv := &automock.EntityConverter{}
+		conv.On("ToEntity", fixSoftDeletedApplicationModel()).Return(fixSoftDeletedApplicationEntity()).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(conv, nil)
+
+		// WHEN
+		err := repo.Archive(ctx, fixSoftDeletedApplicationModel())
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+
+	t.Run("Error when the resource type cannot be soft deleted", func(t *testing.T) {
+		// GIVEN
+		repo := softdelete.NewRepository(nil, nil)
+
+		// WHEN
+		err := repo.Archive(context.TODO(), &model.SoftDeletedResource{ID: appID, ResourceType: resource.Bundle})
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "cannot be soft deleted")
+	})
+}
+
+func TestRepository_Restore(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(`INSERT INTO tenant_runtimes SELECT ta\.\* FROM public\.soft_deleted_resources s, jsonb_populate_recordset\(NULL::tenant_runtimes, s\.tenant_access\) ta .*`).
+			WithArgs(runtimeID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		dbMock.ExpectExec(`INSERT INTO public\.labels SELECT l\.\* FROM public\.soft_deleted_resources s, jsonb_populate_recordset\(NULL::public\.labels, s\.labels\) l .*`).
+			WithArgs(runtimeID).
+			WillReturnResult(sqlmock.NewResult(-1, 3))
+		dbMock.ExpectExec(`INSERT INTO public\.system_auths SELECT sa\.\* FROM public\.soft_deleted_resources s, jsonb_populate_recordset\(NULL::public\.system_auths, s\.system_auths\) sa .*`).
+			WithArgs(runtimeID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		dbMock.ExpectExec(`INSERT INTO public\.webhooks SELECT w\.\* FROM public\.soft_deleted_resources s, jsonb_populate_recordset\(NULL::public\.webhooks, s\.webhooks\) w .*`).
+			WithArgs(runtimeID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.soft_deleted_resources WHERE id = $1`)).
+			WithArgs(runtimeID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(nil, nil)
+
+		// WHEN
+		err := repo.Restore(ctx, resource.Runtime, runtimeID)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when restoring the tenant accesses fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(`INSERT INTO tenant_runtimes .*`).
+			WithArgs(runtimeID).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(nil, nil)
+
+		// WHEN
+		err := repo.Restore(ctx, resource.Runtime, runtimeID)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_GetByID(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+
+	entity := fixSoftDeletedApplicationEntity()
+	rows := sqlmock.NewRows(tableColumns).
+		AddRow(entity.ID, entity.ResourceType, entity.TenantID, entity.Name, entity.DeletedAt, entity.PurgeAfter)
+	dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, resource_type, tenant_id, name, deleted_at, purge_after FROM public.soft_deleted_resources WHERE tenant_id = $1 AND id = $2 AND resource_type = $3`)).
+		WithArgs(tenantID, appID, string(resource.Application)).
+		WillReturnRows(rows)
+
+	conv := &automock.EntityConverter{}
+	conv.On("FromEntity", entity).Return(fixSoftDeletedApplicationModel()).Once()
+	defer conv.AssertExpectations(t)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := softdelete.NewRepository(conv, nil)
+
+	// WHEN
+	result, err := repo.GetByID(ctx, tenantID, resource.Application, appID)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, fixSoftDeletedApplicationModel(), result)
+}
+
+func TestRepository_ListExpiredGlobal(t *testing.T) {
+	// GIVEN
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+
+	entity := fixSoftDeletedApplicationEntity()
+	rows := sqlmock.NewRows(tableColumns).
+		AddRow(entity.ID, entity.ResourceType, entity.TenantID, entity.Name, entity.DeletedAt, entity.PurgeAfter)
+	dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, resource_type, tenant_id, name, deleted_at, purge_after FROM public.soft_deleted_resources WHERE purge_after < $1`)).
+		WithArgs(purgeAfter).
+		WillReturnRows(rows)
+
+	conv := &automock.EntityConverter{}
+	conv.On("FromEntity", entity).Return(fixSoftDeletedApplicationModel()).Once()
+	defer conv.AssertExpectations(t)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := softdelete.NewRepository(conv, nil)
+
+	// WHEN
+	result, err := repo.ListExpiredGlobal(ctx, purgeAfter)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []*model.SoftDeletedResource{fixSoftDeletedApplicationModel()}, result)
+}
+
+func TestRepository_PurgeGlobal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.applications WHERE id = $1`)).
+			WithArgs(appID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.soft_deleted_resources WHERE id = $1`)).
+			WithArgs(appID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(nil, nil)
+
+		// WHEN
+		err := repo.PurgeGlobal(ctx, resource.Application, appID)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when deleting the resource fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.applications WHERE id = $1`)).
+			WithArgs(appID).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(nil, nil)
+
+		// WHEN
+		err := repo.PurgeGlobal(ctx, resource.Application, appID)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while deleting application with ID")
+	})
+}
+
+func TestRepository_ListArchivedSystemAuthsGlobal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixArchivedSystemAuthEntity()
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}).
+			AddRow(entity.ID, entity.TenantID, entity.AppID, entity.RuntimeID, entity.IntegrationSystemID, entity.Value)
+		dbMock.ExpectQuery(`SELECT sa\.id, .* FROM public\.soft_deleted_resources s, jsonb_populate_recordset\(NULL::public\.system_auths, s\.system_auths\) sa WHERE s\.id = \$1`).
+			WithArgs(appID).
+			WillReturnRows(rows)
+
+		systemAuthConv := &automock.SystemAuthConverter{}
+		systemAuthConv.On("FromEntity", entity).Return(fixArchivedSystemAuth(), nil).Once()
+		defer systemAuthConv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(nil, systemAuthConv)
+
+		// WHEN
+		result, err := repo.ListArchivedSystemAuthsGlobal(ctx, appID)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []pkgmodel.SystemAuth{fixArchivedSystemAuth()}, result)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(`SELECT sa\.id, .*`).
+			WithArgs(appID).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(nil, nil)
+
+		// WHEN
+		_, err := repo.ListArchivedSystemAuthsGlobal(ctx, appID)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_ListArchivedLabelsGlobal(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"key", "value"}).
+			AddRow(selfRegLabelKey, `"self-reg-value"`).
+			AddRow(selfregmanager.RegionLabel, `"eu-1"`)
+		dbMock.ExpectQuery(`SELECT l\.key, l\.value FROM public\.soft_deleted_resources s, jsonb_populate_recordset\(NULL::public\.labels, s\.labels\) l WHERE s\.id = \$1`).
+			WithArgs(runtimeID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(nil, nil)
+
+		// WHEN
+		result, err := repo.ListArchivedLabelsGlobal(ctx, runtimeID)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{selfRegLabelKey: "self-reg-value", selfregmanager.RegionLabel: "eu-1"}, result)
+	})
+
+	t.Run("Error when the label value is not JSON", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"key", "value"}).AddRow(selfRegLabelKey, "{")
+		dbMock.ExpectQuery(`SELECT l\.key, l\.value .*`).
+			WithArgs(runtimeID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := softdelete.NewRepository(nil, nil)
+
+		// WHEN
+		_, err := repo.ListArchivedLabelsGlobal(ctx, runtimeID)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while unmarshalling the value of archived label")
+	})
+}
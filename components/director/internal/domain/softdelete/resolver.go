@@ -0,0 +1,169 @@
+package softdelete
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// SoftDeleteService is responsible for the service-layer soft delete operations
+//
+//go:generate mockery --name=SoftDeleteService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SoftDeleteService interface {
+	Restore(ctx context.Context, resourceType resource.Type, id string) error
+	ListDeleted(ctx context.Context, resourceType resource.Type, pageSize int, cursor string) (*model.SoftDeletedResourcePage, error)
+}
+
+// ApplicationService is responsible for the service-layer Application operations
+//
+//go:generate mockery --name=ApplicationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationService interface {
+	Get(ctx context.Context, id string) (*model.Application, error)
+}
+
+// ApplicationConverter converts Applications to their GraphQL representation
+//
+//go:generate mockery --name=ApplicationConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationConverter interface {
+	ToGraphQL(in *model.Application) *graphql.Application
+}
+
+// RuntimeService is responsible for the service-layer Runtime operations
+//
+//go:generate mockery --name=RuntimeService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type RuntimeService interface {
+	Get(ctx context.Context, id string) (*model.Runtime, error)
+}
+
+// RuntimeConverter converts Runtimes to their GraphQL representation
+//
+//go:generate mockery --name=RuntimeConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type RuntimeConverter interface {
+	ToGraphQL(in *model.Runtime) *graphql.Runtime
+}
+
+// Converter converts soft deleted applications to their GraphQL representation
+//
+//go:generate mockery --name=Converter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Converter interface {
+	MultipleToGraphQL(in []*model.SoftDeletedResource) []*graphql.DeletedApplication
+}
+
+// Resolver is the soft delete resolver
+type Resolver struct {
+	transact    persistence.Transactioner
+	svc         SoftDeleteService
+	conv        Converter
+	appSvc      ApplicationService
+	appConv     ApplicationConverter
+	runtimeSvc  RuntimeService
+	runtimeConv RuntimeConverter
+}
+
+// NewResolver creates a new soft delete resolver
+func NewResolver(transact persistence.Transactioner, svc SoftDeleteService, conv Converter, appSvc ApplicationService, appConv ApplicationConverter, runtimeSvc RuntimeService, runtimeConv RuntimeConverter) *Resolver {
+	return &Resolver{
+		transact:    transact,
+		svc:         svc,
+		conv:        conv,
+		appSvc:      appSvc,
+		appConv:     appConv,
+		runtimeSvc:  runtimeSvc,
+		runtimeConv: runtimeConv,
+	}
+}
+
+// DeletedApplications returns a page of the soft deleted applications of the tenant which are not purged yet
+func (r *Resolver) DeletedApplications(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.DeletedApplicationPage, error) {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	page, err := r.svc.ListDeleted(ctx, resource.Application, *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &graphql.DeletedApplicationPage{
+		Data:       r.conv.MultipleToGraphQL(page.Data),
+		TotalCount: page.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(page.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(page.PageInfo.EndCursor),
+			HasNextPage: page.PageInfo.HasNextPage,
+		},
+	}, nil
+}
+
+// RestoreApplication restores a soft deleted application together with its labels and tenant accesses
+func (r *Resolver) RestoreApplication(ctx context.Context, id string) (*graphql.Application, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = r.svc.Restore(ctx, resource.Application, id); err != nil {
+		return nil, err
+	}
+
+	app, err := r.appSvc.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting restored Application with id %s", id)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.appConv.ToGraphQL(app), nil
+}
+
+// RestoreRuntime restores a soft deleted runtime together with its labels and tenant accesses
+func (r *Resolver) RestoreRuntime(ctx context.Context, id string) (*graphql.Runtime, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = r.svc.Restore(ctx, resource.Runtime, id); err != nil {
+		return nil, err
+	}
+
+	runtime, err := r.runtimeSvc.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting restored Runtime with id %s", id)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.runtimeConv.ToGraphQL(runtime), nil
+}
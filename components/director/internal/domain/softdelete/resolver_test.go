@@ -0,0 +1,340 @@
+package softdelete_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_DeletedApplications(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	first := 50
+	after := graphql.PageCursor("cursor")
+	gqlDeleted := []*graphql.DeletedApplication{fixDeletedApplicationGraphQL()}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.SoftDeleteService
+		ConverterFn    func() *automock.Converter
+		First          *int
+		ExpectedOutput *graphql.DeletedApplicationPage
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("ListDeleted", txtest.CtxWithDBMatcher(), resource.Application, first, string(after)).Return(fixSoftDeletedApplicationPage(), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("MultipleToGraphQL", fixSoftDeletedApplicationPage().Data).Return(gqlDeleted).Once()
+				return conv
+			},
+			First: &first,
+			ExpectedOutput: &graphql.DeletedApplicationPage{
+				Data:       gqlDeleted,
+				PageInfo:   &graphql.PageInfo{},
+				TotalCount: 1,
+			},
+		},
+		{
+			Name: "Error when listing fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("ListDeleted", txtest.CtxWithDBMatcher(), resource.Application, first, string(after)).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.Converter { return &automock.Converter{} },
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when first is missing",
+			TxFn:          txGen.ThatDoesntStartTransaction,
+			ServiceFn:     func() *automock.SoftDeleteService { return &automock.SoftDeleteService{} },
+			ConverterFn:   func() *automock.Converter { return &automock.Converter{} },
+			ExpectedError: "missing required parameter 'first'",
+		},
+		{
+			Name:          "Error when beginning transaction fails",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ServiceFn:     func() *automock.SoftDeleteService { return &automock.SoftDeleteService{} },
+			ConverterFn:   func() *automock.Converter { return &automock.Converter{} },
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when committing transaction fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("ListDeleted", txtest.CtxWithDBMatcher(), resource.Application, first, string(after)).Return(fixSoftDeletedApplicationPage(), nil).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.Converter { return &automock.Converter{} },
+			First:         &first,
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+			resolver := softdelete.NewResolver(transact, svc, conv, nil, nil, nil, nil)
+
+			// WHEN
+			result, err := resolver.DeletedApplications(context.TODO(), testCase.First, &after)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedOutput, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_RestoreApplication(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	app := &model.Application{Name: appName, BaseEntity: &model.BaseEntity{ID: appID}}
+	gqlApp := &graphql.Application{Name: appName, BaseEntity: &graphql.BaseEntity{ID: appID}}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.SoftDeleteService
+		AppServiceFn   func() *automock.ApplicationService
+		AppConverterFn func() *automock.ApplicationConverter
+		ExpectedOutput *graphql.Application
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("Restore", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(nil).Once()
+				return svc
+			},
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+				return appSvc
+			},
+			AppConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("ToGraphQL", app).Return(gqlApp).Once()
+				return conv
+			},
+			ExpectedOutput: gqlApp,
+		},
+		{
+			Name: "Error when restoring fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("Restore", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(testErr).Once()
+				return svc
+			},
+			AppServiceFn:   func() *automock.ApplicationService { return &automock.ApplicationService{} },
+			AppConverterFn: func() *automock.ApplicationConverter { return &automock.ApplicationConverter{} },
+			ExpectedError:  testErr,
+		},
+		{
+			Name: "Error when getting the restored application fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("Restore", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(nil).Once()
+				return svc
+			},
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
+				return appSvc
+			},
+			AppConverterFn: func() *automock.ApplicationConverter { return &automock.ApplicationConverter{} },
+			ExpectedError:  testErr,
+		},
+		{
+			Name:           "Error when beginning transaction fails",
+			TxFn:           txGen.ThatFailsOnBegin,
+			ServiceFn:      func() *automock.SoftDeleteService { return &automock.SoftDeleteService{} },
+			AppServiceFn:   func() *automock.ApplicationService { return &automock.ApplicationService{} },
+			AppConverterFn: func() *automock.ApplicationConverter { return &automock.ApplicationConverter{} },
+			ExpectedError:  testErr,
+		},
+		{
+			Name: "Error when committing transaction fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("Restore", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(nil).Once()
+				return svc
+			},
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+				return appSvc
+			},
+			AppConverterFn: func() *automock.ApplicationConverter { return &automock.ApplicationConverter{} },
+			ExpectedError:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			appSvc := testCase.AppServiceFn()
+			appConv := testCase.AppConverterFn()
+			resolver := softdelete.NewResolver(transact, svc, nil, appSvc, appConv, nil, nil)
+
+			// WHEN
+			result, err := resolver.RestoreApplication(context.TODO(), appID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedOutput, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, appSvc, appConv)
+		})
+	}
+}
+
+func TestResolver_RestoreRuntime(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	runtime := &model.Runtime{ID: runtimeID, Name: "my-runtime"}
+	gqlRuntime := &graphql.Runtime{ID: runtimeID, Name: "my-runtime"}
+
+	testCases := []struct {
+		Name               string
+		TxFn               func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn          func() *automock.SoftDeleteService
+		RuntimeServiceFn   func() *automock.RuntimeService
+		RuntimeConverterFn func() *automock.RuntimeConverter
+		ExpectedOutput     *graphql.Runtime
+		ExpectedError      error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("Restore", txtest.CtxWithDBMatcher(), resource.Runtime, runtimeID).Return(nil).Once()
+				return svc
+			},
+			RuntimeServiceFn: func() *automock.RuntimeService {
+				runtimeSvc := &automock.RuntimeService{}
+				runtimeSvc.On("Get", txtest.CtxWithDBMatcher(), runtimeID).Return(runtime, nil).Once()
+				return runtimeSvc
+			},
+			RuntimeConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("ToGraphQL", runtime).Return(gqlRuntime).Once()
+				return conv
+			},
+			ExpectedOutput: gqlRuntime,
+		},
+		{
+			Name: "Error when restoring fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("Restore", txtest.CtxWithDBMatcher(), resource.Runtime, runtimeID).Return(testErr).Once()
+				return svc
+			},
+			RuntimeServiceFn:   func() *automock.RuntimeService { return &automock.RuntimeService{} },
+			RuntimeConverterFn: func() *automock.RuntimeConverter { return &automock.RuntimeConverter{} },
+			ExpectedError:      testErr,
+		},
+		{
+			Name: "Error when getting the restored runtime fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("Restore", txtest.CtxWithDBMatcher(), resource.Runtime, runtimeID).Return(nil).Once()
+				return svc
+			},
+			RuntimeServiceFn: func() *automock.RuntimeService {
+				runtimeSvc := &automock.RuntimeService{}
+				runtimeSvc.On("Get", txtest.CtxWithDBMatcher(), runtimeID).Return(nil, testErr).Once()
+				return runtimeSvc
+			},
+			RuntimeConverterFn: func() *automock.RuntimeConverter { return &automock.RuntimeConverter{} },
+			ExpectedError:      testErr,
+		},
+		{
+			Name: "Error when committing transaction fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.SoftDeleteService {
+				svc := &automock.SoftDeleteService{}
+				svc.On("Restore", txtest.CtxWithDBMatcher(), resource.Runtime, runtimeID).Return(nil).Once()
+				return svc
+			},
+			RuntimeServiceFn: func() *automock.RuntimeService {
+				runtimeSvc := &automock.RuntimeService{}
+				runtimeSvc.On("Get", txtest.CtxWithDBMatcher(), runtimeID).Return(runtime, nil).Once()
+				return runtimeSvc
+			},
+			RuntimeConverterFn: func() *automock.RuntimeConverter { return &automock.RuntimeConverter{} },
+			ExpectedError:      testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			runtimeSvc := testCase.RuntimeServiceFn()
+			runtimeConv := testCase.RuntimeConverterFn()
+			resolver := softdelete.NewResolver(transact, svc, nil, nil, nil, runtimeSvc, runtimeConv)
+
+			// WHEN
+			result, err := resolver.RestoreRuntime(context.TODO(), runtimeID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedOutput, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, runtimeSvc, runtimeConv)
+		})
+	}
+}
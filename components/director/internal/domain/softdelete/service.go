@@ -0,0 +1,107 @@
+package softdelete
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	pkgmodel "github.com/kyma-incubator/compass/components/director/pkg/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// SoftDeletedResourceRepository is responsible for the repo-layer soft deleted resources operations
+//
+//go:generate mockery --name=SoftDeletedResourceRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SoftDeletedResourceRepository interface {
+	Archive(ctx context.Context, item *model.SoftDeletedResource) error
+	Restore(ctx context.Context, resourceType resource.Type, id string) error
+	GetByID(ctx context.Context, tenant string, resourceType resource.Type, id string) (*model.SoftDeletedResource, error)
+	List(ctx context.Context, tenant string, resourceType resource.Type, pageSize int, cursor string) (*model.SoftDeletedResourcePage, error)
+	ListExpiredGlobal(ctx context.Context, before time.Time) ([]*model.SoftDeletedResource, error)
+	PurgeGlobal(ctx context.Context, resourceType resource.Type, id string) error
+	ListArchivedSystemAuthsGlobal(ctx context.Context, id string) ([]pkgmodel.SystemAuth, error)
+	ListArchivedLabelsGlobal(ctx context.Context, id string) (map[string]interface{}, error)
+}
+
+type service struct {
+	repo            SoftDeletedResourceRepository
+	retentionPeriod time.Duration
+	timestampGen    timestamp.Generator
+}
+
+// NewService returns a new soft delete service which keeps the deleted resources restorable for the given retention period
+func NewService(repo SoftDeletedResourceRepository, retentionPeriod time.Duration) *service {
+	return &service{
+		repo:            repo,
+		retentionPeriod: retentionPeriod,
+		timestampGen:    timestamp.DefaultGenerator,
+	}
+}
+
+// SoftDelete hides the resource from all tenants by archiving its labels and tenant accesses.
+// The resource can be restored by its owner tenant until the retention period ends.
+func (s *service) SoftDelete(ctx context.Context, resourceType resource.Type, id string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	deletedAt := s.timestampGen()
+	item := &model.SoftDeletedResource{
+		ID:           id,
+		ResourceType: resourceType,
+		TenantID:     tnt,
+		DeletedAt:    deletedAt,
+		PurgeAfter:   deletedAt.Add(s.retentionPeriod),
+	}
+
+	if err = s.repo.Archive(ctx, item); err != nil {
+		return errors.Wrapf(err, "while soft deleting %s with ID %s", resourceType, id)
+	}
+
+	log.C(ctx).Infof("Soft deleted %s with ID %s. It can be restored until %s", resourceType, id, item.PurgeAfter)
+	return nil
+}
+
+// Restore re-establishes the labels and tenant accesses of a soft deleted resource of the tenant in the context
+func (s *service) Restore(ctx context.Context, resourceType resource.Type, id string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	deleted, err := s.repo.GetByID(ctx, tnt, resourceType, id)
+	if err != nil {
+		return errors.Wrapf(err, "while getting soft deleted %s with ID %s", resourceType, id)
+	}
+
+	if !s.timestampGen().Before(deleted.PurgeAfter) {
+		return apperrors.NewInvalidOperationError("the retention period of the soft deleted resource has ended")
+	}
+
+	if err = s.repo.Restore(ctx, resourceType, id); err != nil {
+		return errors.Wrapf(err, "while restoring %s with ID %s", resourceType, id)
+	}
+
+	log.C(ctx).Infof("Restored soft deleted %s with ID %s", resourceType, id)
+	return nil
+}
+
+// ListDeleted returns a page of the soft deleted resources of the given type owned by the tenant in the context
+func (s *service) ListDeleted(ctx context.Context, resourceType resource.Type, pageSize int, cursor string) (*model.SoftDeletedResourcePage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.repo.List(ctx, tnt, resourceType, pageSize, cursor)
+}
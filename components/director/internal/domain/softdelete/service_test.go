@@ -0,0 +1,185 @@
+package softdelete_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/softdelete/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_SoftDelete(t *testing.T) {
+	ctx := ctxWithTenant()
+	archived := &model.SoftDeletedResource{
+		ID:           appID,
+		ResourceType: resource.Application,
+		TenantID:     tenantID,
+		DeletedAt:    deletedAt,
+		PurgeAfter:   purgeAfter,
+	}
+
+	testCases := []struct {
+		Name          string
+		Context       context.Context
+		Repo          func() *automock.SoftDeletedResourceRepository
+		ExpectedError error
+	}{
+		{
+			Name:    "Success",
+			Context: ctx,
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("Archive", ctx, archived).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:    "Error when archiving fails",
+			Context: ctx,
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("Archive", ctx, archived).Return(testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:          "Error when tenant is missing in the context",
+			Context:       context.TODO(),
+			Repo:          func() *automock.SoftDeletedResourceRepository { return &automock.SoftDeletedResourceRepository{} },
+			ExpectedError: apperrors.NewCannotReadTenantError(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.Repo()
+
+			svc := softdelete.NewService(repo, retentionPeriod)
+			svc.SetTimestampGen(func() time.Time { return deletedAt })
+
+			// WHEN
+			err := svc.SoftDelete(testCase.Context, resource.Application, appID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_Restore(t *testing.T) {
+	ctx := ctxWithTenant()
+
+	testCases := []struct {
+		Name          string
+		Now           time.Time
+		Repo          func() *automock.SoftDeletedResourceRepository
+		ExpectedError error
+	}{
+		{
+			Name: "Success",
+			Now:  purgeAfter.Add(-time.Minute),
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("GetByID", ctx, tenantID, resource.Application, appID).Return(fixSoftDeletedApplicationModel(), nil).Once()
+				repo.On("Restore", ctx, resource.Application, appID).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when the resource is not soft deleted by the tenant",
+			Now:  deletedAt,
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("GetByID", ctx, tenantID, resource.Application, appID).Return(nil, apperrors.NewNotFoundError(resource.SoftDeletedResource, appID)).Once()
+				return repo
+			},
+			ExpectedError: apperrors.NewNotFoundError(resource.SoftDeletedResource, appID),
+		},
+		{
+			Name: "Error when the retention period has ended",
+			Now:  purgeAfter,
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("GetByID", ctx, tenantID, resource.Application, appID).Return(fixSoftDeletedApplicationModel(), nil).Once()
+				return repo
+			},
+			ExpectedError: apperrors.NewInvalidOperationError("the retention period of the soft deleted resource has ended"),
+		},
+		{
+			Name: "Error when restoring fails",
+			Now:  deletedAt,
+			Repo: func() *automock.SoftDeletedResourceRepository {
+				repo := &automock.SoftDeletedResourceRepository{}
+				repo.On("GetByID", ctx, tenantID, resource.Application, appID).Return(fixSoftDeletedApplicationModel(), nil).Once()
+				repo.On("Restore", ctx, resource.Application, appID).Return(testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.Repo()
+
+			svc := softdelete.NewService(repo, retentionPeriod)
+			svc.SetTimestampGen(func() time.Time { return testCase.Now })
+
+			// WHEN
+			err := svc.Restore(ctx, resource.Application, appID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_ListDeleted(t *testing.T) {
+	ctx := ctxWithTenant()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.SoftDeletedResourceRepository{}
+		repo.On("List", ctx, tenantID, resource.Application, 50, "cursor").Return(fixSoftDeletedApplicationPage(), nil).Once()
+		svc := softdelete.NewService(repo, retentionPeriod)
+
+		// WHEN
+		page, err := svc.ListDeleted(ctx, resource.Application, 50, "cursor")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, fixSoftDeletedApplicationPage(), page)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+
+	t.Run("Error when page size is out of range", func(t *testing.T) {
+		svc := softdelete.NewService(&automock.SoftDeletedResourceRepository{}, retentionPeriod)
+
+		// WHEN
+		_, err := svc.ListDeleted(ctx, resource.Application, 201, "")
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "page size must be between 1 and 200")
+	})
+}
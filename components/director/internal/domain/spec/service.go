@@ -198,6 +198,36 @@ func (s *service) CreateByReferenceObjectIDWithDelayedFetchRequest(ctx context.C
 	return id, fr, nil
 }
 
+// ResyncByReferenceObjectID replaces all specifications of the given object with new ones created from the provided inputs.
+// Similarly to CreateByReferenceObjectIDWithDelayedFetchRequest the fetch requests of the new specifications are only persisted and returned without being executed.
+// A new specification fetched from the same URL as a previously fetched one inherits its content and the cache validators of its fetch request,
+// so that the content can be refetched with a conditional request and does not have to be downloaded again if it is unchanged.
+func (s *service) ResyncByReferenceObjectID(ctx context.Context, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string, specs []*model.SpecInput) ([]*model.FetchRequest, error) {
+	fetchedSpecs, err := s.listFetchedSpecsByURL(ctx, resourceType, objectType, objectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing fetched specifications for %q with id %q", objectType, objectID)
+	}
+
+	if err = s.DeleteByReferenceObjectID(ctx, resourceType, objectType, objectID); err != nil {
+		return nil, err
+	}
+
+	fetchRequests := make([]*model.FetchRequest, 0, len(specs))
+	for _, in := range specs {
+		if in == nil {
+			continue
+		}
+
+		fr, err := s.createWithDelayedFetchRequest(ctx, *in, resourceType, objectType, objectID, fetchedSpecs)
+		if err != nil {
+			return nil, err
+		}
+		fetchRequests = append(fetchRequests, fr)
+	}
+
+	return fetchRequests, nil
+}
+
 // UpdateByReferenceObjectID missing godoc
 func (s *service) UpdateByReferenceObjectID(ctx context.Context, id string, in model.SpecInput, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string) error {
 	if err := s.deleteFetchRequestByReferenceObjectID(ctx, id, objectType, resourceType); err != nil {
@@ -337,21 +367,109 @@ func (s *service) createFetchRequest(ctx context.Context, in model.FetchRequestI
 	id := s.uidService.Generate()
 	fr := in.ToFetchRequest(s.timestampGen(), id, getFetchRequestObjectTypeBySpecObjectType(objectType), parentObjectID)
 
+	if err := s.persistFetchRequest(ctx, fr, resourceType); err != nil {
+		return nil, err
+	}
+
+	return fr, nil
+}
+
+func (s *service) persistFetchRequest(ctx context.Context, fr *model.FetchRequest, resourceType resource.Type) error {
+	if resourceType.IsTenantIgnorable() {
+		return s.fetchRequestRepo.CreateGlobal(ctx, fr)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.fetchRequestRepo.Create(ctx, tnt, fr)
+}
+
+type fetchedSpec struct {
+	data         *string
+	etag         *string
+	lastModified *string
+}
+
+func (s *service) listFetchedSpecsByURL(ctx context.Context, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string) (map[string]fetchedSpec, error) {
+	specs, err := s.listSpecsByReferenceObjectID(ctx, objectType, objectID, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	specsByID := make(map[string]*model.Spec, len(specs))
+	specIDs := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		specsByID[spec.ID] = spec
+		specIDs = append(specIDs, spec.ID)
+	}
+
+	if len(specIDs) == 0 {
+		return map[string]fetchedSpec{}, nil
+	}
+
+	var fetchRequests []*model.FetchRequest
 	if resourceType.IsTenantIgnorable() {
-		if err := s.fetchRequestRepo.CreateGlobal(ctx, fr); err != nil {
+		fetchRequests, err = s.fetchRequestRepo.ListByReferenceObjectIDsGlobal(ctx, getFetchRequestObjectTypeBySpecObjectType(objectType), specIDs)
+	} else {
+		var tnt string
+		if tnt, err = tenant.LoadFromContext(ctx); err != nil {
 			return nil, err
 		}
+		fetchRequests, err = s.fetchRequestRepo.ListByReferenceObjectIDs(ctx, tnt, getFetchRequestObjectTypeBySpecObjectType(objectType), specIDs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fetchedSpecs := make(map[string]fetchedSpec, len(fetchRequests))
+	for _, fr := range fetchRequests {
+		spec, ok := specsByID[fr.ObjectID]
+		if !ok || spec.Data == nil || (fr.ETag == nil && fr.LastModified == nil) {
+			continue
+		}
 
-		return fr, nil
+		fetchedSpecs[fr.URL] = fetchedSpec{
+			data:         spec.Data,
+			etag:         fr.ETag,
+			lastModified: fr.LastModified,
+		}
 	}
 
-	tnt, err := tenant.LoadFromContext(ctx)
+	return fetchedSpecs, nil
+}
+
+func (s *service) createWithDelayedFetchRequest(ctx context.Context, in model.SpecInput, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string, fetchedSpecs map[string]fetchedSpec) (*model.FetchRequest, error) {
+	id := s.uidService.Generate()
+	spec, err := in.ToSpec(id, objectType, objectID)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = s.fetchRequestRepo.Create(ctx, tnt, fr); err != nil {
-		return nil, err
+	shouldCreateFetchRequest := in.Data == nil && in.FetchRequest != nil
+
+	var fetched fetchedSpec
+	if shouldCreateFetchRequest {
+		fetched = fetchedSpecs[in.FetchRequest.URL]
+		spec.Data = fetched.data
+	}
+
+	if err = s.createSpec(ctx, spec, resourceType); err != nil {
+		return nil, errors.Wrapf(err, "while creating spec for %q with id %q", objectType, objectID)
+	}
+
+	if !shouldCreateFetchRequest {
+		return nil, nil
+	}
+
+	fr := in.FetchRequest.ToFetchRequest(s.timestampGen(), s.uidService.Generate(), getFetchRequestObjectTypeBySpecObjectType(objectType), id)
+	fr.ETag = fetched.etag
+	fr.LastModified = fetched.lastModified
+
+	if err = s.persistFetchRequest(ctx, fr, resourceType); err != nil {
+		return nil, errors.Wrapf(err, "while creating FetchRequest for %s Specification with id %q", objectType, id)
 	}
 
 	return fr, nil
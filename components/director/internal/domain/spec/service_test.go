@@ -371,6 +371,222 @@ func TestService_ListByReferenceObjectIDs(t *testing.T) {
 	})
 }
 
+func TestService_ResyncByReferenceObjectID(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tnt.SaveToContext(ctx, tenant, externalTenant)
+
+	timestamp := time.Now()
+	etag := `"etag"`
+	lastModified := "Wed, 21 Oct 2015 07:28:00 GMT"
+
+	specInput := fixModelAPISpecInputWithFetchRequest()
+	specInput.Data = nil
+
+	otherSpecInput := fixModelAPISpecInputWithFetchRequest()
+	otherSpecInput.Data = nil
+	otherSpecInput.FetchRequest = &model.FetchRequestInput{URL: "other.url"}
+
+	specFromDB := fixModelAPISpecWithID("oldSpecID")
+	fetchRequestFromDB := &model.FetchRequest{
+		ID:           "oldFetchRequestID",
+		URL:          "foo.bar",
+		ObjectType:   model.APISpecFetchRequestReference,
+		ObjectID:     "oldSpecID",
+		ETag:         &etag,
+		LastModified: &lastModified,
+	}
+
+	specWithInheritedData := fixModelAPISpec()
+	specWithoutData := fixModelAPISpec()
+	specWithoutData.Data = nil
+
+	fixFetchRequest := func(url string, etag, lastModified *string) *model.FetchRequest {
+		return &model.FetchRequest{
+			ID:   specID,
+			URL:  url,
+			Mode: model.FetchModeSingle,
+			Status: &model.FetchRequestStatus{
+				Condition: model.FetchRequestStatusConditionInitial,
+				Timestamp: timestamp,
+			},
+			ObjectType:   model.APISpecFetchRequestReference,
+			ObjectID:     specID,
+			ETag:         etag,
+			LastModified: lastModified,
+		}
+	}
+	inheritedFetchRequest := fixFetchRequest("foo.bar", &etag, &lastModified)
+	newFetchRequest := fixFetchRequest("other.url", nil, nil)
+
+	testCases := []struct {
+		Name                  string
+		RepositoryFn          func() *automock.SpecRepository
+		FetchRequestRepoFn    func() *automock.FetchRequestRepository
+		UIDServiceFn          func() *automock.UIDService
+		Input                 []*model.SpecInput
+		ResourceType          resource.Type
+		ExpectedFetchRequests []*model.FetchRequest
+		ExpectedErr           error
+	}{
+		{
+			Name: "Success for Application inherits the content of specifications fetched from the same URL",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectID", ctx, tenant, model.APISpecReference, apiID).Return([]*model.Spec{specFromDB}, nil).Once()
+				repo.On("DeleteByReferenceObjectID", ctx, tenant, model.APISpecReference, apiID).Return(nil).Once()
+				repo.On("Create", ctx, tenant, specWithInheritedData).Return(nil).Once()
+				repo.On("Create", ctx, tenant, specWithoutData).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("ListByReferenceObjectIDs", ctx, tenant, model.APISpecFetchRequestReference, []string{"oldSpecID"}).Return([]*model.FetchRequest{fetchRequestFromDB}, nil).Once()
+				repo.On("Create", ctx, tenant, inheritedFetchRequest).Return(nil).Once()
+				repo.On("Create", ctx, tenant, newFetchRequest).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(specID).Times(4)
+				return svc
+			},
+			Input:                 []*model.SpecInput{specInput, nil, otherSpecInput},
+			ResourceType:          resource.Application,
+			ExpectedFetchRequests: []*model.FetchRequest{inheritedFetchRequest, newFetchRequest},
+		},
+		{
+			Name: "Success for Application Template Version without previously fetched specifications",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, model.APISpecReference, apiID).Return([]*model.Spec{}, nil).Once()
+				repo.On("DeleteByReferenceObjectIDGlobal", ctx, model.APISpecReference, apiID).Return(nil).Once()
+				repo.On("CreateGlobal", ctx, specWithoutData).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("CreateGlobal", ctx, newFetchRequest).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(specID).Twice()
+				return svc
+			},
+			Input:                 []*model.SpecInput{otherSpecInput},
+			ResourceType:          resource.ApplicationTemplateVersion,
+			ExpectedFetchRequests: []*model.FetchRequest{newFetchRequest},
+		},
+		{
+			Name: "Error while listing specifications",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectID", ctx, tenant, model.APISpecReference, apiID).Return(nil, testErr).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input:        []*model.SpecInput{specInput},
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Error while listing fetch requests",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectID", ctx, tenant, model.APISpecReference, apiID).Return([]*model.Spec{specFromDB}, nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("ListByReferenceObjectIDs", ctx, tenant, model.APISpecFetchRequestReference, []string{"oldSpecID"}).Return(nil, testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input:        []*model.SpecInput{specInput},
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Error while deleting specifications",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectID", ctx, tenant, model.APISpecReference, apiID).Return([]*model.Spec{}, nil).Once()
+				repo.On("DeleteByReferenceObjectID", ctx, tenant, model.APISpecReference, apiID).Return(testErr).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input:        []*model.SpecInput{specInput},
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Error while creating fetch request",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectID", ctx, tenant, model.APISpecReference, apiID).Return([]*model.Spec{}, nil).Once()
+				repo.On("DeleteByReferenceObjectID", ctx, tenant, model.APISpecReference, apiID).Return(nil).Once()
+				repo.On("Create", ctx, tenant, specWithoutData).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, tenant, newFetchRequest).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(specID).Twice()
+				return svc
+			},
+			Input:        []*model.SpecInput{otherSpecInput},
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			fetchRequestRepo := testCase.FetchRequestRepoFn()
+			uidService := testCase.UIDServiceFn()
+
+			svc := spec.NewService(repo, fetchRequestRepo, uidService, nil)
+			svc.SetTimestampGen(func() time.Time {
+				return timestamp
+			})
+
+			// WHEN
+			fetchRequests, err := svc.ResyncByReferenceObjectID(ctx, testCase.ResourceType, model.APISpecReference, apiID, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedFetchRequests, fetchRequests)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, fetchRequestRepo, uidService)
+		})
+	}
+}
+
 func TestService_DeleteByReferenceObjectID(t *testing.T) {
 	// GIVEN
 	testErr := errors.New("Test error")
@@ -0,0 +1,93 @@
+package staticgroup
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+type converter struct{}
+
+// NewConverter creates a new static group converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// FromGraphql converts from graphql.StaticGroupInput to model.StaticGroup
+func (c *converter) FromGraphql(id string, in graphql.StaticGroupInput) *model.StaticGroup {
+	return &model.StaticGroup{
+		ID:        id,
+		GroupName: in.GroupName,
+		Scopes:    in.Scopes,
+	}
+}
+
+// ToGraphQL converts from model.StaticGroup to graphql.StaticGroup
+func (c *converter) ToGraphQL(in *model.StaticGroup) *graphql.StaticGroup {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.StaticGroup{
+		ID:        in.ID,
+		GroupName: in.GroupName,
+		Scopes:    in.Scopes,
+	}
+}
+
+// MultipleToGraphQL converts multiple model.StaticGroup models to graphql.StaticGroup
+func (c *converter) MultipleToGraphQL(in []*model.StaticGroup) []*graphql.StaticGroup {
+	if in == nil {
+		return nil
+	}
+
+	staticGroups := make([]*graphql.StaticGroup, 0, len(in))
+	for _, i := range in {
+		if i == nil {
+			continue
+		}
+		staticGroups = append(staticGroups, c.ToGraphQL(i))
+	}
+
+	return staticGroups
+}
+
+// ToEntity converts model.StaticGroup to Entity
+func (c *converter) ToEntity(in *model.StaticGroup) (*Entity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	marshalledScopes, err := json.Marshal(in.Scopes)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling scopes")
+	}
+
+	return &Entity{
+		ID:        in.ID,
+		GroupName: in.GroupName,
+		Scopes:    string(marshalledScopes),
+	}, nil
+}
+
+// FromEntity converts Entity to model.StaticGroup
+func (c *converter) FromEntity(e *Entity) (*model.StaticGroup, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	var unmarshalledScopes []string
+	if err := json.Unmarshal([]byte(e.Scopes), &unmarshalledScopes); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling scopes")
+	}
+
+	return &model.StaticGroup{
+		ID:        e.ID,
+		GroupName: e.GroupName,
+		Scopes:    unmarshalledScopes,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}, nil
+}
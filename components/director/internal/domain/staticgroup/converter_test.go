@@ -0,0 +1,150 @@
+package staticgroup_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/staticgroup"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+var converter = staticgroup.NewConverter()
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *model.StaticGroup
+		Expected *graphql.StaticGroup
+	}{
+		{
+			Name:     "Success",
+			Input:    StaticGroupModel,
+			Expected: StaticGroupGQLModel,
+		},
+		{
+			Name:     "Success when input is nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			r := converter.ToGraphQL(testCase.Input)
+
+			// THEN
+			require.Equal(t, testCase.Expected, r)
+		})
+	}
+}
+
+func TestConverter_FromGraphql(t *testing.T) {
+	// WHEN
+	r := converter.FromGraphql(TestID, StaticGroupGQLModelInput)
+
+	// THEN
+	require.Equal(t, fixStaticGroupModel(TestID, TestGroupName, TestScopes, time.Time{}), r)
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    []*model.StaticGroup
+		Expected []*graphql.StaticGroup
+	}{
+		{
+			Name:     "Success",
+			Input:    []*model.StaticGroup{StaticGroupModel, nil},
+			Expected: StaticGroupsGQL,
+		},
+		{
+			Name:     "Success when input is nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			r := converter.MultipleToGraphQL(testCase.Input)
+
+			// THEN
+			require.Equal(t, testCase.Expected, r)
+		})
+	}
+}
+
+func TestConverter_ToEntity(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *model.StaticGroup
+		Expected *staticgroup.Entity
+	}{
+		{
+			Name:     "Success",
+			Input:    StaticGroupModel,
+			Expected: fixStaticGroupEntity(TestID, TestGroupName, TestScopesAsString, time.Time{}),
+		},
+		{
+			Name:     "Success when input is nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			r, err := converter.ToEntity(testCase.Input)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.Expected, r)
+		})
+	}
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Input          *staticgroup.Entity
+		Expected       *model.StaticGroup
+		ExpectedErrMsg string
+	}{
+		{
+			Name:     "Success",
+			Input:    StaticGroupEntity,
+			Expected: StaticGroupModel,
+		},
+		{
+			Name:     "Success when input is nil",
+			Input:    nil,
+			Expected: nil,
+		},
+		{
+			Name:           "Error when unmarshalling scopes fails",
+			Input:          StaticGroupEntityInvalidScopes,
+			ExpectedErrMsg: "while unmarshalling scopes",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			r, err := converter.FromEntity(testCase.Input)
+
+			// THEN
+			if testCase.ExpectedErrMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.Expected, r)
+		})
+	}
+}
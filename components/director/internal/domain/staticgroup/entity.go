@@ -0,0 +1,20 @@
+package staticgroup
+
+import "time"
+
+// Entity is a representation of a static group in the DB
+type Entity struct {
+	ID        string     `db:"id"`
+	GroupName string     `db:"group_name"`
+	Scopes    string     `db:"scopes"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt *time.Time `db:"updated_at"`
+}
+
+// EntityCollection is a collection of static group entities.
+type EntityCollection []*Entity
+
+// Len returns the number of entities in the collection.
+func (s EntityCollection) Len() int {
+	return len(s)
+}
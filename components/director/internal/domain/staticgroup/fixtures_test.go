@@ -0,0 +1,139 @@
+package staticgroup_test
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/staticgroup"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/staticgroup/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+)
+
+const (
+	TestID        = "2c0fe288-bb13-4814-ac49-ac88c4a76b10"
+	TestGroupName = "mps-superadmins"
+)
+
+var (
+	TestScopes                = []string{"application:read", "runtime:read"}
+	TestScopesAsString        = "[\"application:read\",\"runtime:read\"]"
+	TestInvalidScopesAsString = "[invalid"
+	TestDefinedScopes         = []string{"application:read", "application:write", "runtime:read"}
+	nilModelEntity            *model.StaticGroup
+	testTime                  = time.Date(2024, 06, 24, 9, 9, 9, 9, time.Local)
+
+	StaticGroupEntity              = fixStaticGroupEntity(TestID, TestGroupName, TestScopesAsString, testTime)
+	StaticGroupEntityInvalidScopes = fixStaticGroupEntity(TestID, TestGroupName, TestInvalidScopesAsString, testTime)
+	StaticGroupModel               = fixStaticGroupModel(TestID, TestGroupName, TestScopes, testTime)
+
+	StaticGroupGQLModel = &graphql.StaticGroup{
+		ID:        TestID,
+		GroupName: TestGroupName,
+		Scopes:    TestScopes,
+	}
+
+	StaticGroupGQLModelInput = graphql.StaticGroupInput{
+		GroupName: TestGroupName,
+		Scopes:    TestScopes,
+	}
+
+	StaticGroupGQLInvalidInput = graphql.StaticGroupInput{
+		Scopes: TestScopes,
+	}
+
+	StaticGroupModelPage = &model.StaticGroupPage{
+		Data: []*model.StaticGroup{StaticGroupModel},
+		PageInfo: &pagination.Page{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+		TotalCount: 1,
+	}
+
+	StaticGroupsGQL = []*graphql.StaticGroup{StaticGroupGQLModel}
+
+	StaticGroupGQLPage = &graphql.StaticGroupPage{
+		Data: StaticGroupsGQL,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor("start"),
+			EndCursor:   graphql.PageCursor("end"),
+			HasNextPage: false,
+		},
+		TotalCount: 1,
+	}
+)
+
+func fixStaticGroupEntity(id, groupName, scopes string, createdAt time.Time) *staticgroup.Entity {
+	return &staticgroup.Entity{
+		ID:        id,
+		GroupName: groupName,
+		Scopes:    scopes,
+		CreatedAt: createdAt,
+	}
+}
+
+func fixStaticGroupModel(id, groupName string, scopes []string, createdAt time.Time) *model.StaticGroup {
+	return &model.StaticGroup{
+		ID:        id,
+		GroupName: groupName,
+		Scopes:    scopes,
+		CreatedAt: createdAt,
+	}
+}
+
+func fixColumns() []string {
+	return []string{"id", "group_name", "scopes", "created_at", "updated_at"}
+}
+
+func fixUnusedStaticGroupRepository() *automock.StaticGroupRepository {
+	return &automock.StaticGroupRepository{}
+}
+
+func fixUnusedScopesProvider() *automock.ScopesProvider {
+	return &automock.ScopesProvider{}
+}
+
+func fixScopesProvider() *automock.ScopesProvider {
+	scopesProvider := &automock.ScopesProvider{}
+	scopesProvider.On("GetDefinedScopes").Return(TestDefinedScopes, nil).Once()
+	return scopesProvider
+}
+
+func fixUIDService() *automock.UIDService {
+	uidSvc := &automock.UIDService{}
+	uidSvc.On("Generate").Return(TestID).Once()
+	return uidSvc
+}
+
+func fixConverter(converterFn func() *automock.Converter) *automock.Converter {
+	if converterFn == nil {
+		return fixUnusedConverter()
+	}
+	return converterFn()
+}
+
+func fixStaticGroupSvc(staticGroupSvcFn func() *automock.StaticGroupService) *automock.StaticGroupService {
+	if staticGroupSvcFn == nil {
+		return fixUnusedStaticGroupSvc()
+	}
+	return staticGroupSvcFn()
+}
+
+func fixUnusedUIDService() *automock.UIDService {
+	return &automock.UIDService{}
+}
+
+func fixUnusedTransactioner() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	return &persistenceautomock.PersistenceTx{}, &persistenceautomock.Transactioner{}
+}
+
+func fixUnusedStaticGroupSvc() *automock.StaticGroupService {
+	return &automock.StaticGroupService{}
+}
+
+func fixUnusedConverter() *automock.Converter {
+	return &automock.Converter{}
+}
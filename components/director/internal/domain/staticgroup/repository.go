@@ -0,0 +1,147 @@
+package staticgroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableName       string = `public.static_groups`
+	groupNameColumn string = "group_name"
+)
+
+var (
+	idTableColumns        = []string{"id"}
+	updatableTableColumns = []string{"group_name", "scopes", "updated_at"}
+	tableColumns          = []string{"id", "group_name", "scopes", "created_at", "updated_at"}
+
+	// Now is a function variable that returns the current time. It is used, so we could mock it in the tests.
+	Now = time.Now
+)
+
+// entityConverter converts between the internal model and entity
+//
+//go:generate mockery --exported --name=entityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type entityConverter interface {
+	ToEntity(in *model.StaticGroup) (*Entity, error)
+	FromEntity(entity *Entity) (*model.StaticGroup, error)
+}
+
+type repository struct {
+	creator               repo.CreatorGlobal
+	existQuerierGlobal    repo.ExistQuerierGlobal
+	singleGetterGlobal    repo.SingleGetterGlobal
+	pageableQuerierGlobal repo.PageableQuerierGlobal
+	updaterGlobal         repo.UpdaterGlobal
+	deleterGlobal         repo.DeleterGlobal
+	conv                  entityConverter
+}
+
+// NewRepository creates a new StaticGroup repository
+func NewRepository(conv entityConverter) *repository {
+	return &repository{
+		creator:               repo.NewCreatorGlobal(resource.StaticGroup, tableName, tableColumns),
+		existQuerierGlobal:    repo.NewExistQuerierGlobal(resource.StaticGroup, tableName),
+		singleGetterGlobal:    repo.NewSingleGetterGlobal(resource.StaticGroup, tableName, tableColumns),
+		pageableQuerierGlobal: repo.NewPageableQuerierGlobal(resource.StaticGroup, tableName, tableColumns),
+		updaterGlobal:         repo.NewUpdaterGlobal(resource.StaticGroup, tableName, updatableTableColumns, idTableColumns),
+		deleterGlobal:         repo.NewDeleterGlobal(resource.StaticGroup, tableName),
+		conv:                  conv,
+	}
+}
+
+// Create creates a new static group in the database with the fields from the model
+func (r *repository) Create(ctx context.Context, model *model.StaticGroup) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be empty")
+	}
+
+	log.C(ctx).Debugf("Converting static group with ID: %s to entity", model.ID)
+	entity, err := r.conv.ToEntity(model)
+	if err != nil {
+		return errors.Wrapf(err, "while converting static group with ID: %s", model.ID)
+	}
+	entity.CreatedAt = Now()
+
+	log.C(ctx).Debugf("Persisting static group with ID: %s and name: %s to DB", model.ID, model.GroupName)
+	return r.creator.Create(ctx, entity)
+}
+
+// Get queries for a single static group matching by a given ID
+func (r *repository) Get(ctx context.Context, id string) (*model.StaticGroup, error) {
+	log.C(ctx).Debugf("Getting static group by ID: %s from DB", id)
+	var entity Entity
+	if err := r.singleGetterGlobal.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	result, err := r.conv.FromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting static group with ID: %s", id)
+	}
+
+	return result, nil
+}
+
+// Update updates the static group with the provided input model
+func (r *repository) Update(ctx context.Context, model *model.StaticGroup) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be empty")
+	}
+
+	log.C(ctx).Debugf("Converting static group with ID: %s to entity", model.ID)
+	entity, err := r.conv.ToEntity(model)
+	if err != nil {
+		return errors.Wrapf(err, "while converting static group with ID: %s", model.ID)
+	}
+	currentTime := Now()
+	entity.UpdatedAt = &currentTime
+
+	log.C(ctx).Debugf("Updating static group with ID: %s and name: %s", model.ID, model.GroupName)
+	return r.updaterGlobal.UpdateSingleGlobal(ctx, entity)
+}
+
+// Delete deletes a static group with given ID
+func (r *repository) Delete(ctx context.Context, id string) error {
+	log.C(ctx).Debugf("Deleting static group with ID: %s from DB", id)
+	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+// Exists check if a static group with given ID exists
+func (r *repository) Exists(ctx context.Context, id string) (bool, error) {
+	log.C(ctx).Debugf("Check if static group with ID: %s exists", id)
+	return r.existQuerierGlobal.ExistsGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+// List queries for all static groups sorted by group name and paginated by the pageSize and cursor parameters
+func (r *repository) List(ctx context.Context, pageSize int, cursor string) (*model.StaticGroupPage, error) {
+	log.C(ctx).Debug("Listing static groups from DB")
+	var entityCollection EntityCollection
+	page, totalCount, err := r.pageableQuerierGlobal.ListGlobal(ctx, pageSize, cursor, groupNameColumn, &entityCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.StaticGroup, 0, len(entityCollection))
+	for _, entity := range entityCollection {
+		result, err := r.conv.FromEntity(entity)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting static group with ID: %s", entity.ID)
+		}
+
+		items = append(items, result)
+	}
+
+	return &model.StaticGroupPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
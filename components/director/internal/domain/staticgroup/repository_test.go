@@ -0,0 +1,200 @@
+package staticgroup_test
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/staticgroup"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/staticgroup/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+func TestRepository_Create(t *testing.T) {
+	staticgroup.Now = func() time.Time { return testTime }
+
+	suite := testdb.RepoCreateTestSuite{
+		Name:       "Create static group",
+		MethodName: "Create",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:       `^INSERT INTO public.static_groups \(.+\) VALUES \(.+\)$`,
+				Args:        []driver.Value{StaticGroupEntity.ID, StaticGroupEntity.GroupName, StaticGroupEntity.Scopes, testTime, nil},
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       staticgroup.NewRepository,
+		ModelEntity:               StaticGroupModel,
+		DBEntity:                  StaticGroupEntity,
+		NilModelEntity:            nilModelEntity,
+		IsGlobal:                  true,
+		DisableConverterErrorTest: false,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_Get(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get static group by ID",
+		MethodName: "Get",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, group_name, scopes, created_at, updated_at FROM public.static_groups WHERE id = $1`),
+				Args:     []driver.Value{TestID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(StaticGroupEntity.ID, StaticGroupEntity.GroupName, StaticGroupEntity.Scopes, testTime, nil)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       staticgroup.NewRepository,
+		ExpectedModelEntity:       StaticGroupModel,
+		ExpectedDBEntity:          StaticGroupEntity,
+		MethodArgs:                []interface{}{TestID},
+		DisableConverterErrorTest: false,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_Update(t *testing.T) {
+	staticgroup.Now = func() time.Time { return testTime }
+	staticGroupModel := fixStaticGroupModel(TestID, TestGroupName, TestScopes, testTime)
+	staticGroupEntity := fixStaticGroupEntity(TestID, TestGroupName, TestScopesAsString, testTime)
+
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update static group by ID",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.static_groups SET group_name = ?, scopes = ?, updated_at = ? WHERE id = ?`),
+				Args:          []driver.Value{staticGroupEntity.GroupName, staticGroupEntity.Scopes, testTime, staticGroupEntity.ID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		RepoConstructorFunc: staticgroup.NewRepository,
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		ModelEntity:    staticGroupModel,
+		DBEntity:       staticGroupEntity,
+		NilModelEntity: nilModelEntity,
+		IsGlobal:       true,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_Delete(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Delete static group by ID",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.static_groups WHERE id = $1`),
+				Args:          []driver.Value{TestID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		RepoConstructorFunc: staticgroup.NewRepository,
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		IsGlobal:   true,
+		MethodArgs: []interface{}{TestID},
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_Exists(t *testing.T) {
+	suite := testdb.RepoExistTestSuite{
+		Name: "Exists static group by ID",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT 1 FROM public.static_groups WHERE id = $1`),
+				Args:     []driver.Value{TestID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectExist()}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectDoesNotExist()}
+				},
+			},
+		},
+		RepoConstructorFunc: staticgroup.NewRepository,
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		TargetID:   TestID,
+		IsGlobal:   true,
+		MethodName: "Exists",
+		MethodArgs: []interface{}{TestID},
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_List(t *testing.T) {
+	suite := testdb.RepoListPageableTestSuite{
+		Name:       "List static groups with paging",
+		MethodName: "List",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, group_name, scopes, created_at, updated_at FROM public.static_groups ORDER BY group_name LIMIT 3 OFFSET 0`),
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(StaticGroupEntity.ID, StaticGroupEntity.GroupName, StaticGroupEntity.Scopes, testTime, nil)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT COUNT(*) FROM public.static_groups`),
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"count"}).AddRow(1)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: []interface{}{StaticGroupModel},
+				ExpectedDBEntities:    []interface{}{StaticGroupEntity},
+				ExpectedPage: &model.StaticGroupPage{
+					Data: []*model.StaticGroup{StaticGroupModel},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 1,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       staticgroup.NewRepository,
+		MethodArgs:                []interface{}{3, ""},
+		DisableConverterErrorTest: false,
+	}
+
+	suite.Run(t)
+}
@@ -0,0 +1,204 @@
+package staticgroup
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// StaticGroupService is responsible for service-layer static group operations
+//
+//go:generate mockery --name=StaticGroupService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type StaticGroupService interface {
+	Create(ctx context.Context, in *model.StaticGroup) (string, error)
+	Get(ctx context.Context, id string) (*model.StaticGroup, error)
+	Update(ctx context.Context, in *model.StaticGroup) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, pageSize int, cursor string) (*model.StaticGroupPage, error)
+}
+
+// Converter converts between the graphql and internal model
+//
+//go:generate mockery --name=Converter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Converter interface {
+	ToGraphQL(in *model.StaticGroup) *graphql.StaticGroup
+	MultipleToGraphQL(in []*model.StaticGroup) []*graphql.StaticGroup
+	FromGraphql(id string, in graphql.StaticGroupInput) *model.StaticGroup
+}
+
+// UIDService generates UUIDs for new entities
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+// Resolver is an object responsible for resolver-layer operations.
+type Resolver struct {
+	transact       persistence.Transactioner
+	conv           Converter
+	staticGroupSvc StaticGroupService
+	uidSvc         UIDService
+}
+
+// NewResolver returns a new object responsible for resolver-layer static group operations.
+func NewResolver(transact persistence.Transactioner, conv Converter, staticGroupSvc StaticGroupService, uidSvc UIDService) *Resolver {
+	return &Resolver{
+		transact:       transact,
+		conv:           conv,
+		staticGroupSvc: staticGroupSvc,
+		uidSvc:         uidSvc,
+	}
+}
+
+// StaticGroup queries the StaticGroup matching ID `id`
+func (r *Resolver) StaticGroup(ctx context.Context, id string) (*graphql.StaticGroup, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	staticGroup, err := r.staticGroupSvc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(staticGroup), nil
+}
+
+// StaticGroups list all StaticGroup with pagination based on `first` and `after`
+func (r *Resolver) StaticGroups(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.StaticGroupPage, error) {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	staticGroupPage, err := r.staticGroupSvc.List(ctx, *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &graphql.StaticGroupPage{
+		Data:       r.conv.MultipleToGraphQL(staticGroupPage.Data),
+		TotalCount: staticGroupPage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(staticGroupPage.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(staticGroupPage.PageInfo.EndCursor),
+			HasNextPage: staticGroupPage.PageInfo.HasNextPage,
+		},
+	}, nil
+}
+
+// CreateStaticGroup creates a StaticGroup with the provided input `in`
+func (r *Resolver) CreateStaticGroup(ctx context.Context, in graphql.StaticGroupInput) (*graphql.StaticGroup, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = in.Validate(); err != nil {
+		return nil, err
+	}
+
+	staticGroupID, err := r.staticGroupSvc.Create(ctx, r.conv.FromGraphql(r.uidSvc.Generate(), in))
+	if err != nil {
+		return nil, err
+	}
+	log.C(ctx).Infof("Successfully created a static group with ID: %s and name: %s", staticGroupID, in.GroupName)
+
+	staticGroup, err := r.staticGroupSvc.Get(ctx, staticGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(staticGroup), nil
+}
+
+// UpdateStaticGroup updates the StaticGroup matching ID `id` using `in`
+func (r *Resolver) UpdateStaticGroup(ctx context.Context, id string, in graphql.StaticGroupInput) (*graphql.StaticGroup, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = in.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = r.staticGroupSvc.Update(ctx, r.conv.FromGraphql(id, in)); err != nil {
+		return nil, err
+	}
+
+	staticGroup, err := r.staticGroupSvc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(staticGroup), nil
+}
+
+// DeleteStaticGroup deletes the StaticGroup matching ID `id`
+func (r *Resolver) DeleteStaticGroup(ctx context.Context, id string) (*graphql.StaticGroup, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	staticGroup, err := r.staticGroupSvc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.staticGroupSvc.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(staticGroup), nil
+}
@@ -0,0 +1,495 @@
+package staticgroup_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/staticgroup"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/staticgroup/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	emptyCtx        = context.Background()
+	txGen           = txtest.NewTransactionContextGenerator(testErr)
+	testErr         = errors.New("test error")
+	invalidInputErr = errors.New("groupName: cannot be blank")
+)
+
+func TestResolver_StaticGroup(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		TransactionerFn  func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ConverterFn      func() *automock.Converter
+		StaticGroupSvcFn func() *automock.StaticGroupService
+		ExpectedOutput   *graphql.StaticGroup
+		ExpectedError    error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", StaticGroupModel).Return(StaticGroupGQLModel).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(StaticGroupModel, nil).Once()
+				return staticGroupSvc
+			},
+			ExpectedOutput: StaticGroupGQLModel,
+		},
+		{
+			Name:            "Error when transaction fails to begin",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ExpectedError:   testErr,
+		},
+		{
+			Name:            "Error when getting static group fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(nil, testErr).Once()
+				return staticGroupSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(StaticGroupModel, nil).Once()
+				return staticGroupSvc
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			conv := fixConverter(testCase.ConverterFn)
+			staticGroupSvc := fixStaticGroupSvc(testCase.StaticGroupSvcFn)
+
+			resolver := staticgroup.NewResolver(transact, conv, staticGroupSvc, fixUnusedUIDService())
+
+			// WHEN
+			result, err := resolver.StaticGroup(emptyCtx, TestID)
+
+			// THEN
+			assertResult(t, testCase.ExpectedOutput, testCase.ExpectedError, result, err)
+			mock.AssertExpectationsForObjects(t, persist, transact, conv, staticGroupSvc)
+		})
+	}
+}
+
+func TestResolver_StaticGroups(t *testing.T) {
+	first := 2
+	gqlAfter := graphql.PageCursor("test")
+
+	testCases := []struct {
+		Name             string
+		First            *int
+		TransactionerFn  func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ConverterFn      func() *automock.Converter
+		StaticGroupSvcFn func() *automock.StaticGroupService
+		ExpectedOutput   *graphql.StaticGroupPage
+		ExpectedError    error
+	}{
+		{
+			Name:            "Success",
+			First:           &first,
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("MultipleToGraphQL", StaticGroupModelPage.Data).Return(StaticGroupsGQL).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("List", txtest.CtxWithDBMatcher(), first, string(gqlAfter)).Return(StaticGroupModelPage, nil).Once()
+				return staticGroupSvc
+			},
+			ExpectedOutput: StaticGroupGQLPage,
+		},
+		{
+			Name:            "Error when 'first' parameter is missing",
+			TransactionerFn: fixUnusedTransactioner,
+			ExpectedError:   apperrors.NewInvalidDataError("missing required parameter 'first'"),
+		},
+		{
+			Name:            "Error when transaction fails to begin",
+			First:           &first,
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ExpectedError:   testErr,
+		},
+		{
+			Name:            "Error when listing static groups fails",
+			First:           &first,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("List", txtest.CtxWithDBMatcher(), first, string(gqlAfter)).Return(nil, testErr).Once()
+				return staticGroupSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing transaction fails",
+			First:           &first,
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("List", txtest.CtxWithDBMatcher(), first, string(gqlAfter)).Return(StaticGroupModelPage, nil).Once()
+				return staticGroupSvc
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			conv := fixConverter(testCase.ConverterFn)
+			staticGroupSvc := fixStaticGroupSvc(testCase.StaticGroupSvcFn)
+
+			resolver := staticgroup.NewResolver(transact, conv, staticGroupSvc, fixUnusedUIDService())
+
+			// WHEN
+			result, err := resolver.StaticGroups(emptyCtx, testCase.First, &gqlAfter)
+
+			// THEN
+			assertResult(t, testCase.ExpectedOutput, testCase.ExpectedError, result, err)
+			mock.AssertExpectationsForObjects(t, persist, transact, conv, staticGroupSvc)
+		})
+	}
+}
+
+func TestResolver_CreateStaticGroup(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Input            graphql.StaticGroupInput
+		TransactionerFn  func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ConverterFn      func() *automock.Converter
+		StaticGroupSvcFn func() *automock.StaticGroupService
+		UIDSvcFn         func() *automock.UIDService
+		ExpectedOutput   *graphql.StaticGroup
+		ExpectedError    error
+	}{
+		{
+			Name:            "Success",
+			Input:           StaticGroupGQLModelInput,
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("FromGraphql", TestID, StaticGroupGQLModelInput).Return(StaticGroupModel).Once()
+				conv.On("ToGraphQL", StaticGroupModel).Return(StaticGroupGQLModel).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Create", txtest.CtxWithDBMatcher(), StaticGroupModel).Return(TestID, nil).Once()
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(StaticGroupModel, nil).Once()
+				return staticGroupSvc
+			},
+			UIDSvcFn:       fixUIDService,
+			ExpectedOutput: StaticGroupGQLModel,
+		},
+		{
+			Name:            "Error when transaction fails to begin",
+			Input:           StaticGroupGQLModelInput,
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ExpectedError:   testErr,
+		},
+		{
+			Name:            "Error when input is invalid",
+			Input:           StaticGroupGQLInvalidInput,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ExpectedError:   invalidInputErr,
+		},
+		{
+			Name:            "Error when creating static group fails",
+			Input:           StaticGroupGQLModelInput,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("FromGraphql", TestID, StaticGroupGQLModelInput).Return(StaticGroupModel).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Create", txtest.CtxWithDBMatcher(), StaticGroupModel).Return("", testErr).Once()
+				return staticGroupSvc
+			},
+			UIDSvcFn:      fixUIDService,
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when getting the created static group fails",
+			Input:           StaticGroupGQLModelInput,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("FromGraphql", TestID, StaticGroupGQLModelInput).Return(StaticGroupModel).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Create", txtest.CtxWithDBMatcher(), StaticGroupModel).Return(TestID, nil).Once()
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(nil, testErr).Once()
+				return staticGroupSvc
+			},
+			UIDSvcFn:      fixUIDService,
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing transaction fails",
+			Input:           StaticGroupGQLModelInput,
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("FromGraphql", TestID, StaticGroupGQLModelInput).Return(StaticGroupModel).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Create", txtest.CtxWithDBMatcher(), StaticGroupModel).Return(TestID, nil).Once()
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(StaticGroupModel, nil).Once()
+				return staticGroupSvc
+			},
+			UIDSvcFn:      fixUIDService,
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			conv := fixConverter(testCase.ConverterFn)
+			staticGroupSvc := fixStaticGroupSvc(testCase.StaticGroupSvcFn)
+			uidSvc := fixUnusedUIDService()
+			if testCase.UIDSvcFn != nil {
+				uidSvc = testCase.UIDSvcFn()
+			}
+
+			resolver := staticgroup.NewResolver(transact, conv, staticGroupSvc, uidSvc)
+
+			// WHEN
+			result, err := resolver.CreateStaticGroup(emptyCtx, testCase.Input)
+
+			// THEN
+			assertResult(t, testCase.ExpectedOutput, testCase.ExpectedError, result, err)
+			mock.AssertExpectationsForObjects(t, persist, transact, conv, staticGroupSvc, uidSvc)
+		})
+	}
+}
+
+func TestResolver_UpdateStaticGroup(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Input            graphql.StaticGroupInput
+		TransactionerFn  func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ConverterFn      func() *automock.Converter
+		StaticGroupSvcFn func() *automock.StaticGroupService
+		ExpectedOutput   *graphql.StaticGroup
+		ExpectedError    error
+	}{
+		{
+			Name:            "Success",
+			Input:           StaticGroupGQLModelInput,
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("FromGraphql", TestID, StaticGroupGQLModelInput).Return(StaticGroupModel).Once()
+				conv.On("ToGraphQL", StaticGroupModel).Return(StaticGroupGQLModel).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Update", txtest.CtxWithDBMatcher(), StaticGroupModel).Return(nil).Once()
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(StaticGroupModel, nil).Once()
+				return staticGroupSvc
+			},
+			ExpectedOutput: StaticGroupGQLModel,
+		},
+		{
+			Name:            "Error when transaction fails to begin",
+			Input:           StaticGroupGQLModelInput,
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ExpectedError:   testErr,
+		},
+		{
+			Name:            "Error when input is invalid",
+			Input:           StaticGroupGQLInvalidInput,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ExpectedError:   invalidInputErr,
+		},
+		{
+			Name:            "Error when updating static group fails",
+			Input:           StaticGroupGQLModelInput,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("FromGraphql", TestID, StaticGroupGQLModelInput).Return(StaticGroupModel).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Update", txtest.CtxWithDBMatcher(), StaticGroupModel).Return(testErr).Once()
+				return staticGroupSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when getting the updated static group fails",
+			Input:           StaticGroupGQLModelInput,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("FromGraphql", TestID, StaticGroupGQLModelInput).Return(StaticGroupModel).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Update", txtest.CtxWithDBMatcher(), StaticGroupModel).Return(nil).Once()
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(nil, testErr).Once()
+				return staticGroupSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing transaction fails",
+			Input:           StaticGroupGQLModelInput,
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("FromGraphql", TestID, StaticGroupGQLModelInput).Return(StaticGroupModel).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Update", txtest.CtxWithDBMatcher(), StaticGroupModel).Return(nil).Once()
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(StaticGroupModel, nil).Once()
+				return staticGroupSvc
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			conv := fixConverter(testCase.ConverterFn)
+			staticGroupSvc := fixStaticGroupSvc(testCase.StaticGroupSvcFn)
+
+			resolver := staticgroup.NewResolver(transact, conv, staticGroupSvc, fixUnusedUIDService())
+
+			// WHEN
+			result, err := resolver.UpdateStaticGroup(emptyCtx, TestID, testCase.Input)
+
+			// THEN
+			assertResult(t, testCase.ExpectedOutput, testCase.ExpectedError, result, err)
+			mock.AssertExpectationsForObjects(t, persist, transact, conv, staticGroupSvc)
+		})
+	}
+}
+
+func TestResolver_DeleteStaticGroup(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		TransactionerFn  func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ConverterFn      func() *automock.Converter
+		StaticGroupSvcFn func() *automock.StaticGroupService
+		ExpectedOutput   *graphql.StaticGroup
+		ExpectedError    error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", StaticGroupModel).Return(StaticGroupGQLModel).Once()
+				return conv
+			},
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(StaticGroupModel, nil).Once()
+				staticGroupSvc.On("Delete", txtest.CtxWithDBMatcher(), TestID).Return(nil).Once()
+				return staticGroupSvc
+			},
+			ExpectedOutput: StaticGroupGQLModel,
+		},
+		{
+			Name:            "Error when transaction fails to begin",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ExpectedError:   testErr,
+		},
+		{
+			Name:            "Error when getting static group fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(nil, testErr).Once()
+				return staticGroupSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when deleting static group fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(StaticGroupModel, nil).Once()
+				staticGroupSvc.On("Delete", txtest.CtxWithDBMatcher(), TestID).Return(testErr).Once()
+				return staticGroupSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			StaticGroupSvcFn: func() *automock.StaticGroupService {
+				staticGroupSvc := &automock.StaticGroupService{}
+				staticGroupSvc.On("Get", txtest.CtxWithDBMatcher(), TestID).Return(StaticGroupModel, nil).Once()
+				staticGroupSvc.On("Delete", txtest.CtxWithDBMatcher(), TestID).Return(nil).Once()
+				return staticGroupSvc
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			conv := fixConverter(testCase.ConverterFn)
+			staticGroupSvc := fixStaticGroupSvc(testCase.StaticGroupSvcFn)
+
+			resolver := staticgroup.NewResolver(transact, conv, staticGroupSvc, fixUnusedUIDService())
+
+			// WHEN
+			result, err := resolver.DeleteStaticGroup(emptyCtx, TestID)
+
+			// THEN
+			assertResult(t, testCase.ExpectedOutput, testCase.ExpectedError, result, err)
+			mock.AssertExpectationsForObjects(t, persist, transact, conv, staticGroupSvc)
+		})
+	}
+}
+
+func assertResult(t *testing.T, expectedOutput interface{}, expectedError error, result interface{}, err error) {
+	if expectedError != nil {
+		require.Error(t, err)
+		require.Contains(t, err.Error(), expectedError.Error())
+	} else {
+		require.NoError(t, err)
+	}
+	require.Equal(t, expectedOutput, result)
+}
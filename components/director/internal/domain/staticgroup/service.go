@@ -0,0 +1,143 @@
+package staticgroup
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// StaticGroupRepository represents the static group repository layer
+//
+//go:generate mockery --name=StaticGroupRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type StaticGroupRepository interface {
+	Create(ctx context.Context, item *model.StaticGroup) error
+	Get(ctx context.Context, id string) (*model.StaticGroup, error)
+	Update(ctx context.Context, in *model.StaticGroup) error
+	Delete(ctx context.Context, id string) error
+	Exists(ctx context.Context, id string) (bool, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.StaticGroupPage, error)
+}
+
+// ScopesProvider provides the scopes which are required by the operations of the API
+//
+//go:generate mockery --name=ScopesProvider --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ScopesProvider interface {
+	GetDefinedScopes() ([]string, error)
+}
+
+type service struct {
+	repo           StaticGroupRepository
+	scopesProvider ScopesProvider
+}
+
+// NewService returns a new object responsible for service-layer static group operations.
+func NewService(repo StaticGroupRepository, scopesProvider ScopesProvider) *service {
+	return &service{
+		repo:           repo,
+		scopesProvider: scopesProvider,
+	}
+}
+
+// Create creates a static group using `item`
+func (s *service) Create(ctx context.Context, item *model.StaticGroup) (string, error) {
+	log.C(ctx).Infof("Creating static group with ID: %s and name: %s", item.ID, item.GroupName)
+	if err := s.validateScopes(item.Scopes); err != nil {
+		return "", err
+	}
+
+	if err := s.repo.Create(ctx, item); err != nil {
+		return "", errors.Wrapf(err, "while creating static group with name: %s", item.GroupName)
+	}
+
+	return item.ID, nil
+}
+
+// Get queries static group matching ID `id`
+func (s *service) Get(ctx context.Context, id string) (*model.StaticGroup, error) {
+	log.C(ctx).Infof("Getting static group with ID: %s", id)
+	staticGroup, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting static group with ID: %s", id)
+	}
+
+	return staticGroup, nil
+}
+
+// Update updates a static group using `in`
+func (s *service) Update(ctx context.Context, in *model.StaticGroup) error {
+	log.C(ctx).Infof("Updating static group with ID: %s and name: %s", in.ID, in.GroupName)
+
+	if exists, err := s.repo.Exists(ctx, in.ID); err != nil {
+		return errors.Wrapf(err, "while ensuring static group with ID: %s exists", in.ID)
+	} else if !exists {
+		return apperrors.NewNotFoundError(resource.StaticGroup, in.ID)
+	}
+
+	if err := s.validateScopes(in.Scopes); err != nil {
+		return err
+	}
+
+	if err := s.repo.Update(ctx, in); err != nil {
+		return errors.Wrapf(err, "while updating static group with ID: %s", in.ID)
+	}
+
+	return nil
+}
+
+// Delete deletes a static group matching ID `id`
+func (s *service) Delete(ctx context.Context, id string) error {
+	log.C(ctx).Infof("Deleting static group with ID: %s", id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return errors.Wrapf(err, "while deleting static group with ID: %s", id)
+	}
+	return nil
+}
+
+// List retrieves static groups with pagination based on `pageSize` and `cursor`
+func (s *service) List(ctx context.Context, pageSize int, cursor string) (*model.StaticGroupPage, error) {
+	log.C(ctx).Info("Listing static groups")
+	if pageSize < 1 || pageSize > 300 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 300")
+	}
+
+	staticGroupPage, err := s.repo.List(ctx, pageSize, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing static groups")
+	}
+
+	return staticGroupPage, nil
+}
+
+// validateScopes ensures that each of the `scopes` is required by at least one operation of the API,
+// so that a typo does not silently result in a group which grants nothing
+func (s *service) validateScopes(scopes []string) error {
+	definedScopes, err := s.scopesProvider.GetDefinedScopes()
+	if err != nil {
+		return errors.Wrap(err, "while getting the defined scopes")
+	}
+
+	defined := make(map[string]bool, len(definedScopes))
+	for _, scope := range definedScopes {
+		defined[scope] = true
+	}
+
+	unknownScopes := make([]string, 0)
+	for _, scope := range scopes {
+		if !defined[scope] {
+			unknownScopes = append(unknownScopes, scope)
+		}
+	}
+
+	if len(unknownScopes) > 0 {
+		sort.Strings(unknownScopes)
+		return apperrors.NewInvalidDataError("unknown scopes: %s", strings.Join(unknownScopes, ", "))
+	}
+
+	return nil
+}
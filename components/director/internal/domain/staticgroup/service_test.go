@@ -0,0 +1,343 @@
+package staticgroup_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/staticgroup"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/staticgroup/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	unknownScopesModel := fixStaticGroupModel(TestID, TestGroupName, []string{"runtime:read", "unknown:write", "unknown:read"}, testTime)
+
+	testCases := []struct {
+		Name             string
+		Input            *model.StaticGroup
+		Repo             func() *automock.StaticGroupRepository
+		ScopesProviderFn func() *automock.ScopesProvider
+		ExpectedOutput   string
+		ExpectedError    string
+	}{
+		{
+			Name:  "Success",
+			Input: StaticGroupModel,
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Create", emptyCtx, StaticGroupModel).Return(nil).Once()
+				return repo
+			},
+			ScopesProviderFn: fixScopesProvider,
+			ExpectedOutput:   TestID,
+		},
+		{
+			Name:             "Error when some of the scopes are not defined",
+			Input:            unknownScopesModel,
+			Repo:             fixUnusedStaticGroupRepository,
+			ScopesProviderFn: fixScopesProvider,
+			ExpectedError:    "unknown scopes: unknown:read, unknown:write",
+		},
+		{
+			Name:  "Error when getting the defined scopes fails",
+			Input: StaticGroupModel,
+			Repo:  fixUnusedStaticGroupRepository,
+			ScopesProviderFn: func() *automock.ScopesProvider {
+				scopesProvider := &automock.ScopesProvider{}
+				scopesProvider.On("GetDefinedScopes").Return(nil, testErr).Once()
+				return scopesProvider
+			},
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:  "Error when creating static group",
+			Input: StaticGroupModel,
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Create", emptyCtx, StaticGroupModel).Return(testErr).Once()
+				return repo
+			},
+			ScopesProviderFn: fixScopesProvider,
+			ExpectedError:    testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.Repo()
+			scopesProvider := testCase.ScopesProviderFn()
+
+			svc := staticgroup.NewService(repo, scopesProvider)
+
+			// WHEN
+			result, err := svc.Create(emptyCtx, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, repo, scopesProvider)
+		})
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Repo           func() *automock.StaticGroupRepository
+		ExpectedOutput *model.StaticGroup
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Get", emptyCtx, TestID).Return(StaticGroupModel, nil).Once()
+				return repo
+			},
+			ExpectedOutput: StaticGroupModel,
+		},
+		{
+			Name: "Error when getting static group",
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Get", emptyCtx, TestID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.Repo()
+
+			svc := staticgroup.NewService(repo, fixUnusedScopesProvider())
+
+			// WHEN
+			result, err := svc.Get(emptyCtx, TestID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	unknownScopesModel := fixStaticGroupModel(TestID, TestGroupName, []string{"unknown:read"}, testTime)
+
+	testCases := []struct {
+		Name             string
+		Input            *model.StaticGroup
+		Repo             func() *automock.StaticGroupRepository
+		ScopesProviderFn func() *automock.ScopesProvider
+		ExpectedError    error
+	}{
+		{
+			Name:  "Success",
+			Input: StaticGroupModel,
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Exists", emptyCtx, TestID).Return(true, nil).Once()
+				repo.On("Update", emptyCtx, StaticGroupModel).Return(nil).Once()
+				return repo
+			},
+			ScopesProviderFn: fixScopesProvider,
+		},
+		{
+			Name:  "Error when checking for static group existence fails",
+			Input: StaticGroupModel,
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Exists", emptyCtx, TestID).Return(false, testErr).Once()
+				return repo
+			},
+			ScopesProviderFn: fixUnusedScopesProvider,
+			ExpectedError:    testErr,
+		},
+		{
+			Name:  "Error when static group does not exist",
+			Input: StaticGroupModel,
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Exists", emptyCtx, TestID).Return(false, nil).Once()
+				return repo
+			},
+			ScopesProviderFn: fixUnusedScopesProvider,
+			ExpectedError:    apperrors.NewNotFoundError(resource.StaticGroup, TestID),
+		},
+		{
+			Name:  "Error when some of the scopes are not defined",
+			Input: unknownScopesModel,
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Exists", emptyCtx, TestID).Return(true, nil).Once()
+				return repo
+			},
+			ScopesProviderFn: fixScopesProvider,
+			ExpectedError:    apperrors.NewInvalidDataError("unknown scopes: unknown:read"),
+		},
+		{
+			Name:  "Error when updating static group fails",
+			Input: StaticGroupModel,
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Exists", emptyCtx, TestID).Return(true, nil).Once()
+				repo.On("Update", emptyCtx, StaticGroupModel).Return(testErr).Once()
+				return repo
+			},
+			ScopesProviderFn: fixScopesProvider,
+			ExpectedError:    testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.Repo()
+			scopesProvider := testCase.ScopesProviderFn()
+
+			svc := staticgroup.NewService(repo, scopesProvider)
+
+			// WHEN
+			err := svc.Update(emptyCtx, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, scopesProvider)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Repo          func() *automock.StaticGroupRepository
+		ExpectedError error
+	}{
+		{
+			Name: "Success",
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Delete", emptyCtx, TestID).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when deleting static group",
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("Delete", emptyCtx, TestID).Return(testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.Repo()
+
+			svc := staticgroup.NewService(repo, fixUnusedScopesProvider())
+
+			// WHEN
+			err := svc.Delete(emptyCtx, TestID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	const pageSize = 3
+	const cursor = "cursor"
+
+	testCases := []struct {
+		Name           string
+		PageSize       int
+		Repo           func() *automock.StaticGroupRepository
+		ExpectedOutput *model.StaticGroupPage
+		ExpectedError  error
+	}{
+		{
+			Name:     "Success",
+			PageSize: pageSize,
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("List", emptyCtx, pageSize, cursor).Return(StaticGroupModelPage, nil).Once()
+				return repo
+			},
+			ExpectedOutput: StaticGroupModelPage,
+		},
+		{
+			Name:          "Error when page size is invalid",
+			PageSize:      301,
+			Repo:          fixUnusedStaticGroupRepository,
+			ExpectedError: apperrors.NewInvalidDataError("page size must be between 1 and 300"),
+		},
+		{
+			Name:     "Error when listing static groups",
+			PageSize: pageSize,
+			Repo: func() *automock.StaticGroupRepository {
+				repo := &automock.StaticGroupRepository{}
+				repo.On("List", emptyCtx, pageSize, cursor).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.Repo()
+
+			svc := staticgroup.NewService(repo, fixUnusedScopesProvider())
+
+			// WHEN
+			result, err := svc.List(emptyCtx, testCase.PageSize, cursor)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package templatedrift
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+// placeholderValue is the persisted form of a single placeholder value
+type placeholderValue struct {
+	Placeholder string `json:"placeholder"`
+	Value       string `json:"value"`
+}
+
+type converter struct{}
+
+// NewConverter returns a new template drift converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the placeholder values model to an entity
+func (c *converter) ToEntity(in *model.ApplicationTemplatePlaceholderValues) (*Entity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	values := make([]placeholderValue, 0, len(in.Values))
+	for _, v := range in.Values {
+		if v == nil {
+			continue
+		}
+		values = append(values, placeholderValue{Placeholder: v.Placeholder, Value: v.Value})
+	}
+
+	marshalledValues, err := json.Marshal(values)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while marshalling the placeholder values of application with ID %s", in.ApplicationID)
+	}
+
+	return &Entity{
+		ApplicationID:         in.ApplicationID,
+		ApplicationTemplateID: in.ApplicationTemplateID,
+		PlaceholderValues:     string(marshalledValues),
+	}, nil
+}
+
+// FromEntity converts the placeholder values entity to a model
+func (c *converter) FromEntity(in *Entity) (*model.ApplicationTemplatePlaceholderValues, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var values []placeholderValue
+	if err := json.Unmarshal([]byte(in.PlaceholderValues), &values); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshalling the placeholder values of application with ID %s", in.ApplicationID)
+	}
+
+	modelValues := make(model.ApplicationFromTemplateInputValues, 0, len(values))
+	for _, v := range values {
+		modelValues = append(modelValues, &model.ApplicationTemplateValueInput{Placeholder: v.Placeholder, Value: v.Value})
+	}
+
+	return &model.ApplicationTemplatePlaceholderValues{
+		ApplicationID:         in.ApplicationID,
+		ApplicationTemplateID: in.ApplicationTemplateID,
+		Values:                modelValues,
+	}, nil
+}
+
+// DriftToGraphQL converts the template drift of an application to its GraphQL representation
+func (c *converter) DriftToGraphQL(in *model.ApplicationTemplateDrift) (*graphql.ApplicationTemplateDrift, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	differences, err := c.differencesToGraphQL(in.Differences)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.ApplicationTemplateDrift{
+		ApplicationTemplateID: in.ApplicationTemplateID,
+		InSync:                in.InSync(),
+		Differences:           differences,
+	}, nil
+}
+
+// UpgradeResultsToGraphQL converts the results of an application template upgrade to their GraphQL representation
+func (c *converter) UpgradeResultsToGraphQL(in []*model.ApplicationTemplateUpgradeResult) ([]*graphql.ApplicationTemplateUpgradeResult, error) {
+	results := make([]*graphql.ApplicationTemplateUpgradeResult, 0, len(in))
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+
+		differences, err := c.differencesToGraphQL(r.Differences)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting the upgrade result of application with ID %s", r.ApplicationID)
+		}
+
+		results = append(results, &graphql.ApplicationTemplateUpgradeResult{
+			ApplicationID: r.ApplicationID,
+			Status:        graphql.ApplicationTemplateUpgradeStatus(r.Status),
+			Differences:   differences,
+			Error:         r.Error,
+		})
+	}
+
+	return results, nil
+}
+
+func (c *converter) differencesToGraphQL(in []*model.ApplicationTemplateDriftDifference) ([]*graphql.ApplicationTemplateDriftDifference, error) {
+	differences := make([]*graphql.ApplicationTemplateDriftDifference, 0, len(in))
+	for _, d := range in {
+		if d == nil {
+			continue
+		}
+
+		current, err := toJSON(d.Current)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while marshalling the current value of %s", d.Field)
+		}
+
+		expected, err := toJSON(d.Expected)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while marshalling the expected value of %s", d.Field)
+		}
+
+		differences = append(differences, &graphql.ApplicationTemplateDriftDifference{
+			Field:    d.Field,
+			Current:  current,
+			Expected: expected,
+		})
+	}
+
+	return differences, nil
+}
+
+func toJSON(value interface{}) (*graphql.JSON, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	marshalled, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	result := graphql.JSON(marshalled)
+	return &result, nil
+}
@@ -0,0 +1,93 @@
+package templatedrift_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/templatedrift"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	// WHEN
+	entity, err := templatedrift.NewConverter().ToEntity(fixPlaceholderValuesModel())
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, fixPlaceholderValuesEntity(), entity)
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		values, err := templatedrift.NewConverter().FromEntity(fixPlaceholderValuesEntity())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixPlaceholderValuesModel(), values)
+	})
+
+	t.Run("Error when the values are not valid JSON", func(t *testing.T) {
+		// GIVEN
+		entity := fixPlaceholderValuesEntity()
+		entity.PlaceholderValues = "{"
+
+		// WHEN
+		_, err := templatedrift.NewConverter().FromEntity(entity)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling the placeholder values")
+	})
+}
+
+func TestConverter_DriftToGraphQL(t *testing.T) {
+	// GIVEN
+	drift := &model.ApplicationTemplateDrift{
+		ApplicationTemplateID: appTemplateID,
+		Differences: []*model.ApplicationTemplateDriftDifference{
+			{Field: "description", Current: str.Ptr("old"), Expected: "new"},
+			{Field: "labels.region", Current: nil, Expected: []interface{}{"eu10"}},
+		},
+	}
+
+	// WHEN
+	result, err := templatedrift.NewConverter().DriftToGraphQL(drift)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, &graphql.ApplicationTemplateDrift{
+		ApplicationTemplateID: appTemplateID,
+		InSync:                false,
+		Differences: []*graphql.ApplicationTemplateDriftDifference{
+			{Field: "description", Current: jsonPtr(`"old"`), Expected: jsonPtr(`"new"`)},
+			{Field: "labels.region", Expected: jsonPtr(`["eu10"]`)},
+		},
+	}, result)
+}
+
+func TestConverter_UpgradeResultsToGraphQL(t *testing.T) {
+	// GIVEN
+	results := []*model.ApplicationTemplateUpgradeResult{
+		{ApplicationID: appID, Status: model.ApplicationTemplateUpgradeStatusUpToDate, Differences: []*model.ApplicationTemplateDriftDifference{}},
+		{ApplicationID: "failed", Status: model.ApplicationTemplateUpgradeStatusFailed, Error: str.Ptr("test error")},
+	}
+
+	// WHEN
+	result, err := templatedrift.NewConverter().UpgradeResultsToGraphQL(results)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, []*graphql.ApplicationTemplateUpgradeResult{
+		{ApplicationID: appID, Status: graphql.ApplicationTemplateUpgradeStatusUpToDate, Differences: []*graphql.ApplicationTemplateDriftDifference{}},
+		{ApplicationID: "failed", Status: graphql.ApplicationTemplateUpgradeStatusFailed, Differences: []*graphql.ApplicationTemplateDriftDifference{}, Error: str.Ptr("test error")},
+	}, result)
+}
+
+func jsonPtr(value string) *graphql.JSON {
+	result := graphql.JSON(value)
+	return &result
+}
@@ -0,0 +1,8 @@
+package templatedrift
+
+// Entity represents the placeholder values of an application in the database
+type Entity struct {
+	ApplicationID         string `db:"app_id"`
+	ApplicationTemplateID string `db:"app_template_id"`
+	PlaceholderValues     string `db:"placeholder_values"`
+}
@@ -0,0 +1,114 @@
+package templatedrift_test
+
+import (
+	"errors"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/templatedrift"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	appID         = "c5ab8c1b-4f1e-4a42-8a45-2b31f2a5e0b7"
+	appTemplateID = "0f1e4a5b-2f4c-4d7e-9a8b-6c5d4e3f2a1b"
+	webhookID     = "7d3b2c1a-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	appName       = "my-app"
+	appInputJSON  = `{"name":"my-app"}`
+	valuesJSON    = `[{"placeholder":"name","value":"my-app"}]`
+)
+
+var (
+	sensitive    = true
+	testErr      = errors.New("test error")
+	tableColumns = []string{"app_id", "app_template_id", "placeholder_values"}
+)
+
+func fixPlaceholderValues() model.ApplicationFromTemplateInputValues {
+	return model.ApplicationFromTemplateInputValues{{Placeholder: "name", Value: appName}}
+}
+
+func fixPlaceholderValuesModel() *model.ApplicationTemplatePlaceholderValues {
+	return &model.ApplicationTemplatePlaceholderValues{
+		ApplicationID:         appID,
+		ApplicationTemplateID: appTemplateID,
+		Values:                fixPlaceholderValues(),
+	}
+}
+
+func fixPlaceholderValuesEntity() *templatedrift.Entity {
+	return &templatedrift.Entity{
+		ApplicationID:         appID,
+		ApplicationTemplateID: appTemplateID,
+		PlaceholderValues:     valuesJSON,
+	}
+}
+
+func fixApplication(name string) *model.Application {
+	return &model.Application{
+		Name:                  name,
+		Description:           str.Ptr("old description"),
+		BaseURL:               str.Ptr("https://old.example.com"),
+		ApplicationTemplateID: str.Ptr(appTemplateID),
+		Status:                &model.ApplicationStatus{Condition: model.ApplicationStatusConditionConnected},
+		BaseEntity:            &model.BaseEntity{ID: appID},
+	}
+}
+
+func fixApplicationTemplate() *model.ApplicationTemplate {
+	return &model.ApplicationTemplate{
+		ID:                   appTemplateID,
+		Name:                 "my-template",
+		ApplicationInputJSON: appInputJSON,
+	}
+}
+
+func fixApplicationTemplateWithSensitivePlaceholder() *model.ApplicationTemplate {
+	appTemplate := fixApplicationTemplate()
+	appTemplate.ApplicationInputJSON = `{"name":"{{name}}","description":"{{token}}","labels":{"region":"eu10","token":"{{token}}"},"webhooks":[{"type":"CONFIGURATION_CHANGED","url":"https://new.example.com/webhook?token={{token}}"}]}`
+	appTemplate.Placeholders = []model.ApplicationTemplatePlaceholder{
+		{Name: "name"},
+		{Name: "token", Sensitive: &sensitive},
+	}
+	return appTemplate
+}
+
+func fixPlaceholderValuesWithSensitiveValue() model.ApplicationFromTemplateInputValues {
+	return append(fixPlaceholderValues(), &model.ApplicationTemplateValueInput{Placeholder: "token", Value: "secret"})
+}
+
+func fixRenderedInput() model.ApplicationRegisterInput {
+	return model.ApplicationRegisterInput{
+		Name:        appName,
+		Description: str.Ptr("new description"),
+		BaseURL:     str.Ptr("https://old.example.com"),
+		Labels: map[string]interface{}{
+			"region":    "eu10",
+			"managed":   "false",
+			"scenarios": []interface{}{"DEFAULT"},
+		},
+		Webhooks: []*model.WebhookInput{
+			{Type: model.WebhookTypeConfigurationChanged, URL: str.Ptr("https://new.example.com/webhook")},
+			{Type: model.WebhookTypeOpenResourceDiscovery, URL: str.Ptr("https://old.example.com/ord")},
+		},
+	}
+}
+
+func fixGQLRenderedInput() graphql.ApplicationRegisterInput {
+	return graphql.ApplicationRegisterInput{Name: appName}
+}
+
+func fixLabels() map[string]*model.Label {
+	return map[string]*model.Label{
+		"region":  {Key: "region", Value: "eu20"},
+		"managed": {Key: "managed", Value: "false"},
+		"custom":  {Key: "custom", Value: "kept"},
+	}
+}
+
+func fixWebhooks() []*model.Webhook {
+	return []*model.Webhook{
+		{ID: webhookID, Type: model.WebhookTypeConfigurationChanged, URL: str.Ptr("https://old.example.com/webhook")},
+		{ID: "ord-webhook", Type: model.WebhookTypeOpenResourceDiscovery, URL: str.Ptr("https://old.example.com/ord")},
+	}
+}
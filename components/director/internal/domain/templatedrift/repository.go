@@ -0,0 +1,66 @@
+package templatedrift
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableName      = "public.app_template_placeholder_values"
+	appIDColumn    = "app_id"
+	valuesColumn   = "placeholder_values"
+	templateColumn = "app_template_id"
+)
+
+var tableColumns = []string{appIDColumn, templateColumn, valuesColumn}
+
+// EntityConverter converts between the model and the entity of the placeholder values of an application
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.ApplicationTemplatePlaceholderValues) (*Entity, error)
+	FromEntity(in *Entity) (*model.ApplicationTemplatePlaceholderValues, error)
+}
+
+type repository struct {
+	upserter     repo.UpserterGlobal
+	singleGetter repo.SingleGetterGlobal
+	conv         EntityConverter
+}
+
+// NewRepository returns a new placeholder values repository
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		upserter:     repo.NewUpserterGlobal(resource.ApplicationTemplatePlaceholderValues, tableName, tableColumns, []string{appIDColumn}, []string{templateColumn, valuesColumn}),
+		singleGetter: repo.NewSingleGetterGlobal(resource.ApplicationTemplatePlaceholderValues, tableName, tableColumns),
+		conv:         conv,
+	}
+}
+
+// Upsert stores the placeholder values of the application, replacing the previously stored ones
+func (r *repository) Upsert(ctx context.Context, in *model.ApplicationTemplatePlaceholderValues) error {
+	if in == nil {
+		return errors.New("placeholder values cannot be empty")
+	}
+
+	entity, err := r.conv.ToEntity(in)
+	if err != nil {
+		return err
+	}
+
+	return r.upserter.UpsertGlobal(ctx, entity)
+}
+
+// GetByApplicationID returns the placeholder values the application was registered with
+func (r *repository) GetByApplicationID(ctx context.Context, appID string) (*model.ApplicationTemplatePlaceholderValues, error) {
+	var entity Entity
+	if err := r.singleGetter.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition(appIDColumn, appID)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity)
+}
@@ -0,0 +1,99 @@
+package templatedrift_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/templatedrift"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/templatedrift/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Upsert(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixPlaceholderValuesEntity()
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.app_template_placeholder_values ( app_id, app_template_id, placeholder_values ) VALUES ( ?, ?, ? ) ON CONFLICT ( app_id ) DO UPDATE SET app_template_id=EXCLUDED.app_template_id, placeholder_values=EXCLUDED.placeholder_values`)).
+			WithArgs(entity.ApplicationID, entity.ApplicationTemplateID, entity.PlaceholderValues).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		conv := &automock.EntityConverter{}
+		conv.On("ToEntity", fixPlaceholderValuesModel()).Return(entity, nil).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := templatedrift.NewRepository(conv)
+
+		// WHEN
+		err := repo.Upsert(ctx, fixPlaceholderValuesModel())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when the values are empty", func(t *testing.T) {
+		// WHEN
+		err := templatedrift.NewRepository(nil).Upsert(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "placeholder values cannot be empty")
+	})
+}
+
+func TestRepository_GetByApplicationID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		entity := fixPlaceholderValuesEntity()
+		rows := sqlmock.NewRows(tableColumns).AddRow(entity.ApplicationID, entity.ApplicationTemplateID, entity.PlaceholderValues)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_id, app_template_id, placeholder_values FROM public.app_template_placeholder_values WHERE app_id = $1`)).
+			WithArgs(appID).
+			WillReturnRows(rows)
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", entity).Return(fixPlaceholderValuesModel(), nil).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := templatedrift.NewRepository(conv)
+
+		// WHEN
+		result, err := repo.GetByApplicationID(ctx, appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixPlaceholderValuesModel(), result)
+	})
+
+	t.Run("Not found error when there are no values", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(`SELECT .* FROM public\.app_template_placeholder_values WHERE app_id = \$1`).
+			WithArgs(appID).
+			WillReturnRows(sqlmock.NewRows(tableColumns))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := templatedrift.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.GetByApplicationID(ctx, appID)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+}
@@ -0,0 +1,129 @@
+package templatedrift
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// TemplateDriftService is responsible for the service-layer template drift operations
+//
+//go:generate mockery --name=TemplateDriftService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type TemplateDriftService interface {
+	GetDrift(ctx context.Context, appID string) (*model.ApplicationTemplateDrift, error)
+	Upgrade(ctx context.Context, appTemplateID, appID string, dryRun bool) ([]*model.ApplicationTemplateDriftDifference, error)
+}
+
+// Converter converts template drifts and upgrade results to their GraphQL representation
+//
+//go:generate mockery --name=Converter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Converter interface {
+	DriftToGraphQL(in *model.ApplicationTemplateDrift) (*graphql.ApplicationTemplateDrift, error)
+	UpgradeResultsToGraphQL(in []*model.ApplicationTemplateUpgradeResult) ([]*graphql.ApplicationTemplateUpgradeResult, error)
+}
+
+// Resolver is the template drift resolver
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      TemplateDriftService
+	conv     Converter
+}
+
+// NewResolver creates a new template drift resolver
+func NewResolver(transact persistence.Transactioner, svc TemplateDriftService, conv Converter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// TemplateDrift resolves the differences between the application and the current version of the application template it was registered from
+func (r *Resolver) TemplateDrift(ctx context.Context, obj *graphql.Application) (*graphql.ApplicationTemplateDrift, error) {
+	if obj == nil || obj.ApplicationTemplateID == nil {
+		return nil, nil
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	drift, err := r.svc.GetDrift(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.DriftToGraphQL(drift)
+}
+
+// UpgradeApplicationsFromTemplate applies the current version of the application template to each of the applications.
+// Every application is upgraded in its own transaction, so a failure is reported in its result and does not affect the others.
+func (r *Resolver) UpgradeApplicationsFromTemplate(ctx context.Context, templateID string, applicationIDs []string, dryRun *bool) ([]*graphql.ApplicationTemplateUpgradeResult, error) {
+	isDryRun := dryRun != nil && *dryRun
+
+	results := make([]*model.ApplicationTemplateUpgradeResult, 0, len(applicationIDs))
+	for _, appID := range applicationIDs {
+		results = append(results, r.upgradeApplication(ctx, templateID, appID, isDryRun))
+	}
+
+	return r.conv.UpgradeResultsToGraphQL(results)
+}
+
+func (r *Resolver) upgradeApplication(ctx context.Context, templateID, appID string, dryRun bool) *model.ApplicationTemplateUpgradeResult {
+	differences, err := r.upgradeApplicationInTx(ctx, templateID, appID, dryRun)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to upgrade application with ID %s from application template with ID %s", appID, templateID)
+		errMessage := err.Error()
+		return &model.ApplicationTemplateUpgradeResult{
+			ApplicationID: appID,
+			Status:        model.ApplicationTemplateUpgradeStatusFailed,
+			Differences:   []*model.ApplicationTemplateDriftDifference{},
+			Error:         &errMessage,
+		}
+	}
+
+	status := model.ApplicationTemplateUpgradeStatusUpgraded
+	if len(differences) == 0 {
+		status = model.ApplicationTemplateUpgradeStatusUpToDate
+	} else if dryRun {
+		status = model.ApplicationTemplateUpgradeStatusDrifted
+	}
+
+	return &model.ApplicationTemplateUpgradeResult{
+		ApplicationID: appID,
+		Status:        status,
+		Differences:   differences,
+	}
+}
+
+func (r *Resolver) upgradeApplicationInTx(ctx context.Context, templateID, appID string, dryRun bool) ([]*model.ApplicationTemplateDriftDifference, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	differences, err := r.svc.Upgrade(ctx, templateID, appID, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return differences, nil
+}
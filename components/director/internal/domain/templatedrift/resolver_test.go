@@ -0,0 +1,188 @@
+package templatedrift_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/templatedrift"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/templatedrift/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_TemplateDrift(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	drift := &model.ApplicationTemplateDrift{ApplicationTemplateID: appTemplateID}
+	gqlDrift := &graphql.ApplicationTemplateDrift{ApplicationTemplateID: appTemplateID, InSync: true}
+	gqlApp := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: appID}, ApplicationTemplateID: str.Ptr(appTemplateID)}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.TemplateDriftService
+		ConverterFn    func() *automock.Converter
+		Application    *graphql.Application
+		ExpectedOutput *graphql.ApplicationTemplateDrift
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TemplateDriftService {
+				svc := &automock.TemplateDriftService{}
+				svc.On("GetDrift", txtest.CtxWithDBMatcher(), appID).Return(drift, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("DriftToGraphQL", drift).Return(gqlDrift, nil).Once()
+				return conv
+			},
+			Application:    gqlApp,
+			ExpectedOutput: gqlDrift,
+		},
+		{
+			Name:        "Returns nil when the application is not registered from a template",
+			TxFn:        txGen.ThatDoesntStartTransaction,
+			ServiceFn:   func() *automock.TemplateDriftService { return &automock.TemplateDriftService{} },
+			ConverterFn: func() *automock.Converter { return &automock.Converter{} },
+			Application: &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: appID}},
+		},
+		{
+			Name: "Error when computing the drift fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.TemplateDriftService {
+				svc := &automock.TemplateDriftService{}
+				svc.On("GetDrift", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn:   func() *automock.Converter { return &automock.Converter{} },
+			Application:   gqlApp,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name:          "Error when the transaction fails to begin",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ServiceFn:     func() *automock.TemplateDriftService { return &automock.TemplateDriftService{} },
+			ConverterFn:   func() *automock.Converter { return &automock.Converter{} },
+			Application:   gqlApp,
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+			resolver := templatedrift.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.TemplateDrift(context.TODO(), testCase.Application)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_UpgradeApplicationsFromTemplate(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	differences := []*model.ApplicationTemplateDriftDifference{{Field: "name", Current: "old", Expected: appName}}
+	gqlResults := []*graphql.ApplicationTemplateUpgradeResult{{ApplicationID: appID}}
+	upToDateID := "up-to-date"
+	failingID := "failing"
+
+	testCases := []struct {
+		Name            string
+		TxFn            func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.TemplateDriftService
+		ApplicationIDs  []string
+		DryRun          *bool
+		ExpectedResults []*model.ApplicationTemplateUpgradeResult
+	}{
+		{
+			Name: "Reports the result of every application",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(2)
+			},
+			ServiceFn: func() *automock.TemplateDriftService {
+				svc := &automock.TemplateDriftService{}
+				svc.On("Upgrade", txtest.CtxWithDBMatcher(), appTemplateID, appID, false).Return(differences, nil).Once()
+				svc.On("Upgrade", txtest.CtxWithDBMatcher(), appTemplateID, upToDateID, false).Return([]*model.ApplicationTemplateDriftDifference{}, nil).Once()
+				svc.On("Upgrade", txtest.CtxWithDBMatcher(), appTemplateID, failingID, false).Return(nil, testErr).Once()
+				return svc
+			},
+			ApplicationIDs: []string{appID, upToDateID, failingID},
+			ExpectedResults: []*model.ApplicationTemplateUpgradeResult{
+				{ApplicationID: appID, Status: model.ApplicationTemplateUpgradeStatusUpgraded, Differences: differences},
+				{ApplicationID: upToDateID, Status: model.ApplicationTemplateUpgradeStatusUpToDate, Differences: []*model.ApplicationTemplateDriftDifference{}},
+				{ApplicationID: failingID, Status: model.ApplicationTemplateUpgradeStatusFailed, Differences: []*model.ApplicationTemplateDriftDifference{}, Error: str.Ptr(testErr.Error())},
+			},
+		},
+		{
+			Name: "Reports drifted applications on dry run",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TemplateDriftService {
+				svc := &automock.TemplateDriftService{}
+				svc.On("Upgrade", txtest.CtxWithDBMatcher(), appTemplateID, appID, true).Return(differences, nil).Once()
+				return svc
+			},
+			ApplicationIDs: []string{appID},
+			DryRun:         boolPtr(true),
+			ExpectedResults: []*model.ApplicationTemplateUpgradeResult{
+				{ApplicationID: appID, Status: model.ApplicationTemplateUpgradeStatusDrifted, Differences: differences},
+			},
+		},
+		{
+			Name:           "Reports failure when the transaction fails to commit",
+			TxFn:           txGen.ThatFailsOnCommit,
+			ApplicationIDs: []string{appID},
+			ServiceFn: func() *automock.TemplateDriftService {
+				svc := &automock.TemplateDriftService{}
+				svc.On("Upgrade", txtest.CtxWithDBMatcher(), appTemplateID, appID, false).Return(differences, nil).Once()
+				return svc
+			},
+			ExpectedResults: []*model.ApplicationTemplateUpgradeResult{
+				{ApplicationID: appID, Status: model.ApplicationTemplateUpgradeStatusFailed, Differences: []*model.ApplicationTemplateDriftDifference{}, Error: str.Ptr(testErr.Error())},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := &automock.Converter{}
+			conv.On("UpgradeResultsToGraphQL", testCase.ExpectedResults).Return(gqlResults, nil).Once()
+			resolver := templatedrift.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.UpgradeApplicationsFromTemplate(context.TODO(), appTemplateID, testCase.ApplicationIDs, testCase.DryRun)
+
+			// THEN
+			require.NoError(t, err)
+			assert.Equal(t, gqlResults, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
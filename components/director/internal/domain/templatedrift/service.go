@@ -0,0 +1,603 @@
+package templatedrift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+const (
+	nameField           = "name"
+	descriptionField    = "description"
+	providerNameField   = "providerName"
+	baseURLField        = "baseUrl"
+	labelsFieldPrefix   = "labels."
+	webhooksFieldPrefix = "webhooks."
+
+	labelsKey   = "labels"
+	webhooksKey = "webhooks"
+)
+
+// PlaceholderValuesRepository is responsible for the repo-layer placeholder values operations
+//
+//go:generate mockery --name=PlaceholderValuesRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type PlaceholderValuesRepository interface {
+	Upsert(ctx context.Context, in *model.ApplicationTemplatePlaceholderValues) error
+	GetByApplicationID(ctx context.Context, appID string) (*model.ApplicationTemplatePlaceholderValues, error)
+}
+
+// ApplicationService is responsible for the service-layer Application operations
+//
+//go:generate mockery --name=ApplicationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationService interface {
+	Get(ctx context.Context, id string) (*model.Application, error)
+	Update(ctx context.Context, id string, in model.ApplicationUpdateInput) error
+	Rename(ctx context.Context, id, name string) error
+	ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error)
+	SetLabel(ctx context.Context, label *model.LabelInput) error
+}
+
+// ApplicationTemplateService is responsible for the service-layer Application Template operations
+//
+//go:generate mockery --name=ApplicationTemplateService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationTemplateService interface {
+	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
+	PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, error)
+}
+
+// ApplicationConverter converts the rendered application input of an application template
+//
+//go:generate mockery --name=ApplicationConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationConverter interface {
+	CreateRegisterInputJSONToGQL(in string) (graphql.ApplicationRegisterInput, error)
+	CreateInputFromGraphQL(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error)
+}
+
+// WebhookService is responsible for the service-layer Webhook operations
+//
+//go:generate mockery --name=WebhookService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type WebhookService interface {
+	ListForApplication(ctx context.Context, applicationID string) ([]*model.Webhook, error)
+	Create(ctx context.Context, owningResourceID string, in model.WebhookInput, objectType model.WebhookReferenceObjectType) (string, error)
+	Update(ctx context.Context, id string, in model.WebhookInput, objectType model.WebhookReferenceObjectType) error
+}
+
+// webhookView is the part of a webhook which is compared with the application template.
+// Credentials are left out as they are not returned when the webhooks are listed.
+type webhookView struct {
+	URL            *string            `json:"url,omitempty"`
+	Mode           *model.WebhookMode `json:"mode,omitempty"`
+	RetryInterval  *int               `json:"retryInterval,omitempty"`
+	Timeout        *int               `json:"timeout,omitempty"`
+	URLTemplate    *string            `json:"urlTemplate,omitempty"`
+	InputTemplate  *string            `json:"inputTemplate,omitempty"`
+	HeaderTemplate *string            `json:"headerTemplate,omitempty"`
+	OutputTemplate *string            `json:"outputTemplate,omitempty"`
+	StatusTemplate *string            `json:"statusTemplate,omitempty"`
+}
+
+type service struct {
+	repo           PlaceholderValuesRepository
+	appSvc         ApplicationService
+	appTemplateSvc ApplicationTemplateService
+	appConverter   ApplicationConverter
+	webhookSvc     WebhookService
+}
+
+// NewService returns a new template drift service
+func NewService(repo PlaceholderValuesRepository, appSvc ApplicationService, appTemplateSvc ApplicationTemplateService, appConverter ApplicationConverter, webhookSvc WebhookService) *service {
+	return &service{
+		repo:           repo,
+		appSvc:         appSvc,
+		appTemplateSvc: appTemplateSvc,
+		appConverter:   appConverter,
+		webhookSvc:     webhookSvc,
+	}
+}
+
+// RecordPlaceholderValues stores the placeholder values the application was registered with from the application template.
+// Values of sensitive placeholders are not stored.
+func (s *service) RecordPlaceholderValues(ctx context.Context, appID string, appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) error {
+	if err := s.repo.Upsert(ctx, &model.ApplicationTemplatePlaceholderValues{
+		ApplicationID:         appID,
+		ApplicationTemplateID: appTemplate.ID,
+		Values:                withoutSensitiveValues(appTemplate, values),
+	}); err != nil {
+		return errors.Wrapf(err, "while storing the placeholder values of application with ID %s", appID)
+	}
+
+	return nil
+}
+
+// GetDrift compares the application with a rendering of the current version of the application template it was registered from.
+// It returns nil if no placeholder values were recorded for the application.
+func (s *service) GetDrift(ctx context.Context, appID string) (*model.ApplicationTemplateDrift, error) {
+	app, err := s.appSvc.Get(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := s.getPlaceholderValues(ctx, app)
+	if err != nil || values == nil {
+		return nil, err
+	}
+
+	appTemplate, err := s.appTemplateSvc.Get(ctx, values.ApplicationTemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting application template with ID %s", values.ApplicationTemplateID)
+	}
+
+	differences, _, err := s.computeDifferences(ctx, app, appTemplate, values.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ApplicationTemplateDrift{
+		ApplicationTemplateID: appTemplate.ID,
+		Differences:           differences,
+	}, nil
+}
+
+// Upgrade applies the current version of the application template to the application and returns the differences it has found.
+// If dryRun is true, the differences are only computed.
+func (s *service) Upgrade(ctx context.Context, appTemplateID, appID string, dryRun bool) ([]*model.ApplicationTemplateDriftDifference, error) {
+	app, err := s.appSvc.Get(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := s.getPlaceholderValues(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+	if values == nil || values.ApplicationTemplateID != appTemplateID {
+		return nil, apperrors.NewInvalidOperationError(fmt.Sprintf("application with ID %s was not registered from application template with ID %s", appID, appTemplateID))
+	}
+
+	appTemplate, err := s.appTemplateSvc.Get(ctx, appTemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting application template with ID %s", appTemplateID)
+	}
+
+	differences, expected, err := s.computeDifferences(ctx, app, appTemplate, values.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun || len(differences) == 0 {
+		return differences, nil
+	}
+
+	log.C(ctx).Infof("Upgrading application with ID %s to the current version of application template with ID %s", appID, appTemplateID)
+	if err := s.apply(ctx, app, expected); err != nil {
+		return nil, errors.Wrapf(err, "while upgrading application with ID %s", appID)
+	}
+
+	return differences, nil
+}
+
+func (s *service) getPlaceholderValues(ctx context.Context, app *model.Application) (*model.ApplicationTemplatePlaceholderValues, error) {
+	if app.ApplicationTemplateID == nil {
+		return nil, nil
+	}
+
+	values, err := s.repo.GetByApplicationID(ctx, app.ID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "while getting the placeholder values of application with ID %s", app.ID)
+	}
+
+	return values, nil
+}
+
+// expectedState is the state of an application as rendered from its application template
+type expectedState struct {
+	input    model.ApplicationRegisterInput
+	labels   map[string]interface{}
+	webhooks map[model.WebhookType]*webhookChange
+}
+
+type webhookChange struct {
+	existing *model.Webhook
+	input    *model.WebhookInput
+}
+
+// computeDifferences renders the application template with the recorded placeholder values and compares the result with the application.
+// Fields rendered from sensitive placeholders are neither compared nor upgraded as the values of these placeholders are not recorded.
+func (s *service) computeDifferences(ctx context.Context, app *model.Application, appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) ([]*model.ApplicationTemplateDriftDifference, *expectedState, error) {
+	comparableTemplate, err := withoutSensitiveFields(appTemplate, app.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rendered, err := s.render(ctx, comparableTemplate, withoutSensitiveValues(appTemplate, values))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expected := &expectedState{
+		input:    *rendered,
+		labels:   make(map[string]interface{}),
+		webhooks: make(map[model.WebhookType]*webhookChange),
+	}
+
+	differences := make([]*model.ApplicationTemplateDriftDifference, 0)
+	addIfDifferent := func(field string, current, wanted *string) {
+		if !isDifferent(current, wanted) {
+			return
+		}
+
+		difference := &model.ApplicationTemplateDriftDifference{Field: field, Expected: *wanted}
+		if current != nil {
+			difference.Current = *current
+		}
+		differences = append(differences, difference)
+	}
+
+	addIfDifferent(nameField, &app.Name, &rendered.Name)
+	addIfDifferent(descriptionField, app.Description, rendered.Description)
+	addIfDifferent(providerNameField, app.ProviderName, rendered.ProviderName)
+	addIfDifferent(baseURLField, app.BaseURL, rendered.BaseURL)
+
+	labelDifferences, err := s.compareLabels(ctx, app.ID, rendered.Labels, expected)
+	if err != nil {
+		return nil, nil, err
+	}
+	differences = append(differences, labelDifferences...)
+
+	webhookDifferences, err := s.compareWebhooks(ctx, app.ID, rendered.Webhooks, expected)
+	if err != nil {
+		return nil, nil, err
+	}
+	differences = append(differences, webhookDifferences...)
+
+	return differences, expected, nil
+}
+
+func (s *service) render(ctx context.Context, appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (*model.ApplicationRegisterInput, error) {
+	appCreateInputJSON, err := s.appTemplateSvc.PrepareApplicationCreateInputJSON(appTemplate, values)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while preparing ApplicationCreateInput JSON from Application Template with name %s", appTemplate.Name)
+	}
+
+	appCreateInputGQL, err := s.appConverter.CreateRegisterInputJSONToGQL(appCreateInputJSON)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting ApplicationCreateInput JSON to GraphQL ApplicationRegistrationInput from Application Template with name %s", appTemplate.Name)
+	}
+
+	if err := inputvalidation.Validate(appCreateInputGQL); err != nil {
+		return nil, errors.Wrapf(err, "while validating application input from Application Template with name %s", appTemplate.Name)
+	}
+
+	appCreateInputModel, err := s.appConverter.CreateInputFromGraphQL(ctx, appCreateInputGQL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting application input from Application Template with name %s", appTemplate.Name)
+	}
+
+	return &appCreateInputModel, nil
+}
+
+// compareLabels reports the labels rendered by the template which are missing or have a different value.
+// Labels which are not part of the template are not reported as they may have been set on the application afterwards.
+func (s *service) compareLabels(ctx context.Context, appID string, rendered map[string]interface{}, expected *expectedState) ([]*model.ApplicationTemplateDriftDifference, error) {
+	if len(rendered) == 0 {
+		return nil, nil
+	}
+
+	labels, err := s.appSvc.ListLabels(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing labels of application with ID %s", appID)
+	}
+
+	keys := make([]string, 0, len(rendered))
+	for key := range rendered {
+		if key == model.ScenariosKey {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	differences := make([]*model.ApplicationTemplateDriftDifference, 0)
+	for _, key := range keys {
+		var current interface{}
+		if lbl, ok := labels[key]; ok && lbl != nil {
+			current = lbl.Value
+		}
+
+		equal, err := jsonEqual(current, rendered[key])
+		if err != nil {
+			return nil, errors.Wrapf(err, "while comparing label %q", key)
+		}
+		if equal {
+			continue
+		}
+
+		expected.labels[key] = rendered[key]
+		differences = append(differences, &model.ApplicationTemplateDriftDifference{Field: labelsFieldPrefix + key, Current: current, Expected: rendered[key]})
+	}
+
+	return differences, nil
+}
+
+// compareWebhooks reports the webhooks rendered by the template which are missing or differ from the webhook of the same type.
+// Webhooks of types which are not part of the template are not reported.
+func (s *service) compareWebhooks(ctx context.Context, appID string, rendered []*model.WebhookInput, expected *expectedState) ([]*model.ApplicationTemplateDriftDifference, error) {
+	if len(rendered) == 0 {
+		return nil, nil
+	}
+
+	webhooks, err := s.webhookSvc.ListForApplication(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing webhooks of application with ID %s", appID)
+	}
+
+	existingByType := make(map[model.WebhookType]*model.Webhook, len(webhooks))
+	for _, wh := range webhooks {
+		if wh != nil {
+			existingByType[wh.Type] = wh
+		}
+	}
+
+	differences := make([]*model.ApplicationTemplateDriftDifference, 0)
+	for _, in := range rendered {
+		if in == nil {
+			continue
+		}
+
+		wanted := webhookInputView(in)
+		existing, ok := existingByType[in.Type]
+		var current interface{}
+		if ok {
+			current = webhookModelView(existing)
+			equal, err := jsonEqual(current, wanted)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while comparing webhook of type %s", in.Type)
+			}
+			if equal {
+				continue
+			}
+		}
+
+		expected.webhooks[in.Type] = &webhookChange{existing: existing, input: in}
+		differences = append(differences, &model.ApplicationTemplateDriftDifference{Field: webhooksFieldPrefix + string(in.Type), Current: current, Expected: wanted})
+	}
+
+	return differences, nil
+}
+
+func (s *service) apply(ctx context.Context, app *model.Application, expected *expectedState) error {
+	if expected.input.Name != app.Name {
+		if err := s.appSvc.Rename(ctx, app.ID, expected.input.Name); err != nil {
+			return err
+		}
+	}
+
+	if isDifferent(app.Description, expected.input.Description) || isDifferent(app.ProviderName, expected.input.ProviderName) || isDifferent(app.BaseURL, expected.input.BaseURL) {
+		updateInput := model.ApplicationUpdateInput{
+			Description:  expected.input.Description,
+			ProviderName: expected.input.ProviderName,
+			BaseURL:      expected.input.BaseURL,
+		}
+		if app.Status != nil {
+			updateInput.StatusCondition = &app.Status.Condition
+		}
+
+		if err := s.appSvc.Update(ctx, app.ID, updateInput); err != nil {
+			return err
+		}
+	}
+
+	keys := make([]string, 0, len(expected.labels))
+	for key := range expected.labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := s.appSvc.SetLabel(ctx, &model.LabelInput{
+			Key:        key,
+			Value:      expected.labels[key],
+			ObjectID:   app.ID,
+			ObjectType: model.ApplicationLabelableObject,
+		}); err != nil {
+			return errors.Wrapf(err, "while setting label %q", key)
+		}
+	}
+
+	for webhookType, change := range expected.webhooks {
+		if change.existing == nil {
+			if _, err := s.webhookSvc.Create(ctx, app.ID, *change.input, model.ApplicationWebhookReference); err != nil {
+				return errors.Wrapf(err, "while creating webhook of type %s", webhookType)
+			}
+			continue
+		}
+
+		if err := s.webhookSvc.Update(ctx, change.existing.ID, *change.input, model.ApplicationWebhookReference); err != nil {
+			return errors.Wrapf(err, "while updating webhook of type %s", webhookType)
+		}
+	}
+
+	return nil
+}
+
+// withoutSensitiveValues drops the values of the sensitive placeholders of the application template
+func withoutSensitiveValues(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) model.ApplicationFromTemplateInputValues {
+	sensitive := sensitivePlaceholderNames(appTemplate)
+	if len(sensitive) == 0 {
+		return values
+	}
+
+	filtered := make(model.ApplicationFromTemplateInputValues, 0, len(values))
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		if _, ok := sensitive[value.Placeholder]; ok {
+			continue
+		}
+		filtered = append(filtered, value)
+	}
+
+	return filtered
+}
+
+// withoutSensitiveFields returns a copy of the application template without the sensitive placeholders and
+// without the application input fields, labels and webhooks which are rendered from them.
+// The name of the application is kept as it is required, so it is not reported as a difference.
+func withoutSensitiveFields(appTemplate *model.ApplicationTemplate, appName string) (*model.ApplicationTemplate, error) {
+	sensitive := sensitivePlaceholderNames(appTemplate)
+	if len(sensitive) == 0 {
+		return appTemplate, nil
+	}
+
+	var input map[string]interface{}
+	if err := json.Unmarshal([]byte(appTemplate.ApplicationInputJSON), &input); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshalling the application input of application template with ID %s", appTemplate.ID)
+	}
+
+	for key, value := range input {
+		switch {
+		case key == labelsKey:
+			if labels, ok := value.(map[string]interface{}); ok {
+				for labelKey, labelValue := range labels {
+					if usesAny(labelValue, sensitive) {
+						delete(labels, labelKey)
+					}
+				}
+				continue
+			}
+		case key == webhooksKey:
+			if webhooks, ok := value.([]interface{}); ok {
+				kept := make([]interface{}, 0, len(webhooks))
+				for _, wh := range webhooks {
+					if !usesAny(wh, sensitive) {
+						kept = append(kept, wh)
+					}
+				}
+				input[key] = kept
+				continue
+			}
+		}
+
+		if !usesAny(value, sensitive) {
+			continue
+		}
+		if key == nameField {
+			input[key] = appName
+			continue
+		}
+		delete(input, key)
+	}
+
+	marshalledInput, err := json.Marshal(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while marshalling the application input of application template with ID %s", appTemplate.ID)
+	}
+
+	placeholders := make([]model.ApplicationTemplatePlaceholder, 0, len(appTemplate.Placeholders))
+	for _, placeholder := range appTemplate.Placeholders {
+		if !placeholder.IsSensitive() {
+			placeholders = append(placeholders, placeholder)
+		}
+	}
+
+	comparable := *appTemplate
+	comparable.ApplicationInputJSON = string(marshalledInput)
+	comparable.Placeholders = placeholders
+	return &comparable, nil
+}
+
+func sensitivePlaceholderNames(appTemplate *model.ApplicationTemplate) map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, placeholder := range appTemplate.Placeholders {
+		if placeholder.IsSensitive() {
+			names[placeholder.Name] = struct{}{}
+		}
+	}
+	return names
+}
+
+// usesAny reports whether the JSON value references any of the placeholders
+func usesAny(value interface{}, placeholders map[string]struct{}) bool {
+	marshalled, err := json.Marshal(value)
+	if err != nil {
+		return true
+	}
+
+	for name := range placeholders {
+		if strings.Contains(string(marshalled), fmt.Sprintf("{{%s}}", name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isDifferent(current, wanted *string) bool {
+	return wanted != nil && str.PtrStrToStr(current) != *wanted
+}
+
+func webhookInputView(in *model.WebhookInput) *webhookView {
+	return &webhookView{
+		URL:            in.URL,
+		Mode:           in.Mode,
+		RetryInterval:  in.RetryInterval,
+		Timeout:        in.Timeout,
+		URLTemplate:    in.URLTemplate,
+		InputTemplate:  in.InputTemplate,
+		HeaderTemplate: in.HeaderTemplate,
+		OutputTemplate: in.OutputTemplate,
+		StatusTemplate: in.StatusTemplate,
+	}
+}
+
+func webhookModelView(in *model.Webhook) *webhookView {
+	return &webhookView{
+		URL:            in.URL,
+		Mode:           in.Mode,
+		RetryInterval:  in.RetryInterval,
+		Timeout:        in.Timeout,
+		URLTemplate:    in.URLTemplate,
+		InputTemplate:  in.InputTemplate,
+		HeaderTemplate: in.HeaderTemplate,
+		OutputTemplate: in.OutputTemplate,
+		StatusTemplate: in.StatusTemplate,
+	}
+}
+
+// jsonEqual compares the values by their JSON representation, so that label values read from the database
+// and label values rendered from the template are equal regardless of their Go types
+func jsonEqual(a, b interface{}) (bool, error) {
+	marshalledA, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+
+	marshalledB, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+
+	var normalizedA, normalizedB interface{}
+	if err := json.Unmarshal(marshalledA, &normalizedA); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(marshalledB, &normalizedB); err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(normalizedA, normalizedB), nil
+}
@@ -0,0 +1,383 @@
+package templatedrift_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/templatedrift"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/templatedrift/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceMocks struct {
+	repo           *automock.PlaceholderValuesRepository
+	appSvc         *automock.ApplicationService
+	appTemplateSvc *automock.ApplicationTemplateService
+	appConv        *automock.ApplicationConverter
+	webhookSvc     *automock.WebhookService
+}
+
+func newServiceMocks() *serviceMocks {
+	return &serviceMocks{
+		repo:           &automock.PlaceholderValuesRepository{},
+		appSvc:         &automock.ApplicationService{},
+		appTemplateSvc: &automock.ApplicationTemplateService{},
+		appConv:        &automock.ApplicationConverter{},
+		webhookSvc:     &automock.WebhookService{},
+	}
+}
+
+func (m *serviceMocks) service() templatedrift.TemplateDriftService {
+	return templatedrift.NewService(m.repo, m.appSvc, m.appTemplateSvc, m.appConv, m.webhookSvc)
+}
+
+func (m *serviceMocks) assertExpectations(t *testing.T) {
+	mock.AssertExpectationsForObjects(t, m.repo, m.appSvc, m.appTemplateSvc, m.appConv, m.webhookSvc)
+}
+
+// expectRendering sets up the mocks which render the application template and read the current state of the application
+func (m *serviceMocks) expectRendering(ctx context.Context, rendered model.ApplicationRegisterInput, labels map[string]*model.Label, webhooks []*model.Webhook) {
+	m.repo.On("GetByApplicationID", ctx, appID).Return(fixPlaceholderValuesModel(), nil).Once()
+	m.appTemplateSvc.On("Get", ctx, appTemplateID).Return(fixApplicationTemplate(), nil).Once()
+	m.appTemplateSvc.On("PrepareApplicationCreateInputJSON", fixApplicationTemplate(), fixPlaceholderValues()).Return(appInputJSON, nil).Once()
+	m.appConv.On("CreateRegisterInputJSONToGQL", appInputJSON).Return(fixGQLRenderedInput(), nil).Once()
+	m.appConv.On("CreateInputFromGraphQL", ctx, fixGQLRenderedInput()).Return(rendered, nil).Once()
+	if len(rendered.Labels) > 0 {
+		m.appSvc.On("ListLabels", ctx, appID).Return(labels, nil).Once()
+	}
+	if len(rendered.Webhooks) > 0 {
+		m.webhookSvc.On("ListForApplication", ctx, appID).Return(webhooks, nil).Once()
+	}
+}
+
+func TestService_RecordPlaceholderValues(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.repo.On("Upsert", ctx, fixPlaceholderValuesModel()).Return(nil).Once()
+
+		// WHEN
+		err := templatedrift.NewService(mocks.repo, nil, nil, nil, nil).RecordPlaceholderValues(ctx, appID, fixApplicationTemplate(), fixPlaceholderValues())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Does not store the values of sensitive placeholders", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.repo.On("Upsert", ctx, fixPlaceholderValuesModel()).Return(nil).Once()
+
+		// WHEN
+		err := templatedrift.NewService(mocks.repo, nil, nil, nil, nil).RecordPlaceholderValues(ctx, appID, fixApplicationTemplateWithSensitivePlaceholder(), fixPlaceholderValuesWithSensitiveValue())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when storing fails", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.repo.On("Upsert", ctx, fixPlaceholderValuesModel()).Return(testErr).Once()
+
+		// WHEN
+		err := templatedrift.NewService(mocks.repo, nil, nil, nil, nil).RecordPlaceholderValues(ctx, appID, fixApplicationTemplate(), fixPlaceholderValues())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestService_GetDrift(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication(appName), nil).Once()
+		mocks.expectRendering(ctx, fixRenderedInput(), fixLabels(), fixWebhooks())
+
+		// WHEN
+		drift, err := mocks.service().GetDrift(ctx, appID)
+
+		// THEN
+		require.NoError(t, err)
+		require.NotNil(t, drift)
+		assert.Equal(t, appTemplateID, drift.ApplicationTemplateID)
+		assert.False(t, drift.InSync())
+		assert.Equal(t, []string{
+			`description: "old description" -> "new description"`,
+			`labels.region: "eu20" -> "eu10"`,
+			`webhooks.CONFIGURATION_CHANGED: {"url":"https://old.example.com/webhook"} -> {"url":"https://new.example.com/webhook"}`,
+		}, describe(t, drift.Differences))
+	})
+
+	t.Run("Reports missing labels and webhooks", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication("old-name"), nil).Once()
+		rendered := fixRenderedInput()
+		rendered.Description = nil
+		mocks.expectRendering(ctx, rendered, map[string]*model.Label{"managed": {Key: "managed", Value: "false"}}, nil)
+
+		// WHEN
+		drift, err := mocks.service().GetDrift(ctx, appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			`name: "old-name" -> "my-app"`,
+			`labels.region: null -> "eu10"`,
+			`webhooks.CONFIGURATION_CHANGED: null -> {"url":"https://new.example.com/webhook"}`,
+			`webhooks.OPEN_RESOURCE_DISCOVERY: null -> {"url":"https://old.example.com/ord"}`,
+		}, describe(t, drift.Differences))
+	})
+
+	t.Run("Does not compare the fields rendered from sensitive placeholders", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		storedValues := fixPlaceholderValuesModel()
+		storedValues.Values = fixPlaceholderValuesWithSensitiveValue()
+		comparableTemplate := fixApplicationTemplateWithSensitivePlaceholder()
+		comparableTemplate.ApplicationInputJSON = `{"labels":{"region":"eu10"},"name":"{{name}}","webhooks":[]}`
+		comparableTemplate.Placeholders = comparableTemplate.Placeholders[:1]
+		rendered := model.ApplicationRegisterInput{Name: appName, Labels: map[string]interface{}{"region": "eu10"}}
+
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication(appName), nil).Once()
+		mocks.repo.On("GetByApplicationID", ctx, appID).Return(storedValues, nil).Once()
+		mocks.appTemplateSvc.On("Get", ctx, appTemplateID).Return(fixApplicationTemplateWithSensitivePlaceholder(), nil).Once()
+		mocks.appTemplateSvc.On("PrepareApplicationCreateInputJSON", comparableTemplate, fixPlaceholderValues()).Return(appInputJSON, nil).Once()
+		mocks.appConv.On("CreateRegisterInputJSONToGQL", appInputJSON).Return(fixGQLRenderedInput(), nil).Once()
+		mocks.appConv.On("CreateInputFromGraphQL", ctx, fixGQLRenderedInput()).Return(rendered, nil).Once()
+		mocks.appSvc.On("ListLabels", ctx, appID).Return(fixLabels(), nil).Once()
+
+		// WHEN
+		drift, err := mocks.service().GetDrift(ctx, appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []string{`labels.region: "eu20" -> "eu10"`}, describe(t, drift.Differences))
+	})
+
+	t.Run("Returns nil when the application is not registered from a template", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		app := fixApplication(appName)
+		app.ApplicationTemplateID = nil
+		mocks.appSvc.On("Get", ctx, appID).Return(app, nil).Once()
+
+		// WHEN
+		drift, err := mocks.service().GetDrift(ctx, appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, drift)
+	})
+
+	t.Run("Returns nil when no placeholder values are recorded", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication(appName), nil).Once()
+		mocks.repo.On("GetByApplicationID", ctx, appID).Return(nil, apperrors.NewNotFoundError(resource.ApplicationTemplatePlaceholderValues, appID)).Once()
+
+		// WHEN
+		drift, err := mocks.service().GetDrift(ctx, appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, drift)
+	})
+
+	t.Run("Error when getting the placeholder values fails", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication(appName), nil).Once()
+		mocks.repo.On("GetByApplicationID", ctx, appID).Return(nil, testErr).Once()
+
+		// WHEN
+		_, err := mocks.service().GetDrift(ctx, appID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+
+	t.Run("Error when rendering the template fails", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication(appName), nil).Once()
+		mocks.repo.On("GetByApplicationID", ctx, appID).Return(fixPlaceholderValuesModel(), nil).Once()
+		mocks.appTemplateSvc.On("Get", ctx, appTemplateID).Return(fixApplicationTemplate(), nil).Once()
+		mocks.appTemplateSvc.On("PrepareApplicationCreateInputJSON", fixApplicationTemplate(), fixPlaceholderValues()).Return("", testErr).Once()
+
+		// WHEN
+		_, err := mocks.service().GetDrift(ctx, appID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestService_Upgrade(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("Dry run only computes the differences", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication(appName), nil).Once()
+		mocks.expectRendering(ctx, fixRenderedInput(), fixLabels(), fixWebhooks())
+
+		// WHEN
+		differences, err := mocks.service().Upgrade(ctx, appTemplateID, appID, true)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Len(t, differences, 3)
+	})
+
+	t.Run("Applies the differences", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		rendered := fixRenderedInput()
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication(appName), nil).Once()
+		mocks.expectRendering(ctx, rendered, fixLabels(), fixWebhooks())
+		condition := model.ApplicationStatusConditionConnected
+		mocks.appSvc.On("Update", ctx, appID, model.ApplicationUpdateInput{
+			Description:     str.Ptr("new description"),
+			BaseURL:         str.Ptr("https://old.example.com"),
+			StatusCondition: &condition,
+		}).Return(nil).Once()
+		mocks.appSvc.On("SetLabel", ctx, &model.LabelInput{Key: "region", Value: "eu10", ObjectID: appID, ObjectType: model.ApplicationLabelableObject}).Return(nil).Once()
+		mocks.webhookSvc.On("Update", ctx, webhookID, *rendered.Webhooks[0], model.ApplicationWebhookReference).Return(nil).Once()
+
+		// WHEN
+		differences, err := mocks.service().Upgrade(ctx, appTemplateID, appID, false)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Len(t, differences, 3)
+	})
+
+	t.Run("Renames the application and creates the missing webhooks", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		rendered := fixRenderedInput()
+		rendered.Description = nil
+		rendered.Labels = nil
+		app := fixApplication("old-name")
+		mocks.appSvc.On("Get", ctx, appID).Return(app, nil).Once()
+		mocks.expectRendering(ctx, rendered, nil, nil)
+		mocks.appSvc.On("Rename", ctx, appID, appName).Return(nil).Once()
+		mocks.webhookSvc.On("Create", ctx, appID, *rendered.Webhooks[0], model.ApplicationWebhookReference).Return("id-1", nil).Once()
+		mocks.webhookSvc.On("Create", ctx, appID, *rendered.Webhooks[1], model.ApplicationWebhookReference).Return("id-2", nil).Once()
+
+		// WHEN
+		differences, err := mocks.service().Upgrade(ctx, appTemplateID, appID, false)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Len(t, differences, 3)
+	})
+
+	t.Run("Does nothing when the application is up to date", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		rendered := fixRenderedInput()
+		rendered.Description = str.Ptr("old description")
+		rendered.Webhooks = rendered.Webhooks[1:]
+		labels := fixLabels()
+		labels["region"].Value = "eu10"
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication(appName), nil).Once()
+		mocks.expectRendering(ctx, rendered, labels, fixWebhooks())
+
+		// WHEN
+		differences, err := mocks.service().Upgrade(ctx, appTemplateID, appID, false)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Empty(t, differences)
+	})
+
+	t.Run("Error when the application is registered from another template", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication(appName), nil).Once()
+		mocks.repo.On("GetByApplicationID", ctx, appID).Return(fixPlaceholderValuesModel(), nil).Once()
+
+		// WHEN
+		_, err := mocks.service().Upgrade(ctx, "another-template", appID, false)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "was not registered from application template with ID another-template")
+	})
+
+	t.Run("Error when updating the application fails", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.appSvc.On("Get", ctx, appID).Return(fixApplication(appName), nil).Once()
+		mocks.expectRendering(ctx, fixRenderedInput(), fixLabels(), fixWebhooks())
+		mocks.appSvc.On("Update", ctx, appID, mock.Anything).Return(testErr).Once()
+
+		// WHEN
+		_, err := mocks.service().Upgrade(ctx, appTemplateID, appID, false)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+
+	t.Run("Error when getting the application fails", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+		mocks.appSvc.On("Get", ctx, appID).Return(nil, testErr).Once()
+
+		// WHEN
+		_, err := mocks.service().Upgrade(ctx, appTemplateID, appID, false)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+// describe renders the differences as "field: current -> expected" with JSON values
+func describe(t *testing.T, differences []*model.ApplicationTemplateDriftDifference) []string {
+	result := make([]string, 0, len(differences))
+	for _, d := range differences {
+		current, err := json.Marshal(d.Current)
+		require.NoError(t, err)
+		expected, err := json.Marshal(d.Expected)
+		require.NoError(t, err)
+		result = append(result, d.Field+": "+string(current)+" -> "+string(expected))
+	}
+	return result
+}
@@ -0,0 +1,95 @@
+package tenant
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/cronjob"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// AccessExpiryConfig configures the job which revokes the tenant accesses with expired grants
+type AccessExpiryConfig struct {
+	// Enabled switches the tenant access expiry job on
+	Enabled bool `envconfig:"default=false,APP_TENANT_ACCESS_EXPIRY_ENABLED"`
+	// JobInterval is how often the expired tenant access grants are revoked
+	JobInterval time.Duration `envconfig:"default=5m,APP_TENANT_ACCESS_EXPIRY_JOB_INTERVAL"`
+}
+
+// ExpiredAccessRevoker revokes the tenant accesses whose grants have expired
+//
+//go:generate mockery --name=ExpiredAccessRevoker --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ExpiredAccessRevoker interface {
+	ListExpiredTenantAccesses(ctx context.Context) ([]*model.TenantAccess, error)
+	RevokeTenantAccess(ctx context.Context, tenantAccess *model.TenantAccess) error
+}
+
+// StartAccessExpiryJob starts the job which revokes the tenant accesses with expired grants and blocks.
+// Only the leader instance executes the job.
+func StartAccessExpiryJob(ctx context.Context, cfg AccessExpiryConfig, electionCfg cronjob.ElectionConfig, transact persistence.Transactioner, revoker ExpiredAccessRevoker) error {
+	expiryJob := cronjob.CronJob{
+		Name: "RevokeExpiredTenantAccesses",
+		Fn: func(jobCtx context.Context) {
+			revoked, err := RevokeExpiredTenantAccesses(jobCtx, transact, revoker)
+			if err != nil {
+				log.C(jobCtx).WithError(err).Errorf("Failed to revoke expired tenant accesses")
+				return
+			}
+			log.C(jobCtx).Infof("Revoked %d expired tenant accesses", revoked)
+		},
+		SchedulePeriod: cfg.JobInterval,
+	}
+	return cronjob.RunCronJob(ctx, electionCfg, expiryJob)
+}
+
+// RevokeExpiredTenantAccesses revokes each of the tenant accesses with expired grants in a separate transaction and returns the number of revoked accesses.
+// The accesses which fail to be revoked are skipped, and they are retried on the next run.
+func RevokeExpiredTenantAccesses(ctx context.Context, transact persistence.Transactioner, revoker ExpiredAccessRevoker) (int, error) {
+	expiredTenantAccesses, err := listExpired(ctx, transact, revoker)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, tenantAccess := range expiredTenantAccesses {
+		log.C(ctx).Infof("Revoking expired access of tenant %q to resource with ID %q of type %q", tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
+		if err := revoke(ctx, transact, revoker, tenantAccess); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to revoke expired access of tenant %q to resource with ID %q of type %q", tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
+			continue
+		}
+		revoked++
+	}
+
+	return revoked, nil
+}
+
+func listExpired(ctx context.Context, transact persistence.Transactioner, revoker ExpiredAccessRevoker) ([]*model.TenantAccess, error) {
+	tx, err := transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer transact.RollbackUnlessCommitted(ctx, tx)
+
+	expiredTenantAccesses, err := revoker.ListExpiredTenantAccesses(persistence.SaveToContext(ctx, tx))
+	if err != nil {
+		return nil, err
+	}
+
+	return expiredTenantAccesses, tx.Commit()
+}
+
+func revoke(ctx context.Context, transact persistence.Transactioner, revoker ExpiredAccessRevoker, tenantAccess *model.TenantAccess) error {
+	tx, err := transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer transact.RollbackUnlessCommitted(ctx, tx)
+
+	if err := revoker.RevokeTenantAccess(persistence.SaveToContext(ctx, tx), tenantAccess); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
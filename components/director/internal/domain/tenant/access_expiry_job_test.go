@@ -0,0 +1,130 @@
+package tenant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeExpiredTenantAccesses(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	secondTenantAccessModel := &model.TenantAccess{
+		ExternalTenantID: testExternal,
+		InternalTenantID: testInternal,
+		ResourceType:     resource.Runtime,
+		ResourceID:       testID2,
+		Owner:            true,
+		Source:           testInternal,
+	}
+
+	testCases := []struct {
+		Name             string
+		TxFn             func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RevokerFn        func() *automock.ExpiredAccessRevoker
+		ExpectedCount    int
+		ExpectedErrorMsg string
+	}{
+		{
+			Name: "Success",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			RevokerFn: func() *automock.ExpiredAccessRevoker {
+				revoker := &automock.ExpiredAccessRevoker{}
+				revoker.On("ListExpiredTenantAccesses", txtest.CtxWithDBMatcher()).Return([]*model.TenantAccess{tenantAccessModel, secondTenantAccessModel}, nil).Once()
+				revoker.On("RevokeTenantAccess", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(nil).Once()
+				revoker.On("RevokeTenantAccess", txtest.CtxWithDBMatcher(), secondTenantAccessModel).Return(nil).Once()
+				return revoker
+			},
+			ExpectedCount: 2,
+		},
+		{
+			Name: "Success when there are no expired grants",
+			TxFn: txGen.ThatSucceeds,
+			RevokerFn: func() *automock.ExpiredAccessRevoker {
+				revoker := &automock.ExpiredAccessRevoker{}
+				revoker.On("ListExpiredTenantAccesses", txtest.CtxWithDBMatcher()).Return([]*model.TenantAccess{}, nil).Once()
+				return revoker
+			},
+		},
+		{
+			Name: "Skips the accesses which fail to be revoked",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				persistTx := &persistenceautomock.PersistenceTx{}
+				persistTx.On("Commit").Return(nil).Twice()
+
+				transact := &persistenceautomock.Transactioner{}
+				transact.On("Begin").Return(persistTx, nil).Times(3)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(3)
+
+				return persistTx, transact
+			},
+			RevokerFn: func() *automock.ExpiredAccessRevoker {
+				revoker := &automock.ExpiredAccessRevoker{}
+				revoker.On("ListExpiredTenantAccesses", txtest.CtxWithDBMatcher()).Return([]*model.TenantAccess{tenantAccessModel, secondTenantAccessModel}, nil).Once()
+				revoker.On("RevokeTenantAccess", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(testError).Once()
+				revoker.On("RevokeTenantAccess", txtest.CtxWithDBMatcher(), secondTenantAccessModel).Return(nil).Once()
+				return revoker
+			},
+			ExpectedCount: 1,
+		},
+		{
+			Name: "Does not count the accesses whose revocation fails on commit",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenFailsOnCommit(1)
+			},
+			RevokerFn: func() *automock.ExpiredAccessRevoker {
+				revoker := &automock.ExpiredAccessRevoker{}
+				revoker.On("ListExpiredTenantAccesses", txtest.CtxWithDBMatcher()).Return([]*model.TenantAccess{tenantAccessModel}, nil).Once()
+				revoker.On("RevokeTenantAccess", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(nil).Once()
+				return revoker
+			},
+		},
+		{
+			Name: "Error when listing expired grants",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			RevokerFn: func() *automock.ExpiredAccessRevoker {
+				revoker := &automock.ExpiredAccessRevoker{}
+				revoker.On("ListExpiredTenantAccesses", txtest.CtxWithDBMatcher()).Return(nil, testError).Once()
+				return revoker
+			},
+			ExpectedErrorMsg: testError.Error(),
+		},
+		{
+			Name:             "Error when beginning transaction",
+			TxFn:             txGen.ThatFailsOnBegin,
+			RevokerFn:        func() *automock.ExpiredAccessRevoker { return &automock.ExpiredAccessRevoker{} },
+			ExpectedErrorMsg: testError.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx, transact := testCase.TxFn()
+			revoker := testCase.RevokerFn()
+
+			// WHEN
+			count, err := tenant.RevokeExpiredTenantAccesses(context.TODO(), transact, revoker)
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.ExpectedCount, count)
+
+			mock.AssertExpectationsForObjects(t, persistTx, transact, revoker)
+		})
+	}
+}
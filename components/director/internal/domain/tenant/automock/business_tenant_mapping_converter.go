@@ -5,12 +5,10 @@ package automock
 import (
 	context "context"
 
-	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
-	mock "github.com/stretchr/testify/mock"
-
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
 	repo "github.com/kyma-incubator/compass/components/director/internal/repo"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // BusinessTenantMappingConverter is an autogenerated mock type for the BusinessTenantMappingConverter type
@@ -19,21 +17,21 @@ type BusinessTenantMappingConverter struct {
 }
 
 // InputFromGraphQL provides a mock function with given fields: ctx, tnt, externalTenantToType, retrieveTenantTypeFn
-func (_m *BusinessTenantMappingConverter) InputFromGraphQL(ctx context.Context, tnt graphql.BusinessTenantMappingInput, externalTenantToType map[string]string, retrieveTenantTypeFn func(context.Context, string) (string, error)) (model.BusinessTenantMappingInput, error) {
+func (_m *BusinessTenantMappingConverter) InputFromGraphQL(ctx context.Context, tnt graphql.BusinessTenantMappingInput, externalTenantToType map[string]string, retrieveTenantTypeFn func(ctx context.Context, t string) (string, error)) (model.BusinessTenantMappingInput, error) {
 	ret := _m.Called(ctx, tnt, externalTenantToType, retrieveTenantTypeFn)
 
 	var r0 model.BusinessTenantMappingInput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, graphql.BusinessTenantMappingInput, map[string]string, func(context.Context, string) (string, error)) (model.BusinessTenantMappingInput, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, graphql.BusinessTenantMappingInput, map[string]string, func(ctx context.Context, t string) (string, error)) (model.BusinessTenantMappingInput, error)); ok {
 		return rf(ctx, tnt, externalTenantToType, retrieveTenantTypeFn)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, graphql.BusinessTenantMappingInput, map[string]string, func(context.Context, string) (string, error)) model.BusinessTenantMappingInput); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, graphql.BusinessTenantMappingInput, map[string]string, func(ctx context.Context, t string) (string, error)) model.BusinessTenantMappingInput); ok {
 		r0 = rf(ctx, tnt, externalTenantToType, retrieveTenantTypeFn)
 	} else {
 		r0 = ret.Get(0).(model.BusinessTenantMappingInput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, graphql.BusinessTenantMappingInput, map[string]string, func(context.Context, string) (string, error)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, graphql.BusinessTenantMappingInput, map[string]string, func(ctx context.Context, t string) (string, error)) error); ok {
 		r1 = rf(ctx, tnt, externalTenantToType, retrieveTenantTypeFn)
 	} else {
 		r1 = ret.Error(1)
@@ -43,15 +41,15 @@ func (_m *BusinessTenantMappingConverter) InputFromGraphQL(ctx context.Context,
 }
 
 // MultipleInputFromGraphQL provides a mock function with given fields: ctx, in, retrieveTenantTypeFn
-func (_m *BusinessTenantMappingConverter) MultipleInputFromGraphQL(ctx context.Context, in []*graphql.BusinessTenantMappingInput, retrieveTenantTypeFn func(context.Context, string) (string, error)) ([]model.BusinessTenantMappingInput, error) {
+func (_m *BusinessTenantMappingConverter) MultipleInputFromGraphQL(ctx context.Context, in []*graphql.BusinessTenantMappingInput, retrieveTenantTypeFn func(ctx context.Context, t string) (string, error)) ([]model.BusinessTenantMappingInput, error) {
 	ret := _m.Called(ctx, in, retrieveTenantTypeFn)
 
 	var r0 []model.BusinessTenantMappingInput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []*graphql.BusinessTenantMappingInput, func(context.Context, string) (string, error)) ([]model.BusinessTenantMappingInput, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []*graphql.BusinessTenantMappingInput, func(ctx context.Context, t string) (string, error)) ([]model.BusinessTenantMappingInput, error)); ok {
 		return rf(ctx, in, retrieveTenantTypeFn)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []*graphql.BusinessTenantMappingInput, func(context.Context, string) (string, error)) []model.BusinessTenantMappingInput); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []*graphql.BusinessTenantMappingInput, func(ctx context.Context, t string) (string, error)) []model.BusinessTenantMappingInput); ok {
 		r0 = rf(ctx, in, retrieveTenantTypeFn)
 	} else {
 		if ret.Get(0) != nil {
@@ -59,7 +57,7 @@ func (_m *BusinessTenantMappingConverter) MultipleInputFromGraphQL(ctx context.C
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []*graphql.BusinessTenantMappingInput, func(context.Context, string) (string, error)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, []*graphql.BusinessTenantMappingInput, func(ctx context.Context, t string) (string, error)) error); ok {
 		r1 = rf(ctx, in, retrieveTenantTypeFn)
 	} else {
 		r1 = ret.Error(1)
@@ -68,6 +66,32 @@ func (_m *BusinessTenantMappingConverter) MultipleInputFromGraphQL(ctx context.C
 	return r0, r1
 }
 
+// MultipleTenantAccessesToGraphQL provides a mock function with given fields: in
+func (_m *BusinessTenantMappingConverter) MultipleTenantAccessesToGraphQL(in []*model.TenantAccess) ([]*graphql.TenantAccess, error) {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.TenantAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]*model.TenantAccess) ([]*graphql.TenantAccess, error)); ok {
+		return rf(in)
+	}
+	if rf, ok := ret.Get(0).(func([]*model.TenantAccess) []*graphql.TenantAccess); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.TenantAccess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]*model.TenantAccess) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MultipleToGraphQL provides a mock function with given fields: in
 func (_m *BusinessTenantMappingConverter) MultipleToGraphQL(in []*model.BusinessTenantMapping) []*graphql.Tenant {
 	ret := _m.Called(in)
@@ -168,6 +192,22 @@ func (_m *BusinessTenantMappingConverter) TenantAccessToGraphQL(in *model.Tenant
 	return r0, r1
 }
 
+// TenantTreeToGraphQL provides a mock function with given fields: in
+func (_m *BusinessTenantMappingConverter) TenantTreeToGraphQL(in *model.TenantTree) *graphql.TenantTree {
+	ret := _m.Called(in)
+
+	var r0 *graphql.TenantTree
+	if rf, ok := ret.Get(0).(func(*model.TenantTree) *graphql.TenantTree); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.TenantTree)
+		}
+	}
+
+	return r0
+}
+
 // ToGraphQL provides a mock function with given fields: in
 func (_m *BusinessTenantMappingConverter) ToGraphQL(in *model.BusinessTenantMapping) *graphql.Tenant {
 	ret := _m.Called(in)
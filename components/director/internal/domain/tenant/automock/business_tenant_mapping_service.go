@@ -6,11 +6,9 @@ import (
 	context "context"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-	mock "github.com/stretchr/testify/mock"
-
-	pkgtenant "github.com/kyma-incubator/compass/components/director/pkg/tenant"
-
 	resource "github.com/kyma-incubator/compass/components/director/pkg/resource"
+	tenantpkg "github.com/kyma-incubator/compass/components/director/pkg/tenant"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // BusinessTenantMappingService is an autogenerated mock type for the BusinessTenantMappingService type
@@ -60,6 +58,44 @@ func (_m *BusinessTenantMappingService) DeleteTenantAccessForResourceRecursively
 	return r0
 }
 
+// DeleteTenantAccessGrant provides a mock function with given fields: ctx, tenantAccess
+func (_m *BusinessTenantMappingService) DeleteTenantAccessGrant(ctx context.Context, tenantAccess *model.TenantAccess) error {
+	ret := _m.Called(ctx, tenantAccess)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TenantAccess) error); ok {
+		r0 = rf(ctx, tenantAccess)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DirectTenantAccessExists provides a mock function with given fields: ctx, tenantID, resourceID, resourceType
+func (_m *BusinessTenantMappingService) DirectTenantAccessExists(ctx context.Context, tenantID string, resourceID string, resourceType resource.Type) (bool, error) {
+	ret := _m.Called(ctx, tenantID, resourceID, resourceType)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, resource.Type) (bool, error)); ok {
+		return rf(ctx, tenantID, resourceID, resourceType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, resource.Type) bool); ok {
+		r0 = rf(ctx, tenantID, resourceID, resourceType)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, resource.Type) error); ok {
+		r1 = rf(ctx, tenantID, resourceID, resourceType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInternalTenant provides a mock function with given fields: ctx, externalTenant
 func (_m *BusinessTenantMappingService) GetInternalTenant(ctx context.Context, externalTenant string) (string, error) {
 	ret := _m.Called(ctx, externalTenant)
@@ -212,6 +248,32 @@ func (_m *BusinessTenantMappingService) GetTenantByID(ctx context.Context, id st
 	return r0, r1
 }
 
+// GetTenantTree provides a mock function with given fields: ctx, externalTenant, depth, direction
+func (_m *BusinessTenantMappingService) GetTenantTree(ctx context.Context, externalTenant string, depth int, direction model.TenantTreeDirection) (*model.TenantTree, error) {
+	ret := _m.Called(ctx, externalTenant, depth, direction)
+
+	var r0 *model.TenantTree
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, model.TenantTreeDirection) (*model.TenantTree, error)); ok {
+		return rf(ctx, externalTenant, depth, direction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, model.TenantTreeDirection) *model.TenantTree); ok {
+		r0 = rf(ctx, externalTenant, depth, direction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantTree)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, model.TenantTreeDirection) error); ok {
+		r1 = rf(ctx, externalTenant, depth, direction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx
 func (_m *BusinessTenantMappingService) List(ctx context.Context) ([]*model.BusinessTenantMapping, error) {
 	ret := _m.Called(ctx)
@@ -290,6 +352,58 @@ func (_m *BusinessTenantMappingService) ListPageBySearchTerm(ctx context.Context
 	return r0, r1
 }
 
+// ListTenantAccessesForResource provides a mock function with given fields: ctx, resourceType, resourceID
+func (_m *BusinessTenantMappingService) ListTenantAccessesForResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.TenantAccess, error) {
+	ret := _m.Called(ctx, resourceType, resourceID)
+
+	var r0 []*model.TenantAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Type, string) ([]*model.TenantAccess, error)); ok {
+		return rf(ctx, resourceType, resourceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Type, string) []*model.TenantAccess); ok {
+		r0 = rf(ctx, resourceType, resourceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TenantAccess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Type, string) error); ok {
+		r1 = rf(ctx, resourceType, resourceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTenantAccessesForTenant provides a mock function with given fields: ctx, tenantID
+func (_m *BusinessTenantMappingService) ListTenantAccessesForTenant(ctx context.Context, tenantID string) ([]*model.TenantAccess, error) {
+	ret := _m.Called(ctx, tenantID)
+
+	var r0 []*model.TenantAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.TenantAccess, error)); ok {
+		return rf(ctx, tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.TenantAccess); ok {
+		r0 = rf(ctx, tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TenantAccess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, id, tenantInput
 func (_m *BusinessTenantMappingService) Update(ctx context.Context, id string, tenantInput model.BusinessTenantMappingInput) error {
 	ret := _m.Called(ctx, id, tenantInput)
@@ -319,7 +433,7 @@ func (_m *BusinessTenantMappingService) UpsertLabel(ctx context.Context, tenantI
 }
 
 // UpsertMany provides a mock function with given fields: ctx, tenantInputs
-func (_m *BusinessTenantMappingService) UpsertMany(ctx context.Context, tenantInputs ...model.BusinessTenantMappingInput) (map[string]pkgtenant.Type, error) {
+func (_m *BusinessTenantMappingService) UpsertMany(ctx context.Context, tenantInputs ...model.BusinessTenantMappingInput) (map[string]tenantpkg.Type, error) {
 	_va := make([]interface{}, len(tenantInputs))
 	for _i := range tenantInputs {
 		_va[_i] = tenantInputs[_i]
@@ -329,16 +443,16 @@ func (_m *BusinessTenantMappingService) UpsertMany(ctx context.Context, tenantIn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 map[string]pkgtenant.Type
+	var r0 map[string]tenantpkg.Type
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ...model.BusinessTenantMappingInput) (map[string]pkgtenant.Type, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ...model.BusinessTenantMappingInput) (map[string]tenantpkg.Type, error)); ok {
 		return rf(ctx, tenantInputs...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ...model.BusinessTenantMappingInput) map[string]pkgtenant.Type); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ...model.BusinessTenantMappingInput) map[string]tenantpkg.Type); ok {
 		r0 = rf(ctx, tenantInputs...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]pkgtenant.Type)
+			r0 = ret.Get(0).(map[string]tenantpkg.Type)
 		}
 	}
 
@@ -375,6 +489,20 @@ func (_m *BusinessTenantMappingService) UpsertSingle(ctx context.Context, tenant
 	return r0, r1
 }
 
+// UpsertTenantAccessGrant provides a mock function with given fields: ctx, tenantAccess
+func (_m *BusinessTenantMappingService) UpsertTenantAccessGrant(ctx context.Context, tenantAccess *model.TenantAccess) error {
+	ret := _m.Called(ctx, tenantAccess)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TenantAccess) error); ok {
+		r0 = rf(ctx, tenantAccess)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewBusinessTenantMappingService creates a new instance of BusinessTenantMappingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBusinessTenantMappingService(t interface {
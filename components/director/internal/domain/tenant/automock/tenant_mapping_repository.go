@@ -4,13 +4,12 @@ package automock
 
 import (
 	context "context"
+	time "time"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-	mock "github.com/stretchr/testify/mock"
-
-	pkgtenant "github.com/kyma-incubator/compass/components/director/pkg/tenant"
-
 	resource "github.com/kyma-incubator/compass/components/director/pkg/resource"
+	tenantpkg "github.com/kyma-incubator/compass/components/director/pkg/tenant"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // TenantMappingRepository is an autogenerated mock type for the TenantMappingRepository type
@@ -18,6 +17,32 @@ type TenantMappingRepository struct {
 	mock.Mock
 }
 
+// CountOwnedResources provides a mock function with given fields: ctx, ids
+func (_m *TenantMappingRepository) CountOwnedResources(ctx context.Context, ids []string) (map[string]*model.TenantOwnedResources, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 map[string]*model.TenantOwnedResources
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]*model.TenantOwnedResources, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]*model.TenantOwnedResources); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.TenantOwnedResources)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteByExternalTenant provides a mock function with given fields: ctx, externalTenant
 func (_m *TenantMappingRepository) DeleteByExternalTenant(ctx context.Context, externalTenant string) error {
 	ret := _m.Called(ctx, externalTenant)
@@ -32,6 +57,20 @@ func (_m *TenantMappingRepository) DeleteByExternalTenant(ctx context.Context, e
 	return r0
 }
 
+// DeleteTenantAccessGrant provides a mock function with given fields: ctx, tenantID, resourceID, resourceType
+func (_m *TenantMappingRepository) DeleteTenantAccessGrant(ctx context.Context, tenantID string, resourceID string, resourceType resource.Type) error {
+	ret := _m.Called(ctx, tenantID, resourceID, resourceType)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, resource.Type) error); ok {
+		r0 = rf(ctx, tenantID, resourceID, resourceType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Exists provides a mock function with given fields: ctx, id
 func (_m *TenantMappingRepository) Exists(ctx context.Context, id string) (bool, error) {
 	ret := _m.Called(ctx, id)
@@ -208,6 +247,32 @@ func (_m *TenantMappingRepository) List(ctx context.Context) ([]*model.BusinessT
 	return r0, r1
 }
 
+// ListAncestors provides a mock function with given fields: ctx, id, maxDepth, limit
+func (_m *TenantMappingRepository) ListAncestors(ctx context.Context, id string, maxDepth int, limit int) ([]*model.TenantTreeNode, error) {
+	ret := _m.Called(ctx, id, maxDepth, limit)
+
+	var r0 []*model.TenantTreeNode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]*model.TenantTreeNode, error)); ok {
+		return rf(ctx, id, maxDepth, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []*model.TenantTreeNode); ok {
+		r0 = rf(ctx, id, maxDepth, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TenantTreeNode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, id, maxDepth, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByExternalTenants provides a mock function with given fields: ctx, externalTenant
 func (_m *TenantMappingRepository) ListByExternalTenants(ctx context.Context, externalTenant []string) ([]*model.BusinessTenantMapping, error) {
 	ret := _m.Called(ctx, externalTenant)
@@ -261,15 +326,15 @@ func (_m *TenantMappingRepository) ListByIds(ctx context.Context, ids []string)
 }
 
 // ListByIdsAndType provides a mock function with given fields: ctx, ids, tenantType
-func (_m *TenantMappingRepository) ListByIdsAndType(ctx context.Context, ids []string, tenantType pkgtenant.Type) ([]*model.BusinessTenantMapping, error) {
+func (_m *TenantMappingRepository) ListByIdsAndType(ctx context.Context, ids []string, tenantType tenantpkg.Type) ([]*model.BusinessTenantMapping, error) {
 	ret := _m.Called(ctx, ids, tenantType)
 
 	var r0 []*model.BusinessTenantMapping
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string, pkgtenant.Type) ([]*model.BusinessTenantMapping, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []string, tenantpkg.Type) ([]*model.BusinessTenantMapping, error)); ok {
 		return rf(ctx, ids, tenantType)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string, pkgtenant.Type) []*model.BusinessTenantMapping); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []string, tenantpkg.Type) []*model.BusinessTenantMapping); ok {
 		r0 = rf(ctx, ids, tenantType)
 	} else {
 		if ret.Get(0) != nil {
@@ -277,7 +342,7 @@ func (_m *TenantMappingRepository) ListByIdsAndType(ctx context.Context, ids []s
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string, pkgtenant.Type) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, []string, tenantpkg.Type) error); ok {
 		r1 = rf(ctx, ids, tenantType)
 	} else {
 		r1 = ret.Error(1)
@@ -287,15 +352,15 @@ func (_m *TenantMappingRepository) ListByIdsAndType(ctx context.Context, ids []s
 }
 
 // ListByParentAndType provides a mock function with given fields: ctx, parentID, tenantType
-func (_m *TenantMappingRepository) ListByParentAndType(ctx context.Context, parentID string, tenantType pkgtenant.Type) ([]*model.BusinessTenantMapping, error) {
+func (_m *TenantMappingRepository) ListByParentAndType(ctx context.Context, parentID string, tenantType tenantpkg.Type) ([]*model.BusinessTenantMapping, error) {
 	ret := _m.Called(ctx, parentID, tenantType)
 
 	var r0 []*model.BusinessTenantMapping
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, pkgtenant.Type) ([]*model.BusinessTenantMapping, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, tenantpkg.Type) ([]*model.BusinessTenantMapping, error)); ok {
 		return rf(ctx, parentID, tenantType)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, pkgtenant.Type) []*model.BusinessTenantMapping); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, tenantpkg.Type) []*model.BusinessTenantMapping); ok {
 		r0 = rf(ctx, parentID, tenantType)
 	} else {
 		if ret.Get(0) != nil {
@@ -303,7 +368,7 @@ func (_m *TenantMappingRepository) ListByParentAndType(ctx context.Context, pare
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, pkgtenant.Type) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, tenantpkg.Type) error); ok {
 		r1 = rf(ctx, parentID, tenantType)
 	} else {
 		r1 = ret.Error(1)
@@ -313,15 +378,15 @@ func (_m *TenantMappingRepository) ListByParentAndType(ctx context.Context, pare
 }
 
 // ListByType provides a mock function with given fields: ctx, tenantType
-func (_m *TenantMappingRepository) ListByType(ctx context.Context, tenantType pkgtenant.Type) ([]*model.BusinessTenantMapping, error) {
+func (_m *TenantMappingRepository) ListByType(ctx context.Context, tenantType tenantpkg.Type) ([]*model.BusinessTenantMapping, error) {
 	ret := _m.Called(ctx, tenantType)
 
 	var r0 []*model.BusinessTenantMapping
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pkgtenant.Type) ([]*model.BusinessTenantMapping, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, tenantpkg.Type) ([]*model.BusinessTenantMapping, error)); ok {
 		return rf(ctx, tenantType)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pkgtenant.Type) []*model.BusinessTenantMapping); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, tenantpkg.Type) []*model.BusinessTenantMapping); ok {
 		r0 = rf(ctx, tenantType)
 	} else {
 		if ret.Get(0) != nil {
@@ -329,7 +394,7 @@ func (_m *TenantMappingRepository) ListByType(ctx context.Context, tenantType pk
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pkgtenant.Type) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, tenantpkg.Type) error); ok {
 		r1 = rf(ctx, tenantType)
 	} else {
 		r1 = ret.Error(1)
@@ -338,6 +403,58 @@ func (_m *TenantMappingRepository) ListByType(ctx context.Context, tenantType pk
 	return r0, r1
 }
 
+// ListDescendants provides a mock function with given fields: ctx, id, maxDepth, limit
+func (_m *TenantMappingRepository) ListDescendants(ctx context.Context, id string, maxDepth int, limit int) ([]*model.TenantTreeNode, error) {
+	ret := _m.Called(ctx, id, maxDepth, limit)
+
+	var r0 []*model.TenantTreeNode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]*model.TenantTreeNode, error)); ok {
+		return rf(ctx, id, maxDepth, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []*model.TenantTreeNode); ok {
+		r0 = rf(ctx, id, maxDepth, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TenantTreeNode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, id, maxDepth, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpiredTenantAccessGrants provides a mock function with given fields: ctx, expiredAt
+func (_m *TenantMappingRepository) ListExpiredTenantAccessGrants(ctx context.Context, expiredAt time.Time) ([]*model.TenantAccess, error) {
+	ret := _m.Called(ctx, expiredAt)
+
+	var r0 []*model.TenantAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*model.TenantAccess, error)); ok {
+		return rf(ctx, expiredAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*model.TenantAccess); ok {
+		r0 = rf(ctx, expiredAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TenantAccess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, expiredAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListPageBySearchTerm provides a mock function with given fields: ctx, searchTerm, pageSize, cursor
 func (_m *TenantMappingRepository) ListPageBySearchTerm(ctx context.Context, searchTerm string, pageSize int, cursor string) (*model.BusinessTenantMappingPage, error) {
 	ret := _m.Called(ctx, searchTerm, pageSize, cursor)
@@ -364,6 +481,58 @@ func (_m *TenantMappingRepository) ListPageBySearchTerm(ctx context.Context, sea
 	return r0, r1
 }
 
+// ListTenantAccessesForResource provides a mock function with given fields: ctx, resourceType, resourceID
+func (_m *TenantMappingRepository) ListTenantAccessesForResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.TenantAccess, error) {
+	ret := _m.Called(ctx, resourceType, resourceID)
+
+	var r0 []*model.TenantAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Type, string) ([]*model.TenantAccess, error)); ok {
+		return rf(ctx, resourceType, resourceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Type, string) []*model.TenantAccess); ok {
+		r0 = rf(ctx, resourceType, resourceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TenantAccess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Type, string) error); ok {
+		r1 = rf(ctx, resourceType, resourceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTenantAccessesForTenant provides a mock function with given fields: ctx, tenantID
+func (_m *TenantMappingRepository) ListTenantAccessesForTenant(ctx context.Context, tenantID string) ([]*model.TenantAccess, error) {
+	ret := _m.Called(ctx, tenantID)
+
+	var r0 []*model.TenantAccess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.TenantAccess, error)); ok {
+		return rf(ctx, tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.TenantAccess); ok {
+		r0 = rf(ctx, tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TenantAccess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnsafeCreate provides a mock function with given fields: ctx, item
 func (_m *TenantMappingRepository) UnsafeCreate(ctx context.Context, item model.BusinessTenantMapping) (string, error) {
 	ret := _m.Called(ctx, item)
@@ -426,6 +595,20 @@ func (_m *TenantMappingRepository) Upsert(ctx context.Context, item model.Busine
 	return r0, r1
 }
 
+// UpsertTenantAccessGrant provides a mock function with given fields: ctx, tenantAccess
+func (_m *TenantMappingRepository) UpsertTenantAccessGrant(ctx context.Context, tenantAccess *model.TenantAccess) error {
+	ret := _m.Called(ctx, tenantAccess)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TenantAccess) error); ok {
+		r0 = rf(ctx, tenantAccess)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewTenantMappingRepository creates a new instance of TenantMappingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTenantMappingRepository(t interface {
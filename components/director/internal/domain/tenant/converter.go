@@ -2,6 +2,7 @@ package tenant
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
@@ -187,6 +188,49 @@ func (c *converter) MultipleToGraphQL(in []*model.BusinessTenantMapping) []*grap
 	return tenants
 }
 
+// TenantTreeToGraphQL converts the given tenant tree into its GraphQL representation.
+func (c *converter) TenantTreeToGraphQL(in *model.TenantTree) *graphql.TenantTree {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.TenantTree{
+		Root:        c.tenantTreeNodeToGraphQL(in.Root),
+		Ancestors:   c.multipleTenantTreeNodesToGraphQL(in.Ancestors),
+		Descendants: c.multipleTenantTreeNodesToGraphQL(in.Descendants),
+		Truncated:   in.Truncated,
+	}
+}
+
+func (c *converter) multipleTenantTreeNodesToGraphQL(in []*model.TenantTreeNode) []*graphql.TenantTreeNode {
+	nodes := make([]*graphql.TenantTreeNode, 0, len(in))
+	for _, node := range in {
+		if node == nil {
+			continue
+		}
+
+		nodes = append(nodes, c.tenantTreeNodeToGraphQL(node))
+	}
+
+	return nodes
+}
+
+func (c *converter) tenantTreeNodeToGraphQL(in *model.TenantTreeNode) *graphql.TenantTreeNode {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.TenantTreeNode{
+		Tenant: c.ToGraphQL(in.Tenant),
+		Depth:  in.Depth,
+		OwnedResources: &graphql.TenantOwnedResources{
+			Applications: in.OwnedResources.Applications,
+			Runtimes:     in.OwnedResources.Runtimes,
+			Formations:   in.OwnedResources.Formations,
+		},
+	}
+}
+
 func (c *converter) MultipleInputToGraphQLInput(in []model.BusinessTenantMappingInput) []graphql.BusinessTenantMappingInput {
 	tenants := make([]graphql.BusinessTenantMappingInput, 0, len(in))
 	for _, tnt := range in {
@@ -207,6 +251,8 @@ func (c *converter) TenantAccessInputFromGraphQL(in graphql.TenantAccessInput) (
 		ResourceType:     resourceType,
 		ResourceID:       in.ResourceID,
 		Owner:            in.Owner,
+		Reason:           in.Reason,
+		ExpiresAt:        (*time.Time)(in.ExpiresAt),
 	}, nil
 }
 
@@ -226,9 +272,32 @@ func (c *converter) TenantAccessToGraphQL(in *model.TenantAccess) (*graphql.Tena
 		ResourceType: resourceType,
 		ResourceID:   in.ResourceID,
 		Owner:        in.Owner,
+		GrantedBy:    in.GrantedBy,
+		Reason:       in.Reason,
+		CreatedAt:    (*graphql.Timestamp)(in.CreatedAt),
+		ExpiresAt:    (*graphql.Timestamp)(in.ExpiresAt),
 	}, nil
 }
 
+// MultipleTenantAccessesToGraphQL converts the provided model.TenantAccess service-layer representations of tenant accesses to the GraphQL-layer representations graphql.TenantAccess.
+func (c *converter) MultipleTenantAccessesToGraphQL(in []*model.TenantAccess) ([]*graphql.TenantAccess, error) {
+	tenantAccesses := make([]*graphql.TenantAccess, 0, len(in))
+	for _, ta := range in {
+		if ta == nil {
+			continue
+		}
+
+		tenantAccess, err := c.TenantAccessToGraphQL(ta)
+		if err != nil {
+			return nil, err
+		}
+
+		tenantAccesses = append(tenantAccesses, tenantAccess)
+	}
+
+	return tenantAccesses, nil
+}
+
 // TenantAccessToEntity converts the provided service-layer representation of a tenant access to the repository-layer one.
 func (c *converter) TenantAccessToEntity(in *model.TenantAccess) *repo.TenantAccess {
 	if in == nil {
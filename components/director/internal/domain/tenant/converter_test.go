@@ -665,6 +665,20 @@ func TestConverter_TenantAccessToGraphQL(t *testing.T) {
 				Owner:        false,
 			},
 		},
+		{
+			Name:  "Success with grant details",
+			Input: fixTenantAccessModelWithGrant(testInternal, testConsumerID, &testTime),
+			ExpectedOutput: &graphql.TenantAccess{
+				TenantID:     testExternal,
+				ResourceType: graphql.TenantAccessObjectTypeApplication,
+				ResourceID:   testID,
+				Owner:        true,
+				GrantedBy:    str.Ptr(testConsumerID),
+				Reason:       str.Ptr(testReason),
+				CreatedAt:    (*graphql.Timestamp)(&testTime),
+				ExpiresAt:    (*graphql.Timestamp)(&testExpiresAt),
+			},
+		},
 		{
 			Name: "Error when converting resource type",
 			Input: &model.TenantAccess{
@@ -695,6 +709,45 @@ func TestConverter_TenantAccessToGraphQL(t *testing.T) {
 	}
 }
 
+func TestConverter_MultipleTenantAccessesToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		c := tenant.NewConverter()
+
+		// WHEN
+		output, err := c.MultipleTenantAccessesToGraphQL([]*model.TenantAccess{tenantAccessModel, nil})
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*graphql.TenantAccess{tenantAccessGQL}, output)
+	})
+
+	t.Run("Success when empty input", func(t *testing.T) {
+		// GIVEN
+		c := tenant.NewConverter()
+
+		// WHEN
+		output, err := c.MultipleTenantAccessesToGraphQL(nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, output)
+	})
+
+	t.Run("Error when converting resource type", func(t *testing.T) {
+		// GIVEN
+		c := tenant.NewConverter()
+
+		// WHEN
+		output, err := c.MultipleTenantAccessesToGraphQL([]*model.TenantAccess{invalidTenantAccessModel})
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unknown tenant access resource type")
+		require.Nil(t, output)
+	})
+}
+
 func TestConverter_TenantAccessToEntity(t *testing.T) {
 	testCases := []struct {
 		Name           string
@@ -766,3 +819,52 @@ func TestConverter_TenantAccessFromEntity(t *testing.T) {
 		})
 	}
 }
+
+func TestConverter_TenantTreeToGraphQL(t *testing.T) {
+	rootModel := &model.BusinessTenantMapping{ID: ids[0], Name: names[0], ExternalTenant: externalTenants[0], Parents: []string{ids[1]}, Type: tnt.Account, Provider: testProvider}
+	parentModel := &model.BusinessTenantMapping{ID: ids[1], Name: names[1], ExternalTenant: externalTenants[1], Parents: []string{}, Type: tnt.Customer, Provider: testProvider}
+
+	testCases := []struct {
+		Name           string
+		Input          *model.TenantTree
+		ExpectedOutput *graphql.TenantTree
+	}{
+		{
+			Name:           "when input is nil",
+			Input:          nil,
+			ExpectedOutput: nil,
+		},
+		{
+			Name: "all fields",
+			Input: &model.TenantTree{
+				Root:        &model.TenantTreeNode{Tenant: rootModel, OwnedResources: model.TenantOwnedResources{Applications: 1, Runtimes: 2}},
+				Ancestors:   []*model.TenantTreeNode{{Tenant: parentModel, Depth: 1, OwnedResources: model.TenantOwnedResources{Formations: 3}}, nil},
+				Descendants: []*model.TenantTreeNode{},
+				Truncated:   true,
+			},
+			ExpectedOutput: &graphql.TenantTree{
+				Root: &graphql.TenantTreeNode{
+					Tenant:         &graphql.Tenant{ID: externalTenants[0], InternalID: ids[0], Name: &names[0], Type: string(tnt.Account), Parents: []string{ids[1]}, Provider: testProvider},
+					OwnedResources: &graphql.TenantOwnedResources{Applications: 1, Runtimes: 2},
+				},
+				Ancestors: []*graphql.TenantTreeNode{
+					{
+						Tenant:         &graphql.Tenant{ID: externalTenants[1], InternalID: ids[1], Name: &names[1], Type: string(tnt.Customer), Parents: []string{}, Provider: testProvider},
+						Depth:          1,
+						OwnedResources: &graphql.TenantOwnedResources{Formations: 3},
+					},
+				},
+				Descendants: []*graphql.TenantTreeNode{},
+				Truncated:   true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			converter := tenant.NewConverter()
+			output := converter.TenantTreeToGraphQL(testCase.Input)
+			require.Equal(t, testCase.ExpectedOutput, output)
+		})
+	}
+}
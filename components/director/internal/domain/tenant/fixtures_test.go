@@ -3,8 +3,10 @@ package tenant_test
 import (
 	"database/sql/driver"
 	"errors"
+	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant/automock"
@@ -42,17 +44,22 @@ const (
 	testLicenseType               = "TESTLICENSE"
 	initializedColumn             = "initialized"
 	invalidResourceType           = "INVALID"
+	testReason                    = "support case"
+	testConsumerID                = "consumer-id"
 )
 
 var (
-	testCustomerID                = str.Ptr("0000customerID")
-	testCustomerIDTrimmed         = str.Ptr("customerID")
-	testError                     = errors.New("test error")
-	testTableColumns              = []string{"id", "external_name", "external_tenant", "type", "provider_name", "status"}
-	tenantAccessTestTableColumns  = []string{"tenant_id", "id", "owner", "source"}
-	testTenantParentsTableColumns = []string{"tenant_id", "parent_id"}
-	testRootParents               = []*model.BusinessTenantMapping{{ID: testParentID}, {ID: testParentID2}}
-	tenantGAModel                 = &model.BusinessTenantMapping{
+	testCustomerID                    = str.Ptr("0000customerID")
+	testCustomerIDTrimmed             = str.Ptr("customerID")
+	testError                         = errors.New("test error")
+	testTime                          = time.Date(2024, time.August, 26, 10, 0, 0, 0, time.UTC)
+	testExpiresAt                     = testTime.Add(24 * time.Hour)
+	testTableColumns                  = []string{"id", "external_name", "external_tenant", "type", "provider_name", "status"}
+	tenantAccessTestTableColumns      = []string{"tenant_id", "id", "owner", "source"}
+	tenantAccessWithGrantTableColumns = []string{"tenant_id", "external_tenant", "resource_type", "id", "owner", "granted_by", "reason", "created_at", "expires_at"}
+	testTenantParentsTableColumns     = []string{"tenant_id", "parent_id"}
+	testRootParents                   = []*model.BusinessTenantMapping{{ID: testParentID}, {ID: testParentID2}}
+	tenantGAModel                     = &model.BusinessTenantMapping{
 		ID:             testInternal,
 		ExternalTenant: testExternal,
 		Type:           tenant.Account,
@@ -74,6 +81,14 @@ var (
 		ResourceID:   testID,
 		Owner:        true,
 	}
+	tenantAccessInputWithGrant = graphql.TenantAccessInput{
+		TenantID:     testExternal,
+		ResourceType: graphql.TenantAccessObjectTypeApplication,
+		ResourceID:   testID,
+		Owner:        true,
+		Reason:       str.Ptr(testReason),
+		ExpiresAt:    (*graphql.Timestamp)(&testExpiresAt),
+	}
 	tenantAccessGQL = &graphql.TenantAccess{
 		TenantID:     testExternal,
 		ResourceType: graphql.TenantAccessObjectTypeApplication,
@@ -344,6 +359,25 @@ func fixTenantAccessesRow() []driver.Value {
 	return []driver.Value{testID, "resourceID", true, "source"}
 }
 
+func fixTenantAccessModelWithGrant(internalTenantID, grantedBy string, createdAt *time.Time) *model.TenantAccess {
+	tenantAccess := &model.TenantAccess{
+		ExternalTenantID: testExternal,
+		InternalTenantID: internalTenantID,
+		ResourceType:     resource.Application,
+		ResourceID:       testID,
+		Owner:            true,
+		Source:           internalTenantID,
+		Reason:           str.Ptr(testReason),
+		CreatedAt:        createdAt,
+		ExpiresAt:        &testExpiresAt,
+	}
+	if grantedBy != "" {
+		tenantAccess.GrantedBy = str.Ptr(grantedBy)
+	}
+
+	return tenantAccess
+}
+
 func boolToPtr(in bool) *bool {
 	return &in
 }
@@ -449,3 +483,31 @@ WHERE id IN ($1) AND source IN ($2, $3) AND NOT EXISTS
 		(SELECT 1 FROM `) + `(.+)` + regexp.QuoteMeta(` ta WHERE ta.tenant_id = a.source AND ta.id = a.id);
 `)
 }
+
+func fixListTenantAccessesQuery(m2mTable string, resourceType resource.Type, filterColumn string, argIdx int) string {
+	return fmt.Sprintf(`SELECT ta.tenant_id, t.external_tenant, '%[2]s' AS resource_type, ta.id, bool_or(ta.owner) AS owner,
+					g.granted_by, g.reason, g.created_at, g.expires_at
+				FROM %[1]s ta
+					JOIN public.business_tenant_mappings t ON t.id = ta.tenant_id
+					LEFT JOIN tenant_access_grants g ON g.tenant_id = ta.tenant_id AND g.resource_type = '%[2]s' AND g.resource_id = ta.id
+				WHERE ta.%[3]s = $%[4]d
+				GROUP BY ta.tenant_id, t.external_tenant, ta.id, g.granted_by, g.reason, g.created_at, g.expires_at`, m2mTable, resourceType, filterColumn, argIdx)
+}
+
+func fixTenantAccessModelFromGrantRow(internalTenantID, externalTenantID string, owner, withGrant bool) *model.TenantAccess {
+	tenantAccess := &model.TenantAccess{
+		ExternalTenantID: externalTenantID,
+		InternalTenantID: internalTenantID,
+		ResourceType:     resource.Application,
+		ResourceID:       testID,
+		Owner:            owner,
+	}
+	if withGrant {
+		tenantAccess.GrantedBy = str.Ptr(testConsumerID)
+		tenantAccess.Reason = str.Ptr(testReason)
+		tenantAccess.CreatedAt = &testTime
+		tenantAccess.ExpiresAt = &testExpiresAt
+	}
+
+	return tenantAccess
+}
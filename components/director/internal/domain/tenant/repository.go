@@ -3,10 +3,12 @@ package tenant
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"fmt"
 	"math"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantparentmapping"
 	"k8s.io/utils/strings/slices"
@@ -55,6 +57,14 @@ const (
 	tenantApplicationsTable   string = "tenant_applications"
 	appTemplateIDColumn       string = "app_template_id"
 	keyColumn                 string = "key"
+	formationsTable           string = "formations"
+	tenantAccessGrantsTable   string = "tenant_access_grants"
+	resourceTypeColumn        string = "resource_type"
+	resourceIDColumn          string = "resource_id"
+	grantedByColumn           string = "granted_by"
+	reasonColumn              string = "reason"
+	createdAtColumn           string = "created_at"
+	expiresAtColumn           string = "expires_at"
 
 	maxParameterChunkSize     int = 50000 // max parameters size in PostgreSQL is 65535
 	getTenantsByParentAndType     = `SELECT %s from %s join %s on %s = %s where %s = ? and %s = ?`
@@ -70,6 +80,11 @@ var (
 	labelsSelectedColumns               = []string{"app_template_id"}
 	applicationsSelectedColumns         = []string{"id"}
 	tenantApplicationsSelectedColumns   = []string{tenantIDColumn}
+
+	tenantAccessGrantColumns           = []string{tenantIDColumn, resourceTypeColumn, resourceIDColumn, grantedByColumn, reasonColumn, createdAtColumn, expiresAtColumn}
+	tenantAccessGrantConflictColumns   = []string{tenantIDColumn, resourceTypeColumn, resourceIDColumn}
+	tenantAccessGrantUpdatableColumns  = []string{grantedByColumn, reasonColumn, createdAtColumn, expiresAtColumn}
+	tenantAccessGrantableResourceTypes = []resource.Type{resource.Application, resource.Runtime, resource.RuntimeContext}
 )
 
 // Converter converts tenants between the model.BusinessTenantMapping service-layer representation of a tenant and the repo-layer representation tenant.Entity.
@@ -98,6 +113,9 @@ type pgRepository struct {
 	tenantApplicationsQueryBuilder   repo.QueryBuilderGlobal
 	tenantParentRepo                 tenantparentmapping.TenantParentRepository
 
+	tenantAccessGrantUpserter repo.UpserterGlobal
+	tenantAccessGrantDeleter  repo.DeleterGlobal
+
 	conv Converter
 }
 
@@ -119,6 +137,8 @@ func NewRepository(conv Converter) *pgRepository {
 		applicationQueryBuilder:             repo.NewQueryBuilderGlobal(resource.Application, applicationTable, applicationsSelectedColumns),
 		tenantApplicationsQueryBuilder:      repo.NewQueryBuilderGlobal(resource.Application, tenantApplicationsTable, tenantApplicationsSelectedColumns),
 		tenantParentRepo:                    tenantparentmapping.NewRepository(),
+		tenantAccessGrantUpserter:           repo.NewUpserterGlobal(resource.TenantAccess, tenantAccessGrantsTable, tenantAccessGrantColumns, tenantAccessGrantConflictColumns, tenantAccessGrantUpdatableColumns),
+		tenantAccessGrantDeleter:            repo.NewDeleterGlobal(resource.TenantAccess, tenantAccessGrantsTable),
 		conv:                                conv,
 	}
 }
@@ -728,6 +748,338 @@ func (r *pgRepository) ListByIdsAndType(ctx context.Context, ids []string, tenan
 	return r.enrichManyWithParents(ctx, entityCollection)
 }
 
+// ListAncestors lists the parents of the tenant with the given internal ID, their parents and so on, up to maxDepth levels above the tenant.
+// Each ancestor is returned once, with its shortest distance from the tenant. At most limit ancestors are returned, the closest ones first.
+func (r *pgRepository) ListAncestors(ctx context.Context, id string, maxDepth, limit int) ([]*model.TenantTreeNode, error) {
+	return r.listRelatives(ctx, id, maxDepth, limit, tenantparentmapping.TenantIDColumn, tenantparentmapping.ParentIDColumn)
+}
+
+// ListDescendants lists the children of the tenant with the given internal ID, their children and so on, up to maxDepth levels below the tenant.
+// Each descendant is returned once, with its shortest distance from the tenant. At most limit descendants are returned, the closest ones first.
+func (r *pgRepository) ListDescendants(ctx context.Context, id string, maxDepth, limit int) ([]*model.TenantTreeNode, error) {
+	return r.listRelatives(ctx, id, maxDepth, limit, tenantparentmapping.ParentIDColumn, tenantparentmapping.TenantIDColumn)
+}
+
+// CountOwnedResources returns the number of applications, runtimes and formations owned by each of the tenants with the given internal IDs
+func (r *pgRepository) CountOwnedResources(ctx context.Context, ids []string) (map[string]*model.TenantOwnedResources, error) {
+	result := make(map[string]*model.TenantOwnedResources, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	rawStmt := `SELECT t.{{ .id }} AS {{ .tenantID }},
+					(SELECT COUNT(*) FROM {{ .tenantApplicationsTable }} ta WHERE ta.{{ .m2mTenantID }} = t.{{ .id }} AND ta.{{ .owner }} = true) AS applications,
+					(SELECT COUNT(*) FROM {{ .tenantRuntimesTable }} tr WHERE tr.{{ .m2mTenantID }} = t.{{ .id }} AND tr.{{ .owner }} = true) AS runtimes,
+					(SELECT COUNT(*) FROM {{ .formationsTable }} f WHERE f.{{ .tenantID }} = t.{{ .id }}) AS formations
+				FROM {{ .tenantsTable }} t
+				WHERE {{ .inCondition }}`
+
+	t, err := template.New("").Parse(rawStmt)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantApplicationsTable, _ := resource.Application.TenantAccessTable()
+	tenantRuntimesTable, _ := resource.Runtime.TenantAccessTable()
+	inCondition := repo.NewInConditionForStringValues(prefixWithTableName("t", IDColumn), ids)
+
+	data := map[string]string{
+		"id":                      IDColumn,
+		"tenantID":                tenantIDColumn,
+		"m2mTenantID":             repo.M2MTenantIDColumn,
+		"owner":                   repo.M2MOwnerColumn,
+		"tenantApplicationsTable": tenantApplicationsTable,
+		"tenantRuntimesTable":     tenantRuntimesTable,
+		"formationsTable":         formationsTable,
+		"tenantsTable":            TableName,
+		"inCondition":             inCondition.GetQueryPart(),
+	}
+
+	res := new(bytes.Buffer)
+	if err = t.Execute(res, data); err != nil {
+		return nil, errors.Wrapf(err, "while executing template")
+	}
+
+	stmt := res.String()
+	stmt = sqlx.Rebind(sqlx.DOLLAR, stmt)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+
+	var dest []ownedResourcesEntity
+	args, _ := inCondition.GetQueryArgs()
+	if err := persist.SelectContext(ctx, &dest, stmt, args...); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.Tenant, resource.List, "while counting owned resources for tenants with IDs %v", ids)
+	}
+
+	for _, entity := range dest {
+		result[entity.TenantID] = &model.TenantOwnedResources{
+			Applications: entity.Applications,
+			Runtimes:     entity.Runtimes,
+			Formations:   entity.Formations,
+		}
+	}
+
+	return result, nil
+}
+
+// UpsertTenantAccessGrant stores the grant details of the given tenant access. Existing grant details for the same tenant and resource are overwritten.
+func (r *pgRepository) UpsertTenantAccessGrant(ctx context.Context, tenantAccess *model.TenantAccess) error {
+	if tenantAccess == nil {
+		return apperrors.NewInternalError("tenant access cannot be nil")
+	}
+
+	entity := &tenantAccessGrantEntity{
+		TenantID:     tenantAccess.InternalTenantID,
+		ResourceType: string(tenantAccess.ResourceType),
+		ResourceID:   tenantAccess.ResourceID,
+		GrantedBy:    repo.NewNullableString(tenantAccess.GrantedBy),
+		Reason:       repo.NewNullableString(tenantAccess.Reason),
+		ExpiresAt:    newNullableTime(tenantAccess.ExpiresAt),
+	}
+	if tenantAccess.CreatedAt != nil {
+		entity.CreatedAt = *tenantAccess.CreatedAt
+	}
+
+	return r.tenantAccessGrantUpserter.UpsertGlobal(ctx, entity)
+}
+
+// DeleteTenantAccessGrant deletes the grant details of the access of the given tenant to the given resource.
+func (r *pgRepository) DeleteTenantAccessGrant(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) error {
+	conditions := repo.Conditions{
+		repo.NewEqualCondition(tenantIDColumn, tenantID),
+		repo.NewEqualCondition(resourceTypeColumn, string(resourceType)),
+		repo.NewEqualCondition(resourceIDColumn, resourceID),
+	}
+
+	return r.tenantAccessGrantDeleter.DeleteManyGlobal(ctx, conditions)
+}
+
+// ListTenantAccessesForResource lists the tenants that have access to the given resource together with the details of the grants, if any.
+func (r *pgRepository) ListTenantAccessesForResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.TenantAccess, error) {
+	stmt, err := buildTenantAccessesQuery(resourceType, repo.M2MResourceIDColumn)
+	if err != nil {
+		return nil, err
+	}
+	stmt = sqlx.Rebind(sqlx.DOLLAR, stmt+" ORDER BY t."+ExternalTenantColumn)
+
+	return r.listTenantAccesses(ctx, stmt, resourceID)
+}
+
+// ListTenantAccessesForTenant lists the accesses of the given tenant to applications, runtimes and runtime contexts together with the details of the grants, if any.
+func (r *pgRepository) ListTenantAccessesForTenant(ctx context.Context, tenantID string) ([]*model.TenantAccess, error) {
+	queries := make([]string, 0, len(tenantAccessGrantableResourceTypes))
+	args := make([]interface{}, 0, len(tenantAccessGrantableResourceTypes))
+	for _, resourceType := range tenantAccessGrantableResourceTypes {
+		query, err := buildTenantAccessesQuery(resourceType, repo.M2MTenantIDColumn)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, "("+query+")")
+		args = append(args, tenantID)
+	}
+
+	stmt := strings.Join(queries, " UNION ALL ") + fmt.Sprintf(" ORDER BY %s, %s", resourceTypeColumn, repo.M2MResourceIDColumn)
+	stmt = sqlx.Rebind(sqlx.DOLLAR, stmt)
+
+	return r.listTenantAccesses(ctx, stmt, args...)
+}
+
+// ListExpiredTenantAccessGrants lists the tenant accesses whose grants expired at or before the given time.
+func (r *pgRepository) ListExpiredTenantAccessGrants(ctx context.Context, expiredAt time.Time) ([]*model.TenantAccess, error) {
+	rawStmt := `SELECT g.{{ .tenantID }}, t.{{ .externalTenant }}, g.{{ .resourceType }}, g.{{ .resourceID }} AS {{ .m2mResourceID }}, false AS {{ .owner }},
+					g.{{ .grantedBy }}, g.{{ .reason }}, g.{{ .createdAt }}, g.{{ .expiresAt }}
+				FROM {{ .grantsTable }} g JOIN {{ .tenantsTable }} t ON t.{{ .id }} = g.{{ .tenantID }}
+				WHERE g.{{ .expiresAt }} IS NOT NULL AND g.{{ .expiresAt }} <= ?
+				ORDER BY g.{{ .expiresAt }}`
+
+	t, err := template.New("").Parse(rawStmt)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]string{
+		"tenantID":       tenantIDColumn,
+		"externalTenant": ExternalTenantColumn,
+		"resourceType":   resourceTypeColumn,
+		"resourceID":     resourceIDColumn,
+		"m2mResourceID":  repo.M2MResourceIDColumn,
+		"owner":          repo.M2MOwnerColumn,
+		"grantedBy":      grantedByColumn,
+		"reason":         reasonColumn,
+		"createdAt":      createdAtColumn,
+		"expiresAt":      expiresAtColumn,
+		"grantsTable":    tenantAccessGrantsTable,
+		"tenantsTable":   TableName,
+		"id":             IDColumn,
+	}
+
+	res := new(bytes.Buffer)
+	if err = t.Execute(res, data); err != nil {
+		return nil, errors.Wrapf(err, "while executing template")
+	}
+
+	stmt := sqlx.Rebind(sqlx.DOLLAR, res.String())
+
+	return r.listTenantAccesses(ctx, stmt, expiredAt)
+}
+
+func (r *pgRepository) listTenantAccesses(ctx context.Context, stmt string, args ...interface{}) ([]*model.TenantAccess, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+
+	var dest []tenantAccessEntity
+	if err := persist.SelectContext(ctx, &dest, stmt, args...); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.TenantAccess, resource.List, "while listing tenant accesses")
+	}
+
+	tenantAccesses := make([]*model.TenantAccess, 0, len(dest))
+	for _, entity := range dest {
+		tenantAccesses = append(tenantAccesses, entity.toModel())
+	}
+
+	return tenantAccesses, nil
+}
+
+func buildTenantAccessesQuery(resourceType resource.Type, filterColumn string) (string, error) {
+	m2mTable, ok := resourceType.TenantAccessTable()
+	if !ok {
+		return "", errors.Errorf("entity %q does not have access table", resourceType)
+	}
+
+	rawStmt := `SELECT ta.{{ .tenantID }}, t.{{ .externalTenant }}, '{{ .resourceTypeValue }}' AS {{ .resourceType }}, ta.{{ .m2mResourceID }}, bool_or(ta.{{ .owner }}) AS {{ .owner }},
+					g.{{ .grantedBy }}, g.{{ .reason }}, g.{{ .createdAt }}, g.{{ .expiresAt }}
+				FROM {{ .m2mTable }} ta
+					JOIN {{ .tenantsTable }} t ON t.{{ .id }} = ta.{{ .tenantID }}
+					LEFT JOIN {{ .grantsTable }} g ON g.{{ .tenantID }} = ta.{{ .tenantID }} AND g.{{ .resourceType }} = '{{ .resourceTypeValue }}' AND g.{{ .resourceID }} = ta.{{ .m2mResourceID }}
+				WHERE ta.{{ .filterColumn }} = ?
+				GROUP BY ta.{{ .tenantID }}, t.{{ .externalTenant }}, ta.{{ .m2mResourceID }}, g.{{ .grantedBy }}, g.{{ .reason }}, g.{{ .createdAt }}, g.{{ .expiresAt }}`
+
+	t, err := template.New("").Parse(rawStmt)
+	if err != nil {
+		return "", err
+	}
+
+	data := map[string]string{
+		"tenantID":          tenantIDColumn,
+		"externalTenant":    ExternalTenantColumn,
+		"resourceTypeValue": string(resourceType),
+		"resourceType":      resourceTypeColumn,
+		"resourceID":        resourceIDColumn,
+		"m2mResourceID":     repo.M2MResourceIDColumn,
+		"owner":             repo.M2MOwnerColumn,
+		"grantedBy":         grantedByColumn,
+		"reason":            reasonColumn,
+		"createdAt":         createdAtColumn,
+		"expiresAt":         expiresAtColumn,
+		"m2mTable":          m2mTable,
+		"tenantsTable":      TableName,
+		"grantsTable":       tenantAccessGrantsTable,
+		"id":                IDColumn,
+		"filterColumn":      filterColumn,
+	}
+
+	res := new(bytes.Buffer)
+	if err = t.Execute(res, data); err != nil {
+		return "", errors.Wrapf(err, "while executing template")
+	}
+
+	return res.String(), nil
+}
+
+func (r *pgRepository) listRelatives(ctx context.Context, id string, maxDepth, limit int, originColumn, relativeColumn string) ([]*model.TenantTreeNode, error) {
+	rawStmt := `WITH RECURSIVE relatives AS
+					(SELECT tp1.{{ .relativeColumn }} AS id, 1 AS depth
+					 FROM {{ .tenantParentsTable }} tp1
+					 WHERE tp1.{{ .originColumn }} = ?
+					 UNION
+					 SELECT tp2.{{ .relativeColumn }}, r.depth + 1
+					 FROM {{ .tenantParentsTable }} tp2 JOIN relatives r ON tp2.{{ .originColumn }} = r.id
+					 WHERE r.depth < ?)
+				SELECT {{ .columns }}, MIN(r.depth) AS depth
+				FROM relatives r JOIN {{ .tenantsTable }} t ON t.{{ .id }} = r.id
+				GROUP BY {{ .columns }}
+				ORDER BY depth, t.{{ .externalTenant }}
+				LIMIT ?`
+
+	t, err := template.New("").Parse(rawStmt)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]string, 0, len(insertColumns))
+	for _, column := range insertColumns {
+		columns = append(columns, prefixWithTableName("t", column))
+	}
+
+	data := map[string]string{
+		"tenantParentsTable": tenantparentmapping.TenantParentsTable,
+		"originColumn":       originColumn,
+		"relativeColumn":     relativeColumn,
+		"tenantsTable":       TableName,
+		"id":                 IDColumn,
+		"externalTenant":     ExternalTenantColumn,
+		"columns":            strings.Join(columns, ", "),
+	}
+
+	res := new(bytes.Buffer)
+	if err = t.Execute(res, data); err != nil {
+		return nil, errors.Wrapf(err, "while executing template")
+	}
+
+	stmt := res.String()
+	stmt = sqlx.Rebind(sqlx.DOLLAR, stmt)
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+
+	var dest []treeNodeEntity
+	if err := persist.SelectContext(ctx, &dest, stmt, id, maxDepth, limit); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.Tenant, resource.List, "while listing relatives of tenant with ID %s", id)
+	}
+
+	if len(dest) == 0 {
+		return []*model.TenantTreeNode{}, nil
+	}
+
+	ids := make([]string, 0, len(dest))
+	for i := range dest {
+		ids = append(ids, dest[i].ID)
+	}
+
+	parents, err := r.tenantParentRepo.ListParentsByTenantIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing parent tenants of the relatives of tenant with ID %s", id)
+	}
+
+	nodes := make([]*model.TenantTreeNode, 0, len(dest))
+	for i := range dest {
+		btm := r.conv.FromEntity(&dest[i].Entity)
+		btm.Parents = parents[btm.ID]
+		if btm.Parents == nil {
+			btm.Parents = []string{}
+		}
+		nodes = append(nodes, &model.TenantTreeNode{
+			Tenant: btm,
+			Depth:  dest[i].Depth,
+		})
+	}
+
+	return nodes, nil
+}
+
 func (r *pgRepository) retrieveOwningResources(ctx context.Context, m2mTable, tenantID string) ([]string, error) {
 	rawStmt := `SELECT DISTINCT ta1.{{ .m2mID }}
 				FROM {{ .m2mTable }} ta1
@@ -803,6 +1155,70 @@ func (r *pgRepository) enrichManyWithParents(ctx context.Context, entityCollecti
 	return btms, nil
 }
 
+type treeNodeEntity struct {
+	tenant.Entity
+	Depth int `db:"depth"`
+}
+
+type tenantAccessGrantEntity struct {
+	TenantID     string         `db:"tenant_id"`
+	ResourceType string         `db:"resource_type"`
+	ResourceID   string         `db:"resource_id"`
+	GrantedBy    sql.NullString `db:"granted_by"`
+	Reason       sql.NullString `db:"reason"`
+	CreatedAt    time.Time      `db:"created_at"`
+	ExpiresAt    sql.NullTime   `db:"expires_at"`
+}
+
+type tenantAccessEntity struct {
+	TenantID         string         `db:"tenant_id"`
+	ExternalTenantID string         `db:"external_tenant"`
+	ResourceType     string         `db:"resource_type"`
+	ResourceID       string         `db:"id"`
+	Owner            bool           `db:"owner"`
+	GrantedBy        sql.NullString `db:"granted_by"`
+	Reason           sql.NullString `db:"reason"`
+	CreatedAt        sql.NullTime   `db:"created_at"`
+	ExpiresAt        sql.NullTime   `db:"expires_at"`
+}
+
+func (e tenantAccessEntity) toModel() *model.TenantAccess {
+	return &model.TenantAccess{
+		ExternalTenantID: e.ExternalTenantID,
+		InternalTenantID: e.TenantID,
+		ResourceType:     resource.Type(e.ResourceType),
+		ResourceID:       e.ResourceID,
+		Owner:            e.Owner,
+		GrantedBy:        repo.StringPtrFromNullableString(e.GrantedBy),
+		Reason:           repo.StringPtrFromNullableString(e.Reason),
+		CreatedAt:        timePtrFromNullableTime(e.CreatedAt),
+		ExpiresAt:        timePtrFromNullableTime(e.ExpiresAt),
+	}
+}
+
+func newNullableTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func timePtrFromNullableTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
+
+type ownedResourcesEntity struct {
+	TenantID     string `db:"tenant_id"`
+	Applications int    `db:"applications"`
+	Runtimes     int    `db:"runtimes"`
+	Formations   int    `db:"formations"`
+}
+
 func buildTenantsByParentAndTypeQuery() string {
 	resultColumns := make([]string, 0, len(insertColumns))
 	for _, column := range insertColumns {
@@ -3266,6 +3266,314 @@ func TestPgRepository_GetParentRecursivelyByExternalTenant(t *testing.T) {
 	})
 }
 
+func TestPgRepository_ListAncestors(t *testing.T) {
+	dbQuery := `WITH RECURSIVE relatives AS
+					(SELECT tp1.parent_id AS id, 1 AS depth
+					 FROM tenant_parents tp1
+					 WHERE tp1.tenant_id = $1
+					 UNION
+					 SELECT tp2.parent_id, r.depth + 1
+					 FROM tenant_parents tp2 JOIN relatives r ON tp2.tenant_id = r.id
+					 WHERE r.depth < $2)
+				SELECT t.id, t.external_name, t.external_tenant, t.type, t.provider_name, t.status, MIN(r.depth) AS depth
+				FROM relatives r JOIN public.business_tenant_mappings t ON t.id = r.id
+				GROUP BY t.id, t.external_name, t.external_tenant, t.type, t.provider_name, t.status
+				ORDER BY depth, t.external_tenant
+				LIMIT $3`
+
+	parentEntity := newEntityBusinessTenantMappingWithExternalID(testParentID, testParentID, testName)
+	parentModel := newModelBusinessTenantMappingWithTypeAndExternalID(testParentID, testParentID, testName, []string{}, nil, tenantEntity.Account)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rowsToReturn := sqlmock.NewRows([]string{"id", "external_name", "external_tenant", "type", "provider_name", "status", "depth"}).
+			AddRow(testParentID, testName, testParentID, tenantEntity.TypeToStr(tenantEntity.Account), testProvider, tenantEntity.Active, 1)
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testID, 2, 10).
+			WillReturnRows(rowsToReturn)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT tenant_id, parent_id FROM tenant_parents WHERE tenant_id IN ($1)`)).
+			WithArgs(testParentID).
+			WillReturnRows(fixSQLTenantParentsRows([]sqlTenantParentsRow{}))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", parentEntity).Return(parentModel).Once()
+		tenantMappingRepo := tenant.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListAncestors(ctx, testID, 2, 10)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.TenantTreeNode{{Tenant: parentModel, Depth: 1}}, result)
+	})
+
+	t.Run("Error when listing parents of an ancestor", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rowsToReturn := sqlmock.NewRows([]string{"id", "external_name", "external_tenant", "type", "provider_name", "status", "depth"}).
+			AddRow(testParentID, testName, testParentID, tenantEntity.TypeToStr(tenantEntity.Account), testProvider, tenantEntity.Active, 1)
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testID, 2, 10).
+			WillReturnRows(rowsToReturn)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT tenant_id, parent_id FROM tenant_parents WHERE tenant_id IN ($1)`)).
+			WithArgs(testParentID).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListAncestors(ctx, testID, 2, 10)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), fmt.Sprintf("while listing parent tenants of the relatives of tenant with ID %s", testID))
+		require.Nil(t, result)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testID, 2, 10).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListAncestors(ctx, testID, 2, 10)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+	})
+
+	t.Run("Error if missing persistence context", func(t *testing.T) {
+		// GIVEN
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		_, err := tenantMappingRepo.ListAncestors(context.TODO(), testID, 2, 10)
+
+		// THEN
+		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
+	})
+}
+
+func TestPgRepository_ListDescendants(t *testing.T) {
+	dbQuery := `WITH RECURSIVE relatives AS
+					(SELECT tp1.tenant_id AS id, 1 AS depth
+					 FROM tenant_parents tp1
+					 WHERE tp1.parent_id = $1
+					 UNION
+					 SELECT tp2.tenant_id, r.depth + 1
+					 FROM tenant_parents tp2 JOIN relatives r ON tp2.parent_id = r.id
+					 WHERE r.depth < $2)
+				SELECT t.id, t.external_name, t.external_tenant, t.type, t.provider_name, t.status, MIN(r.depth) AS depth
+				FROM relatives r JOIN public.business_tenant_mappings t ON t.id = r.id
+				GROUP BY t.id, t.external_name, t.external_tenant, t.type, t.provider_name, t.status
+				ORDER BY depth, t.external_tenant
+				LIMIT $3`
+
+	childEntity := newEntityBusinessTenantMappingWithExternalID(testID, testExternal, testName)
+	childModel := newModelBusinessTenantMapping(testID, testName, []string{testParentID})
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rowsToReturn := sqlmock.NewRows([]string{"id", "external_name", "external_tenant", "type", "provider_name", "status", "depth"}).
+			AddRow(testID, testName, testExternal, tenantEntity.TypeToStr(tenantEntity.Account), testProvider, tenantEntity.Active, 1)
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testParentID, 3, 10).
+			WillReturnRows(rowsToReturn)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT tenant_id, parent_id FROM tenant_parents WHERE tenant_id IN ($1)`)).
+			WithArgs(testID).
+			WillReturnRows(fixSQLTenantParentsRows([]sqlTenantParentsRow{{tenantID: testID, parentID: testParentID}}))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", childEntity).Return(newModelBusinessTenantMapping(testID, testName, []string{})).Once()
+		tenantMappingRepo := tenant.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListDescendants(ctx, testParentID, 3, 10)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.TenantTreeNode{{Tenant: childModel, Depth: 1}}, result)
+	})
+
+	t.Run("Success for multiple descendants with a single query for their parents", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		grandchildID := "grandchild-id"
+		grandchildEntity := newEntityBusinessTenantMappingWithExternalID(grandchildID, grandchildID, testName)
+
+		rowsToReturn := sqlmock.NewRows([]string{"id", "external_name", "external_tenant", "type", "provider_name", "status", "depth"}).
+			AddRow(testID, testName, testExternal, tenantEntity.TypeToStr(tenantEntity.Account), testProvider, tenantEntity.Active, 1).
+			AddRow(grandchildID, testName, grandchildID, tenantEntity.TypeToStr(tenantEntity.Account), testProvider, tenantEntity.Active, 2)
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testParentID, 3, 10).
+			WillReturnRows(rowsToReturn)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT tenant_id, parent_id FROM tenant_parents WHERE tenant_id IN ($1, $2)`)).
+			WithArgs(testID, grandchildID).
+			WillReturnRows(fixSQLTenantParentsRows([]sqlTenantParentsRow{{tenantID: testID, parentID: testParentID}, {tenantID: grandchildID, parentID: testID}}))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", childEntity).Return(newModelBusinessTenantMapping(testID, testName, []string{})).Once()
+		mockConverter.On("FromEntity", grandchildEntity).Return(newModelBusinessTenantMapping(grandchildID, testName, []string{})).Once()
+		tenantMappingRepo := tenant.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListDescendants(ctx, testParentID, 3, 10)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.TenantTreeNode{
+			{Tenant: childModel, Depth: 1},
+			{Tenant: newModelBusinessTenantMapping(grandchildID, testName, []string{testID}), Depth: 2},
+		}, result)
+	})
+
+	t.Run("Success when there are no descendants", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testParentID, 3, 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "external_name", "external_tenant", "type", "provider_name", "status", "depth"}))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListDescendants(ctx, testParentID, 3, 10)
+
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testParentID, 3, 10).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListDescendants(ctx, testParentID, 3, 10)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+	})
+}
+
+func TestPgRepository_CountOwnedResources(t *testing.T) {
+	dbQuery := `SELECT t.id AS tenant_id,
+					(SELECT COUNT(*) FROM tenant_applications ta WHERE ta.tenant_id = t.id AND ta.owner = true) AS applications,
+					(SELECT COUNT(*) FROM tenant_runtimes tr WHERE tr.tenant_id = t.id AND tr.owner = true) AS runtimes,
+					(SELECT COUNT(*) FROM formations f WHERE f.tenant_id = t.id) AS formations
+				FROM public.business_tenant_mappings t
+				WHERE t.id IN ($1, $2)`
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rowsToReturn := sqlmock.NewRows([]string{"tenant_id", "applications", "runtimes", "formations"}).
+			AddRow(testID, 2, 1, 0).
+			AddRow(testParentID, 5, 3, 1)
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testID, testParentID).
+			WillReturnRows(rowsToReturn)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.CountOwnedResources(ctx, []string{testID, testParentID})
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, map[string]*model.TenantOwnedResources{
+			testID:       {Applications: 2, Runtimes: 1, Formations: 0},
+			testParentID: {Applications: 5, Runtimes: 3, Formations: 1},
+		}, result)
+	})
+
+	t.Run("Success when no IDs are provided", func(t *testing.T) {
+		// GIVEN
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.CountOwnedResources(context.TODO(), []string{})
+
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testID, testParentID).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.CountOwnedResources(ctx, []string{testID, testParentID})
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+	})
+
+	t.Run("Error if missing persistence context", func(t *testing.T) {
+		// GIVEN
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		_, err := tenantMappingRepo.CountOwnedResources(context.TODO(), []string{testID})
+
+		// THEN
+		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
+	})
+}
+
 const selectTenantsQuery = `(SELECT tenant_id FROM tenant_runtimes ta WHERE ta.id = $1 AND ta.owner = true AND (NOT EXISTS(SELECT 1 FROM public.business_tenant_mappings JOIN tenant_parents ON public.business_tenant_mappings.id = tenant_parents.tenant_id WHERE parent_id = ta.tenant_id) OR (NOT EXISTS(SELECT 1 FROM tenant_runtimes ta2 WHERE ta2.id = $2 AND ta2.owner = true AND ta2.tenant_id IN (SELECT id FROM public.business_tenant_mappings JOIN tenant_parents ON public.business_tenant_mappings.id = tenant_parents.tenant_id WHERE parent_id = ta.tenant_id)))))`
 
 func mockDBSuccess(t *testing.T, runtimeID string) (*sqlx.DB, testdb.DBMock) {
@@ -3287,3 +3595,295 @@ func mockDBError(t *testing.T, runtimeID string) (*sqlx.DB, testdb.DBMock) {
 func id() string {
 	return uuid.New().String()
 }
+
+func TestPgRepository_UpsertTenantAccessGrant(t *testing.T) {
+	dbQuery := `INSERT INTO tenant_access_grants ( tenant_id, resource_type, resource_id, granted_by, reason, created_at, expires_at )
+				VALUES ( ?, ?, ?, ?, ?, ?, ? )
+				ON CONFLICT ( tenant_id, resource_type, resource_id ) DO UPDATE SET granted_by=EXCLUDED.granted_by, reason=EXCLUDED.reason, created_at=EXCLUDED.created_at, expires_at=EXCLUDED.expires_at`
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testInternal, string(resource.Application), testID, testConsumerID, testReason, testTime, testExpiresAt).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		err := tenantMappingRepo.UpsertTenantAccessGrant(ctx, fixTenantAccessModelWithGrant(testInternal, testConsumerID, &testTime))
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(dbQuery)).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		err := tenantMappingRepo.UpsertTenantAccessGrant(ctx, fixTenantAccessModelWithGrant(testInternal, testConsumerID, &testTime))
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+
+	t.Run("Error when tenant access is nil", func(t *testing.T) {
+		// GIVEN
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		err := tenantMappingRepo.UpsertTenantAccessGrant(context.TODO(), nil)
+
+		// THEN
+		require.EqualError(t, err, apperrors.NewInternalError("tenant access cannot be nil").Error())
+	})
+
+	t.Run("Error if missing persistence context", func(t *testing.T) {
+		// GIVEN
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		err := tenantMappingRepo.UpsertTenantAccessGrant(context.TODO(), fixTenantAccessModelWithGrant(testInternal, testConsumerID, &testTime))
+
+		// THEN
+		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
+	})
+}
+
+func TestPgRepository_DeleteTenantAccessGrant(t *testing.T) {
+	dbQuery := `DELETE FROM tenant_access_grants WHERE tenant_id = $1 AND resource_type = $2 AND resource_id = $3`
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testInternal, string(resource.Application), testID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		err := tenantMappingRepo.DeleteTenantAccessGrant(ctx, testInternal, testID, resource.Application)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testInternal, string(resource.Application), testID).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		err := tenantMappingRepo.DeleteTenantAccessGrant(ctx, testInternal, testID, resource.Application)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestPgRepository_ListTenantAccessesForResource(t *testing.T) {
+	dbQuery := fixListTenantAccessesQuery("tenant_applications", resource.Application, "id", 1) + ` ORDER BY t.external_tenant`
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testID).
+			WillReturnRows(sqlmock.NewRows(tenantAccessWithGrantTableColumns).
+				AddRow(testInternal, testExternal, string(resource.Application), testID, true, testConsumerID, testReason, testTime, testExpiresAt).
+				AddRow(testParentID, testParent2External, string(resource.Application), testID, false, nil, nil, nil, nil))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListTenantAccessesForResource(ctx, resource.Application, testID)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.TenantAccess{
+			fixTenantAccessModelFromGrantRow(testInternal, testExternal, true, true),
+			fixTenantAccessModelFromGrantRow(testParentID, testParent2External, false, false),
+		}, result)
+	})
+
+	t.Run("Error when resource type does not have access table", func(t *testing.T) {
+		// GIVEN
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListTenantAccessesForResource(context.TODO(), resource.Tenant, testID)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "does not have access table")
+		require.Nil(t, result)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testID).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListTenantAccessesForResource(ctx, resource.Application, testID)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+	})
+
+	t.Run("Error if missing persistence context", func(t *testing.T) {
+		// GIVEN
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		_, err := tenantMappingRepo.ListTenantAccessesForResource(context.TODO(), resource.Application, testID)
+
+		// THEN
+		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
+	})
+}
+
+func TestPgRepository_ListTenantAccessesForTenant(t *testing.T) {
+	dbQuery := "(" + fixListTenantAccessesQuery("tenant_applications", resource.Application, "tenant_id", 1) + ") UNION ALL (" +
+		fixListTenantAccessesQuery("tenant_runtimes", resource.Runtime, "tenant_id", 2) + ") UNION ALL (" +
+		fixListTenantAccessesQuery("tenant_runtime_contexts", resource.RuntimeContext, "tenant_id", 3) + ") ORDER BY resource_type, id"
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testInternal, testInternal, testInternal).
+			WillReturnRows(sqlmock.NewRows(tenantAccessWithGrantTableColumns).
+				AddRow(testInternal, testExternal, string(resource.Application), testID, true, testConsumerID, testReason, testTime, testExpiresAt))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListTenantAccessesForTenant(ctx, testInternal)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.TenantAccess{fixTenantAccessModelFromGrantRow(testInternal, testExternal, true, true)}, result)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testInternal, testInternal, testInternal).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListTenantAccessesForTenant(ctx, testInternal)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+	})
+}
+
+func TestPgRepository_ListExpiredTenantAccessGrants(t *testing.T) {
+	dbQuery := `SELECT g.tenant_id, t.external_tenant, g.resource_type, g.resource_id AS id, false AS owner,
+					g.granted_by, g.reason, g.created_at, g.expires_at
+				FROM tenant_access_grants g JOIN public.business_tenant_mappings t ON t.id = g.tenant_id
+				WHERE g.expires_at IS NOT NULL AND g.expires_at <= $1
+				ORDER BY g.expires_at`
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testExpiresAt).
+			WillReturnRows(sqlmock.NewRows(tenantAccessWithGrantTableColumns).
+				AddRow(testInternal, testExternal, string(resource.Application), testID, false, testConsumerID, testReason, testTime, testExpiresAt))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListExpiredTenantAccessGrants(ctx, testExpiresAt)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.TenantAccess{fixTenantAccessModelFromGrantRow(testInternal, testExternal, false, true)}, result)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(dbQuery)).
+			WithArgs(testExpiresAt).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		result, err := tenantMappingRepo.ListExpiredTenantAccessGrants(ctx, testExpiresAt)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+	})
+
+	t.Run("Error if missing persistence context", func(t *testing.T) {
+		// GIVEN
+		tenantMappingRepo := tenant.NewRepository(nil)
+
+		// WHEN
+		_, err := tenantMappingRepo.ListExpiredTenantAccessGrants(context.TODO(), testExpiresAt)
+
+		// THEN
+		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
+	})
+}
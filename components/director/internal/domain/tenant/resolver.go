@@ -21,6 +21,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// defaultTenantTreeDepth is the number of hierarchy levels returned by the tenantTree query when no depth is requested.
+const defaultTenantTreeDepth = 3
+
 // BusinessTenantMappingService is responsible for the service-layer tenant operations.
 //
 //go:generate mockery --name=BusinessTenantMappingService --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -39,8 +42,14 @@ type BusinessTenantMappingService interface {
 	CreateTenantAccessForResourceRecursively(ctx context.Context, tenantAccess *model.TenantAccess) error
 	DeleteTenantAccessForResourceRecursively(ctx context.Context, tenantAccess *model.TenantAccess) error
 	GetTenantAccessForResource(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) (*model.TenantAccess, error)
+	DirectTenantAccessExists(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) (bool, error)
 	GetParentsRecursivelyByExternalTenant(ctx context.Context, externalTenant string) ([]*model.BusinessTenantMapping, error)
 	UpsertLabel(ctx context.Context, tenantID, key string, value interface{}) error
+	GetTenantTree(ctx context.Context, externalTenant string, depth int, direction model.TenantTreeDirection) (*model.TenantTree, error)
+	UpsertTenantAccessGrant(ctx context.Context, tenantAccess *model.TenantAccess) error
+	DeleteTenantAccessGrant(ctx context.Context, tenantAccess *model.TenantAccess) error
+	ListTenantAccessesForResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.TenantAccess, error)
+	ListTenantAccessesForTenant(ctx context.Context, tenantID string) ([]*model.TenantAccess, error)
 }
 
 // BusinessTenantMappingConverter is used to convert the internally used tenant representation model.BusinessTenantMapping
@@ -54,8 +63,10 @@ type BusinessTenantMappingConverter interface {
 	ToGraphQL(in *model.BusinessTenantMapping) *graphql.Tenant
 	TenantAccessInputFromGraphQL(in graphql.TenantAccessInput) (*model.TenantAccess, error)
 	TenantAccessToGraphQL(in *model.TenantAccess) (*graphql.TenantAccess, error)
+	MultipleTenantAccessesToGraphQL(in []*model.TenantAccess) ([]*graphql.TenantAccess, error)
 	TenantAccessToEntity(in *model.TenantAccess) *repo.TenantAccess
 	TenantAccessFromEntity(in *repo.TenantAccess) *model.TenantAccess
+	TenantTreeToGraphQL(in *model.TenantTree) *graphql.TenantTree
 }
 
 // Resolver is the resolver responsible for tenant-related GraphQL requests.
@@ -222,6 +233,40 @@ func (r *Resolver) RootTenants(ctx context.Context, externalTenant string) ([]*g
 	return r.conv.MultipleToGraphQL(result), nil
 }
 
+// TenantTree transactionally retrieves the hierarchy around the tenant with the given external ID
+// together with the resources owned by each tenant in it.
+func (r *Resolver) TenantTree(ctx context.Context, externalTenant string, depth *int, direction *graphql.TenantTreeDirection) (*graphql.TenantTree, error) {
+	treeDepth := defaultTenantTreeDepth
+	if depth != nil {
+		treeDepth = *depth
+	}
+
+	treeDirection := model.TenantTreeDirectionBoth
+	if direction != nil {
+		treeDirection = model.TenantTreeDirection(*direction)
+	}
+
+	log.C(ctx).Infof("Getting tenant tree with depth %d and direction %s for external tenant %q", treeDepth, treeDirection, externalTenant)
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	tree, err := r.srv.GetTenantTree(ctx, externalTenant, treeDepth, treeDirection)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while fetching tenant tree for external tenant %q", externalTenant)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.TenantTreeToGraphQL(tree), nil
+}
+
 // Labels transactionally retrieves all existing labels of the given tenant if it exists.
 func (r *Resolver) Labels(ctx context.Context, obj *graphql.Tenant, key *string) (graphql.Labels, error) {
 	if obj == nil {
@@ -458,6 +503,22 @@ func (r *Resolver) AddTenantAccess(ctx context.Context, in graphql.TenantAccessI
 	tenantAccess.InternalTenantID = internalTenant
 	tenantAccess.Source = internalTenant
 
+	// The access is not created again if the tenant already has it. The grant is stored only for accesses created by this call,
+	// as otherwise the expiry of the grant would revoke an access, e.g. the owner access of the tenant, which was not granted with it.
+	accessExists, err := r.srv.DirectTenantAccessExists(ctx, tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while checking for existing tenant access for tenant %q about resource %q of type %q", tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
+	}
+
+	if accessExists {
+		if tenantAccess.ExpiresAt != nil {
+			return nil, apperrors.NewInvalidDataError("tenant %q already has access to resource %q of type %q, expiresAt can be set only for new accesses", in.TenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
+		}
+		tenantAccess.Reason = nil
+	} else if err := r.srv.UpsertTenantAccessGrant(ctx, tenantAccess); err != nil {
+		return nil, errors.Wrapf(err, "while storing grant of tenant access for tenant %q about resource %q of type %q", tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
+	}
+
 	if err := r.srv.CreateTenantAccessForResourceRecursively(ctx, tenantAccess); err != nil {
 		return nil, errors.Wrapf(err, "while creating tenant access record for tenant %q about resource %q of type %q", tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
 	}
@@ -467,6 +528,10 @@ func (r *Resolver) AddTenantAccess(ctx context.Context, in graphql.TenantAccessI
 		return nil, errors.Wrapf(err, "while fetching stored tenant access for tenant %q about resource %q of type %q", tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
 	}
 	storedTenantAccess.ExternalTenantID = tenantAccess.ExternalTenantID
+	storedTenantAccess.GrantedBy = tenantAccess.GrantedBy
+	storedTenantAccess.Reason = tenantAccess.Reason
+	storedTenantAccess.CreatedAt = tenantAccess.CreatedAt
+	storedTenantAccess.ExpiresAt = tenantAccess.ExpiresAt
 
 	output, err := r.conv.TenantAccessToGraphQL(storedTenantAccess)
 	if err != nil {
@@ -515,6 +580,10 @@ func (r *Resolver) RemoveTenantAccess(ctx context.Context, tenantID, resourceID
 		return nil, errors.Wrapf(err, "while deleting tenant access record for tenant %q about resource %q of type %q", tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
 	}
 
+	if err := r.srv.DeleteTenantAccessGrant(ctx, tenantAccess); err != nil {
+		return nil, errors.Wrapf(err, "while deleting grant of tenant access for tenant %q about resource %q of type %q", tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
+	}
+
 	output, err := r.conv.TenantAccessToGraphQL(tenantAccess)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while converting to graphql tenant access for tenant %q about resource %q of type %q", tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType)
@@ -529,6 +598,60 @@ func (r *Resolver) RemoveTenantAccess(ctx context.Context, tenantID, resourceID
 	return output, nil
 }
 
+// TenantAccesses returns the tenant accesses to the object with the given ID and type
+func (r *Resolver) TenantAccesses(ctx context.Context, objectID string, objectType graphql.TenantAccessObjectType) ([]*graphql.TenantAccess, error) {
+	resourceType, err := fromTenantAccessObjectTypeToResourceType(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	tenantAccesses, err := r.srv.ListTenantAccessesForResource(ctx, resourceType, objectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing tenant accesses for resource %q of type %q", objectID, resourceType)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleTenantAccessesToGraphQL(tenantAccesses)
+}
+
+// TenantAccessesForTenant returns the tenant accesses of the tenant with the given external ID
+func (r *Resolver) TenantAccessesForTenant(ctx context.Context, tenantID string) ([]*graphql.TenantAccess, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	internalTenantID, err := r.srv.GetInternalTenant(ctx, tenantID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting internal tenant for external tenant ID: %q", tenantID)
+	}
+
+	tenantAccesses, err := r.srv.ListTenantAccessesForTenant(ctx, internalTenantID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing tenant accesses for tenant %q", internalTenantID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleTenantAccessesToGraphQL(tenantAccesses)
+}
+
 func (r *Resolver) isSyncableTenant(tenantType tenantpkg.Type) bool {
 	return tenantType == tenantpkg.Account || tenantType == tenantpkg.Customer
 }
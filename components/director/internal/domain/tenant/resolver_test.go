@@ -1361,6 +1361,8 @@ func TestResolver_AddTenantAccess(t *testing.T) {
 			TenantSvcFn: func() *automock.BusinessTenantMappingService {
 				TenantSvc := &automock.BusinessTenantMappingService{}
 				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("DirectTenantAccessExists", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(false, nil).Once()
+				TenantSvc.On("UpsertTenantAccessGrant", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(nil).Once()
 				TenantSvc.On("CreateTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(nil).Once()
 				TenantSvc.On("GetTenantAccessForResource", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(tenantAccessModelWithoutExternalTenant, nil).Once()
 				return TenantSvc
@@ -1374,12 +1376,93 @@ func TestResolver_AddTenantAccess(t *testing.T) {
 			Input:          tenantAccessInput,
 			ExpectedResult: tenantAccessGQL,
 		},
+		{
+			Name: "Success with grant details",
+			TxFn: txGen.ThatSucceeds,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("DirectTenantAccessExists", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(false, nil).Once()
+				TenantSvc.On("UpsertTenantAccessGrant", txtest.CtxWithDBMatcher(), fixTenantAccessModelWithGrant(testInternal, "", nil)).Return(nil).Run(func(args mock.Arguments) {
+					tenantAccess := args.Get(1).(*model.TenantAccess)
+					tenantAccess.GrantedBy = str.Ptr(testConsumerID)
+					tenantAccess.CreatedAt = &testTime
+				}).Once()
+				TenantSvc.On("CreateTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), fixTenantAccessModelWithGrant(testInternal, testConsumerID, &testTime)).Return(nil).Once()
+				TenantSvc.On("GetTenantAccessForResource", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(&model.TenantAccess{InternalTenantID: testInternal, ResourceType: resource.Application, ResourceID: testID, Owner: true, Source: testInternal}, nil).Once()
+				return TenantSvc
+			},
+			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
+				conv := &automock.BusinessTenantMappingConverter{}
+				conv.On("TenantAccessInputFromGraphQL", tenantAccessInputWithGrant).Return(fixTenantAccessModelWithGrant("", "", nil), nil).Once()
+				conv.On("TenantAccessToGraphQL", fixTenantAccessModelWithGrant(testInternal, testConsumerID, &testTime)).Return(tenantAccessGQL, nil).Once()
+				return conv
+			},
+			Input:          tenantAccessInputWithGrant,
+			ExpectedResult: tenantAccessGQL,
+		},
+		{
+			Name: "Success without storing grant when the tenant already has the access",
+			TxFn: txGen.ThatSucceeds,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("DirectTenantAccessExists", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(true, nil).Once()
+				TenantSvc.On("CreateTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(nil).Once()
+				TenantSvc.On("GetTenantAccessForResource", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(tenantAccessModelWithoutExternalTenant, nil).Once()
+				return TenantSvc
+			},
+			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
+				conv := &automock.BusinessTenantMappingConverter{}
+				conv.On("TenantAccessInputFromGraphQL", tenantAccessInput).Return(tenantAccessWithoutInternalTenantModel, nil).Once()
+				conv.On("TenantAccessToGraphQL", tenantAccessModel).Return(tenantAccessGQL, nil).Once()
+				return conv
+			},
+			Input:          tenantAccessInput,
+			ExpectedResult: tenantAccessGQL,
+		},
+		{
+			Name: "Error when expiresAt is set and the tenant already has the access",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("DirectTenantAccessExists", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(true, nil).Once()
+				return TenantSvc
+			},
+			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
+				conv := &automock.BusinessTenantMappingConverter{}
+				conv.On("TenantAccessInputFromGraphQL", tenantAccessInputWithGrant).Return(fixTenantAccessModelWithGrant("", "", nil), nil).Once()
+				return conv
+			},
+			Input:            tenantAccessInputWithGrant,
+			ExpectedErrorMsg: "expiresAt can be set only for new accesses",
+		},
+		{
+			Name: "Error when checking for existing tenant access",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("DirectTenantAccessExists", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(false, testError).Once()
+				return TenantSvc
+			},
+			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
+				conv := &automock.BusinessTenantMappingConverter{}
+				conv.On("TenantAccessInputFromGraphQL", tenantAccessInput).Return(tenantAccessWithoutInternalTenantModel, nil).Once()
+				return conv
+			},
+			Input:            tenantAccessInput,
+			ExpectedErrorMsg: "while checking for existing tenant access",
+		},
 		{
 			Name: "Error when committing transaction",
 			TxFn: txGen.ThatFailsOnCommit,
 			TenantSvcFn: func() *automock.BusinessTenantMappingService {
 				TenantSvc := &automock.BusinessTenantMappingService{}
 				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("DirectTenantAccessExists", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(false, nil).Once()
+				TenantSvc.On("UpsertTenantAccessGrant", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(nil).Once()
 				TenantSvc.On("CreateTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(nil).Once()
 				TenantSvc.On("GetTenantAccessForResource", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(tenantAccessModelWithoutExternalTenant, nil).Once()
 				return TenantSvc
@@ -1399,6 +1482,8 @@ func TestResolver_AddTenantAccess(t *testing.T) {
 			TenantSvcFn: func() *automock.BusinessTenantMappingService {
 				TenantSvc := &automock.BusinessTenantMappingService{}
 				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("DirectTenantAccessExists", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(false, nil).Once()
+				TenantSvc.On("UpsertTenantAccessGrant", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(nil).Once()
 				TenantSvc.On("CreateTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(nil).Once()
 				TenantSvc.On("GetTenantAccessForResource", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(tenantAccessModelWithoutExternalTenant, nil).Once()
 				return TenantSvc
@@ -1418,6 +1503,8 @@ func TestResolver_AddTenantAccess(t *testing.T) {
 			TenantSvcFn: func() *automock.BusinessTenantMappingService {
 				TenantSvc := &automock.BusinessTenantMappingService{}
 				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("DirectTenantAccessExists", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(false, nil).Once()
+				TenantSvc.On("UpsertTenantAccessGrant", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(nil).Once()
 				TenantSvc.On("CreateTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(nil).Once()
 				TenantSvc.On("GetTenantAccessForResource", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(nil, testError).Once()
 				return TenantSvc
@@ -1436,6 +1523,8 @@ func TestResolver_AddTenantAccess(t *testing.T) {
 			TenantSvcFn: func() *automock.BusinessTenantMappingService {
 				TenantSvc := &automock.BusinessTenantMappingService{}
 				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("DirectTenantAccessExists", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(false, nil).Once()
+				TenantSvc.On("UpsertTenantAccessGrant", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(nil).Once()
 				TenantSvc.On("CreateTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(testError).Once()
 				return TenantSvc
 			},
@@ -1447,6 +1536,24 @@ func TestResolver_AddTenantAccess(t *testing.T) {
 			Input:            tenantAccessInput,
 			ExpectedErrorMsg: "while creating tenant access record",
 		},
+		{
+			Name: "Error when storing tenant access grant",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("DirectTenantAccessExists", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(false, nil).Once()
+				TenantSvc.On("UpsertTenantAccessGrant", txtest.CtxWithDBMatcher(), tenantAccessModelWithSource).Return(testError).Once()
+				return TenantSvc
+			},
+			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
+				conv := &automock.BusinessTenantMappingConverter{}
+				conv.On("TenantAccessInputFromGraphQL", tenantAccessInput).Return(tenantAccessWithoutInternalTenantModel, nil).Once()
+				return conv
+			},
+			Input:            tenantAccessInput,
+			ExpectedErrorMsg: "while storing grant of tenant access",
+		},
 		{
 			Name: "Error when getting internal tenant",
 			TxFn: txGen.ThatDoesntExpectCommit,
@@ -1534,6 +1641,7 @@ func TestResolver_RemoveTenantAccess(t *testing.T) {
 				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
 				TenantSvc.On("GetTenantAccessForResource", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(tenantAccessModelWithoutExternalTenant, nil).Once()
 				TenantSvc.On("DeleteTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(nil).Once()
+				TenantSvc.On("DeleteTenantAccessGrant", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(nil).Once()
 				return TenantSvc
 			},
 			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
@@ -1552,6 +1660,7 @@ func TestResolver_RemoveTenantAccess(t *testing.T) {
 				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
 				TenantSvc.On("GetTenantAccessForResource", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(tenantAccessModelWithoutExternalTenant, nil).Once()
 				TenantSvc.On("DeleteTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(nil).Once()
+				TenantSvc.On("DeleteTenantAccessGrant", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(nil).Once()
 				return TenantSvc
 			},
 			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
@@ -1570,6 +1679,7 @@ func TestResolver_RemoveTenantAccess(t *testing.T) {
 				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
 				TenantSvc.On("GetTenantAccessForResource", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(tenantAccessModelWithoutExternalTenant, nil).Once()
 				TenantSvc.On("DeleteTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(nil).Once()
+				TenantSvc.On("DeleteTenantAccessGrant", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(nil).Once()
 				return TenantSvc
 			},
 			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
@@ -1580,6 +1690,20 @@ func TestResolver_RemoveTenantAccess(t *testing.T) {
 			Input:            tenantAccessInput,
 			ExpectedErrorMsg: "while converting to graphql tenant access",
 		},
+		{
+			Name: "Error when deleting tenant access grant",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("GetTenantAccessForResource", txtest.CtxWithDBMatcher(), testInternal, testID, resource.Application).Return(tenantAccessModelWithoutExternalTenant, nil).Once()
+				TenantSvc.On("DeleteTenantAccessForResourceRecursively", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(nil).Once()
+				TenantSvc.On("DeleteTenantAccessGrant", txtest.CtxWithDBMatcher(), tenantAccessModel).Return(testError).Once()
+				return TenantSvc
+			},
+			Input:            tenantAccessInput,
+			ExpectedErrorMsg: "while deleting grant of tenant access",
+		},
 		{
 			Name: "Error when deleting tenant access record",
 			TxFn: txGen.ThatDoesntExpectCommit,
@@ -1761,3 +1885,351 @@ func TestResolver_RootTenants(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_TenantTree(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	externalTenant := "external-tenant"
+	depth := 5
+	direction := graphql.TenantTreeDirectionAncestors
+
+	tenantTreeModel := &model.TenantTree{
+		Root:        &model.TenantTreeNode{Tenant: newModelBusinessTenantMapping(testID, testName, nil)},
+		Ancestors:   []*model.TenantTreeNode{},
+		Descendants: []*model.TenantTreeNode{},
+	}
+	tenantTreeGQL := &graphql.TenantTree{
+		Root:        &graphql.TenantTreeNode{Tenant: &graphql.Tenant{ID: testExternal, InternalID: testID}, OwnedResources: &graphql.TenantOwnedResources{}},
+		Ancestors:   []*graphql.TenantTreeNode{},
+		Descendants: []*graphql.TenantTreeNode{},
+	}
+
+	testCases := []struct {
+		Name              string
+		Depth             *int
+		Direction         *graphql.TenantTreeDirection
+		TxFn              func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		TenantConverterFn func() *automock.BusinessTenantMappingConverter
+		TenantSvcFn       func() *automock.BusinessTenantMappingService
+		ExpectedError     error
+	}{
+		{
+			Name:      "Success",
+			Depth:     &depth,
+			Direction: &direction,
+			TxFn:      txGen.ThatSucceeds,
+			TenantConverterFn: func() *automock.BusinessTenantMappingConverter {
+				converter := &automock.BusinessTenantMappingConverter{}
+				converter.On("TenantTreeToGraphQL", tenantTreeModel).Return(tenantTreeGQL).Once()
+				return converter
+			},
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetTenantTree", txtest.CtxWithDBMatcher(), externalTenant, depth, model.TenantTreeDirectionAncestors).Return(tenantTreeModel, nil).Once()
+				return TenantSvc
+			},
+		},
+		{
+			Name: "Success with default depth and direction",
+			TxFn: txGen.ThatSucceeds,
+			TenantConverterFn: func() *automock.BusinessTenantMappingConverter {
+				converter := &automock.BusinessTenantMappingConverter{}
+				converter.On("TenantTreeToGraphQL", tenantTreeModel).Return(tenantTreeGQL).Once()
+				return converter
+			},
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetTenantTree", txtest.CtxWithDBMatcher(), externalTenant, 3, model.TenantTreeDirectionBoth).Return(tenantTreeModel, nil).Once()
+				return TenantSvc
+			},
+		},
+		{
+			Name:          "That returns error when can not start transaction",
+			TxFn:          txGen.ThatFailsOnBegin,
+			ExpectedError: testError,
+		},
+		{
+			Name: "That returns error when can not get tenant tree",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetTenantTree", txtest.CtxWithDBMatcher(), externalTenant, 3, model.TenantTreeDirectionBoth).Return(nil, testError).Once()
+				return TenantSvc
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "That returns error when cannot commit",
+			TxFn: txGen.ThatFailsOnCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetTenantTree", txtest.CtxWithDBMatcher(), externalTenant, 3, model.TenantTreeDirectionBoth).Return(tenantTreeModel, nil).Once()
+				return TenantSvc
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tenantSvc := &automock.BusinessTenantMappingService{}
+			if testCase.TenantSvcFn != nil {
+				tenantSvc = testCase.TenantSvcFn()
+			}
+			tenantConverter := &automock.BusinessTenantMappingConverter{}
+			if testCase.TenantConverterFn != nil {
+				tenantConverter = testCase.TenantConverterFn()
+			}
+			persist, transact := testCase.TxFn()
+			resolver := tenant.NewResolver(transact, tenantSvc, tenantConverter, nil, sfapiclient.SystemFetcherSyncClientConfig{})
+
+			// WHEN
+			result, err := resolver.TenantTree(ctx, externalTenant, testCase.Depth, testCase.Direction)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tenantTreeGQL, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, tenantSvc, tenantConverter)
+		})
+	}
+}
+
+func TestResolver_TenantAccesses(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	tenantAccessModels := []*model.TenantAccess{tenantAccessModel}
+	tenantAccessGQLs := []*graphql.TenantAccess{tenantAccessGQL}
+
+	testCases := []struct {
+		Name             string
+		TxFn             func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		TenantSvcFn      func() *automock.BusinessTenantMappingService
+		TenantConvFn     func() *automock.BusinessTenantMappingConverter
+		ObjectType       graphql.TenantAccessObjectType
+		ExpectedErrorMsg string
+		ExpectedResult   []*graphql.TenantAccess
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("ListTenantAccessesForResource", txtest.CtxWithDBMatcher(), resource.Application, testID).Return(tenantAccessModels, nil).Once()
+				return TenantSvc
+			},
+			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
+				conv := &automock.BusinessTenantMappingConverter{}
+				conv.On("MultipleTenantAccessesToGraphQL", tenantAccessModels).Return(tenantAccessGQLs, nil).Once()
+				return conv
+			},
+			ObjectType:     graphql.TenantAccessObjectTypeApplication,
+			ExpectedResult: tenantAccessGQLs,
+		},
+		{
+			Name: "Error when converting to graphql",
+			TxFn: txGen.ThatSucceeds,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("ListTenantAccessesForResource", txtest.CtxWithDBMatcher(), resource.Application, testID).Return(tenantAccessModels, nil).Once()
+				return TenantSvc
+			},
+			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
+				conv := &automock.BusinessTenantMappingConverter{}
+				conv.On("MultipleTenantAccessesToGraphQL", tenantAccessModels).Return(nil, testError).Once()
+				return conv
+			},
+			ObjectType:       graphql.TenantAccessObjectTypeApplication,
+			ExpectedErrorMsg: testError.Error(),
+		},
+		{
+			Name: "Error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("ListTenantAccessesForResource", txtest.CtxWithDBMatcher(), resource.Application, testID).Return(tenantAccessModels, nil).Once()
+				return TenantSvc
+			},
+			ObjectType:       graphql.TenantAccessObjectTypeApplication,
+			ExpectedErrorMsg: testError.Error(),
+		},
+		{
+			Name: "Error when listing tenant accesses",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("ListTenantAccessesForResource", txtest.CtxWithDBMatcher(), resource.Application, testID).Return(nil, testError).Once()
+				return TenantSvc
+			},
+			ObjectType:       graphql.TenantAccessObjectTypeApplication,
+			ExpectedErrorMsg: "while listing tenant accesses for resource",
+		},
+		{
+			Name:             "Error when beginning transaction",
+			TxFn:             txGen.ThatFailsOnBegin,
+			ObjectType:       graphql.TenantAccessObjectTypeApplication,
+			ExpectedErrorMsg: testError.Error(),
+		},
+		{
+			Name:             "Error when object type is invalid",
+			TxFn:             txGen.ThatDoesntStartTransaction,
+			ObjectType:       graphql.TenantAccessObjectType(invalidResourceType),
+			ExpectedErrorMsg: fmt.Sprintf("Unknown tenant access resource type %q", invalidResourceType),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tenantSvc := unusedTenantService()
+			if testCase.TenantSvcFn != nil {
+				tenantSvc = testCase.TenantSvcFn()
+			}
+			tenantConv := unusedTenantConverter()
+			if testCase.TenantConvFn != nil {
+				tenantConv = testCase.TenantConvFn()
+			}
+			persist, transact := testCase.TxFn()
+			resolver := tenant.NewResolver(transact, tenantSvc, tenantConv, nil, sfapiclient.SystemFetcherSyncClientConfig{})
+
+			// WHEN
+			result, err := resolver.TenantAccesses(ctx, testID, testCase.ObjectType)
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, tenantSvc, tenantConv)
+		})
+	}
+}
+
+func TestResolver_TenantAccessesForTenant(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	tenantAccessModels := []*model.TenantAccess{tenantAccessModel}
+	tenantAccessGQLs := []*graphql.TenantAccess{tenantAccessGQL}
+
+	testCases := []struct {
+		Name             string
+		TxFn             func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		TenantSvcFn      func() *automock.BusinessTenantMappingService
+		TenantConvFn     func() *automock.BusinessTenantMappingConverter
+		ExpectedErrorMsg string
+		ExpectedResult   []*graphql.TenantAccess
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("ListTenantAccessesForTenant", txtest.CtxWithDBMatcher(), testInternal).Return(tenantAccessModels, nil).Once()
+				return TenantSvc
+			},
+			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
+				conv := &automock.BusinessTenantMappingConverter{}
+				conv.On("MultipleTenantAccessesToGraphQL", tenantAccessModels).Return(tenantAccessGQLs, nil).Once()
+				return conv
+			},
+			ExpectedResult: tenantAccessGQLs,
+		},
+		{
+			Name: "Error when converting to graphql",
+			TxFn: txGen.ThatSucceeds,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("ListTenantAccessesForTenant", txtest.CtxWithDBMatcher(), testInternal).Return(tenantAccessModels, nil).Once()
+				return TenantSvc
+			},
+			TenantConvFn: func() *automock.BusinessTenantMappingConverter {
+				conv := &automock.BusinessTenantMappingConverter{}
+				conv.On("MultipleTenantAccessesToGraphQL", tenantAccessModels).Return(nil, testError).Once()
+				return conv
+			},
+			ExpectedErrorMsg: testError.Error(),
+		},
+		{
+			Name: "Error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("ListTenantAccessesForTenant", txtest.CtxWithDBMatcher(), testInternal).Return(tenantAccessModels, nil).Once()
+				return TenantSvc
+			},
+			ExpectedErrorMsg: testError.Error(),
+		},
+		{
+			Name: "Error when listing tenant accesses",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return(testInternal, nil).Once()
+				TenantSvc.On("ListTenantAccessesForTenant", txtest.CtxWithDBMatcher(), testInternal).Return(nil, testError).Once()
+				return TenantSvc
+			},
+			ExpectedErrorMsg: "while listing tenant accesses for tenant",
+		},
+		{
+			Name: "Error when getting internal tenant",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			TenantSvcFn: func() *automock.BusinessTenantMappingService {
+				TenantSvc := &automock.BusinessTenantMappingService{}
+				TenantSvc.On("GetInternalTenant", txtest.CtxWithDBMatcher(), testExternal).Return("", testError).Once()
+				return TenantSvc
+			},
+			ExpectedErrorMsg: "while getting internal tenant",
+		},
+		{
+			Name:             "Error when beginning transaction",
+			TxFn:             txGen.ThatFailsOnBegin,
+			ExpectedErrorMsg: testError.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tenantSvc := unusedTenantService()
+			if testCase.TenantSvcFn != nil {
+				tenantSvc = testCase.TenantSvcFn()
+			}
+			tenantConv := unusedTenantConverter()
+			if testCase.TenantConvFn != nil {
+				tenantConv = testCase.TenantConvFn()
+			}
+			persist, transact := testCase.TxFn()
+			resolver := tenant.NewResolver(transact, tenantSvc, tenantConv, nil, sfapiclient.SystemFetcherSyncClientConfig{})
+
+			// WHEN
+			result, err := resolver.TenantAccessesForTenant(ctx, testExternal)
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, tenantSvc, tenantConv)
+		})
+	}
+}
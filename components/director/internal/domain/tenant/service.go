@@ -2,8 +2,10 @@ package tenant
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	tenantpkg "github.com/kyma-incubator/compass/components/director/pkg/tenant"
 	"k8s.io/utils/strings/slices"
@@ -28,6 +30,11 @@ const (
 	CostObjectIDLabelKey = "costObjectId"
 	// CostObjectTypeLabelKey is the key for cost object tenant type
 	CostObjectTypeLabelKey = "costObjectType"
+
+	// MaxTenantTreeDepth is the maximum number of hierarchy levels above and below a tenant which can be included in its tenant tree
+	MaxTenantTreeDepth = 10
+	// MaxTenantTreeNodes is the maximum number of ancestors, and separately of descendants, which can be included in a tenant tree
+	MaxTenantTreeNodes = 1000
 )
 
 // TenantMappingRepository is responsible for the repo-layer tenant operations.
@@ -51,6 +58,14 @@ type TenantMappingRepository interface {
 	ListByIds(ctx context.Context, ids []string) ([]*model.BusinessTenantMapping, error)
 	ListByIdsAndType(ctx context.Context, ids []string, tenantType tenantpkg.Type) ([]*model.BusinessTenantMapping, error)
 	GetParentsRecursivelyByExternalTenant(ctx context.Context, externalTenant string) ([]*model.BusinessTenantMapping, error)
+	ListAncestors(ctx context.Context, id string, maxDepth, limit int) ([]*model.TenantTreeNode, error)
+	ListDescendants(ctx context.Context, id string, maxDepth, limit int) ([]*model.TenantTreeNode, error)
+	CountOwnedResources(ctx context.Context, ids []string) (map[string]*model.TenantOwnedResources, error)
+	UpsertTenantAccessGrant(ctx context.Context, tenantAccess *model.TenantAccess) error
+	DeleteTenantAccessGrant(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) error
+	ListTenantAccessesForResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.TenantAccess, error)
+	ListTenantAccessesForTenant(ctx context.Context, tenantID string) ([]*model.TenantAccess, error)
+	ListExpiredTenantAccessGrants(ctx context.Context, expiredAt time.Time) ([]*model.TenantAccess, error)
 }
 
 // LabelUpsertService is responsible for creating, or updating already existing labels, and their label definitions.
@@ -226,6 +241,74 @@ func (s *service) GetParentsRecursivelyByExternalTenant(ctx context.Context, ext
 	return s.tenantMappingRepo.GetParentsRecursivelyByExternalTenant(ctx, externalTenant)
 }
 
+// GetTenantTree returns the tenant with the given external ID together with its ancestors and/or descendants up to the given depth.
+// At most MaxTenantTreeNodes of the closest ancestors and of the closest descendants are included, and the tree is marked as truncated when there are more.
+// Each tenant in the tree contains the number of applications, runtimes and formations it owns.
+func (s *service) GetTenantTree(ctx context.Context, externalTenant string, depth int, direction model.TenantTreeDirection) (*model.TenantTree, error) {
+	if depth < 1 || depth > MaxTenantTreeDepth {
+		return nil, apperrors.NewInvalidDataError("depth must be between 1 and %d", MaxTenantTreeDepth)
+	}
+
+	switch direction {
+	case model.TenantTreeDirectionAncestors, model.TenantTreeDirectionDescendants, model.TenantTreeDirectionBoth:
+	default:
+		return nil, apperrors.NewInvalidDataError("unsupported tenant tree direction %q", direction)
+	}
+
+	rootTenant, err := s.tenantMappingRepo.GetByExternalTenant(ctx, externalTenant)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting tenant with external ID %s", externalTenant)
+	}
+
+	tree := &model.TenantTree{
+		Root:        &model.TenantTreeNode{Tenant: rootTenant},
+		Ancestors:   []*model.TenantTreeNode{},
+		Descendants: []*model.TenantTreeNode{},
+	}
+
+	// One more tenant than the limit is requested in each direction to find out whether the tree is truncated
+	if direction != model.TenantTreeDirectionDescendants {
+		if tree.Ancestors, err = s.tenantMappingRepo.ListAncestors(ctx, rootTenant.ID, depth, MaxTenantTreeNodes+1); err != nil {
+			return nil, errors.Wrapf(err, "while listing ancestors of tenant with ID %s", rootTenant.ID)
+		}
+		if len(tree.Ancestors) > MaxTenantTreeNodes {
+			tree.Ancestors, tree.Truncated = tree.Ancestors[:MaxTenantTreeNodes], true
+		}
+	}
+
+	if direction != model.TenantTreeDirectionAncestors {
+		if tree.Descendants, err = s.tenantMappingRepo.ListDescendants(ctx, rootTenant.ID, depth, MaxTenantTreeNodes+1); err != nil {
+			return nil, errors.Wrapf(err, "while listing descendants of tenant with ID %s", rootTenant.ID)
+		}
+		if len(tree.Descendants) > MaxTenantTreeNodes {
+			tree.Descendants, tree.Truncated = tree.Descendants[:MaxTenantTreeNodes], true
+		}
+	}
+
+	nodes := make([]*model.TenantTreeNode, 0, 1+len(tree.Ancestors)+len(tree.Descendants))
+	nodes = append(nodes, tree.Root)
+	nodes = append(nodes, tree.Ancestors...)
+	nodes = append(nodes, tree.Descendants...)
+
+	ids := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, node.Tenant.ID)
+	}
+
+	ownedResources, err := s.tenantMappingRepo.CountOwnedResources(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while counting resources owned by the tenants in the tree of tenant with ID %s", rootTenant.ID)
+	}
+
+	for _, node := range nodes {
+		if counts, ok := ownedResources[node.Tenant.ID]; ok {
+			node.OwnedResources = *counts
+		}
+	}
+
+	return tree, nil
+}
+
 // CreateTenantAccessForResource creates a tenant access for a single resource.Type
 func (s *service) CreateTenantAccessForResource(ctx context.Context, tenantAccess *model.TenantAccess) error {
 	resourceType := tenantAccess.ResourceType
@@ -314,6 +397,76 @@ func (s *service) GetTenantAccessForResource(ctx context.Context, tenantID, reso
 	return tenantAccessModel, nil
 }
 
+// DirectTenantAccessExists checks whether the tenant has its own access to the resource, which is not inherited from its child tenants.
+func (s *service) DirectTenantAccessExists(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) (bool, error) {
+	m2mTable, ok := resourceType.TenantAccessTable()
+	if !ok {
+		return false, errors.Errorf("entity %q does not have access table", resourceType)
+	}
+
+	return repo.ExistsDirectTenantAccess(ctx, m2mTable, tenantID, resourceID)
+}
+
+// UpsertTenantAccessGrant records who granted the given tenant access, the reason for it and when it expires.
+func (s *service) UpsertTenantAccessGrant(ctx context.Context, tenantAccess *model.TenantAccess) error {
+	now := time.Now().UTC()
+	if tenantAccess.ExpiresAt != nil && !tenantAccess.ExpiresAt.After(now) {
+		return apperrors.NewInvalidDataError("expiresAt must be in the future")
+	}
+
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading consumer")
+	}
+
+	tenantAccess.GrantedBy = str.Ptr(consumerInfo.ConsumerID)
+	tenantAccess.CreatedAt = &now
+
+	if err := s.tenantMappingRepo.UpsertTenantAccessGrant(ctx, tenantAccess); err != nil {
+		return errors.Wrapf(err, "while storing grant of tenant access for resource type %q with ID %q for tenant %q", string(tenantAccess.ResourceType), tenantAccess.ResourceID, tenantAccess.InternalTenantID)
+	}
+
+	return nil
+}
+
+// DeleteTenantAccessGrant deletes the grant details of the given tenant access.
+func (s *service) DeleteTenantAccessGrant(ctx context.Context, tenantAccess *model.TenantAccess) error {
+	if err := s.tenantMappingRepo.DeleteTenantAccessGrant(ctx, tenantAccess.InternalTenantID, tenantAccess.ResourceID, tenantAccess.ResourceType); err != nil {
+		return errors.Wrapf(err, "while deleting grant of tenant access for resource type %q with ID %q for tenant %q", string(tenantAccess.ResourceType), tenantAccess.ResourceID, tenantAccess.InternalTenantID)
+	}
+
+	return nil
+}
+
+// ListTenantAccessesForResource lists the tenants that have access to the given resource.
+func (s *service) ListTenantAccessesForResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.TenantAccess, error) {
+	return s.tenantMappingRepo.ListTenantAccessesForResource(ctx, resourceType, resourceID)
+}
+
+// ListTenantAccessesForTenant lists the resources that the tenant with the given internal ID has access to.
+func (s *service) ListTenantAccessesForTenant(ctx context.Context, tenantID string) ([]*model.TenantAccess, error) {
+	return s.tenantMappingRepo.ListTenantAccessesForTenant(ctx, tenantID)
+}
+
+// ListExpiredTenantAccesses lists the tenant accesses whose grants have expired.
+func (s *service) ListExpiredTenantAccesses(ctx context.Context) ([]*model.TenantAccess, error) {
+	expiredTenantAccesses, err := s.tenantMappingRepo.ListExpiredTenantAccessGrants(ctx, time.Now().UTC())
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing expired tenant access grants")
+	}
+
+	return expiredTenantAccesses, nil
+}
+
+// RevokeTenantAccess deletes the given tenant access together with its grant.
+func (s *service) RevokeTenantAccess(ctx context.Context, tenantAccess *model.TenantAccess) error {
+	if err := s.DeleteTenantAccessForResourceRecursively(ctx, tenantAccess); err != nil {
+		return err
+	}
+
+	return s.DeleteTenantAccessGrant(ctx, tenantAccess)
+}
+
 // ListByParentAndType list tenants by parent ID and tenant.Type
 func (s *service) ListByParentAndType(ctx context.Context, parentID string, tenantType tenantpkg.Type) ([]*model.BusinessTenantMapping, error) {
 	return s.tenantMappingRepo.ListByParentAndType(ctx, parentID, tenantType)
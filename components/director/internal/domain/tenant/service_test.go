@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jmoiron/sqlx"
@@ -16,6 +17,9 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+
 	tenantEntity "github.com/kyma-incubator/compass/components/director/pkg/tenant"
 	"github.com/stretchr/testify/mock"
 
@@ -190,6 +194,202 @@ func TestService_GetParentsRecursivelyByExternalTenant(t *testing.T) {
 	}
 }
 
+func TestService_GetTenantTree(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), "test", "external-test")
+	rootModel := newModelBusinessTenantMapping(testID, testName, []string{testParentID})
+	parentModel := newModelBusinessTenantMappingWithTypeAndExternalID(testParentID, testParentID, testName, []string{}, nil, tenantEntity.Customer)
+	childModel := newModelBusinessTenantMappingWithTypeAndExternalID(testID2, testID2, testName, []string{testID}, nil, tenantEntity.Subaccount)
+
+	fixAncestors := func() []*model.TenantTreeNode {
+		return []*model.TenantTreeNode{{Tenant: parentModel, Depth: 1}}
+	}
+	fixDescendants := func() []*model.TenantTreeNode {
+		return []*model.TenantTreeNode{{Tenant: childModel, Depth: 1}}
+	}
+	fixManyDescendants := func(count int, withOwnedResources bool) []*model.TenantTreeNode {
+		nodes := make([]*model.TenantTreeNode, 0, count)
+		for i := 0; i < count; i++ {
+			node := &model.TenantTreeNode{Tenant: childModel, Depth: 1}
+			if withOwnedResources {
+				node.OwnedResources = model.TenantOwnedResources{Applications: 1}
+			}
+			nodes = append(nodes, node)
+		}
+		return nodes
+	}
+	ownedResources := map[string]*model.TenantOwnedResources{
+		testID:       {Applications: 2, Runtimes: 1},
+		testParentID: {Formations: 3},
+		testID2:      {Applications: 1},
+	}
+
+	testCases := []struct {
+		Name                string
+		Depth               int
+		Direction           model.TenantTreeDirection
+		TenantMappingRepoFn func() *automock.TenantMappingRepository
+		ExpectedError       error
+		ExpectedOutput      *model.TenantTree
+	}{
+		{
+			Name:      "Success in both directions",
+			Depth:     2,
+			Direction: model.TenantTreeDirectionBoth,
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				tenantMappingRepo := &automock.TenantMappingRepository{}
+				tenantMappingRepo.On("GetByExternalTenant", ctx, testExternal).Return(rootModel, nil).Once()
+				tenantMappingRepo.On("ListAncestors", ctx, testID, 2, tenant.MaxTenantTreeNodes+1).Return(fixAncestors(), nil).Once()
+				tenantMappingRepo.On("ListDescendants", ctx, testID, 2, tenant.MaxTenantTreeNodes+1).Return(fixDescendants(), nil).Once()
+				tenantMappingRepo.On("CountOwnedResources", ctx, []string{testID, testParentID, testID2}).Return(ownedResources, nil).Once()
+				return tenantMappingRepo
+			},
+			ExpectedOutput: &model.TenantTree{
+				Root:        &model.TenantTreeNode{Tenant: rootModel, OwnedResources: model.TenantOwnedResources{Applications: 2, Runtimes: 1}},
+				Ancestors:   []*model.TenantTreeNode{{Tenant: parentModel, Depth: 1, OwnedResources: model.TenantOwnedResources{Formations: 3}}},
+				Descendants: []*model.TenantTreeNode{{Tenant: childModel, Depth: 1, OwnedResources: model.TenantOwnedResources{Applications: 1}}},
+			},
+		},
+		{
+			Name:      "Success with ancestors only",
+			Depth:     1,
+			Direction: model.TenantTreeDirectionAncestors,
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				tenantMappingRepo := &automock.TenantMappingRepository{}
+				tenantMappingRepo.On("GetByExternalTenant", ctx, testExternal).Return(rootModel, nil).Once()
+				tenantMappingRepo.On("ListAncestors", ctx, testID, 1, tenant.MaxTenantTreeNodes+1).Return(fixAncestors(), nil).Once()
+				tenantMappingRepo.On("CountOwnedResources", ctx, []string{testID, testParentID}).Return(ownedResources, nil).Once()
+				return tenantMappingRepo
+			},
+			ExpectedOutput: &model.TenantTree{
+				Root:        &model.TenantTreeNode{Tenant: rootModel, OwnedResources: model.TenantOwnedResources{Applications: 2, Runtimes: 1}},
+				Ancestors:   []*model.TenantTreeNode{{Tenant: parentModel, Depth: 1, OwnedResources: model.TenantOwnedResources{Formations: 3}}},
+				Descendants: []*model.TenantTreeNode{},
+			},
+		},
+		{
+			Name:      "Success with descendants only",
+			Depth:     1,
+			Direction: model.TenantTreeDirectionDescendants,
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				tenantMappingRepo := &automock.TenantMappingRepository{}
+				tenantMappingRepo.On("GetByExternalTenant", ctx, testExternal).Return(rootModel, nil).Once()
+				tenantMappingRepo.On("ListDescendants", ctx, testID, 1, tenant.MaxTenantTreeNodes+1).Return(fixDescendants(), nil).Once()
+				tenantMappingRepo.On("CountOwnedResources", ctx, []string{testID, testID2}).Return(ownedResources, nil).Once()
+				return tenantMappingRepo
+			},
+			ExpectedOutput: &model.TenantTree{
+				Root:        &model.TenantTreeNode{Tenant: rootModel, OwnedResources: model.TenantOwnedResources{Applications: 2, Runtimes: 1}},
+				Ancestors:   []*model.TenantTreeNode{},
+				Descendants: []*model.TenantTreeNode{{Tenant: childModel, Depth: 1, OwnedResources: model.TenantOwnedResources{Applications: 1}}},
+			},
+		},
+		{
+			Name:      "Success with truncated descendants",
+			Depth:     1,
+			Direction: model.TenantTreeDirectionDescendants,
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				tenantMappingRepo := &automock.TenantMappingRepository{}
+				tenantMappingRepo.On("GetByExternalTenant", ctx, testExternal).Return(rootModel, nil).Once()
+				tenantMappingRepo.On("ListDescendants", ctx, testID, 1, tenant.MaxTenantTreeNodes+1).Return(fixManyDescendants(tenant.MaxTenantTreeNodes+1, false), nil).Once()
+				tenantMappingRepo.On("CountOwnedResources", ctx, mock.MatchedBy(func(ids []string) bool { return len(ids) == tenant.MaxTenantTreeNodes+1 })).Return(ownedResources, nil).Once()
+				return tenantMappingRepo
+			},
+			ExpectedOutput: &model.TenantTree{
+				Root:        &model.TenantTreeNode{Tenant: rootModel, OwnedResources: model.TenantOwnedResources{Applications: 2, Runtimes: 1}},
+				Ancestors:   []*model.TenantTreeNode{},
+				Descendants: fixManyDescendants(tenant.MaxTenantTreeNodes, true),
+				Truncated:   true,
+			},
+		},
+		{
+			Name:                "Error when depth is out of range",
+			Depth:               tenant.MaxTenantTreeDepth + 1,
+			Direction:           model.TenantTreeDirectionBoth,
+			TenantMappingRepoFn: unusedTenantMappingRepo,
+			ExpectedError:       errors.New("depth must be between 1 and 10"),
+		},
+		{
+			Name:                "Error when direction is not supported",
+			Depth:               1,
+			Direction:           "SIDEWAYS",
+			TenantMappingRepoFn: unusedTenantMappingRepo,
+			ExpectedError:       errors.New(`unsupported tenant tree direction "SIDEWAYS"`),
+		},
+		{
+			Name:      "Error when getting tenant by external ID",
+			Depth:     1,
+			Direction: model.TenantTreeDirectionBoth,
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				tenantMappingRepo := &automock.TenantMappingRepository{}
+				tenantMappingRepo.On("GetByExternalTenant", ctx, testExternal).Return(nil, testError).Once()
+				return tenantMappingRepo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name:      "Error when listing ancestors",
+			Depth:     1,
+			Direction: model.TenantTreeDirectionBoth,
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				tenantMappingRepo := &automock.TenantMappingRepository{}
+				tenantMappingRepo.On("GetByExternalTenant", ctx, testExternal).Return(rootModel, nil).Once()
+				tenantMappingRepo.On("ListAncestors", ctx, testID, 1, tenant.MaxTenantTreeNodes+1).Return(nil, testError).Once()
+				return tenantMappingRepo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name:      "Error when listing descendants",
+			Depth:     1,
+			Direction: model.TenantTreeDirectionBoth,
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				tenantMappingRepo := &automock.TenantMappingRepository{}
+				tenantMappingRepo.On("GetByExternalTenant", ctx, testExternal).Return(rootModel, nil).Once()
+				tenantMappingRepo.On("ListAncestors", ctx, testID, 1, tenant.MaxTenantTreeNodes+1).Return(fixAncestors(), nil).Once()
+				tenantMappingRepo.On("ListDescendants", ctx, testID, 1, tenant.MaxTenantTreeNodes+1).Return(nil, testError).Once()
+				return tenantMappingRepo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name:      "Error when counting owned resources",
+			Depth:     1,
+			Direction: model.TenantTreeDirectionBoth,
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				tenantMappingRepo := &automock.TenantMappingRepository{}
+				tenantMappingRepo.On("GetByExternalTenant", ctx, testExternal).Return(rootModel, nil).Once()
+				tenantMappingRepo.On("ListAncestors", ctx, testID, 1, tenant.MaxTenantTreeNodes+1).Return(fixAncestors(), nil).Once()
+				tenantMappingRepo.On("ListDescendants", ctx, testID, 1, tenant.MaxTenantTreeNodes+1).Return(fixDescendants(), nil).Once()
+				tenantMappingRepo.On("CountOwnedResources", ctx, []string{testID, testParentID, testID2}).Return(nil, testError).Once()
+				return tenantMappingRepo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tenantMappingRepo := testCase.TenantMappingRepoFn()
+			svc := tenant.NewService(tenantMappingRepo, nil, nil)
+
+			// WHEN
+			result, err := svc.GetTenantTree(ctx, testExternal, testCase.Depth, testCase.Direction)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			tenantMappingRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestService_ListByIDsAndType(t *testing.T) {
 	// GIVEN
 	ctx := tenant.SaveToContext(context.TODO(), "test", "external-test")
@@ -1782,6 +1982,236 @@ func TestService_DeleteTenantAccessForResource(t *testing.T) {
 	}
 }
 
+func TestService_UpsertTenantAccessGrant(t *testing.T) {
+	ctxWithConsumer := consumer.SaveToContext(context.TODO(), consumer.Consumer{ConsumerID: testConsumerID})
+	futureExpiresAt := time.Now().UTC().Add(time.Hour)
+
+	testCases := []struct {
+		Name                string
+		Context             context.Context
+		TenantMappingRepoFn func() *automock.TenantMappingRepository
+		ExpiresAt           *time.Time
+		ExpectedErrorMsg    string
+	}{
+		{
+			Name:    "Success",
+			Context: ctxWithConsumer,
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				repo := &automock.TenantMappingRepository{}
+				repo.On("UpsertTenantAccessGrant", ctxWithConsumer, mock.MatchedBy(func(tenantAccess *model.TenantAccess) bool {
+					return tenantAccess.GrantedBy != nil && *tenantAccess.GrantedBy == testConsumerID && tenantAccess.CreatedAt != nil
+				})).Return(nil).Once()
+				return repo
+			},
+			ExpiresAt: &futureExpiresAt,
+		},
+		{
+			Name:    "Error when storing grant",
+			Context: ctxWithConsumer,
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				repo := &automock.TenantMappingRepository{}
+				repo.On("UpsertTenantAccessGrant", ctxWithConsumer, mock.AnythingOfType("*model.TenantAccess")).Return(testError).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "while storing grant of tenant access",
+		},
+		{
+			Name:             "Error when consumer is missing in context",
+			Context:          context.TODO(),
+			ExpectedErrorMsg: "while loading consumer",
+		},
+		{
+			Name:             "Error when expiresAt is not in the future",
+			Context:          ctxWithConsumer,
+			ExpiresAt:        &testExpiresAt,
+			ExpectedErrorMsg: "expiresAt must be in the future",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tenantMappingRepo := unusedTenantMappingRepo()
+			if testCase.TenantMappingRepoFn != nil {
+				tenantMappingRepo = testCase.TenantMappingRepoFn()
+			}
+			tenantAccess := fixTenantAccessModelWithGrant(testInternal, "", nil)
+			tenantAccess.ExpiresAt = testCase.ExpiresAt
+
+			svc := tenant.NewService(tenantMappingRepo, nil, nil)
+
+			// WHEN
+			err := svc.UpsertTenantAccessGrant(testCase.Context, tenantAccess)
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testConsumerID, *tenantAccess.GrantedBy)
+				require.NotNil(t, tenantAccess.CreatedAt)
+			}
+
+			mock.AssertExpectationsForObjects(t, tenantMappingRepo)
+		})
+	}
+}
+
+func TestService_DeleteTenantAccessGrant(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		tenantMappingRepo := &automock.TenantMappingRepository{}
+		defer tenantMappingRepo.AssertExpectations(t)
+		tenantMappingRepo.On("DeleteTenantAccessGrant", ctx, testInternal, testID, resource.Application).Return(nil).Once()
+
+		svc := tenant.NewService(tenantMappingRepo, nil, nil)
+
+		// WHEN
+		err := svc.DeleteTenantAccessGrant(ctx, tenantAccessModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when deleting grant", func(t *testing.T) {
+		// GIVEN
+		tenantMappingRepo := &automock.TenantMappingRepository{}
+		defer tenantMappingRepo.AssertExpectations(t)
+		tenantMappingRepo.On("DeleteTenantAccessGrant", ctx, testInternal, testID, resource.Application).Return(testError).Once()
+
+		svc := tenant.NewService(tenantMappingRepo, nil, nil)
+
+		// WHEN
+		err := svc.DeleteTenantAccessGrant(ctx, tenantAccessModel)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while deleting grant of tenant access")
+	})
+}
+
+func TestService_ListExpiredTenantAccesses(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+
+		tenantMappingRepo := &automock.TenantMappingRepository{}
+		defer tenantMappingRepo.AssertExpectations(t)
+		tenantMappingRepo.On("ListExpiredTenantAccessGrants", ctx, mock.AnythingOfType("time.Time")).Return([]*model.TenantAccess{tenantAccessModel}, nil).Once()
+
+		svc := tenant.NewService(tenantMappingRepo, nil, nil)
+
+		// WHEN
+		result, err := svc.ListExpiredTenantAccesses(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*model.TenantAccess{tenantAccessModel}, result)
+	})
+
+	t.Run("Error when listing expired grants", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+
+		tenantMappingRepo := &automock.TenantMappingRepository{}
+		defer tenantMappingRepo.AssertExpectations(t)
+		tenantMappingRepo.On("ListExpiredTenantAccessGrants", ctx, mock.AnythingOfType("time.Time")).Return(nil, testError).Once()
+
+		svc := tenant.NewService(tenantMappingRepo, nil, nil)
+
+		// WHEN
+		result, err := svc.ListExpiredTenantAccesses(ctx)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while listing expired tenant access grants")
+		require.Nil(t, result)
+	})
+}
+
+func TestService_RevokeTenantAccess(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		TenantMappingRepoFn func() *automock.TenantMappingRepository
+		PersistenceFn       func() (*sqlx.DB, testdb.DBMock)
+		ExpectedErrorMsg    string
+	}{
+		{
+			Name: "Success",
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				repo := &automock.TenantMappingRepository{}
+				repo.On("GetByExternalTenant", mock.Anything, tenantAccessModel.ExternalTenantID).Return(tenantGAModel, nil).Once()
+				repo.On("DeleteTenantAccessGrant", mock.Anything, testInternal, testID, resource.Application).Return(nil).Once()
+				return repo
+			},
+			PersistenceFn: func() (*sqlx.DB, testdb.DBMock) {
+				db, dbMock := testdb.MockDatabase(t)
+				dbMock.ExpectExec(fixDeleteTenantAccessesQuery()).
+					WithArgs(testInternal, testInternal, testID, testID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				return db, dbMock
+			},
+		},
+		{
+			Name: "Error when deleting grant",
+			TenantMappingRepoFn: func() *automock.TenantMappingRepository {
+				repo := &automock.TenantMappingRepository{}
+				repo.On("GetByExternalTenant", mock.Anything, tenantAccessModel.ExternalTenantID).Return(tenantGAModel, nil).Once()
+				repo.On("DeleteTenantAccessGrant", mock.Anything, testInternal, testID, resource.Application).Return(testError).Once()
+				return repo
+			},
+			PersistenceFn: func() (*sqlx.DB, testdb.DBMock) {
+				db, dbMock := testdb.MockDatabase(t)
+				dbMock.ExpectExec(fixDeleteTenantAccessesQuery()).
+					WithArgs(testInternal, testInternal, testID, testID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				return db, dbMock
+			},
+			ExpectedErrorMsg: "while deleting grant of tenant access",
+		},
+		{
+			Name:                "Error when deleting tenant access",
+			TenantMappingRepoFn: unusedTenantMappingRepo,
+			PersistenceFn: func() (*sqlx.DB, testdb.DBMock) {
+				db, dbMock := testdb.MockDatabase(t)
+				dbMock.ExpectExec(fixDeleteTenantAccessesQuery()).
+					WithArgs(testInternal, testInternal, testID, testID).
+					WillReturnError(testError)
+				return db, dbMock
+			},
+			ExpectedErrorMsg: "while deleting tenant acccess for resource type",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			converter := &automock.BusinessTenantMappingConverter{}
+			converter.On("TenantAccessToEntity", tenantAccessModel).Return(tenantAccessEntity).Once()
+			tenantMappingRepo := testCase.TenantMappingRepoFn()
+			db, dbMock := testCase.PersistenceFn()
+			ctx := persistence.SaveToContext(context.TODO(), db)
+
+			svc := tenant.NewService(tenantMappingRepo, nil, converter)
+
+			// WHEN
+			err := svc.RevokeTenantAccess(ctx, tenantAccessModel)
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, converter, tenantMappingRepo)
+			dbMock.AssertExpectations(t)
+		})
+	}
+}
+
 func Test_Exists(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// GIVEN
@@ -1857,6 +2287,80 @@ func Test_ExistsByExternalTenant(t *testing.T) {
 		require.Contains(t, err.Error(), testError.Error())
 	})
 }
+func TestService_DirectTenantAccessExists(t *testing.T) {
+	existQuery := regexp.QuoteMeta(`SELECT 1 FROM tenant_applications WHERE tenant_id = $1 AND id = $2 AND source = $3`)
+
+	testCases := []struct {
+		Name             string
+		PersistenceFn    func() (*sqlx.DB, testdb.DBMock)
+		Input            *model.TenantAccess
+		ExpectedErrorMsg string
+		ExpectedOutput   bool
+	}{
+		{
+			Name: "Success when the tenant has the access",
+			PersistenceFn: func() (*sqlx.DB, testdb.DBMock) {
+				db, dbMock := testdb.MockDatabase(t)
+				dbMock.ExpectQuery(existQuery).WithArgs(testInternal, testID, testInternal).WillReturnRows(testdb.RowWhenObjectExist())
+				return db, dbMock
+			},
+			Input:          tenantAccessModel,
+			ExpectedOutput: true,
+		},
+		{
+			Name: "Success when the tenant does not have the access",
+			PersistenceFn: func() (*sqlx.DB, testdb.DBMock) {
+				db, dbMock := testdb.MockDatabase(t)
+				dbMock.ExpectQuery(existQuery).WithArgs(testInternal, testID, testInternal).WillReturnRows(testdb.RowWhenObjectDoesNotExist())
+				return db, dbMock
+			},
+			Input:          tenantAccessModel,
+			ExpectedOutput: false,
+		},
+		{
+			Name:             "Error when resource does not have access table",
+			Input:            invalidTenantAccessModel,
+			ExpectedErrorMsg: fmt.Sprintf("entity %q does not have access table", invalidResourceType),
+		},
+		{
+			Name: "Error while checking for the tenant access",
+			PersistenceFn: func() (*sqlx.DB, testdb.DBMock) {
+				db, dbMock := testdb.MockDatabase(t)
+				dbMock.ExpectQuery(existQuery).WithArgs(testInternal, testID, testInternal).WillReturnError(testError)
+				return db, dbMock
+			},
+			Input:            tenantAccessModel,
+			ExpectedErrorMsg: "Unexpected error while executing SQL query",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ctx := context.TODO()
+			db, dbMock := unusedDBMock(t)
+			if testCase.PersistenceFn != nil {
+				db, dbMock = testCase.PersistenceFn()
+			}
+			ctx = persistence.SaveToContext(ctx, db)
+
+			svc := tenant.NewService(nil, nil, nil)
+
+			// WHEN
+			result, err := svc.DirectTenantAccessExists(ctx, testCase.Input.InternalTenantID, testCase.Input.ResourceID, testCase.Input.ResourceType)
+
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedOutput, result)
+			}
+
+			dbMock.AssertExpectations(t)
+		})
+	}
+}
+
 func TestService_GetTenantAccessForResource(t *testing.T) {
 	testCases := []struct {
 		Name             string
@@ -0,0 +1,373 @@
+package tenantconfiguration
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+// AppConverter converts application register inputs to the internal model
+//
+//go:generate mockery --name=AppConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type AppConverter interface {
+	CreateInputFromGraphQL(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error)
+	CreateJSONInputJSONToGQL(in string) (graphql.ApplicationJSONInput, error)
+}
+
+// AppTemplateConverter converts application template inputs to the internal model
+//
+//go:generate mockery --name=AppTemplateConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type AppTemplateConverter interface {
+	InputFromGraphQL(in graphql.ApplicationTemplateInput) (model.ApplicationTemplateInput, error)
+}
+
+// RuntimeConverter converts runtime register inputs to the internal model
+//
+//go:generate mockery --name=RuntimeConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type RuntimeConverter interface {
+	RegisterInputFromGraphQL(in graphql.RuntimeRegisterInput) (model.RuntimeRegisterInput, error)
+}
+
+// FormationTemplateConverter converts formation template register inputs to the internal model
+//
+//go:generate mockery --name=FormationTemplateConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationTemplateConverter interface {
+	FromRegisterInputGraphQL(in *graphql.FormationTemplateRegisterInput) (*model.FormationTemplateRegisterInput, error)
+}
+
+// FormationConstraintConverter converts formation constraint inputs to the internal model
+//
+//go:generate mockery --name=FormationConstraintConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationConstraintConverter interface {
+	FromInputGraphQL(in *graphql.FormationConstraintInput) *model.FormationConstraintInput
+}
+
+// LabelDefinitionConverter converts label definitions to GraphQL inputs
+//
+//go:generate mockery --name=LabelDefinitionConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type LabelDefinitionConverter interface {
+	ToGraphQLInput(in model.LabelDefinition) (graphql.LabelDefinitionInput, error)
+}
+
+type converter struct {
+	appConverter                 AppConverter
+	appTemplateConverter         AppTemplateConverter
+	runtimeConverter             RuntimeConverter
+	formationTemplateConverter   FormationTemplateConverter
+	formationConstraintConverter FormationConstraintConverter
+	labelDefinitionConverter     LabelDefinitionConverter
+}
+
+// NewConverter returns a new tenant configuration converter. The import direction reuses the GraphQL input converters of the respective domains.
+func NewConverter(appConverter AppConverter, appTemplateConverter AppTemplateConverter, runtimeConverter RuntimeConverter, formationTemplateConverter FormationTemplateConverter, formationConstraintConverter FormationConstraintConverter, labelDefinitionConverter LabelDefinitionConverter) *converter {
+	return &converter{
+		appConverter:                 appConverter,
+		appTemplateConverter:         appTemplateConverter,
+		runtimeConverter:             runtimeConverter,
+		formationTemplateConverter:   formationTemplateConverter,
+		formationConstraintConverter: formationConstraintConverter,
+		labelDefinitionConverter:     labelDefinitionConverter,
+	}
+}
+
+// ApplicationTemplateToInput converts an application template to a document entry. Webhook credentials are never exported.
+func (c *converter) ApplicationTemplateToInput(in *model.ApplicationTemplate) (*graphql.ApplicationTemplateInput, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var appInput *graphql.ApplicationJSONInput
+	if in.ApplicationInputJSON != "" {
+		gqlAppInput, err := c.appConverter.CreateJSONInputJSONToGQL(in.ApplicationInputJSON)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting application input of application template with name %q", in.Name)
+		}
+		for _, wh := range gqlAppInput.Webhooks {
+			wh.Auth = nil
+		}
+		appInput = &gqlAppInput
+	}
+
+	webhooks := make([]*graphql.WebhookInput, 0, len(in.Webhooks))
+	for i := range in.Webhooks {
+		webhooks = append(webhooks, webhookToInput(&in.Webhooks[i]))
+	}
+
+	placeholders := make([]*graphql.PlaceholderDefinitionInput, 0, len(in.Placeholders))
+	for _, p := range in.Placeholders {
+		placeholders = append(placeholders, &graphql.PlaceholderDefinitionInput{
+			Name:        p.Name,
+			Description: p.Description,
+			JSONPath:    p.JSONPath,
+			Optional:    p.Optional,
+		})
+	}
+
+	return &graphql.ApplicationTemplateInput{
+		Name:                 in.Name,
+		Webhooks:             nilIfEmpty(webhooks),
+		Description:          in.Description,
+		Labels:               in.Labels,
+		ApplicationInput:     appInput,
+		Placeholders:         nilIfEmpty(placeholders),
+		AccessLevel:          graphql.ApplicationTemplateAccessLevel(in.AccessLevel),
+		ApplicationNamespace: in.ApplicationNamespace,
+	}, nil
+}
+
+// ApplicationToEntry converts an application together with its labels, webhooks and bundles to a document entry.
+// Bundles discovered through ORD are omitted as they are recreated by the aggregation, as well as any credentials.
+func (c *converter) ApplicationToEntry(in *model.Application, labels map[string]*model.Label, webhooks []*model.Webhook, bundles []*model.Bundle, appTemplateName *string) (*ApplicationEntry, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	bundleInputs := make([]*graphql.BundleCreateInput, 0, len(bundles))
+	for _, bndl := range bundles {
+		if bndl == nil || bndl.OrdID != nil {
+			continue
+		}
+
+		var correlationIDs []string
+		if len(bndl.CorrelationIDs) > 0 {
+			if err := json.Unmarshal(bndl.CorrelationIDs, &correlationIDs); err != nil {
+				return nil, errors.Wrapf(err, "while unmarshalling correlation IDs of bundle with name %q", bndl.Name)
+			}
+		}
+
+		var schema *graphql.JSONSchema
+		if bndl.InstanceAuthRequestInputSchema != nil {
+			s := graphql.JSONSchema(*bndl.InstanceAuthRequestInputSchema)
+			schema = &s
+		}
+
+		bundleInputs = append(bundleInputs, &graphql.BundleCreateInput{
+			Name:                           bndl.Name,
+			Description:                    bndl.Description,
+			InstanceAuthRequestInputSchema: schema,
+			CorrelationIDs:                 correlationIDs,
+		})
+	}
+	sort.Slice(bundleInputs, func(i, j int) bool { return bundleInputs[i].Name < bundleInputs[j].Name })
+
+	return &ApplicationEntry{
+		ApplicationRegisterInput: graphql.ApplicationRegisterInput{
+			Name:                 in.Name,
+			ProviderName:         in.ProviderName,
+			Description:          in.Description,
+			Labels:               labelsToGraphQL(labels),
+			Webhooks:             webhooksToInputs(webhooks),
+			HealthCheckURL:       in.HealthCheckURL,
+			BaseURL:              in.BaseURL,
+			ApplicationNamespace: in.ApplicationNamespace,
+			IntegrationSystemID:  in.IntegrationSystemID,
+			LocalTenantID:        in.LocalTenantID,
+			Bundles:              nilIfEmpty(bundleInputs),
+		},
+		ApplicationTemplate: appTemplateName,
+	}, nil
+}
+
+// RuntimeToInput converts a runtime together with its labels and webhooks to a document entry
+func (c *converter) RuntimeToInput(in *model.Runtime, labels map[string]*model.Label, webhooks []*model.Webhook) *graphql.RuntimeRegisterInput {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.RuntimeRegisterInput{
+		Name:                 in.Name,
+		Description:          in.Description,
+		Labels:               labelsToGraphQL(labels),
+		Webhooks:             webhooksToInputs(webhooks),
+		ApplicationNamespace: in.ApplicationNamespace,
+	}
+}
+
+// FormationTemplateToInput converts a formation template together with its webhooks to a document entry
+func (c *converter) FormationTemplateToInput(in *model.FormationTemplate, webhooks []*model.Webhook) *graphql.FormationTemplateRegisterInput {
+	if in == nil {
+		return nil
+	}
+
+	var artifactKind *graphql.ArtifactType
+	if in.RuntimeArtifactKind != nil {
+		kind := graphql.ArtifactType(*in.RuntimeArtifactKind)
+		artifactKind = &kind
+	}
+
+	var labels graphql.Labels
+	if len(in.Labels) > 0 {
+		labels = in.Labels
+	}
+
+	return &graphql.FormationTemplateRegisterInput{
+		Name:                   in.Name,
+		ApplicationTypes:       in.ApplicationTypes,
+		RuntimeTypes:           in.RuntimeTypes,
+		RuntimeTypeDisplayName: in.RuntimeTypeDisplayName,
+		RuntimeArtifactKind:    artifactKind,
+		Webhooks:               webhooksToInputs(webhooks),
+		LeadingProductIDs:      in.LeadingProductIDs,
+		SupportsReset:          &in.SupportsReset,
+		DiscoveryConsumers:     in.DiscoveryConsumers,
+		Labels:                 labels,
+	}
+}
+
+// FormationConstraintToEntry converts a formation constraint and the names of the formation templates it is attached to to a document entry
+func (c *converter) FormationConstraintToEntry(in *model.FormationConstraint, formationTemplateNames []string) *FormationConstraintEntry {
+	if in == nil {
+		return nil
+	}
+
+	var description *string
+	if in.Description != "" {
+		description = str.Ptr(in.Description)
+	}
+
+	priority := in.Priority
+
+	sortedNames := append([]string(nil), formationTemplateNames...)
+	sort.Strings(sortedNames)
+
+	return &FormationConstraintEntry{
+		FormationConstraintInput: graphql.FormationConstraintInput{
+			Name:            in.Name,
+			Description:     description,
+			ConstraintType:  graphql.ConstraintType(in.ConstraintType),
+			TargetOperation: graphql.TargetOperation(in.TargetOperation),
+			Operator:        in.Operator,
+			ResourceType:    graphql.ResourceType(in.ResourceType),
+			ResourceSubtype: in.ResourceSubtype,
+			InputTemplate:   in.InputTemplate,
+			ConstraintScope: graphql.ConstraintScope(in.ConstraintScope),
+			Priority:        &priority,
+		},
+		FormationTemplates: sortedNames,
+	}
+}
+
+// LabelDefinitionToInput converts a label definition to a document entry
+func (c *converter) LabelDefinitionToInput(in model.LabelDefinition) (*graphql.LabelDefinitionInput, error) {
+	gqlInput, err := c.labelDefinitionConverter.ToGraphQLInput(in)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting label definition with key %q", in.Key)
+	}
+
+	return &gqlInput, nil
+}
+
+// ApplicationTemplateInputFromEntry converts an application template document entry to the internal model
+func (c *converter) ApplicationTemplateInputFromEntry(in graphql.ApplicationTemplateInput) (model.ApplicationTemplateInput, error) {
+	return c.appTemplateConverter.InputFromGraphQL(in)
+}
+
+// ApplicationInputFromEntry converts an application document entry to the internal model
+func (c *converter) ApplicationInputFromEntry(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error) {
+	return c.appConverter.CreateInputFromGraphQL(ctx, in)
+}
+
+// RuntimeInputFromEntry converts a runtime document entry to the internal model
+func (c *converter) RuntimeInputFromEntry(in graphql.RuntimeRegisterInput) (model.RuntimeRegisterInput, error) {
+	return c.runtimeConverter.RegisterInputFromGraphQL(in)
+}
+
+// FormationTemplateInputFromEntry converts a formation template document entry to the internal model
+func (c *converter) FormationTemplateInputFromEntry(in *graphql.FormationTemplateRegisterInput) (*model.FormationTemplateRegisterInput, error) {
+	return c.formationTemplateConverter.FromRegisterInputGraphQL(in)
+}
+
+// FormationConstraintInputFromEntry converts a formation constraint document entry to the internal model
+func (c *converter) FormationConstraintInputFromEntry(in *graphql.FormationConstraintInput) *model.FormationConstraintInput {
+	return c.formationConstraintConverter.FromInputGraphQL(in)
+}
+
+// ImportResultToGraphQL converts the import result to its GraphQL representation
+func (c *converter) ImportResultToGraphQL(in *model.TenantConfigurationImportResult) *graphql.TenantConfigurationImportResult {
+	if in == nil {
+		return nil
+	}
+
+	results := make([]*graphql.TenantConfigurationObjectResult, 0, len(in.Results))
+	for _, r := range in.Results {
+		if r == nil {
+			continue
+		}
+		results = append(results, &graphql.TenantConfigurationObjectResult{
+			ObjectType: graphql.TenantConfigurationObjectType(r.ObjectType),
+			Name:       r.Name,
+			ID:         r.ID,
+			Action:     graphql.TenantConfigurationImportAction(r.Action),
+			Message:    r.Message,
+		})
+	}
+
+	return &graphql.TenantConfigurationImportResult{
+		Version: in.Version,
+		Mode:    graphql.TenantConfigurationImportMode(in.Mode),
+		Results: results,
+	}
+}
+
+func labelsToGraphQL(labels map[string]*model.Label) graphql.Labels {
+	result := make(graphql.Labels, len(labels))
+	for key, l := range labels {
+		if l == nil || key == model.ScenariosKey {
+			continue
+		}
+		result[key] = l.Value
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func webhooksToInputs(webhooks []*model.Webhook) []*graphql.WebhookInput {
+	inputs := make([]*graphql.WebhookInput, 0, len(webhooks))
+	for _, wh := range webhooks {
+		if wh == nil {
+			continue
+		}
+		inputs = append(inputs, webhookToInput(wh))
+	}
+	sort.SliceStable(inputs, func(i, j int) bool { return inputs[i].Type < inputs[j].Type })
+
+	return nilIfEmpty(inputs)
+}
+
+func webhookToInput(in *model.Webhook) *graphql.WebhookInput {
+	var mode *graphql.WebhookMode
+	if in.Mode != nil {
+		m := graphql.WebhookMode(*in.Mode)
+		mode = &m
+	}
+
+	return &graphql.WebhookInput{
+		Type:             graphql.WebhookType(in.Type),
+		URL:              in.URL,
+		Mode:             mode,
+		CorrelationIDKey: in.CorrelationIDKey,
+		RetryInterval:    in.RetryInterval,
+		Timeout:          in.Timeout,
+		URLTemplate:      in.URLTemplate,
+		InputTemplate:    in.InputTemplate,
+		HeaderTemplate:   in.HeaderTemplate,
+		OutputTemplate:   in.OutputTemplate,
+		StatusTemplate:   in.StatusTemplate,
+	}
+}
+
+func nilIfEmpty[T any](in []T) []T {
+	if len(in) == 0 {
+		return nil
+	}
+	return in
+}
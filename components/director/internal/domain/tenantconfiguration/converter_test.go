@@ -0,0 +1,205 @@
+package tenantconfiguration_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantconfiguration"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantconfiguration/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newConverter(appConv *automock.AppConverter, labelDefConv *automock.LabelDefinitionConverter) tenantconfiguration.Converter {
+	return tenantconfiguration.NewConverter(appConv, &automock.AppTemplateConverter{}, &automock.RuntimeConverter{}, &automock.FormationTemplateConverter{}, &automock.FormationConstraintConverter{}, labelDefConv)
+}
+
+func TestConverter_ApplicationToEntry(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := newConverter(&automock.AppConverter{}, &automock.LabelDefinitionConverter{})
+		mode := model.WebhookModeAsyncCallback
+		webhooks := []*model.Webhook{{
+			ID:   "wh-1",
+			Type: model.WebhookTypeConfigurationChanged,
+			URL:  str.Ptr("https://example.com"),
+			Mode: &mode,
+			Auth: &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "user", Password: "pass"}}},
+		}}
+		bundles := []*model.Bundle{
+			{Name: "manual", Description: str.Ptr("desc"), CorrelationIDs: json.RawMessage(`["id-1"]`), DefaultInstanceAuth: &model.Auth{}},
+			{Name: "ord", OrdID: str.Ptr("ns:consumptionBundle:ord:v1")},
+		}
+		labels := map[string]*model.Label{
+			"key":              {Key: "key", Value: "value"},
+			model.ScenariosKey: {Key: model.ScenariosKey, Value: []interface{}{formationName}},
+		}
+		expectedMode := graphql.WebhookModeAsyncCallback
+
+		// WHEN
+		entry, err := conv.ApplicationToEntry(appModel, labels, webhooks, bundles, str.Ptr(appTemplateName))
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &tenantconfiguration.ApplicationEntry{
+			ApplicationRegisterInput: graphql.ApplicationRegisterInput{
+				Name:   appName,
+				Labels: graphql.Labels{"key": "value"},
+				Webhooks: []*graphql.WebhookInput{{
+					Type: graphql.WebhookTypeConfigurationChanged,
+					URL:  str.Ptr("https://example.com"),
+					Mode: &expectedMode,
+				}},
+				Bundles: []*graphql.BundleCreateInput{{
+					Name:           "manual",
+					Description:    str.Ptr("desc"),
+					CorrelationIDs: []string{"id-1"},
+				}},
+			},
+			ApplicationTemplate: str.Ptr(appTemplateName),
+		}, entry)
+	})
+
+	t.Run("Error when bundle correlation IDs are invalid", func(t *testing.T) {
+		conv := newConverter(&automock.AppConverter{}, &automock.LabelDefinitionConverter{})
+
+		_, err := conv.ApplicationToEntry(appModel, nil, nil, []*model.Bundle{{Name: "bundle", CorrelationIDs: json.RawMessage(`{`)}}, nil)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling correlation IDs")
+	})
+}
+
+func TestConverter_ApplicationTemplateToInput(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		appInputJSON := `{"name":"{{name}}","webhooks":[{"type":"CONFIGURATION_CHANGED","auth":{"credential":{"basic":{"username":"user","password":"pass"}}}}]}`
+		appConv := &automock.AppConverter{}
+		appConv.On("CreateJSONInputJSONToGQL", appInputJSON).Return(graphql.ApplicationJSONInput{
+			Name:     "{{name}}",
+			Webhooks: []*graphql.WebhookInput{{Type: graphql.WebhookTypeConfigurationChanged, Auth: &graphql.AuthInput{}}},
+		}, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, appConv)
+
+		conv := newConverter(appConv, &automock.LabelDefinitionConverter{})
+		appTemplate := &model.ApplicationTemplate{
+			ID:                   appTemplateID,
+			Name:                 appTemplateName,
+			ApplicationInputJSON: appInputJSON,
+			Placeholders:         []model.ApplicationTemplatePlaceholder{{Name: "name"}},
+			AccessLevel:          model.GlobalApplicationTemplateAccessLevel,
+		}
+
+		// WHEN
+		result, err := conv.ApplicationTemplateToInput(appTemplate)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.ApplicationTemplateInput{
+			Name: appTemplateName,
+			ApplicationInput: &graphql.ApplicationJSONInput{
+				Name:     "{{name}}",
+				Webhooks: []*graphql.WebhookInput{{Type: graphql.WebhookTypeConfigurationChanged}},
+			},
+			Placeholders: []*graphql.PlaceholderDefinitionInput{{Name: "name"}},
+			AccessLevel:  graphql.ApplicationTemplateAccessLevelGlobal,
+		}, result)
+	})
+
+	t.Run("Error when application input cannot be converted", func(t *testing.T) {
+		appConv := &automock.AppConverter{}
+		appConv.On("CreateJSONInputJSONToGQL", "{").Return(graphql.ApplicationJSONInput{}, testErr).Once()
+		defer mock.AssertExpectationsForObjects(t, appConv)
+
+		conv := newConverter(appConv, &automock.LabelDefinitionConverter{})
+
+		_, err := conv.ApplicationTemplateToInput(&model.ApplicationTemplate{Name: appTemplateName, ApplicationInputJSON: "{"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestConverter_FormationConstraintToEntry(t *testing.T) {
+	// GIVEN
+	conv := newConverter(&automock.AppConverter{}, &automock.LabelDefinitionConverter{})
+	constraint := &model.FormationConstraint{
+		ID:              constraintID,
+		Name:            constraintName,
+		ConstraintType:  model.PreOperation,
+		TargetOperation: model.AssignFormationOperation,
+		Operator:        "IsNotAssignedToAnyFormationOfType",
+		ResourceType:    model.ApplicationResourceType,
+		ResourceSubtype: "app-type",
+		InputTemplate:   "{}",
+		ConstraintScope: model.FormationTypeFormationConstraintScope,
+		Priority:        1,
+	}
+
+	// WHEN
+	entry := conv.FormationConstraintToEntry(constraint, []string{formationTemplateName})
+
+	// THEN
+	assert.Equal(t, fixFormationConstraintEntry(), entry)
+}
+
+func TestConverter_LabelDefinitionToInput(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		labelDefConv := &automock.LabelDefinitionConverter{}
+		labelDefConv.On("ToGraphQLInput", labelDefinitionModel).Return(*labelDefinitionIn, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, labelDefConv)
+
+		result, err := newConverter(&automock.AppConverter{}, labelDefConv).LabelDefinitionToInput(labelDefinitionModel)
+
+		require.NoError(t, err)
+		assert.Equal(t, labelDefinitionIn, result)
+	})
+
+	t.Run("Error when conversion fails", func(t *testing.T) {
+		labelDefConv := &automock.LabelDefinitionConverter{}
+		labelDefConv.On("ToGraphQLInput", labelDefinitionModel).Return(graphql.LabelDefinitionInput{}, testErr).Once()
+		defer mock.AssertExpectationsForObjects(t, labelDefConv)
+
+		_, err := newConverter(&automock.AppConverter{}, labelDefConv).LabelDefinitionToInput(labelDefinitionModel)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestConverter_ImportResultToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := newConverter(&automock.AppConverter{}, &automock.LabelDefinitionConverter{})
+	in := &model.TenantConfigurationImportResult{
+		Version: tenantconfiguration.DocumentVersion,
+		Mode:    model.TenantConfigurationImportModeUpsert,
+		Results: []*model.TenantConfigurationObjectResult{{
+			ObjectType: model.TenantConfigurationObjectTypeRuntime,
+			Name:       runtimeName,
+			ID:         str.Ptr(runtimeID),
+			Action:     model.TenantConfigurationImportActionUpdate,
+			Message:    str.Ptr("message"),
+		}},
+	}
+
+	// WHEN
+	result := conv.ImportResultToGraphQL(in)
+
+	// THEN
+	assert.Equal(t, &graphql.TenantConfigurationImportResult{
+		Version: tenantconfiguration.DocumentVersion,
+		Mode:    graphql.TenantConfigurationImportModeUpsert,
+		Results: []*graphql.TenantConfigurationObjectResult{{
+			ObjectType: graphql.TenantConfigurationObjectTypeRuntime,
+			Name:       runtimeName,
+			ID:         str.Ptr(runtimeID),
+			Action:     graphql.TenantConfigurationImportActionUpdate,
+			Message:    str.Ptr("message"),
+		}},
+	}, result)
+	assert.Nil(t, conv.ImportResultToGraphQL(nil))
+}
@@ -0,0 +1,162 @@
+package tenantconfiguration
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+// DocumentVersion is the version of the tenant configuration document produced by the export
+const DocumentVersion = "v1"
+
+// Document is a declarative, versioned description of the configuration of a tenant.
+// All entries reference each other by name so that the document is portable between landscapes.
+type Document struct {
+	Version              string                                    `json:"version"`
+	ApplicationTemplates []*graphql.ApplicationTemplateInput       `json:"applicationTemplates,omitempty"`
+	Applications         []*ApplicationEntry                       `json:"applications,omitempty"`
+	Runtimes             []*graphql.RuntimeRegisterInput           `json:"runtimes,omitempty"`
+	FormationTemplates   []*graphql.FormationTemplateRegisterInput `json:"formationTemplates,omitempty"`
+	FormationConstraints []*FormationConstraintEntry               `json:"formationConstraints,omitempty"`
+	LabelDefinitions     []*graphql.LabelDefinitionInput           `json:"labelDefinitions,omitempty"`
+	Formations           []*FormationEntry                         `json:"formations,omitempty"`
+}
+
+// ApplicationEntry describes an application and the name of the application template it was created from, if any
+type ApplicationEntry struct {
+	graphql.ApplicationRegisterInput
+	ApplicationTemplate *string `json:"applicationTemplate,omitempty"`
+}
+
+// FormationConstraintEntry describes a formation constraint and the names of the formation templates it is attached to
+type FormationConstraintEntry struct {
+	graphql.FormationConstraintInput
+	FormationTemplates []string `json:"formationTemplates,omitempty"`
+}
+
+// FormationEntry describes a formation and the names of its participants
+type FormationEntry struct {
+	graphql.FormationInput
+	Applications []string `json:"applications,omitempty"`
+	Runtimes     []string `json:"runtimes,omitempty"`
+}
+
+// Marshal serializes the document in the provided format
+func (d *Document) Marshal(format graphql.TenantConfigurationFormat) (string, error) {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling tenant configuration document")
+	}
+
+	if format == graphql.TenantConfigurationFormatJSON {
+		return string(data), nil
+	}
+
+	data, err = yaml.JSONToYAML(data)
+	if err != nil {
+		return "", errors.Wrap(err, "while converting tenant configuration document to YAML")
+	}
+
+	return string(data), nil
+}
+
+// UnmarshalDocument parses a tenant configuration document. Both JSON and YAML documents are accepted.
+func UnmarshalDocument(in string) (*Document, error) {
+	data, err := yaml.YAMLToJSON([]byte(in))
+	if err != nil {
+		return nil, apperrors.NewInvalidDataError(fmt.Sprintf("tenant configuration document is neither valid JSON nor YAML: %s", err.Error()))
+	}
+
+	doc := &Document{}
+	if err = json.Unmarshal(data, doc); err != nil {
+		return nil, apperrors.NewInvalidDataError(fmt.Sprintf("while unmarshalling tenant configuration document: %s", err.Error()))
+	}
+
+	return doc, nil
+}
+
+// Validate checks that the document has a supported version, that every entry is valid on its own
+// and that there are no duplicated names within an object type
+func (d *Document) Validate() error {
+	if d.Version != DocumentVersion {
+		return apperrors.NewInvalidDataError(fmt.Sprintf("unsupported tenant configuration document version %q, expected %q", d.Version, DocumentVersion))
+	}
+
+	names := make(map[string]map[string]bool)
+	checkName := func(objectType, name string, validationErr error) error {
+		if validationErr != nil {
+			return apperrors.NewInvalidDataError(fmt.Sprintf("invalid %s %q: %s", objectType, name, validationErr.Error()))
+		}
+		if names[objectType] == nil {
+			names[objectType] = make(map[string]bool)
+		}
+		if names[objectType][name] {
+			return apperrors.NewInvalidDataError(fmt.Sprintf("duplicated %s %q", objectType, name))
+		}
+		names[objectType][name] = true
+		return nil
+	}
+
+	for _, in := range d.ApplicationTemplates {
+		if in == nil {
+			return apperrors.NewInvalidDataError("application template entries must not be empty")
+		}
+		if err := checkName("application template", in.Name, in.Validate()); err != nil {
+			return err
+		}
+	}
+	for _, in := range d.Applications {
+		if in == nil {
+			return apperrors.NewInvalidDataError("application entries must not be empty")
+		}
+		if err := checkName("application", in.Name, in.Validate()); err != nil {
+			return err
+		}
+	}
+	for _, in := range d.Runtimes {
+		if in == nil {
+			return apperrors.NewInvalidDataError("runtime entries must not be empty")
+		}
+		if err := checkName("runtime", in.Name, in.Validate()); err != nil {
+			return err
+		}
+	}
+	for _, in := range d.FormationTemplates {
+		if in == nil {
+			return apperrors.NewInvalidDataError("formation template entries must not be empty")
+		}
+		if err := checkName("formation template", in.Name, in.Validate()); err != nil {
+			return err
+		}
+	}
+	for _, in := range d.FormationConstraints {
+		if in == nil {
+			return apperrors.NewInvalidDataError("formation constraint entries must not be empty")
+		}
+		if err := checkName("formation constraint", in.Name, in.Validate()); err != nil {
+			return err
+		}
+	}
+	for _, in := range d.LabelDefinitions {
+		if in == nil {
+			return apperrors.NewInvalidDataError("label definition entries must not be empty")
+		}
+		if err := checkName("label definition", in.Key, in.Validate()); err != nil {
+			return err
+		}
+	}
+	for _, in := range d.Formations {
+		if in == nil {
+			return apperrors.NewInvalidDataError("formation entries must not be empty")
+		}
+		if err := checkName("formation", in.Name, in.Validate()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package tenantconfiguration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantconfiguration"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantconfiguration/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	tenantID              = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	externalTenantID      = "external-tenant"
+	appID                 = "7c8b8bb8-4e6a-4b6c-8c0a-1d9ad2b6a111"
+	appName               = "app-name"
+	appTemplateID         = "58963c6f-24f6-4128-a05c-51d5356e7e09"
+	appTemplateName       = "app-template"
+	runtimeID             = "12e4d5c4-6a51-4e4f-9e1c-4d4f2f5bb2b2"
+	runtimeName           = "runtime-name"
+	formationID           = "7a3c3a4f-3c8a-4c38-a6d1-5cfc0e7e3c3c"
+	formationName         = "formation-name"
+	formationTemplateID   = "bda5378d-caa1-4ee4-b8bf-f733e180fbf9"
+	formationTemplateName = "formation-template"
+	constraintID          = "0e8b8b8b-1f1f-4c4c-9d9d-2a2a2a2a2a2a"
+	constraintName        = "constraint-name"
+)
+
+var (
+	testErr = errors.New("test error")
+	ctx     = tenant.SaveToContext(context.Background(), tenantID, externalTenantID)
+	// ctxWithSharedObjectScopes allows the import to create and modify the objects shared by all tenants
+	ctxWithSharedObjectScopes = scope.SaveToContext(ctx, []string{"tenant_configuration:write", "application_template:write", "formation_template:write", "formation_constraint:write"})
+
+	appModel = &model.Application{
+		Name:                  appName,
+		ApplicationTemplateID: str.Ptr(appTemplateID),
+		BaseEntity:            &model.BaseEntity{ID: appID},
+	}
+	appTemplateModel = &model.ApplicationTemplate{
+		ID:   appTemplateID,
+		Name: appTemplateName,
+	}
+	runtimeModel = &model.Runtime{
+		ID:   runtimeID,
+		Name: runtimeName,
+	}
+	formationModel = &model.Formation{
+		ID:                  formationID,
+		TenantID:            tenantID,
+		FormationTemplateID: formationTemplateID,
+		Name:                formationName,
+	}
+	formationTemplateModel = &model.FormationTemplate{
+		ID:       formationTemplateID,
+		Name:     formationTemplateName,
+		TenantID: str.Ptr(tenantID),
+	}
+	constraintModel = &model.FormationConstraint{
+		ID:   constraintID,
+		Name: constraintName,
+	}
+	labelDefinitionModel = model.LabelDefinition{
+		Key:    model.ScenariosKey,
+		Tenant: tenantID,
+	}
+
+	appLabels         = map[string]*model.Label{"key": {Key: "key", Value: "value"}}
+	runtimeLabels     = map[string]*model.Label{"region": {Key: "region", Value: "eu-1"}}
+	appWebhooks       = []*model.Webhook{{ID: "wh-1", Type: model.WebhookTypeConfigurationChanged, URL: str.Ptr("https://example.com")}}
+	appBundles        = []*model.Bundle{{Name: "bundle"}}
+	scenariosSchema   = graphql.JSONSchema(`{"type":"array","minItems":1,"uniqueItems":true,"items":{"type":"string","enum":["formation-name"]}}`)
+	labelDefinitionIn = &graphql.LabelDefinitionInput{Key: model.ScenariosKey, Schema: &scenariosSchema}
+)
+
+func fixApplicationTemplateInput() *graphql.ApplicationTemplateInput {
+	return &graphql.ApplicationTemplateInput{
+		Name:             appTemplateName,
+		ApplicationInput: &graphql.ApplicationJSONInput{Name: "{{name}}"},
+		Placeholders:     []*graphql.PlaceholderDefinitionInput{{Name: "name"}},
+		AccessLevel:      graphql.ApplicationTemplateAccessLevelGlobal,
+	}
+}
+
+func fixApplicationEntry() *tenantconfiguration.ApplicationEntry {
+	return &tenantconfiguration.ApplicationEntry{
+		ApplicationRegisterInput: graphql.ApplicationRegisterInput{
+			Name:   appName,
+			Labels: graphql.Labels{"key": "value"},
+		},
+		ApplicationTemplate: str.Ptr(appTemplateName),
+	}
+}
+
+func fixRuntimeInput() *graphql.RuntimeRegisterInput {
+	return &graphql.RuntimeRegisterInput{
+		Name:   runtimeName,
+		Labels: graphql.Labels{"region": "eu-1"},
+	}
+}
+
+func fixFormationTemplateInput() *graphql.FormationTemplateRegisterInput {
+	supportsReset := false
+	artifactKind := graphql.ArtifactTypeSubscription
+	return &graphql.FormationTemplateRegisterInput{
+		Name:                   formationTemplateName,
+		ApplicationTypes:       []string{"app-type"},
+		RuntimeTypes:           []string{"runtime-type"},
+		RuntimeTypeDisplayName: str.Ptr("runtime-type-display-name"),
+		RuntimeArtifactKind:    &artifactKind,
+		SupportsReset:          &supportsReset,
+	}
+}
+
+func fixFormationConstraintEntry() *tenantconfiguration.FormationConstraintEntry {
+	priority := 1
+	return &tenantconfiguration.FormationConstraintEntry{
+		FormationConstraintInput: graphql.FormationConstraintInput{
+			Name:            constraintName,
+			ConstraintType:  graphql.ConstraintTypePre,
+			TargetOperation: graphql.TargetOperationAssignFormation,
+			Operator:        "IsNotAssignedToAnyFormationOfType",
+			ResourceType:    graphql.ResourceTypeApplication,
+			ResourceSubtype: "app-type",
+			InputTemplate:   "{}",
+			ConstraintScope: graphql.ConstraintScopeFormationType,
+			Priority:        &priority,
+		},
+		FormationTemplates: []string{formationTemplateName},
+	}
+}
+
+func fixFormationEntry() *tenantconfiguration.FormationEntry {
+	return &tenantconfiguration.FormationEntry{
+		FormationInput: graphql.FormationInput{
+			Name:         formationName,
+			TemplateName: str.Ptr(formationTemplateName),
+		},
+		Applications: []string{appName},
+		Runtimes:     []string{runtimeName},
+	}
+}
+
+func fixDocument() *tenantconfiguration.Document {
+	return &tenantconfiguration.Document{
+		Version:              tenantconfiguration.DocumentVersion,
+		ApplicationTemplates: []*graphql.ApplicationTemplateInput{fixApplicationTemplateInput()},
+		Applications:         []*tenantconfiguration.ApplicationEntry{fixApplicationEntry()},
+		Runtimes:             []*graphql.RuntimeRegisterInput{fixRuntimeInput()},
+		FormationTemplates:   []*graphql.FormationTemplateRegisterInput{fixFormationTemplateInput()},
+		FormationConstraints: []*tenantconfiguration.FormationConstraintEntry{fixFormationConstraintEntry()},
+		LabelDefinitions:     []*graphql.LabelDefinitionInput{labelDefinitionIn},
+		Formations:           []*tenantconfiguration.FormationEntry{fixFormationEntry()},
+	}
+}
+
+func fixRuntimePage(runtimes ...*model.Runtime) *model.RuntimePage {
+	return &model.RuntimePage{
+		Data:       runtimes,
+		TotalCount: len(runtimes),
+		PageInfo:   &pagination.Page{HasNextPage: false},
+	}
+}
+
+func fixFormationPage(formations ...*model.Formation) *model.FormationPage {
+	return &model.FormationPage{
+		Data:       formations,
+		TotalCount: len(formations),
+		PageInfo:   &pagination.Page{HasNextPage: false},
+	}
+}
+
+func fixFormationTemplatePage(formationTemplates ...*model.FormationTemplate) *model.FormationTemplatePage {
+	return &model.FormationTemplatePage{
+		Data:       formationTemplates,
+		TotalCount: len(formationTemplates),
+		PageInfo:   &pagination.Page{HasNextPage: false},
+	}
+}
+
+type serviceMocks struct {
+	appSvc                 *automock.ApplicationService
+	appTemplateSvc         *automock.ApplicationTemplateService
+	runtimeSvc             *automock.RuntimeService
+	formationTemplateSvc   *automock.FormationTemplateService
+	constraintSvc          *automock.FormationConstraintService
+	constraintReferenceSvc *automock.FormationTemplateConstraintReferenceService
+	formationSvc           *automock.FormationService
+	labelDefSvc            *automock.LabelDefinitionService
+	webhookSvc             *automock.WebhookService
+	bundleSvc              *automock.BundleService
+	conv                   *automock.Converter
+}
+
+func newServiceMocks() *serviceMocks {
+	return &serviceMocks{
+		appSvc:                 &automock.ApplicationService{},
+		appTemplateSvc:         &automock.ApplicationTemplateService{},
+		runtimeSvc:             &automock.RuntimeService{},
+		formationTemplateSvc:   &automock.FormationTemplateService{},
+		constraintSvc:          &automock.FormationConstraintService{},
+		constraintReferenceSvc: &automock.FormationTemplateConstraintReferenceService{},
+		formationSvc:           &automock.FormationService{},
+		labelDefSvc:            &automock.LabelDefinitionService{},
+		webhookSvc:             &automock.WebhookService{},
+		bundleSvc:              &automock.BundleService{},
+		conv:                   &automock.Converter{},
+	}
+}
+
+func (m *serviceMocks) service() tenantconfiguration.TenantConfigurationService {
+	return tenantconfiguration.NewService(m.appSvc, m.appTemplateSvc, m.runtimeSvc, m.formationTemplateSvc, m.constraintSvc, m.constraintReferenceSvc, m.formationSvc, m.labelDefSvc, m.webhookSvc, m.bundleSvc, m.conv)
+}
+
+func (m *serviceMocks) assertExpectations(t *testing.T) {
+	mock.AssertExpectationsForObjects(t, m.appSvc, m.appTemplateSvc, m.runtimeSvc, m.formationTemplateSvc, m.constraintSvc, m.constraintReferenceSvc, m.formationSvc, m.labelDefSvc, m.webhookSvc, m.bundleSvc, m.conv)
+}
@@ -0,0 +1,100 @@
+package tenantconfiguration
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// TenantConfigurationService is responsible for exporting and importing the configuration of a tenant
+//
+//go:generate mockery --name=TenantConfigurationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type TenantConfigurationService interface {
+	Export(ctx context.Context) (*Document, error)
+	Import(ctx context.Context, doc *Document, mode model.TenantConfigurationImportMode) (*model.TenantConfigurationImportResult, error)
+}
+
+// Resolver is the tenant configuration resolver
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      TenantConfigurationService
+	conv     Converter
+}
+
+// NewResolver creates a new tenant configuration resolver
+func NewResolver(transact persistence.Transactioner, svc TenantConfigurationService, conv Converter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// ExportTenantConfiguration returns the configuration of the tenant in the context as a document in the requested format
+func (r *Resolver) ExportTenantConfiguration(ctx context.Context, format *graphql.TenantConfigurationFormat) (graphql.CLOB, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	doc, err := r.svc.Export(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "while exporting tenant configuration")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", err
+	}
+
+	outputFormat := graphql.TenantConfigurationFormatYaml
+	if format != nil {
+		outputFormat = *format
+	}
+
+	out, err := doc.Marshal(outputFormat)
+	if err != nil {
+		return "", err
+	}
+
+	return graphql.CLOB(out), nil
+}
+
+// ImportTenantConfiguration applies the provided document to the tenant in the context. The import is atomic - either all objects are imported or none.
+func (r *Resolver) ImportTenantConfiguration(ctx context.Context, document graphql.CLOB, mode *graphql.TenantConfigurationImportMode) (*graphql.TenantConfigurationImportResult, error) {
+	doc, err := UnmarshalDocument(string(document))
+	if err != nil {
+		return nil, err
+	}
+
+	importMode := model.TenantConfigurationImportModeCreateOnly
+	if mode != nil {
+		importMode = model.TenantConfigurationImportMode(*mode)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	result, err := r.svc.Import(ctx, doc, importMode)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while importing tenant configuration in mode %q: %v", importMode, err)
+		return nil, errors.Wrap(err, "while importing tenant configuration")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ImportResultToGraphQL(result), nil
+}
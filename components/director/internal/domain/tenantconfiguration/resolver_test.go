@@ -0,0 +1,238 @@
+package tenantconfiguration_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantconfiguration"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantconfiguration/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ExportTenantConfiguration(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	jsonFormat := graphql.TenantConfigurationFormatJSON
+
+	exportedDoc := &tenantconfiguration.Document{
+		Version:  tenantconfiguration.DocumentVersion,
+		Runtimes: []*graphql.RuntimeRegisterInput{fixRuntimeInput()},
+	}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.TenantConfigurationService
+		Format         *graphql.TenantConfigurationFormat
+		ExpectedOutput string
+		ExpectedError  error
+	}{
+		{
+			Name: "Success with default YAML format",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TenantConfigurationService {
+				svc := &automock.TenantConfigurationService{}
+				svc.On("Export", txtest.CtxWithDBMatcher()).Return(exportedDoc, nil).Once()
+				return svc
+			},
+			ExpectedOutput: "version: v1\n",
+		},
+		{
+			Name: "Success with JSON format",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TenantConfigurationService {
+				svc := &automock.TenantConfigurationService{}
+				svc.On("Export", txtest.CtxWithDBMatcher()).Return(exportedDoc, nil).Once()
+				return svc
+			},
+			Format:         &jsonFormat,
+			ExpectedOutput: `"version": "v1"`,
+		},
+		{
+			Name: "Error when export fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.TenantConfigurationService {
+				svc := &automock.TenantConfigurationService{}
+				svc.On("Export", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name: "Error when beginning transaction fails",
+			TxFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.TenantConfigurationService {
+				return &automock.TenantConfigurationService{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name: "Error when committing transaction fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.TenantConfigurationService {
+				svc := &automock.TenantConfigurationService{}
+				svc.On("Export", txtest.CtxWithDBMatcher()).Return(exportedDoc, nil).Once()
+				return svc
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			resolver := tenantconfiguration.NewResolver(transact, svc, &automock.Converter{})
+
+			// WHEN
+			result, err := resolver.ExportTenantConfiguration(ctx, testCase.Format)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Contains(t, string(result), testCase.ExpectedOutput)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc)
+		})
+	}
+}
+
+func TestResolver_ImportTenantConfiguration(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	upsertMode := graphql.TenantConfigurationImportModeUpsert
+	document := graphql.CLOB("version: v1\nruntimes:\n- name: runtime-name\n")
+
+	expectedDoc := &tenantconfiguration.Document{
+		Version:  tenantconfiguration.DocumentVersion,
+		Runtimes: []*graphql.RuntimeRegisterInput{{Name: runtimeName}},
+	}
+	importResult := &model.TenantConfigurationImportResult{
+		Version: tenantconfiguration.DocumentVersion,
+		Mode:    model.TenantConfigurationImportModeUpsert,
+	}
+	gqlImportResult := &graphql.TenantConfigurationImportResult{
+		Version: tenantconfiguration.DocumentVersion,
+		Mode:    graphql.TenantConfigurationImportModeUpsert,
+	}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.TenantConfigurationService
+		ConverterFn    func() *automock.Converter
+		Document       graphql.CLOB
+		Mode           *graphql.TenantConfigurationImportMode
+		ExpectedOutput *graphql.TenantConfigurationImportResult
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TenantConfigurationService {
+				svc := &automock.TenantConfigurationService{}
+				svc.On("Import", txtest.CtxWithDBMatcher(), expectedDoc, model.TenantConfigurationImportModeUpsert).Return(importResult, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ImportResultToGraphQL", importResult).Return(gqlImportResult).Once()
+				return conv
+			},
+			Document:       document,
+			Mode:           &upsertMode,
+			ExpectedOutput: gqlImportResult,
+		},
+		{
+			Name: "Success with default CREATE_ONLY mode",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TenantConfigurationService {
+				svc := &automock.TenantConfigurationService{}
+				svc.On("Import", txtest.CtxWithDBMatcher(), expectedDoc, model.TenantConfigurationImportModeCreateOnly).Return(importResult, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ImportResultToGraphQL", importResult).Return(gqlImportResult).Once()
+				return conv
+			},
+			Document:       document,
+			ExpectedOutput: gqlImportResult,
+		},
+		{
+			Name: "Error when document is malformed",
+			TxFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.TenantConfigurationService {
+				return &automock.TenantConfigurationService{}
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			Document:      "version: [",
+			ExpectedError: "Invalid data",
+		},
+		{
+			Name: "Error when import fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.TenantConfigurationService {
+				svc := &automock.TenantConfigurationService{}
+				svc.On("Import", txtest.CtxWithDBMatcher(), expectedDoc, model.TenantConfigurationImportModeUpsert).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			Document:      document,
+			Mode:          &upsertMode,
+			ExpectedError: testErr.Error(),
+		},
+		{
+			Name: "Error when committing transaction fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.TenantConfigurationService {
+				svc := &automock.TenantConfigurationService{}
+				svc.On("Import", txtest.CtxWithDBMatcher(), expectedDoc, model.TenantConfigurationImportModeUpsert).Return(importResult, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			Document:      document,
+			Mode:          &upsertMode,
+			ExpectedError: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+			resolver := tenantconfiguration.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.ImportTenantConfiguration(ctx, testCase.Document, testCase.Mode)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOutput, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
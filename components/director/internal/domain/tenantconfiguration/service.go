@@ -0,0 +1,1405 @@
+package tenantconfiguration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+const listPageSize = 200
+
+// The scopes of the mutations which create and modify the objects shared by all tenants. The import creates or modifies
+// such objects only when the caller has the same scope, otherwise it reports a conflict.
+const (
+	applicationTemplateWriteScope = "application_template:write"
+	formationTemplateWriteScope   = "formation_template:write"
+	formationConstraintWriteScope = "formation_constraint:write"
+)
+
+// managedApplicationLabels are set by the application service itself and are never removed by an import
+var managedApplicationLabels = map[string]bool{
+	"name":                true,
+	"integrationSystemID": true,
+}
+
+// ApplicationService is responsible for the service-layer Application operations
+//
+//go:generate mockery --name=ApplicationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationService interface {
+	ListAll(ctx context.Context) ([]*model.Application, error)
+	ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error)
+	Create(ctx context.Context, in model.ApplicationRegisterInput) (string, error)
+	CreateFromTemplate(ctx context.Context, in model.ApplicationRegisterInput, appTemplateID *string, systemFieldDiscoveryValue bool) (string, error)
+	Update(ctx context.Context, id string, in model.ApplicationUpdateInput) error
+	SetLabel(ctx context.Context, label *model.LabelInput) error
+	DeleteLabel(ctx context.Context, applicationID string, key string) error
+}
+
+// ApplicationTemplateService is responsible for the service-layer Application Template operations
+//
+//go:generate mockery --name=ApplicationTemplateService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationTemplateService interface {
+	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
+	ListByName(ctx context.Context, name string) ([]*model.ApplicationTemplate, error)
+	Create(ctx context.Context, in model.ApplicationTemplateInput) (string, error)
+	Update(ctx context.Context, id string, override bool, in model.ApplicationTemplateUpdateInput) error
+}
+
+// RuntimeService is responsible for the service-layer Runtime operations
+//
+//go:generate mockery --name=RuntimeService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type RuntimeService interface {
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	ListLabels(ctx context.Context, runtimeID string) (map[string]*model.Label, error)
+	Create(ctx context.Context, in model.RuntimeRegisterInput) (string, error)
+	Update(ctx context.Context, id string, in model.RuntimeUpdateInput) error
+}
+
+// FormationTemplateService is responsible for the service-layer Formation Template operations
+//
+//go:generate mockery --name=FormationTemplateService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationTemplateService interface {
+	Get(ctx context.Context, id string) (*model.FormationTemplate, error)
+	List(ctx context.Context, filters []*labelfilter.LabelFilter, name *string, pageSize int, cursor string) (*model.FormationTemplatePage, error)
+	Create(ctx context.Context, in *model.FormationTemplateRegisterInput) (string, error)
+	Update(ctx context.Context, id string, in *model.FormationTemplateUpdateInput) error
+}
+
+// FormationConstraintService is responsible for the service-layer Formation Constraint operations
+//
+//go:generate mockery --name=FormationConstraintService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationConstraintService interface {
+	List(ctx context.Context) ([]*model.FormationConstraint, error)
+	ListByFormationTemplateIDs(ctx context.Context, formationTemplateIDs []string) ([][]*model.FormationConstraint, error)
+	Create(ctx context.Context, in *model.FormationConstraintInput) (string, error)
+	Update(ctx context.Context, id string, in *model.FormationConstraintInput) error
+}
+
+// FormationTemplateConstraintReferenceService is responsible for attaching Formation Constraints to Formation Templates
+//
+//go:generate mockery --name=FormationTemplateConstraintReferenceService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationTemplateConstraintReferenceService interface {
+	Create(ctx context.Context, in *model.FormationTemplateConstraintReference) error
+}
+
+// FormationService is responsible for the service-layer Formation operations
+//
+//go:generate mockery --name=FormationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationService interface {
+	List(ctx context.Context, pageSize int, cursor string) (*model.FormationPage, error)
+	GetFormationByName(ctx context.Context, formationName, tnt string) (*model.Formation, error)
+	ListObjectIDsOfTypeForFormations(ctx context.Context, tenantID string, formationNames []string, objectType model.FormationAssignmentType) ([]string, error)
+	CreateFormation(ctx context.Context, tnt string, formation model.Formation, templateName string) (*model.Formation, error)
+	AssignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation, initialConfigurations model.InitialConfigurations) (*model.Formation, error)
+}
+
+// LabelDefinitionService is responsible for the service-layer Label Definition operations
+//
+//go:generate mockery --name=LabelDefinitionService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type LabelDefinitionService interface {
+	List(ctx context.Context, tenant string) ([]model.LabelDefinition, error)
+}
+
+// WebhookService is responsible for the service-layer Webhook operations
+//
+//go:generate mockery --name=WebhookService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type WebhookService interface {
+	ListForApplication(ctx context.Context, applicationID string) ([]*model.Webhook, error)
+	ListForRuntime(ctx context.Context, runtimeID string) ([]*model.Webhook, error)
+	ListForFormationTemplate(ctx context.Context, tenant, formationTemplateID string) ([]*model.Webhook, error)
+}
+
+// BundleService is responsible for the service-layer Bundle operations
+//
+//go:generate mockery --name=BundleService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type BundleService interface {
+	ListByApplicationIDNoPaging(ctx context.Context, appID string) ([]*model.Bundle, error)
+}
+
+// Converter converts between the tenant configuration document, the internal model and GraphQL
+//
+//go:generate mockery --name=Converter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Converter interface {
+	ApplicationTemplateToInput(in *model.ApplicationTemplate) (*graphql.ApplicationTemplateInput, error)
+	ApplicationToEntry(in *model.Application, labels map[string]*model.Label, webhooks []*model.Webhook, bundles []*model.Bundle, appTemplateName *string) (*ApplicationEntry, error)
+	RuntimeToInput(in *model.Runtime, labels map[string]*model.Label, webhooks []*model.Webhook) *graphql.RuntimeRegisterInput
+	FormationTemplateToInput(in *model.FormationTemplate, webhooks []*model.Webhook) *graphql.FormationTemplateRegisterInput
+	FormationConstraintToEntry(in *model.FormationConstraint, formationTemplateNames []string) *FormationConstraintEntry
+	LabelDefinitionToInput(in model.LabelDefinition) (*graphql.LabelDefinitionInput, error)
+	ApplicationTemplateInputFromEntry(in graphql.ApplicationTemplateInput) (model.ApplicationTemplateInput, error)
+	ApplicationInputFromEntry(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error)
+	RuntimeInputFromEntry(in graphql.RuntimeRegisterInput) (model.RuntimeRegisterInput, error)
+	FormationTemplateInputFromEntry(in *graphql.FormationTemplateRegisterInput) (*model.FormationTemplateRegisterInput, error)
+	FormationConstraintInputFromEntry(in *graphql.FormationConstraintInput) *model.FormationConstraintInput
+	ImportResultToGraphQL(in *model.TenantConfigurationImportResult) *graphql.TenantConfigurationImportResult
+}
+
+type service struct {
+	appSvc                 ApplicationService
+	appTemplateSvc         ApplicationTemplateService
+	runtimeSvc             RuntimeService
+	formationTemplateSvc   FormationTemplateService
+	constraintSvc          FormationConstraintService
+	constraintReferenceSvc FormationTemplateConstraintReferenceService
+	formationSvc           FormationService
+	labelDefSvc            LabelDefinitionService
+	webhookSvc             WebhookService
+	bundleSvc              BundleService
+	conv                   Converter
+}
+
+// NewService creates a new tenant configuration service
+func NewService(appSvc ApplicationService, appTemplateSvc ApplicationTemplateService, runtimeSvc RuntimeService, formationTemplateSvc FormationTemplateService, constraintSvc FormationConstraintService, constraintReferenceSvc FormationTemplateConstraintReferenceService, formationSvc FormationService, labelDefSvc LabelDefinitionService, webhookSvc WebhookService, bundleSvc BundleService, conv Converter) *service {
+	return &service{
+		appSvc:                 appSvc,
+		appTemplateSvc:         appTemplateSvc,
+		runtimeSvc:             runtimeSvc,
+		formationTemplateSvc:   formationTemplateSvc,
+		constraintSvc:          constraintSvc,
+		constraintReferenceSvc: constraintReferenceSvc,
+		formationSvc:           formationSvc,
+		labelDefSvc:            labelDefSvc,
+		webhookSvc:             webhookSvc,
+		bundleSvc:              bundleSvc,
+		conv:                   conv,
+	}
+}
+
+// Export builds a tenant configuration document describing the applications, runtimes, formations and label definitions of the tenant in the context,
+// together with the application templates, formation templates and formation constraints they depend on
+func (s *service) Export(ctx context.Context) (*Document, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	e := &exporter{service: s, tenantID: tnt, appTemplates: make(map[string]*model.ApplicationTemplate), formationTemplates: make(map[string]*model.FormationTemplate)}
+
+	doc := &Document{Version: DocumentVersion}
+
+	apps, err := s.appSvc.ListAll(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing applications")
+	}
+	appNames := make(map[string]string, len(apps))
+	for _, app := range apps {
+		entry, err := e.exportApplication(ctx, app)
+		if err != nil {
+			return nil, err
+		}
+		appNames[app.ID] = app.Name
+		doc.Applications = append(doc.Applications, entry)
+	}
+
+	runtimes, err := s.listAllRuntimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	runtimeNames := make(map[string]string, len(runtimes))
+	for _, rt := range runtimes {
+		entry, err := e.exportRuntime(ctx, rt)
+		if err != nil {
+			return nil, err
+		}
+		runtimeNames[rt.ID] = rt.Name
+		doc.Runtimes = append(doc.Runtimes, entry)
+	}
+
+	formations, err := s.listAllFormations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range formations {
+		entry, err := e.exportFormation(ctx, f, appNames, runtimeNames)
+		if err != nil {
+			return nil, err
+		}
+		doc.Formations = append(doc.Formations, entry)
+	}
+
+	tenantFormationTemplates, err := s.listAllFormationTemplates(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, ft := range tenantFormationTemplates {
+		if ft.TenantID != nil && *ft.TenantID == tnt {
+			e.formationTemplates[ft.ID] = ft
+		}
+	}
+
+	for _, appTemplate := range e.appTemplates {
+		entry, err := s.conv.ApplicationTemplateToInput(appTemplate)
+		if err != nil {
+			return nil, err
+		}
+		doc.ApplicationTemplates = append(doc.ApplicationTemplates, entry)
+	}
+
+	formationTemplateIDs := make([]string, 0, len(e.formationTemplates))
+	for id, ft := range e.formationTemplates {
+		entry, err := e.exportFormationTemplate(ctx, ft)
+		if err != nil {
+			return nil, err
+		}
+		formationTemplateIDs = append(formationTemplateIDs, id)
+		doc.FormationTemplates = append(doc.FormationTemplates, entry)
+	}
+
+	if doc.FormationConstraints, err = e.exportFormationConstraints(ctx, formationTemplateIDs); err != nil {
+		return nil, err
+	}
+
+	labelDefs, err := s.labelDefSvc.List(ctx, tnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing label definitions")
+	}
+	for _, ld := range labelDefs {
+		entry, err := s.conv.LabelDefinitionToInput(ld)
+		if err != nil {
+			return nil, err
+		}
+		doc.LabelDefinitions = append(doc.LabelDefinitions, entry)
+	}
+
+	doc.sort()
+
+	return doc, nil
+}
+
+// Import applies the provided tenant configuration document to the tenant in the context according to the import mode.
+// The document is validated as a whole before any object is imported. Objects are matched by name; conflicts do not abort the import.
+// Application templates, formation constraints and formation templates not owned by the tenant are shared by all tenants,
+// so they are created or updated only when the caller also has the scope of the corresponding mutation.
+func (s *service) Import(ctx context.Context, doc *Document, mode model.TenantConfigurationImportMode) (*model.TenantConfigurationImportResult, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	switch mode {
+	case model.TenantConfigurationImportModeCreateOnly, model.TenantConfigurationImportModeUpsert, model.TenantConfigurationImportModeDryRun:
+	default:
+		return nil, apperrors.NewInvalidDataError(fmt.Sprintf("unsupported import mode %q", mode))
+	}
+
+	if doc == nil {
+		return nil, apperrors.NewInvalidDataError("tenant configuration document is required")
+	}
+	if err = doc.Validate(); err != nil {
+		return nil, err
+	}
+
+	imp, err := s.newImporter(ctx, tnt, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := []func(ctx context.Context, doc *Document) error{
+		imp.importFormationTemplates,
+		imp.importFormationConstraints,
+		imp.importApplicationTemplates,
+		imp.importApplications,
+		imp.importRuntimes,
+		imp.importFormations,
+		imp.importLabelDefinitions,
+	}
+	for _, step := range steps {
+		if err = step(ctx, doc); err != nil {
+			return nil, err
+		}
+	}
+
+	log.C(ctx).Infof("Imported tenant configuration document for tenant %q in mode %q with %d results", tnt, mode, len(imp.results))
+
+	return &model.TenantConfigurationImportResult{
+		Version: doc.Version,
+		Mode:    mode,
+		Results: imp.results,
+	}, nil
+}
+
+func (s *service) listAllRuntimes(ctx context.Context) ([]*model.Runtime, error) {
+	var result []*model.Runtime
+	cursor := ""
+	for {
+		page, err := s.runtimeSvc.List(ctx, nil, listPageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrap(err, "while listing runtimes")
+		}
+		result = append(result, page.Data...)
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			return result, nil
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+}
+
+func (s *service) listAllFormations(ctx context.Context) ([]*model.Formation, error) {
+	var result []*model.Formation
+	cursor := ""
+	for {
+		page, err := s.formationSvc.List(ctx, listPageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrap(err, "while listing formations")
+		}
+		result = append(result, page.Data...)
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			return result, nil
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+}
+
+func (s *service) listAllFormationTemplates(ctx context.Context, name *string) ([]*model.FormationTemplate, error) {
+	var result []*model.FormationTemplate
+	cursor := ""
+	for {
+		page, err := s.formationTemplateSvc.List(ctx, nil, name, listPageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrap(err, "while listing formation templates")
+		}
+		result = append(result, page.Data...)
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			return result, nil
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+}
+
+// exporter converts existing objects to document entries, caching the templates they reference
+type exporter struct {
+	*service
+	tenantID           string
+	appTemplates       map[string]*model.ApplicationTemplate
+	formationTemplates map[string]*model.FormationTemplate
+}
+
+func (e *exporter) exportApplication(ctx context.Context, app *model.Application) (*ApplicationEntry, error) {
+	labels, err := e.appSvc.ListLabels(ctx, app.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing labels for application with ID %q", app.ID)
+	}
+
+	webhooks, err := e.webhookSvc.ListForApplication(ctx, app.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing webhooks for application with ID %q", app.ID)
+	}
+
+	bundles, err := e.bundleSvc.ListByApplicationIDNoPaging(ctx, app.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing bundles for application with ID %q", app.ID)
+	}
+
+	var appTemplateName *string
+	if app.ApplicationTemplateID != nil {
+		appTemplate, ok := e.appTemplates[*app.ApplicationTemplateID]
+		if !ok {
+			if appTemplate, err = e.appTemplateSvc.Get(ctx, *app.ApplicationTemplateID); err != nil {
+				return nil, errors.Wrapf(err, "while getting application template with ID %q", *app.ApplicationTemplateID)
+			}
+			e.appTemplates[appTemplate.ID] = appTemplate
+		}
+		appTemplateName = str.Ptr(appTemplate.Name)
+	}
+
+	return e.conv.ApplicationToEntry(app, labels, webhooks, bundles, appTemplateName)
+}
+
+func (e *exporter) exportRuntime(ctx context.Context, rt *model.Runtime) (*graphql.RuntimeRegisterInput, error) {
+	labels, err := e.runtimeSvc.ListLabels(ctx, rt.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing labels for runtime with ID %q", rt.ID)
+	}
+
+	webhooks, err := e.webhookSvc.ListForRuntime(ctx, rt.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing webhooks for runtime with ID %q", rt.ID)
+	}
+
+	return e.conv.RuntimeToInput(rt, labels, webhooks), nil
+}
+
+func (e *exporter) exportFormation(ctx context.Context, f *model.Formation, appNames, runtimeNames map[string]string) (*FormationEntry, error) {
+	ft, ok := e.formationTemplates[f.FormationTemplateID]
+	if !ok {
+		var err error
+		if ft, err = e.formationTemplateSvc.Get(ctx, f.FormationTemplateID); err != nil {
+			return nil, errors.Wrapf(err, "while getting formation template with ID %q", f.FormationTemplateID)
+		}
+		e.formationTemplates[ft.ID] = ft
+	}
+
+	appIDs, err := e.formationSvc.ListObjectIDsOfTypeForFormations(ctx, e.tenantID, []string{f.Name}, model.FormationAssignmentTypeApplication)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing applications in formation %q", f.Name)
+	}
+
+	runtimeIDs, err := e.formationSvc.ListObjectIDsOfTypeForFormations(ctx, e.tenantID, []string{f.Name}, model.FormationAssignmentTypeRuntime)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing runtimes in formation %q", f.Name)
+	}
+
+	return &FormationEntry{
+		FormationInput: graphql.FormationInput{
+			Name:         f.Name,
+			TemplateName: str.Ptr(ft.Name),
+		},
+		Applications: namesForIDs(appIDs, appNames),
+		Runtimes:     namesForIDs(runtimeIDs, runtimeNames),
+	}, nil
+}
+
+func (e *exporter) exportFormationTemplate(ctx context.Context, ft *model.FormationTemplate) (*graphql.FormationTemplateRegisterInput, error) {
+	webhooks, err := e.webhookSvc.ListForFormationTemplate(ctx, e.tenantID, ft.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing webhooks for formation template with ID %q", ft.ID)
+	}
+
+	return e.conv.FormationTemplateToInput(ft, webhooks), nil
+}
+
+func (e *exporter) exportFormationConstraints(ctx context.Context, formationTemplateIDs []string) ([]*FormationConstraintEntry, error) {
+	if len(formationTemplateIDs) == 0 {
+		return nil, nil
+	}
+
+	constraintsPerTemplate, err := e.constraintSvc.ListByFormationTemplateIDs(ctx, formationTemplateIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing formation constraints for formation templates")
+	}
+
+	constraints := make(map[string]*model.FormationConstraint)
+	templateNames := make(map[string][]string)
+	for i, templateConstraints := range constraintsPerTemplate {
+		if i >= len(formationTemplateIDs) {
+			break
+		}
+		ftName := e.formationTemplates[formationTemplateIDs[i]].Name
+		for _, c := range templateConstraints {
+			constraints[c.ID] = c
+			templateNames[c.ID] = append(templateNames[c.ID], ftName)
+		}
+	}
+
+	entries := make([]*FormationConstraintEntry, 0, len(constraints))
+	for id, c := range constraints {
+		entries = append(entries, e.conv.FormationConstraintToEntry(c, templateNames[id]))
+	}
+
+	return entries, nil
+}
+
+func (d *Document) sort() {
+	sort.Slice(d.ApplicationTemplates, func(i, j int) bool { return d.ApplicationTemplates[i].Name < d.ApplicationTemplates[j].Name })
+	sort.Slice(d.Applications, func(i, j int) bool { return d.Applications[i].Name < d.Applications[j].Name })
+	sort.Slice(d.Runtimes, func(i, j int) bool { return d.Runtimes[i].Name < d.Runtimes[j].Name })
+	sort.Slice(d.FormationTemplates, func(i, j int) bool { return d.FormationTemplates[i].Name < d.FormationTemplates[j].Name })
+	sort.Slice(d.FormationConstraints, func(i, j int) bool { return d.FormationConstraints[i].Name < d.FormationConstraints[j].Name })
+	sort.Slice(d.LabelDefinitions, func(i, j int) bool { return d.LabelDefinitions[i].Key < d.LabelDefinitions[j].Key })
+	sort.Slice(d.Formations, func(i, j int) bool { return d.Formations[i].Name < d.Formations[j].Name })
+}
+
+// importer applies the entries of a document and keeps track of the objects known by name
+type importer struct {
+	*exporter
+	mode    model.TenantConfigurationImportMode
+	results []*model.TenantConfigurationObjectResult
+
+	apps                 map[string][]*model.Application
+	runtimes             map[string][]*model.Runtime
+	constraints          map[string]*model.FormationConstraint
+	appTemplateIDs       map[string]string
+	formationTemplateIDs map[string]string
+	// planned holds the names of objects which are only going to be created because the import is a dry run
+	planned map[model.TenantConfigurationObjectType]map[string]bool
+}
+
+func (s *service) newImporter(ctx context.Context, tnt string, mode model.TenantConfigurationImportMode) (*importer, error) {
+	imp := &importer{
+		exporter:             &exporter{service: s, tenantID: tnt, appTemplates: make(map[string]*model.ApplicationTemplate), formationTemplates: make(map[string]*model.FormationTemplate)},
+		mode:                 mode,
+		apps:                 make(map[string][]*model.Application),
+		runtimes:             make(map[string][]*model.Runtime),
+		constraints:          make(map[string]*model.FormationConstraint),
+		appTemplateIDs:       make(map[string]string),
+		formationTemplateIDs: make(map[string]string),
+		planned:              make(map[model.TenantConfigurationObjectType]map[string]bool),
+	}
+
+	apps, err := s.appSvc.ListAll(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing applications")
+	}
+	for _, app := range apps {
+		imp.apps[app.Name] = append(imp.apps[app.Name], app)
+	}
+
+	runtimes, err := s.listAllRuntimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, rt := range runtimes {
+		imp.runtimes[rt.Name] = append(imp.runtimes[rt.Name], rt)
+	}
+
+	constraints, err := s.constraintSvc.List(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing formation constraints")
+	}
+	for _, c := range constraints {
+		imp.constraints[c.Name] = c
+	}
+
+	return imp, nil
+}
+
+func (imp *importer) dryRun() bool {
+	return imp.mode == model.TenantConfigurationImportModeDryRun
+}
+
+// decide returns the action for an existing object based on whether it is equal to its document entry
+func (imp *importer) decide(equal bool) model.TenantConfigurationImportAction {
+	switch {
+	case equal:
+		return model.TenantConfigurationImportActionSkip
+	case imp.mode == model.TenantConfigurationImportModeCreateOnly:
+		return model.TenantConfigurationImportActionConflict
+	default:
+		return model.TenantConfigurationImportActionUpdate
+	}
+}
+
+func (imp *importer) report(objectType model.TenantConfigurationObjectType, name string, id *string, action model.TenantConfigurationImportAction, message string) {
+	if action == model.TenantConfigurationImportActionCreate && imp.dryRun() {
+		if imp.planned[objectType] == nil {
+			imp.planned[objectType] = make(map[string]bool)
+		}
+		imp.planned[objectType][name] = true
+	}
+
+	var msg *string
+	if message != "" {
+		msg = str.Ptr(message)
+	}
+
+	imp.results = append(imp.results, &model.TenantConfigurationObjectResult{
+		ObjectType: objectType,
+		Name:       name,
+		ID:         id,
+		Action:     action,
+		Message:    msg,
+	})
+}
+
+func (imp *importer) importFormationTemplates(ctx context.Context, doc *Document) error {
+	for _, entry := range doc.FormationTemplates {
+		existing, err := imp.listAllFormationTemplates(ctx, str.Ptr(entry.Name))
+		if err != nil {
+			return err
+		}
+
+		in, err := imp.conv.FormationTemplateInputFromEntry(entry)
+		if err != nil {
+			return errors.Wrapf(err, "while converting formation template %q", entry.Name)
+		}
+
+		if len(existing) == 0 {
+			id := ""
+			if !imp.dryRun() {
+				if id, err = imp.formationTemplateSvc.Create(ctx, in); err != nil {
+					return errors.Wrapf(err, "while creating formation template %q", entry.Name)
+				}
+			}
+			imp.formationTemplateIDs[entry.Name] = id
+			imp.report(model.TenantConfigurationObjectTypeFormationTemplate, entry.Name, optionalID(id), model.TenantConfigurationImportActionCreate, "")
+			continue
+		}
+
+		ft := imp.ownedFormationTemplate(existing)
+		imp.formationTemplateIDs[entry.Name] = ft.ID
+		imp.exporter.formationTemplates[ft.ID] = ft
+
+		current, err := imp.exportFormationTemplate(ctx, ft)
+		if err != nil {
+			return err
+		}
+
+		normalized := *entry
+		if normalized.SupportsReset == nil {
+			normalized.SupportsReset = &in.SupportsReset
+		}
+
+		action := imp.decide(equalEntries(current, &normalized))
+		message := ""
+		if action == model.TenantConfigurationImportActionUpdate && !imp.ownsFormationTemplate(ft) && !hasScope(ctx, formationTemplateWriteScope) {
+			action = model.TenantConfigurationImportActionConflict
+			message = fmt.Sprintf("formation template is not owned by the tenant and can be updated only with the %q scope", formationTemplateWriteScope)
+		}
+		switch action {
+		case model.TenantConfigurationImportActionConflict:
+			if message == "" {
+				message = "formation template differs from the document"
+			}
+		case model.TenantConfigurationImportActionUpdate:
+			message = "webhooks and labels of existing formation templates are not modified"
+			if !imp.dryRun() {
+				if err = imp.formationTemplateSvc.Update(ctx, ft.ID, &model.FormationTemplateUpdateInput{
+					Name:                   in.Name,
+					ApplicationTypes:       in.ApplicationTypes,
+					RuntimeTypes:           in.RuntimeTypes,
+					RuntimeTypeDisplayName: in.RuntimeTypeDisplayName,
+					RuntimeArtifactKind:    in.RuntimeArtifactKind,
+					LeadingProductIDs:      in.LeadingProductIDs,
+					SupportsReset:          in.SupportsReset,
+					DiscoveryConsumers:     in.DiscoveryConsumers,
+				}); err != nil {
+					return errors.Wrapf(err, "while updating formation template %q", entry.Name)
+				}
+			}
+		}
+		imp.report(model.TenantConfigurationObjectTypeFormationTemplate, entry.Name, str.Ptr(ft.ID), action, message)
+	}
+
+	return nil
+}
+
+func (imp *importer) importFormationConstraints(ctx context.Context, doc *Document) error {
+	for _, entry := range doc.FormationConstraints {
+		templateIDs := make([]string, 0, len(entry.FormationTemplates))
+		var unknownTemplates []string
+		for _, ftName := range entry.FormationTemplates {
+			ftID, err := imp.formationTemplateID(ctx, ftName)
+			if err != nil {
+				return err
+			}
+			if ftID == "" {
+				if !imp.planned[model.TenantConfigurationObjectTypeFormationTemplate][ftName] {
+					unknownTemplates = append(unknownTemplates, ftName)
+				}
+				continue
+			}
+			templateIDs = append(templateIDs, ftID)
+		}
+
+		if len(unknownTemplates) > 0 {
+			imp.report(model.TenantConfigurationObjectTypeFormationConstraint, entry.Name, nil, model.TenantConfigurationImportActionConflict,
+				fmt.Sprintf("unknown formation templates: %s", strings.Join(unknownTemplates, ", ")))
+			continue
+		}
+
+		in := imp.conv.FormationConstraintInputFromEntry(&entry.FormationConstraintInput)
+
+		existing, ok := imp.constraints[entry.Name]
+		if !ok {
+			if !hasScope(ctx, formationConstraintWriteScope) {
+				imp.report(model.TenantConfigurationObjectTypeFormationConstraint, entry.Name, nil, model.TenantConfigurationImportActionConflict,
+					fmt.Sprintf("formation constraints are shared by all tenants and can be created only with the %q scope", formationConstraintWriteScope))
+				continue
+			}
+
+			id := ""
+			if !imp.dryRun() {
+				var err error
+				if id, err = imp.constraintSvc.Create(ctx, in); err != nil {
+					return errors.Wrapf(err, "while creating formation constraint %q", entry.Name)
+				}
+				if err = imp.attachConstraint(ctx, id, templateIDs); err != nil {
+					return err
+				}
+			}
+			imp.report(model.TenantConfigurationObjectTypeFormationConstraint, entry.Name, optionalID(id), model.TenantConfigurationImportActionCreate, "")
+			continue
+		}
+
+		attachedTemplateIDs, err := imp.attachedFormationTemplateIDs(ctx, existing.ID, templateIDs)
+		if err != nil {
+			return err
+		}
+		var missingTemplateIDs []string
+		for _, ftID := range templateIDs {
+			if !attachedTemplateIDs[ftID] {
+				missingTemplateIDs = append(missingTemplateIDs, ftID)
+			}
+		}
+
+		current := imp.conv.FormationConstraintToEntry(existing, nil)
+		normalized := entry.FormationConstraintInput
+		if normalized.Priority == nil {
+			normalized.Priority = current.Priority
+		}
+
+		action := imp.decide(equalEntries(&current.FormationConstraintInput, &normalized) && len(missingTemplateIDs) == 0)
+		message := ""
+		if action == model.TenantConfigurationImportActionUpdate && !hasScope(ctx, formationConstraintWriteScope) {
+			action = model.TenantConfigurationImportActionConflict
+			message = fmt.Sprintf("formation constraints are shared by all tenants and can be updated only with the %q scope", formationConstraintWriteScope)
+		}
+		switch action {
+		case model.TenantConfigurationImportActionConflict:
+			if message == "" {
+				message = "formation constraint differs from the document"
+			}
+		case model.TenantConfigurationImportActionUpdate:
+			if !imp.dryRun() {
+				if err = imp.constraintSvc.Update(ctx, existing.ID, in); err != nil {
+					return errors.Wrapf(err, "while updating formation constraint %q", entry.Name)
+				}
+				if err = imp.attachConstraint(ctx, existing.ID, missingTemplateIDs); err != nil {
+					return err
+				}
+			}
+		}
+		imp.report(model.TenantConfigurationObjectTypeFormationConstraint, entry.Name, str.Ptr(existing.ID), action, message)
+	}
+
+	return nil
+}
+
+func (imp *importer) attachedFormationTemplateIDs(ctx context.Context, constraintID string, formationTemplateIDs []string) (map[string]bool, error) {
+	attached := make(map[string]bool, len(formationTemplateIDs))
+	if len(formationTemplateIDs) == 0 {
+		return attached, nil
+	}
+
+	constraintsPerTemplate, err := imp.constraintSvc.ListByFormationTemplateIDs(ctx, formationTemplateIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing formation constraints for formation templates")
+	}
+
+	for i, constraints := range constraintsPerTemplate {
+		if i >= len(formationTemplateIDs) {
+			break
+		}
+		for _, c := range constraints {
+			if c.ID == constraintID {
+				attached[formationTemplateIDs[i]] = true
+			}
+		}
+	}
+
+	return attached, nil
+}
+
+func (imp *importer) attachConstraint(ctx context.Context, constraintID string, formationTemplateIDs []string) error {
+	for _, ftID := range formationTemplateIDs {
+		if err := imp.constraintReferenceSvc.Create(ctx, &model.FormationTemplateConstraintReference{ConstraintID: constraintID, FormationTemplateID: ftID}); err != nil {
+			return errors.Wrapf(err, "while attaching formation constraint with ID %q to formation template with ID %q", constraintID, ftID)
+		}
+	}
+	return nil
+}
+
+func (imp *importer) importApplicationTemplates(ctx context.Context, doc *Document) error {
+	for _, entry := range doc.ApplicationTemplates {
+		existing, err := imp.appTemplateSvc.ListByName(ctx, entry.Name)
+		if err != nil {
+			return errors.Wrapf(err, "while listing application templates with name %q", entry.Name)
+		}
+
+		if len(existing) > 1 {
+			imp.report(model.TenantConfigurationObjectTypeApplicationTemplate, entry.Name, nil, model.TenantConfigurationImportActionConflict,
+				"multiple application templates with this name exist")
+			continue
+		}
+
+		in, err := imp.conv.ApplicationTemplateInputFromEntry(*entry)
+		if err != nil {
+			return errors.Wrapf(err, "while converting application template %q", entry.Name)
+		}
+
+		if len(existing) == 0 {
+			if !hasScope(ctx, applicationTemplateWriteScope) {
+				imp.report(model.TenantConfigurationObjectTypeApplicationTemplate, entry.Name, nil, model.TenantConfigurationImportActionConflict,
+					fmt.Sprintf("application templates are shared by all tenants and can be created only with the %q scope", applicationTemplateWriteScope))
+				continue
+			}
+
+			id := ""
+			if !imp.dryRun() {
+				if id, err = imp.appTemplateSvc.Create(ctx, in); err != nil {
+					return errors.Wrapf(err, "while creating application template %q", entry.Name)
+				}
+			}
+			imp.appTemplateIDs[entry.Name] = id
+			imp.report(model.TenantConfigurationObjectTypeApplicationTemplate, entry.Name, optionalID(id), model.TenantConfigurationImportActionCreate, "")
+			continue
+		}
+
+		appTemplate := existing[0]
+		imp.appTemplateIDs[entry.Name] = appTemplate.ID
+
+		current, err := imp.conv.ApplicationTemplateToInput(appTemplate)
+		if err != nil {
+			return err
+		}
+
+		action := imp.decide(equalEntries(current, entry))
+		message := ""
+		if action == model.TenantConfigurationImportActionUpdate && !hasScope(ctx, applicationTemplateWriteScope) {
+			action = model.TenantConfigurationImportActionConflict
+			message = fmt.Sprintf("application templates are shared by all tenants and can be updated only with the %q scope", applicationTemplateWriteScope)
+		}
+		switch action {
+		case model.TenantConfigurationImportActionConflict:
+			if message == "" {
+				message = "application template differs from the document"
+			}
+		case model.TenantConfigurationImportActionUpdate:
+			if !imp.dryRun() {
+				if err = imp.appTemplateSvc.Update(ctx, appTemplate.ID, false, model.ApplicationTemplateUpdateInput{
+					Name:                 in.Name,
+					Description:          in.Description,
+					ApplicationNamespace: in.ApplicationNamespace,
+					ApplicationInputJSON: in.ApplicationInputJSON,
+					Placeholders:         in.Placeholders,
+					AccessLevel:          in.AccessLevel,
+					Labels:               in.Labels,
+					Webhooks:             in.Webhooks,
+				}); err != nil {
+					return errors.Wrapf(err, "while updating application template %q", entry.Name)
+				}
+			}
+		}
+		imp.report(model.TenantConfigurationObjectTypeApplicationTemplate, entry.Name, str.Ptr(appTemplate.ID), action, message)
+	}
+
+	return nil
+}
+
+func (imp *importer) importApplications(ctx context.Context, doc *Document) error {
+	for _, entry := range doc.Applications {
+		existing := imp.apps[entry.Name]
+		if len(existing) > 1 {
+			imp.report(model.TenantConfigurationObjectTypeApplication, entry.Name, nil, model.TenantConfigurationImportActionConflict,
+				"multiple applications with this name exist in the tenant")
+			continue
+		}
+
+		in, err := imp.conv.ApplicationInputFromEntry(ctx, entry.ApplicationRegisterInput)
+		if err != nil {
+			return errors.Wrapf(err, "while converting application %q", entry.Name)
+		}
+
+		if len(existing) == 0 {
+			var appTemplateID *string
+			if entry.ApplicationTemplate != nil {
+				id, err := imp.applicationTemplateID(ctx, *entry.ApplicationTemplate)
+				if err != nil {
+					return err
+				}
+				if id == "" && !imp.planned[model.TenantConfigurationObjectTypeApplicationTemplate][*entry.ApplicationTemplate] {
+					imp.report(model.TenantConfigurationObjectTypeApplication, entry.Name, nil, model.TenantConfigurationImportActionConflict,
+						fmt.Sprintf("unknown application template %q", *entry.ApplicationTemplate))
+					continue
+				}
+				appTemplateID = &id
+			}
+
+			id := ""
+			if !imp.dryRun() {
+				if appTemplateID != nil {
+					id, err = imp.appSvc.CreateFromTemplate(ctx, in, appTemplateID, false)
+				} else {
+					id, err = imp.appSvc.Create(ctx, in)
+				}
+				if err != nil {
+					return errors.Wrapf(err, "while creating application %q", entry.Name)
+				}
+				imp.apps[entry.Name] = []*model.Application{{Name: entry.Name, BaseEntity: &model.BaseEntity{ID: id}}}
+			}
+			imp.report(model.TenantConfigurationObjectTypeApplication, entry.Name, optionalID(id), model.TenantConfigurationImportActionCreate, "")
+			continue
+		}
+
+		app := existing[0]
+		current, err := imp.exportApplication(ctx, app)
+		if err != nil {
+			return err
+		}
+
+		fieldsEqual := equalEntries(applicationFields(current), applicationFields(entry))
+		action := imp.decide(fieldsEqual && equalApplicationParts(current, entry))
+		message := ""
+		switch action {
+		case model.TenantConfigurationImportActionConflict:
+			message = "application differs from the document"
+		case model.TenantConfigurationImportActionUpdate:
+			if !equalApplicationParts(current, entry) {
+				action = model.TenantConfigurationImportActionPartial
+				message = "webhooks and bundles of existing applications are not modified"
+			}
+			if !imp.dryRun() && !fieldsEqual {
+				if err = imp.updateApplication(ctx, app, current.Labels, in); err != nil {
+					return errors.Wrapf(err, "while updating application %q", entry.Name)
+				}
+			}
+		}
+		imp.report(model.TenantConfigurationObjectTypeApplication, entry.Name, str.Ptr(app.ID), action, message)
+	}
+
+	return nil
+}
+
+// updateApplication updates the fields and labels of an existing application. The status of the application is preserved,
+// labels which are not part of the document are removed and labels managed by the application service are left untouched.
+func (imp *importer) updateApplication(ctx context.Context, app *model.Application, currentLabels graphql.Labels, in model.ApplicationRegisterInput) error {
+	var statusCondition *model.ApplicationStatusCondition
+	if app.Status != nil {
+		condition := app.Status.Condition
+		statusCondition = &condition
+	}
+
+	if err := imp.appSvc.Update(ctx, app.ID, model.ApplicationUpdateInput{
+		ProviderName:         in.ProviderName,
+		Description:          in.Description,
+		HealthCheckURL:       in.HealthCheckURL,
+		IntegrationSystemID:  in.IntegrationSystemID,
+		StatusCondition:      statusCondition,
+		BaseURL:              in.BaseURL,
+		ApplicationNamespace: in.ApplicationNamespace,
+		LocalTenantID:        in.LocalTenantID,
+	}); err != nil {
+		return err
+	}
+
+	staleKeys := make([]string, 0, len(currentLabels))
+	for key := range currentLabels {
+		if _, ok := in.Labels[key]; ok || managedApplicationLabels[key] {
+			continue
+		}
+		staleKeys = append(staleKeys, key)
+	}
+	sort.Strings(staleKeys)
+
+	for _, key := range staleKeys {
+		if err := imp.appSvc.DeleteLabel(ctx, app.ID, key); err != nil {
+			return errors.Wrapf(err, "while deleting label %q", key)
+		}
+	}
+
+	keys := make([]string, 0, len(in.Labels))
+	for key := range in.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := imp.appSvc.SetLabel(ctx, &model.LabelInput{
+			Key:        key,
+			Value:      in.Labels[key],
+			ObjectID:   app.ID,
+			ObjectType: model.ApplicationLabelableObject,
+		}); err != nil {
+			return errors.Wrapf(err, "while setting label %q", key)
+		}
+	}
+
+	return nil
+}
+
+// applicationFields returns a copy of the entry without the webhooks and bundles, which are not modified for existing applications
+func applicationFields(entry *ApplicationEntry) *ApplicationEntry {
+	fields := *entry
+	fields.Webhooks = nil
+	fields.Bundles = nil
+	return &fields
+}
+
+func equalApplicationParts(current, desired *ApplicationEntry) bool {
+	return equalEntries(nilIfEmpty(current.Webhooks), nilIfEmpty(desired.Webhooks)) && equalEntries(nilIfEmpty(current.Bundles), nilIfEmpty(desired.Bundles))
+}
+
+func (imp *importer) importRuntimes(ctx context.Context, doc *Document) error {
+	for _, entry := range doc.Runtimes {
+		existing := imp.runtimes[entry.Name]
+		if len(existing) > 1 {
+			imp.report(model.TenantConfigurationObjectTypeRuntime, entry.Name, nil, model.TenantConfigurationImportActionConflict,
+				"multiple runtimes with this name exist in the tenant")
+			continue
+		}
+
+		in, err := imp.conv.RuntimeInputFromEntry(*entry)
+		if err != nil {
+			return errors.Wrapf(err, "while converting runtime %q", entry.Name)
+		}
+
+		if len(existing) == 0 {
+			id := ""
+			if !imp.dryRun() {
+				if id, err = imp.runtimeSvc.Create(ctx, in); err != nil {
+					return errors.Wrapf(err, "while creating runtime %q", entry.Name)
+				}
+				imp.runtimes[entry.Name] = []*model.Runtime{{ID: id, Name: entry.Name}}
+			}
+			imp.report(model.TenantConfigurationObjectTypeRuntime, entry.Name, optionalID(id), model.TenantConfigurationImportActionCreate, "")
+			continue
+		}
+
+		rt := existing[0]
+		current, err := imp.exportRuntime(ctx, rt)
+		if err != nil {
+			return err
+		}
+
+		fieldsEqual := equalEntries(runtimeFields(current), runtimeFields(entry))
+		webhooksEqual := equalEntries(nilIfEmpty(current.Webhooks), nilIfEmpty(entry.Webhooks))
+		action := imp.decide(fieldsEqual && webhooksEqual)
+		message := ""
+		switch action {
+		case model.TenantConfigurationImportActionConflict:
+			message = "runtime differs from the document"
+		case model.TenantConfigurationImportActionUpdate:
+			if !webhooksEqual {
+				action = model.TenantConfigurationImportActionPartial
+				message = "webhooks of existing runtimes are not modified"
+			}
+			if !imp.dryRun() && !fieldsEqual {
+				if err = imp.runtimeSvc.Update(ctx, rt.ID, model.RuntimeUpdateInput{
+					Name:                 in.Name,
+					Description:          in.Description,
+					Labels:               in.Labels,
+					ApplicationNamespace: in.ApplicationNamespace,
+				}); err != nil {
+					return errors.Wrapf(err, "while updating runtime %q", entry.Name)
+				}
+			}
+		}
+		imp.report(model.TenantConfigurationObjectTypeRuntime, entry.Name, str.Ptr(rt.ID), action, message)
+	}
+
+	return nil
+}
+
+// runtimeFields returns a copy of the entry without the webhooks, which are not modified for existing runtimes
+func runtimeFields(entry *graphql.RuntimeRegisterInput) *graphql.RuntimeRegisterInput {
+	fields := *entry
+	fields.Webhooks = nil
+	return &fields
+}
+
+func (imp *importer) importFormations(ctx context.Context, doc *Document) error {
+	for _, entry := range doc.Formations {
+		templateName := model.DefaultTemplateName
+		if entry.TemplateName != nil {
+			templateName = *entry.TemplateName
+		}
+
+		participants, unknown := imp.resolveParticipants(entry)
+		if len(unknown) > 0 {
+			imp.report(model.TenantConfigurationObjectTypeFormation, entry.Name, nil, model.TenantConfigurationImportActionConflict,
+				fmt.Sprintf("unknown participants: %s", strings.Join(unknown, ", ")))
+			continue
+		}
+
+		existing, err := imp.formationSvc.GetFormationByName(ctx, entry.Name, imp.tenantID)
+		if err != nil && !apperrors.IsNotFoundError(err) {
+			return errors.Wrapf(err, "while getting formation %q", entry.Name)
+		}
+
+		if existing == nil {
+			id := ""
+			if !imp.dryRun() {
+				formation, err := imp.formationSvc.CreateFormation(ctx, imp.tenantID, model.Formation{Name: entry.Name}, templateName)
+				if err != nil {
+					return errors.Wrapf(err, "while creating formation %q", entry.Name)
+				}
+				id = formation.ID
+				if err = imp.assignParticipants(ctx, *formation, participants); err != nil {
+					return err
+				}
+			}
+			imp.report(model.TenantConfigurationObjectTypeFormation, entry.Name, optionalID(id), model.TenantConfigurationImportActionCreate, "")
+			continue
+		}
+
+		current, err := imp.exportFormation(ctx, existing, imp.namesByID(model.FormationAssignmentTypeApplication), imp.namesByID(model.FormationAssignmentTypeRuntime))
+		if err != nil {
+			return err
+		}
+
+		if current.TemplateName != nil && *current.TemplateName != templateName {
+			imp.report(model.TenantConfigurationObjectTypeFormation, entry.Name, str.Ptr(existing.ID), model.TenantConfigurationImportActionConflict,
+				fmt.Sprintf("formation is based on formation template %q which cannot be changed", *current.TemplateName))
+			continue
+		}
+
+		missing := missingParticipants(participants, current)
+
+		action := imp.decide(len(missing) == 0)
+		message := ""
+		switch action {
+		case model.TenantConfigurationImportActionConflict:
+			message = "formation is missing participants from the document"
+		case model.TenantConfigurationImportActionUpdate:
+			message = "participants which are not part of the document are not unassigned"
+			if !imp.dryRun() {
+				if err = imp.assignParticipants(ctx, *existing, missing); err != nil {
+					return err
+				}
+			}
+		}
+		imp.report(model.TenantConfigurationObjectTypeFormation, entry.Name, str.Ptr(existing.ID), action, message)
+	}
+
+	return nil
+}
+
+// participant is an application or runtime which should be part of a formation
+type participant struct {
+	name       string
+	id         string
+	objectType graphql.FormationObjectType
+}
+
+func (imp *importer) resolveParticipants(entry *FormationEntry) ([]participant, []string) {
+	var participants []participant
+	var unknown []string
+	for _, name := range entry.Applications {
+		apps := imp.apps[name]
+		switch {
+		case len(apps) == 1:
+			participants = append(participants, participant{name: name, id: apps[0].ID, objectType: graphql.FormationObjectTypeApplication})
+		case len(apps) == 0 && imp.planned[model.TenantConfigurationObjectTypeApplication][name]:
+			participants = append(participants, participant{name: name, objectType: graphql.FormationObjectTypeApplication})
+		default:
+			unknown = append(unknown, fmt.Sprintf("application %q", name))
+		}
+	}
+	for _, name := range entry.Runtimes {
+		runtimes := imp.runtimes[name]
+		switch {
+		case len(runtimes) == 1:
+			participants = append(participants, participant{name: name, id: runtimes[0].ID, objectType: graphql.FormationObjectTypeRuntime})
+		case len(runtimes) == 0 && imp.planned[model.TenantConfigurationObjectTypeRuntime][name]:
+			participants = append(participants, participant{name: name, objectType: graphql.FormationObjectTypeRuntime})
+		default:
+			unknown = append(unknown, fmt.Sprintf("runtime %q", name))
+		}
+	}
+	return participants, unknown
+}
+
+func missingParticipants(participants []participant, current *FormationEntry) []participant {
+	assigned := make(map[graphql.FormationObjectType]map[string]bool)
+	assigned[graphql.FormationObjectTypeApplication] = make(map[string]bool)
+	assigned[graphql.FormationObjectTypeRuntime] = make(map[string]bool)
+	for _, name := range current.Applications {
+		assigned[graphql.FormationObjectTypeApplication][name] = true
+	}
+	for _, name := range current.Runtimes {
+		assigned[graphql.FormationObjectTypeRuntime][name] = true
+	}
+
+	var missing []participant
+	for _, p := range participants {
+		if !assigned[p.objectType][p.name] {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
+
+func (imp *importer) assignParticipants(ctx context.Context, formation model.Formation, participants []participant) error {
+	for _, p := range participants {
+		if _, err := imp.formationSvc.AssignFormation(ctx, imp.tenantID, p.id, p.objectType, formation, nil); err != nil {
+			return errors.Wrapf(err, "while assigning %s %q to formation %q", strings.ToLower(string(p.objectType)), p.name, formation.Name)
+		}
+	}
+	return nil
+}
+
+func (imp *importer) namesByID(objectType model.FormationAssignmentType) map[string]string {
+	names := make(map[string]string)
+	if objectType == model.FormationAssignmentTypeApplication {
+		for name, apps := range imp.apps {
+			for _, app := range apps {
+				names[app.ID] = name
+			}
+		}
+		return names
+	}
+	for name, runtimes := range imp.runtimes {
+		for _, rt := range runtimes {
+			names[rt.ID] = name
+		}
+	}
+	return names
+}
+
+func (imp *importer) importLabelDefinitions(ctx context.Context, doc *Document) error {
+	for _, entry := range doc.LabelDefinitions {
+		if entry.Key != model.ScenariosKey {
+			imp.report(model.TenantConfigurationObjectTypeLabelDefinition, entry.Key, nil, model.TenantConfigurationImportActionConflict,
+				fmt.Sprintf("only the %q label definition can be imported", model.ScenariosKey))
+			continue
+		}
+
+		formationNames, err := scenariosFromSchema(entry.Schema)
+		if err != nil {
+			return err
+		}
+
+		documentFormations := make(map[string]bool, len(doc.Formations))
+		for _, f := range doc.Formations {
+			documentFormations[f.Name] = true
+		}
+
+		var missing []string
+		for _, name := range formationNames {
+			if documentFormations[name] {
+				continue
+			}
+			if _, err := imp.formationSvc.GetFormationByName(ctx, name, imp.tenantID); err != nil {
+				if !apperrors.IsNotFoundError(err) {
+					return errors.Wrapf(err, "while getting formation %q", name)
+				}
+				missing = append(missing, name)
+			}
+		}
+
+		if len(missing) == 0 {
+			imp.report(model.TenantConfigurationObjectTypeLabelDefinition, entry.Key, nil, model.TenantConfigurationImportActionSkip, "")
+			continue
+		}
+
+		if !imp.dryRun() {
+			for _, name := range missing {
+				if _, err := imp.formationSvc.CreateFormation(ctx, imp.tenantID, model.Formation{Name: name}, model.DefaultTemplateName); err != nil {
+					return errors.Wrapf(err, "while creating formation %q", name)
+				}
+			}
+		}
+		imp.report(model.TenantConfigurationObjectTypeLabelDefinition, entry.Key, nil, model.TenantConfigurationImportActionUpdate,
+			fmt.Sprintf("created formations: %s", strings.Join(missing, ", ")))
+	}
+
+	return nil
+}
+
+func (imp *importer) formationTemplateID(ctx context.Context, name string) (string, error) {
+	if id, ok := imp.formationTemplateIDs[name]; ok {
+		return id, nil
+	}
+
+	existing, err := imp.listAllFormationTemplates(ctx, str.Ptr(name))
+	if err != nil {
+		return "", err
+	}
+	if len(existing) == 0 {
+		return "", nil
+	}
+
+	ft := imp.ownedFormationTemplate(existing)
+	imp.formationTemplateIDs[name] = ft.ID
+	return ft.ID, nil
+}
+
+// ownedFormationTemplate returns the formation template owned by the tenant if there is one among the templates with the same name
+func (imp *importer) ownedFormationTemplate(formationTemplates []*model.FormationTemplate) *model.FormationTemplate {
+	for _, ft := range formationTemplates {
+		if imp.ownsFormationTemplate(ft) {
+			return ft
+		}
+	}
+	return formationTemplates[0]
+}
+
+func (imp *importer) ownsFormationTemplate(ft *model.FormationTemplate) bool {
+	return ft.TenantID != nil && *ft.TenantID == imp.tenantID
+}
+
+// hasScope reports whether the caller has the given scope. Imports without scopes in the context are not allowed to modify shared objects.
+func hasScope(ctx context.Context, requiredScope string) bool {
+	present, err := scope.Contains(ctx, requiredScope)
+	return err == nil && present
+}
+
+func (imp *importer) applicationTemplateID(ctx context.Context, name string) (string, error) {
+	if id, ok := imp.appTemplateIDs[name]; ok {
+		return id, nil
+	}
+
+	existing, err := imp.appTemplateSvc.ListByName(ctx, name)
+	if err != nil {
+		return "", errors.Wrapf(err, "while listing application templates with name %q", name)
+	}
+	if len(existing) != 1 {
+		return "", nil
+	}
+
+	imp.appTemplateIDs[name] = existing[0].ID
+	return existing[0].ID, nil
+}
+
+func scenariosFromSchema(schema *graphql.JSONSchema) ([]string, error) {
+	if schema == nil {
+		return nil, nil
+	}
+
+	var parsed struct {
+		Items struct {
+			Enum []string `json:"enum"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal([]byte(*schema), &parsed); err != nil {
+		return nil, apperrors.NewInvalidDataError(fmt.Sprintf("invalid %q label definition schema: %s", model.ScenariosKey, err.Error()))
+	}
+
+	return parsed.Items.Enum, nil
+}
+
+func equalEntries(current, desired interface{}) bool {
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return false
+	}
+	desiredJSON, err := json.Marshal(desired)
+	if err != nil {
+		return false
+	}
+
+	var currentValue, desiredValue interface{}
+	if err = json.Unmarshal(currentJSON, &currentValue); err != nil {
+		return false
+	}
+	if err = json.Unmarshal(desiredJSON, &desiredValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(currentValue, desiredValue)
+}
+
+func namesForIDs(ids []string, names map[string]string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name, ok := names[id]; ok {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func optionalID(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,595 @@
+package tenantconfiguration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantconfiguration"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Export(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		constraintEntry := fixFormationConstraintEntry()
+
+		mocks.appSvc.On("ListAll", ctx).Return([]*model.Application{appModel}, nil).Once()
+		mocks.appSvc.On("ListLabels", ctx, appID).Return(appLabels, nil).Once()
+		mocks.webhookSvc.On("ListForApplication", ctx, appID).Return(appWebhooks, nil).Once()
+		mocks.bundleSvc.On("ListByApplicationIDNoPaging", ctx, appID).Return(appBundles, nil).Once()
+		mocks.appTemplateSvc.On("Get", ctx, appTemplateID).Return(appTemplateModel, nil).Once()
+		mocks.conv.On("ApplicationToEntry", appModel, appLabels, appWebhooks, appBundles, str.Ptr(appTemplateName)).Return(fixApplicationEntry(), nil).Once()
+
+		mocks.runtimeSvc.On("List", ctx, mock.Anything, 200, "").Return(fixRuntimePage(runtimeModel), nil).Once()
+		mocks.runtimeSvc.On("ListLabels", ctx, runtimeID).Return(runtimeLabels, nil).Once()
+		mocks.webhookSvc.On("ListForRuntime", ctx, runtimeID).Return(nil, nil).Once()
+		mocks.conv.On("RuntimeToInput", runtimeModel, runtimeLabels, []*model.Webhook(nil)).Return(fixRuntimeInput()).Once()
+
+		mocks.formationSvc.On("List", ctx, 200, "").Return(fixFormationPage(formationModel), nil).Once()
+		mocks.formationTemplateSvc.On("Get", ctx, formationTemplateID).Return(formationTemplateModel, nil).Once()
+		mocks.formationSvc.On("ListObjectIDsOfTypeForFormations", ctx, tenantID, []string{formationName}, model.FormationAssignmentTypeApplication).Return([]string{appID}, nil).Once()
+		mocks.formationSvc.On("ListObjectIDsOfTypeForFormations", ctx, tenantID, []string{formationName}, model.FormationAssignmentTypeRuntime).Return([]string{runtimeID}, nil).Once()
+
+		mocks.formationTemplateSvc.On("List", ctx, mock.Anything, (*string)(nil), 200, "").Return(fixFormationTemplatePage(formationTemplateModel), nil).Once()
+		mocks.conv.On("ApplicationTemplateToInput", appTemplateModel).Return(fixApplicationTemplateInput(), nil).Once()
+		mocks.webhookSvc.On("ListForFormationTemplate", ctx, tenantID, formationTemplateID).Return(nil, nil).Once()
+		mocks.conv.On("FormationTemplateToInput", formationTemplateModel, []*model.Webhook(nil)).Return(fixFormationTemplateInput()).Once()
+		mocks.constraintSvc.On("ListByFormationTemplateIDs", ctx, []string{formationTemplateID}).Return([][]*model.FormationConstraint{{constraintModel}}, nil).Once()
+		mocks.conv.On("FormationConstraintToEntry", constraintModel, []string{formationTemplateName}).Return(constraintEntry).Once()
+
+		mocks.labelDefSvc.On("List", ctx, tenantID).Return([]model.LabelDefinition{labelDefinitionModel}, nil).Once()
+		mocks.conv.On("LabelDefinitionToInput", labelDefinitionModel).Return(labelDefinitionIn, nil).Once()
+
+		svc := mocks.service()
+
+		// WHEN
+		doc, err := svc.Export(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixDocument(), doc)
+	})
+
+	t.Run("Error when tenant is missing in the context", func(t *testing.T) {
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		_, err := mocks.service().Export(context.TODO())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading tenant from context")
+	})
+
+	t.Run("Error when listing applications fails", func(t *testing.T) {
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		mocks.appSvc.On("ListAll", ctx).Return(nil, testErr).Once()
+
+		_, err := mocks.service().Export(ctx)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+
+	t.Run("Error when listing application labels fails", func(t *testing.T) {
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		mocks.appSvc.On("ListAll", ctx).Return([]*model.Application{appModel}, nil).Once()
+		mocks.appSvc.On("ListLabels", ctx, appID).Return(nil, testErr).Once()
+
+		_, err := mocks.service().Export(ctx)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while listing labels for application")
+	})
+}
+
+func TestService_Import(t *testing.T) {
+	formationTemplateIn := &model.FormationTemplateRegisterInput{Name: formationTemplateName, ApplicationTypes: []string{"app-type"}, RuntimeTypes: []string{"runtime-type"}}
+	constraintIn := &model.FormationConstraintInput{Name: constraintName}
+	appTemplateIn := model.ApplicationTemplateInput{Name: appTemplateName}
+	appIn := model.ApplicationRegisterInput{Name: appName, Labels: map[string]interface{}{"key": "value"}}
+	runtimeIn := model.RuntimeRegisterInput{Name: runtimeName, Labels: map[string]interface{}{"region": "eu-1"}}
+	notFoundErr := apperrors.NewNotFoundError(resource.Formations, formationName)
+
+	expectEmptyTenant := func(ctx context.Context, mocks *serviceMocks) {
+		mocks.appSvc.On("ListAll", ctx).Return(nil, nil).Once()
+		mocks.runtimeSvc.On("List", ctx, mock.Anything, 200, "").Return(fixRuntimePage(), nil).Once()
+		mocks.constraintSvc.On("List", ctx).Return(nil, nil).Once()
+		mocks.formationTemplateSvc.On("List", ctx, mock.Anything, str.Ptr(formationTemplateName), 200, "").Return(fixFormationTemplatePage(), nil).Once()
+		mocks.appTemplateSvc.On("ListByName", ctx, appTemplateName).Return(nil, nil).Once()
+	}
+	expectConversions := func(ctx context.Context, mocks *serviceMocks) {
+		mocks.conv.On("FormationTemplateInputFromEntry", fixFormationTemplateInput()).Return(formationTemplateIn, nil).Once()
+		mocks.conv.On("FormationConstraintInputFromEntry", &fixFormationConstraintEntry().FormationConstraintInput).Return(constraintIn).Once()
+		mocks.conv.On("ApplicationTemplateInputFromEntry", *fixApplicationTemplateInput()).Return(appTemplateIn, nil).Once()
+		mocks.conv.On("ApplicationInputFromEntry", ctx, fixApplicationEntry().ApplicationRegisterInput).Return(appIn, nil).Once()
+		mocks.conv.On("RuntimeInputFromEntry", *fixRuntimeInput()).Return(runtimeIn, nil).Once()
+	}
+
+	t.Run("Success in CREATE_ONLY mode for an empty tenant", func(t *testing.T) {
+		// GIVEN
+		ctx := ctxWithSharedObjectScopes
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		expectEmptyTenant(ctx, mocks)
+		expectConversions(ctx, mocks)
+
+		mocks.formationTemplateSvc.On("Create", ctx, formationTemplateIn).Return(formationTemplateID, nil).Once()
+		mocks.constraintSvc.On("Create", ctx, constraintIn).Return(constraintID, nil).Once()
+		mocks.constraintReferenceSvc.On("Create", ctx, &model.FormationTemplateConstraintReference{ConstraintID: constraintID, FormationTemplateID: formationTemplateID}).Return(nil).Once()
+		mocks.appTemplateSvc.On("Create", ctx, appTemplateIn).Return(appTemplateID, nil).Once()
+		mocks.appSvc.On("CreateFromTemplate", ctx, appIn, str.Ptr(appTemplateID), false).Return(appID, nil).Once()
+		mocks.runtimeSvc.On("Create", ctx, runtimeIn).Return(runtimeID, nil).Once()
+		mocks.formationSvc.On("GetFormationByName", ctx, formationName, tenantID).Return(nil, notFoundErr).Once()
+		mocks.formationSvc.On("CreateFormation", ctx, tenantID, model.Formation{Name: formationName}, formationTemplateName).Return(formationModel, nil).Once()
+		mocks.formationSvc.On("AssignFormation", ctx, tenantID, appID, graphql.FormationObjectTypeApplication, *formationModel, model.InitialConfigurations(nil)).Return(formationModel, nil).Once()
+		mocks.formationSvc.On("AssignFormation", ctx, tenantID, runtimeID, graphql.FormationObjectTypeRuntime, *formationModel, model.InitialConfigurations(nil)).Return(formationModel, nil).Once()
+
+		// WHEN
+		result, err := mocks.service().Import(ctx, fixDocument(), model.TenantConfigurationImportModeCreateOnly)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, tenantconfiguration.DocumentVersion, result.Version)
+		assert.Equal(t, model.TenantConfigurationImportModeCreateOnly, result.Mode)
+		assert.Equal(t, []*model.TenantConfigurationObjectResult{
+			{ObjectType: model.TenantConfigurationObjectTypeFormationTemplate, Name: formationTemplateName, ID: str.Ptr(formationTemplateID), Action: model.TenantConfigurationImportActionCreate},
+			{ObjectType: model.TenantConfigurationObjectTypeFormationConstraint, Name: constraintName, ID: str.Ptr(constraintID), Action: model.TenantConfigurationImportActionCreate},
+			{ObjectType: model.TenantConfigurationObjectTypeApplicationTemplate, Name: appTemplateName, ID: str.Ptr(appTemplateID), Action: model.TenantConfigurationImportActionCreate},
+			{ObjectType: model.TenantConfigurationObjectTypeApplication, Name: appName, ID: str.Ptr(appID), Action: model.TenantConfigurationImportActionCreate},
+			{ObjectType: model.TenantConfigurationObjectTypeRuntime, Name: runtimeName, ID: str.Ptr(runtimeID), Action: model.TenantConfigurationImportActionCreate},
+			{ObjectType: model.TenantConfigurationObjectTypeFormation, Name: formationName, ID: str.Ptr(formationID), Action: model.TenantConfigurationImportActionCreate},
+			{ObjectType: model.TenantConfigurationObjectTypeLabelDefinition, Name: model.ScenariosKey, Action: model.TenantConfigurationImportActionSkip},
+		}, result.Results)
+	})
+
+	t.Run("Success in DRY_RUN mode does not create anything", func(t *testing.T) {
+		// GIVEN
+		ctx := ctxWithSharedObjectScopes
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		expectEmptyTenant(ctx, mocks)
+		expectConversions(ctx, mocks)
+		mocks.formationSvc.On("GetFormationByName", ctx, formationName, tenantID).Return(nil, notFoundErr).Once()
+
+		// WHEN
+		result, err := mocks.service().Import(ctx, fixDocument(), model.TenantConfigurationImportModeDryRun)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, result.Results, 7)
+		for _, r := range result.Results[:6] {
+			assert.Equal(t, model.TenantConfigurationImportActionCreate, r.Action, r.Name)
+			assert.Nil(t, r.ID)
+		}
+		assert.Equal(t, model.TenantConfigurationImportActionSkip, result.Results[6].Action)
+	})
+
+	t.Run("Shared objects are not created without the scopes of their mutations", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		expectEmptyTenant(ctx, mocks)
+		mocks.conv.On("FormationTemplateInputFromEntry", fixFormationTemplateInput()).Return(formationTemplateIn, nil).Once()
+		mocks.conv.On("FormationConstraintInputFromEntry", &fixFormationConstraintEntry().FormationConstraintInput).Return(constraintIn).Once()
+		mocks.conv.On("ApplicationTemplateInputFromEntry", *fixApplicationTemplateInput()).Return(appTemplateIn, nil).Once()
+		mocks.formationTemplateSvc.On("Create", ctx, formationTemplateIn).Return(formationTemplateID, nil).Once()
+
+		doc := &tenantconfiguration.Document{
+			Version:              tenantconfiguration.DocumentVersion,
+			ApplicationTemplates: []*graphql.ApplicationTemplateInput{fixApplicationTemplateInput()},
+			FormationTemplates:   []*graphql.FormationTemplateRegisterInput{fixFormationTemplateInput()},
+			FormationConstraints: []*tenantconfiguration.FormationConstraintEntry{fixFormationConstraintEntry()},
+		}
+
+		// WHEN
+		result, err := mocks.service().Import(ctx, doc, model.TenantConfigurationImportModeUpsert)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, result.Results, 3)
+		assert.Equal(t, model.TenantConfigurationImportActionCreate, result.Results[0].Action)
+		assert.Equal(t, model.TenantConfigurationImportActionConflict, result.Results[1].Action)
+		assert.Contains(t, *result.Results[1].Message, `"formation_constraint:write" scope`)
+		assert.Equal(t, model.TenantConfigurationImportActionConflict, result.Results[2].Action)
+		assert.Contains(t, *result.Results[2].Message, `"application_template:write" scope`)
+	})
+
+	t.Run("Existing formation templates are updated only when owned by the tenant or with the formation template scope", func(t *testing.T) {
+		differentInput := fixFormationTemplateInput()
+		differentInput.ApplicationTypes = []string{"other-app-type"}
+		globalFormationTemplate := &model.FormationTemplate{ID: formationTemplateID, Name: formationTemplateName}
+
+		testCases := []struct {
+			Name            string
+			Ctx             context.Context
+			Mode            model.TenantConfigurationImportMode
+			Existing        *model.FormationTemplate
+			Current         *graphql.FormationTemplateRegisterInput
+			ExpectUpdate    bool
+			ExpectedAction  model.TenantConfigurationImportAction
+			ExpectedMessage string
+		}{
+			{Name: "equal", Ctx: ctx, Mode: model.TenantConfigurationImportModeUpsert, Existing: globalFormationTemplate, Current: fixFormationTemplateInput(), ExpectedAction: model.TenantConfigurationImportActionSkip},
+			{Name: "different in CREATE_ONLY", Ctx: ctx, Mode: model.TenantConfigurationImportModeCreateOnly, Existing: formationTemplateModel, Current: differentInput, ExpectedAction: model.TenantConfigurationImportActionConflict, ExpectedMessage: "formation template differs from the document"},
+			{Name: "different in DRY_RUN", Ctx: ctx, Mode: model.TenantConfigurationImportModeDryRun, Existing: formationTemplateModel, Current: differentInput, ExpectedAction: model.TenantConfigurationImportActionUpdate, ExpectedMessage: "webhooks and labels of existing formation templates are not modified"},
+			{Name: "different in UPSERT when owned by the tenant", Ctx: ctx, Mode: model.TenantConfigurationImportModeUpsert, Existing: formationTemplateModel, Current: differentInput, ExpectUpdate: true, ExpectedAction: model.TenantConfigurationImportActionUpdate, ExpectedMessage: "webhooks and labels of existing formation templates are not modified"},
+			{Name: "different global template in DRY_RUN without scope", Ctx: ctx, Mode: model.TenantConfigurationImportModeDryRun, Existing: globalFormationTemplate, Current: differentInput, ExpectedAction: model.TenantConfigurationImportActionConflict, ExpectedMessage: `"formation_template:write" scope`},
+			{Name: "different global template in UPSERT without scope", Ctx: ctx, Mode: model.TenantConfigurationImportModeUpsert, Existing: globalFormationTemplate, Current: differentInput, ExpectedAction: model.TenantConfigurationImportActionConflict, ExpectedMessage: `"formation_template:write" scope`},
+			{Name: "different global template in UPSERT with scope", Ctx: ctxWithSharedObjectScopes, Mode: model.TenantConfigurationImportModeUpsert, Existing: globalFormationTemplate, Current: differentInput, ExpectUpdate: true, ExpectedAction: model.TenantConfigurationImportActionUpdate, ExpectedMessage: "webhooks and labels of existing formation templates are not modified"},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				// GIVEN
+				ctx := testCase.Ctx
+				mocks := newServiceMocks()
+				defer mocks.assertExpectations(t)
+
+				mocks.appSvc.On("ListAll", ctx).Return(nil, nil).Once()
+				mocks.runtimeSvc.On("List", ctx, mock.Anything, 200, "").Return(fixRuntimePage(), nil).Once()
+				mocks.constraintSvc.On("List", ctx).Return(nil, nil).Once()
+				mocks.formationTemplateSvc.On("List", ctx, mock.Anything, str.Ptr(formationTemplateName), 200, "").Return(fixFormationTemplatePage(testCase.Existing), nil).Once()
+				mocks.conv.On("FormationTemplateInputFromEntry", fixFormationTemplateInput()).Return(formationTemplateIn, nil).Once()
+				mocks.webhookSvc.On("ListForFormationTemplate", ctx, tenantID, formationTemplateID).Return(nil, nil).Once()
+				mocks.conv.On("FormationTemplateToInput", testCase.Existing, []*model.Webhook(nil)).Return(testCase.Current).Once()
+				if testCase.ExpectUpdate {
+					mocks.formationTemplateSvc.On("Update", ctx, formationTemplateID, &model.FormationTemplateUpdateInput{
+						Name:             formationTemplateName,
+						ApplicationTypes: formationTemplateIn.ApplicationTypes,
+						RuntimeTypes:     formationTemplateIn.RuntimeTypes,
+					}).Return(nil).Once()
+				}
+
+				doc := &tenantconfiguration.Document{
+					Version:            tenantconfiguration.DocumentVersion,
+					FormationTemplates: []*graphql.FormationTemplateRegisterInput{fixFormationTemplateInput()},
+				}
+
+				// WHEN
+				result, err := mocks.service().Import(ctx, doc, testCase.Mode)
+
+				// THEN
+				require.NoError(t, err)
+				require.Len(t, result.Results, 1)
+				assert.Equal(t, testCase.ExpectedAction, result.Results[0].Action)
+				assert.Equal(t, str.Ptr(formationTemplateID), result.Results[0].ID)
+				if testCase.ExpectedMessage != "" {
+					assert.Contains(t, *result.Results[0].Message, testCase.ExpectedMessage)
+				}
+			})
+		}
+	})
+
+	t.Run("Existing formation constraints are updated only with the formation constraint scope", func(t *testing.T) {
+		differentEntry := fixFormationConstraintEntry()
+		differentEntry.InputTemplate = `{"changed": true}`
+
+		testCases := []struct {
+			Name            string
+			Ctx             context.Context
+			Mode            model.TenantConfigurationImportMode
+			Current         *tenantconfiguration.FormationConstraintEntry
+			ExpectUpdate    bool
+			ExpectedAction  model.TenantConfigurationImportAction
+			ExpectedMessage string
+		}{
+			{Name: "equal", Ctx: ctx, Mode: model.TenantConfigurationImportModeUpsert, Current: fixFormationConstraintEntry(), ExpectedAction: model.TenantConfigurationImportActionSkip},
+			{Name: "different in CREATE_ONLY", Ctx: ctxWithSharedObjectScopes, Mode: model.TenantConfigurationImportModeCreateOnly, Current: differentEntry, ExpectedAction: model.TenantConfigurationImportActionConflict, ExpectedMessage: "formation constraint differs from the document"},
+			{Name: "different in DRY_RUN with scope", Ctx: ctxWithSharedObjectScopes, Mode: model.TenantConfigurationImportModeDryRun, Current: differentEntry, ExpectedAction: model.TenantConfigurationImportActionUpdate},
+			{Name: "different in DRY_RUN without scope", Ctx: ctx, Mode: model.TenantConfigurationImportModeDryRun, Current: differentEntry, ExpectedAction: model.TenantConfigurationImportActionConflict, ExpectedMessage: `"formation_constraint:write" scope`},
+			{Name: "different in UPSERT without scope", Ctx: ctx, Mode: model.TenantConfigurationImportModeUpsert, Current: differentEntry, ExpectedAction: model.TenantConfigurationImportActionConflict, ExpectedMessage: `"formation_constraint:write" scope`},
+			{Name: "different in UPSERT with scope", Ctx: ctxWithSharedObjectScopes, Mode: model.TenantConfigurationImportModeUpsert, Current: differentEntry, ExpectUpdate: true, ExpectedAction: model.TenantConfigurationImportActionUpdate},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				// GIVEN
+				ctx := testCase.Ctx
+				mocks := newServiceMocks()
+				defer mocks.assertExpectations(t)
+
+				mocks.appSvc.On("ListAll", ctx).Return(nil, nil).Once()
+				mocks.runtimeSvc.On("List", ctx, mock.Anything, 200, "").Return(fixRuntimePage(), nil).Once()
+				mocks.constraintSvc.On("List", ctx).Return([]*model.FormationConstraint{constraintModel}, nil).Once()
+				mocks.formationTemplateSvc.On("List", ctx, mock.Anything, str.Ptr(formationTemplateName), 200, "").Return(fixFormationTemplatePage(formationTemplateModel), nil).Once()
+				mocks.conv.On("FormationConstraintInputFromEntry", &fixFormationConstraintEntry().FormationConstraintInput).Return(constraintIn).Once()
+				mocks.constraintSvc.On("ListByFormationTemplateIDs", ctx, []string{formationTemplateID}).Return([][]*model.FormationConstraint{{constraintModel}}, nil).Once()
+				mocks.conv.On("FormationConstraintToEntry", constraintModel, []string(nil)).Return(testCase.Current).Once()
+				if testCase.ExpectUpdate {
+					mocks.constraintSvc.On("Update", ctx, constraintID, constraintIn).Return(nil).Once()
+				}
+
+				doc := &tenantconfiguration.Document{
+					Version:              tenantconfiguration.DocumentVersion,
+					FormationConstraints: []*tenantconfiguration.FormationConstraintEntry{fixFormationConstraintEntry()},
+				}
+
+				// WHEN
+				result, err := mocks.service().Import(ctx, doc, testCase.Mode)
+
+				// THEN
+				require.NoError(t, err)
+				require.Len(t, result.Results, 1)
+				assert.Equal(t, testCase.ExpectedAction, result.Results[0].Action)
+				assert.Equal(t, str.Ptr(constraintID), result.Results[0].ID)
+				if testCase.ExpectedMessage != "" {
+					assert.Contains(t, *result.Results[0].Message, testCase.ExpectedMessage)
+				}
+			})
+		}
+	})
+
+	t.Run("Existing application templates are updated only with the application template scope", func(t *testing.T) {
+		differentInput := fixApplicationTemplateInput()
+		differentInput.Description = str.Ptr("different description")
+
+		testCases := []struct {
+			Name            string
+			Ctx             context.Context
+			Mode            model.TenantConfigurationImportMode
+			Current         *graphql.ApplicationTemplateInput
+			ExpectUpdate    bool
+			ExpectedAction  model.TenantConfigurationImportAction
+			ExpectedMessage string
+		}{
+			{Name: "equal", Ctx: ctx, Mode: model.TenantConfigurationImportModeUpsert, Current: fixApplicationTemplateInput(), ExpectedAction: model.TenantConfigurationImportActionSkip},
+			{Name: "different in CREATE_ONLY", Ctx: ctxWithSharedObjectScopes, Mode: model.TenantConfigurationImportModeCreateOnly, Current: differentInput, ExpectedAction: model.TenantConfigurationImportActionConflict, ExpectedMessage: "application template differs from the document"},
+			{Name: "different in DRY_RUN with scope", Ctx: ctxWithSharedObjectScopes, Mode: model.TenantConfigurationImportModeDryRun, Current: differentInput, ExpectedAction: model.TenantConfigurationImportActionUpdate},
+			{Name: "different in DRY_RUN without scope", Ctx: ctx, Mode: model.TenantConfigurationImportModeDryRun, Current: differentInput, ExpectedAction: model.TenantConfigurationImportActionConflict, ExpectedMessage: `"application_template:write" scope`},
+			{Name: "different in UPSERT without scope", Ctx: ctx, Mode: model.TenantConfigurationImportModeUpsert, Current: differentInput, ExpectedAction: model.TenantConfigurationImportActionConflict, ExpectedMessage: `"application_template:write" scope`},
+			{Name: "different in UPSERT with scope", Ctx: ctxWithSharedObjectScopes, Mode: model.TenantConfigurationImportModeUpsert, Current: differentInput, ExpectUpdate: true, ExpectedAction: model.TenantConfigurationImportActionUpdate},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				// GIVEN
+				ctx := testCase.Ctx
+				mocks := newServiceMocks()
+				defer mocks.assertExpectations(t)
+
+				mocks.appSvc.On("ListAll", ctx).Return(nil, nil).Once()
+				mocks.runtimeSvc.On("List", ctx, mock.Anything, 200, "").Return(fixRuntimePage(), nil).Once()
+				mocks.constraintSvc.On("List", ctx).Return(nil, nil).Once()
+				mocks.appTemplateSvc.On("ListByName", ctx, appTemplateName).Return([]*model.ApplicationTemplate{appTemplateModel}, nil).Once()
+				mocks.conv.On("ApplicationTemplateInputFromEntry", *fixApplicationTemplateInput()).Return(appTemplateIn, nil).Once()
+				mocks.conv.On("ApplicationTemplateToInput", appTemplateModel).Return(testCase.Current, nil).Once()
+				if testCase.ExpectUpdate {
+					mocks.appTemplateSvc.On("Update", ctx, appTemplateID, false, model.ApplicationTemplateUpdateInput{Name: appTemplateName}).Return(nil).Once()
+				}
+
+				doc := &tenantconfiguration.Document{
+					Version:              tenantconfiguration.DocumentVersion,
+					ApplicationTemplates: []*graphql.ApplicationTemplateInput{fixApplicationTemplateInput()},
+				}
+
+				// WHEN
+				result, err := mocks.service().Import(ctx, doc, testCase.Mode)
+
+				// THEN
+				require.NoError(t, err)
+				require.Len(t, result.Results, 1)
+				assert.Equal(t, testCase.ExpectedAction, result.Results[0].Action)
+				assert.Equal(t, str.Ptr(appTemplateID), result.Results[0].ID)
+				if testCase.ExpectedMessage != "" {
+					assert.Contains(t, *result.Results[0].Message, testCase.ExpectedMessage)
+				}
+			})
+		}
+	})
+
+	t.Run("Existing runtimes are skipped when equal, conflict in CREATE_ONLY and are updated in UPSERT mode", func(t *testing.T) {
+		differentRuntimeInput := fixRuntimeInput()
+		differentRuntimeInput.Labels = graphql.Labels{"region": "eu-2"}
+
+		testCases := []struct {
+			Name           string
+			Mode           model.TenantConfigurationImportMode
+			Current        *graphql.RuntimeRegisterInput
+			ExpectUpdate   bool
+			ExpectedAction model.TenantConfigurationImportAction
+		}{
+			{Name: "equal", Mode: model.TenantConfigurationImportModeCreateOnly, Current: fixRuntimeInput(), ExpectedAction: model.TenantConfigurationImportActionSkip},
+			{Name: "different in CREATE_ONLY", Mode: model.TenantConfigurationImportModeCreateOnly, Current: differentRuntimeInput, ExpectedAction: model.TenantConfigurationImportActionConflict},
+			{Name: "different in DRY_RUN", Mode: model.TenantConfigurationImportModeDryRun, Current: differentRuntimeInput, ExpectedAction: model.TenantConfigurationImportActionUpdate},
+			{Name: "different in UPSERT", Mode: model.TenantConfigurationImportModeUpsert, Current: differentRuntimeInput, ExpectUpdate: true, ExpectedAction: model.TenantConfigurationImportActionUpdate},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				// GIVEN
+				mocks := newServiceMocks()
+				defer mocks.assertExpectations(t)
+
+				mocks.appSvc.On("ListAll", ctx).Return(nil, nil).Once()
+				mocks.runtimeSvc.On("List", ctx, mock.Anything, 200, "").Return(fixRuntimePage(runtimeModel), nil).Once()
+				mocks.constraintSvc.On("List", ctx).Return(nil, nil).Once()
+				mocks.conv.On("RuntimeInputFromEntry", *fixRuntimeInput()).Return(runtimeIn, nil).Once()
+				mocks.runtimeSvc.On("ListLabels", ctx, runtimeID).Return(runtimeLabels, nil).Once()
+				mocks.webhookSvc.On("ListForRuntime", ctx, runtimeID).Return(nil, nil).Once()
+				mocks.conv.On("RuntimeToInput", runtimeModel, runtimeLabels, []*model.Webhook(nil)).Return(testCase.Current).Once()
+				if testCase.ExpectUpdate {
+					mocks.runtimeSvc.On("Update", ctx, runtimeID, model.RuntimeUpdateInput{Name: runtimeName, Labels: runtimeIn.Labels}).Return(nil).Once()
+				}
+
+				doc := &tenantconfiguration.Document{
+					Version:  tenantconfiguration.DocumentVersion,
+					Runtimes: []*graphql.RuntimeRegisterInput{fixRuntimeInput()},
+				}
+
+				// WHEN
+				result, err := mocks.service().Import(ctx, doc, testCase.Mode)
+
+				// THEN
+				require.NoError(t, err)
+				require.Len(t, result.Results, 1)
+				assert.Equal(t, testCase.ExpectedAction, result.Results[0].Action)
+				assert.Equal(t, str.Ptr(runtimeID), result.Results[0].ID)
+			})
+		}
+	})
+
+	t.Run("Existing applications keep their status, lose stale labels and are reported as partial when webhooks differ", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		connectedApp := &model.Application{
+			Name:       appName,
+			Status:     &model.ApplicationStatus{Condition: model.ApplicationStatusConditionConnected},
+			BaseEntity: &model.BaseEntity{ID: appID},
+		}
+		currentEntry := &tenantconfiguration.ApplicationEntry{
+			ApplicationRegisterInput: graphql.ApplicationRegisterInput{
+				Name:     appName,
+				Labels:   graphql.Labels{"key": "value", "stale": "value", "name": appName},
+				Webhooks: []*graphql.WebhookInput{{Type: graphql.WebhookTypeConfigurationChanged, URL: str.Ptr("https://example.com")}},
+			},
+		}
+		desiredEntry := &tenantconfiguration.ApplicationEntry{
+			ApplicationRegisterInput: graphql.ApplicationRegisterInput{
+				Name:   appName,
+				Labels: graphql.Labels{"key": "new-value"},
+			},
+		}
+		appIn := model.ApplicationRegisterInput{Name: appName, Labels: map[string]interface{}{"key": "new-value"}}
+		connected := model.ApplicationStatusConditionConnected
+
+		mocks.appSvc.On("ListAll", ctx).Return([]*model.Application{connectedApp}, nil).Once()
+		mocks.runtimeSvc.On("List", ctx, mock.Anything, 200, "").Return(fixRuntimePage(), nil).Once()
+		mocks.constraintSvc.On("List", ctx).Return(nil, nil).Once()
+		mocks.conv.On("ApplicationInputFromEntry", ctx, desiredEntry.ApplicationRegisterInput).Return(appIn, nil).Once()
+		mocks.appSvc.On("ListLabels", ctx, appID).Return(appLabels, nil).Once()
+		mocks.webhookSvc.On("ListForApplication", ctx, appID).Return(appWebhooks, nil).Once()
+		mocks.bundleSvc.On("ListByApplicationIDNoPaging", ctx, appID).Return(nil, nil).Once()
+		mocks.conv.On("ApplicationToEntry", connectedApp, appLabels, appWebhooks, []*model.Bundle(nil), (*string)(nil)).Return(currentEntry, nil).Once()
+		mocks.appSvc.On("Update", ctx, appID, model.ApplicationUpdateInput{StatusCondition: &connected}).Return(nil).Once()
+		mocks.appSvc.On("DeleteLabel", ctx, appID, "stale").Return(nil).Once()
+		mocks.appSvc.On("SetLabel", ctx, &model.LabelInput{Key: "key", Value: "new-value", ObjectID: appID, ObjectType: model.ApplicationLabelableObject}).Return(nil).Once()
+
+		doc := &tenantconfiguration.Document{
+			Version:      tenantconfiguration.DocumentVersion,
+			Applications: []*tenantconfiguration.ApplicationEntry{desiredEntry},
+		}
+
+		// WHEN
+		result, err := mocks.service().Import(ctx, doc, model.TenantConfigurationImportModeUpsert)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, model.TenantConfigurationImportActionPartial, result.Results[0].Action)
+		assert.Equal(t, "webhooks and bundles of existing applications are not modified", *result.Results[0].Message)
+	})
+
+	t.Run("Formations with unknown participants are reported as conflicts", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		mocks.appSvc.On("ListAll", ctx).Return(nil, nil).Once()
+		mocks.runtimeSvc.On("List", ctx, mock.Anything, 200, "").Return(fixRuntimePage(), nil).Once()
+		mocks.constraintSvc.On("List", ctx).Return(nil, nil).Once()
+
+		doc := &tenantconfiguration.Document{
+			Version:    tenantconfiguration.DocumentVersion,
+			Formations: []*tenantconfiguration.FormationEntry{fixFormationEntry()},
+		}
+
+		// WHEN
+		result, err := mocks.service().Import(ctx, doc, model.TenantConfigurationImportModeUpsert)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, model.TenantConfigurationImportActionConflict, result.Results[0].Action)
+		assert.Contains(t, *result.Results[0].Message, `application "app-name"`)
+		assert.Contains(t, *result.Results[0].Message, `runtime "runtime-name"`)
+	})
+
+	t.Run("Existing formations get missing participants assigned in UPSERT mode", func(t *testing.T) {
+		// GIVEN
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		mocks.appSvc.On("ListAll", ctx).Return([]*model.Application{appModel}, nil).Once()
+		mocks.runtimeSvc.On("List", ctx, mock.Anything, 200, "").Return(fixRuntimePage(runtimeModel), nil).Once()
+		mocks.constraintSvc.On("List", ctx).Return(nil, nil).Once()
+		mocks.formationSvc.On("GetFormationByName", ctx, formationName, tenantID).Return(formationModel, nil).Once()
+		mocks.formationTemplateSvc.On("Get", ctx, formationTemplateID).Return(formationTemplateModel, nil).Once()
+		mocks.formationSvc.On("ListObjectIDsOfTypeForFormations", ctx, tenantID, []string{formationName}, model.FormationAssignmentTypeApplication).Return([]string{appID}, nil).Once()
+		mocks.formationSvc.On("ListObjectIDsOfTypeForFormations", ctx, tenantID, []string{formationName}, model.FormationAssignmentTypeRuntime).Return(nil, nil).Once()
+		mocks.formationSvc.On("AssignFormation", ctx, tenantID, runtimeID, graphql.FormationObjectTypeRuntime, *formationModel, model.InitialConfigurations(nil)).Return(formationModel, nil).Once()
+
+		doc := &tenantconfiguration.Document{
+			Version:    tenantconfiguration.DocumentVersion,
+			Formations: []*tenantconfiguration.FormationEntry{fixFormationEntry()},
+		}
+
+		// WHEN
+		result, err := mocks.service().Import(ctx, doc, model.TenantConfigurationImportModeUpsert)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, model.TenantConfigurationImportActionUpdate, result.Results[0].Action)
+		assert.Equal(t, str.Ptr(formationID), result.Results[0].ID)
+	})
+
+	t.Run("Error for unsupported document version", func(t *testing.T) {
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		_, err := mocks.service().Import(ctx, &tenantconfiguration.Document{Version: "v0"}, model.TenantConfigurationImportModeUpsert)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported tenant configuration document version")
+	})
+
+	t.Run("Error for unsupported import mode", func(t *testing.T) {
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		_, err := mocks.service().Import(ctx, fixDocument(), "MERGE")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported import mode")
+	})
+
+	t.Run("Error when creating an object fails", func(t *testing.T) {
+		mocks := newServiceMocks()
+		defer mocks.assertExpectations(t)
+
+		mocks.appSvc.On("ListAll", ctx).Return(nil, nil).Once()
+		mocks.runtimeSvc.On("List", ctx, mock.Anything, 200, "").Return(fixRuntimePage(), nil).Once()
+		mocks.constraintSvc.On("List", ctx).Return(nil, nil).Once()
+		mocks.conv.On("RuntimeInputFromEntry", *fixRuntimeInput()).Return(runtimeIn, nil).Once()
+		mocks.runtimeSvc.On("Create", ctx, runtimeIn).Return("", testErr).Once()
+
+		doc := &tenantconfiguration.Document{
+			Version:  tenantconfiguration.DocumentVersion,
+			Runtimes: []*graphql.RuntimeRegisterInput{fixRuntimeInput()},
+		}
+
+		_, err := mocks.service().Import(ctx, doc, model.TenantConfigurationImportModeCreateOnly)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while creating runtime")
+	})
+}
@@ -79,6 +79,32 @@ func (_m *TenantParentRepository) ListParents(ctx context.Context, tenantID stri
 	return r0, r1
 }
 
+// ListParentsByTenantIDs provides a mock function with given fields: ctx, tenantIDs
+func (_m *TenantParentRepository) ListParentsByTenantIDs(ctx context.Context, tenantIDs []string) (map[string][]string, error) {
+	ret := _m.Called(ctx, tenantIDs)
+
+	var r0 map[string][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]string, error)); ok {
+		return rf(ctx, tenantIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]string); ok {
+		r0 = rf(ctx, tenantIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, tenantIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Upsert provides a mock function with given fields: ctx, tenantID, parentID
 func (_m *TenantParentRepository) Upsert(ctx context.Context, tenantID string, parentID string) error {
 	ret := _m.Called(ctx, tenantID, parentID)
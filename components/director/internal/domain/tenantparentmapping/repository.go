@@ -67,6 +67,7 @@ type pgRepository struct {
 //go:generate mockery --name=TenantParentRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type TenantParentRepository interface {
 	ListParents(ctx context.Context, tenantID string) ([]string, error)
+	ListParentsByTenantIDs(ctx context.Context, tenantIDs []string) (map[string][]string, error)
 	ListByParent(ctx context.Context, parentID string) ([]string, error)
 	UpsertMultiple(ctx context.Context, tenantID string, parentIDs []string) error
 	Upsert(ctx context.Context, tenantID string, parentID string) error
@@ -98,6 +99,30 @@ func (r *pgRepository) ListParents(ctx context.Context, tenantID string) ([]stri
 	return tenantParents.GetParentIDs(), nil
 }
 
+// ListParentsByTenantIDs lists the parents of each of the provided tenants. Tenants without parents are not present in the result.
+func (r *pgRepository) ListParentsByTenantIDs(ctx context.Context, tenantIDs []string) (map[string][]string, error) {
+	parentsByTenant := make(map[string][]string, len(tenantIDs))
+	if len(tenantIDs) == 0 {
+		return parentsByTenant, nil
+	}
+
+	tenantParents := TenantParentCollection{}
+	conditions := repo.Conditions{
+		repo.NewInConditionForStringValues(TenantIDColumn, tenantIDs),
+	}
+
+	if err := r.listerGlobal.ListGlobal(ctx, &tenantParents, conditions...); err != nil {
+		log.C(ctx).Error(persistence.MapSQLError(ctx, err, resource.TenantParent, resource.List, "while listing tenant parent records from '%s' table", TenantParentsTable))
+		return nil, err
+	}
+
+	for _, tp := range tenantParents {
+		parentsByTenant[tp.TenantID] = append(parentsByTenant[tp.TenantID], tp.ParentID)
+	}
+
+	return parentsByTenant, nil
+}
+
 // ListByParent lists all tenant ids by the provided parent id
 func (r *pgRepository) ListByParent(ctx context.Context, parentID string) ([]string, error) {
 	tenantParents := TenantParentCollection{}
@@ -258,6 +258,79 @@ func TestPgRepository_ListParents(t *testing.T) {
 	}
 }
 
+func TestPgRepository_ListParentsByTenantIDs(t *testing.T) {
+	secondTenantID := "second-tenant-id"
+
+	testCases := []struct {
+		Name                 string
+		DBFN                 func(t *testing.T) (*sqlx.DB, testdb.DBMock)
+		InputTenantIDs       []string
+		ExpectedParentIDs    map[string][]string
+		ExpectedErrorMessage string
+	}{
+		{
+			Name: "Success",
+			DBFN: func(t *testing.T) (*sqlx.DB, testdb.DBMock) {
+				db, dbMock := testdb.MockDatabase(t)
+
+				dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT tenant_id, parent_id FROM tenant_parents WHERE tenant_id IN ($1, $2)`)).
+					WithArgs([]driver.Value{tenantID, secondTenantID}...).
+					WillReturnRows(fixSQLTenantParentsRows([]sqlTenantParentsRow{
+						{tenantID: tenantID, parentID: parentID},
+						{tenantID: secondTenantID, parentID: parentID},
+						{tenantID: secondTenantID, parentID: tenantID},
+					}))
+				return db, dbMock
+			},
+			InputTenantIDs: []string{tenantID, secondTenantID},
+			ExpectedParentIDs: map[string][]string{
+				tenantID:       {parentID},
+				secondTenantID: {parentID, tenantID},
+			},
+		},
+		{
+			Name:              "Success without tenant IDs",
+			InputTenantIDs:    []string{},
+			ExpectedParentIDs: map[string][]string{},
+		},
+		{
+			Name: "Error while listing parents",
+			DBFN: func(t *testing.T) (*sqlx.DB, testdb.DBMock) {
+				db, dbMock := testdb.MockDatabase(t)
+				dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT tenant_id, parent_id FROM tenant_parents WHERE tenant_id IN ($1)`)).
+					WithArgs([]driver.Value{tenantID}...).
+					WillReturnError(testErr)
+				return db, dbMock
+			},
+			InputTenantIDs:       []string{tenantID},
+			ExpectedErrorMessage: "Unexpected error while executing SQL query",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db, dbMock := testdb.MockDatabase(t)
+			if testCase.DBFN != nil {
+				db, dbMock = testCase.DBFN(t)
+			}
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			tenantParentMappingRepo := tenantparentmapping.NewRepository()
+
+			parentIDs, err := tenantParentMappingRepo.ListParentsByTenantIDs(ctx, testCase.InputTenantIDs)
+
+			if testCase.ExpectedErrorMessage != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMessage)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedParentIDs, parentIDs)
+			}
+
+			dbMock.AssertExpectations(t)
+		})
+	}
+}
+
 func TestPgRepository_ListByParent(t *testing.T) {
 	testCases := []struct {
 		Name                 string
@@ -3,6 +3,7 @@ package tombstone
 import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -50,3 +51,17 @@ func (c *converter) FromEntity(entity *Entity) (*model.Tombstone, error) {
 
 	return output, nil
 }
+
+// ToGraphQL converts the internal model to its graphql representation
+func (c *converter) ToGraphQL(in *model.Tombstone) (*graphql.Tombstone, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	return &graphql.Tombstone{
+		ID:          in.ID,
+		OrdID:       in.OrdID,
+		RemovalDate: in.RemovalDate,
+		Description: in.Description,
+	}, nil
+}
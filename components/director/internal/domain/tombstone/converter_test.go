@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,3 +49,28 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := tombstone.NewConverter()
+
+		gqlTombstone, err := conv.ToGraphQL(fixTombstoneModelForApp())
+
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.Tombstone{
+			ID:          tombstoneID,
+			OrdID:       ordID,
+			RemovalDate: "removalDate",
+			Description: str.Ptr(description),
+		}, gqlTombstone)
+	})
+
+	t.Run("Returns nil if tombstone model is nil", func(t *testing.T) {
+		conv := tombstone.NewConverter()
+
+		gqlTombstone, err := conv.ToGraphQL(nil)
+
+		require.NoError(t, err)
+		require.Nil(t, gqlTombstone)
+	})
+}
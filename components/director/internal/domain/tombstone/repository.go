@@ -7,11 +7,17 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 )
 
-const tombstoneTable string = `public.tombstones`
+const (
+	tombstoneTable             string = `public.tombstones`
+	appTemplateVersionIDColumn        = "app_template_version_id"
+	appIDColumn                       = "app_id"
+	idColumn                          = "id"
+)
 
 var (
 	tombstoneColumns = []string{"ord_id", "app_id", "app_template_version_id", "removal_date", "id", "description"}
@@ -33,6 +39,8 @@ type pgRepository struct {
 	singleGetterGlobal repo.SingleGetterGlobal
 	lister             repo.Lister
 	listerGlobal       repo.ListerGlobal
+	unionLister        repo.UnionLister
+	unionListerGlobal  repo.UnionListerGlobal
 	deleter            repo.Deleter
 	creator            repo.Creator
 	creatorGlobal      repo.CreatorGlobal
@@ -49,6 +57,8 @@ func NewRepository(conv EntityConverter) *pgRepository {
 		singleGetterGlobal: repo.NewSingleGetterGlobal(resource.Tombstone, tombstoneTable, tombstoneColumns),
 		lister:             repo.NewLister(tombstoneTable, tombstoneColumns),
 		listerGlobal:       repo.NewListerGlobal(resource.Tombstone, tombstoneTable, tombstoneColumns),
+		unionLister:        repo.NewUnionLister(tombstoneTable, tombstoneColumns),
+		unionListerGlobal:  repo.NewUnionListerGlobal(resource.Tombstone, tombstoneTable, tombstoneColumns),
 		deleter:            repo.NewDeleter(tombstoneTable),
 		creator:            repo.NewCreator(tombstoneTable, tombstoneColumns),
 		creatorGlobal:      repo.NewCreatorGlobal(resource.Tombstone, tombstoneTable, tombstoneColumns),
@@ -166,6 +176,64 @@ func (r *pgRepository) ListByResourceID(ctx context.Context, tenantID, resourceI
 	return tombstones, nil
 }
 
+// ListByResourceIDs gets a page of tombstones for each of the given resource IDs. The resources are either applications or application template versions
+func (r *pgRepository) ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.TombstonePage, error) {
+	tombstoneCollection := tombstoneCollection{}
+
+	var counts map[string]int
+	var err error
+	if resourceType == resource.Application {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionLister.List(ctx, resource.Tombstone, tenantID, resourceIDs, appIDColumn, pageSize, cursor, orderByColumns, &tombstoneCollection)
+	} else {
+		orderByColumns := repo.OrderByParams{repo.NewAscOrderBy(appTemplateVersionIDColumn), repo.NewAscOrderBy(idColumn)}
+		counts, err = r.unionListerGlobal.ListGlobal(ctx, resourceIDs, appTemplateVersionIDColumn, pageSize, cursor, orderByColumns, &tombstoneCollection)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tombstonesByResourceID := make(map[string][]*model.Tombstone, len(resourceIDs))
+	for _, tombstone := range tombstoneCollection {
+		tombstoneModel, err := r.conv.FromEntity(&tombstone)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceID := tombstone.ApplicationID.String
+		if resourceType != resource.Application {
+			resourceID = tombstone.ApplicationTemplateVersionID.String
+		}
+		tombstonesByResourceID[resourceID] = append(tombstonesByResourceID[resourceID], tombstoneModel)
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	tombstonePages := make([]*model.TombstonePage, 0, len(resourceIDs))
+	for _, resourceID := range resourceIDs {
+		totalCount := counts[resourceID]
+		hasNextPage := false
+		endCursor := ""
+		if totalCount > offset+len(tombstonesByResourceID[resourceID]) {
+			hasNextPage = true
+			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		}
+
+		page := &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		}
+
+		tombstonePages = append(tombstonePages, &model.TombstonePage{Data: tombstonesByResourceID[resourceID], TotalCount: totalCount, PageInfo: page})
+	}
+
+	return tombstonePages, nil
+}
+
 type tombstoneCollection []Entity
 
 // Len missing godoc
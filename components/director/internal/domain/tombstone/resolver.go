@@ -0,0 +1,80 @@
+package tombstone
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordpage"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// TombstoneService is responsible for the service-layer Tombstone operations.
+//
+//go:generate mockery --name=TombstoneService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type TombstoneService interface {
+	ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.TombstonePage, error)
+}
+
+// GraphQLConverter converts Tombstones from the model.Tombstone service-layer representation to the graphql-layer representation.
+//
+//go:generate mockery --name=GraphQLConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type GraphQLConverter interface {
+	ToGraphQL(in *model.Tombstone) (*graphql.Tombstone, error)
+}
+
+// Resolver is an object responsible for resolver-layer Tombstone operations.
+type Resolver struct {
+	pages *ordpage.Resolver[*model.TombstonePage, *graphql.TombstonePage]
+}
+
+// NewResolver returns a new object responsible for resolver-layer Tombstone operations.
+func NewResolver(transact persistence.Transactioner, tombstoneSvc TombstoneService, tombstoneConverter GraphQLConverter, appTemplateVersionSvc ordpage.ApplicationTemplateVersionService) *Resolver {
+	toGraphQLPage := func(page *model.TombstonePage) (*graphql.TombstonePage, error) {
+		data, err := ordpage.ConvertData(page.Data, tombstoneConverter.ToGraphQL)
+		if err != nil {
+			return nil, err
+		}
+
+		return &graphql.TombstonePage{
+			Data:       data,
+			TotalCount: page.TotalCount,
+			PageInfo:   ordpage.ToGraphQLPageInfo(page.PageInfo),
+		}, nil
+	}
+	emptyPage := func() *graphql.TombstonePage {
+		return &graphql.TombstonePage{Data: []*graphql.Tombstone{}, PageInfo: &graphql.PageInfo{}}
+	}
+
+	return &Resolver{
+		pages: ordpage.NewResolver(transact, appTemplateVersionSvc, tombstoneSvc.ListByResourceIDs, toGraphQLPage, emptyPage),
+	}
+}
+
+// ApplicationTombstones fetches a page of tombstones for an Application
+func (r *Resolver) ApplicationTombstones(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.TombstonePage, error) {
+	param := dataloader.ParamTombstone{ID: obj.ID, Ctx: ctx, First: first, After: after}
+	return dataloader.TombstoneFor(ctx).TombstoneByID.Load(param)
+}
+
+// ApplicationTombstonesDataLoader retrieves a page of tombstones for each Application ID in the keys argument
+func (r *Resolver) ApplicationTombstonesDataLoader(keys []dataloader.ParamTombstone) ([]*graphql.TombstonePage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	return r.pages.ApplicationPages(keys[0].Ctx, applicationIDs, keys[0].First, keys[0].After)
+}
+
+// ApplicationTemplateTombstones fetches a page of tombstones of the latest version of an Application Template
+func (r *Resolver) ApplicationTemplateTombstones(ctx context.Context, obj *graphql.ApplicationTemplate, first *int, after *graphql.PageCursor) (*graphql.TombstonePage, error) {
+	return r.pages.ApplicationTemplatePage(ctx, obj.ID, first, after)
+}
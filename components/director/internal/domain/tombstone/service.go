@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pkg/errors"
 )
@@ -24,6 +25,7 @@ type TombstoneRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.Tombstone, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.Tombstone, error)
 	ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*model.Tombstone, error)
+	ListByResourceIDs(ctx context.Context, tenantID string, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.TombstonePage, error)
 }
 
 // UIDService missing godoc
@@ -136,6 +138,24 @@ func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, id str
 	return s.tombstoneRepo.ListByResourceID(ctx, "", id, resource.ApplicationTemplateVersion)
 }
 
+// ListByResourceIDs lists a page of tombstones for each of the given application or application template version IDs
+func (s *service) ListByResourceIDs(ctx context.Context, resourceType resource.Type, resourceIDs []string, pageSize int, cursor string) ([]*model.TombstonePage, error) {
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	if resourceType.IsTenantIgnorable() {
+		return s.tombstoneRepo.ListByResourceIDs(ctx, "", resourceType, resourceIDs, pageSize, cursor)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tombstoneRepo.ListByResourceIDs(ctx, tnt, resourceType, resourceIDs, pageSize, cursor)
+}
+
 func (s *service) createTombstone(ctx context.Context, tombstone *model.Tombstone, resourceType resource.Type) error {
 	if resourceType.IsTenantIgnorable() {
 		return s.tombstoneRepo.CreateGlobal(ctx, tombstone)
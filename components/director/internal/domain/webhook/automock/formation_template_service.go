@@ -13,6 +13,20 @@ type FormationTemplateService struct {
 	mock.Mock
 }
 
+// CreateVersion provides a mock function with given fields: ctx, id
+func (_m *FormationTemplateService) CreateVersion(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Exist provides a mock function with given fields: ctx, id
 func (_m *FormationTemplateService) Exist(ctx context.Context, id string) (bool, error) {
 	ret := _m.Called(ctx, id)
@@ -54,6 +54,7 @@ type runtimeService interface {
 //go:generate mockery --exported --name=formationTemplateService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type formationTemplateService interface {
 	Exist(ctx context.Context, id string) (bool, error)
+	CreateVersion(ctx context.Context, id string) error
 }
 
 // WebhookConverter missing godoc
@@ -136,6 +137,10 @@ func (r *Resolver) AddWebhook(ctx context.Context, applicationID *string, applic
 		return nil, err
 	}
 
+	if err = r.createFormationTemplateVersion(ctx, objectID, objectType); err != nil {
+		return nil, err
+	}
+
 	webhook, err := r.webhookSvc.Get(ctx, id, objectType)
 	if err != nil {
 		return nil, err
@@ -172,6 +177,10 @@ func (r *Resolver) UpdateWebhook(ctx context.Context, webhookID string, in graph
 		return nil, err
 	}
 
+	if err = r.createFormationTemplateVersion(ctx, webhook.ObjectID, webhook.ObjectType); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -203,6 +212,10 @@ func (r *Resolver) DeleteWebhook(ctx context.Context, webhookID string) (*graphq
 		return nil, err
 	}
 
+	if err = r.createFormationTemplateVersion(ctx, webhook.ObjectID, webhook.ObjectType); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -233,6 +246,15 @@ func (r *Resolver) checkForExistenceAndCreate(ctx context.Context, input model.W
 	return r.webhookSvc.Create(ctx, objectID, input, objectType)
 }
 
+// createFormationTemplateVersion creates a new version of the formation template when one of its webhooks is changed
+func (r *Resolver) createFormationTemplateVersion(ctx context.Context, objectID string, objectType model.WebhookReferenceObjectType) error {
+	if objectType != model.FormationTemplateWebhookReference {
+		return nil
+	}
+
+	return r.formationTemplateSvc.CreateVersion(ctx, objectID)
+}
+
 func (r *Resolver) genericCheckExistence(ctx context.Context, resourceID string, objectType model.WebhookReferenceObjectType, existsFunc existsFunc) error {
 	found, err := existsFunc(ctx, resourceID)
 	if err != nil {
@@ -73,6 +73,54 @@ func TestResolver_AddWebhook(t *testing.T) {
 			ExpectedWebhook: gqlWebhook,
 			ExpectedErr:     nil,
 		},
+		{
+			Name:            "Success for formation template webhook creates a new formation template version",
+			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ServiceFn: func() *automock.WebhookService {
+				svc := &automock.WebhookService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), givenFormationTemplateID, *modelWebhookInput, model.FormationTemplateWebhookReference).Return(id, nil).Once()
+				svc.On("Get", txtest.CtxWithDBMatcher(), id, model.FormationTemplateWebhookReference).Return(modelWebhook, nil).Once()
+				return svc
+			},
+			FormationTemplateServiceFn: func() *automock.FormationTemplateService {
+				ftSvc := &automock.FormationTemplateService{}
+				ftSvc.On("Exist", txtest.CtxWithDBMatcher(), givenFormationTemplateID).Return(true, nil).Once()
+				ftSvc.On("CreateVersion", txtest.CtxWithDBMatcher(), givenFormationTemplateID).Return(nil).Once()
+				return ftSvc
+			},
+			ConverterFn: func() *automock.WebhookConverter {
+				conv := &automock.WebhookConverter{}
+				conv.On("InputFromGraphQL", gqlWebhookInput).Return(modelWebhookInput, nil).Once()
+				conv.On("ToGraphQL", modelWebhook).Return(gqlWebhook, nil).Once()
+				return conv
+			},
+			ExpectedWebhook: gqlWebhook,
+			ExpectedErr:     nil,
+		},
+		{
+			Name:            "Returns error when creating formation template version fails",
+			PersistenceFn:   txtest.PersistenceContextThatDoesntExpectCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ServiceFn: func() *automock.WebhookService {
+				svc := &automock.WebhookService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), givenFormationTemplateID, *modelWebhookInput, model.FormationTemplateWebhookReference).Return(id, nil).Once()
+				return svc
+			},
+			FormationTemplateServiceFn: func() *automock.FormationTemplateService {
+				ftSvc := &automock.FormationTemplateService{}
+				ftSvc.On("Exist", txtest.CtxWithDBMatcher(), givenFormationTemplateID).Return(true, nil).Once()
+				ftSvc.On("CreateVersion", txtest.CtxWithDBMatcher(), givenFormationTemplateID).Return(testErr).Once()
+				return ftSvc
+			},
+			ConverterFn: func() *automock.WebhookConverter {
+				conv := &automock.WebhookConverter{}
+				conv.On("InputFromGraphQL", gqlWebhookInput).Return(modelWebhookInput, nil).Once()
+				return conv
+			},
+			ExpectedWebhook: nil,
+			ExpectedErr:     testErr,
+		},
 		{
 			Name:          "Returns error on starting transaction",
 			PersistenceFn: txtest.PersistenceContextThatDoesntExpectCommit,
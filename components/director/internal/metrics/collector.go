@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -21,6 +22,8 @@ type Collector struct {
 	hydraRequestTotal      *prometheus.CounterVec
 	hydraRequestDuration   *prometheus.HistogramVec
 	graphQLOperationCount  *prometheus.CounterVec
+	healthCheckTotal       *prometheus.CounterVec
+	healthCheckDuration    *prometheus.HistogramVec
 }
 
 // NewCollector missing godoc
@@ -58,6 +61,18 @@ func NewCollector(config Config) *Collector {
 			Name:      "graphql_operations_per_endpoint",
 			Help:      "Graphql Operations Per Operation",
 		}, []string{"operation_name", "operation_type"}),
+		healthCheckTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "health_check_total",
+			Help:      "Total executed probes of application endpoints",
+		}, []string{"type", "condition"}),
+		healthCheckDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "health_check_duration_seconds",
+			Help:      "Duration of the probes of application endpoints",
+		}, []string{"type"}),
 	}
 }
 
@@ -68,6 +83,8 @@ func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.hydraRequestTotal.Describe(ch)
 	c.hydraRequestDuration.Describe(ch)
 	c.graphQLOperationCount.Describe(ch)
+	c.healthCheckTotal.Describe(ch)
+	c.healthCheckDuration.Describe(ch)
 }
 
 // Collect missing godoc
@@ -77,6 +94,8 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.hydraRequestTotal.Collect(ch)
 	c.hydraRequestDuration.Collect(ch)
 	c.graphQLOperationCount.Collect(ch)
+	c.healthCheckTotal.Collect(ch)
+	c.healthCheckDuration.Collect(ch)
 }
 
 // GraphQLHandlerWithInstrumentation missing godoc
@@ -104,3 +123,12 @@ func (c *Collector) InstrumentGraphqlRequest(operationType, operationName string
 		"operation_type": operationType,
 	}).Inc()
 }
+
+// InstrumentHealthCheck records the outcome and the duration of a probe of an application endpoint
+func (c *Collector) InstrumentHealthCheck(checkType, condition string, duration time.Duration) {
+	c.healthCheckTotal.With(prometheus.Labels{
+		"type":      checkType,
+		"condition": condition,
+	}).Inc()
+	c.healthCheckDuration.With(prometheus.Labels{"type": checkType}).Observe(duration.Seconds())
+}
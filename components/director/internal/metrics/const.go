@@ -21,4 +21,10 @@ const (
 	ResourceTypeMetricLabel = "resource_type"
 	// CorrelationIDMetricLabel is an additional label used by ord aggregator for creating CounterVec for Prometheus
 	CorrelationIDMetricLabel = "x_request_id"
+	// UnchangedKindMetricLabel is the label used by ord aggregator to distinguish between unchanged documents and specifications
+	UnchangedKindMetricLabel = "kind"
+	// UnchangedDocumentKind is the value of the UnchangedKindMetricLabel for ORD documents skipped as unchanged
+	UnchangedDocumentKind = "document"
+	// UnchangedSpecificationKind is the value of the UnchangedKindMetricLabel for specifications skipped as unchanged
+	UnchangedSpecificationKind = "specification"
 )
@@ -95,6 +95,53 @@ func (p AggregationFailurePusher) ReportAggregationFailureORD(ctx context.Contex
 	p.push(ctx)
 }
 
+// AggregationUnchangedPusher is used for pushing metrics to Prometheus related to resources skipped during aggregation as unchanged.
+type AggregationUnchangedPusher struct {
+	unchangedCounter *prometheus.CounterVec
+	pusher           *push.Pusher
+	instanceID       uuid.UUID
+}
+
+// NewAggregationUnchangedPusher returns a new Prometheus metrics pusher that can be used to report resources skipped as unchanged.
+func NewAggregationUnchangedPusher(cfg PusherConfig) AggregationUnchangedPusher {
+	if !cfg.Enabled {
+		return AggregationUnchangedPusher{}
+	}
+	instanceID := uuid.New()
+
+	unchangedCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: cfg.Subsystem,
+		Name:      cfg.MetricName,
+		Help:      fmt.Sprintf("Resources skipped as unchanged during aggregation for %s", cfg.Subsystem),
+	}, cfg.Labels)
+
+	return AggregationUnchangedPusher{
+		unchangedCounter: unchangedCounter,
+		pusher:           newPusher(cfg, unchangedCounter),
+		instanceID:       instanceID,
+	}
+}
+
+// ReportUnchangedORD reports the number of resources of the given kind that were skipped during ORD aggregation as unchanged.
+func (p AggregationUnchangedPusher) ReportUnchangedORD(ctx context.Context, kind string, count int) {
+	if p.pusher == nil || count == 0 {
+		return
+	}
+
+	log.C(ctx).WithFields(logrus.Fields{InstanceIDKeyName: p.instanceID}).Infof("Reporting %d unchanged %s(s)...", count, kind)
+
+	currentResourceID, _ := log.C(ctx).Data["resource_id"].(string)
+	currentResourceType, _ := log.C(ctx).Data["resource_type"].(string)
+
+	p.unchangedCounter.WithLabelValues(kind, currentResourceID, currentResourceType).Add(float64(count))
+
+	if err := p.pusher.Add(); err != nil {
+		wrappedErr := errors.Wrap(err, "while pushing metrics to Pushgateway")
+		log.C(ctx).WithField(InstanceIDKeyName, p.instanceID).Error(wrappedErr)
+	}
+}
+
 func (p AggregationFailurePusher) push(ctx context.Context) {
 	if err := p.pusher.Add(); err != nil {
 		wrappedErr := errors.Wrap(err, "while pushing metrics to Pushgateway")
@@ -86,12 +86,53 @@ func (in ApplicationFromTemplateInputValues) FindPlaceholderValue(name string) (
 
 // ApplicationTemplatePlaceholder missing godoc
 type ApplicationTemplatePlaceholder struct {
-	Name        string
-	Description *string
-	JSONPath    *string
-	Optional    *bool
+	Name          string
+	Description   *string
+	JSONPath      *string
+	Optional      *bool
+	Type          *PlaceholderType
+	Pattern       *string
+	AllowedValues []string
+	DefaultValue  *string
+	Sensitive     *bool
 }
 
+// IsOptional reports whether a value can be omitted for the placeholder
+func (p ApplicationTemplatePlaceholder) IsOptional() bool {
+	return p.Optional != nil && *p.Optional
+}
+
+// IsSensitive reports whether the values of the placeholder must not be exposed
+func (p ApplicationTemplatePlaceholder) IsSensitive() bool {
+	return p.Sensitive != nil && *p.Sensitive
+}
+
+// GetType returns the type of the placeholder. Placeholders defined without a type are strings.
+func (p ApplicationTemplatePlaceholder) GetType() PlaceholderType {
+	if p.Type == nil || *p.Type == "" {
+		return PlaceholderTypeString
+	}
+	return *p.Type
+}
+
+// PlaceholderType is the type of the values accepted by an application template placeholder
+type PlaceholderType string
+
+const (
+	// PlaceholderTypeString accepts any string
+	PlaceholderTypeString PlaceholderType = "STRING"
+	// PlaceholderTypeURL accepts absolute URLs
+	PlaceholderTypeURL PlaceholderType = "URL"
+	// PlaceholderTypeEnum accepts one of the allowed values of the placeholder
+	PlaceholderTypeEnum PlaceholderType = "ENUM"
+	// PlaceholderTypeInteger accepts integers
+	PlaceholderTypeInteger PlaceholderType = "INTEGER"
+	// PlaceholderTypeBoolean accepts true or false
+	PlaceholderTypeBoolean PlaceholderType = "BOOLEAN"
+	// PlaceholderTypeUUID accepts UUIDs
+	PlaceholderTypeUUID PlaceholderType = "UUID"
+)
+
 // ApplicationTemplateValueInput missing godoc
 type ApplicationTemplateValueInput struct {
 	Placeholder string
@@ -0,0 +1,49 @@
+package model
+
+// ApplicationTemplatePlaceholderValues are the placeholder values an application was registered with from an application template.
+// They are used to render the current version of the template for the application again.
+type ApplicationTemplatePlaceholderValues struct {
+	ApplicationID         string
+	ApplicationTemplateID string
+	Values                ApplicationFromTemplateInputValues
+}
+
+// ApplicationTemplateDrift describes how an application differs from a rendering of the current version of its application template
+type ApplicationTemplateDrift struct {
+	ApplicationTemplateID string
+	Differences           []*ApplicationTemplateDriftDifference
+}
+
+// InSync returns true if the application does not differ from its application template
+func (d *ApplicationTemplateDrift) InSync() bool {
+	return len(d.Differences) == 0
+}
+
+// ApplicationTemplateDriftDifference is a single field whose current value differs from the value expected by the application template
+type ApplicationTemplateDriftDifference struct {
+	Field    string
+	Current  interface{}
+	Expected interface{}
+}
+
+// ApplicationTemplateUpgradeStatus is the outcome of upgrading a single application to the current version of its application template
+type ApplicationTemplateUpgradeStatus string
+
+const (
+	// ApplicationTemplateUpgradeStatusDrifted represents an application which differs from its template and was not upgraded because of a dry run
+	ApplicationTemplateUpgradeStatusDrifted ApplicationTemplateUpgradeStatus = "DRIFTED"
+	// ApplicationTemplateUpgradeStatusFailed represents an application whose upgrade failed
+	ApplicationTemplateUpgradeStatusFailed ApplicationTemplateUpgradeStatus = "FAILED"
+	// ApplicationTemplateUpgradeStatusUpgraded represents an application which was upgraded
+	ApplicationTemplateUpgradeStatusUpgraded ApplicationTemplateUpgradeStatus = "UPGRADED"
+	// ApplicationTemplateUpgradeStatusUpToDate represents an application which does not differ from its template
+	ApplicationTemplateUpgradeStatusUpToDate ApplicationTemplateUpgradeStatus = "UP_TO_DATE"
+)
+
+// ApplicationTemplateUpgradeResult is the outcome of upgrading a single application to the current version of its application template
+type ApplicationTemplateUpgradeResult struct {
+	ApplicationID string
+	Status        ApplicationTemplateUpgradeStatus
+	Differences   []*ApplicationTemplateDriftDifference
+	Error         *string
+}
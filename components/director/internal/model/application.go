@@ -236,3 +236,31 @@ type ApplicationWithTenantsPage struct {
 	PageInfo   *pagination.Page
 	TotalCount int
 }
+
+// ApplicationMergeConflictType represents whether a merge conflict concerns an Application property or label
+type ApplicationMergeConflictType string
+
+const (
+	// ApplicationMergeConflictTypeProperty is a conflict between Application properties
+	ApplicationMergeConflictTypeProperty ApplicationMergeConflictType = "PROPERTY"
+	// ApplicationMergeConflictTypeLabel is a conflict between Application labels
+	ApplicationMergeConflictTypeLabel ApplicationMergeConflictType = "LABEL"
+)
+
+// ApplicationMergeConflict represents a value set in both merged Applications. The value of the destination Application is kept.
+type ApplicationMergeConflict struct {
+	Type             ApplicationMergeConflictType
+	Key              string
+	DestinationValue interface{}
+	SourceValue      interface{}
+}
+
+// ApplicationMergePreview represents the outcome of merging the source Application into the destination Application
+type ApplicationMergePreview struct {
+	DestinationID      string
+	SourceID           string
+	Labels             map[string]interface{}
+	Conflicts          []*ApplicationMergeConflict
+	AffectedFormations []string
+	ValidationErrors   []string
+}
@@ -93,3 +93,39 @@ type BusinessTenantMappingPage struct {
 	PageInfo   *pagination.Page
 	TotalCount int
 }
+
+// TenantTreeDirection specifies which relatives of a tenant are included in its tenant tree
+type TenantTreeDirection string
+
+const (
+	// TenantTreeDirectionAncestors includes only the parents of the tenant, their parents and so on
+	TenantTreeDirectionAncestors TenantTreeDirection = "ANCESTORS"
+	// TenantTreeDirectionDescendants includes only the children of the tenant, their children and so on
+	TenantTreeDirectionDescendants TenantTreeDirection = "DESCENDANTS"
+	// TenantTreeDirectionBoth includes both the ancestors and the descendants of the tenant
+	TenantTreeDirectionBoth TenantTreeDirection = "BOTH"
+)
+
+// TenantOwnedResources contains the number of resources owned by a tenant. The applications and runtimes include the ones
+// owned by its descendants, as their ownership is propagated to the ancestors, while the formations include only the ones created in the tenant itself.
+type TenantOwnedResources struct {
+	Applications int
+	Runtimes     int
+	Formations   int
+}
+
+// TenantTreeNode is a tenant from the hierarchy of another tenant together with its distance from that tenant
+type TenantTreeNode struct {
+	Tenant         *BusinessTenantMapping
+	Depth          int
+	OwnedResources TenantOwnedResources
+}
+
+// TenantTree represents a tenant together with its ancestors and descendants in the tenant hierarchy.
+// Truncated is set when only the closest of the ancestors or of the descendants are included in the tree.
+type TenantTree struct {
+	Root        *TenantTreeNode
+	Ancestors   []*TenantTreeNode
+	Descendants []*TenantTreeNode
+	Truncated   bool
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 )
@@ -36,6 +37,13 @@ type Capability struct {
 	*BaseEntity
 }
 
+// CapabilityPage is a page of capabilities
+type CapabilityPage struct {
+	Data       []*Capability
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
 // GetType missing godoc
 func (*Capability) GetType() resource.Type {
 	return resource.Capability
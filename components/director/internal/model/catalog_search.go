@@ -0,0 +1,36 @@
+package model
+
+import (
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// CatalogSearchQuery is a full-text search across the API and event catalog of a tenant.
+// Text uses the web search syntax of Postgres, e.g. quoted phrases, "or" and "-" for negation.
+type CatalogSearchQuery struct {
+	Text            string
+	ResourceTypes   []resource.Type
+	ApplicationIDs  []string
+	ReleaseStatuses []string
+	Visibilities    []string
+}
+
+// CatalogSearchResult is an API, event, entity type, capability or data product which matches a catalog search
+type CatalogSearchResult struct {
+	ResourceType  resource.Type
+	ID            string
+	OrdID         *string
+	Name          string
+	Description   *string
+	ApplicationID *string
+	ReleaseStatus *string
+	Visibility    *string
+	Rank          float64
+}
+
+// CatalogSearchResultPage is a page of catalog search results ordered by relevance
+type CatalogSearchResultPage struct {
+	Data       []*CatalogSearchResult
+	PageInfo   *pagination.Page
+	TotalCount int
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strconv"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 )
@@ -48,6 +49,13 @@ type DataProduct struct {
 	*BaseEntity
 }
 
+// DataProductPage is a page of data products
+type DataProductPage struct {
+	Data       []*DataProduct
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
 // GetType returns Type DataProduct
 func (*DataProduct) GetType() resource.Type {
 	return resource.DataProduct
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
 // DestinationInput missing godoc
 type DestinationInput struct {
 	Name              string `json:"Name"`
@@ -15,14 +21,31 @@ type DestinationInput struct {
 
 // Destination is an internal model representation of the destination entity
 type Destination struct {
-	ID                    string  `json:"id"`
-	Name                  string  `json:"name"`
-	Type                  string  `json:"type"`
-	URL                   string  `json:"url"`
-	Authentication        string  `json:"authentication"`
-	SubaccountID          string  `json:"subaccount_id"`
-	InstanceID            *string `json:"instanceId"`
-	FormationAssignmentID *string `json:"formationAssignmentID"`
+	ID                    string     `json:"id"`
+	Name                  string     `json:"name"`
+	Type                  string     `json:"type"`
+	URL                   string     `json:"url"`
+	Authentication        string     `json:"authentication"`
+	SubaccountID          string     `json:"subaccount_id"`
+	InstanceID            *string    `json:"instanceId"`
+	FormationAssignmentID *string    `json:"formationAssignmentID"`
+	BundleID              *string    `json:"bundleId"`
+	Revision              *string    `json:"revision"`
+	LastSyncTimestamp     *time.Time `json:"lastSyncTimestamp"`
+}
+
+// DestinationFilter narrows down the listed destinations. Empty fields are not taken into account.
+type DestinationFilter struct {
+	Name               *string
+	Type               *string
+	AuthenticationType *string
+}
+
+// DestinationPage is a page of destinations
+type DestinationPage struct {
+	Data       []*Destination
+	PageInfo   *pagination.Page
+	TotalCount int
 }
 
 // HasValidIdentifiers checks if the destination has either one of the pairs: XSystemTenantID and XSystemType, or XSystemBaseURL and XSystemTenantName
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// DestinationCertificateState is the state of a certificate created in the destination service for a formation assignment
+type DestinationCertificateState string
+
+const (
+	// DestinationCertificateStateActive represents the certificate which is currently used by the destinations of the formation assignment
+	DestinationCertificateStateActive DestinationCertificateState = "ACTIVE"
+	// DestinationCertificateStatePending represents a renewed certificate which is waiting for the participant to confirm it before it replaces the active one
+	DestinationCertificateStatePending DestinationCertificateState = "PENDING"
+)
+
+// DestinationCertificate is a certificate created in the remote destination service for a formation assignment.
+// It is tracked so that it can be renewed before it expires.
+type DestinationCertificate struct {
+	ID                    string
+	FormationAssignmentID string
+	AuthenticationType    string
+	Name                  string
+	SubaccountID          string
+	InstanceID            string
+	SelfSigned            bool
+	Generation            int
+	State                 DestinationCertificateState
+	ExpiresAt             *time.Time
+	CreatedAt             time.Time
+}
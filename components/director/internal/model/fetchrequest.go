@@ -16,6 +16,10 @@ type FetchRequest struct {
 	Status     *FetchRequestStatus
 	ObjectType FetchRequestReferenceObjectType
 	ObjectID   string
+	// ETag and LastModified are the HTTP cache validators returned with the last successfully fetched content.
+	// They are sent back as conditional request headers so that unchanged content is not downloaded again.
+	ETag         *string
+	LastModified *string
 }
 
 // FetchRequestReferenceObjectType represents the type of the object that the fetch request is referencing.
@@ -47,6 +47,7 @@ type Formation struct {
 	ID                            string
 	TenantID                      string
 	FormationTemplateID           string
+	FormationTemplateVersion      int
 	Name                          string
 	State                         FormationState
 	Error                         json.RawMessage
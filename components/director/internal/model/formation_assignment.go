@@ -40,6 +40,8 @@ type FormationAssignment struct {
 	Error                         json.RawMessage         `json:"error"`
 	LastStateChangeTimestamp      *time.Time              `json:"last_state_change_timestamp"`
 	LastNotificationSentTimestamp *time.Time              `json:"last_notification_sent_timestamp"`
+	ValidFrom                     *time.Time              `json:"valid_from"`
+	ValidUntil                    *time.Time              `json:"valid_until"`
 }
 
 // FormationAssignmentInput is an input for creating a new FormationAssignment
@@ -52,6 +54,8 @@ type FormationAssignmentInput struct {
 	State       string                  `json:"state"`
 	Value       json.RawMessage         `json:"value"`
 	Error       json.RawMessage         `json:"error"`
+	ValidFrom   *time.Time              `json:"valid_from"`
+	ValidUntil  *time.Time              `json:"valid_until"`
 }
 
 // FormationAssignmentPage missing godoc
@@ -81,6 +85,8 @@ const (
 	CreateReadyFormationAssignmentState FormationAssignmentState = "CREATE_READY"
 	// DeleteReadyFormationAssignmentState indicates that the formation assignment is in a ready state and the response is for an unassign notification
 	DeleteReadyFormationAssignmentState FormationAssignmentState = "DELETE_READY"
+	// ScheduledAssignmentState indicates that the object will be assigned to the formation when the validity period of the formation assignment starts
+	ScheduledAssignmentState FormationAssignmentState = "SCHEDULED"
 	// NotificationRecursionDepthLimit is the maximum count of configuration exchanges during assigning an object to formation
 	NotificationRecursionDepthLimit int = 10
 )
@@ -120,6 +126,8 @@ func (i *FormationAssignmentInput) ToModel(id, tenantID string) *FormationAssign
 		State:       i.State,
 		Value:       i.Value,
 		Error:       i.Error,
+		ValidFrom:   i.ValidFrom,
+		ValidUntil:  i.ValidUntil,
 	}
 }
 
@@ -138,6 +146,8 @@ func (fa *FormationAssignment) Clone() *FormationAssignment {
 		Error:                         fa.Error,
 		LastStateChangeTimestamp:      fa.LastStateChangeTimestamp,
 		LastNotificationSentTimestamp: fa.LastNotificationSentTimestamp,
+		ValidFrom:                     fa.ValidFrom,
+		ValidUntil:                    fa.ValidUntil,
 	}
 }
 
@@ -0,0 +1,207 @@
+package model
+
+import (
+	"reflect"
+	"sort"
+	"time"
+)
+
+// FormationTemplateVersion represents an immutable snapshot of a formation template. Formations are pinned to the version they were created with.
+type FormationTemplateVersion struct {
+	ID                  string
+	FormationTemplateID string
+	Version             int
+	Snapshot            FormationTemplateSnapshot
+	CreatedAt           time.Time
+}
+
+// FormationTemplateSnapshot contains the parts of a formation template that affect its formations
+type FormationTemplateSnapshot struct {
+	Name                   string                              `json:"name"`
+	ApplicationTypes       []string                            `json:"applicationTypes"`
+	RuntimeTypes           []string                            `json:"runtimeTypes"`
+	RuntimeTypeDisplayName *string                             `json:"runtimeTypeDisplayName,omitempty"`
+	RuntimeArtifactKind    *RuntimeArtifactKind                `json:"runtimeArtifactKind,omitempty"`
+	LeadingProductIDs      []string                            `json:"leadingProductIDs"`
+	SupportsReset          bool                                `json:"supportsReset"`
+	DiscoveryConsumers     []string                            `json:"discoveryConsumers"`
+	Webhooks               []*FormationTemplateWebhookSnapshot `json:"webhooks"`
+	ConstraintIDs          []string                            `json:"constraintIDs"`
+}
+
+// FormationTemplateWebhookSnapshot contains the notification relevant fields of a formation template webhook together with its credentials,
+// so that pinned formations can still be notified after the webhook is deleted from the template
+type FormationTemplateWebhookSnapshot struct {
+	ID             string       `json:"id"`
+	Type           WebhookType  `json:"type"`
+	URL            *string      `json:"url,omitempty"`
+	Mode           *WebhookMode `json:"mode,omitempty"`
+	URLTemplate    *string      `json:"urlTemplate,omitempty"`
+	InputTemplate  *string      `json:"inputTemplate,omitempty"`
+	HeaderTemplate *string      `json:"headerTemplate,omitempty"`
+	OutputTemplate *string      `json:"outputTemplate,omitempty"`
+	StatusTemplate *string      `json:"statusTemplate,omitempty"`
+	Auth           *Auth        `json:"auth,omitempty"`
+}
+
+// FormationTemplateMigrationStatus represents the outcome of migrating a formation to another formation template version
+type FormationTemplateMigrationStatus string
+
+const (
+	// FormationTemplateMigrationStatusCompatible denotes that the formation can be migrated. Used for dry runs.
+	FormationTemplateMigrationStatusCompatible FormationTemplateMigrationStatus = "COMPATIBLE"
+	// FormationTemplateMigrationStatusFailed denotes that the migration failed
+	FormationTemplateMigrationStatusFailed FormationTemplateMigrationStatus = "FAILED"
+	// FormationTemplateMigrationStatusIncompatible denotes that some of the participants are not allowed by the target version
+	FormationTemplateMigrationStatusIncompatible FormationTemplateMigrationStatus = "INCOMPATIBLE"
+	// FormationTemplateMigrationStatusMigrated denotes that the formation was pinned to the target version
+	FormationTemplateMigrationStatusMigrated FormationTemplateMigrationStatus = "MIGRATED"
+	// FormationTemplateMigrationStatusUpToDate denotes that the formation is already pinned to the target version
+	FormationTemplateMigrationStatusUpToDate FormationTemplateMigrationStatus = "UP_TO_DATE"
+)
+
+// FormationTemplateMigrationResult describes the outcome of migrating a formation to another formation template version
+type FormationTemplateMigrationResult struct {
+	FormationID          string
+	FromVersion          int
+	ToVersion            int
+	Status               FormationTemplateMigrationStatus
+	WebhooksChanged      bool
+	AddedConstraintIDs   []string
+	RemovedConstraintIDs []string
+	Violations           []string
+	Error                *string
+}
+
+// NewFormationTemplateSnapshot captures the current state of the formation template together with its webhooks and attached constraints
+func NewFormationTemplateSnapshot(formationTemplate *FormationTemplate, webhooks []*Webhook, constraintIDs []string) FormationTemplateSnapshot {
+	webhookSnapshots := make([]*FormationTemplateWebhookSnapshot, 0, len(webhooks))
+	for _, wh := range webhooks {
+		webhookSnapshots = append(webhookSnapshots, &FormationTemplateWebhookSnapshot{
+			ID:             wh.ID,
+			Type:           wh.Type,
+			URL:            wh.URL,
+			Mode:           wh.Mode,
+			URLTemplate:    wh.URLTemplate,
+			InputTemplate:  wh.InputTemplate,
+			HeaderTemplate: wh.HeaderTemplate,
+			OutputTemplate: wh.OutputTemplate,
+			StatusTemplate: wh.StatusTemplate,
+			Auth:           wh.Auth,
+		})
+	}
+	sort.Slice(webhookSnapshots, func(i, j int) bool {
+		return webhookSnapshots[i].ID < webhookSnapshots[j].ID
+	})
+
+	sortedConstraintIDs := make([]string, len(constraintIDs))
+	copy(sortedConstraintIDs, constraintIDs)
+	sort.Strings(sortedConstraintIDs)
+
+	return FormationTemplateSnapshot{
+		Name:                   formationTemplate.Name,
+		ApplicationTypes:       formationTemplate.ApplicationTypes,
+		RuntimeTypes:           formationTemplate.RuntimeTypes,
+		RuntimeTypeDisplayName: formationTemplate.RuntimeTypeDisplayName,
+		RuntimeArtifactKind:    formationTemplate.RuntimeArtifactKind,
+		LeadingProductIDs:      formationTemplate.LeadingProductIDs,
+		SupportsReset:          formationTemplate.SupportsReset,
+		DiscoveryConsumers:     formationTemplate.DiscoveryConsumers,
+		Webhooks:               webhookSnapshots,
+		ConstraintIDs:          sortedConstraintIDs,
+	}
+}
+
+// ApplyTo returns a copy of the formation template with the participant related fields taken from the snapshot
+func (s FormationTemplateSnapshot) ApplyTo(formationTemplate *FormationTemplate) *FormationTemplate {
+	if formationTemplate == nil {
+		return nil
+	}
+
+	pinned := *formationTemplate
+	pinned.ApplicationTypes = s.ApplicationTypes
+	pinned.RuntimeTypes = s.RuntimeTypes
+	pinned.RuntimeTypeDisplayName = s.RuntimeTypeDisplayName
+	pinned.RuntimeArtifactKind = s.RuntimeArtifactKind
+	pinned.SupportsReset = s.SupportsReset
+
+	return &pinned
+}
+
+// PinWebhooks returns the formation template webhooks as described by the snapshot. Current webhooks which are not part of the snapshot are left out.
+// The credentials of the current webhook with the same ID take precedence, as they might have been rotated since the snapshot was taken.
+// Webhooks deleted from the template are rebuilt from the snapshot, including their credentials.
+func (s FormationTemplateSnapshot) PinWebhooks(current []*Webhook, formationTemplateID string) []*Webhook {
+	currentByID := make(map[string]*Webhook, len(current))
+	for _, wh := range current {
+		currentByID[wh.ID] = wh
+	}
+
+	pinned := make([]*Webhook, 0, len(s.Webhooks))
+	for _, snapshot := range s.Webhooks {
+		wh := &Webhook{
+			ID:         snapshot.ID,
+			ObjectID:   formationTemplateID,
+			ObjectType: FormationTemplateWebhookReference,
+			Auth:       snapshot.Auth,
+		}
+		if currentWebhook, ok := currentByID[snapshot.ID]; ok {
+			copied := *currentWebhook
+			wh = &copied
+		}
+
+		wh.Type = snapshot.Type
+		wh.URL = snapshot.URL
+		wh.Mode = snapshot.Mode
+		wh.URLTemplate = snapshot.URLTemplate
+		wh.InputTemplate = snapshot.InputTemplate
+		wh.HeaderTemplate = snapshot.HeaderTemplate
+		wh.OutputTemplate = snapshot.OutputTemplate
+		wh.StatusTemplate = snapshot.StatusTemplate
+		pinned = append(pinned, wh)
+	}
+
+	return pinned
+}
+
+// WebhooksDiffer checks whether the webhooks of the two snapshots differ in anything that affects the notifications.
+// Credentials are not compared, as the current ones are used for the webhooks that still exist.
+func (s FormationTemplateSnapshot) WebhooksDiffer(other FormationTemplateSnapshot) bool {
+	if len(s.Webhooks) == 0 && len(other.Webhooks) == 0 {
+		return false
+	}
+
+	return !reflect.DeepEqual(withoutAuth(s.Webhooks), withoutAuth(other.Webhooks))
+}
+
+func withoutAuth(webhooks []*FormationTemplateWebhookSnapshot) []FormationTemplateWebhookSnapshot {
+	result := make([]FormationTemplateWebhookSnapshot, 0, len(webhooks))
+	for _, wh := range webhooks {
+		copied := *wh
+		copied.Auth = nil
+		result = append(result, copied)
+	}
+
+	return result
+}
+
+// ConstraintsDiff returns the IDs of the constraints that are attached in the other snapshot but not in this one, and the other way around
+func (s FormationTemplateSnapshot) ConstraintsDiff(other FormationTemplateSnapshot) (added []string, removed []string) {
+	return difference(other.ConstraintIDs, s.ConstraintIDs), difference(s.ConstraintIDs, other.ConstraintIDs)
+}
+
+func difference(from, subtract []string) []string {
+	subtracted := make(map[string]struct{}, len(subtract))
+	for _, id := range subtract {
+		subtracted[id] = struct{}{}
+	}
+
+	result := make([]string, 0)
+	for _, id := range from {
+		if _, ok := subtracted[id]; !ok {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+// HealthCheckType is the type of the probed endpoint of an application
+type HealthCheckType string
+
+const (
+	// ApplicationHealthCheckType represents the probe of the health check URL of an application
+	ApplicationHealthCheckType HealthCheckType = "MANAGEMENT_PLANE_APPLICATION_HEALTHCHECK"
+	// ApplicationORDHealthCheckType represents the probe of the ORD well-known endpoint of an application
+	ApplicationORDHealthCheckType HealthCheckType = "MANAGEMENT_PLANE_APPLICATION_ORD_HEALTHCHECK"
+)
+
+// HealthCheckStatusCondition is the outcome of a health check
+type HealthCheckStatusCondition string
+
+const (
+	// HealthCheckStatusConditionSucceeded represents a probe which was answered with a successful status code
+	HealthCheckStatusConditionSucceeded HealthCheckStatusCondition = "SUCCEEDED"
+	// HealthCheckStatusConditionFailed represents a probe which could not be executed or was answered with an unsuccessful status code
+	HealthCheckStatusConditionFailed HealthCheckStatusCondition = "FAILED"
+)
+
+// HealthCheck is the result of probing an endpoint of an application at a given time.
+// The Origin is the ID of the probed application.
+type HealthCheck struct {
+	ID        string
+	Type      HealthCheckType
+	Condition HealthCheckStatusCondition
+	Origin    string
+	URL       string
+	Message   *string
+	Timestamp time.Time
+}
+
+// HealthCheckPage is a page of health checks
+type HealthCheckPage struct {
+	Data       []*HealthCheck
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+// HealthCheckTarget is an application with a health check URL which is probed periodically
+type HealthCheckTarget struct {
+	ApplicationID string
+	URL           string
+}
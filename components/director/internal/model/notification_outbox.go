@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// NotificationOutboxStatus is the delivery status of a notification outbox entry
+type NotificationOutboxStatus string
+
+const (
+	// NotificationOutboxStatusPending represents a notification which is waiting to be delivered
+	NotificationOutboxStatusPending NotificationOutboxStatus = "PENDING"
+	// NotificationOutboxStatusInFlight represents a notification which was claimed for delivery by a director instance
+	NotificationOutboxStatusInFlight NotificationOutboxStatus = "IN_FLIGHT"
+	// NotificationOutboxStatusSent represents a notification which was delivered to the receiver
+	NotificationOutboxStatusSent NotificationOutboxStatus = "SENT"
+	// NotificationOutboxStatusFailed represents a notification whose delivery was given up
+	NotificationOutboxStatusFailed NotificationOutboxStatus = "FAILED"
+)
+
+// NotificationOutboxEntry references a webhook notification which is persisted in the same transaction
+// as the state change that caused it. The notification is generated and delivered asynchronously afterwards,
+// so that the rendered request, which may contain credentials, is never stored.
+type NotificationOutboxEntry struct {
+	ID                    string
+	WebhookID             string
+	FormationID           *string
+	FormationAssignmentID *string
+	Operation             *string
+	CorrelationID         *string
+	Status                NotificationOutboxStatus
+	Attempts              int
+	NextAttemptAt         time.Time
+	LastError             *string
+	CreatedAt             time.Time
+	SentAt                *time.Time
+}
@@ -48,6 +48,8 @@ const (
 	OperationTypeSystemFetching OperationType = "SYSTEM_FETCHING"
 	// OperationTypeSaasRegistryDiscovery specifies saas registry discovery operation type
 	OperationTypeSaasRegistryDiscovery OperationType = "SAAS_REGISTRY_DISCOVERY"
+	// OperationTypeOrdPush specifies the processing of ORD documents pushed by a system
+	OperationTypeOrdPush OperationType = "ORD_PUSH"
 )
 
 // Operation represents an Operation
@@ -0,0 +1,12 @@
+package model
+
+// ORDDocumentCacheValidator represents the HTTP cache validators (ETag and Last-Modified) returned with the last successfully processed
+// version of an ORD document. They are used to fetch the document with a conditional request during the next aggregation.
+type ORDDocumentCacheValidator struct {
+	ID           string
+	WebhookID    string
+	ResourceID   string
+	DocumentURL  string
+	ETag         *string
+	LastModified *string
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ORDPushedPayload represents an ORD configuration together with the ORD documents and specifications pushed by a system.
+// The files are keyed by the URL under which they are referenced from the configuration or the documents.
+// The payload is kept until the asynchronous processing of the push finishes.
+type ORDPushedPayload struct {
+	ID            string
+	ApplicationID string
+	Configuration json.RawMessage
+	Files         map[string]string
+	CreatedAt     time.Time
+}
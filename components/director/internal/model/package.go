@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strconv"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
@@ -37,6 +38,13 @@ type Package struct {
 	DocumentationLabels          json.RawMessage
 }
 
+// PackagePage is a page of packages
+type PackagePage struct {
+	Data       []*Package
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
 // PackageInput missing godoc
 type PackageInput struct {
 	OrdID               string          `json:"ordId"`
@@ -3,6 +3,7 @@ package model
 import (
 	"encoding/json"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
@@ -23,6 +24,13 @@ type Product struct {
 	DocumentationLabels          json.RawMessage
 }
 
+// ProductPage is a page of products
+type ProductPage struct {
+	Data       []*Product
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
 // ProductInput missing godoc
 type ProductInput struct {
 	OrdID               string          `json:"ordId"`
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// SoftDeletedResource is an application or a runtime which was deleted while soft delete was enabled.
+// Until PurgeAfter passes it can be restored together with its labels and tenant accesses.
+type SoftDeletedResource struct {
+	ID           string
+	ResourceType resource.Type
+	TenantID     string
+	Name         string
+	DeletedAt    time.Time
+	PurgeAfter   time.Time
+}
+
+// SoftDeletedResourcePage is a page of soft deleted resources
+type SoftDeletedResourcePage struct {
+	Data       []*SoftDeletedResource
+	PageInfo   *pagination.Page
+	TotalCount int
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+// StaticGroup is a structure that represents a user group
+// mapped to the scopes which are granted to its members
+type StaticGroup struct {
+	ID        string     `json:"id"`
+	GroupName string     `json:"group_name"`
+	Scopes    []string   `json:"scopes"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// StaticGroupPage contains StaticGroup data with page info
+type StaticGroupPage struct {
+	Data       []*StaticGroup
+	PageInfo   *pagination.Page
+	TotalCount int
+}
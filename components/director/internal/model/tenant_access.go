@@ -1,6 +1,10 @@
 package model
 
-import "github.com/kyma-incubator/compass/components/director/pkg/resource"
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
 
 // TenantAccess represents the tenant's access level to the resource
 type TenantAccess struct {
@@ -10,4 +14,8 @@ type TenantAccess struct {
 	ResourceID       string
 	Owner            bool
 	Source           string
+	GrantedBy        *string
+	Reason           *string
+	CreatedAt        *time.Time
+	ExpiresAt        *time.Time
 }
@@ -0,0 +1,65 @@
+package model
+
+// TenantConfigurationImportMode defines how an imported tenant configuration document is applied
+type TenantConfigurationImportMode string
+
+const (
+	// TenantConfigurationImportModeCreateOnly creates missing objects and reports conflicts for objects that differ
+	TenantConfigurationImportModeCreateOnly TenantConfigurationImportMode = "CREATE_ONLY"
+	// TenantConfigurationImportModeUpsert creates missing objects and updates objects that differ
+	TenantConfigurationImportModeUpsert TenantConfigurationImportMode = "UPSERT"
+	// TenantConfigurationImportModeDryRun only reports what would be done without changing anything
+	TenantConfigurationImportModeDryRun TenantConfigurationImportMode = "DRY_RUN"
+)
+
+// TenantConfigurationImportAction is the action taken (or planned) for a single object of the document
+type TenantConfigurationImportAction string
+
+const (
+	// TenantConfigurationImportActionCreate is reported for objects which do not exist in the tenant
+	TenantConfigurationImportActionCreate TenantConfigurationImportAction = "CREATE"
+	// TenantConfigurationImportActionUpdate is reported for existing objects which differ from the document
+	TenantConfigurationImportActionUpdate TenantConfigurationImportAction = "UPDATE"
+	// TenantConfigurationImportActionSkip is reported for existing objects which are equal to the document
+	TenantConfigurationImportActionSkip TenantConfigurationImportAction = "SKIP"
+	// TenantConfigurationImportActionConflict is reported for existing objects which differ from the document and could not be updated
+	TenantConfigurationImportActionConflict TenantConfigurationImportAction = "CONFLICT"
+	// TenantConfigurationImportActionPartial is reported for existing objects which were updated only partially because some of their parts cannot be modified
+	TenantConfigurationImportActionPartial TenantConfigurationImportAction = "PARTIAL"
+)
+
+// TenantConfigurationObjectType is the type of object described in a tenant configuration document
+type TenantConfigurationObjectType string
+
+const (
+	// TenantConfigurationObjectTypeApplicationTemplate represents application template entries
+	TenantConfigurationObjectTypeApplicationTemplate TenantConfigurationObjectType = "APPLICATION_TEMPLATE"
+	// TenantConfigurationObjectTypeApplication represents application entries
+	TenantConfigurationObjectTypeApplication TenantConfigurationObjectType = "APPLICATION"
+	// TenantConfigurationObjectTypeRuntime represents runtime entries
+	TenantConfigurationObjectTypeRuntime TenantConfigurationObjectType = "RUNTIME"
+	// TenantConfigurationObjectTypeFormationTemplate represents formation template entries
+	TenantConfigurationObjectTypeFormationTemplate TenantConfigurationObjectType = "FORMATION_TEMPLATE"
+	// TenantConfigurationObjectTypeFormationConstraint represents formation constraint entries
+	TenantConfigurationObjectTypeFormationConstraint TenantConfigurationObjectType = "FORMATION_CONSTRAINT"
+	// TenantConfigurationObjectTypeLabelDefinition represents label definition entries
+	TenantConfigurationObjectTypeLabelDefinition TenantConfigurationObjectType = "LABEL_DEFINITION"
+	// TenantConfigurationObjectTypeFormation represents formation entries
+	TenantConfigurationObjectTypeFormation TenantConfigurationObjectType = "FORMATION"
+)
+
+// TenantConfigurationObjectResult is the outcome of importing a single object of a tenant configuration document
+type TenantConfigurationObjectResult struct {
+	ObjectType TenantConfigurationObjectType
+	Name       string
+	ID         *string
+	Action     TenantConfigurationImportAction
+	Message    *string
+}
+
+// TenantConfigurationImportResult is the outcome of importing a tenant configuration document
+type TenantConfigurationImportResult struct {
+	Version string
+	Mode    TenantConfigurationImportMode
+	Results []*TenantConfigurationObjectResult
+}
@@ -1,6 +1,9 @@
 package model
 
-import "github.com/kyma-incubator/compass/components/director/pkg/resource"
+import (
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
 
 // Tombstone missing godoc
 type Tombstone struct {
@@ -12,6 +15,13 @@ type Tombstone struct {
 	Description                  *string
 }
 
+// TombstonePage is a page of tombstones
+type TombstonePage struct {
+	Data       []*Tombstone
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
 // TombstoneInput missing godoc
 type TombstoneInput struct {
 	OrdID       string  `json:"ordId"`
@@ -3,6 +3,7 @@ package model
 import (
 	"encoding/json"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
@@ -19,6 +20,13 @@ type Vendor struct {
 	DocumentationLabels          json.RawMessage
 }
 
+// VendorPage is a page of vendors
+type VendorPage struct {
+	Data       []*Vendor
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
 // VendorInput missing godoc
 type VendorInput struct {
 	OrdID               string          `json:"ordId"`
@@ -21,46 +21,55 @@ type Client struct {
 	mock.Mock
 }
 
-// FetchOpenResourceDiscoveryDocuments provides a mock function with given fields: ctx, resource, _a2, ordWebhookMapping, appBaseURL
-func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource ord.Resource, _a2 *model.Webhook, ordWebhookMapping application.ORDWebhookMapping, appBaseURL webhook.OpenResourceDiscoveryWebhookRequestObject) (ord.Documents, []string, string, error) {
-	ret := _m.Called(ctx, resource, _a2, ordWebhookMapping, appBaseURL)
+// FetchOpenResourceDiscoveryDocuments provides a mock function with given fields: ctx, resource, _a2, ordWebhookMapping, appBaseURL, cacheValidators
+func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource ord.Resource, _a2 *model.Webhook, ordWebhookMapping application.ORDWebhookMapping, appBaseURL webhook.OpenResourceDiscoveryWebhookRequestObject, cacheValidators ord.DocumentCacheValidators) (ord.Documents, []string, string, ord.DocumentCacheValidators, error) {
+	ret := _m.Called(ctx, resource, _a2, ordWebhookMapping, appBaseURL, cacheValidators)
 
 	var r0 ord.Documents
 	var r1 []string
 	var r2 string
-	var r3 error
-	if rf, ok := ret.Get(0).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject) (ord.Documents, []string, string, error)); ok {
-		return rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL)
+	var r3 ord.DocumentCacheValidators
+	var r4 error
+	if rf, ok := ret.Get(0).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject, ord.DocumentCacheValidators) (ord.Documents, []string, string, ord.DocumentCacheValidators, error)); ok {
+		return rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL, cacheValidators)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject) ord.Documents); ok {
-		r0 = rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL)
+	if rf, ok := ret.Get(0).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject, ord.DocumentCacheValidators) ord.Documents); ok {
+		r0 = rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL, cacheValidators)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(ord.Documents)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject) []string); ok {
-		r1 = rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL)
+	if rf, ok := ret.Get(1).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject, ord.DocumentCacheValidators) []string); ok {
+		r1 = rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL, cacheValidators)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject) string); ok {
-		r2 = rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL)
+	if rf, ok := ret.Get(2).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject, ord.DocumentCacheValidators) string); ok {
+		r2 = rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL, cacheValidators)
 	} else {
 		r2 = ret.Get(2).(string)
 	}
 
-	if rf, ok := ret.Get(3).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject) error); ok {
-		r3 = rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL)
+	if rf, ok := ret.Get(3).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject, ord.DocumentCacheValidators) ord.DocumentCacheValidators); ok {
+		r3 = rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL, cacheValidators)
 	} else {
-		r3 = ret.Error(3)
+		if ret.Get(3) != nil {
+			r3 = ret.Get(3).(ord.DocumentCacheValidators)
+		}
+	}
+
+	if rf, ok := ret.Get(4).(func(context.Context, ord.Resource, *model.Webhook, application.ORDWebhookMapping, webhook.OpenResourceDiscoveryWebhookRequestObject, ord.DocumentCacheValidators) error); ok {
+		r4 = rf(ctx, resource, _a2, ordWebhookMapping, appBaseURL, cacheValidators)
+	} else {
+		r4 = ret.Error(4)
 	}
 
-	return r0, r1, r2, r3
+	return r0, r1, r2, r3, r4
 }
 
 // NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
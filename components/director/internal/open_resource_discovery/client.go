@@ -59,7 +59,18 @@ func NewClientConfig(maxParallelDocumentsPerApplication int, retryDelay time.Dur
 //
 //go:generate mockery --name=Client --output=automock --outpkg=automock --case=underscore --disable-version-string
 type Client interface {
-	FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource Resource, webhook *model.Webhook, ordWebhookMapping application.ORDWebhookMapping, appBaseURL directorwh.OpenResourceDiscoveryWebhookRequestObject) (Documents, []string, string, error)
+	FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource Resource, webhook *model.Webhook, ordWebhookMapping application.ORDWebhookMapping, appBaseURL directorwh.OpenResourceDiscoveryWebhookRequestObject, cacheValidators DocumentCacheValidators) (Documents, []string, string, DocumentCacheValidators, error)
+}
+
+// DocumentCacheValidators maps the URL of an ORD document to the HTTP cache validators returned by its last successful fetch
+type DocumentCacheValidators map[string]*model.ORDDocumentCacheValidator
+
+type fetchedDocument struct {
+	document     *Document
+	content      string
+	etag         *string
+	lastModified *string
+	notModified  bool
 }
 
 // ORDDocumentsClient defines ORD documents client
@@ -78,14 +89,17 @@ func NewClient(config ClientConfig, httpClient *http.Client, accessStrategyExecu
 	}
 }
 
-// FetchOpenResourceDiscoveryDocuments fetches all the documents for a single ORD .well-known endpoint
-func (c *ORDDocumentsClient) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource Resource, webhook *model.Webhook, ordWebhookMapping application.ORDWebhookMapping, requestObject directorwh.OpenResourceDiscoveryWebhookRequestObject) (Documents, []string, string, error) {
+// FetchOpenResourceDiscoveryDocuments fetches all the documents for a single ORD .well-known endpoint.
+// The documents are requested conditionally based on the provided cache validators. If none of the documents has changed
+// since the last fetch, no documents are returned together with the cache validators of the unchanged documents.
+// Otherwise, the not modified documents are fetched again, as the documents are always processed together.
+func (c *ORDDocumentsClient) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource Resource, webhook *model.Webhook, ordWebhookMapping application.ORDWebhookMapping, requestObject directorwh.OpenResourceDiscoveryWebhookRequestObject, cacheValidators DocumentCacheValidators) (Documents, []string, string, DocumentCacheValidators, error) {
 	var tenantValue string
 
 	if needsTenantHeader := webhook.ObjectType == model.ApplicationTemplateWebhookReference && resource.Type != directorresource.ApplicationTemplate; needsTenantHeader {
 		tntFromCtx, err := tenant.LoadTenantPairFromContext(ctx)
 		if err != nil {
-			return nil, nil, "", errors.Wrapf(err, "while loading tenant from context for application template webhook flow")
+			return nil, nil, "", nil, errors.Wrapf(err, "while loading tenant from context for application template webhook flow")
 		}
 
 		tenantValue = tntFromCtx.ExternalID
@@ -108,21 +122,23 @@ func (c *ORDDocumentsClient) FetchOpenResourceDiscoveryDocuments(ctx context.Con
 
 	if err != nil {
 		log.C(ctx).Error(errors.Wrap(err, "error fetching ORD well-known config").Error())
-		return nil, nil, "", err
+		return nil, nil, "", nil, err
 	}
 
 	webhookBaseURL, err := calculateBaseURL(webhook, *config)
 	if err != nil {
-		return nil, nil, "", errors.Wrap(err, "while calculating baseURL")
+		return nil, nil, "", nil, errors.Wrap(err, "while calculating baseURL")
 	}
 
 	err = config.Validate(webhookBaseURL)
 	if err != nil {
-		return nil, nil, "", errors.Wrap(err, "while validating ORD config")
+		return nil, nil, "", nil, errors.Wrap(err, "while validating ORD config")
 	}
 
 	docs := make([]*Document, 0)
 	docsString := make([]string, 0)
+	notModifiedDocs := make([]notModifiedDocument, 0)
+	fetchedCacheValidators := make(DocumentCacheValidators)
 	docMutex := sync.Mutex{}
 	wg := sync.WaitGroup{}
 	workers := make(chan struct{}, c.config.maxParallelDocumentsPerApplication)
@@ -149,43 +165,62 @@ func (c *ORDDocumentsClient) FetchOpenResourceDiscoveryDocuments(ctx context.Con
 				log.C(ctx).Warnf("Unsupported access strategies for ORD Document %q", documentURL)
 			}
 
-			var doc *Document
-			var docString string
-			err = retry.Do(
-				func() error {
-					var innerErr error
-					doc, docString, innerErr = c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, documentURL, strategy, requestObject)
-					return innerErr
-				},
-				retry.Attempts(c.config.retryAttempts),
-				retry.Delay(c.config.retryDelay),
-				retry.OnRetry(func(n uint, err error) {
-					log.C(ctx).Infof("Retrying request attempt (%d) after error %v", n, err)
-				}),
-			)
+			cacheValidator := cacheValidators[documentURL]
+			var conditionalHeaders http.Header
+			if cacheValidator != nil {
+				conditionalHeaders = httputil.ConditionalRequestHeaders(cacheValidator.ETag, cacheValidator.LastModified)
+			}
+
+			fetched, err := c.fetchDocumentWithRetry(ctx, documentURL, strategy, requestObject, conditionalHeaders)
 			if err != nil {
 				log.C(ctx).Error(errors.Wrapf(err, "error fetching ORD document from: %s", documentURL).Error())
 				addError(&fetchDocErrors, err, &errMutex)
 				return
 			}
 
-			if docDetails.Perspective == SystemVersionPerspective {
-				doc.Perspective = SystemVersionPerspective
-			} else {
-				doc.Perspective = SystemInstancePerspective
+			docMutex.Lock()
+			defer docMutex.Unlock()
+			addCacheValidator(fetchedCacheValidators, resource, webhook, documentURL, fetched, cacheValidator)
+			if fetched.notModified {
+				notModifiedDocs = append(notModifiedDocs, notModifiedDocument{url: documentURL, accessStrategy: strategy, perspective: docDetails.Perspective})
+				return
 			}
-			addDocument(&docs, doc, &docMutex, &docsString, docString)
+
+			addDocument(&docs, &docsString, fetched, docDetails.Perspective)
 		}(docDetails)
 	}
 
 	wg.Wait()
 
-	var fetchDocErr error = nil
 	if len(fetchDocErrors) > 0 {
 		stringErrors := convertErrorsToStrings(fetchDocErrors)
-		fetchDocErr = errors.Errorf(strings.Join(stringErrors, "\n"))
+		return docs, docsString, webhookBaseURL, fetchedCacheValidators, errors.Errorf(strings.Join(stringErrors, "\n"))
+	}
+
+	if len(notModifiedDocs) > 0 && len(docs) == 0 {
+		log.C(ctx).Infof("None of the %d ORD documents has been modified since the last fetch", len(notModifiedDocs))
+		return Documents{}, []string{}, webhookBaseURL, fetchedCacheValidators, nil
+	}
+
+	for _, notModifiedDoc := range notModifiedDocs {
+		log.C(ctx).Infof("ORD document %q has not been modified, but other documents have. It will be fetched again", notModifiedDoc.url)
+		fetched, err := c.fetchDocumentWithRetry(ctx, notModifiedDoc.url, notModifiedDoc.accessStrategy, requestObject, nil)
+		if err != nil {
+			log.C(ctx).Error(errors.Wrapf(err, "error fetching ORD document from: %s", notModifiedDoc.url).Error())
+			return docs, docsString, webhookBaseURL, fetchedCacheValidators, err
+		}
+
+		addCacheValidator(fetchedCacheValidators, resource, webhook, notModifiedDoc.url, fetched, nil)
+		addDocument(&docs, &docsString, fetched, notModifiedDoc.perspective)
 	}
-	return docs, docsString, webhookBaseURL, fetchDocErr
+
+	return docs, docsString, webhookBaseURL, fetchedCacheValidators, nil
+}
+
+type notModifiedDocument struct {
+	url            string
+	accessStrategy accessstrategy.Type
+	perspective    DocumentPerspective
 }
 
 func convertErrorsToStrings(errors []error) (result []string) {
@@ -195,34 +230,58 @@ func convertErrorsToStrings(errors []error) (result []string) {
 	return result
 }
 
-func (c *ORDDocumentsClient) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Context, documentURL string, accessStrategy accessstrategy.Type, requestObject directorwh.OpenResourceDiscoveryWebhookRequestObject) (*Document, string, error) {
+func (c *ORDDocumentsClient) fetchDocumentWithRetry(ctx context.Context, documentURL string, accessStrategy accessstrategy.Type, requestObject directorwh.OpenResourceDiscoveryWebhookRequestObject, conditionalHeaders http.Header) (*fetchedDocument, error) {
+	var fetched *fetchedDocument
+	err := retry.Do(
+		func() error {
+			var innerErr error
+			fetched, innerErr = c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, documentURL, accessStrategy, requestObject, conditionalHeaders)
+			return innerErr
+		},
+		retry.Attempts(c.config.retryAttempts),
+		retry.Delay(c.config.retryDelay),
+		retry.OnRetry(func(n uint, err error) {
+			log.C(ctx).Infof("Retrying request attempt (%d) after error %v", n, err)
+		}),
+	)
+
+	return fetched, err
+}
+
+func (c *ORDDocumentsClient) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Context, documentURL string, accessStrategy accessstrategy.Type, requestObject directorwh.OpenResourceDiscoveryWebhookRequestObject, conditionalHeaders http.Header) (*fetchedDocument, error) {
 	log.C(ctx).Infof("Fetching ORD Document %q with Access Strategy %q", documentURL, accessStrategy)
 	executor, err := c.accessStrategyExecutorProvider.Provide(accessStrategy)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	resp, err := executor.Execute(ctx, c.Client, documentURL, requestObject.TenantID, requestObject.Headers)
+	resp, err := executor.Execute(ctx, c.Client, documentURL, requestObject.TenantID, httputil.MergeHeaders(requestObject.Headers, conditionalHeaders))
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	defer closeBody(ctx, resp.Body)
 
+	etag, lastModified := httputil.CacheValidatorsFromResponse(resp)
+	if resp.StatusCode == http.StatusNotModified && len(conditionalHeaders) > 0 {
+		log.C(ctx).Infof("ORD Document %q has not been modified since the last fetch", documentURL)
+		return &fetchedDocument{etag: etag, lastModified: lastModified, notModified: true}, nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, "", errors.Errorf("error while fetching open resource discovery document %q: status code %d", documentURL, resp.StatusCode)
+		return nil, errors.Errorf("error while fetching open resource discovery document %q: status code %d", documentURL, resp.StatusCode)
 	}
 
 	resp.Body = http.MaxBytesReader(nil, resp.Body, 2097152)
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "error reading document body")
+		return nil, errors.Wrap(err, "error reading document body")
 	}
 	result := &Document{}
 	if err := json.Unmarshal(bodyBytes, &result); err != nil {
-		return nil, "", errors.Wrap(err, "error unmarshaling document")
+		return nil, errors.Wrap(err, "error unmarshaling document")
 	}
-	return result, string(bodyBytes), nil
+	return &fetchedDocument{document: result, content: string(bodyBytes), etag: etag, lastModified: lastModified}, nil
 }
 
 func closeBody(ctx context.Context, body io.ReadCloser) {
@@ -231,11 +290,36 @@ func closeBody(ctx context.Context, body io.ReadCloser) {
 	}
 }
 
-func addDocument(docs *[]*Document, doc *Document, mutex *sync.Mutex, docsString *[]string, docString string) {
-	mutex.Lock()
-	defer mutex.Unlock()
-	*docs = append(*docs, doc)
-	*docsString = append(*docsString, docString)
+func addDocument(docs *[]*Document, docsString *[]string, fetched *fetchedDocument, perspective DocumentPerspective) {
+	if perspective == SystemVersionPerspective {
+		fetched.document.Perspective = SystemVersionPerspective
+	} else {
+		fetched.document.Perspective = SystemInstancePerspective
+	}
+
+	*docs = append(*docs, fetched.document)
+	*docsString = append(*docsString, fetched.content)
+}
+
+// addCacheValidator stores the cache validators of the fetched document. A not modified response is not required to repeat
+// the validators, in which case the previously stored ones remain valid.
+func addCacheValidator(validators DocumentCacheValidators, resource Resource, webhook *model.Webhook, documentURL string, fetched *fetchedDocument, previous *model.ORDDocumentCacheValidator) {
+	etag, lastModified := fetched.etag, fetched.lastModified
+	if fetched.notModified && previous != nil && etag == nil && lastModified == nil {
+		etag, lastModified = previous.ETag, previous.LastModified
+	}
+
+	if etag == nil && lastModified == nil {
+		return
+	}
+
+	validators[documentURL] = &model.ORDDocumentCacheValidator{
+		WebhookID:    webhook.ID,
+		ResourceID:   resource.ID,
+		DocumentURL:  documentURL,
+		ETag:         etag,
+		LastModified: lastModified,
+	}
 }
 
 func addError(fetchDocErrors *[]error, err error, mutex *sync.Mutex) {
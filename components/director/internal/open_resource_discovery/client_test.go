@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
@@ -99,21 +100,93 @@ var successfulRoundTripFunc = func(t *testing.T, bothBaseURLsProvided, noBaseURL
 	}
 }
 
+// conditionalRoundTripFunc serves a well-known config with the given documents. A document which is not listed as modified
+// responds with 304 Not Modified to a request conditional on its entity tag.
+var conditionalRoundTripFunc = func(t *testing.T, documentURIs []string, modifiedDocumentURIs ...string) func(req *http.Request) *http.Response {
+	modified := make(map[string]bool, len(modifiedDocumentURIs))
+	for _, documentURI := range modifiedDocumentURIs {
+		modified[documentURI] = true
+	}
+
+	return func(req *http.Request) *http.Response {
+		var data []byte
+		var err error
+		statusCode := http.StatusOK
+		header := http.Header{}
+		if strings.Contains(req.URL.String(), ord.WellKnownEndpoint) {
+			data, err = json.Marshal(fixWellKnownConfigWithDocuments(documentURIs...))
+			require.NoError(t, err)
+		} else {
+			header.Set(httputil.ETagHeader, ordDocETag)
+			if req.Header.Get(httputil.IfNoneMatchHeader) == ordDocETag && !modified[req.URL.Path] {
+				statusCode = http.StatusNotModified
+			} else {
+				data, err = json.Marshal(fixORDDocument())
+				require.NoError(t, err)
+			}
+		}
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewBuffer(data)),
+		}
+	}
+}
+
 func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 	testErr := errors.New("test")
 
 	testCases := []struct {
-		Name                 string
-		Webhook              *model.Webhook
-		Credentials          *model.Auth
-		AccessStrategy       string
-		RoundTripFunc        func(req *http.Request) *http.Response
-		ExecutorProviderFunc func() accessstrategy.ExecutorProvider
-		ExpectedResult       ord.Documents
-		ExpectedBaseURL      string
-		ExpectedErr          error
-		WebhookURL           string
+		Name                    string
+		Webhook                 *model.Webhook
+		Credentials             *model.Auth
+		AccessStrategy          string
+		RoundTripFunc           func(req *http.Request) *http.Response
+		ExecutorProviderFunc    func() accessstrategy.ExecutorProvider
+		CacheValidators         ord.DocumentCacheValidators
+		ExpectedResult          ord.Documents
+		ExpectedCacheValidators ord.DocumentCacheValidators
+		ExpectedBaseURL         string
+		ExpectedErr             error
+		WebhookURL              string
 	}{
+		{
+			Name:           "Success returns the cache validators of the fetched documents",
+			RoundTripFunc:  conditionalRoundTripFunc(t, []string{ordDocURI}),
+			ExpectedResult: ord.Documents{fixORDDocument()},
+			ExpectedCacheValidators: ord.DocumentCacheValidators{
+				baseURL + ordDocURI: fixDocumentCacheValidator(baseURL + ordDocURI),
+			},
+			ExpectedBaseURL: baseURL,
+		},
+		{
+			Name:          "No documents are returned when none of the documents has been modified",
+			RoundTripFunc: conditionalRoundTripFunc(t, []string{ordDocURI, ordDocURI2}),
+			CacheValidators: ord.DocumentCacheValidators{
+				baseURL + ordDocURI:  fixDocumentCacheValidator(baseURL + ordDocURI),
+				baseURL + ordDocURI2: fixDocumentCacheValidator(baseURL + ordDocURI2),
+			},
+			ExpectedResult: ord.Documents{},
+			ExpectedCacheValidators: ord.DocumentCacheValidators{
+				baseURL + ordDocURI:  fixDocumentCacheValidator(baseURL + ordDocURI),
+				baseURL + ordDocURI2: fixDocumentCacheValidator(baseURL + ordDocURI2),
+			},
+			ExpectedBaseURL: baseURL,
+		},
+		{
+			Name:          "Not modified documents are fetched again when another document has been modified",
+			RoundTripFunc: conditionalRoundTripFunc(t, []string{ordDocURI, ordDocURI2}, ordDocURI2),
+			CacheValidators: ord.DocumentCacheValidators{
+				baseURL + ordDocURI:  fixDocumentCacheValidator(baseURL + ordDocURI),
+				baseURL + ordDocURI2: fixDocumentCacheValidator(baseURL + ordDocURI2),
+			},
+			ExpectedResult: ord.Documents{fixORDDocument(), fixORDDocument()},
+			ExpectedCacheValidators: ord.DocumentCacheValidators{
+				baseURL + ordDocURI:  fixDocumentCacheValidator(baseURL + ordDocURI),
+				baseURL + ordDocURI2: fixDocumentCacheValidator(baseURL + ordDocURI2),
+			},
+			ExpectedBaseURL: baseURL,
+		},
 		{
 			Name:          "Success when webhookURL contains /well-known suffix",
 			RoundTripFunc: successfulRoundTripFunc(t, false, false),
@@ -439,7 +512,7 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				testWebhook.Auth.AccessStrategy = &test.AccessStrategy
 			}
 
-			docs, _, actualBaseURL, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), testResource, testWebhook, ordMappings, requestObject)
+			docs, _, actualBaseURL, cacheValidators, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), testResource, testWebhook, ordMappings, requestObject, test.CacheValidators)
 
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -449,6 +522,11 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				require.Len(t, docs, len(test.ExpectedResult))
 				require.Equal(t, test.ExpectedBaseURL, actualBaseURL)
 				require.Equal(t, test.ExpectedResult, docs)
+				if test.ExpectedCacheValidators != nil {
+					require.Equal(t, test.ExpectedCacheValidators, cacheValidators)
+				} else {
+					require.Empty(t, cacheValidators)
+				}
 			}
 
 			if test.ExecutorProviderFunc != nil {
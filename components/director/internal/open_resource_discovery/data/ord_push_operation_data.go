@@ -0,0 +1,40 @@
+package data
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// OrdPushOperationData represents the data of an operation processing ORD documents pushed by an application.
+type OrdPushOperationData struct {
+	ApplicationID string `json:"applicationID"`
+	PayloadID     string `json:"payloadID"`
+}
+
+// NewOrdPushOperationData creates new OrdPushOperationData.
+func NewOrdPushOperationData(appID, payloadID string) *OrdPushOperationData {
+	return &OrdPushOperationData{
+		ApplicationID: appID,
+		PayloadID:     payloadID,
+	}
+}
+
+// ParseOrdPushOperationData creates new OrdPushOperationData from byte array.
+func ParseOrdPushOperationData(data []byte) (*OrdPushOperationData, error) {
+	ordPushOperationData := &OrdPushOperationData{}
+	if err := json.Unmarshal(data, ordPushOperationData); err != nil {
+		return nil, errors.Wrap(err, "while unmarshaling ord push operation data")
+	}
+	return ordPushOperationData, nil
+}
+
+// GetData builds ord push operation data
+func (b *OrdPushOperationData) GetData() (string, error) {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return "", errors.Wrap(err, "while marshaling ord push operation data")
+	}
+
+	return string(data), nil
+}
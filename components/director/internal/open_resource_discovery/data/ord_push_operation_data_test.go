@@ -0,0 +1,43 @@
+package data_test
+
+import (
+	"testing"
+
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/data"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrdPushOperationData_GetData(t *testing.T) {
+	// GIVEN
+	opData := ord.NewOrdPushOperationData("app-id", "payload-id")
+
+	// WHEN
+	result, err := opData.GetData()
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, "{\"applicationID\":\"app-id\",\"payloadID\":\"payload-id\"}", result)
+}
+
+func TestParseOrdPushOperationData(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		result, err := ord.ParseOrdPushOperationData([]byte("{\"applicationID\":\"app-id\",\"payloadID\":\"payload-id\"}"))
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, ord.NewOrdPushOperationData("app-id", "payload-id"), result)
+	})
+
+	t.Run("Error when the data is not a valid JSON", func(t *testing.T) {
+		// WHEN
+		result, err := ord.ParseOrdPushOperationData([]byte("invalid"))
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshaling ord push operation data")
+		assert.Nil(t, result)
+	})
+}
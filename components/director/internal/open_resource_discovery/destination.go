@@ -0,0 +1,89 @@
+package ord
+
+import (
+	"net"
+	"net/url"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// ErrDestinationNotAllowed is returned when a URL provided by a caller points to a destination which is not publicly reachable
+var ErrDestinationNotAllowed = errors.New("destination is not allowed")
+
+var clusterInternalHostSuffixes = []string{".localhost", ".local", ".internal", ".svc", ".cluster.local"}
+
+// carrierGradeNATRange is the shared address space, which is commonly used for the pod and service networks of the clusters
+var carrierGradeNATRange = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// ValidatePublicDestination checks that the URL is an HTTP(S) URL which does not point to a loopback, private,
+// link-local or cluster-internal destination. Host names are checked only syntactically, the resolved addresses
+// are checked when connecting by the dialer returned from NewPublicDestinationDialer.
+func ValidatePublicDestination(rawURL string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return ErrDestinationNotAllowed
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return ErrDestinationNotAllowed
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(parsedURL.Hostname()), ".")
+	if host == "" {
+		return ErrDestinationNotAllowed
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if !isPublicIP(ip) {
+			return ErrDestinationNotAllowed
+		}
+		return nil
+	}
+
+	// host names without a domain are resolved with the search domains of the cluster
+	if host == "localhost" || !strings.Contains(host, ".") {
+		return ErrDestinationNotAllowed
+	}
+
+	for _, suffix := range clusterInternalHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return ErrDestinationNotAllowed
+		}
+	}
+
+	return nil
+}
+
+// NewPublicDestinationDialer returns a copy of the dialer which refuses to connect to addresses that are not publicly reachable.
+// The check takes place after the host name is resolved, so it also covers redirects and host names resolving to internal addresses.
+func NewPublicDestinationDialer(dialer *net.Dialer) *net.Dialer {
+	publicDialer := *dialer
+	publicDialer.Control = func(_, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return ErrDestinationNotAllowed
+		}
+
+		ip := net.ParseIP(host)
+		if ip == nil || !isPublicIP(ip) {
+			return ErrDestinationNotAllowed
+		}
+
+		return nil
+	}
+
+	return &publicDialer
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!carrierGradeNATRange.Contains(ip)
+}
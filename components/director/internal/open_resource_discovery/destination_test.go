@@ -0,0 +1,131 @@
+package ord_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePublicDestination(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		URL         string
+		ExpectedErr error
+	}{
+		{
+			Name: "Public host name",
+			URL:  "https://test.com/.well-known/open-resource-discovery",
+		},
+		{
+			Name: "Public IP address",
+			URL:  "http://8.8.8.8:8080/.well-known/open-resource-discovery",
+		},
+		{
+			Name:        "Unsupported scheme",
+			URL:         "file:///etc/passwd",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "Missing host",
+			URL:         "http:///.well-known/open-resource-discovery",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "Localhost",
+			URL:         "http://localhost:3000/.well-known/open-resource-discovery",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "Host name without a domain",
+			URL:         "http://compass-director:3000/.well-known/open-resource-discovery",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "Kubernetes service",
+			URL:         "http://compass-director.compass-system.svc:3000/.well-known/open-resource-discovery",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "Fully qualified Kubernetes service",
+			URL:         "http://compass-director.compass-system.svc.cluster.local.:3000/.well-known/open-resource-discovery",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "Loopback IP address",
+			URL:         "http://127.0.0.1:3000/.well-known/open-resource-discovery",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "Private IP address",
+			URL:         "http://10.0.0.1/.well-known/open-resource-discovery",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "Link-local IP address",
+			URL:         "http://169.254.169.254/latest/meta-data",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "Shared address space",
+			URL:         "http://100.64.0.10/.well-known/open-resource-discovery",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "IPv6 loopback address",
+			URL:         "http://[::1]:3000/.well-known/open-resource-discovery",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+		{
+			Name:        "IPv4-mapped IPv6 private address",
+			URL:         "http://[::ffff:192.168.0.1]/.well-known/open-resource-discovery",
+			ExpectedErr: ord.ErrDestinationNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := ord.ValidatePublicDestination(testCase.URL)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.ErrorIs(t, err, testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewPublicDestinationDialer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dialer := &net.Dialer{}
+	publicDialer := ord.NewPublicDestinationDialer(dialer)
+
+	t.Run("Refuses to connect to a loopback address", func(t *testing.T) {
+		// WHEN
+		_, err := publicDialer.DialContext(context.TODO(), "tcp", server.Listener.Addr().String())
+
+		// THEN
+		require.ErrorIs(t, err, ord.ErrDestinationNotAllowed)
+	})
+
+	t.Run("Does not modify the original dialer", func(t *testing.T) {
+		// WHEN
+		conn, err := dialer.DialContext(context.TODO(), "tcp", server.Listener.Addr().String())
+
+		// THEN
+		require.NoError(t, err)
+		assert.NoError(t, conn.Close())
+	})
+}
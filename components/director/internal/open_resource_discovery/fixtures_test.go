@@ -23,6 +23,8 @@ import (
 const (
 	absoluteDocURL              = "http://config.com/open-resource-discovery/v1/documents/example1"
 	ordDocURI                   = "/open-resource-discovery/v1/documents/example1"
+	ordDocURI2                  = "/open-resource-discovery/v1/documents/example2"
+	ordDocETag                  = `"33a64df551425fcc55e4d42a148795d9f25f89d4"`
 	proxyURL                    = "http://proxy.com:8080"
 	baseURL                     = "http://test.com:8080"
 	baseURL2                    = "http://second.com"
@@ -1331,6 +1333,28 @@ func fixWellKnownConfig() *ord.WellKnownConfig {
 	}
 }
 
+func fixWellKnownConfigWithDocuments(documentURIs ...string) *ord.WellKnownConfig {
+	config := fixWellKnownConfig()
+	documentDetails := config.OpenResourceDiscoveryV1.Documents[0]
+	config.OpenResourceDiscoveryV1.Documents = make([]ord.DocumentDetails, 0, len(documentURIs))
+	for _, documentURI := range documentURIs {
+		details := documentDetails
+		details.URL = documentURI
+		config.OpenResourceDiscoveryV1.Documents = append(config.OpenResourceDiscoveryV1.Documents, details)
+	}
+
+	return config
+}
+
+func fixDocumentCacheValidator(documentURL string) *model.ORDDocumentCacheValidator {
+	return &model.ORDDocumentCacheValidator{
+		WebhookID:   whID,
+		ResourceID:  appID,
+		DocumentURL: documentURL,
+		ETag:        str.Ptr(ordDocETag),
+	}
+}
+
 func fixORDDocument() *ord.Document {
 	return fixORDDocumentWithBaseURL("")
 }
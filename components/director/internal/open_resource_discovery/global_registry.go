@@ -63,10 +63,10 @@ func (s *globalRegistryService) SyncGlobalResources(ctx context.Context) (map[st
 		Name: "global-registry",
 		Type: directorresource.Application,
 	}
-	documents, docsString, _, err := s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, resource, &model.Webhook{
+	documents, docsString, _, _, err := s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, resource, &model.Webhook{
 		Type: model.WebhookTypeOpenResourceDiscovery,
 		URL:  &s.config.URL,
-	}, application.ORDWebhookMapping{}, webhook.OpenResourceDiscoveryWebhookRequestObject{})
+	}, application.ORDWebhookMapping{}, webhook.OpenResourceDiscoveryWebhookRequestObject{}, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while fetching global registry documents from %s", s.config.URL)
 	}
@@ -74,7 +74,7 @@ func TestService_SyncGlobalResources(t *testing.T) {
 
 	successfulClientFn := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, ordMapping, ordRequestObject).Return(ord.Documents{fixGlobalRegistryORDDocument()}, []string{}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, ordMapping, ordRequestObject, ord.DocumentCacheValidators(nil)).Return(ord.Documents{fixGlobalRegistryORDDocument()}, []string{}, baseURL, nil, nil)
 		return client
 	}
 
@@ -141,7 +141,7 @@ func TestService_SyncGlobalResources(t *testing.T) {
 			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, ordMapping, ordRequestObject).Return(nil, []string{}, "", testErr)
+				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, ordMapping, ordRequestObject, ord.DocumentCacheValidators(nil)).Return(nil, []string{}, "", nil, testErr)
 				return client
 			},
 			ExpectedErr: testErr,
@@ -170,7 +170,7 @@ func TestService_SyncGlobalResources(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixGlobalRegistryORDDocument()
 				doc.Vendors[0].OrdID = "invalid-ord-id"
-				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, ordMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, ordMapping, ordRequestObject, ord.DocumentCacheValidators(nil)).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			ExpectedValidationError: true,
@@ -189,7 +189,7 @@ func TestService_SyncGlobalResources(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixGlobalRegistryORDDocument()
 				doc.ConsumptionBundles = fixORDDocument().ConsumptionBundles
-				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, ordMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, ordMapping, ordRequestObject, ord.DocumentCacheValidators(nil)).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			ExpectedErr: errors.New("global registry supports only vendors and products"),
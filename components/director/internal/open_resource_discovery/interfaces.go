@@ -2,6 +2,7 @@ package ord
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 
 	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/processor"
@@ -148,6 +149,23 @@ type FetchRequestService interface {
 	UpdateGlobal(ctx context.Context, fr *model.FetchRequest) error
 }
 
+// DocumentCacheValidatorService is responsible for the service-layer ORD document cache validators operations.
+//
+//go:generate mockery --name=DocumentCacheValidatorService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type DocumentCacheValidatorService interface {
+	ListByWebhookIDAndResourceID(ctx context.Context, webhookID, resourceID string) ([]*model.ORDDocumentCacheValidator, error)
+	Upsert(ctx context.Context, in *model.ORDDocumentCacheValidator) error
+}
+
+// PushedPayloadService is responsible for the service-layer operations on ORD payloads pushed by applications.
+//
+//go:generate mockery --name=PushedPayloadService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type PushedPayloadService interface {
+	Create(ctx context.Context, appID string, configuration json.RawMessage, files map[string]string) (string, error)
+	Get(ctx context.Context, id string) (*model.ORDPushedPayload, error)
+	Delete(ctx context.Context, id string) error
+}
+
 // PackageService is responsible for the service-layer Package operations.
 //
 //go:generate mockery --name=PackageService --output=automock --outpkg=automock --case=underscore --disable-version-string
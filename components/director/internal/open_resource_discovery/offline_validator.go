@@ -0,0 +1,202 @@
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	directorresource "github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"github.com/pkg/errors"
+)
+
+// OfflineValidator validates ORD documents with the aggregation logic, without storing anything.
+//
+//go:generate mockery --name=OfflineValidator --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OfflineValidator interface {
+	ValidateWellKnownConfig(ctx context.Context, configURL string) (*ValidationReport, error)
+	ValidateFiles(ctx context.Context, configuration json.RawMessage, files map[string]string, baseURL string) (*ValidationReport, error)
+}
+
+// ValidationReport is the machine-readable result of the offline validation of ORD documents
+type ValidationReport struct {
+	BaseURL   string                      `json:"baseUrl"`
+	Valid     bool                        `json:"valid"`
+	Errors    int                         `json:"errors"`
+	Warnings  int                         `json:"warnings"`
+	Resources []*ResourceValidationReport `json:"resources"`
+}
+
+// ResourceValidationReport holds the validation findings of a single ORD resource. The findings which do not refer
+// to a particular resource, for example the ones concerning the whole document, are reported with an empty ORD ID.
+type ResourceValidationReport struct {
+	OrdID    string             `json:"ordId"`
+	Errors   []*ValidationError `json:"errors,omitempty"`
+	Warnings []*ValidationError `json:"warnings,omitempty"`
+	Info     []*ValidationError `json:"info,omitempty"`
+}
+
+// OfflineDocumentValidator fetches, validates and sanitizes ORD documents the same way the aggregation does,
+// but neither reads from nor writes to the database. The global resources are fetched directly from the global registry.
+type OfflineDocumentValidator struct {
+	client               Client
+	globalRegistryClient Client
+	globalRegistryURL    string
+	documentValidator    Validator
+	documentSanitizer    DocumentSanitizer
+}
+
+// NewOfflineDocumentValidator returns a new validator of ORD documents which does not need the database. The client fetches
+// the documents of the validated systems, while the global registry client fetches the global resources from the trusted global registry.
+func NewOfflineDocumentValidator(client, globalRegistryClient Client, globalRegistryURL string, documentValidator Validator, documentSanitizer DocumentSanitizer) *OfflineDocumentValidator {
+	return &OfflineDocumentValidator{
+		client:               client,
+		globalRegistryClient: globalRegistryClient,
+		globalRegistryURL:    globalRegistryURL,
+		documentValidator:    documentValidator,
+		documentSanitizer:    documentSanitizer,
+	}
+}
+
+// ValidateWellKnownConfig fetches the ORD configuration from the given URL together with all documents listed in it and validates them.
+// The configuration is fetched without credentials and the documents only with the access strategies supported by the client.
+func (v *OfflineDocumentValidator) ValidateWellKnownConfig(ctx context.Context, configURL string) (*ValidationReport, error) {
+	resource := Resource{
+		ID:   "ord-validator",
+		Name: "ord-validator",
+		Type: directorresource.Application,
+	}
+	requestObject := webhook.OpenResourceDiscoveryWebhookRequestObject{Headers: &sync.Map{}}
+
+	documents, docsString, baseURL, _, err := v.client.FetchOpenResourceDiscoveryDocuments(ctx, resource, &model.Webhook{
+		Type: model.WebhookTypeOpenResourceDiscovery,
+		URL:  &configURL,
+	}, application.ORDWebhookMapping{}, requestObject, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while fetching ORD documents from %s", configURL)
+	}
+
+	return v.validate(ctx, documents, docsString, baseURL)
+}
+
+// ValidateFiles validates the ORD documents listed in the configuration. The documents are taken from the files,
+// which are keyed by the URL under which the configuration references them. The base URL is used when the configuration does not provide one.
+func (v *OfflineDocumentValidator) ValidateFiles(ctx context.Context, configuration json.RawMessage, files map[string]string, baseURL string) (*ValidationReport, error) {
+	config, err := parseConfiguration(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.BaseURL != "" {
+		baseURL = config.BaseURL
+	}
+
+	if err = config.Validate(baseURL); err != nil {
+		return nil, errors.Wrap(err, "while validating ORD config")
+	}
+
+	documents, docsString, err := documentsFromFiles(*config, files)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.validate(ctx, documents, docsString, baseURL)
+}
+
+func (v *OfflineDocumentValidator) validate(ctx context.Context, documents Documents, docsString []string, baseURL string) (*ValidationReport, error) {
+	globalResourcesOrdIDs := v.fetchGlobalResources(ctx)
+
+	log.C(ctx).Infof("Validating %d ORD documents", len(documents))
+	validationErrors, err := v.documentValidator.Validate(ctx, documents, baseURL, globalResourcesOrdIDs, docsString, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "while validating ORD documents")
+	}
+
+	log.C(ctx).Infof("Sanitizing %d ORD documents", len(documents))
+	validationErrorsFromSanitize, err := v.documentSanitizer.Sanitize(documents, baseURL, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "while sanitizing ORD documents")
+	}
+
+	return newValidationReport(baseURL, append(validationErrors, validationErrorsFromSanitize...)), nil
+}
+
+// fetchGlobalResources collects the ORD IDs of the vendors and products from the global registry. Without them
+// every reference to a global resource is reported as unknown, so a failure is logged and the validation proceeds.
+func (v *OfflineDocumentValidator) fetchGlobalResources(ctx context.Context) map[string]bool {
+	globalResourcesOrdIDs := make(map[string]bool)
+	if v.globalRegistryURL == "" {
+		log.C(ctx).Warn("Global registry URL is not configured. References to global resources will be reported as unknown")
+		return globalResourcesOrdIDs
+	}
+
+	resource := Resource{
+		ID:   "global-registry",
+		Name: "global-registry",
+		Type: directorresource.Application,
+	}
+	documents, _, _, _, err := v.globalRegistryClient.FetchOpenResourceDiscoveryDocuments(ctx, resource, &model.Webhook{
+		Type: model.WebhookTypeOpenResourceDiscovery,
+		URL:  &v.globalRegistryURL,
+	}, application.ORDWebhookMapping{}, webhook.OpenResourceDiscoveryWebhookRequestObject{}, nil)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Error while fetching global registry documents from %s. Proceeding without global resources...", v.globalRegistryURL)
+		return globalResourcesOrdIDs
+	}
+
+	for _, doc := range documents {
+		for _, vendor := range doc.Vendors {
+			globalResourcesOrdIDs[vendor.OrdID] = true
+		}
+		for _, product := range doc.Products {
+			globalResourcesOrdIDs[product.OrdID] = true
+		}
+	}
+
+	return globalResourcesOrdIDs
+}
+
+func newValidationReport(baseURL string, validationErrors []*ValidationError) *ValidationReport {
+	report := &ValidationReport{
+		BaseURL:   baseURL,
+		Resources: make([]*ResourceValidationReport, 0),
+	}
+
+	resources := make(map[string]*ResourceValidationReport)
+	for _, validationErr := range validationErrors {
+		if validationErr == nil {
+			continue
+		}
+
+		resourceReport, ok := resources[validationErr.OrdID]
+		if !ok {
+			resourceReport = &ResourceValidationReport{OrdID: validationErr.OrdID}
+			resources[validationErr.OrdID] = resourceReport
+			report.Resources = append(report.Resources, resourceReport)
+		}
+
+		switch validationErr.Severity {
+		case ErrorSeverity:
+			resourceReport.Errors = append(resourceReport.Errors, validationErr)
+			report.Errors++
+		case WarningSeverity:
+			resourceReport.Warnings = append(resourceReport.Warnings, validationErr)
+			report.Warnings++
+		default:
+			resourceReport.Info = append(resourceReport.Info, validationErr)
+		}
+	}
+
+	// The documents are fetched concurrently, hence the resources are sorted to keep the report stable
+	sort.SliceStable(report.Resources, func(i, j int) bool {
+		return report.Resources[i].OrdID < report.Resources[j].OrdID
+	})
+
+	report.Valid = report.Errors == 0
+
+	return report
+}
@@ -0,0 +1,272 @@
+package ord_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validationConfigURL         = "http://test.com:8080/.well-known/open-resource-discovery"
+	validationGlobalRegistryURL = "http://global-registry.com/.well-known/open-resource-discovery"
+	validationDocumentURL       = "/open-resource-discovery/v1/documents/example1"
+	validationDocument          = `{"openResourceDiscovery":"1.9"}`
+)
+
+func TestOfflineDocumentValidator_ValidateWellKnownConfig(t *testing.T) {
+	testErr := errors.New("test error")
+
+	validationErrors := []*ord.ValidationError{
+		{OrdID: "ns:apiResource:API_ID:v1", Severity: ord.ErrorSeverity, Type: "code", Description: "invalid title"},
+		{OrdID: "ns:package:PACKAGE_ID:v1", Severity: ord.WarningSeverity, Type: "code", Description: "missing description"},
+		{OrdID: "ns:apiResource:API_ID:v1", Severity: ord.WarningSeverity, Type: "code", Description: "missing changelog"},
+		{OrdID: "ns:apiResource:API_ID:v1", Severity: ord.InfoSeverity, Type: "code", Description: "consider adding links"},
+	}
+	globalDocuments := ord.Documents{{Vendors: []*model.VendorInput{{OrdID: sapVendor}}}}
+
+	isWebhookFor := func(url string) interface{} {
+		return mock.MatchedBy(func(webhook *model.Webhook) bool {
+			return webhook.URL != nil && *webhook.URL == url
+		})
+	}
+
+	testCases := []struct {
+		Name              string
+		GlobalRegistryURL string
+		ClientFn          func() *automock.Client
+		ValidatorFn       func() *automock.Validator
+		ExpectedReport    *ord.ValidationReport
+		ExpectedErr       error
+	}{
+		{
+			Name:              "Success with global resources from the global registry",
+			GlobalRegistryURL: validationGlobalRegistryURL,
+			ClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", mock.Anything, mock.Anything, isWebhookFor(validationConfigURL), mock.Anything, mock.Anything, ord.DocumentCacheValidators(nil)).Return(ord.Documents{}, []string{}, baseURL, nil, nil).Once()
+				client.On("FetchOpenResourceDiscoveryDocuments", mock.Anything, mock.Anything, isWebhookFor(validationGlobalRegistryURL), mock.Anything, mock.Anything, ord.DocumentCacheValidators(nil)).Return(globalDocuments, []string{}, "", nil, nil).Once()
+				return client
+			},
+			ValidatorFn: func() *automock.Validator {
+				validator := &automock.Validator{}
+				validator.On("Validate", mock.Anything, []*ord.Document{}, baseURL, map[string]bool{sapVendor: true}, []string{}, "").Return(validationErrors, nil).Once()
+				return validator
+			},
+			ExpectedReport: &ord.ValidationReport{
+				BaseURL:  baseURL,
+				Valid:    false,
+				Errors:   1,
+				Warnings: 2,
+				Resources: []*ord.ResourceValidationReport{
+					{
+						OrdID:    "ns:apiResource:API_ID:v1",
+						Errors:   []*ord.ValidationError{validationErrors[0]},
+						Warnings: []*ord.ValidationError{validationErrors[2]},
+						Info:     []*ord.ValidationError{validationErrors[3]},
+					},
+					{
+						OrdID:    "ns:package:PACKAGE_ID:v1",
+						Warnings: []*ord.ValidationError{validationErrors[1]},
+					},
+				},
+			},
+		},
+		{
+			Name: "Success without global registry",
+			ClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", mock.Anything, mock.Anything, isWebhookFor(validationConfigURL), mock.Anything, mock.Anything, ord.DocumentCacheValidators(nil)).Return(ord.Documents{}, []string{}, baseURL, nil, nil).Once()
+				return client
+			},
+			ValidatorFn: func() *automock.Validator {
+				validator := &automock.Validator{}
+				validator.On("Validate", mock.Anything, []*ord.Document{}, baseURL, map[string]bool{}, []string{}, "").Return(nil, nil).Once()
+				return validator
+			},
+			ExpectedReport: &ord.ValidationReport{
+				BaseURL:   baseURL,
+				Valid:     true,
+				Resources: []*ord.ResourceValidationReport{},
+			},
+		},
+		{
+			Name:              "Proceeds without global resources when fetching the global registry fails",
+			GlobalRegistryURL: validationGlobalRegistryURL,
+			ClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", mock.Anything, mock.Anything, isWebhookFor(validationConfigURL), mock.Anything, mock.Anything, ord.DocumentCacheValidators(nil)).Return(ord.Documents{}, []string{}, baseURL, nil, nil).Once()
+				client.On("FetchOpenResourceDiscoveryDocuments", mock.Anything, mock.Anything, isWebhookFor(validationGlobalRegistryURL), mock.Anything, mock.Anything, ord.DocumentCacheValidators(nil)).Return(nil, nil, "", nil, testErr).Once()
+				return client
+			},
+			ValidatorFn: func() *automock.Validator {
+				validator := &automock.Validator{}
+				validator.On("Validate", mock.Anything, []*ord.Document{}, baseURL, map[string]bool{}, []string{}, "").Return(nil, nil).Once()
+				return validator
+			},
+			ExpectedReport: &ord.ValidationReport{
+				BaseURL:   baseURL,
+				Valid:     true,
+				Resources: []*ord.ResourceValidationReport{},
+			},
+		},
+		{
+			Name: "Error when fetching the documents fails",
+			ClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", mock.Anything, mock.Anything, isWebhookFor(validationConfigURL), mock.Anything, mock.Anything, ord.DocumentCacheValidators(nil)).Return(nil, nil, "", nil, testErr).Once()
+				return client
+			},
+			ValidatorFn: func() *automock.Validator {
+				return &automock.Validator{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error when the validation fails",
+			ClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", mock.Anything, mock.Anything, isWebhookFor(validationConfigURL), mock.Anything, mock.Anything, ord.DocumentCacheValidators(nil)).Return(ord.Documents{}, []string{}, baseURL, nil, nil).Once()
+				return client
+			},
+			ValidatorFn: func() *automock.Validator {
+				validator := &automock.Validator{}
+				validator.On("Validate", mock.Anything, []*ord.Document{}, baseURL, map[string]bool{}, []string{}, "").Return(nil, testErr).Once()
+				return validator
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := testCase.ClientFn()
+			validator := testCase.ValidatorFn()
+			defer mock.AssertExpectationsForObjects(t, client, validator)
+
+			offlineValidator := ord.NewOfflineDocumentValidator(client, client, testCase.GlobalRegistryURL, validator, ord.NewDocumentSanitizer())
+
+			// WHEN
+			report, err := offlineValidator.ValidateWellKnownConfig(context.TODO(), validationConfigURL)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				require.Nil(t, report)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedReport, report)
+			}
+		})
+	}
+}
+
+func TestOfflineDocumentValidator_ValidateFiles(t *testing.T) {
+	configuration := json.RawMessage(`{"openResourceDiscoveryV1":{"documents":[{"url":"` + validationDocumentURL + `","accessStrategies":[{"type":"open"}]}]}}`)
+	configurationWithBaseURL := json.RawMessage(`{"baseUrl":"http://config.com","openResourceDiscoveryV1":{"documents":[{"url":"` + validationDocumentURL + `","accessStrategies":[{"type":"open"}]}]}}`)
+	files := map[string]string{validationDocumentURL: validationDocument}
+
+	testCases := []struct {
+		Name           string
+		Configuration  json.RawMessage
+		Files          map[string]string
+		BaseURL        string
+		ValidatorFn    func() *automock.Validator
+		ExpectedReport *ord.ValidationReport
+		ExpectedErr    string
+	}{
+		{
+			Name:          "Success",
+			Configuration: configuration,
+			Files:         files,
+			BaseURL:       baseURL,
+			ValidatorFn: func() *automock.Validator {
+				validator := &automock.Validator{}
+				validator.On("Validate", mock.Anything, mock.AnythingOfType("[]*ord.Document"), baseURL, map[string]bool{}, []string{validationDocument}, "").Return(nil, nil).Once()
+				return validator
+			},
+			ExpectedReport: &ord.ValidationReport{
+				BaseURL:   baseURL,
+				Valid:     true,
+				Resources: []*ord.ResourceValidationReport{},
+			},
+		},
+		{
+			Name:          "Success with the base URL of the configuration",
+			Configuration: configurationWithBaseURL,
+			Files:         files,
+			BaseURL:       baseURL,
+			ValidatorFn: func() *automock.Validator {
+				validator := &automock.Validator{}
+				validator.On("Validate", mock.Anything, mock.AnythingOfType("[]*ord.Document"), "http://config.com", map[string]bool{}, []string{validationDocument}, "").Return(nil, nil).Once()
+				return validator
+			},
+			ExpectedReport: &ord.ValidationReport{
+				BaseURL:   "http://config.com",
+				Valid:     true,
+				Resources: []*ord.ResourceValidationReport{},
+			},
+		},
+		{
+			Name:          "Error when the configuration is not valid JSON",
+			Configuration: json.RawMessage(`{`),
+			Files:         files,
+			BaseURL:       baseURL,
+			ValidatorFn:   func() *automock.Validator { return &automock.Validator{} },
+			ExpectedErr:   "error unmarshaling ORD configuration",
+		},
+		{
+			Name:          "Error when there is no base URL for the relative document URLs",
+			Configuration: configuration,
+			Files:         files,
+			ValidatorFn:   func() *automock.Validator { return &automock.Validator{} },
+			ExpectedErr:   "while validating ORD config",
+		},
+		{
+			Name:          "Error when a document listed in the configuration is not provided",
+			Configuration: configuration,
+			Files:         map[string]string{},
+			BaseURL:       baseURL,
+			ValidatorFn:   func() *automock.Validator { return &automock.Validator{} },
+			ExpectedErr:   "was not provided",
+		},
+		{
+			Name:          "Error when a document is not valid JSON",
+			Configuration: configuration,
+			Files:         map[string]string{validationDocumentURL: `{`},
+			BaseURL:       baseURL,
+			ValidatorFn:   func() *automock.Validator { return &automock.Validator{} },
+			ExpectedErr:   "error unmarshaling ORD document",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			validator := testCase.ValidatorFn()
+			client := &automock.Client{}
+			defer mock.AssertExpectationsForObjects(t, client, validator)
+
+			offlineValidator := ord.NewOfflineDocumentValidator(client, client, "", validator, ord.NewDocumentSanitizer())
+
+			// WHEN
+			report, err := offlineValidator.ValidateFiles(context.TODO(), testCase.Configuration, testCase.Files, testCase.BaseURL)
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErr)
+				require.Nil(t, report)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedReport, report)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/pkg/errors"
 )
 
@@ -57,3 +58,76 @@ func (p *OperationsProcessor) Process(ctx context.Context, operation *model.Oper
 	log.C(ctx).Infof("Processing of operation with id %q finished successfully", operation.ID)
 	return nil
 }
+
+// ORDPushService is responsible for processing ORD documents pushed by applications
+//
+//go:generate mockery --name=ORDPushService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ORDPushService interface {
+	ProcessPushedPayload(ctx context.Context, payload *model.ORDPushedPayload) error
+}
+
+// PushOperationsProcessor defines the processor of operations for ORD documents pushed by applications
+type PushOperationsProcessor struct {
+	OrdSvc     ORDPushService
+	PayloadSvc PushedPayloadService
+	Transact   persistence.Transactioner
+}
+
+// Process processes the pushed payload referenced by the given operation. The payload is deleted once processed,
+// as the outcome is kept in the operation itself.
+func (p *PushOperationsProcessor) Process(ctx context.Context, operation *model.Operation) error {
+	opData, err := data.ParseOrdPushOperationData(operation.Data)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing data of operation with id %q", operation.ID)
+	}
+
+	log.C(ctx).Infof("Starting processing of pushed ORD payload with id %q for application with id %q", opData.PayloadID, opData.ApplicationID)
+	payload, err := p.getPayloadInTx(ctx, opData.PayloadID)
+	if err != nil {
+		return err
+	}
+
+	processingErr := p.OrdSvc.ProcessPushedPayload(ctx, payload)
+
+	if err := p.deletePayloadInTx(ctx, payload.ID); err != nil {
+		log.C(ctx).WithError(err).Warnf("Failed to delete pushed ORD payload with id %q", payload.ID)
+	}
+
+	if processingErr != nil {
+		return processingErr
+	}
+
+	log.C(ctx).Infof("Processing of operation with id %q finished successfully", operation.ID)
+	return nil
+}
+
+func (p *PushOperationsProcessor) getPayloadInTx(ctx context.Context, id string) (*model.ORDPushedPayload, error) {
+	tx, err := p.Transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer p.Transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	payload, err := p.PayloadSvc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, tx.Commit()
+}
+
+func (p *PushOperationsProcessor) deletePayloadInTx(ctx context.Context, id string) error {
+	tx, err := p.Transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer p.Transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = p.PayloadSvc.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -48,7 +48,7 @@ type EntityTypeMappingService interface {
 type SpecService interface {
 	CreateByReferenceObjectID(ctx context.Context, in model.SpecInput, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string) (string, error)
 	CreateByReferenceObjectIDWithDelayedFetchRequest(ctx context.Context, in model.SpecInput, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string) (string, *model.FetchRequest, error)
-	DeleteByReferenceObjectID(ctx context.Context, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string) error
+	ResyncByReferenceObjectID(ctx context.Context, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string, specs []*model.SpecInput) ([]*model.FetchRequest, error)
 	GetByID(ctx context.Context, id string, objectType model.SpecReferenceObjectType) (*model.Spec, error)
 	ListFetchRequestsByReferenceObjectIDs(ctx context.Context, tenant string, objectIDs []string, objectType model.SpecReferenceObjectType) ([]*model.FetchRequest, error)
 	ListFetchRequestsByReferenceObjectIDsGlobal(ctx context.Context, objectIDs []string, objectType model.SpecReferenceObjectType) ([]*model.FetchRequest, error)
@@ -285,10 +285,7 @@ func (ap *APIProcessor) createSpecs(ctx context.Context, objectType model.SpecRe
 }
 
 func (ap *APIProcessor) resyncSpecs(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string, specs []*model.SpecInput, resourceType resource.Type) ([]*model.FetchRequest, error) {
-	if err := ap.specSvc.DeleteByReferenceObjectID(ctx, resourceType, objectType, objectID); err != nil {
-		return nil, err
-	}
-	return ap.createSpecs(ctx, objectType, objectID, specs, resourceType)
+	return ap.specSvc.ResyncByReferenceObjectID(ctx, resourceType, objectType, objectID, specs)
 }
 
 func (ap *APIProcessor) refetchFailedSpecs(ctx context.Context, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string) ([]*model.FetchRequest, error) {
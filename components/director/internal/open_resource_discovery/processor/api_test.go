@@ -19,6 +19,7 @@ import (
 
 func TestAPIProcessor_Process(t *testing.T) {
 	txGen := txtest.NewTransactionContextGenerator(testErr)
+	resyncedFetchRequest := &model.FetchRequest{ID: "resynced-fetch-request-id"}
 
 	fixAPIDef := []*model.APIDefinition{
 		fixAPI(apiID, str.Ptr(apiORDID)),
@@ -109,10 +110,7 @@ func TestAPIProcessor_Process(t *testing.T) {
 				spec1 := fixAPIInputs[0].ResourceDefinitions[0].ToSpec()
 				spec2 := fixAPIInputs[0].ResourceDefinitions[1].ToSpec()
 				spec3 := fixAPIInputs[0].ResourceDefinitions[2].ToSpec()
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.APISpecReference, apiID).Return(nil).Once()
-				specSvc.On("CreateByReferenceObjectIDWithDelayedFetchRequest", txtest.CtxWithDBMatcher(), *spec1, resource.Application, model.APISpecReference, apiID).Return("", nil, nil).Once()
-				specSvc.On("CreateByReferenceObjectIDWithDelayedFetchRequest", txtest.CtxWithDBMatcher(), *spec2, resource.Application, model.APISpecReference, apiID).Return("", nil, nil).Once()
-				specSvc.On("CreateByReferenceObjectIDWithDelayedFetchRequest", txtest.CtxWithDBMatcher(), *spec3, resource.Application, model.APISpecReference, apiID).Return("", nil, nil).Once()
+				specSvc.On("ResyncByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.APISpecReference, apiID, []*model.SpecInput{spec1, spec2, spec3}).Return([]*model.FetchRequest{resyncedFetchRequest, resyncedFetchRequest, resyncedFetchRequest}, nil).Once()
 				return specSvc
 			},
 			InputResource:              resource.Application,
@@ -122,7 +120,7 @@ func TestAPIProcessor_Process(t *testing.T) {
 			APIInput:                   fixAPIInputs,
 			InputResourceHashes:        resourceHashes,
 			ExpectedAPIDefOutput:       fixAPIDef,
-			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: nil, RefObjectOrdID: apiORDID}, {FetchRequest: nil, RefObjectOrdID: apiORDID}, {FetchRequest: nil, RefObjectOrdID: apiORDID}},
+			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: resyncedFetchRequest, RefObjectOrdID: apiORDID}, {FetchRequest: resyncedFetchRequest, RefObjectOrdID: apiORDID}, {FetchRequest: resyncedFetchRequest, RefObjectOrdID: apiORDID}},
 		},
 		{
 			Name: "Success - refetch specs",
@@ -484,7 +482,7 @@ func TestAPIProcessor_Process(t *testing.T) {
 			BundleReferenceSvcFn:   successfulBundleReferenceGet,
 			SpecSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.APISpecReference, apiID).Return(testErr).Once()
+				specSvc.On("ResyncByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.APISpecReference, apiID, mock.Anything).Return(nil, testErr).Once()
 				return specSvc
 			},
 			InputResource:       resource.Application,
@@ -587,10 +585,7 @@ func TestAPIProcessor_Process(t *testing.T) {
 				spec1 := fixAPIInputs[0].ResourceDefinitions[0].ToSpec()
 				spec2 := fixAPIInputs[0].ResourceDefinitions[1].ToSpec()
 				spec3 := fixAPIInputs[0].ResourceDefinitions[2].ToSpec()
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.APISpecReference, apiID).Return(nil).Once()
-				specSvc.On("CreateByReferenceObjectIDWithDelayedFetchRequest", txtest.CtxWithDBMatcher(), *spec1, resource.Application, model.APISpecReference, apiID).Return("", nil, nil).Once()
-				specSvc.On("CreateByReferenceObjectIDWithDelayedFetchRequest", txtest.CtxWithDBMatcher(), *spec2, resource.Application, model.APISpecReference, apiID).Return("", nil, nil).Once()
-				specSvc.On("CreateByReferenceObjectIDWithDelayedFetchRequest", txtest.CtxWithDBMatcher(), *spec3, resource.Application, model.APISpecReference, apiID).Return("", nil, nil).Once()
+				specSvc.On("ResyncByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.APISpecReference, apiID, []*model.SpecInput{spec1, spec2, spec3}).Return([]*model.FetchRequest{resyncedFetchRequest, resyncedFetchRequest, resyncedFetchRequest}, nil).Once()
 				return specSvc
 			},
 			InputResource:              resource.Application,
@@ -600,7 +595,7 @@ func TestAPIProcessor_Process(t *testing.T) {
 			APIInput:                   fixAPIInputs,
 			InputResourceHashes:        resourceHashes,
 			ExpectedAPIDefOutput:       fixUpdatedAPIDef,
-			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: nil, RefObjectOrdID: apiORDID}, {FetchRequest: nil, RefObjectOrdID: apiORDID}, {FetchRequest: nil, RefObjectOrdID: apiORDID}},
+			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: resyncedFetchRequest, RefObjectOrdID: apiORDID}, {FetchRequest: resyncedFetchRequest, RefObjectOrdID: apiORDID}, {FetchRequest: resyncedFetchRequest, RefObjectOrdID: apiORDID}},
 		},
 	}
 
@@ -73,20 +73,6 @@ func (_m *SpecService) CreateByReferenceObjectIDWithDelayedFetchRequest(ctx cont
 	return r0, r1, r2
 }
 
-// DeleteByReferenceObjectID provides a mock function with given fields: ctx, resourceType, objectType, objectID
-func (_m *SpecService) DeleteByReferenceObjectID(ctx context.Context, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string) error {
-	ret := _m.Called(ctx, resourceType, objectType, objectID)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Type, model.SpecReferenceObjectType, string) error); ok {
-		r0 = rf(ctx, resourceType, objectType, objectID)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
 // GetByID provides a mock function with given fields: ctx, id, objectType
 func (_m *SpecService) GetByID(ctx context.Context, id string, objectType model.SpecReferenceObjectType) (*model.Spec, error) {
 	ret := _m.Called(ctx, id, objectType)
@@ -217,6 +203,32 @@ func (_m *SpecService) ListIDByReferenceObjectID(ctx context.Context, resourceTy
 	return r0, r1
 }
 
+// ResyncByReferenceObjectID provides a mock function with given fields: ctx, resourceType, objectType, objectID, specs
+func (_m *SpecService) ResyncByReferenceObjectID(ctx context.Context, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string, specs []*model.SpecInput) ([]*model.FetchRequest, error) {
+	ret := _m.Called(ctx, resourceType, objectType, objectID, specs)
+
+	var r0 []*model.FetchRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Type, model.SpecReferenceObjectType, string, []*model.SpecInput) ([]*model.FetchRequest, error)); ok {
+		return rf(ctx, resourceType, objectType, objectID, specs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Type, model.SpecReferenceObjectType, string, []*model.SpecInput) []*model.FetchRequest); ok {
+		r0 = rf(ctx, resourceType, objectType, objectID, specs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FetchRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Type, model.SpecReferenceObjectType, string, []*model.SpecInput) error); ok {
+		r1 = rf(ctx, resourceType, objectType, objectID, specs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateSpecOnly provides a mock function with given fields: ctx, spec
 func (_m *SpecService) UpdateSpecOnly(ctx context.Context, spec model.Spec) error {
 	ret := _m.Called(ctx, spec)
@@ -199,10 +199,7 @@ func (cp *CapabilityProcessor) createSpecs(ctx context.Context, objectType model
 }
 
 func (cp *CapabilityProcessor) resyncSpecs(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string, specs []*model.SpecInput, resourceType resource.Type) ([]*model.FetchRequest, error) {
-	if err := cp.specSvc.DeleteByReferenceObjectID(ctx, resourceType, objectType, objectID); err != nil {
-		return nil, err
-	}
-	return cp.createSpecs(ctx, objectType, objectID, specs, resourceType)
+	return cp.specSvc.ResyncByReferenceObjectID(ctx, resourceType, objectType, objectID, specs)
 }
 
 func (cp *CapabilityProcessor) refetchFailedSpecs(ctx context.Context, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string) ([]*model.FetchRequest, error) {
@@ -20,6 +20,7 @@ import (
 
 func TestCapabilityProcessor_Process(t *testing.T) {
 	txGen := txtest.NewTransactionContextGenerator(testErr)
+	resyncedFetchRequest := &model.FetchRequest{ID: "resynced-fetch-request-id"}
 
 	fixCapabilities := []*model.Capability{
 		fixCapability(capabilityID, str.Ptr(capabilityORDID)),
@@ -86,8 +87,7 @@ func TestCapabilityProcessor_Process(t *testing.T) {
 			SpecSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
 				spec := fixCapabilityInputs[0].CapabilityDefinitions[0].ToSpec()
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.CapabilitySpecReference, capabilityID).Return(nil).Once()
-				specSvc.On("CreateByReferenceObjectIDWithDelayedFetchRequest", txtest.CtxWithDBMatcher(), *spec, resource.Application, model.CapabilitySpecReference, capabilityID).Return("", nil, nil).Once()
+				specSvc.On("ResyncByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.CapabilitySpecReference, capabilityID, []*model.SpecInput{spec}).Return([]*model.FetchRequest{resyncedFetchRequest}, nil).Once()
 				return specSvc
 			},
 			InputResource:              resource.Application,
@@ -96,7 +96,7 @@ func TestCapabilityProcessor_Process(t *testing.T) {
 			CapabilityInput:            fixCapabilityInputs,
 			InputResourceHashes:        resourceHashes,
 			ExpectedCapabilityOutput:   fixCapabilities,
-			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: nil, RefObjectOrdID: capabilityORDID}},
+			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: resyncedFetchRequest, RefObjectOrdID: capabilityORDID}},
 		},
 		{
 			Name: "Success - refetch specs",
@@ -321,7 +321,7 @@ func TestCapabilityProcessor_Process(t *testing.T) {
 			},
 			SpecSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.CapabilitySpecReference, capabilityID).Return(testErr).Once()
+				specSvc.On("ResyncByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.CapabilitySpecReference, capabilityID, mock.Anything).Return(nil, testErr).Once()
 				return specSvc
 			},
 			InputResource:       resource.Application,
@@ -413,8 +413,7 @@ func TestCapabilityProcessor_Process(t *testing.T) {
 			SpecSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
 				spec := fixCapabilityInputs[0].CapabilityDefinitions[0].ToSpec()
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.CapabilitySpecReference, capabilityID).Return(nil).Once()
-				specSvc.On("CreateByReferenceObjectIDWithDelayedFetchRequest", txtest.CtxWithDBMatcher(), *spec, resource.Application, model.CapabilitySpecReference, capabilityID).Return("", nil, nil).Once()
+				specSvc.On("ResyncByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.CapabilitySpecReference, capabilityID, []*model.SpecInput{spec}).Return([]*model.FetchRequest{resyncedFetchRequest}, nil).Once()
 				return specSvc
 			},
 			InputResource:              resource.Application,
@@ -423,7 +422,7 @@ func TestCapabilityProcessor_Process(t *testing.T) {
 			CapabilityInput:            fixCapabilityInputs,
 			InputResourceHashes:        resourceHashes,
 			ExpectedCapabilityOutput:   fixUpdatedCapabilities,
-			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: nil, RefObjectOrdID: capabilityORDID}},
+			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: resyncedFetchRequest, RefObjectOrdID: capabilityORDID}},
 		},
 	}
 
@@ -239,10 +239,7 @@ func (ep *EventProcessor) createSpecs(ctx context.Context, objectType model.Spec
 }
 
 func (ep *EventProcessor) resyncSpecs(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string, specs []*model.SpecInput, resourceType resource.Type) ([]*model.FetchRequest, error) {
-	if err := ep.specSvc.DeleteByReferenceObjectID(ctx, resourceType, objectType, objectID); err != nil {
-		return nil, err
-	}
-	return ep.createSpecs(ctx, objectType, objectID, specs, resourceType)
+	return ep.specSvc.ResyncByReferenceObjectID(ctx, resourceType, objectType, objectID, specs)
 }
 
 func (ep *EventProcessor) refetchFailedSpecs(ctx context.Context, resourceType resource.Type, objectType model.SpecReferenceObjectType, objectID string) ([]*model.FetchRequest, error) {
@@ -19,6 +19,7 @@ import (
 
 func TestEventProcessor_Process(t *testing.T) {
 	txGen := txtest.NewTransactionContextGenerator(testErr)
+	resyncedFetchRequest := &model.FetchRequest{ID: "resynced-fetch-request-id"}
 
 	fixEventDef := []*model.EventDefinition{
 		fixEvent(eventID, str.Ptr(eventORDID)),
@@ -108,8 +109,7 @@ func TestEventProcessor_Process(t *testing.T) {
 			SpecSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
 				spec := fixEventInputs[0].ResourceDefinitions[0].ToSpec()
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.EventSpecReference, eventID).Return(nil).Once()
-				specSvc.On("CreateByReferenceObjectIDWithDelayedFetchRequest", txtest.CtxWithDBMatcher(), *spec, resource.Application, model.EventSpecReference, eventID).Return("", nil, nil).Once()
+				specSvc.On("ResyncByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.EventSpecReference, eventID, []*model.SpecInput{spec}).Return([]*model.FetchRequest{resyncedFetchRequest}, nil).Once()
 				return specSvc
 			},
 			InputResource:              resource.Application,
@@ -119,7 +119,7 @@ func TestEventProcessor_Process(t *testing.T) {
 			EventInput:                 fixEventInputs,
 			InputResourceHashes:        resourceHashes,
 			ExpectedEventDefOutput:     fixEventDef,
-			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: nil, RefObjectOrdID: eventORDID}},
+			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: resyncedFetchRequest, RefObjectOrdID: eventORDID}},
 		},
 		{
 			Name: "Success - refetch specs",
@@ -477,7 +477,7 @@ func TestEventProcessor_Process(t *testing.T) {
 			BundleReferenceSvcFn:   successfulBundleReferenceGet,
 			SpecSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.EventSpecReference, eventID).Return(testErr).Once()
+				specSvc.On("ResyncByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.EventSpecReference, eventID, mock.Anything).Return(nil, testErr).Once()
 				return specSvc
 			},
 			InputResource:       resource.Application,
@@ -579,8 +579,7 @@ func TestEventProcessor_Process(t *testing.T) {
 			SpecSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
 				spec := fixEventInputs[0].ResourceDefinitions[0].ToSpec()
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.EventSpecReference, eventID).Return(nil).Once()
-				specSvc.On("CreateByReferenceObjectIDWithDelayedFetchRequest", txtest.CtxWithDBMatcher(), *spec, resource.Application, model.EventSpecReference, eventID).Return("", nil, nil).Once()
+				specSvc.On("ResyncByReferenceObjectID", txtest.CtxWithDBMatcher(), resource.Application, model.EventSpecReference, eventID, []*model.SpecInput{spec}).Return([]*model.FetchRequest{resyncedFetchRequest}, nil).Once()
 				return specSvc
 			},
 			InputResource:              resource.Application,
@@ -590,7 +589,7 @@ func TestEventProcessor_Process(t *testing.T) {
 			EventInput:                 fixEventInputsWithNewPkg(),
 			InputResourceHashes:        resourceHashes,
 			ExpectedEventDefOutput:     fixUpdatedEventDef,
-			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: nil, RefObjectOrdID: eventORDID}},
+			ExpectedFetchRequestOutput: []*processor.OrdFetchRequest{{FetchRequest: resyncedFetchRequest, RefObjectOrdID: eventORDID}},
 		},
 	}
 
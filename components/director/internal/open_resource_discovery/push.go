@@ -0,0 +1,87 @@
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+// PushConfigurationPartName is the name of the multipart form part holding the ORD well-known configuration of a push.
+// Every other part holds a document or a specification and is named after the URL under which it is referenced.
+const PushConfigurationPartName = "configuration"
+
+func parseConfiguration(configuration json.RawMessage) (*WellKnownConfig, error) {
+	config := WellKnownConfig{}
+	if err := json.Unmarshal(configuration, &config); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling ORD configuration")
+	}
+
+	return &config, nil
+}
+
+// documentsFromFiles resolves the ORD documents listed in the configuration from the provided files, for example the pushed ones
+func documentsFromFiles(config WellKnownConfig, files map[string]string) (Documents, []string, error) {
+	docs := make([]*Document, 0, len(config.OpenResourceDiscoveryV1.Documents))
+	docsString := make([]string, 0, len(config.OpenResourceDiscoveryV1.Documents))
+
+	for _, docDetails := range config.OpenResourceDiscoveryV1.Documents {
+		content, ok := files[docDetails.URL]
+		if !ok {
+			return nil, nil, errors.Errorf("ORD document %q listed in the configuration was not provided", docDetails.URL)
+		}
+
+		document := &Document{}
+		if err := json.Unmarshal([]byte(content), document); err != nil {
+			return nil, nil, errors.Wrapf(err, "error unmarshaling ORD document %q", docDetails.URL)
+		}
+
+		addDocument(&docs, &docsString, &fetchedDocument{document: document, content: content}, docDetails.Perspective)
+	}
+
+	return docs, docsString, nil
+}
+
+// pushedSpecFetcher serves the specifications from the pushed files instead of fetching them from the system
+func pushedSpecFetcher(files map[string]string) specFetcher {
+	return func(ctx context.Context, fr *model.FetchRequest, _ *sync.Map) (*string, *model.FetchRequestStatus) {
+		// The pushed specifications are not fetched, so there are no HTTP cache validators to keep
+		fr.ETag, fr.LastModified = nil, nil
+
+		content, ok := lookupPushedFile(files, fr.URL)
+		if !ok {
+			log.C(ctx).Errorf("Specification %q referenced by fetch request with ID %s was not pushed", fr.URL, fr.ID)
+			return nil, fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec: specification %q was not pushed", fr.URL)), time.Now())
+		}
+
+		return &content, fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, time.Now())
+	}
+}
+
+// lookupPushedFile finds the pushed file referenced by the given URL. The documents usually reference the specifications
+// with relative URLs, which are rewritten to absolute ones during sanitizing, so a relative file name matches the path of the URL.
+func lookupPushedFile(files map[string]string, fileURL string) (string, bool) {
+	if content, ok := files[fileURL]; ok {
+		return content, true
+	}
+
+	parsedURL, err := url.Parse(fileURL)
+	if err != nil {
+		return "", false
+	}
+
+	if content, ok := files[parsedURL.RequestURI()]; ok {
+		return content, true
+	}
+
+	content, ok := files[parsedURL.Path]
+	return content, ok
+}
@@ -0,0 +1,326 @@
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/data"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/httputils"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// PushOperationIDPathParam is the name of the path parameter holding the ID of the operation processing pushed ORD documents
+const PushOperationIDPathParam = "operationID"
+
+// PushOperationStatus represents the state of the asynchronous processing of pushed ORD documents
+type PushOperationStatus struct {
+	OperationID   string          `json:"operationID"`
+	Status        string          `json:"status"`
+	ErrorSeverity string          `json:"errorSeverity,omitempty"`
+	Error         json.RawMessage `json:"error,omitempty"`
+	CreatedAt     *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt     *time.Time      `json:"updatedAt,omitempty"`
+}
+
+type pushHandler struct {
+	opMgr           OperationsManager
+	opSvc           operationsmanager.OperationService
+	appSvc          ApplicationService
+	payloadSvc      PushedPayloadService
+	transact        persistence.Transactioner
+	onDemandChannel chan string
+	maxPayloadSize  int64
+}
+
+// NewORDPushHTTPHandler returns a new HTTP handler, responsible for accepting ORD documents pushed by applications
+func NewORDPushHTTPHandler(opMgr OperationsManager, opSvc operationsmanager.OperationService, appSvc ApplicationService, payloadSvc PushedPayloadService, transact persistence.Transactioner, onDemandChannel chan string, maxPayloadSize int64) *pushHandler {
+	return &pushHandler{
+		opMgr:           opMgr,
+		opSvc:           opSvc,
+		appSvc:          appSvc,
+		payloadSvc:      payloadSvc,
+		transact:        transact,
+		onDemandChannel: onDemandChannel,
+		maxPayloadSize:  maxPayloadSize,
+	}
+}
+
+// PushORDDocuments accepts an ORD configuration together with the ORD documents and specifications of the calling application
+// as a multipart form. The payload is stored and processed asynchronously by an operation, whose ID is returned as a result reference.
+func (h *pushHandler) PushORDDocuments(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	appID, ok := h.authorizedApplicationID(ctx, writer)
+	if !ok {
+		return
+	}
+
+	mediaType, _, err := mime.ParseMediaType(request.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		log.C(ctx).Errorf("Unsupported content type %q of pushed ORD documents", request.Header.Get("Content-Type"))
+		http.Error(writer, "The ORD documents must be pushed as multipart/form-data", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	request.Body = http.MaxBytesReader(writer, request.Body, h.maxPayloadSize)
+	configuration, files, err := readPushedFiles(request)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to read the pushed ORD documents")
+		http.Error(writer, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err = validatePushedFiles(configuration, files); err != nil {
+		log.C(ctx).WithError(err).Errorf("Invalid ORD documents pushed by application with id %q", appID)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err = h.getAppByID(ctx, appID); err != nil {
+		if apperrors.IsNotFoundError(err) {
+			log.C(ctx).WithError(err).Errorf("Application with id %q does not exist", appID)
+			http.Error(writer, "The calling Application does not exist", http.StatusNotFound)
+			return
+		}
+		log.C(ctx).WithError(err).Errorf("Getting application with id %q failed", appID)
+		http.Error(writer, "Getting Application failed", http.StatusInternalServerError)
+		return
+	}
+
+	payloadID, err := h.createPayload(ctx, appID, configuration, files)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Storing the ORD documents pushed by application with id %q failed", appID)
+		http.Error(writer, "Storing the pushed ORD documents failed", http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	rawData, err := data.NewOrdPushOperationData(appID, payloadID).GetData()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Preparing Operation for pushed ORD documents failed")
+		h.deletePayload(ctx, payloadID)
+		http.Error(writer, "Preparing Operation for pushed ORD documents failed", http.StatusInternalServerError)
+		return
+	}
+
+	opID, err := h.opMgr.CreateOperation(ctx, &model.OperationInput{
+		OpType:        model.OperationTypeOrdPush,
+		Status:        model.OperationStatusScheduled,
+		Data:          json.RawMessage(rawData),
+		Priority:      int(operationsmanager.HighOperationPriority),
+		ErrorSeverity: model.OperationErrorSeverityNone,
+		CreatedAt:     &now,
+	})
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Creating Operation for pushed ORD documents failed")
+		h.deletePayload(ctx, payloadID)
+		http.Error(writer, "Creating Operation for pushed ORD documents failed", http.StatusInternalServerError)
+		return
+	}
+	log.C(ctx).Infof("Successfully created operation with id %q for ORD documents pushed by application with id %q", opID, appID)
+
+	// Notify OperationProcessors for new operation
+	h.onDemandChannel <- opID
+
+	writer.Header().Set("Location", strings.TrimSuffix(request.URL.Path, "/")+"/"+opID)
+	httputils.RespondWithBody(ctx, writer, http.StatusAccepted, PushOperationStatus{
+		OperationID: opID,
+		Status:      string(model.OperationStatusScheduled),
+		CreatedAt:   &now,
+	})
+}
+
+// GetPushOperationStatus returns the state of the processing of ORD documents previously pushed by the calling application
+func (h *pushHandler) GetPushOperationStatus(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	appID, ok := h.authorizedApplicationID(ctx, writer)
+	if !ok {
+		return
+	}
+
+	opID := mux.Vars(request)[PushOperationIDPathParam]
+	op, err := h.getOperationByID(ctx, opID)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		log.C(ctx).WithError(err).Errorf("Getting operation with id %q failed", opID)
+		http.Error(writer, "Getting Operation failed", http.StatusInternalServerError)
+		return
+	}
+
+	if err != nil || !isPushOperationOfApplication(op, appID) {
+		log.C(ctx).Errorf("Operation with id %q for pushed ORD documents of application with id %q does not exist", opID, appID)
+		http.Error(writer, "Operation not found", http.StatusNotFound)
+		return
+	}
+
+	httputils.RespondWithBody(ctx, writer, http.StatusOK, PushOperationStatus{
+		OperationID:   op.ID,
+		Status:        string(op.Status),
+		ErrorSeverity: string(op.ErrorSeverity),
+		Error:         op.Error,
+		CreatedAt:     op.CreatedAt,
+		UpdatedAt:     op.UpdatedAt,
+	})
+}
+
+// authorizedApplicationID returns the ID of the calling application. Only applications, authenticated either with
+// a certificate or with a system auth, are allowed to push ORD documents on their own behalf.
+func (h *pushHandler) authorizedApplicationID(ctx context.Context, writer http.ResponseWriter) (string, bool) {
+	cons, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to load consumer from context")
+		http.Error(writer, "Unauthorized", http.StatusUnauthorized)
+		return "", false
+	}
+
+	if cons.Type != consumer.Application || cons.ConsumerID == "" {
+		log.C(ctx).Errorf("Consumer with id %q and type %q is not allowed to push ORD documents", cons.ConsumerID, cons.Type)
+		http.Error(writer, "Only applications are allowed to push ORD documents", http.StatusForbidden)
+		return "", false
+	}
+
+	return cons.ConsumerID, true
+}
+
+func (h *pushHandler) getAppByID(ctx context.Context, appID string) (*model.Application, error) {
+	tx, err := h.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+	app, err := h.appSvc.GetGlobalByID(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	return app, tx.Commit()
+}
+
+func (h *pushHandler) createPayload(ctx context.Context, appID string, configuration json.RawMessage, files map[string]string) (string, error) {
+	tx, err := h.transact.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+	payloadID, err := h.payloadSvc.Create(ctx, appID, configuration, files)
+	if err != nil {
+		return "", err
+	}
+
+	return payloadID, tx.Commit()
+}
+
+func (h *pushHandler) deletePayload(ctx context.Context, payloadID string) {
+	tx, err := h.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to delete pushed ORD payload with id %q", payloadID)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+	if err = h.payloadSvc.Delete(ctx, payloadID); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to delete pushed ORD payload with id %q", payloadID)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to delete pushed ORD payload with id %q", payloadID)
+	}
+}
+
+func (h *pushHandler) getOperationByID(ctx context.Context, opID string) (*model.Operation, error) {
+	tx, err := h.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+	op, err := h.opSvc.Get(ctx, opID)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, tx.Commit()
+}
+
+func isPushOperationOfApplication(op *model.Operation, appID string) bool {
+	if op == nil || op.OpType != model.OperationTypeOrdPush {
+		return false
+	}
+
+	opData, err := data.ParseOrdPushOperationData(op.Data)
+	if err != nil {
+		return false
+	}
+
+	return opData.ApplicationID == appID
+}
+
+// readPushedFiles reads the parts of the multipart request. The configuration part is returned separately,
+// while every other part is returned as a file keyed by the name of the part.
+func readPushedFiles(request *http.Request) (json.RawMessage, map[string]string, error) {
+	reader, err := request.MultipartReader()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var configuration json.RawMessage
+	files := make(map[string]string)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while reading multipart request")
+		}
+
+		name := part.FormName()
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "while reading part %q", name)
+		}
+
+		if name == PushConfigurationPartName {
+			configuration = content
+			continue
+		}
+		files[name] = string(content)
+	}
+
+	return configuration, files, nil
+}
+
+// validatePushedFiles ensures that the configuration and all documents listed in it are present and well-formed.
+// The documents themselves are validated asynchronously during processing.
+func validatePushedFiles(configuration json.RawMessage, files map[string]string) error {
+	if len(configuration) == 0 {
+		return errors.Errorf("the ORD configuration must be provided in the %q part", PushConfigurationPartName)
+	}
+
+	config, err := parseConfiguration(configuration)
+	if err != nil {
+		return err
+	}
+
+	if len(config.OpenResourceDiscoveryV1.Documents) == 0 {
+		return errors.New("the ORD configuration does not list any documents")
+	}
+
+	_, _, err = documentsFromFiles(*config, files)
+	return err
+}
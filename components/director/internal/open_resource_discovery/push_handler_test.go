@@ -0,0 +1,389 @@
+package ord_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/data"
+	operationsmanagerautomock "github.com/kyma-incubator/compass/components/director/internal/operations_manager/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	pushPath              = "/push"
+	pushedAppID           = "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	pushedPayloadID       = "bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	pushOperationID       = "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+	pushedDocumentURL     = "/open-resource-discovery/v1/documents/example1"
+	pushedConfiguration   = `{"openResourceDiscoveryV1":{"documents":[{"url":"/open-resource-discovery/v1/documents/example1","accessStrategies":[{"type":"open"}],"perspective":"system-instance"}]}}`
+	pushedDocumentContent = `{"openResourceDiscovery":"1.9"}`
+)
+
+func TestPushHandler_PushORDDocuments(t *testing.T) {
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	appConsumer := consumer.Consumer{ConsumerID: pushedAppID, Type: consumer.Application}
+	validParts := map[string]string{
+		ord.PushConfigurationPartName: pushedConfiguration,
+		pushedDocumentURL:             pushedDocumentContent,
+	}
+	expectedFiles := map[string]string{pushedDocumentURL: pushedDocumentContent}
+
+	testCases := []struct {
+		Name                string
+		Consumer            *consumer.Consumer
+		Parts               map[string]string
+		ContentType         string
+		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		OperationManagerFn  func() *automock.OperationsManager
+		ApplicationSvcFn    func() *automock.ApplicationService
+		PayloadSvcFn        func() *automock.PushedPayloadService
+		ExpectedStatusCode  int
+		ExpectedErrorOutput string
+		ExpectNotification  bool
+	}{
+		{
+			Name:            "Success",
+			Consumer:        &appConsumer,
+			Parts:           validParts,
+			TransactionerFn: txGen.ThatSucceedsTwice,
+			OperationManagerFn: func() *automock.OperationsManager {
+				opManager := &automock.OperationsManager{}
+				opManager.On("CreateOperation", mock.Anything, mock.MatchedBy(func(in *model.OperationInput) bool {
+					expectedData, err := data.NewOrdPushOperationData(pushedAppID, pushedPayloadID).GetData()
+					return err == nil && in.OpType == model.OperationTypeOrdPush && in.Status == model.OperationStatusScheduled && string(in.Data) == expectedData
+				})).Return(pushOperationID, nil).Once()
+				return opManager
+			},
+			ApplicationSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), pushedAppID).Return(&model.Application{BaseEntity: &model.BaseEntity{ID: pushedAppID}}, nil).Once()
+				return appSvc
+			},
+			PayloadSvcFn: func() *automock.PushedPayloadService {
+				payloadSvc := &automock.PushedPayloadService{}
+				payloadSvc.On("Create", txtest.CtxWithDBMatcher(), pushedAppID, json.RawMessage(pushedConfiguration), expectedFiles).Return(pushedPayloadID, nil).Once()
+				return payloadSvc
+			},
+			ExpectedStatusCode: http.StatusAccepted,
+			ExpectNotification: true,
+		},
+		{
+			Name:               "Error when there is no consumer in the context",
+			Parts:              validParts,
+			TransactionerFn:    txGen.ThatDoesntStartTransaction,
+			ExpectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			Name:                "Error when the consumer is not an application",
+			Consumer:            &consumer.Consumer{ConsumerID: pushedAppID, Type: consumer.Runtime},
+			Parts:               validParts,
+			TransactionerFn:     txGen.ThatDoesntStartTransaction,
+			ExpectedStatusCode:  http.StatusForbidden,
+			ExpectedErrorOutput: "Only applications are allowed to push ORD documents",
+		},
+		{
+			Name:               "Error when the request is not a multipart form",
+			Consumer:           &appConsumer,
+			ContentType:        "application/json",
+			TransactionerFn:    txGen.ThatDoesntStartTransaction,
+			ExpectedStatusCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			Name:                "Error when the configuration is missing",
+			Consumer:            &appConsumer,
+			Parts:               map[string]string{pushedDocumentURL: pushedDocumentContent},
+			TransactionerFn:     txGen.ThatDoesntStartTransaction,
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "the ORD configuration must be provided",
+		},
+		{
+			Name:                "Error when a document listed in the configuration is not pushed",
+			Consumer:            &appConsumer,
+			Parts:               map[string]string{ord.PushConfigurationPartName: pushedConfiguration},
+			TransactionerFn:     txGen.ThatDoesntStartTransaction,
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "was not provided",
+		},
+		{
+			Name:                "Error when the application does not exist",
+			Consumer:            &appConsumer,
+			Parts:               validParts,
+			TransactionerFn:     txGen.ThatDoesntExpectCommit,
+			ExpectedStatusCode:  http.StatusNotFound,
+			ExpectedErrorOutput: "The calling Application does not exist",
+			ApplicationSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), pushedAppID).Return(nil, apperrors.NewNotFoundError(resource.Application, pushedAppID)).Once()
+				return appSvc
+			},
+		},
+		{
+			Name:     "Error when storing the payload fails",
+			Consumer: &appConsumer,
+			Parts:    validParts,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(1)
+			},
+			ApplicationSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), pushedAppID).Return(&model.Application{BaseEntity: &model.BaseEntity{ID: pushedAppID}}, nil).Once()
+				return appSvc
+			},
+			PayloadSvcFn: func() *automock.PushedPayloadService {
+				payloadSvc := &automock.PushedPayloadService{}
+				payloadSvc.On("Create", txtest.CtxWithDBMatcher(), pushedAppID, json.RawMessage(pushedConfiguration), expectedFiles).Return("", testErr).Once()
+				return payloadSvc
+			},
+			ExpectedStatusCode:  http.StatusInternalServerError,
+			ExpectedErrorOutput: "Storing the pushed ORD documents failed",
+		},
+		{
+			Name:     "Error when creating the operation fails and the payload is deleted",
+			Consumer: &appConsumer,
+			Parts:    validParts,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			OperationManagerFn: func() *automock.OperationsManager {
+				opManager := &automock.OperationsManager{}
+				opManager.On("CreateOperation", mock.Anything, mock.Anything).Return("", testErr).Once()
+				return opManager
+			},
+			ApplicationSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), pushedAppID).Return(&model.Application{BaseEntity: &model.BaseEntity{ID: pushedAppID}}, nil).Once()
+				return appSvc
+			},
+			PayloadSvcFn: func() *automock.PushedPayloadService {
+				payloadSvc := &automock.PushedPayloadService{}
+				payloadSvc.On("Create", txtest.CtxWithDBMatcher(), pushedAppID, json.RawMessage(pushedConfiguration), expectedFiles).Return(pushedPayloadID, nil).Once()
+				payloadSvc.On("Delete", txtest.CtxWithDBMatcher(), pushedPayloadID).Return(nil).Once()
+				return payloadSvc
+			},
+			ExpectedStatusCode:  http.StatusInternalServerError,
+			ExpectedErrorOutput: "Creating Operation for pushed ORD documents failed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, tx := testCase.TransactionerFn()
+			operationManager := &automock.OperationsManager{}
+			if testCase.OperationManagerFn != nil {
+				operationManager = testCase.OperationManagerFn()
+			}
+			appSvc := &automock.ApplicationService{}
+			if testCase.ApplicationSvcFn != nil {
+				appSvc = testCase.ApplicationSvcFn()
+			}
+			payloadSvc := &automock.PushedPayloadService{}
+			if testCase.PayloadSvcFn != nil {
+				payloadSvc = testCase.PayloadSvcFn()
+			}
+			opSvc := &operationsmanagerautomock.OperationService{}
+			defer mock.AssertExpectationsForObjects(t, persist, tx, operationManager, appSvc, payloadSvc, opSvc)
+
+			onDemandChannel := make(chan string, 1)
+
+			handler := ord.NewORDPushHTTPHandler(operationManager, opSvc, appSvc, payloadSvc, tx, onDemandChannel, 1024*1024)
+
+			request := fixPushRequest(t, testCase.Parts, testCase.ContentType)
+			if testCase.Consumer != nil {
+				request = request.WithContext(consumer.SaveToContext(request.Context(), *testCase.Consumer))
+			}
+			writer := httptest.NewRecorder()
+
+			// WHEN
+			handler.PushORDDocuments(writer, request)
+
+			// THEN
+			resp := writer.Result()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.ExpectedStatusCode, resp.StatusCode)
+			if len(testCase.ExpectedErrorOutput) > 0 {
+				assert.Contains(t, string(body), testCase.ExpectedErrorOutput)
+			}
+
+			if testCase.ExpectNotification {
+				assert.Equal(t, pushOperationID, <-onDemandChannel)
+				assert.Equal(t, pushPath+"/"+pushOperationID, resp.Header.Get("Location"))
+
+				status := ord.PushOperationStatus{}
+				require.NoError(t, json.Unmarshal(body, &status))
+				assert.Equal(t, pushOperationID, status.OperationID)
+				assert.Equal(t, string(model.OperationStatusScheduled), status.Status)
+			} else {
+				assert.Empty(t, onDemandChannel)
+			}
+		})
+	}
+}
+
+func TestPushHandler_GetPushOperationStatus(t *testing.T) {
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	opData, err := data.NewOrdPushOperationData(pushedAppID, pushedPayloadID).GetData()
+	require.NoError(t, err)
+	otherAppOpData, err := data.NewOrdPushOperationData("other-app-id", pushedPayloadID).GetData()
+	require.NoError(t, err)
+
+	operation := &model.Operation{
+		ID:            pushOperationID,
+		OpType:        model.OperationTypeOrdPush,
+		Status:        model.OperationStatusFailed,
+		Data:          json.RawMessage(opData),
+		Error:         json.RawMessage(`{"error":"validation failed"}`),
+		ErrorSeverity: model.OperationErrorSeverityError,
+	}
+
+	testCases := []struct {
+		Name               string
+		Consumer           *consumer.Consumer
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		OperationSvcFn     func() *operationsmanagerautomock.OperationService
+		ExpectedStatusCode int
+		ExpectedStatus     *ord.PushOperationStatus
+	}{
+		{
+			Name:            "Success",
+			Consumer:        &consumer.Consumer{ConsumerID: pushedAppID, Type: consumer.Application},
+			TransactionerFn: txGen.ThatSucceeds,
+			OperationSvcFn: func() *operationsmanagerautomock.OperationService {
+				opSvc := &operationsmanagerautomock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), pushOperationID).Return(operation, nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedStatus: &ord.PushOperationStatus{
+				OperationID:   pushOperationID,
+				Status:        string(model.OperationStatusFailed),
+				ErrorSeverity: string(model.OperationErrorSeverityError),
+				Error:         json.RawMessage(`{"error":"validation failed"}`),
+			},
+		},
+		{
+			Name:            "Error when the operation belongs to another application",
+			Consumer:        &consumer.Consumer{ConsumerID: pushedAppID, Type: consumer.Application},
+			TransactionerFn: txGen.ThatSucceeds,
+			OperationSvcFn: func() *operationsmanagerautomock.OperationService {
+				opSvc := &operationsmanagerautomock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), pushOperationID).Return(&model.Operation{ID: pushOperationID, OpType: model.OperationTypeOrdPush, Data: json.RawMessage(otherAppOpData)}, nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		{
+			Name:            "Error when the operation is not a push operation",
+			Consumer:        &consumer.Consumer{ConsumerID: pushedAppID, Type: consumer.Application},
+			TransactionerFn: txGen.ThatSucceeds,
+			OperationSvcFn: func() *operationsmanagerautomock.OperationService {
+				opSvc := &operationsmanagerautomock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), pushOperationID).Return(&model.Operation{ID: pushOperationID, OpType: model.OperationTypeOrdAggregation, Data: json.RawMessage(opData)}, nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		{
+			Name:            "Error when the operation does not exist",
+			Consumer:        &consumer.Consumer{ConsumerID: pushedAppID, Type: consumer.Application},
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OperationSvcFn: func() *operationsmanagerautomock.OperationService {
+				opSvc := &operationsmanagerautomock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), pushOperationID).Return(nil, apperrors.NewNotFoundError(resource.Operation, pushOperationID)).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		{
+			Name:            "Error when getting the operation fails",
+			Consumer:        &consumer.Consumer{ConsumerID: pushedAppID, Type: consumer.Application},
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OperationSvcFn: func() *operationsmanagerautomock.OperationService {
+				opSvc := &operationsmanagerautomock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), pushOperationID).Return(nil, testErr).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			Name:            "Error when the consumer is not an application",
+			Consumer:        &consumer.Consumer{ConsumerID: pushedAppID, Type: consumer.IntegrationSystem},
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			OperationSvcFn: func() *operationsmanagerautomock.OperationService {
+				return &operationsmanagerautomock.OperationService{}
+			},
+			ExpectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, tx := testCase.TransactionerFn()
+			opSvc := testCase.OperationSvcFn()
+			defer mock.AssertExpectationsForObjects(t, persist, tx, opSvc)
+
+			handler := ord.NewORDPushHTTPHandler(&automock.OperationsManager{}, opSvc, &automock.ApplicationService{}, &automock.PushedPayloadService{}, tx, make(chan string, 1), 1024)
+
+			request := httptest.NewRequest(http.MethodGet, pushPath+"/"+pushOperationID, nil)
+			request = mux.SetURLVars(request, map[string]string{ord.PushOperationIDPathParam: pushOperationID})
+			request = request.WithContext(consumer.SaveToContext(request.Context(), *testCase.Consumer))
+			writer := httptest.NewRecorder()
+
+			// WHEN
+			handler.GetPushOperationStatus(writer, request)
+
+			// THEN
+			resp := writer.Result()
+			assert.Equal(t, testCase.ExpectedStatusCode, resp.StatusCode)
+
+			if testCase.ExpectedStatus != nil {
+				status := ord.PushOperationStatus{}
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+				assert.Equal(t, *testCase.ExpectedStatus, status)
+			}
+		})
+	}
+}
+
+func fixPushRequest(t *testing.T, parts map[string]string, contentType string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for name, content := range parts {
+		part, err := writer.CreateFormField(name)
+		require.NoError(t, err)
+		_, err = part.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	if contentType == "" {
+		contentType = writer.FormDataContentType()
+	}
+
+	request := httptest.NewRequest(http.MethodPost, pushPath, body).WithContext(context.Background())
+	request.Header.Set("Content-Type", contentType)
+	return request
+}
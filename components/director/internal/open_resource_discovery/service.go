@@ -74,6 +74,9 @@ type CredentialExchangeStrategyTenantMapping struct {
 	Version string
 }
 
+// specFetcher retrieves the content of the specification referenced by a fetch request
+type specFetcher func(ctx context.Context, fr *model.FetchRequest, headers *sync.Map) (*string, *model.FetchRequestStatus)
+
 type fetchRequestResult struct {
 	fetchRequest *model.FetchRequest
 	data         *string
@@ -129,14 +132,15 @@ type Service struct {
 
 	webhookConverter WebhookConverter
 
-	globalRegistrySvc GlobalRegistryService
-	ordClient         Client
-	documentValidator Validator
-	documentSanitizer DocumentSanitizer
+	globalRegistrySvc         GlobalRegistryService
+	ordClient                 Client
+	documentCacheValidatorSvc DocumentCacheValidatorService
+	documentValidator         Validator
+	documentSanitizer         DocumentSanitizer
 }
 
 // NewAggregatorService returns a new object responsible for service-layer ORD operations.
-func NewAggregatorService(config ServiceConfig, metricsCfg MetricsConfig, transact persistence.Transactioner, appSvc ApplicationService, webhookSvc WebhookService, bundleSvc BundleService, bundleReferenceSvc BundleReferenceService, apiProcessor APIProcessor, eventProcessor EventProcessor, entityTypeProcessor EntityTypeProcessor, capabilityProcessor CapabilityProcessor, integrationDependencyProcessor IntegrationDependencyProcessor, dataProductProcessor DataProductProcessor, specSvc SpecService, fetchReqSvc FetchRequestService, packageProcessor PackageProcessor, productProcessor ProductProcessor, vendorProcessor VendorProcessor, tombstoneProcessor TombstoneProcessor, tenantSvc TenantService, globalRegistrySvc GlobalRegistryService, client Client, webhookConverter WebhookConverter, appTemplateVersionSvc ApplicationTemplateVersionService, appTemplateSvc ApplicationTemplateService, tombstonedResourcesDeleter TombstonedResourcesDeleter, labelService LabelService, ordWebhookMapping []application.ORDWebhookMapping, opSvc operationsmanager.OperationService, documentValidator Validator, documentSanitizer DocumentSanitizer) *Service {
+func NewAggregatorService(config ServiceConfig, metricsCfg MetricsConfig, transact persistence.Transactioner, appSvc ApplicationService, webhookSvc WebhookService, bundleSvc BundleService, bundleReferenceSvc BundleReferenceService, apiProcessor APIProcessor, eventProcessor EventProcessor, entityTypeProcessor EntityTypeProcessor, capabilityProcessor CapabilityProcessor, integrationDependencyProcessor IntegrationDependencyProcessor, dataProductProcessor DataProductProcessor, specSvc SpecService, fetchReqSvc FetchRequestService, packageProcessor PackageProcessor, productProcessor ProductProcessor, vendorProcessor VendorProcessor, tombstoneProcessor TombstoneProcessor, tenantSvc TenantService, globalRegistrySvc GlobalRegistryService, client Client, documentCacheValidatorSvc DocumentCacheValidatorService, webhookConverter WebhookConverter, appTemplateVersionSvc ApplicationTemplateVersionService, appTemplateSvc ApplicationTemplateService, tombstonedResourcesDeleter TombstonedResourcesDeleter, labelService LabelService, ordWebhookMapping []application.ORDWebhookMapping, opSvc operationsmanager.OperationService, documentValidator Validator, documentSanitizer DocumentSanitizer) *Service {
 	return &Service{
 		config:                         config,
 		metricsCfg:                     metricsCfg,
@@ -160,6 +164,7 @@ func NewAggregatorService(config ServiceConfig, metricsCfg MetricsConfig, transa
 		tenantSvc:                      tenantSvc,
 		globalRegistrySvc:              globalRegistrySvc,
 		ordClient:                      client,
+		documentCacheValidatorSvc:      documentCacheValidatorSvc,
 		webhookConverter:               webhookConverter,
 		appTemplateVersionSvc:          appTemplateVersionSvc,
 		appTemplateSvc:                 appTemplateSvc,
@@ -271,6 +276,74 @@ func (s *Service) ProcessApplicationTemplate(ctx context.Context, appTemplateID
 	return nil
 }
 
+// ProcessPushedPayload performs resync of ORD information pushed by an application together with its ORD configuration and specifications.
+// The pushed documents go through the same validation, sanitizing and tombstone handling as the fetched ones.
+func (s *Service) ProcessPushedPayload(ctx context.Context, payload *model.ORDPushedPayload) error {
+	ctx, err := s.saveLowestOwnerForAppToContextInTx(ctx, payload.ApplicationID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	app, err := s.appSvc.Get(ctx, payload.ApplicationID)
+	if err != nil {
+		return errors.Wrapf(err, "error while retrieving app with id %q", payload.ApplicationID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	ctx = tenant.SaveLocalTenantIDToContext(ctx, str.PtrStrToStr(app.LocalTenantID))
+	ctx = addFieldToLogger(ctx, "resource_id", app.ID)
+	ctx = addFieldToLogger(ctx, "resource_type", string(directorresource.Application))
+
+	resource := Resource{
+		Type:          directorresource.Application,
+		ID:            app.ID,
+		ParentID:      app.ApplicationTemplateID,
+		Name:          app.Name,
+		LocalTenantID: app.LocalTenantID,
+	}
+
+	config, err := parseConfiguration(payload.Configuration)
+	if err != nil {
+		return err
+	}
+
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = str.PtrStrToStr(app.BaseURL)
+	}
+
+	if err = config.Validate(baseURL); err != nil {
+		return errors.Wrap(err, "while validating pushed ORD config")
+	}
+
+	documents, docsString, err := documentsFromFiles(*config, payload.Files)
+	if err != nil {
+		return err
+	}
+
+	log.C(ctx).Infof("Retrieving global ORD resources")
+	globalResourcesOrdIDs := s.retrieveGlobalResources(ctx)
+
+	ordRequestObject := requestobject.OpenResourceDiscoveryWebhookRequestObject{
+		Application: requestobject.Application{BaseURL: str.PtrStrToStr(app.BaseURL)},
+		Headers:     &sync.Map{},
+	}
+
+	log.C(ctx).Infof("Processing %d pushed ORD documents for application with ID %s", len(documents), app.ID)
+	return s.processFetchedDocuments(ctx, resource, baseURL, "", ordRequestObject, documents, globalResourcesOrdIDs, docsString, nil, pushedSpecFetcher(payload.Files))
+}
+
 func (s *Service) retrieveGlobalResources(ctx context.Context) map[string]bool {
 	globalResourcesOrdIDs, err := s.globalRegistrySvc.SyncGlobalResources(ctx)
 	if err != nil {
@@ -329,7 +402,7 @@ func (s *Service) getWebhooksForApplication(ctx context.Context, appID string) (
 	return webhooks, nil
 }
 
-func (s *Service) processDocuments(ctx context.Context, resource Resource, webhookBaseURL, webhookBaseProxyURL string, ordRequestObject requestobject.OpenResourceDiscoveryWebhookRequestObject, documents Documents, globalResourcesOrdIDs map[string]bool, docsString []string) ([]*ValidationError, error) {
+func (s *Service) processDocuments(ctx context.Context, resource Resource, webhookBaseURL, webhookBaseProxyURL string, ordRequestObject requestobject.OpenResourceDiscoveryWebhookRequestObject, documents Documents, globalResourcesOrdIDs map[string]bool, docsString []string, fetchSpec specFetcher) ([]*ValidationError, error) {
 	if _, err := s.processDescribedSystemVersions(ctx, resource, documents); err != nil {
 		return nil, err
 	}
@@ -477,7 +550,7 @@ func (s *Service) processDocuments(ctx context.Context, resource Resource, webho
 		log.C(ctx).Infof("Finished deleting tombstoned resources for %s with id: %q", resource.Type, resource.ID)
 
 		log.C(ctx).Infof("Starting processing specs for %s with id: %q", resource.Type, resource.ID)
-		if err := s.processSpecs(ctx, resourceToAggregate.Type, fetchRequests, ordRequestObject); err != nil {
+		if err := s.processSpecs(ctx, resourceToAggregate.Type, fetchRequests, ordRequestObject, fetchSpec); err != nil {
 			return validationErrors, err
 		}
 		log.C(ctx).Infof("Finished processing specs for %s with id: %q", resource.Type, resource.ID)
@@ -486,7 +559,7 @@ func (s *Service) processDocuments(ctx context.Context, resource Resource, webho
 	return validationErrors, nil
 }
 
-func (s *Service) processSpecs(ctx context.Context, resourceType directorresource.Type, ordFetchRequests []*processor.OrdFetchRequest, ordRequestObject requestobject.OpenResourceDiscoveryWebhookRequestObject) error {
+func (s *Service) processSpecs(ctx context.Context, resourceType directorresource.Type, ordFetchRequests []*processor.OrdFetchRequest, ordRequestObject requestobject.OpenResourceDiscoveryWebhookRequestObject, fetchSpec specFetcher) error {
 	queue := make(chan *model.FetchRequest)
 
 	workers := s.config.maxParallelSpecificationProcessors
@@ -504,7 +577,7 @@ func (s *Service) processSpecs(ctx context.Context, resourceType directorresourc
 				fr := *fetchRequest
 				ctx = addFieldToLogger(ctx, "fetch_request_id", fr.ID)
 				log.C(ctx).Infof("Will attempt to execute spec fetch request for spec with id %q and spec entity type %q", fr.ObjectID, fr.ObjectType)
-				data, status := s.fetchReqSvc.FetchSpec(ctx, &fr, ordRequestObject.Headers)
+				data, status := fetchSpec(ctx, &fr, ordRequestObject.Headers)
 				log.C(ctx).Infof("Finished executing spec fetch request for spec with id %q and spec entity type %q with result: %s. Adding to result queue...", fr.ObjectID, fr.ObjectType, status.Condition)
 				s.addFetchRequestResult(&fetchRequestResults, &fetchRequestResult{
 					fetchRequest: &fr,
@@ -530,12 +603,20 @@ func (s *Service) processSpecs(ctx context.Context, resourceType directorresourc
 	}
 
 	fetchRequestErrors := 0
+	unchangedSpecs := 0
 	for _, fr := range fetchRequestResults {
 		if fr.status.Condition == model.FetchRequestStatusConditionFailed {
 			fetchRequestErrors += 1
+		} else if !isSpecModified(fr) {
+			unchangedSpecs += 1
 		}
 	}
 
+	if unchangedSpecs > 0 {
+		log.C(ctx).Infof("%d specifications have not been modified since the last fetch and were skipped", unchangedSpecs)
+		s.unchangedMetricsPusher().ReportUnchangedORD(ctx, metrics.UnchangedSpecificationKind, unchangedSpecs)
+	}
+
 	if fetchRequestErrors != 0 {
 		return errors.Errorf("failed to process %d specification fetch requests", fetchRequestErrors)
 	}
@@ -581,7 +662,7 @@ func (s *Service) processFetchRequestResult(ctx context.Context, result *fetchRe
 		specReferenceType = model.CapabilitySpecReference
 	}
 
-	if result.status.Condition == model.FetchRequestStatusConditionSucceeded {
+	if isSpecModified(result) {
 		spec, err := s.specSvc.GetByID(ctx, result.fetchRequest.ObjectID, specReferenceType)
 		if err != nil {
 			return err
@@ -599,7 +680,7 @@ func (s *Service) processFetchRequestResult(ctx context.Context, result *fetchRe
 }
 
 func (s *Service) processFetchRequestResultGlobal(ctx context.Context, result *fetchRequestResult) error {
-	if result.status.Condition == model.FetchRequestStatusConditionSucceeded {
+	if isSpecModified(result) {
 		spec, err := s.specSvc.GetByIDGlobal(ctx, result.fetchRequest.ObjectID)
 		if err != nil {
 			return err
@@ -616,6 +697,12 @@ func (s *Service) processFetchRequestResultGlobal(ctx context.Context, result *f
 	return s.fetchReqSvc.UpdateGlobal(ctx, result.fetchRequest)
 }
 
+// isSpecModified reports whether the fetched specification has to be stored. A successful fetch request without data
+// means that the specification has not been modified since the last fetch, in which case the stored one is kept.
+func isSpecModified(result *fetchRequestResult) bool {
+	return result.status.Condition == model.FetchRequestStatusConditionSucceeded && result.data != nil
+}
+
 func (s *Service) processDescribedSystemVersions(ctx context.Context, resource Resource, documents Documents) ([]*model.ApplicationTemplateVersion, error) {
 	appTemplateID := resource.ID
 	if resource.Type == directorresource.Application && resource.ParentID != nil {
@@ -656,6 +743,70 @@ func (s *Service) listApplicationTemplateVersionByAppTemplateIDInTx(ctx context.
 	return appTemplateVersions, tx.Commit()
 }
 
+func (s *Service) listDocumentCacheValidatorsInTx(ctx context.Context, webhookID, resourceID string) (DocumentCacheValidators, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	validators, err := s.documentCacheValidatorSvc.ListByWebhookIDAndResourceID(ctx, webhookID, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheValidators := make(DocumentCacheValidators, len(validators))
+	for _, validator := range validators {
+		cacheValidators[validator.DocumentURL] = validator
+	}
+
+	return cacheValidators, tx.Commit()
+}
+
+func (s *Service) upsertDocumentCacheValidatorsInTx(ctx context.Context, cacheValidators DocumentCacheValidators) error {
+	if len(cacheValidators) == 0 {
+		return nil
+	}
+
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	for _, validator := range cacheValidators {
+		if err = s.documentCacheValidatorSvc.Upsert(ctx, validator); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *Service) failureMetricsPusher() metrics.AggregationFailurePusher {
+	return metrics.NewAggregationFailurePusher(metrics.PusherConfig{
+		Enabled:    len(s.metricsCfg.PushEndpoint) > 0,
+		Endpoint:   s.metricsCfg.PushEndpoint,
+		MetricName: strings.ReplaceAll(strings.ToLower(s.metricsCfg.JobName), "-", "_") + "_job_sync_failure_number",
+		Timeout:    s.metricsCfg.ClientTimeout,
+		Subsystem:  metrics.OrdAggregatorSubsystem,
+		Labels:     []string{metrics.ErrorMetricLabel, metrics.ResourceIDMetricLabel, metrics.ResourceTypeMetricLabel, metrics.CorrelationIDMetricLabel},
+	})
+}
+
+func (s *Service) unchangedMetricsPusher() metrics.AggregationUnchangedPusher {
+	return metrics.NewAggregationUnchangedPusher(metrics.PusherConfig{
+		Enabled:    len(s.metricsCfg.PushEndpoint) > 0,
+		Endpoint:   s.metricsCfg.PushEndpoint,
+		MetricName: strings.ReplaceAll(strings.ToLower(s.metricsCfg.JobName), "-", "_") + "_job_skipped_unchanged_number",
+		Timeout:    s.metricsCfg.ClientTimeout,
+		Subsystem:  metrics.OrdAggregatorSubsystem,
+		Labels:     []string{metrics.UnchangedKindMetricLabel, metrics.ResourceIDMetricLabel, metrics.ResourceTypeMetricLabel},
+	})
+}
+
 func (s *Service) getApplicationTemplateVersionByAppTemplateIDAndVersionInTx(ctx context.Context, applicationTemplateID, version string) (*model.ApplicationTemplateVersion, error) {
 	tx, err := s.transact.Begin()
 	if err != nil {
@@ -939,21 +1090,14 @@ func (s *Service) resyncAppTemplateVersion(ctx context.Context, appTemplateID st
 
 func (s *Service) processWebhookAndDocuments(ctx context.Context, webhook *model.Webhook, resource Resource, globalResourcesOrdIDs map[string]bool, ordWebhookMapping application.ORDWebhookMapping) error {
 	var (
-		documents      Documents
-		docsString     []string
-		webhookBaseURL string
-		err            error
+		documents              Documents
+		docsString             []string
+		webhookBaseURL         string
+		cacheValidators        DocumentCacheValidators
+		fetchedCacheValidators DocumentCacheValidators
+		err                    error
 	)
 
-	metricsCfg := metrics.PusherConfig{
-		Enabled:    len(s.metricsCfg.PushEndpoint) > 0,
-		Endpoint:   s.metricsCfg.PushEndpoint,
-		MetricName: strings.ReplaceAll(strings.ToLower(s.metricsCfg.JobName), "-", "_") + "_job_sync_failure_number",
-		Timeout:    s.metricsCfg.ClientTimeout,
-		Subsystem:  metrics.OrdAggregatorSubsystem,
-		Labels:     []string{metrics.ErrorMetricLabel, metrics.ResourceIDMetricLabel, metrics.ResourceTypeMetricLabel, metrics.CorrelationIDMetricLabel},
-	}
-
 	ctx = addFieldToLogger(ctx, "resource_id", resource.ID)
 	ctx = addFieldToLogger(ctx, "resource_type", string(resource.Type))
 
@@ -1002,58 +1146,78 @@ func (s *Service) processWebhookAndDocuments(ctx context.Context, webhook *model
 	}
 
 	if (webhook.Type == model.WebhookTypeOpenResourceDiscovery || webhook.Type == model.WebhookTypeOpenResourceDiscoveryStatic) && webhook.URL != nil {
-		documents, docsString, webhookBaseURL, err = s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, resource, webhook, ordWebhookMapping, ordRequestObject)
+		if cacheValidators, err = s.listDocumentCacheValidatorsInTx(ctx, webhook.ID, resource.ID); err != nil {
+			return err
+		}
+
+		documents, docsString, webhookBaseURL, fetchedCacheValidators, err = s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, resource, webhook, ordWebhookMapping, ordRequestObject, cacheValidators)
 		if err != nil {
-			metricsPusher := metrics.NewAggregationFailurePusher(metricsCfg)
-			metricsPusher.ReportAggregationFailureORD(ctx, err.Error())
+			s.failureMetricsPusher().ReportAggregationFailureORD(ctx, err.Error())
 
 			return errors.Wrapf(err, "error fetching ORD document for webhook with id %q: %v", webhook.ID, err)
 		}
-	}
 
-	if len(documents) > 0 {
-		log.C(ctx).Infof("Processing ORD documents for resource %s with ID %s", resource.Type, resource.ID)
-
-		validationErrors, err := s.processDocuments(ctx, resource, webhookBaseURL, ordWebhookMapping.ProxyURL, ordRequestObject, documents, globalResourcesOrdIDs, docsString)
-		if len(validationErrors) > 0 {
-			// convert validationErrors array of pointers to array of objects in order to log them properly
-			var validationErrorsObjects []ValidationError
-			for _, errPtr := range validationErrors {
-				if errPtr != nil {
-					validationErrorsObjects = append(validationErrorsObjects, *errPtr)
-				}
-			}
+		if len(documents) == 0 && len(fetchedCacheValidators) > 0 {
+			log.C(ctx).Infof("ORD documents for resource %s with ID %s have not been modified since the last fetch. Skipping processing...", resource.Type, resource.ID)
+			s.unchangedMetricsPusher().ReportUnchangedORD(ctx, metrics.UnchangedDocumentKind, len(fetchedCacheValidators))
 
-			log.C(ctx).WithError(errors.New(fmt.Sprintf("%s for resource with ID %s", ValidationErrorMsg, resource.ID))).WithField("validation_errors", validationErrorsObjects).Error(ValidationErrorMsg)
+			return nil
 		}
+	}
 
-		if err != nil {
-			metricsPusher := metrics.NewAggregationFailurePusher(metricsCfg)
-			metricsPusher.ReportAggregationFailureORD(ctx, err.Error())
+	if len(documents) > 0 {
+		return s.processFetchedDocuments(ctx, resource, webhookBaseURL, ordWebhookMapping.ProxyURL, ordRequestObject, documents, globalResourcesOrdIDs, docsString, fetchedCacheValidators, s.fetchReqSvc.FetchSpec)
+	}
 
-			log.C(ctx).WithError(err).Errorf("%s: %v", ProcessingErrorMsg, err)
-		}
+	return nil
+}
 
-		if err != nil {
-			return &ProcessingError{
-				ValidationErrors: nil,
-				RuntimeError:     &RuntimeError{Message: err.Error()},
+// processFetchedDocuments validates, sanitizes and stores the ORD documents of a resource, no matter whether they were fetched from the resource or pushed by it.
+// The cache validators of the documents are stored only if the documents are processed without validation errors.
+func (s *Service) processFetchedDocuments(ctx context.Context, resource Resource, webhookBaseURL, webhookBaseProxyURL string, ordRequestObject requestobject.OpenResourceDiscoveryWebhookRequestObject, documents Documents, globalResourcesOrdIDs map[string]bool, docsString []string, cacheValidators DocumentCacheValidators, fetchSpec specFetcher) error {
+	log.C(ctx).Infof("Processing ORD documents for resource %s with ID %s", resource.Type, resource.ID)
+
+	validationErrors, err := s.processDocuments(ctx, resource, webhookBaseURL, webhookBaseProxyURL, ordRequestObject, documents, globalResourcesOrdIDs, docsString, fetchSpec)
+	if len(validationErrors) > 0 {
+		// convert validationErrors array of pointers to array of objects in order to log them properly
+		var validationErrorsObjects []ValidationError
+		for _, errPtr := range validationErrors {
+			if errPtr != nil {
+				validationErrorsObjects = append(validationErrorsObjects, *errPtr)
 			}
 		}
 
-		if len(validationErrors) == 0 {
-			log.C(ctx).Infof("Successfully processed ORD documents for resource with ID %s", resource.ID)
+		log.C(ctx).WithError(errors.New(fmt.Sprintf("%s for resource with ID %s", ValidationErrorMsg, resource.ID))).WithField("validation_errors", validationErrorsObjects).Error(ValidationErrorMsg)
+	}
 
-			return nil
-		}
+	if err != nil {
+		s.failureMetricsPusher().ReportAggregationFailureORD(ctx, err.Error())
+
+		log.C(ctx).WithError(err).Errorf("%s: %v", ProcessingErrorMsg, err)
+	}
 
+	if err != nil {
 		return &ProcessingError{
-			ValidationErrors: validationErrors,
-			RuntimeError:     nil,
+			ValidationErrors: nil,
+			RuntimeError:     &RuntimeError{Message: err.Error()},
 		}
 	}
 
-	return nil
+	if len(validationErrors) == 0 {
+		log.C(ctx).Infof("Successfully processed ORD documents for resource with ID %s", resource.ID)
+
+		// The cache validators are stored only after a complete processing, so that documents with validation errors are processed again on the next run
+		if err = s.upsertDocumentCacheValidatorsInTx(ctx, cacheValidators); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to store the ORD document cache validators for resource with ID %s. The documents will be fully processed on the next run", resource.ID)
+		}
+
+		return nil
+	}
+
+	return &ProcessingError{
+		ValidationErrors: validationErrors,
+		RuntimeError:     nil,
+	}
 }
 
 func (s *Service) getApplicationsForAppTemplate(ctx context.Context, appTemplateID string) ([]*model.Application, error) {
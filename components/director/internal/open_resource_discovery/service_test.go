@@ -438,13 +438,13 @@ func TestService_Processing(t *testing.T) {
 
 	successfulClientFetch := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{fixORDDocument()}, []string{}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{fixORDDocument()}, []string{}, baseURL, nil, nil)
 		return client
 	}
 
 	successfulClientFetchForStaticDoc := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testStaticWebhookForAppTemplate, emptyORDMapping, ordRequestObject).Return(ord.Documents{fixORDStaticDocument()}, []string{}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testStaticWebhookForAppTemplate, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{fixORDStaticDocument()}, []string{}, baseURL, nil, nil)
 		return client
 	}
 
@@ -475,7 +475,7 @@ func TestService_Processing(t *testing.T) {
 		}
 
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, fixWebhookForApplicationWithProxyURL(), ordMappingWithProxy, headerMatcher()).Return(ord.Documents{fixORDDocumentWithoutCredentialExchanges()}, []string{}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, fixWebhookForApplicationWithProxyURL(), ordMappingWithProxy, headerMatcher(), ord.DocumentCacheValidators{}).Return(ord.Documents{fixORDDocumentWithoutCredentialExchanges()}, []string{}, baseURL, nil, nil)
 		return client
 	}
 
@@ -559,6 +559,7 @@ func TestService_Processing(t *testing.T) {
 		tombstonedResourcesDeleterFn     func() *automock.TombstonedResourcesDeleter
 		labelSvcFn                       func() *automock.LabelService
 		clientFn                         func() *automock.Client
+		documentCacheValidatorSvcFn      func() *automock.DocumentCacheValidatorService
 		processFnName                    string
 		webhookMappings                  []application.ORDWebhookMapping
 		documentValidatorFn              func() *automock.Validator
@@ -567,7 +568,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Success for Application Template webhook with Static ORD data when resources are already in db and APIs/Events last update fields are newer should Update them and resync API/Event specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(10)
+				return txGen.ThatSucceedsMultipleTimes(11)
 			},
 			webhookSvcFn:   successfulStaticWebhookListAppTemplate,
 			bundleSvcFn:    successfulBundleUpdateForStaticDoc,
@@ -619,7 +620,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Success when resources are already in db and APIs/Events last update fields are newer should Update them and resync API/Event specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:                         successfulAppGet,
 			tenantSvcFn:                      successfulTenantSvc,
@@ -650,7 +651,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Success when resources are already in db and APIs/Events last update fields are NOT newer should Update them and refetch only failed API/Event specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:       successfulAppGet,
 			tenantSvcFn:    successfulTenantSvc,
@@ -700,7 +701,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Success when resources are not in db should Create them",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:                         successfulAppGet,
 			tenantSvcFn:                      successfulTenantSvc,
@@ -727,10 +728,93 @@ func TestService_Processing(t *testing.T) {
 			labelSvcFn:                       successfulLabelGetByKey,
 			documentValidatorFn:              successfulDocumentValidatorForApplicationFn,
 		},
+		{
+			Name: "Success stores the cache validators of the fetched ORD documents after successful processing",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(13)
+			},
+			appSvcFn:                         successfulAppGet,
+			tenantSvcFn:                      successfulTenantSvc,
+			webhookSvcFn:                     successfulTenantMappingOnlyCreation,
+			webhookConvFn:                    successfulWebhookConversion,
+			bundleSvcFn:                      successfulBundleCreate,
+			apiProcessorFn:                   successfulAPIProcess,
+			eventProcessorFn:                 successfulEventProcess,
+			entityTypeProcessorFn:            successfulEntityTypeProcess,
+			capabilityProcessorFn:            successfulCapabilityProcess,
+			integrationDependencyProcessorFn: successfulIntegrationDependencyProcessing,
+			dataProductProcessorFn:           successfulDataProductProcessing,
+			specSvcFn:                        successfulSpecCreateAndUpdate,
+			fetchReqFn:                       successfulFetchRequestFetchAndUpdate,
+			packageProcessorFn:               successfulPackageProcess,
+			productProcessorFn:               successfulProductProcess,
+			vendorProcessorFn:                successfulVendorProcess,
+			tombstoneProcessorFn:             successfulTombstoneProcessing,
+			tombstonedResourcesDeleterFn:     successfulTombstoneResDeleter,
+			appTemplateVersionSvcFn:          successfulAppTemplateVersionList,
+			globalRegistrySvcFn:              successfulGlobalRegistrySvc,
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{fixORDDocument()}, []string{}, baseURL, ord.DocumentCacheValidators{baseURL + ordDocURI: fixDocumentCacheValidator(baseURL + ordDocURI)}, nil)
+				return client
+			},
+			documentCacheValidatorSvcFn: func() *automock.DocumentCacheValidatorService {
+				documentCacheValidatorSvc := &automock.DocumentCacheValidatorService{}
+				documentCacheValidatorSvc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), whID, appID).Return(nil, nil).Once()
+				documentCacheValidatorSvc.On("Upsert", txtest.CtxWithDBMatcher(), fixDocumentCacheValidator(baseURL+ordDocURI)).Return(nil).Once()
+				return documentCacheValidatorSvc
+			},
+			processFnName:       processApplicationFnName,
+			labelSvcFn:          successfulLabelGetByKey,
+			documentValidatorFn: successfulDocumentValidatorForApplicationFn,
+		},
+		{
+			Name: "Success keeps the stored specifications when they have not been modified since the last fetch",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(12)
+			},
+			appSvcFn:                         successfulAppGet,
+			tenantSvcFn:                      successfulTenantSvc,
+			webhookSvcFn:                     successfulTenantMappingOnlyCreation,
+			webhookConvFn:                    successfulWebhookConversion,
+			bundleSvcFn:                      successfulBundleCreate,
+			apiProcessorFn:                   successfulAPIProcess,
+			eventProcessorFn:                 successfulEventProcess,
+			entityTypeProcessorFn:            successfulEntityTypeProcess,
+			capabilityProcessorFn:            successfulCapabilityProcess,
+			integrationDependencyProcessorFn: successfulIntegrationDependencyProcessing,
+			dataProductProcessorFn:           successfulDataProductProcessing,
+			specSvcFn: func() *automock.SpecService {
+				return &automock.SpecService{}
+			},
+			fetchReqFn: func() *automock.FetchRequestService {
+				fetchReqSvc := &automock.FetchRequestService{}
+				fetchReqSvc.On("FetchSpec", txtest.CtxWithDBMatcher(), mock.Anything, &sync.Map{}).Return(nil, &model.FetchRequestStatus{Condition: model.FetchRequestStatusConditionSucceeded}).
+					Times(len(fixAPI1SpecInputs(baseURL)) + len(fixEvent1SpecInputs()) + len(fixEvent2SpecInputs(baseURL)) + 2*len(fixCapabilitySpecInputs()))
+
+				fetchReqSvc.On("Update", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(actual *model.FetchRequest) bool {
+					return actual.Status.Condition == model.FetchRequestStatusConditionSucceeded
+				})).Return(nil).
+					Times(len(fixAPI1SpecInputs(baseURL)) + len(fixEvent1SpecInputs()) + len(fixEvent2SpecInputs(baseURL)) + 2*len(fixCapabilitySpecInputs()))
+
+				return fetchReqSvc
+			},
+			packageProcessorFn:           successfulPackageProcess,
+			productProcessorFn:           successfulProductProcess,
+			vendorProcessorFn:            successfulVendorProcess,
+			tombstoneProcessorFn:         successfulTombstoneProcessing,
+			tombstonedResourcesDeleterFn: successfulTombstoneResDeleter,
+			appTemplateVersionSvcFn:      successfulAppTemplateVersionList,
+			globalRegistrySvcFn:          successfulGlobalRegistrySvc,
+			clientFn:                     successfulClientFetch,
+			processFnName:                processApplicationFnName,
+			labelSvcFn:                   successfulLabelGetByKey,
+			documentValidatorFn:          successfulDocumentValidatorForApplicationFn,
+		},
 		{
 			Name: "Success when webhook has a proxy URL which should be used to access the document",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(10)
+				return txGen.ThatSucceedsMultipleTimes(11)
 			},
 			appSvcFn:     successfulAppWithBaseURLSvc,
 			tenantSvcFn:  successfulTenantSvc,
@@ -784,7 +868,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Success when resources are not in db should Create them for a Static document",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(10)
+				return txGen.ThatSucceedsMultipleTimes(11)
 			},
 			webhookSvcFn: successfulStaticWebhookListAppTemplate,
 			bundleSvcFn:  successfulBundleCreateForStaticDoc,
@@ -842,7 +926,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Error when creating Application Template Version based on the doc",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(4, 3)
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(5, 4)
 			},
 			webhookSvcFn: successfulStaticWebhookListAppTemplate,
 			appTemplateVersionSvcFn: func() *automock.ApplicationTemplateVersionService {
@@ -875,7 +959,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Error when listing Application Template Version by app template ID",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(4, 3)
 			},
 			webhookSvcFn: successfulStaticWebhookListAppTemplate,
 			appTemplateVersionSvcFn: func() *automock.ApplicationTemplateVersionService {
@@ -892,7 +976,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Error when fetching the Application Template for the given dynamic doc",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(5)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(6)
 
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
@@ -917,7 +1001,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Error when fetching the Application Template for the given dynamic doc for a second time",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(5)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(6)
 
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
@@ -942,7 +1026,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Success when there is ORD webhook on app template",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn: successfulAppSvc,
 			tenantSvcFn: func() *automock.TenantService {
@@ -979,7 +1063,7 @@ func TestService_Processing(t *testing.T) {
 					Name:     testApplication.Name,
 					ParentID: &appTemplateID,
 				}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResources, testWebhookForAppTemplate, emptyORDMapping, ordRequestObject).Return(ord.Documents{fixORDDocument()}, []string{}, baseURL, nil).Once()
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResources, testWebhookForAppTemplate, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{fixORDDocument()}, []string{}, baseURL, nil, nil).Once()
 				return client
 			},
 			processFnName:       processAppInAppTemplateContextFnName,
@@ -989,7 +1073,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Error when synchronizing global resources from global registry should get them from DB and proceed with the rest of the sync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:                         successfulAppGet,
 			tenantSvcFn:                      successfulTenantSvc,
@@ -1024,7 +1108,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Error when synchronizing global resources from global registry and get them from DB should proceed with the rest of the sync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:              successfulAppGet,
 			tenantSvcFn:           successfulTenantSvc,
@@ -1282,15 +1366,38 @@ func TestService_Processing(t *testing.T) {
 			processFnName:           processAppInAppTemplateContextFnName,
 			ExpectedErr:             testErr,
 		},
+		{
+			Name: "Skips processing of ORD documents which have not been modified since the last fetch",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(5)
+			},
+			appSvcFn:     successfulAppGet,
+			tenantSvcFn:  successfulTenantSvc,
+			webhookSvcFn: successfulWebhookList,
+			clientFn: func() *automock.Client {
+				cacheValidators := ord.DocumentCacheValidators{baseURL + ordDocURI: fixDocumentCacheValidator(baseURL + ordDocURI)}
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, cacheValidators).Return(ord.Documents{}, []string{}, baseURL, cacheValidators, nil)
+				return client
+			},
+			documentCacheValidatorSvcFn: func() *automock.DocumentCacheValidatorService {
+				documentCacheValidatorSvc := &automock.DocumentCacheValidatorService{}
+				documentCacheValidatorSvc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), whID, appID).Return([]*model.ORDDocumentCacheValidator{fixDocumentCacheValidator(baseURL + ordDocURI)}, nil).Once()
+				return documentCacheValidatorSvc
+			},
+			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			labelSvcFn:          successfulLabelGetByKey,
+			processFnName:       processApplicationFnName,
+		},
 		{
 			Name: "Skips webhook when ORD documents fetch fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(4)
+				persistTx.On("Commit").Return(nil).Times(5)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(4)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(3)
+				transact.On("Begin").Return(persistTx, nil).Times(5)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(4)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1299,7 +1406,7 @@ func TestService_Processing(t *testing.T) {
 			webhookSvcFn: successfulWebhookList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(nil, []string{}, "", testErr)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(nil, []string{}, "", nil, testErr)
 				return client
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
@@ -1310,7 +1417,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Update application local tenant id when ord local id is unique and application does not have local tenant id",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
@@ -1341,7 +1448,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.DescribedSystemInstance.LocalTenantID = str.Ptr("ordLocalTenantID")
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1359,11 +1466,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Fails to update application local tenant id when ord local id is unique and application does not have local tenant id",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(7)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(6)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
+				transact.On("Begin").Return(persistTx, nil).Times(7)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1382,7 +1489,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.DescribedSystemInstance.LocalTenantID = str.Ptr("ordLocalTenantID")
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1400,7 +1507,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Resync resources for invalid ORD documents when event resource name is empty",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:       successfulAppGet,
 			tenantSvcFn:    successfulTenantSvc,
@@ -1448,7 +1555,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.EventResources[0].Name = "" // invalid document
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1469,7 +1576,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Resync resources for invalid ORD documents when bundle name is empty",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(9)
+				return txGen.ThatSucceedsMultipleTimes(10)
 			},
 			appSvcFn:     successfulAppGet,
 			tenantSvcFn:  successfulTenantSvc,
@@ -1529,7 +1636,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].Name = "" // invalid document
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1550,7 +1657,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Resync resources for invalid ORD documents when vendor ordID is empty",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:              successfulAppGet,
 			tenantSvcFn:           successfulTenantSvc,
@@ -1591,7 +1698,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors[0].OrdID = "" // invalid document
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1612,7 +1719,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Resync resources for invalid ORD documents when product title is empty",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:              successfulAppGet,
 			tenantSvcFn:           successfulTenantSvc,
@@ -1653,7 +1760,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Products[0].Title = "" // invalid document
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1674,7 +1781,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Resync resources for invalid ORD documents when package title is empty",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(6)
+				return txGen.ThatSucceedsMultipleTimes(7)
 			},
 			appSvcFn:            successfulAppGet,
 			tenantSvcFn:         successfulTenantSvc,
@@ -1686,7 +1793,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Packages[0].Title = "" // invalid document
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1708,11 +1815,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Does not resync resources if vendors processing fail",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(7)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(6)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
+				transact.On("Begin").Return(persistTx, nil).Times(7)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1736,11 +1843,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Does not resync resources if products processing fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(7)
+				persistTx.On("Commit").Return(nil).Times(8)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(6)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
+				transact.On("Begin").Return(persistTx, nil).Times(7)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1765,11 +1872,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Does not resync resources if package processing fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(7)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(6)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
+				transact.On("Begin").Return(persistTx, nil).Times(7)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1795,11 +1902,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Fails to list bundles after resync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(9)
+				persistTx.On("Commit").Return(nil).Times(10)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(10)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(9)
+				transact.On("Begin").Return(persistTx, nil).Times(11)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(10)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1829,11 +1936,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Does not resync resources if bundle update fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(7)
+				persistTx.On("Commit").Return(nil).Times(8)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(8)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(7)
+				transact.On("Begin").Return(persistTx, nil).Times(9)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(8)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1860,7 +1967,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Does not resync resources if bundle create fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(7)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(8)
 
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
@@ -1890,11 +1997,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Does not resync resources if bundle have different tenant mapping configuration",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(8)
+				persistTx.On("Commit").Return(nil).Times(9)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(8)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(7)
+				transact.On("Begin").Return(persistTx, nil).Times(9)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(8)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1909,7 +2016,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithMultipleSameTypesFormat, credentialExchangeStrategyType, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
@@ -1930,11 +2037,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Does not resync resources if webhooks could not be enriched",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(8)
+				persistTx.On("Commit").Return(nil).Times(9)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(8)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(7)
+				transact.On("Begin").Return(persistTx, nil).Times(9)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(8)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1955,7 +2062,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
@@ -1975,11 +2082,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Does not resync resources if webhooks cannot be listed for application",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(8)
+				persistTx.On("Commit").Return(nil).Times(9)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(9)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(8)
+				transact.On("Begin").Return(persistTx, nil).Times(10)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(9)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2002,7 +2109,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
@@ -2021,7 +2128,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Does not resync resources if webhooks cannot be converted from graphql input to model input",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(8)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(9)
 
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
@@ -2051,7 +2158,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -2070,7 +2177,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Does not resync resources if webhooks cannot be created",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(8)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(9)
 
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
@@ -2097,7 +2204,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -2116,7 +2223,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Does recreate of tenant mapping webhooks when there are differences",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:    successfulAppGet,
 			tenantSvcFn: successfulTenantSvc,
@@ -2142,7 +2249,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			apiProcessorFn:               successfulAPIProcess,
@@ -2183,11 +2290,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Does not recreate of tenant mapping webhooks when there are differences but deletion fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(12)
+				persistTx.On("Commit").Return(nil).Times(13)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(9)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(9)
+				transact.On("Begin").Return(persistTx, nil).Times(10)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(10)
 				return persistTx, transact
 			},
 			appSvcFn:    successfulAppGet,
@@ -2219,7 +2326,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, baseURL, nil, nil)
 				return client
 			},
 			capabilityProcessorFn: successfulCapabilityProcess,
@@ -2242,7 +2349,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Does not resync resources if api process fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(10)
+				return txGen.ThatSucceedsMultipleTimes(11)
 			},
 			appSvcFn:           successfulAppGet,
 			tenantSvcFn:        successfulTenantSvc,
@@ -2269,7 +2376,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Resync resources returns error if api spec refetch fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:           successfulAppGet,
 			tenantSvcFn:        successfulTenantSvc,
@@ -2339,7 +2446,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Does not resync resources if event process fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(10)
+				return txGen.ThatSucceedsMultipleTimes(11)
 			},
 			appSvcFn:           successfulAppGet,
 			tenantSvcFn:        successfulTenantSvc,
@@ -2368,7 +2475,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Resync resources returns error if event spec refetch fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:           successfulAppGet,
 			tenantSvcFn:        successfulTenantSvc,
@@ -2435,11 +2542,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Does not resync resources if entity type processing fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(10)
+				persistTx.On("Commit").Return(nil).Times(11)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(10)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(10)
+				transact.On("Begin").Return(persistTx, nil).Times(11)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(11)
 				return persistTx, transact
 			},
 			appSvcFn:           successfulAppGet,
@@ -2469,7 +2576,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Does not resync resources if integration dependency processing fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(10)
+				return txGen.ThatSucceedsMultipleTimes(11)
 			},
 			appSvcFn:              successfulAppGet,
 			tenantSvcFn:           successfulTenantSvc,
@@ -2501,11 +2608,11 @@ func TestService_Processing(t *testing.T) {
 			Name: "Does not resync resources if capability process fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(10)
+				persistTx.On("Commit").Return(nil).Times(11)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(10)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(9)
+				transact.On("Begin").Return(persistTx, nil).Times(11)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(10)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2537,7 +2644,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Does not resync resources if data product processing fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(10)
+				return txGen.ThatSucceedsMultipleTimes(11)
 			},
 			appSvcFn:                         successfulAppGet,
 			tenantSvcFn:                      successfulTenantSvc,
@@ -2569,7 +2676,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Does not resync resources if tombstone processing fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(10)
+				return txGen.ThatSucceedsMultipleTimes(11)
 			},
 			appSvcFn:                         successfulAppGet,
 			tenantSvcFn:                      successfulTenantSvc,
@@ -2602,7 +2709,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Does not resync resources if resource deletion due to tombstone fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(10)
+				return txGen.ThatSucceedsMultipleTimes(11)
 			},
 			appSvcFn:                         successfulAppGet,
 			tenantSvcFn:                      successfulTenantSvc,
@@ -2635,7 +2742,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Returns error when failing to open final transaction to commit fetched specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(10)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(11)
 				transact.On("Begin").Return(persistTx, testErr).Once()
 				return persistTx, transact
 			},
@@ -2666,7 +2773,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Returns error when failing to find spec in final transaction when trying to update and persist fetched specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(10)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(11)
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
@@ -2703,7 +2810,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Returns error when failing to update spec in final transaction when trying to update and persist fetched specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(10)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(11)
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
@@ -2746,7 +2853,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Returns error when failing to update fetch request in final transaction when trying to update and persist fetched specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(10)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(11)
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
@@ -2798,7 +2905,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Success when resources are not in db and no SAP Vendor is declared in Documents should Create them as SAP Vendor is coming from the Global Registry",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:      successfulAppGet,
 			tenantSvcFn:   successfulTenantSvc,
@@ -2836,7 +2943,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors = nil
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -2853,7 +2960,7 @@ func TestService_Processing(t *testing.T) {
 		{
 			Name: "Success when resources are already in db and no SAP Vendor is declared in Documents should Update them as SAP Vendor is coming from the Global Registry",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(11)
+				return txGen.ThatSucceedsMultipleTimes(12)
 			},
 			appSvcFn:      successfulAppGet,
 			tenantSvcFn:   successfulTenantSvc,
@@ -2892,7 +2999,7 @@ func TestService_Processing(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors = nil
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{doc}, []string{}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -3019,10 +3126,15 @@ func TestService_Processing(t *testing.T) {
 
 			documentSanitizer := ord.NewDocumentSanitizer()
 
+			documentCacheValidatorSvc := successfulDocumentCacheValidatorsList()
+			if test.documentCacheValidatorSvcFn != nil {
+				documentCacheValidatorSvc = test.documentCacheValidatorSvcFn()
+			}
+
 			metrixCfg := ord.MetricsConfig{}
 
 			ordCfg := ord.NewServiceConfig(100, credentialExchangeStrategyTenantMappings)
-			svc := ord.NewAggregatorService(ordCfg, metrixCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiProcessor, eventProcessor, entityTypeProcessor, capabilityProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, fetchReqSvc, packageProcessor, productProcessor, vendorProcessor, tombstoneProcessor, tenantSvc, globalRegistrySvcFn, client, whConverter, appTemplateVersionSvc, appTemplateSvc, tombstonedResourcesDeleter, labelSvc, ordWebhookMappings, nil, documentValidator, documentSanitizer)
+			svc := ord.NewAggregatorService(ordCfg, metrixCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiProcessor, eventProcessor, entityTypeProcessor, capabilityProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, fetchReqSvc, packageProcessor, productProcessor, vendorProcessor, tombstoneProcessor, tenantSvc, globalRegistrySvcFn, client, documentCacheValidatorSvc, whConverter, appTemplateVersionSvc, appTemplateSvc, tombstonedResourcesDeleter, labelSvc, ordWebhookMappings, nil, documentValidator, documentSanitizer)
 
 			var err error
 			switch test.processFnName {
@@ -3041,6 +3153,9 @@ func TestService_Processing(t *testing.T) {
 			}
 
 			mock.AssertExpectationsForObjects(t, tx, appSvc, whSvc, bndlSvc, entityTypeProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, tombstoneProcessor, tenantSvc, globalRegistrySvcFn, client, labelSvc, tombstonedResourcesDeleter)
+			if test.documentCacheValidatorSvcFn != nil {
+				mock.AssertExpectationsForObjects(t, documentCacheValidatorSvc)
+			}
 		})
 	}
 }
@@ -3064,7 +3179,7 @@ func TestService_ProcessApplication(t *testing.T) {
 
 	successfulClientFetch := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject).Return(ord.Documents{}, []string{}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{}, []string{}, baseURL, nil, nil)
 		return client
 	}
 
@@ -3084,7 +3199,7 @@ func TestService_ProcessApplication(t *testing.T) {
 		{
 			Name: "Success",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(4)
+				return txGen.ThatSucceedsMultipleTimes(5)
 			},
 			appSvcFn: successfulAppGet,
 			tenantSvcFn: func() *automock.TenantService {
@@ -3245,11 +3360,12 @@ func TestService_ProcessApplication(t *testing.T) {
 
 			documentValidator := &automock.Validator{}
 			documentSanitizer := ord.NewDocumentSanitizer()
+			documentCacheValidatorSvc := successfulDocumentCacheValidatorsList()
 
 			metrixCfg := ord.MetricsConfig{}
 
 			ordCfg := ord.NewServiceConfig(100, credentialExchangeStrategyTenantMappings)
-			svc := ord.NewAggregatorService(ordCfg, metrixCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiProcessor, eventProcessor, nil, capabilityProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, fetchReqSvc, packageProcessor, productProcessor, vendorProcessor, tombstoneProcessor, tenantSvc, globalRegistrySvcFn, client, whConverter, appTemplateVersionSvc, appTemplateSvc, tombstonedResourcesDeleter, labelSvc, []application.ORDWebhookMapping{}, nil, documentValidator, documentSanitizer)
+			svc := ord.NewAggregatorService(ordCfg, metrixCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiProcessor, eventProcessor, nil, capabilityProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, fetchReqSvc, packageProcessor, productProcessor, vendorProcessor, tombstoneProcessor, tenantSvc, globalRegistrySvcFn, client, documentCacheValidatorSvc, whConverter, appTemplateVersionSvc, appTemplateSvc, tombstonedResourcesDeleter, labelSvc, []application.ORDWebhookMapping{}, nil, documentValidator, documentSanitizer)
 			err := svc.ProcessApplication(context.TODO(), test.appID)
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -3279,7 +3395,7 @@ func TestService_ProcessApplicationTemplate(t *testing.T) {
 
 	successfulClientFetchForAppTemplate := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceAppTemplate, testWebhookForAppTemplate, emptyORDMapping, ordRequestObject).Return(ord.Documents{}, []string{}, baseURL, nil).Once()
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceAppTemplate, testWebhookForAppTemplate, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{}, []string{}, baseURL, nil, nil).Once()
 		return client
 	}
 
@@ -3301,7 +3417,7 @@ func TestService_ProcessApplicationTemplate(t *testing.T) {
 		{
 			Name: "Success",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
+				return txGen.ThatSucceedsMultipleTimes(3)
 			},
 			appSvcFn: func() *automock.ApplicationService {
 				return &automock.ApplicationService{}
@@ -3407,9 +3523,10 @@ func TestService_ProcessApplicationTemplate(t *testing.T) {
 
 			documentValidator := &automock.Validator{}
 			documentSanitizer := ord.NewDocumentSanitizer()
+			documentCacheValidatorSvc := successfulDocumentCacheValidatorsList()
 
 			ordCfg := ord.NewServiceConfig(100, credentialExchangeStrategyTenantMappings)
-			svc := ord.NewAggregatorService(ordCfg, metricsCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiProcessor, eventProcessor, nil, capabilityProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, fetchReqSvc, packageProcessor, productProcessor, vendorProcessor, tombstoneProcessor, tenantSvc, globalRegistrySvcFn, client, whConverter, appTemplateVersionSvc, appTemplateSvc, tombstonedResourcesDeleter, labelSvc, []application.ORDWebhookMapping{}, nil, documentValidator, documentSanitizer)
+			svc := ord.NewAggregatorService(ordCfg, metricsCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiProcessor, eventProcessor, nil, capabilityProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, fetchReqSvc, packageProcessor, productProcessor, vendorProcessor, tombstoneProcessor, tenantSvc, globalRegistrySvcFn, client, documentCacheValidatorSvc, whConverter, appTemplateVersionSvc, appTemplateSvc, tombstonedResourcesDeleter, labelSvc, []application.ORDWebhookMapping{}, nil, documentValidator, documentSanitizer)
 			err := svc.ProcessApplicationTemplate(context.TODO(), test.appTemplateID)
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -3442,7 +3559,7 @@ func TestService_ProcessAppInAppTemplateContext(t *testing.T) {
 
 	successfulClientFetchForAppTemplate := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceApp, testWebhookForAppTemplate, emptyORDMapping, ordRequestObject).Return(ord.Documents{}, []string{}, baseURL, nil).Once()
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceApp, testWebhookForAppTemplate, emptyORDMapping, ordRequestObject, ord.DocumentCacheValidators{}).Return(ord.Documents{}, []string{}, baseURL, nil, nil).Once()
 		return client
 	}
 
@@ -3465,7 +3582,7 @@ func TestService_ProcessAppInAppTemplateContext(t *testing.T) {
 		{
 			Name: "Success",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(4)
+				return txGen.ThatSucceedsMultipleTimes(5)
 			},
 			appSvcFn: successfulAppSvc,
 			tenantSvcFn: func() *automock.TenantService {
@@ -3688,9 +3805,10 @@ func TestService_ProcessAppInAppTemplateContext(t *testing.T) {
 
 			documentValidator := &automock.Validator{}
 			documentSanitizer := ord.NewDocumentSanitizer()
+			documentCacheValidatorSvc := successfulDocumentCacheValidatorsList()
 
 			ordCfg := ord.NewServiceConfig(100, credentialExchangeStrategyTenantMappings)
-			svc := ord.NewAggregatorService(ordCfg, metrixCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiProcessor, eventProcessor, nil, capabilityProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, fetchReqSvc, packageProcessor, productProcessor, vendorProcessor, tombstoneProcessor, tenantSvc, globalRegistrySvcFn, client, whConverter, appTemplateVersionSvc, appTemplateSvc, tombstonedResourcesDeleter, labelSvc, []application.ORDWebhookMapping{}, nil, documentValidator, documentSanitizer)
+			svc := ord.NewAggregatorService(ordCfg, metrixCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiProcessor, eventProcessor, nil, capabilityProcessor, integrationDependencyProcessor, dataProductProcessor, specSvc, fetchReqSvc, packageProcessor, productProcessor, vendorProcessor, tombstoneProcessor, tenantSvc, globalRegistrySvcFn, client, documentCacheValidatorSvc, whConverter, appTemplateVersionSvc, appTemplateSvc, tombstonedResourcesDeleter, labelSvc, []application.ORDWebhookMapping{}, nil, documentValidator, documentSanitizer)
 			err := svc.ProcessAppInAppTemplateContext(context.TODO(), test.appTemplateID, test.appID)
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -3897,3 +4015,126 @@ func fixCapabilityFetchRequests() []*processor.OrdFetchRequest {
 		},
 	}
 }
+
+func successfulDocumentCacheValidatorsList() *automock.DocumentCacheValidatorService {
+	documentCacheValidatorSvc := &automock.DocumentCacheValidatorService{}
+	documentCacheValidatorSvc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), whID, mock.Anything).Return(nil, nil)
+	return documentCacheValidatorSvc
+}
+
+func TestService_ProcessPushedPayload(t *testing.T) {
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	relativeDocURL := "/open-resource-discovery/v1/documents/example1"
+	pushedConfig := json.RawMessage(fmt.Sprintf(`{"openResourceDiscoveryV1":{"documents":[{"url":"%s","accessStrategies":[{"type":"open"}],"perspective":"system-instance"}]}}`, relativeDocURL))
+	pushedFiles := map[string]string{relativeDocURL: `{"openResourceDiscovery":"1.9"}`}
+
+	appGetFn := func(app *model.Application) func() *automock.ApplicationService {
+		return func() *automock.ApplicationService {
+			appSvc := &automock.ApplicationService{}
+			appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+			return appSvc
+		}
+	}
+
+	testCases := []struct {
+		Name                    string
+		TransactionerFn         func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		appSvcFn                func() *automock.ApplicationService
+		globalRegistrySvcFn     func() *automock.GlobalRegistryService
+		appTemplateVersionSvcFn func() *automock.ApplicationTemplateVersionService
+		validatorFn             func() *automock.Validator
+		Payload                 *model.ORDPushedPayload
+		ExpectedErr             string
+	}{
+		{
+			Name: "Error while validating the pushed documents",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(4)
+			},
+			appSvcFn:            appGetFn(fixApplicationsWithBaseURL()[0]),
+			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			appTemplateVersionSvcFn: func() *automock.ApplicationTemplateVersionService {
+				svc := &automock.ApplicationTemplateVersionService{}
+				svc.On("ListByAppTemplateID", txtest.CtxWithDBMatcher(), appTemplateID).Return([]*model.ApplicationTemplateVersion{}, nil).Twice()
+				return svc
+			},
+			validatorFn: func() *automock.Validator {
+				validator := &automock.Validator{}
+				validator.On("Validate", mock.Anything, mock.Anything, baseURL, map[string]bool{sapVendor: true}, []string{pushedFiles[relativeDocURL]}, "").Return(nil, testErr).Once()
+				return validator
+			},
+			Payload:     &model.ORDPushedPayload{ApplicationID: appID, Configuration: pushedConfig, Files: pushedFiles},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:            "Error when a document listed in the configuration is not pushed",
+			TransactionerFn: txGen.ThatSucceedsTwice,
+			appSvcFn:        appGetFn(fixApplicationsWithBaseURL()[0]),
+			Payload:         &model.ORDPushedPayload{ApplicationID: appID, Configuration: pushedConfig, Files: map[string]string{}},
+			ExpectedErr:     "was not provided",
+		},
+		{
+			Name:            "Error when the configuration has relative document URLs but there is no base URL",
+			TransactionerFn: txGen.ThatSucceedsTwice,
+			appSvcFn:        appGetFn(fixApplications()[0]),
+			Payload:         &model.ORDPushedPayload{ApplicationID: appID, Configuration: pushedConfig, Files: pushedFiles},
+			ExpectedErr:     "while validating pushed ORD config",
+		},
+		{
+			Name:            "Error when the configuration is not valid JSON",
+			TransactionerFn: txGen.ThatSucceedsTwice,
+			appSvcFn:        appGetFn(fixApplicationsWithBaseURL()[0]),
+			Payload:         &model.ORDPushedPayload{ApplicationID: appID, Configuration: json.RawMessage(`{`), Files: pushedFiles},
+			ExpectedErr:     "error unmarshaling ORD configuration",
+		},
+		{
+			Name: "Error while retrieving application",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(1)
+			},
+			appSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
+				return appSvc
+			},
+			Payload:     &model.ORDPushedPayload{ApplicationID: appID, Configuration: pushedConfig, Files: pushedFiles},
+			ExpectedErr: testErr.Error(),
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.Name, func(t *testing.T) {
+			_, tx := test.TransactionerFn()
+			appSvc := test.appSvcFn()
+			tenantSvc := successfulTenantSvcOnce()
+
+			globalRegistrySvc := &automock.GlobalRegistryService{}
+			if test.globalRegistrySvcFn != nil {
+				globalRegistrySvc = test.globalRegistrySvcFn()
+			}
+
+			appTemplateVersionSvc := &automock.ApplicationTemplateVersionService{}
+			if test.appTemplateVersionSvcFn != nil {
+				appTemplateVersionSvc = test.appTemplateVersionSvcFn()
+			}
+
+			documentValidator := &automock.Validator{}
+			if test.validatorFn != nil {
+				documentValidator = test.validatorFn()
+			}
+
+			ordCfg := ord.NewServiceConfig(100, credentialExchangeStrategyTenantMappings)
+			svc := ord.NewAggregatorService(ordCfg, ord.MetricsConfig{}, tx, appSvc, &automock.WebhookService{}, &automock.BundleService{}, &automock.BundleReferenceService{}, &automock.APIProcessor{}, &automock.EventProcessor{}, nil, &automock.CapabilityProcessor{}, &automock.IntegrationDependencyProcessor{}, &automock.DataProductProcessor{}, &automock.SpecService{}, &automock.FetchRequestService{}, &automock.PackageProcessor{}, &automock.ProductProcessor{}, &automock.VendorProcessor{}, &automock.TombstoneProcessor{}, tenantSvc, globalRegistrySvc, &automock.Client{}, &automock.DocumentCacheValidatorService{}, &automock.WebhookConverter{}, appTemplateVersionSvc, &automock.ApplicationTemplateService{}, &automock.TombstonedResourcesDeleter{}, &automock.LabelService{}, []application.ORDWebhookMapping{}, nil, documentValidator, ord.NewDocumentSanitizer())
+			err := svc.ProcessPushedPayload(context.TODO(), test.Payload)
+			if test.ExpectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, tx, appSvc, tenantSvc, globalRegistrySvc, appTemplateVersionSvc, documentValidator)
+		})
+	}
+}
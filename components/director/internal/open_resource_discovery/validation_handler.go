@@ -0,0 +1,96 @@
+package ord
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/httputils"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+// ValidationBaseURLQueryParam is the name of the query parameter holding the base URL of the ORD documents uploaded for validation
+const ValidationBaseURLQueryParam = "baseUrl"
+
+// ValidationRequest represents the request for validating the ORD documents exposed by a system
+type ValidationRequest struct {
+	ConfigURL string `json:"configUrl"`
+}
+
+type validationHandler struct {
+	validator      OfflineValidator
+	maxPayloadSize int64
+}
+
+// NewORDValidationHTTPHandler returns a new HTTP handler, responsible for validating ORD documents without aggregating them
+func NewORDValidationHTTPHandler(validator OfflineValidator, maxPayloadSize int64) *validationHandler {
+	return &validationHandler{
+		validator:      validator,
+		maxPayloadSize: maxPayloadSize,
+	}
+}
+
+// ValidateORDDocuments validates ORD documents and responds with a validation report. The documents are either fetched from
+// the well-known configuration URL in a JSON body, or uploaded as a multipart form in the same format in which they are pushed.
+// Only publicly reachable configuration URLs are accepted.
+func (h *validationHandler) ValidateORDDocuments(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	mediaType, _, err := mime.ParseMediaType(request.Header.Get("Content-Type"))
+	if err != nil {
+		log.C(ctx).Errorf("Unsupported content type %q of ORD validation request", request.Header.Get("Content-Type"))
+		http.Error(writer, "The ORD validation request must be either application/json or multipart/form-data", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	request.Body = http.MaxBytesReader(writer, request.Body, h.maxPayloadSize)
+
+	var report *ValidationReport
+	switch mediaType {
+	case "application/json":
+		payload := ValidationRequest{}
+		if err = json.NewDecoder(request.Body).Decode(&payload); err != nil || payload.ConfigURL == "" {
+			log.C(ctx).Errorf("Invalid ORD validation request body")
+			http.Error(writer, "Invalid request body. The configUrl must be provided", http.StatusBadRequest)
+			return
+		}
+
+		if err = ValidatePublicDestination(payload.ConfigURL); err != nil {
+			log.C(ctx).WithError(err).Errorf("The ORD configuration URL %q is not allowed", payload.ConfigURL)
+			http.Error(writer, "The provided configUrl is not allowed", http.StatusBadRequest)
+			return
+		}
+
+		// The details of the failure are only logged, as they could expose the responses of the fetched destination
+		if report, err = h.validator.ValidateWellKnownConfig(ctx, payload.ConfigURL); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to validate the ORD documents from %q", payload.ConfigURL)
+			http.Error(writer, "Failed to fetch and validate the ORD documents from the provided configUrl", http.StatusBadRequest)
+			return
+		}
+	case "multipart/form-data":
+		configuration, files, readErr := readPushedFiles(request)
+		if readErr != nil {
+			log.C(ctx).WithError(readErr).Errorf("Failed to read the ORD documents for validation")
+			http.Error(writer, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+
+		if err = validatePushedFiles(configuration, files); err != nil {
+			log.C(ctx).WithError(err).Errorf("Invalid ORD documents for validation")
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if report, err = h.validator.ValidateFiles(ctx, configuration, files, request.URL.Query().Get(ValidationBaseURLQueryParam)); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to validate the uploaded ORD documents")
+			http.Error(writer, "Failed to validate the uploaded ORD documents", http.StatusBadRequest)
+			return
+		}
+	default:
+		log.C(ctx).Errorf("Unsupported content type %q of ORD validation request", mediaType)
+		http.Error(writer, "The ORD validation request must be either application/json or multipart/form-data", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	httputils.RespondWithBody(ctx, writer, http.StatusOK, report)
+}
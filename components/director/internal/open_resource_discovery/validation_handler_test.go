@@ -0,0 +1,200 @@
+package ord_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationHandler_ValidateORDDocuments(t *testing.T) {
+	testErr := errors.New("test error")
+
+	report := &ord.ValidationReport{
+		BaseURL:  baseURL,
+		Valid:    false,
+		Errors:   1,
+		Warnings: 0,
+		Resources: []*ord.ResourceValidationReport{
+			{
+				OrdID:  "ns:apiResource:API_ID:v1",
+				Errors: []*ord.ValidationError{{OrdID: "ns:apiResource:API_ID:v1", Severity: ord.ErrorSeverity, Type: "code", Description: "invalid title"}},
+			},
+		},
+	}
+
+	multipartBody, multipartContentType := fixValidationMultipartBody(t, map[string]string{
+		ord.PushConfigurationPartName: pushedConfiguration,
+		pushedDocumentURL:             pushedDocumentContent,
+	})
+	multipartBodyWithoutDocument, _ := fixValidationMultipartBody(t, map[string]string{
+		ord.PushConfigurationPartName: pushedConfiguration,
+	})
+
+	testCases := []struct {
+		Name                string
+		URL                 string
+		Body                []byte
+		ContentType         string
+		ValidatorFn         func() *automock.OfflineValidator
+		ExpectedStatusCode  int
+		ExpectedReport      *ord.ValidationReport
+		ExpectedErrorOutput string
+	}{
+		{
+			Name:        "Success for a well-known configuration URL",
+			URL:         "/validate",
+			Body:        []byte(`{"configUrl":"` + validationConfigURL + `"}`),
+			ContentType: "application/json",
+			ValidatorFn: func() *automock.OfflineValidator {
+				validator := &automock.OfflineValidator{}
+				validator.On("ValidateWellKnownConfig", mock.Anything, validationConfigURL).Return(report, nil).Once()
+				return validator
+			},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedReport:     report,
+		},
+		{
+			Name:        "Success for uploaded documents",
+			URL:         "/validate?baseUrl=" + baseURL,
+			Body:        multipartBody,
+			ContentType: multipartContentType,
+			ValidatorFn: func() *automock.OfflineValidator {
+				validator := &automock.OfflineValidator{}
+				validator.On("ValidateFiles", mock.Anything, json.RawMessage(pushedConfiguration), map[string]string{pushedDocumentURL: pushedDocumentContent}, baseURL).Return(report, nil).Once()
+				return validator
+			},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedReport:     report,
+		},
+		{
+			Name:                "Error when the configuration URL is missing",
+			URL:                 "/validate",
+			Body:                []byte(`{}`),
+			ContentType:         "application/json",
+			ValidatorFn:         func() *automock.OfflineValidator { return &automock.OfflineValidator{} },
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "The configUrl must be provided",
+		},
+		{
+			Name:                "Error when an uploaded document listed in the configuration is missing",
+			URL:                 "/validate",
+			Body:                multipartBodyWithoutDocument,
+			ContentType:         multipartContentType,
+			ValidatorFn:         func() *automock.OfflineValidator { return &automock.OfflineValidator{} },
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "was not provided",
+		},
+		{
+			Name:                "Error when the content type is not supported",
+			URL:                 "/validate",
+			Body:                []byte(validationConfigURL),
+			ContentType:         "text/plain",
+			ValidatorFn:         func() *automock.OfflineValidator { return &automock.OfflineValidator{} },
+			ExpectedStatusCode:  http.StatusUnsupportedMediaType,
+			ExpectedErrorOutput: "must be either application/json or multipart/form-data",
+		},
+		{
+			Name:        "Error when the validation fails",
+			URL:         "/validate",
+			Body:        []byte(`{"configUrl":"` + validationConfigURL + `"}`),
+			ContentType: "application/json",
+			ValidatorFn: func() *automock.OfflineValidator {
+				validator := &automock.OfflineValidator{}
+				validator.On("ValidateWellKnownConfig", mock.Anything, validationConfigURL).Return(nil, testErr).Once()
+				return validator
+			},
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "Failed to fetch and validate the ORD documents from the provided configUrl",
+		},
+		{
+			Name:                "Error when the configuration URL points to a cluster-internal service",
+			URL:                 "/validate",
+			Body:                []byte(`{"configUrl":"http://compass-director.compass-system.svc.cluster.local:3000/.well-known/open-resource-discovery"}`),
+			ContentType:         "application/json",
+			ValidatorFn:         func() *automock.OfflineValidator { return &automock.OfflineValidator{} },
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "The provided configUrl is not allowed",
+		},
+		{
+			Name:                "Error when the configuration URL points to a private address",
+			URL:                 "/validate",
+			Body:                []byte(`{"configUrl":"http://169.254.169.254/latest/meta-data"}`),
+			ContentType:         "application/json",
+			ValidatorFn:         func() *automock.OfflineValidator { return &automock.OfflineValidator{} },
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "The provided configUrl is not allowed",
+		},
+		{
+			Name:        "Error when the validation of uploaded documents fails",
+			URL:         "/validate?baseUrl=" + baseURL,
+			Body:        multipartBody,
+			ContentType: multipartContentType,
+			ValidatorFn: func() *automock.OfflineValidator {
+				validator := &automock.OfflineValidator{}
+				validator.On("ValidateFiles", mock.Anything, json.RawMessage(pushedConfiguration), map[string]string{pushedDocumentURL: pushedDocumentContent}, baseURL).Return(nil, testErr).Once()
+				return validator
+			},
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "Failed to validate the uploaded ORD documents",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			validator := testCase.ValidatorFn()
+			defer mock.AssertExpectationsForObjects(t, validator)
+
+			handler := ord.NewORDValidationHTTPHandler(validator, 1024*1024)
+
+			request := httptest.NewRequest(http.MethodPost, testCase.URL, bytes.NewReader(testCase.Body))
+			request.Header.Set("Content-Type", testCase.ContentType)
+			writer := httptest.NewRecorder()
+
+			// WHEN
+			handler.ValidateORDDocuments(writer, request)
+
+			// THEN
+			resp := writer.Result()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.ExpectedStatusCode, resp.StatusCode)
+			if len(testCase.ExpectedErrorOutput) > 0 {
+				assert.Contains(t, string(body), testCase.ExpectedErrorOutput)
+				assert.NotContains(t, string(body), testErr.Error())
+			}
+
+			if testCase.ExpectedReport != nil {
+				actualReport := &ord.ValidationReport{}
+				require.NoError(t, json.Unmarshal(body, actualReport))
+				assert.Equal(t, testCase.ExpectedReport, actualReport)
+			}
+		})
+	}
+}
+
+func fixValidationMultipartBody(t *testing.T, parts map[string]string) ([]byte, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(t, writer.SetBoundary("validation-boundary"))
+	for name, content := range parts {
+		part, err := writer.CreateFormField(name)
+		require.NoError(t, err)
+		_, err = part.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	return body.Bytes(), writer.FormDataContentType()
+}
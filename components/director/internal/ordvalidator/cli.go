@@ -0,0 +1,181 @@
+package ordvalidator
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const usage = `ordvalidator validates ORD documents with the same logic as the ORD aggregator, without a database.
+
+Usage:
+  ordvalidator --config-url <url> [flags]
+  ordvalidator --config <file> --document <url>=<file> [--document <url>=<file> ...] [flags]
+  ordvalidator --base-url <url> --document <file> [--document <file> ...] [flags]
+
+The validation report is printed as JSON to the standard output. The exit code is 0 when the documents
+have no errors, 1 when they have validation errors, 2 for invalid usage and 3 when the validation could not run.
+The logs are written to the standard error.
+
+Flags:
+`
+
+const (
+	exitCodeOK      = 0
+	exitCodeInvalid = 1
+	exitCodeUsage   = 2
+	exitCodeError   = 3
+
+	maxParallelDocuments = 4
+)
+
+// ValidatorFactory creates an ORD validator from the options
+type ValidatorFactory func(cfg Config) ord.OfflineValidator
+
+// NewValidatorFromConfig creates an ORD validator which fetches the documents with the open access strategy
+func NewValidatorFromConfig(cfg Config) ord.OfflineValidator {
+	httpClient := &http.Client{
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewHTTPTransportWrapper(&http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: cfg.SkipSSLValidation},
+		})),
+		Timeout: cfg.Timeout,
+	}
+
+	executorProvider := accessstrategy.NewExecutorProvider(map[accessstrategy.Type]accessstrategy.Executor{
+		accessstrategy.OpenAccessStrategy: accessstrategy.NewOpenAccessStrategyExecutor(),
+	})
+	client := ord.NewClient(ord.NewClientConfig(maxParallelDocuments, 0, 1), httpClient, executorProvider)
+
+	validationClient := ord.NewValidationClient(cfg.APIMetadataValidatorURL, httpClient, cfg.APIMetadataValidatorURL != "")
+
+	return ord.NewOfflineDocumentValidator(client, client, cfg.GlobalRegistryURL, ord.NewDocumentValidator(validationClient), ord.NewDocumentSanitizer())
+}
+
+// CLI is the ordvalidator command-line interface
+type CLI struct {
+	stdout       io.Writer
+	stderr       io.Writer
+	newValidator ValidatorFactory
+}
+
+// NewCLI creates an ordvalidator command-line interface
+func NewCLI(stdout, stderr io.Writer, newValidator ValidatorFactory) *CLI {
+	return &CLI{
+		stdout:       stdout,
+		stderr:       stderr,
+		newValidator: newValidator,
+	}
+}
+
+// Run validates the ORD documents described by args, prints the validation report and returns the process exit code
+func (c *CLI) Run(ctx context.Context, args []string) int {
+	fs := flag.NewFlagSet("ordvalidator", flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprint(c.stderr, usage)
+		fs.PrintDefaults()
+	}
+
+	cfg := Config{}
+	cfg.registerFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitCodeOK
+		}
+		return exitCodeUsage
+	}
+
+	if fs.NArg() > 0 {
+		return c.usageError(errors.Errorf("unexpected arguments %q", fs.Args()))
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return c.usageError(err)
+	}
+
+	// The report is printed to the standard output, so the logs of the validation go to the standard error
+	ctx, err := log.Configure(ctx, &log.Config{Level: cfg.LogLevel, Format: "text", Output: os.Stderr.Name(), BootstrapCorrelationID: "bootstrap"})
+	if err != nil {
+		return c.usageError(err)
+	}
+
+	report, err := c.validate(ctx, cfg)
+	if err != nil {
+		fmt.Fprintf(c.stderr, "Error: %v\n", err)
+		return exitCodeError
+	}
+
+	encoder := json.NewEncoder(c.stdout)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(report); err != nil {
+		fmt.Fprintf(c.stderr, "Error: %v\n", err)
+		return exitCodeError
+	}
+
+	if !report.Valid {
+		return exitCodeInvalid
+	}
+
+	return exitCodeOK
+}
+
+func (c *CLI) usageError(err error) int {
+	fmt.Fprintf(c.stderr, "Error: %v\nRun \"ordvalidator -h\" for usage.\n", err)
+	return exitCodeUsage
+}
+
+func (c *CLI) validate(ctx context.Context, cfg Config) (*ord.ValidationReport, error) {
+	validator := c.newValidator(cfg)
+
+	if cfg.ConfigURL != "" {
+		return validator.ValidateWellKnownConfig(ctx, cfg.ConfigURL)
+	}
+
+	files := make(map[string]string, len(cfg.Documents))
+	for _, doc := range cfg.Documents {
+		content, err := os.ReadFile(doc.Path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading %q", doc.Path)
+		}
+		files[doc.URL] = string(content)
+	}
+
+	configuration, err := c.configuration(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return validator.ValidateFiles(ctx, configuration, files, cfg.BaseURL)
+}
+
+// configuration reads the local ORD configuration. Without one, a configuration listing all documents is created.
+func (c *CLI) configuration(cfg Config) (json.RawMessage, error) {
+	if cfg.ConfigFile != "" {
+		content, err := os.ReadFile(cfg.ConfigFile)
+		return content, errors.Wrapf(err, "while reading %q", cfg.ConfigFile)
+	}
+
+	config := ord.WellKnownConfig{}
+	for _, doc := range cfg.Documents {
+		config.OpenResourceDiscoveryV1.Documents = append(config.OpenResourceDiscoveryV1.Documents, ord.DocumentDetails{
+			URL:              doc.URL,
+			AccessStrategies: accessstrategy.AccessStrategies{{Type: accessstrategy.OpenAccessStrategy}},
+			Perspective:      ord.SystemInstancePerspective,
+		})
+	}
+
+	return json.Marshal(config)
+}
@@ -0,0 +1,238 @@
+package ordvalidator_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/ordvalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	configURL     = "https://system.com/.well-known/open-resource-discovery"
+	baseURL       = "https://system.com"
+	documentURL   = "/open-resource-discovery/v1/documents/example1"
+	document      = `{"openResourceDiscovery":"1.9"}`
+	configuration = `{"openResourceDiscoveryV1":{"documents":[{"url":"/open-resource-discovery/v1/documents/example1","accessStrategies":[{"type":"open"}]}]}}`
+)
+
+func TestCLI_Run(t *testing.T) {
+	testErr := errors.New("test error")
+
+	dir := t.TempDir()
+	documentPath := filepath.Join(dir, "document.json")
+	configPath := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(documentPath, []byte(document), 0600))
+	require.NoError(t, os.WriteFile(configPath, []byte(configuration), 0600))
+
+	validReport := &ord.ValidationReport{BaseURL: baseURL, Valid: true, Resources: []*ord.ResourceValidationReport{}}
+	invalidReport := &ord.ValidationReport{
+		BaseURL: baseURL,
+		Valid:   false,
+		Errors:  1,
+		Resources: []*ord.ResourceValidationReport{
+			{
+				OrdID:  "ns:apiResource:API_ID:v1",
+				Errors: []*ord.ValidationError{{OrdID: "ns:apiResource:API_ID:v1", Severity: ord.ErrorSeverity, Type: "code", Description: "invalid title"}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name             string
+		Args             []string
+		ValidatorFn      func() *automock.OfflineValidator
+		ExpectedExitCode int
+		ExpectedReport   *ord.ValidationReport
+		ExpectedStderr   string
+	}{
+		{
+			Name: "Valid documents from a configuration URL",
+			Args: []string{"--config-url", configURL},
+			ValidatorFn: func() *automock.OfflineValidator {
+				validator := &automock.OfflineValidator{}
+				validator.On("ValidateWellKnownConfig", mock.Anything, configURL).Return(validReport, nil).Once()
+				return validator
+			},
+			ExpectedExitCode: 0,
+			ExpectedReport:   validReport,
+		},
+		{
+			Name: "Invalid documents from a configuration URL",
+			Args: []string{"--config-url", configURL},
+			ValidatorFn: func() *automock.OfflineValidator {
+				validator := &automock.OfflineValidator{}
+				validator.On("ValidateWellKnownConfig", mock.Anything, configURL).Return(invalidReport, nil).Once()
+				return validator
+			},
+			ExpectedExitCode: 1,
+			ExpectedReport:   invalidReport,
+		},
+		{
+			Name: "Local configuration and documents",
+			Args: []string{"--config", configPath, "--document", documentURL + "=" + documentPath, "--base-url", baseURL},
+			ValidatorFn: func() *automock.OfflineValidator {
+				validator := &automock.OfflineValidator{}
+				validator.On("ValidateFiles", mock.Anything, json.RawMessage(configuration), map[string]string{documentURL: document}, baseURL).Return(validReport, nil).Once()
+				return validator
+			},
+			ExpectedExitCode: 0,
+			ExpectedReport:   validReport,
+		},
+		{
+			Name: "Local documents without configuration",
+			Args: []string{"--document", documentPath, "--base-url", baseURL},
+			ValidatorFn: func() *automock.OfflineValidator {
+				validator := &automock.OfflineValidator{}
+				validator.On("ValidateFiles", mock.Anything, mock.MatchedBy(func(configuration json.RawMessage) bool {
+					config := ord.WellKnownConfig{}
+					return json.Unmarshal(configuration, &config) == nil && len(config.OpenResourceDiscoveryV1.Documents) == 1 && config.OpenResourceDiscoveryV1.Documents[0].URL == documentPath
+				}), map[string]string{documentPath: document}, baseURL).Return(validReport, nil).Once()
+				return validator
+			},
+			ExpectedExitCode: 0,
+			ExpectedReport:   validReport,
+		},
+		{
+			Name: "Error when the validation fails",
+			Args: []string{"--config-url", configURL},
+			ValidatorFn: func() *automock.OfflineValidator {
+				validator := &automock.OfflineValidator{}
+				validator.On("ValidateWellKnownConfig", mock.Anything, configURL).Return(nil, testErr).Once()
+				return validator
+			},
+			ExpectedExitCode: 3,
+			ExpectedStderr:   testErr.Error(),
+		},
+		{
+			Name:             "Error when a local document cannot be read",
+			Args:             []string{"--document", filepath.Join(dir, "missing.json"), "--base-url", baseURL},
+			ExpectedExitCode: 3,
+			ExpectedStderr:   "missing.json",
+		},
+		{
+			Name:             "Error when no documents are given",
+			ExpectedExitCode: 2,
+			ExpectedStderr:   "either --config-url or at least one --document must be provided",
+		},
+		{
+			Name:             "Error when the configuration URL is combined with local documents",
+			Args:             []string{"--config-url", configURL, "--document", documentPath},
+			ExpectedExitCode: 2,
+			ExpectedStderr:   "--config-url cannot be combined with --config or --document",
+		},
+		{
+			Name:             "Error when local documents without configuration have no base URL",
+			Args:             []string{"--document", documentPath},
+			ExpectedExitCode: 2,
+			ExpectedStderr:   "--base-url must be provided",
+		},
+		{
+			Name:             "Error for unexpected arguments",
+			Args:             []string{"--config-url", configURL, "extra"},
+			ExpectedExitCode: 2,
+			ExpectedStderr:   "unexpected arguments",
+		},
+		{
+			Name:             "Help",
+			Args:             []string{"-h"},
+			ExpectedExitCode: 0,
+			ExpectedStderr:   "Usage:",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			validator := &automock.OfflineValidator{}
+			if testCase.ValidatorFn != nil {
+				validator = testCase.ValidatorFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, validator)
+
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			cli := ordvalidator.NewCLI(stdout, stderr, func(cfg ordvalidator.Config) ord.OfflineValidator {
+				return validator
+			})
+
+			// WHEN
+			exitCode := cli.Run(context.TODO(), testCase.Args)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedExitCode, exitCode)
+			if testCase.ExpectedStderr != "" {
+				assert.Contains(t, stderr.String(), testCase.ExpectedStderr)
+			}
+
+			if testCase.ExpectedReport != nil {
+				actualReport := &ord.ValidationReport{}
+				require.NoError(t, json.Unmarshal(stdout.Bytes(), actualReport))
+				assert.Equal(t, testCase.ExpectedReport, actualReport)
+			} else {
+				assert.Empty(t, stdout.String())
+			}
+		})
+	}
+}
+
+func TestDocumentFiles_Set(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Value       string
+		Expected    ordvalidator.DocumentFile
+		ExpectedErr string
+	}{
+		{
+			Name:     "URL and path",
+			Value:    documentURL + "=./document.json",
+			Expected: ordvalidator.DocumentFile{URL: documentURL, Path: "./document.json"},
+		},
+		{
+			Name:     "URL with a query and path",
+			Value:    documentURL + "?version=1=./document.json",
+			Expected: ordvalidator.DocumentFile{URL: documentURL + "?version=1", Path: "./document.json"},
+		},
+		{
+			Name:     "Only path",
+			Value:    "./document.json",
+			Expected: ordvalidator.DocumentFile{URL: "./document.json", Path: "./document.json"},
+		},
+		{
+			Name:        "Empty path",
+			Value:       documentURL + "=",
+			ExpectedErr: "expected <url>=<path> or <path>",
+		},
+		{
+			Name:        "Empty value",
+			Value:       "",
+			ExpectedErr: "the document must not be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			documents := ordvalidator.DocumentFiles{}
+
+			// WHEN
+			err := documents.Set(testCase.Value)
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErr)
+				require.Empty(t, documents)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, ordvalidator.DocumentFiles{testCase.Expected}, documents)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package ordvalidator
+
+import (
+	"flag"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const envPrefix = "ORDVALIDATOR_"
+
+// Config holds the ordvalidator options
+type Config struct {
+	ConfigURL               string
+	ConfigFile              string
+	Documents               DocumentFiles
+	BaseURL                 string
+	GlobalRegistryURL       string
+	APIMetadataValidatorURL string
+	Timeout                 time.Duration
+	SkipSSLValidation       bool
+	LogLevel                string
+}
+
+// DocumentFile is a local ORD document together with the URL under which the ORD configuration references it
+type DocumentFile struct {
+	URL  string
+	Path string
+}
+
+// DocumentFiles is a repeatable flag of local ORD documents given as <url>=<path> or just <path>
+type DocumentFiles []DocumentFile
+
+// String implements flag.Value
+func (d *DocumentFiles) String() string {
+	values := make([]string, 0, len(*d))
+	for _, doc := range *d {
+		values = append(values, doc.URL+"="+doc.Path)
+	}
+	return strings.Join(values, ",")
+}
+
+// Set implements flag.Value. The path is the part after the last "=", as URLs may contain "=" in their query.
+func (d *DocumentFiles) Set(value string) error {
+	if value == "" {
+		return errors.New("the document must not be empty")
+	}
+
+	doc := DocumentFile{URL: value, Path: value}
+	if idx := strings.LastIndex(value, "="); idx >= 0 {
+		doc.URL, doc.Path = value[:idx], value[idx+1:]
+	}
+
+	if doc.URL == "" || doc.Path == "" {
+		return errors.Errorf("invalid document %q, expected <url>=<path> or <path>", value)
+	}
+
+	*d = append(*d, doc)
+	return nil
+}
+
+// registerFlags binds the options to the flag set. Defaults are taken from ORDVALIDATOR_* environment variables.
+func (c *Config) registerFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.ConfigURL, "config-url", "", "URL of the ORD well-known configuration of the system, for example https://system.com/.well-known/open-resource-discovery")
+	fs.StringVar(&c.ConfigFile, "config", "", "Path to a local ORD configuration. When not set, every document is validated as if it was listed in a configuration")
+	fs.Var(&c.Documents, "document", "Local ORD document as <url>=<path>, where <url> is the URL under which the configuration references it. Can be repeated")
+	fs.StringVar(&c.BaseURL, "base-url", "", "Base URL of the system, used when the local configuration does not provide one")
+	fs.StringVar(&c.GlobalRegistryURL, "global-registry-url", envOrDefault("GLOBAL_REGISTRY_URL", ""), "URL of the ORD configuration of the global registry with the known vendors and products [$ORDVALIDATOR_GLOBAL_REGISTRY_URL]")
+	fs.StringVar(&c.APIMetadataValidatorURL, "api-metadata-validator-url", envOrDefault("API_METADATA_VALIDATOR_URL", ""), "URL of the API Metadata Validator. The validation against the ORD specification is skipped when not set [$ORDVALIDATOR_API_METADATA_VALIDATOR_URL]")
+	fs.DurationVar(&c.Timeout, "timeout", 30*time.Second, "Timeout of a single request")
+	fs.BoolVar(&c.SkipSSLValidation, "insecure-skip-tls-verify", false, "Skip the validation of the server certificates")
+	fs.StringVar(&c.LogLevel, "log-level", envOrDefault("LOG_LEVEL", "warning"), "Level of the logs written to the standard error [$ORDVALIDATOR_LOG_LEVEL]")
+}
+
+// Validate checks that the ORD documents are given either by a configuration URL or as local files
+func (c *Config) Validate() error {
+	if c.ConfigURL != "" {
+		if c.ConfigFile != "" || len(c.Documents) > 0 {
+			return errors.New("--config-url cannot be combined with --config or --document")
+		}
+		return nil
+	}
+
+	if len(c.Documents) == 0 {
+		return errors.New("either --config-url or at least one --document must be provided")
+	}
+
+	if c.ConfigFile == "" && c.BaseURL == "" {
+		return errors.New("--base-url must be provided when the documents are validated without --config")
+	}
+
+	return nil
+}
+
+func envOrDefault(key, defaultValue string) string {
+	if value, ok := os.LookupEnv(envPrefix + key); ok {
+		return value
+	}
+	return defaultValue
+}
@@ -138,6 +138,13 @@ func GetSingleTenantAccess(ctx context.Context, m2mTable string, tenantID, resou
 	return tenantAccess, nil
 }
 
+// ExistsDirectTenantAccess checks whether the tenant with ID tenantID has a tenant access record for resource with ID resourceID, which is not inherited from its child tenants
+func ExistsDirectTenantAccess(ctx context.Context, m2mTable string, tenantID, resourceID string) (bool, error) {
+	existQuerier := NewExistQuerierGlobal(resource.TenantAccess, m2mTable)
+
+	return existQuerier.ExistsGlobal(ctx, Conditions{NewEqualCondition(M2MTenantIDColumn, tenantID), NewEqualCondition(M2MResourceIDColumn, resourceID), NewEqualCondition(M2MSourceColumn, tenantID)})
+}
+
 // CreateSingleTenantAccess create a tenant access for a single entity
 func CreateSingleTenantAccess(ctx context.Context, m2mTable string, tenantAccess *TenantAccess) error {
 	values := make([]string, 0, len(M2MColumns))
@@ -181,6 +181,22 @@ func (s *selfRegisterManager) CleanupSelfRegistration(ctx context.Context, resou
 		return nil
 	}
 
+	return s.cleanup(ctx, resourceID, region)
+}
+
+// CleanupPurgedSelfRegistration executes cleanup calls for a self-registered runtime which is purged after being soft deleted.
+// Purging is not triggered by a consumer, so the caller is responsible for checking that the runtime was self-registered.
+func (s *selfRegisterManager) CleanupPurgedSelfRegistration(ctx context.Context, resourceID, region string) error {
+	return s.cleanup(ctx, resourceID, region)
+}
+
+// GetSelfRegDistinguishingLabelKey returns the label key to be used in order to determine whether a resource
+// is being self-registered.
+func (s *selfRegisterManager) GetSelfRegDistinguishingLabelKey() string {
+	return s.cfg.SelfRegisterDistinguishLabelKey
+}
+
+func (s *selfRegisterManager) cleanup(ctx context.Context, resourceID, region string) error {
 	if resourceID == "" {
 		return nil
 	}
@@ -212,12 +228,6 @@ func (s *selfRegisterManager) CleanupSelfRegistration(ctx context.Context, resou
 	return nil
 }
 
-// GetSelfRegDistinguishingLabelKey returns the label key to be used in order to determine whether a resource
-// is being self-registered.
-func (s *selfRegisterManager) GetSelfRegDistinguishingLabelKey() string {
-	return s.cfg.SelfRegisterDistinguishLabelKey
-}
-
 func (s *selfRegisterManager) createSelfRegPrepRequest(id, tenant, targetURL string) (*http.Request, error) {
 	selfRegLabelVal := s.cfg.SelfRegisterLabelValuePrefix + id
 	url, err := urlpkg.Parse(targetURL)
@@ -568,6 +568,49 @@ func TestSelfRegisterManager_CleanupSelfRegistration(t *testing.T) {
 	}
 }
 
+func TestSelfRegisterManager_CleanupPurgedSelfRegistration(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		CallerProvider func(*testing.T, config.SelfRegConfig, string) *automock.ExternalSvcCallerProvider
+		Region         string
+		ExpectedErr    error
+	}{
+		{
+			Name:           "Success without consumer in the context",
+			CallerProvider: selfregmngrtest.CallerThatGetsCalledOnce(http.StatusOK),
+			Region:         testRegion,
+		},
+		{
+			Name:           "Error when region doesn't exist",
+			CallerProvider: selfregmngrtest.CallerThatDoesNotGetCalled,
+			Region:         "not-valid",
+			ExpectedErr:    errors.New("missing configuration for region"),
+		},
+		{
+			Name:           "Error when Call doesn't succeed",
+			CallerProvider: selfregmngrtest.CallerThatDoesNotSucceed,
+			Region:         testRegion,
+			ExpectedErr:    selfregmngrtest.TestError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			svcCallerProvider := testCase.CallerProvider(t, testConfig, testCase.Region)
+			manager, err := selfregmanager.NewSelfRegisterManager(testConfig, svcCallerProvider, appTemplateProductLabelKey)
+			require.NoError(t, err)
+
+			err = manager.CleanupPurgedSelfRegistration(context.TODO(), distinguishLblVal, testCase.Region)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestNewSelfRegisterManager(t *testing.T) {
 	t.Run("Error when creating self register manager fails", func(t *testing.T) {
 		cfg := config.SelfRegConfig{}
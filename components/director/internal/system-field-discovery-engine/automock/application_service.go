@@ -40,6 +40,20 @@ func (_m *ApplicationService) Get(ctx context.Context, id string) (*model.Applic
 	return r0, r1
 }
 
+// SetLabel provides a mock function with given fields: ctx, label
+func (_m *ApplicationService) SetLabel(ctx context.Context, label *model.LabelInput) error {
+	ret := _m.Called(ctx, label)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LabelInput) error); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateBaseURLAndReadyState provides a mock function with given fields: ctx, appID, baseURL, ready
 func (_m *ApplicationService) UpdateBaseURLAndReadyState(ctx context.Context, appID string, baseURL string, ready bool) error {
 	ret := _m.Called(ctx, appID, baseURL, ready)
@@ -5,6 +5,7 @@ package automock
 import (
 	context "context"
 
+	systemfielddiscoveryengine "github.com/kyma-incubator/compass/components/director/internal/system-field-discovery-engine"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -13,13 +14,13 @@ type SystemFieldDiscoveryService struct {
 	mock.Mock
 }
 
-// ProcessSaasRegistryApplication provides a mock function with given fields: ctx, appID, tenantID
-func (_m *SystemFieldDiscoveryService) ProcessSaasRegistryApplication(ctx context.Context, appID string, tenantID string) error {
-	ret := _m.Called(ctx, appID, tenantID)
+// ProcessApplication provides a mock function with given fields: ctx, registry, appID, tenantID
+func (_m *SystemFieldDiscoveryService) ProcessApplication(ctx context.Context, registry systemfielddiscoveryengine.SystemFieldDiscoveryRegistry, appID string, tenantID string) error {
+	ret := _m.Called(ctx, registry, appID, tenantID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, appID, tenantID)
+	if rf, ok := ret.Get(0).(func(context.Context, systemfielddiscoveryengine.SystemFieldDiscoveryRegistry, string, string) error); ok {
+		r0 = rf(ctx, registry, appID, tenantID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// HTTPRegistryConfig describes a generic HTTP/JSON registry used as a source for system field discovery.
+// URLTemplate and ItemsPath are Go templates rendered with the application, its region and the tenant,
+// while BaseURLPath and the values of LabelPaths are gjson paths evaluated against every discovered item.
+// The values inserted by URLTemplate are URL escaped, so they cannot change the path or the query of the registry URL.
+type HTTPRegistryConfig struct {
+	Name         string            `json:"name"`
+	URLTemplate  string            `json:"urlTemplate"`
+	ItemsPath    string            `json:"itemsPath"`
+	BaseURLPath  string            `json:"baseURLPath"`
+	LabelPaths   map[string]string `json:"labelPaths"`
+	ClientID     string            `json:"clientID"`
+	ClientSecret string            `json:"clientSecret"`
+	TokenURL     string            `json:"tokenURL"`
+}
+
+// HasCredentials returns true if OAuth client credentials are configured for the registry
+func (h *HTTPRegistryConfig) HasCredentials() bool {
+	return h.ClientID != "" || h.ClientSecret != "" || h.TokenURL != ""
+}
+
+// validate checks if all required fields are populated.
+// In the end, the error message is aggregated by joining all error messages.
+func (h *HTTPRegistryConfig) validate() error {
+	errorMessages := make([]string, 0)
+
+	if h.Name == "" {
+		errorMessages = append(errorMessages, "Name is missing")
+	}
+	if h.URLTemplate == "" {
+		errorMessages = append(errorMessages, "URL template is missing")
+	}
+	if h.BaseURLPath == "" && len(h.LabelPaths) == 0 {
+		errorMessages = append(errorMessages, "Base URL path or label paths must be provided")
+	}
+	if h.HasCredentials() && (h.ClientID == "" || h.ClientSecret == "" || h.TokenURL == "") {
+		errorMessages = append(errorMessages, "Client ID, Client Secret and Token URL must be provided together")
+	}
+
+	errorMsg := strings.Join(errorMessages, ", ")
+	if errorMsg != "" {
+		return errors.New(errorMsg)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"encoding/json"
+
 	directorcfg "github.com/kyma-incubator/compass/components/director/pkg/config"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
@@ -16,7 +18,10 @@ type SystemFieldDiscoveryEngineConfig struct {
 	SaasRegTokenURLPath     string `envconfig:"APP_SYSTEM_FIELD_DISCOVERY_URL_PATH"`
 	SaasRegURLPath          string `envconfig:"APP_SYSTEM_FIELD_DISCOVERY_SAAS_REGISTRY_URL_PATH"`
 
+	RegistriesConfigPath string `envconfig:"APP_SYSTEM_FIELD_DISCOVERY_REGISTRIES_CONFIG_PATH,optional"`
+
 	RegionToSaasRegConfig map[string]SaasRegConfig `envconfig:"-"`
+	HTTPRegistries        []HTTPRegistryConfig     `envconfig:"-"`
 }
 
 // PrepareConfiguration take cares to build the system field discovery engine configuration
@@ -26,6 +31,11 @@ func (c SystemFieldDiscoveryEngineConfig) PrepareConfiguration() (*SystemFieldDi
 		return nil, errors.Wrap(err, "while building region instances credentials")
 	}
 
+	sfdCfg, err = sfdCfg.MapHTTPRegistryConfigs()
+	if err != nil {
+		return nil, errors.Wrap(err, "while building http registries configuration")
+	}
+
 	return sfdCfg, nil
 }
 
@@ -59,3 +69,35 @@ func (c SystemFieldDiscoveryEngineConfig) MapSaasRegConfigs() (*SystemFieldDisco
 
 	return &c, nil
 }
+
+// MapHTTPRegistryConfigs parses the optional json file with the generic HTTP registries configuration
+func (c SystemFieldDiscoveryEngineConfig) MapHTTPRegistryConfigs() (*SystemFieldDiscoveryEngineConfig, error) {
+	c.HTTPRegistries = nil
+	if c.RegistriesConfigPath == "" {
+		return &c, nil
+	}
+
+	registriesData, err := directorcfg.ReadConfigFile(c.RegistriesConfigPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading http registries configuration")
+	}
+
+	var registries []HTTPRegistryConfig
+	if err := json.Unmarshal([]byte(registriesData), &registries); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling http registries configuration")
+	}
+
+	names := make(map[string]bool, len(registries))
+	for i, registry := range registries {
+		if err := registry.validate(); err != nil {
+			return nil, errors.Wrapf(err, "while validating http registry config at index %d", i)
+		}
+		if names[registry.Name] {
+			return nil, errors.Errorf("http registry with name %q is configured more than once", registry.Name)
+		}
+		names[registry.Name] = true
+	}
+
+	c.HTTPRegistries = registries
+	return &c, nil
+}
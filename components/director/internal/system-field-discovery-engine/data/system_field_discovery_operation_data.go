@@ -10,13 +10,15 @@ import (
 type SystemFieldDiscoveryOperationData struct {
 	ApplicationID string `json:"applicationID"`
 	TenantID      string `json:"tenantID"`
+	Registry      string `json:"registry"`
 }
 
 // NewSystemFieldDiscoveryOperationData creates new SystemFieldDiscoveryOperationData.
-func NewSystemFieldDiscoveryOperationData(appID, tenantID string) *SystemFieldDiscoveryOperationData {
+func NewSystemFieldDiscoveryOperationData(appID, tenantID, registry string) *SystemFieldDiscoveryOperationData {
 	return &SystemFieldDiscoveryOperationData{
 		ApplicationID: appID,
 		TenantID:      tenantID,
+		Registry:      registry,
 	}
 }
 
@@ -14,6 +14,7 @@ func TestSystemFieldDiscoveryOperationData_GetData(t *testing.T) {
 		Name         string
 		AppID        string
 		TenantID     string
+		Registry     string
 		ExpectedData string
 		ExpectedErr  error
 	}{
@@ -21,29 +22,30 @@ func TestSystemFieldDiscoveryOperationData_GetData(t *testing.T) {
 			Name:         "Success",
 			AppID:        "app-id",
 			TenantID:     "tenant-id",
-			ExpectedData: "{\"applicationID\":\"app-id\",\"tenantID\":\"tenant-id\"}",
+			Registry:     "saas-registry",
+			ExpectedData: "{\"applicationID\":\"app-id\",\"tenantID\":\"tenant-id\",\"registry\":\"saas-registry\"}",
 		},
 		{
 			Name:         "Success - missing tenant id",
 			AppID:        "app-id",
 			TenantID:     "",
-			ExpectedData: "{\"applicationID\":\"app-id\",\"tenantID\":\"\"}",
+			ExpectedData: "{\"applicationID\":\"app-id\",\"tenantID\":\"\",\"registry\":\"\"}",
 		},
 		{
 			Name:         "Success - missing application id",
 			TenantID:     "tenant-id",
-			ExpectedData: "{\"applicationID\":\"\",\"tenantID\":\"tenant-id\"}",
+			ExpectedData: "{\"applicationID\":\"\",\"tenantID\":\"tenant-id\",\"registry\":\"\"}",
 		},
 		{
 			Name:         "Success - missing application id and tenant id",
-			ExpectedData: "{\"applicationID\":\"\",\"tenantID\":\"\"}",
+			ExpectedData: "{\"applicationID\":\"\",\"tenantID\":\"\",\"registry\":\"\"}",
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// GIVEN
-			data := data.NewSystemFieldDiscoveryOperationData(testCase.AppID, testCase.TenantID)
+			data := data.NewSystemFieldDiscoveryOperationData(testCase.AppID, testCase.TenantID, testCase.Registry)
 
 			// WHEN
 			result, err := data.GetData()
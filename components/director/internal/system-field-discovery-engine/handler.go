@@ -27,18 +27,28 @@ type OperationsManager interface {
 type SystemFieldDiscoveryResources struct {
 	ApplicationID string `json:"applicationID"`
 	TenantID      string `json:"tenantID"`
+	// Registry is optional and defaults to the saas registry
+	Registry SystemFieldDiscoveryRegistry `json:"registry,omitempty"`
 }
 
 type handler struct {
 	opMgr           OperationsManager
 	onDemandChannel chan string
+	registries      map[SystemFieldDiscoveryRegistry]bool
 }
 
 // NewSystemFieldDiscoveryHTTPHandler returns a new HTTP handler, responsible for handling HTTP requests
-func NewSystemFieldDiscoveryHTTPHandler(opMgr OperationsManager, onDemandChannel chan string) *handler {
+// for the given supported registries
+func NewSystemFieldDiscoveryHTTPHandler(opMgr OperationsManager, onDemandChannel chan string, registries []SystemFieldDiscoveryRegistry) *handler {
+	supportedRegistries := make(map[SystemFieldDiscoveryRegistry]bool, len(registries))
+	for _, registry := range registries {
+		supportedRegistries[registry] = true
+	}
+
 	return &handler{
 		opMgr:           opMgr,
 		onDemandChannel: onDemandChannel,
+		registries:      supportedRegistries,
 	}
 }
 
@@ -60,8 +70,18 @@ func (h *handler) ScheduleSaaSRegistryDiscoveryForSystemFieldDiscoveryData(write
 		return
 	}
 
-	log.C(ctx).Infof("Rescheduling system field discovery data aggregation for application with id %q  and tenant with id %q for saas registry", payload.ApplicationID, payload.TenantID)
-	operation, err := h.opMgr.FindOperationByData(ctx, data.NewSystemFieldDiscoveryOperationData(payload.ApplicationID, payload.TenantID))
+	if payload.Registry == "" {
+		payload.Registry = SystemFieldDiscoverySaaSRegistry
+	}
+
+	if !h.registries[payload.Registry] {
+		log.C(ctx).Errorf("Unsupported registry %q provided for system field discovery aggregation", payload.Registry)
+		http.Error(writer, "Invalid payload, unsupported registry.", http.StatusBadRequest)
+		return
+	}
+
+	log.C(ctx).Infof("Rescheduling system field discovery data aggregation for application with id %q  and tenant with id %q for registry %q", payload.ApplicationID, payload.TenantID, payload.Registry)
+	operation, err := h.opMgr.FindOperationByData(ctx, data.NewSystemFieldDiscoveryOperationData(payload.ApplicationID, payload.TenantID, payload.Registry.ToString()))
 	if err != nil {
 		if !apperrors.IsNotFoundError(err) {
 			log.C(ctx).WithError(err).Errorf("Loading Operation for system field discovery data aggregation failed")
@@ -71,7 +91,7 @@ func (h *handler) ScheduleSaaSRegistryDiscoveryForSystemFieldDiscoveryData(write
 
 		log.C(ctx).Infof("Operation with ApplicationID %q and TenantID %q does not exist. Trying to create...", payload.ApplicationID, payload.TenantID)
 		now := time.Now()
-		data := data.NewSystemFieldDiscoveryOperationData(payload.ApplicationID, payload.TenantID)
+		data := data.NewSystemFieldDiscoveryOperationData(payload.ApplicationID, payload.TenantID, payload.Registry.ToString())
 		rawData, err := data.GetData()
 		if err != nil {
 			log.C(ctx).WithError(err).Errorf("Preparing Operation for system field discovery data aggregation failed")
@@ -25,6 +25,8 @@ func TestHandler_ScheduleAggregationForORDData(t *testing.T) {
 	applicationID := "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
 	tenantID := "bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
 	operationID := "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+	customRegistry := systemfielddiscoveryengine.SystemFieldDiscoveryRegistry("custom-registry")
+	registries := []systemfielddiscoveryengine.SystemFieldDiscoveryRegistry{systemfielddiscoveryengine.SystemFieldDiscoverySaaSRegistry, customRegistry}
 	operation := &model.Operation{ID: operationID}
 
 	testErr := errors.New("test error")
@@ -40,7 +42,7 @@ func TestHandler_ScheduleAggregationForORDData(t *testing.T) {
 			Name: "Success - operation already exists",
 			OperationManagerFn: func() *automock.OperationsManager {
 				opManager := &automock.OperationsManager{}
-				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID)).Return(operation, nil).Once()
+				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID, systemfielddiscoveryengine.SystemFieldDiscoverySaaSRegistry.ToString())).Return(operation, nil).Once()
 				opManager.On("RescheduleOperation", mock.Anything, operationID).Return(nil).Once()
 				return opManager
 			},
@@ -54,7 +56,7 @@ func TestHandler_ScheduleAggregationForORDData(t *testing.T) {
 			Name: "Success - operation does not exist, create new operation",
 			OperationManagerFn: func() *automock.OperationsManager {
 				opManager := &automock.OperationsManager{}
-				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID)).Return(nil, apperrors.NewNotFoundError(resource.Operation, operationID)).Once()
+				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID, systemfielddiscoveryengine.SystemFieldDiscoverySaaSRegistry.ToString())).Return(nil, apperrors.NewNotFoundError(resource.Operation, operationID)).Once()
 				opManager.On("CreateOperation", mock.Anything, mock.Anything).Return(operationID, nil).Once()
 				return opManager
 			},
@@ -64,11 +66,41 @@ func TestHandler_ScheduleAggregationForORDData(t *testing.T) {
 			},
 			ExpectedStatusCode: http.StatusOK,
 		},
+		{
+			Name: "Success - operation for custom registry does not exist, create new operation",
+			OperationManagerFn: func() *automock.OperationsManager {
+				opManager := &automock.OperationsManager{}
+				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID, customRegistry.ToString())).Return(nil, apperrors.NewNotFoundError(resource.Operation, operationID)).Once()
+				opManager.On("CreateOperation", mock.Anything, mock.MatchedBy(func(in *model.OperationInput) bool {
+					return in.OpType == model.OperationTypeSaasRegistryDiscovery && string(in.Data) == `{"applicationID":"`+applicationID+`","tenantID":"`+tenantID+`","registry":"custom-registry"}`
+				})).Return(operationID, nil).Once()
+				return opManager
+			},
+			RequestBody: systemfielddiscoveryengine.SystemFieldDiscoveryResources{
+				ApplicationID: applicationID,
+				TenantID:      tenantID,
+				Registry:      customRegistry,
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "BadRequest - unsupported registry",
+			OperationManagerFn: func() *automock.OperationsManager {
+				return &automock.OperationsManager{}
+			},
+			RequestBody: systemfielddiscoveryengine.SystemFieldDiscoveryResources{
+				ApplicationID: applicationID,
+				TenantID:      tenantID,
+				Registry:      "unknown-registry",
+			},
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "Invalid payload, unsupported registry.",
+		},
 		{
 			Name: "InternalServerError - error while checking if operation exists",
 			OperationManagerFn: func() *automock.OperationsManager {
 				opManager := &automock.OperationsManager{}
-				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID)).Return(nil, testErr).Once()
+				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID, systemfielddiscoveryengine.SystemFieldDiscoverySaaSRegistry.ToString())).Return(nil, testErr).Once()
 				return opManager
 			},
 			RequestBody: systemfielddiscoveryengine.SystemFieldDiscoveryResources{
@@ -106,7 +138,7 @@ func TestHandler_ScheduleAggregationForORDData(t *testing.T) {
 			Name: "InternalServerError - create operation fail",
 			OperationManagerFn: func() *automock.OperationsManager {
 				opManager := &automock.OperationsManager{}
-				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID)).Return(nil, apperrors.NewNotFoundError(resource.Operation, operationID)).Once()
+				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID, systemfielddiscoveryengine.SystemFieldDiscoverySaaSRegistry.ToString())).Return(nil, apperrors.NewNotFoundError(resource.Operation, operationID)).Once()
 				opManager.On("CreateOperation", mock.Anything, mock.Anything).Return("", testErr).Once()
 				return opManager
 			},
@@ -121,7 +153,7 @@ func TestHandler_ScheduleAggregationForORDData(t *testing.T) {
 			Name: "InternalServerError - error while rescheduling operation",
 			OperationManagerFn: func() *automock.OperationsManager {
 				opManager := &automock.OperationsManager{}
-				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID)).Return(operation, nil).Once()
+				opManager.On("FindOperationByData", mock.Anything, data.NewSystemFieldDiscoveryOperationData(applicationID, tenantID, systemfielddiscoveryengine.SystemFieldDiscoverySaaSRegistry.ToString())).Return(operation, nil).Once()
 				opManager.On("RescheduleOperation", mock.Anything, operationID).Return(testErr).Once()
 				return opManager
 			},
@@ -140,7 +172,7 @@ func TestHandler_ScheduleAggregationForORDData(t *testing.T) {
 
 			onDemandChannel := make(chan string, 2)
 
-			handler := systemfielddiscoveryengine.NewSystemFieldDiscoveryHTTPHandler(operationManager, onDemandChannel)
+			handler := systemfielddiscoveryengine.NewSystemFieldDiscoveryHTTPHandler(operationManager, onDemandChannel, registries)
 
 			requestBody, err := json.Marshal(testCase.RequestBody)
 			assert.NoError(t, err)
@@ -0,0 +1,113 @@
+package systemfielddiscoveryengine
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/system-field-discovery-engine/config"
+	pkgAuth "github.com/kyma-incubator/compass/components/director/pkg/auth"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/templatehelper"
+
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+)
+
+type httpRegistry struct {
+	cfg    config.HTTPRegistryConfig
+	client Client
+}
+
+// NewHTTPRegistry returns a generic registry which calls a JSON API and maps its response to system fields
+// as described by the given configuration
+func NewHTTPRegistry(cfg config.HTTPRegistryConfig, client Client) Registry {
+	return &httpRegistry{
+		cfg:    cfg,
+		client: client,
+	}
+}
+
+// Name returns the configured name of the registry
+func (r *httpRegistry) Name() SystemFieldDiscoveryRegistry {
+	return SystemFieldDiscoveryRegistry(r.cfg.Name)
+}
+
+// Discover calls the configured URL and returns the fields of the first item which contains them
+func (r *httpRegistry) Discover(ctx context.Context, request DiscoveryRequest) (*DiscoveredFields, error) {
+	appID := request.Application.ID
+	url, err := templatehelper.ExecuteURLTemplate(r.cfg.URLTemplate, request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while executing url template of registry %q", r.cfg.Name)
+	}
+
+	itemsPath, err := templatehelper.ExecuteTemplate(r.cfg.ItemsPath, request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while executing items path template of registry %q", r.cfg.Name)
+	}
+
+	if r.cfg.HasCredentials() {
+		ctx = pkgAuth.SaveToContext(ctx, &pkgAuth.OAuthCredentials{
+			ClientID:     r.cfg.ClientID,
+			ClientSecret: r.cfg.ClientSecret,
+			TokenURL:     r.cfg.TokenURL,
+		})
+	}
+
+	respBody, err := executeCall(ctx, r.client, url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed executing request for url %q and appID %q", url, appID)
+	}
+
+	if !gjson.ValidBytes(respBody) {
+		return nil, errors.Errorf("response for url %q is not a valid JSON", url)
+	}
+
+	items := gjson.ParseBytes(respBody)
+	if itemsPath != "" {
+		items = items.Get(itemsPath)
+	}
+
+	var candidates []gjson.Result
+	if items.IsArray() {
+		candidates = items.Array()
+	} else if items.Exists() {
+		candidates = []gjson.Result{items}
+	}
+
+	for _, item := range candidates {
+		if fields := r.fieldsFromItem(item); fields != nil {
+			log.C(ctx).Infof("Found system fields in registry %q for url %q and app with id %q", r.cfg.Name, url, appID)
+			return fields, nil
+		}
+	}
+
+	log.C(ctx).Infof("Response for url %q of registry %q does not contain system fields", url, r.cfg.Name)
+	return nil, nil
+}
+
+// fieldsFromItem maps a single item of the response to system fields. Items without a base URL are skipped when a base URL path is configured.
+func (r *httpRegistry) fieldsFromItem(item gjson.Result) *DiscoveredFields {
+	fields := &DiscoveredFields{}
+	if r.cfg.BaseURLPath != "" {
+		fields.BaseURL = item.Get(r.cfg.BaseURLPath).String()
+		if fields.BaseURL == "" {
+			return nil
+		}
+	}
+
+	for key, path := range r.cfg.LabelPaths {
+		value := item.Get(path)
+		if !value.Exists() {
+			continue
+		}
+		if fields.Labels == nil {
+			fields.Labels = make(map[string]interface{}, len(r.cfg.LabelPaths))
+		}
+		fields.Labels[key] = value.Value()
+	}
+
+	if fields.BaseURL == "" && len(fields.Labels) == 0 {
+		return nil
+	}
+
+	return fields
+}
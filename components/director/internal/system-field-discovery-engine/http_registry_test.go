@@ -0,0 +1,275 @@
+package systemfielddiscoveryengine_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	systemfielddiscoveryengine "github.com/kyma-incubator/compass/components/director/internal/system-field-discovery-engine"
+	"github.com/kyma-incubator/compass/components/director/internal/system-field-discovery-engine/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/system-field-discovery-engine/config"
+	pkgAuth "github.com/kyma-incubator/compass/components/director/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPRegistry_Discover(t *testing.T) {
+	applicationID := "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	tenantID := "bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	region := "eu1"
+	expectedURL := "https://registry.eu1.com/v1/systems?tenant=bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb&app=aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	systemsResponse := `{"result":{"systems":[
+						{"url":"","id":"first"},
+						{"url":"app-url.com","id":"second","details":{"owner":"team-a"}}
+					]}}`
+
+	request := systemfielddiscoveryengine.DiscoveryRequest{
+		Application: &model.Application{
+			BaseEntity: &model.BaseEntity{
+				ID: applicationID,
+			},
+		},
+		Region:   region,
+		TenantID: tenantID,
+	}
+
+	registryCfg := config.HTTPRegistryConfig{
+		Name:        "custom-registry",
+		URLTemplate: "https://registry.{{ .Region }}.com/v1/systems?tenant={{ .TenantID }}&app={{ .Application.ID }}",
+		ItemsPath:   "result.systems",
+		BaseURLPath: "url",
+		LabelPaths: map[string]string{
+			"systemId": "id",
+			"owner":    "details.owner",
+		},
+	}
+
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		Name                string
+		Config              func() config.HTTPRegistryConfig
+		HTTPClientFn        func() *automock.Client
+		ExpectedFields      *systemfielddiscoveryengine.DiscoveredFields
+		ExpectedErrorOutput string
+	}{
+		{
+			Name: "Success - fields of the first item with base url are returned",
+			Config: func() config.HTTPRegistryConfig {
+				return registryCfg
+			},
+			HTTPClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+					_, err := pkgAuth.LoadFromContext(req.Context())
+					return req.URL.String() == expectedURL && err != nil
+				})).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(systemsResponse))),
+				}, nil).Once()
+				return client
+			},
+			ExpectedFields: &systemfielddiscoveryengine.DiscoveredFields{
+				BaseURL: "app-url.com",
+				Labels: map[string]interface{}{
+					"systemId": "second",
+					"owner":    "team-a",
+				},
+			},
+		},
+		{
+			Name: "Success - labels only registry with a single object response and credentials",
+			Config: func() config.HTTPRegistryConfig {
+				cfg := registryCfg
+				cfg.ItemsPath = "result.systems.#(id==\"{{ .Application.ID }}\")"
+				cfg.BaseURLPath = ""
+				cfg.LabelPaths = map[string]string{"systemId": "id"}
+				cfg.ClientID = "client-id"
+				cfg.ClientSecret = "client-secret"
+				cfg.TokenURL = "https://token.com"
+				return cfg
+			},
+			HTTPClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+					credentials, err := pkgAuth.LoadFromContext(req.Context())
+					return err == nil && credentials.Get().(*pkgAuth.OAuthCredentials).ClientID == "client-id"
+				})).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(`{"result":{"systems":[{"id":"aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"}]}}`))),
+				}, nil).Once()
+				return client
+			},
+			ExpectedFields: &systemfielddiscoveryengine.DiscoveredFields{
+				Labels: map[string]interface{}{
+					"systemId": applicationID,
+				},
+			},
+		},
+		{
+			Name: "Success - no item contains the fields",
+			Config: func() config.HTTPRegistryConfig {
+				return registryCfg
+			},
+			HTTPClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.Anything).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(`{"result":{"systems":[{"url":""}]}}`))),
+				}, nil).Once()
+				return client
+			},
+		},
+		{
+			Name: "Success - items path does not exist in the response",
+			Config: func() config.HTTPRegistryConfig {
+				return registryCfg
+			},
+			HTTPClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.Anything).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(`{}`))),
+				}, nil).Once()
+				return client
+			},
+		},
+		{
+			Name: "Error - invalid url template",
+			Config: func() config.HTTPRegistryConfig {
+				cfg := registryCfg
+				cfg.URLTemplate = "https://registry.{{ .Region }"
+				return cfg
+			},
+			HTTPClientFn: func() *automock.Client {
+				return &automock.Client{}
+			},
+			ExpectedErrorOutput: "while executing url template of registry \"custom-registry\"",
+		},
+		{
+			Name: "Error - http client returns error",
+			Config: func() config.HTTPRegistryConfig {
+				return registryCfg
+			},
+			HTTPClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.Anything).Return(nil, testErr).Once()
+				return client
+			},
+			ExpectedErrorOutput: testErr.Error(),
+		},
+		{
+			Name: "Error - http client returns non ok response code",
+			Config: func() config.HTTPRegistryConfig {
+				return registryCfg
+			},
+			HTTPClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.Anything).Return(&http.Response{
+					StatusCode: http.StatusInternalServerError,
+					Body:       io.NopCloser(bytes.NewReader([]byte{})),
+				}, nil).Once()
+				return client
+			},
+			ExpectedErrorOutput: "unexpected status code",
+		},
+		{
+			Name: "Error - response is not a valid json",
+			Config: func() config.HTTPRegistryConfig {
+				return registryCfg
+			},
+			HTTPClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.Anything).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(`{"result":`))),
+				}, nil).Once()
+				return client
+			},
+			ExpectedErrorOutput: "is not a valid JSON",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := testCase.HTTPClientFn()
+			defer mock.AssertExpectationsForObjects(t, client)
+
+			registry := systemfielddiscoveryengine.NewHTTPRegistry(testCase.Config(), client)
+
+			// WHEN
+			fields, err := registry.Discover(context.TODO(), request)
+
+			// THEN
+			if len(testCase.ExpectedErrorOutput) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorOutput)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedFields, fields)
+			assert.Equal(t, systemfielddiscoveryengine.SystemFieldDiscoveryRegistry("custom-registry"), registry.Name())
+		})
+	}
+}
+
+func TestHTTPRegistry_DiscoverEscapesTemplateValues(t *testing.T) {
+	name := "a/../b?tenant=other&x"
+	request := systemfielddiscoveryengine.DiscoveryRequest{
+		Application: &model.Application{
+			Name:       name,
+			BaseEntity: &model.BaseEntity{ID: "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"},
+		},
+		Region: "eu1",
+	}
+
+	testCases := []struct {
+		Name         string
+		URLTemplate  string
+		ExpectedPath string
+	}{
+		{
+			Name:         "Values in the path and in the query",
+			URLTemplate:  "https://registry.com/v1/systems/{{ .Application.Name }}?name={{ .Application.Name }}",
+			ExpectedPath: "/v1/systems/a%2F..%2Fb%3Ftenant=other&x",
+		},
+		{
+			Name:         "Values in control structures and variables",
+			URLTemplate:  "https://registry.com/v1/{{ $app := .Application }}{{ with $app }}systems/{{ .Name }}{{ end }}?{{ if .Region }}name={{ $app.Name }}{{ end }}",
+			ExpectedPath: "/v1/systems/a%2F..%2Fb%3Ftenant=other&x",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := &automock.Client{}
+			client.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.Host == "registry.com" &&
+					req.URL.EscapedPath() == testCase.ExpectedPath &&
+					len(req.URL.Query()) == 1 &&
+					req.URL.Query().Get("name") == name
+			})).Return(&http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader([]byte(`{}`))),
+			}, nil).Once()
+			defer mock.AssertExpectationsForObjects(t, client)
+
+			registry := systemfielddiscoveryengine.NewHTTPRegistry(config.HTTPRegistryConfig{
+				Name:        "custom-registry",
+				URLTemplate: testCase.URLTemplate,
+				BaseURLPath: "url",
+			}, client)
+
+			// WHEN
+			fields, err := registry.Discover(context.TODO(), request)
+
+			// THEN
+			require.NoError(t, err)
+			assert.Nil(t, fields)
+		})
+	}
+}
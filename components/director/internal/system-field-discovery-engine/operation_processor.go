@@ -17,7 +17,7 @@ import (
 //
 //go:generate mockery --name=SystemFieldDiscoveryService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type SystemFieldDiscoveryService interface {
-	ProcessSaasRegistryApplication(ctx context.Context, appID, tenantID string) error
+	ProcessApplication(ctx context.Context, registry SystemFieldDiscoveryRegistry, appID, tenantID string) error
 }
 
 // ProcessingError custom error to store information about the processing error
@@ -62,9 +62,15 @@ func (p *OperationsProcessor) Process(ctx context.Context, operation *model.Oper
 		return errors.Wrapf(err, "while unmarshalling operation with id %q", operation.ID)
 	}
 
+	// Operations scheduled before the registry became part of the operation data are always for the saas registry
+	registry := SystemFieldDiscoverySaaSRegistry
+	if opData.Registry != "" {
+		registry = SystemFieldDiscoveryRegistry(opData.Registry)
+	}
+
 	if opData.ApplicationID != "" && opData.TenantID != "" {
-		if err := p.systemFieldDiscoverySvc.ProcessSaasRegistryApplication(ctx, opData.ApplicationID, opData.TenantID); err != nil {
-			return errors.Wrap(err, "while processing saas registry application")
+		if err := p.systemFieldDiscoverySvc.ProcessApplication(ctx, registry, opData.ApplicationID, opData.TenantID); err != nil {
+			return errors.Wrapf(err, "while processing application in registry %q", registry)
 		}
 	} else {
 		log.C(ctx).Infof("Operation with ID %q does not have an application ID or tenant ID defined in operation data", operation.ID)
@@ -17,6 +17,8 @@ func TestOperationProcessor_Process(t *testing.T) {
 	tenantID := "bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
 	testErr := errors.New("test error")
 
+	customRegistry := systemfielddiscoveryengine.SystemFieldDiscoveryRegistry("custom-registry")
+
 	opData := []byte(`
 			{
 				"applicationID": "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
@@ -33,7 +35,7 @@ func TestOperationProcessor_Process(t *testing.T) {
 			Name: "Success",
 			SystemFieldDiscoverySvcFn: func() *automock.SystemFieldDiscoveryService {
 				sfdSvc := &automock.SystemFieldDiscoveryService{}
-				sfdSvc.On("ProcessSaasRegistryApplication", mock.Anything, applicationID, tenantID).Return(nil).Once()
+				sfdSvc.On("ProcessApplication", mock.Anything, systemfielddiscoveryengine.SystemFieldDiscoverySaaSRegistry, applicationID, tenantID).Return(nil).Once()
 				return sfdSvc
 			},
 			Operation: &model.Operation{
@@ -41,6 +43,23 @@ func TestOperationProcessor_Process(t *testing.T) {
 				Data:   opData,
 			},
 		},
+		{
+			Name: "Success - registry from operation data",
+			SystemFieldDiscoverySvcFn: func() *automock.SystemFieldDiscoveryService {
+				sfdSvc := &automock.SystemFieldDiscoveryService{}
+				sfdSvc.On("ProcessApplication", mock.Anything, customRegistry, applicationID, tenantID).Return(nil).Once()
+				return sfdSvc
+			},
+			Operation: &model.Operation{
+				OpType: model.OperationTypeSaasRegistryDiscovery,
+				Data: []byte(`
+					{
+						"applicationID": "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+						"tenantID": "bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+						"registry": "custom-registry"
+					}`),
+			},
+		},
 		{
 			Name: "Success - empty application id",
 			SystemFieldDiscoverySvcFn: func() *automock.SystemFieldDiscoveryService {
@@ -70,10 +89,10 @@ func TestOperationProcessor_Process(t *testing.T) {
 			},
 		},
 		{
-			Name: "Error - ProcessSaasRegistryApplication returns error",
+			Name: "Error - ProcessApplication returns error",
 			SystemFieldDiscoverySvcFn: func() *automock.SystemFieldDiscoveryService {
 				sfdSvc := &automock.SystemFieldDiscoveryService{}
-				sfdSvc.On("ProcessSaasRegistryApplication", mock.Anything, applicationID, tenantID).Return(testErr).Once()
+				sfdSvc.On("ProcessApplication", mock.Anything, systemfielddiscoveryengine.SystemFieldDiscoverySaaSRegistry, applicationID, tenantID).Return(testErr).Once()
 				return sfdSvc
 			},
 			Operation: &model.Operation{
@@ -0,0 +1,29 @@
+package systemfielddiscoveryengine
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// DiscoveryRequest holds the data a registry needs in order to discover the system fields of an application
+type DiscoveryRequest struct {
+	Application *model.Application
+	Region      string
+	TenantID    string
+}
+
+// DiscoveredFields represents the system fields of an application found in a registry
+type DiscoveredFields struct {
+	BaseURL string
+	Labels  map[string]interface{}
+}
+
+// Registry is a source of system fields for applications
+//
+//go:generate mockery --name=Registry --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Registry interface {
+	Name() SystemFieldDiscoveryRegistry
+	// Discover returns the system fields of the application in the request or nil if the registry does not know about them
+	Discover(ctx context.Context, request DiscoveryRequest) (*DiscoveredFields, error)
+}
@@ -0,0 +1,77 @@
+package systemfielddiscoveryengine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/system-field-discovery-engine/config"
+	pkgAuth "github.com/kyma-incubator/compass/components/director/pkg/auth"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+
+	"github.com/pkg/errors"
+)
+
+// subscription represents subscription object in a saas-manager response payload.
+type subscription struct {
+	AppURL string `json:"url"`
+}
+
+// subscriptionsResponse represents collection of all subscription objects in a saas-manager response payload.
+type subscriptionsResponse struct {
+	Subscriptions []subscription `json:"subscriptions"`
+}
+
+type saasRegistry struct {
+	cfg    config.SystemFieldDiscoveryEngineConfig
+	client Client
+}
+
+// NewSaaSRegistry returns a registry which discovers the base URL of an application from its subscriptions in the regional saas registry
+func NewSaaSRegistry(cfg config.SystemFieldDiscoveryEngineConfig, client Client) Registry {
+	return &saasRegistry{
+		cfg:    cfg,
+		client: client,
+	}
+}
+
+// Name returns the name of the saas registry
+func (r *saasRegistry) Name() SystemFieldDiscoveryRegistry {
+	return SystemFieldDiscoverySaaSRegistry
+}
+
+// Discover returns the URL of the first subscription of the application which has one
+func (r *saasRegistry) Discover(ctx context.Context, request DiscoveryRequest) (*DiscoveredFields, error) {
+	appID := request.Application.ID
+	regionCfg, regionExists := r.cfg.RegionToSaasRegConfig[request.Region]
+	if !regionExists {
+		return nil, fmt.Errorf("region %q is not present into the saas reg configuration for application with id %q", request.Region, appID)
+	}
+
+	url := fmt.Sprintf("%s/saas-manager/v1/service/subscriptions?includeIndirectSubscriptions=true&tenantId=%s", regionCfg.SaasRegistryURL, request.TenantID)
+	ctx = pkgAuth.SaveToContext(ctx, &pkgAuth.OAuthCredentials{
+		ClientID:     regionCfg.ClientID,
+		ClientSecret: regionCfg.ClientSecret,
+		TokenURL:     regionCfg.TokenURL + r.cfg.OauthTokenPath,
+	})
+	respBody, err := executeCall(ctx, r.client, url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed executing request for url %q and appID %q", url, appID)
+	}
+
+	var response subscriptionsResponse
+	if err = json.Unmarshal(respBody, &response); err != nil {
+		log.C(ctx).Errorf(errors.Wrap(err, "failed to unmarshal subscriptions response").Error())
+		return nil, errors.Wrapf(err, "while unmarshaling subscription response")
+	}
+
+	for _, subscription := range response.Subscriptions {
+		if subscription.AppURL != "" {
+			log.C(ctx).Infof("Found app URL in the subscriptions for url %q and app with id %q", url, appID)
+			return &DiscoveredFields{BaseURL: subscription.AppURL}, nil
+		}
+	}
+
+	log.C(ctx).Infof("Response for url %q does not contain app URL", url)
+	return nil, nil
+}
@@ -2,15 +2,13 @@ package systemfielddiscoveryengine
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
+	"sort"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/system-field-discovery-engine/config"
-	pkgAuth "github.com/kyma-incubator/compass/components/director/pkg/auth"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
@@ -33,22 +31,13 @@ const (
 	SystemFieldDiscoverySaaSRegistry SystemFieldDiscoveryRegistry = "saas-registry"
 )
 
-// subscription represents subscription object in a saas-manager response payload.
-type subscription struct {
-	AppURL string `json:"url"`
-}
-
-// subscriptionsResponse represents collection of all subscription objects in a saas-manager response payload.
-type subscriptionsResponse struct {
-	Subscriptions []subscription `json:"subscriptions"`
-}
-
 // ApplicationService is responsible for the service-layer Application operations.
 //
 //go:generate mockery --name=ApplicationService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type ApplicationService interface {
 	UpdateBaseURLAndReadyState(ctx context.Context, appID, baseURL string, ready bool) error
 	Get(ctx context.Context, id string) (*model.Application, error)
+	SetLabel(ctx context.Context, label *model.LabelInput) error
 }
 
 // ApplicationTemplateService is responsible for the service-layer ApplicationTemplate operations.
@@ -82,9 +71,8 @@ type Client interface {
 
 // Service consists of various resource services responsible for service-layer system field discovery engine operations.
 type Service struct {
-	cfg      config.SystemFieldDiscoveryEngineConfig
-	client   Client
-	transact persistence.Transactioner
+	transact   persistence.Transactioner
+	registries map[SystemFieldDiscoveryRegistry]Registry
 
 	appSvc         ApplicationService
 	appTemplateSvc ApplicationTemplateService
@@ -92,66 +80,93 @@ type Service struct {
 }
 
 // NewSystemFieldDiscoverEngineService returns a new object responsible for service-layer system field discovery engine operations.
+// Besides the saas registry, a generic HTTP registry is set up for every HTTP registry in the configuration.
 func NewSystemFieldDiscoverEngineService(cfg SystemFieldDiscoveryEngineConfig, client Client, transact persistence.Transactioner, appSvc ApplicationService, appTemplateSvc ApplicationTemplateService, tenantSvc TenantService) (*Service, error) {
 	conf, err := cfg.PrepareConfiguration()
 	if err != nil {
 		return nil, errors.Wrap(err, "while preparing system field discovery engine configuration")
 	}
+
+	registries := map[SystemFieldDiscoveryRegistry]Registry{
+		SystemFieldDiscoverySaaSRegistry: NewSaaSRegistry(*conf, client),
+	}
+	for _, registryCfg := range conf.HTTPRegistries {
+		registry := NewHTTPRegistry(registryCfg, client)
+		if _, exists := registries[registry.Name()]; exists {
+			return nil, errors.Errorf("system field discovery registry %q is already defined", registry.Name())
+		}
+		registries[registry.Name()] = registry
+	}
+
 	return &Service{
-		cfg:            *conf,
 		transact:       transact,
-		client:         client,
+		registries:     registries,
 		appSvc:         appSvc,
 		appTemplateSvc: appTemplateSvc,
 		tenantSvc:      tenantSvc,
 	}, nil
 }
 
+// Registries returns the names of all registries the service can discover system fields from
+func (s *Service) Registries() []SystemFieldDiscoveryRegistry {
+	names := make([]SystemFieldDiscoveryRegistry, 0, len(s.registries))
+	for name := range s.registries {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+	return names
+}
+
 // ProcessSaasRegistryApplication triggers system field discovery engine for an app and tenant in saas registry
 func (s *Service) ProcessSaasRegistryApplication(ctx context.Context, appID, tenantID string) error {
+	return s.ProcessApplication(ctx, SystemFieldDiscoverySaaSRegistry, appID, tenantID)
+}
+
+// ProcessApplication triggers system field discovery engine for an app and tenant in the given registry
+func (s *Service) ProcessApplication(ctx context.Context, registryName SystemFieldDiscoveryRegistry, appID, tenantID string) error {
+	registry, ok := s.registries[registryName]
+	if !ok {
+		return errors.Errorf("system field discovery registry %q is not supported", registryName)
+	}
+
 	ctx, err := s.saveLowestOwnerForAppToContextInTx(ctx, appID)
 	if err != nil {
 		return err
 	}
 
-	region, err := s.getRegionLabelInTx(ctx, appID)
+	app, region, err := s.getApplicationAndRegionLabelInTx(ctx, appID)
 	if err != nil {
 		return errors.Wrapf(err, "retrieving label with key %q for application with id %q failed", regionLabelKey, appID)
 	}
 
-	if _, regionExists := s.cfg.RegionToSaasRegConfig[region]; !regionExists {
-		return fmt.Errorf("region %q is not present into the saas reg configuration for application with id %q", region, appID)
-	}
-
-	url := fmt.Sprintf("%s/saas-manager/v1/service/subscriptions?includeIndirectSubscriptions=true&tenantId=%s", s.cfg.RegionToSaasRegConfig[region].SaasRegistryURL, tenantID)
-	ctx = s.saveCredentialsToContext(ctx, region)
-	respBody, err := executeCall(ctx, s.client, url)
+	fields, err := registry.Discover(ctx, DiscoveryRequest{
+		Application: app,
+		Region:      region,
+		TenantID:    tenantID,
+	})
 	if err != nil {
-		return errors.Wrapf(err, "failed executing request for url %q and appID %q", url, appID)
+		return errors.Wrapf(err, "while discovering system fields in registry %q for application with id %q", registryName, appID)
 	}
-	var response subscriptionsResponse
-	if err = json.Unmarshal(respBody, &response); err != nil {
-		log.C(ctx).Errorf(errors.Wrap(err, "failed to unmarshal subscriptions response").Error())
-		return errors.Wrapf(err, "while unmarshaling subscription response")
+
+	if fields == nil {
+		log.C(ctx).Infof("Registry %q does not contain system fields for application with id %q", registryName, appID)
+		return nil
 	}
-	for _, subscription := range response.Subscriptions {
-		processed, err := s.processSubscription(ctx, subscription, url, appID)
-		if err != nil {
-			return errors.Wrapf(err, "failed processing subscription for url %q and app with id %q", url, appID)
-		}
-		if processed {
-			log.C(ctx).Infof("Successfully processed url %q for app with id %q", url, appID)
-			break
-		}
-		log.C(ctx).Infof("Response for url %q and does not contain app URL", url)
+
+	if err := s.applyDiscoveredFieldsInTx(ctx, appID, fields); err != nil {
+		return errors.Wrapf(err, "failed processing system fields from registry %q for app with id %q", registryName, appID)
 	}
+
+	log.C(ctx).Infof("Successfully processed system fields from registry %q for app with id %q", registryName, appID)
 	return nil
 }
 
-func (s *Service) getRegionLabelInTx(ctx context.Context, appID string) (string, error) {
+func (s *Service) getApplicationAndRegionLabelInTx(ctx context.Context, appID string) (*model.Application, string, error) {
 	tx, err := s.transact.Begin()
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 	defer s.transact.RollbackUnlessCommitted(ctx, tx)
 
@@ -160,27 +175,27 @@ func (s *Service) getRegionLabelInTx(ctx context.Context, appID string) (string,
 	app, err := s.appSvc.Get(ctx, appID)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("error while getting applicationw with id %q", appID)
-		return "", err
+		return nil, "", err
 	}
 
 	if app.ApplicationTemplateID == nil {
-		return "", errors.Errorf("application with id %s does not have application template id", app.ID)
+		return nil, "", errors.Errorf("application with id %s does not have application template id", app.ID)
 	}
 	label, err := s.appTemplateSvc.GetLabel(ctx, *app.ApplicationTemplateID, regionLabelKey)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("error while getting label with key %q for applicationTemplate with ID %q", regionLabelKey, *app.ApplicationTemplateID)
-		return "", err
+		return nil, "", err
 	}
 	regionValue, ok := label.Value.(string)
 	if !ok {
-		return "", errors.Errorf("%q label for applicationTemplate with ID %q is not a string", regionLabelKey, *app.ApplicationTemplateID)
+		return nil, "", errors.Errorf("%q label for applicationTemplate with ID %q is not a string", regionLabelKey, *app.ApplicationTemplateID)
 	}
 
 	if err := tx.Commit(); err != nil {
-		return "", err
+		return nil, "", err
 	}
 
-	return regionValue, nil
+	return app, regionValue, nil
 }
 
 func (s *Service) saveLowestOwnerForAppToContextInTx(ctx context.Context, appID string) (context.Context, error) {
@@ -215,33 +230,38 @@ func (s *Service) saveLowestOwnerForAppToContext(ctx context.Context, appID stri
 	return ctx, nil
 }
 
-func (s *Service) saveCredentialsToContext(ctx context.Context, region string) context.Context {
-	credentials := &pkgAuth.OAuthCredentials{
-		ClientID:     s.cfg.RegionToSaasRegConfig[region].ClientID,
-		ClientSecret: s.cfg.RegionToSaasRegConfig[region].ClientSecret,
-		TokenURL:     s.cfg.RegionToSaasRegConfig[region].TokenURL + s.cfg.OauthTokenPath,
-	}
-	ctx = pkgAuth.SaveToContext(ctx, credentials)
-	return ctx
-}
-
-func (s *Service) processSubscription(ctx context.Context, subscription subscription, url, appID string) (bool, error) {
-	if subscription.AppURL == "" {
-		return false, nil
-	}
-
+func (s *Service) applyDiscoveredFieldsInTx(ctx context.Context, appID string, fields *DiscoveredFields) error {
 	tx, err := s.transact.Begin()
 	if err != nil {
-		return false, errors.Wrapf(err, "failed to begin a transaction for processing subscriptions for url %q and app with id %q", url, appID)
+		return errors.Wrapf(err, "failed to begin a transaction for applying system fields for app with id %q", appID)
 	}
 	defer s.transact.RollbackUnlessCommitted(ctx, tx)
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	if err := s.appSvc.UpdateBaseURLAndReadyState(ctx, appID, subscription.AppURL, true); err != nil {
-		return false, errors.Wrapf(err, "failed to update base url and ready state for app with id %q", appID)
+	if fields.BaseURL != "" {
+		if err := s.appSvc.UpdateBaseURLAndReadyState(ctx, appID, fields.BaseURL, true); err != nil {
+			return errors.Wrapf(err, "failed to update base url and ready state for app with id %q", appID)
+		}
+	}
+
+	labelKeys := make([]string, 0, len(fields.Labels))
+	for key := range fields.Labels {
+		labelKeys = append(labelKeys, key)
+	}
+	sort.Strings(labelKeys)
+
+	for _, key := range labelKeys {
+		if err := s.appSvc.SetLabel(ctx, &model.LabelInput{
+			Key:        key,
+			Value:      fields.Labels[key],
+			ObjectID:   appID,
+			ObjectType: model.ApplicationLabelableObject,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to set label with key %q for app with id %q", key, appID)
+		}
 	}
 
-	return true, tx.Commit()
+	return tx.Commit()
 }
 
 func executeCall(ctx context.Context, client Client, url string) ([]byte, error) {
@@ -518,3 +518,171 @@ func TestService_ProcessSaasRegistryApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestService_ProcessApplication(t *testing.T) {
+	applicationID := "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	applicationTemplateID := "application-template-id"
+	tenantID := "bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	internalTenantID := "internal-tenant-id"
+	customRegistry := systemfielddiscoveryengine.SystemFieldDiscoveryRegistry("custom-registry")
+	systemsResponse := `{"systems":[{"url":"app-url.com","id":"system-id"}]}`
+
+	regionLabelKey := "region"
+	regionLabelValue := "eu1"
+	regionLabel := &model.Label{
+		Key:   regionLabelKey,
+		Value: regionLabelValue,
+	}
+
+	tnt := &model.BusinessTenantMapping{
+		ID:             internalTenantID,
+		ExternalTenant: "external-tenant-id",
+	}
+
+	app := &model.Application{
+		BaseEntity: &model.BaseEntity{
+			ID: applicationID,
+		},
+		ApplicationTemplateID: &applicationTemplateID,
+	}
+
+	sfdCfg := &config.SystemFieldDiscoveryEngineConfig{
+		RegionToSaasRegConfig: map[string]config.SaasRegConfig{regionLabelValue: {}},
+		HTTPRegistries: []config.HTTPRegistryConfig{
+			{
+				Name:        customRegistry.ToString(),
+				URLTemplate: "https://registry.{{ .Region }}.com/systems?tenant={{ .TenantID }}",
+				ItemsPath:   "systems",
+				BaseURLPath: "url",
+				LabelPaths:  map[string]string{"systemId": "id"},
+			},
+		},
+	}
+
+	expectedLabelInput := &model.LabelInput{
+		Key:        "systemId",
+		Value:      "system-id",
+		ObjectID:   applicationID,
+		ObjectType: model.ApplicationLabelableObject,
+	}
+
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name                string
+		Registry            systemfielddiscoveryengine.SystemFieldDiscoveryRegistry
+		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ApplicationSvcFn    func() *automock.ApplicationService
+		HTTPClientFn        func() *automock.Client
+		ExpectedErrorOutput string
+	}{
+		{
+			Name:     "Success - base url and labels are updated",
+			Registry: customRegistry,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			ApplicationSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", mock.Anything, applicationID).Return(app, nil).Once()
+				appSvc.On("UpdateBaseURLAndReadyState", mock.Anything, applicationID, "app-url.com", true).Return(nil).Once()
+				appSvc.On("SetLabel", mock.Anything, expectedLabelInput).Return(nil).Once()
+				return appSvc
+			},
+			HTTPClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+					return req.URL.String() == "https://registry.eu1.com/systems?tenant="+tenantID
+				})).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(systemsResponse))),
+				}, nil).Once()
+				return client
+			},
+		},
+		{
+			Name:     "Error - SetLabel returns error",
+			Registry: customRegistry,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(2)
+			},
+			ApplicationSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", mock.Anything, applicationID).Return(app, nil).Once()
+				appSvc.On("UpdateBaseURLAndReadyState", mock.Anything, applicationID, "app-url.com", true).Return(nil).Once()
+				appSvc.On("SetLabel", mock.Anything, expectedLabelInput).Return(testErr).Once()
+				return appSvc
+			},
+			HTTPClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.Anything).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(systemsResponse))),
+				}, nil).Once()
+				return client
+			},
+			ExpectedErrorOutput: testErr.Error(),
+		},
+		{
+			Name:     "Error - registry is not supported",
+			Registry: "unknown-registry",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatDoesntStartTransaction()
+			},
+			ApplicationSvcFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			HTTPClientFn: func() *automock.Client {
+				return &automock.Client{}
+			},
+			ExpectedErrorOutput: "system field discovery registry \"unknown-registry\" is not supported",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, tx := testCase.TransactionerFn()
+			appSvc := testCase.ApplicationSvcFn()
+			client := testCase.HTTPClientFn()
+
+			appTemplateSvc := &automock.ApplicationTemplateService{}
+			tenantSvc := &automock.TenantService{}
+			if testCase.Registry == customRegistry {
+				appTemplateSvc.On("GetLabel", mock.Anything, applicationTemplateID, regionLabelKey).Return(regionLabel, nil).Once()
+				tenantSvc.On("GetLowestOwnerForResource", mock.Anything, resource.Application, applicationID).Return(internalTenantID, nil).Once()
+				tenantSvc.On("GetTenantByID", mock.Anything, internalTenantID).Return(tnt, nil).Once()
+			}
+
+			sfdConf := &automock.SystemFieldDiscoveryEngineConfig{}
+			sfdConf.On("PrepareConfiguration").Return(sfdCfg, nil).Once()
+			defer mock.AssertExpectationsForObjects(t, persist, tx, appSvc, appTemplateSvc, tenantSvc, sfdConf, client)
+
+			svc, err := systemfielddiscoveryengine.NewSystemFieldDiscoverEngineService(sfdConf, client, tx, appSvc, appTemplateSvc, tenantSvc)
+			assert.NoError(t, err)
+			assert.Equal(t, []systemfielddiscoveryengine.SystemFieldDiscoveryRegistry{customRegistry, systemfielddiscoveryengine.SystemFieldDiscoverySaaSRegistry}, svc.Registries())
+
+			// WHEN
+			err = svc.ProcessApplication(context.TODO(), testCase.Registry, applicationID, tenantID)
+
+			// THEN
+			if len(testCase.ExpectedErrorOutput) > 0 {
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorOutput)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewSystemFieldDiscoverEngineService_DuplicatedRegistry(t *testing.T) {
+	sfdConf := &automock.SystemFieldDiscoveryEngineConfig{}
+	sfdConf.On("PrepareConfiguration").Return(&config.SystemFieldDiscoveryEngineConfig{
+		HTTPRegistries: []config.HTTPRegistryConfig{{Name: systemfielddiscoveryengine.SystemFieldDiscoverySaaSRegistry.ToString()}},
+	}, nil).Once()
+	defer mock.AssertExpectationsForObjects(t, sfdConf)
+
+	_, err := systemfielddiscoveryengine.NewSystemFieldDiscoverEngineService(sfdConf, nil, nil, nil, nil, nil)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "system field discovery registry \"saas-registry\" is already defined")
+}
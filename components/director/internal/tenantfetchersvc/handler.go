@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/internal/tenantfetchersvc/resync"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/gorilla/mux"
@@ -37,6 +38,20 @@ type TenantSubscriber interface {
 	Unsubscribe(ctx context.Context, tenantSubscriptionRequest *TenantSubscriptionRequest) error
 }
 
+// TenantEventsProcessor is used to process tenant events pushed by external systems and to replay already consumed tenant events;
+//
+//go:generate mockery --name=TenantEventsProcessor --output=automock --outpkg=automock --case=underscore --disable-version-string
+type TenantEventsProcessor interface {
+	Name() string
+	ProcessTenantEvent(ctx context.Context, event resync.TenantEvent) error
+	ReplayFrom(ctx context.Context, from time.Time) error
+}
+
+// TenantEventsReplayRequest is the payload of a request for replaying the tenant events of a tenant fetcher job
+type TenantEventsReplayRequest struct {
+	From time.Time `json:"from"`
+}
+
 // HandlerConfig is the configuration required by the tenant handler.
 // It includes configurable parameters for incoming requests, including different tenant IDs json properties, and path parameters.
 type HandlerConfig struct {
@@ -44,9 +59,12 @@ type HandlerConfig struct {
 	TenantWithoutParentOnDemandHandlerEndpoint string `envconfig:"APP_TENANT_WITHOUT_PARENT_ON_DEMAND_HANDLER_ENDPOINT,default=/v1/fetch/{tenantId}"`
 	RegionalHandlerEndpoint                    string `envconfig:"APP_REGIONAL_HANDLER_ENDPOINT,default=/v1/regional/{region}/callback/{tenantId}"`
 	DependenciesEndpoint                       string `envconfig:"APP_REGIONAL_DEPENDENCIES_ENDPOINT,default=/v1/regional/{region}/dependencies"`
+	TenantEventsEndpoint                       string `envconfig:"APP_TENANT_EVENTS_ENDPOINT,default=/v1/events/{job}"`
+	TenantEventsReplayEndpoint                 string `envconfig:"APP_TENANT_EVENTS_REPLAY_ENDPOINT,default=/v1/admin/jobs/{job}/replay"`
 	TenantPathParam                            string `envconfig:"APP_TENANT_PATH_PARAM,default=tenantId"`
 	ParentTenantPathParam                      string `envconfig:"APP_PARENT_TENANT_PATH_PARAM,default=parentTenantId"`
 	RegionPathParam                            string `envconfig:"APP_REGION_PATH_PARAM,default=region"`
+	JobPathParam                               string `envconfig:"APP_JOB_PATH_PARAM,default=job"`
 	XsAppNamePathParam                         string `envconfig:"APP_TENANT_FETCHER_XSAPPNAME_PATH,default=xsappname"`
 	OmitDependenciesCallbackParam              string `envconfig:"APP_TENANT_FETCHER_OMIT_PARAM_NAME"`
 	OmitDependenciesCallbackParamValue         string `envconfig:"APP_TENANT_FETCHER_OMIT_PARAM_VALUE"`
@@ -85,9 +103,10 @@ type Dependency struct {
 }
 
 type handler struct {
-	fetcher    TenantFetcher
-	subscriber TenantSubscriber
-	config     HandlerConfig
+	fetcher         TenantFetcher
+	subscriber      TenantSubscriber
+	eventProcessors map[string]TenantEventsProcessor
+	config          HandlerConfig
 }
 
 // NewTenantsHTTPHandler returns a new HTTP handler, responsible for creation and deletion of regional and non-regional tenants.
@@ -106,6 +125,19 @@ func NewTenantFetcherHTTPHandler(fetcher TenantFetcher, config HandlerConfig) *h
 	}
 }
 
+// NewTenantEventsHTTPHandler returns a new HTTP handler, responsible for ingestion and replay of tenant events for the given tenant fetcher jobs.
+func NewTenantEventsHTTPHandler(processors []TenantEventsProcessor, config HandlerConfig) *handler {
+	eventProcessors := make(map[string]TenantEventsProcessor, len(processors))
+	for _, processor := range processors {
+		eventProcessors[processor.Name()] = processor
+	}
+
+	return &handler{
+		eventProcessors: eventProcessors,
+		config:          config,
+	}
+}
+
 // FetchTenantOnDemand fetches External tenants registry events for a provided subaccount and creates a subaccount tenant
 func (h *handler) FetchTenantOnDemand(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
@@ -200,6 +232,89 @@ func (h *handler) Dependencies(writer http.ResponseWriter, request *http.Request
 	}
 }
 
+// IngestTenantEvent processes a tenant event in the CloudEvents structured JSON format pushed for a tenant fetcher job
+func (h *handler) IngestTenantEvent(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	processor, ok := h.eventProcessor(writer, request)
+	if !ok {
+		return
+	}
+
+	var event resync.TenantEvent
+	if err := json.NewDecoder(request.Body).Decode(&event); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to decode tenant event from request body: %v", err)
+		http.Error(writer, "Failed to decode tenant event from request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := processor.ProcessTenantEvent(ctx, event); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to process tenant event with ID %s by tenant fetcher job %s: %v", event.ID, processor.Name(), err)
+		respondWithTenantEventsError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// ReplayTenantEvents requests the tenant events of a tenant fetcher job since the given time to be consumed again by its next resync
+func (h *handler) ReplayTenantEvents(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	processor, ok := h.eventProcessor(writer, request)
+	if !ok {
+		return
+	}
+
+	var replayRequest TenantEventsReplayRequest
+	if err := json.NewDecoder(request.Body).Decode(&replayRequest); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to decode replay request from request body: %v", err)
+		http.Error(writer, "Failed to decode replay request from request body", http.StatusBadRequest)
+		return
+	}
+
+	if replayRequest.From.IsZero() {
+		http.Error(writer, "Replay timestamp is missing from request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := processor.ReplayFrom(ctx, replayRequest.From); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to replay tenant events of tenant fetcher job %s: %v", processor.Name(), err)
+		respondWithTenantEventsError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusAccepted)
+}
+
+func (h *handler) eventProcessor(writer http.ResponseWriter, request *http.Request) (TenantEventsProcessor, bool) {
+	ctx := request.Context()
+
+	jobName, ok := mux.Vars(request)[h.config.JobPathParam]
+	if !ok || len(jobName) == 0 {
+		log.C(ctx).Error("Job path parameter is missing from request")
+		http.Error(writer, "Job path parameter is missing from request", http.StatusBadRequest)
+		return nil, false
+	}
+
+	processor, ok := h.eventProcessors[jobName]
+	if !ok {
+		log.C(ctx).Errorf("Tenant fetcher job %s not found", jobName)
+		http.Error(writer, fmt.Sprintf("Tenant fetcher job %s not found", jobName), http.StatusNotFound)
+		return nil, false
+	}
+
+	return processor, true
+}
+
+func respondWithTenantEventsError(writer http.ResponseWriter, err error) {
+	if apperrors.ErrorCode(err) == apperrors.InvalidData {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	http.Error(writer, InternalServerError, http.StatusInternalServerError)
+}
+
 func (h *handler) applySubscriptionChange(writer http.ResponseWriter, request *http.Request, subscriptionFunc subscriptionFunc) {
 	ctx := request.Context()
 
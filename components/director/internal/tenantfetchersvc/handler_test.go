@@ -8,12 +8,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/director/internal/tenantfetchersvc"
 	"github.com/kyma-incubator/compass/components/director/internal/tenantfetchersvc/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/tenantfetchersvc/resync"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -580,3 +582,260 @@ func TestService_FetchTenantOnDemand(t *testing.T) {
 		})
 	}
 }
+
+func TestService_IngestTenantEvent(t *testing.T) {
+	const (
+		jobName  = "subaccount-fetcher"
+		eventID  = "2f3dc9ae-66b9-4dbb-8cd0-5b5da0e3f5f1"
+		tenantID = "f09ba084-0e82-49ab-ab2e-b7ecc988312d"
+	)
+
+	target := "/v1/events/:job"
+
+	validHandlerConfig := tenantfetchersvc.HandlerConfig{
+		JobPathParam: "job",
+	}
+
+	event := resync.TenantEvent{
+		SpecVersion: resync.CloudEventsSpecVersion,
+		ID:          eventID,
+		Source:      "/external-registry",
+		Type:        resync.TenantCreatedEventType,
+		Data:        json.RawMessage(fmt.Sprintf(`{"id":"%s"}`, tenantID)),
+	}
+	eventBody, err := json.Marshal(event)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		Name                string
+		Body                []byte
+		PathParams          map[string]string
+		ProcessorFn         func() *automock.TenantEventsProcessor
+		ExpectedErrorOutput string
+		ExpectedStatusCode  int
+	}{
+		{
+			Name:       "Successful event ingestion",
+			Body:       eventBody,
+			PathParams: map[string]string{validHandlerConfig.JobPathParam: jobName},
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				processor.On("ProcessTenantEvent", mock.Anything, event).Return(nil).Once()
+				return processor
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name:       "Failure when job path parameter is missing",
+			Body:       eventBody,
+			PathParams: map[string]string{},
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				return processor
+			},
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "Job path parameter is missing from request",
+		},
+		{
+			Name:       "Failure when job does not exist",
+			Body:       eventBody,
+			PathParams: map[string]string{validHandlerConfig.JobPathParam: "unknown-job"},
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				return processor
+			},
+			ExpectedStatusCode:  http.StatusNotFound,
+			ExpectedErrorOutput: "Tenant fetcher job unknown-job not found",
+		},
+		{
+			Name:       "Failure when body is not a valid event",
+			Body:       []byte("not-an-event"),
+			PathParams: map[string]string{validHandlerConfig.JobPathParam: jobName},
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				return processor
+			},
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "Failed to decode tenant event from request body",
+		},
+		{
+			Name:       "Failure when event is invalid",
+			Body:       eventBody,
+			PathParams: map[string]string{validHandlerConfig.JobPathParam: jobName},
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				processor.On("ProcessTenantEvent", mock.Anything, event).Return(apperrors.NewInvalidDataError("unsupported event type")).Once()
+				return processor
+			},
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "unsupported event type",
+		},
+		{
+			Name:       "Failure when event processing fails",
+			Body:       eventBody,
+			PathParams: map[string]string{validHandlerConfig.JobPathParam: jobName},
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				processor.On("ProcessTenantEvent", mock.Anything, event).Return(errors.New("error")).Once()
+				return processor
+			},
+			ExpectedStatusCode:  http.StatusInternalServerError,
+			ExpectedErrorOutput: tenantfetchersvc.InternalServerError,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			processor := testCase.ProcessorFn()
+			defer mock.AssertExpectationsForObjects(t, processor)
+
+			handler := tenantfetchersvc.NewTenantEventsHTTPHandler([]tenantfetchersvc.TenantEventsProcessor{processor}, validHandlerConfig)
+			req := httptest.NewRequest(http.MethodPost, target, bytes.NewBuffer(testCase.Body))
+			req = mux.SetURLVars(req, testCase.PathParams)
+
+			w := httptest.NewRecorder()
+
+			// WHEN
+			handler.IngestTenantEvent(w, req)
+
+			// THEN
+			resp := w.Result()
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+
+			if len(testCase.ExpectedErrorOutput) > 0 {
+				assert.Contains(t, string(body), testCase.ExpectedErrorOutput)
+			}
+
+			assert.Equal(t, testCase.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestService_ReplayTenantEvents(t *testing.T) {
+	const jobName = "subaccount-fetcher"
+
+	target := "/v1/admin/jobs/:job/replay"
+
+	validHandlerConfig := tenantfetchersvc.HandlerConfig{
+		JobPathParam: "job",
+	}
+
+	from := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+	pathParams := map[string]string{validHandlerConfig.JobPathParam: jobName}
+
+	testCases := []struct {
+		Name                string
+		Body                string
+		PathParams          map[string]string
+		ProcessorFn         func() *automock.TenantEventsProcessor
+		ExpectedErrorOutput string
+		ExpectedStatusCode  int
+	}{
+		{
+			Name:       "Successful replay",
+			Body:       `{"from":"2024-08-01T10:00:00Z"}`,
+			PathParams: pathParams,
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				processor.On("ReplayFrom", mock.Anything, from).Return(nil).Once()
+				return processor
+			},
+			ExpectedStatusCode: http.StatusAccepted,
+		},
+		{
+			Name:       "Failure when job does not exist",
+			Body:       `{"from":"2024-08-01T10:00:00Z"}`,
+			PathParams: map[string]string{validHandlerConfig.JobPathParam: "unknown-job"},
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				return processor
+			},
+			ExpectedStatusCode:  http.StatusNotFound,
+			ExpectedErrorOutput: "Tenant fetcher job unknown-job not found",
+		},
+		{
+			Name:       "Failure when timestamp is not in RFC3339 format",
+			Body:       `{"from":"yesterday"}`,
+			PathParams: pathParams,
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				return processor
+			},
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "Failed to decode replay request from request body",
+		},
+		{
+			Name:       "Failure when timestamp is missing",
+			Body:       `{}`,
+			PathParams: pathParams,
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				return processor
+			},
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "Replay timestamp is missing from request body",
+		},
+		{
+			Name:       "Failure when timestamp is in the future",
+			Body:       `{"from":"2024-08-01T10:00:00Z"}`,
+			PathParams: pathParams,
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				processor.On("ReplayFrom", mock.Anything, from).Return(apperrors.NewInvalidDataError("replay timestamp is in the future")).Once()
+				return processor
+			},
+			ExpectedStatusCode:  http.StatusBadRequest,
+			ExpectedErrorOutput: "replay timestamp is in the future",
+		},
+		{
+			Name:       "Failure when replay fails",
+			Body:       `{"from":"2024-08-01T10:00:00Z"}`,
+			PathParams: pathParams,
+			ProcessorFn: func() *automock.TenantEventsProcessor {
+				processor := &automock.TenantEventsProcessor{}
+				processor.On("Name").Return(jobName)
+				processor.On("ReplayFrom", mock.Anything, from).Return(errors.New("error")).Once()
+				return processor
+			},
+			ExpectedStatusCode:  http.StatusInternalServerError,
+			ExpectedErrorOutput: tenantfetchersvc.InternalServerError,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			processor := testCase.ProcessorFn()
+			defer mock.AssertExpectationsForObjects(t, processor)
+
+			handler := tenantfetchersvc.NewTenantEventsHTTPHandler([]tenantfetchersvc.TenantEventsProcessor{processor}, validHandlerConfig)
+			req := httptest.NewRequest(http.MethodPost, target, bytes.NewBufferString(testCase.Body))
+			req = mux.SetURLVars(req, testCase.PathParams)
+
+			w := httptest.NewRecorder()
+
+			// WHEN
+			handler.ReplayTenantEvents(w, req)
+
+			// THEN
+			resp := w.Result()
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+
+			if len(testCase.ExpectedErrorOutput) > 0 {
+				assert.Contains(t, string(body), testCase.ExpectedErrorOutput)
+			}
+
+			assert.Equal(t, testCase.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
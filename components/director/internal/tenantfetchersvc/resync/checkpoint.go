@@ -0,0 +1,258 @@
+package resync
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	checkpointsTable = "public.tenant_fetcher_checkpoints"
+	jobNameColumn    = "job_name"
+
+	// initialTimestamp is the timestamp from which tenant events are consumed when a job has no checkpoint yet
+	initialTimestamp = "1"
+
+	// requestReplayQuery stores the earliest of the requested replays of a job. The replay is consumed by the next resync of the job,
+	// regardless of which instance received the request.
+	requestReplayQuery = `INSERT INTO public.tenant_fetcher_checkpoints ( job_name, last_consumed_tenant_timestamp, last_full_resync_timestamp, replay_from, updated_at )
+		VALUES ( :job_name, :last_consumed_tenant_timestamp, :last_full_resync_timestamp, :replay_from, :updated_at )
+		ON CONFLICT ( job_name ) DO UPDATE SET replay_from = LEAST(tenant_fetcher_checkpoints.replay_from, EXCLUDED.replay_from), updated_at = EXCLUDED.updated_at`
+	// completeReplayQuery marks the requested replay of a job as done, unless an earlier replay was requested in the meantime
+	completeReplayQuery = `UPDATE public.tenant_fetcher_checkpoints SET replay_from = NULL WHERE job_name = $1 AND replay_from >= $2`
+)
+
+var (
+	checkpointColumns       = []string{"job_name", "last_consumed_tenant_timestamp", "last_full_resync_timestamp", "replay_from", "updated_at"}
+	checkpointUpdateColumns = []string{"last_consumed_tenant_timestamp", "last_full_resync_timestamp", "updated_at"}
+)
+
+// Checkpoint represents the unix timestamps in milliseconds up to which a tenant fetcher job has consumed tenant events.
+// ReplayFrom is set when the tenant events since then have to be consumed again by the next resync of the job.
+type Checkpoint struct {
+	JobName                     string        `db:"job_name"`
+	LastConsumedTenantTimestamp int64         `db:"last_consumed_tenant_timestamp"`
+	LastFullResyncTimestamp     int64         `db:"last_full_resync_timestamp"`
+	ReplayFrom                  sql.NullInt64 `db:"replay_from"`
+	UpdatedAt                   time.Time     `db:"updated_at"`
+}
+
+// CheckpointRepository persists the checkpoints of the tenant fetcher jobs
+//
+//go:generate mockery --name=CheckpointRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CheckpointRepository interface {
+	GetByJobName(ctx context.Context, jobName string) (*Checkpoint, error)
+	Upsert(ctx context.Context, checkpoint *Checkpoint) error
+	RequestReplay(ctx context.Context, checkpoint *Checkpoint) error
+	CompleteReplay(ctx context.Context, jobName string, consumedFrom int64) error
+}
+
+// CheckpointStore provides the timestamps of the last consumed tenant event and of the last full resync of a tenant fetcher job
+// and keeps the requests for replaying its tenant events
+//
+//go:generate mockery --name=CheckpointStore --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CheckpointStore interface {
+	GetCheckpoint(ctx context.Context) (string, string, error)
+	UpdateCheckpoint(ctx context.Context, consumedFromTimestamp, lastConsumedTenantTimestamp, lastFullResyncTimestamp string) error
+	RequestReplay(ctx context.Context, fromTimestamp string) error
+}
+
+type checkpointRepository struct {
+	singleGetter repo.SingleGetterGlobal
+	upserter     repo.UpserterGlobal
+}
+
+// NewCheckpointRepository returns a new repository for the tenant fetcher checkpoints
+func NewCheckpointRepository() CheckpointRepository {
+	return &checkpointRepository{
+		singleGetter: repo.NewSingleGetterGlobal(resource.TenantFetcherCheckpoint, checkpointsTable, checkpointColumns),
+		upserter:     repo.NewUpserterGlobal(resource.TenantFetcherCheckpoint, checkpointsTable, checkpointColumns, []string{jobNameColumn}, checkpointUpdateColumns),
+	}
+}
+
+// GetByJobName returns the checkpoint of the job with the given name
+func (r *checkpointRepository) GetByJobName(ctx context.Context, jobName string) (*Checkpoint, error) {
+	var checkpoint Checkpoint
+	if err := r.singleGetter.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition(jobNameColumn, jobName)}, repo.NoOrderBy, &checkpoint); err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
+// Upsert creates the checkpoint of a job or updates it if it already exists. A requested replay of the job is not changed.
+func (r *checkpointRepository) Upsert(ctx context.Context, checkpoint *Checkpoint) error {
+	return r.upserter.UpsertGlobal(ctx, checkpoint)
+}
+
+// RequestReplay stores the replay of the given checkpoint. If the job already has a checkpoint, only its replay is updated,
+// and an earlier requested replay is kept.
+func (r *checkpointRepository) RequestReplay(ctx context.Context, checkpoint *Checkpoint) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", requestReplayQuery)
+	_, err = persist.NamedExecContext(ctx, requestReplayQuery, checkpoint)
+	return persistence.MapSQLError(ctx, err, resource.TenantFetcherCheckpoint, resource.Upsert, "while requesting replay for job %s", checkpoint.JobName)
+}
+
+// CompleteReplay clears the requested replay of a job if the tenant events since then were consumed by a resync which started consuming them from consumedFrom
+func (r *checkpointRepository) CompleteReplay(ctx context.Context, jobName string, consumedFrom int64) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", completeReplayQuery)
+	_, err = persist.ExecContext(ctx, completeReplayQuery, jobName, consumedFrom)
+	return persistence.MapSQLError(ctx, err, resource.TenantFetcherCheckpoint, resource.Update, "while completing replay for job %s", jobName)
+}
+
+type dbCheckpointStore struct {
+	jobName      string
+	transact     persistence.Transactioner
+	repo         CheckpointRepository
+	legacyClient KubeClient
+}
+
+// NewDBCheckpointStore returns a checkpoint store which keeps the checkpoint of the given job in the database.
+// Until the job stores its first checkpoint, the one from the legacy tenant fetcher ConfigMap is used, if a client for it is provided.
+func NewDBCheckpointStore(jobName string, transact persistence.Transactioner, repo CheckpointRepository, legacyClient KubeClient) CheckpointStore {
+	return &dbCheckpointStore{
+		jobName:      jobName,
+		transact:     transact,
+		repo:         repo,
+		legacyClient: legacyClient,
+	}
+}
+
+// GetCheckpoint returns the timestamps of the last consumed tenant event and of the last full resync of the job.
+// If a replay of the job was requested, the timestamp from which the tenant events have to be replayed is returned as the last consumed one.
+func (s *dbCheckpointStore) GetCheckpoint(ctx context.Context) (string, string, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return "", "", err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	checkpoint, err := s.repo.GetByJobName(ctx, s.jobName)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return "", "", errors.Wrapf(err, "while getting checkpoint of job %s", s.jobName)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", "", err
+	}
+
+	if checkpoint != nil {
+		lastConsumed := checkpoint.LastConsumedTenantTimestamp
+		if checkpoint.ReplayFrom.Valid && checkpoint.ReplayFrom.Int64 < lastConsumed {
+			log.C(ctx).Infof("Tenant events of job %s will be replayed from %d", s.jobName, checkpoint.ReplayFrom.Int64)
+			lastConsumed = checkpoint.ReplayFrom.Int64
+		}
+		return strconv.FormatInt(lastConsumed, 10), strconv.FormatInt(checkpoint.LastFullResyncTimestamp, 10), nil
+	}
+
+	if s.legacyClient == nil {
+		log.C(ctx).Infof("No checkpoint found for job %s, tenant events will be consumed from the beginning", s.jobName)
+		return initialTimestamp, initialTimestamp, nil
+	}
+
+	log.C(ctx).Infof("No checkpoint found for job %s, using the one from the tenant fetcher ConfigMap", s.jobName)
+	return s.legacyClient.GetTenantFetcherConfigMapData(ctx)
+}
+
+// UpdateCheckpoint stores the timestamps of the last consumed tenant event and of the last full resync of the job.
+// The requested replay of the job is completed if it is covered by the tenant events consumed since consumedFromTimestamp.
+func (s *dbCheckpointStore) UpdateCheckpoint(ctx context.Context, consumedFromTimestamp, lastConsumedTenantTimestamp, lastFullResyncTimestamp string) error {
+	consumedFrom, err := strconv.ParseInt(consumedFromTimestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing consumed from timestamp %q", consumedFromTimestamp)
+	}
+
+	lastConsumed, err := strconv.ParseInt(lastConsumedTenantTimestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing last consumed tenant timestamp %q", lastConsumedTenantTimestamp)
+	}
+
+	lastFullResync, err := strconv.ParseInt(lastFullResyncTimestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing last full resync timestamp %q", lastFullResyncTimestamp)
+	}
+
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.repo.Upsert(ctx, &Checkpoint{
+		JobName:                     s.jobName,
+		LastConsumedTenantTimestamp: lastConsumed,
+		LastFullResyncTimestamp:     lastFullResync,
+		UpdatedAt:                   time.Now().UTC(),
+	}); err != nil {
+		return errors.Wrapf(err, "while storing checkpoint of job %s", s.jobName)
+	}
+
+	if err := s.repo.CompleteReplay(ctx, s.jobName, consumedFrom); err != nil {
+		return errors.Wrapf(err, "while completing replay of job %s", s.jobName)
+	}
+
+	return tx.Commit()
+}
+
+// RequestReplay stores a request for replaying the tenant events of the job since the given timestamp. The replay is done by the next resync of the job.
+func (s *dbCheckpointStore) RequestReplay(ctx context.Context, fromTimestamp string) error {
+	from, err := strconv.ParseInt(fromTimestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing replay timestamp %q", fromTimestamp)
+	}
+
+	// The current checkpoint is stored only if the job does not have one in the database yet
+	lastConsumedTenantTimestamp, lastFullResyncTimestamp, err := s.GetCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	lastConsumed, err := strconv.ParseInt(lastConsumedTenantTimestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing last consumed tenant timestamp %q", lastConsumedTenantTimestamp)
+	}
+
+	lastFullResync, err := strconv.ParseInt(lastFullResyncTimestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing last full resync timestamp %q", lastFullResyncTimestamp)
+	}
+
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.repo.RequestReplay(ctx, &Checkpoint{
+		JobName:                     s.jobName,
+		LastConsumedTenantTimestamp: lastConsumed,
+		LastFullResyncTimestamp:     lastFullResync,
+		ReplayFrom:                  sql.NullInt64{Int64: from, Valid: true},
+		UpdatedAt:                   time.Now().UTC(),
+	}); err != nil {
+		return errors.Wrapf(err, "while requesting replay of job %s", s.jobName)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,546 @@
+package resync_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/internal/tenantfetchersvc/resync"
+	"github.com/kyma-incubator/compass/components/director/internal/tenantfetchersvc/resync/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const checkpointJobName = "account-fetcher"
+
+func TestCheckpointRepository_GetByJobName(t *testing.T) {
+	updatedAt := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+	selectQuery := regexp.QuoteMeta(`SELECT job_name, last_consumed_tenant_timestamp, last_full_resync_timestamp, replay_from, updated_at FROM public.tenant_fetcher_checkpoints WHERE job_name = $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"job_name", "last_consumed_tenant_timestamp", "last_full_resync_timestamp", "replay_from", "updated_at"}).
+			AddRow(checkpointJobName, 1722500000000, 1722400000000, 1722300000000, updatedAt)
+		dbMock.ExpectQuery(selectQuery).WithArgs(checkpointJobName).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		checkpoint, err := resync.NewCheckpointRepository().GetByJobName(ctx, checkpointJobName)
+
+		require.NoError(t, err)
+		require.Equal(t, &resync.Checkpoint{
+			JobName:                     checkpointJobName,
+			LastConsumedTenantTimestamp: 1722500000000,
+			LastFullResyncTimestamp:     1722400000000,
+			ReplayFrom:                  sql.NullInt64{Int64: 1722300000000, Valid: true},
+			UpdatedAt:                   updatedAt,
+		}, checkpoint)
+	})
+
+	t.Run("Returns not found error when job has no checkpoint", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(selectQuery).WithArgs(checkpointJobName).WillReturnRows(sqlmock.NewRows([]string{"job_name"}))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		checkpoint, err := resync.NewCheckpointRepository().GetByJobName(ctx, checkpointJobName)
+
+		require.Error(t, err)
+		require.True(t, apperrors.IsNotFoundError(err))
+		require.Nil(t, checkpoint)
+	})
+}
+
+func TestCheckpointRepository_Upsert(t *testing.T) {
+	checkpoint := &resync.Checkpoint{
+		JobName:                     checkpointJobName,
+		LastConsumedTenantTimestamp: 1722500000000,
+		LastFullResyncTimestamp:     1722400000000,
+		UpdatedAt:                   time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC),
+	}
+	upsertQuery := regexp.QuoteMeta(`INSERT INTO public.tenant_fetcher_checkpoints ( job_name, last_consumed_tenant_timestamp, last_full_resync_timestamp, replay_from, updated_at ) VALUES ( ?, ?, ?, ?, ? ) ON CONFLICT ( job_name ) DO UPDATE SET last_consumed_tenant_timestamp=EXCLUDED.last_consumed_tenant_timestamp, last_full_resync_timestamp=EXCLUDED.last_full_resync_timestamp, updated_at=EXCLUDED.updated_at`)
+	upsertArgs := []driver.Value{checkpoint.JobName, checkpoint.LastConsumedTenantTimestamp, checkpoint.LastFullResyncTimestamp, nil, checkpoint.UpdatedAt}
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(upsertQuery).WithArgs(upsertArgs...).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		err := resync.NewCheckpointRepository().Upsert(ctx, checkpoint)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when upserting checkpoint", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(upsertQuery).WithArgs(upsertArgs...).WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		err := resync.NewCheckpointRepository().Upsert(ctx, checkpoint)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestCheckpointRepository_RequestReplay(t *testing.T) {
+	checkpoint := &resync.Checkpoint{
+		JobName:                     checkpointJobName,
+		LastConsumedTenantTimestamp: 1722500000000,
+		LastFullResyncTimestamp:     1722400000000,
+		ReplayFrom:                  sql.NullInt64{Int64: 1722300000000, Valid: true},
+		UpdatedAt:                   time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC),
+	}
+	requestReplayQuery := `INSERT INTO public\.tenant_fetcher_checkpoints \( job_name, last_consumed_tenant_timestamp, last_full_resync_timestamp, replay_from, updated_at \)\s+` +
+		`VALUES \( \?, \?, \?, \?, \? \)\s+` +
+		`ON CONFLICT \( job_name \) DO UPDATE SET replay_from = LEAST\(tenant_fetcher_checkpoints\.replay_from, EXCLUDED\.replay_from\), updated_at = EXCLUDED\.updated_at`
+	requestReplayArgs := []driver.Value{checkpoint.JobName, checkpoint.LastConsumedTenantTimestamp, checkpoint.LastFullResyncTimestamp, checkpoint.ReplayFrom.Int64, checkpoint.UpdatedAt}
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(requestReplayQuery).WithArgs(requestReplayArgs...).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		err := resync.NewCheckpointRepository().RequestReplay(ctx, checkpoint)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when requesting replay", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(requestReplayQuery).WithArgs(requestReplayArgs...).WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		err := resync.NewCheckpointRepository().RequestReplay(ctx, checkpoint)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+
+	t.Run("Error when missing persistence context", func(t *testing.T) {
+		err := resync.NewCheckpointRepository().RequestReplay(context.TODO(), checkpoint)
+
+		require.EqualError(t, err, "Internal Server Error: unable to fetch database from context")
+	})
+}
+
+func TestCheckpointRepository_CompleteReplay(t *testing.T) {
+	completeReplayQuery := regexp.QuoteMeta(`UPDATE public.tenant_fetcher_checkpoints SET replay_from = NULL WHERE job_name = $1 AND replay_from >= $2`)
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(completeReplayQuery).WithArgs(checkpointJobName, 1722300000000).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		err := resync.NewCheckpointRepository().CompleteReplay(ctx, checkpointJobName, 1722300000000)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when completing replay", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(completeReplayQuery).WithArgs(checkpointJobName, 1722300000000).WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		err := resync.NewCheckpointRepository().CompleteReplay(ctx, checkpointJobName, 1722300000000)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+
+	t.Run("Error when missing persistence context", func(t *testing.T) {
+		err := resync.NewCheckpointRepository().CompleteReplay(context.TODO(), checkpointJobName, 1722300000000)
+
+		require.EqualError(t, err, "Internal Server Error: unable to fetch database from context")
+	})
+}
+
+func TestDBCheckpointStore_GetCheckpoint(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	notFoundErr := apperrors.NewNotFoundError(resource.TenantFetcherCheckpoint, checkpointJobName)
+
+	testCases := []struct {
+		Name                          string
+		TransactionerFn               func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RepoFn                        func() *automock.CheckpointRepository
+		LegacyClientFn                func() *automock.KubeClient
+		ExpectedLastConsumedTimestamp string
+		ExpectedLastResyncTimestamp   string
+		ExpectedErrMsg                string
+	}{
+		{
+			Name:            "Success when job has a checkpoint",
+			TransactionerFn: txGen.ThatSucceeds,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("GetByJobName", txtest.CtxWithDBMatcher(), checkpointJobName).Return(&resync.Checkpoint{
+					JobName:                     checkpointJobName,
+					LastConsumedTenantTimestamp: 1722500000000,
+					LastFullResyncTimestamp:     1722400000000,
+				}, nil).Once()
+				return repo
+			},
+			LegacyClientFn:                func() *automock.KubeClient { return &automock.KubeClient{} },
+			ExpectedLastConsumedTimestamp: "1722500000000",
+			ExpectedLastResyncTimestamp:   "1722400000000",
+		},
+		{
+			Name:            "Success when a replay of the job was requested",
+			TransactionerFn: txGen.ThatSucceeds,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("GetByJobName", txtest.CtxWithDBMatcher(), checkpointJobName).Return(&resync.Checkpoint{
+					JobName:                     checkpointJobName,
+					LastConsumedTenantTimestamp: 1722500000000,
+					LastFullResyncTimestamp:     1722400000000,
+					ReplayFrom:                  sql.NullInt64{Int64: 1722300000000, Valid: true},
+				}, nil).Once()
+				return repo
+			},
+			LegacyClientFn:                func() *automock.KubeClient { return &automock.KubeClient{} },
+			ExpectedLastConsumedTimestamp: "1722300000000",
+			ExpectedLastResyncTimestamp:   "1722400000000",
+		},
+		{
+			Name:            "Success when job has no checkpoint and falls back to the legacy ConfigMap",
+			TransactionerFn: txGen.ThatSucceeds,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("GetByJobName", txtest.CtxWithDBMatcher(), checkpointJobName).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			LegacyClientFn: func() *automock.KubeClient {
+				client := &automock.KubeClient{}
+				client.On("GetTenantFetcherConfigMapData", mock.Anything).Return("1722300000000", "1722200000000", nil).Once()
+				return client
+			},
+			ExpectedLastConsumedTimestamp: "1722300000000",
+			ExpectedLastResyncTimestamp:   "1722200000000",
+		},
+		{
+			Name:            "Success when job has no checkpoint and there is no legacy ConfigMap",
+			TransactionerFn: txGen.ThatSucceeds,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("GetByJobName", txtest.CtxWithDBMatcher(), checkpointJobName).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			ExpectedLastConsumedTimestamp: "1",
+			ExpectedLastResyncTimestamp:   "1",
+		},
+		{
+			Name:            "Fails when getting checkpoint fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("GetByJobName", txtest.CtxWithDBMatcher(), checkpointJobName).Return(nil, testErr).Once()
+				return repo
+			},
+			LegacyClientFn: func() *automock.KubeClient { return &automock.KubeClient{} },
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:            "Fails when transaction cannot be started",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			RepoFn:          func() *automock.CheckpointRepository { return &automock.CheckpointRepository{} },
+			LegacyClientFn:  func() *automock.KubeClient { return &automock.KubeClient{} },
+			ExpectedErrMsg:  testErr.Error(),
+		},
+		{
+			Name:            "Fails when transaction cannot be committed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("GetByJobName", txtest.CtxWithDBMatcher(), checkpointJobName).Return(&resync.Checkpoint{JobName: checkpointJobName}, nil).Once()
+				return repo
+			},
+			LegacyClientFn: func() *automock.KubeClient { return &automock.KubeClient{} },
+			ExpectedErrMsg: testErr.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			persistTx, transact := tc.TransactionerFn()
+			repo := tc.RepoFn()
+			defer mock.AssertExpectationsForObjects(t, persistTx, transact, repo)
+
+			var legacyClient resync.KubeClient
+			if tc.LegacyClientFn != nil {
+				client := tc.LegacyClientFn()
+				defer mock.AssertExpectationsForObjects(t, client)
+				legacyClient = client
+			}
+
+			store := resync.NewDBCheckpointStore(checkpointJobName, transact, repo, legacyClient)
+
+			lastConsumedTimestamp, lastResyncTimestamp, err := store.GetCheckpoint(ctx)
+			if len(tc.ExpectedErrMsg) > 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.ExpectedLastConsumedTimestamp, lastConsumedTimestamp)
+				require.Equal(t, tc.ExpectedLastResyncTimestamp, lastResyncTimestamp)
+			}
+		})
+	}
+}
+
+func TestDBCheckpointStore_UpdateCheckpoint(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	checkpointMatcher := mock.MatchedBy(func(checkpoint *resync.Checkpoint) bool {
+		return checkpoint.JobName == checkpointJobName && checkpoint.LastConsumedTenantTimestamp == 1722500000000 && checkpoint.LastFullResyncTimestamp == 1722400000000 && !checkpoint.ReplayFrom.Valid
+	})
+
+	testCases := []struct {
+		Name                  string
+		ConsumedFromTimestamp string
+		LastConsumedTimestamp string
+		LastResyncTimestamp   string
+		TransactionerFn       func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RepoFn                func() *automock.CheckpointRepository
+		ExpectedErrMsg        string
+	}{
+		{
+			Name:                  "Success",
+			ConsumedFromTimestamp: "1722300000000",
+			LastConsumedTimestamp: "1722500000000",
+			LastResyncTimestamp:   "1722400000000",
+			TransactionerFn:       txGen.ThatSucceeds,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("Upsert", txtest.CtxWithDBMatcher(), checkpointMatcher).Return(nil).Once()
+				repo.On("CompleteReplay", txtest.CtxWithDBMatcher(), checkpointJobName, int64(1722300000000)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:                  "Fails when consumed from timestamp is not a number",
+			ConsumedFromTimestamp: "yesterday",
+			LastConsumedTimestamp: "1722500000000",
+			LastResyncTimestamp:   "1722400000000",
+			TransactionerFn:       txGen.ThatDoesntStartTransaction,
+			RepoFn:                func() *automock.CheckpointRepository { return &automock.CheckpointRepository{} },
+			ExpectedErrMsg:        "while parsing consumed from timestamp",
+		},
+		{
+			Name:                  "Fails when last consumed tenant timestamp is not a number",
+			ConsumedFromTimestamp: "1722300000000",
+			LastConsumedTimestamp: "yesterday",
+			LastResyncTimestamp:   "1722400000000",
+			TransactionerFn:       txGen.ThatDoesntStartTransaction,
+			RepoFn:                func() *automock.CheckpointRepository { return &automock.CheckpointRepository{} },
+			ExpectedErrMsg:        "while parsing last consumed tenant timestamp",
+		},
+		{
+			Name:                  "Fails when last full resync timestamp is not a number",
+			ConsumedFromTimestamp: "1722300000000",
+			LastConsumedTimestamp: "1722500000000",
+			LastResyncTimestamp:   "yesterday",
+			TransactionerFn:       txGen.ThatDoesntStartTransaction,
+			RepoFn:                func() *automock.CheckpointRepository { return &automock.CheckpointRepository{} },
+			ExpectedErrMsg:        "while parsing last full resync timestamp",
+		},
+		{
+			Name:                  "Fails when storing checkpoint fails",
+			ConsumedFromTimestamp: "1722300000000",
+			LastConsumedTimestamp: "1722500000000",
+			LastResyncTimestamp:   "1722400000000",
+			TransactionerFn:       txGen.ThatDoesntExpectCommit,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("Upsert", txtest.CtxWithDBMatcher(), checkpointMatcher).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:                  "Fails when completing replay fails",
+			ConsumedFromTimestamp: "1722300000000",
+			LastConsumedTimestamp: "1722500000000",
+			LastResyncTimestamp:   "1722400000000",
+			TransactionerFn:       txGen.ThatDoesntExpectCommit,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("Upsert", txtest.CtxWithDBMatcher(), checkpointMatcher).Return(nil).Once()
+				repo.On("CompleteReplay", txtest.CtxWithDBMatcher(), checkpointJobName, int64(1722300000000)).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrMsg: "while completing replay of job",
+		},
+		{
+			Name:                  "Fails when transaction cannot be committed",
+			ConsumedFromTimestamp: "1722300000000",
+			LastConsumedTimestamp: "1722500000000",
+			LastResyncTimestamp:   "1722400000000",
+			TransactionerFn:       txGen.ThatFailsOnCommit,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("Upsert", txtest.CtxWithDBMatcher(), checkpointMatcher).Return(nil).Once()
+				repo.On("CompleteReplay", txtest.CtxWithDBMatcher(), checkpointJobName, int64(1722300000000)).Return(nil).Once()
+				return repo
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			persistTx, transact := tc.TransactionerFn()
+			repo := tc.RepoFn()
+			defer mock.AssertExpectationsForObjects(t, persistTx, transact, repo)
+
+			store := resync.NewDBCheckpointStore(checkpointJobName, transact, repo, nil)
+
+			err := store.UpdateCheckpoint(ctx, tc.ConsumedFromTimestamp, tc.LastConsumedTimestamp, tc.LastResyncTimestamp)
+			if len(tc.ExpectedErrMsg) > 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDBCheckpointStore_RequestReplay(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	storedCheckpoint := &resync.Checkpoint{
+		JobName:                     checkpointJobName,
+		LastConsumedTenantTimestamp: 1722500000000,
+		LastFullResyncTimestamp:     1722400000000,
+	}
+	replayMatcher := mock.MatchedBy(func(checkpoint *resync.Checkpoint) bool {
+		return checkpoint.JobName == checkpointJobName && checkpoint.LastConsumedTenantTimestamp == 1722500000000 && checkpoint.LastFullResyncTimestamp == 1722400000000 &&
+			checkpoint.ReplayFrom.Valid && checkpoint.ReplayFrom.Int64 == 1722300000000
+	})
+
+	testCases := []struct {
+		Name            string
+		FromTimestamp   string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RepoFn          func() *automock.CheckpointRepository
+		ExpectedErrMsg  string
+	}{
+		{
+			Name:          "Success",
+			FromTimestamp: "1722300000000",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("GetByJobName", txtest.CtxWithDBMatcher(), checkpointJobName).Return(storedCheckpoint, nil).Once()
+				repo.On("RequestReplay", txtest.CtxWithDBMatcher(), replayMatcher).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:            "Fails when replay timestamp is not a number",
+			FromTimestamp:   "yesterday",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			RepoFn:          func() *automock.CheckpointRepository { return &automock.CheckpointRepository{} },
+			ExpectedErrMsg:  "while parsing replay timestamp",
+		},
+		{
+			Name:            "Fails when getting checkpoint fails",
+			FromTimestamp:   "1722300000000",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("GetByJobName", txtest.CtxWithDBMatcher(), checkpointJobName).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:          "Fails when requesting replay fails",
+			FromTimestamp: "1722300000000",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(2, 1)
+			},
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("GetByJobName", txtest.CtxWithDBMatcher(), checkpointJobName).Return(storedCheckpoint, nil).Once()
+				repo.On("RequestReplay", txtest.CtxWithDBMatcher(), replayMatcher).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrMsg: "while requesting replay of job",
+		},
+		{
+			Name:          "Fails when transaction cannot be committed",
+			FromTimestamp: "1722300000000",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenFailsOnCommit(1)
+			},
+			RepoFn: func() *automock.CheckpointRepository {
+				repo := &automock.CheckpointRepository{}
+				repo.On("GetByJobName", txtest.CtxWithDBMatcher(), checkpointJobName).Return(storedCheckpoint, nil).Once()
+				repo.On("RequestReplay", txtest.CtxWithDBMatcher(), replayMatcher).Return(nil).Once()
+				return repo
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			persistTx, transact := tc.TransactionerFn()
+			repo := tc.RepoFn()
+			defer mock.AssertExpectationsForObjects(t, persistTx, transact, repo)
+
+			store := resync.NewDBCheckpointStore(checkpointJobName, transact, repo, nil)
+
+			err := store.RequestReplay(ctx, tc.FromTimestamp)
+			if len(tc.ExpectedErrMsg) > 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package resync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const ingestedEventsTable = "public.ingested_tenant_events"
+
+var ingestedEventColumns = []string{"source", "event_id", "event_type", "job_name", "ingested_at"}
+
+// IngestedEvent represents a tenant event pushed by an external system which was already processed.
+// The source and the ID of the event form its idempotency key.
+type IngestedEvent struct {
+	Source     string    `db:"source"`
+	EventID    string    `db:"event_id"`
+	EventType  string    `db:"event_type"`
+	JobName    string    `db:"job_name"`
+	IngestedAt time.Time `db:"ingested_at"`
+}
+
+// IngestedEventRepository keeps track of the pushed tenant events which were already processed
+//
+//go:generate mockery --name=IngestedEventRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type IngestedEventRepository interface {
+	Claim(ctx context.Context, event *IngestedEvent) (bool, error)
+	DeleteIngestedBefore(ctx context.Context, before time.Time) error
+}
+
+type ingestedEventRepository struct {
+	deleter repo.DeleterGlobal
+}
+
+// NewIngestedEventRepository returns a new repository for the ingested tenant events
+func NewIngestedEventRepository() IngestedEventRepository {
+	return &ingestedEventRepository{
+		deleter: repo.NewDeleterGlobal(resource.IngestedTenantEvent, ingestedEventsTable),
+	}
+}
+
+// Claim records the event as processed and reports whether it was recorded by this call. It returns false when the event
+// was already recorded. While the transaction which recorded the event is not committed, claims of the same event wait for its outcome.
+func (r *ingestedEventRepository) Claim(ctx context.Context, event *IngestedEvent) (bool, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	values := make([]string, 0, len(ingestedEventColumns))
+	for _, c := range ingestedEventColumns {
+		values = append(values, fmt.Sprintf(":%s", c))
+	}
+	stmt := fmt.Sprintf("INSERT INTO %s ( %s ) VALUES ( %s ) ON CONFLICT ( source, event_id ) DO NOTHING", ingestedEventsTable, strings.Join(ingestedEventColumns, ", "), strings.Join(values, ", "))
+
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	res, err := persist.NamedExecContext(ctx, stmt, event)
+	if err = persistence.MapSQLError(ctx, err, resource.IngestedTenantEvent, resource.Create, "while claiming event with ID %s from source %s", event.EventID, event.Source); err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "while checking affected rows")
+	}
+
+	return affected > 0, nil
+}
+
+// DeleteIngestedBefore deletes the records of the events which were ingested before the given time
+func (r *ingestedEventRepository) DeleteIngestedBefore(ctx context.Context, before time.Time) error {
+	return r.deleter.DeleteManyGlobal(ctx, repo.Conditions{repo.NewLessThanCondition("ingested_at", before)})
+}
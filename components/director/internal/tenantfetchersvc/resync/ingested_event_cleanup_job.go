@@ -0,0 +1,51 @@
+package resync
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/cronjob"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// IngestedEventsCleanupConfig configures the job which deletes the records of the ingested tenant events.
+// Events delivered again after their record is deleted are processed again, so the retention period must exceed the redelivery window of the event sources.
+type IngestedEventsCleanupConfig struct {
+	RetentionPeriod time.Duration `envconfig:"default=720h,APP_INGESTED_TENANT_EVENTS_RETENTION_PERIOD"`
+	JobInterval     time.Duration `envconfig:"default=1h,APP_INGESTED_TENANT_EVENTS_CLEANUP_JOB_INTERVAL"`
+}
+
+// StartIngestedEventsCleanupJob starts the job which deletes the records of the tenant events ingested before the retention period and blocks.
+// The deletion is idempotent, so the job runs on every instance without leader election.
+func StartIngestedEventsCleanupJob(ctx context.Context, cfg IngestedEventsCleanupConfig, transact persistence.Transactioner, ingestedEventRepo IngestedEventRepository) error {
+	cleanupJob := cronjob.CronJob{
+		Name: "DeleteIngestedTenantEvents",
+		Fn: func(jobCtx context.Context) {
+			before := time.Now().UTC().Add(-cfg.RetentionPeriod)
+			if err := deleteIngestedEvents(jobCtx, transact, ingestedEventRepo, before); err != nil {
+				log.C(jobCtx).WithError(err).Errorf("Failed to delete the tenant events ingested before %s", before.Format(time.RFC3339))
+				return
+			}
+			log.C(jobCtx).Infof("Deleted the tenant events ingested before %s", before.Format(time.RFC3339))
+		},
+		SchedulePeriod: cfg.JobInterval,
+	}
+	return cronjob.RunCronJob(ctx, cronjob.ElectionConfig{ElectionEnabled: false}, cleanupJob)
+}
+
+func deleteIngestedEvents(ctx context.Context, transact persistence.Transactioner, ingestedEventRepo IngestedEventRepository, before time.Time) error {
+	tx, err := transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = ingestedEventRepo.DeleteIngestedBefore(ctx, before); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,113 @@
+package resync_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/internal/tenantfetchersvc/resync"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ingestedEventSource = "/external-registry"
+	ingestedEventID     = "2f3dc9ae-66b9-4dbb-8cd0-5b5da0e3f5f1"
+)
+
+func TestIngestedEventRepository_Claim(t *testing.T) {
+	event := &resync.IngestedEvent{
+		Source:     ingestedEventSource,
+		EventID:    ingestedEventID,
+		EventType:  resync.TenantCreatedEventType,
+		JobName:    "account-fetcher",
+		IngestedAt: time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC),
+	}
+	insertQuery := regexp.QuoteMeta(`INSERT INTO public.ingested_tenant_events ( source, event_id, event_type, job_name, ingested_at ) VALUES ( ?, ?, ?, ?, ? ) ON CONFLICT ( source, event_id ) DO NOTHING`)
+
+	t.Run("Success when event is claimed", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(insertQuery).WithArgs(event.Source, event.EventID, event.EventType, event.JobName, event.IngestedAt).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		claimed, err := resync.NewIngestedEventRepository().Claim(ctx, event)
+
+		require.NoError(t, err)
+		require.True(t, claimed)
+	})
+
+	t.Run("Success when event was already claimed", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(insertQuery).WithArgs(event.Source, event.EventID, event.EventType, event.JobName, event.IngestedAt).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		claimed, err := resync.NewIngestedEventRepository().Claim(ctx, event)
+
+		require.NoError(t, err)
+		require.False(t, claimed)
+	})
+
+	t.Run("Error when inserting event", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(insertQuery).WithArgs(event.Source, event.EventID, event.EventType, event.JobName, event.IngestedAt).WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		claimed, err := resync.NewIngestedEventRepository().Claim(ctx, event)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+		require.False(t, claimed)
+	})
+
+	t.Run("Error when transaction is missing in the context", func(t *testing.T) {
+		claimed, err := resync.NewIngestedEventRepository().Claim(context.TODO(), event)
+
+		require.Error(t, err)
+		require.False(t, claimed)
+	})
+}
+
+func TestIngestedEventRepository_DeleteIngestedBefore(t *testing.T) {
+	before := time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM public.ingested_tenant_events WHERE ingested_at < $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(deleteQuery).WithArgs(before).WillReturnResult(sqlmock.NewResult(0, 3))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		err := resync.NewIngestedEventRepository().DeleteIngestedBefore(ctx, before)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when deleting events", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(deleteQuery).WithArgs(before).WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		err := resync.NewIngestedEventRepository().DeleteIngestedBefore(ctx, before)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
@@ -120,10 +120,10 @@ func (k *kubernetesClient) UpdateTenantFetcherConfigMapData(ctx context.Context,
 	return err
 }
 
-func resyncTimestamps(ctx context.Context, client KubeClient, fullResyncInterval time.Duration) (*time.Time, string, string, error) {
+func resyncTimestamps(ctx context.Context, checkpointStore CheckpointStore, fullResyncInterval time.Duration) (*time.Time, string, string, error) {
 	startTime := time.Now()
 
-	lastConsumedTenantTimestamp, lastFullResyncTimestamp, err := client.GetTenantFetcherConfigMapData(ctx)
+	lastConsumedTenantTimestamp, lastFullResyncTimestamp, err := checkpointStore.GetCheckpoint(ctx)
 	if err != nil {
 		return nil, "", "", err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/tenant"
 	"github.com/pkg/errors"
+	"github.com/tidwall/sjson"
 )
 
 type contextKey string
@@ -80,14 +81,15 @@ type TenantsSynchronizer struct {
 	mover   TenantMover
 	deleter TenantDeleter
 
-	kubeClient KubeClient
-	config     JobConfig
+	checkpointStore   CheckpointStore
+	ingestedEventRepo IngestedEventRepository
+	config            JobConfig
 
 	metricsReporter AggregationFailurePusher
 }
 
 // NewTenantSynchronizer returns a new tenants synchronizer.
-func NewTenantSynchronizer(config JobConfig, transact persistence.Transactioner, tenantStorageService TenantStorageService, creator TenantCreator, mover TenantMover, deleter TenantDeleter, kubeClient KubeClient, metricsReporter AggregationFailurePusher) *TenantsSynchronizer {
+func NewTenantSynchronizer(config JobConfig, transact persistence.Transactioner, tenantStorageService TenantStorageService, creator TenantCreator, mover TenantMover, deleter TenantDeleter, checkpointStore CheckpointStore, ingestedEventRepo IngestedEventRepository, metricsReporter AggregationFailurePusher) *TenantsSynchronizer {
 	return &TenantsSynchronizer{
 		supportedRegions:     supportedRegions(config),
 		transact:             transact,
@@ -95,7 +97,8 @@ func NewTenantSynchronizer(config JobConfig, transact persistence.Transactioner,
 		creator:              creator,
 		mover:                mover,
 		deleter:              deleter,
-		kubeClient:           kubeClient,
+		checkpointStore:      checkpointStore,
+		ingestedEventRepo:    ingestedEventRepo,
 		config:               config,
 		metricsReporter:      metricsReporter,
 	}
@@ -139,7 +142,7 @@ func (ts *TenantsSynchronizer) Synchronize(ctx context.Context) error {
 }
 
 func (ts *TenantsSynchronizer) synchronizeTenants(ctx context.Context) error {
-	startTime, lastConsumedTenantTimestamp, lastResyncTimestamp, err := resyncTimestamps(ctx, ts.kubeClient, ts.config.FullResyncInterval)
+	startTime, lastConsumedTenantTimestamp, lastResyncTimestamp, err := resyncTimestamps(ctx, ts.checkpointStore, ts.config.FullResyncInterval)
 	if err != nil {
 		return err
 	}
@@ -162,48 +165,172 @@ func (ts *TenantsSynchronizer) synchronizeTenants(ctx context.Context) error {
 			return err
 		}
 
-		tenantsToCreate = dedupeTenants(tenantsToCreate)
-		tenantsToCreate = excludeTenants(tenantsToCreate, tenantsToDelete)
+		if err := ts.processEvents(ctx, region, tenantsToCreate, tenantsToMove, tenantsToDelete); err != nil {
+			return err
+		}
+	}
+
+	return ts.checkpointStore.UpdateCheckpoint(ctx, lastConsumedTenantTimestamp, convertTimeToUnixMilliSecondString(*startTime), lastResyncTimestamp)
+}
+
+func (ts *TenantsSynchronizer) processEvents(ctx context.Context, region string, tenantsToCreate []model.BusinessTenantMappingInput, tenantsToMove []model.MovedSubaccountMappingInput, tenantsToDelete []model.BusinessTenantMappingInput) error {
+	tenantsToCreate = dedupeTenants(tenantsToCreate)
+	tenantsToCreate = excludeTenants(tenantsToCreate, tenantsToDelete)
+
+	totalNewEvents := len(tenantsToCreate) + len(tenantsToDelete) + len(tenantsToMove)
+	log.C(ctx).Printf("Amount of new events for region %s: %d", region, totalNewEvents)
+	if totalNewEvents == 0 {
+		log.C(ctx).Printf("No new events for processing, resync completed for region %s", region)
+		return nil
+	}
 
-		totalNewEvents := len(tenantsToCreate) + len(tenantsToDelete) + len(tenantsToMove)
-		log.C(ctx).Printf("Amount of new events for region %s: %d", region, totalNewEvents)
-		if totalNewEvents == 0 {
-			log.C(ctx).Printf("No new events for processing, resync completed for region %s", region)
-			continue
+	currentTenants := make(map[string]string)
+	if len(tenantsToCreate) > 0 || len(tenantsToDelete) > 0 {
+		currentTenantsIDs := getTenantsIDs(tenantsToCreate, tenantsToDelete)
+		var err error
+		currentTenants, err = ts.currentTenants(ctx, currentTenantsIDs)
+		if err != nil {
+			return err
 		}
+	}
 
-		currentTenants := make(map[string]string)
-		if len(tenantsToCreate) > 0 || len(tenantsToDelete) > 0 {
-			currentTenantsIDs := getTenantsIDs(tenantsToCreate, tenantsToDelete)
-			currentTenants, err = ts.currentTenants(ctx, currentTenantsIDs)
-			if err != nil {
-				return err
-			}
+	// Order of event processing matters - we want the most destructive operation to be last
+	if len(tenantsToCreate) > 0 {
+		if err := ts.createTenants(ctx, currentTenants, tenantsToCreate, region); err != nil {
+			return errors.Wrap(err, "while creating tenants")
 		}
+	}
 
-		// Order of event processing matters - we want the most destructive operation to be last
-		if len(tenantsToCreate) > 0 {
-			if err := ts.createTenants(ctx, currentTenants, tenantsToCreate, region); err != nil {
-				return errors.Wrap(err, "while creating tenants")
-			}
+	if len(tenantsToMove) > 0 {
+		if err := ts.mover.MoveTenants(ctx, tenantsToMove); err != nil {
+			return errors.Wrap(err, "while moving tenants")
 		}
+	}
 
-		if len(tenantsToMove) > 0 {
-			if err := ts.mover.MoveTenants(ctx, tenantsToMove); err != nil {
-				return errors.Wrap(err, "while moving tenants")
-			}
+	if len(tenantsToDelete) > 0 {
+		if err := ts.deleteTenants(ctx, currentTenants, tenantsToDelete); err != nil {
+			return errors.Wrap(err, "while deleting tenants")
 		}
+	}
 
-		if len(tenantsToDelete) > 0 {
-			if err := ts.deleteTenants(ctx, currentTenants, tenantsToDelete); err != nil {
-				return errors.Wrap(err, "while deleting tenants")
-			}
+	log.C(ctx).Printf("Processed all new events for region: %s", region)
+	return nil
+}
+
+// ProcessTenantEvent processes a tenant event pushed by an external system the same way as the events consumed during a resync.
+// Events are identified by their source and ID, so an event which was already processed is ignored.
+// The event is claimed in a transaction which stays open while the event is processed and which is committed only when the processing succeeds.
+// This way concurrent deliveries of the same event wait for the outcome of the first one, and a failed processing releases the claim for a redelivery.
+func (ts *TenantsSynchronizer) ProcessTenantEvent(ctx context.Context, event TenantEvent) error {
+	if err := event.Validate(); err != nil {
+		return err
+	}
+
+	region := event.Region
+	if region == "" {
+		region = ts.config.APIConfig.RegionName
+	}
+	if !slices.Contains(ts.supportedRegions, region) {
+		return apperrors.NewInvalidDataError("region %q is not supported by tenant fetcher job %s", region, ts.Name())
+	}
+
+	tx, err := ts.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer ts.transact.RollbackUnlessCommitted(ctx, tx)
+
+	claimed, err := ts.ingestedEventRepo.Claim(persistence.SaveToContext(ctx, tx), &IngestedEvent{
+		Source:     event.Source,
+		EventID:    event.ID,
+		EventType:  event.Type,
+		JobName:    ts.Name(),
+		IngestedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while claiming event with ID %s from source %s", event.ID, event.Source)
+	}
+	if !claimed {
+		log.C(ctx).Infof("Event with ID %s from source %s was already processed by tenant fetcher job %s", event.ID, event.Source, ts.Name())
+		return nil
+	}
+
+	ctx = context.WithValue(ctx, TenantRegionCtxKey, region)
+	tenantsToCreate, tenantsToMove, tenantsToDelete, err := ts.eventTenants(ctx, region, event)
+	if err != nil {
+		return err
+	}
+
+	log.C(ctx).Infof("Processing event with ID %s of type %s from source %s by tenant fetcher job %s", event.ID, event.Type, event.Source, ts.Name())
+	if err := ts.processEvents(ctx, region, tenantsToCreate, tenantsToMove, tenantsToDelete); err != nil {
+		return errors.Wrapf(err, "while processing event with ID %s from source %s", event.ID, event.Source)
+	}
+
+	return tx.Commit()
+}
+
+// ReplayFrom requests all tenant events since the given time to be consumed again by the next resync of the synchronizer.
+// The request is stored with the checkpoint, so it is not lost if it is received by an instance which does not run the resyncs, or during a resync.
+func (ts *TenantsSynchronizer) ReplayFrom(ctx context.Context, from time.Time) error {
+	if from.After(time.Now()) {
+		return apperrors.NewInvalidDataError("replay timestamp %s is in the future", from.Format(time.RFC3339))
+	}
+
+	if err := ts.checkpointStore.RequestReplay(ctx, convertTimeToUnixMilliSecondString(from)); err != nil {
+		return errors.Wrapf(err, "while requesting replay for tenant fetcher job %s", ts.Name())
+	}
+
+	log.C(ctx).Infof("Tenant events of job %s will be replayed from %s", ts.Name(), from.Format(time.RFC3339))
+	return nil
+}
+
+func (ts *TenantsSynchronizer) eventTenants(ctx context.Context, region string, event TenantEvent) ([]model.BusinessTenantMappingInput, []model.MovedSubaccountMappingInput, []model.BusinessTenantMappingInput, error) {
+	apiConfig := ts.config.APIConfig
+	if regionalConfig, ok := ts.config.RegionalAPIConfigs[region]; ok && regionalConfig != nil {
+		apiConfig = *regionalConfig
+	}
+
+	payload, err := sjson.SetRawBytes([]byte("{}"), apiConfig.TenantFieldMapping.EventsField, []byte("["+string(event.Data)+"]"))
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "while preparing events page for event with ID %s", event.ID)
+	}
+
+	page := EventsPage{
+		FieldMapping:                 apiConfig.TenantFieldMapping,
+		MovedSubaccountsFieldMapping: apiConfig.MovedSubaccountsFieldMapping,
+		ProviderName:                 ts.config.TenantProvider,
+		Payload:                      payload,
+	}
+
+	eventTypes, err := supportedEventTypes(ts.TenantType())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var (
+		tenantsToCreate []model.BusinessTenantMappingInput
+		tenantsToMove   []model.MovedSubaccountMappingInput
+		tenantsToDelete []model.BusinessTenantMappingInput
+	)
+	switch event.Type {
+	case TenantCreatedEventType:
+		tenantsToCreate = page.GetTenantMappings(ctx, eventTypes.createdTenantEvent)
+	case TenantUpdatedEventType:
+		tenantsToCreate = page.GetTenantMappings(ctx, eventTypes.updatedTenantEvent)
+	case TenantDeletedEventType:
+		tenantsToDelete = page.GetTenantMappings(ctx, eventTypes.deletedTenantEvent)
+	case TenantMovedEventType:
+		if ts.TenantType() != tenant.Subaccount {
+			return nil, nil, nil, apperrors.NewInvalidDataError("events of type %s are not supported by tenant fetcher job %s", event.Type, ts.Name())
 		}
+		tenantsToMove = page.GetMovedSubaccounts(ctx)
+	}
 
-		log.C(ctx).Printf("Processed all new events for region: %s", region)
+	if len(tenantsToCreate)+len(tenantsToMove)+len(tenantsToDelete) == 0 {
+		return nil, nil, nil, apperrors.NewInvalidDataError("event with ID %s does not contain a valid tenant", event.ID)
 	}
 
-	return ts.kubeClient.UpdateTenantFetcherConfigMapData(ctx, convertTimeToUnixMilliSecondString(*startTime), lastResyncTimestamp)
+	return tenantsToCreate, tenantsToMove, tenantsToDelete, nil
 }
 
 // SynchronizeTenant is responsible for updating the given tenant with the values available in the external registry,
@@ -60,16 +60,16 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 	deletedAccountTenant := model.BusinessTenantMappingInput{ExternalTenant: deletedTenantID}
 	emptyTenantsResult := make([]model.BusinessTenantMappingInput, 0)
 
-	kubeClientFn := func() *automock.KubeClient {
-		client := &automock.KubeClient{}
-		client.On("GetTenantFetcherConfigMapData", ctx).Return(lastConsumedTenantTimestamp, lastResyncTimestamp, nil)
-		client.On("UpdateTenantFetcherConfigMapData", ctxWithRegion, mock.Anything, mock.Anything).Return(nil)
+	checkpointStoreFn := func() *automock.CheckpointStore {
+		client := &automock.CheckpointStore{}
+		client.On("GetCheckpoint", ctx).Return(lastConsumedTenantTimestamp, lastResyncTimestamp, nil)
+		client.On("UpdateCheckpoint", ctxWithRegion, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		return client
 	}
 
-	kubeClientWithResyncTimestampFn := func() *automock.KubeClient {
-		client := &automock.KubeClient{}
-		client.On("GetTenantFetcherConfigMapData", ctx).Return(lastConsumedTenantTimestamp, lastResyncTimestamp, nil)
+	checkpointStoreWithResyncTimestampFn := func() *automock.CheckpointStore {
+		client := &automock.CheckpointStore{}
+		client.On("GetCheckpoint", ctx).Return(lastConsumedTenantTimestamp, lastResyncTimestamp, nil)
 		return client
 	}
 
@@ -87,7 +87,7 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 		TenantCreatorFn    func() *automock.TenantCreator
 		TenantMoverFn      func() *automock.TenantMover
 		TenantDeleterFn    func() *automock.TenantDeleter
-		KubeClientFn       func() *automock.KubeClient
+		CheckpointStoreFn  func() *automock.CheckpointStore
 		ExpectedErrMsg     string
 	}{
 		{
@@ -118,7 +118,7 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("DeleteTenants", ctxWithRegion, []model.BusinessTenantMappingInput{deletedAccountTenant}).Return(nil)
 				return svc
 			},
-			KubeClientFn: kubeClientFn,
+			CheckpointStoreFn: checkpointStoreFn,
 		},
 		{
 			Name:               "Success when no new events are present",
@@ -140,7 +140,7 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToDelete", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(emptyTenantsResult, nil)
 				return svc
 			},
-			KubeClientFn: kubeClientFn,
+			CheckpointStoreFn: checkpointStoreFn,
 		},
 		{
 			Name:            "Tenant is not created when both create and delete events are present for the same unknown tenant",
@@ -162,7 +162,7 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToDelete", ctxWithRegion, region, lastConsumedTenantTimestamp).Return([]model.BusinessTenantMappingInput{deletedAccountTenant}, nil)
 				return svc
 			},
-			KubeClientFn: kubeClientFn,
+			CheckpointStoreFn: checkpointStoreFn,
 		},
 		{
 			Name:            "Parent tenant is also created when tenant from create event has unknown parent",
@@ -199,7 +199,7 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToDelete", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(emptyTenantsResult, nil)
 				return svc
 			},
-			KubeClientFn: kubeClientFn,
+			CheckpointStoreFn: checkpointStoreFn,
 		},
 		{
 			Name:            "Child tenant is correctly associated with internal ID of parent when parent is pre-existing",
@@ -237,7 +237,7 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToDelete", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(emptyTenantsResult, nil)
 				return svc
 			},
-			KubeClientFn: kubeClientFn,
+			CheckpointStoreFn: checkpointStoreFn,
 		},
 		{
 			Name:               "Fails when fetching created tenants returns an error",
@@ -249,10 +249,10 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToCreate", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(nil, errors.New(failedToFetchNewTenantsErrMsg))
 				return svc
 			},
-			TenantMoverFn:   func() *automock.TenantMover { return &automock.TenantMover{} },
-			TenantDeleterFn: func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
-			KubeClientFn:    kubeClientWithResyncTimestampFn,
-			ExpectedErrMsg:  failedToFetchNewTenantsErrMsg,
+			TenantMoverFn:     func() *automock.TenantMover { return &automock.TenantMover{} },
+			TenantDeleterFn:   func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			CheckpointStoreFn: checkpointStoreWithResyncTimestampFn,
+			ExpectedErrMsg:    failedToFetchNewTenantsErrMsg,
 		},
 		{
 			Name:               "Fails when fetching moved tenants returns an error",
@@ -269,9 +269,9 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToMove", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(nil, errors.New(failedToFetchMovedTenantsErrMsg))
 				return svc
 			},
-			TenantDeleterFn: func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
-			KubeClientFn:    kubeClientWithResyncTimestampFn,
-			ExpectedErrMsg:  failedToFetchMovedTenantsErrMsg,
+			TenantDeleterFn:   func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			CheckpointStoreFn: checkpointStoreWithResyncTimestampFn,
+			ExpectedErrMsg:    failedToFetchMovedTenantsErrMsg,
 		},
 		{
 			Name:               "Fails when fetching deleted tenants returns an error",
@@ -289,8 +289,8 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToDelete", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(nil, errors.New(failedToFetchDeletedTenantsErrMsg))
 				return svc
 			},
-			KubeClientFn:   kubeClientWithResyncTimestampFn,
-			ExpectedErrMsg: failedToFetchDeletedTenantsErrMsg,
+			CheckpointStoreFn: checkpointStoreWithResyncTimestampFn,
+			ExpectedErrMsg:    failedToFetchDeletedTenantsErrMsg,
 		},
 		{
 			Name:            "Fails when creating new tenants returns an error",
@@ -313,8 +313,8 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToDelete", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(emptyTenantsResult, nil)
 				return svc
 			},
-			KubeClientFn:   kubeClientWithResyncTimestampFn,
-			ExpectedErrMsg: failedToCreateTenantsErrMsg,
+			CheckpointStoreFn: checkpointStoreWithResyncTimestampFn,
+			ExpectedErrMsg:    failedToCreateTenantsErrMsg,
 		},
 		{
 			Name:               "Fails when moving tenants returns an error",
@@ -337,8 +337,8 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToDelete", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(emptyTenantsResult, nil)
 				return svc
 			},
-			KubeClientFn:   kubeClientWithResyncTimestampFn,
-			ExpectedErrMsg: failedToMoveTenantsErrMsg,
+			CheckpointStoreFn: checkpointStoreWithResyncTimestampFn,
+			ExpectedErrMsg:    failedToMoveTenantsErrMsg,
 		},
 		{
 			Name:            "Fails when deleting tenants returns an error",
@@ -365,8 +365,8 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("DeleteTenants", ctxWithRegion, []model.BusinessTenantMappingInput{deletedAccountTenant}).Return(errors.New(failedToDeleteTenantsErrMsg))
 				return svc
 			},
-			KubeClientFn:   kubeClientWithResyncTimestampFn,
-			ExpectedErrMsg: failedToDeleteTenantsErrMsg,
+			CheckpointStoreFn: checkpointStoreWithResyncTimestampFn,
+			ExpectedErrMsg:    failedToDeleteTenantsErrMsg,
 		},
 		{
 			Name:            "Fails when fetching existing tenants returns an error",
@@ -392,8 +392,8 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToDelete", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(emptyTenantsResult, nil)
 				return svc
 			},
-			KubeClientFn:   kubeClientWithResyncTimestampFn,
-			ExpectedErrMsg: failedToGetExistingTenantsErrMsg,
+			CheckpointStoreFn: checkpointStoreWithResyncTimestampFn,
+			ExpectedErrMsg:    failedToGetExistingTenantsErrMsg,
 		},
 		{
 			Name:               "Fails when fetching existing tenants returns an error caused by failed transaction start",
@@ -415,8 +415,8 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToDelete", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(emptyTenantsResult, nil)
 				return svc
 			},
-			KubeClientFn:   kubeClientWithResyncTimestampFn,
-			ExpectedErrMsg: testErr.Error(),
+			CheckpointStoreFn: checkpointStoreWithResyncTimestampFn,
+			ExpectedErrMsg:    testErr.Error(),
 		},
 		{
 			Name:            "Fails when fetching existing tenants returns an error caused by failed transaction commit",
@@ -442,8 +442,8 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 				svc.On("TenantsToDelete", ctxWithRegion, region, lastConsumedTenantTimestamp).Return(emptyTenantsResult, nil)
 				return svc
 			},
-			KubeClientFn:   kubeClientWithResyncTimestampFn,
-			ExpectedErrMsg: testErr.Error(),
+			CheckpointStoreFn: checkpointStoreWithResyncTimestampFn,
+			ExpectedErrMsg:    testErr.Error(),
 		},
 		{
 			Name:               "Fails when getting resync info returns an error",
@@ -453,9 +453,9 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 			TenantCreatorFn:    func() *automock.TenantCreator { return &automock.TenantCreator{} },
 			TenantMoverFn:      func() *automock.TenantMover { return &automock.TenantMover{} },
 			TenantDeleterFn:    func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
-			KubeClientFn: func() *automock.KubeClient {
-				client := &automock.KubeClient{}
-				client.On("GetTenantFetcherConfigMapData", ctx).Return("", "", testErr)
+			CheckpointStoreFn: func() *automock.CheckpointStore {
+				client := &automock.CheckpointStore{}
+				client.On("GetCheckpoint", ctx).Return("", "", testErr)
 				return client
 			},
 			ExpectedErrMsg: testErr.Error(),
@@ -468,7 +468,7 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 			tenantCreator := testCase.TenantCreatorFn()
 			tenantMover := testCase.TenantMoverFn()
 			tenantDeleter := testCase.TenantDeleterFn()
-			kubeClient := testCase.KubeClientFn()
+			checkpointStore := testCase.CheckpointStoreFn()
 
 			metricsPusher := &automock.AggregationFailurePusher{}
 			if len(testCase.ExpectedErrMsg) > 0 {
@@ -478,9 +478,9 @@ func TestTenantsSynchronizer_Synchronize(t *testing.T) {
 			}
 
 			defer mock.AssertExpectationsForObjects(t, persist, transact, tenantStorageSvc, tenantCreator, tenantMover,
-				tenantDeleter, kubeClient, metricsPusher)
+				tenantDeleter, checkpointStore, metricsPusher)
 
-			synchronizer := resync.NewTenantSynchronizer(testCase.JobCfg, transact, tenantStorageSvc, tenantCreator, tenantMover, tenantDeleter, kubeClient, metricsPusher)
+			synchronizer := resync.NewTenantSynchronizer(testCase.JobCfg, transact, tenantStorageSvc, tenantCreator, tenantMover, tenantDeleter, checkpointStore, nil, metricsPusher)
 			err := synchronizer.Synchronize(context.TODO())
 			if len(testCase.ExpectedErrMsg) > 0 {
 				require.Error(t, err)
@@ -686,7 +686,7 @@ func TestTenantsSynchronizer_SynchronizeTenant(t *testing.T) {
 
 			defer mock.AssertExpectationsForObjects(t, tenantStorageSvc, tenantCreator)
 
-			synchronizer := resync.NewTenantSynchronizer(testCase.JobCfg, transact, tenantStorageSvc, tenantCreator, nil, nil, nil, nil)
+			synchronizer := resync.NewTenantSynchronizer(testCase.JobCfg, transact, tenantStorageSvc, tenantCreator, nil, nil, nil, nil, nil)
 			err := synchronizer.SynchronizeTenant(ctx, testCase.ParentTenantID, newTenantID)
 			if len(testCase.ExpectedErrMsg) > 0 {
 				require.Error(t, err)
@@ -697,3 +697,330 @@ func TestTenantsSynchronizer_SynchronizeTenant(t *testing.T) {
 		})
 	}
 }
+
+func TestTenantsSynchronizer_ProcessTenantEvent(t *testing.T) {
+	ctx := context.TODO()
+	ctxWithRegion := context.WithValue(ctx, resync.TenantRegionCtxKey, "central")
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	const (
+		jobName   = "account-fetcher"
+		region    = "central"
+		provider  = "test-provider"
+		tenantID  = "da363eb6-9444-4452-9bf6-40ee7e8da4d8"
+		subdomain = "test-subdomain"
+		eventID   = "2f3dc9ae-66b9-4dbb-8cd0-5b5da0e3f5f1"
+		source    = "/external-registry"
+	)
+
+	fieldMapping := resync.TenantFieldMapping{
+		EventsField:       "events",
+		DetailsField:      "eventData",
+		IDField:           "guid",
+		NameField:         "displayName",
+		SubdomainField:    "subdomain",
+		EntityTypeField:   "type",
+		CustomerIDField:   "customerId",
+		CostObjectIDField: "costObjectId",
+	}
+	apiConfig := resync.EventsAPIConfig{TenantFieldMapping: fieldMapping, RegionName: region}
+
+	jobCfg := resync.JobConfig{
+		JobName:        jobName,
+		TenantProvider: provider,
+		TenantType:     tenant.Account,
+		EventsConfig: resync.EventsConfig{
+			APIConfig:          apiConfig,
+			RegionalAPIConfigs: map[string]*resync.EventsAPIConfig{region: &apiConfig},
+		},
+	}
+
+	fixTenantEvent := func(eventType string) resync.TenantEvent {
+		return resync.TenantEvent{
+			SpecVersion: resync.CloudEventsSpecVersion,
+			ID:          eventID,
+			Source:      source,
+			Type:        eventType,
+			Data:        []byte(fmt.Sprintf(`{"type":"GlobalAccount","eventData":{"guid":"%s","displayName":"%s","subdomain":"%s"}}`, tenantID, tenantID, subdomain)),
+		}
+	}
+
+	accountTenant := model.BusinessTenantMappingInput{
+		Name:           tenantID,
+		ExternalTenant: tenantID,
+		Subdomain:      subdomain,
+		Region:         region,
+		Type:           tenant.TypeToStr(tenant.Account),
+		Provider:       provider,
+	}
+
+	ingestedEventMatcher := func(eventType string) interface{} {
+		return mock.MatchedBy(func(event *resync.IngestedEvent) bool {
+			return event.Source == source && event.EventID == eventID && event.EventType == eventType && event.JobName == jobName
+		})
+	}
+
+	claimingEventRepoFn := func(eventType string) func() *automock.IngestedEventRepository {
+		return func() *automock.IngestedEventRepository {
+			repo := &automock.IngestedEventRepository{}
+			repo.On("Claim", txtest.CtxWithDBMatcher(), ingestedEventMatcher(eventType)).Return(true, nil).Once()
+			return repo
+		}
+	}
+
+	testCases := []struct {
+		Name                string
+		Event               resync.TenantEvent
+		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		TenantStorageSvcFn  func() *automock.TenantStorageService
+		TenantCreatorFn     func() *automock.TenantCreator
+		TenantDeleterFn     func() *automock.TenantDeleter
+		IngestedEventRepoFn func() *automock.IngestedEventRepository
+		ExpectedErrMsg      string
+	}{
+		{
+			Name:  "Success when tenant created event is processed",
+			Event: fixTenantEvent(resync.TenantCreatedEventType),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			TenantStorageSvcFn: func() *automock.TenantStorageService {
+				svc := &automock.TenantStorageService{}
+				svc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{tenantID}).Return([]*model.BusinessTenantMapping{}, nil).Once()
+				return svc
+			},
+			TenantCreatorFn: func() *automock.TenantCreator {
+				svc := &automock.TenantCreator{}
+				svc.On("CreateTenants", ctxWithRegion, []model.BusinessTenantMappingInput{accountTenant}).Return(nil).Once()
+				return svc
+			},
+			TenantDeleterFn:     func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			IngestedEventRepoFn: claimingEventRepoFn(resync.TenantCreatedEventType),
+		},
+		{
+			Name:  "Success when tenant deleted event is processed",
+			Event: fixTenantEvent(resync.TenantDeletedEventType),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			TenantStorageSvcFn: func() *automock.TenantStorageService {
+				svc := &automock.TenantStorageService{}
+				deleted := accountTenant.ToBusinessTenantMapping("123")
+				svc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{tenantID}).Return([]*model.BusinessTenantMapping{deleted}, nil).Once()
+				return svc
+			},
+			TenantCreatorFn: func() *automock.TenantCreator { return &automock.TenantCreator{} },
+			TenantDeleterFn: func() *automock.TenantDeleter {
+				svc := &automock.TenantDeleter{}
+				deletedTenant := accountTenant
+				deletedTenant.Region = ""
+				deletedTenant.Parents = []string{}
+				svc.On("DeleteTenants", ctxWithRegion, []model.BusinessTenantMappingInput{deletedTenant}).Return(nil).Once()
+				return svc
+			},
+			IngestedEventRepoFn: claimingEventRepoFn(resync.TenantDeletedEventType),
+		},
+		{
+			Name:               "Success when event was already processed",
+			Event:              fixTenantEvent(resync.TenantCreatedEventType),
+			TransactionerFn:    txGen.ThatDoesntExpectCommit,
+			TenantStorageSvcFn: func() *automock.TenantStorageService { return &automock.TenantStorageService{} },
+			TenantCreatorFn:    func() *automock.TenantCreator { return &automock.TenantCreator{} },
+			TenantDeleterFn:    func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			IngestedEventRepoFn: func() *automock.IngestedEventRepository {
+				repo := &automock.IngestedEventRepository{}
+				repo.On("Claim", txtest.CtxWithDBMatcher(), ingestedEventMatcher(resync.TenantCreatedEventType)).Return(false, nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Fails when event is missing required attributes",
+			Event: resync.TenantEvent{
+				SpecVersion: resync.CloudEventsSpecVersion,
+				Source:      source,
+				Type:        resync.TenantCreatedEventType,
+				Data:        []byte(`{}`),
+			},
+			TransactionerFn:     txGen.ThatDoesntStartTransaction,
+			TenantStorageSvcFn:  func() *automock.TenantStorageService { return &automock.TenantStorageService{} },
+			TenantCreatorFn:     func() *automock.TenantCreator { return &automock.TenantCreator{} },
+			TenantDeleterFn:     func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			IngestedEventRepoFn: func() *automock.IngestedEventRepository { return &automock.IngestedEventRepository{} },
+			ExpectedErrMsg:      "missing required event attributes: id",
+		},
+		{
+			Name: "Fails when event region is not supported by the job",
+			Event: func() resync.TenantEvent {
+				event := fixTenantEvent(resync.TenantCreatedEventType)
+				event.Region = "unknown-region"
+				return event
+			}(),
+			TransactionerFn:     txGen.ThatDoesntStartTransaction,
+			TenantStorageSvcFn:  func() *automock.TenantStorageService { return &automock.TenantStorageService{} },
+			TenantCreatorFn:     func() *automock.TenantCreator { return &automock.TenantCreator{} },
+			TenantDeleterFn:     func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			IngestedEventRepoFn: func() *automock.IngestedEventRepository { return &automock.IngestedEventRepository{} },
+			ExpectedErrMsg:      `region "unknown-region" is not supported`,
+		},
+		{
+			Name:                "Fails when tenant moved event is pushed for a job which does not synchronize subaccounts",
+			Event:               fixTenantEvent(resync.TenantMovedEventType),
+			TransactionerFn:     txGen.ThatDoesntExpectCommit,
+			TenantStorageSvcFn:  func() *automock.TenantStorageService { return &automock.TenantStorageService{} },
+			TenantCreatorFn:     func() *automock.TenantCreator { return &automock.TenantCreator{} },
+			TenantDeleterFn:     func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			IngestedEventRepoFn: claimingEventRepoFn(resync.TenantMovedEventType),
+			ExpectedErrMsg:      "events of type compass.tenant.moved are not supported",
+		},
+		{
+			Name: "Fails when event does not contain a valid tenant",
+			Event: func() resync.TenantEvent {
+				event := fixTenantEvent(resync.TenantCreatedEventType)
+				event.Data = []byte(`{"type":"GlobalAccount","eventData":{}}`)
+				return event
+			}(),
+			TransactionerFn:     txGen.ThatDoesntExpectCommit,
+			TenantStorageSvcFn:  func() *automock.TenantStorageService { return &automock.TenantStorageService{} },
+			TenantCreatorFn:     func() *automock.TenantCreator { return &automock.TenantCreator{} },
+			TenantDeleterFn:     func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			IngestedEventRepoFn: claimingEventRepoFn(resync.TenantCreatedEventType),
+			ExpectedErrMsg:      "does not contain a valid tenant",
+		},
+		{
+			Name:               "Fails when claiming the event fails",
+			Event:              fixTenantEvent(resync.TenantCreatedEventType),
+			TransactionerFn:    txGen.ThatDoesntExpectCommit,
+			TenantStorageSvcFn: func() *automock.TenantStorageService { return &automock.TenantStorageService{} },
+			TenantCreatorFn:    func() *automock.TenantCreator { return &automock.TenantCreator{} },
+			TenantDeleterFn:    func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			IngestedEventRepoFn: func() *automock.IngestedEventRepository {
+				repo := &automock.IngestedEventRepository{}
+				repo.On("Claim", txtest.CtxWithDBMatcher(), ingestedEventMatcher(resync.TenantCreatedEventType)).Return(false, testErr).Once()
+				return repo
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:  "Fails when creating tenants fails",
+			Event: fixTenantEvent(resync.TenantCreatedEventType),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenDoesntExpectCommit(1)
+			},
+			TenantStorageSvcFn: func() *automock.TenantStorageService {
+				svc := &automock.TenantStorageService{}
+				svc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{tenantID}).Return([]*model.BusinessTenantMapping{}, nil).Once()
+				return svc
+			},
+			TenantCreatorFn: func() *automock.TenantCreator {
+				svc := &automock.TenantCreator{}
+				svc.On("CreateTenants", ctxWithRegion, []model.BusinessTenantMappingInput{accountTenant}).Return(testErr).Once()
+				return svc
+			},
+			TenantDeleterFn:     func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			IngestedEventRepoFn: claimingEventRepoFn(resync.TenantCreatedEventType),
+			ExpectedErrMsg:      testErr.Error(),
+		},
+		{
+			Name:  "Fails when committing the claim of the event fails",
+			Event: fixTenantEvent(resync.TenantCreatedEventType),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndThenFailsOnCommit(1)
+			},
+			TenantStorageSvcFn: func() *automock.TenantStorageService {
+				svc := &automock.TenantStorageService{}
+				svc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{tenantID}).Return([]*model.BusinessTenantMapping{}, nil).Once()
+				return svc
+			},
+			TenantCreatorFn: func() *automock.TenantCreator {
+				svc := &automock.TenantCreator{}
+				svc.On("CreateTenants", ctxWithRegion, []model.BusinessTenantMappingInput{accountTenant}).Return(nil).Once()
+				return svc
+			},
+			TenantDeleterFn:     func() *automock.TenantDeleter { return &automock.TenantDeleter{} },
+			IngestedEventRepoFn: claimingEventRepoFn(resync.TenantCreatedEventType),
+			ExpectedErrMsg:      testErr.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			persistTx, transact := tc.TransactionerFn()
+			tenantStorageSvc := tc.TenantStorageSvcFn()
+			tenantCreator := tc.TenantCreatorFn()
+			tenantDeleter := tc.TenantDeleterFn()
+			ingestedEventRepo := tc.IngestedEventRepoFn()
+
+			defer mock.AssertExpectationsForObjects(t, persistTx, transact, tenantStorageSvc, tenantCreator, tenantDeleter, ingestedEventRepo)
+
+			synchronizer := resync.NewTenantSynchronizer(jobCfg, transact, tenantStorageSvc, tenantCreator, &automock.TenantMover{}, tenantDeleter, &automock.CheckpointStore{}, ingestedEventRepo, &automock.AggregationFailurePusher{})
+
+			err := synchronizer.ProcessTenantEvent(ctx, tc.Event)
+			if len(tc.ExpectedErrMsg) > 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTenantsSynchronizer_ReplayFrom(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+
+	jobCfg := resync.JobConfig{JobName: "account-fetcher", TenantType: tenant.Account}
+	from := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+	fromTimestamp := strconv.FormatInt(from.UnixMilli(), 10)
+
+	testCases := []struct {
+		Name              string
+		From              time.Time
+		CheckpointStoreFn func() *automock.CheckpointStore
+		ExpectedErrMsg    string
+	}{
+		{
+			Name: "Success",
+			From: from,
+			CheckpointStoreFn: func() *automock.CheckpointStore {
+				store := &automock.CheckpointStore{}
+				store.On("RequestReplay", ctx, fromTimestamp).Return(nil).Once()
+				return store
+			},
+		},
+		{
+			Name:              "Fails when replay timestamp is in the future",
+			From:              time.Now().Add(time.Hour),
+			CheckpointStoreFn: func() *automock.CheckpointStore { return &automock.CheckpointStore{} },
+			ExpectedErrMsg:    "is in the future",
+		},
+		{
+			Name: "Fails when requesting replay fails",
+			From: from,
+			CheckpointStoreFn: func() *automock.CheckpointStore {
+				store := &automock.CheckpointStore{}
+				store.On("RequestReplay", ctx, fromTimestamp).Return(testErr).Once()
+				return store
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			checkpointStore := tc.CheckpointStoreFn()
+			defer mock.AssertExpectationsForObjects(t, checkpointStore)
+
+			synchronizer := resync.NewTenantSynchronizer(jobCfg, &persistenceautomock.Transactioner{}, &automock.TenantStorageService{}, &automock.TenantCreator{}, &automock.TenantMover{}, &automock.TenantDeleter{}, checkpointStore, &automock.IngestedEventRepository{}, &automock.AggregationFailurePusher{})
+
+			err := synchronizer.ReplayFrom(ctx, tc.From)
+			if len(tc.ExpectedErrMsg) > 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
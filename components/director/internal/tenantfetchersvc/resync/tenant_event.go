@@ -0,0 +1,71 @@
+package resync
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+)
+
+const (
+	// CloudEventsSpecVersion is the version of the CloudEvents specification the pushed tenant events comply with
+	CloudEventsSpecVersion = "1.0"
+
+	// TenantCreatedEventType is the CloudEvents type of events for created tenants
+	TenantCreatedEventType = "compass.tenant.created"
+	// TenantUpdatedEventType is the CloudEvents type of events for updated tenants
+	TenantUpdatedEventType = "compass.tenant.updated"
+	// TenantDeletedEventType is the CloudEvents type of events for deleted tenants
+	TenantDeletedEventType = "compass.tenant.deleted"
+	// TenantMovedEventType is the CloudEvents type of events for subaccounts moved to another parent tenant
+	TenantMovedEventType = "compass.tenant.moved"
+)
+
+// TenantEvent is a tenant event in the CloudEvents structured JSON format pushed by an external system.
+// The data of the event has the same format as a single event returned by the events API of the tenant fetcher job.
+type TenantEvent struct {
+	SpecVersion string `json:"specversion"`
+	ID          string `json:"id"`
+	Source      string `json:"source"`
+	Type        string `json:"type"`
+	Time        string `json:"time,omitempty"`
+	// Region is an extension attribute with the region of the tenant. If missing, the central region of the job is used.
+	Region string          `json:"region,omitempty"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// Validate checks if the event contains all required CloudEvents attributes and is of a supported type
+func (e TenantEvent) Validate() error {
+	missingAttributes := make([]string, 0)
+	if e.ID == "" {
+		missingAttributes = append(missingAttributes, "id")
+	}
+	if e.Source == "" {
+		missingAttributes = append(missingAttributes, "source")
+	}
+	if e.Type == "" {
+		missingAttributes = append(missingAttributes, "type")
+	}
+	if len(e.Data) == 0 {
+		missingAttributes = append(missingAttributes, "data")
+	}
+	if len(missingAttributes) > 0 {
+		return apperrors.NewInvalidDataError("missing required event attributes: %s", strings.Join(missingAttributes, ", "))
+	}
+
+	if e.SpecVersion != CloudEventsSpecVersion {
+		return apperrors.NewInvalidDataError("unsupported CloudEvents spec version %q", e.SpecVersion)
+	}
+
+	switch e.Type {
+	case TenantCreatedEventType, TenantUpdatedEventType, TenantDeletedEventType, TenantMovedEventType:
+	default:
+		return apperrors.NewInvalidDataError("unsupported event type %q", e.Type)
+	}
+
+	if !json.Valid(e.Data) {
+		return apperrors.NewInvalidDataError("event data is not a valid JSON")
+	}
+
+	return nil
+}
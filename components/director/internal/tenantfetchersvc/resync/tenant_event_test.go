@@ -0,0 +1,77 @@
+package resync_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/tenantfetchersvc/resync"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantEvent_Validate(t *testing.T) {
+	validEvent := resync.TenantEvent{
+		SpecVersion: resync.CloudEventsSpecVersion,
+		ID:          "2f3dc9ae-66b9-4dbb-8cd0-5b5da0e3f5f1",
+		Source:      "/external-registry",
+		Type:        resync.TenantUpdatedEventType,
+		Data:        []byte(`{"guid":"da363eb6-9444-4452-9bf6-40ee7e8da4d8"}`),
+	}
+
+	testCases := []struct {
+		Name           string
+		EventFn        func() resync.TenantEvent
+		ExpectedErrMsg string
+	}{
+		{
+			Name:    "Valid event",
+			EventFn: func() resync.TenantEvent { return validEvent },
+		},
+		{
+			Name: "Missing required attributes",
+			EventFn: func() resync.TenantEvent {
+				return resync.TenantEvent{SpecVersion: resync.CloudEventsSpecVersion}
+			},
+			ExpectedErrMsg: "missing required event attributes: id, source, type, data",
+		},
+		{
+			Name: "Unsupported spec version",
+			EventFn: func() resync.TenantEvent {
+				event := validEvent
+				event.SpecVersion = "0.3"
+				return event
+			},
+			ExpectedErrMsg: `unsupported CloudEvents spec version "0.3"`,
+		},
+		{
+			Name: "Unsupported event type",
+			EventFn: func() resync.TenantEvent {
+				event := validEvent
+				event.Type = "compass.tenant.archived"
+				return event
+			},
+			ExpectedErrMsg: `unsupported event type "compass.tenant.archived"`,
+		},
+		{
+			Name: "Invalid event data",
+			EventFn: func() resync.TenantEvent {
+				event := validEvent
+				event.Data = []byte(`{"guid":`)
+				return event
+			},
+			ExpectedErrMsg: "event data is not a valid JSON",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.EventFn().Validate()
+			if len(tc.ExpectedErrMsg) > 0 {
+				require.Error(t, err)
+				require.Equal(t, apperrors.InvalidData, apperrors.ErrorCode(err))
+				require.Contains(t, err.Error(), tc.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+const accessPoliciesPath = "accessPolicies"
+
+// AccessPolicyEffect is the effect of an access policy on the requests it applies to
+type AccessPolicyEffect string
+
+const (
+	// AccessPolicyEffectAllow restricts the requests the policy applies to to the resources matching its resource selector
+	AccessPolicyEffectAllow AccessPolicyEffect = "allow"
+	// AccessPolicyEffectDeny denies the requests the policy applies to on the resources matching its resource selector
+	AccessPolicyEffectDeny AccessPolicyEffect = "deny"
+)
+
+// AccessPolicy is a declarative attribute-based access policy. An empty list of consumer types, consumer IDs, tenants,
+// resource types or operations matches any value. The resource selector matches the resources which have all the given
+// attributes, e.g. labels of applications and runtimes or the template of formations.
+type AccessPolicy struct {
+	Name             string             `json:"name"`
+	Effect           AccessPolicyEffect `json:"effect"`
+	ConsumerTypes    []string           `json:"consumerTypes,omitempty"`
+	ConsumerIDs      []string           `json:"consumerIDs,omitempty"`
+	Tenants          []string           `json:"tenants,omitempty"`
+	ResourceTypes    []string           `json:"resourceTypes,omitempty"`
+	Operations       []string           `json:"operations,omitempty"`
+	ResourceSelector map[string]string  `json:"resourceSelector,omitempty"`
+}
+
+// GetAccessPolicies returns the access policies from the configuration or nil if none are configured
+func (p *Provider) GetAccessPolicies() ([]AccessPolicy, error) {
+	if _, ok := p.cachedConfig[accessPoliciesPath]; p.cachedConfig != nil && !ok {
+		return nil, nil
+	}
+
+	val, err := p.getValueForJSONPath(accessPoliciesPath)
+	if err != nil {
+		if apperrors.IsValueNotFoundInConfiguration(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if _, ok := val.([]interface{}); !ok {
+		return nil, fmt.Errorf("unexpected access policies definition, should be a list, but was %T", val)
+	}
+
+	policiesJSON, err := json.Marshal(val)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling access policies")
+	}
+
+	var policies []AccessPolicy
+	if err := json.Unmarshal(policiesJSON, &policies); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling access policies")
+	}
+
+	names := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		if len(policy.Name) == 0 {
+			return nil, errors.New("access policy name is required")
+		}
+		if names[policy.Name] {
+			return nil, fmt.Errorf("access policy %q is defined more than once", policy.Name)
+		}
+		names[policy.Name] = true
+
+		if policy.Effect != AccessPolicyEffectAllow && policy.Effect != AccessPolicyEffectDeny {
+			return nil, fmt.Errorf("unexpected effect %q of access policy %q", policy.Effect, policy.Name)
+		}
+	}
+
+	return policies, nil
+}
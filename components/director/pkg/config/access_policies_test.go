@@ -0,0 +1,71 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_GetAccessPolicies(t *testing.T) {
+	t.Run("requires Load", func(t *testing.T) {
+		sut := config.NewProvider("anything")
+		_, err := sut.GetAccessPolicies()
+		require.Error(t, err, "required configuration not loaded")
+	})
+
+	// GIVEN
+	sut := config.NewProvider("testdata/valid.yaml")
+	require.NoError(t, sut.Load())
+
+	t.Run("returns access policies", func(t *testing.T) {
+		expected := []config.AccessPolicy{
+			{
+				Name:          "int-system-s4-applications",
+				Effect:        config.AccessPolicyEffectAllow,
+				ConsumerTypes: []string{"Integration System"},
+				ConsumerIDs:   []string{"c7b0f2c1-6b3e-4a0e-9d4e-4d3c1e0b9b11"},
+				ResourceTypes: []string{"application"},
+				Operations:    []string{"update", "delete"},
+				ResourceSelector: map[string]string{
+					"applicationType": "SAP S/4HANA",
+				},
+			},
+			{
+				Name:          "deny-runtime-deletion",
+				Effect:        config.AccessPolicyEffectDeny,
+				ResourceTypes: []string{"runtime"},
+				Operations:    []string{"delete"},
+			},
+		}
+		// WHEN
+		actual, err := sut.GetAccessPolicies()
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	sut = config.NewProvider("testdata/valid-hide-selectors-empty.yaml")
+	require.NoError(t, sut.Load())
+
+	t.Run("returns nil when no access policies are specified", func(t *testing.T) {
+		// WHEN
+		actual, err := sut.GetAccessPolicies()
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, actual)
+	})
+
+	sut = config.NewProvider("testdata/invalid-access-policies-invalid-effect.yaml")
+	require.NoError(t, sut.Load())
+
+	t.Run("returns error when access policy effect is not supported", func(t *testing.T) {
+		// WHEN
+		_, err := sut.GetAccessPolicies()
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unexpected effect "maybe" of access policy "invalid"`)
+	})
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 )
@@ -16,6 +17,40 @@ func (p *Provider) GetRequiredGrantTypes(path string) ([]string, error) {
 	return p.getValues("grant_types", path, false)
 }
 
+// GetDefinedScopes returns all scopes which are required by at least one operation or field of the GraphQL API
+func (p *Provider) GetDefinedScopes() ([]string, error) {
+	val, err := p.getValueForJSONPath("graphql")
+	if err != nil {
+		return nil, err
+	}
+
+	definedScopes := make(map[string]bool)
+	collectScopes(val, definedScopes)
+
+	scopes := make([]string, 0, len(definedScopes))
+	for scope := range definedScopes {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+
+	return scopes, nil
+}
+
+func collectScopes(val interface{}, scopes map[string]bool) {
+	switch v := val.(type) {
+	case string:
+		scopes[v] = true
+	case []interface{}:
+		for _, item := range v {
+			collectScopes(item, scopes)
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			collectScopes(item, scopes)
+		}
+	}
+}
+
 func (p *Provider) getValues(valueType, path string, singeValueExpected bool) ([]string, error) {
 	val, err := p.getValueForJSONPath(path)
 	if err != nil {
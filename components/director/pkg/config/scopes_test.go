@@ -73,6 +73,27 @@ func TestProvider_GetRequiredScopes(t *testing.T) {
 	})
 }
 
+func TestProvider_GetDefinedScopes(t *testing.T) {
+	t.Run("requires Load", func(t *testing.T) {
+		sut := config.NewProvider("anything")
+		_, err := sut.GetDefinedScopes()
+		require.Error(t, err, "required configuration not loaded")
+	})
+
+	t.Run("returns the scopes of all GraphQL operations", func(t *testing.T) {
+		// GIVEN
+		sut := config.NewProvider("testdata/valid.yaml")
+		require.NoError(t, sut.Load())
+
+		// WHEN
+		actual, err := sut.GetDefinedScopes()
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []string{"application:create", "application:delete", "application:get", "global:create", "runtime:get"}, actual)
+	})
+}
+
 func TestProvider_GetRequiredGrantTypes(t *testing.T) {
 	const grantTypesPath = "clientCredentialsRegistrationGrantTypes"
 	var expectedGrantTypes = []string{"client_credentials"}
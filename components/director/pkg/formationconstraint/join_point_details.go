@@ -11,6 +11,8 @@ import (
 type MatchingDetails struct {
 	ResourceType    model.ResourceType
 	ResourceSubtype string
+	// FormationTemplateVersion is the formation template version the formation is pinned to. Zero means the constraints currently attached to the formation template apply.
+	FormationTemplateVersion int
 }
 
 // JoinPointDetails provides an interface for join point details
@@ -20,56 +22,62 @@ type JoinPointDetails interface {
 
 // CRUDFormationOperationDetails contains details applicable to createFormation and deleteFormation join points
 type CRUDFormationOperationDetails struct {
-	FormationType       string
-	FormationTemplateID string
-	FormationName       string
-	TenantID            string
+	FormationType            string
+	FormationTemplateID      string
+	FormationName            string
+	TenantID                 string
+	FormationTemplateVersion int
 }
 
 // GetMatchingDetails returns matching details for CRUDFormationOperationDetails
 func (d *CRUDFormationOperationDetails) GetMatchingDetails() MatchingDetails {
 	return MatchingDetails{
-		ResourceType:    model.FormationResourceType,
-		ResourceSubtype: d.FormationType,
+		ResourceType:             model.FormationResourceType,
+		ResourceSubtype:          d.FormationType,
+		FormationTemplateVersion: d.FormationTemplateVersion,
 	}
 }
 
 // AssignFormationOperationDetails contains details applicable to assignFormation join point
 type AssignFormationOperationDetails struct {
-	ResourceType        model.ResourceType
-	ResourceSubtype     string
-	ResourceID          string
-	FormationType       string
-	FormationTemplateID string
-	FormationID         string
-	FormationName       string
-	TenantID            string
+	ResourceType             model.ResourceType
+	ResourceSubtype          string
+	ResourceID               string
+	FormationType            string
+	FormationTemplateID      string
+	FormationID              string
+	FormationName            string
+	TenantID                 string
+	FormationTemplateVersion int
 }
 
 // GetMatchingDetails returns matching details for AssignFormationOperationDetails
 func (d *AssignFormationOperationDetails) GetMatchingDetails() MatchingDetails {
 	return MatchingDetails{
-		ResourceType:    d.ResourceType,
-		ResourceSubtype: d.ResourceSubtype,
+		ResourceType:             d.ResourceType,
+		ResourceSubtype:          d.ResourceSubtype,
+		FormationTemplateVersion: d.FormationTemplateVersion,
 	}
 }
 
 // UnassignFormationOperationDetails contains details applicable to unassignFormation join point
 type UnassignFormationOperationDetails struct {
-	ResourceType        model.ResourceType
-	ResourceSubtype     string
-	ResourceID          string
-	FormationType       string
-	FormationTemplateID string
-	FormationID         string
-	TenantID            string
+	ResourceType             model.ResourceType
+	ResourceSubtype          string
+	ResourceID               string
+	FormationType            string
+	FormationTemplateID      string
+	FormationID              string
+	TenantID                 string
+	FormationTemplateVersion int
 }
 
 // GetMatchingDetails returns matching details for UnassignFormationOperationDetails
 func (d *UnassignFormationOperationDetails) GetMatchingDetails() MatchingDetails {
 	return MatchingDetails{
-		ResourceType:    d.ResourceType,
-		ResourceSubtype: d.ResourceSubtype,
+		ResourceType:             d.ResourceType,
+		ResourceSubtype:          d.ResourceSubtype,
+		FormationTemplateVersion: d.FormationTemplateVersion,
 	}
 }
 
@@ -105,27 +113,30 @@ type GenerateFormationAssignmentNotificationOperationDetails struct {
 // GetMatchingDetails returns matching details for GenerateFormationAssignmentNotificationOperationDetails
 func (d *GenerateFormationAssignmentNotificationOperationDetails) GetMatchingDetails() MatchingDetails {
 	return MatchingDetails{
-		ResourceType:    d.ResourceType,
-		ResourceSubtype: d.ResourceSubtype,
+		ResourceType:             d.ResourceType,
+		ResourceSubtype:          d.ResourceSubtype,
+		FormationTemplateVersion: pinnedFormationTemplateVersion(d.Formation),
 	}
 }
 
 // GenerateFormationNotificationOperationDetails contains details applicable to generate formation notifications join point
 type GenerateFormationNotificationOperationDetails struct {
-	Operation             model.FormationOperation
-	FormationID           string
-	FormationName         string
-	FormationType         string
-	FormationTemplateID   string
-	TenantID              string
-	CustomerTenantContext *webhook.CustomerTenantContext
+	Operation                model.FormationOperation
+	FormationID              string
+	FormationName            string
+	FormationType            string
+	FormationTemplateID      string
+	TenantID                 string
+	FormationTemplateVersion int
+	CustomerTenantContext    *webhook.CustomerTenantContext
 }
 
 // GetMatchingDetails returns matching details for GenerateFormationAssignmentNotificationOperationDetails
 func (d *GenerateFormationNotificationOperationDetails) GetMatchingDetails() MatchingDetails {
 	return MatchingDetails{
-		ResourceType:    model.FormationResourceType,
-		ResourceSubtype: d.FormationType,
+		ResourceType:             model.FormationResourceType,
+		ResourceSubtype:          d.FormationType,
+		FormationTemplateVersion: d.FormationTemplateVersion,
 	}
 }
 
@@ -146,8 +157,9 @@ type SendNotificationOperationDetails struct {
 // GetMatchingDetails returns matching details for SendNotificationOperationDetails
 func (d *SendNotificationOperationDetails) GetMatchingDetails() MatchingDetails {
 	return MatchingDetails{
-		ResourceType:    d.ResourceType,
-		ResourceSubtype: d.ResourceSubtype,
+		ResourceType:             d.ResourceType,
+		ResourceSubtype:          d.ResourceSubtype,
+		FormationTemplateVersion: pinnedFormationTemplateVersion(d.Formation),
 	}
 }
 
@@ -169,7 +181,16 @@ type NotificationStatusReturnedOperationDetails struct {
 // GetMatchingDetails returns matching details for NotificationStatusReturnedOperationDetails
 func (d *NotificationStatusReturnedOperationDetails) GetMatchingDetails() MatchingDetails {
 	return MatchingDetails{
-		ResourceType:    d.ResourceType,
-		ResourceSubtype: d.ResourceSubtype,
+		ResourceType:             d.ResourceType,
+		ResourceSubtype:          d.ResourceSubtype,
+		FormationTemplateVersion: pinnedFormationTemplateVersion(d.Formation),
+	}
+}
+
+// pinnedFormationTemplateVersion returns the formation template version the formation is pinned to, or zero if the formation is not known
+func pinnedFormationTemplateVersion(formation *model.Formation) int {
+	if formation == nil {
+		return 0
 	}
+	return formation.FormationTemplateVersion
 }
@@ -46,9 +46,23 @@ func (i PlaceholderDefinitionInput) Validate() error {
 		validation.Field(&i.Name, validation.Required, inputvalidation.DNSName),
 		validation.Field(&i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
 		validation.Field(&i.JSONPath, validation.RuneLength(0, jsonPathStringLengthLimit)),
+		validation.Field(&i.Pattern, validation.RuneLength(0, descriptionStringLengthLimit), validation.By(validRegexPattern)),
+		validation.Field(&i.AllowedValues, validation.When(i.Type != nil && *i.Type == PlaceholderTypeEnum, validation.Required).Else(validation.Empty), validation.Each(validation.Required)),
 	)
 }
 
+func validRegexPattern(value interface{}) error {
+	pattern, ok := value.(*string)
+	if !ok || pattern == nil {
+		return nil
+	}
+
+	if _, err := regexp.Compile(*pattern); err != nil {
+		return errors.Errorf("invalid regular expression: %s", err)
+	}
+	return nil
+}
+
 // Validate missing godoc
 func (i ApplicationFromTemplateInput) Validate() error {
 	return validation.Errors{
@@ -789,6 +789,108 @@ func TestPlaceholderDefinitionInput_Validate_Description(t *testing.T) {
 	}
 }
 
+func TestPlaceholderDefinitionInput_Validate_Pattern(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Value *string
+		Valid bool
+	}{
+		{
+			Name:  "Valid",
+			Value: str.Ptr("^[A-Z]{3}$"),
+			Valid: true,
+		},
+		{
+			Name:  "Valid - Nil",
+			Value: (*string)(nil),
+			Valid: true,
+		},
+		{
+			Name:  "Invalid - Not a regular expression",
+			Value: str.Ptr("[A-Z"),
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Too long",
+			Value: str.Ptr(inputvalidationtest.String2001Long),
+			Valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidPlaceholderDefintionInput()
+			sut.Pattern = testCase.Value
+			// WHEN
+			err := sut.Validate()
+			// THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPlaceholderDefinitionInput_Validate_AllowedValues(t *testing.T) {
+	enumType := graphql.PlaceholderTypeEnum
+	stringType := graphql.PlaceholderTypeString
+
+	testCases := []struct {
+		Name          string
+		Type          *graphql.PlaceholderType
+		AllowedValues []string
+		Valid         bool
+	}{
+		{
+			Name:          "Valid - Enum with allowed values",
+			Type:          &enumType,
+			AllowedValues: []string{"eu10", "us10"},
+			Valid:         true,
+		},
+		{
+			Name:  "Valid - String without allowed values",
+			Type:  &stringType,
+			Valid: true,
+		},
+		{
+			Name:  "Invalid - Enum without allowed values",
+			Type:  &enumType,
+			Valid: false,
+		},
+		{
+			Name:          "Invalid - Enum with empty allowed value",
+			Type:          &enumType,
+			AllowedValues: []string{"eu10", ""},
+			Valid:         false,
+		},
+		{
+			Name:          "Invalid - Allowed values without type",
+			AllowedValues: []string{"eu10"},
+			Valid:         false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidPlaceholderDefintionInput()
+			sut.Type = testCase.Type
+			sut.AllowedValues = testCase.AllowedValues
+			// WHEN
+			err := sut.Validate()
+			// THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestApplicationFromTemplateInput_Validate_Rule_EitherPlaceholdersOrPlaceholdersPayloadExists(t *testing.T) {
 	testPlaceholderName := "test"
 	testPlacehoderPayload := "{\"a\":\"b\"}"
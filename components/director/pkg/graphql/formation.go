@@ -10,6 +10,7 @@ type Formation struct {
 	Error                         FormationError `json:"error"`
 	LastStateChangeTimestamp      *Timestamp     `json:"lastStateChangeTimestamp"`
 	LastNotificationSentTimestamp *Timestamp     `json:"lastNotificationSentTimestamp"`
+	FormationTemplateVersion      int            `json:"formationTemplateVersion"`
 }
 
 // FormationPageExt is an extended types used by external API
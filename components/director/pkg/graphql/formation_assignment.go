@@ -13,6 +13,8 @@ type FormationAssignment struct {
 	Error                         *string                 `json:"error"`
 	LastStateChangeTimestamp      *Timestamp              `json:"lastStateChangeTimestamp"`
 	LastNotificationSentTimestamp *Timestamp              `json:"lastNotificationSentTimestamp"`
+	ValidFrom                     *Timestamp              `json:"validFrom"`
+	ValidUntil                    *Timestamp              `json:"validUntil"`
 }
 
 // FormationAssignmentPageExt is an extended types used by external API
@@ -665,7 +665,12 @@ func (fp *GqlFieldsProvider) ForPlaceholders() string {
 	return `
 		name
 		description
-		jsonPath`
+		jsonPath
+		type
+		pattern
+		allowedValues
+		defaultValue
+		sensitive`
 }
 
 // ForEventingConfiguration missing godoc
@@ -701,6 +701,21 @@ func (g *Graphqlizer) PlaceholderDefinitionInputToGQL(in graphql.PlaceholderDefi
 		{{- if .JSONPath }}
 		jsonPath: "{{.JSONPath}}",
 		{{- end }}
+		{{- if .Type }}
+		type: {{.Type}},
+		{{- end }}
+		{{- if .Pattern }}
+		pattern: {{ marshal .Pattern }},
+		{{- end }}
+		{{- if .AllowedValues }}
+		allowedValues: {{ marshal .AllowedValues }},
+		{{- end }}
+		{{- if .DefaultValue }}
+		defaultValue: {{ marshal .DefaultValue }},
+		{{- end }}
+		{{- if .Sensitive }}
+		sensitive: {{.Sensitive}},
+		{{- end }}
 	}`)
 }
 
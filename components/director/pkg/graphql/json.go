@@ -37,3 +37,13 @@ func (j JSON) MarshalGQL(w io.Writer) {
 		log.D().Errorf("while writing %T: %s", j, err)
 	}
 }
+
+// JSONPtrFromRawMessage converts a raw JSON message to a JSON pointer. Empty and null messages are converted to nil
+func JSONPtrFromRawMessage(in json.RawMessage) *JSON {
+	if len(in) == 0 || string(in) == "null" {
+		return nil
+	}
+
+	out := JSON(in)
+	return &out
+}
@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -83,3 +84,37 @@ func TestJSON_MarshalGQL(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONPtrFromRawMessage(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input    json.RawMessage
+		expected *JSON
+	}{
+		//given
+		"object": {
+			input:    json.RawMessage(`{"schema":"schema"}`),
+			expected: rawJSONPtr(`{"schema":"schema"}`),
+		},
+		"array": {
+			input:    json.RawMessage(`["a","b"]`),
+			expected: rawJSONPtr(`["a","b"]`),
+		},
+		"null": {
+			input:    json.RawMessage("null"),
+			expected: nil,
+		},
+		"empty": {
+			input:    nil,
+			expected: nil,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, JSONPtrFromRawMessage(tc.input))
+		})
+	}
+}
+
+func rawJSONPtr(in string) *JSON {
+	out := JSON(in)
+	return &out
+}
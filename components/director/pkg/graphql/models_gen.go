@@ -53,6 +53,13 @@ type APIDefinitionPage struct {
 
 func (APIDefinitionPage) IsPageable() {}
 
+// Selects the part of the API model which is mapped to ORD entity types
+type APIModelSelector struct {
+	Type          string  `json:"type"`
+	EntitySetName *string `json:"entitySetName,omitempty"`
+	JSONPointer   *string `json:"jsonPointer,omitempty"`
+}
+
 // **Validation:**
 // - for ODATA type, accepted formats are XML and JSON, for OPEN_API accepted formats are YAML and JSON
 // - data or fetchRequest required
@@ -63,6 +70,33 @@ type APISpecInput struct {
 	FetchRequest *FetchRequestInput `json:"fetchRequest,omitempty"`
 }
 
+// Explains whether the access policies allow a consumer to perform an operation on a resource
+type AccessExplanation struct {
+	Allowed  bool                      `json:"allowed"`
+	Reason   string                    `json:"reason"`
+	Policies []*AccessPolicyEvaluation `json:"policies"`
+}
+
+type AccessPolicyEvaluation struct {
+	Policy string             `json:"policy"`
+	Effect AccessPolicyEffect `json:"effect"`
+	// Whether the policy applies to the consumer, the tenant, the resource type and the operation
+	Applicable bool `json:"applicable"`
+	// Whether the resource matches the resource selector of the policy
+	SelectorMatched bool   `json:"selectorMatched"`
+	Reason          string `json:"reason"`
+}
+
+type AccessRequestInput struct {
+	// ID of the consumer. Defaults to the ID of the requesting consumer.
+	ConsumerID *string `json:"consumerID,omitempty"`
+	// Type of the consumer, e.g. "Integration System". Defaults to the type of the requesting consumer.
+	ConsumerType *string `json:"consumerType,omitempty"`
+	ResourceType string  `json:"resourceType"`
+	ResourceID   string  `json:"resourceID"`
+	Operation    string  `json:"operation"`
+}
+
 type AppSystemAuth struct {
 	ID                string                   `json:"id"`
 	Auth              *Auth                    `json:"auth,omitempty"`
@@ -110,6 +144,36 @@ type ApplicationJSONInput struct {
 	Bundles              []*BundleCreateInput        `json:"bundles,omitempty"`
 }
 
+// A value set in both merged applications. The value of the destination application is kept.
+type ApplicationMergeConflict struct {
+	Type             ApplicationMergeConflictType `json:"type"`
+	Key              string                       `json:"key"`
+	DestinationValue interface{}                  `json:"destinationValue,omitempty"`
+	SourceValue      interface{}                  `json:"sourceValue,omitempty"`
+}
+
+// The outcome of merging the source application into the destination application, computed without persisting anything.
+type ApplicationMergePreview struct {
+	DestinationID string `json:"destinationID"`
+	SourceID      string `json:"sourceID"`
+	// The labels of the destination application after the merge
+	Labels    Labels                      `json:"labels,omitempty"`
+	Conflicts []*ApplicationMergeConflict `json:"conflicts"`
+	// Bundles, API and event definitions of the source application. They are not transferred to the destination application and are removed together with the source application, as are all its other resources, e.g. packages, specifications, entity types, capabilities and data products.
+	RemovedBundles          []*ApplicationMergeResource `json:"removedBundles"`
+	RemovedAPIDefinitions   []*ApplicationMergeResource `json:"removedAPIDefinitions"`
+	RemovedEventDefinitions []*ApplicationMergeResource `json:"removedEventDefinitions"`
+	// Formations of the source application. The source application has to be unassigned from them before the merge.
+	AffectedFormations []string `json:"affectedFormations"`
+	// Reasons for which the merge would be rejected. The merge can be executed only if the list is empty.
+	ValidationErrors []string `json:"validationErrors"`
+}
+
+type ApplicationMergeResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 type ApplicationPage struct {
 	Data       []*Application `json:"data"`
 	PageInfo   *PageInfo      `json:"pageInfo"`
@@ -144,6 +208,19 @@ type ApplicationStatus struct {
 	Timestamp Timestamp                  `json:"timestamp"`
 }
 
+type ApplicationTemplateDrift struct {
+	ApplicationTemplateID string                                `json:"applicationTemplateID"`
+	InSync                bool                                  `json:"inSync"`
+	Differences           []*ApplicationTemplateDriftDifference `json:"differences"`
+}
+
+type ApplicationTemplateDriftDifference struct {
+	// One of name, description, providerName, baseUrl, labels.<key> or webhooks.<type>
+	Field    string `json:"field"`
+	Current  *JSON  `json:"current,omitempty"`
+	Expected *JSON  `json:"expected,omitempty"`
+}
+
 // **Validation:** provided placeholders' names are unique and used in applicationInput
 type ApplicationTemplateInput struct {
 	// **Validation:** ASCII printable characters, max=100
@@ -180,6 +257,13 @@ type ApplicationTemplateUpdateInput struct {
 	ApplicationNamespace *string                        `json:"applicationNamespace,omitempty"`
 }
 
+type ApplicationTemplateUpgradeResult struct {
+	ApplicationID string                                `json:"applicationID"`
+	Status        ApplicationTemplateUpgradeStatus      `json:"status"`
+	Differences   []*ApplicationTemplateDriftDifference `json:"differences"`
+	Error         *string                               `json:"error,omitempty"`
+}
+
 type ApplicationUpdateInput struct {
 	// **Validation:** max=256
 	ProviderName *string `json:"providerName,omitempty"`
@@ -435,6 +519,70 @@ type CSRFTokenCredentialRequestAuthInput struct {
 	AdditionalQueryParamsSerialized *QueryParamsSerialized `json:"additionalQueryParamsSerialized,omitempty"`
 }
 
+// An ORD capability of an application or an application template version
+type Capability struct {
+	ID                  string   `json:"id"`
+	OrdID               *string  `json:"ordID,omitempty"`
+	LocalID             *string  `json:"localID,omitempty"`
+	Name                string   `json:"name"`
+	Description         *string  `json:"description,omitempty"`
+	ShortDescription    *string  `json:"shortDescription,omitempty"`
+	Type                string   `json:"type"`
+	CustomType          *string  `json:"customType,omitempty"`
+	PartOfPackage       *string  `json:"partOfPackage,omitempty"`
+	Visibility          *string  `json:"visibility,omitempty"`
+	ReleaseStatus       *string  `json:"releaseStatus,omitempty"`
+	SystemInstanceAware *bool    `json:"systemInstanceAware,omitempty"`
+	RelatedEntityTypes  []string `json:"relatedEntityTypes,omitempty"`
+	Links               *JSON    `json:"links,omitempty"`
+	Tags                *JSON    `json:"tags,omitempty"`
+	Labels              Labels   `json:"labels,omitempty"`
+	DocumentationLabels *JSON    `json:"documentationLabels,omitempty"`
+	CorrelationIDs      *JSON    `json:"correlationIDs,omitempty"`
+	Version             *Version `json:"version,omitempty"`
+	LastUpdate          *string  `json:"lastUpdate,omitempty"`
+}
+
+type CapabilityPage struct {
+	Data       []*Capability `json:"data"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+func (CapabilityPage) IsPageable() {}
+
+type CatalogSearchFilter struct {
+	// Only resources of these applications are returned
+	ApplicationIDs []string `json:"applicationIDs,omitempty"`
+	// Only resources with one of these release statuses are returned, for example `active` or `deprecated`
+	ReleaseStatuses []string `json:"releaseStatuses,omitempty"`
+	// Only resources with one of these visibilities are returned, for example `public` or `internal`
+	Visibilities []string `json:"visibilities,omitempty"`
+}
+
+// Resource of the API and event catalog which matches a full-text search
+type CatalogSearchResult struct {
+	ResourceType CatalogResourceType `json:"resourceType"`
+	ID           string              `json:"id"`
+	OrdID        *string             `json:"ordID,omitempty"`
+	// Name of the resource, or its title for entity types and data products
+	Name          string  `json:"name"`
+	Description   *string `json:"description,omitempty"`
+	ApplicationID *string `json:"applicationID,omitempty"`
+	ReleaseStatus *string `json:"releaseStatus,omitempty"`
+	Visibility    *string `json:"visibility,omitempty"`
+	// Relevance of the resource for the search query. Higher values are more relevant.
+	Rank float64 `json:"rank"`
+}
+
+type CatalogSearchResultPage struct {
+	Data       []*CatalogSearchResult `json:"data"`
+	PageInfo   *PageInfo              `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+func (CatalogSearchResultPage) IsPageable() {}
+
 type CertificateOAuthCredentialData struct {
 	ClientID    string `json:"clientId"`
 	Certificate string `json:"certificate"`
@@ -496,6 +644,96 @@ type CredentialRequestAuthInput struct {
 	Csrf *CSRFTokenCredentialRequestAuthInput `json:"csrf,omitempty"`
 }
 
+// An ORD data product of an application or an application template version
+type DataProduct struct {
+	ID                  string   `json:"id"`
+	OrdID               *string  `json:"ordID,omitempty"`
+	LocalID             *string  `json:"localID,omitempty"`
+	Title               string   `json:"title"`
+	ShortDescription    *string  `json:"shortDescription,omitempty"`
+	Description         *string  `json:"description,omitempty"`
+	PartOfPackage       *string  `json:"partOfPackage,omitempty"`
+	Version             *Version `json:"version,omitempty"`
+	LastUpdate          *string  `json:"lastUpdate,omitempty"`
+	Visibility          *string  `json:"visibility,omitempty"`
+	ReleaseStatus       *string  `json:"releaseStatus,omitempty"`
+	Disabled            *bool    `json:"disabled,omitempty"`
+	DeprecationDate     *string  `json:"deprecationDate,omitempty"`
+	SunsetDate          *string  `json:"sunsetDate,omitempty"`
+	Successors          *JSON    `json:"successors,omitempty"`
+	ChangeLogEntries    *JSON    `json:"changeLogEntries,omitempty"`
+	Type                string   `json:"type"`
+	Category            string   `json:"category"`
+	EntityTypes         *JSON    `json:"entityTypes,omitempty"`
+	InputPorts          *JSON    `json:"inputPorts,omitempty"`
+	OutputPorts         *JSON    `json:"outputPorts,omitempty"`
+	Responsible         *string  `json:"responsible,omitempty"`
+	DataProductLinks    *JSON    `json:"dataProductLinks,omitempty"`
+	Links               *JSON    `json:"links,omitempty"`
+	Industry            *JSON    `json:"industry,omitempty"`
+	LineOfBusiness      *JSON    `json:"lineOfBusiness,omitempty"`
+	Tags                *JSON    `json:"tags,omitempty"`
+	Labels              Labels   `json:"labels,omitempty"`
+	DocumentationLabels *JSON    `json:"documentationLabels,omitempty"`
+	PolicyLevel         *string  `json:"policyLevel,omitempty"`
+	CustomPolicyLevel   *string  `json:"customPolicyLevel,omitempty"`
+	SystemInstanceAware *bool    `json:"systemInstanceAware,omitempty"`
+	CorrelationIDs      *JSON    `json:"correlationIDs,omitempty"`
+}
+
+type DataProductPage struct {
+	Data       []*DataProduct `json:"data"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (DataProductPage) IsPageable() {}
+
+// Application which was soft deleted and can be restored with `restoreApplication` until `purgeAfter`
+type DeletedApplication struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	DeletedAt  Timestamp `json:"deletedAt"`
+	PurgeAfter Timestamp `json:"purgeAfter"`
+}
+
+type DeletedApplicationPage struct {
+	Data       []*DeletedApplication `json:"data"`
+	PageInfo   *PageInfo             `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+func (DeletedApplicationPage) IsPageable() {}
+
+// Non-sensitive metadata of a destination fetched from the destination service of the tenant
+type Destination struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Type               string `json:"type"`
+	URL                string `json:"url"`
+	AuthenticationType string `json:"authenticationType"`
+	// The bundle which the destination is linked to by the destination fetcher
+	BundleID *string `json:"bundleID,omitempty"`
+	// The destination fetcher synchronization in which the destination was last fetched
+	Revision          *string    `json:"revision,omitempty"`
+	LastSyncTimestamp *Timestamp `json:"lastSyncTimestamp,omitempty"`
+}
+
+type DestinationFilter struct {
+	// Case-insensitive part of the destination name
+	Name               *string `json:"name,omitempty"`
+	Type               *string `json:"type,omitempty"`
+	AuthenticationType *string `json:"authenticationType,omitempty"`
+}
+
+type DestinationPage struct {
+	Data       []*Destination `json:"data"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (DestinationPage) IsPageable() {}
+
 type DocumentInput struct {
 	// **Validation:** max=128
 	Title string `json:"title"`
@@ -518,6 +756,57 @@ type DocumentPage struct {
 
 func (DocumentPage) IsPageable() {}
 
+// An ORD entity type of an application or an application template version
+type EntityType struct {
+	ID                  string   `json:"id"`
+	OrdID               string   `json:"ordID"`
+	LocalID             string   `json:"localID"`
+	CorrelationIDs      *JSON    `json:"correlationIDs,omitempty"`
+	Level               string   `json:"level"`
+	Title               string   `json:"title"`
+	ShortDescription    *string  `json:"shortDescription,omitempty"`
+	Description         *string  `json:"description,omitempty"`
+	SystemInstanceAware *bool    `json:"systemInstanceAware,omitempty"`
+	ChangeLogEntries    *JSON    `json:"changeLogEntries,omitempty"`
+	PartOfPackage       string   `json:"partOfPackage"`
+	Visibility          string   `json:"visibility"`
+	Links               *JSON    `json:"links,omitempty"`
+	PartOfProducts      []string `json:"partOfProducts,omitempty"`
+	LastUpdate          *string  `json:"lastUpdate,omitempty"`
+	PolicyLevel         *string  `json:"policyLevel,omitempty"`
+	CustomPolicyLevel   *string  `json:"customPolicyLevel,omitempty"`
+	ReleaseStatus       string   `json:"releaseStatus"`
+	SunsetDate          *string  `json:"sunsetDate,omitempty"`
+	DeprecationDate     *string  `json:"deprecationDate,omitempty"`
+	Successors          *JSON    `json:"successors,omitempty"`
+	Extensible          *JSON    `json:"extensible,omitempty"`
+	Tags                *JSON    `json:"tags,omitempty"`
+	Labels              Labels   `json:"labels,omitempty"`
+	DocumentationLabels *JSON    `json:"documentationLabels,omitempty"`
+	Version             *Version `json:"version,omitempty"`
+}
+
+// An ORD entity type mapping of an API or event definition
+type EntityTypeMapping struct {
+	ID                string              `json:"id"`
+	APIModelSelectors []*APIModelSelector `json:"apiModelSelectors,omitempty"`
+	EntityTypeTargets []*EntityTypeTarget `json:"entityTypeTargets,omitempty"`
+}
+
+type EntityTypePage struct {
+	Data       []*EntityType `json:"data"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+func (EntityTypePage) IsPageable() {}
+
+// References an ORD entity type either by its ORD ID or by its correlation ID
+type EntityTypeTarget struct {
+	OrdID         *string `json:"ordID,omitempty"`
+	CorrelationID *string `json:"correlationID,omitempty"`
+}
+
 type EventDefinitionInput struct {
 	// **Validation:** ASCII printable characters, max=100
 	Name string `json:"name"`
@@ -648,6 +937,20 @@ type FormationStatusError struct {
 	ErrorCode    int     `json:"errorCode"`
 }
 
+type FormationTemplateMigrationResult struct {
+	FormationID string                           `json:"formationID"`
+	FromVersion int                              `json:"fromVersion"`
+	ToVersion   int                              `json:"toVersion"`
+	Status      FormationTemplateMigrationStatus `json:"status"`
+	// Whether the webhooks of the target version differ from the ones of the version the formation was pinned to. If so, the notifications of the formation are re-sent after the migration.
+	WebhooksChanged      bool     `json:"webhooksChanged"`
+	AddedConstraintIDs   []string `json:"addedConstraintIDs"`
+	RemovedConstraintIDs []string `json:"removedConstraintIDs"`
+	// Participants of the formation which are not allowed by the target version
+	Violations []string `json:"violations"`
+	Error      *string  `json:"error,omitempty"`
+}
+
 type FormationTemplatePage struct {
 	Data       []*FormationTemplate `json:"data"`
 	PageInfo   *PageInfo            `json:"pageInfo"`
@@ -680,12 +983,25 @@ type FormationTemplateUpdateInput struct {
 	DiscoveryConsumers     []string      `json:"discoveryConsumers,omitempty"`
 }
 
+type FormationTemplateVersion struct {
+	FormationTemplateID string    `json:"formationTemplateID"`
+	Version             int       `json:"version"`
+	Name                string    `json:"name"`
+	ApplicationTypes    []string  `json:"applicationTypes"`
+	RuntimeTypes        []string  `json:"runtimeTypes,omitempty"`
+	SupportsReset       bool      `json:"supportsReset"`
+	WebhookIDs          []string  `json:"webhookIDs"`
+	ConstraintIDs       []string  `json:"constraintIDs"`
+	CreatedAt           Timestamp `json:"createdAt"`
+}
+
 type HealthCheck struct {
 	Type      HealthCheckType            `json:"type"`
 	Condition HealthCheckStatusCondition `json:"condition"`
-	Origin    *string                    `json:"origin,omitempty"`
-	Message   *string                    `json:"message,omitempty"`
-	Timestamp Timestamp                  `json:"timestamp"`
+	// ID of the probed application
+	Origin    *string   `json:"origin,omitempty"`
+	Message   *string   `json:"message,omitempty"`
+	Timestamp Timestamp `json:"timestamp"`
 }
 
 type HealthCheckPage struct {
@@ -824,6 +1140,39 @@ type Operation struct {
 	UpdatedAt     *Timestamp             `json:"updatedAt,omitempty"`
 }
 
+// An ORD package of an application or an application template version
+type Package struct {
+	ID                  string   `json:"id"`
+	OrdID               string   `json:"ordID"`
+	Vendor              *string  `json:"vendor,omitempty"`
+	Title               string   `json:"title"`
+	ShortDescription    string   `json:"shortDescription"`
+	Description         string   `json:"description"`
+	Version             string   `json:"version"`
+	PackageLinks        *JSON    `json:"packageLinks,omitempty"`
+	Links               *JSON    `json:"links,omitempty"`
+	LicenseType         *string  `json:"licenseType,omitempty"`
+	SupportInfo         *string  `json:"supportInfo,omitempty"`
+	Tags                *JSON    `json:"tags,omitempty"`
+	RuntimeRestriction  *string  `json:"runtimeRestriction,omitempty"`
+	Countries           *JSON    `json:"countries,omitempty"`
+	Labels              Labels   `json:"labels,omitempty"`
+	PolicyLevel         *string  `json:"policyLevel,omitempty"`
+	CustomPolicyLevel   *string  `json:"customPolicyLevel,omitempty"`
+	PartOfProducts      []string `json:"partOfProducts,omitempty"`
+	LineOfBusiness      *JSON    `json:"lineOfBusiness,omitempty"`
+	Industry            *JSON    `json:"industry,omitempty"`
+	DocumentationLabels *JSON    `json:"documentationLabels,omitempty"`
+}
+
+type PackagePage struct {
+	Data       []*Package `json:"data"`
+	PageInfo   *PageInfo  `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+func (PackagePage) IsPageable() {}
+
 type PageInfo struct {
 	StartCursor PageCursor `json:"startCursor"`
 	EndCursor   PageCursor `json:"endCursor"`
@@ -831,10 +1180,15 @@ type PageInfo struct {
 }
 
 type PlaceholderDefinition struct {
-	Name        string  `json:"name"`
-	Description *string `json:"description,omitempty"`
-	JSONPath    *string `json:"jsonPath,omitempty"`
-	Optional    *bool   `json:"optional,omitempty"`
+	Name          string          `json:"name"`
+	Description   *string         `json:"description,omitempty"`
+	JSONPath      *string         `json:"jsonPath,omitempty"`
+	Optional      *bool           `json:"optional,omitempty"`
+	Type          PlaceholderType `json:"type"`
+	Pattern       *string         `json:"pattern,omitempty"`
+	AllowedValues []string        `json:"allowedValues,omitempty"`
+	DefaultValue  *string         `json:"defaultValue,omitempty"`
+	Sensitive     bool            `json:"sensitive"`
 }
 
 type PlaceholderDefinitionInput struct {
@@ -845,8 +1199,41 @@ type PlaceholderDefinitionInput struct {
 	// **Validation:**  max=2000
 	JSONPath *string `json:"jsonPath,omitempty"`
 	Optional *bool   `json:"optional,omitempty"`
+	// **Validation:** defaults to STRING
+	Type *PlaceholderType `json:"type,omitempty"`
+	// **Validation:** valid regular expression, max=2000
+	Pattern *string `json:"pattern,omitempty"`
+	// **Validation:** required for and allowed only with type ENUM
+	AllowedValues []string `json:"allowedValues,omitempty"`
+	// Used when no value is provided for the placeholder. **Validation:** must satisfy the placeholder definition
+	DefaultValue *string `json:"defaultValue,omitempty"`
+	// Values of sensitive placeholders are never included in errors and their default value is not exposed
+	Sensitive *bool `json:"sensitive,omitempty"`
+}
+
+// An ORD product of an application or an application template version
+type Product struct {
+	ID                  string  `json:"id"`
+	OrdID               string  `json:"ordID"`
+	Title               string  `json:"title"`
+	ShortDescription    string  `json:"shortDescription"`
+	Description         *string `json:"description,omitempty"`
+	Vendor              string  `json:"vendor"`
+	Parent              *string `json:"parent,omitempty"`
+	CorrelationIDs      *JSON   `json:"correlationIDs,omitempty"`
+	Tags                *JSON   `json:"tags,omitempty"`
+	Labels              Labels  `json:"labels,omitempty"`
+	DocumentationLabels *JSON   `json:"documentationLabels,omitempty"`
+}
+
+type ProductPage struct {
+	Data       []*Product `json:"data"`
+	PageInfo   *PageInfo  `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
 }
 
+func (ProductPage) IsPageable() {}
+
 type Query struct {
 }
 
@@ -918,6 +1305,28 @@ type RuntimeUpdateInput struct {
 	ApplicationNamespace *string                 `json:"applicationNamespace,omitempty"`
 }
 
+// Scopes granted to the users which are members of the group, in addition to the static groups configured in the hydrator
+type StaticGroup struct {
+	ID        string   `json:"id"`
+	GroupName string   `json:"groupName"`
+	Scopes    []string `json:"scopes"`
+}
+
+type StaticGroupInput struct {
+	// **Validation:** required, max=256
+	GroupName string `json:"groupName"`
+	// **Validation:** required, every scope has to be required by at least one operation of the API
+	Scopes []string `json:"scopes"`
+}
+
+type StaticGroupPage struct {
+	Data       []*StaticGroup `json:"data"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (StaticGroupPage) IsPageable() {}
+
 type SystemAuthUpdateInput struct {
 	Auth *AuthInput `json:"auth,omitempty"`
 }
@@ -933,6 +1342,11 @@ type TenantAccess struct {
 	ResourceType TenantAccessObjectType `json:"resourceType"`
 	ResourceID   string                 `json:"resourceID"`
 	Owner        bool                   `json:"owner"`
+	// ID of the consumer that granted the access. Empty for accesses which are not granted through addTenantAccess
+	GrantedBy *string    `json:"grantedBy,omitempty"`
+	Reason    *string    `json:"reason,omitempty"`
+	CreatedAt *Timestamp `json:"createdAt,omitempty"`
+	ExpiresAt *Timestamp `json:"expiresAt,omitempty"`
 }
 
 type TenantAccessInput struct {
@@ -940,6 +1354,31 @@ type TenantAccessInput struct {
 	ResourceType TenantAccessObjectType `json:"resourceType"`
 	ResourceID   string                 `json:"resourceID"`
 	Owner        bool                   `json:"owner"`
+	// Why the access is granted
+	Reason *string `json:"reason,omitempty"`
+	// Optional time after which the access is revoked automatically. Must be in the future
+	ExpiresAt *Timestamp `json:"expiresAt,omitempty"`
+}
+
+type TenantConfigurationImportResult struct {
+	Version string                             `json:"version"`
+	Mode    TenantConfigurationImportMode      `json:"mode"`
+	Results []*TenantConfigurationObjectResult `json:"results"`
+}
+
+type TenantConfigurationObjectResult struct {
+	ObjectType TenantConfigurationObjectType   `json:"objectType"`
+	Name       string                          `json:"name"`
+	ID         *string                         `json:"id,omitempty"`
+	Action     TenantConfigurationImportAction `json:"action"`
+	Message    *string                         `json:"message,omitempty"`
+}
+
+// Number of resources owned by a tenant. The applications and runtimes include the ones it owns because they are owned by its descendants, while the formations include only the ones created in the tenant itself
+type TenantOwnedResources struct {
+	Applications int `json:"applications"`
+	Runtimes     int `json:"runtimes"`
+	Formations   int `json:"formations"`
 }
 
 type TenantPage struct {
@@ -950,6 +1389,57 @@ type TenantPage struct {
 
 func (TenantPage) IsPageable() {}
 
+// A tenant together with its ancestors and descendants in the tenant hierarchy
+type TenantTree struct {
+	Root        *TenantTreeNode   `json:"root"`
+	Ancestors   []*TenantTreeNode `json:"ancestors"`
+	Descendants []*TenantTreeNode `json:"descendants"`
+	// True if the ancestors or the descendants of the tenant exceed the maximum number of tenants returned in a single direction, which is 1000. The closest tenants are returned first
+	Truncated bool `json:"truncated"`
+}
+
+type TenantTreeNode struct {
+	Tenant *Tenant `json:"tenant"`
+	// Number of hierarchy levels between the tenant and the root of the tree
+	Depth          int                   `json:"depth"`
+	OwnedResources *TenantOwnedResources `json:"ownedResources"`
+}
+
+// An ORD tombstone of an application or an application template version, which marks an ORD resource as removed
+type Tombstone struct {
+	ID          string  `json:"id"`
+	OrdID       string  `json:"ordID"`
+	RemovalDate string  `json:"removalDate"`
+	Description *string `json:"description,omitempty"`
+}
+
+type TombstonePage struct {
+	Data       []*Tombstone `json:"data"`
+	PageInfo   *PageInfo    `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
+}
+
+func (TombstonePage) IsPageable() {}
+
+// An ORD vendor of an application or an application template version
+type Vendor struct {
+	ID                  string `json:"id"`
+	OrdID               string `json:"ordID"`
+	Title               string `json:"title"`
+	Partners            *JSON  `json:"partners,omitempty"`
+	Tags                *JSON  `json:"tags,omitempty"`
+	Labels              Labels `json:"labels,omitempty"`
+	DocumentationLabels *JSON  `json:"documentationLabels,omitempty"`
+}
+
+type VendorPage struct {
+	Data       []*Vendor `json:"data"`
+	PageInfo   *PageInfo `json:"pageInfo"`
+	TotalCount int       `json:"totalCount"`
+}
+
+func (VendorPage) IsPageable() {}
+
 type Version struct {
 	// for example 4.6
 	Value      string `json:"value"`
@@ -1054,6 +1544,88 @@ func (e APISpecType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type AccessPolicyEffect string
+
+const (
+	AccessPolicyEffectAllow AccessPolicyEffect = "ALLOW"
+	AccessPolicyEffectDeny  AccessPolicyEffect = "DENY"
+)
+
+var AllAccessPolicyEffect = []AccessPolicyEffect{
+	AccessPolicyEffectAllow,
+	AccessPolicyEffectDeny,
+}
+
+func (e AccessPolicyEffect) IsValid() bool {
+	switch e {
+	case AccessPolicyEffectAllow, AccessPolicyEffectDeny:
+		return true
+	}
+	return false
+}
+
+func (e AccessPolicyEffect) String() string {
+	return string(e)
+}
+
+func (e *AccessPolicyEffect) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccessPolicyEffect(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccessPolicyEffect", str)
+	}
+	return nil
+}
+
+func (e AccessPolicyEffect) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ApplicationMergeConflictType string
+
+const (
+	ApplicationMergeConflictTypeProperty ApplicationMergeConflictType = "PROPERTY"
+	ApplicationMergeConflictTypeLabel    ApplicationMergeConflictType = "LABEL"
+)
+
+var AllApplicationMergeConflictType = []ApplicationMergeConflictType{
+	ApplicationMergeConflictTypeProperty,
+	ApplicationMergeConflictTypeLabel,
+}
+
+func (e ApplicationMergeConflictType) IsValid() bool {
+	switch e {
+	case ApplicationMergeConflictTypeProperty, ApplicationMergeConflictTypeLabel:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationMergeConflictType) String() string {
+	return string(e)
+}
+
+func (e *ApplicationMergeConflictType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationMergeConflictType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationMergeConflictType", str)
+	}
+	return nil
+}
+
+func (e ApplicationMergeConflictType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ApplicationStatusCondition string
 
 const (
@@ -1158,6 +1730,52 @@ func (e ApplicationTemplateAccessLevel) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ApplicationTemplateUpgradeStatus string
+
+const (
+	// The application differs from the template. Returned instead of UPGRADED when the upgrade is a dry run.
+	ApplicationTemplateUpgradeStatusDrifted  ApplicationTemplateUpgradeStatus = "DRIFTED"
+	ApplicationTemplateUpgradeStatusFailed   ApplicationTemplateUpgradeStatus = "FAILED"
+	ApplicationTemplateUpgradeStatusUpgraded ApplicationTemplateUpgradeStatus = "UPGRADED"
+	ApplicationTemplateUpgradeStatusUpToDate ApplicationTemplateUpgradeStatus = "UP_TO_DATE"
+)
+
+var AllApplicationTemplateUpgradeStatus = []ApplicationTemplateUpgradeStatus{
+	ApplicationTemplateUpgradeStatusDrifted,
+	ApplicationTemplateUpgradeStatusFailed,
+	ApplicationTemplateUpgradeStatusUpgraded,
+	ApplicationTemplateUpgradeStatusUpToDate,
+}
+
+func (e ApplicationTemplateUpgradeStatus) IsValid() bool {
+	switch e {
+	case ApplicationTemplateUpgradeStatusDrifted, ApplicationTemplateUpgradeStatusFailed, ApplicationTemplateUpgradeStatusUpgraded, ApplicationTemplateUpgradeStatusUpToDate:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationTemplateUpgradeStatus) String() string {
+	return string(e)
+}
+
+func (e *ApplicationTemplateUpgradeStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationTemplateUpgradeStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationTemplateUpgradeStatus", str)
+	}
+	return nil
+}
+
+func (e ApplicationTemplateUpgradeStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ArtifactType string
 
 const (
@@ -1330,6 +1948,53 @@ func (e BundleInstanceAuthStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type CatalogResourceType string
+
+const (
+	CatalogResourceTypeAPIDefinition   CatalogResourceType = "API_DEFINITION"
+	CatalogResourceTypeEventDefinition CatalogResourceType = "EVENT_DEFINITION"
+	CatalogResourceTypeEntityType      CatalogResourceType = "ENTITY_TYPE"
+	CatalogResourceTypeCapability      CatalogResourceType = "CAPABILITY"
+	CatalogResourceTypeDataProduct     CatalogResourceType = "DATA_PRODUCT"
+)
+
+var AllCatalogResourceType = []CatalogResourceType{
+	CatalogResourceTypeAPIDefinition,
+	CatalogResourceTypeEventDefinition,
+	CatalogResourceTypeEntityType,
+	CatalogResourceTypeCapability,
+	CatalogResourceTypeDataProduct,
+}
+
+func (e CatalogResourceType) IsValid() bool {
+	switch e {
+	case CatalogResourceTypeAPIDefinition, CatalogResourceTypeEventDefinition, CatalogResourceTypeEntityType, CatalogResourceTypeCapability, CatalogResourceTypeDataProduct:
+		return true
+	}
+	return false
+}
+
+func (e CatalogResourceType) String() string {
+	return string(e)
+}
+
+func (e *CatalogResourceType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CatalogResourceType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CatalogResourceType", str)
+	}
+	return nil
+}
+
+func (e CatalogResourceType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ConstraintScope string
 
 const (
@@ -1754,6 +2419,55 @@ func (e FormationStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type FormationTemplateMigrationStatus string
+
+const (
+	// The formation can be migrated. Returned instead of MIGRATED when the migration is a dry run.
+	FormationTemplateMigrationStatusCompatible FormationTemplateMigrationStatus = "COMPATIBLE"
+	FormationTemplateMigrationStatusFailed     FormationTemplateMigrationStatus = "FAILED"
+	// Some of the participants of the formation are not allowed by the target version
+	FormationTemplateMigrationStatusIncompatible FormationTemplateMigrationStatus = "INCOMPATIBLE"
+	FormationTemplateMigrationStatusMigrated     FormationTemplateMigrationStatus = "MIGRATED"
+	FormationTemplateMigrationStatusUpToDate     FormationTemplateMigrationStatus = "UP_TO_DATE"
+)
+
+var AllFormationTemplateMigrationStatus = []FormationTemplateMigrationStatus{
+	FormationTemplateMigrationStatusCompatible,
+	FormationTemplateMigrationStatusFailed,
+	FormationTemplateMigrationStatusIncompatible,
+	FormationTemplateMigrationStatusMigrated,
+	FormationTemplateMigrationStatusUpToDate,
+}
+
+func (e FormationTemplateMigrationStatus) IsValid() bool {
+	switch e {
+	case FormationTemplateMigrationStatusCompatible, FormationTemplateMigrationStatusFailed, FormationTemplateMigrationStatusIncompatible, FormationTemplateMigrationStatusMigrated, FormationTemplateMigrationStatusUpToDate:
+		return true
+	}
+	return false
+}
+
+func (e FormationTemplateMigrationStatus) String() string {
+	return string(e)
+}
+
+func (e *FormationTemplateMigrationStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FormationTemplateMigrationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FormationTemplateMigrationStatus", str)
+	}
+	return nil
+}
+
+func (e FormationTemplateMigrationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type HealthCheckStatusCondition string
 
 const (
@@ -1798,16 +2512,20 @@ func (e HealthCheckStatusCondition) MarshalGQL(w io.Writer) {
 type HealthCheckType string
 
 const (
+	// Probe of the health check URL of an application
 	HealthCheckTypeManagementPlaneApplicationHealthcheck HealthCheckType = "MANAGEMENT_PLANE_APPLICATION_HEALTHCHECK"
+	// Probe of the ORD well-known endpoint of an application
+	HealthCheckTypeManagementPlaneApplicationOrdHealthcheck HealthCheckType = "MANAGEMENT_PLANE_APPLICATION_ORD_HEALTHCHECK"
 )
 
 var AllHealthCheckType = []HealthCheckType{
 	HealthCheckTypeManagementPlaneApplicationHealthcheck,
+	HealthCheckTypeManagementPlaneApplicationOrdHealthcheck,
 }
 
 func (e HealthCheckType) IsValid() bool {
 	switch e {
-	case HealthCheckTypeManagementPlaneApplicationHealthcheck:
+	case HealthCheckTypeManagementPlaneApplicationHealthcheck, HealthCheckTypeManagementPlaneApplicationOrdHealthcheck:
 		return true
 	}
 	return false
@@ -2094,6 +2812,55 @@ func (e OperationType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type PlaceholderType string
+
+const (
+	PlaceholderTypeString  PlaceholderType = "STRING"
+	PlaceholderTypeURL     PlaceholderType = "URL"
+	PlaceholderTypeEnum    PlaceholderType = "ENUM"
+	PlaceholderTypeInteger PlaceholderType = "INTEGER"
+	PlaceholderTypeBoolean PlaceholderType = "BOOLEAN"
+	PlaceholderTypeUUID    PlaceholderType = "UUID"
+)
+
+var AllPlaceholderType = []PlaceholderType{
+	PlaceholderTypeString,
+	PlaceholderTypeURL,
+	PlaceholderTypeEnum,
+	PlaceholderTypeInteger,
+	PlaceholderTypeBoolean,
+	PlaceholderTypeUUID,
+}
+
+func (e PlaceholderType) IsValid() bool {
+	switch e {
+	case PlaceholderTypeString, PlaceholderTypeURL, PlaceholderTypeEnum, PlaceholderTypeInteger, PlaceholderTypeBoolean, PlaceholderTypeUUID:
+		return true
+	}
+	return false
+}
+
+func (e PlaceholderType) String() string {
+	return string(e)
+}
+
+func (e *PlaceholderType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlaceholderType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlaceholderType", str)
+	}
+	return nil
+}
+
+func (e PlaceholderType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ResourceType string
 
 const (
@@ -2413,6 +3180,231 @@ func (e TenantAccessObjectType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type TenantConfigurationFormat string
+
+const (
+	TenantConfigurationFormatJSON TenantConfigurationFormat = "JSON"
+	TenantConfigurationFormatYaml TenantConfigurationFormat = "YAML"
+)
+
+var AllTenantConfigurationFormat = []TenantConfigurationFormat{
+	TenantConfigurationFormatJSON,
+	TenantConfigurationFormatYaml,
+}
+
+func (e TenantConfigurationFormat) IsValid() bool {
+	switch e {
+	case TenantConfigurationFormatJSON, TenantConfigurationFormatYaml:
+		return true
+	}
+	return false
+}
+
+func (e TenantConfigurationFormat) String() string {
+	return string(e)
+}
+
+func (e *TenantConfigurationFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TenantConfigurationFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TenantConfigurationFormat", str)
+	}
+	return nil
+}
+
+func (e TenantConfigurationFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TenantConfigurationImportAction string
+
+const (
+	TenantConfigurationImportActionCreate   TenantConfigurationImportAction = "CREATE"
+	TenantConfigurationImportActionUpdate   TenantConfigurationImportAction = "UPDATE"
+	TenantConfigurationImportActionSkip     TenantConfigurationImportAction = "SKIP"
+	TenantConfigurationImportActionConflict TenantConfigurationImportAction = "CONFLICT"
+	TenantConfigurationImportActionPartial  TenantConfigurationImportAction = "PARTIAL"
+)
+
+var AllTenantConfigurationImportAction = []TenantConfigurationImportAction{
+	TenantConfigurationImportActionCreate,
+	TenantConfigurationImportActionUpdate,
+	TenantConfigurationImportActionSkip,
+	TenantConfigurationImportActionConflict,
+	TenantConfigurationImportActionPartial,
+}
+
+func (e TenantConfigurationImportAction) IsValid() bool {
+	switch e {
+	case TenantConfigurationImportActionCreate, TenantConfigurationImportActionUpdate, TenantConfigurationImportActionSkip, TenantConfigurationImportActionConflict, TenantConfigurationImportActionPartial:
+		return true
+	}
+	return false
+}
+
+func (e TenantConfigurationImportAction) String() string {
+	return string(e)
+}
+
+func (e *TenantConfigurationImportAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TenantConfigurationImportAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TenantConfigurationImportAction", str)
+	}
+	return nil
+}
+
+func (e TenantConfigurationImportAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TenantConfigurationImportMode string
+
+const (
+	TenantConfigurationImportModeCreateOnly TenantConfigurationImportMode = "CREATE_ONLY"
+	TenantConfigurationImportModeUpsert     TenantConfigurationImportMode = "UPSERT"
+	TenantConfigurationImportModeDryRun     TenantConfigurationImportMode = "DRY_RUN"
+)
+
+var AllTenantConfigurationImportMode = []TenantConfigurationImportMode{
+	TenantConfigurationImportModeCreateOnly,
+	TenantConfigurationImportModeUpsert,
+	TenantConfigurationImportModeDryRun,
+}
+
+func (e TenantConfigurationImportMode) IsValid() bool {
+	switch e {
+	case TenantConfigurationImportModeCreateOnly, TenantConfigurationImportModeUpsert, TenantConfigurationImportModeDryRun:
+		return true
+	}
+	return false
+}
+
+func (e TenantConfigurationImportMode) String() string {
+	return string(e)
+}
+
+func (e *TenantConfigurationImportMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TenantConfigurationImportMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TenantConfigurationImportMode", str)
+	}
+	return nil
+}
+
+func (e TenantConfigurationImportMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TenantConfigurationObjectType string
+
+const (
+	TenantConfigurationObjectTypeApplicationTemplate TenantConfigurationObjectType = "APPLICATION_TEMPLATE"
+	TenantConfigurationObjectTypeApplication         TenantConfigurationObjectType = "APPLICATION"
+	TenantConfigurationObjectTypeRuntime             TenantConfigurationObjectType = "RUNTIME"
+	TenantConfigurationObjectTypeFormationTemplate   TenantConfigurationObjectType = "FORMATION_TEMPLATE"
+	TenantConfigurationObjectTypeFormationConstraint TenantConfigurationObjectType = "FORMATION_CONSTRAINT"
+	TenantConfigurationObjectTypeLabelDefinition     TenantConfigurationObjectType = "LABEL_DEFINITION"
+	TenantConfigurationObjectTypeFormation           TenantConfigurationObjectType = "FORMATION"
+)
+
+var AllTenantConfigurationObjectType = []TenantConfigurationObjectType{
+	TenantConfigurationObjectTypeApplicationTemplate,
+	TenantConfigurationObjectTypeApplication,
+	TenantConfigurationObjectTypeRuntime,
+	TenantConfigurationObjectTypeFormationTemplate,
+	TenantConfigurationObjectTypeFormationConstraint,
+	TenantConfigurationObjectTypeLabelDefinition,
+	TenantConfigurationObjectTypeFormation,
+}
+
+func (e TenantConfigurationObjectType) IsValid() bool {
+	switch e {
+	case TenantConfigurationObjectTypeApplicationTemplate, TenantConfigurationObjectTypeApplication, TenantConfigurationObjectTypeRuntime, TenantConfigurationObjectTypeFormationTemplate, TenantConfigurationObjectTypeFormationConstraint, TenantConfigurationObjectTypeLabelDefinition, TenantConfigurationObjectTypeFormation:
+		return true
+	}
+	return false
+}
+
+func (e TenantConfigurationObjectType) String() string {
+	return string(e)
+}
+
+func (e *TenantConfigurationObjectType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TenantConfigurationObjectType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TenantConfigurationObjectType", str)
+	}
+	return nil
+}
+
+func (e TenantConfigurationObjectType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TenantTreeDirection string
+
+const (
+	TenantTreeDirectionAncestors   TenantTreeDirection = "ANCESTORS"
+	TenantTreeDirectionDescendants TenantTreeDirection = "DESCENDANTS"
+	TenantTreeDirectionBoth        TenantTreeDirection = "BOTH"
+)
+
+var AllTenantTreeDirection = []TenantTreeDirection{
+	TenantTreeDirectionAncestors,
+	TenantTreeDirectionDescendants,
+	TenantTreeDirectionBoth,
+}
+
+func (e TenantTreeDirection) IsValid() bool {
+	switch e {
+	case TenantTreeDirectionAncestors, TenantTreeDirectionDescendants, TenantTreeDirectionBoth:
+		return true
+	}
+	return false
+}
+
+func (e TenantTreeDirection) String() string {
+	return string(e)
+}
+
+func (e *TenantTreeDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TenantTreeDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TenantTreeDirection", str)
+	}
+	return nil
+}
+
+func (e TenantTreeDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ViewerType string
 
 const (
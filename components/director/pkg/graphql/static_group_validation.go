@@ -0,0 +1,13 @@
+package graphql
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Validate validates the StaticGroupInput structure's properties
+func (i StaticGroupInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.GroupName, validation.Required, validation.Length(1, 256)),
+		validation.Field(&i.Scopes, validation.Required, validation.Each(validation.Required, validation.Length(1, 256))),
+	)
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+	"sync"
+)
+
+const (
+	// ETagHeader is the response header carrying the entity tag of the returned content
+	ETagHeader = "ETag"
+	// LastModifiedHeader is the response header carrying the last modification date of the returned content
+	LastModifiedHeader = "Last-Modified"
+	// IfNoneMatchHeader is the request header used to make a request conditional on the entity tag
+	IfNoneMatchHeader = "If-None-Match"
+	// IfModifiedSinceHeader is the request header used to make a request conditional on the last modification date
+	IfModifiedSinceHeader = "If-Modified-Since"
+)
+
+// ConditionalRequestHeaders builds the conditional request headers for the provided cache validators.
+// An empty header is returned if no validators are present, which results in an unconditional request.
+func ConditionalRequestHeaders(etag, lastModified *string) http.Header {
+	headers := http.Header{}
+	if etag != nil && len(*etag) > 0 {
+		headers.Set(IfNoneMatchHeader, *etag)
+	}
+	if lastModified != nil && len(*lastModified) > 0 {
+		headers.Set(IfModifiedSinceHeader, *lastModified)
+	}
+
+	return headers
+}
+
+// CacheValidatorsFromResponse returns the ETag and Last-Modified cache validators of the given response, if present
+func CacheValidatorsFromResponse(resp *http.Response) (*string, *string) {
+	return headerValue(resp.Header, ETagHeader), headerValue(resp.Header, LastModifiedHeader)
+}
+
+// MergeHeaders returns a copy of the provided headers map extended with the additional headers.
+// The original map is not modified as it is usually shared between concurrent requests.
+func MergeHeaders(headers *sync.Map, additional http.Header) *sync.Map {
+	if len(additional) == 0 {
+		return headers
+	}
+
+	merged := &sync.Map{}
+	if headers != nil {
+		headers.Range(func(key, value any) bool {
+			merged.Store(key, value)
+			return true
+		})
+	}
+
+	for key := range additional {
+		merged.Store(key, additional.Get(key))
+	}
+
+	return merged
+}
+
+func headerValue(headers http.Header, key string) *string {
+	value := headers.Get(key)
+	if len(value) == 0 {
+		return nil
+	}
+
+	return &value
+}
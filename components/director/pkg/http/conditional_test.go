@@ -0,0 +1,71 @@
+package http_test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalRequestHeaders(t *testing.T) {
+	t.Run("with both validators", func(t *testing.T) {
+		headers := httputil.ConditionalRequestHeaders(str.Ptr(`"etag"`), str.Ptr("Wed, 21 Oct 2015 07:28:00 GMT"))
+
+		require.Equal(t, `"etag"`, headers.Get(httputil.IfNoneMatchHeader))
+		require.Equal(t, "Wed, 21 Oct 2015 07:28:00 GMT", headers.Get(httputil.IfModifiedSinceHeader))
+	})
+
+	t.Run("without validators", func(t *testing.T) {
+		headers := httputil.ConditionalRequestHeaders(nil, str.Ptr(""))
+
+		require.Empty(t, headers)
+	})
+}
+
+func TestCacheValidatorsFromResponse(t *testing.T) {
+	t.Run("with both validators", func(t *testing.T) {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set(httputil.ETagHeader, `"etag"`)
+		resp.Header.Set(httputil.LastModifiedHeader, "Wed, 21 Oct 2015 07:28:00 GMT")
+
+		etag, lastModified := httputil.CacheValidatorsFromResponse(resp)
+
+		require.Equal(t, str.Ptr(`"etag"`), etag)
+		require.Equal(t, str.Ptr("Wed, 21 Oct 2015 07:28:00 GMT"), lastModified)
+	})
+
+	t.Run("without validators", func(t *testing.T) {
+		etag, lastModified := httputil.CacheValidatorsFromResponse(&http.Response{Header: http.Header{}})
+
+		require.Nil(t, etag)
+		require.Nil(t, lastModified)
+	})
+}
+
+func TestMergeHeaders(t *testing.T) {
+	t.Run("returns the original map when there is nothing to add", func(t *testing.T) {
+		headers := &sync.Map{}
+
+		require.Same(t, headers, httputil.MergeHeaders(headers, http.Header{}))
+	})
+
+	t.Run("does not modify the original map", func(t *testing.T) {
+		headers := &sync.Map{}
+		headers.Store("Authorization", "Bearer token")
+
+		merged := httputil.MergeHeaders(headers, http.Header{httputil.IfNoneMatchHeader: []string{`"etag"`}})
+
+		value, ok := merged.Load("Authorization")
+		require.True(t, ok)
+		require.Equal(t, "Bearer token", value)
+		value, ok = merged.Load(httputil.IfNoneMatchHeader)
+		require.True(t, ok)
+		require.Equal(t, `"etag"`, value)
+
+		_, ok = headers.Load(httputil.IfNoneMatchHeader)
+		require.False(t, ok)
+	})
+}
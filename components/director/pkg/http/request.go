@@ -16,6 +16,11 @@ const tenantHeader = "Tenant"
 
 // GetRequestWithCredentials executes a GET http request to the given url with the provided auth credentials
 func GetRequestWithCredentials(ctx context.Context, client *http.Client, url, tnt string, auth *model.Auth) (*http.Response, error) {
+	return GetRequestWithCredentialsAndHeaders(ctx, client, url, tnt, auth, nil)
+}
+
+// GetRequestWithCredentialsAndHeaders executes a GET http request to the given url with the provided auth credentials and additional headers
+func GetRequestWithCredentialsAndHeaders(ctx context.Context, client *http.Client, url, tnt string, auth *model.Auth, headers http.Header) (*http.Response, error) {
 	if auth == nil || (auth.Credential.Basic == nil && auth.Credential.Oauth == nil) {
 		return nil, apperrors.NewInvalidDataError("Credentials not provided")
 	}
@@ -25,6 +30,7 @@ func GetRequestWithCredentials(ctx context.Context, client *http.Client, url, tn
 		return nil, err
 	}
 
+	setHeaders(req, headers)
 	if len(tnt) > 0 {
 		req.Header.Set(tenantHeader, tnt)
 	}
@@ -62,14 +68,28 @@ func secureClient(ctx context.Context, client *http.Client, auth *model.Auth) *h
 
 // GetRequestWithoutCredentials executes a GET http request to the given url
 func GetRequestWithoutCredentials(client *http.Client, url, tnt string) (*http.Response, error) {
+	return GetRequestWithoutCredentialsAndHeaders(client, url, tnt, nil)
+}
+
+// GetRequestWithoutCredentialsAndHeaders executes a GET http request to the given url with the provided additional headers
+func GetRequestWithoutCredentialsAndHeaders(client *http.Client, url, tnt string, headers http.Header) (*http.Response, error) {
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	setHeaders(req, headers)
 	if len(tnt) > 0 {
 		req.Header.Set(tenantHeader, tnt)
 	}
 
 	return client.Do(req)
 }
+
+func setHeaders(req *http.Request, headers http.Header) {
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+}
@@ -64,6 +64,18 @@ func TestRequestWithoutCredentials_FailedRequest(t *testing.T) {
 	require.ErrorIs(t, err, testErr)
 }
 
+func TestRequestWithoutCredentialsAndHeaders_Success(t *testing.T) {
+	client := newTestClient(func(req *http.Request) *http.Response {
+		require.Equal(t, `"etag"`, req.Header.Get("If-None-Match"))
+		require.Equal(t, testTenant, req.Header.Get("Tenant"))
+		return expectedResp
+	})
+
+	resp, err := httputil.GetRequestWithoutCredentialsAndHeaders(client, testURL, testTenant, http.Header{"If-None-Match": []string{`"etag"`}})
+	require.NoError(t, err)
+	require.Equal(t, resp, expectedResp)
+}
+
 func TestRequestWithCredentials_SuccessWithBasicAuth(t *testing.T) {
 	user, pass := "username", "password"
 	client := newTestClient(func(req *http.Request) *http.Response {
@@ -86,6 +98,26 @@ func TestRequestWithCredentials_SuccessWithBasicAuth(t *testing.T) {
 	require.Equal(t, resp, expectedResp)
 }
 
+func TestRequestWithCredentialsAndHeaders_SuccessWithBasicAuth(t *testing.T) {
+	client := newTestClient(func(req *http.Request) *http.Response {
+		_, _, exists := req.BasicAuth()
+		require.True(t, exists)
+		require.Equal(t, "Wed, 21 Oct 2015 07:28:00 GMT", req.Header.Get("If-Modified-Since"))
+		return expectedResp
+	})
+
+	resp, err := httputil.GetRequestWithCredentialsAndHeaders(context.Background(), client, testURL, testTenant, &model.Auth{
+		Credential: model.CredentialData{
+			Basic: &model.BasicCredentialData{
+				Username: "user",
+				Password: "pass",
+			},
+		},
+	}, http.Header{"If-Modified-Since": []string{"Wed, 21 Oct 2015 07:28:00 GMT"}})
+	require.NoError(t, err)
+	require.Equal(t, resp, expectedResp)
+}
+
 func TestRequestWithCredentials_FailedWithBasicAuth(t *testing.T) {
 	client := newTestClient(func(req *http.Request) *http.Response {
 		return nil
@@ -18,6 +18,8 @@ type RequestData struct {
 	TenantType     tenant.Type
 	ClientUser     string
 	ScenarioGroups []ScenarioGroup
+	// Labels of the application by key
+	Labels map[string]interface{}
 }
 
 // ResponseData missing godoc
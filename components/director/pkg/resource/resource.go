@@ -88,10 +88,14 @@ const (
 	AutomaticScenarioAssigment Type = "automaticScenarioAssigment"
 	// CertSubjectMapping type represents certificate subject mapping resource
 	CertSubjectMapping Type = "certSubjectMapping"
+	// StaticGroup type represents static group resource
+	StaticGroup Type = "staticGroup"
 	// Formations type represents formations resource.
 	Formations Type = "formations"
 	// FormationTemplate type represents formation template resource.
 	FormationTemplate Type = "formationTemplate"
+	// FormationTemplateVersion type represents formation template version resource.
+	FormationTemplateVersion Type = "formationTemplateVersion"
 	// FormationAssignment type represents formation assignment resource.
 	FormationAssignment Type = "formationAssignment"
 	// AssignmentOperation type represents assignment operation resource.
@@ -120,6 +124,26 @@ const (
 	Schema Type = "schemaMigration"
 	// SystemsSync type represents systems synchronization timestamps resource
 	SystemsSync Type = "systemsSync"
+	// SoftDeletedResource type represents an archived application or runtime which can still be restored.
+	SoftDeletedResource Type = "softDeletedResource"
+	// NotificationOutboxEntry type represents a notification which is persisted in the outbox until it is delivered.
+	NotificationOutboxEntry Type = "notificationOutboxEntry"
+	// ApplicationTemplatePlaceholderValues type represents the placeholder values an application was registered with from an application template.
+	ApplicationTemplatePlaceholderValues Type = "applicationTemplatePlaceholderValues"
+	// DestinationCertificate type represents a certificate created in the destination service for a formation assignment.
+	DestinationCertificate Type = "destinationCertificate"
+	// HealthCheck type represents the result of probing a health check endpoint of an application.
+	HealthCheck Type = "healthCheck"
+	// ORDDocumentCacheValidator type represents the HTTP cache validators of the last fetch of an ORD document.
+	ORDDocumentCacheValidator Type = "ordDocumentCacheValidator"
+	// ORDPushedPayload type represents an ORD configuration with documents and specifications pushed by a system.
+	ORDPushedPayload Type = "ordPushedPayload"
+	// CatalogSearchResult type represents an API, event, entity type, capability or data product matching a full-text catalog search.
+	CatalogSearchResult Type = "catalogSearchResult"
+	// TenantFetcherCheckpoint type represents the timestamps up to which a tenant fetcher job has consumed tenant events.
+	TenantFetcherCheckpoint Type = "tenantFetcherCheckpoint"
+	// IngestedTenantEvent type represents a tenant event pushed by an external system which was already processed.
+	IngestedTenantEvent Type = "ingestedTenantEvent"
 )
 
 var ignoredTenantAccessTable = map[Type]string{
@@ -165,6 +189,7 @@ var tenantAccessTable = map[Type]string{
 	AppWebhook:                 "application_webhooks_tenants",
 	RuntimeWebhook:             "runtime_webhooks_tenants",
 	FormationTemplateWebhook:   "formation_templates_webhooks_tenants",
+	HealthCheck:                "health_checks_tenants",
 }
 
 var tablesWithEmbeddedTenant = map[Type]string{
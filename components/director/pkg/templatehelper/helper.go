@@ -3,9 +3,11 @@ package templatehelper
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 	"text/template"
+	"text/template/parse"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/tidwall/gjson"
@@ -64,6 +66,94 @@ func ParseTemplate(tmpl *string, data interface{}, dest interface{}) error {
 	return nil
 }
 
+// ExecuteTemplate executes tmpl using data and returns the rendered text as it is
+func ExecuteTemplate(tmpl string, data interface{}) (string, error) {
+	t, err := template.New("").Funcs(getFuncMap()).Option("missingkey=zero").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+
+	res := new(bytes.Buffer)
+	if err = t.Execute(res, data); err != nil {
+		return "", err
+	}
+
+	return res.String(), nil
+}
+
+// ExecuteURLTemplate executes the URL template tmpl using data. Every value inserted in the template is escaped for its position
+// in the URL - with url.PathEscape before the query and with url.QueryEscape in the query - so that the values can neither
+// add path segments nor query parameters.
+func ExecuteURLTemplate(tmpl string, data interface{}) (string, error) {
+	t, err := template.New("").Funcs(getFuncMap()).Funcs(template.FuncMap{
+		pathEscapeFuncName:  escapeWith(url.PathEscape),
+		queryEscapeFuncName: escapeWith(url.QueryEscape),
+	}).Option("missingkey=zero").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+
+	inQuery := false
+	escapeURLActions(t.Tree.Root, &inQuery)
+
+	res := new(bytes.Buffer)
+	if err = t.Execute(res, data); err != nil {
+		return "", err
+	}
+
+	return res.String(), nil
+}
+
+const (
+	pathEscapeFuncName  = "_pathEscape"
+	queryEscapeFuncName = "_queryEscape"
+)
+
+// escapeURLActions appends an escaping command to the pipeline of every action which writes to the output.
+// The actions following the first '?' of the template are escaped as query values and the ones before it as path segments.
+func escapeURLActions(list *parse.ListNode, inQuery *bool) {
+	if list == nil {
+		return
+	}
+
+	for _, node := range list.Nodes {
+		switch n := node.(type) {
+		case *parse.TextNode:
+			if bytes.ContainsRune(n.Text, '?') {
+				*inQuery = true
+			}
+		case *parse.ActionNode:
+			if len(n.Pipe.Decl) > 0 {
+				continue
+			}
+			funcName := pathEscapeFuncName
+			if *inQuery {
+				funcName = queryEscapeFuncName
+			}
+			n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+				NodeType: parse.NodeCommand,
+				Pos:      n.Pos,
+				Args:     []parse.Node{parse.NewIdentifier(funcName).SetPos(n.Pos)},
+			})
+		case *parse.IfNode:
+			escapeURLActions(n.List, inQuery)
+			escapeURLActions(n.ElseList, inQuery)
+		case *parse.RangeNode:
+			escapeURLActions(n.List, inQuery)
+			escapeURLActions(n.ElseList, inQuery)
+		case *parse.WithNode:
+			escapeURLActions(n.List, inQuery)
+			escapeURLActions(n.ElseList, inQuery)
+		}
+	}
+}
+
+func escapeWith(escape func(string) string) func(interface{}) string {
+	return func(value interface{}) string {
+		return escape(fmt.Sprint(value))
+	}
+}
+
 func contains(faConfig json.RawMessage, str string) bool {
 	return strings.Contains(string(faConfig), str)
 }
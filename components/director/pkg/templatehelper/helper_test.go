@@ -0,0 +1,124 @@
+package templatehelper_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/templatehelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteURLTemplate(t *testing.T) {
+	type urlData struct {
+		ID      string
+		Name    string
+		Tags    []string
+		Enabled bool
+		Port    int
+	}
+
+	data := urlData{
+		ID:      "system/1 a",
+		Name:    "a&b=c d",
+		Tags:    []string{"x&y", "z/w"},
+		Enabled: true,
+		Port:    8080,
+	}
+
+	testCases := []struct {
+		Name          string
+		Template      string
+		Data          urlData
+		ExpectedURL   string
+		ExpectedError string
+	}{
+		{
+			Name:        "Escapes values before the query as path segments",
+			Template:    "https://example.com/systems/{{ .ID }}",
+			Data:        data,
+			ExpectedURL: "https://example.com/systems/system%2F1%20a",
+		},
+		{
+			Name:        "Escapes values in the query as query values",
+			Template:    "https://example.com/systems?name={{ .Name }}",
+			Data:        data,
+			ExpectedURL: "https://example.com/systems?name=a%26b%3Dc+d",
+		},
+		{
+			Name:        "Escapes values in the path and in the query",
+			Template:    "https://example.com/systems/{{ .ID }}?name={{ .Name }}",
+			Data:        data,
+			ExpectedURL: "https://example.com/systems/system%2F1%20a?name=a%26b%3Dc+d",
+		},
+		{
+			Name:        "Escapes non-string values",
+			Template:    "https://example.com/ports/{{ .Port }}?port={{ .Port }}",
+			Data:        data,
+			ExpectedURL: "https://example.com/ports/8080?port=8080",
+		},
+		{
+			Name:        "Escapes values in the if branch",
+			Template:    "https://example.com/{{ if .Enabled }}{{ .ID }}{{ else }}{{ .Name }}{{ end }}",
+			Data:        data,
+			ExpectedURL: "https://example.com/system%2F1%20a",
+		},
+		{
+			Name:        "Escapes values in the else branch",
+			Template:    "https://example.com/{{ if .Enabled }}{{ .ID }}{{ else }}{{ .Name }}{{ end }}",
+			Data:        urlData{Name: "a/b"},
+			ExpectedURL: "https://example.com/a%2Fb",
+		},
+		{
+			Name:        "Escapes values as query values after a query started in an if branch",
+			Template:    "https://example.com/{{ .ID }}{{ if .Enabled }}?name={{ .Name }}{{ end }}&id={{ .ID }}",
+			Data:        data,
+			ExpectedURL: "https://example.com/system%2F1%20a?name=a%26b%3Dc+d&id=system%2F1+a",
+		},
+		{
+			Name:        "Escapes values in the range body",
+			Template:    "https://example.com/systems?{{ range .Tags }}tag={{ . }}&{{ end }}",
+			Data:        data,
+			ExpectedURL: "https://example.com/systems?tag=x%26y&tag=z%2Fw&",
+		},
+		{
+			Name:        "Escapes values in the range else branch",
+			Template:    "https://example.com/systems?{{ range .Tags }}tag={{ . }}{{ else }}name={{ .Name }}{{ end }}",
+			Data:        urlData{Name: "a b"},
+			ExpectedURL: "https://example.com/systems?name=a+b",
+		},
+		{
+			Name:        "Escapes values in the with body",
+			Template:    "https://example.com/{{ with .ID }}{{ . }}{{ end }}",
+			Data:        data,
+			ExpectedURL: "https://example.com/system%2F1%20a",
+		},
+		{
+			Name:        "Does not escape variable declarations but escapes the usage of the variables",
+			Template:    "https://example.com/{{ $id := .ID }}{{ $id }}?name={{ $name := .Name }}{{ $name }}",
+			Data:        data,
+			ExpectedURL: "https://example.com/system%2F1%20a?name=a%26b%3Dc+d",
+		},
+		{
+			Name:          "Returns error when the template is invalid",
+			Template:      "https://example.com/{{ .ID ",
+			Data:          data,
+			ExpectedError: "unclosed action",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			result, err := templatehelper.ExecuteURLTemplate(testCase.Template, testCase.Data)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedURL, result)
+			}
+		})
+	}
+}
@@ -56,61 +56,37 @@ func NewClient(httpClient *http.Client, mtlsClient *http.Client) *client {
 }
 
 func (c *client) Do(ctx context.Context, request WebhookRequest) (*webhook.Response, error) {
-	var err error
-	webhook := request.GetWebhook()
-	if webhook == nil {
-		return nil, errors.Errorf("the webhook entity cannot be nil")
+	rendered, err := Render(request)
+	if err != nil {
+		return nil, err
 	}
 
+	return c.DoRendered(ctx, *request.GetWebhook(), rendered, request.GetCorrelationID())
+}
+
+// DoRendered executes an already rendered webhook request and parses the response using the webhook's output template
+func (c *client) DoRendered(ctx context.Context, webhook graphql.Webhook, rendered *RenderedRequest, correlationID string) (*webhook.Response, error) {
 	if webhook.OutputTemplate == nil {
 		return nil, errors.Errorf("missing output template")
 	}
 
-	var method string
-	url := webhook.URL
-	if webhook.URLTemplate != nil {
-		resultURL, err := request.GetObject().ParseURLTemplate(webhook.URLTemplate)
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to parse webhook URL")
-		}
-		url = resultURL.Path
-		method = *resultURL.Method
-	}
-
-	if url == nil {
-		return nil, errors.Errorf("missing webhook url")
-	}
-
-	body := []byte(emptyBody)
-	if webhook.InputTemplate != nil {
-		body, err = request.GetObject().ParseInputTemplate(webhook.InputTemplate)
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to parse webhook input body")
-		}
-	}
-
-	headers := http.Header{}
-	if webhook.HeaderTemplate != nil {
-		headers, err = request.GetObject().ParseHeadersTemplate(webhook.HeaderTemplate)
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to parse webhook headers")
-		}
-	}
-	correlationID := request.GetCorrelationID()
 	var correlationIDKey string
 	if webhook.CorrelationIDKey != nil {
 		correlationIDKey = *webhook.CorrelationIDKey
 	}
 	ctx = correlation.SaveCorrelationKeyValuePairToContext(ctx, correlationIDKey, correlationID)
 
-	req, err := http.NewRequestWithContext(ctx, method, *url, bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, rendered.Method, rendered.URL, bytes.NewBuffer(rendered.Body))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header = headers
+	req.Header = rendered.Headers.Clone()
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
 
-	resp, err := c.executeRequestWithCorrectClient(ctx, req, *webhook)
+	resp, err := c.executeRequestWithCorrectClient(ctx, req, webhook)
 	if err != nil {
 		return nil, errors.Wrap(err, "while initially executing webhook")
 	}
@@ -148,6 +124,57 @@ func (c *client) Do(ctx context.Context, request WebhookRequest) (*webhook.Respo
 	return response, checkForErr(resp, response.SuccessStatusCode, response.IncompleteStatusCode, response.Error)
 }
 
+// Render resolves the URL, method, headers and body of a webhook request from the webhook templates without executing it
+func Render(request WebhookRequest) (*RenderedRequest, error) {
+	var err error
+	webhook := request.GetWebhook()
+	if webhook == nil {
+		return nil, errors.Errorf("the webhook entity cannot be nil")
+	}
+
+	if webhook.OutputTemplate == nil {
+		return nil, errors.Errorf("missing output template")
+	}
+
+	var method string
+	url := webhook.URL
+	if webhook.URLTemplate != nil {
+		resultURL, err := request.GetObject().ParseURLTemplate(webhook.URLTemplate)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to parse webhook URL")
+		}
+		url = resultURL.Path
+		method = *resultURL.Method
+	}
+
+	if url == nil {
+		return nil, errors.Errorf("missing webhook url")
+	}
+
+	body := []byte(emptyBody)
+	if webhook.InputTemplate != nil {
+		body, err = request.GetObject().ParseInputTemplate(webhook.InputTemplate)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to parse webhook input body")
+		}
+	}
+
+	headers := http.Header{}
+	if webhook.HeaderTemplate != nil {
+		headers, err = request.GetObject().ParseHeadersTemplate(webhook.HeaderTemplate)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to parse webhook headers")
+		}
+	}
+
+	return &RenderedRequest{
+		Method:  method,
+		URL:     *url,
+		Headers: headers,
+		Body:    body,
+	}, nil
+}
+
 func (c *client) Poll(ctx context.Context, request *PollRequest) (*webhook.ResponseStatus, error) {
 	var err error
 	webhook := request.Webhook
@@ -629,6 +629,70 @@ func TestClient_Do_WhenMissingCorrelationID_ShouldBeSuccessful(t *testing.T) {
 	require.Equal(t, http.StatusAccepted, *resp.ActualStatusCode)
 }
 
+func TestRender_ShouldResolveWebhookTemplates(t *testing.T) {
+	URLTemplate := "{\"method\": \"PATCH\",\"path\":\"https://test-domain.com/api/v1/applications/{{.Application.ID}}\"}"
+	inputTemplate := "{\"application_id\": \"{{.Application.ID}}\"}"
+	headersTemplate := "{\"user-identity\":[\"{{.Headers.Client_user}}\"]}"
+	outputTemplate := emptyTemplate
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhookReq := &webhookclient.Request{
+		Webhook: &graphql.Webhook{
+			URLTemplate:    &URLTemplate,
+			InputTemplate:  &inputTemplate,
+			HeaderTemplate: &headersTemplate,
+			OutputTemplate: &outputTemplate,
+		},
+		Object: &webhook.ApplicationLifecycleWebhookRequestObject{Application: app, Headers: map[string]string{"Client_user": "user"}},
+	}
+
+	rendered, err := webhookclient.Render(webhookReq)
+
+	require.NoError(t, err)
+	require.Equal(t, http.MethodPatch, rendered.Method)
+	require.Equal(t, "https://test-domain.com/api/v1/applications/appID", rendered.URL)
+	require.Equal(t, []string{"user"}, rendered.Headers["user-identity"])
+	require.JSONEq(t, `{"application_id": "appID"}`, string(rendered.Body))
+}
+
+func TestRender_WhenWebhookIsNil_ShouldReturnError(t *testing.T) {
+	_, err := webhookclient.Render(&webhookclient.Request{})
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the webhook entity cannot be nil")
+}
+
+func TestClient_DoRendered_ShouldExecuteRenderedRequest(t *testing.T) {
+	outputTemplate := "{\"success_status_code\": 202,\"error\": \"{{.Body.error}}\"}"
+	rendered := &webhookclient.RenderedRequest{
+		Method:  http.MethodPost,
+		URL:     "https://test-domain.com/notify",
+		Headers: http.Header{"Idempotency-Key": []string{"key"}},
+		Body:    []byte(`{"id":"1"}`),
+	}
+
+	client := webhookclient.NewClient(&http.Client{
+		Transport: mockedTransport{
+			resp: &http.Response{
+				Body:       io.NopCloser(bytes.NewReader([]byte("{}"))),
+				StatusCode: http.StatusAccepted,
+			},
+			roundTripExpectations: func(r *http.Request) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "https://test-domain.com/notify", r.URL.String())
+				require.Equal(t, "key", r.Header.Get("Idempotency-Key"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, `{"id":"1"}`, string(body))
+			},
+		},
+	}, nil)
+
+	resp, err := client.DoRendered(context.Background(), graphql.Webhook{OutputTemplate: &outputTemplate}, rendered, "")
+
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, *resp.ActualStatusCode)
+}
+
 func TestClient_Poll_WhenHeadersTemplateIsInvalid_ShouldReturnError(t *testing.T) {
 	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
 	webhookReq := &webhookclient.PollRequest{
@@ -2,6 +2,7 @@ package webhookclient
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
@@ -51,6 +52,14 @@ type PollRequest struct {
 	PollURL string
 }
 
+// RenderedRequest represents a webhook request whose templates have already been resolved
+type RenderedRequest struct {
+	Method  string
+	URL     string
+	Headers http.Header
+	Body    []byte
+}
+
 // FormationNotificationRequest represents a formation webhook request to be executed with added Operation, Formation and FormationType
 type FormationNotificationRequest struct {
 	*Request
@@ -81,7 +81,8 @@ type config struct {
 	ConfigurationFile       string
 	ConfigurationFileReload time.Duration `envconfig:"default=1m"`
 
-	StaticGroupsSrc string `envconfig:"default=/data/static-groups.yaml"`
+	StaticGroupsSrc   string `envconfig:"default=/data/static-groups.yaml"`
+	StaticGroupLoader tenantmapping.StaticGroupLoaderConfig
 
 	MetricsConfig metrics.Config
 
@@ -178,7 +179,11 @@ func registerHydratorHandlers(ctx context.Context, router *mux.Router, authentic
 		Transport: httputil.NewCorrelationIDTransport(httputil.NewHTTPTransportWrapper(http.DefaultTransport.(*http.Transport))),
 	}
 
-	internalDirectorClientProvider := director.NewClientProvider(cfg.Director.InternalURL, cfg.Director.ClientTimeout, cfg.Director.SkipSSLValidation)
+	var internalDirectorClientProvider director.ClientProvider = director.NewClientProvider(cfg.Director.InternalURL, cfg.Director.ClientTimeout, cfg.Director.SkipSSLValidation)
+	if cfg.Director.Cache.Enabled {
+		logger.Infof("Caching director responses for %s (not found responses for %s)...", cfg.Director.Cache.TTL, cfg.Director.Cache.NegativeTTL)
+		internalDirectorClientProvider = director.NewCachingClientProvider(internalDirectorClientProvider, director.NewResponseCache(cfg.Director.Cache, metricsCollector))
+	}
 	internalGatewayClientProvider := director.NewClientProvider(cfg.Director.InternalGatewayURL, cfg.Director.ClientTimeout, cfg.Director.SkipSSLValidation)
 	cfgProvider := createAndRunConfigProvider(ctx, cfg)
 
@@ -186,7 +191,7 @@ func registerHydratorHandlers(ctx context.Context, router *mux.Router, authentic
 	authnMappingHandlerFunc := authnmappinghandler.NewHandler(ctx, oathkeeper.NewReqDataParser(), httpClient, authnmappinghandler.DefaultTokenVerifierProvider, authenticators, cfg.InitialSubdomainsForAuthenticators)
 
 	logger.Infof("Registering Tenant Mapping endpoint on %s...", cfg.Handler.TenantMappingEndpoint)
-	tenantMappingHandlerFunc, err := getTenantMappingHandlerFunc(authenticators, internalDirectorClientProvider, internalGatewayClientProvider, cfg.StaticGroupsSrc, cfgProvider, cfg.ConsumerClaimsKeys, metricsCollector, cfg.TenantSubstitutionLabelKey)
+	tenantMappingHandlerFunc, err := getTenantMappingHandlerFunc(ctx, authenticators, internalDirectorClientProvider, internalGatewayClientProvider, cfg.StaticGroupsSrc, cfg.StaticGroupLoader, cfgProvider, cfg.ConsumerClaimsKeys, metricsCollector, cfg.TenantSubstitutionLabelKey)
 	exitOnError(err, "Error while configuring tenant mapping handler")
 
 	logger.Infof("Registering Certificate Resolver endpoint on %s...", cfg.Handler.CertResolverEndpoint)
@@ -218,11 +223,12 @@ func createAndRunConfigProvider(ctx context.Context, cfg config) *configprovider
 	return provider
 }
 
-func getTenantMappingHandlerFunc(authenticators []authenticator.Config, internalDirectorClientProvider, internalGatewayClientProvider director.ClientProvider, staticGroupsSrc string, cfgProvider *configprovider.Provider, consumerClaimsKeysConfig cfg.ConsumerClaimsKeysConfig, metricsCollector *metrics.Collector, tenantSubstitutionLabelKey string) (*tenantmapping.Handler, error) {
+func getTenantMappingHandlerFunc(ctx context.Context, authenticators []authenticator.Config, internalDirectorClientProvider, internalGatewayClientProvider director.ClientProvider, staticGroupsSrc string, staticGroupLoaderCfg tenantmapping.StaticGroupLoaderConfig, cfgProvider *configprovider.Provider, consumerClaimsKeysConfig cfg.ConsumerClaimsKeysConfig, metricsCollector *metrics.Collector, tenantSubstitutionLabelKey string) (*tenantmapping.Handler, error) {
 	staticGroupsRepo, err := tenantmapping.NewStaticGroupRepository(staticGroupsSrc)
 	if err != nil {
 		return nil, errors.Wrap(err, "while creating StaticGroup repository instance")
 	}
+	go tenantmapping.NewStaticGroupLoader(staticGroupsRepo, internalDirectorClientProvider.Client(), staticGroupLoaderCfg).Run(ctx)
 
 	objectContextProviders := map[string]tenantmapping.ObjectContextProvider{
 		tenantmappingconst.UserObjectContextProvider:             tenantmapping.NewUserContextProvider(internalDirectorClientProvider.Client(), staticGroupsRepo),
@@ -5,12 +5,10 @@ package automock
 import (
 	context "context"
 
-	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	schema "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	model "github.com/kyma-incubator/compass/components/director/pkg/model"
 	director "github.com/kyma-incubator/compass/components/hydrator/internal/director"
-
 	mock "github.com/stretchr/testify/mock"
-
-	model "github.com/kyma-incubator/compass/components/director/pkg/model"
 )
 
 // Client is an autogenerated mock type for the Client type
@@ -23,6 +21,10 @@ func (_m *Client) GetSystemAuthByID(ctx context.Context, authID string) (*model.
 	ret := _m.Called(ctx, authID)
 
 	var r0 *model.SystemAuth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.SystemAuth, error)); ok {
+		return rf(ctx, authID)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) *model.SystemAuth); ok {
 		r0 = rf(ctx, authID)
 	} else {
@@ -31,7 +33,6 @@ func (_m *Client) GetSystemAuthByID(ctx context.Context, authID string) (*model.
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = rf(ctx, authID)
 	} else {
@@ -46,6 +47,10 @@ func (_m *Client) GetSystemAuthByToken(ctx context.Context, token string) (*mode
 	ret := _m.Called(ctx, token)
 
 	var r0 *model.SystemAuth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.SystemAuth, error)); ok {
+		return rf(ctx, token)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) *model.SystemAuth); ok {
 		r0 = rf(ctx, token)
 	} else {
@@ -54,7 +59,6 @@ func (_m *Client) GetSystemAuthByToken(ctx context.Context, token string) (*mode
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = rf(ctx, token)
 	} else {
@@ -65,19 +69,22 @@ func (_m *Client) GetSystemAuthByToken(ctx context.Context, token string) (*mode
 }
 
 // GetTenantByExternalID provides a mock function with given fields: ctx, tenantID
-func (_m *Client) GetTenantByExternalID(ctx context.Context, tenantID string) (*graphql.Tenant, error) {
+func (_m *Client) GetTenantByExternalID(ctx context.Context, tenantID string) (*schema.Tenant, error) {
 	ret := _m.Called(ctx, tenantID)
 
-	var r0 *graphql.Tenant
-	if rf, ok := ret.Get(0).(func(context.Context, string) *graphql.Tenant); ok {
+	var r0 *schema.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schema.Tenant, error)); ok {
+		return rf(ctx, tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schema.Tenant); ok {
 		r0 = rf(ctx, tenantID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*graphql.Tenant)
+			r0 = ret.Get(0).(*schema.Tenant)
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = rf(ctx, tenantID)
 	} else {
@@ -88,19 +95,22 @@ func (_m *Client) GetTenantByExternalID(ctx context.Context, tenantID string) (*
 }
 
 // GetTenantByInternalID provides a mock function with given fields: ctx, tenantID
-func (_m *Client) GetTenantByInternalID(ctx context.Context, tenantID string) (*graphql.Tenant, error) {
+func (_m *Client) GetTenantByInternalID(ctx context.Context, tenantID string) (*schema.Tenant, error) {
 	ret := _m.Called(ctx, tenantID)
 
-	var r0 *graphql.Tenant
-	if rf, ok := ret.Get(0).(func(context.Context, string) *graphql.Tenant); ok {
+	var r0 *schema.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schema.Tenant, error)); ok {
+		return rf(ctx, tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schema.Tenant); ok {
 		r0 = rf(ctx, tenantID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*graphql.Tenant)
+			r0 = ret.Get(0).(*schema.Tenant)
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = rf(ctx, tenantID)
 	} else {
@@ -115,13 +125,16 @@ func (_m *Client) GetTenantByLowestOwnerForResource(ctx context.Context, resourc
 	ret := _m.Called(ctx, resourceID, resourceType)
 
 	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, resourceID, resourceType)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
 		r0 = rf(ctx, resourceID, resourceType)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
 		r1 = rf(ctx, resourceID, resourceType)
 	} else {
@@ -146,19 +159,48 @@ func (_m *Client) InvalidateSystemAuthOneTimeToken(ctx context.Context, authID s
 }
 
 // ListCertificateSubjectMappings provides a mock function with given fields: ctx, after
-func (_m *Client) ListCertificateSubjectMappings(ctx context.Context, after string) (*graphql.CertificateSubjectMappingPage, error) {
+func (_m *Client) ListCertificateSubjectMappings(ctx context.Context, after string) (*schema.CertificateSubjectMappingPage, error) {
 	ret := _m.Called(ctx, after)
 
-	var r0 *graphql.CertificateSubjectMappingPage
-	if rf, ok := ret.Get(0).(func(context.Context, string) *graphql.CertificateSubjectMappingPage); ok {
+	var r0 *schema.CertificateSubjectMappingPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*schema.CertificateSubjectMappingPage, error)); ok {
+		return rf(ctx, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *schema.CertificateSubjectMappingPage); ok {
 		r0 = rf(ctx, after)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*graphql.CertificateSubjectMappingPage)
+			r0 = ret.Get(0).(*schema.CertificateSubjectMappingPage)
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStaticGroups provides a mock function with given fields: ctx, after
+func (_m *Client) ListStaticGroups(ctx context.Context, after string) (*director.StaticGroupPage, error) {
+	ret := _m.Called(ctx, after)
+
+	var r0 *director.StaticGroupPage
 	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*director.StaticGroupPage, error)); ok {
+		return rf(ctx, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *director.StaticGroupPage); ok {
+		r0 = rf(ctx, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*director.StaticGroupPage)
+		}
+	}
+
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = rf(ctx, after)
 	} else {
@@ -173,13 +215,16 @@ func (_m *Client) UpdateSystemAuth(ctx context.Context, sysAuth *model.SystemAut
 	ret := _m.Called(ctx, sysAuth)
 
 	var r0 director.UpdateAuthResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SystemAuth) (director.UpdateAuthResult, error)); ok {
+		return rf(ctx, sysAuth)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *model.SystemAuth) director.UpdateAuthResult); ok {
 		r0 = rf(ctx, sysAuth)
 	} else {
 		r0 = ret.Get(0).(director.UpdateAuthResult)
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *model.SystemAuth) error); ok {
 		r1 = rf(ctx, sysAuth)
 	} else {
@@ -189,13 +234,12 @@ func (_m *Client) UpdateSystemAuth(ctx context.Context, sysAuth *model.SystemAut
 	return r0, r1
 }
 
-type mockConstructorTestingTNewClient interface {
+// NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClient(t interface {
 	mock.TestingT
 	Cleanup(func())
-}
-
-// NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewClient(t mockConstructorTestingTNewClient) *Client {
+}) *Client {
 	mock := &Client{}
 	mock.Mock.Test(t)
 
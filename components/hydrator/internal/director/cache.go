@@ -0,0 +1,290 @@
+package director
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	schema "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/model"
+	"github.com/patrickmn/go-cache"
+)
+
+const (
+	tenantByExternalIDOperation             = "tenant_by_external_id"
+	tenantByInternalIDOperation             = "tenant_by_internal_id"
+	tenantByLowestOwnerForResourceOperation = "tenant_by_lowest_owner_for_resource"
+	systemAuthByIDOperation                 = "system_auth_by_id"
+)
+
+// CacheConfig configures the cache of the director responses
+type CacheConfig struct {
+	Enabled     bool          `envconfig:"default=false"`
+	TTL         time.Duration `envconfig:"default=30s"`
+	NegativeTTL time.Duration `envconfig:"default=10s"`
+	MaxEntries  int           `envconfig:"default=10000"`
+}
+
+// CacheInstrumenter collects metrics for the lookups in the director responses cache
+//
+//go:generate mockery --name=CacheInstrumenter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CacheInstrumenter interface {
+	InstrumentCacheLookup(operation string, hit bool)
+}
+
+type cacheEntry struct {
+	value interface{}
+	err   error
+}
+
+// ResponseCache is a bounded TTL cache of director responses which is shared between the director clients.
+// Not found responses are cached as well for a shorter period of time.
+type ResponseCache struct {
+	cfg          CacheConfig
+	store        *cache.Cache
+	instrumenter CacheInstrumenter
+	mutex        sync.Mutex
+}
+
+// NewResponseCache creates a new ResponseCache
+func NewResponseCache(cfg CacheConfig, instrumenter CacheInstrumenter) *ResponseCache {
+	return &ResponseCache{
+		cfg:          cfg,
+		store:        cache.New(cfg.TTL, cfg.TTL),
+		instrumenter: instrumenter,
+	}
+}
+
+func (c *ResponseCache) get(operation, key string) (cacheEntry, bool) {
+	item, found := c.store.Get(operation + ":" + key)
+	c.instrumenter.InstrumentCacheLookup(operation, found)
+	if !found {
+		return cacheEntry{}, false
+	}
+
+	entry, ok := item.(cacheEntry)
+	return entry, ok
+}
+
+func (c *ResponseCache) set(operation, key string, entry cacheEntry, ttl time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.cfg.MaxEntries > 0 && c.store.ItemCount() >= c.cfg.MaxEntries {
+		c.store.DeleteExpired()
+		if c.store.ItemCount() >= c.cfg.MaxEntries {
+			log.D().Debugf("Director responses cache is full, %s response with key %q will not be cached", operation, key)
+			return
+		}
+	}
+
+	c.store.Set(operation+":"+key, entry, ttl)
+}
+
+func (c *ResponseCache) delete(operation, key string) {
+	c.store.Delete(operation + ":" + key)
+}
+
+// cachedLookup serves the value from the cache or fetches and caches it.
+// The cached value is never handed out, callers get a copy made by clone, so that they cannot modify it.
+func cachedLookup[T any](c *ResponseCache, operation, key string, clone func(T) T, fetch func() (T, error)) (T, error) {
+	if entry, found := c.get(operation, key); found {
+		value, _ := entry.value.(T)
+		return clone(value), entry.err
+	}
+
+	value, err := fetch()
+	if err != nil {
+		if IsGQLNotFoundError(err) {
+			c.set(operation, key, cacheEntry{err: err}, c.cfg.NegativeTTL)
+		}
+		return value, err
+	}
+
+	c.set(operation, key, cacheEntry{value: clone(value)}, c.cfg.TTL)
+	return value, nil
+}
+
+func cloneTenant(tenant *schema.Tenant) *schema.Tenant {
+	if tenant == nil {
+		return nil
+	}
+
+	clone := *tenant
+	if tenant.Name != nil {
+		name := *tenant.Name
+		clone.Name = &name
+	}
+	if tenant.Initialized != nil {
+		initialized := *tenant.Initialized
+		clone.Initialized = &initialized
+	}
+	if tenant.Parents != nil {
+		clone.Parents = append([]string{}, tenant.Parents...)
+	}
+	if tenant.Labels != nil {
+		clone.Labels = make(schema.Labels, len(tenant.Labels))
+		for key, value := range tenant.Labels {
+			clone.Labels[key] = value
+		}
+	}
+
+	return &clone
+}
+
+func cloneString(value string) string {
+	return value
+}
+
+func cloneSystemAuth(sysAuth *model.SystemAuth) *model.SystemAuth {
+	if sysAuth == nil {
+		return nil
+	}
+
+	clone := *sysAuth
+	clone.TenantID = cloneStringPtr(sysAuth.TenantID)
+	clone.AppID = cloneStringPtr(sysAuth.AppID)
+	clone.RuntimeID = cloneStringPtr(sysAuth.RuntimeID)
+	clone.IntegrationSystemID = cloneStringPtr(sysAuth.IntegrationSystemID)
+	if sysAuth.Value == nil {
+		return &clone
+	}
+
+	value := *sysAuth.Value
+	value.AccessStrategy = cloneStringPtr(value.AccessStrategy)
+	value.AdditionalHeaders = cloneStringSliceMap(value.AdditionalHeaders)
+	value.AdditionalQueryParams = cloneStringSliceMap(value.AdditionalQueryParams)
+	if value.Credential.Basic != nil {
+		basic := *value.Credential.Basic
+		value.Credential.Basic = &basic
+	}
+	if value.Credential.Oauth != nil {
+		oauth := *value.Credential.Oauth
+		value.Credential.Oauth = &oauth
+	}
+	if value.Credential.CertificateOAuth != nil {
+		certificateOAuth := *value.Credential.CertificateOAuth
+		value.Credential.CertificateOAuth = &certificateOAuth
+	}
+	if value.RequestAuth != nil {
+		requestAuth := *value.RequestAuth
+		if requestAuth.Csrf != nil {
+			csrf := *requestAuth.Csrf
+			csrf.AdditionalHeaders = cloneStringSliceMap(csrf.AdditionalHeaders)
+			csrf.AdditionalQueryParams = cloneStringSliceMap(csrf.AdditionalQueryParams)
+			if csrf.Credential.Basic != nil {
+				basic := *csrf.Credential.Basic
+				csrf.Credential.Basic = &basic
+			}
+			if csrf.Credential.Oauth != nil {
+				oauth := *csrf.Credential.Oauth
+				csrf.Credential.Oauth = &oauth
+			}
+			if csrf.Credential.CertificateOAuth != nil {
+				certificateOAuth := *csrf.Credential.CertificateOAuth
+				csrf.Credential.CertificateOAuth = &certificateOAuth
+			}
+			requestAuth.Csrf = &csrf
+		}
+		value.RequestAuth = &requestAuth
+	}
+	if value.OneTimeToken != nil {
+		oneTimeToken := *value.OneTimeToken
+		if oneTimeToken.ScenarioGroups != nil {
+			oneTimeToken.ScenarioGroups = append([]string{}, oneTimeToken.ScenarioGroups...)
+		}
+		value.OneTimeToken = &oneTimeToken
+	}
+	clone.Value = &value
+
+	return &clone
+}
+
+func cloneStringPtr(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	clone := *value
+	return &clone
+}
+
+func cloneStringSliceMap(values map[string][]string) map[string][]string {
+	if values == nil {
+		return nil
+	}
+
+	clone := make(map[string][]string, len(values))
+	for key, value := range values {
+		clone[key] = append([]string{}, value...)
+	}
+
+	return clone
+}
+
+type cachingClient struct {
+	Client
+	cache *ResponseCache
+}
+
+// NewCachingClient wraps the client so that tenant and system auth lookups are served from the cache.
+// The cached system auth is invalidated whenever it is updated through the client. System auth lookups by token and certificate subject mappings always reach the director.
+func NewCachingClient(client Client, cache *ResponseCache) Client {
+	return &cachingClient{
+		Client: client,
+		cache:  cache,
+	}
+}
+
+func (c *cachingClient) GetTenantByExternalID(ctx context.Context, tenantID string) (*schema.Tenant, error) {
+	return cachedLookup(c.cache, tenantByExternalIDOperation, tenantID, cloneTenant, func() (*schema.Tenant, error) {
+		return c.Client.GetTenantByExternalID(ctx, tenantID)
+	})
+}
+
+func (c *cachingClient) GetTenantByInternalID(ctx context.Context, tenantID string) (*schema.Tenant, error) {
+	return cachedLookup(c.cache, tenantByInternalIDOperation, tenantID, cloneTenant, func() (*schema.Tenant, error) {
+		return c.Client.GetTenantByInternalID(ctx, tenantID)
+	})
+}
+
+func (c *cachingClient) GetTenantByLowestOwnerForResource(ctx context.Context, resourceID, resourceType string) (string, error) {
+	return cachedLookup(c.cache, tenantByLowestOwnerForResourceOperation, resourceType+":"+resourceID, cloneString, func() (string, error) {
+		return c.Client.GetTenantByLowestOwnerForResource(ctx, resourceID, resourceType)
+	})
+}
+
+func (c *cachingClient) GetSystemAuthByID(ctx context.Context, authID string) (*model.SystemAuth, error) {
+	return cachedLookup(c.cache, systemAuthByIDOperation, authID, cloneSystemAuth, func() (*model.SystemAuth, error) {
+		return c.Client.GetSystemAuthByID(ctx, authID)
+	})
+}
+
+func (c *cachingClient) UpdateSystemAuth(ctx context.Context, sysAuth *model.SystemAuth) (UpdateAuthResult, error) {
+	defer c.cache.delete(systemAuthByIDOperation, sysAuth.ID)
+	return c.Client.UpdateSystemAuth(ctx, sysAuth)
+}
+
+func (c *cachingClient) InvalidateSystemAuthOneTimeToken(ctx context.Context, authID string) error {
+	defer c.cache.delete(systemAuthByIDOperation, authID)
+	return c.Client.InvalidateSystemAuthOneTimeToken(ctx, authID)
+}
+
+// CachingClientProvider provides director clients which share a ResponseCache
+type CachingClientProvider struct {
+	provider ClientProvider
+	cache    *ResponseCache
+}
+
+// NewCachingClientProvider creates a new CachingClientProvider
+func NewCachingClientProvider(provider ClientProvider, cache *ResponseCache) CachingClientProvider {
+	return CachingClientProvider{
+		provider: provider,
+		cache:    cache,
+	}
+}
+
+func (cp CachingClientProvider) Client() Client {
+	return NewCachingClient(cp.provider.Client(), cp.cache)
+}
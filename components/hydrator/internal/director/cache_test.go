@@ -0,0 +1,241 @@
+package director_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/model"
+	"github.com/kyma-incubator/compass/components/hydrator/internal/director"
+	"github.com/kyma-incubator/compass/components/hydrator/internal/director/automock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	externalTenantID = "external-tenant-id"
+	authID           = "auth-id"
+)
+
+var cacheConfig = director.CacheConfig{
+	Enabled:     true,
+	TTL:         time.Minute,
+	NegativeTTL: time.Minute,
+	MaxEntries:  10,
+}
+
+func TestCachingClient_GetTenantByExternalID(t *testing.T) {
+	ctx := context.TODO()
+	tenant := &graphql.Tenant{ID: "internal-tenant-id", InternalID: externalTenantID}
+	notFoundErr := errors.New("Object not found")
+	testErr := errors.New("test error")
+
+	t.Run("Serves the tenant from the cache after the first lookup", func(t *testing.T) {
+		client := &automock.Client{}
+		client.On("GetTenantByExternalID", ctx, externalTenantID).Return(tenant, nil).Once()
+		instrumenter := &automock.CacheInstrumenter{}
+		instrumenter.On("InstrumentCacheLookup", "tenant_by_external_id", false).Once()
+		instrumenter.On("InstrumentCacheLookup", "tenant_by_external_id", true).Once()
+		defer mock.AssertExpectationsForObjects(t, client, instrumenter)
+
+		cachingClient := director.NewCachingClient(client, director.NewResponseCache(cacheConfig, instrumenter))
+
+		for i := 0; i < 2; i++ {
+			result, err := cachingClient.GetTenantByExternalID(ctx, externalTenantID)
+			require.NoError(t, err)
+			require.Equal(t, tenant, result)
+		}
+	})
+
+	t.Run("Changes to the returned tenant do not affect the cached one", func(t *testing.T) {
+		cachedTenant := &graphql.Tenant{ID: "internal-tenant-id", InternalID: externalTenantID, Parents: []string{"parent-id"}, Labels: graphql.Labels{"key": "value"}}
+		client := &automock.Client{}
+		client.On("GetTenantByExternalID", ctx, externalTenantID).Return(cachedTenant, nil).Once()
+		instrumenter := &automock.CacheInstrumenter{}
+		instrumenter.On("InstrumentCacheLookup", "tenant_by_external_id", false).Once()
+		instrumenter.On("InstrumentCacheLookup", "tenant_by_external_id", true).Twice()
+		defer mock.AssertExpectationsForObjects(t, client, instrumenter)
+
+		cachingClient := director.NewCachingClient(client, director.NewResponseCache(cacheConfig, instrumenter))
+
+		for i := 0; i < 2; i++ {
+			result, err := cachingClient.GetTenantByExternalID(ctx, externalTenantID)
+			require.NoError(t, err)
+			result.Parents[0] = "modified"
+			result.Labels["key"] = "modified"
+		}
+
+		result, err := cachingClient.GetTenantByExternalID(ctx, externalTenantID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"parent-id"}, result.Parents)
+		require.Equal(t, graphql.Labels{"key": "value"}, result.Labels)
+	})
+
+	t.Run("Caches not found responses", func(t *testing.T) {
+		client := &automock.Client{}
+		client.On("GetTenantByExternalID", ctx, externalTenantID).Return(nil, notFoundErr).Once()
+		instrumenter := &automock.CacheInstrumenter{}
+		instrumenter.On("InstrumentCacheLookup", "tenant_by_external_id", false).Once()
+		instrumenter.On("InstrumentCacheLookup", "tenant_by_external_id", true).Once()
+		defer mock.AssertExpectationsForObjects(t, client, instrumenter)
+
+		cachingClient := director.NewCachingClient(client, director.NewResponseCache(cacheConfig, instrumenter))
+
+		for i := 0; i < 2; i++ {
+			result, err := cachingClient.GetTenantByExternalID(ctx, externalTenantID)
+			require.Error(t, err)
+			require.True(t, director.IsGQLNotFoundError(err))
+			require.Nil(t, result)
+		}
+	})
+
+	t.Run("Does not cache other errors", func(t *testing.T) {
+		client := &automock.Client{}
+		client.On("GetTenantByExternalID", ctx, externalTenantID).Return(nil, testErr).Twice()
+		instrumenter := &automock.CacheInstrumenter{}
+		instrumenter.On("InstrumentCacheLookup", "tenant_by_external_id", false).Twice()
+		defer mock.AssertExpectationsForObjects(t, client, instrumenter)
+
+		cachingClient := director.NewCachingClient(client, director.NewResponseCache(cacheConfig, instrumenter))
+
+		for i := 0; i < 2; i++ {
+			_, err := cachingClient.GetTenantByExternalID(ctx, externalTenantID)
+			require.EqualError(t, err, testErr.Error())
+		}
+	})
+
+	t.Run("Does not cache more than the maximum number of entries", func(t *testing.T) {
+		otherTenantID := "other-tenant-id"
+		client := &automock.Client{}
+		client.On("GetTenantByExternalID", ctx, externalTenantID).Return(tenant, nil).Once()
+		client.On("GetTenantByExternalID", ctx, otherTenantID).Return(tenant, nil).Twice()
+		instrumenter := &automock.CacheInstrumenter{}
+		instrumenter.On("InstrumentCacheLookup", "tenant_by_external_id", false).Times(3)
+		defer mock.AssertExpectationsForObjects(t, client, instrumenter)
+
+		cfg := cacheConfig
+		cfg.MaxEntries = 1
+		cachingClient := director.NewCachingClient(client, director.NewResponseCache(cfg, instrumenter))
+
+		_, err := cachingClient.GetTenantByExternalID(ctx, externalTenantID)
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			_, err = cachingClient.GetTenantByExternalID(ctx, otherTenantID)
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestCachingClient_GetTenantByLowestOwnerForResource(t *testing.T) {
+	ctx := context.TODO()
+	resourceID := "resource-id"
+
+	client := &automock.Client{}
+	client.On("GetTenantByLowestOwnerForResource", ctx, resourceID, "application").Return(externalTenantID, nil).Once()
+	client.On("GetTenantByLowestOwnerForResource", ctx, resourceID, "runtime").Return("", errors.New("Object not found")).Once()
+	instrumenter := &automock.CacheInstrumenter{}
+	instrumenter.On("InstrumentCacheLookup", "tenant_by_lowest_owner_for_resource", false).Twice()
+	instrumenter.On("InstrumentCacheLookup", "tenant_by_lowest_owner_for_resource", true).Twice()
+	defer mock.AssertExpectationsForObjects(t, client, instrumenter)
+
+	cachingClient := director.NewCachingClient(client, director.NewResponseCache(cacheConfig, instrumenter))
+
+	for i := 0; i < 2; i++ {
+		result, err := cachingClient.GetTenantByLowestOwnerForResource(ctx, resourceID, "application")
+		require.NoError(t, err)
+		require.Equal(t, externalTenantID, result)
+
+		result, err = cachingClient.GetTenantByLowestOwnerForResource(ctx, resourceID, "runtime")
+		require.Error(t, err)
+		require.Empty(t, result)
+	}
+}
+
+func TestCachingClient_SystemAuths(t *testing.T) {
+	ctx := context.TODO()
+	sysAuth := &model.SystemAuth{ID: authID}
+
+	t.Run("System auth lookups by ID are cached", func(t *testing.T) {
+		client := &automock.Client{}
+		client.On("GetSystemAuthByID", ctx, authID).Return(sysAuth, nil).Once()
+		instrumenter := &automock.CacheInstrumenter{}
+		instrumenter.On("InstrumentCacheLookup", "system_auth_by_id", false).Once()
+		instrumenter.On("InstrumentCacheLookup", "system_auth_by_id", true).Times(3)
+		defer mock.AssertExpectationsForObjects(t, client, instrumenter)
+
+		cachingClient := director.NewCachingClient(client, director.NewResponseCache(cacheConfig, instrumenter))
+
+		for i := 0; i < 2; i++ {
+			result, err := cachingClient.GetSystemAuthByID(ctx, authID)
+			require.NoError(t, err)
+			require.Equal(t, sysAuth, result)
+		}
+
+		result, err := cachingClient.GetSystemAuthByID(ctx, authID)
+		require.NoError(t, err)
+		appID := "app-id"
+		result.AppID = &appID
+
+		result, err = cachingClient.GetSystemAuthByID(ctx, authID)
+		require.NoError(t, err)
+		require.Nil(t, result.AppID)
+	})
+
+	t.Run("Cached system auth is invalidated when it is updated", func(t *testing.T) {
+		client := &automock.Client{}
+		client.On("GetSystemAuthByID", ctx, authID).Return(sysAuth, nil).Twice()
+		client.On("UpdateSystemAuth", ctx, sysAuth).Return(director.UpdateAuthResult{ID: authID}, nil).Once()
+		instrumenter := &automock.CacheInstrumenter{}
+		instrumenter.On("InstrumentCacheLookup", "system_auth_by_id", false).Twice()
+		defer mock.AssertExpectationsForObjects(t, client, instrumenter)
+
+		cachingClient := director.NewCachingClient(client, director.NewResponseCache(cacheConfig, instrumenter))
+
+		_, err := cachingClient.GetSystemAuthByID(ctx, authID)
+		require.NoError(t, err)
+
+		_, err = cachingClient.UpdateSystemAuth(ctx, sysAuth)
+		require.NoError(t, err)
+
+		_, err = cachingClient.GetSystemAuthByID(ctx, authID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Cached system auth is invalidated when its one-time token is invalidated", func(t *testing.T) {
+		client := &automock.Client{}
+		client.On("GetSystemAuthByID", ctx, authID).Return(sysAuth, nil).Twice()
+		client.On("InvalidateSystemAuthOneTimeToken", ctx, authID).Return(errors.New("test error")).Once()
+		instrumenter := &automock.CacheInstrumenter{}
+		instrumenter.On("InstrumentCacheLookup", "system_auth_by_id", false).Twice()
+		defer mock.AssertExpectationsForObjects(t, client, instrumenter)
+
+		cachingClient := director.NewCachingClient(client, director.NewResponseCache(cacheConfig, instrumenter))
+
+		_, err := cachingClient.GetSystemAuthByID(ctx, authID)
+		require.NoError(t, err)
+
+		err = cachingClient.InvalidateSystemAuthOneTimeToken(ctx, authID)
+		require.Error(t, err)
+
+		_, err = cachingClient.GetSystemAuthByID(ctx, authID)
+		require.NoError(t, err)
+	})
+
+	t.Run("System auth lookups by token are not cached", func(t *testing.T) {
+		token := "one-time-token"
+		client := &automock.Client{}
+		client.On("GetSystemAuthByToken", ctx, token).Return(sysAuth, nil).Twice()
+		instrumenter := &automock.CacheInstrumenter{}
+		defer mock.AssertExpectationsForObjects(t, client, instrumenter)
+
+		cachingClient := director.NewCachingClient(client, director.NewResponseCache(cacheConfig, instrumenter))
+
+		for i := 0; i < 2; i++ {
+			result, err := cachingClient.GetSystemAuthByToken(ctx, token)
+			require.NoError(t, err)
+			require.Equal(t, sysAuth, result)
+		}
+	})
+}
@@ -26,6 +26,7 @@ type Client interface {
 	UpdateSystemAuth(ctx context.Context, sysAuth *model.SystemAuth) (UpdateAuthResult, error)
 	InvalidateSystemAuthOneTimeToken(ctx context.Context, authID string) error
 	ListCertificateSubjectMappings(ctx context.Context, after string) (*schema.CertificateSubjectMappingPage, error)
+	ListStaticGroups(ctx context.Context, after string) (*StaticGroupPage, error)
 }
 
 type Config struct {
@@ -33,6 +34,7 @@ type Config struct {
 	InternalGatewayURL string        `envconfig:"default=http://127.0.0.1:3000/graphql"`
 	ClientTimeout      time.Duration `envconfig:"default=115s"`
 	SkipSSLValidation  bool          `envconfig:"default=false"`
+	Cache              CacheConfig
 }
 
 type client struct {
@@ -67,6 +69,24 @@ type CertSubjectMappingResponse struct {
 	Result *schema.CertificateSubjectMappingPage `json:"result"`
 }
 
+// StaticGroup is a user group mapped to scopes which is managed through the director API
+type StaticGroup struct {
+	ID        string   `json:"id"`
+	GroupName string   `json:"groupName"`
+	Scopes    []string `json:"scopes"`
+}
+
+// StaticGroupPage is a page of static groups
+type StaticGroupPage struct {
+	Data       []*StaticGroup   `json:"data"`
+	PageInfo   *schema.PageInfo `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
+}
+
+type StaticGroupsResponse struct {
+	Result *StaticGroupPage `json:"result"`
+}
+
 type UpdateAuthResult struct {
 	ID string `json:"id"`
 }
@@ -188,6 +208,18 @@ func (c *client) ListCertificateSubjectMappings(ctx context.Context, after strin
 	return response.Result, nil
 }
 
+func (c *client) ListStaticGroups(ctx context.Context, after string) (*StaticGroupPage, error) {
+	query := ListStaticGroupsQuery(300, after)
+	var response StaticGroupsResponse
+
+	err := c.execute(ctx, c.gqlClient, query, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Result, nil
+}
+
 func (c *client) execute(ctx context.Context, client *graphql.Client, query string, res interface{}) error {
 	req := graphql.NewRequest(query)
 
@@ -261,3 +261,21 @@ func ListCertificateSubjectMappingsQuery(first int, after string) string {
         }
     }`, first, after)
 }
+
+func ListStaticGroupsQuery(first int, after string) string {
+	return fmt.Sprintf(`query {
+        result: staticGroups(first: %d, after: "%s") {
+          data {
+            id
+            groupName
+            scopes
+          }
+          pageInfo {
+            startCursor
+            endCursor
+            hasNextPage
+          }
+          totalCount
+        }
+    }`, first, after)
+}
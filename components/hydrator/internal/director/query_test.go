@@ -307,6 +307,38 @@ func TestListCertificateSubjectMappingsQuery(t *testing.T) {
 	})
 }
 
+func TestListStaticGroupsQuery(t *testing.T) {
+	t.Run("Should return static groups list query", func(t *testing.T) {
+		// GIVEN
+		first := 300
+		after := "end-cursor"
+
+		expectedQuery := fmt.Sprintf(`query {
+        result: staticGroups(first: %d, after: "%s") {
+          data {
+            id
+            groupName
+            scopes
+          }
+          pageInfo {
+            startCursor
+            endCursor
+            hasNextPage
+          }
+          totalCount
+        }
+    }`, first, after)
+
+		expectedQuery = trimTabsAndNewLineSpace(expectedQuery)
+
+		// WHEN
+		query := trimTabsAndNewLineSpace(director.ListStaticGroupsQuery(first, after))
+
+		// THEN
+		require.Equal(t, expectedQuery, query)
+	})
+}
+
 func trimTabsAndNewLineSpace(str string) string {
 	var res string
 
@@ -23,6 +23,7 @@ type Collector struct {
 	clientTotal     *prometheus.CounterVec
 	requestTotal    *prometheus.CounterVec
 	requestDuration *prometheus.HistogramVec
+	cacheLookups    *prometheus.CounterVec
 }
 
 // NewCollector missing godoc
@@ -48,6 +49,12 @@ func NewCollector(config Config) *Collector {
 			Name:      "request_duration_seconds",
 			Help:      "Duration of handling requests",
 		}, []string{"code", "method"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: HydratorSubsystem,
+			Name:      "director_cache_lookups_total",
+			Help:      "Total lookups in the director responses cache",
+		}, []string{"operation", "result"}),
 	}
 }
 
@@ -56,6 +63,7 @@ func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.clientTotal.Describe(ch)
 	c.requestTotal.Describe(ch)
 	c.requestDuration.Describe(ch)
+	c.cacheLookups.Describe(ch)
 }
 
 // Collect missing godoc
@@ -63,6 +71,7 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.clientTotal.Collect(ch)
 	c.requestTotal.Collect(ch)
 	c.requestDuration.Collect(ch)
+	c.cacheLookups.Collect(ch)
 }
 
 // InstrumentClient instruments a given client caller.
@@ -88,6 +97,19 @@ func (c *Collector) InstrumentClient(clientID, authFlow, details string) {
 	}).Inc()
 }
 
+// InstrumentCacheLookup counts the hits and misses of a given operation in the director responses cache
+func (c *Collector) InstrumentCacheLookup(operation string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	c.cacheLookups.With(prometheus.Labels{
+		"operation": operation,
+		"result":    result,
+	}).Inc()
+}
+
 // HandlerInstrumentation instruments a handler that counts total requests and requests duration
 func (c *Collector) HandlerInstrumentation(handler http.Handler) http.HandlerFunc {
 	return promhttp.InstrumentHandlerCounter(c.requestTotal,
@@ -1,9 +1,11 @@
 package tenantmapping
 
 import (
+	"bytes"
 	"context"
 	"io/ioutil"
 	"strings"
+	"sync"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -28,39 +30,82 @@ type StaticGroupRepository interface {
 	Get(ctx context.Context, groupnames []string) StaticGroups
 }
 
+// staticGroupRepository holds the static groups from the static groups file and the ones managed through the director.
+// The groups from the file take precedence over the director ones with the same name.
 type staticGroupRepository struct {
-	data map[string]StaticGroup
+	srcPath string
+
+	mutex          sync.RWMutex
+	data           map[string]StaticGroup
+	fileContent    []byte
+	fileGroups     map[string]StaticGroup
+	directorGroups map[string]StaticGroup
 }
 
 // NewStaticGroupRepository missing godoc
 func NewStaticGroupRepository(srcPath string) (*staticGroupRepository, error) {
-	staticGroupsBytes, err := ioutil.ReadFile(srcPath)
+	repo := &staticGroupRepository{
+		srcPath: srcPath,
+	}
+
+	if _, err := repo.Reload(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+// Reload reads the static groups file again and swaps the static groups from it if its content has changed.
+// The previously loaded static groups are kept if the file can not be read or parsed. It reports whether the static groups were swapped.
+func (r *staticGroupRepository) Reload(ctx context.Context) (bool, error) {
+	staticGroupsBytes, err := ioutil.ReadFile(r.srcPath)
 	if err != nil {
-		return nil, errors.Wrap(err, "while reading static groups file")
+		return false, errors.Wrap(err, "while reading static groups file")
+	}
+
+	r.mutex.RLock()
+	unchanged := r.fileContent != nil && bytes.Equal(r.fileContent, staticGroupsBytes)
+	r.mutex.RUnlock()
+	if unchanged {
+		return false, nil
 	}
 
 	var staticGroups []StaticGroup
 	if err := yaml.UnmarshalStrict(staticGroupsBytes, &staticGroups, yaml.DisallowUnknownFields); err != nil {
-		return nil, errors.Wrap(err, "while unmarshalling static groups YAML")
+		return false, errors.Wrap(err, "while unmarshalling static groups YAML")
 	}
 
-	data := make(map[string]StaticGroup)
+	fileGroups := toStaticGroupsMap(ctx, staticGroups)
 
-	for _, sg := range staticGroups {
-		data[sg.GroupName] = sg
-	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.fileContent = staticGroupsBytes
+	r.fileGroups = fileGroups
+	r.data = mergeStaticGroups(r.fileGroups, r.directorGroups)
+
+	return true, nil
+}
+
+// SetDirectorGroups swaps the static groups which are managed through the director
+func (r *staticGroupRepository) SetDirectorGroups(ctx context.Context, staticGroups []StaticGroup) {
+	directorGroups := toStaticGroupsMap(ctx, staticGroups)
 
-	return &staticGroupRepository{
-		data: data,
-	}, nil
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.directorGroups = directorGroups
+	r.data = mergeStaticGroups(r.fileGroups, r.directorGroups)
 }
 
 // Get missing godoc
 func (r *staticGroupRepository) Get(ctx context.Context, groupnames []string) StaticGroups {
+	r.mutex.RLock()
+	data := r.data
+	r.mutex.RUnlock()
+
 	result := []StaticGroup{}
 
 	for _, groupname := range groupnames {
-		if staticGroup, ok := r.data[groupname]; ok {
+		if staticGroup, ok := data[groupname]; ok {
 			result = append(result, staticGroup)
 		} else {
 			log.C(ctx).Warnf("Static group with name %s not found", groupname)
@@ -87,3 +132,31 @@ func (groups StaticGroups) GetGroupScopes() string {
 
 	return strings.Join(filteredScopes, " ")
 }
+
+// toStaticGroupsMap indexes the static groups by name, skipping the ones without a name or scopes
+func toStaticGroupsMap(ctx context.Context, staticGroups []StaticGroup) map[string]StaticGroup {
+	data := make(map[string]StaticGroup, len(staticGroups))
+
+	for _, sg := range staticGroups {
+		if len(sg.GroupName) == 0 || len(sg.Scopes) == 0 {
+			log.C(ctx).Warnf("Skipping static group with name %q as it has no name or scopes", sg.GroupName)
+			continue
+		}
+		data[sg.GroupName] = sg
+	}
+
+	return data
+}
+
+func mergeStaticGroups(fileGroups, directorGroups map[string]StaticGroup) map[string]StaticGroup {
+	data := make(map[string]StaticGroup, len(fileGroups)+len(directorGroups))
+
+	for name, sg := range directorGroups {
+		data[name] = sg
+	}
+	for name, sg := range fileGroups {
+		data[name] = sg
+	}
+
+	return data
+}
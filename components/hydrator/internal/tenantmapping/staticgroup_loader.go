@@ -0,0 +1,123 @@
+package tenantmapping
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/hydrator/internal/director"
+	"github.com/pkg/errors"
+)
+
+// StaticGroupLoaderCorrelationID is the correlation ID of the static groups reloads
+var StaticGroupLoaderCorrelationID = "static-group-loader-correlation-id"
+
+// StaticGroupDirectorClient is a GraphQL client used to list the static groups managed through the director
+//
+//go:generate mockery --name=StaticGroupDirectorClient --output=automock --outpkg=automock --case=underscore --disable-version-string
+type StaticGroupDirectorClient interface {
+	ListStaticGroups(ctx context.Context, after string) (*director.StaticGroupPage, error)
+}
+
+// StaticGroupLoaderConfig configures the reloading of the static groups
+type StaticGroupLoaderConfig struct {
+	ReloadInterval   time.Duration `envconfig:"default=30s"`
+	LoadFromDirector bool          `envconfig:"default=false"`
+}
+
+type staticGroupLoader struct {
+	repo           *staticGroupRepository
+	directorClient StaticGroupDirectorClient
+	cfg            StaticGroupLoaderConfig
+}
+
+// NewStaticGroupLoader creates a loader which periodically reloads the static groups file into the repository
+// and, if enabled, the static groups managed through the director
+func NewStaticGroupLoader(repo *staticGroupRepository, directorClient StaticGroupDirectorClient, cfg StaticGroupLoaderConfig) *staticGroupLoader {
+	return &staticGroupLoader{
+		repo:           repo,
+		directorClient: directorClient,
+		cfg:            cfg,
+	}
+}
+
+// Run reloads the static groups on every tick until the context is cancelled
+func (l *staticGroupLoader) Run(ctx context.Context) {
+	entry := log.C(ctx).WithField(log.FieldRequestID, StaticGroupLoaderCorrelationID)
+	ctx = log.ContextWithLogger(ctx, entry)
+
+	l.Load(ctx)
+
+	t := time.NewTicker(l.cfg.ReloadInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Context cancelled, stopping static groups loader...")
+			t.Stop()
+			return
+		case <-t.C:
+			l.Load(ctx)
+		}
+	}
+}
+
+// Load reloads the static groups once. Failures are logged and the previously loaded static groups are kept.
+func (l *staticGroupLoader) Load(ctx context.Context) {
+	if swapped, err := l.repo.Reload(ctx); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to reload static groups file, keeping the previously loaded static groups: %v", err)
+	} else if swapped {
+		log.C(ctx).Info("Successfully reloaded static groups file")
+	} else {
+		log.C(ctx).Debug("Static groups file has not changed")
+	}
+
+	if !l.cfg.LoadFromDirector {
+		return
+	}
+
+	staticGroups, err := l.listDirectorStaticGroups(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to load static groups from director, keeping the previously loaded static groups: %v", err)
+		return
+	}
+
+	l.repo.SetDirectorGroups(ctx, staticGroups)
+	log.C(ctx).Infof("Static group(s) count from director: %d", len(staticGroups))
+}
+
+func (l *staticGroupLoader) listDirectorStaticGroups(ctx context.Context) ([]StaticGroup, error) {
+	staticGroups := make([]StaticGroup, 0)
+
+	after := ""
+	hasNextPage := true
+	for hasNextPage {
+		page, err := l.directorClient.ListStaticGroups(ctx, after)
+		if err != nil {
+			return nil, errors.Wrap(err, "while listing static groups from director")
+		}
+
+		// The graphql response could be nil, and there could be NO graphql error in case something else failed.
+		// E.g., an error from the oathkeeper or other components
+		if page == nil {
+			return nil, errors.New("the static groups page cannot be nil")
+		}
+		if page.PageInfo == nil {
+			return nil, errors.New("the static groups page info cannot be nil")
+		}
+
+		for _, sg := range page.Data {
+			if sg == nil {
+				continue
+			}
+			staticGroups = append(staticGroups, StaticGroup{
+				GroupName: sg.GroupName,
+				Scopes:    sg.Scopes,
+			})
+		}
+
+		hasNextPage = page.PageInfo.HasNextPage
+		after = string(page.PageInfo.EndCursor)
+	}
+
+	return staticGroups, nil
+}
@@ -0,0 +1,143 @@
+package tenantmapping_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	schema "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/hydrator/internal/director"
+	"github.com/kyma-incubator/compass/components/hydrator/internal/tenantmapping"
+	"github.com/kyma-incubator/compass/components/hydrator/internal/tenantmapping/automock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const staticGroupsFileContent = `
+- groupname: "admin"
+  scopes:
+  - "application:write"
+`
+
+func TestStaticGroupLoader_Load(t *testing.T) {
+	ctx := context.TODO()
+	endCursor := "end-cursor"
+	groupNames := []string{"admin", "developer", "operator"}
+
+	firstPage := &director.StaticGroupPage{
+		Data: []*director.StaticGroup{
+			{ID: "1", GroupName: "admin", Scopes: []string{"runtime:write"}},
+			{ID: "2", GroupName: "developer", Scopes: []string{"application:read"}},
+		},
+		PageInfo:   &schema.PageInfo{EndCursor: schema.PageCursor(endCursor), HasNextPage: true},
+		TotalCount: 3,
+	}
+	secondPage := &director.StaticGroupPage{
+		Data:       []*director.StaticGroup{{ID: "3", GroupName: "operator", Scopes: []string{"runtime:read"}}},
+		PageInfo:   &schema.PageInfo{HasNextPage: false},
+		TotalCount: 3,
+	}
+
+	adminGroup := tenantmapping.StaticGroup{GroupName: "admin", Scopes: []string{"application:write"}}
+	developerGroup := tenantmapping.StaticGroup{GroupName: "developer", Scopes: []string{"application:read"}}
+	operatorGroup := tenantmapping.StaticGroup{GroupName: "operator", Scopes: []string{"runtime:read"}}
+
+	testCases := []struct {
+		Name                 string
+		LoadFromDirector     bool
+		DirectorClientFn     func() *automock.StaticGroupDirectorClient
+		ExpectedStaticGroups tenantmapping.StaticGroups
+	}{
+		{
+			Name:                 "Loads only the static groups file when loading from director is disabled",
+			DirectorClientFn:     func() *automock.StaticGroupDirectorClient { return &automock.StaticGroupDirectorClient{} },
+			ExpectedStaticGroups: tenantmapping.StaticGroups{adminGroup},
+		},
+		{
+			Name:             "Loads the static groups from all director pages with the file ones taking precedence",
+			LoadFromDirector: true,
+			DirectorClientFn: func() *automock.StaticGroupDirectorClient {
+				client := &automock.StaticGroupDirectorClient{}
+				client.On("ListStaticGroups", mock.Anything, "").Return(firstPage, nil).Once()
+				client.On("ListStaticGroups", mock.Anything, endCursor).Return(secondPage, nil).Once()
+				return client
+			},
+			ExpectedStaticGroups: tenantmapping.StaticGroups{adminGroup, developerGroup, operatorGroup},
+		},
+		{
+			Name:             "Keeps the file static groups when listing from director fails",
+			LoadFromDirector: true,
+			DirectorClientFn: func() *automock.StaticGroupDirectorClient {
+				client := &automock.StaticGroupDirectorClient{}
+				client.On("ListStaticGroups", mock.Anything, "").Return(firstPage, nil).Once()
+				client.On("ListStaticGroups", mock.Anything, endCursor).Return(nil, errors.New("test error")).Once()
+				return client
+			},
+			ExpectedStaticGroups: tenantmapping.StaticGroups{adminGroup},
+		},
+		{
+			Name:             "Keeps the file static groups when the director page is nil",
+			LoadFromDirector: true,
+			DirectorClientFn: func() *automock.StaticGroupDirectorClient {
+				client := &automock.StaticGroupDirectorClient{}
+				client.On("ListStaticGroups", mock.Anything, "").Return(nil, nil).Once()
+				return client
+			},
+			ExpectedStaticGroups: tenantmapping.StaticGroups{adminGroup},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "static-groups.yaml")
+			require.NoError(t, ioutil.WriteFile(filePath, []byte(staticGroupsFileContent), 0644))
+
+			repo, err := tenantmapping.NewStaticGroupRepository(filePath)
+			require.NoError(t, err)
+
+			directorClient := testCase.DirectorClientFn()
+			defer directorClient.AssertExpectations(t)
+
+			loader := tenantmapping.NewStaticGroupLoader(repo, directorClient, tenantmapping.StaticGroupLoaderConfig{ReloadInterval: time.Minute, LoadFromDirector: testCase.LoadFromDirector})
+
+			// WHEN
+			loader.Load(ctx)
+
+			// THEN
+			require.Equal(t, testCase.ExpectedStaticGroups, repo.Get(ctx, groupNames))
+		})
+	}
+}
+
+func TestStaticGroupLoader_Run(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "static-groups.yaml")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte(staticGroupsFileContent), 0644))
+
+	repo, err := tenantmapping.NewStaticGroupRepository(filePath)
+	require.NoError(t, err)
+
+	loader := tenantmapping.NewStaticGroupLoader(repo, &automock.StaticGroupDirectorClient{}, tenantmapping.StaticGroupLoaderConfig{ReloadInterval: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		loader.Run(ctx)
+		close(done)
+	}()
+
+	require.NoError(t, ioutil.WriteFile(filePath, []byte(`
+- groupname: "operator"
+  scopes:
+  - "runtime:read"
+`), 0644))
+
+	require.Eventually(t, func() bool {
+		return len(repo.Get(ctx, []string{"operator"})) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+}
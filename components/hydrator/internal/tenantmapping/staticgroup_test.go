@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -127,3 +128,103 @@ func TestStaticGroupRepository(t *testing.T) {
 		require.Equal(t, int(0), len(staticGroup))
 	})
 }
+
+func TestStaticGroupRepository_Reload(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("swaps the static groups when the file content changes", func(t *testing.T) {
+		filePath := writeStaticGroupsFile(t, validGroupsNameFileContent)
+
+		repo, err := NewStaticGroupRepository(filePath)
+		require.NoError(t, err)
+		require.Len(t, repo.Get(ctx, []string{"admin", "developer", "operator"}), 2)
+
+		err = ioutil.WriteFile(filePath, []byte(`
+- groupname: "operator"
+  scopes:
+  - "runtime:read"
+`), 0644)
+		require.NoError(t, err)
+
+		swapped, err := repo.Reload(ctx)
+
+		require.NoError(t, err)
+		require.True(t, swapped)
+		require.Equal(t, StaticGroups{{GroupName: "operator", Scopes: []string{"runtime:read"}}}, repo.Get(ctx, []string{"admin", "developer", "operator"}))
+	})
+
+	t.Run("does not swap the static groups when the file content has not changed", func(t *testing.T) {
+		filePath := writeStaticGroupsFile(t, validGroupsNameFileContent)
+
+		repo, err := NewStaticGroupRepository(filePath)
+		require.NoError(t, err)
+
+		swapped, err := repo.Reload(ctx)
+
+		require.NoError(t, err)
+		require.False(t, swapped)
+		require.Len(t, repo.Get(ctx, []string{"admin", "developer"}), 2)
+	})
+
+	t.Run("keeps the previous static groups when the file content is not valid", func(t *testing.T) {
+		filePath := writeStaticGroupsFile(t, validGroupsNameFileContent)
+
+		repo, err := NewStaticGroupRepository(filePath)
+		require.NoError(t, err)
+
+		err = ioutil.WriteFile(filePath, []byte(unknownGroupsNameFieldsFileContent), 0644)
+		require.NoError(t, err)
+
+		swapped, err := repo.Reload(ctx)
+
+		require.Error(t, err)
+		require.False(t, swapped)
+		require.Len(t, repo.Get(ctx, []string{"admin", "developer"}), 2)
+	})
+
+	t.Run("skips static groups without scopes", func(t *testing.T) {
+		filePath := writeStaticGroupsFile(t, `
+- groupname: "admin"
+  scopes:
+  - "application:write"
+- groupname: "developer"
+  scopes: []
+`)
+
+		repo, err := NewStaticGroupRepository(filePath)
+
+		require.NoError(t, err)
+		require.Equal(t, StaticGroups{{GroupName: "admin", Scopes: []string{"application:write"}}}, repo.Get(ctx, []string{"admin", "developer"}))
+	})
+}
+
+func TestStaticGroupRepository_SetDirectorGroups(t *testing.T) {
+	ctx := context.TODO()
+	filePath := writeStaticGroupsFile(t, validGroupsNameFileContent)
+
+	repo, err := NewStaticGroupRepository(filePath)
+	require.NoError(t, err)
+
+	repo.SetDirectorGroups(ctx, []StaticGroup{
+		{GroupName: "admin", Scopes: []string{"runtime:write"}},
+		{GroupName: "operator", Scopes: []string{"runtime:read"}},
+		{GroupName: "", Scopes: []string{"runtime:read"}},
+	})
+
+	staticGroups := repo.Get(ctx, []string{"admin", "operator", ""})
+	require.Equal(t, StaticGroups{
+		{GroupName: "admin", Scopes: []string{"application:write", "application:read"}},
+		{GroupName: "operator", Scopes: []string{"runtime:read"}},
+	}, staticGroups)
+
+	repo.SetDirectorGroups(ctx, nil)
+	require.Len(t, repo.Get(ctx, []string{"operator"}), 0)
+}
+
+func writeStaticGroupsFile(t *testing.T, content string) string {
+	filePath := filepath.Join(t.TempDir(), "static-groups.yaml")
+	err := ioutil.WriteFile(filePath, []byte(content), 0644)
+	require.NoError(t, err)
+
+	return filePath
+}
@@ -64,10 +64,13 @@ func (m *systemAuthContextProvider) GetObjectContext(ctx context.Context, reqDat
 	log.C(ctx).Infof("Reference object type is %s", refObjectType)
 
 	if authDetails.AuthFlow.IsCertFlow() && sysAuth.Value != nil && sysAuth.Value.CertCommonName != authDetails.AuthID {
-		sysAuth.Value.OneTimeToken = nil
-		sysAuth.Value.CertCommonName = authDetails.AuthID
+		updatedValue := *sysAuth.Value
+		updatedValue.OneTimeToken = nil
+		updatedValue.CertCommonName = authDetails.AuthID
+		updatedSysAuth := *sysAuth
+		updatedSysAuth.Value = &updatedValue
 
-		if _, err := m.directorClient.UpdateSystemAuth(ctx, sysAuth); err != nil {
+		if _, err := m.directorClient.UpdateSystemAuth(ctx, &updatedSysAuth); err != nil {
 			return ObjectContext{}, errors.Wrap(err, "while updating system auth")
 		}
 	}
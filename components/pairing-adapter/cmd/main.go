@@ -5,8 +5,10 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/credloader"
+	"github.com/kyma-incubator/compass/components/director/pkg/namespacedname"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -15,7 +17,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/handler"
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/metrics"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/vrischmann/envconfig"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
@@ -29,41 +34,35 @@ func main() {
 	ctx, err := log.Configure(context.Background(), conf.Log)
 	exitOnError(err, "while configuring logger")
 
-	authStyle, err := getAuthStyle(conf.Auth.OAuthStyle)
-	exitOnError(err, "while getting Auth Style")
-
-	transport := &http.Transport{}
-	client := &http.Client{
-		Transport: httputil.NewCorrelationIDTransport(httputil.NewHTTPTransportWrapper(transport)),
-		Timeout:   conf.ClientTimeout,
-	}
-	ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
-
-	switch conf.Auth.Type {
-	case adapter.AuthTypeOauth:
-		cc := clientcredentials.Config{
-			TokenURL:     conf.Auth.URL,
-			ClientID:     conf.Auth.ClientID,
-			ClientSecret: conf.Auth.ClientSecret,
-			AuthStyle:    authStyle,
+	mappingConfigs, err := adapter.LoadMappingConfigs(conf)
+	exitOnError(err, "while loading mappings")
+
+	metricsCollector := metrics.NewCollector()
+	prometheus.MustRegister(metricsCollector)
+
+	mappingClients := make([]adapter.MappingClient, 0, len(mappingConfigs))
+	probes := make([]adapter.UpstreamProbe, 0, len(mappingConfigs))
+	for _, mappingConfig := range mappingConfigs {
+		client, err := newMappingHTTPClient(ctx, mappingConfig, conf.ClientTimeout)
+		exitOnError(err, fmt.Sprintf("while configuring HTTP client for mapping %q", mappingConfig.Name))
+
+		mappingClients = append(mappingClients, adapter.MappingClient{
+			Name:     mappingConfig.Name,
+			Selector: mappingConfig.Selector,
+			Client:   adapter.NewInstrumentedClient(mappingConfig.Name, adapter.NewClient(client, mappingConfig.Mapping), metricsCollector),
+		})
+
+		if len(mappingConfig.HealthCheckURL) > 0 {
+			probes = append(probes, adapter.UpstreamProbe{
+				Mapping: mappingConfig.Name,
+				URL:     mappingConfig.HealthCheckURL,
+				Doer:    client,
+			})
 		}
-		client = cc.Client(ctx)
-	case adapter.AuthTypeMTLS:
-		certCache, err := credloader.StartCertLoader(ctx, conf.Auth.Config)
-		exitOnError(err, "Failed to initialize certificate loader")
-		transport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: conf.Auth.SkipSSLVerify,
-			GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
-				return certCache.Get()[conf.ExternalClientCertSecretName], nil
-			},
-		}
-	default:
-		exitOnError(errors.Errorf("auth type %s is not supported", conf.Auth.Type), "while configuring auth")
+		log.C(ctx).Infof("Configured mapping %q", mappingConfig.Name)
 	}
 
-	cli := adapter.NewClient(client, conf.Mapping)
-
-	h := adapter.NewHandler(cli)
+	h := adapter.NewRoutingHandler(adapter.NewRouter(mappingClients, conf.DefaultMapping, conf.MappingHeader, conf.ApplicationTypeLabelKey))
 	handlerWithTimeout, err := handler.WithTimeout(h, conf.ServerTimeout)
 	exitOnError(err, "Failed configuring timeout on handler")
 
@@ -74,6 +73,8 @@ func main() {
 	router.HandleFunc("/healthz", func(writer http.ResponseWriter, request *http.Request) {
 		writer.WriteHeader(http.StatusOK)
 	})
+	router.Handle("/healthz/upstreams", adapter.NewUpstreamsHealthHandler(probes))
+	router.Handle("/metrics", promhttp.Handler())
 
 	server := &http.Server{
 		Addr:              fmt.Sprintf(":%s", conf.Port),
@@ -91,6 +92,59 @@ func exitOnError(err error, context string) {
 	}
 }
 
+func newMappingHTTPClient(ctx context.Context, mappingConfig adapter.MappingConfig, defaultTimeout time.Duration) (*http.Client, error) {
+	timeout, err := mappingConfig.GetTimeout(defaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &http.Transport{}
+	client := &http.Client{
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewHTTPTransportWrapper(transport)),
+		Timeout:   timeout,
+	}
+
+	auth := mappingConfig.Auth
+	switch auth.Type {
+	case adapter.AuthTypeOauth:
+		authStyle, err := getAuthStyle(auth.OAuthStyle)
+		if err != nil {
+			return nil, errors.Wrap(err, "while getting Auth Style")
+		}
+
+		cc := clientcredentials.Config{
+			TokenURL:     auth.URL,
+			ClientID:     auth.ClientID,
+			ClientSecret: auth.ClientSecret,
+			AuthStyle:    authStyle,
+		}
+		return cc.Client(context.WithValue(ctx, oauth2.HTTPClient, client)), nil
+	case adapter.AuthTypeMTLS:
+		certSecretName := mappingConfig.ClientCertSecretName
+		if len(certSecretName) == 0 {
+			parsedCertSecret, err := namespacedname.Parse(auth.Config.ExternalClientCertSecret)
+			if err != nil {
+				return nil, errors.Wrap(err, "while parsing client certificate secret")
+			}
+			certSecretName = parsedCertSecret.Name
+		}
+
+		certCache, err := credloader.StartCertLoader(ctx, auth.Config)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to initialize certificate loader")
+		}
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: auth.SkipSSLVerify,
+			GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				return certCache.Get()[certSecretName], nil
+			},
+		}
+		return client, nil
+	default:
+		return nil, errors.Errorf("auth type %s is not supported", auth.Type)
+	}
+}
+
 func getAuthStyle(style adapter.OAuthStyle) (oauth2.AuthStyle, error) {
 	switch style {
 	case adapter.OAuthStyleInParams:
@@ -13,14 +13,34 @@ type Client interface {
 	Do(ctx context.Context, req RequestData) (*ExternalToken, error)
 }
 
+// ClientSelector selects the client of the mapping used for a given request
+//
+//go:generate mockery --name=ClientSelector --output=automock --outpkg=automock --disable-version-string
+type ClientSelector interface {
+	Select(req *http.Request, reqData RequestData) (Client, error)
+}
+
 func NewHandler(cli Client) *Handler {
-	return &Handler{cli: cli}
+	return NewRoutingHandler(staticSelector{cli: cli})
+}
+
+// NewRoutingHandler returns a Handler which calls the client selected for each request
+func NewRoutingHandler(selector ClientSelector) *Handler {
+	return &Handler{selector: selector}
 }
 
 type Handler struct {
+	selector ClientSelector
+}
+
+type staticSelector struct {
 	cli Client
 }
 
+func (s staticSelector) Select(_ *http.Request, _ RequestData) (Client, error) {
+	return s.cli, nil
+}
+
 // swagger:route POST /adapter adapter
 // Request token from external solution
 //
@@ -51,7 +71,14 @@ func (a *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	}
 
 	logger.Infof("Got ApplicationData %+v", reqData)
-	token, err := a.cli.Do(req.Context(), reqData)
+	cli, err := a.selector.Select(req, reqData)
+	if err != nil {
+		logger.Warnf("Got error on selecting mapping: %v\n", err)
+		rw.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	token, err := cli.Do(req.Context(), reqData)
 	if err != nil {
 		logger.Warnf("Got error on calling external pairing server: %v\n", err)
 		rw.WriteHeader(http.StatusInternalServerError)
@@ -124,6 +124,54 @@ func TestHandler(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, rr.Result().StatusCode)
 	})
 
+	t.Run("happy path with routing should call the selected client", func(t *testing.T) {
+		// GIVEN
+		mockClient := &automock.Client{}
+		defer mockClient.AssertExpectations(t)
+		givenToken := &adapter.ExternalToken{
+			Token: "some-token",
+		}
+		givenRequestData := givenReqData()
+		mockClient.On("Do", mock.Anything, givenRequestData).Return(givenToken, nil)
+
+		buf := new(bytes.Buffer)
+		err := json.NewEncoder(buf).Encode(givenRequestData)
+		require.NoError(t, err)
+		givenReq, err := http.NewRequest(http.MethodPost, "", buf)
+		require.NoError(t, err)
+
+		mockSelector := &automock.ClientSelector{}
+		defer mockSelector.AssertExpectations(t)
+		mockSelector.On("Select", givenReq, givenRequestData).Return(mockClient, nil)
+
+		sut := adapter.NewRoutingHandler(mockSelector)
+		rr := httptest.NewRecorder()
+		// WHEN
+		sut.ServeHTTP(rr, givenReq)
+		// THEN
+		assert.Equal(t, http.StatusOK, rr.Result().StatusCode)
+	})
+
+	t.Run("error on selecting mapping", func(t *testing.T) {
+		// GIVEN
+		givenRequestData := givenReqData()
+		buf := new(bytes.Buffer)
+		err := json.NewEncoder(buf).Encode(givenRequestData)
+		require.NoError(t, err)
+		givenReq, err := http.NewRequest(http.MethodPost, "", buf)
+		require.NoError(t, err)
+
+		mockSelector := &automock.ClientSelector{}
+		defer mockSelector.AssertExpectations(t)
+		mockSelector.On("Select", givenReq, givenRequestData).Return(nil, errors.New("some error"))
+
+		sut := adapter.NewRoutingHandler(mockSelector)
+		rr := httptest.NewRecorder()
+		// WHEN
+		sut.ServeHTTP(rr, givenReq)
+		// THEN
+		assert.Equal(t, http.StatusBadRequest, rr.Result().StatusCode)
+	})
 }
 
 func givenReqData() adapter.RequestData {
@@ -0,0 +1,84 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const upstreamStatusOK = "ok"
+
+// UpstreamProbe probes the external token service of a named mapping with a GET request to its health check URL
+type UpstreamProbe struct {
+	Mapping string
+	URL     string
+	Doer    HTTPDoer
+}
+
+// UpstreamsHealthHandler reports the health of the external token services of all probed mappings
+type UpstreamsHealthHandler struct {
+	probes []UpstreamProbe
+}
+
+// NewUpstreamsHealthHandler returns a new UpstreamsHealthHandler for the given probes
+func NewUpstreamsHealthHandler(probes []UpstreamProbe) *UpstreamsHealthHandler {
+	return &UpstreamsHealthHandler{probes: probes}
+}
+
+// ServeHTTP probes all upstreams and responds with their statuses by mapping name.
+// The response status is 503 if any of the upstreams is not healthy.
+func (h *UpstreamsHealthHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	statusCode := http.StatusOK
+	statuses := make(map[string]string, len(h.probes))
+	for _, probe := range h.probes {
+		if err := probe.check(ctx); err != nil {
+			log.C(ctx).Warnf("Upstream of mapping %q is not healthy: %v", probe.Mapping, err)
+			statuses[probe.Mapping] = err.Error()
+			statusCode = http.StatusServiceUnavailable
+			continue
+		}
+		statuses[probe.Mapping] = upstreamStatusOK
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(statusCode)
+	if err := json.NewEncoder(rw).Encode(statuses); err != nil {
+		log.C(ctx).Warnf("Got error on encoding response: %v\n", err)
+	}
+}
+
+func (p UpstreamProbe) check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.URL, nil)
+	if err != nil {
+		return errors.Wrap(err, "while creating health check request")
+	}
+
+	resp, err := p.Doer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "while performing health check request")
+	}
+
+	defer func() {
+		if _, err := io.Copy(ioutil.Discard, resp.Body); err != nil {
+			log.C(ctx).Error("Got error on discarding body content", err)
+		}
+
+		if err := resp.Body.Close(); err != nil {
+			log.C(ctx).Error("Got error on closing response body", err)
+		}
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("wrong status code, got: [%d]", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package adapter_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpstreamsHealthHandler(t *testing.T) {
+	healthyServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer healthyServer.Close()
+
+	unhealthyServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer unhealthyServer.Close()
+
+	t.Run("returns OK when all upstreams are healthy", func(t *testing.T) {
+		// GIVEN
+		sut := adapter.NewUpstreamsHealthHandler([]adapter.UpstreamProbe{
+			{Mapping: "first", URL: healthyServer.URL, Doer: healthyServer.Client()},
+			{Mapping: "second", URL: healthyServer.URL, Doer: healthyServer.Client()},
+		})
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/healthz/upstreams", nil)
+
+		// WHEN
+		sut.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, http.StatusOK, rr.Code)
+		statuses := map[string]string{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&statuses))
+		assert.Equal(t, map[string]string{"first": "ok", "second": "ok"}, statuses)
+	})
+
+	t.Run("returns Service Unavailable when an upstream is not healthy", func(t *testing.T) {
+		// GIVEN
+		sut := adapter.NewUpstreamsHealthHandler([]adapter.UpstreamProbe{
+			{Mapping: "healthy", URL: healthyServer.URL, Doer: healthyServer.Client()},
+			{Mapping: "unhealthy", URL: unhealthyServer.URL, Doer: unhealthyServer.Client()},
+		})
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/healthz/upstreams", nil)
+
+		// WHEN
+		sut.ServeHTTP(rr, req)
+
+		// THEN
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		statuses := map[string]string{}
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&statuses))
+		assert.Equal(t, "ok", statuses["healthy"])
+		assert.Contains(t, statuses["unhealthy"], "wrong status code, got: [500]")
+	})
+}
@@ -0,0 +1,38 @@
+package adapter
+
+import (
+	"context"
+	"time"
+)
+
+// MetricsCollector records the requests to the external token services
+//
+//go:generate mockery --name=MetricsCollector --output=automock --outpkg=automock --disable-version-string
+type MetricsCollector interface {
+	InstrumentMappingRequest(mapping string, duration time.Duration, failed bool)
+}
+
+// InstrumentedClient is a Client which records the duration and the result of the requests of a named mapping
+type InstrumentedClient struct {
+	mapping   string
+	cli       Client
+	collector MetricsCollector
+}
+
+// NewInstrumentedClient returns a new InstrumentedClient for the mapping with the given name
+func NewInstrumentedClient(mapping string, cli Client, collector MetricsCollector) *InstrumentedClient {
+	return &InstrumentedClient{
+		mapping:   mapping,
+		cli:       cli,
+		collector: collector,
+	}
+}
+
+// Do calls the underlying client and records the request
+func (c *InstrumentedClient) Do(ctx context.Context, reqData RequestData) (*ExternalToken, error) {
+	start := time.Now()
+	token, err := c.cli.Do(ctx, reqData)
+	c.collector.InstrumentMappingRequest(c.mapping, time.Since(start), err != nil)
+
+	return token, err
+}
@@ -0,0 +1,51 @@
+package adapter_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter/automock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrumentedClient_Do(t *testing.T) {
+	ctx := context.TODO()
+	reqData := adapter.RequestData{Tenant: "tenant"}
+
+	t.Run("records a successful request", func(t *testing.T) {
+		// GIVEN
+		token := &adapter.ExternalToken{Token: "token"}
+		cli := &automock.Client{}
+		cli.On("Do", ctx, reqData).Return(token, nil).Once()
+		collector := &automock.MetricsCollector{}
+		collector.On("InstrumentMappingRequest", "s4", mock.Anything, false).Once()
+		defer mock.AssertExpectationsForObjects(t, cli, collector)
+
+		// WHEN
+		result, err := adapter.NewInstrumentedClient("s4", cli, collector).Do(ctx, reqData)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, token, result)
+	})
+
+	t.Run("records a failed request", func(t *testing.T) {
+		// GIVEN
+		cli := &automock.Client{}
+		cli.On("Do", ctx, reqData).Return(nil, errors.New("some error")).Once()
+		collector := &automock.MetricsCollector{}
+		collector.On("InstrumentMappingRequest", "s4", mock.Anything, true).Once()
+		defer mock.AssertExpectationsForObjects(t, cli, collector)
+
+		// WHEN
+		result, err := adapter.NewInstrumentedClient("s4", cli, collector).Do(ctx, reqData)
+
+		// THEN
+		require.EqualError(t, err, "some error")
+		assert.Nil(t, result)
+	})
+}
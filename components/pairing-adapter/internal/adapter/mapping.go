@@ -0,0 +1,92 @@
+package adapter
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultMappingName is the name of the mapping configured with the Mapping and Auth environment variables
+const DefaultMappingName = "default"
+
+// LoadMappingConfigs returns the mapping configured with the Mapping and Auth environment variables, if any,
+// followed by the mappings from the mappings config file, if any
+func LoadMappingConfigs(conf Configuration) ([]MappingConfig, error) {
+	mappings := make([]MappingConfig, 0)
+	if len(conf.Mapping.TemplateExternalURL) > 0 {
+		mappings = append(mappings, MappingConfig{
+			Name:                 DefaultMappingName,
+			Mapping:              conf.Mapping,
+			Auth:                 conf.Auth,
+			ClientCertSecretName: conf.ExternalClientCertSecretName,
+		})
+	}
+
+	if len(conf.MappingsConfigFile) > 0 {
+		mappingsBytes, err := ioutil.ReadFile(conf.MappingsConfigFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "while reading mappings config file")
+		}
+
+		var fileMappings []MappingConfig
+		if err := json.Unmarshal(mappingsBytes, &fileMappings); err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling mappings config file")
+		}
+		mappings = append(mappings, fileMappings...)
+	}
+
+	if len(mappings) == 0 {
+		return nil, errors.New("at least one mapping has to be configured")
+	}
+
+	names := make(map[string]bool, len(mappings))
+	for _, m := range mappings {
+		if err := m.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "while validating mapping %q", m.Name)
+		}
+		if names[m.Name] {
+			return nil, errors.Errorf("mapping %q is configured more than once", m.Name)
+		}
+		names[m.Name] = true
+	}
+
+	if len(conf.DefaultMapping) > 0 && !names[conf.DefaultMapping] {
+		return nil, errors.Errorf("default mapping %q is not configured", conf.DefaultMapping)
+	}
+
+	return mappings, nil
+}
+
+// Validate checks that the mapping has a name, an external URL template and a supported auth type
+func (m MappingConfig) Validate() error {
+	if len(m.Name) == 0 {
+		return errors.New("name is required")
+	}
+	if len(m.Mapping.TemplateExternalURL) == 0 {
+		return errors.New("external URL template is required")
+	}
+	if m.Auth.Type != AuthTypeOauth && m.Auth.Type != AuthTypeMTLS {
+		return errors.Errorf("auth type %q is not supported", m.Auth.Type)
+	}
+	if _, err := m.GetTimeout(0); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTimeout returns the timeout of the calls to the external token service or the defaultTimeout if it is not configured
+func (m MappingConfig) GetTimeout(defaultTimeout time.Duration) (time.Duration, error) {
+	if len(m.Timeout) == 0 {
+		return defaultTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(m.Timeout)
+	if err != nil {
+		return 0, errors.Wrapf(err, "while parsing timeout %q", m.Timeout)
+	}
+
+	return timeout, nil
+}
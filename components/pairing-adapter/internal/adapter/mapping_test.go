@@ -0,0 +1,149 @@
+package adapter_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMappingConfigs(t *testing.T) {
+	envMapping := adapter.Mapping{TemplateExternalURL: "https://default.com"}
+	envAuth := adapter.Auth{Type: adapter.AuthTypeOauth}
+
+	fileMappings := `[
+		{
+			"name": "s4",
+			"selector": {"applicationTypes": ["SAP S/4HANA"]},
+			"mapping": {"templateExternalURL": "https://s4.com"},
+			"auth": {"type": "mtls", "config": {"externalClientCertSecret": "ns/s4-cert"}},
+			"timeout": "10s"
+		}
+	]`
+
+	t.Run("loads the mapping from the environment and the mappings from the file", func(t *testing.T) {
+		// GIVEN
+		conf := adapter.Configuration{
+			Mapping:                      envMapping,
+			Auth:                         envAuth,
+			ExternalClientCertSecretName: "cert",
+			MappingsConfigFile:           writeMappingsFile(t, fileMappings),
+			DefaultMapping:               "s4",
+		}
+
+		// WHEN
+		mappings, err := adapter.LoadMappingConfigs(conf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, mappings, 2)
+		assert.Equal(t, adapter.MappingConfig{Name: adapter.DefaultMappingName, Mapping: envMapping, Auth: envAuth, ClientCertSecretName: "cert"}, mappings[0])
+		assert.Equal(t, "s4", mappings[1].Name)
+		assert.Equal(t, []string{"SAP S/4HANA"}, mappings[1].Selector.ApplicationTypes)
+		assert.Equal(t, adapter.AuthTypeMTLS, mappings[1].Auth.Type)
+		assert.Equal(t, "ns/s4-cert", mappings[1].Auth.Config.ExternalClientCertSecret)
+	})
+
+	t.Run("fails when no mappings are configured", func(t *testing.T) {
+		// WHEN
+		_, err := adapter.LoadMappingConfigs(adapter.Configuration{})
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one mapping has to be configured")
+	})
+
+	t.Run("fails when a mapping is configured more than once", func(t *testing.T) {
+		// GIVEN
+		conf := adapter.Configuration{
+			MappingsConfigFile: writeMappingsFile(t, `[
+				{"name": "same", "mapping": {"templateExternalURL": "https://a.com"}, "auth": {"type": "oauth"}},
+				{"name": "same", "mapping": {"templateExternalURL": "https://b.com"}, "auth": {"type": "oauth"}}
+			]`),
+		}
+
+		// WHEN
+		_, err := adapter.LoadMappingConfigs(conf)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `mapping "same" is configured more than once`)
+	})
+
+	t.Run("fails when the default mapping is not configured", func(t *testing.T) {
+		// GIVEN
+		conf := adapter.Configuration{
+			Mapping:        envMapping,
+			Auth:           envAuth,
+			DefaultMapping: "unknown",
+		}
+
+		// WHEN
+		_, err := adapter.LoadMappingConfigs(conf)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `default mapping "unknown" is not configured`)
+	})
+
+	t.Run("fails when a mapping is not valid", func(t *testing.T) {
+		// GIVEN
+		conf := adapter.Configuration{
+			MappingsConfigFile: writeMappingsFile(t, `[{"name": "invalid", "mapping": {"templateExternalURL": "https://a.com"}, "auth": {"type": "basic"}}]`),
+		}
+
+		// WHEN
+		_, err := adapter.LoadMappingConfigs(conf)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `auth type "basic" is not supported`)
+	})
+
+	t.Run("fails when the mappings file cannot be read", func(t *testing.T) {
+		// GIVEN
+		conf := adapter.Configuration{
+			MappingsConfigFile: filepath.Join(t.TempDir(), "missing.json"),
+		}
+
+		// WHEN
+		_, err := adapter.LoadMappingConfigs(conf)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while reading mappings config file")
+	})
+}
+
+func TestMappingConfig_GetTimeout(t *testing.T) {
+	t.Run("returns the default timeout when the timeout is not configured", func(t *testing.T) {
+		timeout, err := adapter.MappingConfig{}.GetTimeout(time.Minute)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Minute, timeout)
+	})
+
+	t.Run("returns the configured timeout", func(t *testing.T) {
+		timeout, err := adapter.MappingConfig{Timeout: "5s"}.GetTimeout(time.Minute)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Second, timeout)
+	})
+
+	t.Run("fails when the configured timeout is not valid", func(t *testing.T) {
+		_, err := adapter.MappingConfig{Timeout: "five seconds"}.GetTimeout(time.Minute)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while parsing timeout")
+	})
+}
+
+func writeMappingsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "mappings.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
@@ -22,31 +22,60 @@ const (
 type OAuthStyle string
 
 type Configuration struct {
-	Mapping       Mapping
-	Auth          Auth
+	// Mapping and Auth configure the mapping named "default". They are optional when MappingsConfigFile is provided.
+	Mapping       Mapping       `envconfig:"optional"`
+	Auth          Auth          `envconfig:"optional"`
 	Port          string        `envconfig:"default=8080"`
 	ClientTimeout time.Duration `envconfig:"default=30s"`
 	ServerTimeout time.Duration `envconfig:"default=30s"`
 	Log           *log.Config
 
-	ExternalClientCertSecretName string `envconfig:"APP_EXTERNAL_CLIENT_CERT_SECRET_NAME"`
+	ExternalClientCertSecretName string `envconfig:"optional,APP_EXTERNAL_CLIENT_CERT_SECRET_NAME"`
+
+	// MappingsConfigFile is the path to a JSON file with additional named mappings
+	MappingsConfigFile      string `envconfig:"optional"`
+	DefaultMapping          string `envconfig:"optional"`
+	MappingHeader           string `envconfig:"default=X-Pairing-Adapter-Mapping"`
+	ApplicationTypeLabelKey string `envconfig:"default=applicationType"`
 }
 
 type Mapping struct {
-	TemplateExternalURL       string
-	TemplateHeaders           string
-	TemplateJSONBody          string
-	TemplateTokenFromResponse string
+	TemplateExternalURL       string `json:"templateExternalURL"`
+	TemplateHeaders           string `json:"templateHeaders"`
+	TemplateJSONBody          string `json:"templateJSONBody"`
+	TemplateTokenFromResponse string `json:"templateTokenFromResponse"`
 }
 
 type Auth struct {
-	Type          string
-	ClientID      string     `envconfig:"optional"`
-	ClientSecret  string     `envconfig:"optional"`
-	URL           string     `envconfig:"optional"`
-	OAuthStyle    OAuthStyle `envconfig:"optional,default=AuthDetect"`
-	SkipSSLVerify bool       `envconfig:"default=false,SKIP_SSL_VERIFY"`
-	Config        credloader.CertConfig
+	Type          string                `json:"type"`
+	ClientID      string                `envconfig:"optional" json:"clientID"`
+	ClientSecret  string                `envconfig:"optional" json:"clientSecret"`
+	URL           string                `envconfig:"optional" json:"url"`
+	OAuthStyle    OAuthStyle            `envconfig:"optional,default=AuthDetect" json:"oauthStyle"`
+	SkipSSLVerify bool                  `envconfig:"default=false,SKIP_SSL_VERIFY" json:"skipSSLVerify"`
+	Config        credloader.CertConfig `json:"config"`
+}
+
+// MappingConfig is a named mapping to an external token service together with the auth and the timeout used to call it
+type MappingConfig struct {
+	Name     string          `json:"name"`
+	Selector MappingSelector `json:"selector"`
+	Mapping  Mapping         `json:"mapping"`
+	Auth     Auth            `json:"auth"`
+	// Timeout of the calls to the external token service, e.g. "10s". The client timeout is used when it is empty.
+	Timeout string `json:"timeout,omitempty"`
+	// HealthCheckURL is probed with a GET request by the upstreams health check. The mapping is not probed when it is empty.
+	HealthCheckURL string `json:"healthCheckURL,omitempty"`
+	// ClientCertSecretName is the name of the secret with the client certificate for the mtls auth type.
+	// The name from the auth config is used when it is empty.
+	ClientCertSecretName string `json:"clientCertSecretName,omitempty"`
+}
+
+// MappingSelector selects the mapping used for the requests of the given integration systems or application types.
+// A mapping can also be selected explicitly with its name in the mapping header.
+type MappingSelector struct {
+	IntegrationSystemIDs []string `json:"integrationSystemIDs,omitempty"`
+	ApplicationTypes     []string `json:"applicationTypes,omitempty"`
 }
 
 // swagger:response externalToken
@@ -74,6 +103,8 @@ type RequestData struct {
 	ClientUser string
 	// in: body
 	ScenarioGroups []ScenarioGroup
+	// in: body
+	Labels map[string]interface{}
 }
 
 type ResponseData struct {
@@ -0,0 +1,92 @@
+package adapter
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// MappingClient is the client of a named mapping together with the selector of the requests it serves
+type MappingClient struct {
+	Name     string
+	Selector MappingSelector
+	Client   Client
+}
+
+// Router selects the mapping client used for a given request
+type Router struct {
+	clients           map[string]Client
+	intSystemMappings map[string]string
+	appTypeMappings   map[string]string
+	defaultMapping    string
+	mappingHeader     string
+	appTypeLabelKey   string
+}
+
+// NewRouter returns a Router over the given mapping clients. The first mapping which selects a given integration system
+// or application type is used for it.
+func NewRouter(mappingClients []MappingClient, defaultMapping, mappingHeader, appTypeLabelKey string) *Router {
+	r := &Router{
+		clients:           make(map[string]Client, len(mappingClients)),
+		intSystemMappings: make(map[string]string),
+		appTypeMappings:   make(map[string]string),
+		defaultMapping:    defaultMapping,
+		mappingHeader:     mappingHeader,
+		appTypeLabelKey:   appTypeLabelKey,
+	}
+
+	for _, mc := range mappingClients {
+		r.clients[mc.Name] = mc.Client
+		for _, intSysID := range mc.Selector.IntegrationSystemIDs {
+			if _, ok := r.intSystemMappings[intSysID]; !ok {
+				r.intSystemMappings[intSysID] = mc.Name
+			}
+		}
+		for _, appType := range mc.Selector.ApplicationTypes {
+			if _, ok := r.appTypeMappings[appType]; !ok {
+				r.appTypeMappings[appType] = mc.Name
+			}
+		}
+	}
+
+	if len(r.defaultMapping) == 0 && len(mappingClients) == 1 {
+		r.defaultMapping = mappingClients[0].Name
+	}
+
+	return r
+}
+
+// Select returns the client of the mapping named in the mapping header, if present. Otherwise, it returns the client
+// of the mapping selecting the integration system or the application type of the request, falling back to the default mapping.
+func (r *Router) Select(req *http.Request, reqData RequestData) (Client, error) {
+	if name := req.Header.Get(r.mappingHeader); len(name) > 0 {
+		return r.clientByName(name)
+	}
+
+	if intSysID := reqData.Application.IntegrationSystemID; intSysID != nil {
+		if name, ok := r.intSystemMappings[*intSysID]; ok {
+			return r.clientByName(name)
+		}
+	}
+
+	if appType, ok := reqData.Labels[r.appTypeLabelKey].(string); ok {
+		if name, ok := r.appTypeMappings[appType]; ok {
+			return r.clientByName(name)
+		}
+	}
+
+	if len(r.defaultMapping) == 0 {
+		return nil, errors.New("no mapping matches the request and no default mapping is configured")
+	}
+
+	return r.clientByName(r.defaultMapping)
+}
+
+func (r *Router) clientByName(name string) (Client, error) {
+	cli, ok := r.clients[name]
+	if !ok {
+		return nil, errors.Errorf("mapping %q is not configured", name)
+	}
+
+	return cli, nil
+}
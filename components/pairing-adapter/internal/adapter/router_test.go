@@ -0,0 +1,123 @@
+package adapter_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mappingHeader   = "X-Pairing-Adapter-Mapping"
+	appTypeLabelKey = "applicationType"
+	intSystemID     = "int-sys-id"
+	appType         = "app-type"
+)
+
+func TestRouter_Select(t *testing.T) {
+	defaultClient := &automock.Client{}
+	intSystemClient := &automock.Client{}
+	appTypeClient := &automock.Client{}
+
+	mappingClients := []adapter.MappingClient{
+		{Name: "default", Client: defaultClient},
+		{Name: "int-system", Selector: adapter.MappingSelector{IntegrationSystemIDs: []string{intSystemID}}, Client: intSystemClient},
+		{Name: "app-type", Selector: adapter.MappingSelector{ApplicationTypes: []string{appType}}, Client: appTypeClient},
+	}
+
+	testCases := []struct {
+		Name           string
+		DefaultMapping string
+		Header         string
+		ReqData        adapter.RequestData
+		ExpectedClient adapter.Client
+		ExpectedErr    string
+	}{
+		{
+			Name:           "selects the mapping from the header",
+			DefaultMapping: "default",
+			Header:         "app-type",
+			ReqData:        adapter.RequestData{Application: graphql.Application{IntegrationSystemID: str.Ptr(intSystemID)}},
+			ExpectedClient: appTypeClient,
+		},
+		{
+			Name:           "selects the mapping by integration system",
+			DefaultMapping: "default",
+			ReqData: adapter.RequestData{
+				Application: graphql.Application{IntegrationSystemID: str.Ptr(intSystemID)},
+				Labels:      map[string]interface{}{appTypeLabelKey: appType},
+			},
+			ExpectedClient: intSystemClient,
+		},
+		{
+			Name:           "selects the mapping by application type",
+			DefaultMapping: "default",
+			ReqData: adapter.RequestData{
+				Application: graphql.Application{IntegrationSystemID: str.Ptr("unknown")},
+				Labels:      map[string]interface{}{appTypeLabelKey: appType},
+			},
+			ExpectedClient: appTypeClient,
+		},
+		{
+			Name:           "falls back to the default mapping",
+			DefaultMapping: "default",
+			ReqData:        adapter.RequestData{Labels: map[string]interface{}{appTypeLabelKey: "unknown"}},
+			ExpectedClient: defaultClient,
+		},
+		{
+			Name:           "fails when the mapping from the header is not configured",
+			DefaultMapping: "default",
+			Header:         "unknown",
+			ExpectedErr:    `mapping "unknown" is not configured`,
+		},
+		{
+			Name:        "fails when no mapping matches and there is no default mapping",
+			ExpectedErr: "no mapping matches the request",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			req, err := http.NewRequest(http.MethodPost, "", nil)
+			require.NoError(t, err)
+			if len(testCase.Header) > 0 {
+				req.Header.Set(mappingHeader, testCase.Header)
+			}
+
+			router := adapter.NewRouter(mappingClients, testCase.DefaultMapping, mappingHeader, appTypeLabelKey)
+
+			// WHEN
+			cli, err := router.Select(req, testCase.ReqData)
+
+			// THEN
+			if len(testCase.ExpectedErr) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+				assert.Nil(t, cli)
+			} else {
+				require.NoError(t, err)
+				assert.Same(t, testCase.ExpectedClient, cli)
+			}
+		})
+	}
+
+	t.Run("uses the only mapping as default", func(t *testing.T) {
+		// GIVEN
+		req, err := http.NewRequest(http.MethodPost, "", nil)
+		require.NoError(t, err)
+		router := adapter.NewRouter(mappingClients[1:2], "", mappingHeader, appTypeLabelKey)
+
+		// WHEN
+		cli, err := router.Select(req, adapter.RequestData{})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Same(t, intSystemClient, cli)
+	})
+}
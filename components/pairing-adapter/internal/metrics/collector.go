@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Namespace is the namespace of the pairing adapter metrics
+	Namespace = "compass"
+	// PairingAdapterSubsystem is the subsystem of the pairing adapter metrics
+	PairingAdapterSubsystem = "pairing_adapter"
+)
+
+// Collector collects the metrics of the calls to the external token services per mapping
+type Collector struct {
+	mappingRequestTotal    *prometheus.CounterVec
+	mappingRequestDuration *prometheus.HistogramVec
+}
+
+// NewCollector returns a new Collector
+func NewCollector() *Collector {
+	return &Collector{
+		mappingRequestTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: PairingAdapterSubsystem,
+			Name:      "mapping_requests_total",
+			Help:      "Total requests to the external token service per mapping",
+		}, []string{"mapping", "result"}),
+		mappingRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: PairingAdapterSubsystem,
+			Name:      "mapping_request_duration_seconds",
+			Help:      "Duration of the requests to the external token service per mapping",
+		}, []string{"mapping", "result"}),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.mappingRequestTotal.Describe(ch)
+	c.mappingRequestDuration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.mappingRequestTotal.Collect(ch)
+	c.mappingRequestDuration.Collect(ch)
+}
+
+// InstrumentMappingRequest records a request to the external token service of the given mapping
+func (c *Collector) InstrumentMappingRequest(mapping string, duration time.Duration, failed bool) {
+	result := "success"
+	if failed {
+		result = "failure"
+	}
+
+	labels := prometheus.Labels{
+		"mapping": mapping,
+		"result":  result,
+	}
+	c.mappingRequestTotal.With(labels).Inc()
+	c.mappingRequestDuration.With(labels).Observe(duration.Seconds())
+}
@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE notification_outbox
+(
+    id                      UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    webhook_id              UUID        NOT NULL,
+    formation_id            UUID,
+    formation_assignment_id UUID,
+    operation               VARCHAR(256),
+    correlation_id          VARCHAR(256),
+    status                  TEXT        NOT NULL CHECK (status IN ('PENDING', 'IN_FLIGHT', 'SENT', 'FAILED')),
+    attempts                INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at         TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error              TEXT,
+    created_at              TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at                 TIMESTAMP
+);
+
+CREATE INDEX notification_outbox_pending_idx
+    ON notification_outbox (next_attempt_at) WHERE status IN ('PENDING', 'IN_FLIGHT');
+
+CREATE INDEX notification_outbox_sent_at_idx
+    ON notification_outbox (sent_at) WHERE status = 'SENT';
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS app_template_placeholder_values;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+-- The values of sensitive placeholders are never stored
+CREATE TABLE app_template_placeholder_values
+(
+    app_id             UUID PRIMARY KEY CHECK (app_id <> '00000000-0000-0000-0000-000000000000') REFERENCES applications (id) ON DELETE CASCADE,
+    app_template_id    UUID      NOT NULL REFERENCES app_templates (id) ON DELETE CASCADE,
+    placeholder_values JSONB     NOT NULL DEFAULT '[]'::jsonb,
+    created_at         TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX app_template_placeholder_values_app_template_id_idx
+    ON app_template_placeholder_values (app_template_id);
+
+COMMIT;
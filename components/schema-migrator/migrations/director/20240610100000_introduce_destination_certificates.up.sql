@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE destination_certificates
+(
+    id                      UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    formation_assignment_id UUID         NOT NULL REFERENCES formation_assignments (id) ON DELETE CASCADE,
+    authentication_type     VARCHAR(256) NOT NULL,
+    name                    VARCHAR(64)  NOT NULL,
+    subaccount_id           VARCHAR(256) NOT NULL,
+    instance_id             VARCHAR(256),
+    self_signed             BOOLEAN      NOT NULL DEFAULT FALSE,
+    generation              INTEGER      NOT NULL DEFAULT 0,
+    state                   TEXT         NOT NULL CHECK (state IN ('ACTIVE', 'PENDING')),
+    expires_at              TIMESTAMP,
+    created_at              TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX destination_certificates_assignment_auth_type_state_idx
+    ON destination_certificates (formation_assignment_id, authentication_type, state);
+
+CREATE INDEX destination_certificates_expires_at_idx
+    ON destination_certificates (expires_at) WHERE state = 'ACTIVE';
+
+COMMIT;
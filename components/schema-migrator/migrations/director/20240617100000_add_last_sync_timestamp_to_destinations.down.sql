@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE destinations
+    DROP COLUMN last_sync_timestamp;
+
+COMMIT;
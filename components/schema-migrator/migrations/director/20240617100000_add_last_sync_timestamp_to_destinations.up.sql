@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE destinations
+    ADD COLUMN last_sync_timestamp TIMESTAMP;
+
+COMMIT;
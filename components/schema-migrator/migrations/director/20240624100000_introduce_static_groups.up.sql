@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE static_groups
+(
+    id         UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    group_name VARCHAR(256) NOT NULL,
+    scopes     JSONB        NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CONSTRAINT static_groups_group_name_unique UNIQUE (group_name)
+);
+
+COMMIT;
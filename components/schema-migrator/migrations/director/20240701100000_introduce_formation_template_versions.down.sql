@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE formations
+    DROP COLUMN IF EXISTS formation_template_version;
+
+DROP TABLE IF EXISTS formation_template_versions;
+
+COMMIT;
@@ -0,0 +1,54 @@
+BEGIN;
+
+CREATE TABLE formation_template_versions
+(
+    id                    UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    formation_template_id UUID      NOT NULL REFERENCES formation_templates (id) ON DELETE CASCADE,
+    version               INTEGER   NOT NULL CHECK (version > 0),
+    snapshot              JSONB     NOT NULL,
+    created_at            TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT formation_template_versions_template_version_unique UNIQUE (formation_template_id, version)
+);
+
+-- Every existing formation template gets its current state as version 1
+INSERT INTO formation_template_versions (id, formation_template_id, version, snapshot, created_at)
+SELECT uuid_generate_v4(),
+       ft.id,
+       1,
+       jsonb_build_object(
+               'name', ft.name,
+               'applicationTypes', COALESCE(ft.application_types, '[]'::jsonb),
+               'runtimeTypes', COALESCE(ft.runtime_types, '[]'::jsonb),
+               'runtimeTypeDisplayName', ft.runtime_type_display_name,
+               'runtimeArtifactKind', ft.runtime_artifact_kind,
+               'leadingProductIDs', COALESCE(ft.leading_product_ids, '[]'::jsonb),
+               'supportsReset', ft.supports_reset,
+               'discoveryConsumers', COALESCE(ft.discovery_consumers, '[]'::jsonb),
+               'webhooks', COALESCE((SELECT jsonb_agg(jsonb_strip_nulls(jsonb_build_object(
+                                                               'id', w.id,
+                                                               'type', w.type,
+                                                               'url', w.url,
+                                                               'mode', w.mode,
+                                                               'urlTemplate', w.url_template,
+                                                               'inputTemplate', w.input_template,
+                                                               'headerTemplate', w.header_template,
+                                                               'outputTemplate', w.output_template,
+                                                               'statusTemplate', w.status_template,
+                                                               'auth', w.auth)) ORDER BY w.id)
+                                     FROM webhooks w
+                                     WHERE w.formation_template_id = ft.id), '[]'::jsonb),
+               'constraintIDs', COALESCE((SELECT jsonb_agg(r.formation_constraint_id ORDER BY r.formation_constraint_id)
+                                          FROM formation_template_constraint_references r
+                                          WHERE r.formation_template_id = ft.id), '[]'::jsonb)
+           ),
+       CURRENT_TIMESTAMP
+FROM formation_templates ft;
+
+-- 0 means that the formation follows the latest version of its template
+ALTER TABLE formations
+    ADD COLUMN formation_template_version INTEGER NOT NULL DEFAULT 0;
+
+UPDATE formations
+SET formation_template_version = 1;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+DROP INDEX IF EXISTS formation_assignments_valid_until_idx;
+DROP INDEX IF EXISTS formation_assignments_valid_from_idx;
+
+DELETE FROM formation_assignments
+WHERE state = 'SCHEDULED';
+
+ALTER TABLE formation_assignments DROP CONSTRAINT formation_assignments_state_check;
+
+ALTER TABLE formation_assignments
+ADD CONSTRAINT formation_assignments_state_check CHECK ( state IN ('INITIAL', 'READY', 'CREATE_ERROR', 'DELETING', 'DELETE_ERROR', 'CONFIG_PENDING'));
+
+ALTER TABLE formation_assignments
+    DROP CONSTRAINT formation_assignments_validity_check,
+    DROP COLUMN valid_from,
+    DROP COLUMN valid_until;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+ALTER TABLE formation_assignments
+    ADD COLUMN valid_from TIMESTAMP,
+    ADD COLUMN valid_until TIMESTAMP,
+    ADD CONSTRAINT formation_assignments_validity_check CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_from < valid_until);
+
+ALTER TABLE formation_assignments DROP CONSTRAINT formation_assignments_state_check;
+
+ALTER TABLE formation_assignments
+ADD CONSTRAINT formation_assignments_state_check CHECK ( state IN ('INITIAL', 'READY', 'CREATE_ERROR', 'DELETING', 'DELETE_ERROR', 'CONFIG_PENDING', 'SCHEDULED'));
+
+-- The schedule of a participant is kept on its self-referencing formation assignment
+CREATE INDEX formation_assignments_valid_from_idx ON formation_assignments (valid_from) WHERE source = target AND state = 'SCHEDULED';
+CREATE INDEX formation_assignments_valid_until_idx ON formation_assignments (valid_until) WHERE source = target AND valid_until IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP VIEW IF EXISTS health_checks_tenants;
+
+DROP TABLE IF EXISTS health_checks;
+
+COMMIT;
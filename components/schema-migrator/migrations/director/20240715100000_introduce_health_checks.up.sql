@@ -0,0 +1,23 @@
+BEGIN;
+
+CREATE TABLE health_checks
+(
+    id        UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    app_id    UUID         NOT NULL REFERENCES applications (id) ON DELETE CASCADE,
+    type      VARCHAR(256) NOT NULL,
+    condition TEXT         NOT NULL CHECK (condition IN ('SUCCEEDED', 'FAILED')),
+    url       TEXT         NOT NULL,
+    message   TEXT,
+    timestamp TIMESTAMP    NOT NULL
+);
+
+CREATE INDEX health_checks_app_id_timestamp_idx ON health_checks (app_id, timestamp DESC);
+
+CREATE INDEX health_checks_timestamp_idx ON health_checks (timestamp);
+
+CREATE OR REPLACE VIEW health_checks_tenants AS
+SELECT hc.*, ta.tenant_id, ta.owner
+FROM health_checks AS hc
+         INNER JOIN tenant_applications ta ON ta.id = hc.app_id;
+
+COMMIT;
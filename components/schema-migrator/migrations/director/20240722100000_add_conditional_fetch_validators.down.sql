@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS ord_document_cache_validators;
+
+ALTER TABLE fetch_requests
+    DROP COLUMN IF EXISTS etag,
+    DROP COLUMN IF EXISTS last_modified;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+ALTER TABLE fetch_requests
+    ADD COLUMN etag          TEXT,
+    ADD COLUMN last_modified TEXT;
+
+CREATE TABLE ord_document_cache_validators
+(
+    id            UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    webhook_id    UUID NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    resource_id   UUID NOT NULL,
+    document_url  TEXT NOT NULL,
+    etag          TEXT,
+    last_modified TEXT,
+    CONSTRAINT ord_document_cache_validators_webhook_resource_url_unique UNIQUE (webhook_id, resource_id, document_url)
+);
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+DROP TABLE IF EXISTS ord_pushed_payloads;
+
+DELETE FROM operation WHERE op_type = 'ORD_PUSH';
+
+ALTER TABLE operation
+    DROP CONSTRAINT operation_op_type_check;
+
+ALTER TABLE operation
+    ADD CONSTRAINT operation_op_type_check CHECK (op_type IN ('ORD_AGGREGATION', 'SYSTEM_FETCHING', 'SAAS_REGISTRY_DISCOVERY'));
+
+COMMIT;
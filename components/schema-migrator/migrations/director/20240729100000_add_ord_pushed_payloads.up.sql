@@ -0,0 +1,20 @@
+BEGIN;
+
+ALTER TABLE operation
+    DROP CONSTRAINT operation_op_type_check;
+
+ALTER TABLE operation
+    ADD CONSTRAINT operation_op_type_check CHECK (op_type IN ('ORD_AGGREGATION', 'SYSTEM_FETCHING', 'SAAS_REGISTRY_DISCOVERY', 'ORD_PUSH'));
+
+CREATE TABLE ord_pushed_payloads
+(
+    id             UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    application_id UUID      NOT NULL REFERENCES applications (id) ON DELETE CASCADE,
+    configuration  JSONB     NOT NULL,
+    files          JSONB     NOT NULL,
+    created_at     TIMESTAMP NOT NULL
+);
+
+CREATE INDEX ord_pushed_payloads_application_id_idx ON ord_pushed_payloads (application_id);
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+DROP TRIGGER set_title_specification ON specifications;
+DROP TRIGGER set_search_vector_api_def ON api_definitions;
+DROP TRIGGER set_search_vector_event_def ON event_api_definitions;
+DROP TRIGGER set_search_vector_capability ON capabilities;
+DROP TRIGGER set_search_vector_entity_type ON entity_types;
+DROP TRIGGER set_search_vector_data_product ON data_products;
+
+ALTER TABLE api_definitions
+    DROP COLUMN search_vector;
+ALTER TABLE event_api_definitions
+    DROP COLUMN search_vector;
+ALTER TABLE capabilities
+    DROP COLUMN search_vector;
+ALTER TABLE entity_types
+    DROP COLUMN search_vector;
+ALTER TABLE data_products
+    DROP COLUMN search_vector;
+ALTER TABLE specifications
+    DROP COLUMN title;
+
+DROP PROCEDURE backfill_catalog_search(INT);
+DROP FUNCTION set_specification_title();
+DROP FUNCTION set_titled_resource_search_vector();
+DROP FUNCTION set_named_resource_search_vector();
+DROP FUNCTION catalog_search_vector(TEXT, TEXT, TEXT, TEXT, JSONB);
+DROP FUNCTION specification_title(TEXT);
+
+COMMIT;
@@ -0,0 +1,157 @@
+BEGIN;
+
+-- Returns the title of an OpenAPI or AsyncAPI specification in JSON or YAML format
+CREATE OR REPLACE FUNCTION specification_title(spec_data TEXT)
+    RETURNS TEXT
+AS
+$$
+BEGIN
+    IF spec_data IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    BEGIN
+        RETURN spec_data::JSONB #>> '{info,title}';
+    EXCEPTION
+        WHEN OTHERS THEN
+            RETURN substring(spec_data FROM '(?n)^info:.*\n(?:[ \t]+.*\n)*?[ \t]+title:[ \t]*[''"]?([^''"\r\n]+)');
+    END;
+END
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- Builds the full-text search document of a catalog resource from its own columns. Matches in the name and the ORD ID rank highest.
+CREATE OR REPLACE FUNCTION catalog_search_vector(name TEXT, ord_id TEXT, short_description TEXT, description TEXT, tags JSONB)
+    RETURNS TSVECTOR
+AS
+$$
+SELECT setweight(to_tsvector('english', COALESCE(name, '')), 'A') ||
+       setweight(to_tsvector('english', COALESCE(ord_id, '')), 'A') ||
+       setweight(to_tsvector('english', COALESCE(tags, '[]'::JSONB)), 'B') ||
+       setweight(to_tsvector('english', COALESCE(short_description, '') || ' ' || COALESCE(description, '')), 'C')
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE OR REPLACE FUNCTION set_named_resource_search_vector()
+    RETURNS TRIGGER
+AS
+$$
+BEGIN
+    NEW.search_vector := catalog_search_vector(NEW.name, NEW.ord_id, NEW.short_description, NEW.description, NEW.tags);
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION set_titled_resource_search_vector()
+    RETURNS TRIGGER
+AS
+$$
+BEGIN
+    NEW.search_vector := catalog_search_vector(NEW.title, NEW.ord_id, NEW.short_description, NEW.description, NEW.tags);
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+-- The title of a specification is captured when its data is written, so that the specification is parsed only once
+CREATE OR REPLACE FUNCTION set_specification_title()
+    RETURNS TRIGGER
+AS
+$$
+BEGIN
+    NEW.title := specification_title(NEW.spec_data);
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE api_definitions
+    ADD COLUMN search_vector TSVECTOR;
+ALTER TABLE event_api_definitions
+    ADD COLUMN search_vector TSVECTOR;
+ALTER TABLE capabilities
+    ADD COLUMN search_vector TSVECTOR;
+ALTER TABLE entity_types
+    ADD COLUMN search_vector TSVECTOR;
+ALTER TABLE data_products
+    ADD COLUMN search_vector TSVECTOR;
+ALTER TABLE specifications
+    ADD COLUMN title TEXT;
+
+CREATE TRIGGER set_search_vector_api_def
+    BEFORE INSERT OR UPDATE OF name, ord_id, short_description, description, tags
+    ON api_definitions
+    FOR EACH ROW
+EXECUTE PROCEDURE set_named_resource_search_vector();
+
+CREATE TRIGGER set_search_vector_event_def
+    BEFORE INSERT OR UPDATE OF name, ord_id, short_description, description, tags
+    ON event_api_definitions
+    FOR EACH ROW
+EXECUTE PROCEDURE set_named_resource_search_vector();
+
+CREATE TRIGGER set_search_vector_capability
+    BEFORE INSERT OR UPDATE OF name, ord_id, short_description, description, tags
+    ON capabilities
+    FOR EACH ROW
+EXECUTE PROCEDURE set_named_resource_search_vector();
+
+CREATE TRIGGER set_search_vector_entity_type
+    BEFORE INSERT OR UPDATE OF title, ord_id, short_description, description, tags
+    ON entity_types
+    FOR EACH ROW
+EXECUTE PROCEDURE set_titled_resource_search_vector();
+
+CREATE TRIGGER set_search_vector_data_product
+    BEFORE INSERT OR UPDATE OF title, ord_id, short_description, description, tags
+    ON data_products
+    FOR EACH ROW
+EXECUTE PROCEDURE set_titled_resource_search_vector();
+
+CREATE TRIGGER set_title_specification
+    BEFORE INSERT OR UPDATE OF spec_data
+    ON specifications
+    FOR EACH ROW
+EXECUTE PROCEDURE set_specification_title();
+
+CREATE INDEX api_definitions_search_vector_idx ON api_definitions USING GIN (search_vector);
+CREATE INDEX event_api_definitions_search_vector_idx ON event_api_definitions USING GIN (search_vector);
+CREATE INDEX capabilities_search_vector_idx ON capabilities USING GIN (search_vector);
+CREATE INDEX entity_types_search_vector_idx ON entity_types USING GIN (search_vector);
+CREATE INDEX data_products_search_vector_idx ON data_products USING GIN (search_vector);
+-- The expression has to match the one the catalog search uses for the specification titles
+CREATE INDEX specifications_title_search_idx ON specifications USING GIN (to_tsvector('english', title));
+
+-- Builds the search documents of the existing catalog resources and the titles of their specifications in batches of the given size.
+-- Each batch is committed separately, so that the rows are not locked until the whole backfill is done. It is called by the backfill_catalog_search migration.
+CREATE OR REPLACE PROCEDURE backfill_catalog_search(batch_size INT)
+AS
+$$
+DECLARE
+    backfill      RECORD;
+    last_id       UUID;
+    batch_last_id UUID;
+BEGIN
+    FOR backfill IN
+        SELECT *
+        FROM (VALUES ('api_definitions', 'search_vector = catalog_search_vector(name, ord_id, short_description, description, tags)'),
+                     ('event_api_definitions', 'search_vector = catalog_search_vector(name, ord_id, short_description, description, tags)'),
+                     ('capabilities', 'search_vector = catalog_search_vector(name, ord_id, short_description, description, tags)'),
+                     ('entity_types', 'search_vector = catalog_search_vector(title, ord_id, short_description, description, tags)'),
+                     ('data_products', 'search_vector = catalog_search_vector(title, ord_id, short_description, description, tags)'),
+                     ('specifications', 'title = specification_title(spec_data)')) AS backfills(table_name, assignment)
+        LOOP
+            last_id := NULL;
+            LOOP
+                EXECUTE format('WITH batch AS (SELECT id FROM %I WHERE $1 IS NULL OR id > $1 ORDER BY id LIMIT $2),
+                                     updated AS (UPDATE %I AS t SET %s FROM batch WHERE t.id = batch.id)
+                                SELECT id FROM batch ORDER BY id DESC LIMIT 1', backfill.table_name, backfill.table_name, backfill.assignment)
+                    INTO batch_last_id
+                    USING last_id, batch_size;
+
+                EXIT WHEN batch_last_id IS NULL;
+                last_id := batch_last_id;
+                COMMIT;
+            END LOOP;
+            COMMIT;
+        END LOOP;
+END
+$$ LANGUAGE plpgsql;
+
+COMMIT;
@@ -0,0 +1,3 @@
+-- Builds the search documents of the existing catalog resources and the titles of their specifications.
+-- The procedure commits after each batch, so the call has to be the only statement of the migration and must not be wrapped in a transaction.
+CALL backfill_catalog_search(1000);
@@ -0,0 +1,8 @@
+BEGIN;
+
+UPDATE operation
+SET data = data - 'registry'
+WHERE op_type = 'SAAS_REGISTRY_DISCOVERY'
+  AND data ->> 'registry' = 'saas-registry';
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+UPDATE operation
+SET data = data || '{"registry": "saas-registry"}'::jsonb
+WHERE op_type = 'SAAS_REGISTRY_DISCOVERY'
+  AND NOT data ? 'registry';
+
+COMMIT;
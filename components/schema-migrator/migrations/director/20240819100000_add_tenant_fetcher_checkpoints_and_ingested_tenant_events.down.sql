@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS ingested_tenant_events;
+DROP TABLE IF EXISTS tenant_fetcher_checkpoints;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE tenant_fetcher_checkpoints
+(
+    job_name                       VARCHAR(256) PRIMARY KEY,
+    last_consumed_tenant_timestamp BIGINT    NOT NULL,
+    last_full_resync_timestamp     BIGINT    NOT NULL,
+    replay_from                    BIGINT,
+    updated_at                     TIMESTAMP NOT NULL
+);
+
+CREATE TABLE ingested_tenant_events
+(
+    source      VARCHAR(512) NOT NULL,
+    event_id    VARCHAR(256) NOT NULL,
+    event_type  VARCHAR(256) NOT NULL,
+    job_name    VARCHAR(256) NOT NULL,
+    ingested_at TIMESTAMP    NOT NULL,
+    PRIMARY KEY (source, event_id)
+);
+
+CREATE INDEX ingested_tenant_events_ingested_at_idx ON ingested_tenant_events (ingested_at);
+
+COMMIT;
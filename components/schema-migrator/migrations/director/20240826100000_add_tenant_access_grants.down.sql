@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TRIGGER delete_tenant_access_grants_runtime_context ON runtime_contexts;
+DROP TRIGGER delete_tenant_access_grants_runtime ON runtimes;
+DROP TRIGGER delete_tenant_access_grants_application ON applications;
+
+DROP FUNCTION delete_tenant_access_grants_of_resource();
+
+DROP TABLE IF EXISTS tenant_access_grants;
+
+COMMIT;
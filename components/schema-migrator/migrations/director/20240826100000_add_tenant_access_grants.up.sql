@@ -0,0 +1,47 @@
+BEGIN;
+
+CREATE TABLE tenant_access_grants
+(
+    tenant_id     UUID         NOT NULL REFERENCES business_tenant_mappings (id) ON DELETE CASCADE,
+    resource_type VARCHAR(256) NOT NULL,
+    resource_id   UUID         NOT NULL,
+    granted_by    VARCHAR(256),
+    reason        TEXT,
+    created_at    TIMESTAMP    NOT NULL,
+    expires_at    TIMESTAMP,
+    PRIMARY KEY (tenant_id, resource_type, resource_id)
+);
+
+CREATE INDEX tenant_access_grants_resource_idx ON tenant_access_grants (resource_type, resource_id);
+CREATE INDEX tenant_access_grants_expires_at_idx ON tenant_access_grants (expires_at) WHERE expires_at IS NOT NULL;
+
+-- The grants reference resources of different types, so they cannot be removed with a foreign key on deletion of the resource
+CREATE OR REPLACE FUNCTION delete_tenant_access_grants_of_resource()
+    RETURNS TRIGGER
+AS
+$$
+BEGIN
+    DELETE FROM tenant_access_grants WHERE resource_type = TG_ARGV[0] AND resource_id = OLD.id;
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER delete_tenant_access_grants_application
+    AFTER DELETE
+    ON applications
+    FOR EACH ROW
+EXECUTE PROCEDURE delete_tenant_access_grants_of_resource('application');
+
+CREATE TRIGGER delete_tenant_access_grants_runtime
+    AFTER DELETE
+    ON runtimes
+    FOR EACH ROW
+EXECUTE PROCEDURE delete_tenant_access_grants_of_resource('runtime');
+
+CREATE TRIGGER delete_tenant_access_grants_runtime_context
+    AFTER DELETE
+    ON runtime_contexts
+    FOR EACH ROW
+EXECUTE PROCEDURE delete_tenant_access_grants_of_resource('runtimeContext');
+
+COMMIT;